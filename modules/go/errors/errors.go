@@ -19,6 +19,35 @@ This package provides common errors used within the Nyx project.
 */
 package errors
 
+/*
+An error meaning that a remote rejected an update because it conflicts with its current contents, like
+a push that is not a fast forward or a tag that already exists on the remote.
+
+You can create errors like this as:
+&ConflictError{Message: fmt.Sprintf("conflict error: %s", description)}
+*/
+type ConflictError struct {
+	// The error message
+	Message string
+
+	// The optional wrapped error
+	Cause error
+}
+
+// Returns the error message
+func (e ConflictError) Error() string {
+	if e.Cause == nil {
+		return e.Message
+	} else {
+		return e.Message + ": " + e.Cause.Error()
+	}
+}
+
+// Returns the wrapped error, if any, or nil
+func (e ConflictError) GetCause() error {
+	return e.Cause
+}
+
 /*
 This error models an issue pertaining data access. Examples are when data can't be
 read from or written to the file system or from/to the network.
@@ -216,6 +245,64 @@ func (e NilPointerError) GetCause() error {
 	return e.Cause
 }
 
+/*
+This error models the case where no significant commits are found since the previous version so there is nothing
+to release and the configuration requires this to be a failure.
+
+You can create errors like this as:
+&NothingToReleaseError{Message: fmt.Sprintf("nothing to release since version '%s'", version)}
+*/
+type NothingToReleaseError struct {
+	// The error message
+	Message string
+
+	// The optional wrapped error
+	Cause error
+}
+
+// Returns the error message
+func (e NothingToReleaseError) Error() string {
+	if e.Cause == nil {
+		return e.Message
+	} else {
+		return e.Message + ": " + e.Cause.Error()
+	}
+}
+
+// Returns the wrapped error, if any, or nil
+func (e NothingToReleaseError) GetCause() error {
+	return e.Cause
+}
+
+/*
+An error meaning that a release has only been partially published, as it has been published to some services
+but the publication failed on others or when uploading its assets.
+
+You can create errors like this as:
+&PartialPublishError{Message: fmt.Sprintf("partial publish error: %s", description)}
+*/
+type PartialPublishError struct {
+	// The error message
+	Message string
+
+	// The optional wrapped error
+	Cause error
+}
+
+// Returns the error message
+func (e PartialPublishError) Error() string {
+	if e.Cause == nil {
+		return e.Message
+	} else {
+		return e.Message + ": " + e.Cause.Error()
+	}
+}
+
+// Returns the wrapped error, if any, or nil
+func (e PartialPublishError) GetCause() error {
+	return e.Cause
+}
+
 /*
 This error models an issue about a regular expression syntax.
 
@@ -328,6 +415,35 @@ func (e ServiceError) GetCause() error {
 	return e.Cause
 }
 
+/*
+An error meaning that an operation reached the boundary of a shallow repository, where the history is truncated
+and the commits beyond the boundary are not available locally.
+
+You can create errors like this as:
+&ShallowRepositoryError{Message: fmt.Sprintf("shallow repository error: %s", description)}
+*/
+type ShallowRepositoryError struct {
+	// The error message
+	Message string
+
+	// The optional wrapped error
+	Cause error
+}
+
+// Returns the error message
+func (e ShallowRepositoryError) Error() string {
+	if e.Cause == nil {
+		return e.Message
+	} else {
+		return e.Message + ": " + e.Cause.Error()
+	}
+}
+
+// Returns the wrapped error, if any, or nil
+func (e ShallowRepositoryError) GetCause() error {
+	return e.Cause
+}
+
 /*
 An error meaning that something in the transport or connection went wrong.
 
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This is the clock package for Nyx, providing the time source used for all the timestamps Nyx produces (like
+the state timestamp, tags, commits and changelog dates).
+
+The clock defaults to the system clock but it can be replaced, for example, to freeze the time in tests or
+to pin timestamps to a given instant so that builds are reproducible.
+*/
+package clock
+
+import (
+	"sync" // https://pkg.go.dev/sync
+	"time" // https://pkg.go.dev/time
+)
+
+/*
+The time source.
+*/
+type Clock interface {
+	/*
+		Returns the current time according to this clock.
+	*/
+	Now() time.Time
+}
+
+/*
+The clock returning the system time.
+*/
+type systemClock struct{}
+
+/*
+Returns the current system time.
+*/
+func (c systemClock) Now() time.Time {
+	return time.Now()
+}
+
+/*
+The clock always returning the same instant.
+*/
+type fixedClock struct {
+	// The instant returned by this clock.
+	instant time.Time
+}
+
+/*
+Returns the instant this clock has been created with.
+*/
+func (c fixedClock) Now() time.Time {
+	return c.instant
+}
+
+var (
+	// The clock currently in use.
+	current Clock = systemClock{}
+
+	// The mutex guarding the current clock.
+	mutex sync.RWMutex
+)
+
+/*
+Returns a new clock returning the system time.
+*/
+func NewSystemClock() Clock {
+	return systemClock{}
+}
+
+/*
+Returns a new clock always returning the given instant.
+
+Arguments are as follows:
+
+- instant the instant returned by the clock
+*/
+func NewFixedClock(instant time.Time) Clock {
+	return fixedClock{instant: instant}
+}
+
+/*
+Returns the clock currently in use.
+*/
+func GetClock() Clock {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	return current
+}
+
+/*
+Sets the clock to use from now on. When the given clock is nil the system clock is restored.
+
+Arguments are as follows:
+
+- clock the clock to use
+*/
+func SetClock(clock Clock) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if clock == nil {
+		current = systemClock{}
+	} else {
+		current = clock
+	}
+}
+
+/*
+Returns the current time according to the clock currently in use.
+*/
+func Now() time.Time {
+	return GetClock().Now()
+}
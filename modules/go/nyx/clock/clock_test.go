@@ -0,0 +1,59 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clock
+
+import (
+	"testing" // https://pkg.go.dev/testing
+	"time"    // https://pkg.go.dev/time
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+func TestSystemClockNow(t *testing.T) {
+	before := time.Now()
+	now := NewSystemClock().Now()
+	after := time.Now()
+	assert.False(t, now.Before(before))
+	assert.False(t, now.After(after))
+}
+
+func TestFixedClockNow(t *testing.T) {
+	instant := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := NewFixedClock(instant)
+	assert.Equal(t, instant, clock.Now())
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, instant, clock.Now())
+}
+
+func TestSetClock(t *testing.T) {
+	defer SetClock(nil)
+
+	instant := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	SetClock(NewFixedClock(instant))
+	assert.Equal(t, instant, Now())
+	assert.Equal(t, instant, GetClock().Now())
+
+	// nil restores the system clock
+	SetClock(nil)
+	assert.NotEqual(t, instant, Now())
+	assert.Equal(t, NewSystemClock(), GetClock())
+}
@@ -17,16 +17,17 @@
 package command
 
 import (
-	"fmt"     // https://pkg.go.dev/fmt
-	"os"      // https://pkg.go.dev/os
-	"strings" // https://pkg.go.dev/strings
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
 
-	regexp2 "github.com/dlclark/regexp2" // https://pkg.go.dev/github.com/dlclark/regexp2, we need to use this instead of the standard 'regexp' to have support for lookarounds (look ahead), even if this implementation is a little slower
-	log "github.com/sirupsen/logrus"     // https://pkg.go.dev/github.com/sirupsen/logrus
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
 
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
 	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
+	pol "github.com/mooltiverse/nyx/modules/go/nyx/policy"
 	svc "github.com/mooltiverse/nyx/modules/go/nyx/services"
 	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
 	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
@@ -92,6 +93,39 @@ func (ac *abstractCommand) isRepositoryClean() (bool, error) {
 	return (*ac.repository).IsClean()
 }
 
+/*
+Returns the reference to the configured changelog file, if configured, or nil
+of no destination file has been set by the configuration.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (ac *abstractCommand) getChangelogFile() (*string, error) {
+	changelogConfiguration, err := ac.State().GetConfiguration().GetChangelog()
+	if err != nil {
+		return nil, err
+	}
+	if changelogConfiguration == nil || changelogConfiguration.GetPath() == nil || "" == strings.TrimSpace(*changelogConfiguration.GetPath()) {
+		return nil, nil
+	}
+
+	changelogFile := *changelogConfiguration.GetPath()
+	// if the file path is relative make it relative to the configured directory
+	if !filepath.IsAbs(changelogFile) {
+		configurationDirectory, err := ac.State().GetConfiguration().GetDirectory()
+		if err != nil {
+			return nil, err
+		}
+		if configurationDirectory != nil {
+			changelogFile = filepath.Join(*configurationDirectory, changelogFile)
+		}
+	}
+
+	return &changelogFile, nil
+}
+
 /*
 Returns true if the internal attributes map contains an attribute with the given name and its value
 equals the given expected value.
@@ -168,6 +202,97 @@ func (ac *abstractCommand) putInternalAttribute(attributeName string, attributeV
 	return nil
 }
 
+/*
+Appends the given line to the multi-line attribute with the given name in the internal attributes map, unless the
+attribute already contains the same line.
+
+Arguments are as follows:
+
+- attributeName the name of the attribute to append the line to
+- line the line to append
+
+Error is only returned under internal unexpected conditions.
+*/
+func (ac *abstractCommand) appendInternalAttributeLine(attributeName string, line string) error {
+	lines, err := ac.getInternalAttributeLines(attributeName)
+	if err != nil {
+		return err
+	}
+	for _, l := range lines {
+		if l == line {
+			return nil
+		}
+	}
+	value := strings.Join(append(lines, line), "\n")
+	return ac.putInternalAttribute(attributeName, &value)
+}
+
+/*
+Returns the lines of the multi-line attribute with the given name from the internal attributes map, or an empty
+slice if the attribute is not available.
+
+Arguments are as follows:
+
+- attributeName the name of the attribute to get
+
+Error is only returned under internal unexpected conditions.
+*/
+func (ac *abstractCommand) getInternalAttributeLines(attributeName string) ([]string, error) {
+	value, err := ac.getInternalAttribute(attributeName)
+	if err != nil {
+		return nil, err
+	}
+	lines := []string{}
+	if value == nil || "null" == *value {
+		return lines, nil
+	}
+	for _, line := range strings.Split(*value, "\n") {
+		if "" != strings.TrimSpace(line) {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+/*
+Removes the attribute with the given name from the internal attributes map, if present.
+
+Arguments are as follows:
+
+- attributeName the name of the attribute to remove
+
+Error is only returned under internal unexpected conditions.
+*/
+func (ac *abstractCommand) removeInternalAttribute(attributeName string) error {
+	internals, err := ac.state.GetInternals()
+	if err != nil {
+		return err
+	}
+	delete(*internals, attributeName)
+	return nil
+}
+
+/*
+Returns the value of the environment variable with the given name, or nil if the name is nil or blank or the
+environment variable is not defined or is empty. This is used to read values from environment variables whose
+names are configured by users, so that they can follow their own naming conventions.
+
+Arguments are as follows:
+
+- name the name of the environment variable to read. It may be nil.
+*/
+func valueOfEnvironmentVariable(name *string) *string {
+	if name == nil || "" == strings.TrimSpace(*name) {
+		return nil
+	}
+	value, ok := os.LookupEnv(strings.TrimSpace(*name))
+	if !ok || "" == value {
+		log.Debugf("the environment variable '%s' is not defined or is empty", strings.TrimSpace(*name))
+		return nil
+	}
+	return &value
+}
+
 /*
 Renders the given template using the internal State object as the context.
 
@@ -179,6 +304,22 @@ Error is:
 - IllegalPropertyError in case the given template can't be rendered.
 */
 func (ac *abstractCommand) renderTemplate(template *string) (*string, error) {
+	return ac.renderTemplateWithData(template, nil)
+}
+
+/*
+Renders the given template using the internal State object as the context and the given data
+as the private variables available to the template (i.e. {{@name}}).
+
+Arguments are as follows:
+
+- template the string template to render.
+- data the private variables to make available to the template, by name. It may be nil.
+
+Error is:
+- IllegalPropertyError in case the given template can't be rendered.
+*/
+func (ac *abstractCommand) renderTemplateWithData(template *string, data map[string]interface{}) (*string, error) {
 	if template == nil {
 		return nil, nil
 	}
@@ -189,7 +330,7 @@ func (ac *abstractCommand) renderTemplate(template *string) (*string, error) {
 		if err != nil {
 			return nil, &errs.IllegalStateError{Message: fmt.Sprintf("the internal state cannot be flattened for rendering"), Cause: err}
 		}
-		res, err := tpl.Render(*template, flatState)
+		res, err := tpl.RenderWithData(*template, flatState, data)
 		if err != nil {
 			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("template '%s' cannot be rendered using the current state", *template), Cause: err}
 		}
@@ -237,6 +378,51 @@ func (ac *abstractCommand) renderTemplateAsInteger(template *string) (int64, err
 	}
 }
 
+/*
+Evaluates the given CEL policy expression using the internal State object as the context, returning
+the boolean outcome.
+
+Arguments are as follows:
+
+- expression the CEL expression to evaluate.
+
+Error is:
+- IllegalPropertyError in case the given expression can't be evaluated or doesn't evaluate to a boolean.
+*/
+func (ac *abstractCommand) evaluatePolicy(expression *string) (bool, error) {
+	if expression == nil {
+		return false, &errs.NilPointerError{Message: "the policy expression cannot be nil"}
+	}
+	return pol.Evaluate(*expression, ac.state)
+}
+
+/*
+Returns true if the gate policy of the given release type, if any, allows the release to be issued.
+When the release type has no gate policy the release is always allowed.
+
+Arguments are as follows:
+
+- releaseType the release type to evaluate the gate policy for
+
+Error is:
+- IllegalPropertyError in case the gate policy can't be evaluated or doesn't evaluate to a boolean.
+*/
+func (ac *abstractCommand) isReleaseGateOpen(releaseType *ent.ReleaseType) (bool, error) {
+	if releaseType == nil || releaseType.GetGatePolicy() == nil || "" == strings.TrimSpace(*releaseType.GetGatePolicy()) {
+		return true, nil
+	}
+	open, err := ac.evaluatePolicy(releaseType.GetGatePolicy())
+	if err != nil {
+		return false, err
+	}
+	if !open {
+		log.Warnf("the release type gate policy '%s' evaluates to false so the release is not issued", *releaseType.GetGatePolicy())
+	} else {
+		log.Debugf("the release type gate policy '%s' evaluates to true", *releaseType.GetGatePolicy())
+	}
+	return open, nil
+}
+
 /*
 Resolves the given options by rendering each value of the given map as a template. Keys are left unchanged.
 
@@ -333,100 +519,17 @@ func (ac *abstractCommand) resolveReleaseType() (*ent.ReleaseType, error) {
 			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("release type '%s' is configured among enabled ones but is not configured", *releaseTypeName)}
 		}
 
-		// evaluate the matching criteria: branch name
-		if releaseType.GetMatchBranches() == nil || "" == strings.TrimSpace(*releaseType.GetMatchBranches()) {
-			log.Debugf("release type '%s' does not specify any branch name requirement", *releaseTypeName)
-		} else {
-			matchBranchesRendered, err := ac.renderTemplate(releaseType.GetMatchBranches())
-			if err != nil {
-				return nil, err
-			}
-			if "" == strings.TrimSpace(*matchBranchesRendered) {
-				log.Debugf("release type '%s' specifies a match branches template '%s' that evaluates to an empty regular expression", *releaseTypeName, *releaseType.GetMatchBranches())
-			} else {
-				log.Debugf("release type '%s' specifies a match branches template '%s' that evaluates to regular expression: '%s'", *releaseTypeName, *releaseType.GetMatchBranches(), *matchBranchesRendered)
-				re, err := regexp2.Compile(*matchBranchesRendered, 0)
-				if err != nil {
-					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("release type '%s' has a malformed matchBranches regular expression: '%s' (was '%s' before rendering the template rendering)", *releaseTypeName, *matchBranchesRendered, *releaseType.GetMatchBranches()), Cause: err}
-				}
-				currentBranch, err := ac.getCurrentBranch()
-				if err != nil {
-					return nil, err
-				}
-				match, err := re.MatchString(currentBranch)
-				if match {
-					log.Debugf("current branch '%s' successfully matched by release type '%s' matchBranches regular expression '%s'", currentBranch, *releaseTypeName, *matchBranchesRendered)
-				} else {
-					log.Debugf("current branch '%s' not matched by release type '%s' matchBranches regular expression '%s'. Skipping release type '%s'", currentBranch, *releaseTypeName, *matchBranchesRendered, *releaseTypeName)
-					continue
-				}
-			}
-		}
-
-		// evaluate the matching criteria: environment variables
-		if releaseType.GetMatchEnvironmentVariables() == nil || len(*releaseType.GetMatchEnvironmentVariables()) == 0 {
-			log.Debugf("release type '%s'  does not specify any environment variable requirement", *releaseTypeName)
-		} else {
-			mismatch := false
-			for varName, varVarueRegExp := range *releaseType.GetMatchEnvironmentVariables() {
-				log.Debugf("evaluating environment variable '%s' as required by release type '%s'", varName, *releaseTypeName)
-
-				varValue := os.Getenv(varName)
-
-				if "" == strings.TrimSpace(varValue) {
-					log.Debugf("environment variable '%s' is required by release type '%s' but is not defined in the current environment. Skipping release type '%s'", varName, *releaseTypeName, *releaseTypeName)
-					mismatch = true
-					continue
-				}
-
-				if "" == strings.TrimSpace(varVarueRegExp) {
-					log.Debugf("environment variable '%s' value successfully matched by release type '%s' regular expression '%s'", varName, *releaseTypeName, varVarueRegExp)
-				} else {
-					re, err := regexp2.Compile(varVarueRegExp, 0)
-					if err != nil {
-						return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("release type '%s' has a malformed environment variable regular expression '%s' to match for environment variable '%s'", *releaseTypeName, varVarueRegExp, varName), Cause: err}
-					}
-					match, err := re.MatchString(varValue)
-					if match {
-						log.Debugf("environment variable '%s' value successfully matched by release type '%s' regular expression '%s'", varName, *releaseTypeName, varVarueRegExp)
-					} else {
-						log.Debugf("environment variable '%s' value not matched by release type '%s' regular expression '%s'", varName, *releaseTypeName, varVarueRegExp)
-						mismatch = true
-						continue
-					}
-				}
-			}
-
-			if mismatch {
-				log.Debugf("environment variables not matched by release type '%s'", *releaseTypeName)
-				continue
-			}
+		// evaluate the matching criteria
+		match, err := ac.matchReleaseType(*releaseTypeName, releaseType)
+		if err != nil {
+			return nil, err
 		}
-
-		// evaluate the matching criteria: workspace status
-		if releaseType.GetMatchWorkspaceStatus() == nil {
-			log.Debugf("release type '%s' does not specify any workspace status requirement", *releaseTypeName)
-		} else {
-			repoClean, err := ac.isRepositoryClean()
-			if err != nil {
-				return nil, err
-			}
-			repoCleanString := ""
-			if repoClean {
-				repoCleanString = "CLEAN"
-			} else {
-				repoCleanString = "DIRTY"
-			}
-
-			if (*releaseType.GetMatchWorkspaceStatus() == ent.CLEAN && repoClean) || (*releaseType.GetMatchWorkspaceStatus() == ent.DIRTY && !repoClean) {
-				log.Debugf("current repository status '%s' successfully matched by release type '%s' matchWorkspaceStatus filter '%s'", repoCleanString, *releaseTypeName, (*releaseType.GetMatchWorkspaceStatus()).String())
-			} else {
-				log.Debugf("current repository status '%s' not matched by release type '%s' matchWorkspaceStatus filter '%s'. Skipping release type '%s'", repoCleanString, *releaseTypeName, (*releaseType.GetMatchWorkspaceStatus()).String(), *releaseTypeName)
-				continue
-			}
+		if !match {
+			log.Debugf("release type '%s' not matched by the current environment. Skipping release type '%s'", *releaseTypeName, *releaseTypeName)
+			continue
 		}
 
-		// if we reached this point the release type matches all of the filters so it can be returned
+		// if we reached this point the release type matches the filters so it can be returned
 		log.Debugf("release type '%s' has been selected", *releaseTypeName)
 		return releaseType, nil
 	}
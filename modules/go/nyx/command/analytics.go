@@ -0,0 +1,557 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"sort"          // https://pkg.go.dev/sort
+	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	ver "github.com/mooltiverse/nyx/modules/go/version"
+)
+
+const (
+	// The maximum number of contributors reported for each release.
+	ANALYTICS_RELEASE_CONTRIBUTORS = 3
+
+	// The maximum number of contributors reported in the summary.
+	ANALYTICS_SUMMARY_CONTRIBUTORS = 10
+
+	// The number of milliseconds in a day, used to turn commit dates into durations.
+	millisecondsPerDay = float64(24 * 60 * 60 * 1000)
+)
+
+/*
+The Analytics command is a reporting command that walks all the releases tagged in the commit history and
+reports their cadence, the lead time from the first commit to the release, the distribution of the bump types
+and the top contributors of each release.
+
+The report is written to the file configured by the 'analyticsFile' global option, as JSON when the file has
+the .json extension or as Markdown otherwise. When the option is not set the Markdown report is printed to the
+standard output.
+
+This command doesn't depend on the release process and never changes the state or the repository.
+
+This class is not meant to be used in multi-threaded environments.
+*/
+type Analytics struct {
+	// Extend abstractCommand by composition
+	abstractCommand
+}
+
+/*
+A contributor to one or more releases.
+*/
+type analyticsContributor struct {
+	// The contributor name.
+	Name string `json:"name"`
+
+	// The contributor email.
+	Email string `json:"email"`
+
+	// The number of commits authored by the contributor.
+	Commits int `json:"commits"`
+}
+
+/*
+The metrics of a single release.
+*/
+type analyticsRelease struct {
+	// The released version.
+	Version string `json:"version"`
+
+	// The SHA-1 identifier of the released commit.
+	Commit string `json:"commit"`
+
+	// The date of the released commit, in RFC 3339 format.
+	Date string `json:"date"`
+
+	// The number of commits in the release, including the released one.
+	Commits int `json:"commits"`
+
+	// The days between the oldest commit in the release and the released commit.
+	LeadTimeDays float64 `json:"leadTimeDays"`
+
+	// The days since the previous release. Nil for the first release.
+	IntervalDays *float64 `json:"intervalDays,omitempty"`
+
+	// The type of bump from the previous release: initial, major, minor, patch, revision, prerelease or promotion.
+	// Empty when the version scheme doesn't expose the core identifiers the bump type is computed from.
+	Bump string `json:"bump,omitempty"`
+
+	// The top contributors of the release, the most active first.
+	Contributors []analyticsContributor `json:"contributors"`
+}
+
+/*
+The metrics aggregated over all releases.
+*/
+type analyticsSummary struct {
+	// The number of releases.
+	Releases int `json:"releases"`
+
+	// The average number of days between two consecutive releases.
+	AverageIntervalDays float64 `json:"averageIntervalDays"`
+
+	// The average lead time of releases, in days.
+	AverageLeadTimeDays float64 `json:"averageLeadTimeDays"`
+
+	// The number of releases by bump type.
+	Bumps map[string]int `json:"bumps"`
+
+	// The top contributors across all releases, the most active first.
+	Contributors []analyticsContributor `json:"contributors"`
+}
+
+/*
+The analytics report, with releases sorted from the oldest to the most recent.
+*/
+type analyticsReport struct {
+	// The metrics of each release.
+	Releases []analyticsRelease `json:"releases"`
+
+	// The aggregated metrics.
+	Summary analyticsSummary `json:"summary"`
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- state the state reference
+- repository the repository reference
+
+Error is:
+
+- NilPointerError: if a given argument is nil
+*/
+func NewAnalytics(state *stt.State, repository *git.Repository) (*Analytics, error) {
+	if state == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the State object cannot be nil")}
+	}
+	if repository == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the Repository object cannot be nil")}
+	}
+	log.Debugf("new Analytics command object")
+
+	res := &Analytics{}
+	res.abstractCommand.repository = repository
+	res.abstractCommand.state = state
+	return res, nil
+}
+
+/*
+The core identifiers of a version the bump type is computed from, exposed by both SemanticVersion and
+FourSegmentVersion. Versions from custom schemes may not implement this.
+*/
+type analyticsCoreVersion interface {
+	GetMajor() int
+	GetMinor() int
+	GetPatch() int
+	GetPrerelease() *string
+}
+
+/*
+The additional core identifier exposed by FourSegmentVersion.
+*/
+type analyticsRevisionVersion interface {
+	GetRevision() int
+}
+
+/*
+Returns the type of bump between the given versions, which is 'initial' when there is no previous version,
+'promotion' when the current version is the final release of the previous pre-release, 'prerelease' when only
+the pre-release identifiers have changed or the name of the most significant core identifier that has changed
+('major', 'minor', 'patch' or 'revision') otherwise. An empty string is returned when the versions don't
+expose their core identifiers, like for some custom version schemes.
+
+Arguments are as follows:
+
+- previous the previous version, if any
+- current the current version
+*/
+func analyticsBumpType(previous ver.Version, current ver.Version) string {
+	if previous == nil {
+		return "initial"
+	}
+	currentCore, ok := current.(analyticsCoreVersion)
+	if !ok {
+		return ""
+	}
+	previousCore, ok := previous.(analyticsCoreVersion)
+	if !ok {
+		return ""
+	}
+	currentRevision, currentHasRevision := current.(analyticsRevisionVersion)
+	previousRevision, previousHasRevision := previous.(analyticsRevisionVersion)
+	switch {
+	case currentCore.GetMajor() != previousCore.GetMajor():
+		return "major"
+	case currentCore.GetMinor() != previousCore.GetMinor():
+		return "minor"
+	case currentCore.GetPatch() != previousCore.GetPatch():
+		return "patch"
+	case currentHasRevision && previousHasRevision && currentRevision.GetRevision() != previousRevision.GetRevision():
+		return "revision"
+	case currentCore.GetPrerelease() == nil && previousCore.GetPrerelease() != nil:
+		return "promotion"
+	default:
+		return "prerelease"
+	}
+}
+
+/*
+Returns up to limit contributors from the given map, sorted by number of commits (descending) and then by name.
+
+Arguments are as follows:
+
+- contributors the contributors, keyed by their identity
+- limit the maximum number of contributors to return
+*/
+func analyticsTopContributors(contributors map[string]*analyticsContributor, limit int) []analyticsContributor {
+	res := make([]analyticsContributor, 0, len(contributors))
+	for _, contributor := range contributors {
+		res = append(res, *contributor)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Commits != res[j].Commits {
+			return res[i].Commits > res[j].Commits
+		}
+		if res[i].Name != res[j].Name {
+			return res[i].Name < res[j].Name
+		}
+		return res[i].Email < res[j].Email
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res
+}
+
+/*
+Adds the author of the given commit to the given contributors map.
+
+Arguments are as follows:
+
+- contributors the contributors, keyed by their identity
+- commit the commit to count
+*/
+func analyticsCountContributor(contributors map[string]*analyticsContributor, commit gitent.Commit) {
+	identity := commit.GetAuthorAction().GetIdentity()
+	key := strings.ToLower(strings.TrimSpace(identity.GetEmail()))
+	if "" == key {
+		key = strings.TrimSpace(identity.GetName())
+	}
+	if _, ok := contributors[key]; !ok {
+		contributors[key] = &analyticsContributor{Name: identity.GetName(), Email: identity.GetEmail()}
+	}
+	contributors[key].Commits++
+}
+
+/*
+Walks the commit history and builds the analytics report.
+
+Each commit tagged with a valid version (according to the configured scheme, release prefix and leniency) or
+with a version recorded by the version storage service marks a release, which includes all the untagged commits
+preceding it. When a commit has multiple versions the greatest is considered. Commits after the latest release
+are ignored.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+- ReleaseError if the stored versions can't be read from the version storage service.
+*/
+func (c *Analytics) buildReport() (*analyticsReport, error) {
+	scheme, err := c.State().GetScheme()
+	if err != nil {
+		return nil, err
+	}
+	releaseLenient, err := c.State().GetConfiguration().GetReleaseLenient()
+	if err != nil {
+		return nil, err
+	}
+	releasePrefix, err := c.State().GetConfiguration().GetReleasePrefix()
+	if err != nil {
+		return nil, err
+	}
+	prereleaseOrder, err := c.getPrereleaseOrder()
+	if err != nil {
+		return nil, err
+	}
+	isLegal := func(version string) bool {
+		_, version = splitEpoch(releaseLenient, releasePrefix, version)
+		return (*releaseLenient && ver.IsLegalWithLenience(*scheme, version, *releaseLenient)) || (!*releaseLenient && ver.IsLegalWithPrefix(*scheme, version, releasePrefix))
+	}
+	compare := func(v1 string, v2 string) int {
+		if *releaseLenient {
+			return ver.CompareWithSanitizationAndPrereleaseOrder(*scheme, &v1, &v2, *releaseLenient, prereleaseOrder)
+		}
+		return ver.CompareWithPrefixAndPrereleaseOrder(*scheme, &v1, &v2, releasePrefix, prereleaseOrder)
+	}
+	valueOf := func(version string) (ver.Version, error) {
+		_, version = splitEpoch(releaseLenient, releasePrefix, version)
+		if *releaseLenient {
+			return ver.ValueOfWithSanitization(*scheme, version, *releaseLenient)
+		}
+		return ver.ValueOfWithPrefix(*scheme, version, releasePrefix)
+	}
+	storedVersions, err := c.getStoredVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	// walk the history back collecting the commits of each release, the most recent first
+	type releaseSegment struct {
+		version string
+		commits []gitent.Commit
+	}
+	segments := make([]*releaseSegment, 0)
+	var current *releaseSegment = nil
+	err = (*c.repository).WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		version := ""
+		candidates := make([]string, 0)
+		for _, tag := range commit.GetTags() {
+			candidates = append(candidates, tag.GetName())
+		}
+		candidates = append(candidates, storedVersions[commit.GetSHA()]...)
+		for _, candidate := range candidates {
+			if isLegal(candidate) && ("" == version || compare(candidate, version) > 0) {
+				version = candidate
+			}
+		}
+		if "" != version {
+			log.Debugf("commit '%s' has been released as '%s'", commit.GetSHA(), version)
+			current = &releaseSegment{version: version, commits: make([]gitent.Commit, 0)}
+			segments = append(segments, current)
+		}
+		if current != nil {
+			current.commits = append(current.commits, commit)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &analyticsReport{Releases: make([]analyticsRelease, 0), Summary: analyticsSummary{Bumps: make(map[string]int)}}
+	allContributors := make(map[string]*analyticsContributor)
+	var previousVersion ver.Version = nil
+	var previousDate *int64 = nil
+	var totalInterval, totalLeadTime float64
+	for i := len(segments) - 1; i >= 0; i-- {
+		segment := segments[i]
+		releaseCommit := segment.commits[0]
+		oldestCommit := segment.commits[len(segment.commits)-1]
+		release := analyticsRelease{
+			Version:      segment.version,
+			Commit:       releaseCommit.GetSHA(),
+			Date:         time.UnixMilli(releaseCommit.GetDate()).UTC().Format(time.RFC3339),
+			Commits:      len(segment.commits),
+			LeadTimeDays: analyticsRoundDays(float64(releaseCommit.GetDate()-oldestCommit.GetDate()) / millisecondsPerDay),
+		}
+		if previousDate != nil {
+			interval := analyticsRoundDays(float64(releaseCommit.GetDate()-*previousDate) / millisecondsPerDay)
+			release.IntervalDays = &interval
+			totalInterval = totalInterval + interval
+		}
+		date := releaseCommit.GetDate()
+		previousDate = &date
+
+		version, err := valueOf(segment.version)
+		if err != nil {
+			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("unable to parse version '%s'", segment.version), Cause: err}
+		}
+		release.Bump = analyticsBumpType(previousVersion, version)
+		previousVersion = version
+
+		releaseContributors := make(map[string]*analyticsContributor)
+		for _, commit := range segment.commits {
+			analyticsCountContributor(releaseContributors, commit)
+			analyticsCountContributor(allContributors, commit)
+		}
+		release.Contributors = analyticsTopContributors(releaseContributors, ANALYTICS_RELEASE_CONTRIBUTORS)
+
+		totalLeadTime = totalLeadTime + release.LeadTimeDays
+		if release.Bump != "" {
+			report.Summary.Bumps[release.Bump]++
+		}
+		report.Releases = append(report.Releases, release)
+	}
+
+	report.Summary.Releases = len(report.Releases)
+	if len(report.Releases) > 1 {
+		report.Summary.AverageIntervalDays = analyticsRoundDays(totalInterval / float64(len(report.Releases)-1))
+	}
+	if len(report.Releases) > 0 {
+		report.Summary.AverageLeadTimeDays = analyticsRoundDays(totalLeadTime / float64(len(report.Releases)))
+	}
+	report.Summary.Contributors = analyticsTopContributors(allContributors, ANALYTICS_SUMMARY_CONTRIBUTORS)
+	return report, nil
+}
+
+/*
+Returns the given number of days rounded to two decimal digits.
+*/
+func analyticsRoundDays(days float64) float64 {
+	return float64(int64(days*100+0.5)) / 100
+}
+
+/*
+Renders the given report as Markdown.
+*/
+func (r *analyticsReport) markdown() string {
+	formatContributors := func(contributors []analyticsContributor) string {
+		names := make([]string, 0, len(contributors))
+		for _, contributor := range contributors {
+			names = append(names, fmt.Sprintf("%s (%d)", contributor.Name, contributor.Commits))
+		}
+		return strings.Join(names, ", ")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Release Analytics\n\n")
+	sb.WriteString("## Summary\n\n")
+	sb.WriteString(fmt.Sprintf("- Releases: %d\n", r.Summary.Releases))
+	sb.WriteString(fmt.Sprintf("- Average interval: %.2f days\n", r.Summary.AverageIntervalDays))
+	sb.WriteString(fmt.Sprintf("- Average lead time: %.2f days\n", r.Summary.AverageLeadTimeDays))
+	bumps := make([]string, 0, len(r.Summary.Bumps))
+	for bump := range r.Summary.Bumps {
+		bumps = append(bumps, bump)
+	}
+	sort.Strings(bumps)
+	for i, bump := range bumps {
+		bumps[i] = fmt.Sprintf("%s: %d", bump, r.Summary.Bumps[bump])
+	}
+	sb.WriteString(fmt.Sprintf("- Bumps: %s\n", strings.Join(bumps, ", ")))
+	sb.WriteString(fmt.Sprintf("- Top contributors: %s\n\n", formatContributors(r.Summary.Contributors)))
+
+	sb.WriteString("## Releases\n\n")
+	sb.WriteString("| Version | Date | Bump | Commits | Lead time (days) | Interval (days) | Top contributors |\n")
+	sb.WriteString("| ------- | ---- | ---- | ------- | ---------------- | --------------- | ---------------- |\n")
+	for _, release := range r.Releases {
+		interval := "-"
+		if release.IntervalDays != nil {
+			interval = fmt.Sprintf("%.2f", *release.IntervalDays)
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %s | %d | %.2f | %s | %s |\n", release.Version, release.Date, release.Bump, release.Commits, release.LeadTimeDays, interval, formatContributors(release.Contributors)))
+	}
+	return sb.String()
+}
+
+/*
+Builds the analytics report and writes it to the configured file or prints it to the standard output.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason or the file can't be written.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+- ReleaseError if the stored versions can't be read from the version storage service.
+*/
+func (c *Analytics) analytics() error {
+	report, err := c.buildReport()
+	if err != nil {
+		return err
+	}
+	log.Debugf("%d releases have been found in the commit history", len(report.Releases))
+
+	analyticsFile, err := c.State().GetConfiguration().GetAnalyticsFile()
+	if err != nil {
+		return err
+	}
+	if analyticsFile == nil || "" == strings.TrimSpace(*analyticsFile) {
+		fmt.Print(report.markdown())
+		return nil
+	}
+
+	path := strings.TrimSpace(*analyticsFile)
+	// if the file path is relative make it relative to the configured directory
+	if !filepath.IsAbs(path) {
+		configurationDirectory, err := c.State().GetConfiguration().GetDirectory()
+		if err != nil {
+			return err
+		}
+		if configurationDirectory != nil {
+			path = filepath.Join(*configurationDirectory, path)
+		}
+	}
+	var content []byte
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		content, err = json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return &errs.DataAccessError{Message: "unable to marshal the analytics report", Cause: err}
+		}
+		content = append(content, '\n')
+	} else {
+		content = []byte(report.markdown())
+	}
+	log.Debugf("writing the analytics report to '%s'", path)
+	err = os.WriteFile(path, content, 0644)
+	if err != nil {
+		return &errs.DataAccessError{Message: fmt.Sprintf("unable to write the analytics report to '%s'. Make sure the path to the file exists and can be written.", path), Cause: err}
+	}
+	return nil
+}
+
+/*
+Returns true if this command is up to date, which means that the internal State would not
+change by running the command again. It other words, when this method returns true any
+invocation of the Run method is needless and idempotent about the state.
+
+This command is never up to date as it's meant to produce its report every time it's invoked.
+
+Error is:
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (c *Analytics) IsUpToDate() (bool, error) {
+	log.Debugf("checking whether the Analytics command is up to date")
+	return false, nil
+}
+
+/*
+Runs the command and returns the updated reference to the state object. In order to improve performances you should only
+invoke this method when IsUpToDate returns false.
+
+Error is:
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+- ReleaseError if the task is unable to complete for reasons due to the release process.
+*/
+func (c *Analytics) Run() (*stt.State, error) {
+	err := c.analytics()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.State(), nil
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+const (
+	// The target to include the build metadata in the version held by the state.
+	BUILD_METADATA_TARGET_STATE = "STATE"
+
+	// The target to include the build metadata in the names of the tags applied to the repository.
+	BUILD_METADATA_TARGET_TAGS = "TAGS"
+
+	// The target to include the build metadata in the release names used in the changelog.
+	BUILD_METADATA_TARGET_CHANGELOG = "CHANGELOG"
+)
+
+/*
+Returns true if the build metadata of the given release type must be included in the given target, according to
+its buildMetadataTargets option. When the option is not set the build metadata is included in all targets.
+
+Error is:
+  - IllegalPropertyError in case the option can't be rendered or contains unknown targets.
+*/
+func (ac *abstractCommand) isBuildMetadataTarget(releaseType *ent.ReleaseType, target string) (bool, error) {
+	if releaseType.GetBuildMetadataTargets() == nil || "" == strings.TrimSpace(*releaseType.GetBuildMetadataTargets()) {
+		return true, nil
+	}
+	buildMetadataTargets, err := ac.renderTemplate(releaseType.GetBuildMetadataTargets())
+	if err != nil {
+		return false, err
+	}
+	res := false
+	for _, buildMetadataTarget := range strings.Split(*buildMetadataTargets, ",") {
+		buildMetadataTarget = strings.ToUpper(strings.TrimSpace(buildMetadataTarget))
+		switch buildMetadataTarget {
+		case "":
+			continue
+		case BUILD_METADATA_TARGET_STATE, BUILD_METADATA_TARGET_TAGS, BUILD_METADATA_TARGET_CHANGELOG:
+			if buildMetadataTarget == target {
+				res = true
+			}
+		default:
+			return false, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal build metadata target '%s' in buildMetadataTargets: '%s'. Allowed values are '%s', '%s' and '%s'", buildMetadataTarget, *buildMetadataTargets, BUILD_METADATA_TARGET_STATE, BUILD_METADATA_TARGET_TAGS, BUILD_METADATA_TARGET_CHANGELOG)}
+		}
+	}
+	return res, nil
+}
+
+/*
+Returns the state version as it must appear in the given target, which means with or without the build metadata
+inferred for the release type, depending on whether the build metadata is included in the state and in the given
+target. When no build metadata has been inferred the state version is returned unchanged.
+
+Error is:
+  - DataAccessError in case the state can't be read for some reason.
+  - IllegalPropertyError in case the release type has some illegal options.
+*/
+func (ac *abstractCommand) getVersionForBuildMetadataTarget(target string) (*string, error) {
+	version, err := ac.State().GetVersion()
+	if err != nil {
+		return nil, err
+	}
+	if version == nil || !ac.State().HasBuildMetadata() || !ac.State().HasReleaseType() {
+		return version, nil
+	}
+	releaseType, err := ac.State().GetReleaseType()
+	if err != nil {
+		return nil, err
+	}
+	inState, err := ac.isBuildMetadataTarget(releaseType, BUILD_METADATA_TARGET_STATE)
+	if err != nil {
+		return nil, err
+	}
+	inTarget, err := ac.isBuildMetadataTarget(releaseType, target)
+	if err != nil {
+		return nil, err
+	}
+	if inState == inTarget {
+		return version, nil
+	}
+
+	buildMetadata, err := ac.State().GetBuildMetadata()
+	if err != nil {
+		return nil, err
+	}
+	var res string
+	if inState {
+		// strip the build metadata, along with its separator, from the end of the state version
+		if strings.HasSuffix(*version, "+"+*buildMetadata) {
+			res = strings.TrimSuffix(*version, "+"+*buildMetadata)
+		} else if strings.HasSuffix(*version, "."+*buildMetadata) {
+			res = strings.TrimSuffix(*version, "."+*buildMetadata)
+		} else {
+			log.Warnf("the version '%s' does not end with the build metadata '%s' so it's used unchanged for the '%s' target", *version, *buildMetadata, target)
+			return version, nil
+		}
+	} else {
+		if strings.Contains(*version, "+") {
+			res = *version + "." + *buildMetadata
+		} else {
+			res = *version + "+" + *buildMetadata
+		}
+	}
+	log.Debugf("the version for the '%s' target is '%s'", target, res)
+	return &res, nil
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	ver "github.com/mooltiverse/nyx/modules/go/version"
+)
+
+/*
+Returns the patch identifiers of the commits that have been released on branches other than the current one,
+mapped to the SHA-1 of the commits they belong to. These are the commits reachable from version tags that are
+not reachable from the current branch, back to the point where the other branch forked off.
+
+Commits in the current branch whose patch identifier is among the returned ones have been cherry-picked from
+(or to) a version already released on another branch.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (ac *abstractCommand) getPatchIDsReleasedOnOtherBranches() (map[string]string, error) {
+	scheme, err := ac.State().GetScheme()
+	if err != nil {
+		return nil, err
+	}
+	releaseLenient, err := ac.State().GetConfiguration().GetReleaseLenient()
+	if err != nil {
+		return nil, err
+	}
+	releasePrefix, err := ac.State().GetConfiguration().GetReleasePrefix()
+	if err != nil {
+		return nil, err
+	}
+
+	// collect the commits in the current branch so they can be told apart from those in other branches
+	currentBranchCommits := make(map[string]bool)
+	err = (*ac.repository).WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		currentBranchCommits[commit.GetSHA()] = true
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := (*ac.repository).GetTags()
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]string)
+	visited := make(map[string]bool)
+	for _, tag := range tags {
+		_, tagVersion := splitEpoch(releaseLenient, releasePrefix, tag.GetName())
+		if !((*releaseLenient && ver.IsLegalWithLenience(*scheme, tagVersion, *releaseLenient)) || (!*releaseLenient && ver.IsLegalWithPrefix(*scheme, tagVersion, releasePrefix))) {
+			continue
+		}
+		target := tag.GetTarget()
+		if currentBranchCommits[target] {
+			continue
+		}
+		log.Debugf("tag '%s' is a version released on another branch, collecting the patch identifiers of its commits", tag.GetName())
+		var walkErr error
+		err = (*ac.repository).WalkHistory(&target, nil, func(commit gitent.Commit) bool {
+			// stop when reaching the current branch or commits that have already been inspected for other tags
+			if currentBranchCommits[commit.GetSHA()] || visited[commit.GetSHA()] {
+				return false
+			}
+			visited[commit.GetSHA()] = true
+			patchID, err := (*ac.repository).GetCommitPatchID(commit.GetSHA())
+			if err != nil {
+				walkErr = err
+				return false
+			}
+			if "" != patchID {
+				res[patchID] = commit.GetSHA()
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		if walkErr != nil {
+			return nil, walkErr
+		}
+	}
+	log.Debugf("%d commits have been released on other branches", len(res))
+	return res, nil
+}
+
+/*
+Returns the SHA-1 of the commit released on another branch that introduces the same changes of the given commit, or
+an empty string if the given commit has not been cherry-picked from (or to) a version released on another branch.
+
+Arguments are as follows:
+
+  - commit the SHA-1 of the commit to check
+  - releasedPatchIDs the patch identifiers of the commits released on other branches, as returned by
+    getPatchIDsReleasedOnOtherBranches()
+
+Error is:
+  - GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (ac *abstractCommand) getReleasedCherryPick(commit string, releasedPatchIDs map[string]string) (string, error) {
+	if len(releasedPatchIDs) == 0 {
+		return "", nil
+	}
+	patchID, err := (*ac.repository).GetCommitPatchID(commit)
+	if err != nil {
+		return "", err
+	}
+	if "" == patchID {
+		return "", nil
+	}
+	return releasedPatchIDs[patchID], nil
+}
@@ -129,6 +129,30 @@ func (c *Clean) IsUpToDate() (bool, error) {
 		}
 	}
 
+	// Check if there are badge files
+	badgesDirectoryPath, err := c.State().GetConfiguration().GetBadgesDirectory()
+	if err != nil {
+		return false, err
+	}
+	if badgesDirectoryPath != nil && "" != strings.TrimSpace(*badgesDirectoryPath) {
+		// if the directory path is relative make it relative to the configured directory
+		if !filepath.IsAbs(*badgesDirectoryPath) {
+			directory, err := c.State().GetConfiguration().GetDirectory()
+			if err != nil {
+				return false, err
+			}
+			badgesDirectoryAbsolutePath := filepath.Join(*directory, *badgesDirectoryPath)
+			badgesDirectoryPath = &badgesDirectoryAbsolutePath
+		}
+		for _, badgeFileName := range []string{stt.VERSION_BADGE_FILE_NAME, stt.RELEASE_DATE_BADGE_FILE_NAME} {
+			_, err := os.Stat(filepath.Join(*badgesDirectoryPath, badgeFileName))
+			if err == nil {
+				log.Debugf("the Clean command is not up to date because the badges directory has been configured ('%s') and the '%s' badge is present on the file system so it can be deleted", *badgesDirectoryPath, badgeFileName)
+				return false, nil
+			}
+		}
+	}
+
 	// Check if there a Changelog file
 	changelogConfiguration, err := c.State().GetConfiguration().GetChangelog()
 	if err != nil {
@@ -154,6 +178,16 @@ func (c *Clean) IsUpToDate() (bool, error) {
 		}
 	}
 
+	// Check if there are tags applied by the Mark command
+	tags, err := c.getInternalAttributeLines(MARK_INTERNAL_OUTPUT_ATTRIBUTE_TAGS)
+	if err != nil {
+		return false, err
+	}
+	if len(tags) > 0 {
+		log.Debugf("the Clean command is not up to date because the state has %d tag(s) applied by the Mark command that can be deleted", len(tags))
+		return false, nil
+	}
+
 	// Otherwise return true
 	return true, nil
 }
@@ -222,6 +256,34 @@ func (c *Clean) Run() (*stt.State, error) {
 		}
 	}
 
+	// Delete the badge files, if any
+	badgesDirectoryPath, err := c.State().GetConfiguration().GetBadgesDirectory()
+	if err != nil {
+		return nil, err
+	}
+	if badgesDirectoryPath != nil && "" != strings.TrimSpace(*badgesDirectoryPath) {
+		// if the directory path is relative make it relative to the configured directory
+		if !filepath.IsAbs(*badgesDirectoryPath) {
+			directory, err := c.State().GetConfiguration().GetDirectory()
+			if err != nil {
+				return nil, err
+			}
+			badgesDirectoryAbsolutePath := filepath.Join(*directory, *badgesDirectoryPath)
+			badgesDirectoryPath = &badgesDirectoryAbsolutePath
+		}
+		for _, badgeFileName := range []string{stt.VERSION_BADGE_FILE_NAME, stt.RELEASE_DATE_BADGE_FILE_NAME} {
+			badgeFilePath := filepath.Join(*badgesDirectoryPath, badgeFileName)
+			log.Debugf("deleting badge file '%s', if present", badgeFilePath)
+			_, err := os.Stat(badgeFilePath)
+			if err == nil {
+				err = os.Remove(badgeFilePath)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
 	// Delete the changelog file, if any
 	changelogConfiguration, err := c.State().GetConfiguration().GetChangelog()
 	if err != nil {
@@ -250,5 +312,80 @@ func (c *Clean) Run() (*stt.State, error) {
 		}
 	}
 
+	// Delete the tags applied by the Mark command, if any
+	err = c.deleteTags()
+	if err != nil {
+		return nil, err
+	}
+
 	return c.State(), nil
 }
+
+/*
+Deletes the tags applied by the Mark command, as recorded in the state, from the remotes they have been pushed to and
+from the local repository. Tags that are already missing are ignored.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (c *Clean) deleteTags() error {
+	tags, err := c.getInternalAttributeLines(MARK_INTERNAL_OUTPUT_ATTRIBUTE_TAGS)
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		log.Debugf("no tags have been applied by the Mark command so no tag is deleted")
+		return nil
+	}
+	dryRun, err := c.State().GetConfiguration().GetDryRun()
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		log.Infof("Git tag deletion skipped due to dry run")
+		return nil
+	}
+
+	// delete from remotes first so that, in case of failure, local tags are still available for another attempt
+	remotes, err := c.getInternalAttributeLines(MARK_INTERNAL_OUTPUT_ATTRIBUTE_REMOTES)
+	if err != nil {
+		return err
+	}
+	for _, remote := range remotes {
+		err = c.deleteRemoteTags(&remote, tags)
+		if err != nil {
+			return err
+		}
+	}
+
+	localTags, err := (*c.Repository()).GetTags()
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		found := false
+		for _, localTag := range localTags {
+			if localTag.GetName() == tag {
+				found = true
+				break
+			}
+		}
+		if found {
+			err = (*c.Repository()).DeleteTag(tag)
+			if err != nil {
+				return err
+			}
+		} else {
+			log.Debugf("tag '%s' is not present in the local repository", tag)
+		}
+	}
+
+	err = c.removeInternalAttribute(MARK_INTERNAL_OUTPUT_ATTRIBUTE_REMOTES)
+	if err != nil {
+		return err
+	}
+	return c.removeInternalAttribute(MARK_INTERNAL_OUTPUT_ATTRIBUTE_TAGS)
+}
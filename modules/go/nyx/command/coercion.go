@@ -0,0 +1,56 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	ver "github.com/mooltiverse/nyx/modules/go/version"
+)
+
+/*
+Returns the given tag name coerced into a legal semantic version, as per the 'releaseCoercion' global option, so that
+near-semver tags (like '1.2', 'v1.2.3.4' or 'release-1.2.3') can be used to infer versions.
+
+The tag name is returned unchanged when coercion is not enabled, the scheme is not SEMVER, the tag name is already a
+legal version (tolerating the release prefix) or it can't be coerced. The epoch, if any, is retained.
+
+Arguments are as follows:
+
+  - scheme the configured version scheme
+  - releaseCoercion the configured release coercion flag. It may be nil
+  - releasePrefix the configured release prefix. It may be nil
+  - tagName the tag name to coerce
+*/
+func coerceVersion(scheme ver.Scheme, releaseCoercion *bool, releasePrefix *string, tagName string) string {
+	if releaseCoercion == nil || !*releaseCoercion || scheme != ver.SEMVER {
+		return tagName
+	}
+	// coercion tolerates any prefix so the epoch is also recognized after it
+	epoch, version := ver.SplitEpochWithLenience(tagName, true)
+	if ver.IsLegalWithPrefix(scheme, version, releasePrefix) {
+		return tagName
+	}
+	coercedVersion, err := ver.CoerceSemanticVersion(version)
+	if err != nil {
+		log.Tracef("tag '%s' can't be coerced to a semantic version: %v", tagName, err)
+		return tagName
+	}
+	coercedVersion = ver.JoinEpoch(epoch, coercedVersion)
+	log.Debugf("tag '%s' has been coerced to the semantic version '%s'", tagName, coercedVersion)
+	return coercedVersion
+}
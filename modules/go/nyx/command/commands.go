@@ -33,6 +33,9 @@ The enumeration of available commands.
 type Commands string
 
 const (
+	// The Analytics command.
+	ANALYTICS Commands = "ANALYTICS"
+
 	// The Clean command.
 	CLEAN Commands = "CLEAN"
 
@@ -45,6 +48,12 @@ const (
 	// The Mark command.
 	MARK Commands = "MARK"
 
+	// The Preview command.
+	PREVIEW Commands = "PREVIEW"
+
+	// The Prune command.
+	PRUNE Commands = "PRUNE"
+
 	// The Publish command.
 	PUBLISH Commands = "PUBLISH"
 )
@@ -54,6 +63,8 @@ Returns the string representation of the command
 */
 func (c Commands) String() string {
 	switch c {
+	case ANALYTICS:
+		return "ANALYTICS"
 	case CLEAN:
 		return "CLEAN"
 	case INFER:
@@ -62,6 +73,10 @@ func (c Commands) String() string {
 		return "MAKE"
 	case MARK:
 		return "MARK"
+	case PREVIEW:
+		return "PREVIEW"
+	case PRUNE:
+		return "PRUNE"
 	case PUBLISH:
 		return "PUBLISH"
 	default:
@@ -79,6 +94,8 @@ Errors can be:
 */
 func ValueOfCommands(s string) (Commands, error) {
 	switch s {
+	case "ANALYTICS":
+		return ANALYTICS, nil
 	case "CLEAN":
 		return CLEAN, nil
 	case "INFER":
@@ -87,6 +104,10 @@ func ValueOfCommands(s string) (Commands, error) {
 		return MAKE, nil
 	case "MARK":
 		return MARK, nil
+	case "PREVIEW":
+		return PREVIEW, nil
+	case "PRUNE":
+		return PRUNE, nil
 	case "PUBLISH":
 		return PUBLISH, nil
 	default:
@@ -0,0 +1,227 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"regexp"  // https://pkg.go.dev/regexp
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+)
+
+const (
+	// The ecosystem assigned to dependency updates whose ecosystem can't be detected.
+	DEPENDENCY_ECOSYSTEM_UNKNOWN = ""
+)
+
+var (
+	// The regular expression matching the names of the identities used by dependency update bots.
+	dependencyBotIdentityRegex = regexp.MustCompile(`(?i)^(dependabot|renovate)(-preview|-bot| bot)?(\[bot\])?$`)
+
+	// The regular expression matching the references to dependency update bots within commit messages, like
+	// 'Signed-off-by' trailers or the names of the branches merged by pull requests.
+	dependencyBotMessageRegex = regexp.MustCompile(`(?i)(dependabot|renovate)(-preview)?\[bot\]|\S+/(dependabot|renovate)/\S+`)
+
+	// The regular expression matching the subject of Dependabot commits, i.e. 'Bump lodash from 4.17.20 to 4.17.21'.
+	dependabotSubjectRegex = regexp.MustCompile(`(?i)^(\w+(\([^)]*\))?!?:\s*)?bump (?P<dependency>\S+) from \S+ to \S+`)
+
+	// The regular expression matching the subject of Renovate commits, i.e. 'Update dependency lodash to v4.17.21',
+	// 'Update module golang.org/x/net to v0.17.0' or 'Update actions/checkout action to v4'.
+	renovateSubjectRegex = regexp.MustCompile(`(?i)^(\w+(\([^)]*\))?!?:\s*)?update (?P<topic>dependency|module|rust crate|helm release|docker image|gem|plugin) (?P<dependency>\S+)|^(\w+(\([^)]*\))?!?:\s*)?update (?P<dependency2>\S+) (?P<topic2>action|docker tag|docker digest)\b`)
+
+	// The regular expression matching the ecosystem within the names of the branches created by Dependabot,
+	// i.e. 'dependabot/npm_and_yarn/lodash-4.17.21'.
+	dependabotBranchRegex = regexp.MustCompile(`dependabot/(?P<ecosystem>[a-z_]+)/`)
+
+	// The ecosystems assigned to the package managers known by Dependabot, as they appear in branch names.
+	dependabotEcosystems = map[string]string{
+		"bundler":        "bundler",
+		"cargo":          "cargo",
+		"composer":       "composer",
+		"docker":         "docker",
+		"github_actions": "github-actions",
+		"go_modules":     "go",
+		"gradle":         "gradle",
+		"maven":          "maven",
+		"npm_and_yarn":   "npm",
+		"nuget":          "nuget",
+		"pip":            "pip",
+		"terraform":      "terraform",
+	}
+
+	// The ecosystems assigned to the topics used by Renovate in commit messages.
+	renovateEcosystems = map[string]string{
+		"action":        "github-actions",
+		"docker digest": "docker",
+		"docker image":  "docker",
+		"docker tag":    "docker",
+		"gem":           "bundler",
+		"helm release":  "helm",
+		"module":        "go",
+		"rust crate":    "cargo",
+	}
+)
+
+/*
+Returns the ecosystem and the name of the dependency updated by the given commit, if the commit is a dependency
+update created by a bot like Dependabot or Renovate, and a flag telling whether the commit is such an update.
+
+Commits are detected as dependency updates when they are authored by a dependency update bot (or mention one in
+their message, like in 'Signed-off-by' trailers or the merged branch name) and their subject follows the Dependabot or
+Renovate conventions. Conventional commit prefixes (like 'chore(deps): ') are allowed.
+
+The ecosystem is detected from the Dependabot branch name or the Renovate topic, when available, or from the format
+of the dependency name otherwise. When it can't be detected the ecosystem is DEPENDENCY_ECOSYSTEM_UNKNOWN.
+*/
+func getDependencyUpdate(commit gitent.Commit) (string, string, bool) {
+	if !dependencyBotIdentityRegex.MatchString(commit.GetAuthorAction().GetIdentity().GetName()) && !dependencyBotIdentityRegex.MatchString(commit.GetCommitAction().GetIdentity().GetName()) && !dependencyBotMessageRegex.MatchString(commit.GetMessage().GetFullMessage()) {
+		return "", "", false
+	}
+
+	subject := strings.TrimSpace(commit.GetMessage().GetShortMessage())
+	dependency := ""
+	ecosystem := DEPENDENCY_ECOSYSTEM_UNKNOWN
+	if match := dependabotSubjectRegex.FindStringSubmatch(subject); match != nil {
+		dependency = match[dependabotSubjectRegex.SubexpIndex("dependency")]
+	} else if match := renovateSubjectRegex.FindStringSubmatch(subject); match != nil {
+		dependency = match[renovateSubjectRegex.SubexpIndex("dependency")] + match[renovateSubjectRegex.SubexpIndex("dependency2")]
+		ecosystem = renovateEcosystems[strings.ToLower(match[renovateSubjectRegex.SubexpIndex("topic")]+match[renovateSubjectRegex.SubexpIndex("topic2")])]
+	} else {
+		return "", "", false
+	}
+
+	if match := dependabotBranchRegex.FindStringSubmatch(commit.GetMessage().GetFullMessage()); match != nil {
+		branchEcosystem := match[dependabotBranchRegex.SubexpIndex("ecosystem")]
+		if known, ok := dependabotEcosystems[branchEcosystem]; ok {
+			ecosystem = known
+		} else {
+			ecosystem = branchEcosystem
+		}
+	}
+	if DEPENDENCY_ECOSYSTEM_UNKNOWN == ecosystem {
+		ecosystem = getDependencyEcosystemFromName(dependency)
+	}
+	return ecosystem, dependency, true
+}
+
+/*
+Returns the ecosystem of the dependency with the given name, detected from the format of the name, or
+DEPENDENCY_ECOSYSTEM_UNKNOWN if the name doesn't tell the ecosystem.
+*/
+func getDependencyEcosystemFromName(dependency string) string {
+	switch {
+	case strings.HasPrefix(dependency, "@"):
+		// scoped packages, i.e. '@types/node'
+		return "npm"
+	case strings.HasPrefix(dependency, "actions/"):
+		return "github-actions"
+	case strings.Contains(dependency, ":"):
+		// group and artifact coordinates, i.e. 'org.slf4j:slf4j-api'
+		return "maven"
+	case strings.Contains(dependency, "/") && strings.Contains(strings.SplitN(dependency, "/", 2)[0], "."):
+		// module paths starting with a domain, i.e. 'golang.org/x/net'
+		return "go"
+	default:
+		return DEPENDENCY_ECOSYSTEM_UNKNOWN
+	}
+}
+
+/*
+Groups the dependency updates among the commits of the given changelog section, replacing them with one summarized
+entry for each ecosystem. Ecosystems having just one update in the section are left untouched.
+
+The summarized entry takes the place of the most recent update in the section and is a copy of that commit with the
+message replaced by a summary like 'Update 3 npm dependencies: a, b, c', followed by the list of the original
+subjects in the message body.
+*/
+func groupDependencyUpdates(section *ent.Section) {
+	ecosystems := []string{}
+	updates := make(map[string][]*gitent.Commit)
+	dependencies := make(map[string][]string)
+	for _, commit := range section.GetCommits() {
+		ecosystem, dependency, ok := getDependencyUpdate(*commit)
+		if !ok {
+			continue
+		}
+		if _, ok := updates[ecosystem]; !ok {
+			ecosystems = append(ecosystems, ecosystem)
+		}
+		updates[ecosystem] = append(updates[ecosystem], commit)
+		// avoid listing the same dependency twice when it has been updated multiple times
+		listed := false
+		for _, d := range dependencies[ecosystem] {
+			if d == dependency {
+				listed = true
+			}
+		}
+		if !listed {
+			dependencies[ecosystem] = append(dependencies[ecosystem], dependency)
+		}
+	}
+
+	// map the first (most recent) update of each ecosystem to its summary and skip the others
+	summaries := make(map[*gitent.Commit]*gitent.Commit)
+	grouped := make(map[*gitent.Commit]bool)
+	for _, ecosystem := range ecosystems {
+		if len(updates[ecosystem]) < 2 {
+			continue
+		}
+		log.Debugf("grouping %d dependency updates for ecosystem '%s' in changelog section '%s'", len(updates[ecosystem]), ecosystem, *section.GetName())
+		for _, commit := range updates[ecosystem] {
+			grouped[commit] = true
+		}
+		summaries[updates[ecosystem][0]] = newDependencyUpdatesSummary(ecosystem, dependencies[ecosystem], updates[ecosystem])
+	}
+	if len(grouped) == 0 {
+		return
+	}
+
+	commits := []*gitent.Commit{}
+	for _, commit := range section.GetCommits() {
+		if summary, ok := summaries[commit]; ok {
+			commits = append(commits, summary)
+		} else if !grouped[commit] {
+			commits = append(commits, commit)
+		}
+	}
+	section.SetCommits(commits)
+}
+
+/*
+Returns the commit summarizing the given dependency updates for the given ecosystem.
+*/
+func newDependencyUpdatesSummary(ecosystem string, dependencies []string, updates []*gitent.Commit) *gitent.Commit {
+	ecosystemName := ""
+	if DEPENDENCY_ECOSYSTEM_UNKNOWN != ecosystem {
+		ecosystemName = ecosystem + " "
+	}
+	shortMessage := fmt.Sprintf("Update %d %sdependencies: %s", len(updates), ecosystemName, strings.Join(dependencies, ", "))
+	var fullMessage strings.Builder
+	fullMessage.WriteString(shortMessage)
+	fullMessage.WriteString("\n\n")
+	for _, update := range updates {
+		fullMessage.WriteString(fmt.Sprintf("* %s (%s)\n", update.GetMessage().GetShortMessage(), update.GetSHA()))
+	}
+
+	summary := *updates[0]
+	summary.Message = *gitent.NewMessageWith(fullMessage.String(), shortMessage, map[string]string{})
+	return &summary
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	ver "github.com/mooltiverse/nyx/modules/go/version"
+)
+
+/*
+Splits the given version string into the epoch and the version without the epoch, recognizing the epoch at the
+beginning of the string or right after the release prefix, according to the configured leniency and prefix.
+The prefix, if any, is retained in the returned version. When the string has no epoch the returned epoch is 0 and
+the string is returned unchanged.
+
+Versions are only legal once the epoch is removed so this must be used before checking or parsing them, while the
+epoch aware comparison functions can be used with the whole string.
+
+Arguments are as follows:
+
+  - releaseLenient the configured release leniency. It may be nil
+  - releasePrefix the configured release prefix. It may be nil
+  - version the version string to split
+*/
+func splitEpoch(releaseLenient *bool, releasePrefix *string, version string) (int, string) {
+	if releaseLenient != nil && *releaseLenient {
+		return ver.SplitEpochWithLenience(version, *releaseLenient)
+	}
+	return ver.SplitEpochWithPrefix(version, releasePrefix)
+}
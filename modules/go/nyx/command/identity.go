@@ -0,0 +1,122 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"regexp"  // https://pkg.go.dev/regexp
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+)
+
+var (
+	// The domains of the no-reply email addresses of the known providers, used to link commits to their accounts.
+	noReplyEmailDomains = map[ent.Provider]string{
+		ent.GITHUB: "users.noreply.github.com",
+		ent.GITLAB: "users.noreply.gitlab.com",
+	}
+
+	// The regular expressions matching the local part of the no-reply email addresses of the known providers.
+	// The 'id' capturing group returns the optional account ID while the 'name' capturing group returns the account name.
+	noReplyEmailLocalPartRegexes = map[ent.Provider]*regexp.Regexp{
+		ent.GITHUB: regexp.MustCompile(`^((?P<id>[0-9]+)\+)?(?P<name>.+)$`),
+		ent.GITLAB: regexp.MustCompile(`^((?P<id>[0-9]+)-)?(?P<name>.+)$`),
+	}
+)
+
+/*
+Returns the identity to use as the author, committer and tagger of the commits and tags created by Nyx, or nil
+if no default identity is configured or the repository already has an identity configured, which takes precedence.
+
+The name and email are read from the environment variables configured with the 'nameVariable' and 'emailVariable'
+options when they are not set explicitly.
+
+When the default identity has no email but a provider is configured, the email is the no-reply address the provider
+links to the account with the configured name.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (ac *abstractCommand) getDefaultIdentity() (*gitent.Identity, error) {
+	gitConfiguration, err := ac.State().GetConfiguration().GetGit()
+	if err != nil {
+		return nil, err
+	}
+	if gitConfiguration == nil || gitConfiguration.GetIdentity() == nil {
+		return nil, nil
+	}
+	configuredName := gitConfiguration.GetIdentity().GetName()
+	if configuredName == nil || "" == strings.TrimSpace(*configuredName) {
+		configuredName = valueOfEnvironmentVariable(gitConfiguration.GetIdentity().GetNameVariable())
+	}
+	if configuredName == nil || "" == strings.TrimSpace(*configuredName) {
+		return nil, nil
+	}
+	configuredEmail := gitConfiguration.GetIdentity().GetEmail()
+	if configuredEmail == nil || "" == strings.TrimSpace(*configuredEmail) {
+		configuredEmail = valueOfEnvironmentVariable(gitConfiguration.GetIdentity().GetEmailVariable())
+	}
+	configuredIdentity, err := (*ac.repository).GetConfiguredIdentity()
+	if err != nil {
+		return nil, err
+	}
+	if configuredIdentity != nil {
+		log.Debugf("the repository has the identity '%s' configured, which takes precedence over the default identity", configuredIdentity.String())
+		return nil, nil
+	}
+
+	name := strings.TrimSpace(*configuredName)
+	provider := gitConfiguration.GetIdentity().GetProvider()
+	var email string
+	if configuredEmail != nil && "" != strings.TrimSpace(*configuredEmail) {
+		email = strings.TrimSpace(*configuredEmail)
+	} else if provider != nil && noReplyEmailDomains[*provider] != "" {
+		email = name + "@" + noReplyEmailDomains[*provider]
+		log.Debugf("the default identity has no email configured, using the '%s' no-reply email '%s'", provider.String(), email)
+	} else {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the default identity '%s' has no email configured and no provider to infer it from", name)}
+	}
+	if provider != nil {
+		checkNoReplyEmail(*provider, name, email)
+	}
+	return gitent.NewIdentityWith(name, email), nil
+}
+
+/*
+Logs a warning when the given email is a no-reply address of the given provider that doesn't match the given name,
+in which case the provider won't link the commits to the account and won't show them as verified.
+*/
+func checkNoReplyEmail(provider ent.Provider, name string, email string) {
+	domain := noReplyEmailDomains[provider]
+	regex := noReplyEmailLocalPartRegexes[provider]
+	if "" == domain || regex == nil || !strings.HasSuffix(strings.ToLower(email), "@"+domain) {
+		return
+	}
+	match := regex.FindStringSubmatch(strings.TrimSuffix(email[:len(email)-len(domain)], "@"))
+	if match == nil || !strings.EqualFold(match[regex.SubexpIndex("name")], name) {
+		log.Warnf("the default identity email '%s' doesn't match the name '%s' so %s won't link commits to the account and won't mark them as verified", email, name, provider.String())
+	} else if ent.GITHUB == provider && "" == match[regex.SubexpIndex("id")] && strings.HasSuffix(name, "[bot]") {
+		log.Warnf("the default identity email '%s' has no account ID, which %s requires to link commits to bot accounts. Consider using the '<ID>+%s@%s' form", email, provider.String(), name, domain)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"bytes"   // https://pkg.go.dev/bytes
+	"fmt"     // https://pkg.go.dev/fmt
+	"os/exec" // https://pkg.go.dev/os/exec
+	"runtime" // https://pkg.go.dev/runtime
+	"sort"    // https://pkg.go.dev/sort
+	"strings" // https://pkg.go.dev/strings
+
+	regexp2 "github.com/dlclark/regexp2" // https://pkg.go.dev/github.com/dlclark/regexp2
+	log "github.com/sirupsen/logrus"     // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+/*
+Runs the given command line through the system shell in the given directory and returns its standard output
+and exit status.
+
+Error is:
+  - ReleaseError in case the command can't be started or the shell can't find or execute it.
+*/
+func runImpactAnalyzerCommand(commandLine string, directory *string) (string, int, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", commandLine)
+	} else {
+		cmd = exec.Command("sh", "-c", commandLine)
+	}
+	if directory != nil {
+		cmd.Dir = *directory
+	}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if stderr.Len() > 0 {
+		log.Debugf("the impact analyzer command '%s' printed to the standard error: %s", commandLine, stderr.String())
+	}
+	if err != nil {
+		exitError, ok := err.(*exec.ExitError)
+		if !ok {
+			return "", 0, &errs.ReleaseError{Message: fmt.Sprintf("unable to run the impact analyzer command '%s'", commandLine), Cause: err}
+		}
+		// by shell conventions these statuses mean that the command was not found or can't be executed
+		if exitError.ExitCode() == 126 || exitError.ExitCode() == 127 {
+			return "", exitError.ExitCode(), &errs.ReleaseError{Message: fmt.Sprintf("the impact analyzer command '%s' can't be found or executed: %s", commandLine, strings.TrimSpace(stderr.String())), Cause: err}
+		}
+		return stdout.String(), exitError.ExitCode(), nil
+	}
+	return stdout.String(), 0, nil
+}
+
+/*
+Runs the enabled impact analyzers, in the order they are enabled, and returns their reports. Each analyzer
+detects an impact when its command exits with a non zero status or its output matches the analyzer expression
+(or, when the expression is not set, its output is not blank).
+
+Analyzers are not run when there is no previous release to compare with or the release scope has no commits.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - ReleaseError in case some analyzer can't be run.
+*/
+func (ac *abstractCommand) runImpactAnalyzers() ([]*ent.ImpactReport, error) {
+	res := make([]*ent.ImpactReport, 0)
+	impactAnalyzers, err := ac.State().GetConfiguration().GetImpactAnalyzers()
+	if err != nil {
+		return nil, err
+	}
+	if impactAnalyzers == nil || impactAnalyzers.GetEnabled() == nil || len(*impactAnalyzers.GetEnabled()) == 0 {
+		return res, nil
+	}
+	releaseScope, err := ac.State().GetReleaseScope()
+	if err != nil {
+		return nil, err
+	}
+	if !releaseScope.HasPreviousVersionCommit() {
+		log.Debugf("impact analyzers are not run as there is no previous release to compare with")
+		return res, nil
+	}
+	if len(releaseScope.GetCommits()) == 0 {
+		log.Debugf("impact analyzers are not run as the release scope has no commits")
+		return res, nil
+	}
+	directory, err := ac.State().GetConfiguration().GetDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, enabled := range *impactAnalyzers.GetEnabled() {
+		if enabled == nil || "" == strings.TrimSpace(*enabled) {
+			continue
+		}
+		name := *enabled
+		impactAnalyzer, ok := (*impactAnalyzers.GetItems())[name]
+		if !ok || impactAnalyzer == nil {
+			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("impact analyzer '%s' is enabled but is not configured", name)}
+		}
+		if impactAnalyzer.GetCommand() == nil || "" == strings.TrimSpace(*impactAnalyzer.GetCommand()) {
+			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("impact analyzer '%s' has no command", name)}
+		}
+		commandLine, err := ac.renderTemplate(impactAnalyzer.GetCommand())
+		if err != nil {
+			return nil, err
+		}
+		title := &name
+		if impactAnalyzer.GetTitle() != nil && "" != strings.TrimSpace(*impactAnalyzer.GetTitle()) {
+			title, err = ac.renderTemplate(impactAnalyzer.GetTitle())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		log.Debugf("running impact analyzer '%s': '%s'", name, *commandLine)
+		output, exitCode, err := runImpactAnalyzerCommand(*commandLine, directory)
+		if err != nil {
+			return nil, err
+		}
+
+		impact := exitCode != 0
+		if !impact {
+			if impactAnalyzer.GetExpression() == nil || "" == strings.TrimSpace(*impactAnalyzer.GetExpression()) {
+				impact = "" != strings.TrimSpace(output)
+			} else {
+				re, err := regexp2.Compile(*impactAnalyzer.GetExpression(), regexp2.Multiline)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot compile regular expression '%s' of impact analyzer '%s'", *impactAnalyzer.GetExpression(), name), Cause: err}
+				}
+				impact, err = re.MatchString(output)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot evaluate regular expression '%s' of impact analyzer '%s'", *impactAnalyzer.GetExpression(), name), Cause: err}
+				}
+			}
+		}
+
+		var bump *string
+		if impact {
+			bump = ent.IMPACT_ANALYZER_BUMP
+			if impactAnalyzer.GetBump() != nil && "" != strings.TrimSpace(*impactAnalyzer.GetBump()) {
+				bump = impactAnalyzer.GetBump()
+			}
+			log.Infof("impact analyzer '%s' detected an impact, meaning that the '%s' identifier has to be bumped", name, *bump)
+		} else {
+			log.Debugf("impact analyzer '%s' detected no impact", name)
+		}
+		trimmedOutput := strings.TrimRight(output, "\r\n")
+		res = append(res, ent.NewImpactReportWith(&name, title, impact, bump, &trimmedOutput))
+	}
+	return res, nil
+}
+
+/*
+Returns the identifiers to bump according to the given impact reports, sorted and without duplicates.
+*/
+func getImpactBumpIdentifiers(impactReports []*ent.ImpactReport) []string {
+	identifiers := make(map[string]bool)
+	for _, impactReport := range impactReports {
+		if impactReport.GetImpact() && impactReport.GetBump() != nil {
+			identifiers[*impactReport.GetBump()] = true
+		}
+	}
+	res := make([]string, 0, len(identifiers))
+	for identifier := range identifiers {
+		res = append(res, identifier)
+	}
+	sort.Strings(res)
+	return res
+}
@@ -29,7 +29,9 @@ import (
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
 	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
 	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
+	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
 	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
 	ver "github.com/mooltiverse/nyx/modules/go/version"
 )
 
@@ -132,6 +134,49 @@ func NewInfer(state *stt.State, repository *git.Repository) (*Infer, error) {
 	return res, nil
 }
 
+/*
+The options tuning how scanRepository() scans the commit history and evaluates commits.
+*/
+type scanOptions struct {
+	// True to compute the files changed by each commit in the release scope.
+	commitChanges bool
+
+	// When true, tags that look like semantic versions are coerced to legal versions, as per
+	// coerceVersion(). It may be nil.
+	releaseCoercion *bool
+
+	// True to follow all the parents of merge commits, false to only follow the first parent.
+	followAllParents bool
+
+	// True to leave merge commits out of the release scope. Their tags are evaluated anyway.
+	ignoreMerges bool
+
+	// The patch identifiers of the commits released on other branches, as returned by
+	// getPatchIDsReleasedOnOtherBranches(). Cherry-picks of these commits are not significant.
+	releasedPatchIDs map[string]string
+
+	// The yanked versions, as returned by getYankedVersions(). Tags bearing these versions are ignored.
+	yankedVersions map[string]bool
+
+	// The pre-release identifiers, from the lowest to the highest precedence, as returned by
+	// getPrereleaseOrder(). It may be nil or empty to compare them as per the scheme.
+	prereleaseOrder []string
+
+	// The versions recorded by the version storage service, as returned by getStoredVersions().
+	// They are evaluated as if they were tags.
+	storedVersions map[string][]string
+
+	// The service to fetch the merged pull requests from, whose messages replace commit messages.
+	// It may be nil.
+	pullRequestService svcapi.PullRequestService
+
+	// The map of pull request labels to the identifiers they bump. It may be nil or empty.
+	bumpLabels map[string]string
+
+	// The enabled path rules, as returned by resolvePathRules(). It may be nil or empty.
+	pathRules []resolvedPathRule
+}
+
 /*
 Scans the Git commit history in order to detect:
   - the previous version (and the prime version, when the release type is configured to use collapsed versioning)
@@ -178,6 +223,7 @@ Arguments are as follows:
     are considered while others are ignored.
   - commitMessageConventions the map of all commit message conventions that have to be evaluated when scanning commits. It
     may be nil or empty when no convention is used, in which case significant commits and bump identifiers are not detected
+  - options the options tuning how commits are scanned and evaluated. See scanOptions for more
   - previousSignificantCommits a list of commits that this method will fill with every commit that is significant since
     the previous version, according to the given commitMessageConventions. It should be empty and must not be nil.
     This list is returned by this method with the outcomes of the repository scan as the first return value.
@@ -197,8 +243,9 @@ Error is:
 - IllegalPropertyError in case the configuration has some illegal options.
 - GitError in case of unexpected issues when accessing the Git repository.
 - ReleaseError if the task is unable to complete for reasons due to the release process.
+- ShallowRepositoryError if the commit history walk reached the boundary of a shallow repository before finding the previous version.
 */
-func (c *Infer) scanRepository(scheme *ver.Scheme, bump *string, releaseLenient *bool, releasePrefix *string, collapsedVersioning *bool, filterTagsExpression *string, commitMessageConventions map[string]*ent.CommitMessageConvention, previousSignificantCommits []gitent.Commit, previousBumpIdentifiers []string, primeSignificantCommits []gitent.Commit, primeBumpIdentifiers []string) ([]gitent.Commit, []string, []gitent.Commit, []string, error) {
+func (c *Infer) scanRepository(scheme *ver.Scheme, bump *string, releaseLenient *bool, releasePrefix *string, collapsedVersioning *bool, filterTagsExpression *string, commitMessageConventions map[string]*ent.CommitMessageConvention, options scanOptions, previousSignificantCommits []gitent.Commit, previousBumpIdentifiers []string, primeSignificantCommits []gitent.Commit, primeBumpIdentifiers []string) ([]gitent.Commit, []string, []gitent.Commit, []string, error) {
 	if scheme == nil {
 		return nil, nil, nil, nil, &errs.NilPointerError{Message: fmt.Sprintf("the scheme cannot be nil")}
 	}
@@ -224,8 +271,46 @@ func (c *Infer) scanRepository(scheme *ver.Scheme, bump *string, releaseLenient
 		return nil, nil, nil, nil, err
 	}
 
+	// records the given commit as significant, bumping the given identifier, in the 'prime commit' scope and,
+	// if the previous version wasn't found yet, also in the 'previous commit' scope
+	addBumpIdentifier := func(commit gitent.Commit, identifier string) {
+		primeBumpIdentifiersResult = append(primeBumpIdentifiersResult, identifier)
+		// check if the commit was already there to avoid adding it twice
+		pmscAlreadyPresent := false
+		for _, psc := range primeSignificantCommitsResult {
+			if psc.GetSHA() == commit.GetSHA() {
+				pmscAlreadyPresent = true
+			}
+		}
+		if !pmscAlreadyPresent {
+			primeSignificantCommitsResult = append(primeSignificantCommitsResult, commit)
+		}
+
+		if !(releaseScope.HasPreviousVersion() && releaseScope.HasPreviousVersionCommit()) {
+			previousBumpIdentifiersResult = append(previousBumpIdentifiersResult, identifier)
+			// check if the commit was already there to avoid adding it twice
+			pvscAlreadyPresent := false
+			for _, psc := range previousSignificantCommitsResult {
+				if psc.GetSHA() == commit.GetSHA() {
+					pvscAlreadyPresent = true
+				}
+			}
+			if !pvscAlreadyPresent {
+				previousSignificantCommitsResult = append(previousSignificantCommitsResult, commit)
+			}
+		}
+	}
+
 	log.Debugf("walking the commit history...")
-	(*c.Repository()).WalkHistory(nil, nil, func(cc gitent.Commit) bool {
+	walkHistory := func(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+		return (*c.Repository()).WalkHistory(start, end, visit)
+	}
+	if options.followAllParents {
+		walkHistory = (*c.Repository()).WalkHistoryWithAllParents
+	}
+	// errors occurring within the visitor stop the walk and are returned afterwards
+	var visitErr error
+	walkErr := walkHistory(nil, nil, func(cc gitent.Commit) bool {
 		log.Debugf("stepping by commit '%s'", cc.GetSHA())
 		log.Debugf("commit '%s' has '%d' tags: '%s'", cc.GetSHA(), len(cc.GetTags()), cc.GetTags())
 
@@ -237,17 +322,40 @@ func (c *Infer) scanRepository(scheme *ver.Scheme, bump *string, releaseLenient
 		// collapsed versioning their search may go beyond (backward) the previousVersion and previousVersionCommit
 		// otherwise they are the same.
 		// If the commit has multiple valid version tags they are all evaluated and compared to select the greatest
-		for _, tag := range cc.GetTags() {
-			if (*releaseLenient && ver.IsLegalWithLenience(*scheme, tag.GetName(), *releaseLenient)) || (!*releaseLenient && ver.IsLegalWithPrefix(*scheme, tag.GetName(), releasePrefix)) {
+		// Versions recorded by the version storage service are evaluated just like tags.
+		tags := cc.GetTags()
+		for _, storedVersion := range options.storedVersions[cc.GetSHA()] {
+			tagged := false
+			for _, tag := range tags {
+				if tag.GetName() == storedVersion {
+					tagged = true
+				}
+			}
+			if !tagged {
+				log.Debugf("commit '%s' has stored version '%s'", cc.GetSHA(), storedVersion)
+				tags = append(tags, *gitent.NewTagWith(storedVersion, cc.GetSHA(), false))
+			}
+		}
+		for _, tag := range tags {
+			if isYankedVersion(options.yankedVersions, tag.GetName(), releasePrefix) {
+				log.Debugf("evaluating tag '%s': tag is a yanked version so it will be ignored. The tag is applied to commit '%s'", tag.GetName(), cc.GetSHA())
+				continue
+			}
+			if coercedVersion := coerceVersion(*scheme, options.releaseCoercion, releasePrefix, tag.GetName()); coercedVersion != tag.GetName() {
+				log.Debugf("evaluating tag '%s': tag is coerced to '%s' which is used in the next evaluation steps. The tag is applied to commit '%s'", tag.GetName(), coercedVersion, cc.GetSHA())
+				tag = *gitent.NewTagWith(coercedVersion, tag.GetTarget(), tag.IsAnnotated())
+			}
+			_, tagVersion := splitEpoch(releaseLenient, releasePrefix, tag.GetName())
+			if (*releaseLenient && ver.IsLegalWithLenience(*scheme, tagVersion, *releaseLenient)) || (!*releaseLenient && ver.IsLegalWithPrefix(*scheme, tagVersion, releasePrefix)) {
 				log.Debugf("evaluating tag '%s': tag is a valid version according to the '%s' scheme and will be passed to the next evaluation steps. The tag is applied to commit '%s'", tag.GetName(), (*scheme).String(), cc.GetSHA())
 
 				var previousVersionComparison int
 				if *releaseLenient {
 					v1 := tag.GetName()
-					previousVersionComparison = ver.CompareWithSanitization(*scheme, &v1, releaseScope.GetPreviousVersion(), *releaseLenient)
+					previousVersionComparison = ver.CompareWithSanitizationAndPrereleaseOrder(*scheme, &v1, releaseScope.GetPreviousVersion(), *releaseLenient, options.prereleaseOrder)
 				} else {
 					v1 := tag.GetName()
-					previousVersionComparison = ver.CompareWithPrefix(*scheme, &v1, releaseScope.GetPreviousVersion(), releasePrefix)
+					previousVersionComparison = ver.CompareWithPrefixAndPrereleaseOrder(*scheme, &v1, releaseScope.GetPreviousVersion(), releasePrefix, options.prereleaseOrder)
 				}
 				if previousVersionComparison > 0 {
 					if releaseScope.GetPreviousVersion() == nil {
@@ -290,16 +398,16 @@ func (c *Infer) scanRepository(scheme *ver.Scheme, bump *string, releaseLenient
 				if collapsedVersioning != nil && *collapsedVersioning {
 					log.Debugf("evaluating tag '%s': the selected release type uses collapsed versioning so the tag will be passed to the next evaluation steps to check if it's a valid primeVersion. The tag is applied to commit '%s'", tag.GetName(), cc.GetSHA())
 
-					if (*releaseLenient && ver.IsCoreWithLenience(*scheme, tag.GetName(), *releaseLenient)) || (!*releaseLenient && ver.IsCoreWithPrefix(*scheme, tag.GetName(), releasePrefix)) {
+					if (*releaseLenient && ver.IsCoreWithLenience(*scheme, tagVersion, *releaseLenient)) || (!*releaseLenient && ver.IsCoreWithPrefix(*scheme, tagVersion, releasePrefix)) {
 						log.Debugf("evaluating tag '%s': tag is a valid core version according to the '%s' scheme and the selected release type uses collapsed versioning so the tag will be passed to the next evaluation steps to check if it's a valid primeVersion. The tag is applied to commit '%s'", tag.GetName(), (*scheme).String(), cc.GetSHA())
 
 						var primeVersionComparison int
 						if *releaseLenient {
 							v1 := tag.GetName()
-							primeVersionComparison = ver.CompareWithSanitization(*scheme, &v1, releaseScope.GetPrimeVersion(), *releaseLenient)
+							primeVersionComparison = ver.CompareWithSanitizationAndPrereleaseOrder(*scheme, &v1, releaseScope.GetPrimeVersion(), *releaseLenient, options.prereleaseOrder)
 						} else {
 							v1 := tag.GetName()
-							primeVersionComparison = ver.CompareWithPrefix(*scheme, &v1, releaseScope.GetPrimeVersion(), releasePrefix)
+							primeVersionComparison = ver.CompareWithPrefixAndPrereleaseOrder(*scheme, &v1, releaseScope.GetPrimeVersion(), releasePrefix, options.prereleaseOrder)
 						}
 						if primeVersionComparison > 0 {
 							if releaseScope.GetPrimeVersion() == nil {
@@ -335,17 +443,97 @@ func (c *Infer) scanRepository(scheme *ver.Scheme, bump *string, releaseLenient
 			}
 		}
 
+		// merge commits are neither part of the release scope nor inspected, if so configured, although their tags have been evaluated
+		if options.ignoreMerges && len(cc.GetParents()) > 1 {
+			log.Debugf("commit '%s' is a merge commit and merge commits are ignored so it's not added to the release scope nor inspected", cc.GetSHA())
+			return !(releaseScope.HasPreviousVersion() && releaseScope.HasPreviousVersionCommit() && releaseScope.HasPrimeVersion() && releaseScope.HasPrimeVersionCommit())
+		}
+
+		// The significant commit brings the message to evaluate, which is the title and labels of the pull request
+		// that merged the commit instead of the commit message, if so configured. Pull requests are only fetched for
+		// commits that are going to be inspected
+		sc := cc
+		var pullRequest *svcapi.PullRequest
+		if (!(releaseScope.HasPreviousVersion() && releaseScope.HasPreviousVersionCommit())) || (collapsedVersioning != nil && *collapsedVersioning && (!(releaseScope.HasPrimeVersion() && releaseScope.HasPrimeVersionCommit()))) {
+			pullRequest = c.getMergedPullRequest(options.pullRequestService, cc)
+			if pullRequest != nil {
+				sc.Message = newPullRequestMessage(*pullRequest)
+			}
+		}
+
 		// If this is a commit within the scope let's add it to the scope and inspect it
 		if !(releaseScope.HasPreviousVersion() && releaseScope.HasPreviousVersionCommit()) {
 			log.Debugf("commit '%s' has no valid version tags so it's added to the release scope", cc.GetSHA())
+			// the files changed by the commit are made available along with the commit, if so configured
+			if options.commitChanges {
+				changes, err := (*c.Repository()).GetCommitChanges(cc.GetSHA())
+				if err != nil {
+					visitErr = err
+					return false
+				}
+				sc.SetChanges(changes)
+			}
 			commits := releaseScope.GetCommits()
-			commitToAppend := cc // avoid duplicate appends of the same item
+			commitToAppend := sc // avoid duplicate appends of the same item
 			commits = append(commits, &commitToAppend)
 			releaseScope.SetCommits(commits)
 		}
 
+		// commits cherry-picked from versions released on other branches are ignored when inferring the identifier to bump, if so configured
+		ignoredCherryPick := false
+		if bump == nil && len(options.releasedPatchIDs) > 0 {
+			releasedCommit, err := c.getReleasedCherryPick(cc.GetSHA(), options.releasedPatchIDs)
+			if err != nil {
+				log.Errorf("cannot compute the patch identifier for commit '%s': %v", cc.GetSHA(), err)
+			} else if "" != releasedCommit {
+				log.Debugf("commit '%s' has been cherry-picked from (or to) commit '%s', already released on another branch, so it will be ignored when inferring the identifier to bump", cc.GetSHA(), releasedCommit)
+				ignoredCherryPick = true
+			}
+		}
+
+		// if the 'bump' was not overridden by user, the paths changed by the commit can bump identifiers, according to the path rules,
+		// and when all of them are matched by rules marking them as not significant the commit message conventions are not evaluated
+		ignoredPaths := false
+		if bump == nil && !ignoredCherryPick && len(options.pathRules) > 0 {
+			if (!(releaseScope.HasPreviousVersion() && releaseScope.HasPreviousVersionCommit())) || (collapsedVersioning != nil && *collapsedVersioning && (!(releaseScope.HasPrimeVersion() && releaseScope.HasPrimeVersionCommit()))) {
+				var changedPaths []string
+				var err error
+				if sc.GetChanges() != nil {
+					for _, change := range sc.GetChanges() {
+						changedPaths = append(changedPaths, change.GetPath())
+					}
+				} else {
+					changedPaths, err = (*c.Repository()).GetCommitChangedPaths(cc.GetSHA())
+				}
+				if err != nil {
+					log.Errorf("cannot get the paths changed by commit '%s': %v", cc.GetSHA(), err)
+				} else {
+					var pathIdentifiers []string
+					pathIdentifiers, ignoredPaths = getPathRuleIdentifiers(cc.GetSHA(), changedPaths, options.pathRules)
+					if ignoredPaths {
+						log.Debugf("all the paths changed by commit '%s' are matched by path rules marking them as not significant so the commit is not significant", cc.GetSHA())
+					}
+					for _, pathIdentifier := range pathIdentifiers {
+						addBumpIdentifier(sc, pathIdentifier)
+					}
+				}
+			}
+		}
+
+		// if the 'bump' was not overridden by user, the labels of the pull request that merged the commit override the commit message conventions, if they match any bump label
+		labelIdentifiers, labelled := getBumpLabelIdentifiers(pullRequest, options.bumpLabels)
+		if bump == nil && !ignoredCherryPick && labelled {
+			if len(labelIdentifiers) == 0 {
+				log.Debugf("the labels of pull request '%d' that merged commit '%s' match bump labels not bumping any identifier so the commit is not significant", (*pullRequest).GetNumber(), cc.GetSHA())
+			}
+			for _, labelIdentifier := range labelIdentifiers {
+				log.Debugf("the labels of pull request '%d' that merged commit '%s' match a bump label, meaning that the '%s' identifier has to be bumped, according to this commit", (*pullRequest).GetNumber(), cc.GetSHA(), labelIdentifier)
+				addBumpIdentifier(sc, labelIdentifier)
+			}
+		}
+
 		// if the 'bump' was not overridden by user, evaluate the commit message against the configured conventions to see which identifier must be dumped, if any
-		if bump == nil {
+		if bump == nil && !ignoredCherryPick && !labelled && !ignoredPaths {
 			if commitMessageConventions != nil {
 				// Let's find the identifier to bump (unless the bump was overridden by user).
 				// We need to consider all commits within the scope and, when using collapsed versioning,
@@ -358,51 +546,25 @@ func (c *Infer) scanRepository(scheme *ver.Scheme, bump *string, releaseLenient
 						if err != nil {
 							log.Errorf("cannot compile regular expression '%s': %v", *cmcEntryValue.GetExpression(), err)
 						}
-						match, err := re.MatchString(cc.GetMessage().GetFullMessage())
+						match, err := re.MatchString(sc.GetMessage().GetFullMessage())
 						if err != nil {
-							log.Errorf("cannot evaluate regular expression '%s' against '%s': %v", *cmcEntryValue.GetExpression(), cc.GetMessage().GetFullMessage(), err)
+							log.Errorf("cannot evaluate regular expression '%s' against '%s': %v", *cmcEntryValue.GetExpression(), sc.GetMessage().GetFullMessage(), err)
 						}
 						if match {
 							log.Debugf("commit message convention '%s' matches commit '%s'", cmcEntryKey, cc.GetSHA())
 							for bumpExpressionKey, bumpExpressionValue := range *cmcEntryValue.GetBumpExpressions() {
-								log.Debugf("matching commit '%s' ('%s') against bump expression '%s' ('%s') of message convention '%s'", cc.GetSHA(), cc.GetMessage().GetFullMessage(), bumpExpressionKey, bumpExpressionValue, cmcEntryKey)
+								log.Debugf("matching commit '%s' ('%s') against bump expression '%s' ('%s') of message convention '%s'", cc.GetSHA(), sc.GetMessage().GetFullMessage(), bumpExpressionKey, bumpExpressionValue, cmcEntryKey)
 								re, err = regexp2.Compile(bumpExpressionValue, 0)
 								if err != nil {
 									log.Errorf("cannot compile regular expression '%s': %v", bumpExpressionValue, err)
 								}
-								match, err = re.MatchString(cc.GetMessage().GetFullMessage())
+								match, err = re.MatchString(sc.GetMessage().GetFullMessage())
 								if err != nil {
-									log.Errorf("cannot evaluate regular expression '%s' against '%s': %v", bumpExpressionValue, cc.GetMessage().GetFullMessage(), err)
+									log.Errorf("cannot evaluate regular expression '%s' against '%s': %v", bumpExpressionValue, sc.GetMessage().GetFullMessage(), err)
 								}
 								if match {
 									log.Debugf("bump expression '%s' of message convention '%s' matches commit '%s', meaning that the '%s' identifier has to be bumped, according to this commit", bumpExpressionKey, cmcEntryKey, cc.GetSHA(), bumpExpressionKey)
-									// if we reached this point this is also in the 'prime commit' scope
-									primeBumpIdentifiersResult = append(primeBumpIdentifiersResult, bumpExpressionKey)
-									// check if the commit was already there to avoid adding it twice
-									pmscAlreadyPresent := false
-									for _, psc := range primeSignificantCommitsResult {
-										if psc.GetSHA() == cc.GetSHA() {
-											pmscAlreadyPresent = true
-										}
-									}
-									if !pmscAlreadyPresent {
-										primeSignificantCommitsResult = append(primeSignificantCommitsResult, cc)
-									}
-
-									if !(releaseScope.HasPreviousVersion() && releaseScope.HasPreviousVersionCommit()) {
-										// if the previous version wasn't found yet this is in the 'previous commit' scope
-										previousBumpIdentifiersResult = append(previousBumpIdentifiersResult, bumpExpressionKey)
-										// check if the commit was already there to avoid adding it twice
-										pvscAlreadyPresent := false
-										for _, psc := range previousSignificantCommitsResult {
-											if psc.GetSHA() == cc.GetSHA() {
-												pvscAlreadyPresent = true
-											}
-										}
-										if !pvscAlreadyPresent {
-											previousSignificantCommitsResult = append(previousSignificantCommitsResult, cc)
-										}
-									}
+									addBumpIdentifier(sc, bumpExpressionKey)
 								} else {
 									log.Debugf("bump expression '%s' of message convention '%s' doesn't match commit '%s'", bumpExpressionKey, cmcEntryKey, cc.GetSHA())
 								}
@@ -420,6 +582,19 @@ func (c *Infer) scanRepository(scheme *ver.Scheme, bump *string, releaseLenient
 		// stop walking the commit history if we already have the previous and prime versions (and their commits), otherwise keep walking
 		return !(releaseScope.HasPreviousVersion() && releaseScope.HasPreviousVersionCommit() && releaseScope.HasPrimeVersion() && releaseScope.HasPrimeVersionCommit())
 	})
+	if visitErr != nil {
+		return nil, nil, nil, nil, visitErr
+	}
+	if walkErr != nil {
+		// reaching the boundary of a shallow repository before finding the previous (or prime) version means it may be
+		// beyond the boundary so the scan is not reliable
+		if _, shallow := walkErr.(*errs.ShallowRepositoryError); shallow {
+			if !(releaseScope.HasPreviousVersion() && releaseScope.HasPreviousVersionCommit()) || (collapsedVersioning != nil && *collapsedVersioning && !(releaseScope.HasPrimeVersion() && releaseScope.HasPrimeVersionCommit())) {
+				return nil, nil, nil, nil, walkErr
+			}
+		}
+		log.Debugf("walking the commit history stopped with an error: %v", walkErr)
+	}
 
 	log.Debugf("walking the commit history finished. The release scope contains %d commits.", len(releaseScope.GetCommits()))
 	if collapsedVersioning != nil && *collapsedVersioning {
@@ -620,6 +795,106 @@ func (c *Infer) applyExtraIdentifiers(scheme *ver.Scheme, releaseType *ent.Relea
 	return &res, nil
 }
 
+/*
+Applies the build metadata defined by the releaseType, if any, and returns the new version with the build metadata.
+The build metadata is only applied when the given version is a new one, different from the previous version, and
+replaces the build part that the version may have inherited from the previous version. Build identifiers added
+by the extra identifiers are kept and the build metadata is appended after them.
+
+The rendered build metadata is stored in the state even when it's not included in the returned version because
+the release type doesn't have the STATE target among its buildMetadataTargets.
+
+Arguments are as follows:
+
+- scheme the versioning scheme in use. It can't be nil or empty
+- releaseType the release type giving parameters on how to compute the version. It can't be nil
+- previousVersion the previous version. It can't be nil
+- version the version to apply the build metadata to. It can't be nil
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (c *Infer) applyBuildMetadata(scheme *ver.Scheme, releaseType *ent.ReleaseType, previousVersion *ver.Version, version *ver.Version) (*ver.Version, error) {
+	if scheme == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the scheme cannot be nil")}
+	}
+	if releaseType == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the release type cannot be nil")}
+	}
+	if previousVersion == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the previous version cannot be nil")}
+	}
+	if version == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the version cannot be nil")}
+	}
+
+	if releaseType.GetBuildMetadata() == nil || "" == strings.TrimSpace(*releaseType.GetBuildMetadata()) {
+		log.Debugf("the release type does not define any build metadata so none is applied")
+		return version, nil
+	}
+	if (*version).String() == (*previousVersion).String() {
+		log.Debugf("no new version has been inferred so the build metadata is not applied")
+		return version, nil
+	}
+
+	buildMetadata, err := c.renderTemplate(releaseType.GetBuildMetadata())
+	if err != nil {
+		return nil, err
+	}
+	// a leading '+' is tolerated as it's how the build metadata appears in versions
+	trimmedBuildMetadata := strings.TrimPrefix(strings.TrimSpace(*buildMetadata), "+")
+	if "" == trimmedBuildMetadata {
+		log.Debugf("the build metadata template '%s' renders to an empty string so no build metadata is applied", *releaseType.GetBuildMetadata())
+		return version, nil
+	}
+	log.Debugf("the build metadata template '%s' renders to '%s'", *releaseType.GetBuildMetadata(), trimmedBuildMetadata)
+
+	// Semver is the only supported scheme so far...
+	if ver.SEMVER != *scheme {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("build metadata is supported for '%s' scheme only", ver.SEMVER)}
+	}
+	semanticVersion, err := ver.ValueOfSemanticVersion((*version).String()) // faster and safer than casting...
+	if err != nil {
+		return nil, err
+	}
+	previousSemanticVersion, err := ver.ValueOfSemanticVersion((*previousVersion).String())
+	if err != nil {
+		return nil, err
+	}
+	// drop the build part inherited from the previous version, unless extra identifiers have changed it
+	if semanticVersion.GetBuild() != nil && previousSemanticVersion.GetBuild() != nil && *semanticVersion.GetBuild() == *previousSemanticVersion.GetBuild() {
+		semanticVersion, err = semanticVersion.SetBuild()
+		if err != nil {
+			return nil, err
+		}
+	}
+	// check the build metadata is legal even when it's not applied to the version
+	versionWithBuildMetadata, err := semanticVersion.AppendBuild(trimmedBuildMetadata)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the build metadata template '%s' renders to '%s', which is not legal as build metadata", *releaseType.GetBuildMetadata(), trimmedBuildMetadata), Cause: err}
+	}
+	err = c.State().SetBuildMetadata(&trimmedBuildMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	inState, err := c.isBuildMetadataTarget(releaseType, BUILD_METADATA_TARGET_STATE)
+	if err != nil {
+		return nil, err
+	}
+	var res ver.Version
+	if inState {
+		res = versionWithBuildMetadata
+		log.Debugf("the version after applying the build metadata is '%s'", res.String())
+	} else {
+		res = semanticVersion
+		log.Debugf("the build metadata is not included in the state so the version is '%s'", res.String())
+	}
+	return &res, nil
+}
+
 /*
 Computes the new version (if needed) based on the given arguments. The computed version is not stored in the state
 object but is just returned by this method, ready to be further mangled. Extra attributes are also applied, if the release type
@@ -753,15 +1028,19 @@ func (c *Infer) computeVersion(scheme *ver.Scheme, bump *string, releaseLenient
 				}
 
 				// now compare the prime and previous version and see which one is greater
+				prereleaseOrder, err := c.getPrereleaseOrder()
+				if err != nil {
+					return nil, err
+				}
 				var comparison int
 				if *releaseLenient {
 					v1 := primeVersionBumped.String()
 					v2 := previousVersionBumped.String()
-					comparison = ver.CompareWithSanitization(*scheme, &v1, &v2, *releaseLenient)
+					comparison = ver.CompareWithSanitizationAndPrereleaseOrder(*scheme, &v1, &v2, *releaseLenient, prereleaseOrder)
 				} else {
 					v1 := primeVersionBumped.String()
 					v2 := previousVersionBumped.String()
-					comparison = ver.CompareWithPrefix(*scheme, &v1, &v2, releasePrefix)
+					comparison = ver.CompareWithPrefixAndPrereleaseOrder(*scheme, &v1, &v2, releasePrefix, prereleaseOrder)
 				}
 				if comparison <= 0 {
 					res = previousVersionBumped
@@ -1028,11 +1307,150 @@ func (c *Infer) checkVersionRange(scheme *ver.Scheme, version *ver.Version, stat
 	}
 }
 
+/*
+Checks if the given version satisfies the version constraint rendered from the given template, like '>=1.2 <2.0',
+'~1.4' or '^2.1'. Using a template allows to make the constraint dynamic and use state values.
+
+Returns true if the check is required and succeeds, false if it's not required (the template is nil or renders to
+an empty string), otherwise if it is required and does not succeed a ReleaseError is thrown.
+
+Arguments are as follows:
+
+  - scheme the versioning scheme in use. Constraints are only supported for the SEMVER scheme
+  - version the version to check. It can't be nil
+  - versionConstraintTemplate the optional template that, once rendered, is used as the constraint
+
+Error is:
+
+- IllegalPropertyError in case the configuration has some illegal options.
+- ReleaseError if the version doesn't satisfy the constraint.
+*/
+func (c *Infer) checkVersionConstraint(scheme *ver.Scheme, version *ver.Version, versionConstraintTemplate *string) (bool, error) {
+	if version == nil {
+		return false, &errs.NilPointerError{Message: fmt.Sprintf("version cannot be nil")}
+	}
+	if versionConstraintTemplate == nil || "" == strings.TrimSpace(*versionConstraintTemplate) {
+		log.Debugf("no version constraint check is performed")
+		return false, nil
+	}
+	versionConstraint, err := c.renderTemplate(versionConstraintTemplate)
+	if err != nil {
+		return false, err
+	}
+	if versionConstraint == nil || "" == strings.TrimSpace(*versionConstraint) {
+		log.Debugf("the version constraint template '%s' evaluates to an empty string so no version constraint check is performed", *versionConstraintTemplate)
+		return false, nil
+	}
+	if scheme == nil || ver.SEMVER != *scheme {
+		return false, &errs.IllegalPropertyError{Message: fmt.Sprintf("version constraint check is supported for '%s' scheme only", ver.SEMVER.String())}
+	}
+	constraint, err := ver.ValueOfSemanticVersionConstraint(*versionConstraint)
+	if err != nil {
+		return false, &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot parse version constraint '%s' (evaluated by template '%s')", *versionConstraint, *versionConstraintTemplate), Cause: err}
+	}
+	semanticVersion, ok := (*version).(ver.SemanticVersion)
+	if !ok {
+		return false, &errs.IllegalPropertyError{Message: fmt.Sprintf("version '%s' is not a semantic version", (*version).String())}
+	}
+	log.Debugf("performing version constraint check against version '%s' using the constraint '%s'", (*version).String(), constraint.String())
+	if constraint.Check(semanticVersion) {
+		log.Debugf("version '%s' successfully satisfies version constraint '%s'", (*version).String(), constraint.String())
+		return true, nil
+	}
+	return false, &errs.ReleaseError{Message: fmt.Sprintf("version '%s' doesn't satisfy version constraint '%s'", (*version).String(), constraint.String())}
+}
+
+/*
+Returns the given version or, if it has been yanked, the first greater version that has not been yanked. Yanked
+versions can't be issued again so the given version is bumped again, using the same identifier that was bumped to
+compute it (or the collapsed version qualifier when the release type uses collapsed versioning), until a version
+that has not been yanked is found.
+
+Arguments are as follows:
+
+  - releasePrefix the release prefix that has been configured. It may be nil or empty
+  - releaseType the selected release type
+  - version the computed version
+  - yankedVersions the yanked versions, as returned by getYankedVersions()
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- ReleaseError if the task is unable to complete for reasons due to the release process.
+*/
+func (c *Infer) skipYankedVersions(releasePrefix *string, releaseType *ent.ReleaseType, version *ver.Version, yankedVersions map[string]bool) (*ver.Version, error) {
+	if !isYankedVersion(yankedVersions, (*version).String(), releasePrefix) {
+		return version, nil
+	}
+
+	var identifier *string
+	var err error
+	if releaseType.GetCollapseVersions() != nil && *releaseType.GetCollapseVersions() {
+		identifier, err = c.renderTemplate(releaseType.GetCollapsedVersionQualifier())
+	} else {
+		identifier, err = c.State().GetBump()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if identifier == nil || "" == strings.TrimSpace(*identifier) {
+		log.Warnf("version '%s' has been yanked but no identifier has been bumped to compute it so it can't be skipped", (*version).String())
+		return version, nil
+	}
+
+	res := *version
+	for isYankedVersion(yankedVersions, res.String(), releasePrefix) {
+		log.Debugf("version '%s' has been yanked so identifier '%s' is bumped again", res.String(), *identifier)
+		res, err = res.BumpVersion(*identifier)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &res, nil
+}
+
+/*
+Applies the configured policy for when no significant commits have been found since the previous version, so there is
+nothing to release. Depending on the policy the version is left to the previous version, the version is cleared
+or an error is returned.
+
+Arguments are as follows:
+
+  - previousVersion the previous version, used for logging
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- NothingToReleaseError if the configured policy requires to fail when there is nothing to release.
+*/
+func (c *Infer) applyNothingToReleasePolicy(previousVersion *string) error {
+	nothingToRelease, err := c.State().GetConfiguration().GetNothingToRelease()
+	if err != nil {
+		return err
+	}
+	previousVersionString := ""
+	if previousVersion != nil {
+		previousVersionString = *previousVersion
+	}
+	switch *nothingToRelease {
+	case ent.FAIL:
+		return &errs.NothingToReleaseError{Message: fmt.Sprintf("no significant commits have been found since version '%s' so there is nothing to release", previousVersionString)}
+	case ent.SKIP:
+		log.Debugf("no significant commits have been found since version '%s' so there is nothing to release and no version is emitted", previousVersionString)
+		return c.State().SetVersion(nil)
+	default:
+		log.Infof("no significant commits have been found since version '%s' so there is nothing to release and the version is the previous version", previousVersionString)
+		return nil
+	}
+}
+
 /*
 Checks if the given version is the latest in the repository, according to the scheme.
 To run this check the given version is checked against all tags in the repository (ignoring those not
-complying with the given scheme) and only if the given version is to be considered newer or equal to any
-other version tag true is returned.
+complying with the given scheme and the yanked versions) and only if the given version is to be considered newer or
+equal to any other version tag true is returned.
 
 Arguments are as follows:
 
@@ -1055,27 +1473,45 @@ func (c *Infer) checkLatestVersion(scheme ver.Scheme, version string, releaseLen
 	if err != nil {
 		return false, err
 	}
+	yankedVersions, err := c.getYankedVersions()
+	if err != nil {
+		return false, err
+	}
+	prereleaseOrder, err := c.getPrereleaseOrder()
+	if err != nil {
+		return false, err
+	}
+	releaseCoercion, err := c.State().GetConfiguration().GetReleaseCoercion()
+	if err != nil {
+		return false, err
+	}
 
 	for _, tag := range tags {
 		tagName := tag.GetName()
 		log.Tracef("checking against tag '%s'", tagName)
+		if isYankedVersion(yankedVersions, tagName, releasePrefix) {
+			log.Tracef("tag '%s' is a yanked version and will be ignored", tagName)
+			continue
+		}
+		tagName = coerceVersion(scheme, releaseCoercion, releasePrefix, tagName)
+		_, tagVersion := splitEpoch(releaseLenient, releasePrefix, tagName)
 		var isLegal bool
 		if releaseLenient != nil && *releaseLenient {
-			isLegal = ver.IsLegalWithLenience(scheme, tagName, *releaseLenient)
+			isLegal = ver.IsLegalWithLenience(scheme, tagVersion, *releaseLenient)
 		} else {
-			isLegal = ver.IsLegalWithPrefix(scheme, tagName, releasePrefix)
+			isLegal = ver.IsLegalWithPrefix(scheme, tagVersion, releasePrefix)
 		}
 		if isLegal {
 			log.Tracef("tag '%s' is a legal version according to '%s'", tagName, scheme.String())
 			if releaseLenient != nil && *releaseLenient {
-				if ver.CompareWithSanitization(scheme, &version, &tagName, *releaseLenient) < 0 {
+				if ver.CompareWithSanitizationAndPrereleaseOrder(scheme, &version, &tagName, *releaseLenient, prereleaseOrder) < 0 {
 					log.Debugf("tag '%s' is greater than '%s' according to '%s' so '%s' is not the latest version", tagName, version, scheme.String(), version)
 					return false, nil
 				} else {
 					log.Tracef("tag '%s' is less or equal than '%s' according to '%s' so next tags will be tested (if any)", tagName, version, scheme.String())
 				}
 			} else {
-				if ver.CompareWithPrefix(scheme, &version, &tagName, releasePrefix) < 0 {
+				if ver.CompareWithPrefixAndPrereleaseOrder(scheme, &version, &tagName, releasePrefix, prereleaseOrder) < 0 {
 					log.Debugf("tag '%s' is greater than '%s' according to '%s' so '%s' is not the latest version", tagName, version, scheme.String(), version)
 					return false, nil
 				} else {
@@ -1090,6 +1526,124 @@ func (c *Infer) checkLatestVersion(scheme ver.Scheme, version string, releaseLen
 	return true, nil
 }
 
+/*
+Extracts the metadata from the given branch name using the branchMetadataExpression configuration option.
+Each named capturing group in the expression yields an entry in the returned map, where the key is the
+group name and the value is the portion of the branch name captured by the group. Unnamed groups are ignored.
+
+The returned map is empty when no expression has been configured or the expression doesn't match the branch name.
+
+Arguments are as follows:
+
+  - branch the branch name to extract metadata from
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (c *Infer) extractBranchMetadata(branch string) (*map[string]string, error) {
+	branchMetadata := make(map[string]string)
+
+	branchMetadataExpression, err := c.State().GetConfiguration().GetBranchMetadataExpression()
+	if err != nil {
+		return nil, err
+	}
+	if branchMetadataExpression == nil || "" == strings.TrimSpace(*branchMetadataExpression) {
+		log.Debugf("no branch metadata expression has been configured")
+		return &branchMetadata, nil
+	}
+
+	log.Debugf("extracting metadata from branch '%s' using regular expression '%s'", branch, *branchMetadataExpression)
+	re, err := regexp2.Compile(*branchMetadataExpression, 0)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("malformed branch metadata regular expression: '%s'", *branchMetadataExpression), Cause: err}
+	}
+	match, err := re.FindStringMatch(branch)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("unable to evaluate the branch metadata regular expression '%s' against branch '%s'", *branchMetadataExpression, branch), Cause: err}
+	}
+	if match == nil {
+		log.Debugf("branch '%s' is not matched by the branch metadata regular expression '%s' so no metadata is extracted", branch, *branchMetadataExpression)
+		return &branchMetadata, nil
+	}
+	for _, groupName := range re.GetGroupNames() {
+		// numeric names are assigned by the engine to unnamed groups (including the whole match, named '0')
+		if _, err := strconv.Atoi(groupName); err == nil {
+			continue
+		}
+		group := match.GroupByName(groupName)
+		if group != nil && len(group.Captures) > 0 {
+			log.Debugf("branch metadata '%s' extracted from branch '%s' with value '%s'", groupName, branch, group.String())
+			branchMetadata[groupName] = group.String()
+		}
+	}
+	return &branchMetadata, nil
+}
+
+/*
+Resets the release scope in the State object, discarding the outcomes of any previous scan of the commit history.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+*/
+func (c *Infer) clearReleaseScope() error {
+	releaseScope, err := c.State().GetReleaseScope()
+	if err != nil {
+		return err
+	}
+	releaseScope.SetCommits(make([]*gitent.Commit, 0))
+	releaseScope.SetImpactReports(make([]*ent.ImpactReport, 0))
+	releaseScope.SetPreviousVersion(nil)
+	releaseScope.SetPreviousVersionCommit(nil)
+	releaseScope.SetPrimeVersion(nil)
+	releaseScope.SetPrimeVersionCommit(nil)
+	releaseScope.SetSignificantCommits(make([]*gitent.Commit, 0))
+	return nil
+}
+
+/*
+Sets the state timestamp according to the configured timestamp source. When the source is COMMIT the timestamp
+is pinned to the date of the latest commit so that it's the same on every run, otherwise the timestamp set by the
+clock when the state was created is left unchanged.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (c *Infer) applyTimestampSource() error {
+	timestampSource, err := c.State().GetConfiguration().GetTimestampSource()
+	if err != nil {
+		return err
+	}
+	if timestampSource == nil || ent.COMMIT != *timestampSource {
+		return nil
+	}
+	latestCommit, err := c.getLatestCommit()
+	if err != nil {
+		// the repository may have no commits yet, in which case the error is raised later on
+		log.Debugf("the latest commit can't be resolved so the state timestamp is left unchanged: %v", err)
+		return nil
+	}
+	var commitDate *int64 = nil
+	err = (*c.Repository()).WalkHistory(&latestCommit, &latestCommit, func(commit gitent.Commit) bool {
+		date := commit.GetDate()
+		commitDate = &date
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if commitDate != nil {
+		log.Debugf("the state timestamp is pinned to the date of the latest commit '%s': %d", latestCommit, *commitDate)
+		return c.State().SetTimestamp(commitDate)
+	}
+	return nil
+}
+
 /*
 Reset the attributes store by this command into the internal state object.
 This is required before running the command in order to make sure that the new execution is not affected
@@ -1106,6 +1660,14 @@ func (c *Infer) clearStateOutputAttributes() error {
 	if err != nil {
 		return err
 	}
+	err = c.State().SetBranchMetadata(nil)
+	if err != nil {
+		return err
+	}
+	err = c.State().SetBuildMetadata(nil)
+	if err != nil {
+		return err
+	}
 	// the bump attribute can only be set (or reset) when the used didn't override the value from the configuration
 	configurationBump, err := c.State().GetConfiguration().GetBump()
 	if err != nil {
@@ -1117,16 +1679,10 @@ func (c *Infer) clearStateOutputAttributes() error {
 			return err
 		}
 	}
-	releaseScope, err := c.State().GetReleaseScope()
+	err = c.clearReleaseScope()
 	if err != nil {
 		return err
 	}
-	releaseScope.SetCommits(make([]*gitent.Commit, 0))
-	releaseScope.SetPreviousVersion(nil)
-	releaseScope.SetPreviousVersionCommit(nil)
-	releaseScope.SetPrimeVersion(nil)
-	releaseScope.SetPrimeVersionCommit(nil)
-	releaseScope.SetSignificantCommits(make([]*gitent.Commit, 0))
 	err = c.State().SetReleaseType(nil)
 	if err != nil {
 		return err
@@ -1146,6 +1702,10 @@ func (c *Infer) clearStateOutputAttributes() error {
 	if err != nil {
 		return err
 	}
+	err = c.State().SetNothingToRelease(nil)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1407,6 +1967,7 @@ Error is:
 - IllegalPropertyError in case the configuration has some illegal options.
 - GitError in case of unexpected issues when accessing the Git repository.
 - ReleaseError if the task is unable to complete for reasons due to the release process.
+- ShallowRepositoryError if the previous version is beyond the boundary of a shallow repository that can't be unshallowed.
 */
 func (c *Infer) Run() (*stt.State, error) {
 	log.Debugf("running the Infer command...")
@@ -1416,6 +1977,39 @@ func (c *Infer) Run() (*stt.State, error) {
 		return nil, err
 	}
 
+	// tags must be fetched before anything else as they're used to match release types and infer versions
+	gitConfiguration, err := c.State().GetConfiguration().GetGit()
+	if err != nil {
+		return nil, err
+	}
+	if gitConfiguration != nil && gitConfiguration.GetFetchTags() != nil && *gitConfiguration.GetFetchTags() {
+		log.Debugf("fetching tags from remotes before inferring the version")
+		err = c.fetchTags()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the timestamp must be set before the release type is resolved as it can be used by matching criteria
+	err = c.applyTimestampSource()
+	if err != nil {
+		return nil, err
+	}
+
+	// branch metadata must be available before the release type is resolved as they can be used by matching criteria
+	currentBranch, err := c.getCurrentBranch()
+	if err != nil {
+		return nil, err
+	}
+	branchMetadata, err := c.extractBranchMetadata(currentBranch)
+	if err != nil {
+		return nil, err
+	}
+	err = c.State().SetBranchMetadata(branchMetadata)
+	if err != nil {
+		return nil, err
+	}
+
 	releaseType, err := c.resolveReleaseType()
 	if err != nil {
 		return nil, err
@@ -1424,6 +2018,10 @@ func (c *Infer) Run() (*stt.State, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.checkRequiredEnvironmentVariables(releaseType)
+	if err != nil {
+		return nil, err
+	}
 
 	scheme, err := c.State().GetScheme()
 	if err != nil {
@@ -1437,6 +2035,14 @@ func (c *Infer) Run() (*stt.State, error) {
 	if err != nil {
 		return nil, err
 	}
+	releaseCoercion, err := c.State().GetConfiguration().GetReleaseCoercion()
+	if err != nil {
+		return nil, err
+	}
+	commitChanges, err := c.State().GetConfiguration().GetCommitChanges()
+	if err != nil {
+		return nil, err
+	}
 	configurationVersion, err := c.State().GetConfiguration().GetVersion()
 	if err != nil {
 		return nil, err
@@ -1481,10 +2087,132 @@ func (c *Infer) Run() (*stt.State, error) {
 		if err != nil {
 			return nil, err
 		}
-		previousSignificantCommits, previousBumpIdentifiers, primeSignificantCommits, primeBumpIdentifiers, err = c.scanRepository(scheme, bump, releaseLenient, releasePrefix, releaseType.GetCollapseVersions(), filterTags, *commitMessageConventions.GetItems(), previousSignificantCommits, previousBumpIdentifiers, primeSignificantCommits, primeBumpIdentifiers)
+		followAllParents, err := c.renderTemplateAsBoolean(releaseType.GetFollowAllParents())
 		if err != nil {
 			return nil, err
 		}
+		if followAllParents {
+			log.Debugf("the release type follows all the parents of merge commits when walking the commit history")
+		}
+		ignoreMerges, err := c.renderTemplateAsBoolean(releaseType.GetIgnoreMerges())
+		if err != nil {
+			return nil, err
+		}
+		if ignoreMerges {
+			log.Debugf("the release type ignores merge commits when inferring the version")
+		}
+		var releasedPatchIDs map[string]string
+		ignoreCherryPicks, err := c.renderTemplateAsBoolean(releaseType.GetIgnoreCherryPicks())
+		if err != nil {
+			return nil, err
+		}
+		if ignoreCherryPicks {
+			log.Debugf("the release type ignores commits cherry-picked from versions released on other branches")
+			releasedPatchIDs, err = c.getPatchIDsReleasedOnOtherBranches()
+			if err != nil {
+				return nil, err
+			}
+		}
+		yankedVersions, err := c.getYankedVersions()
+		if err != nil {
+			return nil, err
+		}
+		prereleaseOrder, err := c.getPrereleaseOrder()
+		if err != nil {
+			return nil, err
+		}
+		storedVersions, err := c.getStoredVersions()
+		if err != nil {
+			return nil, err
+		}
+		pullRequestService, err := c.resolvePullRequestMessagesService(releaseType)
+		if err != nil {
+			return nil, err
+		}
+		var bumpLabels map[string]string
+		if releaseType.GetBumpLabels() != nil && len(*releaseType.GetBumpLabels()) > 0 {
+			if pullRequestService == nil {
+				log.Warnf("the release type defines %d bump labels but they are ignored as pull requests are not fetched. Use the 'pullRequestMessages' option to set the service to fetch pull requests from", len(*releaseType.GetBumpLabels()))
+			} else {
+				bumpLabels = *releaseType.GetBumpLabels()
+			}
+		}
+		pathRules, err := c.resolvePathRules()
+		if err != nil {
+			return nil, err
+		}
+		options := scanOptions{
+			commitChanges:      commitChanges != nil && *commitChanges,
+			releaseCoercion:    releaseCoercion,
+			followAllParents:   followAllParents,
+			ignoreMerges:       ignoreMerges,
+			releasedPatchIDs:   releasedPatchIDs,
+			yankedVersions:     yankedVersions,
+			prereleaseOrder:    prereleaseOrder,
+			storedVersions:     storedVersions,
+			pullRequestService: pullRequestService,
+			bumpLabels:         bumpLabels,
+			pathRules:          pathRules,
+		}
+		previousSignificantCommits, previousBumpIdentifiers, primeSignificantCommits, primeBumpIdentifiers, err = c.scanRepository(scheme, bump, releaseLenient, releasePrefix, releaseType.GetCollapseVersions(), filterTags, *commitMessageConventions.GetItems(), options, previousSignificantCommits, previousBumpIdentifiers, primeSignificantCommits, primeBumpIdentifiers)
+		if _, shallow := err.(*errs.ShallowRepositoryError); shallow {
+			unshallow := *ent.GIT_UNSHALLOW
+			if gitConfiguration != nil && gitConfiguration.GetUnshallow() != nil {
+				unshallow = *gitConfiguration.GetUnshallow()
+			}
+			if !unshallow {
+				log.Errorf("the repository is shallow and the commit history walk reached the shallow boundary before finding the previous version. Fetch the complete history or enable the 'git.unshallow' option")
+				return nil, err
+			}
+			log.Infof("the repository is shallow and the commit history walk reached the shallow boundary before finding the previous version. The missing history is fetched and the commit history is scanned again")
+			err = c.unshallow()
+			if err != nil {
+				return nil, err
+			}
+			err = c.clearReleaseScope()
+			if err != nil {
+				return nil, err
+			}
+			previousSignificantCommits, previousBumpIdentifiers, primeSignificantCommits, primeBumpIdentifiers, err = c.scanRepository(scheme, bump, releaseLenient, releasePrefix, releaseType.GetCollapseVersions(), filterTags, *commitMessageConventions.GetItems(), options, []gitent.Commit{}, []string{}, []gitent.Commit{}, []string{})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// the verdicts of the impact analyzers can raise the identifiers to bump, unless the 'bump' was overridden by user
+		if bump == nil {
+			impactReports, err := c.runImpactAnalyzers()
+			if err != nil {
+				return nil, err
+			}
+			releaseScope, err := c.State().GetReleaseScope()
+			if err != nil {
+				return nil, err
+			}
+			releaseScope.SetImpactReports(impactReports)
+			impactBumpIdentifiers := getImpactBumpIdentifiers(impactReports)
+			previousBumpIdentifiers = append(previousBumpIdentifiers, impactBumpIdentifiers...)
+			primeBumpIdentifiers = append(primeBumpIdentifiers, impactBumpIdentifiers...)
+		}
+
+		// with four segment versions the configured significance can be moved to the fourth number
+		if ver.FOUR_SEGMENT == *scheme {
+			revisionBump, err := c.State().GetConfiguration().GetRevisionBump()
+			if err != nil {
+				return nil, err
+			}
+			if revisionBump != nil && "" != strings.TrimSpace(*revisionBump) {
+				log.Debugf("changes with '%s' significance bump the '%s' number", *revisionBump, ver.REVISION_IDENTIFIER)
+				previousBumpIdentifiers, err = ver.MapFourSegmentRevisionSignificance(previousBumpIdentifiers, strings.TrimSpace(*revisionBump))
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal value '%s' for option '%s'", *revisionBump, "revisionBump"), Cause: err}
+				}
+				primeBumpIdentifiers, err = ver.MapFourSegmentRevisionSignificance(primeBumpIdentifiers, strings.TrimSpace(*revisionBump))
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal value '%s' for option '%s'", *revisionBump, "revisionBump"), Cause: err}
+				}
+			}
+		}
 
 		// STEP 2: use default values for those attributes that were not found in the Git commit history
 		err = c.fillStateMissingValuesWithDefaults(releaseType)
@@ -1497,20 +2225,26 @@ func (c *Infer) Run() (*stt.State, error) {
 		if err != nil {
 			return nil, err
 		}
+		// the epoch, if any, is not part of the version so it's removed before parsing and carried over to the new version
+		epoch, previousVersionString := splitEpoch(releaseLenient, releasePrefix, *releaseScope.GetPreviousVersion())
+		if epoch > 0 {
+			log.Debugf("previous version '%s' has epoch '%d' which is carried over to the new version", *releaseScope.GetPreviousVersion(), epoch)
+		}
 		var previousVersion ver.Version
 		if *releaseLenient {
-			previousVersion, err = ver.ValueOfWithSanitization(*scheme, *releaseScope.GetPreviousVersion(), *releaseLenient)
+			previousVersion, err = ver.ValueOfWithSanitization(*scheme, previousVersionString, *releaseLenient)
 		} else {
-			previousVersion, err = ver.ValueOfWithPrefix(*scheme, *releaseScope.GetPreviousVersion(), releasePrefix)
+			previousVersion, err = ver.ValueOfWithPrefix(*scheme, previousVersionString, releasePrefix)
 		}
 		if err != nil {
 			return nil, err
 		}
+		_, primeVersionString := splitEpoch(releaseLenient, releasePrefix, *releaseScope.GetPrimeVersion())
 		var primeVersion ver.Version
 		if *releaseLenient {
-			primeVersion, err = ver.ValueOfWithSanitization(*scheme, *releaseScope.GetPrimeVersion(), *releaseLenient)
+			primeVersion, err = ver.ValueOfWithSanitization(*scheme, primeVersionString, *releaseLenient)
 		} else {
-			primeVersion, err = ver.ValueOfWithPrefix(*scheme, *releaseScope.GetPrimeVersion(), releasePrefix)
+			primeVersion, err = ver.ValueOfWithPrefix(*scheme, primeVersionString, releasePrefix)
 		}
 		if err != nil {
 			return nil, err
@@ -1520,13 +2254,23 @@ func (c *Infer) Run() (*stt.State, error) {
 			return nil, err
 		}
 
+		version, err = c.skipYankedVersions(releasePrefix, releaseType, version, yankedVersions)
+		if err != nil {
+			return nil, err
+		}
+
+		version, err = c.applyBuildMetadata(scheme, releaseType, &previousVersion, version)
+		if err != nil {
+			return nil, err
+		}
+
 		log.Debugf("computed version is: '%s'", (*version).String())
 
 		var stringVersion string
 		if releasePrefix == nil {
-			stringVersion = (*version).String()
+			stringVersion = ver.JoinEpoch(epoch, (*version).String())
 		} else {
-			stringVersion = *releasePrefix + (*version).String()
+			stringVersion = *releasePrefix + ver.JoinEpoch(epoch, (*version).String())
 		}
 		log.Infof("Version: '%s'", stringVersion)
 
@@ -1562,20 +2306,60 @@ func (c *Infer) Run() (*stt.State, error) {
 		} else {
 			log.Debugf("version '%s' did not require version range checks", (*version).String())
 		}
+		checkVersionConstraintOk, err := c.checkVersionConstraint(scheme, version, releaseType.GetVersionConstraint())
+		if err != nil {
+			return nil, err
+		}
+		if checkVersionConstraintOk {
+			log.Debugf("version '%s' successfully passed constraint checks", (*version).String())
+		} else {
+			log.Debugf("version '%s' did not require version constraint checks", (*version).String())
+		}
+		requireSignedCommits, err := c.renderTemplateAsBoolean(releaseType.GetRequireSignedCommits())
+		if err != nil {
+			return nil, err
+		}
+		if requireSignedCommits {
+			err = c.verifyReleaseScopeSignatures()
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		// STEP 5: store values to the state object
 		c.State().SetVersion(&stringVersion)
+
+		// STEP 6: apply the configured policy when there is nothing to release
+		newVersion, err := c.State().GetNewVersion()
+		if err != nil {
+			return nil, err
+		}
+		if !newVersion {
+			err = c.applyNothingToReleasePolicy(releaseScope.GetPreviousVersion())
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
-	stringVersion, err := c.State().GetVersion()
+	newVersion, err := c.State().GetNewVersion()
 	if err != nil {
 		return nil, err
 	}
-	// check if the state version, regardless whether it was inferred or overridden, is the latest
-	latestVersion, err := c.checkLatestVersion(*scheme, *stringVersion, releaseLenient, releasePrefix)
+	c.State().SetNothingToRelease(utl.PointerToBoolean(!newVersion))
+	stringVersion, err := c.State().GetVersion()
 	if err != nil {
 		return nil, err
 	}
-	c.State().SetLatestVersion(&latestVersion)
+	if stringVersion == nil {
+		log.Debugf("no version has been emitted so the latest version check is skipped")
+	} else {
+		// check if the state version, regardless whether it was inferred or overridden, is the latest
+		latestVersion, err := c.checkLatestVersion(*scheme, *stringVersion, releaseLenient, releasePrefix)
+		if err != nil {
+			return nil, err
+		}
+		c.State().SetLatestVersion(&latestVersion)
+	}
 
 	err = c.storeStatusInternalAttributes()
 	if err != nil {
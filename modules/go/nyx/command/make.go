@@ -34,6 +34,7 @@ import (
 
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
 	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
 	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
 	tpl "github.com/mooltiverse/nyx/modules/go/nyx/template"
@@ -113,39 +114,6 @@ func NewMake(state *stt.State, repository *git.Repository) (*Make, error) {
 	return res, nil
 }
 
-/*
-Returns the reference to the configured changelog file, if configured, or nil
-of no destination file has been set by the configuration.
-
-Error is:
-
-- DataAccessError in case the configuration can't be loaded for some reason.
-- IllegalPropertyError in case the configuration has some illegal options.
-*/
-func (c *Make) getChangelogFile() (*string, error) {
-	changelogConfiguration, err := c.State().GetConfiguration().GetChangelog()
-	if err != nil {
-		return nil, err
-	}
-	if changelogConfiguration == nil || changelogConfiguration.GetPath() == nil || "" == strings.TrimSpace(*changelogConfiguration.GetPath()) {
-		return nil, nil
-	}
-
-	changelogFile := *changelogConfiguration.GetPath()
-	// if the file path is relative make it relative to the configured directory
-	if !filepath.IsAbs(changelogFile) {
-		configurationDirectory, err := c.State().GetConfiguration().GetDirectory()
-		if err != nil {
-			return nil, err
-		}
-		if configurationDirectory != nil {
-			changelogFile = filepath.Join(*configurationDirectory, changelogFile)
-		}
-	}
-
-	return &changelogFile, nil
-}
-
 /*
 Returns string containing the template to be used for rendering.
 If the configuration overrides the template then the reader will use to that
@@ -192,6 +160,38 @@ func (c *Make) getChangelogTemplate() (string, error) {
 	}
 }
 
+/*
+Returns the content of the file with the given path, relative to the repository root, as it is at the released commit.
+Files larger than the maximum size allowed to templates are not read.
+
+Error is:
+  - GitError in case the file can't be read from the repository.
+*/
+func (c *Make) readReleasedFile(path string) (string, error) {
+	var commit string
+	releaseScope, err := c.State().GetReleaseScope()
+	if err != nil {
+		return "", err
+	}
+	if releaseScope != nil && releaseScope.HasFinalCommit() {
+		commit = releaseScope.GetFinalCommit().GetSHA()
+	} else {
+		commit, err = c.getLatestCommit()
+		if err != nil {
+			return "", err
+		}
+	}
+	return (*c.Repository()).GetCommitFileContent(commit, path, tpl.REPOSITORY_FILE_MAX_SIZE)
+}
+
+/*
+Returns the private variables to make available to the changelog template, which bring the reader used by the
+repositoryFile template helper to read files from the repository at the released commit.
+*/
+func (c *Make) getChangelogTemplateData() map[string]interface{} {
+	return map[string]interface{}{tpl.REPOSITORY_FILE_READER_DATA_NAME: tpl.RepositoryFileReader(c.readReleasedFile)}
+}
+
 /*
 Builds the changelog assets.
 
@@ -240,14 +240,15 @@ func (c *Make) buildChangelog() error {
 		dateString := date.Format("2006-01-02")
 
 		// As of now we just have one release: the one being issued
-		version, err := c.State().GetVersion()
+		version, err := c.getVersionForBuildMetadataTarget(BUILD_METADATA_TARGET_CHANGELOG)
 		if err != nil {
 			return err
 		}
 		release := ent.NewReleaseWith(version, &dateString)
 		changelog.SetReleases([]*ent.Release{release})
 
-		// As of now we can just pick the commits from the current release scope.
+		// As of now we can just pick the commits from the current release scope, plus those of the pre-releases
+		// that are optionally merged into the release.
 		// We just need to distribute the commits among sections, which means filtering and translating
 		// the sections if the user has configured them, or just use the commit 'type's as section names
 		// if the user didn't map the sections
@@ -255,97 +256,80 @@ func (c *Make) buildChangelog() error {
 		if err != nil {
 			return err
 		}
+		// the reports of the impact analyzers that detected an impact get their own section in the release
+		impactReports := make([]*ent.ImpactReport, 0)
+		for _, impactReport := range releaseScope.GetImpactReports() {
+			if impactReport.GetImpact() {
+				impactReports = append(impactReports, impactReport)
+			}
+		}
+		release.SetImpactReports(impactReports)
 		changelogConfiguration, err := c.State().GetConfiguration().GetChangelog()
 		if err != nil {
 			return err
 		}
-		for _, commit := range releaseScope.GetCommits() {
-			// Now we need to infer the commit type by using the commit message conventions
-			var commitTypes []string
-			commitMessageConventions, err := c.State().GetConfiguration().GetCommitMessageConventions()
+		upgradeNotes, err := c.getUpgradeNotes(changelogConfiguration)
+		if err != nil {
+			return err
+		}
+		release.SetUpgradeNotes(upgradeNotes)
+		var releasedPatchIDs map[string]string
+		if changelogConfiguration.GetDeduplicateCherryPicks() != nil && *changelogConfiguration.GetDeduplicateCherryPicks() {
+			log.Debugf("commits cherry-picked from versions released on other branches will be left out of the changelog")
+			releasedPatchIDs, err = c.getPatchIDsReleasedOnOtherBranches()
 			if err != nil {
 				return err
 			}
-			if commitMessageConventions.GetItems() != nil {
-				log.Debugf("trying to infer the commit type based on the commit message of commit '%s'", commit.GetSHA())
-				for cmcEntryKey, cmcEntryValue := range *commitMessageConventions.GetItems() {
-					log.Debugf("evaluating commit '%s' against message convention '%s'", commit.GetSHA(), cmcEntryKey)
-					re, err := regexp2.Compile(*cmcEntryValue.GetExpression(), 0)
-					if err != nil {
-						return &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot compile regular expression '%s'", *cmcEntryValue.GetExpression()), Cause: err}
-					}
-					matchMessage, err := re.FindStringMatch(commit.GetMessage().GetFullMessage())
-					if err != nil {
-						return &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot evaluate regular expression '%s' against '%s'", *cmcEntryValue.GetExpression(), commit.GetMessage().GetFullMessage()), Cause: err}
-					}
-					// if the commit message matches multiple times we need to determine the commit type for all matches
-					for matchMessage != nil {
-						log.Debugf("commit message convention '%s' matches commit '%s'", cmcEntryKey, commit.GetSHA())
-						commitTypeGroup := matchMessage.GroupByName("type")
-						if commitTypeGroup != nil && len(commitTypeGroup.Captures) > 0 {
-							commitTypeString := commitTypeGroup.Captures[0].String()
-							commitType := &commitTypeString
-							// avoid inserting duplicates in the commitTypes, only add the new commitType if was not already present
-							commitTypeAlreadyPresent := false
-							for _, v := range commitTypes {
-								if v == commitTypeString {
-									commitTypeAlreadyPresent = true
-								}
-							}
-							if !commitTypeAlreadyPresent {
-								commitTypes = append(commitTypes, *commitType)
-								log.Debugf("the commit '%s' is of type '%s'", commit.GetSHA(), *commitType)
-							}
-						} else {
-							// the regular expression doesn't match the name capturing group, no commit type is inferred
-							//return &errs.IllegalPropertyError{Message: fmt.Sprintf("the regular expression '%s' defined for commit message convention '%s' does not define the 'type' named capturing group", *cmcEntryValue.GetExpression(), cmcEntryKey)}
-						}
-						matchMessage, err = re.FindNextMatch(matchMessage)
-						if err != nil {
-							return &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot evaluate regular expression '%s' against '%s'", *cmcEntryValue.GetExpression(), commit.GetMessage().GetFullMessage()), Cause: err}
-						}
-					}
+		}
+		commits := releaseScope.GetCommits()
+		var preReleases []*preReleaseScope
+		if changelogConfiguration.GetMergePreReleases() != nil && ent.SEPARATE != *changelogConfiguration.GetMergePreReleases() {
+			preReleases, err = c.getMergeablePreReleaseScopes()
+			if err != nil {
+				return err
+			}
+			// the release scope may already contain the commits of pre-releases, depending on the release type
+			preReleaseCommits := make(map[string]bool)
+			for _, preRelease := range preReleases {
+				for _, commit := range preRelease.commits {
+					preReleaseCommits[commit.GetSHA()] = true
 				}
 			}
-			if len(commitTypes) == 0 {
-				log.Debugf("unable infer the 'type' for commit '%s'. The commit will not appear in the changelog.", commit.GetSHA())
-			} else {
-				for _, commitType := range commitTypes {
-					// If the user has defined some sections mapping we need to map the commit type to those sections,
-					// otherwise the section will be the commit type
-					if changelogConfiguration.GetSections() == nil || len(*changelogConfiguration.GetSections()) == 0 {
-						log.Debugf("changelog sections haven't been defined by user. Commit '%s' will appear in section '%s' (same as the commit type)", commit.GetSHA(), commitType)
-						releaseCommits := release.GetSection(commitType, true).GetCommits()
-						commitCopy := commit // avoid appending the same item by creating a copy of the item
-						releaseCommits = append(releaseCommits, commitCopy)
-						release.GetSection(commitType, true).SetCommits(releaseCommits)
-					} else {
-						for sectionEntryKey, sectionEntryValue := range *changelogConfiguration.GetSections() {
-							log.Debugf("evaluating commit type '%s' against changelog section '%s'", commitType, sectionEntryKey)
-							re, err := regexp2.Compile(sectionEntryValue, 0)
-							if err != nil {
-								return &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot compile regular expression '%s'", sectionEntryValue), Cause: err}
-							}
-							match, err := re.MatchString(commitType)
-							if err != nil {
-								return &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot evaluate regular expression '%s' against '%s'", sectionEntryValue, commitType), Cause: err}
-							}
-							if match {
-								log.Debugf("expression '%s' for section '%s' successfully matches type '%s' so commit '%s' will appear under the '%s' section", sectionEntryValue, sectionEntryKey, commitType, commit.GetSHA(), sectionEntryKey)
-								releaseCommits := release.GetSection(sectionEntryKey, true).GetCommits()
-								commitCopy := commit // avoid appending the same item by creating a copy of the item
-								releaseCommits = append(releaseCommits, commitCopy)
-								release.GetSection(sectionEntryKey, true).SetCommits(releaseCommits)
-
-								break
-							} else {
-								log.Debugf("expression '%s' for section '%s' does not match type '%s'. Trying with next sections, if any.", sectionEntryValue, sectionEntryKey, commitType)
-								continue
-							}
-						}
-					}
+			commits = make([]*gitent.Commit, 0)
+			for _, commit := range releaseScope.GetCommits() {
+				if !preReleaseCommits[commit.GetSHA()] {
+					commits = append(commits, commit)
 				}
 			}
+			if ent.FLAT == *changelogConfiguration.GetMergePreReleases() {
+				log.Debugf("the commits from %d pre-releases are merged into the release sections", len(preReleases))
+				for _, preRelease := range preReleases {
+					commits = append(commits, preRelease.commits...)
+				}
+			}
+		}
+		err = c.addCommitsToSections(release, commits, changelogConfiguration, releasedPatchIDs)
+		if err != nil {
+			return err
+		}
+		decorateSections(release, changelogConfiguration)
+
+		// with nested merging each pre-release gets its own sub-header within the release
+		if changelogConfiguration.GetMergePreReleases() != nil && ent.NESTED == *changelogConfiguration.GetMergePreReleases() && len(preReleases) > 0 {
+			log.Debugf("%d pre-releases are merged into the release as sub-headers", len(preReleases))
+			nestedPreReleases := make([]*ent.Release, 0)
+			for _, preRelease := range preReleases {
+				preReleaseName := preRelease.name
+				preReleaseDate := time.UnixMilli(preRelease.date).UTC().Format("2006-01-02")
+				nestedPreRelease := ent.NewReleaseWith(&preReleaseName, &preReleaseDate)
+				err = c.addCommitsToSections(nestedPreRelease, preRelease.commits, changelogConfiguration, releasedPatchIDs)
+				if err != nil {
+					return err
+				}
+				decorateSections(nestedPreRelease, changelogConfiguration)
+				nestedPreReleases = append(nestedPreReleases, nestedPreRelease)
+			}
+			release.SetPreReleases(nestedPreReleases)
 		}
 
 		dryRun, err := c.State().GetConfiguration().GetDryRun()
@@ -361,7 +345,7 @@ func (c *Make) buildChangelog() error {
 				return err
 			}
 
-			changelogBuffer, err := tpl.Render(template, changelog)
+			changelogBuffer, err := tpl.RenderWithData(template, changelog, c.getChangelogTemplateData())
 			if err != nil {
 				return &errs.DataAccessError{Message: fmt.Sprintf("unable to render the changelog to file '%s'. Make sure the path to the file exists and can be written.", *changelogFile), Cause: err}
 			}
@@ -414,7 +398,16 @@ func (c *Make) buildChangelog() error {
 				if err != nil {
 					return &errs.DataAccessError{Message: fmt.Sprintf("unable to load the changelog file from '%s'", *changelogFile), Cause: err}
 				}
-				previousContentBuffer := string(previousContentBytes)
+				// releases that have been yanked since the previous contents were written are annotated
+				yankedVersions, err := c.getYankedVersions()
+				if err != nil {
+					return err
+				}
+				releasePrefix, err := c.State().GetConfiguration().GetReleasePrefix()
+				if err != nil {
+					return err
+				}
+				previousContentBuffer := annotateYankedReleases(string(previousContentBytes), yankedVersions, releasePrefix)
 
 				if strings.EqualFold("tail", strings.TrimSpace(*changelogConfiguration.GetAppend())) {
 					changelogBuffer = previousContentBuffer + changelogBuffer
@@ -438,6 +431,139 @@ func (c *Make) buildChangelog() error {
 	return nil
 }
 
+/*
+Distributes the given commits among the sections of the given release, according to the commit types inferred by the
+commit message conventions and the sections mapping in the changelog configuration. Commits cherry-picked from versions
+already released on other branches, as told by the given patch identifiers, are left out.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (c *Make) addCommitsToSections(release *ent.Release, commits []*gitent.Commit, changelogConfiguration *ent.ChangelogConfiguration, releasedPatchIDs map[string]string) error {
+	for _, commit := range commits {
+		releasedCommit, err := c.getReleasedCherryPick(commit.GetSHA(), releasedPatchIDs)
+		if err != nil {
+			return err
+		}
+		if "" != releasedCommit {
+			log.Debugf("commit '%s' has been cherry-picked from (or to) commit '%s', already released on another branch, so it's left out of the changelog", commit.GetSHA(), releasedCommit)
+			continue
+		}
+
+		// Now we need to infer the commit type by using the commit message conventions
+		var commitTypes []string
+		commitMessageConventions, err := c.State().GetConfiguration().GetCommitMessageConventions()
+		if err != nil {
+			return err
+		}
+		if commitMessageConventions.GetItems() != nil {
+			log.Debugf("trying to infer the commit type based on the commit message of commit '%s'", commit.GetSHA())
+			for cmcEntryKey, cmcEntryValue := range *commitMessageConventions.GetItems() {
+				log.Debugf("evaluating commit '%s' against message convention '%s'", commit.GetSHA(), cmcEntryKey)
+				re, err := regexp2.Compile(*cmcEntryValue.GetExpression(), 0)
+				if err != nil {
+					return &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot compile regular expression '%s'", *cmcEntryValue.GetExpression()), Cause: err}
+				}
+				matchMessage, err := re.FindStringMatch(commit.GetMessage().GetFullMessage())
+				if err != nil {
+					return &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot evaluate regular expression '%s' against '%s'", *cmcEntryValue.GetExpression(), commit.GetMessage().GetFullMessage()), Cause: err}
+				}
+				// if the commit message matches multiple times we need to determine the commit type for all matches
+				for matchMessage != nil {
+					log.Debugf("commit message convention '%s' matches commit '%s'", cmcEntryKey, commit.GetSHA())
+					commitTypeGroup := matchMessage.GroupByName("type")
+					if commitTypeGroup != nil && len(commitTypeGroup.Captures) > 0 {
+						commitTypeString := commitTypeGroup.Captures[0].String()
+						commitType := &commitTypeString
+						// avoid inserting duplicates in the commitTypes, only add the new commitType if was not already present
+						commitTypeAlreadyPresent := false
+						for _, v := range commitTypes {
+							if v == commitTypeString {
+								commitTypeAlreadyPresent = true
+							}
+						}
+						if !commitTypeAlreadyPresent {
+							commitTypes = append(commitTypes, *commitType)
+							log.Debugf("the commit '%s' is of type '%s'", commit.GetSHA(), *commitType)
+						}
+					} else {
+						// the regular expression doesn't match the name capturing group, no commit type is inferred
+						//return &errs.IllegalPropertyError{Message: fmt.Sprintf("the regular expression '%s' defined for commit message convention '%s' does not define the 'type' named capturing group", *cmcEntryValue.GetExpression(), cmcEntryKey)}
+					}
+					matchMessage, err = re.FindNextMatch(matchMessage)
+					if err != nil {
+						return &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot evaluate regular expression '%s' against '%s'", *cmcEntryValue.GetExpression(), commit.GetMessage().GetFullMessage()), Cause: err}
+					}
+				}
+			}
+		}
+		if len(commitTypes) == 0 {
+			log.Debugf("unable infer the 'type' for commit '%s'. The commit will not appear in the changelog.", commit.GetSHA())
+		} else {
+			for _, commitType := range commitTypes {
+				// If the user has defined some sections mapping we need to map the commit type to those sections,
+				// otherwise the section will be the commit type
+				if changelogConfiguration.GetSections() == nil || len(*changelogConfiguration.GetSections()) == 0 {
+					log.Debugf("changelog sections haven't been defined by user. Commit '%s' will appear in section '%s' (same as the commit type)", commit.GetSHA(), commitType)
+					releaseCommits := release.GetSection(commitType, true).GetCommits()
+					commitCopy := commit // avoid appending the same item by creating a copy of the item
+					releaseCommits = append(releaseCommits, commitCopy)
+					release.GetSection(commitType, true).SetCommits(releaseCommits)
+				} else {
+					for sectionEntryKey, sectionEntryValue := range *changelogConfiguration.GetSections() {
+						log.Debugf("evaluating commit type '%s' against changelog section '%s'", commitType, sectionEntryKey)
+						re, err := regexp2.Compile(sectionEntryValue, 0)
+						if err != nil {
+							return &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot compile regular expression '%s'", sectionEntryValue), Cause: err}
+						}
+						match, err := re.MatchString(commitType)
+						if err != nil {
+							return &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot evaluate regular expression '%s' against '%s'", sectionEntryValue, commitType), Cause: err}
+						}
+						if match {
+							log.Debugf("expression '%s' for section '%s' successfully matches type '%s' so commit '%s' will appear under the '%s' section", sectionEntryValue, sectionEntryKey, commitType, commit.GetSHA(), sectionEntryKey)
+							releaseCommits := release.GetSection(sectionEntryKey, true).GetCommits()
+							commitCopy := commit // avoid appending the same item by creating a copy of the item
+							releaseCommits = append(releaseCommits, commitCopy)
+							release.GetSection(sectionEntryKey, true).SetCommits(releaseCommits)
+
+							break
+						} else {
+							log.Debugf("expression '%s' for section '%s' does not match type '%s'. Trying with next sections, if any.", sectionEntryValue, sectionEntryKey, commitType)
+							continue
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+/*
+Groups dependency updates and decorates the sections of the given release with the configured emojis, badges and collapsing.
+*/
+func decorateSections(release *ent.Release, changelogConfiguration *ent.ChangelogConfiguration) {
+	for _, section := range release.GetSections() {
+		if changelogConfiguration.GetGroupDependencyUpdates() != nil && *changelogConfiguration.GetGroupDependencyUpdates() {
+			groupDependencyUpdates(section)
+		}
+		if emoji, ok := (*changelogConfiguration.GetEmojis())[*section.GetName()]; ok && "" != emoji {
+			section.SetEmoji(&emoji)
+		}
+		if badge, ok := (*changelogConfiguration.GetBadges())[*section.GetName()]; ok && "" != badge {
+			section.SetBadge(&badge)
+		}
+		if changelogConfiguration.GetCollapseThreshold() != nil && *changelogConfiguration.GetCollapseThreshold() > 0 && len(section.GetCommits()) > *changelogConfiguration.GetCollapseThreshold() {
+			log.Debugf("changelog section '%s' has %d commits, more than the collapse threshold (%d), so it will be collapsed", *section.GetName(), len(section.GetCommits()), *changelogConfiguration.GetCollapseThreshold())
+			section.SetCollapsed(true)
+		}
+	}
+}
+
 /*
 Builds the configured assets.
 
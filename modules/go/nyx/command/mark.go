@@ -25,8 +25,11 @@ import (
 
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
 	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
+	github "github.com/mooltiverse/nyx/modules/go/nyx/services/github"
 	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	tel "github.com/mooltiverse/nyx/modules/go/nyx/telemetry"
 	utl "github.com/mooltiverse/nyx/modules/go/utils"
 )
 
@@ -66,6 +69,15 @@ const (
 
 	// The name used for the internal state attribute where we store the last commit created by this command.
 	MARK_INTERNAL_OUPUT_ATTRIBUTE_COMMIT = MARK_INTERNAL_OUTPUT_ATTRIBUTE_PREFIX + "." + "commit"
+
+	// The name used for the internal state attribute where we store the tags applied by this command, one per line, so they can be deleted by the Clean command.
+	MARK_INTERNAL_OUTPUT_ATTRIBUTE_TAGS = MARK_INTERNAL_OUTPUT_ATTRIBUTE_PREFIX + "." + "tags"
+
+	// The name used for the internal state attribute where we store the remotes pushed to by this command, one per line, so tags can be deleted from them by the Clean command.
+	MARK_INTERNAL_OUTPUT_ATTRIBUTE_REMOTES = MARK_INTERNAL_OUTPUT_ATTRIBUTE_PREFIX + "." + "remotes"
+
+	// The name used for the internal state attribute where we store the tags applied with the force flag by this command, one per line, so they can be force pushed to remotes.
+	MARK_INTERNAL_OUTPUT_ATTRIBUTE_FORCED_TAGS = MARK_INTERNAL_OUTPUT_ATTRIBUTE_PREFIX + "." + "forcedTags"
 )
 
 /*
@@ -106,7 +118,68 @@ func NewMark(state *stt.State, repository *git.Repository) (*Mark, error) {
 }
 
 /*
-Commits pending changes to the Git repository, applies a release tags and pushes changes to remotes.
+Stashes the uncommitted changes unrelated to the release when the Git 'stash' option is enabled, so that they are not
+committed along with the release. The changelog and the release metadata files are left in place.
+
+Returns true if some changes have been stashed and must be restored by unstash().
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (c *Mark) stash(releaseType *ent.ReleaseType) (bool, error) {
+	gitConfiguration, err := c.State().GetConfiguration().GetGit()
+	if err != nil {
+		return false, err
+	}
+	if gitConfiguration == nil || gitConfiguration.GetStash() == nil || !*gitConfiguration.GetStash() {
+		return false, nil
+	}
+	dryRun, err := c.State().GetConfiguration().GetDryRun()
+	if err != nil {
+		return false, err
+	}
+	if *dryRun {
+		log.Infof("Git stash skipped due to dry run")
+		return false, nil
+	}
+
+	excludedPaths := []string{}
+	changelogFile, err := c.getChangelogFile()
+	if err != nil {
+		return false, err
+	}
+	if changelogFile != nil {
+		excludedPaths = append(excludedPaths, *changelogFile)
+	}
+	releaseMetadataFile, err := c.getReleaseMetadataFile(releaseType)
+	if err != nil {
+		return false, err
+	}
+	if releaseMetadataFile != nil {
+		excludedPaths = append(excludedPaths, *releaseMetadataFile)
+	}
+
+	log.Debugf("stashing local changes unrelated to the release (excluding '%v')", excludedPaths)
+	return (*c.Repository()).Stash(excludedPaths)
+}
+
+/*
+Restores the changes stashed by stash().
+
+Error is:
+
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (c *Mark) unstash() error {
+	log.Debugf("restoring the stashed local changes")
+	return (*c.Repository()).StashPop()
+}
+
+/*
+Records the commit of pending changes in the given transaction, so that it's applied along with the release tags.
 
 Error is:
 
@@ -115,7 +188,7 @@ Error is:
 - GitError in case of unexpected issues when accessing the Git repository.
 - ReleaseError if the task is unable to complete for reasons due to the release process.
 */
-func (c *Mark) commit() error {
+func (c *Mark) commit(transaction *git.Transaction) error {
 	clean, err := (*c.Repository()).IsClean()
 	if err != nil {
 		return err
@@ -148,32 +221,49 @@ func (c *Mark) commit() error {
 				}
 			}
 
-			// Here we commit all uncommitted files (of course if they're not ignored by .gitignore). Should we pick a specific subset instead? Maybe among the artifacts produced by Nyx?
-			// Here we can also specify the Author and Committer Identity as per https://github.com/mooltiverse/nyx/issues/65
-			finalCommit, err := (*c.Repository()).CommitPathsWithMessage([]string{"."}, commitMessage)
+			// when a default identity is configured and the repository has none, it's used as the Author and Committer Identity as per https://github.com/mooltiverse/nyx/issues/65
+			identity, err := c.getDefaultIdentity()
 			if err != nil {
 				return err
 			}
-			log.Debugf("local changes committed at '%s'", finalCommit.GetSHA())
-			commitSHA := finalCommit.GetSHA()
-			c.putInternalAttribute(MARK_INTERNAL_OUPUT_ATTRIBUTE_COMMIT, &commitSHA)
 
-			log.Debugf("adding commit '%s' to the release scope", finalCommit.GetSHA())
-			releaseScope, err := c.State().GetReleaseScope()
+			// Here we commit all uncommitted files (of course if they're not ignored by .gitignore). Should we pick a specific subset instead? Maybe among the artifacts produced by Nyx?
+			err = transaction.Commit([]string{"."}, commitMessage, identity, identity)
 			if err != nil {
 				return err
 			}
-
-			releaseCommits := releaseScope.GetCommits()
-			releaseCommits = append(releaseCommits, &finalCommit)
-			releaseScope.SetCommits(releaseCommits)
 		}
 	}
 	return nil
 }
 
 /*
-Applies release tags to the latest commit.
+Records the given commit, made by applying the transaction, in the internal attributes and the release scope.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+*/
+func (c *Mark) committed(finalCommit gitent.Commit) error {
+	log.Debugf("local changes committed at '%s'", finalCommit.GetSHA())
+	commitSHA := finalCommit.GetSHA()
+	c.putInternalAttribute(MARK_INTERNAL_OUPUT_ATTRIBUTE_COMMIT, &commitSHA)
+
+	log.Debugf("adding commit '%s' to the release scope", finalCommit.GetSHA())
+	releaseScope, err := c.State().GetReleaseScope()
+	if err != nil {
+		return err
+	}
+
+	releaseCommits := releaseScope.GetCommits()
+	releaseCommits = append(releaseCommits, &finalCommit)
+	releaseScope.SetCommits(releaseCommits)
+	return nil
+}
+
+/*
+Records the release tags in the given transaction, so that they're applied to the latest commit, which is the release
+commit when it's recorded in the same transaction.
 
 Error is:
 
@@ -182,7 +272,7 @@ Error is:
 - GitError in case of unexpected issues when accessing the Git repository.
 - ReleaseError if the task is unable to complete for reasons due to the release process.
 */
-func (c *Mark) tag() error {
+func (c *Mark) tag(transaction *git.Transaction) error {
 	dryRun, err := c.State().GetConfiguration().GetDryRun()
 	if err != nil {
 		return err
@@ -198,18 +288,46 @@ func (c *Mark) tag() error {
 		if err != nil {
 			return err
 		}
-		latestCommit, err := (*c.Repository()).GetLatestCommit()
+		// when a default identity is configured and the repository has none, it's used as the Tagger Identity as per https://github.com/mooltiverse/nyx/issues/65
+		identity, err := c.getDefaultIdentity()
+		if err != nil {
+			return err
+		}
+		// forced tags are recorded from scratch on every run so that only the ones applied by this run are force pushed
+		err = c.removeInternalAttribute(MARK_INTERNAL_OUTPUT_ATTRIBUTE_FORCED_TAGS)
 		if err != nil {
 			return err
 		}
 		if releaseType.GetGitTagNames() == nil || len(*releaseType.GetGitTagNames()) == 0 {
 			log.Debugf("no tag name has been configured for this release type so no tag is applied")
 		} else {
+			// tags existing before this run are not recorded as they must survive the Clean command, even when they're moved
+			existingTags := map[string]bool{}
+			tags, err := (*c.Repository()).GetTags()
+			if err != nil {
+				return err
+			}
+			for _, existingTag := range tags {
+				existingTags[existingTag.GetName()] = true
+			}
+			// tag names are rendered using the state version, which may differ from the version to use for tags as per the build metadata targets
+			stateVersion, err := c.State().GetVersion()
+			if err != nil {
+				return err
+			}
+			tagVersion, err := c.getVersionForBuildMetadataTarget(BUILD_METADATA_TARGET_TAGS)
+			if err != nil {
+				return err
+			}
 			for _, tagTemplate := range *releaseType.GetGitTagNames() {
 				tag, err := c.renderTemplate(tagTemplate)
 				if err != nil {
 					return err
 				}
+				if stateVersion != nil && tagVersion != nil && *stateVersion != *tagVersion {
+					replacedTag := strings.Replace(*tag, *stateVersion, *tagVersion, 1)
+					tag = &replacedTag
+				}
 				forceFlag, err := c.renderTemplateAsBoolean(releaseType.GetGitTagForce())
 				if err != nil {
 					return err
@@ -217,21 +335,118 @@ func (c *Mark) tag() error {
 
 				log.Tracef("tag template '%s' renders to '%s'", *tagTemplate, *tag)
 				log.Debugf("tag force flag is '%t'", forceFlag)
-				log.Debugf("tagging latest commit '%s' with tag '%s'", latestCommit, *tag)
-				// Here we can also specify the Tagger Identity as per https://github.com/mooltiverse/nyx/issues/65
+				if existingTags[*tag] && !forceFlag {
+					log.Warnf("the repository already has a tag '%s' and the tag force flag is disabled so the tag is not moved", *tag)
+					continue
+				}
+				log.Debugf("tagging latest commit with tag '%s'", *tag)
 				if tagMessage == nil || "" == strings.TrimSpace(*tagMessage) {
-					(*c.Repository()).TagWithMessageAndForce(tag, nil, forceFlag)
+					err = transaction.Tag(tag, nil, nil, forceFlag)
 				} else {
-					(*c.Repository()).TagWithMessageAndForce(tag, tagMessage, forceFlag)
+					err = transaction.Tag(tag, tagMessage, identity, forceFlag)
+				}
+				if err != nil {
+					return err
+				}
+
+				if forceFlag {
+					err = c.appendInternalAttributeLine(MARK_INTERNAL_OUTPUT_ATTRIBUTE_FORCED_TAGS, *tag)
+					if err != nil {
+						return err
+					}
 				}
 
-				log.Debugf("tag '%s' applied to commit '%s'", *tag, latestCommit)
+				if !existingTags[*tag] {
+					err = c.appendInternalAttributeLine(MARK_INTERNAL_OUTPUT_ATTRIBUTE_TAGS, *tag)
+					if err != nil {
+						return err
+					}
+				}
 			}
 		}
 	}
 	return nil
 }
 
+/*
+Logs a warning when the current branch is behind its upstream, as the release may then be based on a stale
+history and push tags the remote doesn't expect. The tracking status is only known as of the last fetch so
+when it can't be determined the check is just skipped.
+*/
+func (c *Mark) checkTrackingStatus() {
+	trackingStatus, err := (*c.Repository()).GetTrackingStatus()
+	if err != nil {
+		log.Debugf("unable to get the tracking status of the current branch: %v", err)
+		return
+	}
+	if trackingStatus == nil {
+		log.Debugf("the current branch has no upstream, the tracking status is not checked")
+		return
+	}
+	if trackingStatus.GetBehind() > 0 {
+		log.Warnf("branch '%s' is '%d' commits behind its upstream '%s', the release may be based on a stale history. Consider pulling remote changes before releasing", trackingStatus.GetBranch(), trackingStatus.GetBehind(), trackingStatus.GetUpstream())
+	}
+}
+
+/*
+Returns the refspecs to use to push tags to the given remote along with the tags, among the given forced ones,
+to force push to it, according to the 'pushTags' option configured for the remote:
+
+- ALL: all the local tags are pushed (the returned refspecs are nil) along with all the forced tags
+- NEW: only the tags applied by the release are pushed, forced tags included
+- NONE: no tag is pushed (the returned refspecs are empty)
+- any other value is a tag name pattern, with at most one '*' wildcard, matching the tags to push
+
+Arguments are as follows:
+
+- remote the name of the remote to push to
+- forcedTags the names of the tags to force push
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (c *Mark) getPushedTags(remote string, forcedTags []string) ([]string, []string, error) {
+	pushTags, err := c.getRemotePushTags(remote)
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Debugf("tags pushed to remote '%s' are '%s'", remote, pushTags)
+	switch strings.ToUpper(pushTags) {
+	case ent.PUSH_TAGS_ALL:
+		return nil, forcedTags, nil
+	case ent.PUSH_TAGS_NONE:
+		return []string{}, []string{}, nil
+	case ent.PUSH_TAGS_NEW:
+		newTags, err := c.getInternalAttributeLines(MARK_INTERNAL_OUTPUT_ATTRIBUTE_TAGS)
+		if err != nil {
+			return nil, nil, err
+		}
+		refSpecs := []string{}
+		added := map[string]bool{}
+		for _, tag := range append(newTags, forcedTags...) {
+			if !added[tag] {
+				added[tag] = true
+				refSpecs = append(refSpecs, "refs/tags/"+tag+":refs/tags/"+tag)
+			}
+		}
+		return refSpecs, forcedTags, nil
+	default:
+		if strings.Count(pushTags, "*") > 1 {
+			return nil, nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the tag pattern '%s' configured for remote '%s' has more than one '*' wildcard", pushTags, remote)}
+		}
+		prefix, suffix, wildcard := strings.Cut(pushTags, "*")
+		matchingForcedTags := []string{}
+		for _, tag := range forcedTags {
+			if (wildcard && len(tag) >= len(prefix)+len(suffix) && strings.HasPrefix(tag, prefix) && strings.HasSuffix(tag, suffix)) || (!wildcard && tag == pushTags) {
+				matchingForcedTags = append(matchingForcedTags, tag)
+			}
+		}
+		return []string{"refs/tags/" + pushTags + ":refs/tags/" + pushTags}, matchingForcedTags, nil
+	}
+}
+
 /*
 Pushes changes to remotes.
 
@@ -251,83 +466,116 @@ func (c *Mark) push() error {
 		log.Infof("Git push skipped due to dry run")
 	} else {
 		log.Debugf("pushing local changes to remotes")
+		c.checkTrackingStatus()
 		releaseType, err := c.State().GetReleaseType()
 		if err != nil {
 			return err
 		}
-		releaseTypes, err := c.State().GetConfiguration().GetReleaseTypes()
+		remotes, err := c.getRemoteRepositories()
 		if err != nil {
 			return err
 		}
-		remotes := releaseTypes.GetRemoteRepositories()
-		if remotes == nil || len(*remotes) == 0 {
-			log.Debugf("the list of remotes is not defined. Using the default remote '%s'", git.DEFAULT_REMOTE_NAME)
-			remotes = &[]*string{utl.PointerToString(git.DEFAULT_REMOTE_NAME)}
+		forcedTags, err := c.getInternalAttributeLines(MARK_INTERNAL_OUTPUT_ATTRIBUTE_FORCED_TAGS)
+		if err != nil {
+			return err
 		}
-		for _, remote := range *remotes {
+		for _, remote := range remotes {
 			log.Debugf("pushing local changes to remote '%s'", *remote)
 
-			// Now we need to find the credentials by going through all the configured remotes and finding
-			// the one that supports the target remote.
-			log.Debugf("looking up credentials for remote '%s'", *remote)
-			var authenticationMethod *ent.AuthenticationMethod
-			var user *string
-			var password *string
-			var privateKey *string
-			var passphrase *string
-			gitConfiguration, err := c.State().GetConfiguration().GetGit()
+			// the remote is recorded before pushing so that tags can be deleted even after a partially failed push
+			err = c.appendInternalAttributeLine(MARK_INTERNAL_OUTPUT_ATTRIBUTE_REMOTES, *remote)
 			if err != nil {
 				return err
 			}
-			if gitConfiguration == nil || gitConfiguration.GetRemotes() == nil {
-				log.Debugf("no Git remote repository has been configured")
-			} else {
-				gitRemoteConfiguration, ok := (*gitConfiguration.GetRemotes())[*remote]
-				if ok {
-					log.Debugf("using configured credentials for remote '%s'", *remote)
-					authenticationMethod = gitRemoteConfiguration.GetAuthenticationMethod()
-					user, err = c.renderTemplate(gitRemoteConfiguration.GetUser())
-					if err != nil {
-						return err
-					}
-					password, err = c.renderTemplate(gitRemoteConfiguration.GetPassword())
-					if err != nil {
-						return err
-					}
-					privateKey, err = c.renderTemplate(gitRemoteConfiguration.GetPrivateKey())
-					if err != nil {
-						return err
-					}
-					passphrase, err = c.renderTemplate(gitRemoteConfiguration.GetPassphrase())
-					if err != nil {
-						return err
-					}
-				} else {
-					log.Debugf("no configuration available for remote '%s'", *remote)
+
+			tagRefSpecs, remoteForcedTags, err := c.getPushedTags(*remote, forcedTags)
+			if err != nil {
+				return err
+			}
+
+			// tags applied with the force flag may already exist on the remote pointing to other commits (i.e. floating tags like 'v1')
+			// so they are force pushed on their own first, without forcing the rest of the push
+			if len(remoteForcedTags) > 0 {
+				log.Debugf("force pushing '%d' tags to remote '%s'", len(remoteForcedTags), *remote)
+				err = c.pushRemoteTags(remote, remoteForcedTags, true)
+				if err != nil {
+					return err
 				}
 			}
 
+			credentials, err := c.getRemoteCredentials(*remote)
+			if err != nil {
+				return err
+			}
+
 			// finally push
 			forceFlag, err := c.renderTemplateAsBoolean(releaseType.GetGitPushForce())
 			if err != nil {
 				return err
 			}
 			log.Debugf("push force flag is '%t'", forceFlag)
-			if authenticationMethod != nil && ent.PUBLIC_KEY == *authenticationMethod {
+			// the lease makes the force push safe, so it takes precedence over the plain force flag
+			leaseFlag, err := c.renderTemplateAsBoolean(releaseType.GetGitPushForceWithLease())
+			if err != nil {
+				return err
+			}
+			log.Debugf("push force with lease flag is '%t'", leaseFlag)
+			if credentials.authenticationMethod != nil && ent.PUBLIC_KEY == *credentials.authenticationMethod {
 				log.Debugf("attempting push to '%s' using public key credentials.", *remote)
 
-				_, err = (*c.Repository()).PushToRemoteWithPublicKeyAndForce(remote, privateKey, passphrase, forceFlag)
+				if leaseFlag {
+					_, err = (*c.Repository()).PushToRemoteWithPublicKeyAndHostKeysAndLeaseAndTagRefSpecs(remote, credentials.privateKey, credentials.passphrase, credentials.knownHosts, credentials.strictHostKeyChecking, tagRefSpecs)
+				} else {
+					_, err = (*c.Repository()).PushToRemoteWithPublicKeyAndHostKeysAndForceAndTagRefSpecs(remote, credentials.privateKey, credentials.passphrase, credentials.knownHosts, credentials.strictHostKeyChecking, forceFlag, tagRefSpecs)
+				}
+				if err != nil {
+					return err
+				}
+			} else if credentials.authenticationMethod != nil && ent.GITHUB_APP == *credentials.authenticationMethod {
+				log.Debugf("attempting push to '%s' using GitHub App installation token credentials.", *remote)
+
+				// tokens are minted for each push, or reused until they're about to expire, so they never expire mid-release
+				if credentials.appID == nil || credentials.privateKey == nil {
+					return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the App ID or the private key are not configured", *remote, ent.GITHUB_APP.String())}
+				}
+				token, err := github.GetInstallationToken(nil, *credentials.appID, *credentials.privateKey, credentials.installationID)
+				if err != nil {
+					return err
+				}
+				if leaseFlag {
+					_, err = (*c.Repository()).PushToRemoteWithUserNameAndPasswordAndLeaseAndTagRefSpecs(remote, utl.PointerToString(github.INSTALLATION_TOKEN_USER), &token, tagRefSpecs)
+				} else {
+					_, err = (*c.Repository()).PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(remote, utl.PointerToString(github.INSTALLATION_TOKEN_USER), &token, forceFlag, tagRefSpecs)
+				}
+				if err != nil {
+					return err
+				}
+			} else if credentials.authenticationMethod != nil && ent.TOKEN == *credentials.authenticationMethod {
+				log.Debugf("attempting push to '%s' using token credentials.", *remote)
+
+				if credentials.password == nil {
+					return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the token is not configured as the password", *remote, ent.TOKEN.String())}
+				}
+				if leaseFlag {
+					_, err = (*c.Repository()).PushToRemoteWithTokenAndLeaseAndTagRefSpecs(remote, credentials.password, credentials.user, tagRefSpecs)
+				} else {
+					_, err = (*c.Repository()).PushToRemoteWithTokenAndForceAndTagRefSpecs(remote, credentials.password, credentials.user, forceFlag, tagRefSpecs)
+				}
 				if err != nil {
 					return err
 				}
 			} else {
-				if user == nil && password == nil {
-					log.Debugf("no credentials were configured for remote '%s'. Attempting anonymous push.", *remote)
+				if credentials.user == nil && credentials.password == nil {
+					log.Debugf("no credentials were configured for remote '%s'. Attempting push with netrc credentials, if any, or anonymous push.", *remote)
 				} else {
 					log.Debugf("attempting push to '%s' using user name and password credentials.", *remote)
 				}
 
-				_, err = (*c.Repository()).PushToRemoteWithUserNameAndPasswordAndForce(remote, user, password, forceFlag)
+				if leaseFlag {
+					_, err = (*c.Repository()).PushToRemoteWithUserNameAndPasswordAndLeaseAndTagRefSpecs(remote, credentials.user, credentials.password, tagRefSpecs)
+				} else {
+					_, err = (*c.Repository()).PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(remote, credentials.user, credentials.password, forceFlag, tagRefSpecs)
+				}
 				if err != nil {
 					return err
 				}
@@ -339,6 +587,168 @@ func (c *Mark) push() error {
 	return nil
 }
 
+/*
+Commits pending changes, tags and pushes to remotes according to the flags of the given release type.
+
+Arguments are as follows:
+
+- releaseType the release type to use for the configuration flags
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+- ReleaseError if the task is unable to complete for reasons due to the release process.
+*/
+func (c *Mark) mark(releaseType *ent.ReleaseType) (err error) {
+	// the commit and the tags are applied together so that none of them is left in the repository if any of them fails
+	transaction, err := git.NewTransaction(*c.Repository())
+	if err != nil {
+		return err
+	}
+
+	doCommit, err := c.renderTemplateAsBoolean(releaseType.GetGitCommit())
+	if err != nil {
+		return err
+	}
+	doTag, err := c.renderTemplateAsBoolean(releaseType.GetGitTag())
+	if err != nil {
+		return err
+	}
+
+	// STASH
+	if doCommit || doTag {
+		// err must not be redeclared here as the deferred function below needs to set the named return value
+		var stashed bool
+		stashed, err = c.stash(releaseType)
+		if err != nil {
+			return err
+		}
+		if stashed {
+			// stashed changes are restored even when committing or tagging fails
+			defer func() {
+				unstashErr := c.unstash()
+				if err == nil {
+					err = unstashErr
+				}
+			}()
+		}
+	}
+
+	// COMMIT
+	if doCommit {
+		log.Debugf("the release type has the git commit flag enabled")
+		dryRun, err := c.State().GetConfiguration().GetDryRun()
+		if err != nil {
+			return err
+		}
+		if *dryRun {
+			log.Infof("release metadata file skipped due to dry run")
+		} else {
+			err = c.writeReleaseMetadataFile(releaseType)
+			if err != nil {
+				return err
+			}
+		}
+		err = c.commit(transaction)
+		if err != nil {
+			return err
+		}
+	} else {
+		log.Debugf("the release type has the git commit flag disabled")
+	}
+
+	// TAG
+	if doTag {
+		log.Debugf("the release type has the git tag flag enabled")
+		err = c.tag(transaction)
+		if err != nil {
+			return err
+		}
+	} else {
+		log.Debugf("the release type has the git tag flag disabled")
+	}
+
+	// APPLY
+	finalCommit, tags, err := transaction.Apply()
+	if err != nil {
+		return err
+	}
+	if finalCommit != nil {
+		err = c.committed(*finalCommit)
+		if err != nil {
+			return err
+		}
+	}
+	for _, tag := range tags {
+		log.Debugf("tag '%s' applied to commit '%s'", tag.GetName(), tag.GetTarget())
+	}
+
+	// PUSH
+	doPush, err := c.renderTemplateAsBoolean(releaseType.GetGitPush())
+	if err != nil {
+		return err
+	}
+	if doPush {
+		log.Debugf("the release type has the git push flag enabled")
+		done := tel.Start(tel.GIT_CATEGORY, "push")
+		err = c.push()
+		done(err)
+		if err != nil {
+			return err
+		}
+	} else {
+		log.Debugf("the release type has the git push flag disabled")
+	}
+
+	// STORE
+	return c.storeVersion()
+}
+
+/*
+Records the new version, along with the commit it's released from, on the version storage service, if any.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+- ReleaseError if the task is unable to complete for reasons due to the release process.
+*/
+func (c *Mark) storeVersion() error {
+	service, serviceName, err := c.resolveVersionStorageService()
+	if err != nil {
+		return err
+	}
+	if service == nil {
+		log.Debugf("no version storage service has been configured so the version is only recorded by tags")
+		return nil
+	}
+	dryRun, err := c.State().GetConfiguration().GetDryRun()
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		log.Infof("storing the version to '%s' skipped due to dry run", serviceName)
+		return nil
+	}
+	version, err := c.State().GetVersion()
+	if err != nil {
+		return err
+	}
+	commit, err := (*c.Repository()).GetLatestCommit()
+	if err != nil {
+		return err
+	}
+	log.Debugf("storing version '%s' released from commit '%s' to '%s'", *version, commit, serviceName)
+	_, err = service.StoreVersion(*version, commit)
+	if err != nil {
+		return &errs.ReleaseError{Message: fmt.Sprintf("unable to store version '%s' to '%s'", *version, serviceName), Cause: err}
+	}
+	return nil
+}
+
 /*
 This method stores the state internal attributes used for up-to-date checks so that subsequent invocations
 of the IsUpToDate() method can find them and determine if the command is already up to date.
@@ -571,49 +981,34 @@ func (c *Mark) Run() (*stt.State, error) {
 			if err != nil {
 				return nil, err
 			}
-			// COMMIT
-			doCommit, err := c.renderTemplateAsBoolean(releaseType.GetGitCommit())
+			gateOpen, err := c.isReleaseGateOpen(releaseType)
 			if err != nil {
 				return nil, err
 			}
-			if doCommit {
-				log.Debugf("the release type has the git commit flag enabled")
-				err = c.commit()
+			if gateOpen {
+				// take a snapshot so the repository can be restored as it was if any of the following steps fails,
+				// including the release metadata file that is written to the working tree before committing
+				snapshotPaths := []string{}
+				releaseMetadataFile, err := c.getReleaseMetadataFile(releaseType)
 				if err != nil {
 					return nil, err
 				}
-			} else {
-				log.Debugf("the release type has the git commit flag disabled")
-			}
-
-			// TAG
-			doTag, err := c.renderTemplateAsBoolean(releaseType.GetGitTag())
-			if err != nil {
-				return nil, err
-			}
-			if doTag {
-				log.Debugf("the release type has the git tag flag enabled")
-				err = c.tag()
+				if releaseMetadataFile != nil {
+					snapshotPaths = append(snapshotPaths, *releaseMetadataFile)
+				}
+				snapshot, err := (*c.Repository()).SnapshotWithPaths(snapshotPaths)
 				if err != nil {
 					return nil, err
 				}
-			} else {
-				log.Debugf("the release type has the git tag flag disabled")
-			}
-
-			// PUSH
-			doPush, err := c.renderTemplateAsBoolean(releaseType.GetGitPush())
-			if err != nil {
-				return nil, err
-			}
-			if doPush {
-				log.Debugf("the release type has the git push flag enabled")
-				err = c.push()
+				err = c.mark(releaseType)
 				if err != nil {
+					log.Warnf("restoring the repository to the status it had before running the Mark command due to an error: %v", err)
+					restoreErr := snapshot.Restore()
+					if restoreErr != nil {
+						log.Errorf("unable to restore the repository to the status it had before running the Mark command: %v", restoreErr)
+					}
 					return nil, err
 				}
-			} else {
-				log.Debugf("the release type has the git push flag disabled")
 			}
 		} else {
 			log.Warnf("no release type available. Nothing to release.")
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"           // https://pkg.go.dev/fmt
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
+
+	doublestar "github.com/bmatcuk/doublestar/v4" // https://github.com/bmatcuk/doublestar
+	log "github.com/sirupsen/logrus"              // https://pkg.go.dev/github.com/sirupsen/logrus
+	slices "golang.org/x/exp/slices"              // https://pkg.go.dev/golang.org/x/exp/slices
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+/*
+A path rule resolved from the configuration and ready to be matched against the paths changed by commits.
+*/
+type resolvedPathRule struct {
+	// The name of the rule.
+	name string
+
+	// The glob patterns matching the paths the rule applies to.
+	patterns []string
+
+	// The identifier to bump when a commit changes any path matched by the rule. Empty means the rule bumps no identifier.
+	bump string
+
+	// The flag telling whether changes to the paths matched by the rule are significant.
+	significant bool
+}
+
+/*
+Returns the enabled path rules, in the order they are enabled.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (ac *abstractCommand) resolvePathRules() ([]resolvedPathRule, error) {
+	res := make([]resolvedPathRule, 0)
+	pathRules, err := ac.State().GetConfiguration().GetPathRules()
+	if err != nil {
+		return nil, err
+	}
+	if pathRules == nil || pathRules.GetEnabled() == nil || len(*pathRules.GetEnabled()) == 0 {
+		return res, nil
+	}
+
+	for _, enabled := range *pathRules.GetEnabled() {
+		if enabled == nil || "" == strings.TrimSpace(*enabled) {
+			continue
+		}
+		name := *enabled
+		pathRule, ok := (*pathRules.GetItems())[name]
+		if !ok || pathRule == nil {
+			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("path rule '%s' is enabled but is not configured", name)}
+		}
+		patterns := make([]string, 0)
+		if pathRule.GetPaths() != nil {
+			for _, path := range *pathRule.GetPaths() {
+				if path == nil || "" == strings.TrimSpace(*path) {
+					continue
+				}
+				pattern := filepath.ToSlash(strings.TrimSpace(*path))
+				if !doublestar.ValidatePattern(pattern) {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("path rule '%s' has a malformed glob pattern: '%s'", name, *path)}
+				}
+				patterns = append(patterns, pattern)
+			}
+		}
+		if len(patterns) == 0 {
+			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("path rule '%s' has no paths", name)}
+		}
+		bump := ""
+		if pathRule.GetBump() != nil {
+			bump = strings.TrimSpace(*pathRule.GetBump())
+		}
+		significant := *ent.PATH_RULE_SIGNIFICANT
+		if pathRule.GetSignificant() != nil {
+			significant = *pathRule.GetSignificant()
+		}
+		res = append(res, resolvedPathRule{name: name, patterns: patterns, bump: bump, significant: significant})
+	}
+	return res, nil
+}
+
+/*
+Returns the identifiers to bump for a commit changing the given paths according to the given path rules, along with
+a flag telling if the commit is not significant because all of its paths are matched by rules marking them as not
+significant. When the flag is true the commit message conventions must not be evaluated for the commit.
+
+Each path is only evaluated against the first rule matching it, in the order rules are given. Rules marking their
+paths as not significant never bump any identifier. When no paths are given (i.e. for empty commits) no identifier
+is returned and the flag is false.
+
+Arguments are as follows:
+
+  - commit the SHA-1 of the commit, only used for logging
+  - paths the paths changed by the commit
+  - pathRules the path rules, in the order they are evaluated. It may be nil or empty, in which case no rule matches
+*/
+func getPathRuleIdentifiers(commit string, paths []string, pathRules []resolvedPathRule) ([]string, bool) {
+	if len(paths) == 0 || len(pathRules) == 0 {
+		return nil, false
+	}
+	identifiers := []string{}
+	ignoredPaths := 0
+	for _, path := range paths {
+		for _, pathRule := range pathRules {
+			matched := false
+			for _, pattern := range pathRule.patterns {
+				if match, _ := doublestar.Match(pattern, filepath.ToSlash(path)); match {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				continue
+			}
+			if !pathRule.significant {
+				log.Debugf("path '%s' changed by commit '%s' is matched by path rule '%s' and is not significant", path, commit, pathRule.name)
+				ignoredPaths++
+			} else if "" != pathRule.bump {
+				log.Debugf("path '%s' changed by commit '%s' is matched by path rule '%s', meaning that the '%s' identifier has to be bumped, according to this commit", path, commit, pathRule.name, pathRule.bump)
+				if !slices.Contains(identifiers, pathRule.bump) {
+					identifiers = append(identifiers, pathRule.bump)
+				}
+			} else {
+				log.Debugf("path '%s' changed by commit '%s' is matched by path rule '%s' which bumps no identifier", path, commit, pathRule.name)
+			}
+			break
+		}
+	}
+	return identifiers, ignoredPaths == len(paths)
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	ver "github.com/mooltiverse/nyx/modules/go/version"
+)
+
+/*
+The scope of a pre-release that precedes a final release.
+*/
+type preReleaseScope struct {
+	// The pre-release version.
+	name string
+
+	// The date of the commit tagged with the pre-release version, in milliseconds.
+	date int64
+
+	// The commits in the pre-release scope, the most recent first.
+	commits []*gitent.Commit
+}
+
+/*
+Returns the scopes of the pre-releases issued since the latest final release, when the version being released is
+a final one. Scopes are returned the most recent first, each with the commits since the pre-release before it
+(or since the latest final release, for the oldest one).
+
+The history is inspected from the final commit of the release scope so pre-releases are found regardless of
+whether the release scope starts from the latest final release or from the latest pre-release.
+
+An empty slice is returned when the version being released is a pre-release or no pre-release has been issued
+since the latest final release.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (ac *abstractCommand) getMergeablePreReleaseScopes() ([]*preReleaseScope, error) {
+	res := make([]*preReleaseScope, 0)
+	scheme, err := ac.State().GetScheme()
+	if err != nil {
+		return nil, err
+	}
+	releaseLenient, err := ac.State().GetConfiguration().GetReleaseLenient()
+	if err != nil {
+		return nil, err
+	}
+	releasePrefix, err := ac.State().GetConfiguration().GetReleasePrefix()
+	if err != nil {
+		return nil, err
+	}
+	isLegal := func(version string) bool {
+		_, version = splitEpoch(releaseLenient, releasePrefix, version)
+		return (*releaseLenient && ver.IsLegalWithLenience(*scheme, version, *releaseLenient)) || (!*releaseLenient && ver.IsLegalWithPrefix(*scheme, version, releasePrefix))
+	}
+	isCore := func(version string) bool {
+		_, version = splitEpoch(releaseLenient, releasePrefix, version)
+		return (*releaseLenient && ver.IsCoreWithLenience(*scheme, version, *releaseLenient)) || (!*releaseLenient && ver.IsCoreWithPrefix(*scheme, version, releasePrefix))
+	}
+
+	version, err := ac.State().GetVersion()
+	if err != nil {
+		return nil, err
+	}
+	if version == nil || !isCore(*version) {
+		log.Debugf("the version being released is not a final one so there are no pre-releases to merge")
+		return res, nil
+	}
+	releaseScope, err := ac.State().GetReleaseScope()
+	if err != nil {
+		return nil, err
+	}
+	if releaseScope.GetFinalCommit() == nil {
+		log.Debugf("the release scope has no commits so there are no pre-releases to merge")
+		return res, nil
+	}
+
+	// walk the history back, starting a new scope at every pre-release, until the latest final release
+	var current *preReleaseScope = nil
+	start := releaseScope.GetFinalCommit().GetSHA()
+	err = (*ac.repository).WalkHistory(&start, nil, func(commit gitent.Commit) bool {
+		preReleaseTag := ""
+		for _, tag := range commit.GetTags() {
+			// the version being released may have already been tagged
+			if tag.GetName() == *version || !isLegal(tag.GetName()) {
+				continue
+			}
+			if isCore(tag.GetName()) {
+				log.Debugf("commit '%s' is tagged with the final release '%s', no more pre-releases to merge", commit.GetSHA(), tag.GetName())
+				return false
+			}
+			if "" == preReleaseTag {
+				preReleaseTag = tag.GetName()
+			}
+		}
+		if "" != preReleaseTag {
+			log.Debugf("collecting the commits of pre-release '%s'", preReleaseTag)
+			current = &preReleaseScope{name: preReleaseTag, date: commit.GetDate(), commits: make([]*gitent.Commit, 0)}
+			res = append(res, current)
+		}
+		// commits more recent than the latest pre-release only belong to the release being issued
+		if current != nil {
+			commitCopy := commit
+			current.commits = append(current.commits, &commitCopy)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("%d pre-releases have been issued since the latest final release", len(res))
+	return res, nil
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+)
+
+/*
+Returns the pre-release identifiers, from the lowest to the highest precedence, as configured by the
+'prereleaseOrder' global option, to be used when comparing versions. The returned slice is empty when the option
+is not set, in which case pre-release identifiers are compared as per the version scheme.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (ac *abstractCommand) getPrereleaseOrder() ([]string, error) {
+	prereleaseOrder, err := ac.State().GetConfiguration().GetPrereleaseOrder()
+	if err != nil {
+		return nil, err
+	}
+	res := []string{}
+	if prereleaseOrder == nil {
+		return res, nil
+	}
+	for _, identifier := range strings.Split(*prereleaseOrder, ",") {
+		if "" != strings.TrimSpace(identifier) {
+			res = append(res, strings.TrimSpace(identifier))
+		}
+	}
+	if len(res) > 0 {
+		log.Debugf("pre-release identifiers are compared using the custom order '%s'", strings.Join(res, " < "))
+	}
+	return res, nil
+}
@@ -0,0 +1,337 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
+	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	tpl "github.com/mooltiverse/nyx/modules/go/nyx/template"
+)
+
+const (
+	// The marker used to identify the preview comment among other comments on pull requests, so that it can be updated
+	// by subsequent runs instead of creating new comments.
+	PREVIEW_COMMENT_MARKER = "<!-- nyx-release-preview -->"
+
+	// The common prefix used for all the internal state attributes managed by this class.
+	PREVIEW_INTERNAL_ATTRIBUTE_PREFIX = "preview"
+
+	// The common prefix used for all the internal state attributes managed by this class, representing an input.
+	PREVIEW_INTERNAL_INPUT_ATTRIBUTE_PREFIX = PREVIEW_INTERNAL_ATTRIBUTE_PREFIX + "." + "input"
+
+	// The name used for the internal state attribute where we store the SHA-1 of the last
+	// commit in the current branch by the time this command was last executed.
+	PREVIEW_INTERNAL_INPUT_ATTRIBUTE_REPOSITORY_LAST_COMMIT = PREVIEW_INTERNAL_INPUT_ATTRIBUTE_PREFIX + "." + "repository" + "." + "last" + "." + "commit"
+
+	// The name used for the internal state attribute where we store the version.
+	PREVIEW_INTERNAL_INPUT_ATTRIBUTE_STATE_VERSION = PREVIEW_INTERNAL_INPUT_ATTRIBUTE_PREFIX + "." + "state" + "." + "version"
+)
+
+/*
+The Preview command takes care of publishing a preview of the release that would be issued from the current
+pull request (or merge request) as a comment on the pull request itself, giving reviewers visibility on the
+version impact and the changelog before changes are merged.
+
+This class is not meant to be used in multi-threaded environments.
+*/
+type Preview struct {
+	// Extend abstractCommand by composition
+	abstractCommand
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- state the state reference
+- repository the repository reference
+
+Error is:
+
+- NilPointerError: if a given argument is nil
+*/
+func NewPreview(state *stt.State, repository *git.Repository) (*Preview, error) {
+	if state == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the State object cannot be nil")}
+	}
+	if repository == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the Repository object cannot be nil")}
+	}
+	log.Debugf("new Preview command object")
+
+	res := &Preview{}
+	res.abstractCommand.repository = repository
+	res.abstractCommand.state = state
+	return res, nil
+}
+
+/*
+Renders the body of the preview comment, including the marker, from the current state.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (c *Preview) renderComment() (string, error) {
+	var body strings.Builder
+	body.WriteString(PREVIEW_COMMENT_MARKER + "\n")
+	body.WriteString("### Release preview\n\n")
+
+	version, err := c.State().GetVersion()
+	if err != nil {
+		return "", err
+	}
+	newVersion, err := c.State().GetNewVersion()
+	if err != nil {
+		return "", err
+	}
+	if !newVersion {
+		if version == nil {
+			body.WriteString("The changes in this pull request do not produce a new release.\n")
+		} else {
+			body.WriteString(fmt.Sprintf("The changes in this pull request do not produce a new release. The version remains `%s`.\n", *version))
+		}
+		return body.String(), nil
+	}
+
+	previousVersion := "none"
+	releaseScope, err := c.State().GetReleaseScope()
+	if err != nil {
+		return "", err
+	}
+	if releaseScope != nil && releaseScope.GetPreviousVersion() != nil {
+		previousVersion = *releaseScope.GetPreviousVersion()
+	}
+	bump := "none"
+	stateBump, err := c.State().GetBump()
+	if err != nil {
+		return "", err
+	}
+	if stateBump != nil && "" != strings.TrimSpace(*stateBump) {
+		bump = *stateBump
+	}
+	body.WriteString(fmt.Sprintf("The changes in this pull request produce a new release with version `%s`.\n\n", *version))
+	body.WriteString("| Previous version | New version | Bump |\n")
+	body.WriteString("| ---------------- | ----------- | ---- |\n")
+	body.WriteString(fmt.Sprintf("| `%s` | `%s` | `%s` |\n", previousVersion, *version, bump))
+
+	changelog, err := c.State().GetChangelog()
+	if err != nil {
+		return "", err
+	}
+	if changelog == nil {
+		log.Debugf("no changelog is available so the preview will not contain the changelog")
+	} else {
+		// the Make command knows how to load the user configured template, if any, so we just use it here
+		makeCommand := &Make{abstractCommand: c.abstractCommand}
+		template, err := makeCommand.getChangelogTemplate()
+		if err != nil {
+			return "", err
+		}
+		changelogBuffer, err := tpl.RenderWithData(template, changelog, makeCommand.getChangelogTemplateData())
+		if err != nil {
+			return "", &errs.DataAccessError{Message: fmt.Sprintf("unable to render the changelog for the preview"), Cause: err}
+		}
+		body.WriteString("\n<details>\n<summary>Changelog</summary>\n\n")
+		body.WriteString(changelogBuffer)
+		body.WriteString("\n</details>\n")
+	}
+	return body.String(), nil
+}
+
+/*
+Publishes the preview comment to the pull request using the configured publication services supporting the
+PULL_REQUEST_COMMENTS feature.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- ReleaseError if the task is unable to complete for reasons due to the release process.
+*/
+func (c *Preview) preview() error {
+	releaseTypes, err := c.State().GetConfiguration().GetReleaseTypes()
+	if err != nil {
+		return err
+	}
+	if releaseTypes.GetPublicationServices() == nil || len(*releaseTypes.GetPublicationServices()) == 0 {
+		log.Debugf("no publication services have been configured so no preview will be published")
+		return nil
+	}
+
+	body, err := c.renderComment()
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := c.State().GetConfiguration().GetDryRun()
+	if err != nil {
+		return err
+	}
+	for _, serviceName := range *releaseTypes.GetPublicationServices() {
+		service, err := c.resolveReleaseService(*serviceName)
+		if err != nil {
+			return err
+		}
+		if service == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the preview cannot be published because the '%s' service has not been configured", *serviceName)}
+		}
+		supportingService, ok := (*service).(svcapi.Service)
+		if !ok || !supportingService.Supports(svcapi.PULL_REQUEST_COMMENTS) {
+			log.Debugf("the '%s' service does not support the %s feature so no preview is published there", *serviceName, svcapi.PULL_REQUEST_COMMENTS)
+			continue
+		}
+		commentService, ok := (*service).(svcapi.PullRequestCommentService)
+		if !ok {
+			return &errs.UnsupportedOperationError{Message: fmt.Sprintf("the '%s' service supports the %s feature but does not implement the %s interface", *serviceName, svcapi.PULL_REQUEST_COMMENTS, "PullRequestCommentService")}
+		}
+		if *dryRun {
+			log.Infof("the preview comment is not published to the '%s' service due to dry run", *serviceName)
+		} else {
+			log.Debugf("publishing the preview comment to the '%s' service", *serviceName)
+			// The first three parameters here are nil because the repository owner, name and the pull request are expected to be passed
+			// along with service options or detected from the environment. This is just a place where we could override them.
+			err = commentService.PublishPullRequestComment(nil, nil, nil, PREVIEW_COMMENT_MARKER, body)
+			if err != nil {
+				return &errs.ReleaseError{Message: fmt.Sprintf("unable to publish the preview comment to the '%s' service", *serviceName), Cause: err}
+			}
+			log.Debugf("the preview comment has been published to the '%s' service", *serviceName)
+		}
+	}
+	return nil
+}
+
+/*
+This method stores the state internal attributes used for up-to-date checks so that subsequent invocations
+of the IsUpToDate() method can find them and determine if the command is already up to date.
+
+This method is meant to be invoked at the end of a successful Run().
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (c *Preview) storeStatusInternalAttributes() error {
+	log.Debugf("storing the Preview command internal attributes to the State")
+	dryRun, err := c.State().GetConfiguration().GetDryRun()
+	if err != nil {
+		return err
+	}
+	if !*dryRun {
+		latestCommit, err := c.getLatestCommit()
+		if err != nil {
+			return err
+		}
+		err = c.putInternalAttribute(PREVIEW_INTERNAL_INPUT_ATTRIBUTE_REPOSITORY_LAST_COMMIT, &latestCommit)
+		if err != nil {
+			return err
+		}
+		version, err := c.State().GetVersion()
+		if err != nil {
+			return err
+		}
+		err = c.putInternalAttribute(PREVIEW_INTERNAL_INPUT_ATTRIBUTE_STATE_VERSION, version)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Returns true if this command is up to date, which means that the internal State would not
+change by running the command again. It other words, when this method returns true any
+invocation of the Run method is needless and idempotent about the state.
+
+This method uses the quickest method to verify whether the state is up to date or not. This method must not rely on
+dependencies and it must always evaluate its own status independently.
+
+As a general rule this method checks if its inputs (i.e. from the configuration) have changed since the last run.
+
+Error is:
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (c *Preview) IsUpToDate() (bool, error) {
+	log.Debugf("checking whether the Preview command is up to date")
+
+	// Never up to date if this command hasn't stored a version yet into the state or the stored version is different than the state version
+	version, err := c.State().GetVersion()
+	if err != nil {
+		return false, err
+	}
+	isVersionUpTodate, err := c.isInternalAttributeUpToDate(PREVIEW_INTERNAL_INPUT_ATTRIBUTE_STATE_VERSION, version)
+	if err != nil {
+		return false, err
+	}
+	if version == nil || !isVersionUpTodate {
+		log.Debugf("the Preview command is not up to date because the internal state has no version yet or the state version doesn't match the version previously previewed by Preview")
+		return false, nil
+	}
+
+	// The command is never considered up to date when the repository last commit has changed
+	latestCommit, err := c.getLatestCommit()
+	if err != nil {
+		return false, err
+	}
+	isLatestCommitUpToDate, err := c.isInternalAttributeUpToDate(PREVIEW_INTERNAL_INPUT_ATTRIBUTE_REPOSITORY_LAST_COMMIT, &latestCommit)
+	if err != nil {
+		return false, err
+	}
+	if !isLatestCommitUpToDate {
+		log.Debugf("the Preview command is not up to date because the last commit has changed")
+		return false, nil
+	}
+
+	return true, nil
+}
+
+/*
+Runs the command and returns the updated reference to the state object. In order to improve performances you should only
+invoke this method when IsUpToDate returns false.
+
+Error is:
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+- ReleaseError if the task is unable to complete for reasons due to the release process.
+*/
+func (c *Preview) Run() (*stt.State, error) {
+	err := c.preview()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.storeStatusInternalAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.State(), nil
+}
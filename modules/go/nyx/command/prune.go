@@ -0,0 +1,164 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
+	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+)
+
+/*
+The Prune command is a maintenance command that enforces the retention policies configured on the publication
+services, removing the assets of published releases that exceed them so that storage quotas stay under control.
+
+This command doesn't depend on the release process and never changes the state or the repository.
+
+This class is not meant to be used in multi-threaded environments.
+*/
+type Prune struct {
+	// Extend abstractCommand by composition
+	abstractCommand
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- state the state reference
+- repository the repository reference
+
+Error is:
+
+- NilPointerError: if a given argument is nil
+*/
+func NewPrune(state *stt.State, repository *git.Repository) (*Prune, error) {
+	if state == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the State object cannot be nil")}
+	}
+	if repository == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the Repository object cannot be nil")}
+	}
+	log.Debugf("new Prune command object")
+
+	res := &Prune{}
+	res.abstractCommand.repository = repository
+	res.abstractCommand.state = state
+	return res, nil
+}
+
+/*
+Enforces the retention policies on all the publication services supporting the RELEASE_RETENTION feature.
+Services not supporting the feature are skipped.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- ReleaseError if the task is unable to complete for reasons due to the release process.
+*/
+func (c *Prune) prune() error {
+	releaseTypes, err := c.State().GetConfiguration().GetReleaseTypes()
+	if err != nil {
+		return err
+	}
+	if releaseTypes == nil || releaseTypes.GetPublicationServices() == nil || len(*releaseTypes.GetPublicationServices()) == 0 {
+		log.Debugf("no publication services have been configured so there is nothing to prune")
+		return nil
+	}
+	dryRun, err := c.State().GetConfiguration().GetDryRun()
+	if err != nil {
+		return err
+	}
+
+	for _, serviceName := range *releaseTypes.GetPublicationServices() {
+		service, err := c.resolveReleaseService(*serviceName)
+		if err != nil {
+			return err
+		}
+		if service == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the release type uses the '%s' publication service but no such service has been configured in the 'services' section", *serviceName)}
+		}
+		supportingService, ok := (*service).(svcapi.Service)
+		if !ok || !supportingService.Supports(svcapi.RELEASE_RETENTION) {
+			log.Debugf("the '%s' service does not support the %s feature so it's not pruned", *serviceName, svcapi.RELEASE_RETENTION)
+			continue
+		}
+		retentionService, ok := (*service).(svcapi.RetentionService)
+		if !ok {
+			return &errs.UnsupportedOperationError{Message: fmt.Sprintf("the '%s' service supports the %s feature but does not implement the %s interface", *serviceName, svcapi.RELEASE_RETENTION, "RetentionService")}
+		}
+		if *dryRun {
+			log.Infof("enforcing the retention policy on '%s' skipped due to dry run", *serviceName)
+			continue
+		}
+		// The two parameters here are nil because the repository owner and name are expected to be passed
+		// along with service options. This is just a place where we could override them.
+		removed, err := retentionService.EnforceRetention(nil, nil)
+		if err != nil {
+			return &errs.ReleaseError{Message: fmt.Sprintf("unable to enforce the retention policy on '%s'", *serviceName), Cause: err}
+		}
+		for _, asset := range removed {
+			log.Infof("release asset '%s' has been removed from '%s' as per the retention policy", asset, *serviceName)
+		}
+		log.Debugf("%d release assets have been removed from '%s'", len(removed), *serviceName)
+	}
+	return nil
+}
+
+/*
+Returns true if this command is up to date, which means that the internal State would not
+change by running the command again. It other words, when this method returns true any
+invocation of the Run method is needless and idempotent about the state.
+
+This command is never up to date as its outcome only depends on the releases published on the remote services,
+which may change at any time.
+
+Error is:
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (c *Prune) IsUpToDate() (bool, error) {
+	log.Debugf("checking whether the Prune command is up to date")
+	return false, nil
+}
+
+/*
+Runs the command and returns the updated reference to the state object. In order to improve performances you should only
+invoke this method when IsUpToDate returns false.
+
+Error is:
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+- ReleaseError if the task is unable to complete for reasons due to the release process.
+*/
+func (c *Prune) Run() (*stt.State, error) {
+	err := c.prune()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.State(), nil
+}
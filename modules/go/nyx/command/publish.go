@@ -17,18 +17,31 @@
 package command
 
 import (
-	"fmt" // https://pkg.go.dev/fmt
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strconv"       // https://pkg.go.dev/strconv
+	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
 
-	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+	regexp2 "github.com/dlclark/regexp2" // https://pkg.go.dev/github.com/dlclark/regexp2, we need to use this instead of the standard 'regexp' to have support for lookarounds (look ahead), even if this implementation is a little slower
+	log "github.com/sirupsen/logrus"     // https://pkg.go.dev/github.com/sirupsen/logrus
 
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
 	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
-	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+	io "github.com/mooltiverse/nyx/modules/go/nyx/io"
+	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
 	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	tel "github.com/mooltiverse/nyx/modules/go/nyx/telemetry"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
 )
 
 const (
+	// The name of the environment variable that, when defined, brings the time (in seconds since the epoch) to use
+	// for the entries of release asset archives, according to https://reproducible-builds.org/specs/source-date-epoch/.
+	SOURCE_DATE_EPOCH_ENVVAR_NAME = "SOURCE_DATE_EPOCH"
+
 	// The common prefix used for all the internal state attributes managed by this class.
 	PUBLISH_INTERNAL_ATTRIBUTE_PREFIX = "publish"
 
@@ -41,8 +54,15 @@ const (
 	// The name used for the internal state attribute where we store the version.
 	PUBLISH_INTERNAL_INPUT_ATTRIBUTE_STATE_VERSION = PUBLISH_INTERNAL_INPUT_ATTRIBUTE_PREFIX + "." + "state" + "." + "version"
 
+	// The name used for the internal state attribute where we store the yanked versions.
+	PUBLISH_INTERNAL_INPUT_ATTRIBUTE_CONFIGURATION_YANKED = PUBLISH_INTERNAL_INPUT_ATTRIBUTE_PREFIX + "." + "configuration" + "." + "yanked"
+
 	// The name used for the internal state attribute where we store the last version that was published by this command.
 	PUBLISH_INTERNAL_OUPUT_ATTRIBUTE_STATE_VERSION = PUBLISH_INTERNAL_OUTPUT_ATTRIBUTE_PREFIX + "." + "state" + "." + "version"
+
+	// The format string used to build the name of the branch the downstream update pull requests are opened from.
+	// The first parameter is the name of the downstream update, the second is the version.
+	DOWNSTREAM_UPDATE_BRANCH_FORMAT_STRING = "nyx/%s/%s"
 )
 
 /*
@@ -82,6 +102,96 @@ func NewPublish(state *stt.State, repository *git.Repository) (*Publish, error)
 	return res, nil
 }
 
+/*
+Waits for the approval of the release on the protected environment configured by the release type, if any.
+The approval is checked on the first publication service supporting the RELEASE_APPROVALS feature and
+this method polls the service until the approval is granted, rejected or the timeout expires.
+
+Arguments are as follows:
+
+- releaseType the current release type
+- serviceNames the names of the publication services
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- ReleaseError if the approval is rejected or not granted within the timeout.
+*/
+func (c *Publish) waitForApproval(releaseType *ent.ReleaseType, serviceNames []*string) error {
+	environment, err := c.renderTemplate(releaseType.GetPublishApprovalEnvironment())
+	if err != nil {
+		return err
+	}
+	if environment == nil || len(*environment) == 0 {
+		log.Debugf("the release type does not require any approval to publish")
+		return nil
+	}
+
+	pollingIntervalTemplate := releaseType.GetPublishApprovalPollingInterval()
+	if pollingIntervalTemplate == nil {
+		pollingIntervalTemplate = ent.RELEASE_TYPE_PUBLISH_APPROVAL_POLLING_INTERVAL
+	}
+	pollingInterval, err := c.renderTemplateAsInteger(pollingIntervalTemplate)
+	if err != nil {
+		return err
+	}
+	if pollingInterval <= 0 {
+		return &errs.IllegalPropertyError{Message: fmt.Sprintf("the publish approval polling interval must be a positive number of seconds while it is '%d'", pollingInterval)}
+	}
+	timeoutTemplate := releaseType.GetPublishApprovalTimeout()
+	if timeoutTemplate == nil {
+		timeoutTemplate = ent.RELEASE_TYPE_PUBLISH_APPROVAL_TIMEOUT
+	}
+	timeout, err := c.renderTemplateAsInteger(timeoutTemplate)
+	if err != nil {
+		return err
+	}
+
+	var approvalService svcapi.ApprovalService = nil
+	for _, serviceName := range serviceNames {
+		service, err := c.resolveReleaseService(*serviceName)
+		if err != nil {
+			return err
+		}
+		if service == nil {
+			continue
+		}
+		if supportingService, ok := (*service).(svcapi.Service); ok && supportingService.Supports(svcapi.RELEASE_APPROVALS) {
+			if castService, ok := (*service).(svcapi.ApprovalService); ok {
+				log.Debugf("the '%s' service is used to check for the publish approval", *serviceName)
+				approvalService = castService
+				break
+			}
+		}
+	}
+	if approvalService == nil {
+		return &errs.IllegalPropertyError{Message: fmt.Sprintf("the release type requires the approval for the '%s' environment but none of the publication services supports the %s feature", *environment, svcapi.RELEASE_APPROVALS)}
+	}
+
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		// The first two parameters here are nil because the repository owner and name are expected to be passed
+		// along with service options. This is just a place where we could override them.
+		status, err := approvalService.GetApprovalStatus(nil, nil, *environment)
+		if err != nil {
+			return err
+		}
+		switch status {
+		case svcapi.APPROVED:
+			log.Infof("the publish approval for the '%s' environment has been granted", *environment)
+			return nil
+		case svcapi.REJECTED:
+			return &errs.ReleaseError{Message: fmt.Sprintf("the publish approval for the '%s' environment has been rejected", *environment)}
+		}
+		if !time.Now().Add(time.Duration(pollingInterval) * time.Second).Before(deadline) {
+			return &errs.ReleaseError{Message: fmt.Sprintf("the publish approval for the '%s' environment has not been granted within %d seconds", *environment, timeout)}
+		}
+		log.Infof("waiting for the publish approval for the '%s' environment, checking again in %d seconds", *environment, pollingInterval)
+		time.Sleep(time.Duration(pollingInterval) * time.Second)
+	}
+}
+
 /*
 Publishes the release to remotes.
 
@@ -91,8 +201,17 @@ Error is:
 - IllegalPropertyError in case the configuration has some illegal options.
 - GitError in case of unexpected issues when accessing the Git repository.
 - ReleaseError if the task is unable to complete for reasons due to the release process.
+- PartialPublishError if the release has been published to some services but a later step failed, wrapping the failure.
 */
-func (c *Publish) publish() error {
+func (c *Publish) publish() (err error) {
+	// the services the release has already been published to, so that failures after the first publication are reported as partial
+	published := []string{}
+	defer func() {
+		if err != nil && len(published) > 0 {
+			err = &errs.PartialPublishError{Message: fmt.Sprintf("the release has only been partially published as it has been published to '%s' before the failure", strings.Join(published, "', '")), Cause: err}
+		}
+	}()
+
 	releaseTypes, err := c.State().GetConfiguration().GetReleaseTypes()
 	if err != nil {
 		return err
@@ -117,6 +236,14 @@ func (c *Publish) publish() error {
 		if err != nil {
 			return err
 		}
+		if *dryRun {
+			log.Debugf("the publish approval check is skipped due to dry run")
+		} else {
+			err = c.waitForApproval(releaseType, *releaseTypes.GetPublicationServices())
+			if err != nil {
+				return err
+			}
+		}
 		for _, serviceName := range *releaseTypes.GetPublicationServices() {
 			log.Debugf("publishing version '%s' to '%s'", *version, *serviceName)
 			if *dryRun {
@@ -148,16 +275,19 @@ func (c *Publish) publish() error {
 					return err
 				}
 				releaseOptions := &map[string]interface{}{
-					api.RELEASE_OPTION_DRAFT:       publishDraft,
-					api.RELEASE_OPTION_PRE_RELEASE: publishPreRelease,
+					svcapi.RELEASE_OPTION_DRAFT:       publishDraft,
+					svcapi.RELEASE_OPTION_PRE_RELEASE: publishPreRelease,
 				}
 
 				// The first two parameters here are nil because the repository owner and name are expected to be passed
 				// along with service options. This is just a place where we could override them.
+				done := tel.Start(tel.SERVICES_CATEGORY, "publishRelease")
 				release, err := (*service).PublishRelease(nil, nil, releaseName, *version, description, releaseOptions)
+				done(err)
 				if err != nil {
 					return err
 				}
+				published = append(published, *serviceName)
 				err = c.putInternalAttribute(PUBLISH_INTERNAL_OUPUT_ATTRIBUTE_STATE_VERSION, version)
 				if err != nil {
 					return err
@@ -168,8 +298,17 @@ func (c *Publish) publish() error {
 				if err != nil {
 					return err
 				}
+				// the directory where archives are created, only created when needed
+				archiveDirectory := ""
+				defer func() {
+					if "" != archiveDirectory {
+						os.RemoveAll(archiveDirectory)
+					}
+				}()
 				if releaseAssets == nil || len(*releaseAssets) == 0 {
 					log.Debugf("no release asset has been configured for publication")
+				} else if supportingService, ok := (*service).(svcapi.Service); ok && !supportingService.Supports(svcapi.RELEASE_ASSETS) {
+					log.Debugf("the '%s' service does not support release assets so no release asset will be published to it", *serviceName)
 				} else {
 					for configuredAssetKey, configuredAssetValue := range *releaseAssets {
 						// if the release type has configured the release types, that is considered a filter over the global release types
@@ -186,27 +325,14 @@ func (c *Publish) publish() error {
 						if assets == nil || includeAsset {
 							log.Debugf("publishing release asset '%s'", configuredAssetKey)
 
-							// we need to render each asset's field before we publish, so we create a new Attachment instance with all the fields rendered from the configured asset
-							assetFileName, err := c.renderTemplate(configuredAssetValue.GetFileName())
+							// we need to render each asset's field before we publish, so we create new Attachment instances with all the fields rendered from the configured asset
+							renderedAssets, err := c.renderReleaseAsset(configuredAssetValue, &archiveDirectory)
 							if err != nil {
 								return err
 							}
-							assetDescription, err := c.renderTemplate(configuredAssetValue.GetDescription())
-							if err != nil {
-								return err
-							}
-							assetPath, err := c.renderTemplate(configuredAssetValue.GetPath())
-							if err != nil {
-								return err
-							}
-							assetType, err := c.renderTemplate(configuredAssetValue.GetType())
-							if err != nil {
-								return err
-							}
-							asset := ent.NewAttachmentWith(assetFileName, assetDescription, assetPath, assetType)
 
-							// now actually publish the asset
-							release, err = (*service).PublishReleaseAssets(nil, nil, release, []ent.Attachment{*asset})
+							// now actually publish the assets
+							release, err = (*service).PublishReleaseAssets(nil, nil, release, renderedAssets)
 							if err != nil {
 								return err
 							}
@@ -214,7 +340,7 @@ func (c *Publish) publish() error {
 							if err != nil {
 								return err
 							}
-							resultAssetsObject := append(*resultAssets, *asset)
+							resultAssetsObject := append(*resultAssets, renderedAssets...)
 							resultAssets = &resultAssetsObject
 							err = c.State().SetReleaseAssets(resultAssets)
 							if err != nil {
@@ -234,6 +360,370 @@ func (c *Publish) publish() error {
 	return nil
 }
 
+/*
+Renders the given configured release asset and returns the attachments to publish for it. The returned attachments
+are more than one when the asset is configured for multiple platforms, in which case each attachment is rendered
+with the '@os' and '@arch' template variables set to the platform values.
+
+When the asset is configured to be archived, the directory (or file) in its path is archived into a new file
+within the given archive directory, which is created as a temporary directory if it's blank.
+
+Attachments whose path is a local file also have the digest of the file set, so that it's recorded in the state
+and services can verify the published content against it.
+
+Arguments are as follows:
+
+  - configuredAsset the configured release asset
+  - archiveDirectory the directory where archives are created. If blank, a new temporary directory is created and
+    its path is stored here so the caller can remove it when done
+
+Error is:
+- IllegalPropertyError in case the asset has illegal options or some of its templates can't be rendered.
+- IOError in case the asset archive can't be created or the asset file can't be read.
+*/
+func (c *Publish) renderReleaseAsset(configuredAsset *ent.Attachment, archiveDirectory *string) ([]ent.Attachment, error) {
+	platforms, err := c.renderTemplate(configuredAsset.GetPlatforms())
+	if err != nil {
+		return nil, err
+	}
+	// each item is the template data used to render the asset for a platform, or nil when no platform is configured
+	platformData := []map[string]interface{}{}
+	if platforms != nil {
+		for _, platform := range strings.Split(*platforms, ",") {
+			platform = strings.TrimSpace(platform)
+			if "" == platform {
+				continue
+			}
+			osName, archName, found := strings.Cut(platform, "/")
+			if !found || "" == strings.TrimSpace(osName) || "" == strings.TrimSpace(archName) {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the release asset platform '%s' is not in the '<os>/<arch>' form", platform)}
+			}
+			platformData = append(platformData, map[string]interface{}{"os": strings.TrimSpace(osName), "arch": strings.TrimSpace(archName)})
+		}
+	}
+	if len(platformData) == 0 {
+		platformData = append(platformData, nil)
+	}
+
+	res := []ent.Attachment{}
+	for _, data := range platformData {
+		assetFileName, err := c.renderTemplateWithData(configuredAsset.GetFileName(), data)
+		if err != nil {
+			return nil, err
+		}
+		assetDescription, err := c.renderTemplateWithData(configuredAsset.GetDescription(), data)
+		if err != nil {
+			return nil, err
+		}
+		assetPath, err := c.renderTemplateWithData(configuredAsset.GetPath(), data)
+		if err != nil {
+			return nil, err
+		}
+		assetType, err := c.renderTemplateWithData(configuredAsset.GetType(), data)
+		if err != nil {
+			return nil, err
+		}
+		assetArchive, err := c.renderTemplateWithData(configuredAsset.GetArchive(), data)
+		if err != nil {
+			return nil, err
+		}
+
+		if assetArchive != nil && "" != strings.TrimSpace(*assetArchive) {
+			if assetFileName == nil || "" == strings.TrimSpace(*assetFileName) {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("release assets must have a file name in order to be archived")}
+			}
+			if assetPath == nil || "" == strings.TrimSpace(*assetPath) {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the release asset '%s' must have a path in order to be archived", *assetFileName)}
+			}
+			if "" == *archiveDirectory {
+				*archiveDirectory, err = os.MkdirTemp("", "nyx-assets-")
+				if err != nil {
+					return nil, &errs.IOError{Message: fmt.Sprintf("unable to create the temporary directory for release asset archives"), Cause: err}
+				}
+			}
+			archivePath := filepath.Join(*archiveDirectory, filepath.Base(*assetFileName))
+			modTime, err := c.archiveModTime()
+			if err != nil {
+				return nil, err
+			}
+			log.Debugf("archiving release asset path '%s' to '%s'", *assetPath, archivePath)
+			err = io.Archive(*assetPath, archivePath, strings.TrimSpace(*assetArchive), modTime)
+			if err != nil {
+				return nil, err
+			}
+			assetPath = &archivePath
+			if assetType == nil || "" == strings.TrimSpace(*assetType) {
+				if io.ARCHIVE_FORMAT_ZIP == strings.TrimSpace(*assetArchive) {
+					assetType = utl.PointerToString("application/zip")
+				} else {
+					assetType = utl.PointerToString("application/gzip")
+				}
+			}
+		}
+		attachment := ent.NewAttachmentWith(assetFileName, assetDescription, assetPath, assetType)
+		if assetPath != nil {
+			if fileInfo, err := os.Stat(*assetPath); err == nil && fileInfo.Mode().IsRegular() {
+				digest, err := io.FileDigest(*assetPath)
+				if err != nil {
+					return nil, err
+				}
+				attachment.SetDigest(&digest)
+			}
+		}
+		res = append(res, *attachment)
+	}
+	return res, nil
+}
+
+/*
+Returns the modification time to set on release asset archive entries so that archives are reproducible.
+
+This is the time in the SOURCE_DATE_EPOCH environment variable (in seconds since the epoch), if defined, otherwise
+the date of the final commit in the release scope, if any, or January 1st, 1980 as a last resort.
+
+Error is:
+- IllegalPropertyError in case the SOURCE_DATE_EPOCH environment variable has an illegal value.
+- DataAccessError in case the release scope can't be read.
+*/
+func (c *Publish) archiveModTime() (time.Time, error) {
+	if sourceDateEpoch, found := os.LookupEnv(SOURCE_DATE_EPOCH_ENVVAR_NAME); found && "" != strings.TrimSpace(sourceDateEpoch) {
+		seconds, err := strconv.ParseInt(strings.TrimSpace(sourceDateEpoch), 10, 64)
+		if err != nil {
+			return time.Time{}, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' environment variable has an illegal value '%s'", SOURCE_DATE_EPOCH_ENVVAR_NAME, sourceDateEpoch), Cause: err}
+		}
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+	releaseScope, err := c.State().GetReleaseScope()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if releaseScope != nil && releaseScope.GetFinalCommit() != nil {
+		// commit dates are in milliseconds
+		return time.UnixMilli(releaseScope.GetFinalCommit().GetDate()).UTC(), nil
+	}
+	return time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC), nil
+}
+
+/*
+Opens a pull request in each downstream repository configured in the downstream updates section, replacing the text
+matched by the configured regular expression in the configured file, so that downstream repositories start depending on
+the version that has just been released. Downstream files that are already up to date are left untouched.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- ReleaseError if the task is unable to complete for reasons due to the release process.
+*/
+func (c *Publish) updateDownstream() error {
+	downstreamUpdates, err := c.State().GetConfiguration().GetDownstreamUpdates()
+	if err != nil {
+		return err
+	}
+	if downstreamUpdates == nil || downstreamUpdates.GetEnabled() == nil || len(*downstreamUpdates.GetEnabled()) == 0 {
+		log.Debugf("no downstream updates have been configured")
+		return nil
+	}
+	dryRun, err := c.State().GetConfiguration().GetDryRun()
+	if err != nil {
+		return err
+	}
+	version, err := c.State().GetVersion()
+	if err != nil {
+		return err
+	}
+
+	for _, downstreamUpdateName := range *downstreamUpdates.GetEnabled() {
+		downstreamUpdate, ok := (*downstreamUpdates.GetItems())[*downstreamUpdateName]
+		if !ok || downstreamUpdate == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("downstream update '%s' is configured among enabled ones but is not configured", *downstreamUpdateName)}
+		}
+		if downstreamUpdate.GetService() == nil || "" == strings.TrimSpace(*downstreamUpdate.GetService()) || downstreamUpdate.GetPath() == nil || "" == strings.TrimSpace(*downstreamUpdate.GetPath()) || downstreamUpdate.GetMatch() == nil || "" == strings.TrimSpace(*downstreamUpdate.GetMatch()) || downstreamUpdate.GetReplace() == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("downstream update '%s' must define the service, path, match and replace attributes", *downstreamUpdateName)}
+		}
+
+		service, err := c.resolveReleaseService(*downstreamUpdate.GetService())
+		if err != nil {
+			return err
+		}
+		if service == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the downstream update '%s' uses the '%s' service but no such service has been configured in the 'services' section", *downstreamUpdateName, *downstreamUpdate.GetService())}
+		}
+		supportingService, ok := (*service).(svcapi.Service)
+		if !ok || !supportingService.Supports(svcapi.PULL_REQUESTS) {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the downstream update '%s' uses the '%s' service which does not support the %s feature", *downstreamUpdateName, *downstreamUpdate.GetService(), svcapi.PULL_REQUESTS)}
+		}
+		pullRequestService, ok := (*service).(svcapi.PullRequestService)
+		if !ok {
+			return &errs.UnsupportedOperationError{Message: fmt.Sprintf("the '%s' service supports the %s feature but does not implement the %s interface", *downstreamUpdate.GetService(), svcapi.PULL_REQUESTS, "PullRequestService")}
+		}
+
+		owner, err := c.renderTemplate(downstreamUpdate.GetOwner())
+		if err != nil {
+			return err
+		}
+		repository, err := c.renderTemplate(downstreamUpdate.GetRepository())
+		if err != nil {
+			return err
+		}
+		branch, err := c.renderTemplate(downstreamUpdate.GetBranch())
+		if err != nil {
+			return err
+		}
+		path, err := c.renderTemplate(downstreamUpdate.GetPath())
+		if err != nil {
+			return err
+		}
+		replacement, err := c.renderTemplate(downstreamUpdate.GetReplace())
+		if err != nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("unable to render the replacement string '%s'", *downstreamUpdate.GetReplace()), Cause: err}
+		}
+		title, err := c.renderTemplate(downstreamUpdate.GetTitle())
+		if err != nil {
+			return err
+		}
+		if title == nil || len(*title) == 0 {
+			// if no title template was specified then fall-back to a title with the version
+			defaultTitle := fmt.Sprintf("Update to version %s", *version)
+			title = &defaultTitle
+		}
+		// an empty owner, repository or branch means the service options or the repository default branch are used
+		if owner != nil && len(*owner) == 0 {
+			owner = nil
+		}
+		if repository != nil && len(*repository) == 0 {
+			repository = nil
+		}
+		if branch != nil && len(*branch) == 0 {
+			branch = nil
+		}
+
+		content, err := pullRequestService.GetFileContent(owner, repository, branch, *path)
+		if err != nil {
+			return &errs.ReleaseError{Message: fmt.Sprintf("unable to read file '%s' for the downstream update '%s'", *path, *downstreamUpdateName), Cause: err}
+		}
+		re, err := regexp2.Compile(*downstreamUpdate.GetMatch(), 0)
+		if err != nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("unable to compile regular expression '%s'", *downstreamUpdate.GetMatch()), Cause: err}
+		}
+		updatedContent, err := re.Replace(content, *replacement, -1, -1)
+		if err != nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("unable to replace text matched by the regular expression '%s'", *downstreamUpdate.GetMatch()), Cause: err}
+		}
+		if updatedContent == content {
+			log.Debugf("file '%s' for the downstream update '%s' is already up to date", *path, *downstreamUpdateName)
+			continue
+		}
+
+		head := fmt.Sprintf(DOWNSTREAM_UPDATE_BRANCH_FORMAT_STRING, *downstreamUpdateName, *version)
+		if *dryRun {
+			log.Infof("the pull request for the downstream update '%s' is not opened due to dry run", *downstreamUpdateName)
+		} else {
+			body := fmt.Sprintf("Updates `%s` to version %s.", *path, *version)
+			url, err := pullRequestService.OpenFileUpdatePullRequest(owner, repository, branch, head, *path, updatedContent, *title, body)
+			if err != nil {
+				return &errs.ReleaseError{Message: fmt.Sprintf("unable to open the pull request for the downstream update '%s'", *downstreamUpdateName), Cause: err}
+			}
+			log.Infof("the pull request for the downstream update '%s' has been opened: %s", *downstreamUpdateName, url)
+		}
+	}
+	return nil
+}
+
+/*
+Marks the releases of the yanked versions as yanked on all the publication services supporting the RELEASE_YANKING
+feature. Services not supporting the feature are skipped, as well as releases that have already been marked as yanked.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- ReleaseError if the task is unable to complete for reasons due to the release process.
+*/
+func (c *Publish) yank() error {
+	yankedVersions, err := c.getYankedVersions()
+	if err != nil {
+		return err
+	}
+	if len(yankedVersions) == 0 {
+		log.Debugf("no versions have been yanked")
+		return nil
+	}
+	releaseTypes, err := c.State().GetConfiguration().GetReleaseTypes()
+	if err != nil {
+		return err
+	}
+	if releaseTypes == nil || releaseTypes.GetPublicationServices() == nil || len(*releaseTypes.GetPublicationServices()) == 0 {
+		log.Debugf("no publication services have been configured")
+		return nil
+	}
+	dryRun, err := c.State().GetConfiguration().GetDryRun()
+	if err != nil {
+		return err
+	}
+	releasePrefix, err := c.State().GetConfiguration().GetReleasePrefix()
+	if err != nil {
+		return err
+	}
+	versions := sortYankedVersions(yankedVersions)
+
+	for _, serviceName := range *releaseTypes.GetPublicationServices() {
+		if *dryRun {
+			log.Infof("marking yanked releases on '%s' skipped due to dry run", *serviceName)
+			continue
+		}
+		service, err := c.resolveReleaseService(*serviceName)
+		if err != nil {
+			return err
+		}
+		if service == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the release type uses the '%s' publication service but no such service has been configured in the 'services' section", *serviceName)}
+		}
+		supportingService, ok := (*service).(svcapi.Service)
+		if !ok || !supportingService.Supports(svcapi.RELEASE_YANKING) {
+			log.Debugf("the '%s' service does not support release yanking so yanked releases are not marked on it", *serviceName)
+			continue
+		}
+		yankService, ok := (*service).(svcapi.YankService)
+		if !ok {
+			return &errs.UnsupportedOperationError{Message: fmt.Sprintf("the '%s' service supports the %s feature but does not implement the %s interface", *serviceName, svcapi.RELEASE_YANKING, "YankService")}
+		}
+		for _, version := range versions {
+			tag := version
+			if releasePrefix != nil {
+				tag = *releasePrefix + version
+			}
+			// The first two parameters here are nil because the repository owner and name are expected to be passed
+			// along with service options. This is just a place where we could override them.
+			yanked, err := yankService.YankRelease(nil, nil, tag)
+			if err != nil {
+				return &errs.ReleaseError{Message: fmt.Sprintf("unable to mark release '%s' as yanked on '%s'", tag, *serviceName), Cause: err}
+			}
+			if yanked {
+				log.Infof("release '%s' has been marked as yanked on '%s'", tag, *serviceName)
+			}
+		}
+	}
+	return nil
+}
+
+/*
+Returns the yanked versions as a sorted, comma separated string, suitable to be stored as an internal attribute.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (c *Publish) getYankedVersionsAttribute() (*string, error) {
+	yankedVersions, err := c.getYankedVersions()
+	if err != nil {
+		return nil, err
+	}
+	res := strings.Join(sortYankedVersions(yankedVersions), ",")
+	return &res, nil
+}
+
 /*
 This method stores the state internal attributes used for up-to-date checks so that subsequent invocations
 of the IsUpToDate() method can find them and determine if the command is already up to date.
@@ -261,6 +751,14 @@ func (c *Publish) storeStatusInternalAttributes() error {
 		if err != nil {
 			return err
 		}
+		yanked, err := c.getYankedVersionsAttribute()
+		if err != nil {
+			return err
+		}
+		err = c.putInternalAttribute(PUBLISH_INTERNAL_INPUT_ATTRIBUTE_CONFIGURATION_YANKED, yanked)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -297,6 +795,20 @@ func (c *Publish) IsUpToDate() (bool, error) {
 		return false, nil
 	}
 
+	// Never up to date if the yanked versions have changed since the last run
+	yanked, err := c.getYankedVersionsAttribute()
+	if err != nil {
+		return false, err
+	}
+	isYankedUpToDate, err := c.isInternalAttributeUpToDate(PUBLISH_INTERNAL_INPUT_ATTRIBUTE_CONFIGURATION_YANKED, yanked)
+	if err != nil {
+		return false, err
+	}
+	if !isYankedUpToDate {
+		log.Debugf("the Publish command is not up to date because the yanked versions have changed since the last run")
+		return false, nil
+	}
+
 	return isVersionUpTodate, nil
 }
 
@@ -309,6 +821,7 @@ Error is:
 - IllegalPropertyError in case the configuration has some illegal options.
 - GitError in case of unexpected issues when accessing the Git repository.
 - ReleaseError if the task is unable to complete for reasons due to the release process.
+- PartialPublishError if the release has been published to some services but a later step failed.
 */
 func (c *Publish) Run() (*stt.State, error) {
 	newVersion, err := c.State().GetNewVersion()
@@ -321,13 +834,23 @@ func (c *Publish) Run() (*stt.State, error) {
 		if err != nil {
 			return nil, err
 		}
+		gateOpen, err := c.isReleaseGateOpen(releaseType)
+		if err != nil {
+			return nil, err
+		}
 		doCommit, err := c.renderTemplateAsBoolean(releaseType.GetPublish())
-		if doCommit {
+		if !gateOpen {
+			log.Debugf("the release type gate policy prevents publishing")
+		} else if doCommit {
 			log.Debugf("the release type has the publish flag enabled")
 			err = c.publish()
 			if err != nil {
 				return nil, err
 			}
+			err = c.updateDownstream()
+			if err != nil {
+				return nil, err
+			}
 		} else {
 			log.Debugf("the release type has the publish flag disabled")
 		}
@@ -335,6 +858,11 @@ func (c *Publish) Run() (*stt.State, error) {
 		log.Debugf("no version change detected. Nothing to publish.")
 	}
 
+	err = c.yank()
+	if err != nil {
+		return nil, err
+	}
+
 	err = c.storeStatusInternalAttributes()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,166 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+const (
+	// The prefix of the lines appended to the pull request title, one for each label, when a pull request
+	// is used in place of a commit message. Commit message conventions can match these lines to use labels
+	// in bump expressions.
+	PULL_REQUEST_MESSAGE_LABEL_PREFIX = "Label: "
+
+	// The value that, when used as the identifier of a bump label, makes the commits merged by pull requests
+	// having that label not bump any identifier, regardless of their messages.
+	BUMP_LABEL_NONE = "none"
+)
+
+/*
+Returns the service to fetch merged pull requests from, whose titles and labels are used in place of commit
+messages, as configured by the given release type, or nil if the release type uses plain commit messages.
+
+Arguments are as follows:
+
+- releaseType the release type to read the configuration from. It can't be nil
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - ReleaseError if the task is unable to complete for reasons due to the release process.
+  - UnsupportedOperationError if the service supports the PULL_REQUESTS feature but does not implement the
+    PullRequestService interface.
+*/
+func (ac *abstractCommand) resolvePullRequestMessagesService(releaseType *ent.ReleaseType) (svcapi.PullRequestService, error) {
+	serviceName, err := ac.renderTemplate(releaseType.GetPullRequestMessages())
+	if err != nil {
+		return nil, err
+	}
+	if serviceName == nil || "" == strings.TrimSpace(*serviceName) {
+		return nil, nil
+	}
+
+	service, err := ac.resolveReleaseService(*serviceName)
+	if err != nil {
+		return nil, err
+	}
+	if service == nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the release type uses the '%s' service to fetch pull requests but no such service has been configured in the 'services' section", *serviceName)}
+	}
+	supportingService, ok := (*service).(svcapi.Service)
+	if !ok || !supportingService.Supports(svcapi.PULL_REQUESTS) {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the release type uses the '%s' service to fetch pull requests but the service does not support the %s feature", *serviceName, svcapi.PULL_REQUESTS)}
+	}
+	pullRequestService, ok := (*service).(svcapi.PullRequestService)
+	if !ok {
+		return nil, &errs.UnsupportedOperationError{Message: fmt.Sprintf("the '%s' service supports the %s feature but does not implement the %s interface", *serviceName, svcapi.PULL_REQUESTS, "PullRequestService")}
+	}
+	log.Debugf("the release type uses the titles and labels of the pull requests merged through the '%s' service in place of commit messages", *serviceName)
+	return pullRequestService, nil
+}
+
+/*
+Returns the message to use in place of a commit message for the given pull request. The short message is the
+pull request title while the full message also brings one line for each label, prefixed by PULL_REQUEST_MESSAGE_LABEL_PREFIX.
+
+Arguments are as follows:
+
+- pullRequest the pull request to build the message from
+*/
+func newPullRequestMessage(pullRequest svcapi.PullRequest) gitent.Message {
+	title := strings.TrimSpace(pullRequest.GetTitle())
+	var fullMessage strings.Builder
+	fullMessage.WriteString(title)
+	if len(pullRequest.GetLabels()) > 0 {
+		fullMessage.WriteString("\n")
+		for _, label := range pullRequest.GetLabels() {
+			fullMessage.WriteString("\n")
+			fullMessage.WriteString(PULL_REQUEST_MESSAGE_LABEL_PREFIX)
+			fullMessage.WriteString(label)
+		}
+	}
+	return *gitent.NewMessageWith(fullMessage.String(), title, map[string]string{})
+}
+
+/*
+Returns the merged pull request that brought the given commit, as returned by the given service, or nil if
+the commit has not been merged by any pull request or the pull request can't be fetched.
+
+Arguments are as follows:
+
+- pullRequestService the service to fetch the pull request from. If nil this method returns nil
+- commit the commit to fetch the pull request for
+*/
+func (ac *abstractCommand) getMergedPullRequest(pullRequestService svcapi.PullRequestService, commit gitent.Commit) *svcapi.PullRequest {
+	if pullRequestService == nil {
+		return nil
+	}
+	// The first two parameters here are nil because the repository owner and name are expected to be passed
+	// along with service options.
+	pullRequest, err := pullRequestService.GetMergedPullRequest(nil, nil, commit.GetSHA())
+	if err != nil {
+		log.Warnf("cannot fetch the pull request that merged commit '%s', the commit message will be used instead: %v", commit.GetSHA(), err)
+		return nil
+	}
+	if pullRequest == nil {
+		log.Debugf("commit '%s' has not been merged by any pull request, the commit message will be used", commit.GetSHA())
+		return nil
+	}
+	log.Debugf("commit '%s' has been merged by pull request '%d' so its title '%s' is used in place of the commit message", commit.GetSHA(), (*pullRequest).GetNumber(), (*pullRequest).GetTitle())
+	return pullRequest
+}
+
+/*
+Returns the identifiers to bump for a commit merged by the given pull request according to the given bump labels,
+along with a flag telling if any of the pull request labels is among the bump labels. When the flag is true the
+returned identifiers override those inferred from the commit message conventions, so an empty list means the commit
+doesn't bump any identifier, which happens when all the matching labels map to BUMP_LABEL_NONE.
+
+Arguments are as follows:
+
+  - pullRequest the pull request to read the labels from. It may be nil, in which case no label matches
+  - bumpLabels the map of bump labels, where keys are label names and values are the identifiers to bump. It may be
+    nil or empty, in which case no label matches
+*/
+func getBumpLabelIdentifiers(pullRequest *svcapi.PullRequest, bumpLabels map[string]string) ([]string, bool) {
+	if pullRequest == nil || len(bumpLabels) == 0 {
+		return nil, false
+	}
+	identifiers := []string{}
+	matched := false
+	for _, label := range (*pullRequest).GetLabels() {
+		identifier, ok := bumpLabels[label]
+		if !ok {
+			continue
+		}
+		matched = true
+		identifier = strings.TrimSpace(identifier)
+		if BUMP_LABEL_NONE != identifier && "" != identifier {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	return identifiers, matched
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+/*
+The contents of the release metadata file, giving applications a build information source versioned along with
+the code.
+*/
+type releaseMetadata struct {
+	// The released version.
+	Version string `json:"version"`
+
+	// The release date, in the RFC 3339 format.
+	Date string `json:"date"`
+
+	// The release timestamp, in milliseconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+
+	// The SHA-1 of the latest commit before the release. This can't be the commit bringing the metadata file
+	// as a file can't store the identifier of the commit it belongs to.
+	Commit string `json:"commit"`
+
+	// The name of the released branch.
+	Branch string `json:"branch"`
+}
+
+/*
+Returns the path of the release metadata file configured for the given release type or nil if no release metadata
+file has been configured. Relative paths are resolved against the configured directory.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (ac *abstractCommand) getReleaseMetadataFile(releaseType *ent.ReleaseType) (*string, error) {
+	releaseMetadataFile, err := ac.renderTemplate(releaseType.GetReleaseMetadataFile())
+	if err != nil {
+		return nil, err
+	}
+	if releaseMetadataFile == nil || "" == strings.TrimSpace(*releaseMetadataFile) {
+		return nil, nil
+	}
+
+	path := strings.TrimSpace(*releaseMetadataFile)
+	// if the file path is relative make it relative to the configured directory
+	if !filepath.IsAbs(path) {
+		configurationDirectory, err := ac.State().GetConfiguration().GetDirectory()
+		if err != nil {
+			return nil, err
+		}
+		if configurationDirectory != nil {
+			path = filepath.Join(*configurationDirectory, path)
+		}
+	}
+	return &path, nil
+}
+
+/*
+Writes the release metadata file configured for the given release type, if any, so that it can be committed along
+with the other release changes.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason or the file can't be written.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (ac *abstractCommand) writeReleaseMetadataFile(releaseType *ent.ReleaseType) error {
+	path, err := ac.getReleaseMetadataFile(releaseType)
+	if err != nil {
+		return err
+	}
+	if path == nil {
+		log.Debugf("no release metadata file has been configured")
+		return nil
+	}
+
+	metadata := releaseMetadata{}
+	version, err := ac.State().GetVersion()
+	if err != nil {
+		return err
+	}
+	if version != nil {
+		metadata.Version = *version
+	}
+	timestamp, err := ac.State().GetTimestamp()
+	if err != nil {
+		return err
+	}
+	if timestamp != nil {
+		metadata.Timestamp = *timestamp
+		metadata.Date = time.UnixMilli(*timestamp).UTC().Format(time.RFC3339)
+	}
+	branch, err := ac.State().GetBranch()
+	if err != nil {
+		return err
+	}
+	if branch != nil {
+		metadata.Branch = *branch
+	}
+	metadata.Commit, err = (*ac.repository).GetLatestCommit()
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return &errs.DataAccessError{Message: "unable to marshal the release metadata", Cause: err}
+	}
+	log.Debugf("writing the release metadata file '%s'", *path)
+	err = os.WriteFile(*path, append(content, '\n'), 0644)
+	if err != nil {
+		return &errs.DataAccessError{Message: fmt.Sprintf("unable to write the release metadata file '%s'. Make sure the path to the file exists and can be written.", *path), Cause: err}
+	}
+	return nil
+}
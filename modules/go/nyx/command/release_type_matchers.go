@@ -0,0 +1,448 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"os"      // https://pkg.go.dev/os
+	"strings" // https://pkg.go.dev/strings
+	"time"    // https://pkg.go.dev/time
+
+	regexp2 "github.com/dlclark/regexp2" // https://pkg.go.dev/github.com/dlclark/regexp2, we need to use this instead of the standard 'regexp' to have support for lookarounds (look ahead), even if this implementation is a little slower
+	log "github.com/sirupsen/logrus"     // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	clk "github.com/mooltiverse/nyx/modules/go/nyx/clock"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+/*
+A predicate evaluating one of the matching criteria of a release type against the current repository and environment.
+
+The returned value is nil when the release type doesn't define the criterion, so that it's ignored when combining
+the results of all the criteria, otherwise it's true or false, depending on whether the criterion is satisfied.
+
+Arguments are as follows:
+
+  - releaseTypeName the name of the release type to evaluate
+  - releaseType the release type to evaluate
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - GitError in case of unexpected issues when accessing the Git repository.
+*/
+type releaseTypeMatcher func(releaseTypeName string, releaseType *ent.ReleaseType) (*bool, error)
+
+/*
+Returns the ordered list of predicates used to evaluate the matching criteria of release types.
+
+New criteria can be plugged in by adding them to the returned list. Predicates are evaluated in order so
+the cheapest ones should come first as the evaluation stops as soon as the overall result is known.
+*/
+func (ac *abstractCommand) releaseTypeMatchers() []releaseTypeMatcher {
+	return []releaseTypeMatcher{
+		ac.matchReleaseTypeBranches,
+		ac.matchReleaseTypeBranchMetadata,
+		ac.matchReleaseTypeEnvironmentVariables,
+		ac.matchReleaseTypeDaysOfWeek,
+		ac.matchReleaseTypeWorkspaceStatus,
+		ac.matchReleaseTypeTags,
+		ac.matchReleaseTypeChangedPaths,
+		ac.matchReleaseTypeExpression,
+		ac.matchReleaseTypePolicy,
+	}
+}
+
+/*
+Evaluates all the matching criteria of the given release type and combines their results according to the
+release type match mode. When the match mode is ALL (the default) all the criteria defined by the release type
+must be satisfied, while when it's ANY at least one of them must be satisfied. A release type not defining
+any criteria always matches.
+
+Arguments are as follows:
+
+  - releaseTypeName the name of the release type to evaluate
+  - releaseType the release type to evaluate
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (ac *abstractCommand) matchReleaseType(releaseTypeName string, releaseType *ent.ReleaseType) (bool, error) {
+	matchMode := ent.ALL
+	if releaseType.GetMatchMode() != nil {
+		matchMode = *releaseType.GetMatchMode()
+	}
+	log.Debugf("release type '%s' matching criteria are combined using the '%s' match mode", releaseTypeName, matchMode.String())
+
+	defined := false
+	for _, matcher := range ac.releaseTypeMatchers() {
+		match, err := matcher(releaseTypeName, releaseType)
+		if err != nil {
+			return false, err
+		}
+		if match == nil {
+			continue
+		}
+		defined = true
+		if matchMode == ent.ANY && *match {
+			return true, nil
+		}
+		if matchMode == ent.ALL && !*match {
+			return false, nil
+		}
+	}
+
+	if !defined {
+		log.Debugf("release type '%s' does not define any matching criteria so it matches anything", releaseTypeName)
+		return true, nil
+	}
+	// when the mode is ALL and we got here all criteria were satisfied, when it's ANY none was satisfied
+	return matchMode == ent.ALL, nil
+}
+
+/*
+Evaluates the branch name matching criteria for the given release type.
+*/
+func (ac *abstractCommand) matchReleaseTypeBranches(releaseTypeName string, releaseType *ent.ReleaseType) (*bool, error) {
+	if releaseType.GetMatchBranches() == nil || "" == strings.TrimSpace(*releaseType.GetMatchBranches()) {
+		log.Debugf("release type '%s' does not specify any branch name requirement", releaseTypeName)
+		return nil, nil
+	}
+	matchBranchesRendered, err := ac.renderTemplate(releaseType.GetMatchBranches())
+	if err != nil {
+		return nil, err
+	}
+	if "" == strings.TrimSpace(*matchBranchesRendered) {
+		log.Debugf("release type '%s' specifies a match branches template '%s' that evaluates to an empty regular expression", releaseTypeName, *releaseType.GetMatchBranches())
+		return nil, nil
+	}
+	log.Debugf("release type '%s' specifies a match branches template '%s' that evaluates to regular expression: '%s'", releaseTypeName, *releaseType.GetMatchBranches(), *matchBranchesRendered)
+	re, err := regexp2.Compile(*matchBranchesRendered, 0)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("release type '%s' has a malformed matchBranches regular expression: '%s' (was '%s' before rendering the template rendering)", releaseTypeName, *matchBranchesRendered, *releaseType.GetMatchBranches()), Cause: err}
+	}
+	currentBranch, err := ac.getCurrentBranch()
+	if err != nil {
+		return nil, err
+	}
+	match, _ := re.MatchString(currentBranch)
+	if match {
+		log.Debugf("current branch '%s' successfully matched by release type '%s' matchBranches regular expression '%s'", currentBranch, releaseTypeName, *matchBranchesRendered)
+	} else {
+		log.Debugf("current branch '%s' not matched by release type '%s' matchBranches regular expression '%s'", currentBranch, releaseTypeName, *matchBranchesRendered)
+	}
+	return &match, nil
+}
+
+/*
+Evaluates the branch metadata matching criteria for the given release type.
+*/
+func (ac *abstractCommand) matchReleaseTypeBranchMetadata(releaseTypeName string, releaseType *ent.ReleaseType) (*bool, error) {
+	if releaseType.GetMatchBranchMetadata() == nil || len(*releaseType.GetMatchBranchMetadata()) == 0 {
+		log.Debugf("release type '%s' does not specify any branch metadata requirement", releaseTypeName)
+		return nil, nil
+	}
+	branchMetadata, err := ac.state.GetBranchMetadata()
+	if err != nil {
+		return nil, err
+	}
+	match := true
+	for metadataName, metadataValueRegExp := range *releaseType.GetMatchBranchMetadata() {
+		log.Debugf("evaluating branch metadata '%s' as required by release type '%s'", metadataName, releaseTypeName)
+
+		metadataValue := ""
+		if branchMetadata != nil {
+			metadataValue = (*branchMetadata)[metadataName]
+		}
+
+		if "" == strings.TrimSpace(metadataValue) {
+			log.Debugf("branch metadata '%s' is required by release type '%s' but has not been extracted from the current branch", metadataName, releaseTypeName)
+			match = false
+			continue
+		}
+
+		if "" == strings.TrimSpace(metadataValueRegExp) {
+			log.Debugf("branch metadata '%s' value successfully matched by release type '%s' regular expression '%s'", metadataName, releaseTypeName, metadataValueRegExp)
+		} else {
+			re, err := regexp2.Compile(metadataValueRegExp, 0)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("release type '%s' has a malformed branch metadata regular expression '%s' to match for branch metadata '%s'", releaseTypeName, metadataValueRegExp, metadataName), Cause: err}
+			}
+			valueMatch, _ := re.MatchString(metadataValue)
+			if valueMatch {
+				log.Debugf("branch metadata '%s' value successfully matched by release type '%s' regular expression '%s'", metadataName, releaseTypeName, metadataValueRegExp)
+			} else {
+				log.Debugf("branch metadata '%s' value not matched by release type '%s' regular expression '%s'", metadataName, releaseTypeName, metadataValueRegExp)
+				match = false
+			}
+		}
+	}
+	if !match {
+		log.Debugf("branch metadata not matched by release type '%s'", releaseTypeName)
+	}
+	return &match, nil
+}
+
+/*
+Evaluates the environment variables matching criteria for the given release type.
+*/
+func (ac *abstractCommand) matchReleaseTypeEnvironmentVariables(releaseTypeName string, releaseType *ent.ReleaseType) (*bool, error) {
+	if releaseType.GetMatchEnvironmentVariables() == nil || len(*releaseType.GetMatchEnvironmentVariables()) == 0 {
+		log.Debugf("release type '%s'  does not specify any environment variable requirement", releaseTypeName)
+		return nil, nil
+	}
+	match := true
+	for varName, varVarueRegExp := range *releaseType.GetMatchEnvironmentVariables() {
+		log.Debugf("evaluating environment variable '%s' as required by release type '%s'", varName, releaseTypeName)
+
+		varValue := os.Getenv(varName)
+
+		if "" == strings.TrimSpace(varValue) {
+			log.Debugf("environment variable '%s' is required by release type '%s' but is not defined in the current environment", varName, releaseTypeName)
+			match = false
+			continue
+		}
+
+		if "" == strings.TrimSpace(varVarueRegExp) {
+			log.Debugf("environment variable '%s' value successfully matched by release type '%s' regular expression '%s'", varName, releaseTypeName, varVarueRegExp)
+		} else {
+			re, err := regexp2.Compile(varVarueRegExp, 0)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("release type '%s' has a malformed environment variable regular expression '%s' to match for environment variable '%s'", releaseTypeName, varVarueRegExp, varName), Cause: err}
+			}
+			valueMatch, _ := re.MatchString(varValue)
+			if valueMatch {
+				log.Debugf("environment variable '%s' value successfully matched by release type '%s' regular expression '%s'", varName, releaseTypeName, varVarueRegExp)
+			} else {
+				log.Debugf("environment variable '%s' value not matched by release type '%s' regular expression '%s'", varName, releaseTypeName, varVarueRegExp)
+				match = false
+			}
+		}
+	}
+	if !match {
+		log.Debugf("environment variables not matched by release type '%s'", releaseTypeName)
+	}
+	return &match, nil
+}
+
+/*
+Evaluates the days of week matching criteria for the given release type. The current day is taken from the
+state timestamp, in UTC.
+*/
+func (ac *abstractCommand) matchReleaseTypeDaysOfWeek(releaseTypeName string, releaseType *ent.ReleaseType) (*bool, error) {
+	if releaseType.GetMatchDaysOfWeek() == nil || "" == strings.TrimSpace(*releaseType.GetMatchDaysOfWeek()) {
+		log.Debugf("release type '%s' does not specify any day of week requirement", releaseTypeName)
+		return nil, nil
+	}
+	matchDaysOfWeekRendered, err := ac.renderTemplate(releaseType.GetMatchDaysOfWeek())
+	if err != nil {
+		return nil, err
+	}
+	if "" == strings.TrimSpace(*matchDaysOfWeekRendered) {
+		log.Debugf("release type '%s' specifies a match days of week template '%s' that evaluates to an empty list", releaseTypeName, *releaseType.GetMatchDaysOfWeek())
+		return nil, nil
+	}
+
+	now := clk.Now()
+	timestamp, err := ac.state.GetTimestamp()
+	if err != nil {
+		return nil, err
+	}
+	if timestamp != nil {
+		now = time.UnixMilli(*timestamp)
+	}
+	today := now.UTC().Weekday()
+
+	match := false
+	for _, dayOfWeek := range strings.Split(*matchDaysOfWeekRendered, ",") {
+		dayOfWeek = strings.TrimSpace(dayOfWeek)
+		if "" == dayOfWeek {
+			continue
+		}
+		legal := false
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.EqualFold(day.String(), dayOfWeek) {
+				legal = true
+				if day == today {
+					match = true
+				}
+			}
+		}
+		if !legal {
+			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("release type '%s' has an illegal day of week '%s' in matchDaysOfWeek: '%s'", releaseTypeName, dayOfWeek, *matchDaysOfWeekRendered)}
+		}
+	}
+	if match {
+		log.Debugf("current day of week '%s' successfully matched by release type '%s' matchDaysOfWeek '%s'", today.String(), releaseTypeName, *matchDaysOfWeekRendered)
+	} else {
+		log.Debugf("current day of week '%s' not matched by release type '%s' matchDaysOfWeek '%s'", today.String(), releaseTypeName, *matchDaysOfWeekRendered)
+	}
+	return &match, nil
+}
+
+/*
+Evaluates the workspace status matching criteria for the given release type.
+*/
+func (ac *abstractCommand) matchReleaseTypeWorkspaceStatus(releaseTypeName string, releaseType *ent.ReleaseType) (*bool, error) {
+	if releaseType.GetMatchWorkspaceStatus() == nil {
+		log.Debugf("release type '%s' does not specify any workspace status requirement", releaseTypeName)
+		return nil, nil
+	}
+	repoClean, err := ac.isRepositoryClean()
+	if err != nil {
+		return nil, err
+	}
+	repoCleanString := ""
+	if repoClean {
+		repoCleanString = "CLEAN"
+	} else {
+		repoCleanString = "DIRTY"
+	}
+
+	match := (*releaseType.GetMatchWorkspaceStatus() == ent.CLEAN && repoClean) || (*releaseType.GetMatchWorkspaceStatus() == ent.DIRTY && !repoClean)
+	if match {
+		log.Debugf("current repository status '%s' successfully matched by release type '%s' matchWorkspaceStatus filter '%s'", repoCleanString, releaseTypeName, (*releaseType.GetMatchWorkspaceStatus()).String())
+	} else {
+		log.Debugf("current repository status '%s' not matched by release type '%s' matchWorkspaceStatus filter '%s'", repoCleanString, releaseTypeName, (*releaseType.GetMatchWorkspaceStatus()).String())
+	}
+	return &match, nil
+}
+
+/*
+Evaluates the tags matching criteria for the given release type. The criteria is satisfied when at least one
+of the tags applied to the latest commit matches the configured regular expression.
+*/
+func (ac *abstractCommand) matchReleaseTypeTags(releaseTypeName string, releaseType *ent.ReleaseType) (*bool, error) {
+	if releaseType.GetMatchTags() == nil || "" == strings.TrimSpace(*releaseType.GetMatchTags()) {
+		log.Debugf("release type '%s' does not specify any tag requirement", releaseTypeName)
+		return nil, nil
+	}
+	matchTagsRendered, err := ac.renderTemplate(releaseType.GetMatchTags())
+	if err != nil {
+		return nil, err
+	}
+	if "" == strings.TrimSpace(*matchTagsRendered) {
+		log.Debugf("release type '%s' specifies a match tags template '%s' that evaluates to an empty regular expression", releaseTypeName, *releaseType.GetMatchTags())
+		return nil, nil
+	}
+	re, err := regexp2.Compile(*matchTagsRendered, 0)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("release type '%s' has a malformed matchTags regular expression: '%s' (was '%s' before rendering the template rendering)", releaseTypeName, *matchTagsRendered, *releaseType.GetMatchTags()), Cause: err}
+	}
+	latestCommit, err := ac.getLatestCommit()
+	if err != nil {
+		return nil, err
+	}
+	tags, err := (*ac.repository).GetCommitTags(latestCommit)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		if match, _ := re.MatchString(tag.GetName()); match {
+			log.Debugf("tag '%s' on the latest commit '%s' successfully matched by release type '%s' matchTags regular expression '%s'", tag.GetName(), latestCommit, releaseTypeName, *matchTagsRendered)
+			return &match, nil
+		}
+	}
+	log.Debugf("no tag on the latest commit '%s' matched by release type '%s' matchTags regular expression '%s'", latestCommit, releaseTypeName, *matchTagsRendered)
+	match := false
+	return &match, nil
+}
+
+/*
+Evaluates the changed paths matching criteria for the given release type. The criteria is satisfied when at least
+one of the paths changed by the latest commit matches the configured regular expression.
+*/
+func (ac *abstractCommand) matchReleaseTypeChangedPaths(releaseTypeName string, releaseType *ent.ReleaseType) (*bool, error) {
+	if releaseType.GetMatchChangedPaths() == nil || "" == strings.TrimSpace(*releaseType.GetMatchChangedPaths()) {
+		log.Debugf("release type '%s' does not specify any changed path requirement", releaseTypeName)
+		return nil, nil
+	}
+	matchChangedPathsRendered, err := ac.renderTemplate(releaseType.GetMatchChangedPaths())
+	if err != nil {
+		return nil, err
+	}
+	if "" == strings.TrimSpace(*matchChangedPathsRendered) {
+		log.Debugf("release type '%s' specifies a match changed paths template '%s' that evaluates to an empty regular expression", releaseTypeName, *releaseType.GetMatchChangedPaths())
+		return nil, nil
+	}
+	re, err := regexp2.Compile(*matchChangedPathsRendered, 0)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("release type '%s' has a malformed matchChangedPaths regular expression: '%s' (was '%s' before rendering the template rendering)", releaseTypeName, *matchChangedPathsRendered, *releaseType.GetMatchChangedPaths()), Cause: err}
+	}
+	latestCommit, err := ac.getLatestCommit()
+	if err != nil {
+		return nil, err
+	}
+	paths, err := (*ac.repository).GetCommitChangedPaths(latestCommit)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		if match, _ := re.MatchString(path); match {
+			log.Debugf("path '%s' changed by the latest commit '%s' successfully matched by release type '%s' matchChangedPaths regular expression '%s'", path, latestCommit, releaseTypeName, *matchChangedPathsRendered)
+			return &match, nil
+		}
+	}
+	log.Debugf("no path changed by the latest commit '%s' matched by release type '%s' matchChangedPaths regular expression '%s'", latestCommit, releaseTypeName, *matchChangedPathsRendered)
+	match := false
+	return &match, nil
+}
+
+/*
+Evaluates the template expression matching criteria for the given release type. The criteria is satisfied when
+the template evaluates to true, according to templates.ToBoolean.
+*/
+func (ac *abstractCommand) matchReleaseTypeExpression(releaseTypeName string, releaseType *ent.ReleaseType) (*bool, error) {
+	if releaseType.GetMatchExpression() == nil || "" == strings.TrimSpace(*releaseType.GetMatchExpression()) {
+		log.Debugf("release type '%s' does not specify any expression requirement", releaseTypeName)
+		return nil, nil
+	}
+	match, err := ac.renderTemplateAsBoolean(releaseType.GetMatchExpression())
+	if err != nil {
+		return nil, err
+	}
+	if match {
+		log.Debugf("release type '%s' matchExpression '%s' evaluates to true", releaseTypeName, *releaseType.GetMatchExpression())
+	} else {
+		log.Debugf("release type '%s' matchExpression '%s' evaluates to false", releaseTypeName, *releaseType.GetMatchExpression())
+	}
+	return &match, nil
+}
+
+/*
+Evaluates the policy matching criteria for the given release type. The criteria is satisfied when
+the CEL expression evaluates to true against the current state.
+*/
+func (ac *abstractCommand) matchReleaseTypePolicy(releaseTypeName string, releaseType *ent.ReleaseType) (*bool, error) {
+	if releaseType.GetMatchPolicy() == nil || "" == strings.TrimSpace(*releaseType.GetMatchPolicy()) {
+		log.Debugf("release type '%s' does not specify any policy requirement", releaseTypeName)
+		return nil, nil
+	}
+	match, err := ac.evaluatePolicy(releaseType.GetMatchPolicy())
+	if err != nil {
+		return nil, err
+	}
+	if match {
+		log.Debugf("release type '%s' matchPolicy '%s' evaluates to true", releaseTypeName, *releaseType.GetMatchPolicy())
+	} else {
+		log.Debugf("release type '%s' matchPolicy '%s' evaluates to false", releaseTypeName, *releaseType.GetMatchPolicy())
+	}
+	return &match, nil
+}
@@ -0,0 +1,411 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
+	github "github.com/mooltiverse/nyx/modules/go/nyx/services/github"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+The credentials configured for a Git remote, with all templates already rendered.
+*/
+type remoteCredentials struct {
+	// The authentication method. When nil the user name and password are used, if any.
+	authenticationMethod *ent.AuthenticationMethod
+
+	// The user name, also used along with tokens.
+	user *string
+
+	// The password, also used to hold tokens.
+	password *string
+
+	// The SSH private key or GitHub App private key.
+	privateKey *string
+
+	// The passphrase of the SSH private key.
+	passphrase *string
+
+	// The known SSH host keys.
+	knownHosts *string
+
+	// When false the keys of SSH hosts are not verified.
+	strictHostKeyChecking bool
+
+	// The GitHub App ID.
+	appID *string
+
+	// The GitHub App installation ID.
+	installationID *string
+}
+
+/*
+Returns the names of the remote repositories to use, as configured by the release types, or the default remote
+(origin) when none is configured.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (ac *abstractCommand) getRemoteRepositories() ([]*string, error) {
+	releaseTypes, err := ac.State().GetConfiguration().GetReleaseTypes()
+	if err != nil {
+		return nil, err
+	}
+	remotes := releaseTypes.GetRemoteRepositories()
+	if remotes == nil || len(*remotes) == 0 {
+		log.Debugf("the list of remotes is not defined. Using the default remote '%s'", git.DEFAULT_REMOTE_NAME)
+		return []*string{utl.PointerToString(git.DEFAULT_REMOTE_NAME)}, nil
+	}
+	return *remotes, nil
+}
+
+/*
+Returns the credentials for the given remote by going through all the configured remotes and finding the one
+matching the given name. When no configuration is available for the remote the returned credentials are empty.
+
+Arguments are as follows:
+
+- remote the name of the remote to get the credentials for
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (ac *abstractCommand) getRemoteCredentials(remote string) (remoteCredentials, error) {
+	log.Debugf("looking up credentials for remote '%s'", remote)
+	res := remoteCredentials{strictHostKeyChecking: *ent.GIT_REMOTE_STRICT_HOST_KEY_CHECKING}
+	gitConfiguration, err := ac.State().GetConfiguration().GetGit()
+	if err != nil {
+		return res, err
+	}
+	if gitConfiguration == nil || gitConfiguration.GetRemotes() == nil {
+		log.Debugf("no Git remote repository has been configured")
+		return res, nil
+	}
+	gitRemoteConfiguration, ok := (*gitConfiguration.GetRemotes())[remote]
+	if !ok {
+		log.Debugf("no configuration available for remote '%s'", remote)
+		return res, nil
+	}
+	log.Debugf("using configured credentials for remote '%s'", remote)
+	res.authenticationMethod = gitRemoteConfiguration.GetAuthenticationMethod()
+	if res.user, err = ac.renderTemplate(gitRemoteConfiguration.GetUser()); err != nil {
+		return res, err
+	}
+	if res.user == nil {
+		res.user = valueOfEnvironmentVariable(gitRemoteConfiguration.GetUserVariable())
+	}
+	if res.password, err = ac.renderTemplate(gitRemoteConfiguration.GetPassword()); err != nil {
+		return res, err
+	}
+	if res.password == nil {
+		res.password = valueOfEnvironmentVariable(gitRemoteConfiguration.GetPasswordVariable())
+	}
+	if res.privateKey, err = ac.renderTemplate(gitRemoteConfiguration.GetPrivateKey()); err != nil {
+		return res, err
+	}
+	if res.passphrase, err = ac.renderTemplate(gitRemoteConfiguration.GetPassphrase()); err != nil {
+		return res, err
+	}
+	if res.knownHosts, err = ac.renderTemplate(gitRemoteConfiguration.GetKnownHosts()); err != nil {
+		return res, err
+	}
+	if gitRemoteConfiguration.GetStrictHostKeyChecking() != nil {
+		res.strictHostKeyChecking = *gitRemoteConfiguration.GetStrictHostKeyChecking()
+	}
+	if res.appID, err = ac.renderTemplate(gitRemoteConfiguration.GetAppID()); err != nil {
+		return res, err
+	}
+	if res.installationID, err = ac.renderTemplate(gitRemoteConfiguration.GetInstallationID()); err != nil {
+		return res, err
+	}
+	// user names and passwords can't be used with SSH remotes so public keys are assumed when no method is set
+	if res.authenticationMethod == nil && res.user == nil && res.password == nil && ac.Repository() != nil {
+		uri, err := (*ac.Repository()).GetRemoteURL(&remote)
+		if err == nil && git.GitInstance().IsSSHURI(uri) {
+			log.Debugf("remote '%s' uses the SSH protocol and has no authentication method configured so '%s' is assumed", remote, ent.PUBLIC_KEY.String())
+			publicKey := ent.PUBLIC_KEY
+			res.authenticationMethod = &publicKey
+		}
+	}
+	return res, nil
+}
+
+/*
+Returns the tags to push to the given remote as configured by its 'pushTags' option, with the template already
+rendered. When no configuration is available for the remote the default (ALL) is returned.
+
+Arguments are as follows:
+
+- remote the name of the remote to get the option for
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (ac *abstractCommand) getRemotePushTags(remote string) (string, error) {
+	gitConfiguration, err := ac.State().GetConfiguration().GetGit()
+	if err != nil {
+		return "", err
+	}
+	if gitConfiguration == nil || gitConfiguration.GetRemotes() == nil {
+		return *ent.GIT_REMOTE_PUSH_TAGS, nil
+	}
+	gitRemoteConfiguration, ok := (*gitConfiguration.GetRemotes())[remote]
+	if !ok {
+		return *ent.GIT_REMOTE_PUSH_TAGS, nil
+	}
+	pushTags, err := ac.renderTemplate(gitRemoteConfiguration.GetPushTags())
+	if err != nil {
+		return "", err
+	}
+	if pushTags == nil || strings.TrimSpace(*pushTags) == "" {
+		return *ent.GIT_REMOTE_PUSH_TAGS, nil
+	}
+	return strings.TrimSpace(*pushTags), nil
+}
+
+/*
+Fetches all the tags from the configured remote repositories, using the credentials configured for each of them,
+so that the version is inferred from the complete set of tags even when the local clone lacks some.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (ac *abstractCommand) fetchTags() error {
+	remotes, err := ac.getRemoteRepositories()
+	if err != nil {
+		return err
+	}
+	for _, remote := range remotes {
+		log.Debugf("fetching tags from remote '%s'", *remote)
+		credentials, err := ac.getRemoteCredentials(*remote)
+		if err != nil {
+			return err
+		}
+		if credentials.authenticationMethod != nil && ent.PUBLIC_KEY == *credentials.authenticationMethod {
+			log.Debugf("attempting to fetch tags from '%s' using public key credentials.", *remote)
+			_, err = (*ac.Repository()).FetchTagsFromRemoteWithPublicKeyAndHostKeys(remote, credentials.privateKey, credentials.passphrase, credentials.knownHosts, credentials.strictHostKeyChecking)
+		} else if credentials.authenticationMethod != nil && ent.GITHUB_APP == *credentials.authenticationMethod {
+			log.Debugf("attempting to fetch tags from '%s' using GitHub App installation token credentials.", *remote)
+			if credentials.appID == nil || credentials.privateKey == nil {
+				return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the App ID or the private key are not configured", *remote, ent.GITHUB_APP.String())}
+			}
+			token, tokenErr := github.GetInstallationToken(nil, *credentials.appID, *credentials.privateKey, credentials.installationID)
+			if tokenErr != nil {
+				return tokenErr
+			}
+			_, err = (*ac.Repository()).FetchTagsFromRemoteWithUserNameAndPassword(remote, utl.PointerToString(github.INSTALLATION_TOKEN_USER), &token)
+		} else if credentials.authenticationMethod != nil && ent.TOKEN == *credentials.authenticationMethod {
+			log.Debugf("attempting to fetch tags from '%s' using token credentials.", *remote)
+			if credentials.password == nil {
+				return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the token is not configured as the password", *remote, ent.TOKEN.String())}
+			}
+			_, err = (*ac.Repository()).FetchTagsFromRemoteWithToken(remote, credentials.password, credentials.user)
+		} else {
+			if credentials.user == nil && credentials.password == nil {
+				log.Debugf("no credentials were configured for remote '%s'. Attempting to fetch tags with netrc credentials, if any, or anonymously.", *remote)
+			} else {
+				log.Debugf("attempting to fetch tags from '%s' using user name and password credentials.", *remote)
+			}
+			_, err = (*ac.Repository()).FetchTagsFromRemoteWithUserNameAndPassword(remote, credentials.user, credentials.password)
+		}
+		if err != nil {
+			return err
+		}
+		log.Debugf("tags fetched from remote '%s'", *remote)
+	}
+	return nil
+}
+
+/*
+Fetches the missing history of a shallow repository from the first of the configured remote repositories, using the
+credentials configured for it, so that the commit history can be walked beyond the shallow boundary.
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (ac *abstractCommand) unshallow() error {
+	remotes, err := ac.getRemoteRepositories()
+	if err != nil {
+		return err
+	}
+	remote := remotes[0]
+	log.Debugf("fetching the missing history from remote '%s'", *remote)
+	credentials, err := ac.getRemoteCredentials(*remote)
+	if err != nil {
+		return err
+	}
+	if credentials.authenticationMethod != nil && ent.PUBLIC_KEY == *credentials.authenticationMethod {
+		log.Debugf("attempting to fetch the missing history from '%s' using public key credentials.", *remote)
+		_, err = (*ac.Repository()).UnshallowFromRemoteWithPublicKeyAndHostKeys(remote, credentials.privateKey, credentials.passphrase, credentials.knownHosts, credentials.strictHostKeyChecking)
+	} else if credentials.authenticationMethod != nil && ent.GITHUB_APP == *credentials.authenticationMethod {
+		log.Debugf("attempting to fetch the missing history from '%s' using GitHub App installation token credentials.", *remote)
+		if credentials.appID == nil || credentials.privateKey == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the App ID or the private key are not configured", *remote, ent.GITHUB_APP.String())}
+		}
+		token, tokenErr := github.GetInstallationToken(nil, *credentials.appID, *credentials.privateKey, credentials.installationID)
+		if tokenErr != nil {
+			return tokenErr
+		}
+		_, err = (*ac.Repository()).UnshallowFromRemoteWithUserNameAndPassword(remote, utl.PointerToString(github.INSTALLATION_TOKEN_USER), &token)
+	} else if credentials.authenticationMethod != nil && ent.TOKEN == *credentials.authenticationMethod {
+		log.Debugf("attempting to fetch the missing history from '%s' using token credentials.", *remote)
+		if credentials.password == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the token is not configured as the password", *remote, ent.TOKEN.String())}
+		}
+		_, err = (*ac.Repository()).UnshallowFromRemoteWithToken(remote, credentials.password, credentials.user)
+	} else {
+		if credentials.user == nil && credentials.password == nil {
+			log.Debugf("no credentials were configured for remote '%s'. Attempting to fetch the missing history with netrc credentials, if any, or anonymously.", *remote)
+		} else {
+			log.Debugf("attempting to fetch the missing history from '%s' using user name and password credentials.", *remote)
+		}
+		_, err = (*ac.Repository()).UnshallowFromRemoteWithUserNameAndPassword(remote, credentials.user, credentials.password)
+	}
+	if err != nil {
+		return err
+	}
+	log.Debugf("missing history fetched from remote '%s'", *remote)
+	return nil
+}
+
+/*
+Deletes the given tags from the given remote repository, using the credentials configured for it. Tags that don't
+exist on the remote are ignored.
+
+Arguments are as follows:
+
+- remote the name of the remote to delete the tags from
+- tags the names of the tags to delete
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (ac *abstractCommand) deleteRemoteTags(remote *string, tags []string) error {
+	credentials, err := ac.getRemoteCredentials(*remote)
+	if err != nil {
+		return err
+	}
+	// GitHub App installation tokens are minted once and used for all the tags
+	var installationToken *string
+	if credentials.authenticationMethod != nil && ent.GITHUB_APP == *credentials.authenticationMethod {
+		if credentials.appID == nil || credentials.privateKey == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the App ID or the private key are not configured", *remote, ent.GITHUB_APP.String())}
+		}
+		token, err := github.GetInstallationToken(nil, *credentials.appID, *credentials.privateKey, credentials.installationID)
+		if err != nil {
+			return err
+		}
+		installationToken = &token
+	} else if credentials.authenticationMethod != nil && ent.TOKEN == *credentials.authenticationMethod && credentials.password == nil {
+		return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the token is not configured as the password", *remote, ent.TOKEN.String())}
+	}
+	for _, tag := range tags {
+		log.Debugf("deleting tag '%s' from remote '%s'", tag, *remote)
+		if credentials.authenticationMethod != nil && ent.PUBLIC_KEY == *credentials.authenticationMethod {
+			_, err = (*ac.Repository()).DeleteRemoteTagWithPublicKeyAndHostKeys(remote, tag, credentials.privateKey, credentials.passphrase, credentials.knownHosts, credentials.strictHostKeyChecking)
+		} else if installationToken != nil {
+			_, err = (*ac.Repository()).DeleteRemoteTagWithUserNameAndPassword(remote, tag, utl.PointerToString(github.INSTALLATION_TOKEN_USER), installationToken)
+		} else if credentials.authenticationMethod != nil && ent.TOKEN == *credentials.authenticationMethod {
+			_, err = (*ac.Repository()).DeleteRemoteTagWithToken(remote, tag, credentials.password, credentials.user)
+		} else {
+			_, err = (*ac.Repository()).DeleteRemoteTagWithUserNameAndPassword(remote, tag, credentials.user, credentials.password)
+		}
+		if err != nil {
+			return err
+		}
+		log.Debugf("tag '%s' deleted from remote '%s'", tag, *remote)
+	}
+	return nil
+}
+
+/*
+Pushes the given tags to the given remote repository, using the credentials configured for it. When force is true
+tags are updated on the remote even if they already exist there pointing to other objects, which is how floating tags
+are moved across releases.
+
+Arguments are as follows:
+
+- remote the name of the remote to push the tags to
+- tags the names of the tags to push
+- force set it to true if you want the tags to be pushed using the force option
+
+Error is:
+
+- DataAccessError in case the configuration can't be loaded for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func (ac *abstractCommand) pushRemoteTags(remote *string, tags []string, force bool) error {
+	credentials, err := ac.getRemoteCredentials(*remote)
+	if err != nil {
+		return err
+	}
+	// GitHub App installation tokens are minted once and used for all the tags
+	var installationToken *string
+	if credentials.authenticationMethod != nil && ent.GITHUB_APP == *credentials.authenticationMethod {
+		if credentials.appID == nil || credentials.privateKey == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the App ID or the private key are not configured", *remote, ent.GITHUB_APP.String())}
+		}
+		token, err := github.GetInstallationToken(nil, *credentials.appID, *credentials.privateKey, credentials.installationID)
+		if err != nil {
+			return err
+		}
+		installationToken = &token
+	} else if credentials.authenticationMethod != nil && ent.TOKEN == *credentials.authenticationMethod && credentials.password == nil {
+		return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the token is not configured as the password", *remote, ent.TOKEN.String())}
+	}
+	for _, tag := range tags {
+		log.Debugf("pushing tag '%s' to remote '%s' (force: %t)", tag, *remote, force)
+		if credentials.authenticationMethod != nil && ent.PUBLIC_KEY == *credentials.authenticationMethod {
+			_, err = (*ac.Repository()).PushTagToRemoteWithPublicKeyAndHostKeysAndForce(remote, tag, credentials.privateKey, credentials.passphrase, credentials.knownHosts, credentials.strictHostKeyChecking, force)
+		} else if installationToken != nil {
+			_, err = (*ac.Repository()).PushTagToRemoteWithUserNameAndPasswordAndForce(remote, tag, utl.PointerToString(github.INSTALLATION_TOKEN_USER), installationToken, force)
+		} else if credentials.authenticationMethod != nil && ent.TOKEN == *credentials.authenticationMethod {
+			_, err = (*ac.Repository()).PushTagToRemoteWithTokenAndForce(remote, tag, credentials.password, credentials.user, force)
+		} else {
+			_, err = (*ac.Repository()).PushTagToRemoteWithUserNameAndPasswordAndForce(remote, tag, credentials.user, credentials.password, force)
+		}
+		if err != nil {
+			return err
+		}
+		log.Debugf("tag '%s' pushed to remote '%s'", tag, *remote)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"os"      // https://pkg.go.dev/os
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+/*
+Checks that all the environment variables required by the given release type are set, so that a missing secret
+is reported before any release step runs instead of making the process fail halfway. Variables set to the empty
+string are considered missing, as CI systems often expose undefined secrets this way.
+All the missing variables are reported at once. When running in dry run mode they're just logged as a warning.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - ReleaseError in case any of the required environment variables is not set.
+*/
+func (ac *abstractCommand) checkRequiredEnvironmentVariables(releaseType *ent.ReleaseType) error {
+	if releaseType == nil || releaseType.GetRequiredEnvironmentVariables() == nil {
+		return nil
+	}
+	var missing []string
+	for _, name := range *releaseType.GetRequiredEnvironmentVariables() {
+		if name == nil || "" == strings.TrimSpace(*name) {
+			continue
+		}
+		if value, ok := os.LookupEnv(*name); ok && value != "" {
+			log.Debugf("the required environment variable '%s' is set", *name)
+		} else {
+			missing = append(missing, *name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	message := fmt.Sprintf("the release type requires %d environment variables that are not set: %s", len(missing), strings.Join(missing, ", "))
+	dryRun, err := ac.State().GetConfiguration().GetDryRun()
+	if err != nil {
+		return err
+	}
+	if dryRun != nil && *dryRun {
+		log.Warnf("%s (ignored in dry run mode)", message)
+		return nil
+	}
+	return &errs.ReleaseError{Message: message}
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+Verifies the signatures of all the commits in the release scope against the trusted keys configured in the Git
+configuration, storing the outcome in each commit, so that it's also available in the state.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - GitError in case of unexpected issues when accessing the Git repository or the trusted keys can't be read.
+  - ReleaseError in case any of the commits in the release scope doesn't have a valid signature.
+*/
+func (ac *abstractCommand) verifyReleaseScopeSignatures() error {
+	gitConfiguration, err := ac.State().GetConfiguration().GetGit()
+	if err != nil {
+		return err
+	}
+	var trustedKeys *string
+	if gitConfiguration != nil && gitConfiguration.GetTrustedKeys() != nil {
+		trustedKeys, err = ac.renderTemplate(gitConfiguration.GetTrustedKeys())
+		if err != nil {
+			return err
+		}
+	}
+	if trustedKeys == nil || "" == strings.TrimSpace(*trustedKeys) {
+		log.Warnf("the release type requires signed commits but no trusted keys are configured. Use the 'git.trustedKeys' option to set the keys to verify signatures with")
+	}
+
+	releaseScope, err := ac.State().GetReleaseScope()
+	if err != nil {
+		return err
+	}
+	var unsigned []string
+	for _, commit := range releaseScope.GetCommits() {
+		signature, err := (*ac.repository).VerifyCommitSignature(commit.GetSHA(), trustedKeys)
+		if err != nil {
+			return err
+		}
+		commit.SetSignature(&signature)
+		if signature.IsValid() {
+			log.Debugf("commit '%s' has a valid signature by '%s'", commit.GetSHA(), signature.GetSigner())
+		} else {
+			log.Debugf("commit '%s' doesn't have a valid signature (%s)", commit.GetSHA(), signature.String())
+			unsigned = append(unsigned, commit.GetSHA())
+		}
+	}
+	if len(unsigned) > 0 {
+		return &errs.ReleaseError{Message: fmt.Sprintf("the release type requires all commits in the release scope to be signed but %d commits don't have a valid signature: %s", len(unsigned), strings.Join(unsigned, ", "))}
+	}
+	log.Debugf("all the %d commits in the release scope have a valid signature", len(releaseScope.GetCommits()))
+	return nil
+}
@@ -4,20 +4,69 @@
 {{#releases}}
 ## {{name}} ({{date}})
 
+{{#if upgradeNotes}}
+### Upgrade notes
+
+{{{upgradeNotes}}}
+
+{{/if}}
 {{#sections}}
-### {{name}}
+### {{#if emoji}}{{emoji}} {{/if}}{{name}}{{#if badge}} {{{badge}}}{{/if}}
+
+{{#if collapsed}}
+<details>
+<summary>Show all changes</summary>
 
+{{/if}}
 {{#commits}}
 * [{{#short5}}{{sha}}{{/short5}}] {{message.shortMessage}} ({{authorAction.identity.name}})
 
 {{/commits}}
+{{#if collapsed}}
+</details>
+
+{{/if}}
 {{^commits}}
 No changes.
 {{/commits}}
 {{/sections}}
 {{^sections}}
+{{^preReleases}}
 No changes.
+{{/preReleases}}
 {{/sections}}
+{{#preReleases}}
+### {{name}} ({{date}})
+
+{{#sections}}
+#### {{#if emoji}}{{emoji}} {{/if}}{{name}}{{#if badge}} {{{badge}}}{{/if}}
+
+{{#if collapsed}}
+<details>
+<summary>Show all changes</summary>
+
+{{/if}}
+{{#commits}}
+* [{{#short5}}{{sha}}{{/short5}}] {{message.shortMessage}} ({{authorAction.identity.name}})
+
+{{/commits}}
+{{#if collapsed}}
+</details>
+
+{{/if}}
+{{/sections}}
+{{^sections}}
+No changes.
+{{/sections}}
+{{/preReleases}}
+{{#impactReports}}
+### {{title}}
+
+```
+{{{output}}}
+```
+
+{{/impactReports}}
 {{/releases}}
 {{^releases}}
 No releases.
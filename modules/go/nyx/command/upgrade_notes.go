@@ -0,0 +1,83 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+const (
+	// The delimiter of the front matter block files may start with, like pages of documentation sites do.
+	FRONT_MATTER_DELIMITER = "---"
+)
+
+/*
+Returns the upgrade notes of the release, read at the released commit from the file whose path is rendered from the
+'upgradeNotes' changelog option. The front matter the file may start with is stripped. When the option is empty,
+the file doesn't exist or has no content nil is returned, as upgrade notes are optional.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (c *Make) getUpgradeNotes(changelogConfiguration *ent.ChangelogConfiguration) (*string, error) {
+	if changelogConfiguration.GetUpgradeNotes() == nil || "" == strings.TrimSpace(*changelogConfiguration.GetUpgradeNotes()) {
+		log.Debugf("upgrade notes have not been configured")
+		return nil, nil
+	}
+	path, err := c.renderTemplate(changelogConfiguration.GetUpgradeNotes())
+	if err != nil {
+		return nil, err
+	}
+	if path == nil || "" == strings.TrimSpace(*path) {
+		log.Debugf("the path to the upgrade notes has been rendered to an empty value")
+		return nil, nil
+	}
+	content, err := c.readReleasedFile(strings.TrimSpace(*path))
+	if err != nil {
+		log.Debugf("no upgrade notes have been read from '%s': %v", strings.TrimSpace(*path), err)
+		return nil, nil
+	}
+	content = strings.TrimSpace(stripFrontMatter(content))
+	if "" == content {
+		log.Debugf("the upgrade notes read from '%s' are empty", strings.TrimSpace(*path))
+		return nil, nil
+	}
+	log.Debugf("upgrade notes have been read from '%s'", strings.TrimSpace(*path))
+	return &content, nil
+}
+
+/*
+Returns the given content without the front matter block it may start with, which is delimited by two '---' lines.
+When there is no complete front matter block the content is returned unchanged.
+*/
+func stripFrontMatter(content string) string {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	if len(lines) == 0 || FRONT_MATTER_DELIMITER != strings.TrimSpace(lines[0]) {
+		return content
+	}
+	for i := 1; i < len(lines); i++ {
+		if FRONT_MATTER_DELIMITER == strings.TrimSpace(lines[i]) {
+			return strings.Join(lines[i+1:], "\n")
+		}
+	}
+	return content
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	svc "github.com/mooltiverse/nyx/modules/go/nyx/services"
+	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+/*
+Returns the VersionStorageService configured by the 'versionStorageService' global option, also resolving its
+configuration option templates, along with its name. When the option is not set nil is returned.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options or the service has not been configured.
+  - UnsupportedOperationError if the service configuration exists but the service class does not
+    support the VERSION_STORAGE feature.
+*/
+func (ac *abstractCommand) resolveVersionStorageService() (svcapi.VersionStorageService, string, error) {
+	serviceName, err := ac.State().GetConfiguration().GetVersionStorageService()
+	if err != nil {
+		return nil, "", err
+	}
+	if serviceName == nil || "" == strings.TrimSpace(*serviceName) {
+		return nil, "", nil
+	}
+
+	services, err := ac.State().GetConfiguration().GetServices()
+	if err != nil {
+		return nil, "", err
+	}
+	if services == nil {
+		return nil, "", &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' service is used to store versions but no such service has been configured in the 'services' section", *serviceName)}
+	}
+	serviceConfiguration, ok := (*services)[*serviceName]
+	if !ok {
+		return nil, "", &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' service is used to store versions but no such service has been configured in the 'services' section", *serviceName)}
+	}
+	log.Debugf("instantiating version storage service '%s' of type '%s' with '%d' options", *serviceName, serviceConfiguration.GetType().String(), len(*serviceConfiguration.GetOptions()))
+	resolvedOptions, err := ac.resolveServiceOptions(*serviceConfiguration.GetOptions())
+	if err != nil {
+		return nil, "", err
+	}
+	service, err := svc.VersionStorageServiceInstance(*serviceConfiguration.GetType(), resolvedOptions)
+	if err != nil {
+		return nil, "", err
+	}
+	return service, *serviceName, nil
+}
+
+/*
+Returns the versions recorded by the configured version storage service, where keys are the SHA-1 identifiers of the
+commits and values are the versions released from each commit. When no version storage service is configured an
+empty map is returned.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+  - ReleaseError if the stored versions can't be read from the service.
+*/
+func (ac *abstractCommand) getStoredVersions() (map[string][]string, error) {
+	res := make(map[string][]string)
+	service, serviceName, err := ac.resolveVersionStorageService()
+	if err != nil {
+		return nil, err
+	}
+	if service == nil {
+		return res, nil
+	}
+	storedVersions, err := service.GetStoredVersions()
+	if err != nil {
+		return nil, &errs.ReleaseError{Message: fmt.Sprintf("unable to read the stored versions from '%s'", serviceName), Cause: err}
+	}
+	for _, storedVersion := range storedVersions {
+		if "" == strings.TrimSpace(storedVersion.GetVersion()) || "" == strings.TrimSpace(storedVersion.GetCommit()) {
+			log.Debugf("ignoring an incomplete stored version '%s' with commit '%s' from '%s'", storedVersion.GetVersion(), storedVersion.GetCommit(), serviceName)
+			continue
+		}
+		res[storedVersion.GetCommit()] = append(res[storedVersion.GetCommit()], storedVersion.GetVersion())
+	}
+	log.Debugf("%d versions have been read from the '%s' version storage service", len(storedVersions), serviceName)
+	return res, nil
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command
+
+import (
+	"sort"    // https://pkg.go.dev/sort
+	"strings" // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+)
+
+const (
+	// The annotation appended to the headings of yanked releases in changelogs.
+	YANKED_RELEASE_ANNOTATION = "[YANKED]"
+)
+
+/*
+Returns the set of yanked versions, as configured by the release types 'yanked' option. Versions are stored without
+the release prefix, if any, so they can be matched regardless of it.
+
+Error is:
+  - DataAccessError in case the configuration can't be loaded for some reason.
+  - IllegalPropertyError in case the configuration has some illegal options.
+*/
+func (ac *abstractCommand) getYankedVersions() (map[string]bool, error) {
+	releaseTypes, err := ac.State().GetConfiguration().GetReleaseTypes()
+	if err != nil {
+		return nil, err
+	}
+	releasePrefix, err := ac.State().GetConfiguration().GetReleasePrefix()
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]bool)
+	if releaseTypes == nil || releaseTypes.GetYanked() == nil {
+		return res, nil
+	}
+	for _, yanked := range *releaseTypes.GetYanked() {
+		if yanked != nil && "" != strings.TrimSpace(*yanked) {
+			res[trimReleasePrefix(strings.TrimSpace(*yanked), releasePrefix)] = true
+		}
+	}
+	if len(res) > 0 {
+		log.Debugf("%d versions have been yanked", len(res))
+	}
+	return res, nil
+}
+
+/*
+Returns true if the given version or tag name is among the given yanked versions, regardless of the release prefix.
+
+Arguments are as follows:
+
+  - yankedVersions the yanked versions, as returned by getYankedVersions()
+  - version the version or tag name to check
+  - releasePrefix the configured release prefix. It may be nil
+*/
+func isYankedVersion(yankedVersions map[string]bool, version string, releasePrefix *string) bool {
+	if len(yankedVersions) == 0 {
+		return false
+	}
+	return yankedVersions[version] || yankedVersions[trimReleasePrefix(version, releasePrefix)]
+}
+
+/*
+Returns the given version without the given release prefix, if any.
+*/
+func trimReleasePrefix(version string, releasePrefix *string) string {
+	if releasePrefix == nil || "" == *releasePrefix {
+		return version
+	}
+	return strings.TrimPrefix(version, *releasePrefix)
+}
+
+/*
+Appends the YANKED_RELEASE_ANNOTATION to the headings of the yanked releases in the given changelog contents, unless
+they already have it. A release heading is a Markdown heading whose first word is the release version, optionally
+within square brackets (i.e. '## 1.2.3 (2020-01-01)' or '## [1.2.3](https://example.com) - 2020-01-01').
+
+Arguments are as follows:
+
+  - content the changelog contents
+  - yankedVersions the yanked versions, as returned by getYankedVersions()
+  - releasePrefix the configured release prefix. It may be nil
+*/
+func annotateYankedReleases(content string, yankedVersions map[string]bool, releasePrefix *string) string {
+	if len(yankedVersions) == 0 {
+		return content
+	}
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		heading := strings.TrimRight(line, "\r")
+		if !strings.HasPrefix(heading, "#") || strings.Contains(heading, YANKED_RELEASE_ANNOTATION) {
+			continue
+		}
+		words := strings.Fields(strings.TrimLeft(heading, "#"))
+		if len(words) == 0 {
+			continue
+		}
+		version := words[0]
+		if strings.HasPrefix(version, "[") && strings.Contains(version, "]") {
+			version = version[1:strings.Index(version, "]")]
+		}
+		if isYankedVersion(yankedVersions, version, releasePrefix) {
+			log.Debugf("annotating the changelog heading of yanked release '%s'", version)
+			lines[i] = heading + " " + YANKED_RELEASE_ANNOTATION + line[len(heading):]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+/*
+Returns the given yanked versions as a sorted slice.
+
+Arguments are as follows:
+
+  - yankedVersions the yanked versions, as returned by getYankedVersions()
+*/
+func sortYankedVersions(yankedVersions map[string]bool) []string {
+	res := make([]string, 0, len(yankedVersions))
+	for version := range yankedVersions {
+		res = append(res, version)
+	}
+	sort.Strings(res)
+	return res
+}
@@ -35,6 +35,15 @@ import (
 )
 
 const (
+	// The name of the argument to read for this value.
+	ANALYTICS_FILE_ARGUMENT_NAME = "--analytics-file"
+
+	// The name of the argument to read for this value.
+	BADGES_DIRECTORY_ARGUMENT_NAME = "--badges-directory"
+
+	// The name of the argument to read for this value.
+	BRANCH_METADATA_EXPRESSION_ARGUMENT_NAME = "--branch-metadata-expression"
+
 	// The name of the argument to read for this value.
 	BUMP_ARGUMENT_NAME = "--bump"
 
@@ -47,6 +56,48 @@ const (
 	// The name of the argument to read for this value.
 	CHANGELOG_CONFIGURATION_APPEND_ARGUMENT_NAME = CHANGELOG_CONFIGURATION_ARGUMENT_NAME + "-append"
 
+	// The name of the argument to read for this value.
+	CHANGELOG_CONFIGURATION_BADGES_ARGUMENT_NAME = CHANGELOG_CONFIGURATION_ARGUMENT_NAME + "-badges"
+
+	// The regular expression used to scan the name of a changelog section from an argument
+	// name. This expression is used to detect if an argument is used to define
+	// the badge of a changelog section.
+	// This expression uses the 'name' capturing group which returns the section name, if detected.
+	CHANGELOG_CONFIGURATION_BADGES_ARGUMENT_ITEM_NAME_REGEX = CHANGELOG_CONFIGURATION_BADGES_ARGUMENT_NAME + "-(?<name>[a-zA-Z0-9]+)$"
+
+	// The parametrized name of the argument to read for the badge of a changelog section.
+	// This string is a prototype that contains a '%s' parameter for the section name
+	// and must be rendered using fmt.Sprintf(CHANGELOG_CONFIGURATION_BADGES_ARGUMENT_ITEM_VALUE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the section with the given 'name'.
+	CHANGELOG_CONFIGURATION_BADGES_ARGUMENT_ITEM_VALUE_FORMAT_STRING = CHANGELOG_CONFIGURATION_BADGES_ARGUMENT_NAME + "-%s"
+
+	// The name of the argument to read for this value.
+	CHANGELOG_CONFIGURATION_COLLAPSE_THRESHOLD_ARGUMENT_NAME = CHANGELOG_CONFIGURATION_ARGUMENT_NAME + "-collapse-threshold"
+
+	// The name of the argument to read for this value.
+	CHANGELOG_CONFIGURATION_DEDUPLICATE_CHERRY_PICKS_ARGUMENT_NAME = CHANGELOG_CONFIGURATION_ARGUMENT_NAME + "-deduplicate-cherry-picks"
+
+	// The name of the argument to read for this value.
+	CHANGELOG_CONFIGURATION_GROUP_DEPENDENCY_UPDATES_ARGUMENT_NAME = CHANGELOG_CONFIGURATION_ARGUMENT_NAME + "-group-dependency-updates"
+
+	// The name of the argument to read for this value.
+	CHANGELOG_CONFIGURATION_MERGE_PRE_RELEASES_ARGUMENT_NAME = CHANGELOG_CONFIGURATION_ARGUMENT_NAME + "-merge-pre-releases"
+
+	// The name of the argument to read for this value.
+	CHANGELOG_CONFIGURATION_EMOJIS_ARGUMENT_NAME = CHANGELOG_CONFIGURATION_ARGUMENT_NAME + "-emojis"
+
+	// The regular expression used to scan the name of a changelog section from an argument
+	// name. This expression is used to detect if an argument is used to define
+	// the emoji of a changelog section.
+	// This expression uses the 'name' capturing group which returns the section name, if detected.
+	CHANGELOG_CONFIGURATION_EMOJIS_ARGUMENT_ITEM_NAME_REGEX = CHANGELOG_CONFIGURATION_EMOJIS_ARGUMENT_NAME + "-(?<name>[a-zA-Z0-9]+)$"
+
+	// The parametrized name of the argument to read for the emoji of a changelog section.
+	// This string is a prototype that contains a '%s' parameter for the section name
+	// and must be rendered using fmt.Sprintf(CHANGELOG_CONFIGURATION_EMOJIS_ARGUMENT_ITEM_VALUE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the section with the given 'name'.
+	CHANGELOG_CONFIGURATION_EMOJIS_ARGUMENT_ITEM_VALUE_FORMAT_STRING = CHANGELOG_CONFIGURATION_EMOJIS_ARGUMENT_NAME + "-%s"
+
 	// The name of the argument to read for this value.
 	CHANGELOG_CONFIGURATION_PATH_ARGUMENT_NAME = CHANGELOG_CONFIGURATION_ARGUMENT_NAME + "-path"
 
@@ -85,6 +136,12 @@ const (
 	// The name of the argument to read for this value.
 	CHANGELOG_CONFIGURATION_TEMPLATE_ARGUMENT_NAME = CHANGELOG_CONFIGURATION_ARGUMENT_NAME + "-template"
 
+	// The name of the argument to read for this value.
+	CHANGELOG_CONFIGURATION_UPGRADE_NOTES_ARGUMENT_NAME = CHANGELOG_CONFIGURATION_ARGUMENT_NAME + "-upgrade-notes"
+
+	// The name of the argument to read for this value.
+	COMMIT_CHANGES_ARGUMENT_NAME = "--commit-changes"
+
 	// The name of the argument to read for this value.
 	COMMIT_MESSAGE_CONVENTIONS_ARGUMENT_NAME = "--commit-message-conventions"
 
@@ -111,6 +168,9 @@ const (
 	// in order to get the actual name of the argument that brings the value for the convention with the given 'name'.
 	COMMIT_MESSAGE_CONVENTIONS_ARGUMENT_ITEM_BUMP_EXPRESSIONS_FORMAT_STRING = COMMIT_MESSAGE_CONVENTIONS_ARGUMENT_NAME + "-%s-bumpExpressions"
 
+	// The name of the argument to read for this value.
+	COMMIT_LINT_FILE_ARGUMENT_NAME = "--commit-lint-file"
+
 	// The name of the argument to read for this value.
 	CONFIGURATION_FILE_ARGUMENT_NAME = "--configuration-file"
 
@@ -120,15 +180,231 @@ const (
 	// The name of the argument to read for this value.
 	DIRECTORY_ARGUMENT_NAME = "--directory"
 
+	// The name of the argument to read for this value.
+	DOWNSTREAM_UPDATES_ARGUMENT_NAME = "--downstream-updates"
+
+	// The name of the argument to read for this value.
+	DOWNSTREAM_UPDATES_ENABLED_ARGUMENT_NAME = DOWNSTREAM_UPDATES_ARGUMENT_NAME + "-enabled"
+
+	// The regular expression used to scan the name of a downstream update from a command line argument
+	// name. This expression is used to detect if a command line argument is used to define
+	// a downstream update.
+	// This expression uses the 'name' capturing group which returns the downstream update name, if detected.
+	DOWNSTREAM_UPDATES_ARGUMENT_ITEM_NAME_REGEX = DOWNSTREAM_UPDATES_ARGUMENT_NAME + "-(?<name>[a-zA-Z0-9]+)-([a-zA-Z0-9-]+)$"
+
+	// The parametrized name of the argument to read for the 'branch' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_BRANCH_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ARGUMENT_ITEM_BRANCH_FORMAT_STRING = DOWNSTREAM_UPDATES_ARGUMENT_NAME + "-%s-branch"
+
+	// The parametrized name of the argument to read for the 'match' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_MATCH_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ARGUMENT_ITEM_MATCH_FORMAT_STRING = DOWNSTREAM_UPDATES_ARGUMENT_NAME + "-%s-match"
+
+	// The parametrized name of the argument to read for the 'owner' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_OWNER_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ARGUMENT_ITEM_OWNER_FORMAT_STRING = DOWNSTREAM_UPDATES_ARGUMENT_NAME + "-%s-owner"
+
+	// The parametrized name of the argument to read for the 'path' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_PATH_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ARGUMENT_ITEM_PATH_FORMAT_STRING = DOWNSTREAM_UPDATES_ARGUMENT_NAME + "-%s-path"
+
+	// The parametrized name of the argument to read for the 'replace' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_REPLACE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ARGUMENT_ITEM_REPLACE_FORMAT_STRING = DOWNSTREAM_UPDATES_ARGUMENT_NAME + "-%s-replace"
+
+	// The parametrized name of the argument to read for the 'repository' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_REPOSITORY_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ARGUMENT_ITEM_REPOSITORY_FORMAT_STRING = DOWNSTREAM_UPDATES_ARGUMENT_NAME + "-%s-repository"
+
+	// The parametrized name of the argument to read for the 'service' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_SERVICE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ARGUMENT_ITEM_SERVICE_FORMAT_STRING = DOWNSTREAM_UPDATES_ARGUMENT_NAME + "-%s-service"
+
+	// The parametrized name of the argument to read for the 'title' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_TITLE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ARGUMENT_ITEM_TITLE_FORMAT_STRING = DOWNSTREAM_UPDATES_ARGUMENT_NAME + "-%s-title"
+
 	// The short name of the argument to read for this value.
 	DIRECTORY_ARGUMENT_SHORT_NAME = "-d"
 
 	// The name of the argument to read for this value.
 	DRY_RUN_ARGUMENT_NAME = "--dry-run"
 
+	// The name of the argument to read for this value.
+	EVENT_BUS_ARGUMENT_NAME = "--event-bus"
+
+	// The name of the argument to read for this value.
+	EVENT_BUS_ENABLED_ARGUMENT_NAME = EVENT_BUS_ARGUMENT_NAME + "-enabled"
+
+	// The regular expression used to scan the name of a event emitter from a command line argument
+	// name. This expression is used to detect if a command line argument is used to define
+	// a event emitter.
+	// This expression uses the 'name' capturing group which returns the event emitter name, if detected.
+	EVENT_BUS_ARGUMENT_ITEM_NAME_REGEX = EVENT_BUS_ARGUMENT_NAME + "-(?<name>[a-zA-Z0-9]+)-([a-zA-Z0-9-]+)$"
+
+	// The parametrized name of the argument to read for the 'endpoint' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_ENDPOINT_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ARGUMENT_ITEM_ENDPOINT_FORMAT_STRING = EVENT_BUS_ARGUMENT_NAME + "-%s-endpoint"
+
+	// The parametrized name of the argument to read for the 'events' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_EVENTS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ARGUMENT_ITEM_EVENTS_FORMAT_STRING = EVENT_BUS_ARGUMENT_NAME + "-%s-events"
+
+	// The parametrized name of the argument to read for the 'password' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_PASSWORD_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ARGUMENT_ITEM_PASSWORD_FORMAT_STRING = EVENT_BUS_ARGUMENT_NAME + "-%s-password"
+
+	// The parametrized name of the argument to read for the 'region' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_REGION_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ARGUMENT_ITEM_REGION_FORMAT_STRING = EVENT_BUS_ARGUMENT_NAME + "-%s-region"
+
+	// The parametrized name of the argument to read for the 'topic' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_TOPIC_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ARGUMENT_ITEM_TOPIC_FORMAT_STRING = EVENT_BUS_ARGUMENT_NAME + "-%s-topic"
+
+	// The parametrized name of the argument to read for the 'type' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_TYPE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ARGUMENT_ITEM_TYPE_FORMAT_STRING = EVENT_BUS_ARGUMENT_NAME + "-%s-type"
+
+	// The parametrized name of the argument to read for the 'user' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_USER_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ARGUMENT_ITEM_USER_FORMAT_STRING = EVENT_BUS_ARGUMENT_NAME + "-%s-user"
+
 	// The name of the argument to read for this value.
 	GIT_CONFIGURATION_ARGUMENT_NAME = "--git"
 
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_HEADERS_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-headers"
+
+	// The regular expression used to scan the name of an extra HTTP header from an argument
+	// name. This expression is used to detect if an argument is used to define
+	// an extra HTTP header.
+	// This expression uses the 'name' capturing group which returns the header name, if detected.
+	GIT_CONFIGURATION_HEADERS_ARGUMENT_ITEM_NAME_REGEX = GIT_CONFIGURATION_HEADERS_ARGUMENT_NAME + "-(?<name>[a-zA-Z0-9-]+)$"
+
+	// The parametrized name of the argument to read for the value of an extra HTTP header.
+	// This string is a prototype that contains a '%s' parameter for the header name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_HEADERS_ARGUMENT_ITEM_VALUE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the header with the given 'name'.
+	GIT_CONFIGURATION_HEADERS_ARGUMENT_ITEM_VALUE_FORMAT_STRING = GIT_CONFIGURATION_HEADERS_ARGUMENT_NAME + "-%s"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_IDENTITY_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-identity"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_IDENTITY_EMAIL_ARGUMENT_NAME = GIT_CONFIGURATION_IDENTITY_ARGUMENT_NAME + "-email"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_IDENTITY_EMAIL_VARIABLE_ARGUMENT_NAME = GIT_CONFIGURATION_IDENTITY_ARGUMENT_NAME + "-email-variable"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_IDENTITY_NAME_ARGUMENT_NAME = GIT_CONFIGURATION_IDENTITY_ARGUMENT_NAME + "-name"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_IDENTITY_NAME_VARIABLE_ARGUMENT_NAME = GIT_CONFIGURATION_IDENTITY_ARGUMENT_NAME + "-name-variable"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_IDENTITY_PROVIDER_ARGUMENT_NAME = GIT_CONFIGURATION_IDENTITY_ARGUMENT_NAME + "-provider"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_PROXY_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-proxy"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_SINGLE_BRANCH_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-single-branch"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_FETCH_TAGS_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-fetch-tags"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_UNSHALLOW_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-unshallow"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_MIRROR_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-mirror"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_BACKEND_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-backend"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_SERVICE_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-service"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_TRUSTED_KEYS_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-trusted-keys"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_TIMESTAMP_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-timestamp"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_NO_VERIFY_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-no-verify"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_HOOKS_PATH_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-hooks-path"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_IGNORE_SUBMODULES_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-ignore-submodules"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_STASH_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-stash"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_TIMEOUT_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-timeout"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_RETRIES_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-retries"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_RETRY_DELAY_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-retry-delay"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_LOCK_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-lock"
+
+	// The name of the argument to read for this value.
+	GIT_CONFIGURATION_LOCK_TIMEOUT_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-lock-timeout"
+
 	// The name of the argument to read for this value.
 	GIT_CONFIGURATION_REMOTES_ARGUMENT_NAME = GIT_CONFIGURATION_ARGUMENT_NAME + "-remotes"
 
@@ -173,12 +449,149 @@ const (
 	// in order to get the actual name of the argument that brings the value for the remote with the given 'name'.
 	GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PASSPHRASE_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ARGUMENT_NAME + "-%s-passphrase"
 
+	// The parametrized name of the argument to read for the 'knownHosts' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_KNOWN_HOSTS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_KNOWN_HOSTS_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ARGUMENT_NAME + "-%s-knownHosts"
+
+	// The parametrized name of the argument to read for the 'strictHostKeyChecking' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_STRICT_HOST_KEY_CHECKING_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_STRICT_HOST_KEY_CHECKING_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ARGUMENT_NAME + "-%s-strictHostKeyChecking"
+
+	// The parametrized name of the argument to read for the 'appId' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_APP_ID_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_APP_ID_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ARGUMENT_NAME + "-%s-appId"
+
+	// The parametrized name of the argument to read for the 'installationId' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_INSTALLATION_ID_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_INSTALLATION_ID_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ARGUMENT_NAME + "-%s-installationId"
+
+	// The parametrized name of the argument to read for the 'userVariable' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_USER_VARIABLE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_USER_VARIABLE_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ARGUMENT_NAME + "-%s-userVariable"
+
+	// The parametrized name of the argument to read for the 'passwordVariable' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PASSWORD_VARIABLE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PASSWORD_VARIABLE_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ARGUMENT_NAME + "-%s-passwordVariable"
+
+	// The parametrized name of the argument to read for the 'pushTags' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PUSH_TAGS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PUSH_TAGS_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ARGUMENT_NAME + "-%s-pushTags"
+
 	// The name of the argument to read for this value.
 	HELP_ARGUMENT_NAME = "--help"
 
+	// The name of the argument to read for this value.
+	IMPACT_ANALYZERS_ARGUMENT_NAME = "--impact-analyzers"
+
+	// The name of the argument to read for this value.
+	IMPACT_ANALYZERS_ENABLED_ARGUMENT_NAME = IMPACT_ANALYZERS_ARGUMENT_NAME + "-enabled"
+
+	// The regular expression used to scan the name of an impact analyzer from a command line argument
+	// name. This expression is used to detect if a command line argument is used to define
+	// an impact analyzer.
+	// This expression uses the 'name' capturing group which returns the impact analyzer name, if detected.
+	IMPACT_ANALYZERS_ARGUMENT_ITEM_NAME_REGEX = IMPACT_ANALYZERS_ARGUMENT_NAME + "-(?<name>[a-zA-Z0-9]+)-([a-zA-Z0-9-]+)$"
+
+	// The parametrized name of the argument to read for the 'bump' attribute of an
+	// impact analyzer.
+	// This string is a prototype that contains a '%s' parameter for the impact analyzer name
+	// and must be rendered using fmt.Sprintf(IMPACT_ANALYZERS_ARGUMENT_ITEM_BUMP_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the impact analyzer with the given 'name'.
+	IMPACT_ANALYZERS_ARGUMENT_ITEM_BUMP_FORMAT_STRING = IMPACT_ANALYZERS_ARGUMENT_NAME + "-%s-bump"
+
+	// The parametrized name of the argument to read for the 'command' attribute of an
+	// impact analyzer.
+	// This string is a prototype that contains a '%s' parameter for the impact analyzer name
+	// and must be rendered using fmt.Sprintf(IMPACT_ANALYZERS_ARGUMENT_ITEM_COMMAND_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the impact analyzer with the given 'name'.
+	IMPACT_ANALYZERS_ARGUMENT_ITEM_COMMAND_FORMAT_STRING = IMPACT_ANALYZERS_ARGUMENT_NAME + "-%s-command"
+
+	// The parametrized name of the argument to read for the 'expression' attribute of an
+	// impact analyzer.
+	// This string is a prototype that contains a '%s' parameter for the impact analyzer name
+	// and must be rendered using fmt.Sprintf(IMPACT_ANALYZERS_ARGUMENT_ITEM_EXPRESSION_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the impact analyzer with the given 'name'.
+	IMPACT_ANALYZERS_ARGUMENT_ITEM_EXPRESSION_FORMAT_STRING = IMPACT_ANALYZERS_ARGUMENT_NAME + "-%s-expression"
+
+	// The parametrized name of the argument to read for the 'title' attribute of an
+	// impact analyzer.
+	// This string is a prototype that contains a '%s' parameter for the impact analyzer name
+	// and must be rendered using fmt.Sprintf(IMPACT_ANALYZERS_ARGUMENT_ITEM_TITLE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the impact analyzer with the given 'name'.
+	IMPACT_ANALYZERS_ARGUMENT_ITEM_TITLE_FORMAT_STRING = IMPACT_ANALYZERS_ARGUMENT_NAME + "-%s-title"
+
 	// The name of the argument to read for this value.
 	INITIAL_VERSION_ARGUMENT_NAME = "--initial-version"
 
+	// The name of the argument to read for this value.
+	JUNIT_REPORT_FILE_ARGUMENT_NAME = "--junit-report-file"
+
+	// The name of the argument to read for this value.
+	NOTHING_TO_RELEASE_ARGUMENT_NAME = "--nothing-to-release"
+
+	// The name of the argument to read for this value.
+	ORGANIZATION_CONFIGURATION_REPOSITORY_ARGUMENT_NAME = "--organization-configuration-repository"
+
+	// The name of the argument to read for this value.
+	ORGANIZATION_CONFIGURATION_SERVICE_ARGUMENT_NAME = "--organization-configuration-service"
+
+	// The name of the argument to read for this value.
+	PATH_RULES_ARGUMENT_NAME = "--path-rules"
+
+	// The name of the argument to read for this value.
+	PATH_RULES_ENABLED_ARGUMENT_NAME = PATH_RULES_ARGUMENT_NAME + "-enabled"
+
+	// The regular expression used to scan the name of a path rule from a command line argument
+	// name. This expression is used to detect if a command line argument is used to define
+	// a path rule.
+	// This expression uses the 'name' capturing group which returns the path rule name, if detected.
+	PATH_RULES_ARGUMENT_ITEM_NAME_REGEX = PATH_RULES_ARGUMENT_NAME + "-(?<name>[a-zA-Z0-9]+)-([a-zA-Z0-9-]+)$"
+
+	// The parametrized name of the argument to read for the 'bump' attribute of a
+	// path rule.
+	// This string is a prototype that contains a '%s' parameter for the path rule name
+	// and must be rendered using fmt.Sprintf(PATH_RULES_ARGUMENT_ITEM_BUMP_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the path rule with the given 'name'.
+	PATH_RULES_ARGUMENT_ITEM_BUMP_FORMAT_STRING = PATH_RULES_ARGUMENT_NAME + "-%s-bump"
+
+	// The parametrized name of the argument to read for the 'paths' attribute of a
+	// path rule.
+	// This string is a prototype that contains a '%s' parameter for the path rule name
+	// and must be rendered using fmt.Sprintf(PATH_RULES_ARGUMENT_ITEM_PATHS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the path rule with the given 'name'.
+	PATH_RULES_ARGUMENT_ITEM_PATHS_FORMAT_STRING = PATH_RULES_ARGUMENT_NAME + "-%s-paths"
+
+	// The parametrized name of the argument to read for the 'significant' attribute of a
+	// path rule.
+	// This string is a prototype that contains a '%s' parameter for the path rule name
+	// and must be rendered using fmt.Sprintf(PATH_RULES_ARGUMENT_ITEM_SIGNIFICANT_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the path rule with the given 'name'.
+	PATH_RULES_ARGUMENT_ITEM_SIGNIFICANT_FORMAT_STRING = PATH_RULES_ARGUMENT_NAME + "-%s-significant"
+
+	// The name of the argument to read for this value.
+	PRERELEASE_ORDER_ARGUMENT_NAME = "--prerelease-order"
+
 	// The name of the argument to read for this value.
 	PRESET_ARGUMENT_NAME = "--preset"
 
@@ -219,6 +632,23 @@ const (
 	// in order to get the actual name of the argument that brings the value for the release asset type with the given 'name'.
 	RELEASE_ASSETS_ARGUMENT_ITEM_TYPE_FORMAT_STRING = RELEASE_ASSETS_ARGUMENT_NAME + "-%s-type"
 
+	// The parametrized name of the argument to read for the 'archive' attribute of a
+	// release asset.
+	// This string is a prototype that contains a '%s' parameter for the release asset name
+	// and must be rendered using fmt.Sprintf(RELEASE_ASSETS_ARGUMENT_ITEM_ARCHIVE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release asset archive with the given 'name'.
+	RELEASE_ASSETS_ARGUMENT_ITEM_ARCHIVE_FORMAT_STRING = RELEASE_ASSETS_ARGUMENT_NAME + "-%s-archive"
+
+	// The parametrized name of the argument to read for the 'platforms' attribute of a
+	// release asset.
+	// This string is a prototype that contains a '%s' parameter for the release asset name
+	// and must be rendered using fmt.Sprintf(RELEASE_ASSETS_ARGUMENT_ITEM_PLATFORMS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release asset platforms with the given 'name'.
+	RELEASE_ASSETS_ARGUMENT_ITEM_PLATFORMS_FORMAT_STRING = RELEASE_ASSETS_ARGUMENT_NAME + "-%s-platforms"
+
+	// The name of the argument to read for this value.
+	RELEASE_COERCION_ARGUMENT_NAME = "--release-coercion"
+
 	// The name of the argument to read for this value.
 	RELEASE_LENIENT_ARGUMENT_NAME = "--release-lenient"
 
@@ -237,6 +667,9 @@ const (
 	// The name of the argument to read for this value.
 	RELEASE_TYPES_REMOTE_REPOSITORIES_ARGUMENT_NAME = RELEASE_TYPES_ARGUMENT_NAME + "-remote-repositories"
 
+	// The name of the argument to read for this value.
+	RELEASE_TYPES_YANKED_ARGUMENT_NAME = RELEASE_TYPES_ARGUMENT_NAME + "-yanked"
+
 	// The regular expression used to scan the name of a release type from an argument
 	// name. This expression is used to detect if an argument is used to define
 	// a release type.
@@ -250,6 +683,27 @@ const (
 	// in order to get the actual name of the argument variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ARGUMENT_ITEM_ASSETS_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-assets"
 
+	// The parametrized name of the argument to read for the 'buildMetadata' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_BUILD_METADATA_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_BUILD_METADATA_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-build-metadata"
+
+	// The parametrized name of the argument to read for the 'buildMetadataTargets' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_BUILD_METADATA_TARGETS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_BUILD_METADATA_TARGETS_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-build-metadata-targets"
+
+	// The parametrized name of the argument to read for the 'bumpLabels' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_BUMP_LABELS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_BUMP_LABELS_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-bump-labels"
+
 	// The parametrized name of the argument to read for the 'collapseVersions' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -278,6 +732,20 @@ const (
 	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ARGUMENT_ITEM_FILTER_TAGS_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-filter-tags"
 
+	// The parametrized name of the argument to read for the 'followAllParents' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_FOLLOW_ALL_PARENTS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_FOLLOW_ALL_PARENTS_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-follow-all-parents"
+
+	// The parametrized name of the argument to read for the 'gatePolicy' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GATE_POLICY_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_GATE_POLICY_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-gate-policy"
+
 	// The parametrized name of the argument to read for the 'gitCommit' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -306,6 +774,13 @@ const (
 	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ARGUMENT_ITEM_GIT_PUSH_FORCE_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-git-push-force"
 
+	// The parametrized name of the argument to read for the 'gitPushForceWithLease' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GIT_PUSH_FORCE_WITH_LEASE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_GIT_PUSH_FORCE_WITH_LEASE_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-git-push-force-with-lease"
+
 	// The parametrized name of the argument to read for the 'gitTag' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -341,6 +816,20 @@ const (
 	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ARGUMENT_ITEM_IDENTIFIERS_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-identifiers"
 
+	// The parametrized name of the argument to read for the 'ignoreCherryPicks' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_IGNORE_CHERRY_PICKS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_IGNORE_CHERRY_PICKS_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-ignore-cherry-picks"
+
+	// The parametrized name of the argument to read for the 'ignoreMerges' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_IGNORE_MERGES_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_IGNORE_MERGES_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-ignore-merges"
+
 	// The parametrized name of the argument to read for the 'matchBranches' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -348,6 +837,27 @@ const (
 	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ARGUMENT_ITEM_MATCH_BRANCHES_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-match-branches"
 
+	// The parametrized name of the argument to read for the 'matchBranchMetadata' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_BRANCH_METADATA_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_MATCH_BRANCH_METADATA_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-match-branch-metadata"
+
+	// The parametrized name of the argument to read for the 'matchChangedPaths' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_CHANGED_PATHS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_MATCH_CHANGED_PATHS_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-match-changed-paths"
+
+	// The parametrized name of the argument to read for the 'matchDaysOfWeek' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_DAYS_OF_WEEK_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_MATCH_DAYS_OF_WEEK_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-match-days-of-week"
+
 	// The parametrized name of the argument to read for the 'matchEnvironmentVariables' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -355,6 +865,34 @@ const (
 	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ARGUMENT_ITEM_MATCH_ENVIRONMENT_VARIABLES_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-match-environment-variables"
 
+	// The parametrized name of the argument to read for the 'matchExpression' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_EXPRESSION_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_MATCH_EXPRESSION_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-match-expression"
+
+	// The parametrized name of the argument to read for the 'matchMode' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_MODE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_MATCH_MODE_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-match-mode"
+
+	// The parametrized name of the argument to read for the 'matchPolicy' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_POLICY_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_MATCH_POLICY_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-match-policy"
+
+	// The parametrized name of the argument to read for the 'matchTags' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_TAGS_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_MATCH_TAGS_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-match-tags"
+
 	// The parametrized name of the argument to read for the 'matchWorkspaceStatus' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -369,6 +907,27 @@ const (
 	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-publish"
 
+	// The parametrized name of the argument to read for the 'publishApprovalEnvironment' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_APPROVAL_ENVIRONMENT_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_APPROVAL_ENVIRONMENT_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-publish-approval-environment"
+
+	// The parametrized name of the argument to read for the 'publishApprovalPollingInterval' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_APPROVAL_POLLING_INTERVAL_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_APPROVAL_POLLING_INTERVAL_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-publish-approval-polling-interval"
+
+	// The parametrized name of the argument to read for the 'publishApprovalTimeout' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_APPROVAL_TIMEOUT_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_APPROVAL_TIMEOUT_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-publish-approval-timeout"
+
 	// The parametrized name of the argument to read for the 'publishDraft' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -383,6 +942,20 @@ const (
 	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_PRE_RELEASE_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-publish-pre-release"
 
+	// The parametrized name of the argument to read for the 'pullRequestMessages' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PULL_REQUEST_MESSAGES_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_PULL_REQUEST_MESSAGES_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-pull-request-messages"
+
+	// The parametrized name of the argument to read for the 'releaseMetadataFile' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_RELEASE_METADATA_FILE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_RELEASE_METADATA_FILE_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-release-metadata-file"
+
 	// The parametrized name of the argument to read for the 'releaseName' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -390,26 +963,68 @@ const (
 	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ARGUMENT_ITEM_RELEASE_NAME_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-release-name"
 
-	// The parametrized name of the argument to read for the 'versionRange' attribute of a
+	// The parametrized name of the argument to read for the 'requiredEnvironmentVariables' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
-	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_VERSION_RANGE_FORMAT_STRING, name)
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_REQUIRED_ENVIRONMENT_VARIABLES_FORMAT_STRING, name)
 	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
-	RELEASE_TYPES_ARGUMENT_ITEM_VERSION_RANGE_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-version-range"
+	RELEASE_TYPES_ARGUMENT_ITEM_REQUIRED_ENVIRONMENT_VARIABLES_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-required-environment-variables"
 
-	// The parametrized name of the argument to read for the 'versionRangeFromBranchName' attribute of a
+	// The parametrized name of the argument to read for the 'requireSignedCommits' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
-	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_VERSION_RANGE_FROM_BRANCH_NAME_FORMAT_STRING, name)
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_REQUIRE_SIGNED_COMMITS_FORMAT_STRING, name)
 	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
-	RELEASE_TYPES_ARGUMENT_ITEM_VERSION_RANGE_FROM_BRANCH_NAME_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-version-range-from-branch-name"
+	RELEASE_TYPES_ARGUMENT_ITEM_REQUIRE_SIGNED_COMMITS_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-require-signed-commits"
 
-	// The name of the argument to read for this value.
+	// The parametrized name of the argument to read for the 'versionConstraint' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_VERSION_CONSTRAINT_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_VERSION_CONSTRAINT_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-version-constraint"
+
+	// The parametrized name of the argument to read for the 'versionRange' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_VERSION_RANGE_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_VERSION_RANGE_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-version-range"
+
+	// The parametrized name of the argument to read for the 'versionRangeFromBranchName' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_VERSION_RANGE_FROM_BRANCH_NAME_FORMAT_STRING, name)
+	// in order to get the actual name of the argument that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ARGUMENT_ITEM_VERSION_RANGE_FROM_BRANCH_NAME_FORMAT_STRING = RELEASE_TYPES_ARGUMENT_NAME + "-%s-version-range-from-branch-name"
+
+	// The name of the argument to read for this value.
+	REPORT_FILE_ARGUMENT_NAME = "--report-file"
+
+	// The name of the argument to read for this value.
 	RESUME_ARGUMENT_NAME = "--resume"
 
+	// The name of the argument to read for this value.
+	REVISION_BUMP_ARGUMENT_NAME = "--revision-bump"
+
 	// The name of the argument to read for this value.
 	SCHEME_ARGUMENT_NAME = "--scheme"
 
+	// The name of the argument to read for this value.
+	SERVER_CONFIGURATION_ARGUMENT_NAME = "--server"
+
+	// The name of the argument to read for this value.
+	SERVER_CONFIGURATION_ADDRESS_ARGUMENT_NAME = SERVER_CONFIGURATION_ARGUMENT_NAME + "-address"
+
+	// The name of the argument to read for this value.
+	SERVER_CONFIGURATION_BRANCHES_ARGUMENT_NAME = SERVER_CONFIGURATION_ARGUMENT_NAME + "-branches"
+
+	// The name of the argument to read for this value.
+	SERVER_CONFIGURATION_COMMAND_ARGUMENT_NAME = SERVER_CONFIGURATION_ARGUMENT_NAME + "-command"
+
+	// The name of the argument to read for this value.
+	SERVER_CONFIGURATION_SECRET_ARGUMENT_NAME = SERVER_CONFIGURATION_ARGUMENT_NAME + "-secret"
+
 	// The name of the argument to read for this value.
 	SERVICES_ARGUMENT_NAME = "--services"
 
@@ -439,6 +1054,12 @@ const (
 	// The name of the argument to read for this value.
 	STATE_FILE_ARGUMENT_NAME = "--state-file"
 
+	// The name of the argument to read for this value.
+	STATE_FILE_SIGNING_KEY_ARGUMENT_NAME = "--state-file-signing-key"
+
+	// The name of the argument to read for this value.
+	STRICT_EXIT_CODES_ARGUMENT_NAME = "--strict-exit-codes"
+
 	// The name of the argument to read for this value.
 	SUBSTITUTIONS_ARGUMENT_NAME = "--substitutions"
 
@@ -478,6 +1099,15 @@ const (
 	// The name of the argument to read for this value.
 	SUMMARY_FILE_ARGUMENT_NAME = "--summary-file"
 
+	// The name of the argument to read for this value.
+	TELEMETRY_ENDPOINT_ARGUMENT_NAME = "--telemetry-endpoint"
+
+	// The name of the argument to read for this value.
+	TELEMETRY_FILE_ARGUMENT_NAME = "--telemetry-file"
+
+	// The name of the argument to read for this value.
+	TIMESTAMP_SOURCE_ARGUMENT_NAME = "--timestamp-source"
+
 	// The name of the argument to read for this value.
 	VERBOSITY_ARGUMENT_NAME = "--verbosity"
 
@@ -504,6 +1134,9 @@ const (
 
 	// The short name of the argument to read for this value.
 	VERSION_ARGUMENT_SHORT_NAME = "-v"
+
+	// The name of the argument to read for this value.
+	VERSION_STORAGE_SERVICE_ARGUMENT_NAME = "--version-storage-service"
 )
 
 var (
@@ -532,15 +1165,30 @@ type CommandLineConfigurationLayer struct {
 	// The commit message convention configuration section.
 	commitMessageConventions *ent.CommitMessageConventions
 
+	// The downstream updates configuration section.
+	downstreamUpdates *ent.DownstreamUpdates
+
+	// The event emitters configuration section.
+	eventBus *ent.EventBus
+
 	// The Git configuration section.
 	git *ent.GitConfiguration
 
+	// The impact analyzers configuration section.
+	impactAnalyzers *ent.ImpactAnalyzers
+
+	// The path rules configuration section.
+	pathRules *ent.PathRules
+
 	// The release assets configuration section
 	releaseAssets *map[string]*ent.Attachment
 
 	// The release types configuration section.
 	releaseTypes *ent.ReleaseTypes
 
+	// The server configuration section.
+	server *ent.ServerConfiguration
+
 	// The services configuration section
 	services *map[string]*ent.ServiceConfiguration
 
@@ -804,13 +1452,12 @@ func (clcl *CommandLineConfigurationLayer) parseArguments(arguments []string) ma
 	for _, entry := range arguments {
 		// options all start with a '-' or '--' so we can ignore all the rest (which is likely the command)
 		if entry != "" && strings.HasPrefix(entry, "-") {
-			entryValue := strings.Split(entry, "=")
+			// only split on the first '=' as values may contain other '=' characters (i.e. policy expressions)
+			entryValue := strings.SplitN(entry, "=", 2)
 			if len(entryValue) == 1 {
 				argumentsMap[entryValue[0]] = "" // this is a flag, with no value
-			} else if len(entryValue) == 2 {
+			} else {
 				argumentsMap[entryValue[0]] = entryValue[1]
-			} else if len(entryValue) > 2 {
-				log.Errorf("malformed argument %s", entry)
 			}
 		}
 	}
@@ -888,6 +1535,39 @@ func (clcl *CommandLineConfigurationLayer) withArguments(arguments []string) {
 	}
 }
 
+/*
+Returns the path to the file where the analytics report about past releases is written as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetAnalyticsFile() (*string, error) {
+	return clcl.getArgument(ANALYTICS_FILE_ARGUMENT_NAME), nil
+}
+
+/*
+Returns the directory where the version and release date badges are written as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetBadgesDirectory() (*string, error) {
+	return clcl.getArgument(BADGES_DIRECTORY_ARGUMENT_NAME), nil
+}
+
+/*
+Returns the regular expression used to extract metadata from the current branch name as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetBranchMetadataExpression() (*string, error) {
+	return clcl.getArgument(BRANCH_METADATA_EXPRESSION_ARGUMENT_NAME), nil
+}
+
 /*
 Returns the version identifier to bump as it's defined by this configuration. A nil value means undefined.
 
@@ -918,174 +1598,675 @@ func (clcl *CommandLineConfigurationLayer) GetChangelog() (*ent.ChangelogConfigu
 		if err != nil {
 			return nil, err
 		}
-		// now we have the set of all section names configured through arguments and we can
-		// query specific arguments
-		for _, sectionName := range sectionNames {
-			sectionValue := clcl.getArgument(fmt.Sprintf(CHANGELOG_CONFIGURATION_SECTIONS_ARGUMENT_ITEM_REGEXP_FORMAT_STRING, sectionName))
-			sections[sectionName] = *sectionValue
+		// now we have the set of all section names configured through arguments and we can
+		// query specific arguments
+		for _, sectionName := range sectionNames {
+			sectionValue := clcl.getArgument(fmt.Sprintf(CHANGELOG_CONFIGURATION_SECTIONS_ARGUMENT_ITEM_REGEXP_FORMAT_STRING, sectionName))
+			sections[sectionName] = *sectionValue
+		}
+
+		// parse the 'substitutions' map
+		substitutions := make(map[string]string)
+		substitutionNames, err := clcl.scanItemNamesInArguments("changelog", CHANGELOG_CONFIGURATION_SUBSTITUTIONS_ARGUMENT_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all section names configured through arguments and we can
+		// query specific arguments
+		for _, substitutionName := range substitutionNames {
+			substitutionValue := clcl.getArgument(fmt.Sprintf(CHANGELOG_CONFIGURATION_SUBSTITUTIONS_ARGUMENT_ITEM_REGEXP_FORMAT_STRING, substitutionName))
+			substitutions[substitutionName] = *substitutionValue
+		}
+
+		// parse the 'badges' map
+		badges := make(map[string]string)
+		badgeNames, err := clcl.scanItemNamesInArguments("changelog", CHANGELOG_CONFIGURATION_BADGES_ARGUMENT_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, sectionName := range badgeNames {
+			badges[sectionName] = *clcl.getArgument(fmt.Sprintf(CHANGELOG_CONFIGURATION_BADGES_ARGUMENT_ITEM_VALUE_FORMAT_STRING, sectionName))
+		}
+
+		// parse the 'emojis' map
+		emojis := make(map[string]string)
+		emojiNames, err := clcl.scanItemNamesInArguments("changelog", CHANGELOG_CONFIGURATION_EMOJIS_ARGUMENT_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, sectionName := range emojiNames {
+			emojis[sectionName] = *clcl.getArgument(fmt.Sprintf(CHANGELOG_CONFIGURATION_EMOJIS_ARGUMENT_ITEM_VALUE_FORMAT_STRING, sectionName))
+		}
+
+		var collapseThreshold *int = nil
+		collapseThresholdString := clcl.getArgument(CHANGELOG_CONFIGURATION_COLLAPSE_THRESHOLD_ARGUMENT_NAME)
+		if collapseThresholdString != nil && "" != *collapseThresholdString {
+			ct, err := strconv.Atoi(*collapseThresholdString)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", CHANGELOG_CONFIGURATION_COLLAPSE_THRESHOLD_ARGUMENT_NAME, *collapseThresholdString), Cause: err}
+			}
+			collapseThreshold = &ct
+		}
+
+		var deduplicateCherryPicks *bool = nil
+		deduplicateCherryPicksString := clcl.getArgument(CHANGELOG_CONFIGURATION_DEDUPLICATE_CHERRY_PICKS_ARGUMENT_NAME)
+		if deduplicateCherryPicksString != nil {
+			// empty string is considered 'false'
+			if "" == *deduplicateCherryPicksString {
+				dcp := false
+				deduplicateCherryPicks = &dcp
+			} else {
+				dcp, err := strconv.ParseBool(*deduplicateCherryPicksString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", CHANGELOG_CONFIGURATION_DEDUPLICATE_CHERRY_PICKS_ARGUMENT_NAME, *deduplicateCherryPicksString), Cause: err}
+				}
+				deduplicateCherryPicks = &dcp
+			}
+		}
+
+		var groupDependencyUpdates *bool = nil
+		groupDependencyUpdatesString := clcl.getArgument(CHANGELOG_CONFIGURATION_GROUP_DEPENDENCY_UPDATES_ARGUMENT_NAME)
+		if groupDependencyUpdatesString != nil {
+			// empty string is considered 'false'
+			if "" == *groupDependencyUpdatesString {
+				gdu := false
+				groupDependencyUpdates = &gdu
+			} else {
+				gdu, err := strconv.ParseBool(*groupDependencyUpdatesString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", CHANGELOG_CONFIGURATION_GROUP_DEPENDENCY_UPDATES_ARGUMENT_NAME, *groupDependencyUpdatesString), Cause: err}
+				}
+				groupDependencyUpdates = &gdu
+			}
+		}
+
+		var mergePreReleases *ent.PreReleaseMerge = nil
+		mergePreReleasesString := clcl.getArgument(CHANGELOG_CONFIGURATION_MERGE_PRE_RELEASES_ARGUMENT_NAME)
+		if mergePreReleasesString != nil {
+			prm, err := ent.ValueOfPreReleaseMerge(*mergePreReleasesString)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", CHANGELOG_CONFIGURATION_MERGE_PRE_RELEASES_ARGUMENT_NAME, *mergePreReleasesString), Cause: err}
+			}
+			mergePreReleases = &prm
+		}
+
+		clcl.changelog, err = ent.NewChangelogConfigurationWith(clcl.getArgument(CHANGELOG_CONFIGURATION_APPEND_ARGUMENT_NAME), &badges, collapseThreshold, deduplicateCherryPicks, &emojis, groupDependencyUpdates, mergePreReleases, clcl.getArgument(CHANGELOG_CONFIGURATION_PATH_ARGUMENT_NAME), &sections, clcl.getArgument(CHANGELOG_CONFIGURATION_TEMPLATE_ARGUMENT_NAME), &substitutions, clcl.getArgument(CHANGELOG_CONFIGURATION_UPGRADE_NOTES_ARGUMENT_NAME))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return clcl.changelog, nil
+}
+
+/*
+Returns the flag that enables computing the files changed by each commit in the release scope
+as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetCommitChanges() (*bool, error) {
+	commitChangesString := clcl.getArgument(COMMIT_CHANGES_ARGUMENT_NAME)
+	if commitChangesString == nil || *commitChangesString == "" {
+		if clcl.hasArgument(COMMIT_CHANGES_ARGUMENT_NAME) {
+			// this is a flag so the value may not be passed
+			return utl.PointerToBoolean(true), nil
+		} else {
+			return nil, nil
+		}
+	}
+	commitChanges, err := strconv.ParseBool(*commitChangesString)
+	return &commitChanges, err
+}
+
+/*
+Returns the commit message convention configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetCommitMessageConventions() (*ent.CommitMessageConventions, error) {
+	if clcl.commitMessageConventions == nil {
+		// parse the 'enabled' items list
+		enabled := clcl.getItemNamesListFromArgument("commitMessageConventions", "enabled", COMMIT_MESSAGE_CONVENTIONS_ENABLED_ARGUMENT_NAME)
+
+		// parse the 'items' map
+		items := make(map[string]*ent.CommitMessageConvention)
+
+		itemNames, err := clcl.scanItemNamesInArguments("commitMessageConventions", COMMIT_MESSAGE_CONVENTIONS_ARGUMENT_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all item names configured through arguments and we can
+		// query specific arguments
+		for _, itemName := range itemNames {
+			expression := clcl.getArgument(fmt.Sprintf(COMMIT_MESSAGE_CONVENTIONS_ARGUMENT_ITEM_EXPRESSION_FORMAT_STRING, itemName))
+			bumpExpressions := clcl.getAttributeMapFromArgument("commitMessageConventions"+"."+itemName+"."+"bumpExpressions", fmt.Sprintf(COMMIT_MESSAGE_CONVENTIONS_ARGUMENT_ITEM_BUMP_EXPRESSIONS_FORMAT_STRING, itemName), nil)
+
+			items[itemName] = ent.NewCommitMessageConventionWith(expression, &bumpExpressions)
+		}
+		enabledPointers := clcl.toSliceOfStringPointers(enabled)
+		clcl.commitMessageConventions, err = ent.NewCommitMessageConventionsWith(&enabledPointers, &items)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return clcl.commitMessageConventions, nil
+}
+
+/*
+Returns the path to the file where the commit lint report is written in the SARIF format as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetCommitLintFile() (*string, error) {
+	return clcl.getArgument(COMMIT_LINT_FILE_ARGUMENT_NAME), nil
+}
+
+/*
+Returns the path to a custom configuration file as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetConfigurationFile() (*string, error) {
+	if clcl.getArgument(CONFIGURATION_FILE_ARGUMENT_SHORT_NAME) != nil {
+		return clcl.getArgument(CONFIGURATION_FILE_ARGUMENT_SHORT_NAME), nil
+	} else {
+		return clcl.getArgument(CONFIGURATION_FILE_ARGUMENT_NAME), nil
+	}
+}
+
+/*
+Returns the directory to use as the working directory as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetDirectory() (*string, error) {
+	if clcl.getArgument(DIRECTORY_ARGUMENT_SHORT_NAME) != nil {
+		return clcl.getArgument(DIRECTORY_ARGUMENT_SHORT_NAME), nil
+	} else {
+		return clcl.getArgument(DIRECTORY_ARGUMENT_NAME), nil
+	}
+}
+
+/*
+Returns the downstream updates configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetDownstreamUpdates() (*ent.DownstreamUpdates, error) {
+	if clcl.downstreamUpdates == nil {
+		// parse the 'enabled' items list
+		enabled := clcl.getItemNamesListFromArgument("downstreamUpdates", "enabled", DOWNSTREAM_UPDATES_ENABLED_ARGUMENT_NAME)
+
+		// parse the 'items' map
+		items := make(map[string]*ent.DownstreamUpdate)
+
+		itemNames, err := clcl.scanItemNamesInArguments("downstreamUpdates", DOWNSTREAM_UPDATES_ARGUMENT_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all item names configured through command line arguments and we can
+		// query specific arguments
+		for _, itemName := range itemNames {
+			branch := clcl.getArgument(fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_BRANCH_FORMAT_STRING, itemName))
+			match := clcl.getArgument(fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_MATCH_FORMAT_STRING, itemName))
+			owner := clcl.getArgument(fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_OWNER_FORMAT_STRING, itemName))
+			path := clcl.getArgument(fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_PATH_FORMAT_STRING, itemName))
+			replace := clcl.getArgument(fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_REPLACE_FORMAT_STRING, itemName))
+			repository := clcl.getArgument(fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_REPOSITORY_FORMAT_STRING, itemName))
+			service := clcl.getArgument(fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_SERVICE_FORMAT_STRING, itemName))
+			title := clcl.getArgument(fmt.Sprintf(DOWNSTREAM_UPDATES_ARGUMENT_ITEM_TITLE_FORMAT_STRING, itemName))
+
+			items[itemName] = ent.NewDownstreamUpdateWith(branch, match, owner, path, replace, repository, service, title)
+		}
+		enabledPointers := clcl.toSliceOfStringPointers(enabled)
+		clcl.downstreamUpdates, err = ent.NewDownstreamUpdatesWith(&enabledPointers, &items)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return clcl.downstreamUpdates, nil
+}
+
+/*
+Returns the value of the dry run flag as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetDryRun() (*bool, error) {
+	dryRunString := clcl.getArgument(DRY_RUN_ARGUMENT_NAME)
+	if dryRunString == nil || *dryRunString == "" {
+		if clcl.hasArgument(DRY_RUN_ARGUMENT_NAME) {
+			// this is a flag so the value may not be passed
+			return utl.PointerToBoolean(true), nil
+		} else {
+			return nil, nil
+		}
+	}
+	dryRun, err := strconv.ParseBool(*dryRunString)
+	return &dryRun, err
+}
+
+/*
+Returns the event emitters configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetEventBus() (*ent.EventBus, error) {
+	if clcl.eventBus == nil {
+		// parse the 'enabled' items list
+		enabled := clcl.getItemNamesListFromArgument("eventBus", "enabled", EVENT_BUS_ENABLED_ARGUMENT_NAME)
+
+		// parse the 'items' map
+		items := make(map[string]*ent.EventEmitter)
+
+		itemNames, err := clcl.scanItemNamesInArguments("eventBus", EVENT_BUS_ARGUMENT_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all item names configured through command line arguments and we can
+		// query specific arguments
+		for _, itemName := range itemNames {
+			endpoint := clcl.getArgument(fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_ENDPOINT_FORMAT_STRING, itemName))
+			events := clcl.getArgument(fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_EVENTS_FORMAT_STRING, itemName))
+			password := clcl.getArgument(fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_PASSWORD_FORMAT_STRING, itemName))
+			region := clcl.getArgument(fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_REGION_FORMAT_STRING, itemName))
+			topic := clcl.getArgument(fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_TOPIC_FORMAT_STRING, itemName))
+			emitterType := clcl.getArgument(fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_TYPE_FORMAT_STRING, itemName))
+			user := clcl.getArgument(fmt.Sprintf(EVENT_BUS_ARGUMENT_ITEM_USER_FORMAT_STRING, itemName))
+
+			items[itemName] = ent.NewEventEmitterWith(endpoint, events, password, region, topic, emitterType, user)
+		}
+		enabledPointers := clcl.toSliceOfStringPointers(enabled)
+		clcl.eventBus, err = ent.NewEventBusWith(&enabledPointers, &items)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return clcl.eventBus, nil
+}
+
+/*
+Returns the Git configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetGit() (*ent.GitConfiguration, error) {
+	if clcl.git == nil {
+		// parse the 'remotes' map
+		remotes := make(map[string]*ent.GitRemoteConfiguration)
+
+		itemNames, err := clcl.scanItemNamesInArguments("git", GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all item names configured through arguments and we can
+		// query specific arguments
+		for _, itemName := range itemNames {
+			var authenticationMethod *ent.AuthenticationMethod = nil
+			authenticationMethodString := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_AUTHENTICATION_METHOD_FORMAT_STRING, itemName))
+			if authenticationMethodString != nil {
+				am, err := ent.ValueOfAuthenticationMethod(*authenticationMethodString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_AUTHENTICATION_METHOD_FORMAT_STRING, itemName), *authenticationMethodString), Cause: err}
+				}
+				authenticationMethod = &am
+			}
+			password := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PASSWORD_FORMAT_STRING, itemName))
+			user := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_USER_FORMAT_STRING, itemName))
+			privateKey := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PRIVATE_KEY_FORMAT_STRING, itemName))
+			passphrase := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PASSPHRASE_FORMAT_STRING, itemName))
+			knownHosts := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_KNOWN_HOSTS_FORMAT_STRING, itemName))
+			appID := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_APP_ID_FORMAT_STRING, itemName))
+			installationID := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_INSTALLATION_ID_FORMAT_STRING, itemName))
+			userVariable := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_USER_VARIABLE_FORMAT_STRING, itemName))
+			passwordVariable := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PASSWORD_VARIABLE_FORMAT_STRING, itemName))
+			pushTags := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PUSH_TAGS_FORMAT_STRING, itemName))
+			var strictHostKeyChecking *bool = nil
+			strictHostKeyCheckingString := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_STRICT_HOST_KEY_CHECKING_FORMAT_STRING, itemName))
+			if strictHostKeyCheckingString != nil && "" != *strictHostKeyCheckingString {
+				shkc, err := strconv.ParseBool(*strictHostKeyCheckingString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_STRICT_HOST_KEY_CHECKING_FORMAT_STRING, itemName), *strictHostKeyCheckingString), Cause: err}
+				}
+				strictHostKeyChecking = &shkc
+			}
+
+			remotes[itemName] = ent.NewGitRemoteConfigurationWith(authenticationMethod, user, password, privateKey, passphrase, knownHosts, strictHostKeyChecking, appID, installationID, userVariable, passwordVariable, pushTags)
+		}
+
+		// parse the 'headers' map
+		headers := make(map[string]string)
+		headerNames, err := clcl.scanItemNamesInArguments("git", GIT_CONFIGURATION_HEADERS_ARGUMENT_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, headerName := range headerNames {
+			headers[headerName] = *clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_HEADERS_ARGUMENT_ITEM_VALUE_FORMAT_STRING, headerName))
 		}
 
-		// parse the 'substitutions' map
-		substitutions := make(map[string]string)
-		substitutionNames, err := clcl.scanItemNamesInArguments("changelog", CHANGELOG_CONFIGURATION_SUBSTITUTIONS_ARGUMENT_ITEM_NAME_REGEX, nil)
-		if err != nil {
-			return nil, err
+		// parse the 'identity' object
+		var identityProvider *ent.Provider
+		identityProviderString := clcl.getArgument(GIT_CONFIGURATION_IDENTITY_PROVIDER_ARGUMENT_NAME)
+		if identityProviderString != nil {
+			provider, err := ent.ValueOfProvider(*identityProviderString)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", GIT_CONFIGURATION_IDENTITY_PROVIDER_ARGUMENT_NAME, *identityProviderString)}
+			}
+			identityProvider = &provider
 		}
-		// now we have the set of all section names configured through arguments and we can
-		// query specific arguments
-		for _, substitutionName := range substitutionNames {
-			substitutionValue := clcl.getArgument(fmt.Sprintf(CHANGELOG_CONFIGURATION_SUBSTITUTIONS_ARGUMENT_ITEM_REGEXP_FORMAT_STRING, substitutionName))
-			substitutions[substitutionName] = *substitutionValue
+		identity := ent.NewGitIdentityConfigurationWith(clcl.getArgument(GIT_CONFIGURATION_IDENTITY_EMAIL_ARGUMENT_NAME), clcl.getArgument(GIT_CONFIGURATION_IDENTITY_NAME_ARGUMENT_NAME), identityProvider, clcl.getArgument(GIT_CONFIGURATION_IDENTITY_EMAIL_VARIABLE_ARGUMENT_NAME), clcl.getArgument(GIT_CONFIGURATION_IDENTITY_NAME_VARIABLE_ARGUMENT_NAME))
+
+		var singleBranch *bool = nil
+		singleBranchString := clcl.getArgument(GIT_CONFIGURATION_SINGLE_BRANCH_ARGUMENT_NAME)
+		if singleBranchString != nil {
+			// empty string is considered 'false'
+			if "" == *singleBranchString {
+				sb := false
+				singleBranch = &sb
+			} else {
+				sb, err := strconv.ParseBool(*singleBranchString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", GIT_CONFIGURATION_SINGLE_BRANCH_ARGUMENT_NAME, *singleBranchString), Cause: err}
+				}
+				singleBranch = &sb
+			}
+		}
+
+		var fetchTags *bool = nil
+		fetchTagsString := clcl.getArgument(GIT_CONFIGURATION_FETCH_TAGS_ARGUMENT_NAME)
+		if fetchTagsString != nil {
+			// empty string is considered 'false'
+			if "" == *fetchTagsString {
+				ft := false
+				fetchTags = &ft
+			} else {
+				ft, err := strconv.ParseBool(*fetchTagsString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", GIT_CONFIGURATION_FETCH_TAGS_ARGUMENT_NAME, *fetchTagsString), Cause: err}
+				}
+				fetchTags = &ft
+			}
+		}
+
+		var unshallow *bool = nil
+		unshallowString := clcl.getArgument(GIT_CONFIGURATION_UNSHALLOW_ARGUMENT_NAME)
+		if unshallowString != nil {
+			// empty string is considered 'false'
+			if "" == *unshallowString {
+				u := false
+				unshallow = &u
+			} else {
+				u, err := strconv.ParseBool(*unshallowString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", GIT_CONFIGURATION_UNSHALLOW_ARGUMENT_NAME, *unshallowString), Cause: err}
+				}
+				unshallow = &u
+			}
+		}
+
+		var mirror *bool = nil
+		mirrorString := clcl.getArgument(GIT_CONFIGURATION_MIRROR_ARGUMENT_NAME)
+		if mirrorString != nil {
+			// empty string is considered 'false'
+			if "" == *mirrorString {
+				m := false
+				mirror = &m
+			} else {
+				m, err := strconv.ParseBool(*mirrorString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", GIT_CONFIGURATION_MIRROR_ARGUMENT_NAME, *mirrorString), Cause: err}
+				}
+				mirror = &m
+			}
+		}
+
+		var noVerify *bool = nil
+		noVerifyString := clcl.getArgument(GIT_CONFIGURATION_NO_VERIFY_ARGUMENT_NAME)
+		if noVerifyString != nil {
+			// empty string is considered 'false'
+			if "" == *noVerifyString {
+				nv := false
+				noVerify = &nv
+			} else {
+				nv, err := strconv.ParseBool(*noVerifyString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", GIT_CONFIGURATION_NO_VERIFY_ARGUMENT_NAME, *noVerifyString), Cause: err}
+				}
+				noVerify = &nv
+			}
+		}
+
+		var stash *bool = nil
+		stashString := clcl.getArgument(GIT_CONFIGURATION_STASH_ARGUMENT_NAME)
+		if stashString != nil {
+			// empty string is considered 'false'
+			if "" == *stashString {
+				s := false
+				stash = &s
+			} else {
+				s, err := strconv.ParseBool(*stashString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", GIT_CONFIGURATION_STASH_ARGUMENT_NAME, *stashString), Cause: err}
+				}
+				stash = &s
+			}
+		}
+
+		var lock *bool = nil
+		lockString := clcl.getArgument(GIT_CONFIGURATION_LOCK_ARGUMENT_NAME)
+		if lockString != nil {
+			// empty string is considered 'false'
+			if "" == *lockString {
+				l := false
+				lock = &l
+			} else {
+				l, err := strconv.ParseBool(*lockString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", GIT_CONFIGURATION_LOCK_ARGUMENT_NAME, *lockString), Cause: err}
+				}
+				lock = &l
+			}
+		}
+
+		var backend *ent.GitBackend = nil
+		backendString := clcl.getArgument(GIT_CONFIGURATION_BACKEND_ARGUMENT_NAME)
+		if backendString != nil {
+			b, err := ent.ValueOfGitBackend(*backendString)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", GIT_CONFIGURATION_BACKEND_ARGUMENT_NAME, *backendString), Cause: err}
+			}
+			backend = &b
 		}
 
-		clcl.changelog, err = ent.NewChangelogConfigurationWith(clcl.getArgument(CHANGELOG_CONFIGURATION_APPEND_ARGUMENT_NAME), clcl.getArgument(CHANGELOG_CONFIGURATION_PATH_ARGUMENT_NAME), &sections, clcl.getArgument(CHANGELOG_CONFIGURATION_TEMPLATE_ARGUMENT_NAME), &substitutions)
+		clcl.git, err = ent.NewGitConfigurationWith(&headers, identity, clcl.getArgument(GIT_CONFIGURATION_PROXY_ARGUMENT_NAME), &remotes, singleBranch, fetchTags, unshallow, mirror, backend, clcl.getArgument(GIT_CONFIGURATION_SERVICE_ARGUMENT_NAME), clcl.getArgument(GIT_CONFIGURATION_TRUSTED_KEYS_ARGUMENT_NAME), clcl.getArgument(GIT_CONFIGURATION_TIMESTAMP_ARGUMENT_NAME), noVerify, clcl.getArgument(GIT_CONFIGURATION_HOOKS_PATH_ARGUMENT_NAME), clcl.getArgument(GIT_CONFIGURATION_IGNORE_SUBMODULES_ARGUMENT_NAME), stash, clcl.getArgument(GIT_CONFIGURATION_TIMEOUT_ARGUMENT_NAME), clcl.getArgument(GIT_CONFIGURATION_RETRIES_ARGUMENT_NAME), clcl.getArgument(GIT_CONFIGURATION_RETRY_DELAY_ARGUMENT_NAME), lock, clcl.getArgument(GIT_CONFIGURATION_LOCK_TIMEOUT_ARGUMENT_NAME))
 		if err != nil {
 			return nil, err
 		}
 	}
-	return clcl.changelog, nil
+	return clcl.git, nil
 }
 
 /*
-Returns the commit message convention configuration section.
+Returns the impact analyzers configuration section.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (clcl *CommandLineConfigurationLayer) GetCommitMessageConventions() (*ent.CommitMessageConventions, error) {
-	if clcl.commitMessageConventions == nil {
+func (clcl *CommandLineConfigurationLayer) GetImpactAnalyzers() (*ent.ImpactAnalyzers, error) {
+	if clcl.impactAnalyzers == nil {
 		// parse the 'enabled' items list
-		enabled := clcl.getItemNamesListFromArgument("commitMessageConventions", "enabled", COMMIT_MESSAGE_CONVENTIONS_ENABLED_ARGUMENT_NAME)
+		enabled := clcl.getItemNamesListFromArgument("impactAnalyzers", "enabled", IMPACT_ANALYZERS_ENABLED_ARGUMENT_NAME)
 
 		// parse the 'items' map
-		items := make(map[string]*ent.CommitMessageConvention)
+		items := make(map[string]*ent.ImpactAnalyzer)
 
-		itemNames, err := clcl.scanItemNamesInArguments("commitMessageConventions", COMMIT_MESSAGE_CONVENTIONS_ARGUMENT_ITEM_NAME_REGEX, nil)
+		itemNames, err := clcl.scanItemNamesInArguments("impactAnalyzers", IMPACT_ANALYZERS_ARGUMENT_ITEM_NAME_REGEX, nil)
 		if err != nil {
 			return nil, err
 		}
-		// now we have the set of all item names configured through arguments and we can
+		// now we have the set of all item names configured through command line arguments and we can
 		// query specific arguments
 		for _, itemName := range itemNames {
-			expression := clcl.getArgument(fmt.Sprintf(COMMIT_MESSAGE_CONVENTIONS_ARGUMENT_ITEM_EXPRESSION_FORMAT_STRING, itemName))
-			bumpExpressions := clcl.getAttributeMapFromArgument("commitMessageConventions"+"."+itemName+"."+"bumpExpressions", fmt.Sprintf(COMMIT_MESSAGE_CONVENTIONS_ARGUMENT_ITEM_BUMP_EXPRESSIONS_FORMAT_STRING, itemName), nil)
+			bump := clcl.getArgument(fmt.Sprintf(IMPACT_ANALYZERS_ARGUMENT_ITEM_BUMP_FORMAT_STRING, itemName))
+			command := clcl.getArgument(fmt.Sprintf(IMPACT_ANALYZERS_ARGUMENT_ITEM_COMMAND_FORMAT_STRING, itemName))
+			expression := clcl.getArgument(fmt.Sprintf(IMPACT_ANALYZERS_ARGUMENT_ITEM_EXPRESSION_FORMAT_STRING, itemName))
+			title := clcl.getArgument(fmt.Sprintf(IMPACT_ANALYZERS_ARGUMENT_ITEM_TITLE_FORMAT_STRING, itemName))
 
-			items[itemName] = ent.NewCommitMessageConventionWith(expression, &bumpExpressions)
+			items[itemName] = ent.NewImpactAnalyzerWith(bump, command, expression, title)
 		}
 		enabledPointers := clcl.toSliceOfStringPointers(enabled)
-		clcl.commitMessageConventions, err = ent.NewCommitMessageConventionsWith(&enabledPointers, &items)
+		clcl.impactAnalyzers, err = ent.NewImpactAnalyzersWith(&enabledPointers, &items)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return clcl.commitMessageConventions, nil
+	return clcl.impactAnalyzers, nil
 }
 
 /*
-Returns the path to a custom configuration file as it's defined by this configuration. A nil value means undefined.
+Returns the initial version defined by this configuration to use when no past version is available in the commit history. A nil value means undefined.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (clcl *CommandLineConfigurationLayer) GetConfigurationFile() (*string, error) {
-	if clcl.getArgument(CONFIGURATION_FILE_ARGUMENT_SHORT_NAME) != nil {
-		return clcl.getArgument(CONFIGURATION_FILE_ARGUMENT_SHORT_NAME), nil
-	} else {
-		return clcl.getArgument(CONFIGURATION_FILE_ARGUMENT_NAME), nil
-	}
+func (clcl *CommandLineConfigurationLayer) GetInitialVersion() (*string, error) {
+	return clcl.getArgument(INITIAL_VERSION_ARGUMENT_NAME), nil
 }
 
 /*
-Returns the directory to use as the working directory as it's defined by this configuration. A nil value means undefined.
+Returns the path to the file where the JUnit XML release report must be saved as it's defined by this configuration. A nil value means undefined.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (clcl *CommandLineConfigurationLayer) GetDirectory() (*string, error) {
-	if clcl.getArgument(DIRECTORY_ARGUMENT_SHORT_NAME) != nil {
-		return clcl.getArgument(DIRECTORY_ARGUMENT_SHORT_NAME), nil
+func (clcl *CommandLineConfigurationLayer) GetJUnitReportFile() (*string, error) {
+	return clcl.getArgument(JUNIT_REPORT_FILE_ARGUMENT_NAME), nil
+}
+
+/*
+Returns the policy applied when there is nothing to release as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetNothingToRelease() (*ent.NothingToReleasePolicy, error) {
+	nothingToReleaseString := clcl.getArgument(NOTHING_TO_RELEASE_ARGUMENT_NAME)
+	if nothingToReleaseString == nil {
+		return nil, nil
 	} else {
-		return clcl.getArgument(DIRECTORY_ARGUMENT_NAME), nil
+		nothingToRelease, err := ent.ValueOfNothingToReleasePolicy(*nothingToReleaseString)
+		return &nothingToRelease, err
 	}
 }
 
 /*
-Returns the value of the dry run flag as it's defined by this configuration. A nil value means undefined.
+Returns the name of the repository the organization configuration is discovered from as it's defined by this configuration. A nil value means undefined.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (clcl *CommandLineConfigurationLayer) GetDryRun() (*bool, error) {
-	dryRunString := clcl.getArgument(DRY_RUN_ARGUMENT_NAME)
-	if dryRunString == nil || *dryRunString == "" {
-		if clcl.hasArgument(DRY_RUN_ARGUMENT_NAME) {
-			// this is a flag so the value may not be passed
-			return utl.PointerToBoolean(true), nil
-		} else {
-			return nil, nil
-		}
-	}
-	dryRun, err := strconv.ParseBool(*dryRunString)
-	return &dryRun, err
+func (clcl *CommandLineConfigurationLayer) GetOrganizationConfigurationRepository() (*string, error) {
+	return clcl.getArgument(ORGANIZATION_CONFIGURATION_REPOSITORY_ARGUMENT_NAME), nil
 }
 
 /*
-Returns the Git configuration section.
+Returns the name of the service used to discover the organization configuration as it's defined by this configuration. A nil value means undefined.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (clcl *CommandLineConfigurationLayer) GetGit() (*ent.GitConfiguration, error) {
-	if clcl.git == nil {
-		// parse the 'remotes' map
-		remotes := make(map[string]*ent.GitRemoteConfiguration)
+func (clcl *CommandLineConfigurationLayer) GetOrganizationConfigurationService() (*string, error) {
+	return clcl.getArgument(ORGANIZATION_CONFIGURATION_SERVICE_ARGUMENT_NAME), nil
+}
 
-		itemNames, err := clcl.scanItemNamesInArguments("git", GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_NAME_REGEX, nil)
+/*
+Returns the path rules configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetPathRules() (*ent.PathRules, error) {
+	if clcl.pathRules == nil {
+		// parse the 'enabled' items list
+		enabled := clcl.getItemNamesListFromArgument("pathRules", "enabled", PATH_RULES_ENABLED_ARGUMENT_NAME)
+
+		// parse the 'items' map
+		items := make(map[string]*ent.PathRule)
+
+		itemNames, err := clcl.scanItemNamesInArguments("pathRules", PATH_RULES_ARGUMENT_ITEM_NAME_REGEX, nil)
 		if err != nil {
 			return nil, err
 		}
-		// now we have the set of all item names configured through arguments and we can
+		// now we have the set of all item names configured through command line arguments and we can
 		// query specific arguments
 		for _, itemName := range itemNames {
-			var authenticationMethod *ent.AuthenticationMethod = nil
-			authenticationMethodString := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_AUTHENTICATION_METHOD_FORMAT_STRING, itemName))
-			if authenticationMethodString != nil {
-				am, err := ent.ValueOfAuthenticationMethod(*authenticationMethodString)
+			bump := clcl.getArgument(fmt.Sprintf(PATH_RULES_ARGUMENT_ITEM_BUMP_FORMAT_STRING, itemName))
+			pathsList := clcl.getArgument(fmt.Sprintf(PATH_RULES_ARGUMENT_ITEM_PATHS_FORMAT_STRING, itemName))
+			var paths *[]*string
+			if pathsList != nil {
+				pathsSlice := strings.Split(*pathsList, ",")
+				var pathsArray []*string
+				for _, path := range pathsSlice {
+					pathCopy := path
+					pathsArray = append(pathsArray, &pathCopy)
+				}
+				paths = &pathsArray
+			}
+			var significant *bool = nil
+			significantString := clcl.getArgument(fmt.Sprintf(PATH_RULES_ARGUMENT_ITEM_SIGNIFICANT_FORMAT_STRING, itemName))
+			if significantString != nil && "" != *significantString {
+				s, err := strconv.ParseBool(*significantString)
 				if err != nil {
-					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_AUTHENTICATION_METHOD_FORMAT_STRING, itemName), *authenticationMethodString), Cause: err}
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", fmt.Sprintf(PATH_RULES_ARGUMENT_ITEM_SIGNIFICANT_FORMAT_STRING, itemName), *significantString), Cause: err}
 				}
-				authenticationMethod = &am
+				significant = &s
 			}
-			password := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PASSWORD_FORMAT_STRING, itemName))
-			user := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_USER_FORMAT_STRING, itemName))
-			privateKey := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PRIVATE_KEY_FORMAT_STRING, itemName))
-			passphrase := clcl.getArgument(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ARGUMENT_ITEM_PASSPHRASE_FORMAT_STRING, itemName))
 
-			remotes[itemName] = ent.NewGitRemoteConfigurationWith(authenticationMethod, user, password, privateKey, passphrase)
+			items[itemName] = ent.NewPathRuleWith(bump, paths, significant)
 		}
-
-		clcl.git, err = ent.NewGitConfigurationWith(&remotes)
+		enabledPointers := clcl.toSliceOfStringPointers(enabled)
+		clcl.pathRules, err = ent.NewPathRulesWith(&enabledPointers, &items)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return clcl.git, nil
+	return clcl.pathRules, nil
 }
 
 /*
-Returns the initial version defined by this configuration to use when no past version is available in the commit history. A nil value means undefined.
+Returns the comma separated list of pre-release identifiers, from the lowest to the highest precedence, used to compare pre-release versions as it's defined by this configuration. A nil value means undefined.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (clcl *CommandLineConfigurationLayer) GetInitialVersion() (*string, error) {
-	return clcl.getArgument(INITIAL_VERSION_ARGUMENT_NAME), nil
+func (clcl *CommandLineConfigurationLayer) GetPrereleaseOrder() (*string, error) {
+	return clcl.getArgument(PRERELEASE_ORDER_ARGUMENT_NAME), nil
 }
 
 /*
@@ -1121,14 +2302,40 @@ func (clcl *CommandLineConfigurationLayer) GetReleaseAssets() (*map[string]*ent.
 			description := clcl.getArgument(fmt.Sprintf(RELEASE_ASSETS_ARGUMENT_ITEM_DESCRIPTION_FORMAT_STRING, itemName))
 			path := clcl.getArgument(fmt.Sprintf(RELEASE_ASSETS_ARGUMENT_ITEM_PATH_FORMAT_STRING, itemName))
 			attachmentType := clcl.getArgument(fmt.Sprintf(RELEASE_ASSETS_ARGUMENT_ITEM_TYPE_FORMAT_STRING, itemName))
+			archive := clcl.getArgument(fmt.Sprintf(RELEASE_ASSETS_ARGUMENT_ITEM_ARCHIVE_FORMAT_STRING, itemName))
+			platforms := clcl.getArgument(fmt.Sprintf(RELEASE_ASSETS_ARGUMENT_ITEM_PLATFORMS_FORMAT_STRING, itemName))
 
 			ras[itemName] = ent.NewAttachmentWith(fileName, description, path, attachmentType)
+			ras[itemName].SetArchive(archive)
+			ras[itemName].SetPlatforms(platforms)
 		}
 		clcl.releaseAssets = &ras
 	}
 	return clcl.releaseAssets, nil
 }
 
+/*
+Returns the flag that enables coercion of release names that look like semantic versions, but are not, into legal semantic versions
+as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetReleaseCoercion() (*bool, error) {
+	releaseCoercionString := clcl.getArgument(RELEASE_COERCION_ARGUMENT_NAME)
+	if releaseCoercionString == nil || *releaseCoercionString == "" {
+		if clcl.hasArgument(RELEASE_COERCION_ARGUMENT_NAME) {
+			// this is a flag so the value may not be passed
+			return utl.PointerToBoolean(true), nil
+		} else {
+			return nil, nil
+		}
+	}
+	releaseCoercion, err := strconv.ParseBool(*releaseCoercionString)
+	return &releaseCoercion, err
+}
+
 /*
 Returns the flag that enables tolerance in reading release names with arbitrary prefixes or extra non critical characters
 as it's defined by this configuration. A nil value means undefined.
@@ -1180,6 +2387,9 @@ func (clcl *CommandLineConfigurationLayer) GetReleaseTypes() (*ent.ReleaseTypes,
 		// parse the 'remoteRepositories' items list
 		remoteRepositories := clcl.getItemNamesListFromArgument("releaseTypes", "remoteRepositories", RELEASE_TYPES_REMOTE_REPOSITORIES_ARGUMENT_NAME)
 
+		// parse the 'yanked' items list
+		yanked := clcl.getItemNamesListFromArgument("releaseTypes", "yanked", RELEASE_TYPES_YANKED_ARGUMENT_NAME)
+
 		// parse the 'items' map
 		items := make(map[string]*ent.ReleaseType)
 
@@ -1219,13 +2429,18 @@ func (clcl *CommandLineConfigurationLayer) GetReleaseTypes() (*ent.ReleaseTypes,
 			} else {
 				assets = nil
 			}
+			buildMetadata := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_BUILD_METADATA_FORMAT_STRING, itemName))
+			buildMetadataTargets := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_BUILD_METADATA_TARGETS_FORMAT_STRING, itemName))
 			collapseVersionQualifier := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_COLLAPSED_VERSION_QUALIFIER_FORMAT_STRING, itemName))
 			description := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_DESCRIPTION_FORMAT_STRING, itemName))
 			filterTags := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_FILTER_TAGS_FORMAT_STRING, itemName))
+			followAllParents := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_FOLLOW_ALL_PARENTS_FORMAT_STRING, itemName))
+			gatePolicy := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GATE_POLICY_FORMAT_STRING, itemName))
 			gitCommit := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GIT_COMMIT_FORMAT_STRING, itemName))
 			gitCommitMessage := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GIT_COMMIT_MESSAGE_FORMAT_STRING, itemName))
 			gitPush := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GIT_PUSH_FORMAT_STRING, itemName))
 			gitPushForce := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GIT_PUSH_FORCE_FORMAT_STRING, itemName))
+			gitPushForceWithLease := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GIT_PUSH_FORCE_WITH_LEASE_FORMAT_STRING, itemName))
 			gitTag := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GIT_TAG_FORMAT_STRING, itemName))
 			gitTagForce := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GIT_TAG_FORCE_FORMAT_STRING, itemName))
 			gitTagMessage := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_GIT_TAG_MESSAGE_FORMAT_STRING, itemName))
@@ -1246,8 +2461,26 @@ func (clcl *CommandLineConfigurationLayer) GetReleaseTypes() (*ent.ReleaseTypes,
 			if err != nil {
 				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value", fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_IDENTIFIERS_FORMAT_STRING, itemName)), Cause: err}
 			}
+			ignoreCherryPicks := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_IGNORE_CHERRY_PICKS_FORMAT_STRING, itemName))
+			ignoreMerges := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_IGNORE_MERGES_FORMAT_STRING, itemName))
 			matchBranches := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_BRANCHES_FORMAT_STRING, itemName))
+			bumpLabels := clcl.getAttributeMapFromArgument("releaseTypes"+"."+itemName+"."+"bumpLabels", fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_BUMP_LABELS_FORMAT_STRING, itemName), nil)
+			matchBranchMetadata := clcl.getAttributeMapFromArgument("releaseTypes"+"."+itemName+"."+"matchBranchMetadata", fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_BRANCH_METADATA_FORMAT_STRING, itemName), nil)
+			matchChangedPaths := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_CHANGED_PATHS_FORMAT_STRING, itemName))
+			matchDaysOfWeek := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_DAYS_OF_WEEK_FORMAT_STRING, itemName))
 			matchEnvironmentVariables := clcl.getAttributeMapFromArgument("releaseTypes"+"."+itemName+"."+"matchEnvironmentVariables", fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_ENVIRONMENT_VARIABLES_FORMAT_STRING, itemName), nil)
+			matchExpression := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_EXPRESSION_FORMAT_STRING, itemName))
+			var matchMode *ent.MatchMode = nil
+			matchModeString := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_MODE_FORMAT_STRING, itemName))
+			if matchModeString != nil {
+				mm, err := ent.ValueOfMatchMode(*matchModeString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_MODE_FORMAT_STRING, itemName), *matchModeString), Cause: err}
+				}
+				matchMode = &mm
+			}
+			matchPolicy := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_POLICY_FORMAT_STRING, itemName))
+			matchTags := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_TAGS_FORMAT_STRING, itemName))
 			var matchWorkspaceStatus *ent.WorkspaceStatus = nil
 			matchWorkspaceStatusString := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_MATCH_WORKSPACE_STATUS_FORMAT_STRING, itemName))
 			if matchWorkspaceStatusString != nil {
@@ -1258,9 +2491,29 @@ func (clcl *CommandLineConfigurationLayer) GetReleaseTypes() (*ent.ReleaseTypes,
 				matchWorkspaceStatus = &mws
 			}
 			publish := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_FORMAT_STRING, itemName))
+			publishApprovalEnvironment := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_APPROVAL_ENVIRONMENT_FORMAT_STRING, itemName))
+			publishApprovalPollingInterval := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_APPROVAL_POLLING_INTERVAL_FORMAT_STRING, itemName))
+			publishApprovalTimeout := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_APPROVAL_TIMEOUT_FORMAT_STRING, itemName))
 			publishDraft := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_DRAFT_FORMAT_STRING, itemName))
 			publishPreRelease := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PUBLISH_PRE_RELEASE_FORMAT_STRING, itemName))
+			pullRequestMessages := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_PULL_REQUEST_MESSAGES_FORMAT_STRING, itemName))
+			releaseMetadataFile := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_RELEASE_METADATA_FILE_FORMAT_STRING, itemName))
 			releaseName := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_RELEASE_NAME_FORMAT_STRING, itemName))
+			requiredEnvironmentVariablesList := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_REQUIRED_ENVIRONMENT_VARIABLES_FORMAT_STRING, itemName))
+			var requiredEnvironmentVariables *[]*string
+			if requiredEnvironmentVariablesList != nil {
+				requiredEnvironmentVariablesSlice := strings.Split(*requiredEnvironmentVariablesList, ",")
+				var requiredEnvironmentVariablesArray []*string
+				for _, variableName := range requiredEnvironmentVariablesSlice {
+					variableNameCopy := strings.TrimSpace(variableName)
+					requiredEnvironmentVariablesArray = append(requiredEnvironmentVariablesArray, &variableNameCopy)
+				}
+				requiredEnvironmentVariables = &requiredEnvironmentVariablesArray
+			} else {
+				requiredEnvironmentVariables = nil
+			}
+			requireSignedCommits := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_REQUIRE_SIGNED_COMMITS_FORMAT_STRING, itemName))
+			versionConstraint := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_VERSION_CONSTRAINT_FORMAT_STRING, itemName))
 			versionRange := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_VERSION_RANGE_FORMAT_STRING, itemName))
 			var versionRangeFromBranchName *bool = nil
 			versionRangeFromBranchNameString := clcl.getArgument(fmt.Sprintf(RELEASE_TYPES_ARGUMENT_ITEM_VERSION_RANGE_FROM_BRANCH_NAME_FORMAT_STRING, itemName))
@@ -1272,21 +2525,58 @@ func (clcl *CommandLineConfigurationLayer) GetReleaseTypes() (*ent.ReleaseTypes,
 				versionRangeFromBranchName = &vrfbn
 			}
 
-			items[itemName] = ent.NewReleaseTypeWith(assets, collapseVersions, collapseVersionQualifier, description, filterTags, gitCommit, gitCommitMessage, gitPush, gitPushForce, gitTag, gitTagForce, gitTagMessage, gitTagNames, &identifiers, matchBranches, &matchEnvironmentVariables, matchWorkspaceStatus, publish, publishDraft, publishPreRelease, releaseName, versionRange, versionRangeFromBranchName)
+			releaseType := ent.NewReleaseTypeWith(assets, collapseVersions, collapseVersionQualifier, description, filterTags, gitCommit, gitCommitMessage, gitPush, gitPushForce, gitTag, gitTagForce, gitTagMessage, gitTagNames, &identifiers, matchBranches, &matchEnvironmentVariables, matchWorkspaceStatus, publish, publishDraft, publishPreRelease, releaseName, versionRange, versionRangeFromBranchName)
+			releaseType.SetBuildMetadata(buildMetadata)
+			releaseType.SetBuildMetadataTargets(buildMetadataTargets)
+			releaseType.SetBumpLabels(&bumpLabels)
+			releaseType.SetFollowAllParents(followAllParents)
+			releaseType.SetGatePolicy(gatePolicy)
+			releaseType.SetGitPushForceWithLease(gitPushForceWithLease)
+			releaseType.SetIgnoreCherryPicks(ignoreCherryPicks)
+			releaseType.SetIgnoreMerges(ignoreMerges)
+			releaseType.SetMatchBranchMetadata(&matchBranchMetadata)
+			releaseType.SetMatchChangedPaths(matchChangedPaths)
+			releaseType.SetMatchDaysOfWeek(matchDaysOfWeek)
+			releaseType.SetMatchExpression(matchExpression)
+			releaseType.SetMatchMode(matchMode)
+			releaseType.SetMatchPolicy(matchPolicy)
+			releaseType.SetMatchTags(matchTags)
+			releaseType.SetPublishApprovalEnvironment(publishApprovalEnvironment)
+			releaseType.SetPublishApprovalPollingInterval(publishApprovalPollingInterval)
+			releaseType.SetPublishApprovalTimeout(publishApprovalTimeout)
+			releaseType.SetPullRequestMessages(pullRequestMessages)
+			releaseType.SetReleaseMetadataFile(releaseMetadataFile)
+			releaseType.SetRequiredEnvironmentVariables(requiredEnvironmentVariables)
+			releaseType.SetRequireSignedCommits(requireSignedCommits)
+			releaseType.SetVersionConstraint(versionConstraint)
+			items[itemName] = releaseType
 		}
 
 		enabledPointers := clcl.toSliceOfStringPointers(enabled)
 		publicationServicesPointers := clcl.toSliceOfStringPointers(publicationServices)
 		remoteRepositoriesPointers := clcl.toSliceOfStringPointers(remoteRepositories)
+		yankedPointers := clcl.toSliceOfStringPointers(yanked)
 		clcl.releaseTypes, err = ent.NewReleaseTypesWith(&enabledPointers, &publicationServicesPointers, &remoteRepositoriesPointers, &items)
 		if err != nil {
 			return nil, err
 		}
+		clcl.releaseTypes.SetYanked(&yankedPointers)
 	}
 
 	return clcl.releaseTypes, nil
 }
 
+/*
+Returns the path to the file where the HTML release report must be saved as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetReportFile() (*string, error) {
+	return clcl.getArgument(REPORT_FILE_ARGUMENT_NAME), nil
+}
+
 /*
 Returns the value of the resume flag as it's defined by this configuration. A nil value means undefined.
 
@@ -1308,6 +2598,18 @@ func (clcl *CommandLineConfigurationLayer) GetResume() (*bool, error) {
 	return &resume, err
 }
 
+/*
+Returns the name of the core identifier (major, minor or patch) whose significance bumps the fourth number
+of four segment versions as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetRevisionBump() (*string, error) {
+	return clcl.getArgument(REVISION_BUMP_ARGUMENT_NAME), nil
+}
+
 /*
 Returns the versioning scheme as it's defined by this configuration. A nil value means undefined.
 
@@ -1320,8 +2622,25 @@ func (clcl *CommandLineConfigurationLayer) GetScheme() (*ver.Scheme, error) {
 	if schemeString == nil {
 		return nil, nil
 	}
-	scheme, err := ver.ValueOfScheme("SEMVER")
-	return &scheme, err
+	scheme, err := ver.ValueOfScheme(*schemeString)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", SCHEME_ARGUMENT_NAME, *schemeString), Cause: err}
+	}
+	return &scheme, nil
+}
+
+/*
+Returns the server configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetServer() (*ent.ServerConfiguration, error) {
+	if clcl.server == nil {
+		clcl.server = ent.NewServerConfigurationWith(clcl.getArgument(SERVER_CONFIGURATION_ADDRESS_ARGUMENT_NAME), clcl.getArgument(SERVER_CONFIGURATION_BRANCHES_ARGUMENT_NAME), clcl.getArgument(SERVER_CONFIGURATION_COMMAND_ARGUMENT_NAME), clcl.getArgument(SERVER_CONFIGURATION_SECRET_ARGUMENT_NAME))
+	}
+	return clcl.server, nil
 }
 
 /*
@@ -1382,6 +2701,38 @@ func (clcl *CommandLineConfigurationLayer) GetStateFile() (*string, error) {
 	return clcl.getArgument(STATE_FILE_ARGUMENT_NAME), nil
 }
 
+/*
+Returns the secret key used to sign the state file and verify it when resuming as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetStateFileSigningKey() (*string, error) {
+	return clcl.getArgument(STATE_FILE_SIGNING_KEY_ARGUMENT_NAME), nil
+}
+
+/*
+Returns the value of the strict exit codes flag as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetStrictExitCodes() (*bool, error) {
+	strictExitCodesString := clcl.getArgument(STRICT_EXIT_CODES_ARGUMENT_NAME)
+	if strictExitCodesString == nil || *strictExitCodesString == "" {
+		if clcl.hasArgument(STRICT_EXIT_CODES_ARGUMENT_NAME) {
+			// this is a flag so the value may not be passed
+			return utl.PointerToBoolean(true), nil
+		} else {
+			return nil, nil
+		}
+	}
+	strictExitCodes, err := strconv.ParseBool(*strictExitCodesString)
+	return &strictExitCodes, err
+}
+
 /*
 Returns the substitutions configuration section.
 
@@ -1451,6 +2802,45 @@ func (clcl *CommandLineConfigurationLayer) GetSummaryFile() (*string, error) {
 	return clcl.getArgument(SUMMARY_FILE_ARGUMENT_NAME), nil
 }
 
+/*
+Returns the URL of the endpoint where the telemetry report must be uploaded as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetTelemetryEndpoint() (*string, error) {
+	return clcl.getArgument(TELEMETRY_ENDPOINT_ARGUMENT_NAME), nil
+}
+
+/*
+Returns the path to the file where the telemetry report must be saved as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetTelemetryFile() (*string, error) {
+	return clcl.getArgument(TELEMETRY_FILE_ARGUMENT_NAME), nil
+}
+
+/*
+Returns the source of the release timestamp as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetTimestampSource() (*ent.TimestampSource, error) {
+	timestampSourceString := clcl.getArgument(TIMESTAMP_SOURCE_ARGUMENT_NAME)
+	if timestampSourceString == nil {
+		return nil, nil
+	} else {
+		timestampSource, err := ent.ValueOfTimestampSource(*timestampSourceString)
+		return &timestampSource, err
+	}
+}
+
 /*
 Returns the logging verbosity level as it's defined by this configuration. A nil value means undefined.
 
@@ -1496,3 +2886,14 @@ func (clcl *CommandLineConfigurationLayer) GetVersion() (*string, error) {
 		return clcl.getArgument(VERSION_ARGUMENT_NAME), nil
 	}
 }
+
+/*
+Returns the name of the service used to read and store released versions as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (clcl *CommandLineConfigurationLayer) GetVersionStorageService() (*string, error) {
+	return clcl.getArgument(VERSION_STORAGE_SERVICE_ARGUMENT_NAME), nil
+}
@@ -70,6 +70,57 @@ func TestCommandLineConfigurationLayerGetBump(t *testing.T) {
 	assert.Equal(t, "a", *bump)
 }
 
+func TestCommandLineConfigurationLayerGetAnalyticsFile(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	analyticsFile, err := commandLineConfigurationLayer.GetAnalyticsFile()
+	assert.NoError(t, err)
+	assert.Nil(t, analyticsFile)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--analytics-file=analytics.json",
+	})
+	analyticsFile, err = commandLineConfigurationLayer.GetAnalyticsFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "analytics.json", *analyticsFile)
+}
+
+func TestCommandLineConfigurationLayerGetBadgesDirectory(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	badgesDirectory, err := commandLineConfigurationLayer.GetBadgesDirectory()
+	assert.NoError(t, err)
+	assert.Nil(t, badgesDirectory)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--badges-directory=badges",
+	})
+	badgesDirectory, err = commandLineConfigurationLayer.GetBadgesDirectory()
+	assert.NoError(t, err)
+	assert.Equal(t, "badges", *badgesDirectory)
+}
+
+func TestCommandLineConfigurationLayerGetBranchMetadataExpression(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	branchMetadataExpression, err := commandLineConfigurationLayer.GetBranchMetadataExpression()
+	assert.NoError(t, err)
+	assert.Nil(t, branchMetadataExpression)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--branch-metadata-expression=^feature/(?<scope>[a-z]+)-.*$",
+	})
+	branchMetadataExpression, err = commandLineConfigurationLayer.GetBranchMetadataExpression()
+	assert.NoError(t, err)
+	assert.Equal(t, "^feature/(?<scope>[a-z]+)-.*$", *branchMetadataExpression)
+}
+
 func TestCommandLineConfigurationLayerGetChangelog(t *testing.T) {
 	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
 
@@ -77,10 +128,17 @@ func TestCommandLineConfigurationLayerGetChangelog(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, changelog)
 	assert.Nil(t, changelog.GetAppend())
+	assert.Equal(t, 0, len(*changelog.GetBadges()))
+	assert.Nil(t, changelog.GetCollapseThreshold())
+	assert.Nil(t, changelog.GetDeduplicateCherryPicks())
+	assert.Nil(t, changelog.GetGroupDependencyUpdates())
+	assert.Nil(t, changelog.GetMergePreReleases())
+	assert.Equal(t, 0, len(*changelog.GetEmojis()))
 	assert.Nil(t, changelog.GetPath())
 	assert.Equal(t, 0, len(*changelog.GetSections()))
 	assert.Equal(t, 0, len(*changelog.GetSubstitutions()))
 	assert.Nil(t, changelog.GetTemplate())
+	assert.Nil(t, changelog.GetUpgradeNotes())
 
 	// get a new instance or a stale set of arguments is still in the configuration layer
 	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
@@ -93,26 +151,43 @@ func TestCommandLineConfigurationLayerGetChangelog(t *testing.T) {
 	assert.NotNil(t, changelog)
 
 	assert.Nil(t, changelog.GetAppend())
+	assert.Nil(t, changelog.GetDeduplicateCherryPicks())
+	assert.Nil(t, changelog.GetGroupDependencyUpdates())
+	assert.Nil(t, changelog.GetMergePreReleases())
 	assert.Equal(t, "CHANGELOG.md", *changelog.GetPath())
 	assert.Equal(t, 0, len(*changelog.GetSections()))
 	assert.Equal(t, 0, len(*changelog.GetSubstitutions()))
 	assert.Nil(t, changelog.GetTemplate())
+	assert.Nil(t, changelog.GetUpgradeNotes())
 
 	// get a new instance or a stale set of arguments is still in the configuration layer
 	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
 	commandLineConfigurationLayer.withArguments([]string{
 		"--changelog-append=head",
+		"--changelog-badges-Section1=badge1",
+		"--changelog-collapse-threshold=10",
+		"--changelog-deduplicate-cherry-picks=true",
+		"--changelog-emojis-Section1=:sparkles:",
+		"--changelog-group-dependency-updates=true",
+		"--changelog-merge-pre-releases=NESTED",
 		"--changelog-path=CHANGELOG.md",
 		"--changelog-sections-Section1=regex1",
 		"--changelog-sections-Section2=regex2",
 		"--changelog-substitutions-Expr1=string1",
 		"--changelog-template=changelog.tpl",
+		"--changelog-upgrade-notes=UPGRADE.md",
 	})
 
 	changelog, err = commandLineConfigurationLayer.GetChangelog()
 	assert.NoError(t, err)
 	assert.NotNil(t, changelog)
 	assert.Equal(t, "head", *changelog.GetAppend())
+	assert.Equal(t, "badge1", (*changelog.GetBadges())["Section1"])
+	assert.Equal(t, 10, *changelog.GetCollapseThreshold())
+	assert.Equal(t, true, *changelog.GetDeduplicateCherryPicks())
+	assert.Equal(t, ":sparkles:", (*changelog.GetEmojis())["Section1"])
+	assert.Equal(t, true, *changelog.GetGroupDependencyUpdates())
+	assert.Equal(t, ent.NESTED, *changelog.GetMergePreReleases())
 	assert.Equal(t, "CHANGELOG.md", *changelog.GetPath())
 
 	assert.Equal(t, 2, len(*changelog.GetSections()))
@@ -124,6 +199,46 @@ func TestCommandLineConfigurationLayerGetChangelog(t *testing.T) {
 	substitutions := *changelog.GetSubstitutions()
 	assert.Equal(t, "string1", substitutions["Expr1"])
 	assert.Equal(t, "changelog.tpl", *changelog.GetTemplate())
+	assert.Equal(t, "UPGRADE.md", *changelog.GetUpgradeNotes())
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--changelog-collapse-threshold=ten",
+	})
+
+	_, err = commandLineConfigurationLayer.GetChangelog()
+	assert.Error(t, err)
+}
+
+func TestCommandLineConfigurationLayerGetCommitChanges(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	commitChanges, err := commandLineConfigurationLayer.GetCommitChanges()
+	assert.NoError(t, err)
+	assert.Nil(t, commitChanges)
+
+	// Test the name and value version
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--commit-changes=false",
+	})
+
+	commitChanges, err = commandLineConfigurationLayer.GetCommitChanges()
+	assert.NoError(t, err)
+	assert.Equal(t, false, *commitChanges)
+
+	// Test the flag version
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--commit-changes",
+	})
+
+	commitChanges, err = commandLineConfigurationLayer.GetCommitChanges()
+	assert.NoError(t, err)
+	assert.Equal(t, true, *commitChanges)
 }
 
 func TestCommandLineConfigurationLayerGetCommitMessageConventions(t *testing.T) {
@@ -205,6 +320,23 @@ func TestCommandLineConfigurationLayerGetCommitMessageConventions(t *testing.T)
 	assert.Equal(t, "gamma1", bumpExpressions["gamma"])
 }
 
+func TestCommandLineConfigurationLayerGetCommitLintFile(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	commitLintFile, err := commandLineConfigurationLayer.GetCommitLintFile()
+	assert.NoError(t, err)
+	assert.Nil(t, commitLintFile)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--commit-lint-file=commit-lint.sarif",
+	})
+	commitLintFile, err = commandLineConfigurationLayer.GetCommitLintFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "commit-lint.sarif", *commitLintFile)
+}
+
 func TestCommandLineConfigurationLayerGetConfigurationFile(t *testing.T) {
 	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
 
@@ -297,6 +429,75 @@ func TestCommandLineConfigurationLayerGetDirectory(t *testing.T) {
 	defer os.RemoveAll(dir2) // clean up
 }
 
+func TestCommandLineConfigurationLayerGetDownstreamUpdates(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	downstreamUpdates, err := commandLineConfigurationLayer.GetDownstreamUpdates()
+	assert.NoError(t, err)
+	assert.NotNil(t, downstreamUpdates)
+	assert.Equal(t, 0, len(*downstreamUpdates.GetEnabled()))
+	assert.Equal(t, 0, len(*downstreamUpdates.GetItems()))
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--downstream-updates-enabled=one,two",
+	})
+
+	downstreamUpdates, err = commandLineConfigurationLayer.GetDownstreamUpdates()
+	assert.NoError(t, err)
+	assert.NotNil(t, downstreamUpdates)
+
+	enabled := *downstreamUpdates.GetEnabled()
+	items := *downstreamUpdates.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "one")
+	assert.Equal(t, *enabled[1], "two")
+	assert.Equal(t, 0, len(items))
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--downstream-updates-enabled=one,two",
+		"--downstream-updates-one-branch=main",
+		"--downstream-updates-one-match=example.com/mod v[0-9.]+",
+		"--downstream-updates-one-owner=acme",
+		"--downstream-updates-one-path=go.mod",
+		"--downstream-updates-one-replace=example.com/mod v{{version}}",
+		"--downstream-updates-one-repository=app",
+		"--downstream-updates-one-service=github",
+		"--downstream-updates-one-title=Bump to {{version}}",
+		"--downstream-updates-two-branch=main",
+	})
+
+	downstreamUpdates, err = commandLineConfigurationLayer.GetDownstreamUpdates()
+	assert.NoError(t, err)
+	assert.NotNil(t, downstreamUpdates)
+
+	enabled = *downstreamUpdates.GetEnabled()
+	items = *downstreamUpdates.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "one")
+	assert.Equal(t, *enabled[1], "two")
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, "main", *items["one"].GetBranch())
+	assert.Equal(t, "example.com/mod v[0-9.]+", *items["one"].GetMatch())
+	assert.Equal(t, "acme", *items["one"].GetOwner())
+	assert.Equal(t, "go.mod", *items["one"].GetPath())
+	assert.Equal(t, "example.com/mod v{{version}}", *items["one"].GetReplace())
+	assert.Equal(t, "app", *items["one"].GetRepository())
+	assert.Equal(t, "github", *items["one"].GetService())
+	assert.Equal(t, "Bump to {{version}}", *items["one"].GetTitle())
+	assert.Equal(t, "main", *items["two"].GetBranch())
+	assert.Nil(t, items["two"].GetMatch())
+	assert.Nil(t, items["two"].GetOwner())
+	assert.Nil(t, items["two"].GetPath())
+	assert.Nil(t, items["two"].GetReplace())
+	assert.Nil(t, items["two"].GetRepository())
+	assert.Nil(t, items["two"].GetService())
+	assert.Nil(t, items["two"].GetTitle())
+}
+
 func TestCommandLineConfigurationLayerGetDryRun(t *testing.T) {
 	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
 
@@ -327,17 +528,130 @@ func TestCommandLineConfigurationLayerGetDryRun(t *testing.T) {
 	assert.Equal(t, true, *dryRun)
 }
 
+func TestCommandLineConfigurationLayerGetEventBus(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	eventBus, err := commandLineConfigurationLayer.GetEventBus()
+	assert.NoError(t, err)
+	assert.NotNil(t, eventBus)
+	assert.Equal(t, 0, len(*eventBus.GetEnabled()))
+	assert.Equal(t, 0, len(*eventBus.GetItems()))
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--event-bus-enabled=one,two",
+	})
+
+	eventBus, err = commandLineConfigurationLayer.GetEventBus()
+	assert.NoError(t, err)
+	assert.NotNil(t, eventBus)
+
+	enabled := *eventBus.GetEnabled()
+	items := *eventBus.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "one")
+	assert.Equal(t, *enabled[1], "two")
+	assert.Equal(t, 0, len(items))
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--event-bus-enabled=one,two",
+		"--event-bus-one-endpoint=https://kafka.example.com",
+		"--event-bus-one-events=PUBLISHED,FAILED",
+		"--event-bus-one-password=secret",
+		"--event-bus-one-region=eu-west-1",
+		"--event-bus-one-topic=releases",
+		"--event-bus-one-type=KAFKA",
+		"--event-bus-one-user=jdoe",
+		"--event-bus-two-endpoint=https://kafka.example.com",
+	})
+
+	eventBus, err = commandLineConfigurationLayer.GetEventBus()
+	assert.NoError(t, err)
+	assert.NotNil(t, eventBus)
+
+	enabled = *eventBus.GetEnabled()
+	items = *eventBus.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "one")
+	assert.Equal(t, *enabled[1], "two")
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, "https://kafka.example.com", *items["one"].GetEndpoint())
+	assert.Equal(t, "PUBLISHED,FAILED", *items["one"].GetEvents())
+	assert.Equal(t, "secret", *items["one"].GetPassword())
+	assert.Equal(t, "eu-west-1", *items["one"].GetRegion())
+	assert.Equal(t, "releases", *items["one"].GetTopic())
+	assert.Equal(t, "KAFKA", *items["one"].GetType())
+	assert.Equal(t, "jdoe", *items["one"].GetUser())
+	assert.Equal(t, "https://kafka.example.com", *items["two"].GetEndpoint())
+	assert.Nil(t, items["two"].GetEvents())
+	assert.Nil(t, items["two"].GetPassword())
+	assert.Nil(t, items["two"].GetRegion())
+	assert.Nil(t, items["two"].GetTopic())
+	assert.Nil(t, items["two"].GetType())
+	assert.Nil(t, items["two"].GetUser())
+}
+
 func TestCommandLineConfigurationLayerGetGit(t *testing.T) {
 	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
 
 	git, err := commandLineConfigurationLayer.GetGit()
 	assert.NoError(t, err)
 	assert.NotNil(t, git)
+	assert.Equal(t, 0, len(*git.GetHeaders()))
+	assert.Nil(t, git.GetIdentity().GetEmail())
+	assert.Nil(t, git.GetIdentity().GetName())
+	assert.Nil(t, git.GetIdentity().GetProvider())
+	assert.Nil(t, git.GetProxy())
 	assert.Equal(t, 0, len(*git.GetRemotes()))
+	assert.Nil(t, git.GetSingleBranch())
+	assert.Nil(t, git.GetFetchTags())
+	assert.Nil(t, git.GetUnshallow())
+	assert.Nil(t, git.GetMirror())
+	assert.Nil(t, git.GetBackend())
+	assert.Nil(t, git.GetService())
+	assert.Nil(t, git.GetTrustedKeys())
+	assert.Nil(t, git.GetTimestamp())
+	assert.Nil(t, git.GetNoVerify())
+	assert.Nil(t, git.GetHooksPath())
+	assert.Nil(t, git.GetIgnoreSubmodules())
+	assert.Nil(t, git.GetStash())
+	assert.Nil(t, git.GetTimeout())
+	assert.Nil(t, git.GetRetries())
+	assert.Nil(t, git.GetRetryDelay())
+	assert.Nil(t, git.GetLock())
+	assert.Nil(t, git.GetLockTimeout())
 
 	// get a new instance or a stale set of arguments is still in the configuration layer
 	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
 	commandLineConfigurationLayer.withArguments([]string{
+		"--git-proxy=http://proxy.example.com:3128",
+		"--git-single-branch=true",
+		"--git-fetch-tags=true",
+		"--git-unshallow=false",
+		"--git-mirror=true",
+		"--git-backend=CLI",
+		"--git-service=github",
+		"--git-trusted-keys=keys",
+		"--git-timestamp=1700000000",
+		"--git-no-verify=true",
+		"--git-hooks-path=.githooks",
+		"--git-ignore-submodules=dirty",
+		"--git-stash=true",
+		"--git-timeout=30",
+		"--git-retries=3",
+		"--git-retry-delay=5",
+		"--git-lock=true",
+		"--git-lock-timeout=60",
+		"--git-identity-email=12345+nyx[bot]@users.noreply.github.com",
+		"--git-identity-name=nyx[bot]",
+		"--git-identity-provider=GITHUB",
+		"--git-identity-email-variable=BOT_GIT_EMAIL",
+		"--git-identity-name-variable=BOT_GIT_NAME",
+		"--git-headers-Authorization=Basic OnNlY3JldA==",
+		"--git-headers-X-Custom-Header=value",
 		"--git-remotes-one-user=jdoe",
 		"--git-remotes-two-user=stiger",
 	})
@@ -347,6 +661,35 @@ func TestCommandLineConfigurationLayerGetGit(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, git)
 
+	assert.Equal(t, 2, len(*git.GetHeaders()))
+	assert.Equal(t, "Basic OnNlY3JldA==", (*git.GetHeaders())["Authorization"])
+	assert.Equal(t, "value", (*git.GetHeaders())["X-Custom-Header"])
+
+	assert.Equal(t, "http://proxy.example.com:3128", *git.GetProxy())
+	assert.Equal(t, true, *git.GetSingleBranch())
+	assert.Equal(t, true, *git.GetFetchTags())
+	assert.Equal(t, false, *git.GetUnshallow())
+	assert.Equal(t, true, *git.GetMirror())
+	assert.Equal(t, ent.CLI, *git.GetBackend())
+	assert.Equal(t, "github", *git.GetService())
+	assert.Equal(t, "keys", *git.GetTrustedKeys())
+	assert.Equal(t, "1700000000", *git.GetTimestamp())
+	assert.Equal(t, true, *git.GetNoVerify())
+	assert.Equal(t, ".githooks", *git.GetHooksPath())
+	assert.Equal(t, "dirty", *git.GetIgnoreSubmodules())
+	assert.Equal(t, true, *git.GetStash())
+	assert.Equal(t, "30", *git.GetTimeout())
+	assert.Equal(t, "3", *git.GetRetries())
+	assert.Equal(t, "5", *git.GetRetryDelay())
+	assert.Equal(t, true, *git.GetLock())
+	assert.Equal(t, "60", *git.GetLockTimeout())
+
+	assert.Equal(t, "12345+nyx[bot]@users.noreply.github.com", *git.GetIdentity().GetEmail())
+	assert.Equal(t, "nyx[bot]", *git.GetIdentity().GetName())
+	assert.Equal(t, ent.GITHUB, *git.GetIdentity().GetProvider())
+	assert.Equal(t, "BOT_GIT_EMAIL", *git.GetIdentity().GetEmailVariable())
+	assert.Equal(t, "BOT_GIT_NAME", *git.GetIdentity().GetNameVariable())
+
 	assert.Equal(t, 2, len(*git.GetRemotes()))
 	assert.NotNil(t, remotes["one"])
 	assert.NotNil(t, remotes["two"])
@@ -359,11 +702,18 @@ func TestCommandLineConfigurationLayerGetGit(t *testing.T) {
 		"--git-remotes-one-password=pwd",
 		"--git-remotes-one-privateKey=pk1",
 		"--git-remotes-one-passphrase=pp1",
+		"--git-remotes-one-appId=123",
+		"--git-remotes-one-installationId=456",
+		"--git-remotes-one-userVariable=RELEASE_USER",
+		"--git-remotes-one-passwordVariable=RELEASE_TOKEN",
+		"--git-remotes-one-pushTags=NEW",
 		"--git-remotes-two-authenticationMethod=PUBLIC_KEY",
 		"--git-remotes-two-user=stiger",
 		"--git-remotes-two-password=sct",
 		"--git-remotes-two-privateKey=pk2",
 		"--git-remotes-two-passphrase=pp2",
+		"--git-remotes-two-knownHosts=kh2",
+		"--git-remotes-two-strictHostKeyChecking=false",
 	})
 
 	git, err = commandLineConfigurationLayer.GetGit()
@@ -372,14 +722,81 @@ func TestCommandLineConfigurationLayerGetGit(t *testing.T) {
 	assert.Equal(t, 2, len(*git.GetRemotes()))
 	assert.Equal(t, ent.USER_PASSWORD, *remotes["one"].GetAuthenticationMethod())
 	assert.Equal(t, "pwd", *remotes["one"].GetPassword())
+	assert.Equal(t, "123", *remotes["one"].GetAppID())
+	assert.Equal(t, "456", *remotes["one"].GetInstallationID())
+	assert.Nil(t, remotes["two"].GetAppID())
+	assert.Equal(t, "RELEASE_USER", *remotes["one"].GetUserVariable())
+	assert.Equal(t, "RELEASE_TOKEN", *remotes["one"].GetPasswordVariable())
+	assert.Nil(t, remotes["two"].GetPasswordVariable())
+	assert.Equal(t, "NEW", *remotes["one"].GetPushTags())
+	assert.Nil(t, remotes["two"].GetPushTags())
 	assert.Equal(t, "jdoe", *remotes["one"].GetUser())
 	assert.Equal(t, "pk1", *remotes["one"].GetPrivateKey())
 	assert.Equal(t, "pp1", *remotes["one"].GetPassphrase())
+	assert.Nil(t, remotes["one"].GetKnownHosts())
+	assert.Nil(t, remotes["one"].GetStrictHostKeyChecking())
 	assert.Equal(t, ent.PUBLIC_KEY, *remotes["two"].GetAuthenticationMethod())
 	assert.Equal(t, "sct", *remotes["two"].GetPassword())
 	assert.Equal(t, "stiger", *remotes["two"].GetUser())
 	assert.Equal(t, "pk2", *remotes["two"].GetPrivateKey())
 	assert.Equal(t, "pp2", *remotes["two"].GetPassphrase())
+	assert.Equal(t, "kh2", *remotes["two"].GetKnownHosts())
+	assert.False(t, *remotes["two"].GetStrictHostKeyChecking())
+}
+
+func TestCommandLineConfigurationLayerGetImpactAnalyzers(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	impactAnalyzers, err := commandLineConfigurationLayer.GetImpactAnalyzers()
+	assert.NoError(t, err)
+	assert.NotNil(t, impactAnalyzers)
+	assert.Equal(t, 0, len(*impactAnalyzers.GetEnabled()))
+	assert.Equal(t, 0, len(*impactAnalyzers.GetItems()))
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--impact-analyzers-enabled=one,two",
+	})
+
+	impactAnalyzers, err = commandLineConfigurationLayer.GetImpactAnalyzers()
+	assert.NoError(t, err)
+	assert.NotNil(t, impactAnalyzers)
+
+	enabled := *impactAnalyzers.GetEnabled()
+	items := *impactAnalyzers.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "one")
+	assert.Equal(t, *enabled[1], "two")
+	assert.Equal(t, 0, len(items))
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--impact-analyzers-enabled=one,two",
+		"--impact-analyzers-one-bump=minor",
+		"--impact-analyzers-one-command=apidiff -incompatible {{releaseScope.previousVersion}} HEAD",
+		"--impact-analyzers-one-expression=^Incompatible",
+		"--impact-analyzers-one-title=API changes",
+		"--impact-analyzers-two-command=oasdiff breaking old.yaml new.yaml",
+	})
+
+	impactAnalyzers, err = commandLineConfigurationLayer.GetImpactAnalyzers()
+	assert.NoError(t, err)
+	assert.NotNil(t, impactAnalyzers)
+
+	enabled = *impactAnalyzers.GetEnabled()
+	items = *impactAnalyzers.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, "minor", *items["one"].GetBump())
+	assert.Equal(t, "apidiff -incompatible {{releaseScope.previousVersion}} HEAD", *items["one"].GetCommand())
+	assert.Equal(t, "^Incompatible", *items["one"].GetExpression())
+	assert.Equal(t, "API changes", *items["one"].GetTitle())
+	assert.Nil(t, items["two"].GetBump())
+	assert.Equal(t, "oasdiff breaking old.yaml new.yaml", *items["two"].GetCommand())
+	assert.Nil(t, items["two"].GetExpression())
+	assert.Nil(t, items["two"].GetTitle())
 }
 
 func TestCommandLineConfigurationLayerGetInitialVersion(t *testing.T) {
@@ -400,6 +817,168 @@ func TestCommandLineConfigurationLayerGetInitialVersion(t *testing.T) {
 	assert.Equal(t, "0.3.5", *initialVersion)
 }
 
+func TestCommandLineConfigurationLayerGetJUnitReportFile(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	junitReportFile, err := commandLineConfigurationLayer.GetJUnitReportFile()
+	assert.NoError(t, err)
+	assert.Nil(t, junitReportFile)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--junit-report-file=report.xml",
+	})
+	junitReportFile, err = commandLineConfigurationLayer.GetJUnitReportFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "report.xml", *junitReportFile)
+}
+
+func TestCommandLineConfigurationLayerGetNothingToRelease(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	nothingToRelease, err := commandLineConfigurationLayer.GetNothingToRelease()
+	assert.NoError(t, err)
+	assert.Nil(t, nothingToRelease)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--nothing-to-release=" + ent.FAIL.String(),
+	})
+
+	nothingToRelease, err = commandLineConfigurationLayer.GetNothingToRelease()
+	assert.NoError(t, err)
+	assert.Equal(t, ent.FAIL, *nothingToRelease)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--nothing-to-release=NONE",
+	})
+
+	_, err = commandLineConfigurationLayer.GetNothingToRelease()
+	assert.Error(t, err)
+}
+
+func TestCommandLineConfigurationLayerGetOrganizationConfigurationRepository(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	organizationConfigurationRepository, err := commandLineConfigurationLayer.GetOrganizationConfigurationRepository()
+	assert.NoError(t, err)
+	assert.Nil(t, organizationConfigurationRepository)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--organization-configuration-repository=acme/.nyx",
+	})
+
+	organizationConfigurationRepository, err = commandLineConfigurationLayer.GetOrganizationConfigurationRepository()
+	assert.NoError(t, err)
+	assert.Equal(t, "acme/.nyx", *organizationConfigurationRepository)
+}
+
+func TestCommandLineConfigurationLayerGetOrganizationConfigurationService(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	organizationConfigurationService, err := commandLineConfigurationLayer.GetOrganizationConfigurationService()
+	assert.NoError(t, err)
+	assert.Nil(t, organizationConfigurationService)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--organization-configuration-service=github",
+	})
+
+	organizationConfigurationService, err = commandLineConfigurationLayer.GetOrganizationConfigurationService()
+	assert.NoError(t, err)
+	assert.Equal(t, "github", *organizationConfigurationService)
+}
+
+func TestCommandLineConfigurationLayerGetPathRules(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	pathRules, err := commandLineConfigurationLayer.GetPathRules()
+	assert.NoError(t, err)
+	assert.NotNil(t, pathRules)
+	assert.Equal(t, 0, len(*pathRules.GetEnabled()))
+	assert.Equal(t, 0, len(*pathRules.GetItems()))
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--path-rules-enabled=api,docs",
+	})
+
+	pathRules, err = commandLineConfigurationLayer.GetPathRules()
+	assert.NoError(t, err)
+	assert.NotNil(t, pathRules)
+
+	enabled := *pathRules.GetEnabled()
+	items := *pathRules.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "api")
+	assert.Equal(t, *enabled[1], "docs")
+	assert.Equal(t, 0, len(items))
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--path-rules-enabled=api,docs",
+		"--path-rules-api-bump=minor",
+		"--path-rules-api-paths=api/**,proto/**",
+		"--path-rules-docs-paths=docs/**",
+		"--path-rules-docs-significant=false",
+	})
+
+	pathRules, err = commandLineConfigurationLayer.GetPathRules()
+	assert.NoError(t, err)
+	assert.NotNil(t, pathRules)
+
+	enabled = *pathRules.GetEnabled()
+	items = *pathRules.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, "minor", *items["api"].GetBump())
+	assert.Equal(t, 2, len(*items["api"].GetPaths()))
+	assert.Equal(t, "api/**", *(*items["api"].GetPaths())[0])
+	assert.Equal(t, "proto/**", *(*items["api"].GetPaths())[1])
+	assert.Nil(t, items["api"].GetSignificant())
+	assert.Nil(t, items["docs"].GetBump())
+	assert.Equal(t, 1, len(*items["docs"].GetPaths()))
+	assert.Equal(t, "docs/**", *(*items["docs"].GetPaths())[0])
+	assert.False(t, *items["docs"].GetSignificant())
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--path-rules-docs-significant=notaboolean",
+	})
+
+	_, err = commandLineConfigurationLayer.GetPathRules()
+	assert.Error(t, err)
+}
+
+func TestCommandLineConfigurationLayerGetPrereleaseOrder(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	prereleaseOrder, err := commandLineConfigurationLayer.GetPrereleaseOrder()
+	assert.NoError(t, err)
+	assert.Nil(t, prereleaseOrder)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--prerelease-order=dev,alpha,beta,rc",
+	})
+
+	prereleaseOrder, err = commandLineConfigurationLayer.GetPrereleaseOrder()
+	assert.NoError(t, err)
+	assert.Equal(t, "dev,alpha,beta,rc", *prereleaseOrder)
+}
+
 func TestCommandLineConfigurationLayerGetPreset(t *testing.T) {
 	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
 
@@ -448,10 +1027,14 @@ func TestCommandLineConfigurationLayerGetReleaseAssets(t *testing.T) {
 		"--release-assets-asset2-description=Binary Asset",
 		"--release-assets-asset2-type=application/octet-stream",
 		"--release-assets-asset2-path=asset.bin",
+		"--release-assets-asset3-fileName=asset-{{@os}}-{{@arch}}.tar.gz",
+		"--release-assets-asset3-path=dist/{{@os}}/{{@arch}}",
+		"--release-assets-asset3-archive=tar.gz",
+		"--release-assets-asset3-platforms=linux/amd64,darwin/arm64",
 	})
 
 	releaseAssets, err = commandLineConfigurationLayer.GetReleaseAssets()
-	assert.Equal(t, 2, len(*releaseAssets))
+	assert.Equal(t, 3, len(*releaseAssets))
 	assert.NotNil(t, (*releaseAssets)["asset1"])
 	assert.NotNil(t, (*releaseAssets)["asset2"])
 	assert.Equal(t, "asset.txt", *(*releaseAssets)["asset1"].GetFileName())
@@ -462,6 +1045,42 @@ func TestCommandLineConfigurationLayerGetReleaseAssets(t *testing.T) {
 	assert.Equal(t, "Binary Asset", *(*releaseAssets)["asset2"].GetDescription())
 	assert.Equal(t, "application/octet-stream", *(*releaseAssets)["asset2"].GetType())
 	assert.Equal(t, "asset.bin", *(*releaseAssets)["asset2"].GetPath())
+	assert.Nil(t, (*releaseAssets)["asset2"].GetArchive())
+	assert.Nil(t, (*releaseAssets)["asset2"].GetPlatforms())
+	assert.Equal(t, "asset-{{@os}}-{{@arch}}.tar.gz", *(*releaseAssets)["asset3"].GetFileName())
+	assert.Equal(t, "dist/{{@os}}/{{@arch}}", *(*releaseAssets)["asset3"].GetPath())
+	assert.Equal(t, "tar.gz", *(*releaseAssets)["asset3"].GetArchive())
+	assert.Equal(t, "linux/amd64,darwin/arm64", *(*releaseAssets)["asset3"].GetPlatforms())
+}
+
+func TestCommandLineConfigurationLayerGetReleaseCoercion(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	releaseCoercion, err := commandLineConfigurationLayer.GetReleaseCoercion()
+	assert.NoError(t, err)
+	assert.Nil(t, releaseCoercion)
+
+	// Test the name and value version
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--release-coercion=false",
+	})
+
+	releaseCoercion, err = commandLineConfigurationLayer.GetReleaseCoercion()
+	assert.NoError(t, err)
+	assert.Equal(t, false, *releaseCoercion)
+
+	// Test the flag version
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--release-coercion",
+	})
+
+	releaseCoercion, err = commandLineConfigurationLayer.GetReleaseCoercion()
+	assert.NoError(t, err)
+	assert.Equal(t, true, *releaseCoercion)
 }
 
 func TestCommandLineConfigurationLayerGetReleaseLenient(t *testing.T) {
@@ -522,6 +1141,7 @@ func TestCommandLineConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Equal(t, 0, len(*releaseTypes.GetEnabled()))
 	assert.Equal(t, 0, len(*releaseTypes.GetPublicationServices()))
 	assert.Equal(t, 0, len(*releaseTypes.GetRemoteRepositories()))
+	assert.Equal(t, 0, len(*releaseTypes.GetYanked()))
 	assert.Equal(t, 0, len(*releaseTypes.GetItems()))
 
 	// get a new instance or a stale set of arguments is still in the configuration layer
@@ -530,6 +1150,7 @@ func TestCommandLineConfigurationLayerGetReleaseTypes(t *testing.T) {
 		"--release-types-enabled=one,two",
 		"--release-types-publication-services=first,second",
 		"--release-types-remote-repositories=origin,replica",
+		"--release-types-yanked=1.0.1,1.2.0",
 	})
 
 	releaseTypes, err = commandLineConfigurationLayer.GetReleaseTypes()
@@ -542,6 +1163,9 @@ func TestCommandLineConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Equal(t, 2, len(*releaseTypes.GetRemoteRepositories()))
 	assert.Equal(t, "origin", *(*releaseTypes.GetRemoteRepositories())[0])
 	assert.Equal(t, "replica", *(*releaseTypes.GetRemoteRepositories())[1])
+	assert.Equal(t, 2, len(*releaseTypes.GetYanked()))
+	assert.Equal(t, "1.0.1", *(*releaseTypes.GetYanked())[0])
+	assert.Equal(t, "1.2.0", *(*releaseTypes.GetYanked())[1])
 	assert.Equal(t, 0, len(*releaseTypes.GetItems()))
 
 	// get a new instance or a stale set of arguments is still in the configuration layer
@@ -587,13 +1211,20 @@ func TestCommandLineConfigurationLayerGetReleaseTypes(t *testing.T) {
 		"--release-types-one-match-workspace-status=" + ent.DIRTY.String(),
 		"--release-types-one-publish=false",
 		"--release-types-one-version-range=true",
+		"--release-types-two-build-metadata=sha.{{#short7}}{{releaseScope.finalCommit}}{{/short7}}",
+		"--release-types-two-build-metadata-targets=STATE,CHANGELOG",
 		"--release-types-two-collapse-versions=false",
 		"--release-types-two-description=description2",
 		"--release-types-two-filter-tags=filter2",
+		"--release-types-two-gate-policy=state.branch == 'main'",
+		"--release-types-two-ignore-cherry-picks=true",
+		"--release-types-two-ignore-merges=true",
+		"--release-types-two-follow-all-parents=true",
 		"--release-types-two-git-commit=false",
 		"--release-types-two-git-commit-message=Commit message",
 		"--release-types-two-git-push=false",
 		"--release-types-two-git-push-force=true",
+		"--release-types-two-git-push-force-with-lease=true",
 		"--release-types-two-git-tag=false",
 		"--release-types-two-git-tag-force=true",
 		"--release-types-two-git-tag-message=Tag message",
@@ -607,13 +1238,30 @@ func TestCommandLineConfigurationLayerGetReleaseTypes(t *testing.T) {
 		"--release-types-two-identifiers-9-position=" + ent.BUILD.String(),
 		"--release-types-two-identifiers-9-qualifier=q3",
 		"--release-types-two-identifiers-9-value=v3",
+		"--release-types-two-match-branch-metadata-scope=^(api|cli)$",
+		"--release-types-two-bump-labels-breaking=major",
+		"--release-types-two-bump-labels-skip-release=none",
+		"--release-types-two-match-changed-paths=^api/.*$",
+		"--release-types-two-match-days-of-week=MONDAY,FRIDAY",
 		"--release-types-two-match-environment-variables-PATH=any path",
 		"--release-types-two-match-environment-variables-USER=any user",
+		"--release-types-two-match-expression=true",
+		"--release-types-two-match-mode=" + ent.ANY.String(),
+		"--release-types-two-match-policy=state.bump == 'minor'",
+		"--release-types-two-match-tags=^deploy-.*$",
 		"--release-types-two-match-workspace-status=" + ent.CLEAN.String(),
 		"--release-types-two-publish=true",
+		"--release-types-two-publish-approval-environment=production",
+		"--release-types-two-publish-approval-polling-interval=10",
+		"--release-types-two-publish-approval-timeout=600",
 		"--release-types-two-publish-draft=false",
 		"--release-types-two-publish-pre-release=true",
+		"--release-types-two-pull-request-messages=github",
+		"--release-types-two-release-metadata-file=.nyx-release.json",
 		"--release-types-two-release-name=myrelease",
+		"--release-types-two-required-environment-variables=GITHUB_TOKEN, GPG_KEY",
+		"--release-types-two-require-signed-commits=true",
+		"--release-types-two-version-constraint=>=1.2 <2.0",
 		"--release-types-two-version-range-from-branch-name=true",
 	})
 
@@ -637,6 +1285,10 @@ func TestCommandLineConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Equal(t, "qualifier1", *(*(*releaseTypes.GetItems())["one"]).GetCollapsedVersionQualifier())
 	assert.Equal(t, "description1", *(*(*releaseTypes.GetItems())["one"]).GetDescription())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetFilterTags())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetGatePolicy())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetIgnoreCherryPicks())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetIgnoreMerges())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetFollowAllParents())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["one"]).GetGitCommit())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetGitCommitMessage())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["one"]).GetGitTag())
@@ -645,14 +1297,33 @@ func TestCommandLineConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetGitTagNames())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["one"]).GetGitPush())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetGitPushForce())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetGitPushForceWithLease())
 	assert.Equal(t, 0, len(*(*(*releaseTypes.GetItems())["one"]).GetIdentifiers()))
 	assert.Equal(t, "alpha,beta", *(*(*releaseTypes.GetItems())["one"]).GetMatchBranches())
+	assert.Equal(t, 0, len(*(*(*releaseTypes.GetItems())["one"]).GetMatchBranchMetadata()))
+	assert.Equal(t, 0, len(*(*(*releaseTypes.GetItems())["one"]).GetBumpLabels()))
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchChangedPaths())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchDaysOfWeek())
 	assert.Equal(t, 0, len(*(*(*releaseTypes.GetItems())["one"]).GetMatchEnvironmentVariables()))
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchExpression())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchPolicy())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchMode())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchTags())
 	assert.Equal(t, ent.DIRTY, *(*(*releaseTypes.GetItems())["one"]).GetMatchWorkspaceStatus())
 	assert.Equal(t, "false", *(*(*releaseTypes.GetItems())["one"]).GetPublish())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPublishApprovalEnvironment())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPublishApprovalPollingInterval())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPublishApprovalTimeout())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPublishDraft())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPublishPreRelease())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPullRequestMessages())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetReleaseMetadataFile())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetReleaseName())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetRequiredEnvironmentVariables())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetRequireSignedCommits())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetBuildMetadata())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetBuildMetadataTargets())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetVersionConstraint())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["one"]).GetVersionRange())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetVersionRangeFromBranchName())
 	assert.Nil(t, (*(*releaseTypes).GetItems())["two"].GetAssets())
@@ -660,6 +1331,10 @@ func TestCommandLineConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Nil(t, (*(*releaseTypes.GetItems())["two"]).GetCollapsedVersionQualifier())
 	assert.Equal(t, "description2", *(*(*releaseTypes.GetItems())["two"]).GetDescription())
 	assert.Equal(t, "filter2", *(*(*releaseTypes.GetItems())["two"]).GetFilterTags())
+	assert.Equal(t, "state.branch == 'main'", *(*(*releaseTypes.GetItems())["two"]).GetGatePolicy())
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetIgnoreCherryPicks())
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetIgnoreMerges())
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetFollowAllParents())
 	assert.Equal(t, "false", *(*(*releaseTypes.GetItems())["two"]).GetGitCommit())
 	assert.Equal(t, "Commit message", *(*(*releaseTypes.GetItems())["two"]).GetGitCommitMessage())
 	assert.Equal(t, "false", *(*(*releaseTypes.GetItems())["two"]).GetGitTag())
@@ -671,6 +1346,7 @@ func TestCommandLineConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Equal(t, "three", *(*(*(*releaseTypes.GetItems())["two"]).GetGitTagNames())[2])
 	assert.Equal(t, "false", *(*(*releaseTypes.GetItems())["two"]).GetGitPush())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetGitPushForce())
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetGitPushForceWithLease())
 	assert.Equal(t, 3, len(*(*(*releaseTypes.GetItems())["two"]).GetIdentifiers()))
 	assert.Equal(t, ent.PRE_RELEASE, *(*(*(*releaseTypes.GetItems())["two"]).GetIdentifiers())[0].GetPosition())
 	assert.Equal(t, "q1", *(*(*(*releaseTypes.GetItems())["two"]).GetIdentifiers())[0].GetQualifier())
@@ -682,18 +1358,58 @@ func TestCommandLineConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Equal(t, "q3", *(*(*(*releaseTypes.GetItems())["two"]).GetIdentifiers())[2].GetQualifier())
 	assert.Equal(t, "v3", *(*(*(*releaseTypes.GetItems())["two"]).GetIdentifiers())[2].GetValue())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["two"]).GetMatchBranches())
+	assert.Equal(t, 1, len(*(*(*releaseTypes.GetItems())["two"]).GetMatchBranchMetadata()))
+	assert.Equal(t, "^(api|cli)$", (*(*(*releaseTypes.GetItems())["two"]).GetMatchBranchMetadata())["scope"])
+	assert.Equal(t, 2, len(*(*(*releaseTypes.GetItems())["two"]).GetBumpLabels()))
+	assert.Equal(t, "major", (*(*(*releaseTypes.GetItems())["two"]).GetBumpLabels())["breaking"])
+	assert.Equal(t, "none", (*(*(*releaseTypes.GetItems())["two"]).GetBumpLabels())["skip-release"])
+	assert.Equal(t, "^api/.*$", *(*(*releaseTypes.GetItems())["two"]).GetMatchChangedPaths())
+	assert.Equal(t, "MONDAY,FRIDAY", *(*(*releaseTypes.GetItems())["two"]).GetMatchDaysOfWeek())
 	assert.Equal(t, 2, len(*(*(*releaseTypes.GetItems())["two"]).GetMatchEnvironmentVariables()))
 	assert.Equal(t, "any path", (*(*(*releaseTypes.GetItems())["two"]).GetMatchEnvironmentVariables())["PATH"])
 	assert.Equal(t, "any user", (*(*(*releaseTypes.GetItems())["two"]).GetMatchEnvironmentVariables())["USER"])
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetMatchExpression())
+	assert.Equal(t, "state.bump == 'minor'", *(*(*releaseTypes.GetItems())["two"]).GetMatchPolicy())
+	assert.Equal(t, ent.ANY, *(*(*releaseTypes.GetItems())["two"]).GetMatchMode())
+	assert.Equal(t, "^deploy-.*$", *(*(*releaseTypes.GetItems())["two"]).GetMatchTags())
 	assert.Equal(t, ent.CLEAN, *(*(*releaseTypes.GetItems())["two"]).GetMatchWorkspaceStatus())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetPublish())
+	assert.Equal(t, "production", *(*(*releaseTypes.GetItems())["two"]).GetPublishApprovalEnvironment())
+	assert.Equal(t, "10", *(*(*releaseTypes.GetItems())["two"]).GetPublishApprovalPollingInterval())
+	assert.Equal(t, "600", *(*(*releaseTypes.GetItems())["two"]).GetPublishApprovalTimeout())
 	assert.Equal(t, "false", *(*(*releaseTypes.GetItems())["two"]).GetPublishDraft())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetPublishPreRelease())
+	assert.Equal(t, "github", *(*(*releaseTypes.GetItems())["two"]).GetPullRequestMessages())
+	assert.Equal(t, ".nyx-release.json", *(*(*releaseTypes.GetItems())["two"]).GetReleaseMetadataFile())
 	assert.Equal(t, "myrelease", *(*(*releaseTypes.GetItems())["two"]).GetReleaseName())
+	assert.Equal(t, 2, len(*(*(*releaseTypes.GetItems())["two"]).GetRequiredEnvironmentVariables()))
+	assert.Equal(t, "GITHUB_TOKEN", *(*(*(*releaseTypes.GetItems())["two"]).GetRequiredEnvironmentVariables())[0])
+	assert.Equal(t, "GPG_KEY", *(*(*(*releaseTypes.GetItems())["two"]).GetRequiredEnvironmentVariables())[1])
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetRequireSignedCommits())
+	assert.Equal(t, "sha.{{#short7}}{{releaseScope.finalCommit}}{{/short7}}", *(*(*releaseTypes.GetItems())["two"]).GetBuildMetadata())
+	assert.Equal(t, "STATE,CHANGELOG", *(*(*releaseTypes.GetItems())["two"]).GetBuildMetadataTargets())
+	assert.Equal(t, ">=1.2 <2.0", *(*(*releaseTypes.GetItems())["two"]).GetVersionConstraint())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["two"]).GetVersionRange())
 	assert.True(t, *(*(*releaseTypes.GetItems())["two"]).GetVersionRangeFromBranchName())
 }
 
+func TestCommandLineConfigurationLayerGetReportFile(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	reportFile, err := commandLineConfigurationLayer.GetReportFile()
+	assert.NoError(t, err)
+	assert.Nil(t, reportFile)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--report-file=report.html",
+	})
+	reportFile, err = commandLineConfigurationLayer.GetReportFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "report.html", *reportFile)
+}
+
 func TestCommandLineConfigurationLayerGetResume(t *testing.T) {
 	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
 
@@ -724,6 +1440,23 @@ func TestCommandLineConfigurationLayerGetResume(t *testing.T) {
 	assert.Equal(t, true, *resume)
 }
 
+func TestCommandLineConfigurationLayerGetRevisionBump(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	revisionBump, err := commandLineConfigurationLayer.GetRevisionBump()
+	assert.NoError(t, err)
+	assert.Nil(t, revisionBump)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--revision-bump=patch",
+	})
+	revisionBump, err = commandLineConfigurationLayer.GetRevisionBump()
+	assert.NoError(t, err)
+	assert.Equal(t, "patch", *revisionBump)
+}
+
 func TestCommandLineConfigurationLayerGetScheme(t *testing.T) {
 	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
 
@@ -740,6 +1473,45 @@ func TestCommandLineConfigurationLayerGetScheme(t *testing.T) {
 	scheme, err = commandLineConfigurationLayer.GetScheme()
 	assert.NoError(t, err)
 	assert.Equal(t, ver.SEMVER, *scheme)
+
+	// schemes that have not been registered are rejected
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--scheme=UNREGISTERED",
+	})
+
+	_, err = commandLineConfigurationLayer.GetScheme()
+	assert.Error(t, err)
+}
+
+func TestCommandLineConfigurationLayerGetServer(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	server, err := commandLineConfigurationLayer.GetServer()
+	assert.NoError(t, err)
+	assert.NotNil(t, server)
+	assert.Nil(t, server.GetAddress())
+	assert.Nil(t, server.GetBranches())
+	assert.Nil(t, server.GetCommand())
+	assert.Nil(t, server.GetSecret())
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--server-address=:9090",
+		"--server-branches=^(main|master)$",
+		"--server-command=mark",
+		"--server-secret=s3cr3t",
+	})
+
+	server, err = commandLineConfigurationLayer.GetServer()
+	assert.NoError(t, err)
+	assert.NotNil(t, server)
+
+	assert.Equal(t, ":9090", *server.GetAddress())
+	assert.Equal(t, "^(main|master)$", *server.GetBranches())
+	assert.Equal(t, "mark", *server.GetCommand())
+	assert.Equal(t, "s3cr3t", *server.GetSecret())
 }
 
 func TestCommandLineConfigurationLayerGetServices(t *testing.T) {
@@ -834,6 +1606,54 @@ func TestCommandLineConfigurationLayerGetStateFile(t *testing.T) {
 	assert.Equal(t, "state.yml", *stateFile)
 }
 
+func TestCommandLineConfigurationLayerGetStateFileSigningKey(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	stateFileSigningKey, err := commandLineConfigurationLayer.GetStateFileSigningKey()
+	assert.NoError(t, err)
+	assert.Nil(t, stateFileSigningKey)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--state-file-signing-key=secret",
+	})
+
+	stateFileSigningKey, err = commandLineConfigurationLayer.GetStateFileSigningKey()
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", *stateFileSigningKey)
+}
+
+func TestCommandLineConfigurationLayerGetStrictExitCodes(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	strictExitCodes, err := commandLineConfigurationLayer.GetStrictExitCodes()
+	assert.NoError(t, err)
+	assert.Nil(t, strictExitCodes)
+
+	// Test the name and value version
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--strict-exit-codes=false",
+	})
+
+	strictExitCodes, err = commandLineConfigurationLayer.GetStrictExitCodes()
+	assert.NoError(t, err)
+	assert.Equal(t, false, *strictExitCodes)
+
+	// Test the flag version
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--strict-exit-codes",
+	})
+
+	strictExitCodes, err = commandLineConfigurationLayer.GetStrictExitCodes()
+	assert.NoError(t, err)
+	assert.Equal(t, true, *strictExitCodes)
+}
+
 func TestCommandLineConfigurationLayerGetSubstitutions(t *testing.T) {
 	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
 
@@ -910,6 +1730,67 @@ func TestCommandLineConfigurationLayerGetSubstitutions(t *testing.T) {
 	assert.Equal(t, "version: 7.8.9", *items["two"].GetReplace())
 }
 
+func TestCommandLineConfigurationLayerGetTelemetryEndpoint(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	telemetryEndpoint, err := commandLineConfigurationLayer.GetTelemetryEndpoint()
+	assert.NoError(t, err)
+	assert.Nil(t, telemetryEndpoint)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--telemetry-endpoint=https://telemetry.example.com/nyx",
+	})
+	telemetryEndpoint, err = commandLineConfigurationLayer.GetTelemetryEndpoint()
+	assert.NoError(t, err)
+	assert.Equal(t, "https://telemetry.example.com/nyx", *telemetryEndpoint)
+}
+
+func TestCommandLineConfigurationLayerGetTelemetryFile(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	telemetryFile, err := commandLineConfigurationLayer.GetTelemetryFile()
+	assert.NoError(t, err)
+	assert.Nil(t, telemetryFile)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--telemetry-file=telemetry.json",
+	})
+	telemetryFile, err = commandLineConfigurationLayer.GetTelemetryFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "telemetry.json", *telemetryFile)
+}
+
+func TestCommandLineConfigurationLayerGetTimestampSource(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	timestampSource, err := commandLineConfigurationLayer.GetTimestampSource()
+	assert.NoError(t, err)
+	assert.Nil(t, timestampSource)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--timestamp-source=" + ent.COMMIT.String(),
+	})
+
+	timestampSource, err = commandLineConfigurationLayer.GetTimestampSource()
+	assert.NoError(t, err)
+	assert.Equal(t, ent.COMMIT, *timestampSource)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--timestamp-source=NONE",
+	})
+
+	_, err = commandLineConfigurationLayer.GetTimestampSource()
+	assert.Error(t, err)
+}
+
 func TestCommandLineConfigurationLayerGetVerbosity(t *testing.T) {
 	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
 
@@ -1109,6 +1990,24 @@ func TestCommandLineConfigurationLayerGetVersion(t *testing.T) {
 	assert.Equal(t, "4.5.7", *version)
 }
 
+func TestCommandLineConfigurationLayerGetVersionStorageService(t *testing.T) {
+	commandLineConfigurationLayer := CommandLineConfigurationLayer{}
+
+	versionStorageService, err := commandLineConfigurationLayer.GetVersionStorageService()
+	assert.NoError(t, err)
+	assert.Nil(t, versionStorageService)
+
+	// get a new instance or a stale set of arguments is still in the configuration layer
+	commandLineConfigurationLayer = CommandLineConfigurationLayer{}
+	commandLineConfigurationLayer.withArguments([]string{
+		"--version-storage-service=bucket",
+	})
+
+	versionStorageService, err = commandLineConfigurationLayer.GetVersionStorageService()
+	assert.NoError(t, err)
+	assert.Equal(t, "bucket", *versionStorageService)
+}
+
 /*func TestCommandLineConfigurationLayerPrintHelp(t *testing.T) {
 	PrintHelp()
 }*/
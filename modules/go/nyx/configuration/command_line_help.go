@@ -28,17 +28,34 @@ func PrintHelp() {
 	fmt.Println("    nyx [arguments] [command]")
 	fmt.Println()
 	fmt.Println("Commands are:")
+	fmt.Println("    analytics           reports the release cadence, lead times, bump types and contributors of past releases")
 	fmt.Println("    clean               reverts the repository to its initial state and removes files created by other commands, if any")
 	fmt.Println("    infer               inspects the commit history and repository status and computes the project version")
 	fmt.Println("    make                produces artifacts (i.e. changelog) as per the configuration")
 	fmt.Println("    mark                commits, tags and pushes, according to the configuration and the repository status")
+	fmt.Println("    preview             comments the pull request with the version and changelog the changes would release")
+	fmt.Println("    prune               removes the release assets exceeding the retention policies of the publication services")
 	fmt.Println("    publish             publish the new release, if any, to the configured services")
+	fmt.Println("    serve               listens for push webhooks from GitHub or GitLab and runs the configured command for the")
+	fmt.Println("                        pushed branches (see Server arguments below)")
 	fmt.Println()
 	fmt.Println("Global arguments are:")
+	fmt.Println("    --analytics-file=<PATH>            write the report produced by the analytics command to the given file <PATH>,")
+	fmt.Println("                                       as JSON if it has the .json extension or as Markdown otherwise")
+	fmt.Println("    --badges-directory=<PATH>          write shields.io compatible version and release date badges to the given")
+	fmt.Println("                                       directory <PATH> when saving the state")
 	fmt.Println("-b, --bump=<NAME>                      overrides the version component number to bump and prevents inference from the")
 	fmt.Println("                                       commit history, causing the version component named <NAME> to always be bumped.")
 	fmt.Println("                                       When using SEMVER <NAME> can be 'core', 'major', 'minor' or another name which")
 	fmt.Println("                                       will be used as an additional identifier")
+	fmt.Println("    --branch-metadata-expression=<REGEX> a regular expression with named groups used to extract metadata from the")
+	fmt.Println("                                       current branch name. Each named group yields a branch metadata item that can be")
+	fmt.Println("                                       used in templates and to match release types")
+	fmt.Println("    --commit-changes[=true|false]      when true the files changed by each commit in the release scope are computed")
+	fmt.Println("                                       and made available with the commit. When no value is passed then 'true' is")
+	fmt.Println("                                       assumed (default: false)")
+	fmt.Println("    --commit-lint-file=<PATH>          check the commits in the release scope against the commit message")
+	fmt.Println("                                       conventions and write violations to <PATH> as a SARIF report")
 	fmt.Println("-c, --configuration-file=<PATH>        load the configuration file from the given <PATH> or remote URL. The file format")
 	fmt.Println("                                       is inferred from the file extension. Supported formats are .json and .yml/.yaml.")
 	fmt.Println("                                       When the extension is not recognized JSON will be used (default: .nyx.json or")
@@ -53,14 +70,34 @@ func PrintHelp() {
 	fmt.Println("    --help                             prints this help and exit")
 	fmt.Println("    --info                             shorthand for --verbosity=INFO")
 	fmt.Println("    --initial-version=<VERSION>        the default version to use when no previous version can be inferred from the")
-	fmt.Println("                                       commit history (default: '0.1.0' when using SEMVER scheme)")
+	fmt.Println("                                       commit history (default: '0.1.0' with SEMVER, '0.1.0.0' with FOUR_SEGMENT)")
+	fmt.Println("    --junit-report-file=<PATH>         writes a JUnit XML report with the outcome of each command and publication to")
+	fmt.Println("                                       the given file after each run, so CI tools can show it along with test results")
+	fmt.Println("    --nothing-to-release=<POLICY>      what to do when there are no significant commits to release, where <POLICY> can")
+	fmt.Println("                                       be PREVIOUS_VERSION (use the previous version), SKIP (emit no version) or FAIL")
+	fmt.Println("                                       (default: PREVIOUS_VERSION)")
+	fmt.Println("    --organization-configuration-repository=<NAME>")
+	fmt.Println("                                       the repository to discover the organization configuration from, as a name")
+	fmt.Println("                                       or as 'owner/name' (default: '.nyx')")
+	fmt.Println("    --organization-configuration-service=<NAME>")
+	fmt.Println("                                       the name of the service used to discover the organization configuration when")
+	fmt.Println("                                       no local configuration file exists")
+	fmt.Println("    --prerelease-order=<IDENTIFIERS>   the comma separated list of pre-release identifiers, from the lowest to the")
+	fmt.Println("                                       highest precedence (i.e. 'dev,alpha,beta,rc'), used to compare pre-release")
+	fmt.Println("                                       versions instead of their ASCII sort order")
 	fmt.Println("    --preset=<NAME>                    the name of a configuration preset to use. See the docs for available presets")
+	fmt.Println("    --release-coercion[=true|false]    when true tags read from the commit history that look like semantic versions")
+	fmt.Println("                                       but are not (i.e. '1.2' or 'v1.2.3.4') are coerced to legal semantic versions.")
+	fmt.Println("                                       When no value is passed then 'true' is assumed (default: false)")
 	fmt.Println("    --release-lenient[=true|false]     when true tags read from the commit history will tolerate (and ignore) arbitrary")
 	fmt.Println("                                       prefixes. When no value is passed then 'true' is assumed (default: true)")
 	fmt.Println("    --release-prefix=<PREFIX>          the prefix to add to newly generated releases (i.e. 'v' for 'v1.2.3')")
+	fmt.Println("    --report-file=<PATH>               writes a self-contained HTML release report to the given file after each run")
 	fmt.Println("    --resume[=true|false]              resume operations from an existing state file. Requires --state-file. When no")
 	fmt.Println("                                       value is passed then 'true' is assumed (default: false)")
-	fmt.Println("    --scheme=<NAME>                    the version scheme to use. This version only supports SEMVER (default: SEMVER)")
+	fmt.Println("    --revision-bump=<NAME>             the core identifier (major, minor or patch) whose significance bumps the")
+	fmt.Println("                                       fourth number when using the FOUR_SEGMENT scheme")
+	fmt.Println("    --scheme=<NAME>                    the version scheme to use: SEMVER or FOUR_SEGMENT (default: SEMVER)")
 	fmt.Println("    --shared-configuration-file=<PATH> load a shared configuration file from the given <PATH> or remote URL. The file")
 	fmt.Println("                                       format is inferred from the file extension. Supported formats are .json and")
 	fmt.Println("                                       .yml/.yaml. When the extension is not recognized JSON will be used")
@@ -68,14 +105,41 @@ func PrintHelp() {
 	fmt.Println("    --state-file=<PATH>                enables writing the state file to the given <PATH>. The file format is inferred")
 	fmt.Println("                                       from the file extension. Supported formats are .json and .yml/.yaml. When the")
 	fmt.Println("                                       extension is not recognized JSON will be used")
+	fmt.Println("    --state-file-signing-key=<KEY>     the secret key used to sign the state file and to verify it when resuming")
+	fmt.Println("    --strict-exit-codes[=true|false]   when true, runs that don't issue any release exit with a non zero status telling")
+	fmt.Println("                                       whether the worktree is dirty or there is nothing to release (default: false)")
+	fmt.Println("    --telemetry-endpoint=<URL>         the URL of the endpoint to upload the telemetry report to, once saved. Requires")
+	fmt.Println("                                       --telemetry-file")
+	fmt.Println("    --telemetry-file=<PATH>            enables collecting anonymous usage and performance metrics and writes them to")
+	fmt.Println("                                       <PATH> as a JSON report. No metric is collected unless this option is set")
+	fmt.Println("    --timestamp-source=<SOURCE>        the source of the release timestamp, where <SOURCE> can be SYSTEM (the current")
+	fmt.Println("                                       time) or COMMIT (the date of the latest commit) (default: SYSTEM)")
 	fmt.Println("    --trace                            shorthand for --verbosity=TRACE")
 	fmt.Println("    --verbosity=<LEVEL>                controls the output verbosity, where <LEVEL> can be among FATAL, ERROR, WARNING,")
 	fmt.Println("                                       INFO, DEBUG, TRACE (default: WARNING)")
 	fmt.Println("-v, --version=<VERSION>                overrides the version and prevents version inference from the repository status")
 	fmt.Println("                                       and commit history")
+	fmt.Println("    --version-storage-service=<NAME>   the name of the service used to read the previous versions from and store the")
+	fmt.Println("                                       new versions to, in addition to Git tags")
 	fmt.Println("    --warning                          shorthand for --verbosity=WARNING")
 	fmt.Println()
 	fmt.Println("Changelog arguments are:")
+	fmt.Println("    --changelog-badges-<NAME>=<BADGE>                 the <BADGE> (any Markdown snippet) to show next to the title")
+	fmt.Println("                                                      of the changelog section with the given <NAME>")
+	fmt.Println("    --changelog-collapse-threshold=<NUMBER>           the number of commits above which the list of commits in a")
+	fmt.Println("                                                      changelog section is collapsed")
+	fmt.Println("    --changelog-deduplicate-cherry-picks=true|false   when true, commits that have been cherry-picked from versions")
+	fmt.Println("                                                      released on other branches are left out of the changelog")
+	fmt.Println("                                                      (default: false)")
+	fmt.Println("    --changelog-emojis-<NAME>=<EMOJI>                 the <EMOJI> used as a prefix for the title of the changelog")
+	fmt.Println("                                                      section with the given <NAME>")
+	fmt.Println("    --changelog-group-dependency-updates=true|false   when true, dependency updates from bots (Dependabot, Renovate)")
+	fmt.Println("                                                      are grouped in one changelog entry per ecosystem")
+	fmt.Println("                                                      (default: false)")
+	fmt.Println("    --changelog-merge-pre-releases=SEPARATE|FLAT|NESTED")
+	fmt.Println("                                                      how the pre-releases preceding a final release are merged into")
+	fmt.Println("                                                      its changelog: not at all (SEPARATE), into its sections (FLAT)")
+	fmt.Println("                                                      or as sub-headers (NESTED) (default: SEPARATE)")
 	fmt.Println("    --changelog-path=<PATH>                           the absolute or relative <PATH> to the changelog file that is")
 	fmt.Println("                                                      generated. If the file already exists it's overwritten.")
 	fmt.Println("                                                      Setting this argument implicitly enables the changelog creation")
@@ -92,6 +156,9 @@ func PrintHelp() {
 	fmt.Println("                                                      instead of the built-in template. The template must be a valid")
 	fmt.Println("                                                      Handlebars template and can use functions. See the docs for the")
 	fmt.Println("                                                      default template, the object model and the functions reference")
+	fmt.Println("    --changelog-upgrade-notes=<TEMPLATE>              the <TEMPLATE> rendering the path to the file with the upgrade")
+	fmt.Println("                                                      notes of the release, read from the released commit (default:")
+	fmt.Println("                                                      docs/upgrade-notes/{{version}}.md). Set it empty to disable")
 	fmt.Println()
 	fmt.Println("Commit Message Conventions arguments are:")
 	fmt.Println("    --commit-message-conventions-enabled=<NAMES>                             the comma separated list of convention")
@@ -113,17 +180,151 @@ func PrintHelp() {
 	fmt.Println("                                                                             The configuration for a convention named")
 	fmt.Println("                                                                             <NAME> is implicitly created by this option")
 	fmt.Println()
+	fmt.Println("Downstream Updates arguments are:")
+	fmt.Println("    --downstream-updates-enabled=<NAMES>              the comma separated list of downstream update names enabled for")
+	fmt.Println("                                                      the project. Each name must correspond to a downstream update")
+	fmt.Println("                                                      <NAME>. Use this argument to toggle the configured downstream")
+	fmt.Println("                                                      updates on/off")
+	fmt.Println("    --downstream-updates-<NAME>-branch=<TEMPLATE>     the branch of the downstream repository to open the pull request")
+	fmt.Println("                                                      against (default: the repository default branch)")
+	fmt.Println("    --downstream-updates-<NAME>-match=<REGEX>         the regular expression matching the text to replace in the")
+	fmt.Println("                                                      downstream file")
+	fmt.Println("    --downstream-updates-<NAME>-owner=<TEMPLATE>      the owner of the downstream repository (default: the service")
+	fmt.Println("                                                      option)")
+	fmt.Println("    --downstream-updates-<NAME>-path=<TEMPLATE>       the path of the file to update in the downstream repository")
+	fmt.Println("    --downstream-updates-<NAME>-replace=<TEMPLATE>    the text replacing the matched text in the downstream file")
+	fmt.Println("    --downstream-updates-<NAME>-repository=<TEMPLATE> the name of the downstream repository (default: the service")
+	fmt.Println("                                                      option)")
+	fmt.Println("    --downstream-updates-<NAME>-service=<NAME>        the name of the service configuration used to open the pull")
+	fmt.Println("                                                      request. The service must support pull requests")
+	fmt.Println("    --downstream-updates-<NAME>-title=<TEMPLATE>      the title of the pull request (default: 'Update to version")
+	fmt.Println("                                                      <VERSION>')")
+	fmt.Println()
+	fmt.Println("Event Bus arguments are:")
+	fmt.Println("    --event-bus-enabled=<NAMES>              the comma separated list of event emitter names enabled for the project.")
+	fmt.Println("                                             Each name must correspond to an event emitter <NAME>. Use this argument")
+	fmt.Println("                                             to toggle the configured event emitters on/off")
+	fmt.Println("    --event-bus-<NAME>-endpoint=<URL>        the URL of the event bus endpoint (default: depends on the type)")
+	fmt.Println("    --event-bus-<NAME>-events=<TYPES>        the comma separated list of event types to publish among INFERRED,")
+	fmt.Println("                                             MARKED, PUBLISHED and FAILED (default: all)")
+	fmt.Println("    --event-bus-<NAME>-password=<TEMPLATE>   the password (or secret key) used to authenticate to the event bus")
+	fmt.Println("    --event-bus-<NAME>-region=<NAME>         the region hosting the event bus, required by EVENTBRIDGE")
+	fmt.Println("    --event-bus-<NAME>-topic=<NAME>          the Kafka topic, NATS subject or EventBridge event bus to publish to")
+	fmt.Println("    --event-bus-<NAME>-type=<TYPE>           the type of the event bus, one of KAFKA, NATS or EVENTBRIDGE")
+	fmt.Println("    --event-bus-<NAME>-user=<TEMPLATE>       the user name (or access key) used to authenticate to the event bus")
+	fmt.Println()
 	fmt.Println("Git arguments are:")
+	fmt.Println("    --git-headers-<NAME>=<VALUE>             adds the HTTP header named <NAME> with the given value to all the")
+	fmt.Println("                                             requests sent to HTTP and HTTPS remotes (i.e. Authorization)")
+	fmt.Println("    --git-identity-email=<EMAIL>             the email of the default identity used for the commits and tags created")
+	fmt.Println("                                             by Nyx when the repository has no identity configured")
+	fmt.Println("    --git-identity-email-variable=<NAME>     the name of the environment variable to read the default identity email")
+	fmt.Println("                                             from when --git-identity-email is not set (i.e. BOT_GIT_EMAIL)")
+	fmt.Println("    --git-identity-name=<NAME>               the name of the default identity used for the commits and tags created")
+	fmt.Println("                                             by Nyx when the repository has no identity configured")
+	fmt.Println("    --git-identity-name-variable=<NAME>      the name of the environment variable to read the default identity name")
+	fmt.Println("                                             from when --git-identity-name is not set (i.e. BOT_GIT_NAME)")
+	fmt.Println("    --git-identity-provider=<PROVIDER>       the provider hosting the default identity account, one of GITHUB or")
+	fmt.Println("                                             GITLAB, used to infer the no-reply email when it's not set")
+	fmt.Println("    --git-proxy=<URL>                        the URL of the proxy to use for HTTP and HTTPS remotes. When not set")
+	fmt.Println("                                             the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used")
+	fmt.Println("    --git-remotes-<NAME>-appId=<TEMPLATE>    the ID of the GitHub App to authenticate as when the authentication method")
+	fmt.Println("                                             of the remote named <NAME> is GITHUB_APP")
+	fmt.Println("    --git-remotes-<NAME>-authenticationMethod=<METHOD> the authentication method for the remote named <NAME>,")
+	fmt.Println("                                             one of USER_PASSWORD, PUBLIC_KEY, GITHUB_APP or TOKEN. With TOKEN the")
+	fmt.Println("                                             token is passed as the password and mapped to the credentials expected")
+	fmt.Println("                                             by the provider detected from the remote URL")
+	fmt.Println("    --git-remotes-<NAME>-installationId=<TEMPLATE> the ID of the GitHub App installation to mint tokens for. When")
+	fmt.Println("                                             not set the App must have exactly one installation")
+	fmt.Println("    --git-remotes-<NAME>-knownHosts=<TEMPLATE> the path to a known_hosts file or the pinned host keys, in the")
+	fmt.Println("                                             known_hosts format, to verify the SSH host keys of the remote named <NAME>")
+	fmt.Println("                                             against (default: the system known_hosts files)")
 	fmt.Println("    --git-remotes-<NAME>-password=<TEMPLATE> sets the user name to use when connecting to the remote Git service named")
 	fmt.Println("                                             <NAME>. When using OAuth or Personal Access Tokens you may need to pass")
 	fmt.Println("                                             special values here (see the docs for details).")
 	fmt.Println("                                             The configuration for git service named <NAME> is implicitly created by")
 	fmt.Println("                                             this option")
+	fmt.Println("    --git-remotes-<NAME>-passwordVariable=<NAME> the name of the environment variable to read the password of the")
+	fmt.Println("                                             remote named <NAME> from when its password is not set (i.e. RELEASE_TOKEN)")
+	fmt.Println("    --git-remotes-<NAME>-pushTags=<TAGS>     the tags to push to the remote named <NAME>: ALL to push all the local tags,")
+	fmt.Println("                                             NEW to only push the tags applied by the release, NONE to push no tags")
+	fmt.Println("                                             or a tag name pattern with a single '*' wildcard (i.e. v*) (default: ALL)")
+	fmt.Println("    --git-remotes-<NAME>-strictHostKeyChecking=true|false when false the SSH host keys of the remote named <NAME>")
+	fmt.Println("                                             are not verified. This is insecure (default: true)")
 	fmt.Println("    --git-remotes-<NAME>-user=<TEMPLATE>     sets the password to use when connecting to the remote Git service named")
 	fmt.Println("                                             <NAME>. When using OAuth or Personal Access Tokens you may need to pass")
 	fmt.Println("                                             special values here (see the docs for details).")
 	fmt.Println("                                             The configuration for git service named <NAME> is implicitly created by")
 	fmt.Println("                                             this option")
+	fmt.Println("    --git-remotes-<NAME>-userVariable=<NAME> the name of the environment variable to read the user name of the")
+	fmt.Println("                                             remote named <NAME> from when its user name is not set")
+	fmt.Println("    --git-single-branch=true|false           when true, repositories are cloned fetching only the branch being released")
+	fmt.Println("                                             instead of all the remote branches (default: false)")
+	fmt.Println("    --git-fetch-tags=true|false              when true, tags are fetched from the remote repositories before inferring")
+	fmt.Println("                                             the version (default: false)")
+	fmt.Println("    --git-unshallow=true|false               when true, shallow repositories are unshallowed fetching the missing history")
+	fmt.Println("                                             when inferring the version hits the shallow boundary (default: true)")
+	fmt.Println("    --git-mirror=true|false                  when true, repositories are cloned as bare mirrors, with no working tree")
+	fmt.Println("                                             (default: false)")
+	fmt.Println("    --git-backend=GO_GIT|CLI|REMOTE          the Git implementation used to access repositories: the embedded")
+	fmt.Println("                                             go-git library, the git executable in the PATH or the APIs of the")
+	fmt.Println("                                             hosting service, without a local clone (default: GO_GIT)")
+	fmt.Println("    --git-service=<NAME>                     the name of the service used to access the repository with the")
+	fmt.Println("                                             REMOTE backend. It must support the COMMIT_HISTORY feature")
+	fmt.Println("    --git-trusted-keys=<KEYS>                the armored PGP public keys used to verify the signatures of")
+	fmt.Println("                                             commits and tags")
+	fmt.Println("    --git-timestamp=<SECONDS>                the timestamp, in seconds since the epoch, used for the commits")
+	fmt.Println("                                             and tags created by Nyx (i.e. $SOURCE_DATE_EPOCH). When not")
+	fmt.Println("                                             set the current time is used")
+	fmt.Println("    --git-timeout=<SECONDS>                  the maximum number of seconds each clone, fetch, push or listing")
+	fmt.Println("                                             of the remote references is allowed to take. When not set or")
+	fmt.Println("                                             zero remote operations never time out")
+	fmt.Println("    --git-retries=<NUMBER>                   the number of times each clone, fetch, push or listing of the")
+	fmt.Println("                                             remote references is retried when it fails for transient")
+	fmt.Println("                                             network or provider errors. When not set it's never retried")
+	fmt.Println("    --git-retry-delay=<SECONDS>              the number of seconds to wait before the first retry, doubling")
+	fmt.Println("                                             at every further retry. When not set it's 2 seconds")
+	fmt.Println("    --git-lock=true|false                    when true, the Infer and Mark commands lock the repository so")
+	fmt.Println("                                             that concurrent runs can't interfere (default: false)")
+	fmt.Println("    --git-lock-timeout=<SECONDS>             the maximum number of seconds to wait for the repository lock")
+	fmt.Println("                                             held by another run. When not set it fails straight away")
+	fmt.Println("    --git-no-verify=true|false               bypass the pre-commit and commit-msg hooks when Nyx creates")
+	fmt.Println("                                             commits, like 'git commit --no-verify'. Only the CLI backend")
+	fmt.Println("                                             runs hooks")
+	fmt.Println("    --git-hooks-path=<PATH>                  the directory to look up hooks from when Nyx creates commits,")
+	fmt.Println("                                             overriding the core.hooksPath Git option")
+	fmt.Println("    --git-ignore-submodules=<MODE>           the changes to submodules ignored when checking the repository")
+	fmt.Println("                                             status and staging, like 'git status --ignore-submodules'")
+	fmt.Println("                                             (none, untracked, dirty or all)")
+	fmt.Println("    --git-stash=true|false                   when true, the Mark command stashes the uncommitted changes other")
+	fmt.Println("                                             than the changelog and release metadata files before committing")
+	fmt.Println("                                             and tagging and restores them afterwards. Only the CLI backend")
+	fmt.Println("                                             supports stashing (default: false)")
+	fmt.Println()
+	fmt.Println("Impact Analyzers arguments are:")
+	fmt.Println("    --impact-analyzers-enabled=<NAMES>                the comma separated list of impact analyzer names enabled for")
+	fmt.Println("                                                      the project. Each name must correspond to an impact analyzer")
+	fmt.Println("                                                      <NAME>. Use this argument to toggle the configured impact")
+	fmt.Println("                                                      analyzers on/off")
+	fmt.Println("    --impact-analyzers-<NAME>-bump=<IDENTIFIER>       the identifier to bump when the analyzer detects an impact")
+	fmt.Println("                                                      (default: major)")
+	fmt.Println("    --impact-analyzers-<NAME>-command=<TEMPLATE>      the command line running the analyzer between the previous")
+	fmt.Println("                                                      release and the current commit")
+	fmt.Println("    --impact-analyzers-<NAME>-expression=<REGEX>      the regular expression matched against the analyzer output to")
+	fmt.Println("                                                      detect an impact (default: any non blank output)")
+	fmt.Println("    --impact-analyzers-<NAME>-title=<TEMPLATE>        the title of the report section in the changelog (default:")
+	fmt.Println("                                                      the analyzer name)")
+	fmt.Println()
+	fmt.Println("Path Rules arguments are:")
+	fmt.Println("    --path-rules-enabled=<NAMES>                      the comma separated list of path rule names enabled for the")
+	fmt.Println("                                                      project. Each name must correspond to a path rule <NAME>.")
+	fmt.Println("                                                      Use this argument to toggle the configured path rules on/off")
+	fmt.Println("    --path-rules-<NAME>-bump=<IDENTIFIER>             the identifier to bump when a commit changes any path matched")
+	fmt.Println("                                                      by the rule")
+	fmt.Println("    --path-rules-<NAME>-paths=<GLOBS>                 the comma separated list of glob patterns matching the paths")
+	fmt.Println("                                                      the rule applies to")
+	fmt.Println("    --path-rules-<NAME>-significant=true|false        when false, changes to the paths matched by the rule are not")
+	fmt.Println("                                                      significant (default: true)")
 	fmt.Println()
 	fmt.Println("Release Type arguments are:")
 	fmt.Println("    --release-types-enabled=<NAMES>                                      the comma separated list of release type names")
@@ -143,6 +344,32 @@ func PrintHelp() {
 	fmt.Println("                                                                         name must correspond to a git remote")
 	fmt.Println("                                                                         repository named <NAME>. This option applies")
 	fmt.Println("                                                                         to all release types")
+	fmt.Println("    --release-types-yanked=<VERSIONS>                                    the comma separated list of yanked versions,")
+	fmt.Println("                                                                         excluded from version inference, annotated in")
+	fmt.Println("                                                                         changelogs and marked on publication services")
+	fmt.Println("    --release-types-<NAME>-build-metadata=<TEMPLATE>                     the build metadata (like 'sha.abc1234' or")
+	fmt.Println("                                                                         'build.42') appended to new version numbers")
+	fmt.Println("                                                                         released for this release type. Only available")
+	fmt.Println("                                                                         with the SEMVER scheme. This value can be a")
+	fmt.Println("                                                                         template (see the docs) that is evaluated")
+	fmt.Println("                                                                         dynamically at runtime.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
+	fmt.Println("    --release-types-<NAME>-build-metadata-targets=<TEMPLATE>             the comma separated list of the targets where")
+	fmt.Println("                                                                         the build metadata is included, among STATE,")
+	fmt.Println("                                                                         TAGS and CHANGELOG (default: all of them). This")
+	fmt.Println("                                                                         value can be a template (see the docs) that is")
+	fmt.Println("                                                                         evaluated dynamically at runtime.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
+	fmt.Println("    --release-types-<NAME>-bump-labels-<LABEL>=<IDENTIFIER>              the identifier to bump for commits merged by")
+	fmt.Println("                                                                         pull requests having the <LABEL> label, which")
+	fmt.Println("                                                                         overrides the identifiers inferred from commit")
+	fmt.Println("                                                                         messages. Use 'none' to make those commits not")
+	fmt.Println("                                                                         bump any identifier. Pull requests are fetched")
+	fmt.Println("                                                                         from the service set by the pull-request-messages")
+	fmt.Println("                                                                         option. This argument can be repeated to set")
+	fmt.Println("                                                                         multiple options for the given release type.")
 	fmt.Println("    --release-types-<NAME>-collapse-versions=true|false                  determines if the release type uses collapsed")
 	fmt.Println("                                                                         versioning (like a pre-release increment, see")
 	fmt.Println("                                                                         the docs) or not. The configuration for a")
@@ -171,6 +398,18 @@ func PrintHelp() {
 	fmt.Println("                                                                         dynamically at runtime. The configuration")
 	fmt.Println("                                                                         for a release type named <NAME> is implicitly")
 	fmt.Println("                                                                         created by this option")
+	fmt.Println("    --release-types-<NAME>-follow-all-parents=<TEMPLATE>                 a boolean that, when true, causes all the")
+	fmt.Println("                                                                         parents of merge commits to be followed when")
+	fmt.Println("                                                                         walking the commit history, so that commits")
+	fmt.Println("                                                                         merged from other branches are also inspected.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
+	fmt.Println("                                                                         (default: false)")
+	fmt.Println("    --release-types-<NAME>-gate-policy=<EXPRESSION>                      a CEL expression that must evaluate to true")
+	fmt.Println("                                                                         against the state for the release to be")
+	fmt.Println("                                                                         committed, tagged, pushed and published. The")
+	fmt.Println("                                                                         configuration for a release type named <NAME>")
+	fmt.Println("                                                                         is implicitly created by this option")
 	fmt.Println("    --release-types-<NAME>-git-commit=<TEMPLATE>                         a boolean that, when true, causes new commits")
 	fmt.Println("                                                                         to be added to the repository if new artifacts")
 	fmt.Println("                                                                         are produced. This value can be a simple")
@@ -194,6 +433,15 @@ func PrintHelp() {
 	fmt.Println("                                                                         The configuration for a release type named")
 	fmt.Println("                                                                         <NAME> is implicitly created by this option")
 	fmt.Println("                                                                         (default: false)")
+	fmt.Println("    --release-types-<NAME>-git-push-force-with-lease=<TEMPLATE>          a boolean that, when true, causes the branch to")
+	fmt.Println("                                                                         be force pushed only if the remote branch has")
+	fmt.Println("                                                                         not been updated since it was last fetched,")
+	fmt.Println("                                                                         like 'git push --force-with-lease' does. This")
+	fmt.Println("                                                                         value can be a simple boolean or a template")
+	fmt.Println("                                                                         (see the docs) that is evaluated dynamically at")
+	fmt.Println("                                                                         runtime. The configuration for a release type")
+	fmt.Println("                                                                         named <NAME> is implicitly created by this")
+	fmt.Println("                                                                         option (default: false)")
 	fmt.Println("    --release-types-<NAME>-git-tag=<TEMPLATE>                            a boolean that, when true, causes new tags to")
 	fmt.Println("                                                                         be added to the repository if new commits are")
 	fmt.Println("                                                                         produced. This value can be a simple boolean")
@@ -233,6 +481,23 @@ func PrintHelp() {
 	fmt.Println("                                                                         type named <NAME> and an identifier with")
 	fmt.Println("                                                                         ordinal <#> is implicitly created by")
 	fmt.Println("                                                                         this option")
+	fmt.Println("    --release-types-<NAME>-ignore-cherry-picks=<TEMPLATE>                a boolean that, when true, causes commits that")
+	fmt.Println("                                                                         have been cherry-picked from versions released")
+	fmt.Println("                                                                         on other branches to be ignored when inferring")
+	fmt.Println("                                                                         the version. This value can be a simple")
+	fmt.Println("                                                                         boolean or a template (see the docs) that is")
+	fmt.Println("                                                                         evaluated dynamically at runtime.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
+	fmt.Println("                                                                         (default: false)")
+	fmt.Println("    --release-types-<NAME>-ignore-merges=<TEMPLATE>                      a boolean that, when true, causes merge commits")
+	fmt.Println("                                                                         to be left out of the release scope and not to")
+	fmt.Println("                                                                         be evaluated when inferring the version. This")
+	fmt.Println("                                                                         value can be a simple boolean or a template")
+	fmt.Println("                                                                         (see the docs) that is evaluated dynamically")
+	fmt.Println("                                                                         at runtime. The configuration for a release")
+	fmt.Println("                                                                         type named <NAME> is implicitly created by")
+	fmt.Println("                                                                         this option (default: false)")
 	fmt.Println("    --release-types-<NAME>-match-branches=<TEMPLATE>                     a regular expression that matches only the")
 	fmt.Println("                                                                         branch names for which the release type is")
 	fmt.Println("                                                                         configured and ignore the others. This value")
@@ -241,11 +506,45 @@ func PrintHelp() {
 	fmt.Println("                                                                         runtime. The configuration for a release type")
 	fmt.Println("                                                                         named <NAME> is implicitly created by")
 	fmt.Println("                                                                         this option")
+	fmt.Println("    --release-types-<NAME>-match-branch-metadata-<KEY>=<REGEX>           a rule that makes the release type effective")
+	fmt.Println("                                                                         only when a branch metadata item named <KEY>")
+	fmt.Println("                                                                         has been extracted from the branch name and")
+	fmt.Println("                                                                         its value matches <REGEX>. This argument can")
+	fmt.Println("                                                                         be repeated to set multiple options for the")
+	fmt.Println("                                                                         given release type.")
+	fmt.Println("    --release-types-<NAME>-match-changed-paths=<TEMPLATE>                a regular expression that makes the release")
+	fmt.Println("                                                                         type effective only when at least one of the")
+	fmt.Println("                                                                         paths changed by the latest commit matches it.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
+	fmt.Println("    --release-types-<NAME>-match-days-of-week=<TEMPLATE>                 a comma separated list of days of the week")
+	fmt.Println("                                                                         (i.e. MONDAY,FRIDAY) that makes the release")
+	fmt.Println("                                                                         type effective only on the given days. The")
+	fmt.Println("                                                                         configuration for a release type named <NAME>")
+	fmt.Println("                                                                         is implicitly created by this option")
 	fmt.Println("    --release-types-<NAME>-match-environment-variables-<VARNAME>=<VALUE> a rule that makes the release type effective")
 	fmt.Println("                                                                         only when an environment variable named")
 	fmt.Println("                                                                         <VARNAME> exists and has the same value as")
 	fmt.Println("                                                                         <VALUE>. This argument can be repeated to set")
 	fmt.Println("                                                                         multiple options for the given release type.")
+	fmt.Println("    --release-types-<NAME>-match-expression=<TEMPLATE>                   a template that makes the release type")
+	fmt.Println("                                                                         effective only when it evaluates to true. The")
+	fmt.Println("                                                                         configuration for a release type named <NAME>")
+	fmt.Println("                                                                         is implicitly created by this option")
+	fmt.Println("    --release-types-<NAME>-match-mode=ALL|ANY                            the logic used to combine the matching rules of")
+	fmt.Println("                                                                         the release type: ALL (the default) requires")
+	fmt.Println("                                                                         all the rules to be satisfied, ANY just one of")
+	fmt.Println("                                                                         them")
+	fmt.Println("    --release-types-<NAME>-match-policy=<EXPRESSION>                     a CEL expression that makes the release type")
+	fmt.Println("                                                                         effective only when it evaluates to true")
+	fmt.Println("                                                                         against the state. The configuration for a")
+	fmt.Println("                                                                         release type named <NAME> is implicitly created")
+	fmt.Println("                                                                         by this option")
+	fmt.Println("    --release-types-<NAME>-match-tags=<TEMPLATE>                         a regular expression that makes the release")
+	fmt.Println("                                                                         type effective only when at least one of the")
+	fmt.Println("                                                                         tags applied to the latest commit matches it.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
 	fmt.Println("    --release-types-<NAME>-match-workspace-status=CLEAN|DIRTY            a rule that makes the release type effective")
 	fmt.Println("                                                                         only the git repository is in the given state")
 	fmt.Println("                                                                         (CLEAN means there are no uncommitted changes,")
@@ -258,6 +557,66 @@ func PrintHelp() {
 	fmt.Println("                                                                         at runtime. The configuration for a release")
 	fmt.Println("                                                                         type named <NAME> is implicitly created by")
 	fmt.Println("                                                                         this option (default: false)")
+	fmt.Println("    --release-types-<NAME>-publish-approval-environment=<TEMPLATE>       the name of the protected environment on the")
+	fmt.Println("                                                                         hosting service that must approve new releases")
+	fmt.Println("                                                                         before they are published. This value can be a")
+	fmt.Println("                                                                         simple string or a template (see the docs) that")
+	fmt.Println("                                                                         is evaluated dynamically at runtime. The")
+	fmt.Println("                                                                         configuration for a release type named <NAME>")
+	fmt.Println("                                                                         is implicitly created by this option")
+	fmt.Println("    --release-types-<NAME>-publish-approval-polling-interval=<TEMPLATE>  the number of seconds between two subsequent")
+	fmt.Println("                                                                         checks of the publish approval status. The")
+	fmt.Println("                                                                         configuration for a release type named <NAME>")
+	fmt.Println("                                                                         is implicitly created by this option")
+	fmt.Println("                                                                         (default: 30)")
+	fmt.Println("    --release-types-<NAME>-publish-approval-timeout=<TEMPLATE>           the maximum number of seconds to wait for the")
+	fmt.Println("                                                                         publish approval. The configuration for a")
+	fmt.Println("                                                                         release type named <NAME> is implicitly created")
+	fmt.Println("                                                                         by this option (default: 1800)")
+	fmt.Println("    --release-types-<NAME>-pull-request-messages=<TEMPLATE>              the name of the service (among those configured)")
+	fmt.Println("                                                                         used to fetch the pull requests that merged")
+	fmt.Println("                                                                         commits, whose titles and labels are used in")
+	fmt.Println("                                                                         place of commit messages when inferring the")
+	fmt.Println("                                                                         version and building the changelog. This value")
+	fmt.Println("                                                                         can be a simple string or a template (see the")
+	fmt.Println("                                                                         docs) that is evaluated dynamically at runtime.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
+	fmt.Println("    --release-types-<NAME>-release-metadata-file=<TEMPLATE>              the path of a JSON file with the version, date,")
+	fmt.Println("                                                                         commit and branch of the release, written and")
+	fmt.Println("                                                                         committed when marking the release so it can be")
+	fmt.Println("                                                                         used as a build information source. This value")
+	fmt.Println("                                                                         can be a simple string or a template (see the")
+	fmt.Println("                                                                         docs) that is evaluated dynamically at runtime.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
+	fmt.Println("    --release-types-<NAME>-required-environment-variables=<NAMES>        a comma separated list of the names of the")
+	fmt.Println("                                                                         environment variables (i.e. secrets) that must")
+	fmt.Println("                                                                         be set and not empty for the release type. All")
+	fmt.Println("                                                                         missing variables are reported at once, before")
+	fmt.Println("                                                                         any release step runs.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
+	fmt.Println("    --release-types-<NAME>-require-signed-commits=<TEMPLATE>             a boolean that, when true, causes the process")
+	fmt.Println("                                                                         to stop with an error when any of the commits")
+	fmt.Println("                                                                         in the release scope doesn't have a valid")
+	fmt.Println("                                                                         signature, verified against the Git trusted")
+	fmt.Println("                                                                         keys. This value can be a simple boolean or a")
+	fmt.Println("                                                                         template (see the docs) that is evaluated")
+	fmt.Println("                                                                         dynamically at runtime.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
+	fmt.Println("                                                                         (default: false)")
+	fmt.Println("    --release-types-<NAME>-version-constraint=<TEMPLATE>                 a version constraint (like '>=1.2 <2.0', '~1.4'")
+	fmt.Println("                                                                         or '^2.1') that new version numbers to be")
+	fmt.Println("                                                                         released for this release type must satisfy.")
+	fmt.Println("                                                                         When the constraint isn't satisfied the release")
+	fmt.Println("                                                                         process stops with an error. Only available with")
+	fmt.Println("                                                                         the SEMVER scheme. This value can be a template")
+	fmt.Println("                                                                         (see the docs) that is evaluated dynamically at")
+	fmt.Println("                                                                         runtime.")
+	fmt.Println("                                                                         The configuration for a release type named")
+	fmt.Println("                                                                         <NAME> is implicitly created by this option")
 	fmt.Println("    --release-types-<NAME>-version-range=<TEMPLATE>                      a regular expression that matches new version")
 	fmt.Println("                                                                         numbers to be released for this release type.")
 	fmt.Println("                                                                         When the expression doesn't match new version")
@@ -277,12 +636,21 @@ func PrintHelp() {
 	fmt.Println("                                                                         <NAME> is implicitly created by this option")
 	fmt.Println("                                                                         (default: false)")
 	fmt.Println()
+	fmt.Println("Server arguments are:")
+	fmt.Println("    --server-address=<ADDRESS>  the address (host and port) the server listens on (default: :8080)")
+	fmt.Println("    --server-branches=<REGEX>   the regular expression matching the names of the pushed branches to run the")
+	fmt.Println("                                release process for (default: all branches)")
+	fmt.Println("    --server-command=<NAME>     the command to run for each matching push (default: publish)")
+	fmt.Println("    --server-secret=<TEMPLATE>  the secret used to verify the webhook signatures. This option is required")
+	fmt.Println()
 	fmt.Println("Services arguments are:")
 	fmt.Println("    --services-<NAME>-type=<TYPE>                sets the <TYPE> for the service configuration named <NAME>. <NAME>")
 	fmt.Println("                                                 can be any name assigned by the user and is a symbolic name for the")
 	fmt.Println("                                                 service configuration. <TYPE> must be a supported service type")
-	fmt.Println("                                                 (GITHUB or GITLAB). The configuration for a service named <NAME>")
-	fmt.Println("                                                 is implicitly created by this option")
+	fmt.Println("                                                 (GERRIT, GITHUB, GITLAB, GO_PROXY, PACKAGE_REPOSITORY,")
+	fmt.Println("                                                 TERRAFORM_REGISTRY or VERSIONS_FILE).")
+	fmt.Println("                                                 The configuration for a service named <NAME> is implicitly created")
+	fmt.Println("                                                 by this option")
 	fmt.Println("    --services-<NAME>-options-<OPTION>=<VALUE>   sets the option named <OPTION> to the given <VALUE> for the service")
 	fmt.Println("                                                 named <NAME>. <NAME> can be any name assigned by the user and is a")
 	fmt.Println("                                                 symbolic name for the service configuration. <OPTION> and <VALUE>")
@@ -26,13 +26,20 @@ import (
 	"fmt"           // https://pkg.go.dev/fmt
 	"os"            // https://pkg.go.dev/os
 	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
 	"sync"          // https://pkg.go.dev/sync
 
 	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
 
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
 	io "github.com/mooltiverse/nyx/modules/go/nyx/io"
+	svc "github.com/mooltiverse/nyx/modules/go/nyx/services"
+	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+	gh "github.com/mooltiverse/nyx/modules/go/nyx/services/github"
+	tpl "github.com/mooltiverse/nyx/modules/go/nyx/template"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
 	ver "github.com/mooltiverse/nyx/modules/go/version"
 )
 
@@ -63,15 +70,38 @@ type Configuration struct {
 	// The private instance of the commit message convention configuration section.
 	commitMessageConventionsSection *ent.CommitMessageConventions
 
+	// The private instance of the downstream updates configuration section.
+	downstreamUpdatesSection *ent.DownstreamUpdates
+
+	// The private instance of the event emitters configuration section.
+	eventBusSection *ent.EventBus
+
 	// The private instance of the Git configuration section.
 	gitSection *ent.GitConfiguration
 
+	// The private instance of the impact analyzers configuration section.
+	impactAnalyzersSection *ent.ImpactAnalyzers
+
+	// The key (service, owner and repository) the organization configuration has last been discovered with, used
+	// to avoid querying the remote service again when the layers are updated. A nil value means discovery never happened.
+	organizationConfigurationKey *string
+
+	// The organization configuration layer last discovered with the organization configuration key. A nil value means
+	// no configuration was found.
+	organizationConfigurationLayer *ConfigurationLayer
+
+	// The private instance of the path rules configuration section.
+	pathRulesSection *ent.PathRules
+
 	// The private instance of the release assets configuration section.
 	releaseAssetsSection *map[string]*ent.Attachment
 
 	// The private instance of the release types configuration section.
 	releaseTypesSection *ent.ReleaseTypes
 
+	// The private instance of the server configuration section.
+	serverSection *ent.ServerConfiguration
+
 	// The private instance of the services configuration section.
 	servicesSection *map[string]*ent.ServiceConfiguration
 
@@ -224,9 +254,14 @@ func (c *Configuration) resetCache() {
 
 	c.changelogSection = nil
 	c.commitMessageConventionsSection = nil
+	c.downstreamUpdatesSection = nil
+	c.eventBusSection = nil
 	c.gitSection = nil
+	c.impactAnalyzersSection = nil
+	c.pathRulesSection = nil
 	c.releaseAssetsSection = nil
 	c.releaseTypesSection = nil
+	c.serverSection = nil
 	c.servicesSection = nil
 	c.substitutionsSection = nil
 }
@@ -303,8 +338,19 @@ func (c *Configuration) updateConfiguredConfigurationLayers() error {
 		log.Debugf("custom shared configuration file '%s' loaded", file)
 	}
 
+	// now the organization configuration, discovered from the remote service only when no local configuration file exists
+	if c.layers[CUSTOM_LOCAL_FILE] != nil || c.layers[STANDARD_LOCAL_FILE] != nil {
+		log.Debug("a local configuration file is available, clearing the organization configuration, if any")
+		c.layers[ORGANIZATION_FILE] = nil
+	} else {
+		organizationConfigurationLayer, err := c.discoverOrganizationConfiguration()
+		if err != nil {
+			return err
+		}
+		c.layers[ORGANIZATION_FILE] = organizationConfigurationLayer
+	}
+
 	// now the preset
-	// now the local shared configuration file
 	preset, err := c.GetPreset()
 	if err != nil {
 		return err
@@ -329,6 +375,171 @@ func (c *Configuration) updateConfiguredConfigurationLayers() error {
 	return nil
 }
 
+/*
+Discovers the organization configuration from the organization repository using the configured service and returns
+the layer it has been loaded into, or nil if discovery is disabled or no configuration file is found in the repository.
+
+The result is cached so the remote service is only queried again when the service, the repository owner or the
+repository name change.
+
+This method is invoked by updateConfiguredConfigurationLayers() so it must not acquire the configuration lock.
+
+Errors can be:
+
+- DataAccessError: in case data cannot be read or accessed.
+- IllegalPropertyError: in case some option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) discoverOrganizationConfiguration() (*ConfigurationLayer, error) {
+	serviceName, err := c.GetOrganizationConfigurationService()
+	if err != nil {
+		return nil, err
+	}
+	if serviceName == nil {
+		log.Debug("no organization configuration service has been defined, clearing the organization configuration, if any")
+		return nil, nil
+	} else if *serviceName == "" {
+		log.Error("an empty name has been defined for the organization configuration service and it will be ignored")
+		return nil, nil
+	}
+	repositoryName, err := c.GetOrganizationConfigurationRepository()
+	if err != nil {
+		return nil, err
+	}
+	if repositoryName == nil || *repositoryName == "" {
+		log.Error("an empty name has been defined for the organization configuration repository and it will be ignored")
+		return nil, nil
+	}
+
+	// look up the service configuration straight from the layers instead of using GetServices() to avoid caching
+	// the services section before the organization configuration layer is in place
+	var serviceConfiguration *ent.ServiceConfiguration
+	for layerPriority, layer := range c.layers {
+		if layer != nil && layerPriority != int(ORGANIZATION_FILE) {
+			services, err := (*layer).GetServices()
+			if err != nil {
+				return nil, err
+			}
+			if services != nil && (*services)[*serviceName] != nil {
+				serviceConfiguration = (*services)[*serviceName]
+				break
+			}
+		}
+	}
+	if serviceConfiguration == nil || serviceConfiguration.GetType() == nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the organization configuration service '%s' has not been configured or has no type", *serviceName)}
+	}
+	options := make(map[string]string)
+	if serviceConfiguration.GetOptions() != nil {
+		for optionKey, optionValue := range *serviceConfiguration.GetOptions() {
+			renderedValue, err := tpl.Render(optionValue, nil)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("unable to render the option '%s' of the organization configuration service '%s'", optionKey, *serviceName), Cause: err}
+			}
+			options[optionKey] = renderedValue
+		}
+	}
+
+	// the repository may be given as 'owner/name', otherwise the owner comes from the service options or the remote URL
+	var owner *string
+	name := *repositoryName
+	if i := strings.LastIndex(*repositoryName, "/"); i >= 0 {
+		owner = utl.PointerToString((*repositoryName)[:i])
+		name = (*repositoryName)[i+1:]
+	} else if repositoryOwner, ok := options[gh.REPOSITORY_OWNER_OPTION_NAME]; !ok || repositoryOwner == "" {
+		owner = c.getRemoteRepositoryOwner()
+	}
+	ownerKey := ""
+	if owner != nil {
+		ownerKey = *owner
+	}
+	key := fmt.Sprintf("%s|%s|%s", *serviceName, ownerKey, name)
+	if c.organizationConfigurationKey != nil && *c.organizationConfigurationKey == key {
+		log.Debugf("the organization configuration has already been discovered using service '%s'", *serviceName)
+		return c.organizationConfigurationLayer, nil
+	}
+
+	service, err := svc.Instance(*serviceConfiguration.GetType(), options)
+	if err != nil {
+		return nil, err
+	}
+	if !service.Supports(svcapi.PULL_REQUESTS) {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the organization configuration service '%s' does not support reading files from repositories", *serviceName)}
+	}
+	pullRequestService := service.(svcapi.PullRequestService)
+
+	var layer *ConfigurationLayer
+	for _, fileName := range []string{".nyx.json", ".nyx.yaml", ".nyx.yml"} {
+		log.Debugf("searching for the organization configuration file '%s' in repository '%s' using service '%s'", fileName, *repositoryName, *serviceName)
+		content, err := pullRequestService.GetFileContent(owner, &name, nil, fileName)
+		if err != nil {
+			log.Debugf("organization configuration file '%s' not available in repository '%s': %v", fileName, *repositoryName, err)
+			continue
+		}
+		var scl ConfigurationLayer = NewSimpleConfigurationLayer()
+		err = io.LoadFromContent([]byte(content), fileName, scl)
+		if err != nil {
+			return nil, err
+		}
+		layer = &scl
+		log.Debugf("organization configuration file '%s' loaded from repository '%s'", fileName, *repositoryName)
+		break
+	}
+	if layer == nil {
+		log.Warnf("no organization configuration file found in repository '%s' using service '%s', the organization configuration will be ignored", *repositoryName, *serviceName)
+	}
+	c.organizationConfigurationKey = &key
+	c.organizationConfigurationLayer = layer
+	return layer, nil
+}
+
+/*
+Returns the owner of the repository in the configured directory, inferred from the URL of its default remote, or nil
+if it can't be inferred.
+
+The owner is everything in the URL path before the repository name so nested groups (like GitLab subgroups) are retained.
+*/
+func (c *Configuration) getRemoteRepositoryOwner() *string {
+	directory, err := c.GetDirectory()
+	if err != nil || directory == nil {
+		return nil
+	}
+	repository, err := git.GitInstance().Open(*directory)
+	if err != nil {
+		log.Debugf("unable to open the Git repository in '%s' to infer the organization: %v", *directory, err)
+		return nil
+	}
+	remoteURL, err := repository.GetRemoteURL(utl.PointerToString(git.DEFAULT_REMOTE_NAME))
+	if err != nil {
+		log.Debugf("unable to read the remote URL to infer the organization: %v", err)
+		return nil
+	}
+	return repositoryOwnerFromURL(remoteURL)
+}
+
+/*
+Returns the owner of the repository from the given remote URL, or nil if the URL doesn't have an owner.
+Both standard URLs (like https://host/owner/repository.git) and SCP-like URLs (like git@host:owner/repository.git)
+are supported.
+*/
+func repositoryOwnerFromURL(remoteURL string) *string {
+	path := strings.TrimSpace(remoteURL)
+	if i := strings.Index(path, "://"); i >= 0 {
+		path = path[i+3:]
+		if j := strings.Index(path, "/"); j >= 0 {
+			path = path[j+1:]
+		} else {
+			return nil
+		}
+	} else if i := strings.Index(path, ":"); i >= 0 {
+		path = path[i+1:]
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if i := strings.LastIndex(path, "/"); i > 0 {
+		return utl.PointerToString(path[:i])
+	}
+	return nil
+}
+
 /*
 Flattens the resolved configuration represented by this object into a simple configuration object
 where all dynamic values have been resolved.
@@ -353,6 +564,18 @@ func (c *Configuration) Flatten() (*SimpleConfigurationLayer, error) {
 	//
 	// Invoking all the getter methods also causes this object to resolve all fields, even those that weren't
 	// resolved before.
+	analyticsFile, err := c.GetAnalyticsFile()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "analyticsFile"), Cause: err}
+	}
+	badgesDirectory, err := c.GetBadgesDirectory()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "badgesDirectory"), Cause: err}
+	}
+	branchMetadataExpression, err := c.GetBranchMetadataExpression()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "branchMetadataExpression"), Cause: err}
+	}
 	bump, err := c.GetBump()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "bump"), Cause: err}
@@ -361,10 +584,18 @@ func (c *Configuration) Flatten() (*SimpleConfigurationLayer, error) {
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "changelog"), Cause: err}
 	}
+	commitChanges, err := c.GetCommitChanges()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "commitChanges"), Cause: err}
+	}
 	commitMessageConventions, err := c.GetCommitMessageConventions()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "commitMessageConventions"), Cause: err}
 	}
+	commitLintFile, err := c.GetCommitLintFile()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "commitLintFile"), Cause: err}
+	}
 	configurationFile, err := c.GetConfigurationFile()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "configurationFile"), Cause: err}
@@ -373,18 +604,54 @@ func (c *Configuration) Flatten() (*SimpleConfigurationLayer, error) {
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "directory"), Cause: err}
 	}
+	downstreamUpdates, err := c.GetDownstreamUpdates()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "downstreamUpdates"), Cause: err}
+	}
 	dryRun, err := c.GetDryRun()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "dryRun"), Cause: err}
 	}
+	eventBus, err := c.GetEventBus()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "eventBus"), Cause: err}
+	}
 	git, err := c.GetGit()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "git"), Cause: err}
 	}
+	impactAnalyzers, err := c.GetImpactAnalyzers()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "impactAnalyzers"), Cause: err}
+	}
 	initialVersion, err := c.GetInitialVersion()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "initialVersion"), Cause: err}
 	}
+	junitReportFile, err := c.GetJUnitReportFile()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "junitReportFile"), Cause: err}
+	}
+	nothingToRelease, err := c.GetNothingToRelease()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "nothingToRelease"), Cause: err}
+	}
+	organizationConfigurationRepository, err := c.GetOrganizationConfigurationRepository()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "organizationConfigurationRepository"), Cause: err}
+	}
+	organizationConfigurationService, err := c.GetOrganizationConfigurationService()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "organizationConfigurationService"), Cause: err}
+	}
+	pathRules, err := c.GetPathRules()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "pathRules"), Cause: err}
+	}
+	prereleaseOrder, err := c.GetPrereleaseOrder()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "prereleaseOrder"), Cause: err}
+	}
 	preset, err := c.GetPreset()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "preset"), Cause: err}
@@ -393,6 +660,10 @@ func (c *Configuration) Flatten() (*SimpleConfigurationLayer, error) {
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "releaseAssets"), Cause: err}
 	}
+	releaseCoercion, err := c.GetReleaseCoercion()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "releaseCoercion"), Cause: err}
+	}
 	releaseLenient, err := c.GetReleaseLenient()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "releaseLenient"), Cause: err}
@@ -405,14 +676,26 @@ func (c *Configuration) Flatten() (*SimpleConfigurationLayer, error) {
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "releaseTypes"), Cause: err}
 	}
+	reportFile, err := c.GetReportFile()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "reportFile"), Cause: err}
+	}
 	resume, err := c.GetResume()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "resume"), Cause: err}
 	}
+	revisionBump, err := c.GetRevisionBump()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "revisionBump"), Cause: err}
+	}
 	scheme, err := c.GetScheme()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "scheme"), Cause: err}
 	}
+	server, err := c.GetServer()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "server"), Cause: err}
+	}
 	services, err := c.GetServices()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "services"), Cause: err}
@@ -425,6 +708,10 @@ func (c *Configuration) Flatten() (*SimpleConfigurationLayer, error) {
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "stateFile"), Cause: err}
 	}
+	strictExitCodes, err := c.GetStrictExitCodes()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "strictExitCodes"), Cause: err}
+	}
 	substitutions, err := c.GetSubstitutions()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "substitutions"), Cause: err}
@@ -437,6 +724,18 @@ func (c *Configuration) Flatten() (*SimpleConfigurationLayer, error) {
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "summaryFile"), Cause: err}
 	}
+	telemetryEndpoint, err := c.GetTelemetryEndpoint()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "telemetryEndpoint"), Cause: err}
+	}
+	telemetryFile, err := c.GetTelemetryFile()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "telemetryFile"), Cause: err}
+	}
+	timestampSource, err := c.GetTimestampSource()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "timestampSource"), Cause: err}
+	}
 	verbosity, err := c.GetVerbosity()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "verbosity"), Cause: err}
@@ -445,31 +744,59 @@ func (c *Configuration) Flatten() (*SimpleConfigurationLayer, error) {
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "version"), Cause: err}
 	}
+	versionStorageService, err := c.GetVersionStorageService()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "versionStorageService"), Cause: err}
+	}
 
+	// stateFileSigningKey is deliberately left out as it's a secret and must never end up in the state file
 	return &SimpleConfigurationLayer{
-		Bump:                     bump,
-		Changelog:                changelog,
-		CommitMessageConventions: commitMessageConventions,
-		ConfigurationFile:        configurationFile,
-		Directory:                directory,
-		DryRun:                   dryRun,
-		Git:                      git,
-		InitialVersion:           initialVersion,
-		Preset:                   preset,
-		ReleaseAssets:            releaseAssets,
-		ReleaseLenient:           releaseLenient,
-		ReleasePrefix:            releasePrefix,
-		ReleaseTypes:             releaseTypes,
-		Resume:                   resume,
-		Scheme:                   scheme,
-		Services:                 services,
-		SharedConfigurationFile:  sharedConfigurationFile,
-		Substitutions:            substitutions,
-		StateFile:                stateFile,
-		Summary:                  summary,
-		SummaryFile:              summaryFile,
-		Verbosity:                verbosity,
-		Version:                  version,
+		AnalyticsFile:                       analyticsFile,
+		BadgesDirectory:                     badgesDirectory,
+		BranchMetadataExpression:            branchMetadataExpression,
+		Bump:                                bump,
+		Changelog:                           changelog,
+		CommitChanges:                       commitChanges,
+		CommitMessageConventions:            commitMessageConventions,
+		CommitLintFile:                      commitLintFile,
+		ConfigurationFile:                   configurationFile,
+		Directory:                           directory,
+		DownstreamUpdates:                   downstreamUpdates,
+		DryRun:                              dryRun,
+		EventBus:                            eventBus,
+		Git:                                 git,
+		ImpactAnalyzers:                     impactAnalyzers,
+		InitialVersion:                      initialVersion,
+		JUnitReportFile:                     junitReportFile,
+		NothingToRelease:                    nothingToRelease,
+		OrganizationConfigurationRepository: organizationConfigurationRepository,
+		OrganizationConfigurationService:    organizationConfigurationService,
+		PathRules:                           pathRules,
+		PrereleaseOrder:                     prereleaseOrder,
+		Preset:                              preset,
+		ReleaseAssets:                       releaseAssets,
+		ReleaseCoercion:                     releaseCoercion,
+		ReleaseLenient:                      releaseLenient,
+		ReleasePrefix:                       releasePrefix,
+		ReleaseTypes:                        releaseTypes,
+		ReportFile:                          reportFile,
+		Resume:                              resume,
+		RevisionBump:                        revisionBump,
+		Scheme:                              scheme,
+		Server:                              server,
+		Services:                            services,
+		SharedConfigurationFile:             sharedConfigurationFile,
+		Substitutions:                       substitutions,
+		StateFile:                           stateFile,
+		StrictExitCodes:                     strictExitCodes,
+		Summary:                             summary,
+		SummaryFile:                         summaryFile,
+		TelemetryEndpoint:                   telemetryEndpoint,
+		TelemetryFile:                       telemetryFile,
+		TimestampSource:                     timestampSource,
+		Verbosity:                           verbosity,
+		Version:                             version,
+		VersionStorageService:               versionStorageService,
 	}, nil
 }
 
@@ -601,6 +928,78 @@ func (c *Configuration) WithRuntimeConfiguration(layer *ConfigurationLayer) (*Co
 	return c, nil
 }
 
+/*
+Returns the path to the file where the analytics report about past releases is written as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetAnalyticsFile() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "analyticsFile")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			analyticsFile, err := (*configurationLayer).GetAnalyticsFile()
+			if err != nil {
+				return nil, err
+			}
+			if analyticsFile != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "analyticsFile", *analyticsFile)
+				return analyticsFile, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetAnalyticsFile()
+}
+
+/*
+Returns the directory where the version and release date badges are written as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetBadgesDirectory() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "badgesDirectory")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			badgesDirectory, err := (*configurationLayer).GetBadgesDirectory()
+			if err != nil {
+				return nil, err
+			}
+			if badgesDirectory != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "badgesDirectory", *badgesDirectory)
+				return badgesDirectory, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetBadgesDirectory()
+}
+
+/*
+Returns the regular expression used to extract metadata from the current branch name as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetBranchMetadataExpression() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "branchMetadataExpression")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			branchMetadataExpression, err := (*configurationLayer).GetBranchMetadataExpression()
+			if err != nil {
+				return nil, err
+			}
+			if branchMetadataExpression != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "branchMetadataExpression", *branchMetadataExpression)
+				return branchMetadataExpression, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetBranchMetadataExpression()
+}
+
 /*
 Returns the version identifier to bump as it's defined by this configuration.
 
@@ -640,7 +1039,7 @@ func (c *Configuration) GetChangelog() (*ent.ChangelogConfiguration, error) {
 			if layer != nil {
 				// Since all attributes of the changelog configuration are objects we assume that if they are nil
 				// they have the default values and we keep non nil values as those overriding defaults.
-				// The sections map is assumed to override inherited values if its size is not 0
+				// The sections, badges and emojis maps are assumed to override inherited values if their size is not 0
 				changelog, err := (*layer).GetChangelog()
 				if err != nil {
 					return nil, err
@@ -649,6 +1048,24 @@ func (c *Configuration) GetChangelog() (*ent.ChangelogConfiguration, error) {
 				if c.changelogSection.GetAppend() == nil {
 					c.changelogSection.SetAppend(changelog.GetAppend())
 				}
+				if c.changelogSection.GetBadges() == nil || len(*c.changelogSection.GetBadges()) == 0 {
+					c.changelogSection.SetBadges(changelog.GetBadges())
+				}
+				if c.changelogSection.GetCollapseThreshold() == nil {
+					c.changelogSection.SetCollapseThreshold(changelog.GetCollapseThreshold())
+				}
+				if c.changelogSection.GetDeduplicateCherryPicks() == nil {
+					c.changelogSection.SetDeduplicateCherryPicks(changelog.GetDeduplicateCherryPicks())
+				}
+				if c.changelogSection.GetEmojis() == nil || len(*c.changelogSection.GetEmojis()) == 0 {
+					c.changelogSection.SetEmojis(changelog.GetEmojis())
+				}
+				if c.changelogSection.GetGroupDependencyUpdates() == nil {
+					c.changelogSection.SetGroupDependencyUpdates(changelog.GetGroupDependencyUpdates())
+				}
+				if c.changelogSection.GetMergePreReleases() == nil {
+					c.changelogSection.SetMergePreReleases(changelog.GetMergePreReleases())
+				}
 				if c.changelogSection.GetPath() == nil {
 					c.changelogSection.SetPath(changelog.GetPath())
 				}
@@ -661,6 +1078,9 @@ func (c *Configuration) GetChangelog() (*ent.ChangelogConfiguration, error) {
 				if c.changelogSection.GetTemplate() == nil {
 					c.changelogSection.SetTemplate(changelog.GetTemplate())
 				}
+				if c.changelogSection.GetUpgradeNotes() == nil {
+					c.changelogSection.SetUpgradeNotes(changelog.GetUpgradeNotes())
+				}
 			}
 		}
 		log.Tracef("the '%s' configuration option has been resolved", "changelog")
@@ -668,6 +1088,31 @@ func (c *Configuration) GetChangelog() (*ent.ChangelogConfiguration, error) {
 	return c.changelogSection, nil
 }
 
+/*
+Returns the flag that enables computing the files changed by each commit in the release scope
+as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetCommitChanges() (*bool, error) {
+	log.Tracef("retrieving the '%s' configuration option", "commitChanges")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			commitChanges, err := (*configurationLayer).GetCommitChanges()
+			if err != nil {
+				return nil, err
+			}
+			if commitChanges != nil {
+				log.Tracef("the '%s' configuration option value is: '%v'", "commitChanges", *commitChanges)
+				return commitChanges, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetCommitChanges()
+}
+
 /*
 Returns the commit message convention configuration section.
 
@@ -725,6 +1170,30 @@ func (c *Configuration) GetCommitMessageConventions() (*ent.CommitMessageConvent
 	return c.commitMessageConventionsSection, nil
 }
 
+/*
+Returns the path to the file where the commit lint report is written in the SARIF format as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetCommitLintFile() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "commitLintFile")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			commitLintFile, err := (*configurationLayer).GetCommitLintFile()
+			if err != nil {
+				return nil, err
+			}
+			if commitLintFile != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "commitLintFile", *commitLintFile)
+				return commitLintFile, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetCommitLintFile()
+}
+
 /*
 Returns the path to a shared configuration file as it's defined by this configuration.
 
@@ -777,39 +1246,96 @@ func (c *Configuration) GetDirectory() (*string, error) {
 }
 
 /*
-This method allows to override the default directory that will be returned by GetDirectory().
-This method must be invoked before instances of Nyx or other classes are created or the given value may be ignored.
-*/
-func SetDefaultDirectory(directory *string) error {
-	if directory == nil {
-		log.Tracef("setting the default directory '%s'", "nil")
-		GetDefaultLayerInstance().SetDirectory(directory)
-	} else {
-		dir, err := filepath.Abs(*directory)
-		if err != nil {
-			return &errs.DataAccessError{Message: fmt.Sprintf("unable to get the absolute path for directory '%s'", dir), Cause: err}
-		}
-		log.Tracef("setting the default directory '%s'", dir)
-
-		GetDefaultLayerInstance().SetDirectory(&dir)
-	}
-	return nil
-}
-
-/*
-Returns the value of the dry run flag as it's defined by this configuration.
+Returns the downstream updates configuration section.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (c *Configuration) GetDryRun() (*bool, error) {
-	log.Tracef("retrieving the '%s' configuration option", "dryRun")
-	for _, configurationLayer := range c.layers {
-		if configurationLayer != nil {
-			dryRun, err := (*configurationLayer).GetDryRun()
-			if err != nil {
-				return nil, err
+func (c *Configuration) GetDownstreamUpdates() (*ent.DownstreamUpdates, error) {
+	log.Trace("retrieving the downstream updates")
+	if c.downstreamUpdatesSection == nil {
+		// parse the 'enabled' items list
+		enabled := make([]*string, 0)
+		for _, layer := range c.layers {
+			if layer != nil {
+				downstreamUpdates, err := (*layer).GetDownstreamUpdates()
+				if err != nil {
+					return nil, err
+				}
+				if downstreamUpdates.GetEnabled() != nil && len(*downstreamUpdates.GetEnabled()) > 0 {
+					enabled = *downstreamUpdates.GetEnabled()
+					log.Tracef("the '%s.%s' configuration option value is: '%v'", "downstreamUpdates", "enabled", enabled)
+					break
+				}
+			}
+		}
+
+		// parse the 'items' map
+		items := make(map[string]*ent.DownstreamUpdate)
+		for _, enabledItem := range enabled {
+			for _, layer := range c.layers {
+				if layer != nil {
+					downstreamUpdates, err := (*layer).GetDownstreamUpdates()
+					if err != nil {
+						return nil, err
+					}
+
+					if downstreamUpdates != nil && (*downstreamUpdates).GetItems() != nil {
+						item := (*(*downstreamUpdates).GetItems())[*enabledItem]
+						if item != nil {
+							items[*enabledItem] = item
+							log.Tracef("the '%s.%s[%s]' configuration option has been resolved", "downstreamUpdates", "items", *enabledItem)
+							break
+						}
+					}
+				}
+			}
+		}
+
+		s, err := ent.NewDownstreamUpdatesWith(&enabled, &items)
+		if err != nil {
+			return nil, err
+		}
+		c.downstreamUpdatesSection = s
+	}
+	return c.downstreamUpdatesSection, nil
+}
+
+/*
+This method allows to override the default directory that will be returned by GetDirectory().
+This method must be invoked before instances of Nyx or other classes are created or the given value may be ignored.
+*/
+func SetDefaultDirectory(directory *string) error {
+	if directory == nil {
+		log.Tracef("setting the default directory '%s'", "nil")
+		GetDefaultLayerInstance().SetDirectory(directory)
+	} else {
+		dir, err := filepath.Abs(*directory)
+		if err != nil {
+			return &errs.DataAccessError{Message: fmt.Sprintf("unable to get the absolute path for directory '%s'", dir), Cause: err}
+		}
+		log.Tracef("setting the default directory '%s'", dir)
+
+		GetDefaultLayerInstance().SetDirectory(&dir)
+	}
+	return nil
+}
+
+/*
+Returns the value of the dry run flag as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetDryRun() (*bool, error) {
+	log.Tracef("retrieving the '%s' configuration option", "dryRun")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			dryRun, err := (*configurationLayer).GetDryRun()
+			if err != nil {
+				return nil, err
 			}
 			if dryRun != nil {
 				log.Tracef("the '%s' configuration option value is: '%v'", "dryRun", *dryRun)
@@ -820,6 +1346,63 @@ func (c *Configuration) GetDryRun() (*bool, error) {
 	return GetDefaultLayerInstance().GetDryRun()
 }
 
+/*
+Returns the event emitters configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetEventBus() (*ent.EventBus, error) {
+	log.Trace("retrieving the event emitters")
+	if c.eventBusSection == nil {
+		// parse the 'enabled' items list
+		enabled := make([]*string, 0)
+		for _, layer := range c.layers {
+			if layer != nil {
+				eventBus, err := (*layer).GetEventBus()
+				if err != nil {
+					return nil, err
+				}
+				if eventBus.GetEnabled() != nil && len(*eventBus.GetEnabled()) > 0 {
+					enabled = *eventBus.GetEnabled()
+					log.Tracef("the '%s.%s' configuration option value is: '%v'", "eventBus", "enabled", enabled)
+					break
+				}
+			}
+		}
+
+		// parse the 'items' map
+		items := make(map[string]*ent.EventEmitter)
+		for _, enabledItem := range enabled {
+			for _, layer := range c.layers {
+				if layer != nil {
+					eventBus, err := (*layer).GetEventBus()
+					if err != nil {
+						return nil, err
+					}
+
+					if eventBus != nil && (*eventBus).GetItems() != nil {
+						item := (*(*eventBus).GetItems())[*enabledItem]
+						if item != nil {
+							items[*enabledItem] = item
+							log.Tracef("the '%s.%s[%s]' configuration option has been resolved", "eventBus", "items", *enabledItem)
+							break
+						}
+					}
+				}
+			}
+		}
+
+		s, err := ent.NewEventBusWith(&enabled, &items)
+		if err != nil {
+			return nil, err
+		}
+		c.eventBusSection = s
+	}
+	return c.eventBusSection, nil
+}
+
 /*
 Returns the Git configuration section.
 
@@ -830,6 +1413,26 @@ Error is:
 func (c *Configuration) GetGit() (*ent.GitConfiguration, error) {
 	log.Trace("retrieving the Git configuration")
 	if c.gitSection == nil {
+		var proxy *string
+		var singleBranch *bool
+		var fetchTags *bool
+		var unshallow *bool
+		var mirror *bool
+		var backend *ent.GitBackend
+		var service *string
+		var trustedKeys *string
+		var timestamp *string
+		var noVerify *bool
+		var hooksPath *string
+		var ignoreSubmodules *string
+		var stash *bool
+		var timeout *string
+		var retries *string
+		var retryDelay *string
+		var lock *bool
+		var lockTimeout *string
+		headers := make(map[string]string)
+		identity := ent.NewGitIdentityConfiguration()
 		// parse the 'remotes' map
 		remotes := make(map[string]*ent.GitRemoteConfiguration)
 		for _, layer := range c.layers {
@@ -838,6 +1441,108 @@ func (c *Configuration) GetGit() (*ent.GitConfiguration, error) {
 				if err != nil {
 					return nil, err
 				}
+				if (*git).GetIdentity() != nil {
+					if identity.GetEmail() == nil && (*git).GetIdentity().GetEmail() != nil {
+						identity.SetEmail((*git).GetIdentity().GetEmail())
+						log.Tracef("the '%s.%s.%s' configuration option has been resolved", "git", "identity", "email")
+					}
+					if identity.GetName() == nil && (*git).GetIdentity().GetName() != nil {
+						identity.SetName((*git).GetIdentity().GetName())
+						log.Tracef("the '%s.%s.%s' configuration option has been resolved", "git", "identity", "name")
+					}
+					if identity.GetProvider() == nil && (*git).GetIdentity().GetProvider() != nil {
+						identity.SetProvider((*git).GetIdentity().GetProvider())
+						log.Tracef("the '%s.%s.%s' configuration option has been resolved", "git", "identity", "provider")
+					}
+					if identity.GetEmailVariable() == nil && (*git).GetIdentity().GetEmailVariable() != nil {
+						identity.SetEmailVariable((*git).GetIdentity().GetEmailVariable())
+						log.Tracef("the '%s.%s.%s' configuration option has been resolved", "git", "identity", "emailVariable")
+					}
+					if identity.GetNameVariable() == nil && (*git).GetIdentity().GetNameVariable() != nil {
+						identity.SetNameVariable((*git).GetIdentity().GetNameVariable())
+						log.Tracef("the '%s.%s.%s' configuration option has been resolved", "git", "identity", "nameVariable")
+					}
+				}
+				if proxy == nil && (*git).GetProxy() != nil {
+					proxy = (*git).GetProxy()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "proxy")
+				}
+				if singleBranch == nil && (*git).GetSingleBranch() != nil {
+					singleBranch = (*git).GetSingleBranch()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "singleBranch")
+				}
+				if fetchTags == nil && (*git).GetFetchTags() != nil {
+					fetchTags = (*git).GetFetchTags()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "fetchTags")
+				}
+				if unshallow == nil && (*git).GetUnshallow() != nil {
+					unshallow = (*git).GetUnshallow()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "unshallow")
+				}
+				if mirror == nil && (*git).GetMirror() != nil {
+					mirror = (*git).GetMirror()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "mirror")
+				}
+				if backend == nil && (*git).GetBackend() != nil {
+					backend = (*git).GetBackend()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "backend")
+				}
+				if service == nil && (*git).GetService() != nil {
+					service = (*git).GetService()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "service")
+				}
+				if trustedKeys == nil && (*git).GetTrustedKeys() != nil {
+					trustedKeys = (*git).GetTrustedKeys()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "trustedKeys")
+				}
+				if timestamp == nil && (*git).GetTimestamp() != nil {
+					timestamp = (*git).GetTimestamp()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "timestamp")
+				}
+				if noVerify == nil && (*git).GetNoVerify() != nil {
+					noVerify = (*git).GetNoVerify()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "noVerify")
+				}
+				if hooksPath == nil && (*git).GetHooksPath() != nil {
+					hooksPath = (*git).GetHooksPath()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "hooksPath")
+				}
+				if ignoreSubmodules == nil && (*git).GetIgnoreSubmodules() != nil {
+					ignoreSubmodules = (*git).GetIgnoreSubmodules()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "ignoreSubmodules")
+				}
+				if stash == nil && (*git).GetStash() != nil {
+					stash = (*git).GetStash()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "stash")
+				}
+				if timeout == nil && (*git).GetTimeout() != nil {
+					timeout = (*git).GetTimeout()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "timeout")
+				}
+				if retries == nil && (*git).GetRetries() != nil {
+					retries = (*git).GetRetries()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "retries")
+				}
+				if retryDelay == nil && (*git).GetRetryDelay() != nil {
+					retryDelay = (*git).GetRetryDelay()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "retryDelay")
+				}
+				if lock == nil && (*git).GetLock() != nil {
+					lock = (*git).GetLock()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "lock")
+				}
+				if lockTimeout == nil && (*git).GetLockTimeout() != nil {
+					lockTimeout = (*git).GetLockTimeout()
+					log.Tracef("the '%s.%s' configuration option has been resolved", "git", "lockTimeout")
+				}
+				if (*git).GetHeaders() != nil {
+					for headerName, headerValue := range *(*git).GetHeaders() {
+						if _, ok := headers[headerName]; !ok {
+							headers[headerName] = headerValue
+							log.Tracef("the '%s.%s[%s]' configuration option has been resolved", "git", "headers", headerName)
+						}
+					}
+				}
 				for remoteName, remote := range *(*git).GetRemotes() {
 					if remote != nil && remotes[remoteName] == nil {
 						remotes[remoteName] = remote
@@ -847,7 +1552,7 @@ func (c *Configuration) GetGit() (*ent.GitConfiguration, error) {
 			}
 		}
 
-		gs, err := ent.NewGitConfigurationWith(&remotes)
+		gs, err := ent.NewGitConfigurationWith(&headers, identity, proxy, &remotes, singleBranch, fetchTags, unshallow, mirror, backend, service, trustedKeys, timestamp, noVerify, hooksPath, ignoreSubmodules, stash, timeout, retries, retryDelay, lock, lockTimeout)
 		if err != nil {
 			return nil, err
 		}
@@ -856,6 +1561,63 @@ func (c *Configuration) GetGit() (*ent.GitConfiguration, error) {
 	return c.gitSection, nil
 }
 
+/*
+Returns the impact analyzers configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetImpactAnalyzers() (*ent.ImpactAnalyzers, error) {
+	log.Trace("retrieving the impact analyzers")
+	if c.impactAnalyzersSection == nil {
+		// parse the 'enabled' items list
+		enabled := make([]*string, 0)
+		for _, layer := range c.layers {
+			if layer != nil {
+				impactAnalyzers, err := (*layer).GetImpactAnalyzers()
+				if err != nil {
+					return nil, err
+				}
+				if impactAnalyzers.GetEnabled() != nil && len(*impactAnalyzers.GetEnabled()) > 0 {
+					enabled = *impactAnalyzers.GetEnabled()
+					log.Tracef("the '%s.%s' configuration option value is: '%v'", "impactAnalyzers", "enabled", enabled)
+					break
+				}
+			}
+		}
+
+		// parse the 'items' map
+		items := make(map[string]*ent.ImpactAnalyzer)
+		for _, enabledItem := range enabled {
+			for _, layer := range c.layers {
+				if layer != nil {
+					impactAnalyzers, err := (*layer).GetImpactAnalyzers()
+					if err != nil {
+						return nil, err
+					}
+
+					if impactAnalyzers != nil && (*impactAnalyzers).GetItems() != nil {
+						item := (*(*impactAnalyzers).GetItems())[*enabledItem]
+						if item != nil {
+							items[*enabledItem] = item
+							log.Tracef("the '%s.%s[%s]' configuration option has been resolved", "impactAnalyzers", "items", *enabledItem)
+							break
+						}
+					}
+				}
+			}
+		}
+
+		s, err := ent.NewImpactAnalyzersWith(&enabled, &items)
+		if err != nil {
+			return nil, err
+		}
+		c.impactAnalyzersSection = s
+	}
+	return c.impactAnalyzersSection, nil
+}
+
 /*
 Returns the initial version defined by this configuration to use when no past version is available in the commit history.
 
@@ -865,8 +1627,8 @@ Error is:
 */
 func (c *Configuration) GetInitialVersion() (*string, error) {
 	log.Tracef("retrieving the '%s' configuration option", "initialVersion")
-	for _, configurationLayer := range c.layers {
-		if configurationLayer != nil {
+	for layerPriority, configurationLayer := range c.layers {
+		if configurationLayer != nil && layerPriority != int(DEFAULT) {
 			initialVersion, err := (*configurationLayer).GetInitialVersion()
 			if err != nil {
 				return nil, err
@@ -877,9 +1639,202 @@ func (c *Configuration) GetInitialVersion() (*string, error) {
 			}
 		}
 	}
+	// when not configured, the initial version is the default one for the scheme in use
+	scheme, err := c.GetScheme()
+	if err != nil {
+		return nil, err
+	}
+	if scheme != nil && ver.SEMVER != *scheme {
+		initialVersion := ver.DefaultInitial(*scheme).String()
+		log.Tracef("the '%s' configuration option value is the default for the '%s' scheme: '%s'", "initialVersion", scheme.String(), initialVersion)
+		return &initialVersion, nil
+	}
 	return GetDefaultLayerInstance().GetInitialVersion()
 }
 
+/*
+Returns the path to the file where the JUnit XML release report must be saved as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetJUnitReportFile() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "junitReportFile")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			junitReportFile, err := (*configurationLayer).GetJUnitReportFile()
+			if err != nil {
+				return nil, err
+			}
+			if junitReportFile != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "junitReportFile", *junitReportFile)
+				return junitReportFile, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetJUnitReportFile()
+}
+
+/*
+Returns the policy applied when no significant commits are found since the previous version, so there is nothing to release.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetNothingToRelease() (*ent.NothingToReleasePolicy, error) {
+	log.Tracef("retrieving the '%s' configuration option", "nothingToRelease")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			nothingToRelease, err := (*configurationLayer).GetNothingToRelease()
+			if err != nil {
+				return nil, err
+			}
+			if nothingToRelease != nil {
+				log.Tracef("the '%s' configuration option value is: '%v'", "nothingToRelease", *nothingToRelease)
+				return nothingToRelease, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetNothingToRelease()
+}
+
+/*
+Returns the name of the repository the organization configuration is discovered from as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetOrganizationConfigurationRepository() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "organizationConfigurationRepository")
+	for layerPriority, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			// organization configuration options are ignored on the organization configuration layer to avoid chaining
+			if layerPriority != int(ORGANIZATION_FILE) {
+				organizationConfigurationRepository, err := (*configurationLayer).GetOrganizationConfigurationRepository()
+				if err != nil {
+					return nil, err
+				}
+				if organizationConfigurationRepository != nil {
+					log.Tracef("the '%s' configuration option value is: '%s'", "organizationConfigurationRepository", *organizationConfigurationRepository)
+					return organizationConfigurationRepository, nil
+				}
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetOrganizationConfigurationRepository()
+}
+
+/*
+Returns the name of the service used to discover the organization configuration as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetOrganizationConfigurationService() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "organizationConfigurationService")
+	for layerPriority, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			// organization configuration options are ignored on the organization configuration layer to avoid chaining
+			if layerPriority != int(ORGANIZATION_FILE) {
+				organizationConfigurationService, err := (*configurationLayer).GetOrganizationConfigurationService()
+				if err != nil {
+					return nil, err
+				}
+				if organizationConfigurationService != nil {
+					log.Tracef("the '%s' configuration option value is: '%s'", "organizationConfigurationService", *organizationConfigurationService)
+					return organizationConfigurationService, nil
+				}
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetOrganizationConfigurationService()
+}
+
+/*
+Returns the path rules configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetPathRules() (*ent.PathRules, error) {
+	log.Trace("retrieving the path rules")
+	if c.pathRulesSection == nil {
+		// parse the 'enabled' items list
+		enabled := make([]*string, 0)
+		for _, layer := range c.layers {
+			if layer != nil {
+				pathRules, err := (*layer).GetPathRules()
+				if err != nil {
+					return nil, err
+				}
+				if pathRules != nil && pathRules.GetEnabled() != nil && len(*pathRules.GetEnabled()) > 0 {
+					enabled = *pathRules.GetEnabled()
+					log.Tracef("the '%s.%s' configuration option value is: '%v'", "pathRules", "enabled", enabled)
+					break
+				}
+			}
+		}
+
+		// parse the 'items' map
+		items := make(map[string]*ent.PathRule)
+		for _, enabledItem := range enabled {
+			for _, layer := range c.layers {
+				if layer != nil {
+					pathRules, err := (*layer).GetPathRules()
+					if err != nil {
+						return nil, err
+					}
+
+					if pathRules != nil && (*pathRules).GetItems() != nil {
+						item := (*(*pathRules).GetItems())[*enabledItem]
+						if item != nil {
+							items[*enabledItem] = item
+							log.Tracef("the '%s.%s[%s]' configuration option has been resolved", "pathRules", "items", *enabledItem)
+							break
+						}
+					}
+				}
+			}
+		}
+
+		s, err := ent.NewPathRulesWith(&enabled, &items)
+		if err != nil {
+			return nil, err
+		}
+		c.pathRulesSection = s
+	}
+	return c.pathRulesSection, nil
+}
+
+/*
+Returns the comma separated list of pre-release identifiers, from the lowest to the highest precedence, used to compare pre-release versions as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetPrereleaseOrder() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "prereleaseOrder")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			prereleaseOrder, err := (*configurationLayer).GetPrereleaseOrder()
+			if err != nil {
+				return nil, err
+			}
+			if prereleaseOrder != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "prereleaseOrder", *prereleaseOrder)
+				return prereleaseOrder, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetPrereleaseOrder()
+}
+
 /*
 Returns the selected preset configuration as it's defined by this configuration.
 
@@ -935,6 +1890,31 @@ func (c *Configuration) GetReleaseAssets() (*map[string]*ent.Attachment, error)
 	return c.releaseAssetsSection, nil
 }
 
+/*
+Returns the flag that enables coercion of release names that look like semantic versions, but are not, into legal semantic versions
+as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetReleaseCoercion() (*bool, error) {
+	log.Tracef("retrieving the '%s' configuration option", "releaseCoercion")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			releaseCoercion, err := (*configurationLayer).GetReleaseCoercion()
+			if err != nil {
+				return nil, err
+			}
+			if releaseCoercion != nil {
+				log.Tracef("the '%s' configuration option value is: '%v'", "releaseCoercion", *releaseCoercion)
+				return releaseCoercion, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetReleaseCoercion()
+}
+
 /*
 Returns the flag that enables tolerance in reading release names with arbitrary prefixes or extra non critical characters
 as it's defined by this configuration.
@@ -1042,6 +2022,22 @@ func (c *Configuration) GetReleaseTypes() (*ent.ReleaseTypes, error) {
 			}
 		}
 
+		// parse the 'yanked' items list
+		yanked := make([]*string, 0)
+		for _, layer := range c.layers {
+			if layer != nil {
+				releaseTypes, err := (*layer).GetReleaseTypes()
+				if err != nil {
+					return nil, err
+				}
+				if releaseTypes.GetYanked() != nil && len(*releaseTypes.GetYanked()) > 0 {
+					yanked = *releaseTypes.GetYanked()
+					log.Tracef("the '%s.%s' configuration option value is: '%v'", "releaseTypes", "yanked", yanked)
+					break
+				}
+			}
+		}
+
 		// parse the 'items' map
 		items := make(map[string]*ent.ReleaseType)
 		for _, enabledItem := range enabled {
@@ -1066,11 +2062,38 @@ func (c *Configuration) GetReleaseTypes() (*ent.ReleaseTypes, error) {
 		if err != nil {
 			return nil, err
 		}
+		if len(yanked) > 0 {
+			rt.SetYanked(&yanked)
+		}
 		c.releaseTypesSection = rt
 	}
 	return c.releaseTypesSection, nil
 }
 
+/*
+Returns the path to the file where the HTML release report must be saved as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetReportFile() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "reportFile")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			reportFile, err := (*configurationLayer).GetReportFile()
+			if err != nil {
+				return nil, err
+			}
+			if reportFile != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "reportFile", *reportFile)
+				return reportFile, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetReportFile()
+}
+
 /*
 Returns the value of the resume flag as it's defined by this configuration.
 
@@ -1095,6 +2118,31 @@ func (c *Configuration) GetResume() (*bool, error) {
 	return GetDefaultLayerInstance().GetResume()
 }
 
+/*
+Returns the name of the core identifier (major, minor or patch) whose significance bumps the fourth number
+of four segment versions as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetRevisionBump() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "revisionBump")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			revisionBump, err := (*configurationLayer).GetRevisionBump()
+			if err != nil {
+				return nil, err
+			}
+			if revisionBump != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "revisionBump", *revisionBump)
+				return revisionBump, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetRevisionBump()
+}
+
 /*
 Returns the versioning scheme to use as it's defined by this configuration.
 
@@ -1119,6 +2167,45 @@ func (c *Configuration) GetScheme() (*ver.Scheme, error) {
 	return GetDefaultLayerInstance().GetScheme()
 }
 
+/*
+Returns the server configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetServer() (*ent.ServerConfiguration, error) {
+	log.Trace("retrieving the server configuration")
+	if c.serverSection == nil {
+		c.serverSection = ent.NewServerConfiguration()
+		for _, layer := range c.layers {
+			if layer != nil {
+				// Since all attributes of the server configuration are objects we assume that if they are nil
+				// they have the default values and we keep non nil values as those overriding defaults.
+				server, err := (*layer).GetServer()
+				if err != nil {
+					return nil, err
+				}
+
+				if c.serverSection.GetAddress() == nil {
+					c.serverSection.SetAddress(server.GetAddress())
+				}
+				if c.serverSection.GetBranches() == nil {
+					c.serverSection.SetBranches(server.GetBranches())
+				}
+				if c.serverSection.GetCommand() == nil {
+					c.serverSection.SetCommand(server.GetCommand())
+				}
+				if c.serverSection.GetSecret() == nil {
+					c.serverSection.SetSecret(server.GetSecret())
+				}
+			}
+		}
+		log.Tracef("the '%s' configuration option has been resolved", "server")
+	}
+	return c.serverSection, nil
+}
+
 /*
 Returns the services configuration section.
 
@@ -1201,6 +2288,55 @@ func (c *Configuration) GetStateFile() (*string, error) {
 	return GetDefaultLayerInstance().GetStateFile()
 }
 
+/*
+Returns the secret key used to sign the state file and verify it when resuming as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetStateFileSigningKey() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "stateFileSigningKey")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			stateFileSigningKey, err := (*configurationLayer).GetStateFileSigningKey()
+			if err != nil {
+				return nil, err
+			}
+			if stateFileSigningKey != nil {
+				// the value is not logged as it's a secret
+				log.Tracef("the '%s' configuration option has been resolved", "stateFileSigningKey")
+				return stateFileSigningKey, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetStateFileSigningKey()
+}
+
+/*
+Returns the value of the strict exit codes flag as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetStrictExitCodes() (*bool, error) {
+	log.Tracef("retrieving the '%s' configuration option", "strictExitCodes")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			strictExitCodes, err := (*configurationLayer).GetStrictExitCodes()
+			if err != nil {
+				return nil, err
+			}
+			if strictExitCodes != nil {
+				log.Tracef("the '%s' configuration option value is: '%v'", "strictExitCodes", *strictExitCodes)
+				return strictExitCodes, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetStrictExitCodes()
+}
+
 /*
 Returns the substitutions configuration section.
 
@@ -1306,6 +2442,78 @@ func (c *Configuration) GetSummaryFile() (*string, error) {
 	return GetDefaultLayerInstance().GetSummaryFile()
 }
 
+/*
+Returns the URL of the endpoint where the telemetry report must be uploaded as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetTelemetryEndpoint() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "telemetryEndpoint")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			telemetryEndpoint, err := (*configurationLayer).GetTelemetryEndpoint()
+			if err != nil {
+				return nil, err
+			}
+			if telemetryEndpoint != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "telemetryEndpoint", *telemetryEndpoint)
+				return telemetryEndpoint, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetTelemetryEndpoint()
+}
+
+/*
+Returns the path to the file where the telemetry report must be saved as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetTelemetryFile() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "telemetryFile")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			telemetryFile, err := (*configurationLayer).GetTelemetryFile()
+			if err != nil {
+				return nil, err
+			}
+			if telemetryFile != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "telemetryFile", *telemetryFile)
+				return telemetryFile, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetTelemetryFile()
+}
+
+/*
+Returns the source of the release timestamp as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetTimestampSource() (*ent.TimestampSource, error) {
+	log.Tracef("retrieving the '%s' configuration option", "timestampSource")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			timestampSource, err := (*configurationLayer).GetTimestampSource()
+			if err != nil {
+				return nil, err
+			}
+			if timestampSource != nil {
+				log.Tracef("the '%s' configuration option value is: '%v'", "timestampSource", *timestampSource)
+				return timestampSource, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetTimestampSource()
+}
+
 /*
 Returns the logging verbosity level as it's defined by this configuration.
 
@@ -1353,3 +2561,27 @@ func (c *Configuration) GetVersion() (*string, error) {
 	}
 	return GetDefaultLayerInstance().GetVersion()
 }
+
+/*
+Returns the name of the service used to read and store released versions as it's defined by this configuration.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (c *Configuration) GetVersionStorageService() (*string, error) {
+	log.Tracef("retrieving the '%s' configuration option", "versionStorageService")
+	for _, configurationLayer := range c.layers {
+		if configurationLayer != nil {
+			versionStorageService, err := (*configurationLayer).GetVersionStorageService()
+			if err != nil {
+				return nil, err
+			}
+			if versionStorageService != nil {
+				log.Tracef("the '%s' configuration option value is: '%s'", "versionStorageService", *versionStorageService)
+				return versionStorageService, nil
+			}
+		}
+	}
+	return GetDefaultLayerInstance().GetVersionStorageService()
+}
@@ -69,6 +69,14 @@ func TestSaveAndLoadJSON(t *testing.T) {
 		assert.Equal(t, *sBump, *tBump)
 	}
 
+	sCommitLintFile, _ := source.GetCommitLintFile()
+	tCommitLintFile, _ := target.GetCommitLintFile()
+	if sCommitLintFile == nil {
+		assert.Equal(t, ent.COMMIT_LINT_FILE, tCommitLintFile)
+	} else {
+		assert.Equal(t, *sCommitLintFile, *tCommitLintFile)
+	}
+
 	sConfigurationFile, _ := source.GetConfigurationFile()
 	tConfigurationFile, _ := target.GetConfigurationFile()
 	if sConfigurationFile == nil {
@@ -102,6 +110,14 @@ func TestSaveAndLoadJSON(t *testing.T) {
 		assert.Equal(t, *sInitialVersion, *tInitialVersion)
 	}
 
+	sJUnitReportFile, _ := source.GetJUnitReportFile()
+	tJUnitReportFile, _ := target.GetJUnitReportFile()
+	if sJUnitReportFile == nil {
+		assert.Equal(t, ent.JUNIT_REPORT_FILE, tJUnitReportFile)
+	} else {
+		assert.Equal(t, *sJUnitReportFile, *tJUnitReportFile)
+	}
+
 	sPreset, _ := source.GetPreset()
 	tPreset, _ := target.GetPreset()
 	if sPreset == nil {
@@ -142,6 +158,14 @@ func TestSaveAndLoadJSON(t *testing.T) {
 		assert.Equal(t, *sReleasePrefix, *tReleasePrefix)
 	}
 
+	sReportFile, _ := source.GetReportFile()
+	tReportFile, _ := target.GetReportFile()
+	if sReportFile == nil {
+		assert.Equal(t, ent.REPORT_FILE, tReportFile)
+	} else {
+		assert.Equal(t, *sReportFile, *tReportFile)
+	}
+
 	sResume, _ := source.GetResume()
 	tResume, _ := target.GetResume()
 	if sResume == nil {
@@ -174,6 +198,14 @@ func TestSaveAndLoadJSON(t *testing.T) {
 		assert.Equal(t, *sSummary, *tSummary)
 	}
 
+	sBadgesDirectory, _ := source.GetBadgesDirectory()
+	tBadgesDirectory, _ := target.GetBadgesDirectory()
+	if sBadgesDirectory == nil {
+		assert.Equal(t, ent.BADGES_DIRECTORY, tBadgesDirectory)
+	} else {
+		assert.Equal(t, *sBadgesDirectory, *tBadgesDirectory)
+	}
+
 	sSummaryFile, _ := source.GetSummaryFile()
 	tSummaryFile, _ := target.GetSummaryFile()
 	if sSummaryFile == nil {
@@ -182,6 +214,30 @@ func TestSaveAndLoadJSON(t *testing.T) {
 		assert.Equal(t, *sSummaryFile, *tSummaryFile)
 	}
 
+	sTelemetryEndpoint, _ := source.GetTelemetryEndpoint()
+	tTelemetryEndpoint, _ := target.GetTelemetryEndpoint()
+	if sTelemetryEndpoint == nil {
+		assert.Equal(t, ent.TELEMETRY_ENDPOINT, tTelemetryEndpoint)
+	} else {
+		assert.Equal(t, *sTelemetryEndpoint, *tTelemetryEndpoint)
+	}
+
+	sTelemetryFile, _ := source.GetTelemetryFile()
+	tTelemetryFile, _ := target.GetTelemetryFile()
+	if sTelemetryFile == nil {
+		assert.Equal(t, ent.TELEMETRY_FILE, tTelemetryFile)
+	} else {
+		assert.Equal(t, *sTelemetryFile, *tTelemetryFile)
+	}
+
+	sTimestampSource, _ := source.GetTimestampSource()
+	tTimestampSource, _ := target.GetTimestampSource()
+	if sTimestampSource == nil {
+		assert.Equal(t, ent.TIMESTAMP_SOURCE, tTimestampSource)
+	} else {
+		assert.Equal(t, *sTimestampSource, *tTimestampSource)
+	}
+
 	sStateFile, _ := source.GetStateFile()
 	tStateFile, _ := target.GetStateFile()
 	if sStateFile == nil {
@@ -218,6 +274,24 @@ func TestSaveAndLoadJSON(t *testing.T) {
 			assert.Equal(t, *sChangelog.GetAppend(), *tChangelog.GetAppend())
 		}
 
+		if sChangelog.GetDeduplicateCherryPicks() == nil {
+			assert.Nil(t, tChangelog.GetDeduplicateCherryPicks())
+		} else {
+			assert.Equal(t, *sChangelog.GetDeduplicateCherryPicks(), *tChangelog.GetDeduplicateCherryPicks())
+		}
+
+		if sChangelog.GetGroupDependencyUpdates() == nil {
+			assert.Nil(t, tChangelog.GetGroupDependencyUpdates())
+		} else {
+			assert.Equal(t, *sChangelog.GetGroupDependencyUpdates(), *tChangelog.GetGroupDependencyUpdates())
+		}
+
+		if sChangelog.GetMergePreReleases() == nil {
+			assert.Nil(t, tChangelog.GetMergePreReleases())
+		} else {
+			assert.Equal(t, *sChangelog.GetMergePreReleases(), *tChangelog.GetMergePreReleases())
+		}
+
 		if sChangelog.GetPath() == nil {
 			assert.Nil(t, tChangelog.GetPath())
 		} else {
@@ -276,6 +350,24 @@ func TestSaveAndLoadJSON(t *testing.T) {
 	if sGit == nil {
 		assert.Equal(t, ent.GIT, tGit)
 	} else {
+		assert.Equal(t, sGit.GetProxy(), tGit.GetProxy())
+		assert.Equal(t, sGit.GetSingleBranch(), tGit.GetSingleBranch())
+		assert.Equal(t, sGit.GetFetchTags(), tGit.GetFetchTags())
+		assert.Equal(t, sGit.GetUnshallow(), tGit.GetUnshallow())
+		assert.Equal(t, sGit.GetMirror(), tGit.GetMirror())
+		assert.Equal(t, sGit.GetBackend(), tGit.GetBackend())
+		assert.Equal(t, sGit.GetService(), tGit.GetService())
+		assert.Equal(t, sGit.GetTrustedKeys(), tGit.GetTrustedKeys())
+		assert.Equal(t, sGit.GetTimestamp(), tGit.GetTimestamp())
+		assert.Equal(t, sGit.GetNoVerify(), tGit.GetNoVerify())
+		assert.Equal(t, sGit.GetHooksPath(), tGit.GetHooksPath())
+		assert.Equal(t, sGit.GetIgnoreSubmodules(), tGit.GetIgnoreSubmodules())
+		assert.Equal(t, sGit.GetStash(), tGit.GetStash())
+		assert.Equal(t, sGit.GetTimeout(), tGit.GetTimeout())
+		assert.Equal(t, sGit.GetRetries(), tGit.GetRetries())
+		assert.Equal(t, sGit.GetRetryDelay(), tGit.GetRetryDelay())
+		assert.Equal(t, sGit.GetLock(), tGit.GetLock())
+		assert.Equal(t, sGit.GetLockTimeout(), tGit.GetLockTimeout())
 		if sGit.GetRemotes() == nil {
 			assert.Nil(t, tGit.GetRemotes())
 		} else {
@@ -287,6 +379,8 @@ func TestSaveAndLoadJSON(t *testing.T) {
 				assert.Equal(t, (*(*sGit.GetRemotes())[sGitRemotesItemKey]).GetUser(), (*(*tGit.GetRemotes())[sGitRemotesItemKey]).GetUser())
 				assert.Equal(t, (*(*sGit.GetRemotes())[sGitRemotesItemKey]).GetPrivateKey(), (*(*tGit.GetRemotes())[sGitRemotesItemKey]).GetPrivateKey())
 				assert.Equal(t, (*(*sGit.GetRemotes())[sGitRemotesItemKey]).GetPassphrase(), (*(*tGit.GetRemotes())[sGitRemotesItemKey]).GetPassphrase())
+				assert.Equal(t, (*(*sGit.GetRemotes())[sGitRemotesItemKey]).GetKnownHosts(), (*(*tGit.GetRemotes())[sGitRemotesItemKey]).GetKnownHosts())
+				assert.Equal(t, (*(*sGit.GetRemotes())[sGitRemotesItemKey]).GetStrictHostKeyChecking(), (*(*tGit.GetRemotes())[sGitRemotesItemKey]).GetStrictHostKeyChecking())
 			}
 		}
 	}
@@ -320,6 +414,13 @@ func TestSaveAndLoadJSON(t *testing.T) {
 				assert.NotNil(t, (*tReleaseTypes.GetRemoteRepositories())[sGitRemoteRepositoriesItemKey])
 			}
 		}
+		if sReleaseTypes.GetYanked() == nil {
+			assert.Nil(t, tReleaseTypes.GetYanked())
+		} else {
+			for sYankedItemKey, _ := range *sReleaseTypes.GetYanked() {
+				assert.Equal(t, *(*sReleaseTypes.GetYanked())[sYankedItemKey], *(*tReleaseTypes.GetYanked())[sYankedItemKey])
+			}
+		}
 		if sReleaseTypes.GetItems() == nil {
 			assert.Nil(t, tReleaseTypes.GetItems())
 		} else {
@@ -340,10 +441,15 @@ func TestSaveAndLoadJSON(t *testing.T) {
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetCollapseVersions(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetCollapseVersions())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetCollapsedVersionQualifier(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetCollapsedVersionQualifier())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetDescription(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetDescription())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGatePolicy(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGatePolicy())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetIgnoreCherryPicks(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetIgnoreCherryPicks())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetIgnoreMerges(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetIgnoreMerges())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetFollowAllParents(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetFollowAllParents())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitCommit(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitCommit())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitCommitMessage(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitCommitMessage())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPush(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPush())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPushForce(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPushForce())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPushForceWithLease(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPushForceWithLease())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTag(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTag())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTagForce(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTagForce())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTagMessage(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTagMessage())
@@ -372,6 +478,25 @@ func TestSaveAndLoadJSON(t *testing.T) {
 
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranches(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranches())
 
+				if (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBumpLabels() == nil {
+					assert.Equal(t, ent.RELEASE_TYPE_BUMP_LABELS, (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBumpLabels())
+				} else {
+					for sBumpLabelsItemKey, _ := range *(*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBumpLabels() {
+						assert.Equal(t, (*(*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBumpLabels())[sBumpLabelsItemKey], (*(*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBumpLabels())[sBumpLabelsItemKey])
+					}
+				}
+
+				if (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranchMetadata() == nil {
+					assert.Equal(t, ent.RELEASE_TYPE_MATCH_BRANCH_METADATA, (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranchMetadata())
+				} else {
+					for sMatchBranchMetadataItemKey, _ := range *(*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranchMetadata() {
+						assert.Equal(t, (*(*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranchMetadata())[sMatchBranchMetadataItemKey], (*(*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranchMetadata())[sMatchBranchMetadataItemKey])
+					}
+				}
+
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchChangedPaths(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchChangedPaths())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchDaysOfWeek(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchDaysOfWeek())
+
 				if (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchEnvironmentVariables() == nil {
 					assert.Equal(t, ent.RELEASE_TYPE_MATCH_ENVIRONMENT_VARIABLES, (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchEnvironmentVariables())
 				} else {
@@ -380,11 +505,22 @@ func TestSaveAndLoadJSON(t *testing.T) {
 					}
 				}
 
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchExpression(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchExpression())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchPolicy(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchPolicy())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchMode(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchMode())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchTags(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchTags())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchWorkspaceStatus(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchWorkspaceStatus())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublish(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublish())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublishDraft(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublishDraft())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublishPreRelease(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublishPreRelease())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPullRequestMessages(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPullRequestMessages())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetReleaseMetadataFile(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetReleaseMetadataFile())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetReleaseName(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetReleaseName())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetRequiredEnvironmentVariables(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetRequiredEnvironmentVariables())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetRequireSignedCommits(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetRequireSignedCommits())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBuildMetadata(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBuildMetadata())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBuildMetadataTargets(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBuildMetadataTargets())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionConstraint(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionConstraint())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionRange(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionRange())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionRangeFromBranchName(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionRangeFromBranchName())
 			}
@@ -412,6 +548,135 @@ func TestSaveAndLoadJSON(t *testing.T) {
 		}
 	}
 
+	sDownstreamUpdates, _ := source.GetDownstreamUpdates()
+	tDownstreamUpdates, _ := target.GetDownstreamUpdates()
+
+	if sDownstreamUpdates == nil {
+		assert.Equal(t, ent.DOWNSTREAM_UPDATES, tDownstreamUpdates)
+	} else {
+		if sDownstreamUpdates.GetEnabled() == nil {
+			assert.Nil(t, tDownstreamUpdates.GetEnabled())
+		} else {
+			for sDownstreamUpdatesEnabled, _ := range *sDownstreamUpdates.GetEnabled() {
+				assert.NotNil(t, (*tDownstreamUpdates.GetEnabled())[sDownstreamUpdatesEnabled])
+				assert.Equal(t, (*sDownstreamUpdates.GetEnabled())[sDownstreamUpdatesEnabled], (*tDownstreamUpdates.GetEnabled())[sDownstreamUpdatesEnabled])
+			}
+			for sDownstreamUpdatesItemKey, _ := range *sDownstreamUpdates.GetItems() {
+				assert.NotNil(t, (*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey])
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetBranch(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetBranch())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetMatch(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetMatch())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetOwner(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetOwner())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetPath(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetPath())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetReplace(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetReplace())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetRepository(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetRepository())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetService(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetService())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetTitle(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetTitle())
+			}
+		}
+	}
+
+	sEventBus, _ := source.GetEventBus()
+	tEventBus, _ := target.GetEventBus()
+
+	if sEventBus == nil {
+		assert.Equal(t, ent.EVENT_BUS, tEventBus)
+	} else {
+		if sEventBus.GetEnabled() == nil {
+			assert.Nil(t, tEventBus.GetEnabled())
+		} else {
+			for sEventBusEnabled, _ := range *sEventBus.GetEnabled() {
+				assert.NotNil(t, (*tEventBus.GetEnabled())[sEventBusEnabled])
+				assert.Equal(t, (*sEventBus.GetEnabled())[sEventBusEnabled], (*tEventBus.GetEnabled())[sEventBusEnabled])
+			}
+			for sEventBusItemKey, _ := range *sEventBus.GetItems() {
+				assert.NotNil(t, (*tEventBus.GetItems())[sEventBusItemKey])
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetEndpoint(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetEndpoint())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetEvents(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetEvents())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetPassword(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetPassword())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetRegion(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetRegion())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetTopic(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetTopic())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetType(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetType())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetUser(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetUser())
+			}
+		}
+	}
+
+	sServer, _ := source.GetServer()
+	tServer, _ := target.GetServer()
+
+	if sServer == nil {
+		assert.Nil(t, tServer)
+	} else {
+		if sServer.GetAddress() == nil {
+			assert.Nil(t, tServer.GetAddress())
+		} else {
+			assert.Equal(t, *sServer.GetAddress(), *tServer.GetAddress())
+		}
+
+		if sServer.GetBranches() == nil {
+			assert.Nil(t, tServer.GetBranches())
+		} else {
+			assert.Equal(t, *sServer.GetBranches(), *tServer.GetBranches())
+		}
+
+		if sServer.GetCommand() == nil {
+			assert.Nil(t, tServer.GetCommand())
+		} else {
+			assert.Equal(t, *sServer.GetCommand(), *tServer.GetCommand())
+		}
+
+		if sServer.GetSecret() == nil {
+			assert.Nil(t, tServer.GetSecret())
+		} else {
+			assert.Equal(t, *sServer.GetSecret(), *tServer.GetSecret())
+		}
+	}
+
+	sImpactAnalyzers, _ := source.GetImpactAnalyzers()
+	tImpactAnalyzers, _ := target.GetImpactAnalyzers()
+
+	if sImpactAnalyzers == nil {
+		assert.Equal(t, ent.IMPACT_ANALYZERS, tImpactAnalyzers)
+	} else {
+		if sImpactAnalyzers.GetEnabled() == nil {
+			assert.Nil(t, tImpactAnalyzers.GetEnabled())
+		} else {
+			for sImpactAnalyzersEnabled, _ := range *sImpactAnalyzers.GetEnabled() {
+				assert.NotNil(t, (*tImpactAnalyzers.GetEnabled())[sImpactAnalyzersEnabled])
+				assert.Equal(t, (*sImpactAnalyzers.GetEnabled())[sImpactAnalyzersEnabled], (*tImpactAnalyzers.GetEnabled())[sImpactAnalyzersEnabled])
+			}
+			for sImpactAnalyzersItemKey, _ := range *sImpactAnalyzers.GetItems() {
+				assert.NotNil(t, (*tImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey])
+				assert.Equal(t, (*(*sImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetBump(), (*(*tImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetBump())
+				assert.Equal(t, (*(*sImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetCommand(), (*(*tImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetCommand())
+				assert.Equal(t, (*(*sImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetExpression(), (*(*tImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetExpression())
+				assert.Equal(t, (*(*sImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetTitle(), (*(*tImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetTitle())
+			}
+		}
+	}
+
+	sPathRules, _ := source.GetPathRules()
+	tPathRules, _ := target.GetPathRules()
+
+	if sPathRules == nil {
+		assert.Equal(t, ent.PATH_RULES, tPathRules)
+	} else {
+		if sPathRules.GetEnabled() == nil {
+			assert.Nil(t, tPathRules.GetEnabled())
+		} else {
+			for sPathRulesEnabled, _ := range *sPathRules.GetEnabled() {
+				assert.NotNil(t, (*tPathRules.GetEnabled())[sPathRulesEnabled])
+				assert.Equal(t, (*sPathRules.GetEnabled())[sPathRulesEnabled], (*tPathRules.GetEnabled())[sPathRulesEnabled])
+			}
+			for sPathRulesItemKey, _ := range *sPathRules.GetItems() {
+				assert.NotNil(t, (*tPathRules.GetItems())[sPathRulesItemKey])
+				assert.Equal(t, (*(*sPathRules.GetItems())[sPathRulesItemKey]).GetBump(), (*(*tPathRules.GetItems())[sPathRulesItemKey]).GetBump())
+				assert.Equal(t, (*(*sPathRules.GetItems())[sPathRulesItemKey]).GetPaths(), (*(*tPathRules.GetItems())[sPathRulesItemKey]).GetPaths())
+				assert.Equal(t, (*(*sPathRules.GetItems())[sPathRulesItemKey]).GetSignificant(), (*(*tPathRules.GetItems())[sPathRulesItemKey]).GetSignificant())
+			}
+		}
+	}
+
 	sSubstitutions, _ := source.GetSubstitutions()
 	tSubstitutions, _ := target.GetSubstitutions()
 
@@ -468,6 +733,14 @@ func TestSaveAndLoadYAML(t *testing.T) {
 		assert.Equal(t, *sBump, *tBump)
 	}
 
+	sCommitLintFile, _ := source.GetCommitLintFile()
+	tCommitLintFile, _ := target.GetCommitLintFile()
+	if sCommitLintFile == nil {
+		assert.Equal(t, ent.COMMIT_LINT_FILE, tCommitLintFile)
+	} else {
+		assert.Equal(t, *sCommitLintFile, *tCommitLintFile)
+	}
+
 	sConfigurationFile, _ := source.GetConfigurationFile()
 	tConfigurationFile, _ := target.GetConfigurationFile()
 	if sConfigurationFile == nil {
@@ -501,6 +774,14 @@ func TestSaveAndLoadYAML(t *testing.T) {
 		assert.Equal(t, *sInitialVersion, *tInitialVersion)
 	}
 
+	sJUnitReportFile, _ := source.GetJUnitReportFile()
+	tJUnitReportFile, _ := target.GetJUnitReportFile()
+	if sJUnitReportFile == nil {
+		assert.Equal(t, ent.JUNIT_REPORT_FILE, tJUnitReportFile)
+	} else {
+		assert.Equal(t, *sJUnitReportFile, *tJUnitReportFile)
+	}
+
 	sPreset, _ := source.GetPreset()
 	tPreset, _ := target.GetPreset()
 	if sPreset == nil {
@@ -541,6 +822,14 @@ func TestSaveAndLoadYAML(t *testing.T) {
 		assert.Equal(t, *sReleasePrefix, *tReleasePrefix)
 	}
 
+	sReportFile, _ := source.GetReportFile()
+	tReportFile, _ := target.GetReportFile()
+	if sReportFile == nil {
+		assert.Equal(t, ent.REPORT_FILE, tReportFile)
+	} else {
+		assert.Equal(t, *sReportFile, *tReportFile)
+	}
+
 	sResume, _ := source.GetResume()
 	tResume, _ := target.GetResume()
 	if sResume == nil {
@@ -573,6 +862,14 @@ func TestSaveAndLoadYAML(t *testing.T) {
 		assert.Equal(t, *sSummary, *tSummary)
 	}
 
+	sBadgesDirectory, _ := source.GetBadgesDirectory()
+	tBadgesDirectory, _ := target.GetBadgesDirectory()
+	if sBadgesDirectory == nil {
+		assert.Equal(t, ent.BADGES_DIRECTORY, tBadgesDirectory)
+	} else {
+		assert.Equal(t, *sBadgesDirectory, *tBadgesDirectory)
+	}
+
 	sSummaryFile, _ := source.GetSummaryFile()
 	tSummaryFile, _ := target.GetSummaryFile()
 	if sSummaryFile == nil {
@@ -581,6 +878,30 @@ func TestSaveAndLoadYAML(t *testing.T) {
 		assert.Equal(t, *sSummaryFile, *tSummaryFile)
 	}
 
+	sTelemetryEndpoint, _ := source.GetTelemetryEndpoint()
+	tTelemetryEndpoint, _ := target.GetTelemetryEndpoint()
+	if sTelemetryEndpoint == nil {
+		assert.Equal(t, ent.TELEMETRY_ENDPOINT, tTelemetryEndpoint)
+	} else {
+		assert.Equal(t, *sTelemetryEndpoint, *tTelemetryEndpoint)
+	}
+
+	sTelemetryFile, _ := source.GetTelemetryFile()
+	tTelemetryFile, _ := target.GetTelemetryFile()
+	if sTelemetryFile == nil {
+		assert.Equal(t, ent.TELEMETRY_FILE, tTelemetryFile)
+	} else {
+		assert.Equal(t, *sTelemetryFile, *tTelemetryFile)
+	}
+
+	sTimestampSource, _ := source.GetTimestampSource()
+	tTimestampSource, _ := target.GetTimestampSource()
+	if sTimestampSource == nil {
+		assert.Equal(t, ent.TIMESTAMP_SOURCE, tTimestampSource)
+	} else {
+		assert.Equal(t, *sTimestampSource, *tTimestampSource)
+	}
+
 	sStateFile, _ := source.GetStateFile()
 	tStateFile, _ := target.GetStateFile()
 	if sStateFile == nil {
@@ -617,6 +938,24 @@ func TestSaveAndLoadYAML(t *testing.T) {
 			assert.Equal(t, *sChangelog.GetAppend(), *tChangelog.GetAppend())
 		}
 
+		if sChangelog.GetDeduplicateCherryPicks() == nil {
+			assert.Nil(t, tChangelog.GetDeduplicateCherryPicks())
+		} else {
+			assert.Equal(t, *sChangelog.GetDeduplicateCherryPicks(), *tChangelog.GetDeduplicateCherryPicks())
+		}
+
+		if sChangelog.GetGroupDependencyUpdates() == nil {
+			assert.Nil(t, tChangelog.GetGroupDependencyUpdates())
+		} else {
+			assert.Equal(t, *sChangelog.GetGroupDependencyUpdates(), *tChangelog.GetGroupDependencyUpdates())
+		}
+
+		if sChangelog.GetMergePreReleases() == nil {
+			assert.Nil(t, tChangelog.GetMergePreReleases())
+		} else {
+			assert.Equal(t, *sChangelog.GetMergePreReleases(), *tChangelog.GetMergePreReleases())
+		}
+
 		if sChangelog.GetPath() == nil {
 			assert.Nil(t, tChangelog.GetPath())
 		} else {
@@ -675,6 +1014,24 @@ func TestSaveAndLoadYAML(t *testing.T) {
 	if sGit == nil {
 		assert.Equal(t, ent.GIT, tGit)
 	} else {
+		assert.Equal(t, sGit.GetProxy(), tGit.GetProxy())
+		assert.Equal(t, sGit.GetSingleBranch(), tGit.GetSingleBranch())
+		assert.Equal(t, sGit.GetFetchTags(), tGit.GetFetchTags())
+		assert.Equal(t, sGit.GetUnshallow(), tGit.GetUnshallow())
+		assert.Equal(t, sGit.GetMirror(), tGit.GetMirror())
+		assert.Equal(t, sGit.GetBackend(), tGit.GetBackend())
+		assert.Equal(t, sGit.GetService(), tGit.GetService())
+		assert.Equal(t, sGit.GetTrustedKeys(), tGit.GetTrustedKeys())
+		assert.Equal(t, sGit.GetTimestamp(), tGit.GetTimestamp())
+		assert.Equal(t, sGit.GetNoVerify(), tGit.GetNoVerify())
+		assert.Equal(t, sGit.GetHooksPath(), tGit.GetHooksPath())
+		assert.Equal(t, sGit.GetIgnoreSubmodules(), tGit.GetIgnoreSubmodules())
+		assert.Equal(t, sGit.GetStash(), tGit.GetStash())
+		assert.Equal(t, sGit.GetTimeout(), tGit.GetTimeout())
+		assert.Equal(t, sGit.GetRetries(), tGit.GetRetries())
+		assert.Equal(t, sGit.GetRetryDelay(), tGit.GetRetryDelay())
+		assert.Equal(t, sGit.GetLock(), tGit.GetLock())
+		assert.Equal(t, sGit.GetLockTimeout(), tGit.GetLockTimeout())
 		if sGit.GetRemotes() == nil {
 			assert.Nil(t, tGit.GetRemotes())
 		} else {
@@ -686,6 +1043,8 @@ func TestSaveAndLoadYAML(t *testing.T) {
 				assert.Equal(t, (*(*sGit.GetRemotes())[sGitRemotesItemKey]).GetUser(), (*(*tGit.GetRemotes())[sGitRemotesItemKey]).GetUser())
 				assert.Equal(t, (*(*sGit.GetRemotes())[sGitRemotesItemKey]).GetPrivateKey(), (*(*tGit.GetRemotes())[sGitRemotesItemKey]).GetPrivateKey())
 				assert.Equal(t, (*(*sGit.GetRemotes())[sGitRemotesItemKey]).GetPassphrase(), (*(*tGit.GetRemotes())[sGitRemotesItemKey]).GetPassphrase())
+				assert.Equal(t, (*(*sGit.GetRemotes())[sGitRemotesItemKey]).GetKnownHosts(), (*(*tGit.GetRemotes())[sGitRemotesItemKey]).GetKnownHosts())
+				assert.Equal(t, (*(*sGit.GetRemotes())[sGitRemotesItemKey]).GetStrictHostKeyChecking(), (*(*tGit.GetRemotes())[sGitRemotesItemKey]).GetStrictHostKeyChecking())
 			}
 		}
 	}
@@ -719,6 +1078,13 @@ func TestSaveAndLoadYAML(t *testing.T) {
 				assert.NotNil(t, (*tReleaseTypes.GetRemoteRepositories())[sGitRemoteRepositoriesItemKey])
 			}
 		}
+		if sReleaseTypes.GetYanked() == nil {
+			assert.Nil(t, tReleaseTypes.GetYanked())
+		} else {
+			for sYankedItemKey, _ := range *sReleaseTypes.GetYanked() {
+				assert.Equal(t, *(*sReleaseTypes.GetYanked())[sYankedItemKey], *(*tReleaseTypes.GetYanked())[sYankedItemKey])
+			}
+		}
 		if sReleaseTypes.GetItems() == nil {
 			assert.Nil(t, tReleaseTypes.GetItems())
 		} else {
@@ -739,10 +1105,15 @@ func TestSaveAndLoadYAML(t *testing.T) {
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetCollapseVersions(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetCollapseVersions())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetCollapsedVersionQualifier(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetCollapsedVersionQualifier())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetDescription(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetDescription())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGatePolicy(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGatePolicy())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetIgnoreCherryPicks(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetIgnoreCherryPicks())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetIgnoreMerges(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetIgnoreMerges())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetFollowAllParents(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetFollowAllParents())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitCommit(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitCommit())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitCommitMessage(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitCommitMessage())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPush(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPush())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPushForce(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPushForce())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPushForceWithLease(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitPushForceWithLease())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTag(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTag())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTagForce(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTagForce())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTagMessage(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetGitTagMessage())
@@ -771,6 +1142,25 @@ func TestSaveAndLoadYAML(t *testing.T) {
 
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranches(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranches())
 
+				if (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBumpLabels() == nil {
+					assert.Equal(t, ent.RELEASE_TYPE_BUMP_LABELS, (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBumpLabels())
+				} else {
+					for sBumpLabelsItemKey, _ := range *(*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBumpLabels() {
+						assert.Equal(t, (*(*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBumpLabels())[sBumpLabelsItemKey], (*(*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBumpLabels())[sBumpLabelsItemKey])
+					}
+				}
+
+				if (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranchMetadata() == nil {
+					assert.Equal(t, ent.RELEASE_TYPE_MATCH_BRANCH_METADATA, (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranchMetadata())
+				} else {
+					for sMatchBranchMetadataItemKey, _ := range *(*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranchMetadata() {
+						assert.Equal(t, (*(*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranchMetadata())[sMatchBranchMetadataItemKey], (*(*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchBranchMetadata())[sMatchBranchMetadataItemKey])
+					}
+				}
+
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchChangedPaths(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchChangedPaths())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchDaysOfWeek(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchDaysOfWeek())
+
 				if (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchEnvironmentVariables() == nil {
 					assert.Equal(t, ent.RELEASE_TYPE_MATCH_ENVIRONMENT_VARIABLES, (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchEnvironmentVariables())
 				} else {
@@ -779,11 +1169,22 @@ func TestSaveAndLoadYAML(t *testing.T) {
 					}
 				}
 
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchExpression(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchExpression())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchPolicy(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchPolicy())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchMode(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchMode())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchTags(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchTags())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchWorkspaceStatus(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetMatchWorkspaceStatus())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublish(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublish())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublishDraft(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublishDraft())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublishPreRelease(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPublishPreRelease())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPullRequestMessages(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetPullRequestMessages())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetReleaseMetadataFile(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetReleaseMetadataFile())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetReleaseName(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetReleaseName())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetRequiredEnvironmentVariables(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetRequiredEnvironmentVariables())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetRequireSignedCommits(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetRequireSignedCommits())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBuildMetadata(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBuildMetadata())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBuildMetadataTargets(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetBuildMetadataTargets())
+				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionConstraint(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionConstraint())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionRange(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionRange())
 				assert.Equal(t, (*(*sReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionRangeFromBranchName(), (*(*tReleaseTypes.GetItems())[sReleaseTypesItemKey]).GetVersionRangeFromBranchName())
 			}
@@ -811,6 +1212,135 @@ func TestSaveAndLoadYAML(t *testing.T) {
 		}
 	}
 
+	sDownstreamUpdates, _ := source.GetDownstreamUpdates()
+	tDownstreamUpdates, _ := target.GetDownstreamUpdates()
+
+	if sDownstreamUpdates == nil {
+		assert.Equal(t, ent.DOWNSTREAM_UPDATES, tDownstreamUpdates)
+	} else {
+		if sDownstreamUpdates.GetEnabled() == nil {
+			assert.Nil(t, tDownstreamUpdates.GetEnabled())
+		} else {
+			for sDownstreamUpdatesEnabled, _ := range *sDownstreamUpdates.GetEnabled() {
+				assert.NotNil(t, (*tDownstreamUpdates.GetEnabled())[sDownstreamUpdatesEnabled])
+				assert.Equal(t, (*sDownstreamUpdates.GetEnabled())[sDownstreamUpdatesEnabled], (*tDownstreamUpdates.GetEnabled())[sDownstreamUpdatesEnabled])
+			}
+			for sDownstreamUpdatesItemKey, _ := range *sDownstreamUpdates.GetItems() {
+				assert.NotNil(t, (*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey])
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetBranch(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetBranch())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetMatch(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetMatch())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetOwner(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetOwner())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetPath(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetPath())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetReplace(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetReplace())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetRepository(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetRepository())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetService(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetService())
+				assert.Equal(t, (*(*sDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetTitle(), (*(*tDownstreamUpdates.GetItems())[sDownstreamUpdatesItemKey]).GetTitle())
+			}
+		}
+	}
+
+	sEventBus, _ := source.GetEventBus()
+	tEventBus, _ := target.GetEventBus()
+
+	if sEventBus == nil {
+		assert.Equal(t, ent.EVENT_BUS, tEventBus)
+	} else {
+		if sEventBus.GetEnabled() == nil {
+			assert.Nil(t, tEventBus.GetEnabled())
+		} else {
+			for sEventBusEnabled, _ := range *sEventBus.GetEnabled() {
+				assert.NotNil(t, (*tEventBus.GetEnabled())[sEventBusEnabled])
+				assert.Equal(t, (*sEventBus.GetEnabled())[sEventBusEnabled], (*tEventBus.GetEnabled())[sEventBusEnabled])
+			}
+			for sEventBusItemKey, _ := range *sEventBus.GetItems() {
+				assert.NotNil(t, (*tEventBus.GetItems())[sEventBusItemKey])
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetEndpoint(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetEndpoint())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetEvents(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetEvents())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetPassword(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetPassword())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetRegion(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetRegion())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetTopic(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetTopic())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetType(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetType())
+				assert.Equal(t, (*(*sEventBus.GetItems())[sEventBusItemKey]).GetUser(), (*(*tEventBus.GetItems())[sEventBusItemKey]).GetUser())
+			}
+		}
+	}
+
+	sServer, _ := source.GetServer()
+	tServer, _ := target.GetServer()
+
+	if sServer == nil {
+		assert.Nil(t, tServer)
+	} else {
+		if sServer.GetAddress() == nil {
+			assert.Nil(t, tServer.GetAddress())
+		} else {
+			assert.Equal(t, *sServer.GetAddress(), *tServer.GetAddress())
+		}
+
+		if sServer.GetBranches() == nil {
+			assert.Nil(t, tServer.GetBranches())
+		} else {
+			assert.Equal(t, *sServer.GetBranches(), *tServer.GetBranches())
+		}
+
+		if sServer.GetCommand() == nil {
+			assert.Nil(t, tServer.GetCommand())
+		} else {
+			assert.Equal(t, *sServer.GetCommand(), *tServer.GetCommand())
+		}
+
+		if sServer.GetSecret() == nil {
+			assert.Nil(t, tServer.GetSecret())
+		} else {
+			assert.Equal(t, *sServer.GetSecret(), *tServer.GetSecret())
+		}
+	}
+
+	sImpactAnalyzers, _ := source.GetImpactAnalyzers()
+	tImpactAnalyzers, _ := target.GetImpactAnalyzers()
+
+	if sImpactAnalyzers == nil {
+		assert.Equal(t, ent.IMPACT_ANALYZERS, tImpactAnalyzers)
+	} else {
+		if sImpactAnalyzers.GetEnabled() == nil {
+			assert.Nil(t, tImpactAnalyzers.GetEnabled())
+		} else {
+			for sImpactAnalyzersEnabled, _ := range *sImpactAnalyzers.GetEnabled() {
+				assert.NotNil(t, (*tImpactAnalyzers.GetEnabled())[sImpactAnalyzersEnabled])
+				assert.Equal(t, (*sImpactAnalyzers.GetEnabled())[sImpactAnalyzersEnabled], (*tImpactAnalyzers.GetEnabled())[sImpactAnalyzersEnabled])
+			}
+			for sImpactAnalyzersItemKey, _ := range *sImpactAnalyzers.GetItems() {
+				assert.NotNil(t, (*tImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey])
+				assert.Equal(t, (*(*sImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetBump(), (*(*tImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetBump())
+				assert.Equal(t, (*(*sImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetCommand(), (*(*tImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetCommand())
+				assert.Equal(t, (*(*sImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetExpression(), (*(*tImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetExpression())
+				assert.Equal(t, (*(*sImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetTitle(), (*(*tImpactAnalyzers.GetItems())[sImpactAnalyzersItemKey]).GetTitle())
+			}
+		}
+	}
+
+	sPathRules, _ := source.GetPathRules()
+	tPathRules, _ := target.GetPathRules()
+
+	if sPathRules == nil {
+		assert.Equal(t, ent.PATH_RULES, tPathRules)
+	} else {
+		if sPathRules.GetEnabled() == nil {
+			assert.Nil(t, tPathRules.GetEnabled())
+		} else {
+			for sPathRulesEnabled, _ := range *sPathRules.GetEnabled() {
+				assert.NotNil(t, (*tPathRules.GetEnabled())[sPathRulesEnabled])
+				assert.Equal(t, (*sPathRules.GetEnabled())[sPathRulesEnabled], (*tPathRules.GetEnabled())[sPathRulesEnabled])
+			}
+			for sPathRulesItemKey, _ := range *sPathRules.GetItems() {
+				assert.NotNil(t, (*tPathRules.GetItems())[sPathRulesItemKey])
+				assert.Equal(t, (*(*sPathRules.GetItems())[sPathRulesItemKey]).GetBump(), (*(*tPathRules.GetItems())[sPathRulesItemKey]).GetBump())
+				assert.Equal(t, (*(*sPathRules.GetItems())[sPathRulesItemKey]).GetPaths(), (*(*tPathRules.GetItems())[sPathRulesItemKey]).GetPaths())
+				assert.Equal(t, (*(*sPathRules.GetItems())[sPathRulesItemKey]).GetSignificant(), (*(*tPathRules.GetItems())[sPathRulesItemKey]).GetSignificant())
+			}
+		}
+	}
+
 	sSubstitutions, _ := source.GetSubstitutions()
 	tSubstitutions, _ := target.GetSubstitutions()
 
@@ -852,11 +1382,11 @@ func TestSerializationWithMultipleConfigurationLayersJSON(t *testing.T) {
 	mediumPriorityConfigurationLayerMock.SetBump(utl.PointerToString("beta"))
 	highPriorityConfigurationLayerMock.SetBump(utl.PointerToString("gamma"))
 
-	lpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(nil, utl.PointerToString("CHANGELOG1.md"), &map[string]string{"SectionA1": "regexA1", "SectionA2": "regexA2"}, utl.PointerToString("changelog1.tpl"), &map[string]string{"Expression1": "string1"})
+	lpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(nil, nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG1.md"), &map[string]string{"SectionA1": "regexA1", "SectionA2": "regexA2"}, utl.PointerToString("changelog1.tpl"), &map[string]string{"Expression1": "string1"}, nil)
 	lowPriorityConfigurationLayerMock.SetChangelog(lpChangelogConfiguration)
-	mpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("head"), utl.PointerToString("CHANGELOG2.md"), &map[string]string{"SectionB1": "regexB1", "SectionB2": "regexB2"}, utl.PointerToString("changelog2.tpl"), &map[string]string{"Expression2": "string2"})
+	mpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("head"), nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG2.md"), &map[string]string{"SectionB1": "regexB1", "SectionB2": "regexB2"}, utl.PointerToString("changelog2.tpl"), &map[string]string{"Expression2": "string2"}, nil)
 	mediumPriorityConfigurationLayerMock.SetChangelog(mpChangelogConfiguration)
-	hpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("tail"), utl.PointerToString("CHANGELOG2.md"), &map[string]string{"SectionC1": "regexC1", "SectionC2": "regexC2"}, utl.PointerToString("changelog3.tpl"), &map[string]string{"Expression3": "string3"})
+	hpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("tail"), nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG2.md"), &map[string]string{"SectionC1": "regexC1", "SectionC2": "regexC2"}, utl.PointerToString("changelog3.tpl"), &map[string]string{"Expression3": "string3"}, nil)
 	highPriorityConfigurationLayerMock.SetChangelog(hpChangelogConfiguration)
 
 	lpCommitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("convention1")}, &map[string]*ent.CommitMessageConvention{"convention1": ent.NewCommitMessageConventionWith(utl.PointerToString("expr1"), &map[string]string{})})
@@ -878,11 +1408,11 @@ func TestSerializationWithMultipleConfigurationLayersJSON(t *testing.T) {
 	mediumPriorityConfigurationLayerMock.SetDryRun(utl.PointerToBoolean(true))
 	highPriorityConfigurationLayerMock.SetDryRun(utl.PointerToBoolean(false))
 
-	lpGitConfiguration, _ := ent.NewGitConfigurationWith(&map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("jdoe1"), utl.PointerToString("pwd1"), nil, nil), "replica": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("stiger1"), utl.PointerToString("sec1"), nil, nil)})
+	lpGitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, utl.PointerToString("http://proxy.example.com:3128"), &map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("jdoe1"), utl.PointerToString("pwd1"), nil, nil, nil, nil, nil, nil, nil, nil, nil), "replica": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("stiger1"), utl.PointerToString("sec1"), nil, nil, nil, nil, nil, nil, nil, nil, nil)}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	lowPriorityConfigurationLayerMock.SetGit(lpGitConfiguration)
-	mpGitConfiguration, _ := ent.NewGitConfigurationWith(&map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("jdoe2"), utl.PointerToString("pwd2"), nil, nil), "clone": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("stiger2"), utl.PointerToString("sec2"), nil, nil)})
+	mpGitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("jdoe2"), utl.PointerToString("pwd2"), nil, nil, nil, nil, nil, nil, nil, nil, nil), "clone": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("stiger2"), utl.PointerToString("sec2"), nil, nil, nil, nil, nil, nil, nil, nil, nil)}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	mediumPriorityConfigurationLayerMock.SetGit(mpGitConfiguration)
-	hpGitConfiguration, _ := ent.NewGitConfigurationWith(&map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe3"), utl.PointerToString("pwd3"), utl.PointerToString("key3"), utl.PointerToString("passphrase3"))})
+	hpGitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe3"), utl.PointerToString("pwd3"), utl.PointerToString("key3"), utl.PointerToString("passphrase3"), nil, nil, nil, nil, nil, nil, nil)}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	highPriorityConfigurationLayerMock.SetGit(hpGitConfiguration)
 
 	lowPriorityConfigurationLayerMock.SetInitialVersion(utl.PointerToString("9.9.9"))
@@ -946,6 +1476,9 @@ func TestSerializationWithMultipleConfigurationLayersJSON(t *testing.T) {
 	lowPriorityConfigurationLayerMock.SetSummaryFile(utl.PointerToString("summary.low"))
 	mediumPriorityConfigurationLayerMock.SetSummaryFile(utl.PointerToString("summary.medium"))
 	highPriorityConfigurationLayerMock.SetSummaryFile(utl.PointerToString("summary.high"))
+	lowPriorityConfigurationLayerMock.SetTimestampSource(ent.PointerToTimestampSource(ent.SYSTEM))
+	mediumPriorityConfigurationLayerMock.SetTimestampSource(ent.PointerToTimestampSource(ent.SYSTEM))
+	highPriorityConfigurationLayerMock.SetTimestampSource(ent.PointerToTimestampSource(ent.COMMIT))
 
 	lowPriorityConfigurationLayerMock.SetVerbosity(ent.PointerToVerbosity(ent.TRACE))
 	mediumPriorityConfigurationLayerMock.SetVerbosity(ent.PointerToVerbosity(ent.INFO))
@@ -1115,6 +1648,10 @@ func TestSerializationWithMultipleConfigurationLayersJSON(t *testing.T) {
 	summaryFile, _ := deserializedConfigurationLayer.GetSummaryFile()
 	assert.Equal(t, *hpSummaryFile, *summaryFile)
 
+	hpTimestampSource, _ := highPriorityConfigurationLayerMock.GetTimestampSource()
+	timestampSource, _ := deserializedConfigurationLayer.GetTimestampSource()
+	assert.Equal(t, *hpTimestampSource, *timestampSource)
+
 	hpVerbosity, _ := highPriorityConfigurationLayerMock.GetVerbosity()
 	verbosity, _ := deserializedConfigurationLayer.GetVerbosity()
 	assert.Equal(t, *hpVerbosity, *verbosity)
@@ -1140,11 +1677,11 @@ func TestSerializationWithMultipleConfigurationLayersYAML(t *testing.T) {
 	mediumPriorityConfigurationLayerMock.SetBump(utl.PointerToString("beta"))
 	highPriorityConfigurationLayerMock.SetBump(utl.PointerToString("gamma"))
 
-	lpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(nil, utl.PointerToString("CHANGELOG1.md"), &map[string]string{"SectionA1": "regexA1", "SectionA2": "regexA2"}, utl.PointerToString("changelog1.tpl"), &map[string]string{"Expression1": "string1"})
+	lpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(nil, nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG1.md"), &map[string]string{"SectionA1": "regexA1", "SectionA2": "regexA2"}, utl.PointerToString("changelog1.tpl"), &map[string]string{"Expression1": "string1"}, nil)
 	lowPriorityConfigurationLayerMock.SetChangelog(lpChangelogConfiguration)
-	mpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("head"), utl.PointerToString("CHANGELOG2.md"), &map[string]string{"SectionB1": "regexB1", "SectionB2": "regexB2"}, utl.PointerToString("changelog2.tpl"), &map[string]string{"Expression2": "string2"})
+	mpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("head"), nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG2.md"), &map[string]string{"SectionB1": "regexB1", "SectionB2": "regexB2"}, utl.PointerToString("changelog2.tpl"), &map[string]string{"Expression2": "string2"}, nil)
 	mediumPriorityConfigurationLayerMock.SetChangelog(mpChangelogConfiguration)
-	hpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("tail"), utl.PointerToString("CHANGELOG3.md"), &map[string]string{"SectionC1": "regexC1", "SectionC2": "regexC2"}, utl.PointerToString("changelog3.tpl"), &map[string]string{"Expression3": "string3"})
+	hpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("tail"), nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG3.md"), &map[string]string{"SectionC1": "regexC1", "SectionC2": "regexC2"}, utl.PointerToString("changelog3.tpl"), &map[string]string{"Expression3": "string3"}, nil)
 	highPriorityConfigurationLayerMock.SetChangelog(hpChangelogConfiguration)
 
 	lpCommitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("convention1")}, &map[string]*ent.CommitMessageConvention{"convention1": ent.NewCommitMessageConventionWith(utl.PointerToString("expr1"), &map[string]string{})})
@@ -1166,11 +1703,11 @@ func TestSerializationWithMultipleConfigurationLayersYAML(t *testing.T) {
 	mediumPriorityConfigurationLayerMock.SetDryRun(utl.PointerToBoolean(true))
 	highPriorityConfigurationLayerMock.SetDryRun(utl.PointerToBoolean(false))
 
-	lpGitConfiguration, _ := ent.NewGitConfigurationWith(&map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("jdoe1"), utl.PointerToString("pwd1"), nil, nil), "replica": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("stiger1"), utl.PointerToString("sec1"), nil, nil)})
+	lpGitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, utl.PointerToString("http://proxy.example.com:3128"), &map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("jdoe1"), utl.PointerToString("pwd1"), nil, nil, nil, nil, nil, nil, nil, nil, nil), "replica": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("stiger1"), utl.PointerToString("sec1"), nil, nil, nil, nil, nil, nil, nil, nil, nil)}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	lowPriorityConfigurationLayerMock.SetGit(lpGitConfiguration)
-	mpGitConfiguration, _ := ent.NewGitConfigurationWith(&map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("jdoe2"), utl.PointerToString("pwd2"), nil, nil), "clone": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("stiger2"), utl.PointerToString("sec2"), nil, nil)})
+	mpGitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("jdoe2"), utl.PointerToString("pwd2"), nil, nil, nil, nil, nil, nil, nil, nil, nil), "clone": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("stiger2"), utl.PointerToString("sec2"), nil, nil, nil, nil, nil, nil, nil, nil, nil)}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	mediumPriorityConfigurationLayerMock.SetGit(mpGitConfiguration)
-	hpGitConfiguration, _ := ent.NewGitConfigurationWith(&map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe3"), utl.PointerToString("pwd3"), utl.PointerToString("key3"), utl.PointerToString("passphrase3"))})
+	hpGitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe3"), utl.PointerToString("pwd3"), utl.PointerToString("key3"), utl.PointerToString("passphrase3"), nil, nil, nil, nil, nil, nil, nil)}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	highPriorityConfigurationLayerMock.SetGit(hpGitConfiguration)
 
 	lowPriorityConfigurationLayerMock.SetInitialVersion(utl.PointerToString("9.9.9"))
@@ -1234,6 +1771,9 @@ func TestSerializationWithMultipleConfigurationLayersYAML(t *testing.T) {
 	lowPriorityConfigurationLayerMock.SetSummaryFile(utl.PointerToString("summary.low"))
 	mediumPriorityConfigurationLayerMock.SetSummaryFile(utl.PointerToString("summary.medium"))
 	highPriorityConfigurationLayerMock.SetSummaryFile(utl.PointerToString("summary.high"))
+	lowPriorityConfigurationLayerMock.SetTimestampSource(ent.PointerToTimestampSource(ent.SYSTEM))
+	mediumPriorityConfigurationLayerMock.SetTimestampSource(ent.PointerToTimestampSource(ent.SYSTEM))
+	highPriorityConfigurationLayerMock.SetTimestampSource(ent.PointerToTimestampSource(ent.COMMIT))
 
 	lowPriorityConfigurationLayerMock.SetVerbosity(ent.PointerToVerbosity(ent.TRACE))
 	mediumPriorityConfigurationLayerMock.SetVerbosity(ent.PointerToVerbosity(ent.INFO))
@@ -1403,6 +1943,10 @@ func TestSerializationWithMultipleConfigurationLayersYAML(t *testing.T) {
 	summaryFile, _ := deserializedConfigurationLayer.GetSummaryFile()
 	assert.Equal(t, *hpSummaryFile, *summaryFile)
 
+	hpTimestampSource, _ := highPriorityConfigurationLayerMock.GetTimestampSource()
+	timestampSource, _ := deserializedConfigurationLayer.GetTimestampSource()
+	assert.Equal(t, *hpTimestampSource, *timestampSource)
+
 	hpVerbosity, _ := highPriorityConfigurationLayerMock.GetVerbosity()
 	verbosity, _ := deserializedConfigurationLayer.GetVerbosity()
 	assert.Equal(t, *hpVerbosity, *verbosity)
@@ -25,6 +25,33 @@ import (
 This interface models the root configuration, with global options and nested sections.
 */
 type ConfigurationRoot interface {
+	/*
+		Returns the path to the file where the analytics report about past releases is written as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetAnalyticsFile() (*string, error)
+
+	/*
+		Returns the directory where the version and release date badges are written as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetBadgesDirectory() (*string, error)
+
+	/*
+		Returns the regular expression used to extract metadata from the current branch name as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetBranchMetadataExpression() (*string, error)
+
 	/*
 		Returns the version identifier to bump as it's defined by this configuration.
 
@@ -43,6 +70,16 @@ type ConfigurationRoot interface {
 	*/
 	GetChangelog() (*ent.ChangelogConfiguration, error)
 
+	/*
+		Returns the flag that enables computing the files changed by each commit in the release scope
+		as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetCommitChanges() (*bool, error)
+
 	/*
 		Returns the commit message convention configuration section.
 
@@ -52,6 +89,15 @@ type ConfigurationRoot interface {
 	*/
 	GetCommitMessageConventions() (*ent.CommitMessageConventions, error)
 
+	/*
+		Returns the path to the file where the commit lint report is written in the SARIF format as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetCommitLintFile() (*string, error)
+
 	/*
 		Returns the path to a custom configuration file as it's defined by this configuration.
 
@@ -70,6 +116,15 @@ type ConfigurationRoot interface {
 	*/
 	GetDirectory() (*string, error)
 
+	/*
+		Returns the downstream updates configuration section.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetDownstreamUpdates() (*ent.DownstreamUpdates, error)
+
 	/*
 		Returns the value of the dry run flag as it's defined by this configuration.
 
@@ -79,6 +134,15 @@ type ConfigurationRoot interface {
 	*/
 	GetDryRun() (*bool, error)
 
+	/*
+		Returns the event emitters configuration section.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetEventBus() (*ent.EventBus, error)
+
 	/*
 		Returns the Git configuration section.
 
@@ -88,6 +152,15 @@ type ConfigurationRoot interface {
 	*/
 	GetGit() (*ent.GitConfiguration, error)
 
+	/*
+		Returns the impact analyzers configuration section.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetImpactAnalyzers() (*ent.ImpactAnalyzers, error)
+
 	/*
 		Returns the initial version defined by this configuration to use when no past version is available in the commit history.
 
@@ -97,6 +170,60 @@ type ConfigurationRoot interface {
 	*/
 	GetInitialVersion() (*string, error)
 
+	/*
+		Returns the path to the file where the JUnit XML release report must be saved as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetJUnitReportFile() (*string, error)
+
+	/*
+		Returns the policy applied when no significant commits are found since the previous version, so there is nothing to release.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetNothingToRelease() (*ent.NothingToReleasePolicy, error)
+
+	/*
+		Returns the name of the repository the organization configuration is discovered from as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetOrganizationConfigurationRepository() (*string, error)
+
+	/*
+		Returns the name of the service used to discover the organization configuration as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetOrganizationConfigurationService() (*string, error)
+
+	/*
+		Returns the path rules configuration section.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetPathRules() (*ent.PathRules, error)
+
+	/*
+		Returns the comma separated list of pre-release identifiers, from the lowest to the highest precedence, used to compare pre-release versions as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetPrereleaseOrder() (*string, error)
+
 	/*
 		Returns the selected preset configuration as it's defined by this configuration.
 
@@ -115,6 +242,16 @@ type ConfigurationRoot interface {
 	*/
 	GetReleaseAssets() (*map[string]*ent.Attachment, error)
 
+	/*
+		Returns the flag that enables coercion of release names that look like semantic versions, but are not, into legal semantic versions
+		as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetReleaseCoercion() (*bool, error)
+
 	/*
 		Returns the flag that enables tolerance in reading release names with arbitrary prefixes or extra non critical characters
 		as it's defined by this configuration.
@@ -143,6 +280,15 @@ type ConfigurationRoot interface {
 	*/
 	GetReleaseTypes() (*ent.ReleaseTypes, error)
 
+	/*
+		Returns the path to the file where the HTML release report must be saved as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetReportFile() (*string, error)
+
 	/*
 		Returns the value of the resume flag as it's defined by this configuration.
 
@@ -152,6 +298,16 @@ type ConfigurationRoot interface {
 	*/
 	GetResume() (*bool, error)
 
+	/*
+		Returns the name of the core identifier (major, minor or patch) whose significance bumps the fourth number
+		of four segment versions as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetRevisionBump() (*string, error)
+
 	/*
 		Returns the versioning scheme to use as it's defined by this configuration.
 
@@ -161,6 +317,15 @@ type ConfigurationRoot interface {
 	*/
 	GetScheme() (*ver.Scheme, error)
 
+	/*
+		Returns the server configuration section.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetServer() (*ent.ServerConfiguration, error)
+
 	/*
 		Returns the services configuration section.
 
@@ -188,6 +353,24 @@ type ConfigurationRoot interface {
 	*/
 	GetStateFile() (*string, error)
 
+	/*
+		Returns the secret key used to sign the state file and verify it when resuming as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetStateFileSigningKey() (*string, error)
+
+	/*
+		Returns the value of the strict exit codes flag as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetStrictExitCodes() (*bool, error)
+
 	/*
 		Returns the substitutions configuration section.
 
@@ -215,6 +398,33 @@ type ConfigurationRoot interface {
 	*/
 	GetSummaryFile() (*string, error)
 
+	/*
+		Returns the URL of the endpoint where the telemetry report must be uploaded as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetTelemetryEndpoint() (*string, error)
+
+	/*
+		Returns the path to the file where the telemetry report must be saved as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetTelemetryFile() (*string, error)
+
+	/*
+		Returns the source of the release timestamp as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetTimestampSource() (*ent.TimestampSource, error)
+
 	/*
 		Returns the logging verbosity level as it's defined by this configuration.
 
@@ -232,4 +442,13 @@ type ConfigurationRoot interface {
 		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 	*/
 	GetVersion() (*string, error)
+
+	/*
+		Returns the name of the service used to read and store released versions as it's defined by this configuration.
+
+		Error is:
+		- DataAccessError: in case the option cannot be read or accessed.
+		- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+	*/
+	GetVersionStorageService() (*string, error)
 }
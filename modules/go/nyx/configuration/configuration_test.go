@@ -22,10 +22,14 @@
 package configuration
 
 import (
-	"fmt"           // https://pkg.go.dev/fmt
-	"os"            // https://pkg.go.dev/os
-	"path/filepath" // https://pkg.go.dev/path/filepath
-	"testing"       // https://pkg.go.dev/testing
+	"encoding/base64"   // https://pkg.go.dev/encoding/base64
+	"fmt"               // https://pkg.go.dev/fmt
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"os"                // https://pkg.go.dev/os
+	"path/filepath"     // https://pkg.go.dev/path/filepath
+	"strings"           // https://pkg.go.dev/strings
+	"testing"           // https://pkg.go.dev/testing
 
 	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
 
@@ -38,6 +42,12 @@ import (
 /*
 Performs checks against default values
 */
+func TestConfigurationDefaultsGetAnalyticsFile(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	analyticsFile, _ := configuration.GetAnalyticsFile()
+	assert.Nil(t, analyticsFile)
+}
+
 func TestConfigurationDefaultsGetBump(t *testing.T) {
 	configuration, _ := NewConfiguration()
 	bump, _ := configuration.GetBump()
@@ -64,6 +74,12 @@ func TestConfigurationDefaultsGetChangelog(t *testing.T) {
 	}
 }
 
+func TestConfigurationDefaultsGetCommitChanges(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	commitChanges, _ := configuration.GetCommitChanges()
+	assert.Equal(t, *ent.COMMIT_CHANGES, *commitChanges)
+}
+
 func TestConfigurationDefaultsGetCommitMessageConventions(t *testing.T) {
 	configuration, _ := NewConfiguration()
 	commitMessageConventions, _ := configuration.GetCommitMessageConventions()
@@ -97,6 +113,18 @@ func TestConfigurationDefaultsGetDirectory(t *testing.T) {
 	}
 }
 
+func TestConfigurationDefaultsGetDownstreamUpdates(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	downstreamUpdates, _ := configuration.GetDownstreamUpdates()
+	if downstreamUpdates == nil {
+		assert.Nil(t, downstreamUpdates)
+	} else {
+		assert.Equal(t, *ent.DOWNSTREAM_UPDATES, *downstreamUpdates)
+		assert.Equal(t, (*ent.DOWNSTREAM_UPDATES).GetEnabled(), (*downstreamUpdates).GetEnabled())
+		assert.Equal(t, 0, len(*downstreamUpdates.GetItems()))
+	}
+}
+
 func TestConfigurationDefaultsSetDirectory(t *testing.T) {
 	tempDir, _ := os.MkdirTemp("", fmt.Sprintf("%p", t))
 	SetDefaultDirectory(&tempDir)
@@ -118,6 +146,18 @@ func TestConfigurationDefaultsGetDryRun(t *testing.T) {
 	}
 }
 
+func TestConfigurationDefaultsGetEventBus(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	eventBus, _ := configuration.GetEventBus()
+	if eventBus == nil {
+		assert.Nil(t, eventBus)
+	} else {
+		assert.Equal(t, *ent.EVENT_BUS, *eventBus)
+		assert.Equal(t, (*ent.EVENT_BUS).GetEnabled(), (*eventBus).GetEnabled())
+		assert.Equal(t, 0, len(*eventBus.GetItems()))
+	}
+}
+
 func TestConfigurationDefaultsGetGit(t *testing.T) {
 	configuration, _ := NewConfiguration()
 	git, _ := configuration.GetGit()
@@ -129,6 +169,18 @@ func TestConfigurationDefaultsGetGit(t *testing.T) {
 	}
 }
 
+func TestConfigurationDefaultsGetImpactAnalyzers(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	impactAnalyzers, _ := configuration.GetImpactAnalyzers()
+	if impactAnalyzers == nil {
+		assert.Nil(t, impactAnalyzers)
+	} else {
+		assert.Equal(t, *ent.IMPACT_ANALYZERS, *impactAnalyzers)
+		assert.Equal(t, (*ent.IMPACT_ANALYZERS).GetEnabled(), (*impactAnalyzers).GetEnabled())
+		assert.Equal(t, 0, len(*impactAnalyzers.GetItems()))
+	}
+}
+
 func TestConfigurationDefaultsGetInitialVersion(t *testing.T) {
 	configuration, _ := NewConfiguration()
 	initialVersion, _ := configuration.GetInitialVersion()
@@ -139,6 +191,42 @@ func TestConfigurationDefaultsGetInitialVersion(t *testing.T) {
 	}
 }
 
+func TestConfigurationDefaultsGetNothingToRelease(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	nothingToRelease, _ := configuration.GetNothingToRelease()
+	assert.Equal(t, *ent.NOTHING_TO_RELEASE, *nothingToRelease)
+}
+
+func TestConfigurationDefaultsGetOrganizationConfigurationRepository(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	organizationConfigurationRepository, _ := configuration.GetOrganizationConfigurationRepository()
+	assert.Equal(t, *ent.ORGANIZATION_CONFIGURATION_REPOSITORY, *organizationConfigurationRepository)
+}
+
+func TestConfigurationDefaultsGetOrganizationConfigurationService(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	organizationConfigurationService, _ := configuration.GetOrganizationConfigurationService()
+	assert.Nil(t, organizationConfigurationService)
+}
+
+func TestConfigurationDefaultsGetPathRules(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	pathRules, _ := configuration.GetPathRules()
+	if pathRules == nil {
+		assert.Nil(t, pathRules)
+	} else {
+		assert.Equal(t, *ent.PATH_RULES, *pathRules)
+		assert.Equal(t, (*ent.PATH_RULES).GetEnabled(), (*pathRules).GetEnabled())
+		assert.Equal(t, 0, len(*pathRules.GetItems()))
+	}
+}
+
+func TestConfigurationDefaultsGetPrereleaseOrder(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	prereleaseOrder, _ := configuration.GetPrereleaseOrder()
+	assert.Nil(t, prereleaseOrder)
+}
+
 func TestConfigurationDefaultsGetPreset(t *testing.T) {
 	configuration, _ := NewConfiguration()
 	preset, _ := configuration.GetPreset()
@@ -159,6 +247,12 @@ func TestConfigurationDefaultsGetReleaseAssets(t *testing.T) {
 	}
 }
 
+func TestConfigurationDefaultsGetReleaseCoercion(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	releaseCoercion, _ := configuration.GetReleaseCoercion()
+	assert.Equal(t, *ent.RELEASE_COERCION, *releaseCoercion)
+}
+
 func TestConfigurationDefaultsGetReleaseLenient(t *testing.T) {
 	configuration, _ := NewConfiguration()
 	releaseLenient, _ := configuration.GetReleaseLenient()
@@ -175,7 +269,7 @@ func TestConfigurationDefaultsGetReleasePrefix(t *testing.T) {
 	if releasePrefix == nil {
 		assert.Nil(t, releasePrefix)
 	} else {
-		assert.Equal(t, *ent.RELEASE_PREFIX, *releasePrefix)
+		assert.Equal(t, ent.RELEASE_PREFIX, releasePrefix)
 	}
 }
 
@@ -201,6 +295,12 @@ func TestConfigurationDefaultsGetResume(t *testing.T) {
 	}
 }
 
+func TestConfigurationDefaultsGetRevisionBump(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	revisionBump, _ := configuration.GetRevisionBump()
+	assert.Nil(t, revisionBump)
+}
+
 func TestConfigurationDefaultsGetScheme(t *testing.T) {
 	configuration, _ := NewConfiguration()
 	scheme, _ := configuration.GetScheme()
@@ -211,6 +311,20 @@ func TestConfigurationDefaultsGetScheme(t *testing.T) {
 	}
 }
 
+func TestConfigurationDefaultsGetServer(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	server, _ := configuration.GetServer()
+	if server == nil {
+		assert.Nil(t, server)
+	} else {
+		assert.Equal(t, *ent.SERVER, *server)
+		assert.Equal(t, (*ent.SERVER).GetAddress(), (*server).GetAddress())
+		assert.Equal(t, (*ent.SERVER).GetBranches(), (*server).GetBranches())
+		assert.Equal(t, (*ent.SERVER).GetCommand(), (*server).GetCommand())
+		assert.Equal(t, (*ent.SERVER).GetSecret(), (*server).GetSecret())
+	}
+}
+
 func TestConfigurationDefaultsGetServices(t *testing.T) {
 	configuration, _ := NewConfiguration()
 	services, _ := configuration.GetServices()
@@ -240,6 +354,25 @@ func TestConfigurationDefaultsGetStateFile(t *testing.T) {
 		assert.Equal(t, *ent.STATE_FILE, *stateFile)
 	}
 }
+func TestConfigurationDefaultsGetStateFileSigningKey(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	stateFileSigningKey, _ := configuration.GetStateFileSigningKey()
+	if stateFileSigningKey == nil {
+		assert.Nil(t, stateFileSigningKey)
+	} else {
+		assert.Equal(t, *ent.STATE_FILE_SIGNING_KEY, *stateFileSigningKey)
+	}
+}
+
+func TestConfigurationDefaultsGetStrictExitCodes(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	strictExitCodes, _ := configuration.GetStrictExitCodes()
+	if strictExitCodes == nil {
+		assert.Nil(t, strictExitCodes)
+	} else {
+		assert.Equal(t, *ent.STRICT_EXIT_CODES, *strictExitCodes)
+	}
+}
 
 func TestConfigurationDefaultsGetSubstitutions(t *testing.T) {
 	configuration, _ := NewConfiguration()
@@ -273,6 +406,12 @@ func TestConfigurationDefaultsGetSummaryFile(t *testing.T) {
 	}
 }
 
+func TestConfigurationDefaultsGetTimestampSource(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	timestampSource, _ := configuration.GetTimestampSource()
+	assert.Equal(t, *ent.TIMESTAMP_SOURCE, *timestampSource)
+}
+
 func TestConfigurationDefaultsGetVerbosity(t *testing.T) {
 	configuration, _ := NewConfiguration()
 	verbosity, _ := configuration.GetVerbosity()
@@ -293,6 +432,12 @@ func TestConfigurationDefaultsGetVersion(t *testing.T) {
 	}
 }
 
+func TestConfigurationDefaultsGetVersionStorageService(t *testing.T) {
+	configuration, _ := NewConfiguration()
+	versionStorageService, _ := configuration.GetVersionStorageService()
+	assert.Nil(t, versionStorageService)
+}
+
 /*
 Performs checks against the injection of a command line configuration
 */
@@ -337,6 +482,7 @@ func TestConfigurationWithCommandLineConfigurationGetChangelog(t *testing.T) {
 		"--changelog-sections-Section2=regex2",
 		"--changelog-substitutions-Expression1=string1",
 		"--changelog-template=changelog.tpl",
+		"--changelog-upgrade-notes=UPGRADE.md",
 	})
 
 	// in order to make the test meaningful, make sure the default and mock values are different
@@ -358,6 +504,8 @@ func TestConfigurationWithCommandLineConfigurationGetChangelog(t *testing.T) {
 	assert.Equal(t, 0, len(*(*changelog2).GetSubstitutions()))
 	assert.Nil(t, (*ent.CHANGELOG).GetTemplate())
 	assert.Nil(t, (*changelog2).GetTemplate())
+	assert.Equal(t, "docs/upgrade-notes/{{version}}.md", *(*ent.CHANGELOG).GetUpgradeNotes())
+	assert.Equal(t, "docs/upgrade-notes/{{version}}.md", *(*changelog2).GetUpgradeNotes())
 
 	// inject the command line configuration and test the new value is returned from that
 	var cl ConfigurationLayer = configurationLayerMock
@@ -374,6 +522,7 @@ func TestConfigurationWithCommandLineConfigurationGetChangelog(t *testing.T) {
 	assert.Equal(t, 1, len(*(*changelog2).GetSubstitutions()))
 	assert.Equal(t, "string1", (*(*changelog2).GetSubstitutions())["Expression1"])
 	assert.Equal(t, "changelog.tpl", *(*changelog2).GetTemplate())
+	assert.Equal(t, "UPGRADE.md", *(*changelog2).GetUpgradeNotes())
 
 	// now remove the command line configuration and test that now default values are returned again
 	configuration.WithCommandLineConfiguration(nil)
@@ -383,6 +532,7 @@ func TestConfigurationWithCommandLineConfigurationGetChangelog(t *testing.T) {
 	assert.Equal(t, 0, len(*(*changelog2).GetSections()))
 	assert.Equal(t, 0, len(*(*changelog2).GetSubstitutions()))
 	assert.Nil(t, (*changelog2).GetTemplate())
+	assert.Equal(t, "docs/upgrade-notes/{{version}}.md", *(*changelog2).GetUpgradeNotes())
 }
 
 func TestConfigurationWithCommandLineConfigurationGetCommitMessageConventions(t *testing.T) {
@@ -524,6 +674,7 @@ func TestConfigurationWithCommandLineConfigurationGetGit(t *testing.T) {
 	configurationLayerMock := NewCommandLineConfigurationLayer()
 	configuration, _ := NewConfiguration()
 	configurationLayerMock.withArguments([]string{
+		"--git-proxy=http://proxy.example.com:3128",
 		"--git-remotes-origin-authenticationMethod=PUBLIC_KEY",
 		"--git-remotes-origin-user=jdoe",
 		"--git-remotes-origin-password=pwd",
@@ -551,6 +702,7 @@ func TestConfigurationWithCommandLineConfigurationGetGit(t *testing.T) {
 	configuration.WithCommandLineConfiguration(&cl)
 
 	git2, _ = configuration.GetGit()
+	assert.Equal(t, "http://proxy.example.com:3128", *(*git2).GetProxy())
 	assert.NotNil(t, (*git2).GetRemotes())
 	assert.Equal(t, 1, len(*(*git2).GetRemotes()))
 	assert.Equal(t, ent.PUBLIC_KEY, *(*(*git2).GetRemotes())["origin"].GetAuthenticationMethod())
@@ -1215,7 +1367,7 @@ func TestConfigurationWithPluginConfigurationGetBump(t *testing.T) {
 func TestConfigurationWithPluginConfigurationGetChangelog(t *testing.T) {
 	configurationLayerMock := NewSimpleConfigurationLayer()
 	configuration, _ := NewConfiguration()
-	changelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("head"), utl.PointerToString("CHANGELOG.md"), &map[string]string{"Section1": "regex1", "Section2": "regex2"}, utl.PointerToString("changelog.tpl"), &map[string]string{"Expression1": "string1"})
+	changelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("head"), nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG.md"), &map[string]string{"Section1": "regex1", "Section2": "regex2"}, utl.PointerToString("changelog.tpl"), &map[string]string{"Expression1": "string1"}, nil)
 	configurationLayerMock.SetChangelog(changelogConfiguration)
 
 	// in order to make the test meaningful, make sure the default and mock values are different
@@ -1394,7 +1546,7 @@ func TestConfigurationWithPluginConfigurationGetDryRun(t *testing.T) {
 func TestConfigurationWithPluginConfigurationGetGit(t *testing.T) {
 	configurationLayerMock := NewSimpleConfigurationLayer()
 	configuration, _ := NewConfiguration()
-	gitConfiguration, _ := ent.NewGitConfigurationWith(&map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe"), utl.PointerToString("pwd"), utl.PointerToString("key"), utl.PointerToString("passphrase"))})
+	gitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe"), utl.PointerToString("pwd"), utl.PointerToString("key"), utl.PointerToString("passphrase"), nil, nil, nil, nil, nil, nil, nil)}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	configurationLayerMock.SetGit(gitConfiguration)
 
 	// in order to make the test meaningful, make sure the default and mock values are different
@@ -2010,7 +2162,7 @@ func TestConfigurationWithRuntimeConfigurationGetBump(t *testing.T) {
 func TestConfigurationWithRuntimeConfigurationGetChangelog(t *testing.T) {
 	configurationLayerMock := NewSimpleConfigurationLayer()
 	configuration, _ := NewConfiguration()
-	changelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("head"), utl.PointerToString("CHANGELOG.md"), &map[string]string{"Section1": "regex1", "Section2": "regex2"}, utl.PointerToString("changelog.tpl"), &map[string]string{"Expression1": "string1"})
+	changelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("head"), nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG.md"), &map[string]string{"Section1": "regex1", "Section2": "regex2"}, utl.PointerToString("changelog.tpl"), &map[string]string{"Expression1": "string1"}, nil)
 	configurationLayerMock.SetChangelog(changelogConfiguration)
 
 	// in order to make the test meaningful, make sure the default and mock values are different
@@ -2189,7 +2341,7 @@ func TestConfigurationWithRuntimeConfigurationGetDryRun(t *testing.T) {
 func TestConfigurationWithRuntimeConfigurationGetGit(t *testing.T) {
 	configurationLayerMock := NewSimpleConfigurationLayer()
 	configuration, _ := NewConfiguration()
-	gitConfiguration, _ := ent.NewGitConfigurationWith(&map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe"), utl.PointerToString("pwd"), utl.PointerToString("key"), utl.PointerToString("passphrase"))})
+	gitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe"), utl.PointerToString("pwd"), utl.PointerToString("key"), utl.PointerToString("passphrase"), nil, nil, nil, nil, nil, nil, nil)}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	configurationLayerMock.SetGit(gitConfiguration)
 
 	// in order to make the test meaningful, make sure the default and mock values are different
@@ -2255,6 +2407,25 @@ func TestConfigurationWithRuntimeConfigurationGetInitialVersion(t *testing.T) {
 	assert.Equal(t, *ent.INITIAL_VERSION, *initialVersion2)
 }
 
+func TestConfigurationWithRuntimeConfigurationGetInitialVersionDefaultForScheme(t *testing.T) {
+	configurationLayerMock := NewSimpleConfigurationLayer()
+	configuration, _ := NewConfiguration()
+	configurationLayerMock.SetScheme(ver.PointerToScheme(ver.FOUR_SEGMENT))
+	var cl ConfigurationLayer = configurationLayerMock
+	configuration.WithRuntimeConfiguration(&cl)
+
+	// when the initial version is not configured it's the default for the scheme
+	initialVersion, err := configuration.GetInitialVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, ver.FOUR_SEGMENT_VERSION_DEFAULT_INITIAL_VERSION, *initialVersion)
+
+	// the configured initial version has priority
+	configurationLayerMock.SetInitialVersion(utl.PointerToString("1.2.3.4"))
+	initialVersion, err = configuration.GetInitialVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3.4", *initialVersion)
+}
+
 func TestConfigurationWithRuntimeConfigurationGetPreset(t *testing.T) {
 	configurationLayerMock := NewSimpleConfigurationLayer()
 	configuration, _ := NewConfiguration()
@@ -2803,7 +2974,7 @@ func TestConfigurationWithMultipleConfigurationLayersGetChangelog(t *testing.T)
 	mediumPriorityConfigurationLayerMock := NewCommandLineConfigurationLayer()
 	highPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
 	configuration, _ := NewConfiguration()
-	lpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(nil, utl.PointerToString("CHANGELOG1.md"), &map[string]string{"SectionA1": "regexA1", "SectionA2": "regexA2"}, utl.PointerToString("changelog1.tpl"), &map[string]string{"Expression1": "string1"})
+	lpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(nil, nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG1.md"), &map[string]string{"SectionA1": "regexA1", "SectionA2": "regexA2"}, utl.PointerToString("changelog1.tpl"), &map[string]string{"Expression1": "string1"}, nil)
 	lowPriorityConfigurationLayerMock.SetChangelog(lpChangelogConfiguration)
 	mediumPriorityConfigurationLayerMock.withArguments([]string{
 		"--changelog-append=head",
@@ -2813,7 +2984,7 @@ func TestConfigurationWithMultipleConfigurationLayersGetChangelog(t *testing.T)
 		"--changelog-substitutions-Expression2=string2",
 		"--changelog-template=changelog2.tpl",
 	})
-	hpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("tail"), utl.PointerToString("CHANGELOG3.md"), &map[string]string{"SectionC1": "regexC1", "SectionC2": "regexC2"}, utl.PointerToString("changelog3.tpl"), &map[string]string{"Expression3": "string3"})
+	hpChangelogConfiguration, _ := ent.NewChangelogConfigurationWith(utl.PointerToString("tail"), nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG3.md"), &map[string]string{"SectionC1": "regexC1", "SectionC2": "regexC2"}, utl.PointerToString("changelog3.tpl"), &map[string]string{"Expression3": "string3"}, nil)
 	highPriorityConfigurationLayerMock.SetChangelog(hpChangelogConfiguration)
 
 	// inject the command line configuration and test the new value is returned from that
@@ -2949,7 +3120,7 @@ func TestConfigurationWithMultipleConfigurationLayersGetGit(t *testing.T) {
 	mediumPriorityConfigurationLayerMock := NewCommandLineConfigurationLayer()
 	highPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
 	configuration, _ := NewConfiguration()
-	lpGitConfiguration, _ := ent.NewGitConfigurationWith(&map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("jdoe1"), utl.PointerToString("pwd1"), nil, nil), "replica": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("stiger1"), utl.PointerToString("sec1"), nil, nil)})
+	lpGitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("jdoe1"), utl.PointerToString("pwd1"), nil, nil, nil, nil, nil, nil, nil, nil, nil), "replica": ent.NewGitRemoteConfigurationWith(nil, utl.PointerToString("stiger1"), utl.PointerToString("sec1"), nil, nil, nil, nil, nil, nil, nil, nil, nil)}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	lowPriorityConfigurationLayerMock.SetGit(lpGitConfiguration)
 	mediumPriorityConfigurationLayerMock.withArguments([]string{
 		"--git-remotes-origin-user=jdoe2",
@@ -2957,7 +3128,7 @@ func TestConfigurationWithMultipleConfigurationLayersGetGit(t *testing.T) {
 		"--git-remotes-clone-user=stiger2",
 		"--git-remotes-clone-password=sec2",
 	})
-	hpGitConfiguration, _ := ent.NewGitConfigurationWith(&map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe3"), utl.PointerToString("pwd3"), utl.PointerToString("key3"), utl.PointerToString("passphrase3"))})
+	hpGitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe3"), utl.PointerToString("pwd3"), utl.PointerToString("key3"), utl.PointerToString("passphrase3"), nil, nil, nil, nil, nil, nil, nil)}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	highPriorityConfigurationLayerMock.SetGit(hpGitConfiguration)
 
 	// inject the command line configuration and test the new value is returned from that
@@ -3012,6 +3183,54 @@ func TestConfigurationWithMultipleConfigurationLayersGetInitialVersion(t *testin
 	assert.Equal(t, *hpInitialVersion, *initialVersion)
 }
 
+func TestConfigurationWithMultipleConfigurationLayersGetNothingToRelease(t *testing.T) {
+	lowPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
+	mediumPriorityConfigurationLayerMock := NewCommandLineConfigurationLayer()
+	highPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
+	configuration, _ := NewConfiguration()
+	lowPriorityConfigurationLayerMock.SetNothingToRelease(ent.PointerToNothingToReleasePolicy(ent.PREVIOUS_VERSION))
+	mediumPriorityConfigurationLayerMock.withArguments([]string{
+		"--nothing-to-release=" + ent.SKIP.String(),
+	})
+	highPriorityConfigurationLayerMock.SetNothingToRelease(ent.PointerToNothingToReleasePolicy(ent.FAIL))
+
+	// inject the plugin configuration and test the new value is returned from that
+	var lpl ConfigurationLayer = lowPriorityConfigurationLayerMock
+	var mpl ConfigurationLayer = mediumPriorityConfigurationLayerMock
+	var hpl ConfigurationLayer = highPriorityConfigurationLayerMock
+	configuration.WithPluginConfiguration(&lpl)
+	configuration.WithCommandLineConfiguration(&mpl)
+	configuration.WithRuntimeConfiguration(&hpl)
+
+	hpNothingToRelease, _ := highPriorityConfigurationLayerMock.GetNothingToRelease()
+	nothingToRelease, _ := configuration.GetNothingToRelease()
+	assert.Equal(t, *hpNothingToRelease, *nothingToRelease)
+}
+
+func TestConfigurationWithMultipleConfigurationLayersGetOrganizationConfigurationRepository(t *testing.T) {
+	lowPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
+	mediumPriorityConfigurationLayerMock := NewCommandLineConfigurationLayer()
+	highPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
+	configuration, _ := NewConfiguration()
+	lowPriorityConfigurationLayerMock.SetOrganizationConfigurationRepository(utl.PointerToString("low/.nyx"))
+	mediumPriorityConfigurationLayerMock.withArguments([]string{
+		"--organization-configuration-repository=medium/.nyx",
+	})
+	highPriorityConfigurationLayerMock.SetOrganizationConfigurationRepository(utl.PointerToString("high/.nyx"))
+
+	// inject the plugin configuration and test the new value is returned from that
+	var lpl ConfigurationLayer = lowPriorityConfigurationLayerMock
+	var mpl ConfigurationLayer = mediumPriorityConfigurationLayerMock
+	var hpl ConfigurationLayer = highPriorityConfigurationLayerMock
+	configuration.WithPluginConfiguration(&lpl)
+	configuration.WithCommandLineConfiguration(&mpl)
+	configuration.WithRuntimeConfiguration(&hpl)
+
+	hpOrganizationConfigurationRepository, _ := highPriorityConfigurationLayerMock.GetOrganizationConfigurationRepository()
+	organizationConfigurationRepository, _ := configuration.GetOrganizationConfigurationRepository()
+	assert.Equal(t, *hpOrganizationConfigurationRepository, *organizationConfigurationRepository)
+}
+
 func TestConfigurationWithMultipleConfigurationLayersGetPreset(t *testing.T) {
 	lowPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
 	mediumPriorityConfigurationLayerMock := NewCommandLineConfigurationLayer()
@@ -3352,6 +3571,53 @@ func TestConfigurationWithMultipleConfigurationLayersGetStateFile(t *testing.T)
 	stateFile, _ := configuration.GetStateFile()
 	assert.Equal(t, *hpStateFile, *stateFile)
 }
+func TestConfigurationWithMultipleConfigurationLayersGetStateFileSigningKey(t *testing.T) {
+	lowPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
+	mediumPriorityConfigurationLayerMock := NewCommandLineConfigurationLayer()
+	highPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
+	configuration, _ := NewConfiguration()
+	lowPriorityConfigurationLayerMock.SetStateFileSigningKey(utl.PointerToString("secret1"))
+	mediumPriorityConfigurationLayerMock.withArguments([]string{
+		"--state-file-signing-key=secret2",
+	})
+	highPriorityConfigurationLayerMock.SetStateFileSigningKey(utl.PointerToString("secret3"))
+
+	// inject the plugin configuration and test the new value is returned from that
+	var lpl ConfigurationLayer = lowPriorityConfigurationLayerMock
+	var mpl ConfigurationLayer = mediumPriorityConfigurationLayerMock
+	var hpl ConfigurationLayer = highPriorityConfigurationLayerMock
+	configuration.WithPluginConfiguration(&lpl)
+	configuration.WithCommandLineConfiguration(&mpl)
+	configuration.WithRuntimeConfiguration(&hpl)
+
+	hpStateFileSigningKey, _ := highPriorityConfigurationLayerMock.GetStateFileSigningKey()
+	stateFileSigningKey, _ := configuration.GetStateFileSigningKey()
+	assert.Equal(t, *hpStateFileSigningKey, *stateFileSigningKey)
+}
+
+func TestConfigurationWithMultipleConfigurationLayersGetStrictExitCodes(t *testing.T) {
+	lowPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
+	mediumPriorityConfigurationLayerMock := NewCommandLineConfigurationLayer()
+	highPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
+	configuration, _ := NewConfiguration()
+	lowPriorityConfigurationLayerMock.SetStrictExitCodes(utl.PointerToBoolean(true))
+	mediumPriorityConfigurationLayerMock.withArguments([]string{
+		"--strict-exit-codes",
+	})
+	highPriorityConfigurationLayerMock.SetStrictExitCodes(utl.PointerToBoolean(false))
+
+	// inject the plugin configuration and test the new value is returned from that
+	var lpl ConfigurationLayer = lowPriorityConfigurationLayerMock
+	var mpl ConfigurationLayer = mediumPriorityConfigurationLayerMock
+	var hpl ConfigurationLayer = highPriorityConfigurationLayerMock
+	configuration.WithPluginConfiguration(&lpl)
+	configuration.WithCommandLineConfiguration(&mpl)
+	configuration.WithRuntimeConfiguration(&hpl)
+
+	hpStrictExitCodes, _ := highPriorityConfigurationLayerMock.GetStrictExitCodes()
+	strictExitCodes, _ := configuration.GetStrictExitCodes()
+	assert.Equal(t, *hpStrictExitCodes, *strictExitCodes)
+}
 
 func TestConfigurationWithMultipleConfigurationLayersGetSubstitutions(t *testing.T) {
 	lowPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
@@ -3433,6 +3699,30 @@ func TestConfigurationWithMultipleConfigurationLayersGetSummaryFile(t *testing.T
 	assert.Equal(t, *hpSummaryFile, *summaryFile)
 }
 
+func TestConfigurationWithMultipleConfigurationLayersGetTimestampSource(t *testing.T) {
+	lowPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
+	mediumPriorityConfigurationLayerMock := NewCommandLineConfigurationLayer()
+	highPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
+	configuration, _ := NewConfiguration()
+	lowPriorityConfigurationLayerMock.SetTimestampSource(ent.PointerToTimestampSource(ent.SYSTEM))
+	mediumPriorityConfigurationLayerMock.withArguments([]string{
+		"--timestamp-source=" + ent.SYSTEM.String(),
+	})
+	highPriorityConfigurationLayerMock.SetTimestampSource(ent.PointerToTimestampSource(ent.COMMIT))
+
+	// inject the plugin configuration and test the new value is returned from that
+	var lpl ConfigurationLayer = lowPriorityConfigurationLayerMock
+	var mpl ConfigurationLayer = mediumPriorityConfigurationLayerMock
+	var hpl ConfigurationLayer = highPriorityConfigurationLayerMock
+	configuration.WithPluginConfiguration(&lpl)
+	configuration.WithCommandLineConfiguration(&mpl)
+	configuration.WithRuntimeConfiguration(&hpl)
+
+	hpTimestampSource, _ := highPriorityConfigurationLayerMock.GetTimestampSource()
+	timestampSource, _ := configuration.GetTimestampSource()
+	assert.Equal(t, *hpTimestampSource, *timestampSource)
+}
+
 func TestConfigurationWithMultipleConfigurationLayersGetVerbosity(t *testing.T) {
 	lowPriorityConfigurationLayerMock := NewSimpleConfigurationLayer()
 	mediumPriorityConfigurationLayerMock := NewCommandLineConfigurationLayer()
@@ -3620,3 +3910,118 @@ func TestConfigurationWithCombinedConfigurationWithStandardCustomFilesAndCommand
 	assert.Equal(t, "cmdlinePrefix", *releasePrefix) // this must come from the custom configuration file defined by the command line config
 	assert.Equal(t, "cmdline", *bump)                // this is the value configured in the command line and has higher priority over all others
 }
+
+/*
+Performs checks against the discovery of the organization configuration
+*/
+func TestConfigurationWithOrganizationConfiguration(t *testing.T) {
+	tempDir, _ := os.MkdirTemp("", fmt.Sprintf("%p", t))
+	SetDefaultDirectory(&tempDir)
+
+	// serve the organization configuration only as YAML so the JSON lookup fails first
+	requests := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path] = requests[r.URL.Path] + 1
+		if strings.HasSuffix(r.URL.Path, "/repos/acme/.nyx/contents/.nyx.yaml") {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"type":"file","encoding":"base64","name":".nyx.yaml","path":".nyx.yaml","content":"%s"}`, base64.StdEncoding.EncodeToString([]byte("bump: \"organization\"\nreleasePrefix: \"org\"\norganizationConfigurationService: \"ignored\"\n")))
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"Not Found"}`)
+		}
+	}))
+	defer server.Close()
+
+	runtimeConfiguration := NewSimpleConfigurationLayer()
+	runtimeConfiguration.SetBump(utl.PointerToString("runtime"))
+	runtimeConfiguration.SetServices(&map[string]*ent.ServiceConfiguration{"org": ent.NewServiceConfigurationWith(ent.PointerToProvider(ent.GITHUB), &map[string]string{"BASE_URI": server.URL + "/"})})
+	runtimeConfiguration.SetOrganizationConfigurationService(utl.PointerToString("org"))
+	runtimeConfiguration.SetOrganizationConfigurationRepository(utl.PointerToString("acme/.nyx"))
+
+	configuration, _ := NewConfiguration()
+	var rcl ConfigurationLayer = runtimeConfiguration
+	_, err := configuration.WithRuntimeConfiguration(&rcl)
+	assert.NoError(t, err)
+
+	bump, _ := configuration.GetBump()
+	releasePrefix, _ := configuration.GetReleasePrefix()
+	organizationConfigurationService, _ := configuration.GetOrganizationConfigurationService()
+	assert.Equal(t, "org", *releasePrefix)                    // this is configured only on the organization file
+	assert.Equal(t, "runtime", *bump)                         // this is the value configured in the runtime layer and has higher priority over the organization file
+	assert.Equal(t, "org", *organizationConfigurationService) // this is ignored on the organization file to avoid chaining
+
+	// updating the layers again must not query the service again
+	_, err = configuration.WithRuntimeConfiguration(&rcl)
+	assert.NoError(t, err)
+	for path, count := range requests {
+		assert.Equal(t, 1, count, path)
+	}
+}
+
+func TestConfigurationWithOrganizationConfigurationAndStandardFiles(t *testing.T) {
+	tempDir, _ := os.MkdirTemp("", fmt.Sprintf("%p", t))
+	SetDefaultDirectory(&tempDir)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	// the organization configuration is only discovered when no local configuration file exists
+	standardLocalConfiguration := NewSimpleConfigurationLayer()
+	standardLocalConfiguration.SetBump(utl.PointerToString("standard-local"))
+	standardLocalConfiguration.SetServices(&map[string]*ent.ServiceConfiguration{"org": ent.NewServiceConfigurationWith(ent.PointerToProvider(ent.GITHUB), &map[string]string{"BASE_URI": server.URL + "/", "REPOSITORY_OWNER": "acme"})})
+	standardLocalConfiguration.SetOrganizationConfigurationService(utl.PointerToString("org"))
+	standardLocalConfigurationFile, _ := os.Create(filepath.Join(tempDir, ".nyx.json"))
+	defer os.Remove(standardLocalConfigurationFile.Name())
+	io.Save(standardLocalConfigurationFile.Name(), standardLocalConfiguration)
+
+	configuration, err := NewConfiguration()
+	assert.NoError(t, err)
+	bump, _ := configuration.GetBump()
+	assert.Equal(t, "standard-local", *bump)
+	assert.Equal(t, 0, requests)
+}
+
+func TestConfigurationWithOrganizationConfigurationNotFound(t *testing.T) {
+	tempDir, _ := os.MkdirTemp("", fmt.Sprintf("%p", t))
+	SetDefaultDirectory(&tempDir)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	runtimeConfiguration := NewSimpleConfigurationLayer()
+	runtimeConfiguration.SetServices(&map[string]*ent.ServiceConfiguration{"org": ent.NewServiceConfigurationWith(ent.PointerToProvider(ent.GITHUB), &map[string]string{"BASE_URI": server.URL + "/", "REPOSITORY_OWNER": "acme"})})
+	runtimeConfiguration.SetOrganizationConfigurationService(utl.PointerToString("org"))
+
+	// a missing organization configuration is not an error
+	configuration, _ := NewConfiguration()
+	var rcl ConfigurationLayer = runtimeConfiguration
+	_, err := configuration.WithRuntimeConfiguration(&rcl)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, requests) // one for every supported file name
+
+	releasePrefix, _ := configuration.GetReleasePrefix()
+	assert.Equal(t, ent.RELEASE_PREFIX, releasePrefix)
+
+	// an undefined service is an error instead
+	runtimeConfiguration.SetOrganizationConfigurationService(utl.PointerToString("missing"))
+	_, err = configuration.WithRuntimeConfiguration(&rcl)
+	assert.Error(t, err)
+}
+
+func TestConfigurationRepositoryOwnerFromURL(t *testing.T) {
+	assert.Equal(t, "acme", *repositoryOwnerFromURL("https://github.com/acme/project.git"))
+	assert.Equal(t, "acme", *repositoryOwnerFromURL("https://user@github.com/acme/project"))
+	assert.Equal(t, "acme/group/subgroup", *repositoryOwnerFromURL("https://gitlab.com/acme/group/subgroup/project.git"))
+	assert.Equal(t, "acme", *repositoryOwnerFromURL("git@github.com:acme/project.git"))
+	assert.Equal(t, "acme", *repositoryOwnerFromURL("ssh://git@github.com/acme/project.git"))
+	assert.Nil(t, repositoryOwnerFromURL("https://github.com/project.git"))
+	assert.Nil(t, repositoryOwnerFromURL("https://github.com"))
+}
@@ -59,6 +59,30 @@ func GetDefaultLayerInstance() *DefaultLayer {
 	return defaultLayerInstance
 }
 
+/*
+Returns the default path to the file where the analytics report about past releases is written. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetAnalyticsFile() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "analyticsFile", ent.ANALYTICS_FILE)
+	return ent.ANALYTICS_FILE, nil
+}
+
+/*
+Returns the default directory where the version and release date badges are written. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetBadgesDirectory() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "badgesDirectory", ent.BADGES_DIRECTORY)
+	return ent.BADGES_DIRECTORY, nil
+}
+
+/*
+Returns the default regular expression used to extract metadata from the current branch name. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetBranchMetadataExpression() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "branchMetadataExpression", ent.BRANCH_METADATA_EXPRESSION)
+	return ent.BRANCH_METADATA_EXPRESSION, nil
+}
+
 /*
 Returns the default version identifier to bump. A nil value means undefined.
 */
@@ -75,6 +99,15 @@ func (dl *DefaultLayer) GetChangelog() (*ent.ChangelogConfiguration, error) {
 	return ent.CHANGELOG, nil
 }
 
+/*
+Returns the default flag that enables computing the files changed by each commit in the release scope.
+A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetCommitChanges() (*bool, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "commitChanges", ent.COMMIT_CHANGES)
+	return ent.COMMIT_CHANGES, nil
+}
+
 /*
 Returns the default commit message convention configuration section.
 */
@@ -83,6 +116,14 @@ func (dl *DefaultLayer) GetCommitMessageConventions() (*ent.CommitMessageConvent
 	return ent.COMMIT_MESSAGE_CONVENTIONS, nil
 }
 
+/*
+Returns the default path to the file where the commit lint report is written in the SARIF format. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetCommitLintFile() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "commitLintFile", ent.COMMIT_LINT_FILE)
+	return ent.COMMIT_LINT_FILE, nil
+}
+
 /*
 Returns the default path to a custom configuration file. A nil value means undefined.
 */
@@ -109,6 +150,14 @@ func (dl *DefaultLayer) GetDirectory() (*string, error) {
 	}
 }
 
+/*
+Returns the default downstream updates configuration section.
+*/
+func (dl *DefaultLayer) GetDownstreamUpdates() (*ent.DownstreamUpdates, error) {
+	log.Tracef("retrieving the default '%s' configuration option", "downstreamUpdates")
+	return ent.DOWNSTREAM_UPDATES, nil
+}
+
 /*
 Sets the default directory to use as the working directory. A nil value means undefined.
 */
@@ -130,6 +179,14 @@ func (dl *DefaultLayer) GetDryRun() (*bool, error) {
 	return ent.DRY_RUN, nil
 }
 
+/*
+Returns the default event emitters configuration section.
+*/
+func (dl *DefaultLayer) GetEventBus() (*ent.EventBus, error) {
+	log.Tracef("retrieving the default '%s' configuration option", "eventBus")
+	return ent.EVENT_BUS, nil
+}
+
 /*
 Returns the default Git configuration section.
 */
@@ -138,6 +195,14 @@ func (dl *DefaultLayer) GetGit() (*ent.GitConfiguration, error) {
 	return ent.GIT, nil
 }
 
+/*
+Returns the default impact analyzers configuration section.
+*/
+func (dl *DefaultLayer) GetImpactAnalyzers() (*ent.ImpactAnalyzers, error) {
+	log.Tracef("retrieving the default '%s' configuration option", "impactAnalyzers")
+	return ent.IMPACT_ANALYZERS, nil
+}
+
 /*
 Returns the default initial version defined by this configuration to use when no past version is available in the commit history. A nil value means undefined.
 */
@@ -146,6 +211,54 @@ func (dl *DefaultLayer) GetInitialVersion() (*string, error) {
 	return ent.INITIAL_VERSION, nil
 }
 
+/*
+Returns the default path to the file where the JUnit XML release report must be saved. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetJUnitReportFile() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "junitReportFile", ent.JUNIT_REPORT_FILE)
+	return ent.JUNIT_REPORT_FILE, nil
+}
+
+/*
+Returns the default policy applied when there is nothing to release. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetNothingToRelease() (*ent.NothingToReleasePolicy, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "nothingToRelease", ent.NOTHING_TO_RELEASE)
+	return ent.NOTHING_TO_RELEASE, nil
+}
+
+/*
+Returns the default name of the repository the organization configuration is discovered from. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetOrganizationConfigurationRepository() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "organizationConfigurationRepository", ent.ORGANIZATION_CONFIGURATION_REPOSITORY)
+	return ent.ORGANIZATION_CONFIGURATION_REPOSITORY, nil
+}
+
+/*
+Returns the default name of the service used to discover the organization configuration. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetOrganizationConfigurationService() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "organizationConfigurationService", ent.ORGANIZATION_CONFIGURATION_SERVICE)
+	return ent.ORGANIZATION_CONFIGURATION_SERVICE, nil
+}
+
+/*
+Returns the default path rules configuration section.
+*/
+func (dl *DefaultLayer) GetPathRules() (*ent.PathRules, error) {
+	log.Tracef("retrieving the default '%s' configuration option", "pathRules")
+	return ent.PATH_RULES, nil
+}
+
+/*
+Returns the default comma separated list of pre-release identifiers used to compare pre-release versions. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetPrereleaseOrder() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "prereleaseOrder", ent.PRERELEASE_ORDER)
+	return ent.PRERELEASE_ORDER, nil
+}
+
 /*
 Returns the default selected preset configuration. A nil value means undefined.
 */
@@ -162,6 +275,15 @@ func (dl *DefaultLayer) GetReleaseAssets() (*map[string]*ent.Attachment, error)
 	return ent.RELEASE_ASSETS, nil
 }
 
+/*
+Returns the default flag that enables coercion of release names that look like semantic versions, but are not, into legal semantic versions.
+A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetReleaseCoercion() (*bool, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "releaseCoercion", ent.RELEASE_COERCION)
+	return ent.RELEASE_COERCION, nil
+}
+
 /*
 Returns the default flag that enables tolerance in reading release names with arbitrary prefixes or extra non critical characters.
 A nil value means undefined.
@@ -187,6 +309,14 @@ func (dl *DefaultLayer) GetReleaseTypes() (*ent.ReleaseTypes, error) {
 	return ent.RELEASE_TYPES, nil
 }
 
+/*
+Returns the default path to the file where the HTML release report must be saved. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetReportFile() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "reportFile", ent.REPORT_FILE)
+	return ent.REPORT_FILE, nil
+}
+
 /*
 Returns the default value of the resume flag. A nil value means undefined.
 */
@@ -195,6 +325,15 @@ func (dl *DefaultLayer) GetResume() (*bool, error) {
 	return ent.RESUME, nil
 }
 
+/*
+Returns the default name of the core identifier whose significance bumps the fourth number of four segment versions.
+A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetRevisionBump() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "revisionBump", ent.REVISION_BUMP)
+	return ent.REVISION_BUMP, nil
+}
+
 /*
 Returns the default versioning scheme. A nil value means undefined.
 */
@@ -203,6 +342,14 @@ func (dl *DefaultLayer) GetScheme() (*ver.Scheme, error) {
 	return ent.SCHEME, nil
 }
 
+/*
+Returns the default server configuration section.
+*/
+func (dl *DefaultLayer) GetServer() (*ent.ServerConfiguration, error) {
+	log.Tracef("retrieving the default '%s' configuration option", "server")
+	return ent.SERVER, nil
+}
+
 /*
 Returns the default services configuration section. A nil value means undefined.
 */
@@ -227,6 +374,23 @@ func (dl *DefaultLayer) GetStateFile() (*string, error) {
 	return ent.STATE_FILE, nil
 }
 
+/*
+Returns the default secret key used to sign the state file. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetStateFileSigningKey() (*string, error) {
+	// the value is not logged as it's a secret
+	log.Tracef("retrieving the default '%s' configuration option", "stateFileSigningKey")
+	return ent.STATE_FILE_SIGNING_KEY, nil
+}
+
+/*
+Returns the default value of the strict exit codes flag. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetStrictExitCodes() (*bool, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "strictExitCodes", ent.STRICT_EXIT_CODES)
+	return ent.STRICT_EXIT_CODES, nil
+}
+
 /*
 Returns the default substitutions configuration section.
 */
@@ -251,6 +415,30 @@ func (dl *DefaultLayer) GetSummaryFile() (*string, error) {
 	return ent.SUMMARY_FILE, nil
 }
 
+/*
+Returns the default URL of the endpoint where the telemetry report must be uploaded. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetTelemetryEndpoint() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "telemetryEndpoint", ent.TELEMETRY_ENDPOINT)
+	return ent.TELEMETRY_ENDPOINT, nil
+}
+
+/*
+Returns the default path to the file where the telemetry report must be saved. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetTelemetryFile() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "telemetryFile", ent.TELEMETRY_FILE)
+	return ent.TELEMETRY_FILE, nil
+}
+
+/*
+Returns the default source of the release timestamp. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetTimestampSource() (*ent.TimestampSource, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "timestampSource", ent.TIMESTAMP_SOURCE)
+	return ent.TIMESTAMP_SOURCE, nil
+}
+
 /*
 Returns the default logging verbosity level. A nil value means undefined.
 */
@@ -266,3 +454,11 @@ func (dl *DefaultLayer) GetVersion() (*string, error) {
 	log.Tracef("retrieving the default '%s' configuration option: '%v'", "version", ent.VERSION)
 	return ent.VERSION, nil
 }
+
+/*
+Returns the default name of the service used to read and store released versions. A nil value means undefined.
+*/
+func (dl *DefaultLayer) GetVersionStorageService() (*string, error) {
+	log.Tracef("retrieving the default '%s' configuration option: '%v'", "versionStorageService", ent.VERSION_STORAGE_SERVICE)
+	return ent.VERSION_STORAGE_SERVICE, nil
+}
@@ -36,6 +36,15 @@ const (
 	// The prefix of all environment variables considered by this class.
 	ENVVAR_NAME_GLOBAL_PREFIX = "NYX_"
 
+	// The name of the environment variable to read for this value.
+	ANALYTICS_FILE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "ANALYTICS_FILE"
+
+	// The name of the environment variable to read for this value.
+	BADGES_DIRECTORY_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "BADGES_DIRECTORY"
+
+	// The name of the environment variable to read for this value.
+	BRANCH_METADATA_EXPRESSION_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "BRANCH_METADATA_EXPRESSION"
+
 	// The name of the environment variable to read for this value.
 	BUMP_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "BUMP"
 
@@ -45,6 +54,48 @@ const (
 	// The name of the environment variable to read for this value.
 	CHANGELOG_CONFIGURATION_APPEND_ENVVAR_NAME = CHANGELOG_CONFIGURATION_ENVVAR_NAME + "_APPEND"
 
+	// The name of the environment variable to read for this value.
+	CHANGELOG_CONFIGURATION_BADGES_ENVVAR_NAME = CHANGELOG_CONFIGURATION_ENVVAR_NAME + "_BADGES"
+
+	// The regular expression used to scan the name of a changelog section from an environment variable
+	// name. This expression is used to detect if an environment variable is used to define
+	// the badge of a changelog section.
+	// This expression uses the 'name' capturing group which returns the section name, if detected.
+	CHANGELOG_CONFIGURATION_BADGES_ENVVAR_ITEM_NAME_REGEX = CHANGELOG_CONFIGURATION_BADGES_ENVVAR_NAME + "_(?<name>[a-zA-Z0-9]+)$"
+
+	// The parametrized name of the environment variable to read for the badge of a changelog section.
+	// This string is a prototype that contains a '%s' parameter for the section name
+	// and must be rendered using fmt.Sprintf(CHANGELOG_CONFIGURATION_BADGES_ENVVAR_ITEM_VALUE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the section with the given 'name'.
+	CHANGELOG_CONFIGURATION_BADGES_ENVVAR_ITEM_VALUE_FORMAT_STRING = CHANGELOG_CONFIGURATION_BADGES_ENVVAR_NAME + "_%s"
+
+	// The name of the environment variable to read for this value.
+	CHANGELOG_CONFIGURATION_COLLAPSE_THRESHOLD_ENVVAR_NAME = CHANGELOG_CONFIGURATION_ENVVAR_NAME + "_COLLAPSE_THRESHOLD"
+
+	// The name of the environment variable to read for this value.
+	CHANGELOG_CONFIGURATION_DEDUPLICATE_CHERRY_PICKS_ENVVAR_NAME = CHANGELOG_CONFIGURATION_ENVVAR_NAME + "_DEDUPLICATE_CHERRY_PICKS"
+
+	// The name of the environment variable to read for this value.
+	CHANGELOG_CONFIGURATION_GROUP_DEPENDENCY_UPDATES_ENVVAR_NAME = CHANGELOG_CONFIGURATION_ENVVAR_NAME + "_GROUP_DEPENDENCY_UPDATES"
+
+	// The name of the environment variable to read for this value.
+	CHANGELOG_CONFIGURATION_MERGE_PRE_RELEASES_ENVVAR_NAME = CHANGELOG_CONFIGURATION_ENVVAR_NAME + "_MERGE_PRE_RELEASES"
+
+	// The name of the environment variable to read for this value.
+	CHANGELOG_CONFIGURATION_EMOJIS_ENVVAR_NAME = CHANGELOG_CONFIGURATION_ENVVAR_NAME + "_EMOJIS"
+
+	// The regular expression used to scan the name of a changelog section from an environment variable
+	// name. This expression is used to detect if an environment variable is used to define
+	// the emoji of a changelog section.
+	// This expression uses the 'name' capturing group which returns the section name, if detected.
+	CHANGELOG_CONFIGURATION_EMOJIS_ENVVAR_ITEM_NAME_REGEX = CHANGELOG_CONFIGURATION_EMOJIS_ENVVAR_NAME + "_(?<name>[a-zA-Z0-9]+)$"
+
+	// The parametrized name of the environment variable to read for the emoji of a changelog section.
+	// This string is a prototype that contains a '%s' parameter for the section name
+	// and must be rendered using fmt.Sprintf(CHANGELOG_CONFIGURATION_EMOJIS_ENVVAR_ITEM_VALUE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the section with the given 'name'.
+	CHANGELOG_CONFIGURATION_EMOJIS_ENVVAR_ITEM_VALUE_FORMAT_STRING = CHANGELOG_CONFIGURATION_EMOJIS_ENVVAR_NAME + "_%s"
+
 	// The name of the environment variable to read for this value.
 	CHANGELOG_CONFIGURATION_PATH_ENVVAR_NAME = CHANGELOG_CONFIGURATION_ENVVAR_NAME + "_PATH"
 
@@ -83,6 +134,12 @@ const (
 	// The name of the environment variable to read for this value.
 	CHANGELOG_CONFIGURATION_TEMPLATE_ENVVAR_NAME = CHANGELOG_CONFIGURATION_ENVVAR_NAME + "_TEMPLATE"
 
+	// The name of the environment variable to read for this value.
+	CHANGELOG_CONFIGURATION_UPGRADE_NOTES_ENVVAR_NAME = CHANGELOG_CONFIGURATION_ENVVAR_NAME + "_UPGRADE_NOTES"
+
+	// The name of the environment variable to read for this value.
+	COMMIT_CHANGES_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "COMMIT_CHANGES"
+
 	// The name of the environment variable to read for this value.
 	COMMIT_MESSAGE_CONVENTIONS_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "COMMIT_MESSAGE_CONVENTIONS"
 
@@ -109,18 +166,238 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the convention with the given 'name'.
 	COMMIT_MESSAGE_CONVENTIONS_ENVVAR_ITEM_BUMP_EXPRESSIONS_FORMAT_STRING = COMMIT_MESSAGE_CONVENTIONS_ENVVAR_NAME + "_%s_BUMP_EXPRESSIONS"
 
+	// The name of the environment variable to read for this value.
+	COMMIT_LINT_FILE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "COMMIT_LINT_FILE"
+
 	// The name of the environment variable to read for this value.
 	CONFIGURATION_FILE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "CONFIGURATION_FILE"
 
 	// The name of the environment variable to read for this value.
 	DIRECTORY_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "DIRECTORY"
 
+	// The name of the environment variable to read for this value.
+	DOWNSTREAM_UPDATES_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "DOWNSTREAM_UPDATES"
+
+	// The name of the environment variable to read for this value.
+	DOWNSTREAM_UPDATES_ENABLED_ENVVAR_NAME = DOWNSTREAM_UPDATES_ENVVAR_NAME + "_ENABLED"
+
+	// The regular expression used to scan the name of a downstream update from an environment variable
+	// name. This expression is used to detect if an environment variable is used to define
+	// a downstream update.
+	// This expression uses the 'name' capturing group which returns the downstream update name, if detected.
+	DOWNSTREAM_UPDATES_ENVVAR_ITEM_NAME_REGEX = DOWNSTREAM_UPDATES_ENVVAR_NAME + "_(?<name>[a-zA-Z0-9]+)_([a-zA-Z0-9_]+)$"
+
+	// The parametrized name of the environment variable to read for the 'branch' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_BRANCH_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ENVVAR_ITEM_BRANCH_FORMAT_STRING = DOWNSTREAM_UPDATES_ENVVAR_NAME + "_%s_BRANCH"
+
+	// The parametrized name of the environment variable to read for the 'match' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_MATCH_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ENVVAR_ITEM_MATCH_FORMAT_STRING = DOWNSTREAM_UPDATES_ENVVAR_NAME + "_%s_MATCH"
+
+	// The parametrized name of the environment variable to read for the 'owner' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_OWNER_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ENVVAR_ITEM_OWNER_FORMAT_STRING = DOWNSTREAM_UPDATES_ENVVAR_NAME + "_%s_OWNER"
+
+	// The parametrized name of the environment variable to read for the 'path' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_PATH_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ENVVAR_ITEM_PATH_FORMAT_STRING = DOWNSTREAM_UPDATES_ENVVAR_NAME + "_%s_PATH"
+
+	// The parametrized name of the environment variable to read for the 'replace' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_REPLACE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ENVVAR_ITEM_REPLACE_FORMAT_STRING = DOWNSTREAM_UPDATES_ENVVAR_NAME + "_%s_REPLACE"
+
+	// The parametrized name of the environment variable to read for the 'repository' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_REPOSITORY_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ENVVAR_ITEM_REPOSITORY_FORMAT_STRING = DOWNSTREAM_UPDATES_ENVVAR_NAME + "_%s_REPOSITORY"
+
+	// The parametrized name of the environment variable to read for the 'service' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_SERVICE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ENVVAR_ITEM_SERVICE_FORMAT_STRING = DOWNSTREAM_UPDATES_ENVVAR_NAME + "_%s_SERVICE"
+
+	// The parametrized name of the environment variable to read for the 'title' attribute of a
+	// downstream update.
+	// This string is a prototype that contains a '%s' parameter for the downstream update name
+	// and must be rendered using fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_TITLE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the downstream update with the given 'name'.
+	DOWNSTREAM_UPDATES_ENVVAR_ITEM_TITLE_FORMAT_STRING = DOWNSTREAM_UPDATES_ENVVAR_NAME + "_%s_TITLE"
+
 	// The name of the environment variable to read for this value.
 	DRY_RUN_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "DRY_RUN"
 
+	// The name of the environment variable to read for this value.
+	EVENT_BUS_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "EVENT_BUS"
+
+	// The name of the environment variable to read for this value.
+	EVENT_BUS_ENABLED_ENVVAR_NAME = EVENT_BUS_ENVVAR_NAME + "_ENABLED"
+
+	// The regular expression used to scan the name of a event emitter from an environment variable
+	// name. This expression is used to detect if an environment variable is used to define
+	// a event emitter.
+	// This expression uses the 'name' capturing group which returns the event emitter name, if detected.
+	EVENT_BUS_ENVVAR_ITEM_NAME_REGEX = EVENT_BUS_ENVVAR_NAME + "_(?<name>[a-zA-Z0-9]+)_([a-zA-Z0-9_]+)$"
+
+	// The parametrized name of the environment variable to read for the 'endpoint' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_ENDPOINT_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ENVVAR_ITEM_ENDPOINT_FORMAT_STRING = EVENT_BUS_ENVVAR_NAME + "_%s_ENDPOINT"
+
+	// The parametrized name of the environment variable to read for the 'events' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_EVENTS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ENVVAR_ITEM_EVENTS_FORMAT_STRING = EVENT_BUS_ENVVAR_NAME + "_%s_EVENTS"
+
+	// The parametrized name of the environment variable to read for the 'password' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_PASSWORD_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ENVVAR_ITEM_PASSWORD_FORMAT_STRING = EVENT_BUS_ENVVAR_NAME + "_%s_PASSWORD"
+
+	// The parametrized name of the environment variable to read for the 'region' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_REGION_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ENVVAR_ITEM_REGION_FORMAT_STRING = EVENT_BUS_ENVVAR_NAME + "_%s_REGION"
+
+	// The parametrized name of the environment variable to read for the 'topic' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_TOPIC_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ENVVAR_ITEM_TOPIC_FORMAT_STRING = EVENT_BUS_ENVVAR_NAME + "_%s_TOPIC"
+
+	// The parametrized name of the environment variable to read for the 'type' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_TYPE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ENVVAR_ITEM_TYPE_FORMAT_STRING = EVENT_BUS_ENVVAR_NAME + "_%s_TYPE"
+
+	// The parametrized name of the environment variable to read for the 'user' attribute of a
+	// event emitter.
+	// This string is a prototype that contains a '%s' parameter for the event emitter name
+	// and must be rendered using fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_USER_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the event emitter with the given 'name'.
+	EVENT_BUS_ENVVAR_ITEM_USER_FORMAT_STRING = EVENT_BUS_ENVVAR_NAME + "_%s_USER"
+
 	// The name of the environment variable to read for this value.
 	GIT_CONFIGURATION_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "GIT"
 
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_HEADERS_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_HEADERS"
+
+	// The regular expression used to scan the name of an extra HTTP header from an environment variable
+	// name. This expression is used to detect if an environment variable is used to define
+	// an extra HTTP header.
+	// This expression uses the 'name' capturing group which returns the header name, if detected.
+	// Since environment variable names can't contain dashes, underscores in header names are replaced by dashes.
+	GIT_CONFIGURATION_HEADERS_ENVVAR_ITEM_NAME_REGEX = GIT_CONFIGURATION_HEADERS_ENVVAR_NAME + "_(?<name>[a-zA-Z0-9_]+)$"
+
+	// The parametrized name of the environment variable to read for the value of an extra HTTP header.
+	// This string is a prototype that contains a '%s' parameter for the header name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_HEADERS_ENVVAR_ITEM_VALUE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the header with the given 'name'.
+	GIT_CONFIGURATION_HEADERS_ENVVAR_ITEM_VALUE_FORMAT_STRING = GIT_CONFIGURATION_HEADERS_ENVVAR_NAME + "_%s"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_IDENTITY_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_IDENTITY"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_IDENTITY_EMAIL_ENVVAR_NAME = GIT_CONFIGURATION_IDENTITY_ENVVAR_NAME + "_EMAIL"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_IDENTITY_EMAIL_VARIABLE_ENVVAR_NAME = GIT_CONFIGURATION_IDENTITY_ENVVAR_NAME + "_EMAIL_VARIABLE"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_IDENTITY_NAME_ENVVAR_NAME = GIT_CONFIGURATION_IDENTITY_ENVVAR_NAME + "_NAME"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_IDENTITY_NAME_VARIABLE_ENVVAR_NAME = GIT_CONFIGURATION_IDENTITY_ENVVAR_NAME + "_NAME_VARIABLE"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_IDENTITY_PROVIDER_ENVVAR_NAME = GIT_CONFIGURATION_IDENTITY_ENVVAR_NAME + "_PROVIDER"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_PROXY_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_PROXY"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_SINGLE_BRANCH_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_SINGLE_BRANCH"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_FETCH_TAGS_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_FETCH_TAGS"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_UNSHALLOW_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_UNSHALLOW"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_MIRROR_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_MIRROR"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_BACKEND_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_BACKEND"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_SERVICE_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_SERVICE"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_TRUSTED_KEYS_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_TRUSTED_KEYS"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_TIMESTAMP_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_TIMESTAMP"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_NO_VERIFY_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_NO_VERIFY"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_HOOKS_PATH_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_HOOKS_PATH"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_IGNORE_SUBMODULES_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_IGNORE_SUBMODULES"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_STASH_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_STASH"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_TIMEOUT_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_TIMEOUT"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_RETRIES_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_RETRIES"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_RETRY_DELAY_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_RETRY_DELAY"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_LOCK_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_LOCK"
+
+	// The name of the environment variable to read for this value.
+	GIT_CONFIGURATION_LOCK_TIMEOUT_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_LOCK_TIMEOUT"
+
 	// The name of the environment variable to read for this value.
 	GIT_CONFIGURATION_REMOTES_ENVVAR_NAME = GIT_CONFIGURATION_ENVVAR_NAME + "_REMOTES"
 
@@ -165,9 +442,146 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the remote with the given 'name'.
 	GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PASSPHRASE_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ENVVAR_NAME + "_%s_PASSPHRASE"
 
+	// The parametrized name of the environment variable to read for the 'knownHosts' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_KNOWN_HOSTS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_KNOWN_HOSTS_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ENVVAR_NAME + "_%s_KNOWN_HOSTS"
+
+	// The parametrized name of the environment variable to read for the 'strictHostKeyChecking' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_STRICT_HOST_KEY_CHECKING_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_STRICT_HOST_KEY_CHECKING_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ENVVAR_NAME + "_%s_STRICT_HOST_KEY_CHECKING"
+
+	// The parametrized name of the environment variable to read for the 'appId' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_APP_ID_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_APP_ID_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ENVVAR_NAME + "_%s_APP_ID"
+
+	// The parametrized name of the environment variable to read for the 'installationId' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_INSTALLATION_ID_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_INSTALLATION_ID_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ENVVAR_NAME + "_%s_INSTALLATION_ID"
+
+	// The parametrized name of the environment variable to read for the 'userVariable' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_USER_VARIABLE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_USER_VARIABLE_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ENVVAR_NAME + "_%s_USER_VARIABLE"
+
+	// The parametrized name of the environment variable to read for the 'passwordVariable' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PASSWORD_VARIABLE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PASSWORD_VARIABLE_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ENVVAR_NAME + "_%s_PASSWORD_VARIABLE"
+
+	// The parametrized name of the environment variable to read for the 'pushTags' attribute of a
+	// Git remote configuration.
+	// This string is a prototype that contains a '%s' parameter for the remote name
+	// and must be rendered using fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PUSH_TAGS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the remote with the given 'name'.
+	GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PUSH_TAGS_FORMAT_STRING = GIT_CONFIGURATION_REMOTES_ENVVAR_NAME + "_%s_PUSH_TAGS"
+
+	// The name of the environment variable to read for this value.
+	IMPACT_ANALYZERS_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "IMPACT_ANALYZERS"
+
+	// The name of the environment variable to read for this value.
+	IMPACT_ANALYZERS_ENABLED_ENVVAR_NAME = IMPACT_ANALYZERS_ENVVAR_NAME + "_ENABLED"
+
+	// The regular expression used to scan the name of an impact analyzer from an environment variable
+	// name. This expression is used to detect if an environment variable is used to define
+	// an impact analyzer.
+	// This expression uses the 'name' capturing group which returns the impact analyzer name, if detected.
+	IMPACT_ANALYZERS_ENVVAR_ITEM_NAME_REGEX = IMPACT_ANALYZERS_ENVVAR_NAME + "_(?<name>[a-zA-Z0-9]+)_([a-zA-Z0-9_]+)$"
+
+	// The parametrized name of the environment variable to read for the 'bump' attribute of an
+	// impact analyzer.
+	// This string is a prototype that contains a '%s' parameter for the impact analyzer name
+	// and must be rendered using fmt.Sprintf(IMPACT_ANALYZERS_ENVVAR_ITEM_BUMP_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the impact analyzer with the given 'name'.
+	IMPACT_ANALYZERS_ENVVAR_ITEM_BUMP_FORMAT_STRING = IMPACT_ANALYZERS_ENVVAR_NAME + "_%s_BUMP"
+
+	// The parametrized name of the environment variable to read for the 'command' attribute of an
+	// impact analyzer.
+	// This string is a prototype that contains a '%s' parameter for the impact analyzer name
+	// and must be rendered using fmt.Sprintf(IMPACT_ANALYZERS_ENVVAR_ITEM_COMMAND_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the impact analyzer with the given 'name'.
+	IMPACT_ANALYZERS_ENVVAR_ITEM_COMMAND_FORMAT_STRING = IMPACT_ANALYZERS_ENVVAR_NAME + "_%s_COMMAND"
+
+	// The parametrized name of the environment variable to read for the 'expression' attribute of an
+	// impact analyzer.
+	// This string is a prototype that contains a '%s' parameter for the impact analyzer name
+	// and must be rendered using fmt.Sprintf(IMPACT_ANALYZERS_ENVVAR_ITEM_EXPRESSION_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the impact analyzer with the given 'name'.
+	IMPACT_ANALYZERS_ENVVAR_ITEM_EXPRESSION_FORMAT_STRING = IMPACT_ANALYZERS_ENVVAR_NAME + "_%s_EXPRESSION"
+
+	// The parametrized name of the environment variable to read for the 'title' attribute of an
+	// impact analyzer.
+	// This string is a prototype that contains a '%s' parameter for the impact analyzer name
+	// and must be rendered using fmt.Sprintf(IMPACT_ANALYZERS_ENVVAR_ITEM_TITLE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the impact analyzer with the given 'name'.
+	IMPACT_ANALYZERS_ENVVAR_ITEM_TITLE_FORMAT_STRING = IMPACT_ANALYZERS_ENVVAR_NAME + "_%s_TITLE"
+
 	// The name of the environment variable to read for this value.
 	INITIAL_VERSION_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "INITIAL_VERSION"
 
+	// The name of the environment variable to read for this value.
+	JUNIT_REPORT_FILE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "JUNIT_REPORT_FILE"
+
+	// The name of the environment variable to read for this value.
+	NOTHING_TO_RELEASE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "NOTHING_TO_RELEASE"
+
+	// The name of the environment variable to read for this value.
+	ORGANIZATION_CONFIGURATION_REPOSITORY_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "ORGANIZATION_CONFIGURATION_REPOSITORY"
+
+	// The name of the environment variable to read for this value.
+	ORGANIZATION_CONFIGURATION_SERVICE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "ORGANIZATION_CONFIGURATION_SERVICE"
+
+	// The name of the environment variable to read for this value.
+	PATH_RULES_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "PATH_RULES"
+
+	// The name of the environment variable to read for this value.
+	PATH_RULES_ENABLED_ENVVAR_NAME = PATH_RULES_ENVVAR_NAME + "_ENABLED"
+
+	// The regular expression used to scan the name of a path rule from an environment variable
+	// name. This expression is used to detect if an environment variable is used to define
+	// a path rule.
+	// This expression uses the 'name' capturing group which returns the path rule name, if detected.
+	PATH_RULES_ENVVAR_ITEM_NAME_REGEX = PATH_RULES_ENVVAR_NAME + "_(?<name>[a-zA-Z0-9]+)_([a-zA-Z0-9_]+)$"
+
+	// The parametrized name of the environment variable to read for the 'bump' attribute of a
+	// path rule.
+	// This string is a prototype that contains a '%s' parameter for the path rule name
+	// and must be rendered using fmt.Sprintf(PATH_RULES_ENVVAR_ITEM_BUMP_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the path rule with the given 'name'.
+	PATH_RULES_ENVVAR_ITEM_BUMP_FORMAT_STRING = PATH_RULES_ENVVAR_NAME + "_%s_BUMP"
+
+	// The parametrized name of the environment variable to read for the 'paths' attribute of a
+	// path rule.
+	// This string is a prototype that contains a '%s' parameter for the path rule name
+	// and must be rendered using fmt.Sprintf(PATH_RULES_ENVVAR_ITEM_PATHS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the path rule with the given 'name'.
+	PATH_RULES_ENVVAR_ITEM_PATHS_FORMAT_STRING = PATH_RULES_ENVVAR_NAME + "_%s_PATHS"
+
+	// The parametrized name of the environment variable to read for the 'significant' attribute of a
+	// path rule.
+	// This string is a prototype that contains a '%s' parameter for the path rule name
+	// and must be rendered using fmt.Sprintf(PATH_RULES_ENVVAR_ITEM_SIGNIFICANT_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the path rule with the given 'name'.
+	PATH_RULES_ENVVAR_ITEM_SIGNIFICANT_FORMAT_STRING = PATH_RULES_ENVVAR_NAME + "_%s_SIGNIFICANT"
+
+	// The name of the environment variable to read for this value.
+	PRERELEASE_ORDER_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "PRERELEASE_ORDER"
+
 	// The name of the environment variable to read for this value.
 	PRESET_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "PRESET"
 
@@ -208,6 +622,23 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release asset type with the given 'name'.
 	RELEASE_ASSETS_ENVVAR_ITEM_TYPE_FORMAT_STRING = RELEASE_ASSETS_ENVVAR_NAME + "_%s_TYPE"
 
+	// The parametrized name of the environment variable to read for the 'archive' attribute of a
+	// release asset.
+	// This string is a prototype that contains a '%s' parameter for the release asset name
+	// and must be rendered using fmt.Sprintf(RELEASE_ASSETS_ENVVAR_ITEM_ARCHIVE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release asset archive with the given 'name'.
+	RELEASE_ASSETS_ENVVAR_ITEM_ARCHIVE_FORMAT_STRING = RELEASE_ASSETS_ENVVAR_NAME + "_%s_ARCHIVE"
+
+	// The parametrized name of the environment variable to read for the 'platforms' attribute of a
+	// release asset.
+	// This string is a prototype that contains a '%s' parameter for the release asset name
+	// and must be rendered using fmt.Sprintf(RELEASE_ASSETS_ENVVAR_ITEM_PLATFORMS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release asset platforms with the given 'name'.
+	RELEASE_ASSETS_ENVVAR_ITEM_PLATFORMS_FORMAT_STRING = RELEASE_ASSETS_ENVVAR_NAME + "_%s_PLATFORMS"
+
+	// The name of the environment variable to read for this value.
+	RELEASE_COERCION_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "RELEASE_COERCION"
+
 	// The name of the environment variable to read for this value.
 	RELEASE_LENIENT_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "RELEASE_LENIENT"
 
@@ -226,6 +657,9 @@ const (
 	// The name of the environment variable to read for this value.
 	RELEASE_TYPES_REMOTE_REPOSITORIES_ENVVAR_NAME = RELEASE_TYPES_ENVVAR_NAME + "_REMOTE_REPOSITORIES"
 
+	// The name of the environment variable to read for this value.
+	RELEASE_TYPES_YANKED_ENVVAR_NAME = RELEASE_TYPES_ENVVAR_NAME + "_YANKED"
+
 	// The regular expression used to scan the name of a release type from an environment
 	// variable name. This expression is used to detect if an environment variable is used to define
 	// a release type.
@@ -239,6 +673,27 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ENVVAR_ITEM_ASSETS_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_ASSETS"
 
+	// The parametrized name of the environment variable to read for the 'buildMetadata' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_BUILD_METADATA_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_BUILD_METADATA_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_BUILD_METADATA"
+
+	// The parametrized name of the environment variable to read for the 'buildMetadataTargets' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_BUILD_METADATA_TARGETS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_BUILD_METADATA_TARGETS_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_BUILD_METADATA_TARGETS"
+
+	// The parametrized name of the environment variable to read for the 'bumpLabels' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_BUMP_LABELS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_BUMP_LABELS_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_BUMP_LABELS"
+
 	// The parametrized name of the environment variable to read for the 'collapseVersions' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -267,6 +722,20 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ENVVAR_ITEM_FILTER_TAGS_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_FILTER_TAGS"
 
+	// The parametrized name of the environment variable to read for the 'followAllParents' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_FOLLOW_ALL_PARENTS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_FOLLOW_ALL_PARENTS_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_FOLLOW_ALL_PARENTS"
+
+	// The parametrized name of the environment variable to read for the 'gatePolicy' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GATE_POLICY_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_GATE_POLICY_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_GATE_POLICY"
+
 	// The parametrized name of the environment variable to read for the 'gitCommit' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -295,6 +764,13 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ENVVAR_ITEM_GIT_PUSH_FORCE_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_GIT_PUSH_FORCE"
 
+	// The parametrized name of the environment variable to read for the 'gitPushForceWithLease' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GIT_PUSH_FORCE_WITH_LEASE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_GIT_PUSH_FORCE_WITH_LEASE_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_GIT_PUSH_FORCE_WITH_LEASE"
+
 	// The parametrized name of the environment variable to read for the 'gitTag' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -330,6 +806,20 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ENVVAR_ITEM_IDENTIFIERS_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_IDENTIFIERS"
 
+	// The parametrized name of the environment variable to read for the 'ignoreCherryPicks' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_IGNORE_CHERRY_PICKS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_IGNORE_CHERRY_PICKS_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_IGNORE_CHERRY_PICKS"
+
+	// The parametrized name of the environment variable to read for the 'ignoreMerges' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_IGNORE_MERGES_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_IGNORE_MERGES_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_IGNORE_MERGES"
+
 	// The parametrized name of the environment variable to read for the 'matchBranches' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -337,6 +827,27 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ENVVAR_ITEM_MATCH_BRANCHES_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_MATCH_BRANCHES"
 
+	// The parametrized name of the environment variable to read for the 'matchBranchMetadata' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_BRANCH_METADATA_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_MATCH_BRANCH_METADATA_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_MATCH_BRANCH_METADATA"
+
+	// The parametrized name of the environment variable to read for the 'matchChangedPaths' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_CHANGED_PATHS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_MATCH_CHANGED_PATHS_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_MATCH_CHANGED_PATHS"
+
+	// The parametrized name of the environment variable to read for the 'matchDaysOfWeek' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_DAYS_OF_WEEK_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_MATCH_DAYS_OF_WEEK_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_MATCH_DAYS_OF_WEEK"
+
 	// The parametrized name of the environment variable to read for the 'matchEnvironmentVariables' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -344,6 +855,34 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ENVVAR_ITEM_MATCH_ENVIRONMENT_VARIABLES_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_MATCH_ENVIRONMENT_VARIABLES"
 
+	// The parametrized name of the environment variable to read for the 'matchExpression' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_EXPRESSION_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_MATCH_EXPRESSION_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_MATCH_EXPRESSION"
+
+	// The parametrized name of the environment variable to read for the 'matchMode' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_MODE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_MATCH_MODE_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_MATCH_MODE"
+
+	// The parametrized name of the environment variable to read for the 'matchPolicy' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_POLICY_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_MATCH_POLICY_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_MATCH_POLICY"
+
+	// The parametrized name of the environment variable to read for the 'matchTags' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_TAGS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_MATCH_TAGS_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_MATCH_TAGS"
+
 	// The parametrized name of the environment variable to read for the 'matchWorkspaceStatus' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -358,6 +897,27 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_PUBLISH"
 
+	// The parametrized name of the environment variable to read for the 'publishApprovalEnvironment' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_APPROVAL_ENVIRONMENT_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_APPROVAL_ENVIRONMENT_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_PUBLISH_APPROVAL_ENVIRONMENT"
+
+	// The parametrized name of the environment variable to read for the 'publishApprovalPollingInterval' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_APPROVAL_POLLING_INTERVAL_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_APPROVAL_POLLING_INTERVAL_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_PUBLISH_APPROVAL_POLLING_INTERVAL"
+
+	// The parametrized name of the environment variable to read for the 'publishApprovalTimeout' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_APPROVAL_TIMEOUT_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_APPROVAL_TIMEOUT_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_PUBLISH_APPROVAL_TIMEOUT"
+
 	// The parametrized name of the environment variable to read for the 'publishDraft' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -372,6 +932,20 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_PRE_RELEASE_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_PUBLISH_PRE_RELEASE"
 
+	// The parametrized name of the environment variable to read for the 'pullRequestMessages' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PULL_REQUEST_MESSAGES_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_PULL_REQUEST_MESSAGES_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_PULL_REQUEST_MESSAGES"
+
+	// The parametrized name of the environment variable to read for the 'releaseMetadataFile' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_RELEASE_METADATA_FILE_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_RELEASE_METADATA_FILE_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_RELEASE_METADATA_FILE"
+
 	// The parametrized name of the environment variable to read for the 'releaseName' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -379,6 +953,27 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ENVVAR_ITEM_RELEASE_NAME_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_RELEASE_NAME"
 
+	// The parametrized name of the environment variable to read for the 'requiredEnvironmentVariables' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_REQUIRED_ENVIRONMENT_VARIABLES_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_REQUIRED_ENVIRONMENT_VARIABLES_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_REQUIRED_ENVIRONMENT_VARIABLES"
+
+	// The parametrized name of the environment variable to read for the 'requireSignedCommits' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_REQUIRE_SIGNED_COMMITS_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_REQUIRE_SIGNED_COMMITS_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_REQUIRE_SIGNED_COMMITS"
+
+	// The parametrized name of the environment variable to read for the 'versionConstraint' attribute of a
+	// release type.
+	// This string is a prototype that contains a '%s' parameter for the release type name
+	// and must be rendered using fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_VERSION_CONSTRAINT_FORMAT_STRING, name)
+	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
+	RELEASE_TYPES_ENVVAR_ITEM_VERSION_CONSTRAINT_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_VERSION_CONSTRAINT"
+
 	// The parametrized name of the environment variable to read for the 'versionRange' attribute of a
 	// release type.
 	// This string is a prototype that contains a '%s' parameter for the release type name
@@ -393,12 +988,33 @@ const (
 	// in order to get the actual name of the environment variable that brings the value for the release type with the given 'name'.
 	RELEASE_TYPES_ENVVAR_ITEM_VERSION_RANGE_FROM_BRANCH_NAME_FORMAT_STRING = RELEASE_TYPES_ENVVAR_NAME + "_%s_VERSION_RANGE_FROM_BRANCH_NAME"
 
+	// The name of the environment variable to read for this value.
+	REPORT_FILE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "REPORT_FILE"
+
 	// The name of the environment variable to read for this value.
 	RESUME_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "RESUME"
 
+	// The name of the environment variable to read for this value.
+	REVISION_BUMP_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "REVISION_BUMP"
+
 	// The name of the environment variable to read for this value.
 	SCHEME_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "SCHEME"
 
+	// The name of the environment variable to read for this value.
+	SERVER_CONFIGURATION_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "SERVER"
+
+	// The name of the environment variable to read for this value.
+	SERVER_CONFIGURATION_ADDRESS_ENVVAR_NAME = SERVER_CONFIGURATION_ENVVAR_NAME + "_ADDRESS"
+
+	// The name of the environment variable to read for this value.
+	SERVER_CONFIGURATION_BRANCHES_ENVVAR_NAME = SERVER_CONFIGURATION_ENVVAR_NAME + "_BRANCHES"
+
+	// The name of the environment variable to read for this value.
+	SERVER_CONFIGURATION_COMMAND_ENVVAR_NAME = SERVER_CONFIGURATION_ENVVAR_NAME + "_COMMAND"
+
+	// The name of the environment variable to read for this value.
+	SERVER_CONFIGURATION_SECRET_ENVVAR_NAME = SERVER_CONFIGURATION_ENVVAR_NAME + "_SECRET"
+
 	// The name of the environment variable to read for this value.
 	SERVICES_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "SERVICES"
 
@@ -428,6 +1044,12 @@ const (
 	// The name of the environment variable to read for this value.
 	STATE_FILE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "STATE_FILE"
 
+	// The name of the environment variable to read for this value.
+	STATE_FILE_SIGNING_KEY_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "STATE_FILE_SIGNING_KEY"
+
+	// The name of the environment variable to read for this value.
+	STRICT_EXIT_CODES_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "STRICT_EXIT_CODES"
+
 	// The name of the environment variable to read for this value.
 	SUBSTITUTIONS_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "SUBSTITUTIONS"
 
@@ -467,11 +1089,23 @@ const (
 	// The name of the environment variable to read for this value.
 	SUMMARY_FILE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "SUMMARY_FILE"
 
+	// The name of the environment variable to read for this value.
+	TELEMETRY_ENDPOINT_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "TELEMETRY_ENDPOINT"
+
+	// The name of the environment variable to read for this value.
+	TELEMETRY_FILE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "TELEMETRY_FILE"
+
+	// The name of the environment variable to read for this value.
+	TIMESTAMP_SOURCE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "TIMESTAMP_SOURCE"
+
 	// The name of the environment variable to read for this value.
 	VERBOSITY_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "VERBOSITY"
 
 	// The name of the environment variable to read for this value.
 	VERSION_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "VERSION"
+
+	// The name of the environment variable to read for this value.
+	VERSION_STORAGE_SERVICE_ENVVAR_NAME = ENVVAR_NAME_GLOBAL_PREFIX + "VERSION_STORAGE_SERVICE"
 )
 
 var (
@@ -500,15 +1134,30 @@ type EnvironmentConfigurationLayer struct {
 	// The commit message convention configuration section.
 	commitMessageConventions *ent.CommitMessageConventions
 
+	// The downstream updates configuration section.
+	downstreamUpdates *ent.DownstreamUpdates
+
+	// The event emitters configuration section.
+	eventBus *ent.EventBus
+
 	// The Git configuration section.
 	git *ent.GitConfiguration
 
+	// The impact analyzers configuration section.
+	impactAnalyzers *ent.ImpactAnalyzers
+
+	// The path rules configuration section.
+	pathRules *ent.PathRules
+
 	// The release assets configuration section
 	releaseAssets *map[string]*ent.Attachment
 
 	// The release types configuration section.
 	releaseTypes *ent.ReleaseTypes
 
+	// The server configuration section.
+	server *ent.ServerConfiguration
+
 	// The services configuration section
 	services *map[string]*ent.ServiceConfiguration
 
@@ -757,7 +1406,8 @@ func (ecl *EnvironmentConfigurationLayer) parseEnvironmentVariables(variables []
 
 	// now parse all environment variables and put them in the map
 	for _, entry := range variables {
-		entryValue := strings.Split(entry, "=")
+		// only split on the first '=' as values may contain other '=' characters (i.e. policy expressions)
+		entryValue := strings.SplitN(entry, "=", 2)
 		environmentVariablesMap[entryValue[0]] = entryValue[1]
 	}
 
@@ -807,199 +1457,728 @@ read from the operating system.
 
 Arguments are as follows:
 
-- variables the slice of environment variables to parse
-*/
-func (ecl *EnvironmentConfigurationLayer) withEnvironmentVariables(variables []string) {
-	if ecl.environmentVariablesMap == nil {
-		environmentConfigurationLayerLock.Lock()
-		defer environmentConfigurationLayerLock.Unlock()
-		if ecl.environmentVariablesMap == nil {
-			ecl.environmentVariablesMap = ecl.parseEnvironmentVariables(variables)
+- variables the slice of environment variables to parse
+*/
+func (ecl *EnvironmentConfigurationLayer) withEnvironmentVariables(variables []string) {
+	if ecl.environmentVariablesMap == nil {
+		environmentConfigurationLayerLock.Lock()
+		defer environmentConfigurationLayerLock.Unlock()
+		if ecl.environmentVariablesMap == nil {
+			ecl.environmentVariablesMap = ecl.parseEnvironmentVariables(variables)
+		}
+	}
+}
+
+/*
+Returns the path to the file where the analytics report about past releases is written as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetAnalyticsFile() (*string, error) {
+	return ecl.getEnvVar(ANALYTICS_FILE_ENVVAR_NAME), nil
+}
+
+/*
+Returns the directory where the version and release date badges are written as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetBadgesDirectory() (*string, error) {
+	return ecl.getEnvVar(BADGES_DIRECTORY_ENVVAR_NAME), nil
+}
+
+/*
+Returns the regular expression used to extract metadata from the current branch name as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetBranchMetadataExpression() (*string, error) {
+	return ecl.getEnvVar(BRANCH_METADATA_EXPRESSION_ENVVAR_NAME), nil
+}
+
+/*
+Returns the version identifier to bump as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetBump() (*string, error) {
+	return ecl.getEnvVar(BUMP_ENVVAR_NAME), nil
+}
+
+/*
+Returns the changelog configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetChangelog() (*ent.ChangelogConfiguration, error) {
+	if ecl.changelog == nil {
+		// parse the 'sections' map
+		sections := make(map[string]string)
+		sectionNames, err := ecl.scanItemNamesInEnvironmentVariables("changelog", CHANGELOG_CONFIGURATION_SECTIONS_ENVVAR_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all section names configured through environment variables and we can
+		// query specific environment variables
+		for _, sectionName := range sectionNames {
+			sectionValue := ecl.getEnvVar(fmt.Sprintf(CHANGELOG_CONFIGURATION_SECTIONS_ENVVAR_ITEM_REGEXP_FORMAT_STRING, sectionName))
+			sections[sectionName] = *sectionValue
+		}
+
+		// parse the 'substitutions' map
+		substitutions := make(map[string]string)
+		substitutionNames, err := ecl.scanItemNamesInEnvironmentVariables("changelog", CHANGELOG_CONFIGURATION_SUBSTITUTIONS_ENVVAR_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all section names configured through environment variables and we can
+		// query specific environment variables
+		for _, substitutionName := range substitutionNames {
+			substitutionValue := ecl.getEnvVar(fmt.Sprintf(CHANGELOG_CONFIGURATION_SUBSTITUTIONS_ENVVAR_ITEM_REGEXP_FORMAT_STRING, substitutionName))
+			substitutions[substitutionName] = *substitutionValue
+		}
+
+		// parse the 'badges' map
+		badges := make(map[string]string)
+		badgeNames, err := ecl.scanItemNamesInEnvironmentVariables("changelog", CHANGELOG_CONFIGURATION_BADGES_ENVVAR_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, sectionName := range badgeNames {
+			badges[sectionName] = *ecl.getEnvVar(fmt.Sprintf(CHANGELOG_CONFIGURATION_BADGES_ENVVAR_ITEM_VALUE_FORMAT_STRING, sectionName))
+		}
+
+		// parse the 'emojis' map
+		emojis := make(map[string]string)
+		emojiNames, err := ecl.scanItemNamesInEnvironmentVariables("changelog", CHANGELOG_CONFIGURATION_EMOJIS_ENVVAR_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, sectionName := range emojiNames {
+			emojis[sectionName] = *ecl.getEnvVar(fmt.Sprintf(CHANGELOG_CONFIGURATION_EMOJIS_ENVVAR_ITEM_VALUE_FORMAT_STRING, sectionName))
+		}
+
+		var collapseThreshold *int = nil
+		collapseThresholdString := ecl.getEnvVar(CHANGELOG_CONFIGURATION_COLLAPSE_THRESHOLD_ENVVAR_NAME)
+		if collapseThresholdString != nil && "" != *collapseThresholdString {
+			ct, err := strconv.Atoi(*collapseThresholdString)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", CHANGELOG_CONFIGURATION_COLLAPSE_THRESHOLD_ENVVAR_NAME, *collapseThresholdString), Cause: err}
+			}
+			collapseThreshold = &ct
+		}
+
+		var deduplicateCherryPicks *bool = nil
+		deduplicateCherryPicksString := ecl.getEnvVar(CHANGELOG_CONFIGURATION_DEDUPLICATE_CHERRY_PICKS_ENVVAR_NAME)
+		if deduplicateCherryPicksString != nil {
+			// empty string is considered 'false'
+			if "" == *deduplicateCherryPicksString {
+				dcp := false
+				deduplicateCherryPicks = &dcp
+			} else {
+				dcp, err := strconv.ParseBool(*deduplicateCherryPicksString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", CHANGELOG_CONFIGURATION_DEDUPLICATE_CHERRY_PICKS_ENVVAR_NAME, *deduplicateCherryPicksString), Cause: err}
+				}
+				deduplicateCherryPicks = &dcp
+			}
+		}
+
+		var groupDependencyUpdates *bool = nil
+		groupDependencyUpdatesString := ecl.getEnvVar(CHANGELOG_CONFIGURATION_GROUP_DEPENDENCY_UPDATES_ENVVAR_NAME)
+		if groupDependencyUpdatesString != nil {
+			// empty string is considered 'false'
+			if "" == *groupDependencyUpdatesString {
+				gdu := false
+				groupDependencyUpdates = &gdu
+			} else {
+				gdu, err := strconv.ParseBool(*groupDependencyUpdatesString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", CHANGELOG_CONFIGURATION_GROUP_DEPENDENCY_UPDATES_ENVVAR_NAME, *groupDependencyUpdatesString), Cause: err}
+				}
+				groupDependencyUpdates = &gdu
+			}
+		}
+
+		var mergePreReleases *ent.PreReleaseMerge = nil
+		mergePreReleasesString := ecl.getEnvVar(CHANGELOG_CONFIGURATION_MERGE_PRE_RELEASES_ENVVAR_NAME)
+		if mergePreReleasesString != nil {
+			prm, err := ent.ValueOfPreReleaseMerge(*mergePreReleasesString)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", CHANGELOG_CONFIGURATION_MERGE_PRE_RELEASES_ENVVAR_NAME, *mergePreReleasesString), Cause: err}
+			}
+			mergePreReleases = &prm
+		}
+
+		ecl.changelog, err = ent.NewChangelogConfigurationWith(ecl.getEnvVar(CHANGELOG_CONFIGURATION_APPEND_ENVVAR_NAME), &badges, collapseThreshold, deduplicateCherryPicks, &emojis, groupDependencyUpdates, mergePreReleases, ecl.getEnvVar(CHANGELOG_CONFIGURATION_PATH_ENVVAR_NAME), &sections, ecl.getEnvVar(CHANGELOG_CONFIGURATION_TEMPLATE_ENVVAR_NAME), &substitutions, ecl.getEnvVar(CHANGELOG_CONFIGURATION_UPGRADE_NOTES_ENVVAR_NAME))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ecl.changelog, nil
+}
+
+/*
+Returns the flag that enables computing the files changed by each commit in the release scope
+as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetCommitChanges() (*bool, error) {
+	commitChangesString := ecl.getEnvVar(COMMIT_CHANGES_ENVVAR_NAME)
+	if commitChangesString == nil {
+		return nil, nil
+	}
+	commitChanges, err := strconv.ParseBool(*commitChangesString)
+	return &commitChanges, err
+}
+
+/*
+Returns the commit message convention configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetCommitMessageConventions() (*ent.CommitMessageConventions, error) {
+	if ecl.commitMessageConventions == nil {
+		// parse the 'enabled' items list
+		enabled := ecl.getItemNamesListFromEnvironmentVariable("commitMessageConventions", "enabled", COMMIT_MESSAGE_CONVENTIONS_ENABLED_ENVVAR_NAME)
+
+		// parse the 'items' map
+		items := make(map[string]*ent.CommitMessageConvention)
+
+		itemNames, err := ecl.scanItemNamesInEnvironmentVariables("commitMessageConventions", COMMIT_MESSAGE_CONVENTIONS_ENVVAR_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all item names configured through environment variables and we can
+		// query specific environment variables
+		for _, itemName := range itemNames {
+			expression := ecl.getEnvVar(fmt.Sprintf(COMMIT_MESSAGE_CONVENTIONS_ENVVAR_ITEM_EXPRESSION_FORMAT_STRING, itemName))
+			bumpExpressions := ecl.getAttributeMapFromEnvironmentVariable("commitMessageConventions"+"."+itemName+"."+"bumpExpressions", fmt.Sprintf(COMMIT_MESSAGE_CONVENTIONS_ENVVAR_ITEM_BUMP_EXPRESSIONS_FORMAT_STRING, itemName), nil)
+
+			items[itemName] = ent.NewCommitMessageConventionWith(expression, &bumpExpressions)
+		}
+		enabledPointers := ecl.toSliceOfStringPointers(enabled)
+		ecl.commitMessageConventions, err = ent.NewCommitMessageConventionsWith(&enabledPointers, &items)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ecl.commitMessageConventions, nil
+}
+
+/*
+Returns the path to the file where the commit lint report is written in the SARIF format as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetCommitLintFile() (*string, error) {
+	return ecl.getEnvVar(COMMIT_LINT_FILE_ENVVAR_NAME), nil
+}
+
+/*
+Returns the path to a custom configuration file as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetConfigurationFile() (*string, error) {
+	return ecl.getEnvVar(CONFIGURATION_FILE_ENVVAR_NAME), nil
+}
+
+/*
+Returns the directory to use as the working directory as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetDirectory() (*string, error) {
+	return ecl.getEnvVar(DIRECTORY_ENVVAR_NAME), nil
+}
+
+/*
+Returns the downstream updates configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetDownstreamUpdates() (*ent.DownstreamUpdates, error) {
+	if ecl.downstreamUpdates == nil {
+		// parse the 'enabled' items list
+		enabled := ecl.getItemNamesListFromEnvironmentVariable("downstreamUpdates", "enabled", DOWNSTREAM_UPDATES_ENABLED_ENVVAR_NAME)
+
+		// parse the 'items' map
+		items := make(map[string]*ent.DownstreamUpdate)
+
+		itemNames, err := ecl.scanItemNamesInEnvironmentVariables("downstreamUpdates", DOWNSTREAM_UPDATES_ENVVAR_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all item names configured through environment variables and we can
+		// query specific environment variables
+		for _, itemName := range itemNames {
+			branch := ecl.getEnvVar(fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_BRANCH_FORMAT_STRING, itemName))
+			match := ecl.getEnvVar(fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_MATCH_FORMAT_STRING, itemName))
+			owner := ecl.getEnvVar(fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_OWNER_FORMAT_STRING, itemName))
+			path := ecl.getEnvVar(fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_PATH_FORMAT_STRING, itemName))
+			replace := ecl.getEnvVar(fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_REPLACE_FORMAT_STRING, itemName))
+			repository := ecl.getEnvVar(fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_REPOSITORY_FORMAT_STRING, itemName))
+			service := ecl.getEnvVar(fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_SERVICE_FORMAT_STRING, itemName))
+			title := ecl.getEnvVar(fmt.Sprintf(DOWNSTREAM_UPDATES_ENVVAR_ITEM_TITLE_FORMAT_STRING, itemName))
+
+			items[itemName] = ent.NewDownstreamUpdateWith(branch, match, owner, path, replace, repository, service, title)
+		}
+		enabledPointers := ecl.toSliceOfStringPointers(enabled)
+		ecl.downstreamUpdates, err = ent.NewDownstreamUpdatesWith(&enabledPointers, &items)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ecl.downstreamUpdates, nil
+}
+
+/*
+Returns the value of the dry run flag as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetDryRun() (*bool, error) {
+	dryRunString := ecl.getEnvVar(DRY_RUN_ENVVAR_NAME)
+	if dryRunString == nil {
+		return nil, nil
+	}
+	dryRun, err := strconv.ParseBool(*dryRunString)
+	return &dryRun, err
+}
+
+/*
+Returns the event emitters configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetEventBus() (*ent.EventBus, error) {
+	if ecl.eventBus == nil {
+		// parse the 'enabled' items list
+		enabled := ecl.getItemNamesListFromEnvironmentVariable("eventBus", "enabled", EVENT_BUS_ENABLED_ENVVAR_NAME)
+
+		// parse the 'items' map
+		items := make(map[string]*ent.EventEmitter)
+
+		itemNames, err := ecl.scanItemNamesInEnvironmentVariables("eventBus", EVENT_BUS_ENVVAR_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all item names configured through environment variables and we can
+		// query specific environment variables
+		for _, itemName := range itemNames {
+			endpoint := ecl.getEnvVar(fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_ENDPOINT_FORMAT_STRING, itemName))
+			events := ecl.getEnvVar(fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_EVENTS_FORMAT_STRING, itemName))
+			password := ecl.getEnvVar(fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_PASSWORD_FORMAT_STRING, itemName))
+			region := ecl.getEnvVar(fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_REGION_FORMAT_STRING, itemName))
+			topic := ecl.getEnvVar(fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_TOPIC_FORMAT_STRING, itemName))
+			emitterType := ecl.getEnvVar(fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_TYPE_FORMAT_STRING, itemName))
+			user := ecl.getEnvVar(fmt.Sprintf(EVENT_BUS_ENVVAR_ITEM_USER_FORMAT_STRING, itemName))
+
+			items[itemName] = ent.NewEventEmitterWith(endpoint, events, password, region, topic, emitterType, user)
+		}
+		enabledPointers := ecl.toSliceOfStringPointers(enabled)
+		ecl.eventBus, err = ent.NewEventBusWith(&enabledPointers, &items)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ecl.eventBus, nil
+}
+
+/*
+Returns the Git configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetGit() (*ent.GitConfiguration, error) {
+	if ecl.git == nil {
+		// parse the 'remotes' map
+		remotes := make(map[string]*ent.GitRemoteConfiguration)
+
+		itemNames, err := ecl.scanItemNamesInEnvironmentVariables("git", GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		// now we have the set of all item names configured through environment variables and we can
+		// query specific environment variables
+		for _, itemName := range itemNames {
+			var authenticationMethod *ent.AuthenticationMethod = nil
+			authenticationMethodString := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_AUTHENTICATION_METHOD_FORMAT_STRING, itemName))
+			if authenticationMethodString != nil {
+				am, err := ent.ValueOfAuthenticationMethod(*authenticationMethodString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_AUTHENTICATION_METHOD_FORMAT_STRING, itemName), *authenticationMethodString), Cause: err}
+				}
+				authenticationMethod = &am
+			}
+			password := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PASSWORD_FORMAT_STRING, itemName))
+			user := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_USER_FORMAT_STRING, itemName))
+			privateKey := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PRIVATE_KEY_FORMAT_STRING, itemName))
+			passphrase := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PASSPHRASE_FORMAT_STRING, itemName))
+			knownHosts := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_KNOWN_HOSTS_FORMAT_STRING, itemName))
+			appID := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_APP_ID_FORMAT_STRING, itemName))
+			installationID := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_INSTALLATION_ID_FORMAT_STRING, itemName))
+			userVariable := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_USER_VARIABLE_FORMAT_STRING, itemName))
+			passwordVariable := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PASSWORD_VARIABLE_FORMAT_STRING, itemName))
+			pushTags := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PUSH_TAGS_FORMAT_STRING, itemName))
+			var strictHostKeyChecking *bool = nil
+			strictHostKeyCheckingString := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_STRICT_HOST_KEY_CHECKING_FORMAT_STRING, itemName))
+			if strictHostKeyCheckingString != nil && "" != *strictHostKeyCheckingString {
+				shkc, err := strconv.ParseBool(*strictHostKeyCheckingString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_STRICT_HOST_KEY_CHECKING_FORMAT_STRING, itemName), *strictHostKeyCheckingString), Cause: err}
+				}
+				strictHostKeyChecking = &shkc
+			}
+
+			remotes[itemName] = ent.NewGitRemoteConfigurationWith(authenticationMethod, user, password, privateKey, passphrase, knownHosts, strictHostKeyChecking, appID, installationID, userVariable, passwordVariable, pushTags)
+		}
+
+		// parse the 'headers' map
+		headers := make(map[string]string)
+		headerNames, err := ecl.scanItemNamesInEnvironmentVariables("git", GIT_CONFIGURATION_HEADERS_ENVVAR_ITEM_NAME_REGEX, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, headerName := range headerNames {
+			headers[strings.ReplaceAll(headerName, "_", "-")] = *ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_HEADERS_ENVVAR_ITEM_VALUE_FORMAT_STRING, headerName))
+		}
+
+		// parse the 'identity' object
+		var identityProvider *ent.Provider
+		identityProviderString := ecl.getEnvVar(GIT_CONFIGURATION_IDENTITY_PROVIDER_ENVVAR_NAME)
+		if identityProviderString != nil {
+			provider, err := ent.ValueOfProvider(*identityProviderString)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", GIT_CONFIGURATION_IDENTITY_PROVIDER_ENVVAR_NAME, *identityProviderString)}
+			}
+			identityProvider = &provider
+		}
+		identity := ent.NewGitIdentityConfigurationWith(ecl.getEnvVar(GIT_CONFIGURATION_IDENTITY_EMAIL_ENVVAR_NAME), ecl.getEnvVar(GIT_CONFIGURATION_IDENTITY_NAME_ENVVAR_NAME), identityProvider, ecl.getEnvVar(GIT_CONFIGURATION_IDENTITY_EMAIL_VARIABLE_ENVVAR_NAME), ecl.getEnvVar(GIT_CONFIGURATION_IDENTITY_NAME_VARIABLE_ENVVAR_NAME))
+
+		var singleBranch *bool = nil
+		singleBranchString := ecl.getEnvVar(GIT_CONFIGURATION_SINGLE_BRANCH_ENVVAR_NAME)
+		if singleBranchString != nil {
+			// empty string is considered 'false'
+			if "" == *singleBranchString {
+				sb := false
+				singleBranch = &sb
+			} else {
+				sb, err := strconv.ParseBool(*singleBranchString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", GIT_CONFIGURATION_SINGLE_BRANCH_ENVVAR_NAME, *singleBranchString), Cause: err}
+				}
+				singleBranch = &sb
+			}
 		}
-	}
-}
 
-/*
-Returns the version identifier to bump as it's defined by this configuration. A nil value means undefined.
+		var fetchTags *bool = nil
+		fetchTagsString := ecl.getEnvVar(GIT_CONFIGURATION_FETCH_TAGS_ENVVAR_NAME)
+		if fetchTagsString != nil {
+			// empty string is considered 'false'
+			if "" == *fetchTagsString {
+				ft := false
+				fetchTags = &ft
+			} else {
+				ft, err := strconv.ParseBool(*fetchTagsString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", GIT_CONFIGURATION_FETCH_TAGS_ENVVAR_NAME, *fetchTagsString), Cause: err}
+				}
+				fetchTags = &ft
+			}
+		}
 
-Error is:
-- DataAccessError: in case the option cannot be read or accessed.
-- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
-*/
-func (ecl *EnvironmentConfigurationLayer) GetBump() (*string, error) {
-	return ecl.getEnvVar(BUMP_ENVVAR_NAME), nil
-}
+		var unshallow *bool = nil
+		unshallowString := ecl.getEnvVar(GIT_CONFIGURATION_UNSHALLOW_ENVVAR_NAME)
+		if unshallowString != nil {
+			// empty string is considered 'false'
+			if "" == *unshallowString {
+				u := false
+				unshallow = &u
+			} else {
+				u, err := strconv.ParseBool(*unshallowString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", GIT_CONFIGURATION_UNSHALLOW_ENVVAR_NAME, *unshallowString), Cause: err}
+				}
+				unshallow = &u
+			}
+		}
 
-/*
-Returns the changelog configuration section.
+		var mirror *bool = nil
+		mirrorString := ecl.getEnvVar(GIT_CONFIGURATION_MIRROR_ENVVAR_NAME)
+		if mirrorString != nil {
+			// empty string is considered 'false'
+			if "" == *mirrorString {
+				m := false
+				mirror = &m
+			} else {
+				m, err := strconv.ParseBool(*mirrorString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", GIT_CONFIGURATION_MIRROR_ENVVAR_NAME, *mirrorString), Cause: err}
+				}
+				mirror = &m
+			}
+		}
 
-Error is:
-- DataAccessError: in case the option cannot be read or accessed.
-- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
-*/
-func (ecl *EnvironmentConfigurationLayer) GetChangelog() (*ent.ChangelogConfiguration, error) {
-	if ecl.changelog == nil {
-		// parse the 'sections' map
-		sections := make(map[string]string)
-		sectionNames, err := ecl.scanItemNamesInEnvironmentVariables("changelog", CHANGELOG_CONFIGURATION_SECTIONS_ENVVAR_ITEM_NAME_REGEX, nil)
-		if err != nil {
-			return nil, err
+		var noVerify *bool = nil
+		noVerifyString := ecl.getEnvVar(GIT_CONFIGURATION_NO_VERIFY_ENVVAR_NAME)
+		if noVerifyString != nil {
+			// empty string is considered 'false'
+			if "" == *noVerifyString {
+				nv := false
+				noVerify = &nv
+			} else {
+				nv, err := strconv.ParseBool(*noVerifyString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", GIT_CONFIGURATION_NO_VERIFY_ENVVAR_NAME, *noVerifyString), Cause: err}
+				}
+				noVerify = &nv
+			}
 		}
-		// now we have the set of all section names configured through environment variables and we can
-		// query specific environment variables
-		for _, sectionName := range sectionNames {
-			sectionValue := ecl.getEnvVar(fmt.Sprintf(CHANGELOG_CONFIGURATION_SECTIONS_ENVVAR_ITEM_REGEXP_FORMAT_STRING, sectionName))
-			sections[sectionName] = *sectionValue
+
+		var stash *bool = nil
+		stashString := ecl.getEnvVar(GIT_CONFIGURATION_STASH_ENVVAR_NAME)
+		if stashString != nil {
+			// empty string is considered 'false'
+			if "" == *stashString {
+				s := false
+				stash = &s
+			} else {
+				s, err := strconv.ParseBool(*stashString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", GIT_CONFIGURATION_STASH_ENVVAR_NAME, *stashString), Cause: err}
+				}
+				stash = &s
+			}
 		}
 
-		// parse the 'substitutions' map
-		substitutions := make(map[string]string)
-		substitutionNames, err := ecl.scanItemNamesInEnvironmentVariables("changelog", CHANGELOG_CONFIGURATION_SUBSTITUTIONS_ENVVAR_ITEM_NAME_REGEX, nil)
-		if err != nil {
-			return nil, err
+		var lock *bool = nil
+		lockString := ecl.getEnvVar(GIT_CONFIGURATION_LOCK_ENVVAR_NAME)
+		if lockString != nil {
+			// empty string is considered 'false'
+			if "" == *lockString {
+				l := false
+				lock = &l
+			} else {
+				l, err := strconv.ParseBool(*lockString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", GIT_CONFIGURATION_LOCK_ENVVAR_NAME, *lockString), Cause: err}
+				}
+				lock = &l
+			}
 		}
-		// now we have the set of all section names configured through environment variables and we can
-		// query specific environment variables
-		for _, substitutionName := range substitutionNames {
-			substitutionValue := ecl.getEnvVar(fmt.Sprintf(CHANGELOG_CONFIGURATION_SUBSTITUTIONS_ENVVAR_ITEM_REGEXP_FORMAT_STRING, substitutionName))
-			substitutions[substitutionName] = *substitutionValue
+
+		var backend *ent.GitBackend = nil
+		backendString := ecl.getEnvVar(GIT_CONFIGURATION_BACKEND_ENVVAR_NAME)
+		if backendString != nil {
+			b, err := ent.ValueOfGitBackend(*backendString)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", GIT_CONFIGURATION_BACKEND_ENVVAR_NAME, *backendString), Cause: err}
+			}
+			backend = &b
 		}
 
-		ecl.changelog, err = ent.NewChangelogConfigurationWith(ecl.getEnvVar(CHANGELOG_CONFIGURATION_APPEND_ENVVAR_NAME), ecl.getEnvVar(CHANGELOG_CONFIGURATION_PATH_ENVVAR_NAME), &sections, ecl.getEnvVar(CHANGELOG_CONFIGURATION_TEMPLATE_ENVVAR_NAME), &substitutions)
+		ecl.git, err = ent.NewGitConfigurationWith(&headers, identity, ecl.getEnvVar(GIT_CONFIGURATION_PROXY_ENVVAR_NAME), &remotes, singleBranch, fetchTags, unshallow, mirror, backend, ecl.getEnvVar(GIT_CONFIGURATION_SERVICE_ENVVAR_NAME), ecl.getEnvVar(GIT_CONFIGURATION_TRUSTED_KEYS_ENVVAR_NAME), ecl.getEnvVar(GIT_CONFIGURATION_TIMESTAMP_ENVVAR_NAME), noVerify, ecl.getEnvVar(GIT_CONFIGURATION_HOOKS_PATH_ENVVAR_NAME), ecl.getEnvVar(GIT_CONFIGURATION_IGNORE_SUBMODULES_ENVVAR_NAME), stash, ecl.getEnvVar(GIT_CONFIGURATION_TIMEOUT_ENVVAR_NAME), ecl.getEnvVar(GIT_CONFIGURATION_RETRIES_ENVVAR_NAME), ecl.getEnvVar(GIT_CONFIGURATION_RETRY_DELAY_ENVVAR_NAME), lock, ecl.getEnvVar(GIT_CONFIGURATION_LOCK_TIMEOUT_ENVVAR_NAME))
 		if err != nil {
 			return nil, err
 		}
 	}
-	return ecl.changelog, nil
+	return ecl.git, nil
 }
 
 /*
-Returns the commit message convention configuration section.
+Returns the impact analyzers configuration section.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (ecl *EnvironmentConfigurationLayer) GetCommitMessageConventions() (*ent.CommitMessageConventions, error) {
-	if ecl.commitMessageConventions == nil {
+func (ecl *EnvironmentConfigurationLayer) GetImpactAnalyzers() (*ent.ImpactAnalyzers, error) {
+	if ecl.impactAnalyzers == nil {
 		// parse the 'enabled' items list
-		enabled := ecl.getItemNamesListFromEnvironmentVariable("commitMessageConventions", "enabled", COMMIT_MESSAGE_CONVENTIONS_ENABLED_ENVVAR_NAME)
+		enabled := ecl.getItemNamesListFromEnvironmentVariable("impactAnalyzers", "enabled", IMPACT_ANALYZERS_ENABLED_ENVVAR_NAME)
 
 		// parse the 'items' map
-		items := make(map[string]*ent.CommitMessageConvention)
+		items := make(map[string]*ent.ImpactAnalyzer)
 
-		itemNames, err := ecl.scanItemNamesInEnvironmentVariables("commitMessageConventions", COMMIT_MESSAGE_CONVENTIONS_ENVVAR_ITEM_NAME_REGEX, nil)
+		itemNames, err := ecl.scanItemNamesInEnvironmentVariables("impactAnalyzers", IMPACT_ANALYZERS_ENVVAR_ITEM_NAME_REGEX, nil)
 		if err != nil {
 			return nil, err
 		}
 		// now we have the set of all item names configured through environment variables and we can
 		// query specific environment variables
 		for _, itemName := range itemNames {
-			expression := ecl.getEnvVar(fmt.Sprintf(COMMIT_MESSAGE_CONVENTIONS_ENVVAR_ITEM_EXPRESSION_FORMAT_STRING, itemName))
-			bumpExpressions := ecl.getAttributeMapFromEnvironmentVariable("commitMessageConventions"+"."+itemName+"."+"bumpExpressions", fmt.Sprintf(COMMIT_MESSAGE_CONVENTIONS_ENVVAR_ITEM_BUMP_EXPRESSIONS_FORMAT_STRING, itemName), nil)
+			bump := ecl.getEnvVar(fmt.Sprintf(IMPACT_ANALYZERS_ENVVAR_ITEM_BUMP_FORMAT_STRING, itemName))
+			command := ecl.getEnvVar(fmt.Sprintf(IMPACT_ANALYZERS_ENVVAR_ITEM_COMMAND_FORMAT_STRING, itemName))
+			expression := ecl.getEnvVar(fmt.Sprintf(IMPACT_ANALYZERS_ENVVAR_ITEM_EXPRESSION_FORMAT_STRING, itemName))
+			title := ecl.getEnvVar(fmt.Sprintf(IMPACT_ANALYZERS_ENVVAR_ITEM_TITLE_FORMAT_STRING, itemName))
 
-			items[itemName] = ent.NewCommitMessageConventionWith(expression, &bumpExpressions)
+			items[itemName] = ent.NewImpactAnalyzerWith(bump, command, expression, title)
 		}
 		enabledPointers := ecl.toSliceOfStringPointers(enabled)
-		ecl.commitMessageConventions, err = ent.NewCommitMessageConventionsWith(&enabledPointers, &items)
+		ecl.impactAnalyzers, err = ent.NewImpactAnalyzersWith(&enabledPointers, &items)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return ecl.commitMessageConventions, nil
+	return ecl.impactAnalyzers, nil
 }
 
 /*
-Returns the path to a custom configuration file as it's defined by this configuration. A nil value means undefined.
+Returns the initial version defined by this configuration to use when no past version is available in the commit history. A nil value means undefined.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (ecl *EnvironmentConfigurationLayer) GetConfigurationFile() (*string, error) {
-	return ecl.getEnvVar(CONFIGURATION_FILE_ENVVAR_NAME), nil
+func (ecl *EnvironmentConfigurationLayer) GetInitialVersion() (*string, error) {
+	return ecl.getEnvVar(INITIAL_VERSION_ENVVAR_NAME), nil
 }
 
 /*
-Returns the directory to use as the working directory as it's defined by this configuration. A nil value means undefined.
+Returns the path to the file where the JUnit XML release report must be saved as it's defined by this configuration. A nil value means undefined.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (ecl *EnvironmentConfigurationLayer) GetDirectory() (*string, error) {
-	return ecl.getEnvVar(DIRECTORY_ENVVAR_NAME), nil
+func (ecl *EnvironmentConfigurationLayer) GetJUnitReportFile() (*string, error) {
+	return ecl.getEnvVar(JUNIT_REPORT_FILE_ENVVAR_NAME), nil
 }
 
 /*
-Returns the value of the dry run flag as it's defined by this configuration. A nil value means undefined.
+Returns the policy applied when there is nothing to release as it's defined by this configuration. A nil value means undefined.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (ecl *EnvironmentConfigurationLayer) GetDryRun() (*bool, error) {
-	dryRunString := ecl.getEnvVar(DRY_RUN_ENVVAR_NAME)
-	if dryRunString == nil {
+func (ecl *EnvironmentConfigurationLayer) GetNothingToRelease() (*ent.NothingToReleasePolicy, error) {
+	nothingToReleaseString := ecl.getEnvVar(NOTHING_TO_RELEASE_ENVVAR_NAME)
+	if nothingToReleaseString == nil {
 		return nil, nil
+	} else {
+		nothingToRelease, err := ent.ValueOfNothingToReleasePolicy(*nothingToReleaseString)
+		return &nothingToRelease, err
 	}
-	dryRun, err := strconv.ParseBool(*dryRunString)
-	return &dryRun, err
 }
 
 /*
-Returns the Git configuration section.
+Returns the name of the repository the organization configuration is discovered from as it's defined by this configuration. A nil value means undefined.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (ecl *EnvironmentConfigurationLayer) GetGit() (*ent.GitConfiguration, error) {
-	if ecl.git == nil {
-		// parse the 'remotes' map
-		remotes := make(map[string]*ent.GitRemoteConfiguration)
+func (ecl *EnvironmentConfigurationLayer) GetOrganizationConfigurationRepository() (*string, error) {
+	return ecl.getEnvVar(ORGANIZATION_CONFIGURATION_REPOSITORY_ENVVAR_NAME), nil
+}
 
-		itemNames, err := ecl.scanItemNamesInEnvironmentVariables("git", GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_NAME_REGEX, nil)
+/*
+Returns the name of the service used to discover the organization configuration as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetOrganizationConfigurationService() (*string, error) {
+	return ecl.getEnvVar(ORGANIZATION_CONFIGURATION_SERVICE_ENVVAR_NAME), nil
+}
+
+/*
+Returns the path rules configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetPathRules() (*ent.PathRules, error) {
+	if ecl.pathRules == nil {
+		// parse the 'enabled' items list
+		enabled := ecl.getItemNamesListFromEnvironmentVariable("pathRules", "enabled", PATH_RULES_ENABLED_ENVVAR_NAME)
+
+		// parse the 'items' map
+		items := make(map[string]*ent.PathRule)
+
+		itemNames, err := ecl.scanItemNamesInEnvironmentVariables("pathRules", PATH_RULES_ENVVAR_ITEM_NAME_REGEX, nil)
 		if err != nil {
 			return nil, err
 		}
 		// now we have the set of all item names configured through environment variables and we can
 		// query specific environment variables
 		for _, itemName := range itemNames {
-			var authenticationMethod *ent.AuthenticationMethod = nil
-			authenticationMethodString := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_AUTHENTICATION_METHOD_FORMAT_STRING, itemName))
-			if authenticationMethodString != nil {
-				am, err := ent.ValueOfAuthenticationMethod(*authenticationMethodString)
+			bump := ecl.getEnvVar(fmt.Sprintf(PATH_RULES_ENVVAR_ITEM_BUMP_FORMAT_STRING, itemName))
+			pathsList := ecl.getEnvVar(fmt.Sprintf(PATH_RULES_ENVVAR_ITEM_PATHS_FORMAT_STRING, itemName))
+			var paths *[]*string
+			if pathsList != nil {
+				pathsSlice := strings.Split(*pathsList, ",")
+				var pathsArray []*string
+				for _, path := range pathsSlice {
+					pathCopy := path
+					pathsArray = append(pathsArray, &pathCopy)
+				}
+				paths = &pathsArray
+			}
+			var significant *bool = nil
+			significantString := ecl.getEnvVar(fmt.Sprintf(PATH_RULES_ENVVAR_ITEM_SIGNIFICANT_FORMAT_STRING, itemName))
+			if significantString != nil && "" != *significantString {
+				s, err := strconv.ParseBool(*significantString)
 				if err != nil {
-					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The argument '%s' has an illegal value '%s'", fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_AUTHENTICATION_METHOD_FORMAT_STRING, itemName), *authenticationMethodString), Cause: err}
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", fmt.Sprintf(PATH_RULES_ENVVAR_ITEM_SIGNIFICANT_FORMAT_STRING, itemName), *significantString), Cause: err}
 				}
-				authenticationMethod = &am
+				significant = &s
 			}
-			password := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PASSWORD_FORMAT_STRING, itemName))
-			user := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_USER_FORMAT_STRING, itemName))
-			privateKey := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PRIVATE_KEY_FORMAT_STRING, itemName))
-			passphrase := ecl.getEnvVar(fmt.Sprintf(GIT_CONFIGURATION_REMOTES_ENVVAR_ITEM_PASSPHRASE_FORMAT_STRING, itemName))
 
-			remotes[itemName] = ent.NewGitRemoteConfigurationWith(authenticationMethod, user, password, privateKey, passphrase)
+			items[itemName] = ent.NewPathRuleWith(bump, paths, significant)
 		}
-
-		ecl.git, err = ent.NewGitConfigurationWith(&remotes)
+		enabledPointers := ecl.toSliceOfStringPointers(enabled)
+		ecl.pathRules, err = ent.NewPathRulesWith(&enabledPointers, &items)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return ecl.git, nil
+	return ecl.pathRules, nil
 }
 
 /*
-Returns the initial version defined by this configuration to use when no past version is available in the commit history. A nil value means undefined.
+Returns the comma separated list of pre-release identifiers, from the lowest to the highest precedence, used to compare pre-release versions as it's defined by this configuration. A nil value means undefined.
 
 Error is:
 - DataAccessError: in case the option cannot be read or accessed.
 - IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
 */
-func (ecl *EnvironmentConfigurationLayer) GetInitialVersion() (*string, error) {
-	return ecl.getEnvVar(INITIAL_VERSION_ENVVAR_NAME), nil
+func (ecl *EnvironmentConfigurationLayer) GetPrereleaseOrder() (*string, error) {
+	return ecl.getEnvVar(PRERELEASE_ORDER_ENVVAR_NAME), nil
 }
 
 /*
@@ -1035,14 +2214,35 @@ func (ecl *EnvironmentConfigurationLayer) GetReleaseAssets() (*map[string]*ent.A
 			description := ecl.getEnvVar(fmt.Sprintf(RELEASE_ASSETS_ENVVAR_ITEM_DESCRIPTION_FORMAT_STRING, itemName))
 			path := ecl.getEnvVar(fmt.Sprintf(RELEASE_ASSETS_ENVVAR_ITEM_PATH_FORMAT_STRING, itemName))
 			attachmentType := ecl.getEnvVar(fmt.Sprintf(RELEASE_ASSETS_ENVVAR_ITEM_TYPE_FORMAT_STRING, itemName))
+			archive := ecl.getEnvVar(fmt.Sprintf(RELEASE_ASSETS_ENVVAR_ITEM_ARCHIVE_FORMAT_STRING, itemName))
+			platforms := ecl.getEnvVar(fmt.Sprintf(RELEASE_ASSETS_ENVVAR_ITEM_PLATFORMS_FORMAT_STRING, itemName))
 
 			ras[itemName] = ent.NewAttachmentWith(fileName, description, path, attachmentType)
+			ras[itemName].SetArchive(archive)
+			ras[itemName].SetPlatforms(platforms)
 		}
 		ecl.releaseAssets = &ras
 	}
 	return ecl.releaseAssets, nil
 }
 
+/*
+Returns the flag that enables coercion of release names that look like semantic versions, but are not, into legal semantic versions
+as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetReleaseCoercion() (*bool, error) {
+	releaseCoercionString := ecl.getEnvVar(RELEASE_COERCION_ENVVAR_NAME)
+	if releaseCoercionString == nil {
+		return nil, nil
+	}
+	releaseCoercion, err := strconv.ParseBool(*releaseCoercionString)
+	return &releaseCoercion, err
+}
+
 /*
 Returns the flag that enables tolerance in reading release names with arbitrary prefixes or extra non critical characters
 as it's defined by this configuration. A nil value means undefined.
@@ -1089,6 +2289,9 @@ func (ecl *EnvironmentConfigurationLayer) GetReleaseTypes() (*ent.ReleaseTypes,
 		// parse the 'remoteRepositories' items list
 		remoteRepositories := ecl.getItemNamesListFromEnvironmentVariable("releaseTypes", "remoteRepositories", RELEASE_TYPES_REMOTE_REPOSITORIES_ENVVAR_NAME)
 
+		// parse the 'yanked' items list
+		yanked := ecl.getItemNamesListFromEnvironmentVariable("releaseTypes", "yanked", RELEASE_TYPES_YANKED_ENVVAR_NAME)
+
 		// parse the 'items' map
 		items := make(map[string]*ent.ReleaseType)
 
@@ -1128,13 +2331,18 @@ func (ecl *EnvironmentConfigurationLayer) GetReleaseTypes() (*ent.ReleaseTypes,
 			} else {
 				assets = nil
 			}
+			buildMetadata := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_BUILD_METADATA_FORMAT_STRING, itemName))
+			buildMetadataTargets := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_BUILD_METADATA_TARGETS_FORMAT_STRING, itemName))
 			collapseVersionQualifier := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_COLLAPSED_VERSION_QUALIFIER_FORMAT_STRING, itemName))
 			description := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_DESCRIPTION_FORMAT_STRING, itemName))
 			filterTags := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_FILTER_TAGS_FORMAT_STRING, itemName))
+			followAllParents := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_FOLLOW_ALL_PARENTS_FORMAT_STRING, itemName))
+			gatePolicy := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GATE_POLICY_FORMAT_STRING, itemName))
 			gitCommit := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GIT_COMMIT_FORMAT_STRING, itemName))
 			gitCommitMessage := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GIT_COMMIT_MESSAGE_FORMAT_STRING, itemName))
 			gitPush := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GIT_PUSH_FORMAT_STRING, itemName))
 			gitPushForce := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GIT_PUSH_FORCE_FORMAT_STRING, itemName))
+			gitPushForceWithLease := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GIT_PUSH_FORCE_WITH_LEASE_FORMAT_STRING, itemName))
 			gitTag := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GIT_TAG_FORMAT_STRING, itemName))
 			gitTagForce := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GIT_TAG_FORCE_FORMAT_STRING, itemName))
 			gitTagMessage := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_GIT_TAG_MESSAGE_FORMAT_STRING, itemName))
@@ -1155,8 +2363,26 @@ func (ecl *EnvironmentConfigurationLayer) GetReleaseTypes() (*ent.ReleaseTypes,
 			if err != nil {
 				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value", fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_IDENTIFIERS_FORMAT_STRING, itemName)), Cause: err}
 			}
+			ignoreCherryPicks := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_IGNORE_CHERRY_PICKS_FORMAT_STRING, itemName))
+			ignoreMerges := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_IGNORE_MERGES_FORMAT_STRING, itemName))
 			matchBranches := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_BRANCHES_FORMAT_STRING, itemName))
+			bumpLabels := ecl.getAttributeMapFromEnvironmentVariable("releaseTypes"+"."+itemName+"."+"bumpLabels", fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_BUMP_LABELS_FORMAT_STRING, itemName), nil)
+			matchBranchMetadata := ecl.getAttributeMapFromEnvironmentVariable("releaseTypes"+"."+itemName+"."+"matchBranchMetadata", fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_BRANCH_METADATA_FORMAT_STRING, itemName), nil)
+			matchChangedPaths := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_CHANGED_PATHS_FORMAT_STRING, itemName))
+			matchDaysOfWeek := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_DAYS_OF_WEEK_FORMAT_STRING, itemName))
 			matchEnvironmentVariables := ecl.getAttributeMapFromEnvironmentVariable("releaseTypes"+"."+itemName+"."+"matchEnvironmentVariables", fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_ENVIRONMENT_VARIABLES_FORMAT_STRING, itemName), nil)
+			matchExpression := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_EXPRESSION_FORMAT_STRING, itemName))
+			var matchMode *ent.MatchMode = nil
+			matchModeString := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_MODE_FORMAT_STRING, itemName))
+			if matchModeString != nil {
+				mm, err := ent.ValueOfMatchMode(*matchModeString)
+				if err != nil {
+					return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_MODE_FORMAT_STRING, itemName), *matchModeString), Cause: err}
+				}
+				matchMode = &mm
+			}
+			matchPolicy := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_POLICY_FORMAT_STRING, itemName))
+			matchTags := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_TAGS_FORMAT_STRING, itemName))
 			var matchWorkspaceStatus *ent.WorkspaceStatus = nil
 			matchWorkspaceStatusString := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_MATCH_WORKSPACE_STATUS_FORMAT_STRING, itemName))
 			if matchWorkspaceStatusString != nil {
@@ -1167,9 +2393,29 @@ func (ecl *EnvironmentConfigurationLayer) GetReleaseTypes() (*ent.ReleaseTypes,
 				matchWorkspaceStatus = &mws
 			}
 			publish := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_FORMAT_STRING, itemName))
+			publishApprovalEnvironment := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_APPROVAL_ENVIRONMENT_FORMAT_STRING, itemName))
+			publishApprovalPollingInterval := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_APPROVAL_POLLING_INTERVAL_FORMAT_STRING, itemName))
+			publishApprovalTimeout := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_APPROVAL_TIMEOUT_FORMAT_STRING, itemName))
 			publishDraft := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_DRAFT_FORMAT_STRING, itemName))
 			publishPreRelease := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PUBLISH_PRE_RELEASE_FORMAT_STRING, itemName))
+			pullRequestMessages := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_PULL_REQUEST_MESSAGES_FORMAT_STRING, itemName))
+			releaseMetadataFile := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_RELEASE_METADATA_FILE_FORMAT_STRING, itemName))
 			releaseName := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_RELEASE_NAME_FORMAT_STRING, itemName))
+			requiredEnvironmentVariablesList := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_REQUIRED_ENVIRONMENT_VARIABLES_FORMAT_STRING, itemName))
+			var requiredEnvironmentVariables *[]*string
+			if requiredEnvironmentVariablesList != nil {
+				requiredEnvironmentVariablesSlice := strings.Split(*requiredEnvironmentVariablesList, ",")
+				var requiredEnvironmentVariablesArray []*string
+				for _, variableName := range requiredEnvironmentVariablesSlice {
+					variableNameCopy := strings.TrimSpace(variableName)
+					requiredEnvironmentVariablesArray = append(requiredEnvironmentVariablesArray, &variableNameCopy)
+				}
+				requiredEnvironmentVariables = &requiredEnvironmentVariablesArray
+			} else {
+				requiredEnvironmentVariables = nil
+			}
+			requireSignedCommits := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_REQUIRE_SIGNED_COMMITS_FORMAT_STRING, itemName))
+			versionConstraint := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_VERSION_CONSTRAINT_FORMAT_STRING, itemName))
 			versionRange := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_VERSION_RANGE_FORMAT_STRING, itemName))
 			var versionRangeFromBranchName *bool = nil
 			versionRangeFromBranchNameString := ecl.getEnvVar(fmt.Sprintf(RELEASE_TYPES_ENVVAR_ITEM_VERSION_RANGE_FROM_BRANCH_NAME_FORMAT_STRING, itemName))
@@ -1181,21 +2427,58 @@ func (ecl *EnvironmentConfigurationLayer) GetReleaseTypes() (*ent.ReleaseTypes,
 				versionRangeFromBranchName = &vrfbn
 			}
 
-			items[itemName] = ent.NewReleaseTypeWith(assets, collapseVersions, collapseVersionQualifier, description, filterTags, gitCommit, gitCommitMessage, gitPush, gitPushForce, gitTag, gitTagForce, gitTagMessage, gitTagNames, &identifiers, matchBranches, &matchEnvironmentVariables, matchWorkspaceStatus, publish, publishDraft, publishPreRelease, releaseName, versionRange, versionRangeFromBranchName)
+			releaseType := ent.NewReleaseTypeWith(assets, collapseVersions, collapseVersionQualifier, description, filterTags, gitCommit, gitCommitMessage, gitPush, gitPushForce, gitTag, gitTagForce, gitTagMessage, gitTagNames, &identifiers, matchBranches, &matchEnvironmentVariables, matchWorkspaceStatus, publish, publishDraft, publishPreRelease, releaseName, versionRange, versionRangeFromBranchName)
+			releaseType.SetBuildMetadata(buildMetadata)
+			releaseType.SetBuildMetadataTargets(buildMetadataTargets)
+			releaseType.SetBumpLabels(&bumpLabels)
+			releaseType.SetFollowAllParents(followAllParents)
+			releaseType.SetGatePolicy(gatePolicy)
+			releaseType.SetGitPushForceWithLease(gitPushForceWithLease)
+			releaseType.SetIgnoreCherryPicks(ignoreCherryPicks)
+			releaseType.SetIgnoreMerges(ignoreMerges)
+			releaseType.SetMatchBranchMetadata(&matchBranchMetadata)
+			releaseType.SetMatchChangedPaths(matchChangedPaths)
+			releaseType.SetMatchDaysOfWeek(matchDaysOfWeek)
+			releaseType.SetMatchExpression(matchExpression)
+			releaseType.SetMatchMode(matchMode)
+			releaseType.SetMatchPolicy(matchPolicy)
+			releaseType.SetMatchTags(matchTags)
+			releaseType.SetPublishApprovalEnvironment(publishApprovalEnvironment)
+			releaseType.SetPublishApprovalPollingInterval(publishApprovalPollingInterval)
+			releaseType.SetPublishApprovalTimeout(publishApprovalTimeout)
+			releaseType.SetPullRequestMessages(pullRequestMessages)
+			releaseType.SetReleaseMetadataFile(releaseMetadataFile)
+			releaseType.SetRequiredEnvironmentVariables(requiredEnvironmentVariables)
+			releaseType.SetRequireSignedCommits(requireSignedCommits)
+			releaseType.SetVersionConstraint(versionConstraint)
+			items[itemName] = releaseType
 		}
 
 		enabledPointers := ecl.toSliceOfStringPointers(enabled)
 		publicationServicesPointers := ecl.toSliceOfStringPointers(publicationServices)
 		remoteRepositoriesPointers := ecl.toSliceOfStringPointers(remoteRepositories)
+		yankedPointers := ecl.toSliceOfStringPointers(yanked)
 		ecl.releaseTypes, err = ent.NewReleaseTypesWith(&enabledPointers, &publicationServicesPointers, &remoteRepositoriesPointers, &items)
 		if err != nil {
 			return nil, err
 		}
+		ecl.releaseTypes.SetYanked(&yankedPointers)
 	}
 
 	return ecl.releaseTypes, nil
 }
 
+/*
+Returns the path to the file where the HTML release report must be saved as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetReportFile() (*string, error) {
+	return ecl.getEnvVar(REPORT_FILE_ENVVAR_NAME), nil
+}
+
 /*
 Returns the value of the resume flag as it's defined by this configuration. A nil value means undefined.
 
@@ -1212,6 +2495,18 @@ func (ecl *EnvironmentConfigurationLayer) GetResume() (*bool, error) {
 	return &resume, err
 }
 
+/*
+Returns the name of the core identifier (major, minor or patch) whose significance bumps the fourth number
+of four segment versions as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetRevisionBump() (*string, error) {
+	return ecl.getEnvVar(REVISION_BUMP_ENVVAR_NAME), nil
+}
+
 /*
 Returns the versioning scheme as it's defined by this configuration. A nil value means undefined.
 
@@ -1224,8 +2519,25 @@ func (ecl *EnvironmentConfigurationLayer) GetScheme() (*ver.Scheme, error) {
 	if schemeString == nil {
 		return nil, nil
 	}
-	scheme, err := ver.ValueOfScheme("SEMVER")
-	return &scheme, err
+	scheme, err := ver.ValueOfScheme(*schemeString)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("The environment variable '%s' has an illegal value '%s'", SCHEME_ENVVAR_NAME, *schemeString), Cause: err}
+	}
+	return &scheme, nil
+}
+
+/*
+Returns the server configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetServer() (*ent.ServerConfiguration, error) {
+	if ecl.server == nil {
+		ecl.server = ent.NewServerConfigurationWith(ecl.getEnvVar(SERVER_CONFIGURATION_ADDRESS_ENVVAR_NAME), ecl.getEnvVar(SERVER_CONFIGURATION_BRANCHES_ENVVAR_NAME), ecl.getEnvVar(SERVER_CONFIGURATION_COMMAND_ENVVAR_NAME), ecl.getEnvVar(SERVER_CONFIGURATION_SECRET_ENVVAR_NAME))
+	}
+	return ecl.server, nil
 }
 
 /*
@@ -1286,6 +2598,33 @@ func (ecl *EnvironmentConfigurationLayer) GetStateFile() (*string, error) {
 	return ecl.getEnvVar(STATE_FILE_ENVVAR_NAME), nil
 }
 
+/*
+Returns the secret key used to sign the state file and verify it when resuming as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetStateFileSigningKey() (*string, error) {
+	return ecl.getEnvVar(STATE_FILE_SIGNING_KEY_ENVVAR_NAME), nil
+}
+
+/*
+Returns the value of the strict exit codes flag as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetStrictExitCodes() (*bool, error) {
+	strictExitCodesString := ecl.getEnvVar(STRICT_EXIT_CODES_ENVVAR_NAME)
+	if strictExitCodesString == nil {
+		return nil, nil
+	}
+	strictExitCodes, err := strconv.ParseBool(*strictExitCodesString)
+	return &strictExitCodes, err
+}
+
 /*
 Returns the substitutions configuration section.
 
@@ -1350,6 +2689,45 @@ func (ecl *EnvironmentConfigurationLayer) GetSummaryFile() (*string, error) {
 	return ecl.getEnvVar(SUMMARY_FILE_ENVVAR_NAME), nil
 }
 
+/*
+Returns the URL of the endpoint where the telemetry report must be uploaded as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetTelemetryEndpoint() (*string, error) {
+	return ecl.getEnvVar(TELEMETRY_ENDPOINT_ENVVAR_NAME), nil
+}
+
+/*
+Returns the path to the file where the telemetry report must be saved as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetTelemetryFile() (*string, error) {
+	return ecl.getEnvVar(TELEMETRY_FILE_ENVVAR_NAME), nil
+}
+
+/*
+Returns the source of the release timestamp as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetTimestampSource() (*ent.TimestampSource, error) {
+	timestampSourceString := ecl.getEnvVar(TIMESTAMP_SOURCE_ENVVAR_NAME)
+	if timestampSourceString == nil {
+		return nil, nil
+	} else {
+		timestampSource, err := ent.ValueOfTimestampSource(*timestampSourceString)
+		return &timestampSource, err
+	}
+}
+
 /*
 Returns the logging verbosity level as it's defined by this configuration. A nil value means undefined.
 
@@ -1377,3 +2755,14 @@ Error is:
 func (ecl *EnvironmentConfigurationLayer) GetVersion() (*string, error) {
 	return ecl.getEnvVar(VERSION_ENVVAR_NAME), nil
 }
+
+/*
+Returns the name of the service used to read and store released versions as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (ecl *EnvironmentConfigurationLayer) GetVersionStorageService() (*string, error) {
+	return ecl.getEnvVar(VERSION_STORAGE_SERVICE_ENVVAR_NAME), nil
+}
@@ -49,6 +49,60 @@ func TestEnvironmentConfigurationLayerGetBump(t *testing.T) {
 	assert.Equal(t, "b", *bump)
 }
 
+func TestEnvironmentConfigurationLayerGetAnalyticsFile(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	analyticsFile, err := environmentConfigurationLayer.GetAnalyticsFile()
+	assert.NoError(t, err)
+	assert.Nil(t, analyticsFile)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_ANALYTICS_FILE=analytics.json",
+	})
+
+	analyticsFile, err = environmentConfigurationLayer.GetAnalyticsFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "analytics.json", *analyticsFile)
+}
+
+func TestEnvironmentConfigurationLayerGetBadgesDirectory(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	badgesDirectory, err := environmentConfigurationLayer.GetBadgesDirectory()
+	assert.NoError(t, err)
+	assert.Nil(t, badgesDirectory)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_BADGES_DIRECTORY=badges",
+	})
+
+	badgesDirectory, err = environmentConfigurationLayer.GetBadgesDirectory()
+	assert.NoError(t, err)
+	assert.Equal(t, "badges", *badgesDirectory)
+}
+
+func TestEnvironmentConfigurationLayerGetBranchMetadataExpression(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	branchMetadataExpression, err := environmentConfigurationLayer.GetBranchMetadataExpression()
+	assert.NoError(t, err)
+	assert.Nil(t, branchMetadataExpression)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_BRANCH_METADATA_EXPRESSION=^feature/(?<scope>[a-z]+)-.*$",
+	})
+
+	branchMetadataExpression, err = environmentConfigurationLayer.GetBranchMetadataExpression()
+	assert.NoError(t, err)
+	assert.Equal(t, "^feature/(?<scope>[a-z]+)-.*$", *branchMetadataExpression)
+}
+
 func TestEnvironmentConfigurationLayerGetChangelog(t *testing.T) {
 	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
 
@@ -56,10 +110,17 @@ func TestEnvironmentConfigurationLayerGetChangelog(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, changelog)
 	assert.Nil(t, changelog.GetAppend())
+	assert.Equal(t, 0, len(*changelog.GetBadges()))
+	assert.Nil(t, changelog.GetCollapseThreshold())
+	assert.Nil(t, changelog.GetDeduplicateCherryPicks())
+	assert.Nil(t, changelog.GetGroupDependencyUpdates())
+	assert.Nil(t, changelog.GetMergePreReleases())
+	assert.Equal(t, 0, len(*changelog.GetEmojis()))
 	assert.Nil(t, changelog.GetPath())
 	assert.Equal(t, 0, len(*changelog.GetSections()))
 	assert.Equal(t, 0, len(*changelog.GetSubstitutions()))
 	assert.Nil(t, changelog.GetTemplate())
+	assert.Nil(t, changelog.GetUpgradeNotes())
 
 	// get a new instance or a stale set of environment variables is still in the configuration layer
 	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
@@ -72,26 +133,43 @@ func TestEnvironmentConfigurationLayerGetChangelog(t *testing.T) {
 	assert.NotNil(t, changelog)
 
 	assert.Nil(t, changelog.GetAppend())
+	assert.Nil(t, changelog.GetDeduplicateCherryPicks())
+	assert.Nil(t, changelog.GetGroupDependencyUpdates())
+	assert.Nil(t, changelog.GetMergePreReleases())
 	assert.Equal(t, "CHANGELOG.md", *changelog.GetPath())
 	assert.Equal(t, 0, len(*changelog.GetSections()))
 	assert.Equal(t, 0, len(*changelog.GetSubstitutions()))
 	assert.Nil(t, changelog.GetTemplate())
+	assert.Nil(t, changelog.GetUpgradeNotes())
 
 	// get a new instance or a stale set of environment variables is still in the configuration layer
 	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
 	environmentConfigurationLayer.withEnvironmentVariables([]string{
 		"NYX_CHANGELOG_APPEND=head",
+		"NYX_CHANGELOG_BADGES_Section1=badge1",
+		"NYX_CHANGELOG_COLLAPSE_THRESHOLD=10",
+		"NYX_CHANGELOG_DEDUPLICATE_CHERRY_PICKS=true",
+		"NYX_CHANGELOG_EMOJIS_Section1=:sparkles:",
+		"NYX_CHANGELOG_GROUP_DEPENDENCY_UPDATES=true",
+		"NYX_CHANGELOG_MERGE_PRE_RELEASES=NESTED",
 		"NYX_CHANGELOG_PATH=CHANGELOG.md",
 		"NYX_CHANGELOG_SECTIONS_Section1=regex1",
 		"NYX_CHANGELOG_SECTIONS_Section2=regex2",
 		"NYX_CHANGELOG_SUBSTITUTIONS_Expr1=string1",
 		"NYX_CHANGELOG_TEMPLATE=changelog.tpl",
+		"NYX_CHANGELOG_UPGRADE_NOTES=UPGRADE.md",
 	})
 
 	changelog, err = environmentConfigurationLayer.GetChangelog()
 	assert.NoError(t, err)
 	assert.NotNil(t, changelog)
 	assert.Equal(t, "head", *changelog.GetAppend())
+	assert.Equal(t, "badge1", (*changelog.GetBadges())["Section1"])
+	assert.Equal(t, 10, *changelog.GetCollapseThreshold())
+	assert.Equal(t, true, *changelog.GetDeduplicateCherryPicks())
+	assert.Equal(t, ":sparkles:", (*changelog.GetEmojis())["Section1"])
+	assert.Equal(t, true, *changelog.GetGroupDependencyUpdates())
+	assert.Equal(t, ent.NESTED, *changelog.GetMergePreReleases())
 	assert.Equal(t, "CHANGELOG.md", *changelog.GetPath())
 
 	assert.Equal(t, 2, len(*changelog.GetSections()))
@@ -103,6 +181,34 @@ func TestEnvironmentConfigurationLayerGetChangelog(t *testing.T) {
 	substitutions := *changelog.GetSubstitutions()
 	assert.Equal(t, "string1", substitutions["Expr1"])
 	assert.Equal(t, "changelog.tpl", *changelog.GetTemplate())
+	assert.Equal(t, "UPGRADE.md", *changelog.GetUpgradeNotes())
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_CHANGELOG_COLLAPSE_THRESHOLD=ten",
+	})
+
+	_, err = environmentConfigurationLayer.GetChangelog()
+	assert.Error(t, err)
+}
+
+func TestEnvironmentConfigurationLayerGetCommitChanges(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	commitChanges, err := environmentConfigurationLayer.GetCommitChanges()
+	assert.NoError(t, err)
+	assert.Nil(t, commitChanges)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_COMMIT_CHANGES=true",
+	})
+
+	commitChanges, err = environmentConfigurationLayer.GetCommitChanges()
+	assert.NoError(t, err)
+	assert.Equal(t, true, *commitChanges)
 }
 
 func TestEnvironmentConfigurationLayerGetCommitMessageConventions(t *testing.T) {
@@ -184,6 +290,24 @@ func TestEnvironmentConfigurationLayerGetCommitMessageConventions(t *testing.T)
 	assert.Equal(t, "gamma1", bumpExpressions["gamma"])
 }
 
+func TestEnvironmentConfigurationLayerGetCommitLintFile(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	commitLintFile, err := environmentConfigurationLayer.GetCommitLintFile()
+	assert.NoError(t, err)
+	assert.Nil(t, commitLintFile)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_COMMIT_LINT_FILE=commit-lint.sarif",
+	})
+
+	commitLintFile, err = environmentConfigurationLayer.GetCommitLintFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "commit-lint.sarif", *commitLintFile)
+}
+
 func TestEnvironmentConfigurationLayerGetConfigurationFile(t *testing.T) {
 	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
 
@@ -225,6 +349,75 @@ func TestEnvironmentConfigurationLayerGetDirectory(t *testing.T) {
 	defer os.RemoveAll(dir) // clean up
 }
 
+func TestEnvironmentConfigurationLayerGetDownstreamUpdates(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	downstreamUpdates, err := environmentConfigurationLayer.GetDownstreamUpdates()
+	assert.NoError(t, err)
+	assert.NotNil(t, downstreamUpdates)
+	assert.Equal(t, 0, len(*downstreamUpdates.GetEnabled()))
+	assert.Equal(t, 0, len(*downstreamUpdates.GetItems()))
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_DOWNSTREAM_UPDATES_ENABLED=one,two",
+	})
+
+	downstreamUpdates, err = environmentConfigurationLayer.GetDownstreamUpdates()
+	assert.NoError(t, err)
+	assert.NotNil(t, downstreamUpdates)
+
+	enabled := *downstreamUpdates.GetEnabled()
+	items := *downstreamUpdates.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "one")
+	assert.Equal(t, *enabled[1], "two")
+	assert.Equal(t, 0, len(items))
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_DOWNSTREAM_UPDATES_ENABLED=one,two",
+		"NYX_DOWNSTREAM_UPDATES_one_BRANCH=main",
+		"NYX_DOWNSTREAM_UPDATES_one_MATCH=example.com/mod v[0-9.]+",
+		"NYX_DOWNSTREAM_UPDATES_one_OWNER=acme",
+		"NYX_DOWNSTREAM_UPDATES_one_PATH=go.mod",
+		"NYX_DOWNSTREAM_UPDATES_one_REPLACE=example.com/mod v{{version}}",
+		"NYX_DOWNSTREAM_UPDATES_one_REPOSITORY=app",
+		"NYX_DOWNSTREAM_UPDATES_one_SERVICE=github",
+		"NYX_DOWNSTREAM_UPDATES_one_TITLE=Bump to {{version}}",
+		"NYX_DOWNSTREAM_UPDATES_two_BRANCH=main",
+	})
+
+	downstreamUpdates, err = environmentConfigurationLayer.GetDownstreamUpdates()
+	assert.NoError(t, err)
+	assert.NotNil(t, downstreamUpdates)
+
+	enabled = *downstreamUpdates.GetEnabled()
+	items = *downstreamUpdates.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "one")
+	assert.Equal(t, *enabled[1], "two")
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, "main", *items["one"].GetBranch())
+	assert.Equal(t, "example.com/mod v[0-9.]+", *items["one"].GetMatch())
+	assert.Equal(t, "acme", *items["one"].GetOwner())
+	assert.Equal(t, "go.mod", *items["one"].GetPath())
+	assert.Equal(t, "example.com/mod v{{version}}", *items["one"].GetReplace())
+	assert.Equal(t, "app", *items["one"].GetRepository())
+	assert.Equal(t, "github", *items["one"].GetService())
+	assert.Equal(t, "Bump to {{version}}", *items["one"].GetTitle())
+	assert.Equal(t, "main", *items["two"].GetBranch())
+	assert.Nil(t, items["two"].GetMatch())
+	assert.Nil(t, items["two"].GetOwner())
+	assert.Nil(t, items["two"].GetPath())
+	assert.Nil(t, items["two"].GetReplace())
+	assert.Nil(t, items["two"].GetRepository())
+	assert.Nil(t, items["two"].GetService())
+	assert.Nil(t, items["two"].GetTitle())
+}
+
 func TestEnvironmentConfigurationLayerGetDryRun(t *testing.T) {
 	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
 
@@ -243,17 +436,130 @@ func TestEnvironmentConfigurationLayerGetDryRun(t *testing.T) {
 	assert.Equal(t, true, *dryRun)
 }
 
+func TestEnvironmentConfigurationLayerGetEventBus(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	eventBus, err := environmentConfigurationLayer.GetEventBus()
+	assert.NoError(t, err)
+	assert.NotNil(t, eventBus)
+	assert.Equal(t, 0, len(*eventBus.GetEnabled()))
+	assert.Equal(t, 0, len(*eventBus.GetItems()))
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_EVENT_BUS_ENABLED=one,two",
+	})
+
+	eventBus, err = environmentConfigurationLayer.GetEventBus()
+	assert.NoError(t, err)
+	assert.NotNil(t, eventBus)
+
+	enabled := *eventBus.GetEnabled()
+	items := *eventBus.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "one")
+	assert.Equal(t, *enabled[1], "two")
+	assert.Equal(t, 0, len(items))
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_EVENT_BUS_ENABLED=one,two",
+		"NYX_EVENT_BUS_one_ENDPOINT=https://kafka.example.com",
+		"NYX_EVENT_BUS_one_EVENTS=PUBLISHED,FAILED",
+		"NYX_EVENT_BUS_one_PASSWORD=secret",
+		"NYX_EVENT_BUS_one_REGION=eu-west-1",
+		"NYX_EVENT_BUS_one_TOPIC=releases",
+		"NYX_EVENT_BUS_one_TYPE=KAFKA",
+		"NYX_EVENT_BUS_one_USER=jdoe",
+		"NYX_EVENT_BUS_two_ENDPOINT=https://kafka.example.com",
+	})
+
+	eventBus, err = environmentConfigurationLayer.GetEventBus()
+	assert.NoError(t, err)
+	assert.NotNil(t, eventBus)
+
+	enabled = *eventBus.GetEnabled()
+	items = *eventBus.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "one")
+	assert.Equal(t, *enabled[1], "two")
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, "https://kafka.example.com", *items["one"].GetEndpoint())
+	assert.Equal(t, "PUBLISHED,FAILED", *items["one"].GetEvents())
+	assert.Equal(t, "secret", *items["one"].GetPassword())
+	assert.Equal(t, "eu-west-1", *items["one"].GetRegion())
+	assert.Equal(t, "releases", *items["one"].GetTopic())
+	assert.Equal(t, "KAFKA", *items["one"].GetType())
+	assert.Equal(t, "jdoe", *items["one"].GetUser())
+	assert.Equal(t, "https://kafka.example.com", *items["two"].GetEndpoint())
+	assert.Nil(t, items["two"].GetEvents())
+	assert.Nil(t, items["two"].GetPassword())
+	assert.Nil(t, items["two"].GetRegion())
+	assert.Nil(t, items["two"].GetTopic())
+	assert.Nil(t, items["two"].GetType())
+	assert.Nil(t, items["two"].GetUser())
+}
+
 func TestEnvironmentConfigurationLayerGetGit(t *testing.T) {
 	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
 
 	git, err := environmentConfigurationLayer.GetGit()
 	assert.NoError(t, err)
 	assert.NotNil(t, git)
+	assert.Equal(t, 0, len(*git.GetHeaders()))
+	assert.Nil(t, git.GetIdentity().GetEmail())
+	assert.Nil(t, git.GetIdentity().GetName())
+	assert.Nil(t, git.GetIdentity().GetProvider())
+	assert.Nil(t, git.GetProxy())
+	assert.Nil(t, git.GetSingleBranch())
+	assert.Nil(t, git.GetFetchTags())
+	assert.Nil(t, git.GetUnshallow())
+	assert.Nil(t, git.GetMirror())
+	assert.Nil(t, git.GetBackend())
+	assert.Nil(t, git.GetService())
+	assert.Nil(t, git.GetTrustedKeys())
+	assert.Nil(t, git.GetTimestamp())
+	assert.Nil(t, git.GetNoVerify())
+	assert.Nil(t, git.GetHooksPath())
+	assert.Nil(t, git.GetIgnoreSubmodules())
+	assert.Nil(t, git.GetStash())
+	assert.Nil(t, git.GetTimeout())
+	assert.Nil(t, git.GetRetries())
+	assert.Nil(t, git.GetRetryDelay())
+	assert.Nil(t, git.GetLock())
+	assert.Nil(t, git.GetLockTimeout())
 	assert.Equal(t, 0, len(*git.GetRemotes()))
 
 	// get a new instance or a stale set of environment variables is still in the configuration layer
 	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
 	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_GIT_PROXY=http://proxy.example.com:3128",
+		"NYX_GIT_SINGLE_BRANCH=true",
+		"NYX_GIT_FETCH_TAGS=true",
+		"NYX_GIT_UNSHALLOW=false",
+		"NYX_GIT_MIRROR=true",
+		"NYX_GIT_BACKEND=CLI",
+		"NYX_GIT_SERVICE=github",
+		"NYX_GIT_TRUSTED_KEYS=keys",
+		"NYX_GIT_TIMESTAMP=1700000000",
+		"NYX_GIT_NO_VERIFY=true",
+		"NYX_GIT_HOOKS_PATH=.githooks",
+		"NYX_GIT_IGNORE_SUBMODULES=dirty",
+		"NYX_GIT_STASH=true",
+		"NYX_GIT_TIMEOUT=30",
+		"NYX_GIT_RETRIES=3",
+		"NYX_GIT_RETRY_DELAY=5",
+		"NYX_GIT_LOCK=true",
+		"NYX_GIT_LOCK_TIMEOUT=60",
+		"NYX_GIT_IDENTITY_EMAIL=12345+nyx[bot]@users.noreply.github.com",
+		"NYX_GIT_IDENTITY_NAME=nyx[bot]",
+		"NYX_GIT_IDENTITY_PROVIDER=GITHUB",
+		"NYX_GIT_IDENTITY_EMAIL_VARIABLE=BOT_GIT_EMAIL",
+		"NYX_GIT_IDENTITY_NAME_VARIABLE=BOT_GIT_NAME",
+		"NYX_GIT_HEADERS_Authorization=Basic OnNlY3JldA==",
+		"NYX_GIT_HEADERS_X_Custom_Header=value",
 		"NYX_GIT_REMOTES_one_USER=jdoe",
 		"NYX_GIT_REMOTES_two_USER=stiger",
 	})
@@ -263,6 +569,35 @@ func TestEnvironmentConfigurationLayerGetGit(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, git)
 
+	assert.Equal(t, 2, len(*git.GetHeaders()))
+	assert.Equal(t, "Basic OnNlY3JldA==", (*git.GetHeaders())["Authorization"])
+	assert.Equal(t, "value", (*git.GetHeaders())["X-Custom-Header"])
+
+	assert.Equal(t, "http://proxy.example.com:3128", *git.GetProxy())
+	assert.Equal(t, true, *git.GetSingleBranch())
+	assert.Equal(t, true, *git.GetFetchTags())
+	assert.Equal(t, false, *git.GetUnshallow())
+	assert.Equal(t, true, *git.GetMirror())
+	assert.Equal(t, ent.CLI, *git.GetBackend())
+	assert.Equal(t, "github", *git.GetService())
+	assert.Equal(t, "keys", *git.GetTrustedKeys())
+	assert.Equal(t, "1700000000", *git.GetTimestamp())
+	assert.Equal(t, true, *git.GetNoVerify())
+	assert.Equal(t, ".githooks", *git.GetHooksPath())
+	assert.Equal(t, "dirty", *git.GetIgnoreSubmodules())
+	assert.Equal(t, true, *git.GetStash())
+	assert.Equal(t, "30", *git.GetTimeout())
+	assert.Equal(t, "3", *git.GetRetries())
+	assert.Equal(t, "5", *git.GetRetryDelay())
+	assert.Equal(t, true, *git.GetLock())
+	assert.Equal(t, "60", *git.GetLockTimeout())
+
+	assert.Equal(t, "12345+nyx[bot]@users.noreply.github.com", *git.GetIdentity().GetEmail())
+	assert.Equal(t, "nyx[bot]", *git.GetIdentity().GetName())
+	assert.Equal(t, ent.GITHUB, *git.GetIdentity().GetProvider())
+	assert.Equal(t, "BOT_GIT_EMAIL", *git.GetIdentity().GetEmailVariable())
+	assert.Equal(t, "BOT_GIT_NAME", *git.GetIdentity().GetNameVariable())
+
 	assert.Equal(t, 2, len(*git.GetRemotes()))
 	assert.NotNil(t, remotes["one"])
 	assert.NotNil(t, remotes["two"])
@@ -275,11 +610,18 @@ func TestEnvironmentConfigurationLayerGetGit(t *testing.T) {
 		"NYX_GIT_REMOTES_one_PASSWORD=pwd",
 		"NYX_GIT_REMOTES_one_PRIVATE_KEY=pk1",
 		"NYX_GIT_REMOTES_one_PASSPHRASE=pp1",
+		"NYX_GIT_REMOTES_one_APP_ID=123",
+		"NYX_GIT_REMOTES_one_INSTALLATION_ID=456",
+		"NYX_GIT_REMOTES_one_USER_VARIABLE=RELEASE_USER",
+		"NYX_GIT_REMOTES_one_PASSWORD_VARIABLE=RELEASE_TOKEN",
+		"NYX_GIT_REMOTES_one_PUSH_TAGS=v*",
 		"NYX_GIT_REMOTES_two_AUTHENTICATION_METHOD=PUBLIC_KEY",
 		"NYX_GIT_REMOTES_two_USER=stiger",
 		"NYX_GIT_REMOTES_two_PASSWORD=sct",
 		"NYX_GIT_REMOTES_two_PRIVATE_KEY=pk2",
 		"NYX_GIT_REMOTES_two_PASSPHRASE=pp2",
+		"NYX_GIT_REMOTES_two_KNOWN_HOSTS=kh2",
+		"NYX_GIT_REMOTES_two_STRICT_HOST_KEY_CHECKING=false",
 	})
 
 	git, err = environmentConfigurationLayer.GetGit()
@@ -288,14 +630,81 @@ func TestEnvironmentConfigurationLayerGetGit(t *testing.T) {
 	assert.Equal(t, 2, len(*git.GetRemotes()))
 	assert.Equal(t, ent.USER_PASSWORD, *remotes["one"].GetAuthenticationMethod())
 	assert.Equal(t, "pwd", *remotes["one"].GetPassword())
+	assert.Equal(t, "123", *remotes["one"].GetAppID())
+	assert.Equal(t, "456", *remotes["one"].GetInstallationID())
+	assert.Nil(t, remotes["two"].GetAppID())
+	assert.Equal(t, "RELEASE_USER", *remotes["one"].GetUserVariable())
+	assert.Equal(t, "RELEASE_TOKEN", *remotes["one"].GetPasswordVariable())
+	assert.Nil(t, remotes["two"].GetPasswordVariable())
+	assert.Equal(t, "v*", *remotes["one"].GetPushTags())
+	assert.Nil(t, remotes["two"].GetPushTags())
 	assert.Equal(t, "jdoe", *remotes["one"].GetUser())
 	assert.Equal(t, "pk1", *remotes["one"].GetPrivateKey())
 	assert.Equal(t, "pp1", *remotes["one"].GetPassphrase())
+	assert.Nil(t, remotes["one"].GetKnownHosts())
+	assert.Nil(t, remotes["one"].GetStrictHostKeyChecking())
 	assert.Equal(t, ent.PUBLIC_KEY, *remotes["two"].GetAuthenticationMethod())
 	assert.Equal(t, "sct", *remotes["two"].GetPassword())
 	assert.Equal(t, "stiger", *remotes["two"].GetUser())
 	assert.Equal(t, "pk2", *remotes["two"].GetPrivateKey())
 	assert.Equal(t, "pp2", *remotes["two"].GetPassphrase())
+	assert.Equal(t, "kh2", *remotes["two"].GetKnownHosts())
+	assert.False(t, *remotes["two"].GetStrictHostKeyChecking())
+}
+
+func TestEnvironmentConfigurationLayerGetImpactAnalyzers(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	impactAnalyzers, err := environmentConfigurationLayer.GetImpactAnalyzers()
+	assert.NoError(t, err)
+	assert.NotNil(t, impactAnalyzers)
+	assert.Equal(t, 0, len(*impactAnalyzers.GetEnabled()))
+	assert.Equal(t, 0, len(*impactAnalyzers.GetItems()))
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_IMPACT_ANALYZERS_ENABLED=one,two",
+	})
+
+	impactAnalyzers, err = environmentConfigurationLayer.GetImpactAnalyzers()
+	assert.NoError(t, err)
+	assert.NotNil(t, impactAnalyzers)
+
+	enabled := *impactAnalyzers.GetEnabled()
+	items := *impactAnalyzers.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "one")
+	assert.Equal(t, *enabled[1], "two")
+	assert.Equal(t, 0, len(items))
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_IMPACT_ANALYZERS_ENABLED=one,two",
+		"NYX_IMPACT_ANALYZERS_one_BUMP=minor",
+		"NYX_IMPACT_ANALYZERS_one_COMMAND=apidiff -incompatible {{releaseScope.previousVersion}} HEAD",
+		"NYX_IMPACT_ANALYZERS_one_EXPRESSION=^Incompatible",
+		"NYX_IMPACT_ANALYZERS_one_TITLE=API changes",
+		"NYX_IMPACT_ANALYZERS_two_COMMAND=oasdiff breaking old.yaml new.yaml",
+	})
+
+	impactAnalyzers, err = environmentConfigurationLayer.GetImpactAnalyzers()
+	assert.NoError(t, err)
+	assert.NotNil(t, impactAnalyzers)
+
+	enabled = *impactAnalyzers.GetEnabled()
+	items = *impactAnalyzers.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, "minor", *items["one"].GetBump())
+	assert.Equal(t, "apidiff -incompatible {{releaseScope.previousVersion}} HEAD", *items["one"].GetCommand())
+	assert.Equal(t, "^Incompatible", *items["one"].GetExpression())
+	assert.Equal(t, "API changes", *items["one"].GetTitle())
+	assert.Nil(t, items["two"].GetBump())
+	assert.Equal(t, "oasdiff breaking old.yaml new.yaml", *items["two"].GetCommand())
+	assert.Nil(t, items["two"].GetExpression())
+	assert.Nil(t, items["two"].GetTitle())
 }
 
 func TestEnvironmentConfigurationLayerGetInitialVersion(t *testing.T) {
@@ -316,6 +725,169 @@ func TestEnvironmentConfigurationLayerGetInitialVersion(t *testing.T) {
 	assert.Equal(t, "0.3.5", *initialVersion)
 }
 
+func TestEnvironmentConfigurationLayerGetJUnitReportFile(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	junitReportFile, err := environmentConfigurationLayer.GetJUnitReportFile()
+	assert.NoError(t, err)
+	assert.Nil(t, junitReportFile)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_JUNIT_REPORT_FILE=report.xml",
+	})
+
+	junitReportFile, err = environmentConfigurationLayer.GetJUnitReportFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "report.xml", *junitReportFile)
+}
+
+func TestEnvironmentConfigurationLayerGetNothingToRelease(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	nothingToRelease, err := environmentConfigurationLayer.GetNothingToRelease()
+	assert.NoError(t, err)
+	assert.Nil(t, nothingToRelease)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_NOTHING_TO_RELEASE=" + ent.SKIP.String(),
+	})
+
+	nothingToRelease, err = environmentConfigurationLayer.GetNothingToRelease()
+	assert.NoError(t, err)
+	assert.Equal(t, ent.SKIP, *nothingToRelease)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_NOTHING_TO_RELEASE=NONE",
+	})
+
+	_, err = environmentConfigurationLayer.GetNothingToRelease()
+	assert.Error(t, err)
+}
+
+func TestEnvironmentConfigurationLayerGetOrganizationConfigurationRepository(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	organizationConfigurationRepository, err := environmentConfigurationLayer.GetOrganizationConfigurationRepository()
+	assert.NoError(t, err)
+	assert.Nil(t, organizationConfigurationRepository)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_ORGANIZATION_CONFIGURATION_REPOSITORY=acme/.nyx",
+	})
+
+	organizationConfigurationRepository, err = environmentConfigurationLayer.GetOrganizationConfigurationRepository()
+	assert.NoError(t, err)
+	assert.Equal(t, "acme/.nyx", *organizationConfigurationRepository)
+}
+
+func TestEnvironmentConfigurationLayerGetOrganizationConfigurationService(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	organizationConfigurationService, err := environmentConfigurationLayer.GetOrganizationConfigurationService()
+	assert.NoError(t, err)
+	assert.Nil(t, organizationConfigurationService)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_ORGANIZATION_CONFIGURATION_SERVICE=github",
+	})
+
+	organizationConfigurationService, err = environmentConfigurationLayer.GetOrganizationConfigurationService()
+	assert.NoError(t, err)
+	assert.Equal(t, "github", *organizationConfigurationService)
+}
+
+func TestEnvironmentConfigurationLayerGetPathRules(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	pathRules, err := environmentConfigurationLayer.GetPathRules()
+	assert.NoError(t, err)
+	assert.NotNil(t, pathRules)
+	assert.Equal(t, 0, len(*pathRules.GetEnabled()))
+	assert.Equal(t, 0, len(*pathRules.GetItems()))
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_PATH_RULES_ENABLED=api,docs",
+	})
+
+	pathRules, err = environmentConfigurationLayer.GetPathRules()
+	assert.NoError(t, err)
+	assert.NotNil(t, pathRules)
+
+	enabled := *pathRules.GetEnabled()
+	items := *pathRules.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, *enabled[0], "api")
+	assert.Equal(t, *enabled[1], "docs")
+	assert.Equal(t, 0, len(items))
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_PATH_RULES_ENABLED=api,docs",
+		"NYX_PATH_RULES_api_BUMP=minor",
+		"NYX_PATH_RULES_api_PATHS=api/**,proto/**",
+		"NYX_PATH_RULES_docs_PATHS=docs/**",
+		"NYX_PATH_RULES_docs_SIGNIFICANT=false",
+	})
+
+	pathRules, err = environmentConfigurationLayer.GetPathRules()
+	assert.NoError(t, err)
+	assert.NotNil(t, pathRules)
+
+	enabled = *pathRules.GetEnabled()
+	items = *pathRules.GetItems()
+	assert.Equal(t, 2, len(enabled))
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, "minor", *items["api"].GetBump())
+	assert.Equal(t, 2, len(*items["api"].GetPaths()))
+	assert.Equal(t, "api/**", *(*items["api"].GetPaths())[0])
+	assert.Equal(t, "proto/**", *(*items["api"].GetPaths())[1])
+	assert.Nil(t, items["api"].GetSignificant())
+	assert.Nil(t, items["docs"].GetBump())
+	assert.Equal(t, 1, len(*items["docs"].GetPaths()))
+	assert.Equal(t, "docs/**", *(*items["docs"].GetPaths())[0])
+	assert.False(t, *items["docs"].GetSignificant())
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_PATH_RULES_docs_SIGNIFICANT=notaboolean",
+	})
+
+	_, err = environmentConfigurationLayer.GetPathRules()
+	assert.Error(t, err)
+}
+
+func TestEnvironmentConfigurationLayerGetPrereleaseOrder(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	prereleaseOrder, err := environmentConfigurationLayer.GetPrereleaseOrder()
+	assert.NoError(t, err)
+	assert.Nil(t, prereleaseOrder)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_PRERELEASE_ORDER=dev,alpha,beta,rc",
+	})
+
+	prereleaseOrder, err = environmentConfigurationLayer.GetPrereleaseOrder()
+	assert.NoError(t, err)
+	assert.Equal(t, "dev,alpha,beta,rc", *prereleaseOrder)
+}
+
 func TestEnvironmentConfigurationLayerGetPreset(t *testing.T) {
 	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
 
@@ -364,10 +936,14 @@ func TestEnvironmentConfigurationLayerGetReleaseAssets(t *testing.T) {
 		"NYX_RELEASE_ASSETS_asset2_DESCRIPTION=Binary Asset",
 		"NYX_RELEASE_ASSETS_asset2_TYPE=application/octet-stream",
 		"NYX_RELEASE_ASSETS_asset2_PATH=asset.bin",
+		"NYX_RELEASE_ASSETS_asset3_FILE_NAME=asset-{{@os}}-{{@arch}}.tar.gz",
+		"NYX_RELEASE_ASSETS_asset3_PATH=dist/{{@os}}/{{@arch}}",
+		"NYX_RELEASE_ASSETS_asset3_ARCHIVE=tar.gz",
+		"NYX_RELEASE_ASSETS_asset3_PLATFORMS=linux/amd64,darwin/arm64",
 	})
 
 	releaseAssets, err = environmentConfigurationLayer.GetReleaseAssets()
-	assert.Equal(t, 2, len(*releaseAssets))
+	assert.Equal(t, 3, len(*releaseAssets))
 	assert.NotNil(t, (*releaseAssets)["asset1"])
 	assert.NotNil(t, (*releaseAssets)["asset2"])
 	assert.Equal(t, "asset.txt", *(*releaseAssets)["asset1"].GetFileName())
@@ -378,6 +954,30 @@ func TestEnvironmentConfigurationLayerGetReleaseAssets(t *testing.T) {
 	assert.Equal(t, "Binary Asset", *(*releaseAssets)["asset2"].GetDescription())
 	assert.Equal(t, "application/octet-stream", *(*releaseAssets)["asset2"].GetType())
 	assert.Equal(t, "asset.bin", *(*releaseAssets)["asset2"].GetPath())
+	assert.Nil(t, (*releaseAssets)["asset2"].GetArchive())
+	assert.Nil(t, (*releaseAssets)["asset2"].GetPlatforms())
+	assert.Equal(t, "asset-{{@os}}-{{@arch}}.tar.gz", *(*releaseAssets)["asset3"].GetFileName())
+	assert.Equal(t, "dist/{{@os}}/{{@arch}}", *(*releaseAssets)["asset3"].GetPath())
+	assert.Equal(t, "tar.gz", *(*releaseAssets)["asset3"].GetArchive())
+	assert.Equal(t, "linux/amd64,darwin/arm64", *(*releaseAssets)["asset3"].GetPlatforms())
+}
+
+func TestEnvironmentConfigurationLayerGetReleaseCoercion(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	releaseCoercion, err := environmentConfigurationLayer.GetReleaseCoercion()
+	assert.NoError(t, err)
+	assert.Nil(t, releaseCoercion)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_RELEASE_COERCION=true",
+	})
+
+	releaseCoercion, err = environmentConfigurationLayer.GetReleaseCoercion()
+	assert.NoError(t, err)
+	assert.Equal(t, true, *releaseCoercion)
 }
 
 func TestEnvironmentConfigurationLayerGetReleaseLenient(t *testing.T) {
@@ -426,6 +1026,7 @@ func TestEnvironmentConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Equal(t, 0, len(*releaseTypes.GetEnabled()))
 	assert.Equal(t, 0, len(*releaseTypes.GetPublicationServices()))
 	assert.Equal(t, 0, len(*releaseTypes.GetRemoteRepositories()))
+	assert.Equal(t, 0, len(*releaseTypes.GetYanked()))
 	assert.Equal(t, 0, len(*releaseTypes.GetItems()))
 
 	// get a new instance or a stale set of environment variables is still in the configuration layer
@@ -434,6 +1035,7 @@ func TestEnvironmentConfigurationLayerGetReleaseTypes(t *testing.T) {
 		"NYX_RELEASE_TYPES_ENABLED=one,two",
 		"NYX_RELEASE_TYPES_PUBLICATION_SERVICES=first,second",
 		"NYX_RELEASE_TYPES_REMOTE_REPOSITORIES=origin,replica",
+		"NYX_RELEASE_TYPES_YANKED=1.0.1,1.2.0",
 	})
 
 	releaseTypes, err = environmentConfigurationLayer.GetReleaseTypes()
@@ -446,6 +1048,9 @@ func TestEnvironmentConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Equal(t, 2, len(*releaseTypes.GetRemoteRepositories()))
 	assert.Equal(t, "origin", *(*releaseTypes.GetRemoteRepositories())[0])
 	assert.Equal(t, "replica", *(*releaseTypes.GetRemoteRepositories())[1])
+	assert.Equal(t, 2, len(*releaseTypes.GetYanked()))
+	assert.Equal(t, "1.0.1", *(*releaseTypes.GetYanked())[0])
+	assert.Equal(t, "1.2.0", *(*releaseTypes.GetYanked())[1])
 	assert.Equal(t, 0, len(*releaseTypes.GetItems()))
 
 	// get a new instance or a stale set of environment variables is still in the configuration layer
@@ -489,13 +1094,20 @@ func TestEnvironmentConfigurationLayerGetReleaseTypes(t *testing.T) {
 		"NYX_RELEASE_TYPES_one_MATCH_WORKSPACE_STATUS=" + ent.DIRTY.String(),
 		"NYX_RELEASE_TYPES_one_PUBLISH=false",
 		"NYX_RELEASE_TYPES_one_VERSION_RANGE=true",
+		"NYX_RELEASE_TYPES_two_BUILD_METADATA=sha.{{#short7}}{{releaseScope.finalCommit}}{{/short7}}",
+		"NYX_RELEASE_TYPES_two_BUILD_METADATA_TARGETS=STATE,CHANGELOG",
 		"NYX_RELEASE_TYPES_two_COLLAPSE_VERSIONS=false",
 		"NYX_RELEASE_TYPES_two_DESCRIPTION=description2",
 		"NYX_RELEASE_TYPES_two_FILTER_TAGS=filter2",
+		"NYX_RELEASE_TYPES_two_GATE_POLICY=state.branch == 'main'",
+		"NYX_RELEASE_TYPES_two_IGNORE_CHERRY_PICKS=true",
+		"NYX_RELEASE_TYPES_two_IGNORE_MERGES=true",
+		"NYX_RELEASE_TYPES_two_FOLLOW_ALL_PARENTS=true",
 		"NYX_RELEASE_TYPES_two_GIT_COMMIT=false",
 		"NYX_RELEASE_TYPES_two_GIT_COMMIT_MESSAGE=Commit message",
 		"NYX_RELEASE_TYPES_two_GIT_PUSH=false",
 		"NYX_RELEASE_TYPES_two_GIT_PUSH_FORCE=true",
+		"NYX_RELEASE_TYPES_two_GIT_PUSH_FORCE_WITH_LEASE=true",
 		"NYX_RELEASE_TYPES_two_GIT_TAG=false",
 		"NYX_RELEASE_TYPES_two_GIT_TAG_FORCE=true",
 		"NYX_RELEASE_TYPES_two_GIT_TAG_MESSAGE=Tag message",
@@ -509,13 +1121,29 @@ func TestEnvironmentConfigurationLayerGetReleaseTypes(t *testing.T) {
 		"NYX_RELEASE_TYPES_two_IDENTIFIERS_9_POSITION=" + ent.BUILD.String(),
 		"NYX_RELEASE_TYPES_two_IDENTIFIERS_9_QUALIFIER=q3",
 		"NYX_RELEASE_TYPES_two_IDENTIFIERS_9_VALUE=v3",
+		"NYX_RELEASE_TYPES_two_MATCH_BRANCH_METADATA_scope=^(api|cli)$",
+		"NYX_RELEASE_TYPES_two_BUMP_LABELS_breaking=major",
+		"NYX_RELEASE_TYPES_two_MATCH_CHANGED_PATHS=^api/.*$",
+		"NYX_RELEASE_TYPES_two_MATCH_DAYS_OF_WEEK=MONDAY,FRIDAY",
 		"NYX_RELEASE_TYPES_two_MATCH_ENVIRONMENT_VARIABLES_PATH=any path",
 		"NYX_RELEASE_TYPES_two_MATCH_ENVIRONMENT_VARIABLES_USER=any user",
+		"NYX_RELEASE_TYPES_two_MATCH_EXPRESSION=true",
+		"NYX_RELEASE_TYPES_two_MATCH_MODE=" + ent.ANY.String(),
+		"NYX_RELEASE_TYPES_two_MATCH_POLICY=state.bump == 'minor'",
+		"NYX_RELEASE_TYPES_two_MATCH_TAGS=^deploy-.*$",
 		"NYX_RELEASE_TYPES_two_MATCH_WORKSPACE_STATUS=" + ent.CLEAN.String(),
 		"NYX_RELEASE_TYPES_two_PUBLISH=true",
+		"NYX_RELEASE_TYPES_two_PUBLISH_APPROVAL_ENVIRONMENT=production",
+		"NYX_RELEASE_TYPES_two_PUBLISH_APPROVAL_POLLING_INTERVAL=10",
+		"NYX_RELEASE_TYPES_two_PUBLISH_APPROVAL_TIMEOUT=600",
 		"NYX_RELEASE_TYPES_two_PUBLISH_DRAFT=false",
 		"NYX_RELEASE_TYPES_two_PUBLISH_PRE_RELEASE=true",
+		"NYX_RELEASE_TYPES_two_PULL_REQUEST_MESSAGES=github",
+		"NYX_RELEASE_TYPES_two_RELEASE_METADATA_FILE=.nyx-release.json",
 		"NYX_RELEASE_TYPES_two_RELEASE_NAME=myrelease",
+		"NYX_RELEASE_TYPES_two_REQUIRED_ENVIRONMENT_VARIABLES=GITHUB_TOKEN, GPG_KEY",
+		"NYX_RELEASE_TYPES_two_REQUIRE_SIGNED_COMMITS=true",
+		"NYX_RELEASE_TYPES_two_VERSION_CONSTRAINT=>=1.2 <2.0",
 		"NYX_RELEASE_TYPES_two_VERSION_RANGE_FROM_BRANCH_NAME=true",
 	})
 
@@ -539,6 +1167,10 @@ func TestEnvironmentConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Equal(t, "qualifier1", *(*(*releaseTypes.GetItems())["one"]).GetCollapsedVersionQualifier())
 	assert.Equal(t, "description1", *(*(*releaseTypes.GetItems())["one"]).GetDescription())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetFilterTags())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetGatePolicy())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetIgnoreCherryPicks())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetIgnoreMerges())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetFollowAllParents())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["one"]).GetGitCommit())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetGitCommitMessage())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["one"]).GetGitTag())
@@ -547,14 +1179,33 @@ func TestEnvironmentConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetGitTagNames())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["one"]).GetGitPush())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetGitPushForce())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetGitPushForceWithLease())
 	assert.Equal(t, 0, len(*(*(*releaseTypes.GetItems())["one"]).GetIdentifiers()))
 	assert.Equal(t, "alpha,beta", *(*(*releaseTypes.GetItems())["one"]).GetMatchBranches())
+	assert.Equal(t, 0, len(*(*(*releaseTypes.GetItems())["one"]).GetMatchBranchMetadata()))
+	assert.Equal(t, 0, len(*(*(*releaseTypes.GetItems())["one"]).GetBumpLabels()))
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchChangedPaths())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchDaysOfWeek())
 	assert.Equal(t, 0, len(*(*(*releaseTypes.GetItems())["one"]).GetMatchEnvironmentVariables()))
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchExpression())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchPolicy())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchMode())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetMatchTags())
 	assert.Equal(t, ent.DIRTY, *(*(*releaseTypes.GetItems())["one"]).GetMatchWorkspaceStatus())
 	assert.Equal(t, "false", *(*(*releaseTypes.GetItems())["one"]).GetPublish())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPublishApprovalEnvironment())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPublishApprovalPollingInterval())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPublishApprovalTimeout())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPublishDraft())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPublishPreRelease())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetPullRequestMessages())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetReleaseMetadataFile())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetReleaseName())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetRequiredEnvironmentVariables())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetRequireSignedCommits())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetBuildMetadata())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetBuildMetadataTargets())
+	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetVersionConstraint())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["one"]).GetVersionRange())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["one"]).GetVersionRangeFromBranchName())
 	assert.Nil(t, (*(*releaseTypes).GetItems())["two"].GetAssets())
@@ -562,6 +1213,10 @@ func TestEnvironmentConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Nil(t, (*(*releaseTypes.GetItems())["two"]).GetCollapsedVersionQualifier())
 	assert.Equal(t, "description2", *(*(*releaseTypes.GetItems())["two"]).GetDescription())
 	assert.Equal(t, "filter2", *(*(*releaseTypes.GetItems())["two"]).GetFilterTags())
+	assert.Equal(t, "state.branch == 'main'", *(*(*releaseTypes.GetItems())["two"]).GetGatePolicy())
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetIgnoreCherryPicks())
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetIgnoreMerges())
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetFollowAllParents())
 	assert.Equal(t, "false", *(*(*releaseTypes.GetItems())["two"]).GetGitCommit())
 	assert.Equal(t, "Commit message", *(*(*releaseTypes.GetItems())["two"]).GetGitCommitMessage())
 	assert.Equal(t, "false", *(*(*releaseTypes.GetItems())["two"]).GetGitTag())
@@ -573,6 +1228,7 @@ func TestEnvironmentConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Equal(t, "three", *(*(*(*releaseTypes.GetItems())["two"]).GetGitTagNames())[2])
 	assert.Equal(t, "false", *(*(*releaseTypes.GetItems())["two"]).GetGitPush())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetGitPushForce())
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetGitPushForceWithLease())
 	assert.Equal(t, 3, len(*(*(*releaseTypes.GetItems())["two"]).GetIdentifiers()))
 	assert.Equal(t, ent.PRE_RELEASE, *(*(*(*releaseTypes.GetItems())["two"]).GetIdentifiers())[0].GetPosition())
 	assert.Equal(t, "q1", *(*(*(*releaseTypes.GetItems())["two"]).GetIdentifiers())[0].GetQualifier())
@@ -584,18 +1240,58 @@ func TestEnvironmentConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Equal(t, "q3", *(*(*(*releaseTypes.GetItems())["two"]).GetIdentifiers())[2].GetQualifier())
 	assert.Equal(t, "v3", *(*(*(*releaseTypes.GetItems())["two"]).GetIdentifiers())[2].GetValue())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["two"]).GetMatchBranches())
+	assert.Equal(t, 1, len(*(*(*releaseTypes.GetItems())["two"]).GetMatchBranchMetadata()))
+	assert.Equal(t, "^(api|cli)$", (*(*(*releaseTypes.GetItems())["two"]).GetMatchBranchMetadata())["scope"])
+	assert.Equal(t, 1, len(*(*(*releaseTypes.GetItems())["two"]).GetBumpLabels()))
+	assert.Equal(t, "major", (*(*(*releaseTypes.GetItems())["two"]).GetBumpLabels())["breaking"])
+	assert.Equal(t, "^api/.*$", *(*(*releaseTypes.GetItems())["two"]).GetMatchChangedPaths())
+	assert.Equal(t, "MONDAY,FRIDAY", *(*(*releaseTypes.GetItems())["two"]).GetMatchDaysOfWeek())
 	assert.Equal(t, 2, len(*(*(*releaseTypes.GetItems())["two"]).GetMatchEnvironmentVariables()))
 	assert.Equal(t, "any path", (*(*(*releaseTypes.GetItems())["two"]).GetMatchEnvironmentVariables())["PATH"])
 	assert.Equal(t, "any user", (*(*(*releaseTypes.GetItems())["two"]).GetMatchEnvironmentVariables())["USER"])
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetMatchExpression())
+	assert.Equal(t, "state.bump == 'minor'", *(*(*releaseTypes.GetItems())["two"]).GetMatchPolicy())
+	assert.Equal(t, ent.ANY, *(*(*releaseTypes.GetItems())["two"]).GetMatchMode())
+	assert.Equal(t, "^deploy-.*$", *(*(*releaseTypes.GetItems())["two"]).GetMatchTags())
 	assert.Equal(t, ent.CLEAN, *(*(*releaseTypes.GetItems())["two"]).GetMatchWorkspaceStatus())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetPublish())
+	assert.Equal(t, "production", *(*(*releaseTypes.GetItems())["two"]).GetPublishApprovalEnvironment())
+	assert.Equal(t, "10", *(*(*releaseTypes.GetItems())["two"]).GetPublishApprovalPollingInterval())
+	assert.Equal(t, "600", *(*(*releaseTypes.GetItems())["two"]).GetPublishApprovalTimeout())
 	assert.Equal(t, "false", *(*(*releaseTypes.GetItems())["two"]).GetPublishDraft())
 	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetPublishPreRelease())
+	assert.Equal(t, "github", *(*(*releaseTypes.GetItems())["two"]).GetPullRequestMessages())
+	assert.Equal(t, ".nyx-release.json", *(*(*releaseTypes.GetItems())["two"]).GetReleaseMetadataFile())
 	assert.Equal(t, "myrelease", *(*(*releaseTypes.GetItems())["two"]).GetReleaseName())
+	assert.Equal(t, 2, len(*(*(*releaseTypes.GetItems())["two"]).GetRequiredEnvironmentVariables()))
+	assert.Equal(t, "GITHUB_TOKEN", *(*(*(*releaseTypes.GetItems())["two"]).GetRequiredEnvironmentVariables())[0])
+	assert.Equal(t, "GPG_KEY", *(*(*(*releaseTypes.GetItems())["two"]).GetRequiredEnvironmentVariables())[1])
+	assert.Equal(t, "true", *(*(*releaseTypes.GetItems())["two"]).GetRequireSignedCommits())
+	assert.Equal(t, "sha.{{#short7}}{{releaseScope.finalCommit}}{{/short7}}", *(*(*releaseTypes.GetItems())["two"]).GetBuildMetadata())
+	assert.Equal(t, "STATE,CHANGELOG", *(*(*releaseTypes.GetItems())["two"]).GetBuildMetadataTargets())
+	assert.Equal(t, ">=1.2 <2.0", *(*(*releaseTypes.GetItems())["two"]).GetVersionConstraint())
 	assert.Nil(t, (*(*releaseTypes.GetItems())["two"]).GetVersionRange())
 	assert.True(t, *(*(*releaseTypes.GetItems())["two"]).GetVersionRangeFromBranchName())
 }
 
+func TestEnvironmentConfigurationLayerGetReportFile(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	reportFile, err := environmentConfigurationLayer.GetReportFile()
+	assert.NoError(t, err)
+	assert.Nil(t, reportFile)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_REPORT_FILE=report.html",
+	})
+
+	reportFile, err = environmentConfigurationLayer.GetReportFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "report.html", *reportFile)
+}
+
 func TestEnvironmentConfigurationLayerGetResume(t *testing.T) {
 	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
 
@@ -614,6 +1310,24 @@ func TestEnvironmentConfigurationLayerGetResume(t *testing.T) {
 	assert.Equal(t, true, *resume)
 }
 
+func TestEnvironmentConfigurationLayerGetRevisionBump(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	revisionBump, err := environmentConfigurationLayer.GetRevisionBump()
+	assert.NoError(t, err)
+	assert.Nil(t, revisionBump)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_REVISION_BUMP=patch",
+	})
+
+	revisionBump, err = environmentConfigurationLayer.GetRevisionBump()
+	assert.NoError(t, err)
+	assert.Equal(t, "patch", *revisionBump)
+}
+
 func TestEnvironmentConfigurationLayerGetScheme(t *testing.T) {
 	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
 
@@ -630,6 +1344,45 @@ func TestEnvironmentConfigurationLayerGetScheme(t *testing.T) {
 	scheme, err = environmentConfigurationLayer.GetScheme()
 	assert.NoError(t, err)
 	assert.Equal(t, ver.SEMVER, *scheme)
+
+	// schemes that have not been registered are rejected
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_SCHEME=UNREGISTERED",
+	})
+
+	_, err = environmentConfigurationLayer.GetScheme()
+	assert.Error(t, err)
+}
+
+func TestEnvironmentConfigurationLayerGetServer(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	server, err := environmentConfigurationLayer.GetServer()
+	assert.NoError(t, err)
+	assert.NotNil(t, server)
+	assert.Nil(t, server.GetAddress())
+	assert.Nil(t, server.GetBranches())
+	assert.Nil(t, server.GetCommand())
+	assert.Nil(t, server.GetSecret())
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_SERVER_ADDRESS=:9090",
+		"NYX_SERVER_BRANCHES=^(main|master)$",
+		"NYX_SERVER_COMMAND=mark",
+		"NYX_SERVER_SECRET=s3cr3t",
+	})
+
+	server, err = environmentConfigurationLayer.GetServer()
+	assert.NoError(t, err)
+	assert.NotNil(t, server)
+
+	assert.Equal(t, ":9090", *server.GetAddress())
+	assert.Equal(t, "^(main|master)$", *server.GetBranches())
+	assert.Equal(t, "mark", *server.GetCommand())
+	assert.Equal(t, "s3cr3t", *server.GetSecret())
 }
 
 func TestEnvironmentConfigurationLayerGetServices(t *testing.T) {
@@ -724,6 +1477,42 @@ func TestEnvironmentConfigurationLayerGetStateFile(t *testing.T) {
 	assert.Equal(t, "state.yml", *stateFile)
 }
 
+func TestEnvironmentConfigurationLayerGetStateFileSigningKey(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	stateFileSigningKey, err := environmentConfigurationLayer.GetStateFileSigningKey()
+	assert.NoError(t, err)
+	assert.Nil(t, stateFileSigningKey)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_STATE_FILE_SIGNING_KEY=secret",
+	})
+
+	stateFileSigningKey, err = environmentConfigurationLayer.GetStateFileSigningKey()
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", *stateFileSigningKey)
+}
+
+func TestEnvironmentConfigurationLayerGetStrictExitCodes(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	strictExitCodes, err := environmentConfigurationLayer.GetStrictExitCodes()
+	assert.NoError(t, err)
+	assert.Nil(t, strictExitCodes)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_STRICT_EXIT_CODES=true",
+	})
+
+	strictExitCodes, err = environmentConfigurationLayer.GetStrictExitCodes()
+	assert.NoError(t, err)
+	assert.Equal(t, true, *strictExitCodes)
+}
+
 func TestEnvironmentConfigurationLayerGetSubstitutions(t *testing.T) {
 	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
 
@@ -836,6 +1625,60 @@ func TestEnvironmentConfigurationLayerGetSummaryFile(t *testing.T) {
 	assert.Equal(t, "summary.txt", *summaryFile)
 }
 
+func TestEnvironmentConfigurationLayerGetTelemetryEndpoint(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	telemetryEndpoint, err := environmentConfigurationLayer.GetTelemetryEndpoint()
+	assert.NoError(t, err)
+	assert.Nil(t, telemetryEndpoint)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_TELEMETRY_ENDPOINT=https://telemetry.example.com/nyx",
+	})
+
+	telemetryEndpoint, err = environmentConfigurationLayer.GetTelemetryEndpoint()
+	assert.NoError(t, err)
+	assert.Equal(t, "https://telemetry.example.com/nyx", *telemetryEndpoint)
+}
+
+func TestEnvironmentConfigurationLayerGetTelemetryFile(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	telemetryFile, err := environmentConfigurationLayer.GetTelemetryFile()
+	assert.NoError(t, err)
+	assert.Nil(t, telemetryFile)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_TELEMETRY_FILE=telemetry.json",
+	})
+
+	telemetryFile, err = environmentConfigurationLayer.GetTelemetryFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "telemetry.json", *telemetryFile)
+}
+
+func TestEnvironmentConfigurationLayerGetTimestampSource(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	timestampSource, err := environmentConfigurationLayer.GetTimestampSource()
+	assert.NoError(t, err)
+	assert.Nil(t, timestampSource)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_TIMESTAMP_SOURCE=" + ent.COMMIT.String(),
+	})
+
+	timestampSource, err = environmentConfigurationLayer.GetTimestampSource()
+	assert.NoError(t, err)
+	assert.Equal(t, ent.COMMIT, *timestampSource)
+}
+
 func TestEnvironmentConfigurationLayerGetVerbosity(t *testing.T) {
 	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
 
@@ -871,3 +1714,21 @@ func TestEnvironmentConfigurationLayerGetVersion(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "3.5.7", *version)
 }
+
+func TestEnvironmentConfigurationLayerGetVersionStorageService(t *testing.T) {
+	environmentConfigurationLayer := EnvironmentConfigurationLayer{}
+
+	versionStorageService, err := environmentConfigurationLayer.GetVersionStorageService()
+	assert.NoError(t, err)
+	assert.Nil(t, versionStorageService)
+
+	// get a new instance or a stale set of environment variables is still in the configuration layer
+	environmentConfigurationLayer = EnvironmentConfigurationLayer{}
+	environmentConfigurationLayer.withEnvironmentVariables([]string{
+		"NYX_VERSION_STORAGE_SERVICE=bucket",
+	})
+
+	versionStorageService, err = environmentConfigurationLayer.GetVersionStorageService()
+	assert.NoError(t, err)
+	assert.Equal(t, "bucket", *versionStorageService)
+}
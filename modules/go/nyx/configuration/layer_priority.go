@@ -47,14 +47,17 @@ const (
 	// The layer that models a shared configuration file from a standard location
 	STANDARD_SHARED_FILE layerPriority = 7
 
+	// The layer that models the optional configuration discovered from the organization repository
+	ORGANIZATION_FILE layerPriority = 8
+
 	// The layer that models the optional preset configuration.
-	PRESET layerPriority = 8
+	PRESET layerPriority = 9
 
 	// The layer that models default options.
-	DEFAULT layerPriority = 9
+	DEFAULT layerPriority = 10
 
 	// The number of items in this enum type
-	LAYER_PRIORITY_LENGTH int = 10
+	LAYER_PRIORITY_LENGTH int = 11
 )
 
 /*
@@ -78,6 +81,8 @@ func (lp layerPriority) String() string {
 		return "CUSTOM_SHARED_FILE"
 	case STANDARD_SHARED_FILE:
 		return "STANDARD_SHARED_FILE"
+	case ORGANIZATION_FILE:
+		return "ORGANIZATION_FILE"
 	case PRESET:
 		return "PRESET"
 	case DEFAULT:
@@ -36,6 +36,7 @@ func TestLayerPriorityString(t *testing.T) {
 	assert.Equal(t, "STANDARD_LOCAL_FILE", STANDARD_LOCAL_FILE.String())
 	assert.Equal(t, "CUSTOM_SHARED_FILE", CUSTOM_SHARED_FILE.String())
 	assert.Equal(t, "STANDARD_SHARED_FILE", STANDARD_SHARED_FILE.String())
+	assert.Equal(t, "ORGANIZATION_FILE", ORGANIZATION_FILE.String())
 	assert.Equal(t, "PRESET", PRESET.String())
 	assert.Equal(t, "DEFAULT", DEFAULT.String())
 }
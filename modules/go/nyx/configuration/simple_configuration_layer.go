@@ -28,36 +28,83 @@ This structure is JSON and YAML aware so all objects are properly managed for ma
 as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
 */
 type SimpleConfigurationLayer struct {
+	// The path to the file where the analytics report about past releases is written as it's defined by this configuration. A nil value means undefined.
+	AnalyticsFile *string `json:"analyticsFile,omitempty" yaml:"analyticsFile,omitempty" handlebars:"analyticsFile"`
+
+	// The directory where the version and release date badges are written as it's defined by this configuration. A nil value means undefined.
+	BadgesDirectory *string `json:"badgesDirectory,omitempty" yaml:"badgesDirectory,omitempty" handlebars:"badgesDirectory"`
+
+	// The regular expression used to extract metadata from the current branch name as it's defined by this configuration. A nil value means undefined.
+	BranchMetadataExpression *string `json:"branchMetadataExpression,omitempty" yaml:"branchMetadataExpression,omitempty" handlebars:"branchMetadataExpression"`
+
 	// The version identifier to bump as it's defined by this configuration. A nil value means undefined.
 	Bump *string `json:"bump,omitempty" yaml:"bump,omitempty" handlebars:"bump"`
 
 	// The changelog configuration section.
 	Changelog *ent.ChangelogConfiguration `json:"changelog,omitempty" yaml:"changelog,omitempty" handlebars:"changelog"`
 
+	// The flag that enables computing the files changed by each commit in the release scope
+	// as it's defined by this configuration. A nil value means undefined.
+	CommitChanges *bool `json:"commitChanges,omitempty" yaml:"commitChanges,omitempty" handlebars:"commitChanges"`
+
 	// The commit message convention configuration section.
 	CommitMessageConventions *ent.CommitMessageConventions `json:"commitMessageConventions,omitempty" yaml:"commitMessageConventions,omitempty" handlebars:"commitMessageConventions"`
 
+	// The path to the file where the commit lint report is written in the SARIF format. A nil value means undefined.
+	CommitLintFile *string `json:"commitLintFile,omitempty" yaml:"commitLintFile,omitempty" handlebars:"commitLintFile"`
+
 	// The path to a custom configuration file as it's defined by this configuration. A nil value means undefined.
 	ConfigurationFile *string `json:"configurationFile,omitempty" yaml:"configurationFile,omitempty" handlebars:"configurationFile"`
 
 	// The directory to use as the working directory as it's defined by this configuration. A nil value means undefined.
 	Directory *string `json:"directory,omitempty" yaml:"directory,omitempty" handlebars:"directory"`
 
+	// The downstream updates configuration section.
+	DownstreamUpdates *ent.DownstreamUpdates `json:"downstreamUpdates,omitempty" yaml:"downstreamUpdates,omitempty" handlebars:"downstreamUpdates"`
+
 	// The value of the dry run flag as it's defined by this configuration. A nil value means undefined.
 	DryRun *bool `json:"dryRun,omitempty" yaml:"dryRun,omitempty" handlebars:"dryRun"`
 
+	// The event emitters configuration section.
+	EventBus *ent.EventBus `json:"eventBus,omitempty" yaml:"eventBus,omitempty" handlebars:"eventBus"`
+
 	// The Git configuration section.
 	Git *ent.GitConfiguration `json:"git,omitempty" yaml:"git,omitempty" handlebars:"git"`
 
+	// The impact analyzers configuration section.
+	ImpactAnalyzers *ent.ImpactAnalyzers `json:"impactAnalyzers,omitempty" yaml:"impactAnalyzers,omitempty" handlebars:"impactAnalyzers"`
+
 	// The the initial version defined by this configuration to use when no past version is available in the commit history. A nil value means undefined.
 	InitialVersion *string `json:"initialVersion,omitempty" yaml:"initialVersion,omitempty" handlebars:"initialVersion"`
 
+	// The path to the file where the JUnit XML release report must be saved as it's defined by this configuration. A nil value means undefined.
+	JUnitReportFile *string `json:"junitReportFile,omitempty" yaml:"junitReportFile,omitempty" handlebars:"junitReportFile"`
+
+	// The policy applied when there is nothing to release as it's defined by this configuration. A nil value means undefined.
+	NothingToRelease *ent.NothingToReleasePolicy `json:"nothingToRelease,omitempty" yaml:"nothingToRelease,omitempty" handlebars:"nothingToRelease"`
+
+	// The name of the repository the organization configuration is discovered from as it's defined by this configuration. A nil value means undefined.
+	OrganizationConfigurationRepository *string `json:"organizationConfigurationRepository,omitempty" yaml:"organizationConfigurationRepository,omitempty" handlebars:"organizationConfigurationRepository"`
+
+	// The name of the service used to discover the organization configuration as it's defined by this configuration. A nil value means undefined.
+	OrganizationConfigurationService *string `json:"organizationConfigurationService,omitempty" yaml:"organizationConfigurationService,omitempty" handlebars:"organizationConfigurationService"`
+
+	// The path rules configuration section.
+	PathRules *ent.PathRules `json:"pathRules,omitempty" yaml:"pathRules,omitempty" handlebars:"pathRules"`
+
+	// The comma separated list of pre-release identifiers, from the lowest to the highest precedence, as it's defined by this configuration. A nil value means undefined.
+	PrereleaseOrder *string `json:"prereleaseOrder,omitempty" yaml:"prereleaseOrder,omitempty" handlebars:"prereleaseOrder"`
+
 	// The selected preset configuration as it's defined by this configuration. A nil value means undefined.
 	Preset *string `json:"preset,omitempty" yaml:"preset,omitempty" handlebars:"preset"`
 
 	// The release assets configuration section
 	ReleaseAssets *map[string]*ent.Attachment `json:"releaseAssets,omitempty" yaml:"releaseAssets,omitempty" handlebars:"releaseAssets"`
 
+	// The flag that enables coercion of release names that look like semantic versions, but are not, into legal semantic versions
+	// as it's defined by this configuration. A nil value means undefined.
+	ReleaseCoercion *bool `json:"releaseCoercion,omitempty" yaml:"releaseCoercion,omitempty" handlebars:"releaseCoercion"`
+
 	// The flag that enables tolerance in reading release names with arbitrary prefixes or extra non critical characters
 	// as it's defined by this configuration. A nil value means undefined.
 	ReleaseLenient *bool `json:"releaseLenient,omitempty" yaml:"releaseLenient,omitempty" handlebars:"releaseLenient"`
@@ -68,12 +115,22 @@ type SimpleConfigurationLayer struct {
 	// The release types configuration section.
 	ReleaseTypes *ent.ReleaseTypes `json:"releaseTypes,omitempty" yaml:"releaseTypes,omitempty" handlebars:"releaseTypes"`
 
+	// The path to the file where the HTML release report must be saved as it's defined by this configuration. A nil value means undefined.
+	ReportFile *string `json:"reportFile,omitempty" yaml:"reportFile,omitempty" handlebars:"reportFile"`
+
 	// The value of the resume flag as it's defined by this configuration. A nil value means undefined.
 	Resume *bool `json:"resume,omitempty" yaml:"resume,omitempty" handlebars:"resume"`
 
+	// The name of the core identifier whose significance bumps the fourth number of four segment versions
+	// as it's defined by this configuration. A nil value means undefined.
+	RevisionBump *string `json:"revisionBump,omitempty" yaml:"revisionBump,omitempty" handlebars:"revisionBump"`
+
 	// The scheme defined by this configuration. A nil value means undefined.
 	Scheme *ver.Scheme `json:"scheme,omitempty" yaml:"scheme,omitempty" handlebars:"scheme"`
 
+	// The server configuration section.
+	Server *ent.ServerConfiguration `json:"server,omitempty" yaml:"server,omitempty" handlebars:"server"`
+
 	// The services configuration section
 	Services *map[string]*ent.ServiceConfiguration `json:"services,omitempty" yaml:"services,omitempty" handlebars:"services"`
 
@@ -83,6 +140,12 @@ type SimpleConfigurationLayer struct {
 	// The path to the file where the Nyx State must be saved as it's defined by this configuration. A nil value means undefined.
 	StateFile *string `json:"stateFile,omitempty" yaml:"stateFile,omitempty" handlebars:"stateFile"`
 
+	// The secret key used to sign the state file and verify it when resuming. A nil value means undefined.
+	StateFileSigningKey *string `json:"stateFileSigningKey,omitempty" yaml:"stateFileSigningKey,omitempty"`
+
+	// The value of the strict exit codes flag as it's defined by this configuration. A nil value means undefined.
+	StrictExitCodes *bool `json:"strictExitCodes,omitempty" yaml:"strictExitCodes,omitempty" handlebars:"strictExitCodes"`
+
 	// The substitutions configuration section.
 	Substitutions *ent.Substitutions `json:"substitutions,omitempty" yaml:"substitutions,omitempty" handlebars:"substitutions"`
 
@@ -92,11 +155,23 @@ type SimpleConfigurationLayer struct {
 	// The path to the file where the Nyx summary must be saved as it's defined by this configuration. A nil value means undefined.
 	SummaryFile *string `json:"summaryFile,omitempty" yaml:"summaryFile,omitempty" handlebars:"summaryFile"`
 
+	// The URL of the endpoint where the telemetry report must be uploaded as it's defined by this configuration. A nil value means undefined.
+	TelemetryEndpoint *string `json:"telemetryEndpoint,omitempty" yaml:"telemetryEndpoint,omitempty" handlebars:"telemetryEndpoint"`
+
+	// The path to the file where the telemetry report must be saved as it's defined by this configuration. A nil value means undefined.
+	TelemetryFile *string `json:"telemetryFile,omitempty" yaml:"telemetryFile,omitempty" handlebars:"telemetryFile"`
+
+	// The source of the release timestamp defined by this configuration. A nil value means undefined.
+	TimestampSource *ent.TimestampSource `json:"timestampSource,omitempty" yaml:"timestampSource,omitempty" handlebars:"timestampSource"`
+
 	// The verbosity defined by this configuration. A nil value means undefined.
 	Verbosity *ent.Verbosity `json:"verbosity,omitempty" yaml:"verbosity,omitempty" handlebars:"verbosity"`
 
 	// The version defined by this configuration. A nil value means undefined.
 	Version *string `json:"version,omitempty" yaml:"version,omitempty" handlebars:"version"`
+
+	// The name of the service used to read and store released versions as it's defined by this configuration. A nil value means undefined.
+	VersionStorageService *string `json:"versionStorageService,omitempty" yaml:"versionStorageService,omitempty" handlebars:"versionStorageService"`
 }
 
 /*
@@ -114,15 +189,74 @@ Loads default values on the target instance
 func (scl *SimpleConfigurationLayer) setDefaults() {
 	scl.Changelog = ent.NewChangelogConfiguration()
 	scl.CommitMessageConventions = ent.NewCommitMessageConventions()
+	scl.DownstreamUpdates = ent.NewDownstreamUpdates()
+	scl.EventBus = ent.NewEventBus()
 	scl.Git = ent.NewGitConfiguration()
+	scl.ImpactAnalyzers = ent.NewImpactAnalyzers()
+	scl.PathRules = ent.NewPathRules()
 	svra := make(map[string]*ent.Attachment)
 	scl.ReleaseAssets = &svra
 	scl.ReleaseTypes = ent.NewReleaseTypes()
+	scl.Server = ent.NewServerConfiguration()
 	svsc := make(map[string]*ent.ServiceConfiguration)
 	scl.Services = &svsc
 	scl.Substitutions = ent.NewSubstitutions()
 }
 
+/*
+Returns the path to the file where the analytics report about past releases is written as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetAnalyticsFile() (*string, error) {
+	return scl.AnalyticsFile, nil
+}
+
+/*
+Sets the path to the file where the analytics report about past releases is written as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetAnalyticsFile(analyticsFile *string) {
+	scl.AnalyticsFile = analyticsFile
+}
+
+/*
+Returns the directory where the version and release date badges are written as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetBadgesDirectory() (*string, error) {
+	return scl.BadgesDirectory, nil
+}
+
+/*
+Sets the directory where the version and release date badges are written as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetBadgesDirectory(badgesDirectory *string) {
+	scl.BadgesDirectory = badgesDirectory
+}
+
+/*
+Returns the regular expression used to extract metadata from the current branch name as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetBranchMetadataExpression() (*string, error) {
+	return scl.BranchMetadataExpression, nil
+}
+
+/*
+Sets the regular expression used to extract metadata from the current branch name as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetBranchMetadataExpression(branchMetadataExpression *string) {
+	scl.BranchMetadataExpression = branchMetadataExpression
+}
+
 /*
 Returns the version identifier to bump as it's defined by this configuration. A nil value means undefined.
 
@@ -159,6 +293,26 @@ func (scl *SimpleConfigurationLayer) SetChangelog(changelog *ent.ChangelogConfig
 	scl.Changelog = changelog
 }
 
+/*
+Returns the flag that enables computing the files changed by each commit in the release scope
+as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetCommitChanges() (*bool, error) {
+	return scl.CommitChanges, nil
+}
+
+/*
+Sets the flag that enables computing the files changed by each commit in the release scope
+as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetCommitChanges(commitChanges *bool) {
+	scl.CommitChanges = commitChanges
+}
+
 /*
 Returns the commit message convention configuration section.
 
@@ -177,6 +331,24 @@ func (scl *SimpleConfigurationLayer) SetCommitMessageConventions(commitMessageCo
 	scl.CommitMessageConventions = commitMessageConventions
 }
 
+/*
+Returns the path to the file where the commit lint report is written in the SARIF format as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetCommitLintFile() (*string, error) {
+	return scl.CommitLintFile, nil
+}
+
+/*
+Sets the path to the file where the commit lint report is written in the SARIF format as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetCommitLintFile(commitLintFile *string) {
+	scl.CommitLintFile = commitLintFile
+}
+
 /*
 Returns the path to a custom configuration file as it's defined by this configuration. A nil value means undefined.
 
@@ -213,6 +385,24 @@ func (scl *SimpleConfigurationLayer) SetDirectory(directory *string) {
 	scl.Directory = directory
 }
 
+/*
+Returns the downstream updates configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetDownstreamUpdates() (*ent.DownstreamUpdates, error) {
+	return scl.DownstreamUpdates, nil
+}
+
+/*
+Sets the downstream updates configuration section.
+*/
+func (scl *SimpleConfigurationLayer) SetDownstreamUpdates(downstreamUpdates *ent.DownstreamUpdates) {
+	scl.DownstreamUpdates = downstreamUpdates
+}
+
 /*
 Returns the value of the dry run flag as it's defined by this configuration. A nil value means undefined.
 
@@ -231,6 +421,24 @@ func (scl *SimpleConfigurationLayer) SetDryRun(dryRun *bool) {
 	scl.DryRun = dryRun
 }
 
+/*
+Returns the event emitters configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetEventBus() (*ent.EventBus, error) {
+	return scl.EventBus, nil
+}
+
+/*
+Sets the event emitters configuration section.
+*/
+func (scl *SimpleConfigurationLayer) SetEventBus(eventBus *ent.EventBus) {
+	scl.EventBus = eventBus
+}
+
 /*
 Returns the Git configuration section.
 
@@ -249,6 +457,24 @@ func (scl *SimpleConfigurationLayer) SetGit(git *ent.GitConfiguration) {
 	scl.Git = git
 }
 
+/*
+Returns the impact analyzers configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetImpactAnalyzers() (*ent.ImpactAnalyzers, error) {
+	return scl.ImpactAnalyzers, nil
+}
+
+/*
+Sets the impact analyzers configuration section.
+*/
+func (scl *SimpleConfigurationLayer) SetImpactAnalyzers(impactAnalyzers *ent.ImpactAnalyzers) {
+	scl.ImpactAnalyzers = impactAnalyzers
+}
+
 /*
 Returns the initial version defined by this configuration to use when no past version is available in the commit history. A nil value means undefined.
 
@@ -267,6 +493,114 @@ func (scl *SimpleConfigurationLayer) SetInitialVersion(initialVersion *string) {
 	scl.InitialVersion = initialVersion
 }
 
+/*
+Returns the path to the file where the JUnit XML release report must be saved as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetJUnitReportFile() (*string, error) {
+	return scl.JUnitReportFile, nil
+}
+
+/*
+Sets the path to the file where the JUnit XML release report must be saved as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetJUnitReportFile(junitReportFile *string) {
+	scl.JUnitReportFile = junitReportFile
+}
+
+/*
+Returns the policy applied when there is nothing to release as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetNothingToRelease() (*ent.NothingToReleasePolicy, error) {
+	return scl.NothingToRelease, nil
+}
+
+/*
+Sets the policy applied when there is nothing to release as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetNothingToRelease(nothingToRelease *ent.NothingToReleasePolicy) {
+	scl.NothingToRelease = nothingToRelease
+}
+
+/*
+Returns the name of the repository the organization configuration is discovered from as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetOrganizationConfigurationRepository() (*string, error) {
+	return scl.OrganizationConfigurationRepository, nil
+}
+
+/*
+Sets the name of the repository the organization configuration is discovered from as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetOrganizationConfigurationRepository(organizationConfigurationRepository *string) {
+	scl.OrganizationConfigurationRepository = organizationConfigurationRepository
+}
+
+/*
+Returns the name of the service used to discover the organization configuration as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetOrganizationConfigurationService() (*string, error) {
+	return scl.OrganizationConfigurationService, nil
+}
+
+/*
+Sets the name of the service used to discover the organization configuration as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetOrganizationConfigurationService(organizationConfigurationService *string) {
+	scl.OrganizationConfigurationService = organizationConfigurationService
+}
+
+/*
+Returns the path rules configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetPathRules() (*ent.PathRules, error) {
+	return scl.PathRules, nil
+}
+
+/*
+Sets the path rules configuration section.
+*/
+func (scl *SimpleConfigurationLayer) SetPathRules(pathRules *ent.PathRules) {
+	scl.PathRules = pathRules
+}
+
+/*
+Returns the comma separated list of pre-release identifiers, from the lowest to the highest precedence, used to compare pre-release versions as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetPrereleaseOrder() (*string, error) {
+	return scl.PrereleaseOrder, nil
+}
+
+/*
+Sets the comma separated list of pre-release identifiers, from the lowest to the highest precedence, used to compare pre-release versions as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetPrereleaseOrder(prereleaseOrder *string) {
+	scl.PrereleaseOrder = prereleaseOrder
+}
+
 /*
 Returns the selected preset configuration as it's defined by this configuration. A nil value means undefined.
 
@@ -303,6 +637,26 @@ func (scl *SimpleConfigurationLayer) SetReleaseAssets(releaseAssets *map[string]
 	scl.ReleaseAssets = releaseAssets
 }
 
+/*
+Returns the flag that enables coercion of release names that look like semantic versions, but are not, into legal semantic versions
+as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetReleaseCoercion() (*bool, error) {
+	return scl.ReleaseCoercion, nil
+}
+
+/*
+Sets the flag that enables coercion of release names that look like semantic versions, but are not, into legal semantic versions
+as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetReleaseCoercion(releaseCoercion *bool) {
+	scl.ReleaseCoercion = releaseCoercion
+}
+
 /*
 Returns the flag that enables tolerance in reading release names with arbitrary prefixes or extra non critical characters
 as it's defined by this configuration. A nil value means undefined.
@@ -359,6 +713,24 @@ func (scl *SimpleConfigurationLayer) SetReleaseTypes(releaseTypes *ent.ReleaseTy
 	scl.ReleaseTypes = releaseTypes
 }
 
+/*
+Returns the path to the file where the HTML release report must be saved as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetReportFile() (*string, error) {
+	return scl.ReportFile, nil
+}
+
+/*
+Sets the path to the file where the HTML release report must be saved as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetReportFile(reportFile *string) {
+	scl.ReportFile = reportFile
+}
+
 /*
 Returns the value of the resume flag as it's defined by this configuration. A nil value means undefined.
 
@@ -377,6 +749,26 @@ func (scl *SimpleConfigurationLayer) SetResume(resume *bool) {
 	scl.Resume = resume
 }
 
+/*
+Returns the name of the core identifier (major, minor or patch) whose significance bumps the fourth number
+of four segment versions as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetRevisionBump() (*string, error) {
+	return scl.RevisionBump, nil
+}
+
+/*
+Sets the name of the core identifier (major, minor or patch) whose significance bumps the fourth number
+of four segment versions as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetRevisionBump(revisionBump *string) {
+	scl.RevisionBump = revisionBump
+}
+
 /*
 Returns the versioning scheme as it's defined by this configuration. A nil value means undefined.
 
@@ -395,6 +787,24 @@ func (scl *SimpleConfigurationLayer) SetScheme(scheme *ver.Scheme) {
 	scl.Scheme = scheme
 }
 
+/*
+Returns the server configuration section.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetServer() (*ent.ServerConfiguration, error) {
+	return scl.Server, nil
+}
+
+/*
+Sets the server configuration section.
+*/
+func (scl *SimpleConfigurationLayer) SetServer(server *ent.ServerConfiguration) {
+	scl.Server = server
+}
+
 /*
 Returns the services configuration section. A nil value means undefined.
 
@@ -449,6 +859,42 @@ func (scl *SimpleConfigurationLayer) SetStateFile(stateFile *string) {
 	scl.StateFile = stateFile
 }
 
+/*
+Returns the secret key used to sign the state file and verify it when resuming as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetStateFileSigningKey() (*string, error) {
+	return scl.StateFileSigningKey, nil
+}
+
+/*
+Sets the secret key used to sign the state file and verify it when resuming as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetStateFileSigningKey(stateFileSigningKey *string) {
+	scl.StateFileSigningKey = stateFileSigningKey
+}
+
+/*
+Returns the value of the strict exit codes flag as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetStrictExitCodes() (*bool, error) {
+	return scl.StrictExitCodes, nil
+}
+
+/*
+Sets the value of the strict exit codes flag as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetStrictExitCodes(strictExitCodes *bool) {
+	scl.StrictExitCodes = strictExitCodes
+}
+
 /*
 Returns the substitutions configuration section.
 
@@ -503,6 +949,60 @@ func (scl *SimpleConfigurationLayer) SetSummaryFile(summaryFile *string) {
 	scl.SummaryFile = summaryFile
 }
 
+/*
+Returns the URL of the endpoint where the telemetry report must be uploaded as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetTelemetryEndpoint() (*string, error) {
+	return scl.TelemetryEndpoint, nil
+}
+
+/*
+Sets the URL of the endpoint where the telemetry report must be uploaded as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetTelemetryEndpoint(telemetryEndpoint *string) {
+	scl.TelemetryEndpoint = telemetryEndpoint
+}
+
+/*
+Returns the path to the file where the telemetry report must be saved as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetTelemetryFile() (*string, error) {
+	return scl.TelemetryFile, nil
+}
+
+/*
+Sets the path to the file where the telemetry report must be saved as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetTelemetryFile(telemetryFile *string) {
+	scl.TelemetryFile = telemetryFile
+}
+
+/*
+Returns the source of the release timestamp as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetTimestampSource() (*ent.TimestampSource, error) {
+	return scl.TimestampSource, nil
+}
+
+/*
+Sets the source of the release timestamp as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetTimestampSource(timestampSource *ent.TimestampSource) {
+	scl.TimestampSource = timestampSource
+}
+
 /*
 Returns the logging verbosity level as it's defined by this configuration. A nil value means undefined.
 
@@ -538,3 +1038,21 @@ Sets the version defined by this configuration. A nil value means undefined.
 func (scl *SimpleConfigurationLayer) SetVersion(version *string) {
 	scl.Version = version
 }
+
+/*
+Returns the name of the service used to read and store released versions as it's defined by this configuration. A nil value means undefined.
+
+Error is:
+- DataAccessError: in case the option cannot be read or accessed.
+- IllegalPropertyError: in case the option has been defined but has incorrect values or it can't be resolved.
+*/
+func (scl *SimpleConfigurationLayer) GetVersionStorageService() (*string, error) {
+	return scl.VersionStorageService, nil
+}
+
+/*
+Sets the name of the service used to read and store released versions as it's defined by this configuration. A nil value means undefined.
+*/
+func (scl *SimpleConfigurationLayer) SetVersionStorageService(versionStorageService *string) {
+	scl.VersionStorageService = versionStorageService
+}
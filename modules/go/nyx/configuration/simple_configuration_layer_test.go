@@ -44,6 +44,45 @@ func TestSimpleConfigurationLayerGetBump(t *testing.T) {
 	assert.Equal(t, "b", *bump)
 }
 
+func TestSimpleConfigurationLayerGetAnalyticsFile(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	analyticsFile, error := simpleConfigurationLayer.GetAnalyticsFile()
+	assert.NoError(t, error)
+	assert.Nil(t, analyticsFile)
+
+	simpleConfigurationLayer.SetAnalyticsFile(utl.PointerToString("analytics.json"))
+	analyticsFile, error = simpleConfigurationLayer.GetAnalyticsFile()
+	assert.NoError(t, error)
+	assert.Equal(t, "analytics.json", *analyticsFile)
+}
+
+func TestSimpleConfigurationLayerGetBadgesDirectory(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	badgesDirectory, error := simpleConfigurationLayer.GetBadgesDirectory()
+	assert.NoError(t, error)
+	assert.Nil(t, badgesDirectory)
+
+	simpleConfigurationLayer.SetBadgesDirectory(utl.PointerToString("badges"))
+	badgesDirectory, error = simpleConfigurationLayer.GetBadgesDirectory()
+	assert.NoError(t, error)
+	assert.Equal(t, "badges", *badgesDirectory)
+}
+
+func TestSimpleConfigurationLayerGetBranchMetadataExpression(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	branchMetadataExpression, error := simpleConfigurationLayer.GetBranchMetadataExpression()
+	assert.NoError(t, error)
+	assert.Nil(t, branchMetadataExpression)
+
+	simpleConfigurationLayer.SetBranchMetadataExpression(utl.PointerToString("^feature/(?<scope>[a-z]+)-.*$"))
+	branchMetadataExpression, error = simpleConfigurationLayer.GetBranchMetadataExpression()
+	assert.NoError(t, error)
+	assert.Equal(t, "^feature/(?<scope>[a-z]+)-.*$", *branchMetadataExpression)
+}
+
 func TestSimpleConfigurationLayerGetChangelogConfiguration(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -51,7 +90,7 @@ func TestSimpleConfigurationLayerGetChangelogConfiguration(t *testing.T) {
 	assert.NoError(t, error)
 	assert.NotNil(t, cc)
 
-	ccParam, _ := ent.NewChangelogConfigurationWith(nil, utl.PointerToString("CHANGELOG.md"), &map[string]string{"Section1": "regex1", "Section2": "regex2"}, utl.PointerToString("changelog.tpl"), &map[string]string{"Expression1": "string1"})
+	ccParam, _ := ent.NewChangelogConfigurationWith(nil, nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG.md"), &map[string]string{"Section1": "regex1", "Section2": "regex2"}, utl.PointerToString("changelog.tpl"), &map[string]string{"Expression1": "string1"}, nil)
 
 	simpleConfigurationLayer.SetChangelog(ccParam)
 	cc, error = simpleConfigurationLayer.GetChangelog()
@@ -62,6 +101,19 @@ func TestSimpleConfigurationLayerGetChangelogConfiguration(t *testing.T) {
 	assert.Equal(t, 1, len(*cc.GetSubstitutions()))
 }
 
+func TestSimpleConfigurationLayerGetCommitChanges(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	commitChanges, error := simpleConfigurationLayer.GetCommitChanges()
+	assert.NoError(t, error)
+	assert.Nil(t, commitChanges)
+
+	simpleConfigurationLayer.SetCommitChanges(utl.PointerToBoolean(true))
+	commitChanges, error = simpleConfigurationLayer.GetCommitChanges()
+	assert.NoError(t, error)
+	assert.Equal(t, true, *commitChanges)
+}
+
 func TestSimpleConfigurationLayerGetCommitMessageConventions(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -86,6 +138,19 @@ func TestSimpleConfigurationLayerGetCommitMessageConventions(t *testing.T) {
 	assert.Equal(t, 2, len(*cmc.GetItems()))
 }
 
+func TestSimpleConfigurationLayerGetCommitLintFile(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	commitLintFile, error := simpleConfigurationLayer.GetCommitLintFile()
+	assert.NoError(t, error)
+	assert.Nil(t, commitLintFile)
+
+	simpleConfigurationLayer.SetCommitLintFile(utl.PointerToString("commit-lint.sarif"))
+	commitLintFile, error = simpleConfigurationLayer.GetCommitLintFile()
+	assert.NoError(t, error)
+	assert.Equal(t, "commit-lint.sarif", *commitLintFile)
+}
+
 func TestSimpleConfigurationLayerGetConfigurationFile(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -112,6 +177,30 @@ func TestSimpleConfigurationLayerGetDirectory(t *testing.T) {
 	assert.Equal(t, "mydir", *directory)
 }
 
+func TestSimpleConfigurationLayerGetDownstreamUpdates(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	downstreamUpdates, error := simpleConfigurationLayer.GetDownstreamUpdates()
+	assert.NoError(t, error)
+	assert.NotNil(t, downstreamUpdates)
+
+	items := make(map[string]*ent.DownstreamUpdate)
+	items["one"] = ent.NewDownstreamUpdate()
+	items["two"] = ent.NewDownstreamUpdate()
+
+	enabled := []*string{utl.PointerToString("one"), utl.PointerToString("two")}
+
+	downstreamUpdatesParam, _ := ent.NewDownstreamUpdatesWith(&enabled, &items)
+
+	simpleConfigurationLayer.SetDownstreamUpdates(downstreamUpdatesParam)
+	downstreamUpdates, error = simpleConfigurationLayer.GetDownstreamUpdates()
+	assert.NoError(t, error)
+	assert.Equal(t, *downstreamUpdatesParam, *downstreamUpdates)
+
+	assert.Equal(t, 2, len(*downstreamUpdates.GetEnabled()))
+	assert.Equal(t, 2, len(*downstreamUpdates.GetItems()))
+}
+
 func TestSimpleConfigurationLayerGetDryRun(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -125,6 +214,30 @@ func TestSimpleConfigurationLayerGetDryRun(t *testing.T) {
 	assert.Equal(t, true, *dryRun)
 }
 
+func TestSimpleConfigurationLayerGetEventBus(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	eventBus, error := simpleConfigurationLayer.GetEventBus()
+	assert.NoError(t, error)
+	assert.NotNil(t, eventBus)
+
+	items := make(map[string]*ent.EventEmitter)
+	items["one"] = ent.NewEventEmitter()
+	items["two"] = ent.NewEventEmitter()
+
+	enabled := []*string{utl.PointerToString("one"), utl.PointerToString("two")}
+
+	eventBusParam, _ := ent.NewEventBusWith(&enabled, &items)
+
+	simpleConfigurationLayer.SetEventBus(eventBusParam)
+	eventBus, error = simpleConfigurationLayer.GetEventBus()
+	assert.NoError(t, error)
+	assert.Equal(t, *eventBusParam, *eventBus)
+
+	assert.Equal(t, 2, len(*eventBus.GetEnabled()))
+	assert.Equal(t, 2, len(*eventBus.GetItems()))
+}
+
 func TestSimpleConfigurationLayerGetGit(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -133,10 +246,10 @@ func TestSimpleConfigurationLayerGetGit(t *testing.T) {
 	assert.NotNil(t, git)
 
 	remotes := make(map[string]*ent.GitRemoteConfiguration)
-	remotes["origin1"] = ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("jdoe1"), utl.PointerToString("pwd1"), utl.PointerToString("pk1"), utl.PointerToString("pp1"))
-	remotes["origin2"] = ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe2"), utl.PointerToString("pwd2"), utl.PointerToString("pk2"), utl.PointerToString("pp2"))
+	remotes["origin1"] = ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("jdoe1"), utl.PointerToString("pwd1"), utl.PointerToString("pk1"), utl.PointerToString("pp1"), nil, nil, nil, nil, nil, nil, nil)
+	remotes["origin2"] = ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), utl.PointerToString("jdoe2"), utl.PointerToString("pwd2"), utl.PointerToString("pk2"), utl.PointerToString("pp2"), utl.PointerToString("kh2"), utl.PointerToBoolean(false), nil, nil, nil, nil, nil)
 
-	gitParam, _ := ent.NewGitConfigurationWith(&remotes)
+	gitParam, _ := ent.NewGitConfigurationWith(nil, nil, nil, &remotes, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	simpleConfigurationLayer.SetGit(gitParam)
 	git, error = simpleConfigurationLayer.GetGit()
@@ -156,6 +269,32 @@ func TestSimpleConfigurationLayerGetGit(t *testing.T) {
 	assert.Equal(t, "jdoe2", *remotes["origin2"].GetUser())
 	assert.Equal(t, "pk2", *remotes["origin2"].GetPrivateKey())
 	assert.Equal(t, "pp2", *remotes["origin2"].GetPassphrase())
+	assert.Equal(t, "kh2", *remotes["origin2"].GetKnownHosts())
+	assert.False(t, *remotes["origin2"].GetStrictHostKeyChecking())
+}
+
+func TestSimpleConfigurationLayerGetImpactAnalyzers(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	impactAnalyzers, error := simpleConfigurationLayer.GetImpactAnalyzers()
+	assert.NoError(t, error)
+	assert.NotNil(t, impactAnalyzers)
+
+	items := make(map[string]*ent.ImpactAnalyzer)
+	items["one"] = ent.NewImpactAnalyzer()
+	items["two"] = ent.NewImpactAnalyzer()
+
+	enabled := []*string{utl.PointerToString("one"), utl.PointerToString("two")}
+
+	impactAnalyzersParam, _ := ent.NewImpactAnalyzersWith(&enabled, &items)
+
+	simpleConfigurationLayer.SetImpactAnalyzers(impactAnalyzersParam)
+	impactAnalyzers, error = simpleConfigurationLayer.GetImpactAnalyzers()
+	assert.NoError(t, error)
+	assert.Equal(t, *impactAnalyzersParam, *impactAnalyzers)
+
+	assert.Equal(t, 2, len(*impactAnalyzers.GetEnabled()))
+	assert.Equal(t, 2, len(*impactAnalyzers.GetItems()))
 }
 
 func TestSimpleConfigurationLayerGetInitialVersion(t *testing.T) {
@@ -171,6 +310,95 @@ func TestSimpleConfigurationLayerGetInitialVersion(t *testing.T) {
 	assert.Equal(t, "0.3.5", *initialVersion)
 }
 
+func TestSimpleConfigurationLayerGetJUnitReportFile(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	junitReportFile, error := simpleConfigurationLayer.GetJUnitReportFile()
+	assert.NoError(t, error)
+	assert.Nil(t, junitReportFile)
+
+	simpleConfigurationLayer.SetJUnitReportFile(utl.PointerToString("report.xml"))
+	junitReportFile, error = simpleConfigurationLayer.GetJUnitReportFile()
+	assert.NoError(t, error)
+	assert.Equal(t, "report.xml", *junitReportFile)
+}
+
+func TestSimpleConfigurationLayerGetNothingToRelease(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	nothingToRelease, error := simpleConfigurationLayer.GetNothingToRelease()
+	assert.NoError(t, error)
+	assert.Nil(t, nothingToRelease)
+
+	simpleConfigurationLayer.SetNothingToRelease(ent.PointerToNothingToReleasePolicy(ent.SKIP))
+	nothingToRelease, error = simpleConfigurationLayer.GetNothingToRelease()
+	assert.NoError(t, error)
+	assert.Equal(t, ent.SKIP, *nothingToRelease)
+}
+
+func TestSimpleConfigurationLayerGetOrganizationConfigurationRepository(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	organizationConfigurationRepository, error := simpleConfigurationLayer.GetOrganizationConfigurationRepository()
+	assert.NoError(t, error)
+	assert.Nil(t, organizationConfigurationRepository)
+
+	simpleConfigurationLayer.SetOrganizationConfigurationRepository(utl.PointerToString("acme/.nyx"))
+	organizationConfigurationRepository, error = simpleConfigurationLayer.GetOrganizationConfigurationRepository()
+	assert.NoError(t, error)
+	assert.Equal(t, "acme/.nyx", *organizationConfigurationRepository)
+}
+
+func TestSimpleConfigurationLayerGetOrganizationConfigurationService(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	organizationConfigurationService, error := simpleConfigurationLayer.GetOrganizationConfigurationService()
+	assert.NoError(t, error)
+	assert.Nil(t, organizationConfigurationService)
+
+	simpleConfigurationLayer.SetOrganizationConfigurationService(utl.PointerToString("github"))
+	organizationConfigurationService, error = simpleConfigurationLayer.GetOrganizationConfigurationService()
+	assert.NoError(t, error)
+	assert.Equal(t, "github", *organizationConfigurationService)
+}
+
+func TestSimpleConfigurationLayerGetPathRules(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	pathRules, error := simpleConfigurationLayer.GetPathRules()
+	assert.NoError(t, error)
+	assert.NotNil(t, pathRules)
+
+	items := make(map[string]*ent.PathRule)
+	items["api"] = ent.NewPathRule()
+	items["docs"] = ent.NewPathRule()
+
+	enabled := []*string{utl.PointerToString("api"), utl.PointerToString("docs")}
+
+	pathRulesParam, _ := ent.NewPathRulesWith(&enabled, &items)
+
+	simpleConfigurationLayer.SetPathRules(pathRulesParam)
+	pathRules, error = simpleConfigurationLayer.GetPathRules()
+	assert.NoError(t, error)
+	assert.Equal(t, *pathRulesParam, *pathRules)
+
+	assert.Equal(t, 2, len(*pathRules.GetEnabled()))
+	assert.Equal(t, 2, len(*pathRules.GetItems()))
+}
+
+func TestSimpleConfigurationLayerGetPrereleaseOrder(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	prereleaseOrder, error := simpleConfigurationLayer.GetPrereleaseOrder()
+	assert.NoError(t, error)
+	assert.Nil(t, prereleaseOrder)
+
+	simpleConfigurationLayer.SetPrereleaseOrder(utl.PointerToString("dev,alpha,beta,rc"))
+	prereleaseOrder, error = simpleConfigurationLayer.GetPrereleaseOrder()
+	assert.NoError(t, error)
+	assert.Equal(t, "dev,alpha,beta,rc", *prereleaseOrder)
+}
+
 func TestSimpleConfigurationLayerGetPreset(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -214,6 +442,19 @@ func TestSimpleConfigurationLayerGetReleaseAssets(t *testing.T) {
 	assert.Equal(t, "asset.bin", *(*releaseAssets)["asset2"].GetPath())
 }
 
+func TestSimpleConfigurationLayerGetReleaseCoercion(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	releaseCoercion, error := simpleConfigurationLayer.GetReleaseCoercion()
+	assert.NoError(t, error)
+	assert.Nil(t, releaseCoercion)
+
+	simpleConfigurationLayer.SetReleaseCoercion(utl.PointerToBoolean(true))
+	releaseCoercion, error = simpleConfigurationLayer.GetReleaseCoercion()
+	assert.NoError(t, error)
+	assert.Equal(t, true, *releaseCoercion)
+}
+
 func TestSimpleConfigurationLayerGetReleaseLenient(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -271,6 +512,19 @@ func TestSimpleConfigurationLayerGetReleaseTypes(t *testing.T) {
 	assert.Nil(t, (*(*releaseTypes.GetItems())["two"]).GetAssets())
 }
 
+func TestSimpleConfigurationLayerGetReportFile(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	reportFile, error := simpleConfigurationLayer.GetReportFile()
+	assert.NoError(t, error)
+	assert.Nil(t, reportFile)
+
+	simpleConfigurationLayer.SetReportFile(utl.PointerToString("report.html"))
+	reportFile, error = simpleConfigurationLayer.GetReportFile()
+	assert.NoError(t, error)
+	assert.Equal(t, "report.html", *reportFile)
+}
+
 func TestSimpleConfigurationLayerGetResume(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -284,6 +538,19 @@ func TestSimpleConfigurationLayerGetResume(t *testing.T) {
 	assert.Equal(t, true, *resume)
 }
 
+func TestSimpleConfigurationLayerGetRevisionBump(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	revisionBump, error := simpleConfigurationLayer.GetRevisionBump()
+	assert.NoError(t, error)
+	assert.Nil(t, revisionBump)
+
+	simpleConfigurationLayer.SetRevisionBump(utl.PointerToString("patch"))
+	revisionBump, error = simpleConfigurationLayer.GetRevisionBump()
+	assert.NoError(t, error)
+	assert.Equal(t, "patch", *revisionBump)
+}
+
 func TestSimpleConfigurationLayerGetScheme(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -297,6 +564,21 @@ func TestSimpleConfigurationLayerGetScheme(t *testing.T) {
 	assert.Equal(t, ver.SEMVER, *scheme)
 }
 
+func TestSimpleConfigurationLayerGetServer(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	server, error := simpleConfigurationLayer.GetServer()
+	assert.NoError(t, error)
+	assert.NotNil(t, server)
+
+	serverParam := ent.NewServerConfigurationWith(utl.PointerToString(":9090"), utl.PointerToString("^(main|master)$"), utl.PointerToString("mark"), utl.PointerToString("s3cr3t"))
+
+	simpleConfigurationLayer.SetServer(serverParam)
+	server, error = simpleConfigurationLayer.GetServer()
+	assert.NoError(t, error)
+	assert.Equal(t, *serverParam, *server)
+}
+
 func TestSimpleConfigurationLayerGetServices(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -340,6 +622,32 @@ func TestSimpleConfigurationLayerGetStateFile(t *testing.T) {
 	assert.Equal(t, "state.yml", *stateFile)
 }
 
+func TestSimpleConfigurationLayerGetStateFileSigningKey(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	stateFileSigningKey, error := simpleConfigurationLayer.GetStateFileSigningKey()
+	assert.NoError(t, error)
+	assert.Nil(t, stateFileSigningKey)
+
+	simpleConfigurationLayer.SetStateFileSigningKey(utl.PointerToString("secret"))
+	stateFileSigningKey, error = simpleConfigurationLayer.GetStateFileSigningKey()
+	assert.NoError(t, error)
+	assert.Equal(t, "secret", *stateFileSigningKey)
+}
+
+func TestSimpleConfigurationLayerGetStrictExitCodes(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	strictExitCodes, error := simpleConfigurationLayer.GetStrictExitCodes()
+	assert.NoError(t, error)
+	assert.Nil(t, strictExitCodes)
+
+	simpleConfigurationLayer.SetStrictExitCodes(utl.PointerToBoolean(true))
+	strictExitCodes, error = simpleConfigurationLayer.GetStrictExitCodes()
+	assert.NoError(t, error)
+	assert.Equal(t, true, *strictExitCodes)
+}
+
 func TestSimpleConfigurationLayerGetSubstitutions(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -390,6 +698,45 @@ func TestSimpleConfigurationLayerGetSummaryFile(t *testing.T) {
 	assert.Equal(t, "summary.txt", *summaryFile)
 }
 
+func TestSimpleConfigurationLayerGetTelemetryEndpoint(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	telemetryEndpoint, error := simpleConfigurationLayer.GetTelemetryEndpoint()
+	assert.NoError(t, error)
+	assert.Nil(t, telemetryEndpoint)
+
+	simpleConfigurationLayer.SetTelemetryEndpoint(utl.PointerToString("https://telemetry.example.com/nyx"))
+	telemetryEndpoint, error = simpleConfigurationLayer.GetTelemetryEndpoint()
+	assert.NoError(t, error)
+	assert.Equal(t, "https://telemetry.example.com/nyx", *telemetryEndpoint)
+}
+
+func TestSimpleConfigurationLayerGetTelemetryFile(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	telemetryFile, error := simpleConfigurationLayer.GetTelemetryFile()
+	assert.NoError(t, error)
+	assert.Nil(t, telemetryFile)
+
+	simpleConfigurationLayer.SetTelemetryFile(utl.PointerToString("telemetry.json"))
+	telemetryFile, error = simpleConfigurationLayer.GetTelemetryFile()
+	assert.NoError(t, error)
+	assert.Equal(t, "telemetry.json", *telemetryFile)
+}
+
+func TestSimpleConfigurationLayerGetTimestampSource(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	timestampSource, error := simpleConfigurationLayer.GetTimestampSource()
+	assert.NoError(t, error)
+	assert.Nil(t, timestampSource)
+
+	simpleConfigurationLayer.SetTimestampSource(ent.PointerToTimestampSource(ent.COMMIT))
+	timestampSource, error = simpleConfigurationLayer.GetTimestampSource()
+	assert.NoError(t, error)
+	assert.Equal(t, ent.COMMIT, *timestampSource)
+}
+
 func TestSimpleConfigurationLayerGetVerbosity(t *testing.T) {
 	simpleConfigurationLayer := NewSimpleConfigurationLayer()
 
@@ -415,3 +762,16 @@ func TestSimpleConfigurationLayerGetVersion(t *testing.T) {
 	assert.NoError(t, error)
 	assert.Equal(t, "3.5.7", *version)
 }
+
+func TestSimpleConfigurationLayerGetVersionStorageService(t *testing.T) {
+	simpleConfigurationLayer := NewSimpleConfigurationLayer()
+
+	versionStorageService, error := simpleConfigurationLayer.GetVersionStorageService()
+	assert.NoError(t, error)
+	assert.Nil(t, versionStorageService)
+
+	simpleConfigurationLayer.SetVersionStorageService(utl.PointerToString("bucket"))
+	versionStorageService, error = simpleConfigurationLayer.GetVersionStorageService()
+	assert.NoError(t, error)
+	assert.Equal(t, "bucket", *versionStorageService)
+}
@@ -33,6 +33,15 @@ type Attachment struct {
 
 	// The attachment MIME type.
 	Type *string `json:"type,omitempty" yaml:"type,omitempty"`
+
+	// The format of the archive to build from the attachment path, when the path is a directory to be archived.
+	Archive *string `json:"archive,omitempty" yaml:"archive,omitempty"`
+
+	// The comma separated list of platforms (in the <os>/<arch> form) to build one attachment for.
+	Platforms *string `json:"platforms,omitempty" yaml:"platforms,omitempty"`
+
+	// The SHA-256 digest (hex encoded) of the attachment content, when the attachment is a local file.
+	Digest *string `json:"digest,omitempty" yaml:"digest,omitempty"`
 }
 
 /*
@@ -111,3 +120,45 @@ Sets the attachment path (local file or URL).
 func (a *Attachment) SetType(attachmentType *string) {
 	a.Type = attachmentType
 }
+
+/*
+Returns the format of the archive to build from the attachment path, when the path is a directory to be archived.
+*/
+func (a *Attachment) GetArchive() *string {
+	return a.Archive
+}
+
+/*
+Sets the format of the archive to build from the attachment path, when the path is a directory to be archived.
+*/
+func (a *Attachment) SetArchive(archive *string) {
+	a.Archive = archive
+}
+
+/*
+Returns the comma separated list of platforms (in the <os>/<arch> form) to build one attachment for.
+*/
+func (a *Attachment) GetPlatforms() *string {
+	return a.Platforms
+}
+
+/*
+Sets the comma separated list of platforms (in the <os>/<arch> form) to build one attachment for.
+*/
+func (a *Attachment) SetPlatforms(platforms *string) {
+	a.Platforms = platforms
+}
+
+/*
+Returns the SHA-256 digest (hex encoded) of the attachment content, when the attachment is a local file.
+*/
+func (a *Attachment) GetDigest() *string {
+	return a.Digest
+}
+
+/*
+Sets the SHA-256 digest (hex encoded) of the attachment content, when the attachment is a local file.
+*/
+func (a *Attachment) SetDigest(digest *string) {
+	a.Digest = digest
+}
@@ -73,3 +73,30 @@ func TestAttachmentGetType(t *testing.T) {
 	tt := a.GetType()
 	assert.Equal(t, "t1", *tt)
 }
+
+func TestAttachmentGetArchive(t *testing.T) {
+	a := &Attachment{}
+
+	assert.Nil(t, a.GetArchive())
+	a.SetArchive(utl.PointerToString("tar.gz"))
+	ar := a.GetArchive()
+	assert.Equal(t, "tar.gz", *ar)
+}
+
+func TestAttachmentGetPlatforms(t *testing.T) {
+	a := &Attachment{}
+
+	assert.Nil(t, a.GetPlatforms())
+	a.SetPlatforms(utl.PointerToString("linux/amd64,darwin/arm64"))
+	pl := a.GetPlatforms()
+	assert.Equal(t, "linux/amd64,darwin/arm64", *pl)
+}
+
+func TestAttachmentGetDigest(t *testing.T) {
+	a := &Attachment{}
+
+	assert.Nil(t, a.GetDigest())
+	a.SetDigest(utl.PointerToString("ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"))
+	d := a.GetDigest()
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", *d)
+}
@@ -30,9 +30,15 @@ This type maps allowed values for autentication methods.
 type AuthenticationMethod string
 
 const (
+	// GitHub App installation tokens, minted on the fly from the App ID and private key.
+	GITHUB_APP AuthenticationMethod = "GITHUB_APP"
+
 	// Public key authentication (SSH).
 	PUBLIC_KEY AuthenticationMethod = "PUBLIC_KEY"
 
+	// A single token, passed as the user name or the password depending on the provider hosting the remote.
+	TOKEN AuthenticationMethod = "TOKEN"
+
 	// User name and password.
 	USER_PASSWORD AuthenticationMethod = "USER_PASSWORD"
 )
@@ -42,8 +48,12 @@ Returns the string representation of the authentication method
 */
 func (am AuthenticationMethod) String() string {
 	switch am {
+	case GITHUB_APP:
+		return "GITHUB_APP"
 	case PUBLIC_KEY:
 		return "PUBLIC_KEY"
+	case TOKEN:
+		return "TOKEN"
 	case USER_PASSWORD:
 		return "USER_PASSWORD"
 	default:
@@ -61,8 +71,12 @@ Errors can be:
 */
 func ValueOfAuthenticationMethod(s string) (AuthenticationMethod, error) {
 	switch s {
+	case "GITHUB_APP":
+		return GITHUB_APP, nil
 	case "PUBLIC_KEY":
 		return PUBLIC_KEY, nil
+	case "TOKEN":
+		return TOKEN, nil
 	case "USER_PASSWORD":
 		return USER_PASSWORD, nil
 	default:
@@ -28,14 +28,22 @@ import (
 )
 
 func TestAuthenticationMethodString(t *testing.T) {
+	assert.Equal(t, "GITHUB_APP", GITHUB_APP.String())
 	assert.Equal(t, "PUBLIC_KEY", PUBLIC_KEY.String())
+	assert.Equal(t, "TOKEN", TOKEN.String())
 	assert.Equal(t, "USER_PASSWORD", USER_PASSWORD.String())
 }
 
 func TestAuthenticationMethodValueOfAuthenticationMethod(t *testing.T) {
-	authenticationMethod, err := ValueOfAuthenticationMethod("PUBLIC_KEY")
+	authenticationMethod, err := ValueOfAuthenticationMethod("GITHUB_APP")
+	assert.NoError(t, err)
+	assert.Equal(t, GITHUB_APP, authenticationMethod)
+	authenticationMethod, err = ValueOfAuthenticationMethod("PUBLIC_KEY")
 	assert.NoError(t, err)
 	assert.Equal(t, PUBLIC_KEY, authenticationMethod)
+	authenticationMethod, err = ValueOfAuthenticationMethod("TOKEN")
+	assert.NoError(t, err)
+	assert.Equal(t, TOKEN, authenticationMethod)
 	authenticationMethod, err = ValueOfAuthenticationMethod("USER_PASSWORD")
 	assert.NoError(t, err)
 	assert.Equal(t, USER_PASSWORD, authenticationMethod)
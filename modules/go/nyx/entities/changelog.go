@@ -77,11 +77,20 @@ type Release struct {
 	// The release date attribute
 	Date *string `json:"date,omitempty" yaml:"date,omitempty"`
 
+	// The reports of the impact analyzers that detected an impact in the release.
+	ImpactReports []*ImpactReport `json:"impactReports,omitempty" yaml:"impactReports,omitempty"`
+
 	// The release name attribute
 	Name *string `json:"name,omitempty" yaml:"name,omitempty"`
 
+	// The pre-releases merged into this release, each with its own sections.
+	PreReleases []*Release `json:"preReleases,omitempty" yaml:"preReleases,omitempty"`
+
 	// The changelog release sections.
 	Sections []*Section `json:"sections,omitempty" yaml:"sections,omitempty"`
+
+	// The notes with the migration guidance for the release.
+	UpgradeNotes *string `json:"upgradeNotes,omitempty" yaml:"upgradeNotes,omitempty"`
 }
 
 /*
@@ -127,6 +136,20 @@ func (r *Release) SetDate(date *string) {
 	r.Date = date
 }
 
+/*
+Returns the reports of the impact analyzers that detected an impact in the release.
+*/
+func (r *Release) GetImpactReports() []*ImpactReport {
+	return r.ImpactReports
+}
+
+/*
+Sets the reports of the impact analyzers that detected an impact in the release.
+*/
+func (r *Release) SetImpactReports(impactReports []*ImpactReport) {
+	r.ImpactReports = impactReports
+}
+
 /*
 Returns the release name.
 */
@@ -141,6 +164,20 @@ func (r *Release) SetName(name *string) {
 	r.Name = name
 }
 
+/*
+Returns the pre-releases merged into this release, each with its own sections.
+*/
+func (r *Release) GetPreReleases() []*Release {
+	return r.PreReleases
+}
+
+/*
+Sets the pre-releases merged into this release, each with its own sections.
+*/
+func (r *Release) SetPreReleases(preReleases []*Release) {
+	r.PreReleases = preReleases
+}
+
 /*
 Returns the changelog release sections.
 */
@@ -180,6 +217,20 @@ func (r *Release) SetSections(sections []*Section) {
 	r.Sections = sections
 }
 
+/*
+Returns the notes with the migration guidance for the release.
+*/
+func (r *Release) GetUpgradeNotes() *string {
+	return r.UpgradeNotes
+}
+
+/*
+Sets the notes with the migration guidance for the release.
+*/
+func (r *Release) SetUpgradeNotes(upgradeNotes *string) {
+	r.UpgradeNotes = upgradeNotes
+}
+
 /*
 This object models a single section in a changelog release.
 
@@ -192,6 +243,15 @@ type Section struct {
 
 	// The changelog section commits.
 	Commits []*gitent.Commit `json:"commits,omitempty" yaml:"commits,omitempty"`
+
+	// The section badge attribute
+	Badge *string `json:"badge,omitempty" yaml:"badge,omitempty"`
+
+	// The section collapsed flag
+	Collapsed bool `json:"collapsed,omitempty" yaml:"collapsed,omitempty"`
+
+	// The section emoji attribute
+	Emoji *string `json:"emoji,omitempty" yaml:"emoji,omitempty"`
 }
 
 /*
@@ -253,3 +313,45 @@ Sets the section commits.
 func (s *Section) SetCommits(commits []*gitent.Commit) {
 	s.Commits = commits
 }
+
+/*
+Returns the section badge, shown next to the section title.
+*/
+func (s *Section) GetBadge() *string {
+	return s.Badge
+}
+
+/*
+Sets the section badge, shown next to the section title.
+*/
+func (s *Section) SetBadge(badge *string) {
+	s.Badge = badge
+}
+
+/*
+Returns true if the list of commits in the section is collapsed.
+*/
+func (s *Section) GetCollapsed() bool {
+	return s.Collapsed
+}
+
+/*
+Sets the flag telling if the list of commits in the section is collapsed.
+*/
+func (s *Section) SetCollapsed(collapsed bool) {
+	s.Collapsed = collapsed
+}
+
+/*
+Returns the section emoji, used as a prefix for the section title.
+*/
+func (s *Section) GetEmoji() *string {
+	return s.Emoji
+}
+
+/*
+Sets the section emoji, used as a prefix for the section title.
+*/
+func (s *Section) SetEmoji(emoji *string) {
+	s.Emoji = emoji
+}
@@ -32,6 +32,24 @@ type ChangelogConfiguration struct {
 	// The flag instructing if and when to append contents to the existing changelog file.
 	Append *string `json:"append,omitempty" yaml:"append,omitempty"`
 
+	// The map of sections and badges to show next to section titles.
+	Badges *map[string]string `json:"badges,omitempty" yaml:"badges,omitempty"`
+
+	// The number of commits above which the list of commits in a section is collapsed.
+	CollapseThreshold *int `json:"collapseThreshold,omitempty" yaml:"collapseThreshold,omitempty"`
+
+	// The flag telling whether commits cherry-picked from versions released on other branches are left out of the changelog.
+	DeduplicateCherryPicks *bool `json:"deduplicateCherryPicks,omitempty" yaml:"deduplicateCherryPicks,omitempty"`
+
+	// The map of sections and emojis to prefix section titles with.
+	Emojis *map[string]string `json:"emojis,omitempty" yaml:"emojis,omitempty"`
+
+	// The flag telling whether dependency updates from bots (like Dependabot and Renovate) are grouped in one entry per ecosystem.
+	GroupDependencyUpdates *bool `json:"groupDependencyUpdates,omitempty" yaml:"groupDependencyUpdates,omitempty"`
+
+	// The way the scopes of pre-releases are merged into the changelog of the final release that follows them.
+	MergePreReleases *PreReleaseMerge `json:"mergePreReleases,omitempty" yaml:"mergePreReleases,omitempty"`
+
 	// The path to the destination file.
 	Path *string `json:"path,omitempty" yaml:"path,omitempty"`
 
@@ -43,6 +61,9 @@ type ChangelogConfiguration struct {
 
 	// The path to the optional template file.
 	Template *string `json:"template,omitempty" yaml:"template,omitempty"`
+
+	// The template rendering the path to the file with the upgrade notes of the release.
+	UpgradeNotes *string `json:"upgradeNotes,omitempty" yaml:"upgradeNotes,omitempty"`
 }
 
 /*
@@ -51,8 +72,12 @@ Default constructor
 func NewChangelogConfiguration() *ChangelogConfiguration {
 	cl := ChangelogConfiguration{}
 
+	badges := make(map[string]string)
+	emojis := make(map[string]string)
 	sections := make(map[string]string)
 	substitutions := make(map[string]string)
+	cl.Badges = &badges
+	cl.Emojis = &emojis
 	cl.Sections = &sections
 	cl.Substitutions = &substitutions
 
@@ -65,16 +90,23 @@ Standard constructor.
 Arguments are as follows:
 
 - append the flag instructing if and when to append contents to the existing changelog file. It may be nil
+- badges the map of sections and badges to show next to section titles.
+- collapseThreshold the number of commits above which the list of commits in a section is collapsed. It may be nil
+- deduplicateCherryPicks the flag telling whether commits cherry-picked from versions released on other branches are left out of the changelog. It may be nil
+- emojis the map of sections and emojis to prefix section titles with.
+- groupDependencyUpdates the flag telling whether dependency updates from bots (like Dependabot and Renovate) are grouped in one entry per ecosystem. It may be nil
+- mergePreReleases the way the scopes of pre-releases are merged into the changelog of the final release that follows them. It may be nil
 - path the path to the destination file. It may be nil
 - sections the map of sections and commit types.
 - template the path to the optional template file. It may be nil
 - substitutions the map of substitution strings.
+- upgradeNotes the template rendering the path to the file with the upgrade notes of the release. It may be nil
 
 Errors can be:
 
 - NilPointerError in case sections is nil
 */
-func NewChangelogConfigurationWith(append *string, path *string, sections *map[string]string, template *string, substitutions *map[string]string) (*ChangelogConfiguration, error) {
+func NewChangelogConfigurationWith(append *string, badges *map[string]string, collapseThreshold *int, deduplicateCherryPicks *bool, emojis *map[string]string, groupDependencyUpdates *bool, mergePreReleases *PreReleaseMerge, path *string, sections *map[string]string, template *string, substitutions *map[string]string, upgradeNotes *string) (*ChangelogConfiguration, error) {
 	cl := ChangelogConfiguration{}
 
 	if sections == nil {
@@ -82,11 +114,26 @@ func NewChangelogConfigurationWith(append *string, path *string, sections *map[s
 	}
 
 	cl.Append = append
+	cl.Badges = badges
+	cl.CollapseThreshold = collapseThreshold
+	cl.DeduplicateCherryPicks = deduplicateCherryPicks
+	cl.Emojis = emojis
+	cl.GroupDependencyUpdates = groupDependencyUpdates
+	cl.MergePreReleases = mergePreReleases
 	cl.Path = path
 	cl.Sections = sections
 	cl.Substitutions = substitutions
 	cl.Template = template
+	cl.UpgradeNotes = upgradeNotes
 
+	if cl.Badges == nil {
+		b := make(map[string]string)
+		cl.Badges = &b
+	}
+	if cl.Emojis == nil {
+		e := make(map[string]string)
+		cl.Emojis = &e
+	}
 	if cl.Sections == nil {
 		s := make(map[string]string)
 		cl.Sections = &s
@@ -118,6 +165,126 @@ func (cl *ChangelogConfiguration) SetAppend(append *string) error {
 	return nil
 }
 
+/*
+Returns the map of sections and badges to show next to section titles.
+*/
+func (cl *ChangelogConfiguration) GetBadges() *map[string]string {
+	return cl.Badges
+}
+
+/*
+Sets the map of sections and badges to show next to section titles.
+
+Errors can be:
+
+- NilPointerError in case the given parameter is nil
+*/
+func (cl *ChangelogConfiguration) SetBadges(badges *map[string]string) error {
+	if badges == nil {
+		return &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "badges")}
+	}
+	cl.Badges = badges
+	return nil
+}
+
+/*
+Returns the number of commits above which the list of commits in a section is collapsed.
+*/
+func (cl *ChangelogConfiguration) GetCollapseThreshold() *int {
+	return cl.CollapseThreshold
+}
+
+/*
+Sets the number of commits above which the list of commits in a section is collapsed.
+
+Errors can be:
+
+- none
+*/
+func (cl *ChangelogConfiguration) SetCollapseThreshold(collapseThreshold *int) error {
+	cl.CollapseThreshold = collapseThreshold
+	return nil
+}
+
+/*
+Returns the flag telling whether commits cherry-picked from versions released on other branches are left out of the changelog.
+*/
+func (cl *ChangelogConfiguration) GetDeduplicateCherryPicks() *bool {
+	return cl.DeduplicateCherryPicks
+}
+
+/*
+Sets the flag telling whether commits cherry-picked from versions released on other branches are left out of the changelog.
+
+Errors can be:
+
+- none
+*/
+func (cl *ChangelogConfiguration) SetDeduplicateCherryPicks(deduplicateCherryPicks *bool) error {
+	cl.DeduplicateCherryPicks = deduplicateCherryPicks
+	return nil
+}
+
+/*
+Returns the map of sections and emojis to prefix section titles with.
+*/
+func (cl *ChangelogConfiguration) GetEmojis() *map[string]string {
+	return cl.Emojis
+}
+
+/*
+Sets the map of sections and emojis to prefix section titles with.
+
+Errors can be:
+
+- NilPointerError in case the given parameter is nil
+*/
+func (cl *ChangelogConfiguration) SetEmojis(emojis *map[string]string) error {
+	if emojis == nil {
+		return &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "emojis")}
+	}
+	cl.Emojis = emojis
+	return nil
+}
+
+/*
+Returns the flag telling whether dependency updates from bots (like Dependabot and Renovate) are grouped in one entry per ecosystem.
+*/
+func (cl *ChangelogConfiguration) GetGroupDependencyUpdates() *bool {
+	return cl.GroupDependencyUpdates
+}
+
+/*
+Sets the flag telling whether dependency updates from bots (like Dependabot and Renovate) are grouped in one entry per ecosystem.
+
+Errors can be:
+
+- none
+*/
+func (cl *ChangelogConfiguration) SetGroupDependencyUpdates(groupDependencyUpdates *bool) error {
+	cl.GroupDependencyUpdates = groupDependencyUpdates
+	return nil
+}
+
+/*
+Returns the way the scopes of pre-releases are merged into the changelog of the final release that follows them.
+*/
+func (cl *ChangelogConfiguration) GetMergePreReleases() *PreReleaseMerge {
+	return cl.MergePreReleases
+}
+
+/*
+Sets the way the scopes of pre-releases are merged into the changelog of the final release that follows them.
+
+Errors can be:
+
+- none
+*/
+func (cl *ChangelogConfiguration) SetMergePreReleases(mergePreReleases *PreReleaseMerge) error {
+	cl.MergePreReleases = mergePreReleases
+	return nil
+}
+
 /*
 Returns the path to the destination file.
 */
@@ -199,3 +366,22 @@ func (cl *ChangelogConfiguration) SetTemplate(template *string) error {
 	cl.Template = template
 	return nil
 }
+
+/*
+Returns the template rendering the path to the file with the upgrade notes of the release.
+*/
+func (cl *ChangelogConfiguration) GetUpgradeNotes() *string {
+	return cl.UpgradeNotes
+}
+
+/*
+Sets the template rendering the path to the file with the upgrade notes of the release.
+
+Errors can be:
+
+- none
+*/
+func (cl *ChangelogConfiguration) SetUpgradeNotes(upgradeNotes *string) error {
+	cl.UpgradeNotes = upgradeNotes
+	return nil
+}
@@ -34,10 +34,17 @@ func TestChangelogConfigurationNewChangelogConfiguration(t *testing.T) {
 
 	// default constructor has its fields set to default values
 	assert.Nil(t, cc.GetAppend())
+	assert.Equal(t, 0, len(*cc.GetBadges()))
+	assert.Nil(t, cc.GetCollapseThreshold())
+	assert.Nil(t, cc.GetDeduplicateCherryPicks())
+	assert.Equal(t, 0, len(*cc.GetEmojis()))
+	assert.Nil(t, cc.GetGroupDependencyUpdates())
+	assert.Nil(t, cc.GetMergePreReleases())
 	assert.Nil(t, cc.GetPath())
 	assert.Equal(t, 0, len(*cc.GetSections()))
 	assert.Equal(t, 0, len(*cc.GetSubstitutions()))
 	assert.Nil(t, cc.GetTemplate())
+	assert.Nil(t, cc.GetUpgradeNotes())
 }
 
 func TestChangelogConfigurationNewChangelogConfigurationWith(t *testing.T) {
@@ -48,11 +55,27 @@ func TestChangelogConfigurationNewChangelogConfigurationWith(t *testing.T) {
 	substitutions := make(map[string]string)
 	substitutions["Expression1"] = "string1"
 
-	cc, err := NewChangelogConfigurationWith(utl.PointerToString("tail"), utl.PointerToString("CHANGELOG.md"), &sections, utl.PointerToString("changelog.tpl"), &substitutions)
+	badges := map[string]string{"Section1": "badge1"}
+	emojis := map[string]string{"Section1": ":sparkles:"}
+	collapseThreshold := 10
+
+	cc, err := NewChangelogConfigurationWith(utl.PointerToString("tail"), &badges, &collapseThreshold, utl.PointerToBoolean(true), &emojis, utl.PointerToBoolean(true), PointerToPreReleaseMerge(NESTED), utl.PointerToString("CHANGELOG.md"), &sections, utl.PointerToString("changelog.tpl"), &substitutions, utl.PointerToString("docs/upgrade-notes/{{version}}.md"))
 	assert.NoError(t, err)
 
 	a := cc.GetAppend()
 	assert.Equal(t, "tail", *a)
+	b := cc.GetBadges()
+	assert.Equal(t, &badges, b)
+	ct := cc.GetCollapseThreshold()
+	assert.Equal(t, 10, *ct)
+	dcp := cc.GetDeduplicateCherryPicks()
+	assert.Equal(t, true, *dcp)
+	e := cc.GetEmojis()
+	assert.Equal(t, &emojis, e)
+	gdu := cc.GetGroupDependencyUpdates()
+	assert.Equal(t, true, *gdu)
+	mpr := cc.GetMergePreReleases()
+	assert.Equal(t, NESTED, *mpr)
 	p := cc.GetPath()
 	assert.Equal(t, "CHANGELOG.md", *p)
 	s1 := cc.GetSections()
@@ -61,9 +84,11 @@ func TestChangelogConfigurationNewChangelogConfigurationWith(t *testing.T) {
 	assert.Equal(t, "changelog.tpl", *t1)
 	s2 := cc.GetSubstitutions()
 	assert.Equal(t, &substitutions, s2)
+	un := cc.GetUpgradeNotes()
+	assert.Equal(t, "docs/upgrade-notes/{{version}}.md", *un)
 
 	// also test error conditions when nil parameters are passed
-	_, err = NewChangelogConfigurationWith(nil, utl.PointerToString("CHANGELOG.md"), nil, utl.PointerToString("changelog.tpl"), &substitutions)
+	_, err = NewChangelogConfigurationWith(nil, nil, nil, nil, nil, nil, nil, utl.PointerToString("CHANGELOG.md"), nil, utl.PointerToString("changelog.tpl"), &substitutions, nil)
 	assert.NotNil(t, err)
 }
 
@@ -75,6 +100,69 @@ func TestChangelogConfigurationGetAppend(t *testing.T) {
 	assert.Equal(t, "tail", *a)
 }
 
+func TestChangelogConfigurationGetBadges(t *testing.T) {
+	badges := map[string]string{"Section1": "badge1"}
+
+	cc := NewChangelogConfiguration()
+
+	err := cc.SetBadges(&badges)
+	assert.NoError(t, err)
+	b := cc.GetBadges()
+	assert.Equal(t, &badges, b)
+
+	// also test error conditions when nil parameters are passed
+	err = cc.SetBadges(nil)
+	assert.NotNil(t, err)
+}
+
+func TestChangelogConfigurationGetCollapseThreshold(t *testing.T) {
+	cc := NewChangelogConfiguration()
+
+	collapseThreshold := 10
+	cc.SetCollapseThreshold(&collapseThreshold)
+	ct := cc.GetCollapseThreshold()
+	assert.Equal(t, 10, *ct)
+}
+
+func TestChangelogConfigurationGetDeduplicateCherryPicks(t *testing.T) {
+	cc := NewChangelogConfiguration()
+
+	cc.SetDeduplicateCherryPicks(utl.PointerToBoolean(true))
+	dcp := cc.GetDeduplicateCherryPicks()
+	assert.Equal(t, true, *dcp)
+}
+
+func TestChangelogConfigurationGetGroupDependencyUpdates(t *testing.T) {
+	cc := NewChangelogConfiguration()
+
+	cc.SetGroupDependencyUpdates(utl.PointerToBoolean(true))
+	gdu := cc.GetGroupDependencyUpdates()
+	assert.Equal(t, true, *gdu)
+}
+
+func TestChangelogConfigurationGetMergePreReleases(t *testing.T) {
+	cc := NewChangelogConfiguration()
+
+	cc.SetMergePreReleases(PointerToPreReleaseMerge(FLAT))
+	mpr := cc.GetMergePreReleases()
+	assert.Equal(t, FLAT, *mpr)
+}
+
+func TestChangelogConfigurationGetEmojis(t *testing.T) {
+	emojis := map[string]string{"Section1": ":sparkles:"}
+
+	cc := NewChangelogConfiguration()
+
+	err := cc.SetEmojis(&emojis)
+	assert.NoError(t, err)
+	e := cc.GetEmojis()
+	assert.Equal(t, &emojis, e)
+
+	// also test error conditions when nil parameters are passed
+	err = cc.SetEmojis(nil)
+	assert.NotNil(t, err)
+}
+
 func TestChangelogConfigurationGetPath(t *testing.T) {
 	cc := NewChangelogConfiguration()
 
@@ -123,3 +211,11 @@ func TestChangelogConfigurationGetSubstitutions(t *testing.T) {
 	err = cc.SetSubstitutions(nil)
 	assert.NotNil(t, err)
 }
+
+func TestChangelogConfigurationGetUpgradeNotes(t *testing.T) {
+	cc := NewChangelogConfiguration()
+
+	cc.SetUpgradeNotes(utl.PointerToString("docs/upgrade-notes/{{version}}.md"))
+	un := cc.GetUpgradeNotes()
+	assert.Equal(t, "docs/upgrade-notes/{{version}}.md", *un)
+}
@@ -99,6 +99,24 @@ func TestReleaseGetDate(t *testing.T) {
 	assert.Equal(t, "date", *d)
 }
 
+func TestReleaseGetImpactReports(t *testing.T) {
+	release := NewRelease()
+	assert.Equal(t, 0, len(release.GetImpactReports()))
+
+	impactReports := []*ImpactReport{NewImpactReportWith(utl.PointerToString("api"), utl.PointerToString("API changes"), true, utl.PointerToString("major"), utl.PointerToString("removed func F"))}
+	release.SetImpactReports(impactReports)
+	assert.Equal(t, impactReports, release.GetImpactReports())
+}
+
+func TestReleaseGetPreReleases(t *testing.T) {
+	release := NewRelease()
+	assert.Equal(t, 0, len(release.GetPreReleases()))
+
+	preReleases := []*Release{NewReleaseWith(utl.PointerToString("1.0.0-rc.1"), utl.PointerToString("date1")), NewReleaseWith(utl.PointerToString("1.0.0-rc.2"), utl.PointerToString("date2"))}
+	release.SetPreReleases(preReleases)
+	assert.Equal(t, preReleases, release.GetPreReleases())
+}
+
 func TestReleaseGetSections(t *testing.T) {
 	sections := make([]*Section, 0)
 	sections = append(sections, NewSectionWith(utl.PointerToString("one"), nil))
@@ -122,12 +140,24 @@ func TestReleaseGetSection(t *testing.T) {
 	assert.Equal(t, 1, len(release.GetSections()))
 }
 
+func TestReleaseGetUpgradeNotes(t *testing.T) {
+	release := NewRelease()
+	assert.Nil(t, release.GetUpgradeNotes())
+
+	release.SetUpgradeNotes(utl.PointerToString("notes"))
+	un := release.GetUpgradeNotes()
+	assert.Equal(t, "notes", *un)
+}
+
 func TestSectionNewSection(t *testing.T) {
 	section := NewSection()
 
 	// default constructor has its fields set to default values
 	assert.Nil(t, section.GetName())
 	assert.Equal(t, 0, len(section.GetCommits()))
+	assert.Nil(t, section.GetBadge())
+	assert.False(t, section.GetCollapsed())
+	assert.Nil(t, section.GetEmoji())
 }
 
 func TestSectionNewSectionWith(t *testing.T) {
@@ -164,3 +194,26 @@ func TestSectionGetCommits(t *testing.T) {
 	c := section.GetCommits()
 	assert.Equal(t, commits, c)
 }
+
+func TestSectionGetBadge(t *testing.T) {
+	section := NewSection()
+
+	section.SetBadge(utl.PointerToString("badge"))
+	b := section.GetBadge()
+	assert.Equal(t, "badge", *b)
+}
+
+func TestSectionGetCollapsed(t *testing.T) {
+	section := NewSection()
+
+	section.SetCollapsed(true)
+	assert.True(t, section.GetCollapsed())
+}
+
+func TestSectionGetEmoji(t *testing.T) {
+	section := NewSection()
+
+	section.SetEmoji(utl.PointerToString(":sparkles:"))
+	e := section.GetEmoji()
+	assert.Equal(t, ":sparkles:", *e)
+}
@@ -25,37 +25,158 @@ import (
 
 // The following should be declared as constants but then Go wouldn't let us initialize them
 var (
+	// The default file where the analytics report about past releases is written. Value: nil
+	ANALYTICS_FILE *string = nil
+
+	// The default directory where the version and release date badges are written. Value: nil
+	BADGES_DIRECTORY *string = nil
+
+	// The default regular expression used to extract metadata from the current branch name. Value: nil
+	BRANCH_METADATA_EXPRESSION *string = nil
+
 	// The default version identifier to bump. Value: nil
 	BUMP *string = nil
 
-	// The default changelog configuration block.
-	CHANGELOG, _ = NewChangelogConfigurationWith(nil, nil, &map[string]string{}, nil, &map[string]string{})
+	// The default changelog configuration block, with the upgrade notes read from 'docs/upgrade-notes/<version>.md'.
+	CHANGELOG, _ = NewChangelogConfigurationWith(nil, nil, nil, nil, nil, nil, nil, nil, &map[string]string{}, nil, &map[string]string{}, utl.PointerToString("docs/upgrade-notes/{{version}}.md"))
+
+	// The default flag that enables computing the files changed by each commit in the release scope. Value: false
+	COMMIT_CHANGES *bool = utl.PointerToBoolean(false)
 
 	// The default commit message conventions block.
 	COMMIT_MESSAGE_CONVENTIONS, _ = NewCommitMessageConventionsWith(&[]*string{}, &map[string]*CommitMessageConvention{})
 
+	// The default path to the file where the commit lint report is written in the SARIF format. Value: nil
+	COMMIT_LINT_FILE *string = nil
+
 	// The default custom configuration file path. Value: nil
 	CONFIGURATION_FILE *string = nil
 
 	// The default working directory. Defaults to the current user directory returned by reading the os.Getwd()
 	DIRECTORY *string = ignoreError(os.Getwd())
 
+	// The default downstream updates block.
+	DOWNSTREAM_UPDATES, _ = NewDownstreamUpdatesWith(&[]*string{}, &map[string]*DownstreamUpdate{})
+
 	// The default flag that prevents to alter any repository state and instead just log the actions that would be taken. Value: false
 	DRY_RUN *bool = utl.PointerToBoolean(false)
 
+	// The default event emitters block.
+	EVENT_BUS, _ = NewEventBusWith(&[]*string{}, &map[string]*EventEmitter{})
+
 	// The default Git configuration block.
-	GIT, _ = NewGitConfigurationWith(&map[string]*GitRemoteConfiguration{})
+	GIT, _ = NewGitConfigurationWith(nil, nil, GIT_PROXY, &map[string]*GitRemoteConfiguration{}, GIT_SINGLE_BRANCH, GIT_FETCH_TAGS, GIT_UNSHALLOW, GIT_MIRROR, GIT_BACKEND, GIT_SERVICE, GIT_TRUSTED_KEYS, GIT_TIMESTAMP, GIT_NO_VERIFY, GIT_HOOKS_PATH, GIT_IGNORE_SUBMODULES, GIT_STASH, GIT_TIMEOUT, GIT_RETRIES, GIT_RETRY_DELAY, GIT_LOCK, GIT_LOCK_TIMEOUT)
+
+	// The default URL of the proxy to use for HTTP and HTTPS Git remotes. When nil the proxy is read from the
+	// standard environment variables (HTTP_PROXY, HTTPS_PROXY and NO_PROXY). Value: nil
+	GIT_PROXY *string = nil
+
+	// The default flag telling whether clones only fetch the branch to check out. Value: nil
+	GIT_SINGLE_BRANCH *bool = nil
+
+	// The default flag telling whether tags are fetched from the remote before inferring the version. Value: nil
+	GIT_FETCH_TAGS *bool = nil
+
+	// The default flag telling whether shallow repositories are automatically unshallowed when the commit history walk
+	// reaches the shallow boundary. Value: true
+	GIT_UNSHALLOW *bool = utl.PointerToBoolean(true)
+
+	// The default flag telling whether clones are bare mirrors of the remote repository. Value: nil
+	GIT_MIRROR *bool = nil
+
+	// The default Git implementation used to access repositories. Value: GO_GIT
+	GIT_BACKEND *GitBackend = PointerToGitBackend(GO_GIT)
+
+	// The default name of the service used to access the repository when using the REMOTE backend. Value: nil
+	GIT_SERVICE *string = nil
+
+	// The default armored PGP public keys used to verify the signatures of commits and tags. Value: nil
+	GIT_TRUSTED_KEYS *string = nil
+
+	// The default timestamp (seconds since the epoch) used for the commits and tags created by Nyx. When nil the
+	// current time is used. Value: nil
+	GIT_TIMESTAMP *string = nil
+
+	// The default flag telling whether hooks are bypassed when Nyx creates commits. Value: nil
+	GIT_NO_VERIFY *bool = nil
+
+	// The default directory to look up hooks from when Nyx creates commits. When nil the directory configured for the
+	// repository is used. Value: nil
+	GIT_HOOKS_PATH *string = nil
+
+	// The default mode telling which changes to submodules are ignored when checking the repository status and
+	// staging. When nil the Git default applies, which is not ignoring any change. Value: nil
+	GIT_IGNORE_SUBMODULES *string = nil
+
+	// The default flag telling whether the Mark command stashes the uncommitted changes unrelated to the release
+	// before committing and tagging. Value: nil
+	GIT_STASH *bool = nil
+
+	// The default maximum number of seconds each operation connecting to a remote is allowed to take. When nil remote
+	// operations never time out. Value: nil
+	GIT_TIMEOUT *string = nil
+
+	// The default number of times each operation connecting to a remote is retried when it fails for transient reasons.
+	// When nil remote operations are never retried. Value: nil
+	GIT_RETRIES *string = nil
+
+	// The default number of seconds to wait before the first retry of an operation connecting to a remote. When nil the
+	// delay is 2 seconds. Value: nil
+	GIT_RETRY_DELAY *string = nil
+
+	// The default flag telling whether the Infer and Mark commands lock the repository. Value: nil
+	GIT_LOCK *bool = nil
+
+	// The default maximum number of seconds to wait for the repository lock when it's held by another run. When nil
+	// runs fail straight away when the repository is locked. Value: nil
+	GIT_LOCK_TIMEOUT *string = nil
+
+	// The default flag telling whether the keys of SSH hosts are verified for Git remotes. Value: true
+	GIT_REMOTE_STRICT_HOST_KEY_CHECKING *bool = utl.PointerToBoolean(true)
+
+	// The default tags pushed to Git remotes. Value: ALL
+	GIT_REMOTE_PUSH_TAGS *string = utl.PointerToString(PUSH_TAGS_ALL)
+
+	// The default impact analyzers block.
+	IMPACT_ANALYZERS, _ = NewImpactAnalyzersWith(&[]*string{}, &map[string]*ImpactAnalyzer{})
+
+	// The default identifier bumped when an impact analyzer detects an impact. Value: major
+	IMPACT_ANALYZER_BUMP *string = utl.PointerToString("major")
 
 	// The default initial version to use.
 	// This strongly depends on the SCHEME and as long as it's SEMVER, we use that to select the initial version.
 	INITIAL_VERSION *string = utl.PointerToString(ver.SEMANTIC_VERSION_DEFAULT_INITIAL_VERSION)
 
+	// The default path to the local JUnit XML release report file. Value: nil
+	JUNIT_REPORT_FILE *string = nil
+
+	// The default policy applied when there is nothing to release. Value: PREVIOUS_VERSION
+	NOTHING_TO_RELEASE *NothingToReleasePolicy = PointerToNothingToReleasePolicy(PREVIOUS_VERSION)
+
+	// The default name of the repository the organization configuration is discovered from. Value: .nyx
+	ORGANIZATION_CONFIGURATION_REPOSITORY *string = utl.PointerToString(".nyx")
+
+	// The default name of the service used to discover the organization configuration. Value: nil
+	ORGANIZATION_CONFIGURATION_SERVICE *string = nil
+
+	// The default path rules block.
+	PATH_RULES, _ = NewPathRulesWith(&[]*string{}, &map[string]*PathRule{})
+
+	// The default flag telling whether changes to the paths matched by a path rule are significant. Value: true
+	PATH_RULE_SIGNIFICANT *bool = utl.PointerToBoolean(true)
+
+	// The default comma separated list of pre-release identifiers to use when comparing pre-release versions. Value: nil
+	PRERELEASE_ORDER *string = nil
+
 	// The default preset configuration. Value: nil
 	PRESET *string = nil
 
 	// The release assets configuration block.
 	RELEASE_ASSETS = &map[string]*Attachment{}
 
+	// The default flag that enables coercion of near-semver release names (like '1.2' or 'v1.2.3.4') into legal semantic versions when reading releases from the history. Value: false
+	RELEASE_COERCION *bool = utl.PointerToBoolean(false)
+
 	// The default flag that alows reading releases from the history tolerating arbitrary prefixes and extra non critical characters. Value: true
 	RELEASE_LENIENT *bool = utl.PointerToBoolean(true)
 
@@ -65,6 +186,15 @@ var (
 	// The list of selected asset names to publish for the release type. Value: nil
 	RELEASE_TYPE_ASSETS *[]*string = nil
 
+	// The optional template to render as the build metadata to append to new versions. Value: nil
+	RELEASE_TYPE_BUILD_METADATA *string = nil
+
+	// The optional template to render as the comma separated list of the targets where the build metadata is included. Value: nil
+	RELEASE_TYPE_BUILD_METADATA_TARGETS *string = nil
+
+	// The map of the bump labels, where keys are the names of pull request labels and values are the identifiers to bump. Value: nil
+	RELEASE_TYPE_BUMP_LABELS *map[string]string
+
 	// The flag indicating whether or not the 'collapsed' versioning (pre-release style) must be used. Value: false
 	RELEASE_TYPE_COLLAPSE_VERSIONS *bool = utl.PointerToBoolean(false)
 
@@ -77,6 +207,12 @@ var (
 	// The optional template to render as a regular expression used to match tags from the commit history. Value: nil
 	RELEASE_TYPE_FILTER_TAGS *string = nil
 
+	// The optional flag or the template to render indicating whether or not all the parents of merge commits are followed when walking the commit history. Value: nil
+	RELEASE_TYPE_FOLLOW_ALL_PARENTS *string = nil
+
+	// The default optional CEL expression that must evaluate to true against the state for the release to be issued. Value: nil
+	RELEASE_TYPE_GATE_POLICY *string = nil
+
 	// The optional flag or the template to render indicating whether or not a new commit must be generated in case new artifacts are generated. Value: 'false'
 	RELEASE_TYPE_GIT_COMMIT *string = utl.PointerToString("false")
 
@@ -92,6 +228,9 @@ var (
 	// The optional flag or the template to enable/disable the Git push operation. Value: 'nil'
 	RELEASE_TYPE_GIT_PUSH_FORCE *string = nil
 
+	// The optional flag or the template to render indicating whether or not the branch must be force pushed with a lease, only overwriting the remote branch if it has not been updated since it was last fetched. Value: 'nil'
+	RELEASE_TYPE_GIT_PUSH_FORCE_WITH_LEASE *string = nil
+
 	// The optional flag or the template to render indicating whether or not a new tag must be generated. Value: 'false'
 	RELEASE_TYPE_GIT_TAG *string = utl.PointerToString("false")
 
@@ -107,27 +246,78 @@ var (
 	// The identifiers configuration block. Elements of this list must be of type Identifier. Value: nil
 	RELEASE_TYPE_IDENTIFIERS *[]*Identifier = nil
 
+	// The default optional flag telling whether commits cherry-picked from versions released on other branches are ignored when inferring the version. Value: nil
+	RELEASE_TYPE_IGNORE_CHERRY_PICKS *string = nil
+
+	// The optional flag or the template to render indicating whether or not merge commits are ignored when inferring the version. Value: nil
+	RELEASE_TYPE_IGNORE_MERGES *string = nil
+
 	// The optional template to render as a regular expression used to match branch names. Value: nil
 	RELEASE_TYPE_MATCH_BRANCHES *string = nil
 
+	// The map of the match branch metadata items, where keys are the names of the metadata extracted from the branch name and values are regular expressions. Value: nil
+	RELEASE_TYPE_MATCH_BRANCH_METADATA *map[string]string
+
+	// The optional template to render as a regular expression used to match the paths of the files changed by the latest commit. Value: nil
+	RELEASE_TYPE_MATCH_CHANGED_PATHS *string = nil
+
+	// The optional template to render as a comma separated list of the days of the week when the release type is enabled. Value: nil
+	RELEASE_TYPE_MATCH_DAYS_OF_WEEK *string = nil
+
 	// The map of the match environment variables items, where keys are environment variable names and values are regular expressions.. Value: nil
 	RELEASE_TYPE_MATCH_ENVIRONMENT_VARIABLES *map[string]string
 
+	// The optional template that, once rendered, must evaluate to true for the release type to be matched. Value: nil
+	RELEASE_TYPE_MATCH_EXPRESSION *string = nil
+
+	// The optional logic used to combine the matching criteria. Value: nil
+	RELEASE_TYPE_MATCH_MODE *MatchMode = nil
+
+	// The default optional CEL expression that must evaluate to true against the state for the release type to be matched. Value: nil
+	RELEASE_TYPE_MATCH_POLICY *string = nil
+
+	// The optional template to render as a regular expression used to match the tags applied to the latest commit. Value: nil
+	RELEASE_TYPE_MATCH_TAGS *string = nil
+
 	// The identifier of a specific workspace status to be matched. Value: nil
 	RELEASE_TYPE_MATCH_WORKSPACE_STATUS *WorkspaceStatus = nil
 
 	// The optional flag or the template to render indicating whether or not releases must be published. Value: 'false'
 	RELEASE_TYPE_PUBLISH *string = utl.PointerToString("false")
 
+	// The optional template to render as the name of the provider environment whose approval is required before publishing releases. Value: nil
+	RELEASE_TYPE_PUBLISH_APPROVAL_ENVIRONMENT *string = nil
+
+	// The optional template to render as the number of seconds to wait between two subsequent checks for the publish approval. Value: '30'
+	RELEASE_TYPE_PUBLISH_APPROVAL_POLLING_INTERVAL *string = utl.PointerToString("30")
+
+	// The optional template to render as the maximum number of seconds to wait for the publish approval. Value: '1800'
+	RELEASE_TYPE_PUBLISH_APPROVAL_TIMEOUT *string = utl.PointerToString("1800")
+
 	// The optional template to set the draft flag of releases published to remote services. Value: 'false'
 	RELEASE_TYPE_PUBLISH_DRAFT *string = utl.PointerToString("false")
 
 	// The optional template to set the pre-release flag of releases published to remote services. Value: 'false'
 	RELEASE_TYPE_PUBLISH_PRE_RELEASE *string = utl.PointerToString("false")
 
+	// The optional template to render as the name of the service to fetch the merged pull requests from, whose titles and labels are used in place of commit messages. Value: nil
+	RELEASE_TYPE_PULL_REQUEST_MESSAGES *string = nil
+
+	// The optional template to render as the path of the release metadata file to write and commit when marking releases. Value: nil
+	RELEASE_TYPE_RELEASE_METADATA_FILE *string = nil
+
 	// The optional template to set the name of releases published to remote services. Value: nil
 	RELEASE_TYPE_RELEASE_NAME *string = nil
 
+	// The optional list of the names of the environment variables that must be set for this release type. Value: nil
+	RELEASE_TYPE_REQUIRED_ENVIRONMENT_VARIABLES *[]*string = nil
+
+	// The optional flag telling whether all the commits in the release scope must have a valid signature. Value: nil
+	RELEASE_TYPE_REQUIRE_SIGNED_COMMITS *string = nil
+
+	// The optional template to render as a version constraint that versions issued by this release type must satisfy. Value: nil
+	RELEASE_TYPE_VERSION_CONSTRAINT *string = nil
+
 	// The optional template to render as a regular expression used to constrain versions issued by this release type. Value: nil
 	RELEASE_TYPE_VERSION_RANGE *string = nil
 
@@ -137,12 +327,21 @@ var (
 	// The default release types block.
 	RELEASE_TYPES, _ = NewReleaseTypesWith(&[]*string{RELEASE_TYPE_NAME}, &[]*string{}, &[]*string{}, &map[string]*ReleaseType{*RELEASE_TYPE_NAME: NewReleaseType()})
 
+	// The default path to the local HTML release report file. Value: nil
+	REPORT_FILE *string = nil
+
 	// The default flag that enables loading a previously stored State file and resume operations from there. Value: false
 	RESUME *bool = utl.PointerToBoolean(false)
 
+	// The default name of the identifier whose significance bumps the fourth number of four segment versions. Value: nil
+	REVISION_BUMP *string = nil
+
 	// The default versioning scheme to use. Value: SEMVER
 	SCHEME *ver.Scheme = ver.PointerToScheme(ver.SEMVER)
 
+	// The default server configuration.
+	SERVER = NewServerConfigurationWith(utl.PointerToString(":8080"), nil, utl.PointerToString("publish"), nil)
+
 	// The services configuration block.
 	SERVICES *map[string]*ServiceConfiguration = &map[string]*ServiceConfiguration{}
 
@@ -152,6 +351,12 @@ var (
 	// The default path to the local state file. Value: nil
 	STATE_FILE *string = nil
 
+	// The default secret key used to sign the state file. Value: nil
+	STATE_FILE_SIGNING_KEY *string = nil
+
+	// The default flag that tells whether runs not issuing a release must exit with a non zero status. Value: false
+	STRICT_EXIT_CODES *bool = utl.PointerToBoolean(false)
+
 	// The default substitutions block.
 	SUBSTITUTIONS, _ = NewSubstitutionsWith(&[]*string{}, &map[string]*Substitution{})
 
@@ -161,11 +366,23 @@ var (
 	// The default path to the local summary file. Value: nil
 	SUMMARY_FILE *string = nil
 
+	// The default URL of the endpoint where the telemetry report is uploaded. Value: nil
+	TELEMETRY_ENDPOINT *string = nil
+
+	// The default path to the local telemetry report file. Value: nil
+	TELEMETRY_FILE *string = nil
+
+	// The default source of the release timestamp. Value: SYSTEM
+	TIMESTAMP_SOURCE *TimestampSource = PointerToTimestampSource(SYSTEM)
+
 	// The default logging level. Value: WARNING
 	VERBOSITY *Verbosity = PointerToVerbosity(WARNING)
 
 	// The default release version. Value: nil
 	VERSION *string = nil
+
+	// The default name of the service used to store released versions. Value: nil
+	VERSION_STORAGE_SERVICE *string = nil
 )
 
 /*
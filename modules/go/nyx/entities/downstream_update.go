@@ -0,0 +1,198 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+/*
+This object models a rule to open a pull request in a downstream repository to update the version of the released
+artifact it depends on, by replacing some text matched by a regular expression in a file of that repository.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type DownstreamUpdate struct {
+	// The name of the branch of the downstream repository to open the pull request against. If nil the repository default branch is used.
+	Branch *string `json:"branch,omitempty" yaml:"branch,omitempty"`
+
+	// The regular expression used to match the text to be replaced in the downstream file.
+	Match *string `json:"match,omitempty" yaml:"match,omitempty"`
+
+	// The name of the owner of the downstream repository.
+	Owner *string `json:"owner,omitempty" yaml:"owner,omitempty"`
+
+	// The path of the file to update within the downstream repository.
+	Path *string `json:"path,omitempty" yaml:"path,omitempty"`
+
+	// The template expression defining the text to use when replacing all matched tokens.
+	Replace *string `json:"replace,omitempty" yaml:"replace,omitempty"`
+
+	// The name of the downstream repository.
+	Repository *string `json:"repository,omitempty" yaml:"repository,omitempty"`
+
+	// The name of the service configuration used to open the pull request.
+	Service *string `json:"service,omitempty" yaml:"service,omitempty"`
+
+	// The template expression defining the title of the pull request.
+	Title *string `json:"title,omitempty" yaml:"title,omitempty"`
+}
+
+/*
+Default constructor
+*/
+func NewDownstreamUpdate() *DownstreamUpdate {
+	return &DownstreamUpdate{}
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+  - branch the name of the branch of the downstream repository to open the pull request against. If nil the repository default branch is used.
+  - match the regular expression used to match the text to be replaced in the downstream file.
+  - owner the name of the owner of the downstream repository.
+  - path the path of the file to update within the downstream repository.
+  - replace the template expression defining the text to use when replacing all matched tokens.
+  - repository the name of the downstream repository.
+  - service the name of the service configuration used to open the pull request.
+  - title the template expression defining the title of the pull request.
+*/
+func NewDownstreamUpdateWith(branch *string, match *string, owner *string, path *string, replace *string, repository *string, service *string, title *string) *DownstreamUpdate {
+	du := DownstreamUpdate{}
+
+	du.Branch = branch
+	du.Match = match
+	du.Owner = owner
+	du.Path = path
+	du.Replace = replace
+	du.Repository = repository
+	du.Service = service
+	du.Title = title
+
+	return &du
+}
+
+/*
+Returns the name of the branch of the downstream repository to open the pull request against. If nil the repository default branch is used.
+*/
+func (du *DownstreamUpdate) GetBranch() *string {
+	return du.Branch
+}
+
+/*
+Sets the name of the branch of the downstream repository to open the pull request against. If nil the repository default branch is used.
+*/
+func (du *DownstreamUpdate) SetBranch(branch *string) {
+	du.Branch = branch
+}
+
+/*
+Returns the regular expression used to match the text to be replaced in the downstream file.
+*/
+func (du *DownstreamUpdate) GetMatch() *string {
+	return du.Match
+}
+
+/*
+Sets the regular expression used to match the text to be replaced in the downstream file.
+*/
+func (du *DownstreamUpdate) SetMatch(match *string) {
+	du.Match = match
+}
+
+/*
+Returns the name of the owner of the downstream repository.
+*/
+func (du *DownstreamUpdate) GetOwner() *string {
+	return du.Owner
+}
+
+/*
+Sets the name of the owner of the downstream repository.
+*/
+func (du *DownstreamUpdate) SetOwner(owner *string) {
+	du.Owner = owner
+}
+
+/*
+Returns the path of the file to update within the downstream repository.
+*/
+func (du *DownstreamUpdate) GetPath() *string {
+	return du.Path
+}
+
+/*
+Sets the path of the file to update within the downstream repository.
+*/
+func (du *DownstreamUpdate) SetPath(path *string) {
+	du.Path = path
+}
+
+/*
+Returns the template expression defining the text to use when replacing all matched tokens.
+*/
+func (du *DownstreamUpdate) GetReplace() *string {
+	return du.Replace
+}
+
+/*
+Sets the template expression defining the text to use when replacing all matched tokens.
+*/
+func (du *DownstreamUpdate) SetReplace(replace *string) {
+	du.Replace = replace
+}
+
+/*
+Returns the name of the downstream repository.
+*/
+func (du *DownstreamUpdate) GetRepository() *string {
+	return du.Repository
+}
+
+/*
+Sets the name of the downstream repository.
+*/
+func (du *DownstreamUpdate) SetRepository(repository *string) {
+	du.Repository = repository
+}
+
+/*
+Returns the name of the service configuration used to open the pull request.
+*/
+func (du *DownstreamUpdate) GetService() *string {
+	return du.Service
+}
+
+/*
+Sets the name of the service configuration used to open the pull request.
+*/
+func (du *DownstreamUpdate) SetService(service *string) {
+	du.Service = service
+}
+
+/*
+Returns the template expression defining the title of the pull request.
+*/
+func (du *DownstreamUpdate) GetTitle() *string {
+	return du.Title
+}
+
+/*
+Sets the template expression defining the title of the pull request.
+*/
+func (du *DownstreamUpdate) SetTitle(title *string) {
+	du.Title = title
+}
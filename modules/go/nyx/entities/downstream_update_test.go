@@ -0,0 +1,121 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+func TestDownstreamUpdateNewDownstreamUpdate(t *testing.T) {
+	du := NewDownstreamUpdate()
+
+	// default constructor has its fields set to default values
+	assert.Nil(t, du.GetBranch())
+	assert.Nil(t, du.GetMatch())
+	assert.Nil(t, du.GetOwner())
+	assert.Nil(t, du.GetPath())
+	assert.Nil(t, du.GetReplace())
+	assert.Nil(t, du.GetRepository())
+	assert.Nil(t, du.GetService())
+	assert.Nil(t, du.GetTitle())
+}
+
+func TestDownstreamUpdateNewDownstreamUpdateWith(t *testing.T) {
+	du := NewDownstreamUpdateWith(utl.PointerToString("branch1"), utl.PointerToString("match1"), utl.PointerToString("owner1"), utl.PointerToString("path1"), utl.PointerToString("replace1"), utl.PointerToString("repository1"), utl.PointerToString("service1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "branch1", *du.GetBranch())
+	assert.Equal(t, "match1", *du.GetMatch())
+	assert.Equal(t, "owner1", *du.GetOwner())
+	assert.Equal(t, "path1", *du.GetPath())
+	assert.Equal(t, "replace1", *du.GetReplace())
+	assert.Equal(t, "repository1", *du.GetRepository())
+	assert.Equal(t, "service1", *du.GetService())
+	assert.Equal(t, "title1", *du.GetTitle())
+}
+
+func TestDownstreamUpdateGetBranch(t *testing.T) {
+	du := NewDownstreamUpdateWith(utl.PointerToString("branch1"), utl.PointerToString("match1"), utl.PointerToString("owner1"), utl.PointerToString("path1"), utl.PointerToString("replace1"), utl.PointerToString("repository1"), utl.PointerToString("service1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "branch1", *du.GetBranch())
+	du.SetBranch(utl.PointerToString("branch2"))
+	assert.Equal(t, "branch2", *du.GetBranch())
+}
+
+func TestDownstreamUpdateGetMatch(t *testing.T) {
+	du := NewDownstreamUpdateWith(utl.PointerToString("branch1"), utl.PointerToString("match1"), utl.PointerToString("owner1"), utl.PointerToString("path1"), utl.PointerToString("replace1"), utl.PointerToString("repository1"), utl.PointerToString("service1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "match1", *du.GetMatch())
+	du.SetMatch(utl.PointerToString("match2"))
+	assert.Equal(t, "match2", *du.GetMatch())
+}
+
+func TestDownstreamUpdateGetOwner(t *testing.T) {
+	du := NewDownstreamUpdateWith(utl.PointerToString("branch1"), utl.PointerToString("match1"), utl.PointerToString("owner1"), utl.PointerToString("path1"), utl.PointerToString("replace1"), utl.PointerToString("repository1"), utl.PointerToString("service1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "owner1", *du.GetOwner())
+	du.SetOwner(utl.PointerToString("owner2"))
+	assert.Equal(t, "owner2", *du.GetOwner())
+}
+
+func TestDownstreamUpdateGetPath(t *testing.T) {
+	du := NewDownstreamUpdateWith(utl.PointerToString("branch1"), utl.PointerToString("match1"), utl.PointerToString("owner1"), utl.PointerToString("path1"), utl.PointerToString("replace1"), utl.PointerToString("repository1"), utl.PointerToString("service1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "path1", *du.GetPath())
+	du.SetPath(utl.PointerToString("path2"))
+	assert.Equal(t, "path2", *du.GetPath())
+}
+
+func TestDownstreamUpdateGetReplace(t *testing.T) {
+	du := NewDownstreamUpdateWith(utl.PointerToString("branch1"), utl.PointerToString("match1"), utl.PointerToString("owner1"), utl.PointerToString("path1"), utl.PointerToString("replace1"), utl.PointerToString("repository1"), utl.PointerToString("service1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "replace1", *du.GetReplace())
+	du.SetReplace(utl.PointerToString("replace2"))
+	assert.Equal(t, "replace2", *du.GetReplace())
+}
+
+func TestDownstreamUpdateGetRepository(t *testing.T) {
+	du := NewDownstreamUpdateWith(utl.PointerToString("branch1"), utl.PointerToString("match1"), utl.PointerToString("owner1"), utl.PointerToString("path1"), utl.PointerToString("replace1"), utl.PointerToString("repository1"), utl.PointerToString("service1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "repository1", *du.GetRepository())
+	du.SetRepository(utl.PointerToString("repository2"))
+	assert.Equal(t, "repository2", *du.GetRepository())
+}
+
+func TestDownstreamUpdateGetService(t *testing.T) {
+	du := NewDownstreamUpdateWith(utl.PointerToString("branch1"), utl.PointerToString("match1"), utl.PointerToString("owner1"), utl.PointerToString("path1"), utl.PointerToString("replace1"), utl.PointerToString("repository1"), utl.PointerToString("service1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "service1", *du.GetService())
+	du.SetService(utl.PointerToString("service2"))
+	assert.Equal(t, "service2", *du.GetService())
+}
+
+func TestDownstreamUpdateGetTitle(t *testing.T) {
+	du := NewDownstreamUpdateWith(utl.PointerToString("branch1"), utl.PointerToString("match1"), utl.PointerToString("owner1"), utl.PointerToString("path1"), utl.PointerToString("replace1"), utl.PointerToString("repository1"), utl.PointerToString("service1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "title1", *du.GetTitle())
+	du.SetTitle(utl.PointerToString("title2"))
+	assert.Equal(t, "title2", *du.GetTitle())
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+/*
+This object models an emitter publishing release lifecycle events (like the version being inferred or the release
+being published) to an event bus like Kafka, NATS or AWS EventBridge.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type EventEmitter struct {
+	// The URL of the event bus endpoint to publish events to. If nil the default endpoint for the emitter type is used, when available.
+	Endpoint *string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+
+	// The comma separated list of the event types to publish. If nil all event types are published.
+	Events *string `json:"events,omitempty" yaml:"events,omitempty"`
+
+	// The template expression defining the password (or secret key) used to authenticate to the event bus.
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+
+	// The name of the region hosting the event bus, if the event bus type requires one.
+	Region *string `json:"region,omitempty" yaml:"region,omitempty"`
+
+	// The name of the topic (or subject, or event bus) events are published to.
+	Topic *string `json:"topic,omitempty" yaml:"topic,omitempty"`
+
+	// The type of the event bus.
+	Type *string `json:"type,omitempty" yaml:"type,omitempty"`
+
+	// The template expression defining the user name (or access key) used to authenticate to the event bus.
+	User *string `json:"user,omitempty" yaml:"user,omitempty"`
+}
+
+/*
+Default constructor
+*/
+func NewEventEmitter() *EventEmitter {
+	return &EventEmitter{}
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+  - endpoint the URL of the event bus endpoint to publish events to. If nil the default endpoint for the emitter type is used, when available.
+  - events the comma separated list of the event types to publish. If nil all event types are published.
+  - password the template expression defining the password (or secret key) used to authenticate to the event bus.
+  - region the name of the region hosting the event bus, if the event bus type requires one.
+  - topic the name of the topic (or subject, or event bus) events are published to.
+  - emitterType the type of the event bus.
+  - user the template expression defining the user name (or access key) used to authenticate to the event bus.
+*/
+func NewEventEmitterWith(endpoint *string, events *string, password *string, region *string, topic *string, emitterType *string, user *string) *EventEmitter {
+	ee := EventEmitter{}
+
+	ee.Endpoint = endpoint
+	ee.Events = events
+	ee.Password = password
+	ee.Region = region
+	ee.Topic = topic
+	ee.Type = emitterType
+	ee.User = user
+
+	return &ee
+}
+
+/*
+Returns the URL of the event bus endpoint to publish events to. If nil the default endpoint for the emitter type is used, when available.
+*/
+func (ee *EventEmitter) GetEndpoint() *string {
+	return ee.Endpoint
+}
+
+/*
+Sets the URL of the event bus endpoint to publish events to. If nil the default endpoint for the emitter type is used, when available.
+*/
+func (ee *EventEmitter) SetEndpoint(endpoint *string) {
+	ee.Endpoint = endpoint
+}
+
+/*
+Returns the comma separated list of the event types to publish. If nil all event types are published.
+*/
+func (ee *EventEmitter) GetEvents() *string {
+	return ee.Events
+}
+
+/*
+Sets the comma separated list of the event types to publish. If nil all event types are published.
+*/
+func (ee *EventEmitter) SetEvents(events *string) {
+	ee.Events = events
+}
+
+/*
+Returns the template expression defining the password (or secret key) used to authenticate to the event bus.
+*/
+func (ee *EventEmitter) GetPassword() *string {
+	return ee.Password
+}
+
+/*
+Sets the template expression defining the password (or secret key) used to authenticate to the event bus.
+*/
+func (ee *EventEmitter) SetPassword(password *string) {
+	ee.Password = password
+}
+
+/*
+Returns the name of the region hosting the event bus, if the event bus type requires one.
+*/
+func (ee *EventEmitter) GetRegion() *string {
+	return ee.Region
+}
+
+/*
+Sets the name of the region hosting the event bus, if the event bus type requires one.
+*/
+func (ee *EventEmitter) SetRegion(region *string) {
+	ee.Region = region
+}
+
+/*
+Returns the name of the topic (or subject, or event bus) events are published to.
+*/
+func (ee *EventEmitter) GetTopic() *string {
+	return ee.Topic
+}
+
+/*
+Sets the name of the topic (or subject, or event bus) events are published to.
+*/
+func (ee *EventEmitter) SetTopic(topic *string) {
+	ee.Topic = topic
+}
+
+/*
+Returns the type of the event bus.
+*/
+func (ee *EventEmitter) GetType() *string {
+	return ee.Type
+}
+
+/*
+Sets the type of the event bus.
+*/
+func (ee *EventEmitter) SetType(emitterType *string) {
+	ee.Type = emitterType
+}
+
+/*
+Returns the template expression defining the user name (or access key) used to authenticate to the event bus.
+*/
+func (ee *EventEmitter) GetUser() *string {
+	return ee.User
+}
+
+/*
+Sets the template expression defining the user name (or access key) used to authenticate to the event bus.
+*/
+func (ee *EventEmitter) SetUser(user *string) {
+	ee.User = user
+}
@@ -0,0 +1,111 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+func TestEventEmitterNewEventEmitter(t *testing.T) {
+	ee := NewEventEmitter()
+
+	// default constructor has its fields set to default values
+	assert.Nil(t, ee.GetEndpoint())
+	assert.Nil(t, ee.GetEvents())
+	assert.Nil(t, ee.GetPassword())
+	assert.Nil(t, ee.GetRegion())
+	assert.Nil(t, ee.GetTopic())
+	assert.Nil(t, ee.GetType())
+	assert.Nil(t, ee.GetUser())
+}
+
+func TestEventEmitterNewEventEmitterWith(t *testing.T) {
+	ee := NewEventEmitterWith(utl.PointerToString("endpoint1"), utl.PointerToString("events1"), utl.PointerToString("password1"), utl.PointerToString("region1"), utl.PointerToString("topic1"), utl.PointerToString("type1"), utl.PointerToString("user1"))
+
+	assert.Equal(t, "endpoint1", *ee.GetEndpoint())
+	assert.Equal(t, "events1", *ee.GetEvents())
+	assert.Equal(t, "password1", *ee.GetPassword())
+	assert.Equal(t, "region1", *ee.GetRegion())
+	assert.Equal(t, "topic1", *ee.GetTopic())
+	assert.Equal(t, "type1", *ee.GetType())
+	assert.Equal(t, "user1", *ee.GetUser())
+}
+
+func TestEventEmitterGetEndpoint(t *testing.T) {
+	ee := NewEventEmitterWith(utl.PointerToString("endpoint1"), utl.PointerToString("events1"), utl.PointerToString("password1"), utl.PointerToString("region1"), utl.PointerToString("topic1"), utl.PointerToString("type1"), utl.PointerToString("user1"))
+
+	assert.Equal(t, "endpoint1", *ee.GetEndpoint())
+	ee.SetEndpoint(utl.PointerToString("endpoint2"))
+	assert.Equal(t, "endpoint2", *ee.GetEndpoint())
+}
+
+func TestEventEmitterGetEvents(t *testing.T) {
+	ee := NewEventEmitterWith(utl.PointerToString("endpoint1"), utl.PointerToString("events1"), utl.PointerToString("password1"), utl.PointerToString("region1"), utl.PointerToString("topic1"), utl.PointerToString("type1"), utl.PointerToString("user1"))
+
+	assert.Equal(t, "events1", *ee.GetEvents())
+	ee.SetEvents(utl.PointerToString("events2"))
+	assert.Equal(t, "events2", *ee.GetEvents())
+}
+
+func TestEventEmitterGetPassword(t *testing.T) {
+	ee := NewEventEmitterWith(utl.PointerToString("endpoint1"), utl.PointerToString("events1"), utl.PointerToString("password1"), utl.PointerToString("region1"), utl.PointerToString("topic1"), utl.PointerToString("type1"), utl.PointerToString("user1"))
+
+	assert.Equal(t, "password1", *ee.GetPassword())
+	ee.SetPassword(utl.PointerToString("password2"))
+	assert.Equal(t, "password2", *ee.GetPassword())
+}
+
+func TestEventEmitterGetRegion(t *testing.T) {
+	ee := NewEventEmitterWith(utl.PointerToString("endpoint1"), utl.PointerToString("events1"), utl.PointerToString("password1"), utl.PointerToString("region1"), utl.PointerToString("topic1"), utl.PointerToString("type1"), utl.PointerToString("user1"))
+
+	assert.Equal(t, "region1", *ee.GetRegion())
+	ee.SetRegion(utl.PointerToString("region2"))
+	assert.Equal(t, "region2", *ee.GetRegion())
+}
+
+func TestEventEmitterGetTopic(t *testing.T) {
+	ee := NewEventEmitterWith(utl.PointerToString("endpoint1"), utl.PointerToString("events1"), utl.PointerToString("password1"), utl.PointerToString("region1"), utl.PointerToString("topic1"), utl.PointerToString("type1"), utl.PointerToString("user1"))
+
+	assert.Equal(t, "topic1", *ee.GetTopic())
+	ee.SetTopic(utl.PointerToString("topic2"))
+	assert.Equal(t, "topic2", *ee.GetTopic())
+}
+
+func TestEventEmitterGetType(t *testing.T) {
+	ee := NewEventEmitterWith(utl.PointerToString("endpoint1"), utl.PointerToString("events1"), utl.PointerToString("password1"), utl.PointerToString("region1"), utl.PointerToString("topic1"), utl.PointerToString("type1"), utl.PointerToString("user1"))
+
+	assert.Equal(t, "type1", *ee.GetType())
+	ee.SetType(utl.PointerToString("type2"))
+	assert.Equal(t, "type2", *ee.GetType())
+}
+
+func TestEventEmitterGetUser(t *testing.T) {
+	ee := NewEventEmitterWith(utl.PointerToString("endpoint1"), utl.PointerToString("events1"), utl.PointerToString("password1"), utl.PointerToString("region1"), utl.PointerToString("topic1"), utl.PointerToString("type1"), utl.PointerToString("user1"))
+
+	assert.Equal(t, "user1", *ee.GetUser())
+	ee.SetUser(utl.PointerToString("user2"))
+	assert.Equal(t, "user2", *ee.GetUser())
+}
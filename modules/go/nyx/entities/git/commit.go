@@ -16,6 +16,10 @@
 
 package git
 
+import (
+	"strings" // https://pkg.go.dev/strings
+)
+
 /*
 This object is a Git commit value holder independent from the underlying Git implementation.
 
@@ -26,6 +30,9 @@ type Commit struct {
 	// The author data
 	AuthorAction Action `json:"authorAction,omitempty" yaml:"authorAction,omitempty"`
 
+	// The files changed by the commit, only available when they have been computed.
+	Changes []FileChange `json:"changes,omitempty" yaml:"changes,omitempty"`
+
 	// The committer data
 	CommitAction Action `json:"commitAction,omitempty" yaml:"commitAction,omitempty"`
 
@@ -38,9 +45,15 @@ type Commit struct {
 	// The parents SHA's.
 	Parents []string `json:"parents,omitempty" yaml:"parents,omitempty"`
 
+	// The signature, only available when the signature has been verified.
+	Signature *Signature `json:"signature,omitempty" yaml:"signature,omitempty"`
+
 	// The tags associated to the commit.
 	Tags []Tag `json:"tags,omitempty" yaml:"tags,omitempty"`
 
+	// The trailers parsed from the commit message, where keys are the trailer keys and values are all the values given for each key.
+	Trailers map[string][]string `json:"trailers,omitempty" yaml:"trailers,omitempty"`
+
 	// The commit SHA-1 identifier.
 	Sha string `json:"sha,omitempty" yaml:"sha,omitempty"`
 }
@@ -55,7 +68,7 @@ Arguments are as follows:
 - parents the SHA-1 identifiers of parent commits. If the commit has no parents an empty list must be passed
 - authorAction the value holder about the author
 - commitAction the value holder about the committer
-- message the commit message
+- message the commit message. Trailers are parsed from the full message
 - tags the tags applied to this commit. If the commit has no tags an empty set must be passed
 */
 func NewCommitWith(sha string, date int64, parents []string, authorAction Action, commitAction Action, message Message, tags []Tag) *Commit {
@@ -67,6 +80,7 @@ func NewCommitWith(sha string, date int64, parents []string, authorAction Action
 	c.Message = message
 	c.Parents = parents
 	c.Tags = tags
+	c.Trailers = ParseTrailers(message.GetFullMessage())
 	c.Sha = sha
 
 	return &c
@@ -86,6 +100,20 @@ func (c Commit) GetCommitAction() Action {
 	return c.CommitAction
 }
 
+/*
+Returns the files changed by the commit, or nil if the changes have not been computed.
+*/
+func (c Commit) GetChanges() []FileChange {
+	return c.Changes
+}
+
+/*
+Sets the files changed by the commit.
+*/
+func (c *Commit) SetChanges(changes []FileChange) {
+	c.Changes = changes
+}
+
 /*
 Returns the commit date.
 */
@@ -107,6 +135,20 @@ func (c Commit) GetParents() []string {
 	return c.Parents
 }
 
+/*
+Returns the signature of the commit, or nil if the signature has not been verified.
+*/
+func (c Commit) GetSignature() *Signature {
+	return c.Signature
+}
+
+/*
+Sets the signature of the commit, resulting from its verification.
+*/
+func (c *Commit) SetSignature(signature *Signature) {
+	c.Signature = signature
+}
+
 /*
 Returns the immutable list of tags pointing to this commit.
 */
@@ -114,6 +156,31 @@ func (c Commit) GetTags() []Tag {
 	return c.Tags
 }
 
+/*
+Returns the values of the trailer with the given key, in the same order as they appear in the message, or nil if the commit
+has no such trailer. Keys are matched regardless of their case so 'Co-authored-by' and 'Co-Authored-By' are the same trailer.
+
+Arguments are as follows:
+
+- key the trailer key
+*/
+func (c Commit) GetTrailer(key string) []string {
+	var res []string
+	for k, values := range c.Trailers {
+		if strings.EqualFold(k, key) {
+			res = append(res, values...)
+		}
+	}
+	return res
+}
+
+/*
+Returns the trailers parsed from the commit message, where keys are the trailer keys and values are all the values given for each key.
+*/
+func (c Commit) GetTrailers() map[string][]string {
+	return c.Trailers
+}
+
 /*
 Returns the SHA-1 identifier for the commit.
 */
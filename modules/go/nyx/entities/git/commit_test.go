@@ -58,4 +58,28 @@ func TestNewCommitWith(t *testing.T) {
 	message1 := commit.GetMessage()
 	assert.Equal(t, "full", message1.GetFullMessage())
 	assert.Equal(t, 2, len(commit.GetTags()))
+	assert.Nil(t, commit.GetSignature())
+
+	commit.SetSignature(NewSignatureWith(true, true, "signer"))
+	assert.True(t, commit.GetSignature().IsValid())
+	assert.Equal(t, "signer", commit.GetSignature().GetSigner())
+
+	assert.Nil(t, commit.GetChanges())
+	commit.SetChanges([]FileChange{*NewFileChangeWith("README.md", 2, 1)})
+	assert.Equal(t, 1, len(commit.GetChanges()))
+	assert.Equal(t, "README.md", commit.GetChanges()[0].GetPath())
+}
+
+func TestCommitTrailers(t *testing.T) {
+	timeStamp := NewTimeStampFrom(time.Now())
+	action := NewActionWith(*NewIdentityWith("author", ""), *timeStamp)
+	message := NewMessageWith("header\n\nSigned-off-by: Jim <jim@example.com>\nCo-authored-by: Sam <sam@example.com>\nCo-Authored-By: Tom <tom@example.com>", "header", map[string]string{})
+
+	commit := NewCommitWith("f9422bd6e5b0ac0ab0df2bffc280c3d4caa11b44", 999999, []string{}, *action, *action, *message, []Tag{})
+
+	assert.Equal(t, 3, len(commit.GetTrailers()))
+	assert.Equal(t, []string{"Jim <jim@example.com>"}, commit.GetTrailer("Signed-off-by"))
+	assert.Equal(t, []string{"Jim <jim@example.com>"}, commit.GetTrailer("signed-off-by"))
+	assert.ElementsMatch(t, []string{"Sam <sam@example.com>", "Tom <tom@example.com>"}, commit.GetTrailer("Co-authored-by"))
+	assert.Nil(t, commit.GetTrailer("Reviewed-by"))
 }
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+)
+
+/*
+This object is a value holder independent from the underlying Git implementation, bringing the changes made by
+a commit to a single file.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type FileChange struct {
+	// The number of lines added to the file.
+	Additions int `json:"additions,omitempty" yaml:"additions,omitempty"`
+
+	// The number of lines deleted from the file.
+	Deletions int `json:"deletions,omitempty" yaml:"deletions,omitempty"`
+
+	// The path of the file, relative to the repository root.
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- path the path of the file, relative to the repository root and using the forward slash as the separator
+- additions the number of lines added to the file
+- deletions the number of lines deleted from the file
+*/
+func NewFileChangeWith(path string, additions int, deletions int) *FileChange {
+	fc := FileChange{}
+
+	fc.Path = path
+	fc.Additions = additions
+	fc.Deletions = deletions
+
+	return &fc
+}
+
+/*
+Returns the number of lines added to the file. Binary files have no lines so they always return 0.
+*/
+func (fc FileChange) GetAdditions() int {
+	return fc.Additions
+}
+
+/*
+Returns the number of lines deleted from the file. Binary files have no lines so they always return 0.
+*/
+func (fc FileChange) GetDeletions() int {
+	return fc.Deletions
+}
+
+/*
+Returns the path of the file, relative to the repository root and using the forward slash as the separator.
+*/
+func (fc FileChange) GetPath() string {
+	return fc.Path
+}
+
+/*
+Returns the string representation of the file change.
+*/
+func (fc FileChange) String() string {
+	return fmt.Sprintf("%s (+%d -%d)", fc.Path, fc.Additions, fc.Deletions)
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+This class maps allowed values for the hash algorithms (object formats) used by Git repositories to identify objects.
+*/
+type HashAlgorithm string
+
+const (
+	// The SHA-1 algorithm, used by most repositories, with 40 characters long identifiers.
+	SHA1 HashAlgorithm = "SHA1"
+
+	// The SHA-256 algorithm, used by repositories created with the 'sha256' object format, with 64 characters long identifiers.
+	SHA256 HashAlgorithm = "SHA256"
+)
+
+/*
+Returns the string representation of the hash algorithm
+*/
+func (ha HashAlgorithm) String() string {
+	switch ha {
+	case SHA1:
+		return "SHA1"
+	case SHA256:
+		return "SHA256"
+	default:
+		// this is never reached, but in case...
+		panic("unknown HashAlgorithm. This means the switch/case statement needs to be updated")
+	}
+}
+
+/*
+Returns the number of hexadecimal characters of the full (non abbreviated) object identifiers produced by the hash algorithm.
+*/
+func (ha HashAlgorithm) GetHexLength() int {
+	switch ha {
+	case SHA1:
+		return 40
+	case SHA256:
+		return 64
+	default:
+		// this is never reached, but in case...
+		panic("unknown HashAlgorithm. This means the switch/case statement needs to be updated")
+	}
+}
+
+/*
+Returns the hash algorithm corresponding to the given string. The string is matched regardless of its case
+so that the object format names used by Git (like 'sha1' and 'sha256') are also accepted.
+
+Errors can be:
+
+- IllegalPropertyError in case an unknown hash algorithm is passed
+*/
+func ValueOfHashAlgorithm(s string) (HashAlgorithm, error) {
+	switch strings.ToUpper(s) {
+	case "SHA1":
+		return SHA1, nil
+	case "SHA256":
+		return SHA256, nil
+	default:
+		return SHA1, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal hash algorithm '%s'", s)}
+	}
+}
+
+/*
+Returns the hash algorithm that produced the given full (non abbreviated) object identifier, based on its length.
+
+Errors can be:
+
+- IllegalArgumentError in case the given identifier is abbreviated or its length doesn't match any known hash algorithm
+*/
+func HashAlgorithmOf(id string) (HashAlgorithm, error) {
+	for _, ha := range []HashAlgorithm{SHA1, SHA256} {
+		if len(id) == ha.GetHexLength() {
+			return ha, nil
+		}
+	}
+	return SHA1, &errs.IllegalArgumentError{Message: fmt.Sprintf("the length of the object identifier '%s' doesn't match any known hash algorithm", id)}
+}
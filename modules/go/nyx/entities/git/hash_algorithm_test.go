@@ -0,0 +1,61 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"strings" // https://pkg.go.dev/strings
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+func TestHashAlgorithmString(t *testing.T) {
+	assert.Equal(t, "SHA1", SHA1.String())
+	assert.Equal(t, "SHA256", SHA256.String())
+}
+
+func TestHashAlgorithmGetHexLength(t *testing.T) {
+	assert.Equal(t, 40, SHA1.GetHexLength())
+	assert.Equal(t, 64, SHA256.GetHexLength())
+}
+
+func TestHashAlgorithmValueOfHashAlgorithm(t *testing.T) {
+	hashAlgorithm, err := ValueOfHashAlgorithm("SHA1")
+	assert.NoError(t, err)
+	assert.Equal(t, SHA1, hashAlgorithm)
+	hashAlgorithm, err = ValueOfHashAlgorithm("sha256")
+	assert.NoError(t, err)
+	assert.Equal(t, SHA256, hashAlgorithm)
+	_, err = ValueOfHashAlgorithm("MD5")
+	assert.Error(t, err)
+}
+
+func TestHashAlgorithmOf(t *testing.T) {
+	hashAlgorithm, err := HashAlgorithmOf("f9422bd6e5b0ac0ab0df2bffc280c3d4caa11b44")
+	assert.NoError(t, err)
+	assert.Equal(t, SHA1, hashAlgorithm)
+	hashAlgorithm, err = HashAlgorithmOf(strings.Repeat("a1", 32))
+	assert.NoError(t, err)
+	assert.Equal(t, SHA256, hashAlgorithm)
+	_, err = HashAlgorithmOf("f9422bd")
+	assert.Error(t, err)
+}
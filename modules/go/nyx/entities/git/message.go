@@ -16,6 +16,16 @@
 
 package git
 
+import (
+	"regexp"  // https://pkg.go.dev/regexp
+	"strings" // https://pkg.go.dev/strings
+)
+
+var (
+	// The regular expression matching a trailer line, capturing the key and the value.
+	trailerRegex = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*)[ \t]*:[ \t]*(.*)$`)
+)
+
 /*
 This object is a Git commit message value holder independent from the underlying Git implementation.
 
@@ -83,3 +93,42 @@ func (m Message) String() string {
 		return m.ShortMessage + " ..."
 	}
 }
+
+/*
+Returns the trailers (like 'Signed-off-by' or 'Co-authored-by') parsed from the given message, where keys are the trailer
+keys as they appear in the message and values are all the values given for the same key, in the same order as they appear.
+
+Like Git does, trailers are only searched in the last paragraph of the message, which must not be the first one (the header),
+and only when all of its lines are trailers. Lines starting with a whitespace are considered as continuations of the previous
+trailer value. The returned map is empty when the message has no trailers.
+
+Arguments are as follows:
+
+- message the full message
+*/
+func ParseTrailers(message string) map[string][]string {
+	trailers := make(map[string][]string)
+	paragraphs := strings.Split(strings.ReplaceAll(strings.TrimSpace(message), "\r\n", "\n"), "\n\n")
+	if len(paragraphs) < 2 {
+		return trailers
+	}
+
+	var keys []string
+	var values []string
+	for _, line := range strings.Split(paragraphs[len(paragraphs)-1], "\n") {
+		if len(keys) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			// it's the continuation of the previous trailer
+			values[len(values)-1] = values[len(values)-1] + " " + strings.TrimSpace(line)
+		} else if match := trailerRegex.FindStringSubmatch(line); match != nil {
+			keys = append(keys, match[1])
+			values = append(values, strings.TrimSpace(match[2]))
+		} else {
+			// the last paragraph is not a trailers block
+			return trailers
+		}
+	}
+	for i, key := range keys {
+		trailers[key] = append(trailers[key], values[i])
+	}
+	return trailers
+}
@@ -39,3 +39,19 @@ func TestNewMessageWith(t *testing.T) {
 
 	assert.Equal(t, "short ...", message.String())
 }
+
+func TestParseTrailers(t *testing.T) {
+	// messages with no trailers
+	assert.Equal(t, 0, len(ParseTrailers("")))
+	assert.Equal(t, 0, len(ParseTrailers("Signed-off-by: Jim <jim@example.com>")))
+	assert.Equal(t, 0, len(ParseTrailers("header\n\nsome body")))
+	assert.Equal(t, 0, len(ParseTrailers("header\n\nsome body\nSigned-off-by: Jim <jim@example.com>")))
+
+	// standard and custom trailers, with repeated keys and continuation lines
+	trailers := ParseTrailers("header\n\nsome body\n\nSigned-off-by: Jim <jim@example.com>\nCo-authored-by: Sam <sam@example.com>\nReviewed-by: Ann\nCo-authored-by: Tom <tom@example.com>\nIssue: 123\n  and 456\n")
+	assert.Equal(t, 4, len(trailers))
+	assert.Equal(t, []string{"Jim <jim@example.com>"}, trailers["Signed-off-by"])
+	assert.Equal(t, []string{"Sam <sam@example.com>", "Tom <tom@example.com>"}, trailers["Co-authored-by"])
+	assert.Equal(t, []string{"Ann"}, trailers["Reviewed-by"])
+	assert.Equal(t, []string{"123 and 456"}, trailers["Issue"])
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+/*
+This object is a Git remote value holder independent from the underlying Git implementation.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type Remote struct {
+	// The URL to fetch from.
+	FetchURL string `json:"fetchURL,omitempty" yaml:"fetchURL,omitempty"`
+
+	// The name.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// The URLs to push to.
+	PushURLs []string `json:"pushURLs,omitempty" yaml:"pushURLs,omitempty"`
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- name the remote name
+- fetchURL the URL to fetch from
+- pushURLs the URLs to push to
+*/
+func NewRemoteWith(name string, fetchURL string, pushURLs []string) *Remote {
+	r := Remote{}
+
+	r.Name = name
+	r.FetchURL = fetchURL
+	r.PushURLs = pushURLs
+
+	return &r
+}
+
+/*
+Returns the URL to fetch from.
+*/
+func (r Remote) GetFetchURL() string {
+	return r.FetchURL
+}
+
+/*
+Returns the name.
+*/
+func (r Remote) GetName() string {
+	return r.Name
+}
+
+/*
+Returns the URLs to push to. Unless push URLs are configured explicitly (with the 'pushurl' option) these
+are the same URLs used to fetch.
+*/
+func (r Remote) GetPushURLs() []string {
+	return r.PushURLs
+}
+
+/*
+Returns the string representation of the remote.
+*/
+func (r Remote) String() string {
+	return r.Name
+}
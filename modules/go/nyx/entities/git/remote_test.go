@@ -0,0 +1,40 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+func TestNewRemoteWith(t *testing.T) {
+	remote := NewRemoteWith("origin", "https://github.com/mooltiverse/nyx.git", []string{"git@github.com:mooltiverse/nyx.git"})
+
+	assert.Equal(t, "origin", remote.GetName())
+
+	assert.Equal(t, "https://github.com/mooltiverse/nyx.git", remote.GetFetchURL())
+
+	assert.Equal(t, []string{"git@github.com:mooltiverse/nyx.git"}, remote.GetPushURLs())
+
+	assert.Equal(t, "origin", remote.String())
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+/*
+This object is a Git signature value holder independent from the underlying Git implementation, bringing the
+result of the verification of the signature of a commit or a tag.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type Signature struct {
+	// The signed flag.
+	Signed bool `json:"signed,omitempty" yaml:"signed,omitempty"`
+
+	// The signer, if known.
+	Signer string `json:"signer,omitempty" yaml:"signer,omitempty"`
+
+	// The valid flag.
+	Valid bool `json:"valid,omitempty" yaml:"valid,omitempty"`
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- signed make it true if the object is signed, false otherwise
+- valid make it true if the signature has been successfully verified against a trusted key, false otherwise
+- signer the identity of the signer, if known, or an empty string
+*/
+func NewSignatureWith(signed bool, valid bool, signer string) *Signature {
+	s := Signature{}
+
+	s.Signed = signed
+	s.Valid = valid
+	s.Signer = signer
+
+	return &s
+}
+
+/*
+Returns true if the object is signed, regardless of whether the signature is valid or not.
+*/
+func (s Signature) IsSigned() bool {
+	return s.Signed
+}
+
+/*
+Returns true if the signature has been successfully verified against a trusted key.
+*/
+func (s Signature) IsValid() bool {
+	return s.Valid
+}
+
+/*
+Returns the identity of the signer, if known, or an empty string.
+*/
+func (s Signature) GetSigner() string {
+	return s.Signer
+}
+
+/*
+Returns the string representation of the signature.
+*/
+func (s Signature) String() string {
+	if !s.Signed {
+		return "unsigned"
+	} else if !s.Valid {
+		return "invalid"
+	} else if s.Signer == "" {
+		return "valid"
+	} else {
+		return "valid (" + s.Signer + ")"
+	}
+}
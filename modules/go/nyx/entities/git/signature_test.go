@@ -0,0 +1,49 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+func TestNewSignatureWith(t *testing.T) {
+	unsigned := NewSignatureWith(false, false, "")
+	invalid := NewSignatureWith(true, false, "")
+	valid := NewSignatureWith(true, true, "John Doe <jdoe@example.com>")
+
+	assert.False(t, unsigned.IsSigned())
+	assert.True(t, invalid.IsSigned())
+	assert.True(t, valid.IsSigned())
+
+	assert.False(t, unsigned.IsValid())
+	assert.False(t, invalid.IsValid())
+	assert.True(t, valid.IsValid())
+
+	assert.Equal(t, "", unsigned.GetSigner())
+	assert.Equal(t, "John Doe <jdoe@example.com>", valid.GetSigner())
+
+	assert.Equal(t, "unsigned", unsigned.String())
+	assert.Equal(t, "invalid", invalid.String())
+	assert.Equal(t, "valid (John Doe <jdoe@example.com>)", valid.String())
+}
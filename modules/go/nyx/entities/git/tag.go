@@ -26,9 +26,18 @@ type Tag struct {
 	// The annotated or lightweight flag.
 	Annotated bool `json:"annotated,omitempty" yaml:"annotated,omitempty"`
 
+	// The tag message, only available for annotated tags.
+	Message *Message `json:"message,omitempty" yaml:"message,omitempty"`
+
 	// The name.
 	Name string `json:"name,omitempty" yaml:"name,omitempty"`
 
+	// The signature, only available when the signature has been verified.
+	Signature *Signature `json:"signature,omitempty" yaml:"signature,omitempty"`
+
+	// The tagger identity and time stamp, only available for annotated tags.
+	Tagger *Action `json:"tagger,omitempty" yaml:"tagger,omitempty"`
+
 	// The tagged object ID.
 	Target string `json:"target,omitempty" yaml:"target,omitempty"`
 }
@@ -52,6 +61,25 @@ func NewTagWith(name string, target string, annotated bool) *Tag {
 	return &t
 }
 
+/*
+Constructor for annotated tags.
+
+Arguments are as follows:
+
+- name the simple name (without prefix)
+- target the ID (SHA-1) of the tagged object
+- message the tag message
+- tagger the tagger identity and time stamp
+*/
+func NewAnnotatedTagWith(name string, target string, message Message, tagger Action) *Tag {
+	t := NewTagWith(name, target, true)
+
+	t.Message = &message
+	t.Tagger = &tagger
+
+	return t
+}
+
 /*
 Returns true if this is an annotated tag, false if it's a lightweight tag.
 */
@@ -59,6 +87,13 @@ func (t Tag) IsAnnotated() bool {
 	return t.Annotated
 }
 
+/*
+Returns the tag message, or nil if this is a lightweight tag.
+*/
+func (t Tag) GetMessage() *Message {
+	return t.Message
+}
+
 /*
 Returns the name.
 */
@@ -66,6 +101,27 @@ func (t Tag) GetName() string {
 	return t.Name
 }
 
+/*
+Returns the signature of the tag, or nil if the signature has not been verified.
+*/
+func (t Tag) GetSignature() *Signature {
+	return t.Signature
+}
+
+/*
+Sets the signature of the tag, resulting from its verification.
+*/
+func (t *Tag) SetSignature(signature *Signature) {
+	t.Signature = signature
+}
+
+/*
+Returns the tagger identity and time stamp, or nil if this is a lightweight tag.
+*/
+func (t Tag) GetTagger() *Action {
+	return t.Tagger
+}
+
 /*
 Returns the ID (SHA-1) of the tagged object.
 */
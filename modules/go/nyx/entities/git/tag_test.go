@@ -23,6 +23,7 @@ package git
 
 import (
 	"testing" // https://pkg.go.dev/testing
+	"time"    // https://pkg.go.dev/time
 
 	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
 )
@@ -42,4 +43,26 @@ func TestNewReleaseTypeWith(t *testing.T) {
 
 	assert.Equal(t, "ltag", lightweightTag.String())
 	assert.Equal(t, "atag", annotatedTag.String())
+
+	assert.Nil(t, annotatedTag.GetSignature())
+	annotatedTag.SetSignature(NewSignatureWith(true, false, ""))
+	assert.True(t, annotatedTag.GetSignature().IsSigned())
+	assert.False(t, annotatedTag.GetSignature().IsValid())
+
+	assert.Nil(t, lightweightTag.GetMessage())
+	assert.Nil(t, lightweightTag.GetTagger())
+}
+
+func TestNewAnnotatedTagWith(t *testing.T) {
+	timeStamp := NewTimeStampFrom(time.Now())
+	annotatedTag := NewAnnotatedTagWith("atag", "target", *NewMessageWith("full\n\nbody", "full", map[string]string{}), *NewActionWith(*NewIdentityWith("Jim", "jim@example.com"), *timeStamp))
+
+	assert.Equal(t, "atag", annotatedTag.GetName())
+	assert.Equal(t, "target", annotatedTag.GetTarget())
+	assert.True(t, annotatedTag.IsAnnotated())
+	assert.Equal(t, "full\n\nbody", annotatedTag.GetMessage().GetFullMessage())
+	assert.Equal(t, "full", annotatedTag.GetMessage().GetShortMessage())
+	assert.Equal(t, "Jim", annotatedTag.GetTagger().GetIdentity().GetName())
+	assert.Equal(t, "jim@example.com", annotatedTag.GetTagger().GetIdentity().GetEmail())
+	assert.Equal(t, *timeStamp, annotatedTag.GetTagger().GetTimeStamp())
 }
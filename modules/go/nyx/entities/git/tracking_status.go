@@ -0,0 +1,106 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+)
+
+/*
+This object is a Git branch tracking status value holder independent from the underlying Git implementation. It tells
+how many commits a local branch is ahead of and behind its upstream branch.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type TrackingStatus struct {
+	// The number of commits in the local branch that are not in the upstream branch.
+	Ahead int `json:"ahead" yaml:"ahead"`
+
+	// The number of commits in the upstream branch that are not in the local branch.
+	Behind int `json:"behind" yaml:"behind"`
+
+	// The local branch name.
+	Branch string `json:"branch,omitempty" yaml:"branch,omitempty"`
+
+	// The upstream branch name.
+	Upstream string `json:"upstream,omitempty" yaml:"upstream,omitempty"`
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- branch the local branch name
+- upstream the upstream branch name (i.e. 'origin/main')
+- ahead the number of commits in the local branch that are not in the upstream branch
+- behind the number of commits in the upstream branch that are not in the local branch
+*/
+func NewTrackingStatusWith(branch string, upstream string, ahead int, behind int) *TrackingStatus {
+	s := TrackingStatus{}
+
+	s.Branch = branch
+	s.Upstream = upstream
+	s.Ahead = ahead
+	s.Behind = behind
+
+	return &s
+}
+
+/*
+Returns the number of commits in the local branch that are not in the upstream branch.
+*/
+func (s TrackingStatus) GetAhead() int {
+	return s.Ahead
+}
+
+/*
+Returns the number of commits in the upstream branch that are not in the local branch.
+*/
+func (s TrackingStatus) GetBehind() int {
+	return s.Behind
+}
+
+/*
+Returns the local branch name.
+*/
+func (s TrackingStatus) GetBranch() string {
+	return s.Branch
+}
+
+/*
+Returns the upstream branch name.
+*/
+func (s TrackingStatus) GetUpstream() string {
+	return s.Upstream
+}
+
+/*
+Returns the string representation of the tracking status, in the same format used by 'git status --branch --short'.
+*/
+func (s TrackingStatus) String() string {
+	res := fmt.Sprintf("%s...%s", s.Branch, s.Upstream)
+	if s.Ahead > 0 && s.Behind > 0 {
+		res = res + fmt.Sprintf(" [ahead %d, behind %d]", s.Ahead, s.Behind)
+	} else if s.Ahead > 0 {
+		res = res + fmt.Sprintf(" [ahead %d]", s.Ahead)
+	} else if s.Behind > 0 {
+		res = res + fmt.Sprintf(" [behind %d]", s.Behind)
+	}
+	return res
+}
@@ -0,0 +1,48 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+func TestNewTrackingStatusWith(t *testing.T) {
+	status := NewTrackingStatusWith("main", "origin/main", 2, 1)
+
+	assert.Equal(t, "main", status.GetBranch())
+
+	assert.Equal(t, "origin/main", status.GetUpstream())
+
+	assert.Equal(t, 2, status.GetAhead())
+
+	assert.Equal(t, 1, status.GetBehind())
+
+	assert.Equal(t, "main...origin/main [ahead 2, behind 1]", status.String())
+}
+
+func TestTrackingStatusString(t *testing.T) {
+	assert.Equal(t, "main...origin/main", NewTrackingStatusWith("main", "origin/main", 0, 0).String())
+	assert.Equal(t, "main...origin/main [ahead 3]", NewTrackingStatusWith("main", "origin/main", 3, 0).String())
+	assert.Equal(t, "main...origin/main [behind 4]", NewTrackingStatusWith("main", "origin/main", 0, 4).String())
+}
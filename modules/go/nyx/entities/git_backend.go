@@ -0,0 +1,76 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+This class maps allowed values for the Git implementation (backend) used to access repositories.
+*/
+type GitBackend string
+
+const (
+	// The backend using the go-git library, embedded in Nyx.
+	GO_GIT GitBackend = "GO_GIT"
+
+	// The backend running the git executable available in the PATH.
+	CLI GitBackend = "CLI"
+
+	// The read only backend using the APIs of a hosting service, without a local repository.
+	REMOTE GitBackend = "REMOTE"
+)
+
+/*
+Returns the string representation of the Git backend
+*/
+func (gb GitBackend) String() string {
+	switch gb {
+	case GO_GIT:
+		return "GO_GIT"
+	case CLI:
+		return "CLI"
+	case REMOTE:
+		return "REMOTE"
+	default:
+		// this is never reached, but in case...
+		panic("unknown GitBackend. This means the switch/case statement needs to be updated")
+	}
+}
+
+/*
+Returns the Git backend corresponding to the given string.
+
+Errors can be:
+
+- IllegalPropertyError in case an unknown Git backend is passed
+*/
+func ValueOfGitBackend(s string) (GitBackend, error) {
+	switch s {
+	case "GO_GIT":
+		return GO_GIT, nil
+	case "CLI":
+		return CLI, nil
+	case "REMOTE":
+		return REMOTE, nil
+	default:
+		return GO_GIT, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal Git backend '%s'", s)}
+	}
+}
@@ -29,8 +29,72 @@ This structure is JSON and YAML aware so all objects are properly managed for ma
 as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
 */
 type GitConfiguration struct {
+	// The map of extra headers to add to requests sent to HTTP and HTTPS remotes.
+	Headers *map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+
+	// The default identity used for the commits and tags created by Nyx.
+	Identity *GitIdentityConfiguration `json:"identity,omitempty" yaml:"identity,omitempty"`
+
+	// The optional URL of the proxy to use for HTTP and HTTPS remotes.
+	Proxy *string `json:"proxy,omitempty" yaml:"proxy,omitempty"`
+
 	// The map of remotes configuration options.
 	Remotes *map[string]*GitRemoteConfiguration `json:"remotes,omitempty" yaml:"remotes,omitempty"`
+
+	// The optional flag telling whether clones only fetch the branch to check out.
+	SingleBranch *bool `json:"singleBranch,omitempty" yaml:"singleBranch,omitempty"`
+
+	// The optional flag telling whether tags are fetched from the remote before inferring the version.
+	FetchTags *bool `json:"fetchTags,omitempty" yaml:"fetchTags,omitempty"`
+
+	// The optional flag telling whether shallow repositories are automatically unshallowed when the commit history walk reaches the shallow boundary.
+	Unshallow *bool `json:"unshallow,omitempty" yaml:"unshallow,omitempty"`
+
+	// The optional flag telling whether clones are bare mirrors of the remote repository.
+	Mirror *bool `json:"mirror,omitempty" yaml:"mirror,omitempty"`
+
+	// The optional Git implementation used to access repositories.
+	Backend *GitBackend `json:"backend,omitempty" yaml:"backend,omitempty"`
+
+	// The optional name of the service used to access the repository when using the REMOTE backend.
+	Service *string `json:"service,omitempty" yaml:"service,omitempty"`
+
+	// The optional armored PGP public keys used to verify the signatures of commits and tags.
+	TrustedKeys *string `json:"trustedKeys,omitempty" yaml:"trustedKeys,omitempty"`
+
+	// The optional timestamp (seconds since the epoch) used for the commits and tags created by Nyx.
+	Timestamp *string `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+
+	// The optional flag telling whether hooks are bypassed when Nyx creates commits, like 'git commit --no-verify'.
+	NoVerify *bool `json:"noVerify,omitempty" yaml:"noVerify,omitempty"`
+
+	// The optional directory to look up hooks from when Nyx creates commits, overriding the 'core.hooksPath' Git option.
+	HooksPath *string `json:"hooksPath,omitempty" yaml:"hooksPath,omitempty"`
+
+	// The optional mode telling which changes to submodules are ignored when checking the repository status and
+	// staging, like 'git status --ignore-submodules'.
+	IgnoreSubmodules *string `json:"ignoreSubmodules,omitempty" yaml:"ignoreSubmodules,omitempty"`
+
+	// The optional flag telling whether the Mark command stashes the uncommitted changes unrelated to the release
+	// before committing and tagging and restores them afterwards.
+	Stash *bool `json:"stash,omitempty" yaml:"stash,omitempty"`
+
+	// The optional maximum number of seconds each operation connecting to a remote (cloning, fetching, pushing and
+	// listing the remote references) is allowed to take.
+	Timeout *string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// The optional number of times each operation connecting to a remote is retried when it fails for transient reasons.
+	Retries *string `json:"retries,omitempty" yaml:"retries,omitempty"`
+
+	// The optional number of seconds to wait before the first retry of an operation connecting to a remote.
+	RetryDelay *string `json:"retryDelay,omitempty" yaml:"retryDelay,omitempty"`
+
+	// The optional flag telling whether the Infer and Mark commands lock the repository so that concurrent runs
+	// can't interfere with each other.
+	Lock *bool `json:"lock,omitempty" yaml:"lock,omitempty"`
+
+	// The optional maximum number of seconds to wait for the repository lock when it's held by another run.
+	LockTimeout *string `json:"lockTimeout,omitempty" yaml:"lockTimeout,omitempty"`
 }
 
 /*
@@ -47,20 +111,68 @@ Standard constructor.
 
 Arguments are as follows:
 
+- headers the map of extra headers to add to requests sent to HTTP and HTTPS remotes. It may be nil
+- identity the default identity used for the commits and tags created by Nyx. It may be nil
+- proxy the optional URL of the proxy to use for HTTP and HTTPS remotes.
 - remotes the map of remotes configuration options.
+- singleBranch the optional flag telling whether clones only fetch the branch to check out. It may be nil
+- fetchTags the optional flag telling whether tags are fetched from the remote before inferring the version. It may be nil
+- unshallow the optional flag telling whether shallow repositories are automatically unshallowed when the commit history walk reaches the shallow boundary. It may be nil
+- mirror the optional flag telling whether clones are bare mirrors of the remote repository. It may be nil
+- backend the optional Git implementation used to access repositories. It may be nil
+- service the optional name of the service used to access the repository when using the REMOTE backend. It may be nil
+- trustedKeys the optional armored PGP public keys used to verify the signatures of commits and tags. It may be nil
+- timestamp the optional timestamp (seconds since the epoch) used for the commits and tags created by Nyx. It may be nil
+- noVerify the optional flag telling whether hooks are bypassed when Nyx creates commits, like 'git commit --no-verify'. It may be nil
+- hooksPath the optional directory to look up hooks from when Nyx creates commits, overriding the 'core.hooksPath' Git option. It may be nil
+- ignoreSubmodules the optional mode telling which changes to submodules are ignored when checking the repository status and staging (none, untracked, dirty or all). It may be nil
+- stash the optional flag telling whether the Mark command stashes the uncommitted changes unrelated to the release before committing and tagging and restores them afterwards. It may be nil
+- timeout the optional maximum number of seconds each operation connecting to a remote (cloning, fetching, pushing and listing the remote references) is allowed to take. It may be nil
+- retries the optional number of times each operation connecting to a remote is retried when it fails for transient reasons. It may be nil
+- retryDelay the optional number of seconds to wait before the first retry of an operation connecting to a remote, doubling at every further retry. It may be nil
+- lock the optional flag telling whether the Infer and Mark commands lock the repository so that concurrent runs can't interfere with each other. It may be nil
+- lockTimeout the optional maximum number of seconds to wait for the repository lock when it's held by another run. It may be nil
 
 Errors can be:
 
-- NilPointerError in case the given parameter is nil
+- NilPointerError in case the remotes parameter is nil
 */
-func NewGitConfigurationWith(remotes *map[string]*GitRemoteConfiguration) (*GitConfiguration, error) {
+func NewGitConfigurationWith(headers *map[string]string, identity *GitIdentityConfiguration, proxy *string, remotes *map[string]*GitRemoteConfiguration, singleBranch *bool, fetchTags *bool, unshallow *bool, mirror *bool, backend *GitBackend, service *string, trustedKeys *string, timestamp *string, noVerify *bool, hooksPath *string, ignoreSubmodules *string, stash *bool, timeout *string, retries *string, retryDelay *string, lock *bool, lockTimeout *string) (*GitConfiguration, error) {
 	gc := GitConfiguration{}
 
 	if remotes == nil {
 		return nil, &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "remotes")}
 	}
 
+	gc.Headers = headers
+	gc.Identity = identity
+	gc.Proxy = proxy
 	gc.Remotes = remotes
+	gc.SingleBranch = singleBranch
+	gc.FetchTags = fetchTags
+	gc.Unshallow = unshallow
+	gc.Mirror = mirror
+	gc.Backend = backend
+	gc.Service = service
+	gc.TrustedKeys = trustedKeys
+	gc.Timestamp = timestamp
+	gc.NoVerify = noVerify
+	gc.HooksPath = hooksPath
+	gc.IgnoreSubmodules = ignoreSubmodules
+	gc.Stash = stash
+	gc.Timeout = timeout
+	gc.Retries = retries
+	gc.RetryDelay = retryDelay
+	gc.Lock = lock
+	gc.LockTimeout = lockTimeout
+
+	if gc.Headers == nil {
+		h := make(map[string]string)
+		gc.Headers = &h
+	}
+	if gc.Identity == nil {
+		gc.Identity = NewGitIdentityConfiguration()
+	}
 
 	return &gc, nil
 }
@@ -69,7 +181,85 @@ func NewGitConfigurationWith(remotes *map[string]*GitRemoteConfiguration) (*GitC
 Loads default values on the target instance
 */
 func (gc *GitConfiguration) setDefaults() {
+	gc.Headers = &map[string]string{}
+	gc.Identity = NewGitIdentityConfiguration()
+	gc.Proxy = GIT_PROXY
 	gc.Remotes = &map[string]*GitRemoteConfiguration{}
+	gc.SingleBranch = GIT_SINGLE_BRANCH
+	gc.FetchTags = GIT_FETCH_TAGS
+	gc.Unshallow = GIT_UNSHALLOW
+	gc.Mirror = GIT_MIRROR
+	gc.Backend = GIT_BACKEND
+	gc.Service = GIT_SERVICE
+	gc.TrustedKeys = GIT_TRUSTED_KEYS
+	gc.Timestamp = GIT_TIMESTAMP
+	gc.NoVerify = GIT_NO_VERIFY
+	gc.HooksPath = GIT_HOOKS_PATH
+	gc.IgnoreSubmodules = GIT_IGNORE_SUBMODULES
+	gc.Stash = GIT_STASH
+	gc.Timeout = GIT_TIMEOUT
+	gc.Retries = GIT_RETRIES
+	gc.RetryDelay = GIT_RETRY_DELAY
+	gc.Lock = GIT_LOCK
+	gc.LockTimeout = GIT_LOCK_TIMEOUT
+}
+
+/*
+Returns the map of extra headers to add to requests sent to HTTP and HTTPS remotes.
+*/
+func (gc *GitConfiguration) GetHeaders() *map[string]string {
+	return gc.Headers
+}
+
+/*
+Sets the map of extra headers to add to requests sent to HTTP and HTTPS remotes.
+
+Errors can be:
+
+- NilPointerError in case the given parameter is nil
+*/
+func (gc *GitConfiguration) SetHeaders(headers *map[string]string) error {
+	if headers == nil {
+		return &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "headers")}
+	}
+	gc.Headers = headers
+	return nil
+}
+
+/*
+Returns the default identity used for the commits and tags created by Nyx.
+*/
+func (gc *GitConfiguration) GetIdentity() *GitIdentityConfiguration {
+	return gc.Identity
+}
+
+/*
+Sets the default identity used for the commits and tags created by Nyx.
+
+Errors can be:
+
+- NilPointerError in case the given parameter is nil
+*/
+func (gc *GitConfiguration) SetIdentity(identity *GitIdentityConfiguration) error {
+	if identity == nil {
+		return &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "identity")}
+	}
+	gc.Identity = identity
+	return nil
+}
+
+/*
+Returns the optional URL of the proxy to use for HTTP and HTTPS remotes.
+*/
+func (gc *GitConfiguration) GetProxy() *string {
+	return gc.Proxy
+}
+
+/*
+Sets the optional URL of the proxy to use for HTTP and HTTPS remotes.
+*/
+func (gc *GitConfiguration) SetProxy(proxy *string) {
+	gc.Proxy = proxy
 }
 
 /*
@@ -93,3 +283,251 @@ func (gc *GitConfiguration) SetRemotes(remotes *map[string]*GitRemoteConfigurati
 	gc.Remotes = remotes
 	return nil
 }
+
+/*
+Returns the optional flag telling whether clones only fetch the branch to check out.
+*/
+func (gc *GitConfiguration) GetSingleBranch() *bool {
+	return gc.SingleBranch
+}
+
+/*
+Sets the optional flag telling whether clones only fetch the branch to check out.
+*/
+func (gc *GitConfiguration) SetSingleBranch(singleBranch *bool) {
+	gc.SingleBranch = singleBranch
+}
+
+/*
+Returns the optional flag telling whether tags are fetched from the remote before inferring the version.
+*/
+func (gc *GitConfiguration) GetFetchTags() *bool {
+	return gc.FetchTags
+}
+
+/*
+Sets the optional flag telling whether tags are fetched from the remote before inferring the version.
+*/
+func (gc *GitConfiguration) SetFetchTags(fetchTags *bool) {
+	gc.FetchTags = fetchTags
+}
+
+/*
+Returns the optional flag telling whether shallow repositories are automatically unshallowed when the commit history walk reaches the shallow boundary.
+*/
+func (gc *GitConfiguration) GetUnshallow() *bool {
+	return gc.Unshallow
+}
+
+/*
+Sets the optional flag telling whether shallow repositories are automatically unshallowed when the commit history walk reaches the shallow boundary.
+*/
+func (gc *GitConfiguration) SetUnshallow(unshallow *bool) {
+	gc.Unshallow = unshallow
+}
+
+/*
+Returns the optional flag telling whether clones are bare mirrors of the remote repository.
+*/
+func (gc *GitConfiguration) GetMirror() *bool {
+	return gc.Mirror
+}
+
+/*
+Sets the optional flag telling whether clones are bare mirrors of the remote repository.
+*/
+func (gc *GitConfiguration) SetMirror(mirror *bool) {
+	gc.Mirror = mirror
+}
+
+/*
+Returns the optional Git implementation used to access repositories.
+*/
+func (gc *GitConfiguration) GetBackend() *GitBackend {
+	return gc.Backend
+}
+
+/*
+Sets the optional Git implementation used to access repositories.
+*/
+func (gc *GitConfiguration) SetBackend(backend *GitBackend) {
+	gc.Backend = backend
+}
+
+/*
+Returns the optional name of the service used to access the repository when using the REMOTE backend.
+*/
+func (gc *GitConfiguration) GetService() *string {
+	return gc.Service
+}
+
+/*
+Sets the optional name of the service used to access the repository when using the REMOTE backend.
+*/
+func (gc *GitConfiguration) SetService(service *string) {
+	gc.Service = service
+}
+
+/*
+Returns the optional armored PGP public keys used to verify the signatures of commits and tags.
+*/
+func (gc *GitConfiguration) GetTrustedKeys() *string {
+	return gc.TrustedKeys
+}
+
+/*
+Sets the optional armored PGP public keys used to verify the signatures of commits and tags.
+*/
+func (gc *GitConfiguration) SetTrustedKeys(trustedKeys *string) {
+	gc.TrustedKeys = trustedKeys
+}
+
+/*
+Returns the optional timestamp (seconds since the epoch) used for the commits and tags created by Nyx.
+*/
+func (gc *GitConfiguration) GetTimestamp() *string {
+	return gc.Timestamp
+}
+
+/*
+Sets the optional timestamp (seconds since the epoch) used for the commits and tags created by Nyx.
+*/
+func (gc *GitConfiguration) SetTimestamp(timestamp *string) {
+	gc.Timestamp = timestamp
+}
+
+/*
+Returns the optional flag telling whether hooks are bypassed when Nyx creates commits, like 'git commit --no-verify'.
+*/
+func (gc *GitConfiguration) GetNoVerify() *bool {
+	return gc.NoVerify
+}
+
+/*
+Sets the optional flag telling whether hooks are bypassed when Nyx creates commits, like 'git commit --no-verify'.
+*/
+func (gc *GitConfiguration) SetNoVerify(noVerify *bool) {
+	gc.NoVerify = noVerify
+}
+
+/*
+Returns the optional directory to look up hooks from when Nyx creates commits, overriding the 'core.hooksPath' Git option.
+*/
+func (gc *GitConfiguration) GetHooksPath() *string {
+	return gc.HooksPath
+}
+
+/*
+Sets the optional directory to look up hooks from when Nyx creates commits, overriding the 'core.hooksPath' Git option.
+*/
+func (gc *GitConfiguration) SetHooksPath(hooksPath *string) {
+	gc.HooksPath = hooksPath
+}
+
+/*
+Returns the optional mode telling which changes to submodules are ignored when checking the repository status and
+staging, like 'git status --ignore-submodules'.
+*/
+func (gc *GitConfiguration) GetIgnoreSubmodules() *string {
+	return gc.IgnoreSubmodules
+}
+
+/*
+Sets the optional mode telling which changes to submodules are ignored when checking the repository status and
+staging, like 'git status --ignore-submodules'.
+*/
+func (gc *GitConfiguration) SetIgnoreSubmodules(ignoreSubmodules *string) {
+	gc.IgnoreSubmodules = ignoreSubmodules
+}
+
+/*
+Returns the optional flag telling whether the Mark command stashes the uncommitted changes unrelated to the release
+before committing and tagging and restores them afterwards.
+*/
+func (gc *GitConfiguration) GetStash() *bool {
+	return gc.Stash
+}
+
+/*
+Sets the optional flag telling whether the Mark command stashes the uncommitted changes unrelated to the release
+before committing and tagging and restores them afterwards.
+*/
+func (gc *GitConfiguration) SetStash(stash *bool) {
+	gc.Stash = stash
+}
+
+/*
+Returns the optional maximum number of seconds each operation connecting to a remote (cloning, fetching, pushing and
+listing the remote references) is allowed to take.
+*/
+func (gc *GitConfiguration) GetTimeout() *string {
+	return gc.Timeout
+}
+
+/*
+Sets the optional maximum number of seconds each operation connecting to a remote (cloning, fetching, pushing and
+listing the remote references) is allowed to take.
+*/
+func (gc *GitConfiguration) SetTimeout(timeout *string) {
+	gc.Timeout = timeout
+}
+
+/*
+Returns the optional number of times each operation connecting to a remote is retried when it fails for transient
+reasons.
+*/
+func (gc *GitConfiguration) GetRetries() *string {
+	return gc.Retries
+}
+
+/*
+Sets the optional number of times each operation connecting to a remote is retried when it fails for transient
+reasons.
+*/
+func (gc *GitConfiguration) SetRetries(retries *string) {
+	gc.Retries = retries
+}
+
+/*
+Returns the optional number of seconds to wait before the first retry of an operation connecting to a remote.
+*/
+func (gc *GitConfiguration) GetRetryDelay() *string {
+	return gc.RetryDelay
+}
+
+/*
+Sets the optional number of seconds to wait before the first retry of an operation connecting to a remote.
+*/
+func (gc *GitConfiguration) SetRetryDelay(retryDelay *string) {
+	gc.RetryDelay = retryDelay
+}
+
+/*
+Returns the optional flag telling whether the Infer and Mark commands lock the repository so that concurrent runs
+can't interfere with each other.
+*/
+func (gc *GitConfiguration) GetLock() *bool {
+	return gc.Lock
+}
+
+/*
+Sets the optional flag telling whether the Infer and Mark commands lock the repository so that concurrent runs
+can't interfere with each other.
+*/
+func (gc *GitConfiguration) SetLock(lock *bool) {
+	gc.Lock = lock
+}
+
+/*
+Returns the optional maximum number of seconds to wait for the repository lock when it's held by another run.
+*/
+func (gc *GitConfiguration) GetLockTimeout() *string {
+	return gc.LockTimeout
+}
+
+/*
+Sets the optional maximum number of seconds to wait for the repository lock when it's held by another run.
+*/
+func (gc *GitConfiguration) SetLockTimeout(lockTimeout *string) {
+	gc.LockTimeout = lockTimeout
+}
@@ -33,30 +33,97 @@ func TestGitConfigurationNewGitConfiguration(t *testing.T) {
 	gitConfiguration := NewGitConfiguration()
 
 	// default constructor has its fields set to default values
+	assert.Equal(t, 0, len(*gitConfiguration.GetHeaders()))
+	assert.NotNil(t, gitConfiguration.GetIdentity())
+	assert.Nil(t, gitConfiguration.GetIdentity().GetName())
+	assert.Nil(t, gitConfiguration.GetProxy())
 	assert.NotNil(t, gitConfiguration.GetRemotes())
+	assert.Nil(t, gitConfiguration.GetSingleBranch())
+	assert.Nil(t, gitConfiguration.GetFetchTags())
+	assert.Equal(t, true, *gitConfiguration.GetUnshallow())
 }
 
 func TestGitConfigurationNewGitConfigurationWith(t *testing.T) {
 	remotes := make(map[string]*GitRemoteConfiguration)
-	remotes["r1"] = NewGitRemoteConfigurationWith(PointerToAuthenticationMethod(USER_PASSWORD), utl.PointerToString("u1"), utl.PointerToString("p1"), utl.PointerToString("k1"), utl.PointerToString("h1"))
-	remotes["r2"] = NewGitRemoteConfigurationWith(PointerToAuthenticationMethod(PUBLIC_KEY), utl.PointerToString("u2"), utl.PointerToString("p2"), utl.PointerToString("k2"), utl.PointerToString("h2"))
+	remotes["r1"] = NewGitRemoteConfigurationWith(PointerToAuthenticationMethod(USER_PASSWORD), utl.PointerToString("u1"), utl.PointerToString("p1"), utl.PointerToString("k1"), utl.PointerToString("h1"), nil, nil, nil, nil, nil, nil, nil)
+	remotes["r2"] = NewGitRemoteConfigurationWith(PointerToAuthenticationMethod(PUBLIC_KEY), utl.PointerToString("u2"), utl.PointerToString("p2"), utl.PointerToString("k2"), utl.PointerToString("h2"), nil, nil, nil, nil, nil, nil, nil)
 
-	gitConfiguration, err := NewGitConfigurationWith(&remotes)
+	headers := map[string]string{"Authorization": "Basic OnRva2Vu"}
+	identity := NewGitIdentityConfigurationWith(utl.PointerToString("nyx[bot]@users.noreply.github.com"), utl.PointerToString("nyx[bot]"), PointerToProvider(GITHUB), nil, nil)
+
+	gitConfiguration, err := NewGitConfigurationWith(&headers, identity, utl.PointerToString("http://proxy.example.com:3128"), &remotes, utl.PointerToBoolean(true), utl.PointerToBoolean(true), utl.PointerToBoolean(false), utl.PointerToBoolean(true), PointerToGitBackend(CLI), utl.PointerToString("github"), utl.PointerToString("keys"), utl.PointerToString("1700000000"), utl.PointerToBoolean(true), utl.PointerToString(".githooks"), utl.PointerToString("dirty"), utl.PointerToBoolean(true), utl.PointerToString("30"), utl.PointerToString("3"), utl.PointerToString("5"), utl.PointerToBoolean(true), utl.PointerToString("60"))
 	assert.NoError(t, err)
 
+	assert.Equal(t, &headers, gitConfiguration.GetHeaders())
+	assert.Equal(t, identity, gitConfiguration.GetIdentity())
+	assert.Equal(t, "http://proxy.example.com:3128", *gitConfiguration.GetProxy())
 	assert.Equal(t, &remotes, gitConfiguration.GetRemotes())
+	assert.Equal(t, true, *gitConfiguration.GetSingleBranch())
+	assert.Equal(t, true, *gitConfiguration.GetFetchTags())
+	assert.Equal(t, false, *gitConfiguration.GetUnshallow())
+	assert.Equal(t, true, *gitConfiguration.GetMirror())
+	assert.Equal(t, CLI, *gitConfiguration.GetBackend())
+	assert.Equal(t, "github", *gitConfiguration.GetService())
+	assert.Equal(t, "keys", *gitConfiguration.GetTrustedKeys())
+	assert.Equal(t, "1700000000", *gitConfiguration.GetTimestamp())
+	assert.Equal(t, true, *gitConfiguration.GetNoVerify())
+	assert.Equal(t, ".githooks", *gitConfiguration.GetHooksPath())
+	assert.Equal(t, "dirty", *gitConfiguration.GetIgnoreSubmodules())
+	assert.Equal(t, true, *gitConfiguration.GetStash())
+	assert.Equal(t, "30", *gitConfiguration.GetTimeout())
+	assert.Equal(t, "3", *gitConfiguration.GetRetries())
+	assert.Equal(t, "5", *gitConfiguration.GetRetryDelay())
+	assert.Equal(t, true, *gitConfiguration.GetLock())
+	assert.Equal(t, "60", *gitConfiguration.GetLockTimeout())
+
+	// also test error conditions when nil parameters are passed
+	_, err = NewGitConfigurationWith(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	assert.NotNil(t, err)
+}
+
+func TestGitConfigurationGetHeaders(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	headers := map[string]string{"Authorization": "Basic OnRva2Vu"}
+
+	err := gitConfiguration.SetHeaders(&headers)
+	assert.NoError(t, err)
+	assert.Equal(t, &headers, gitConfiguration.GetHeaders())
 
 	// also test error conditions when nil parameters are passed
-	_, err = NewGitConfigurationWith(nil)
+	err = gitConfiguration.SetHeaders(nil)
 	assert.NotNil(t, err)
 }
 
+func TestGitConfigurationGetIdentity(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	identity := NewGitIdentityConfigurationWith(utl.PointerToString("jdoe@example.com"), utl.PointerToString("John Doe"), nil, nil, nil)
+
+	err := gitConfiguration.SetIdentity(identity)
+	assert.NoError(t, err)
+	assert.Equal(t, identity, gitConfiguration.GetIdentity())
+
+	// also test error conditions when nil parameters are passed
+	err = gitConfiguration.SetIdentity(nil)
+	assert.NotNil(t, err)
+}
+
+func TestGitConfigurationGetProxy(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	gitConfiguration.SetProxy(utl.PointerToString("http://proxy.example.com:3128"))
+	assert.Equal(t, "http://proxy.example.com:3128", *gitConfiguration.GetProxy())
+	gitConfiguration.SetProxy(nil)
+	assert.Nil(t, gitConfiguration.GetProxy())
+}
+
 func TestGitConfigurationGetRemotes(t *testing.T) {
 	gitConfiguration := NewGitConfiguration()
 
 	remotes := make(map[string]*GitRemoteConfiguration)
-	remotes["r1"] = NewGitRemoteConfigurationWith(PointerToAuthenticationMethod(USER_PASSWORD), utl.PointerToString("u1"), utl.PointerToString("p1"), utl.PointerToString("k1"), utl.PointerToString("h1"))
-	remotes["r2"] = NewGitRemoteConfigurationWith(PointerToAuthenticationMethod(PUBLIC_KEY), utl.PointerToString("u2"), utl.PointerToString("p2"), utl.PointerToString("k2"), utl.PointerToString("h2"))
+	remotes["r1"] = NewGitRemoteConfigurationWith(PointerToAuthenticationMethod(USER_PASSWORD), utl.PointerToString("u1"), utl.PointerToString("p1"), utl.PointerToString("k1"), utl.PointerToString("h1"), nil, nil, nil, nil, nil, nil, nil)
+	remotes["r2"] = NewGitRemoteConfigurationWith(PointerToAuthenticationMethod(PUBLIC_KEY), utl.PointerToString("u2"), utl.PointerToString("p2"), utl.PointerToString("k2"), utl.PointerToString("h2"), nil, nil, nil, nil, nil, nil, nil)
 
 	err := gitConfiguration.SetRemotes(&remotes)
 	assert.NoError(t, err)
@@ -66,3 +133,169 @@ func TestGitConfigurationGetRemotes(t *testing.T) {
 	err = gitConfiguration.SetRemotes(nil)
 	assert.NotNil(t, err)
 }
+
+func TestGitConfigurationGetSingleBranch(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	gitConfiguration.SetSingleBranch(utl.PointerToBoolean(true))
+	assert.Equal(t, true, *gitConfiguration.GetSingleBranch())
+	gitConfiguration.SetSingleBranch(nil)
+	assert.Nil(t, gitConfiguration.GetSingleBranch())
+}
+
+func TestGitConfigurationGetFetchTags(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	gitConfiguration.SetFetchTags(utl.PointerToBoolean(true))
+	assert.Equal(t, true, *gitConfiguration.GetFetchTags())
+	gitConfiguration.SetFetchTags(nil)
+	assert.Nil(t, gitConfiguration.GetFetchTags())
+}
+
+func TestGitConfigurationGetUnshallow(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	gitConfiguration.SetUnshallow(utl.PointerToBoolean(false))
+	assert.Equal(t, false, *gitConfiguration.GetUnshallow())
+	gitConfiguration.SetUnshallow(nil)
+	assert.Nil(t, gitConfiguration.GetUnshallow())
+}
+
+func TestGitConfigurationGetMirror(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	gitConfiguration.SetMirror(utl.PointerToBoolean(true))
+	assert.Equal(t, true, *gitConfiguration.GetMirror())
+	gitConfiguration.SetMirror(nil)
+	assert.Nil(t, gitConfiguration.GetMirror())
+}
+
+func TestGitConfigurationGetBackend(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Equal(t, GO_GIT, *gitConfiguration.GetBackend())
+	gitConfiguration.SetBackend(PointerToGitBackend(CLI))
+	assert.Equal(t, CLI, *gitConfiguration.GetBackend())
+	gitConfiguration.SetBackend(nil)
+	assert.Nil(t, gitConfiguration.GetBackend())
+}
+
+func TestGitConfigurationGetService(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetService())
+	gitConfiguration.SetService(utl.PointerToString("github"))
+	assert.Equal(t, "github", *gitConfiguration.GetService())
+	gitConfiguration.SetService(nil)
+	assert.Nil(t, gitConfiguration.GetService())
+}
+
+func TestGitConfigurationGetTrustedKeys(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetTrustedKeys())
+	gitConfiguration.SetTrustedKeys(utl.PointerToString("keys"))
+	assert.Equal(t, "keys", *gitConfiguration.GetTrustedKeys())
+	gitConfiguration.SetTrustedKeys(nil)
+	assert.Nil(t, gitConfiguration.GetTrustedKeys())
+}
+
+func TestGitConfigurationGetTimestamp(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetTimestamp())
+	gitConfiguration.SetTimestamp(utl.PointerToString("1700000000"))
+	assert.Equal(t, "1700000000", *gitConfiguration.GetTimestamp())
+	gitConfiguration.SetTimestamp(nil)
+	assert.Nil(t, gitConfiguration.GetTimestamp())
+}
+
+func TestGitConfigurationGetNoVerify(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetNoVerify())
+	gitConfiguration.SetNoVerify(utl.PointerToBoolean(true))
+	assert.Equal(t, true, *gitConfiguration.GetNoVerify())
+	gitConfiguration.SetNoVerify(nil)
+	assert.Nil(t, gitConfiguration.GetNoVerify())
+}
+
+func TestGitConfigurationGetHooksPath(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetHooksPath())
+	gitConfiguration.SetHooksPath(utl.PointerToString(".githooks"))
+	assert.Equal(t, ".githooks", *gitConfiguration.GetHooksPath())
+	gitConfiguration.SetHooksPath(nil)
+	assert.Nil(t, gitConfiguration.GetHooksPath())
+}
+
+func TestGitConfigurationGetIgnoreSubmodules(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetIgnoreSubmodules())
+	gitConfiguration.SetIgnoreSubmodules(utl.PointerToString("dirty"))
+	assert.Equal(t, "dirty", *gitConfiguration.GetIgnoreSubmodules())
+	gitConfiguration.SetIgnoreSubmodules(nil)
+	assert.Nil(t, gitConfiguration.GetIgnoreSubmodules())
+}
+
+func TestGitConfigurationGetStash(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetStash())
+	gitConfiguration.SetStash(utl.PointerToBoolean(true))
+	assert.Equal(t, true, *gitConfiguration.GetStash())
+	gitConfiguration.SetStash(nil)
+	assert.Nil(t, gitConfiguration.GetStash())
+}
+
+func TestGitConfigurationGetTimeout(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetTimeout())
+	gitConfiguration.SetTimeout(utl.PointerToString("30"))
+	assert.Equal(t, "30", *gitConfiguration.GetTimeout())
+	gitConfiguration.SetTimeout(nil)
+	assert.Nil(t, gitConfiguration.GetTimeout())
+}
+
+func TestGitConfigurationGetRetries(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetRetries())
+	gitConfiguration.SetRetries(utl.PointerToString("3"))
+	assert.Equal(t, "3", *gitConfiguration.GetRetries())
+	gitConfiguration.SetRetries(nil)
+	assert.Nil(t, gitConfiguration.GetRetries())
+}
+
+func TestGitConfigurationGetRetryDelay(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetRetryDelay())
+	gitConfiguration.SetRetryDelay(utl.PointerToString("5"))
+	assert.Equal(t, "5", *gitConfiguration.GetRetryDelay())
+	gitConfiguration.SetRetryDelay(nil)
+	assert.Nil(t, gitConfiguration.GetRetryDelay())
+}
+
+func TestGitConfigurationGetLock(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetLock())
+	gitConfiguration.SetLock(utl.PointerToBoolean(true))
+	assert.Equal(t, true, *gitConfiguration.GetLock())
+	gitConfiguration.SetLock(nil)
+	assert.Nil(t, gitConfiguration.GetLock())
+}
+
+func TestGitConfigurationGetLockTimeout(t *testing.T) {
+	gitConfiguration := NewGitConfiguration()
+
+	assert.Nil(t, gitConfiguration.GetLockTimeout())
+	gitConfiguration.SetLockTimeout(utl.PointerToString("60"))
+	assert.Equal(t, "60", *gitConfiguration.GetLockTimeout())
+	gitConfiguration.SetLockTimeout(nil)
+	assert.Nil(t, gitConfiguration.GetLockTimeout())
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+/*
+This object models the fields used to configure the default identity used for the commits and tags created by Nyx.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type GitIdentityConfiguration struct {
+	// The identity email.
+	Email *string `json:"email,omitempty" yaml:"email,omitempty"`
+
+	// The identity name.
+	Name *string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// The provider hosting the account the identity belongs to.
+	Provider *Provider `json:"provider,omitempty" yaml:"provider,omitempty"`
+
+	// The name of the environment variable to read the identity email from, when the email is not set.
+	EmailVariable *string `json:"emailVariable,omitempty" yaml:"emailVariable,omitempty"`
+
+	// The name of the environment variable to read the identity name from, when the name is not set.
+	NameVariable *string `json:"nameVariable,omitempty" yaml:"nameVariable,omitempty"`
+}
+
+/*
+Default constructor
+*/
+func NewGitIdentityConfiguration() *GitIdentityConfiguration {
+	return &GitIdentityConfiguration{}
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- email the identity email.
+- name the identity name.
+- provider the provider hosting the account the identity belongs to.
+- emailVariable the name of the environment variable to read the identity email from, when the email is not set.
+- nameVariable the name of the environment variable to read the identity name from, when the name is not set.
+*/
+func NewGitIdentityConfigurationWith(email *string, name *string, provider *Provider, emailVariable *string, nameVariable *string) *GitIdentityConfiguration {
+	gic := GitIdentityConfiguration{}
+
+	gic.Email = email
+	gic.Name = name
+	gic.Provider = provider
+	gic.EmailVariable = emailVariable
+	gic.NameVariable = nameVariable
+
+	return &gic
+}
+
+/*
+Returns the identity email.
+*/
+func (gic *GitIdentityConfiguration) GetEmail() *string {
+	return gic.Email
+}
+
+/*
+Sets the identity email.
+*/
+func (gic *GitIdentityConfiguration) SetEmail(email *string) {
+	gic.Email = email
+}
+
+/*
+Returns the identity name.
+*/
+func (gic *GitIdentityConfiguration) GetName() *string {
+	return gic.Name
+}
+
+/*
+Sets the identity name.
+*/
+func (gic *GitIdentityConfiguration) SetName(name *string) {
+	gic.Name = name
+}
+
+/*
+Returns the provider hosting the account the identity belongs to.
+*/
+func (gic *GitIdentityConfiguration) GetProvider() *Provider {
+	return gic.Provider
+}
+
+/*
+Sets the provider hosting the account the identity belongs to.
+*/
+func (gic *GitIdentityConfiguration) SetProvider(provider *Provider) {
+	gic.Provider = provider
+}
+
+/*
+Returns the name of the environment variable to read the identity email from, when the email is not set.
+*/
+func (gic *GitIdentityConfiguration) GetEmailVariable() *string {
+	return gic.EmailVariable
+}
+
+/*
+Sets the name of the environment variable to read the identity email from, when the email is not set.
+*/
+func (gic *GitIdentityConfiguration) SetEmailVariable(emailVariable *string) {
+	gic.EmailVariable = emailVariable
+}
+
+/*
+Returns the name of the environment variable to read the identity name from, when the name is not set.
+*/
+func (gic *GitIdentityConfiguration) GetNameVariable() *string {
+	return gic.NameVariable
+}
+
+/*
+Sets the name of the environment variable to read the identity name from, when the name is not set.
+*/
+func (gic *GitIdentityConfiguration) SetNameVariable(nameVariable *string) {
+	gic.NameVariable = nameVariable
+}
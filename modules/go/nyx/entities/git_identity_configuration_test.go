@@ -0,0 +1,86 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+func TestGitIdentityConfigurationNewGitIdentityConfiguration(t *testing.T) {
+	gic := NewGitIdentityConfiguration()
+
+	// default constructor has its fields set to default values
+	assert.Nil(t, gic.GetEmail())
+	assert.Nil(t, gic.GetName())
+	assert.Nil(t, gic.GetProvider())
+	assert.Nil(t, gic.GetEmailVariable())
+	assert.Nil(t, gic.GetNameVariable())
+}
+
+func TestGitIdentityConfigurationNewGitIdentityConfigurationWith(t *testing.T) {
+	gic := NewGitIdentityConfigurationWith(utl.PointerToString("12345+nyx[bot]@users.noreply.github.com"), utl.PointerToString("nyx[bot]"), PointerToProvider(GITHUB), utl.PointerToString("BOT_GIT_EMAIL"), utl.PointerToString("BOT_GIT_NAME"))
+
+	assert.Equal(t, "12345+nyx[bot]@users.noreply.github.com", *gic.GetEmail())
+	assert.Equal(t, "nyx[bot]", *gic.GetName())
+	assert.Equal(t, GITHUB, *gic.GetProvider())
+	assert.Equal(t, "BOT_GIT_EMAIL", *gic.GetEmailVariable())
+	assert.Equal(t, "BOT_GIT_NAME", *gic.GetNameVariable())
+}
+
+func TestGitIdentityConfigurationGetEmail(t *testing.T) {
+	gitIdentityConfiguration := NewGitIdentityConfiguration()
+
+	gitIdentityConfiguration.SetEmail(utl.PointerToString("jdoe@example.com"))
+	assert.Equal(t, "jdoe@example.com", *gitIdentityConfiguration.GetEmail())
+}
+
+func TestGitIdentityConfigurationGetName(t *testing.T) {
+	gitIdentityConfiguration := NewGitIdentityConfiguration()
+
+	gitIdentityConfiguration.SetName(utl.PointerToString("John Doe"))
+	assert.Equal(t, "John Doe", *gitIdentityConfiguration.GetName())
+}
+
+func TestGitIdentityConfigurationGetProvider(t *testing.T) {
+	gitIdentityConfiguration := NewGitIdentityConfiguration()
+
+	gitIdentityConfiguration.SetProvider(PointerToProvider(GITLAB))
+	assert.Equal(t, GITLAB, *gitIdentityConfiguration.GetProvider())
+}
+
+func TestGitIdentityConfigurationGetEmailVariable(t *testing.T) {
+	gitIdentityConfiguration := NewGitIdentityConfiguration()
+
+	gitIdentityConfiguration.SetEmailVariable(utl.PointerToString("BOT_GIT_EMAIL"))
+	assert.Equal(t, "BOT_GIT_EMAIL", *gitIdentityConfiguration.GetEmailVariable())
+}
+
+func TestGitIdentityConfigurationGetNameVariable(t *testing.T) {
+	gitIdentityConfiguration := NewGitIdentityConfiguration()
+
+	gitIdentityConfiguration.SetNameVariable(utl.PointerToString("BOT_GIT_NAME"))
+	assert.Equal(t, "BOT_GIT_NAME", *gitIdentityConfiguration.GetNameVariable())
+}
@@ -16,6 +16,17 @@
 
 package entities
 
+const (
+	// The value of the 'pushTags' remote option to push all the local tags.
+	PUSH_TAGS_ALL = "ALL"
+
+	// The value of the 'pushTags' remote option to only push the tags applied by the release.
+	PUSH_TAGS_NEW = "NEW"
+
+	// The value of the 'pushTags' remote option to push no tags.
+	PUSH_TAGS_NONE = "NONE"
+)
+
 /*
 This object models the fields used to configure the remote Git repository.
 
@@ -37,6 +48,27 @@ type GitRemoteConfiguration struct {
 
 	// The passphrase for the private key.
 	Passphrase *string `json:"passphrase,omitempty" yaml:"passphrase,omitempty"`
+
+	// The known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+	KnownHosts *string `json:"knownHosts,omitempty" yaml:"knownHosts,omitempty"`
+
+	// The flag telling whether the SSH host keys must be verified.
+	StrictHostKeyChecking *bool `json:"strictHostKeyChecking,omitempty" yaml:"strictHostKeyChecking,omitempty"`
+
+	// The ID of the GitHub App to authenticate as.
+	AppID *string `json:"appId,omitempty" yaml:"appId,omitempty"`
+
+	// The ID of the GitHub App installation to mint tokens for.
+	InstallationID *string `json:"installationId,omitempty" yaml:"installationId,omitempty"`
+
+	// The name of the environment variable to read the remote user name from, when the user name is not set.
+	UserVariable *string `json:"userVariable,omitempty" yaml:"userVariable,omitempty"`
+
+	// The name of the environment variable to read the remote password from, when the password is not set.
+	PasswordVariable *string `json:"passwordVariable,omitempty" yaml:"passwordVariable,omitempty"`
+
+	// The tags to push: ALL, NEW, NONE or a tag name pattern with a single '*' wildcard.
+	PushTags *string `json:"pushTags,omitempty" yaml:"pushTags,omitempty"`
 }
 
 /*
@@ -56,8 +88,15 @@ Arguments are as follows:
 - password the remote password.
 - privateKey the private key.
 - passphrase the passphrase for the private key.
+- knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+- strictHostKeyChecking the flag telling whether the SSH host keys must be verified.
+- appID the ID of the GitHub App to authenticate as.
+- installationID the ID of the GitHub App installation to mint tokens for.
+- userVariable the name of the environment variable to read the remote user name from, when the user name is not set.
+- passwordVariable the name of the environment variable to read the remote password from, when the password is not set.
+- pushTags the tags to push: ALL, NEW, NONE or a tag name pattern with a single '*' wildcard.
 */
-func NewGitRemoteConfigurationWith(authenticationMethod *AuthenticationMethod, user *string, password *string, privateKey *string, passphrase *string) *GitRemoteConfiguration {
+func NewGitRemoteConfigurationWith(authenticationMethod *AuthenticationMethod, user *string, password *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking *bool, appID *string, installationID *string, userVariable *string, passwordVariable *string, pushTags *string) *GitRemoteConfiguration {
 	grc := GitRemoteConfiguration{}
 
 	grc.AuthenticationMethod = authenticationMethod
@@ -65,6 +104,13 @@ func NewGitRemoteConfigurationWith(authenticationMethod *AuthenticationMethod, u
 	grc.Password = password
 	grc.PrivateKey = privateKey
 	grc.Passphrase = passphrase
+	grc.KnownHosts = knownHosts
+	grc.StrictHostKeyChecking = strictHostKeyChecking
+	grc.AppID = appID
+	grc.InstallationID = installationID
+	grc.UserVariable = userVariable
+	grc.PasswordVariable = passwordVariable
+	grc.PushTags = pushTags
 
 	return &grc
 }
@@ -138,3 +184,101 @@ Sets the passphrase for the private key.
 func (grc *GitRemoteConfiguration) SetPassphrase(passphrase *string) {
 	grc.Passphrase = passphrase
 }
+
+/*
+Returns the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+*/
+func (grc *GitRemoteConfiguration) GetKnownHosts() *string {
+	return grc.KnownHosts
+}
+
+/*
+Sets the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+*/
+func (grc *GitRemoteConfiguration) SetKnownHosts(knownHosts *string) {
+	grc.KnownHosts = knownHosts
+}
+
+/*
+Returns the flag telling whether the SSH host keys must be verified.
+*/
+func (grc *GitRemoteConfiguration) GetStrictHostKeyChecking() *bool {
+	return grc.StrictHostKeyChecking
+}
+
+/*
+Sets the flag telling whether the SSH host keys must be verified.
+*/
+func (grc *GitRemoteConfiguration) SetStrictHostKeyChecking(strictHostKeyChecking *bool) {
+	grc.StrictHostKeyChecking = strictHostKeyChecking
+}
+
+/*
+Returns the ID of the GitHub App to authenticate as.
+*/
+func (grc *GitRemoteConfiguration) GetAppID() *string {
+	return grc.AppID
+}
+
+/*
+Sets the ID of the GitHub App to authenticate as.
+*/
+func (grc *GitRemoteConfiguration) SetAppID(appID *string) {
+	grc.AppID = appID
+}
+
+/*
+Returns the ID of the GitHub App installation to mint tokens for.
+*/
+func (grc *GitRemoteConfiguration) GetInstallationID() *string {
+	return grc.InstallationID
+}
+
+/*
+Sets the ID of the GitHub App installation to mint tokens for.
+*/
+func (grc *GitRemoteConfiguration) SetInstallationID(installationID *string) {
+	grc.InstallationID = installationID
+}
+
+/*
+Returns the name of the environment variable to read the remote user name from, when the user name is not set.
+*/
+func (grc *GitRemoteConfiguration) GetUserVariable() *string {
+	return grc.UserVariable
+}
+
+/*
+Sets the name of the environment variable to read the remote user name from, when the user name is not set.
+*/
+func (grc *GitRemoteConfiguration) SetUserVariable(userVariable *string) {
+	grc.UserVariable = userVariable
+}
+
+/*
+Returns the name of the environment variable to read the remote password from, when the password is not set.
+*/
+func (grc *GitRemoteConfiguration) GetPasswordVariable() *string {
+	return grc.PasswordVariable
+}
+
+/*
+Sets the name of the environment variable to read the remote password from, when the password is not set.
+*/
+func (grc *GitRemoteConfiguration) SetPasswordVariable(passwordVariable *string) {
+	grc.PasswordVariable = passwordVariable
+}
+
+/*
+Returns the tags to push: ALL, NEW, NONE or a tag name pattern with a single '*' wildcard.
+*/
+func (grc *GitRemoteConfiguration) GetPushTags() *string {
+	return grc.PushTags
+}
+
+/*
+Sets the tags to push: ALL, NEW, NONE or a tag name pattern with a single '*' wildcard.
+*/
+func (grc *GitRemoteConfiguration) SetPushTags(pushTags *string) {
+	grc.PushTags = pushTags
+}
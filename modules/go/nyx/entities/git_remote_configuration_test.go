@@ -38,10 +38,16 @@ func TestGitRemoteConfigurationNewGitRemoteConfiguration(t *testing.T) {
 	assert.Nil(t, rgc.GetPassword())
 	assert.Nil(t, rgc.GetPrivateKey())
 	assert.Nil(t, rgc.GetPassphrase())
+	assert.Nil(t, rgc.GetKnownHosts())
+	assert.Nil(t, rgc.GetStrictHostKeyChecking())
+	assert.Nil(t, rgc.GetAppID())
+	assert.Nil(t, rgc.GetInstallationID())
+	assert.Nil(t, rgc.GetUserVariable())
+	assert.Nil(t, rgc.GetPasswordVariable())
 }
 
 func TestGitRemoteConfigurationNewGitRemoteConfigurationWith(t *testing.T) {
-	rgc := NewGitRemoteConfigurationWith(PointerToAuthenticationMethod(USER_PASSWORD), utl.PointerToString("u1"), utl.PointerToString("p1"), utl.PointerToString("k1"), utl.PointerToString("h1"))
+	rgc := NewGitRemoteConfigurationWith(PointerToAuthenticationMethod(USER_PASSWORD), utl.PointerToString("u1"), utl.PointerToString("p1"), utl.PointerToString("k1"), utl.PointerToString("h1"), utl.PointerToString("kh1"), utl.PointerToBoolean(false), utl.PointerToString("123"), utl.PointerToString("456"), utl.PointerToString("RELEASE_USER"), utl.PointerToString("RELEASE_TOKEN"), utl.PointerToString("NEW"))
 
 	a := rgc.GetAuthenticationMethod()
 	assert.Equal(t, USER_PASSWORD, *a)
@@ -53,6 +59,15 @@ func TestGitRemoteConfigurationNewGitRemoteConfigurationWith(t *testing.T) {
 	assert.Equal(t, "k1", *pk)
 	psp := rgc.GetPassphrase()
 	assert.Equal(t, "h1", *psp)
+	kh := rgc.GetKnownHosts()
+	assert.Equal(t, "kh1", *kh)
+	shkc := rgc.GetStrictHostKeyChecking()
+	assert.False(t, *shkc)
+	assert.Equal(t, "123", *rgc.GetAppID())
+	assert.Equal(t, "456", *rgc.GetInstallationID())
+	assert.Equal(t, "RELEASE_USER", *rgc.GetUserVariable())
+	assert.Equal(t, "RELEASE_TOKEN", *rgc.GetPasswordVariable())
+	assert.Equal(t, "NEW", *rgc.GetPushTags())
 }
 
 func TestGitRemoteConfigurationGetAuthenticationMethod(t *testing.T) {
@@ -94,3 +109,55 @@ func TestGitRemoteConfigurationGetPassphrased(t *testing.T) {
 	p := remoteGitConfiguration.GetPassphrase()
 	assert.Equal(t, "h1", *p)
 }
+
+func TestGitRemoteConfigurationGetKnownHosts(t *testing.T) {
+	remoteGitConfiguration := NewGitRemoteConfiguration()
+
+	remoteGitConfiguration.SetKnownHosts(utl.PointerToString("kh1"))
+	kh := remoteGitConfiguration.GetKnownHosts()
+	assert.Equal(t, "kh1", *kh)
+}
+
+func TestGitRemoteConfigurationGetStrictHostKeyChecking(t *testing.T) {
+	remoteGitConfiguration := NewGitRemoteConfiguration()
+
+	remoteGitConfiguration.SetStrictHostKeyChecking(utl.PointerToBoolean(false))
+	shkc := remoteGitConfiguration.GetStrictHostKeyChecking()
+	assert.False(t, *shkc)
+}
+
+func TestGitRemoteConfigurationGetAppID(t *testing.T) {
+	remoteGitConfiguration := NewGitRemoteConfiguration()
+
+	remoteGitConfiguration.SetAppID(utl.PointerToString("123"))
+	assert.Equal(t, "123", *remoteGitConfiguration.GetAppID())
+}
+
+func TestGitRemoteConfigurationGetInstallationID(t *testing.T) {
+	remoteGitConfiguration := NewGitRemoteConfiguration()
+
+	remoteGitConfiguration.SetInstallationID(utl.PointerToString("456"))
+	assert.Equal(t, "456", *remoteGitConfiguration.GetInstallationID())
+}
+
+func TestGitRemoteConfigurationGetUserVariable(t *testing.T) {
+	remoteGitConfiguration := NewGitRemoteConfiguration()
+
+	remoteGitConfiguration.SetUserVariable(utl.PointerToString("RELEASE_USER"))
+	assert.Equal(t, "RELEASE_USER", *remoteGitConfiguration.GetUserVariable())
+}
+
+func TestGitRemoteConfigurationGetPasswordVariable(t *testing.T) {
+	remoteGitConfiguration := NewGitRemoteConfiguration()
+
+	remoteGitConfiguration.SetPasswordVariable(utl.PointerToString("RELEASE_TOKEN"))
+	assert.Equal(t, "RELEASE_TOKEN", *remoteGitConfiguration.GetPasswordVariable())
+}
+
+func TestGitRemoteConfigurationGetPushTags(t *testing.T) {
+	remoteGitConfiguration := NewGitRemoteConfiguration()
+
+	assert.Nil(t, remoteGitConfiguration.GetPushTags())
+	remoteGitConfiguration.SetPushTags(utl.PointerToString("v*"))
+	assert.Equal(t, "v*", *remoteGitConfiguration.GetPushTags())
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+/*
+This object models an external tool (like an API or schema diff tool) that compares the previous release with
+the current state of the repository and whose verdict can raise the identifier to bump and add a section to
+the changelog.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type ImpactAnalyzer struct {
+	// The identifier to bump when the analyzer detects an impact. If nil the default identifier is used.
+	Bump *string `json:"bump,omitempty" yaml:"bump,omitempty"`
+
+	// The template expression defining the command line to run the analyzer.
+	Command *string `json:"command,omitempty" yaml:"command,omitempty"`
+
+	// The regular expression matched against the analyzer output to detect an impact. If nil any non blank output is an impact.
+	Expression *string `json:"expression,omitempty" yaml:"expression,omitempty"`
+
+	// The template expression defining the title of the report section in the changelog. If nil the analyzer name is used.
+	Title *string `json:"title,omitempty" yaml:"title,omitempty"`
+}
+
+/*
+Default constructor
+*/
+func NewImpactAnalyzer() *ImpactAnalyzer {
+	return &ImpactAnalyzer{}
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+  - bump the identifier to bump when the analyzer detects an impact. If nil the default identifier is used.
+  - command the template expression defining the command line to run the analyzer.
+  - expression the regular expression matched against the analyzer output to detect an impact. If nil any non blank output is an impact.
+  - title the template expression defining the title of the report section in the changelog. If nil the analyzer name is used.
+*/
+func NewImpactAnalyzerWith(bump *string, command *string, expression *string, title *string) *ImpactAnalyzer {
+	ia := ImpactAnalyzer{}
+
+	ia.Bump = bump
+	ia.Command = command
+	ia.Expression = expression
+	ia.Title = title
+
+	return &ia
+}
+
+/*
+Returns the identifier to bump when the analyzer detects an impact. If nil the default identifier is used.
+*/
+func (ia *ImpactAnalyzer) GetBump() *string {
+	return ia.Bump
+}
+
+/*
+Sets the identifier to bump when the analyzer detects an impact. If nil the default identifier is used.
+*/
+func (ia *ImpactAnalyzer) SetBump(bump *string) {
+	ia.Bump = bump
+}
+
+/*
+Returns the template expression defining the command line to run the analyzer.
+*/
+func (ia *ImpactAnalyzer) GetCommand() *string {
+	return ia.Command
+}
+
+/*
+Sets the template expression defining the command line to run the analyzer.
+*/
+func (ia *ImpactAnalyzer) SetCommand(command *string) {
+	ia.Command = command
+}
+
+/*
+Returns the regular expression matched against the analyzer output to detect an impact. If nil any non blank output is an impact.
+*/
+func (ia *ImpactAnalyzer) GetExpression() *string {
+	return ia.Expression
+}
+
+/*
+Sets the regular expression matched against the analyzer output to detect an impact. If nil any non blank output is an impact.
+*/
+func (ia *ImpactAnalyzer) SetExpression(expression *string) {
+	ia.Expression = expression
+}
+
+/*
+Returns the template expression defining the title of the report section in the changelog. If nil the analyzer name is used.
+*/
+func (ia *ImpactAnalyzer) GetTitle() *string {
+	return ia.Title
+}
+
+/*
+Sets the template expression defining the title of the report section in the changelog. If nil the analyzer name is used.
+*/
+func (ia *ImpactAnalyzer) SetTitle(title *string) {
+	ia.Title = title
+}
@@ -0,0 +1,81 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+func TestImpactAnalyzerNewImpactAnalyzer(t *testing.T) {
+	ia := NewImpactAnalyzer()
+
+	// default constructor has its fields set to default values
+	assert.Nil(t, ia.GetBump())
+	assert.Nil(t, ia.GetCommand())
+	assert.Nil(t, ia.GetExpression())
+	assert.Nil(t, ia.GetTitle())
+}
+
+func TestImpactAnalyzerNewImpactAnalyzerWith(t *testing.T) {
+	ia := NewImpactAnalyzerWith(utl.PointerToString("major"), utl.PointerToString("command1"), utl.PointerToString("expression1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "major", *ia.GetBump())
+	assert.Equal(t, "command1", *ia.GetCommand())
+	assert.Equal(t, "expression1", *ia.GetExpression())
+	assert.Equal(t, "title1", *ia.GetTitle())
+}
+
+func TestImpactAnalyzerGetBump(t *testing.T) {
+	ia := NewImpactAnalyzerWith(utl.PointerToString("major"), utl.PointerToString("command1"), utl.PointerToString("expression1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "major", *ia.GetBump())
+	ia.SetBump(utl.PointerToString("minor"))
+	assert.Equal(t, "minor", *ia.GetBump())
+}
+
+func TestImpactAnalyzerGetCommand(t *testing.T) {
+	ia := NewImpactAnalyzerWith(utl.PointerToString("major"), utl.PointerToString("command1"), utl.PointerToString("expression1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "command1", *ia.GetCommand())
+	ia.SetCommand(utl.PointerToString("command2"))
+	assert.Equal(t, "command2", *ia.GetCommand())
+}
+
+func TestImpactAnalyzerGetExpression(t *testing.T) {
+	ia := NewImpactAnalyzerWith(utl.PointerToString("major"), utl.PointerToString("command1"), utl.PointerToString("expression1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "expression1", *ia.GetExpression())
+	ia.SetExpression(utl.PointerToString("expression2"))
+	assert.Equal(t, "expression2", *ia.GetExpression())
+}
+
+func TestImpactAnalyzerGetTitle(t *testing.T) {
+	ia := NewImpactAnalyzerWith(utl.PointerToString("major"), utl.PointerToString("command1"), utl.PointerToString("expression1"), utl.PointerToString("title1"))
+
+	assert.Equal(t, "title1", *ia.GetTitle())
+	ia.SetTitle(utl.PointerToString("title2"))
+	assert.Equal(t, "title2", *ia.GetTitle())
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+/*
+This object models the outcome of an impact analyzer run against the release scope.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type ImpactReport struct {
+	// The identifier bumped because of the impact, if any.
+	Bump *string `json:"bump,omitempty" yaml:"bump,omitempty" handlebars:"bump"`
+
+	// The flag telling if the analyzer detected an impact.
+	Impact bool `json:"impact,omitempty" yaml:"impact,omitempty" handlebars:"impact"`
+
+	// The name of the analyzer.
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" handlebars:"name"`
+
+	// The output of the analyzer.
+	Output *string `json:"output,omitempty" yaml:"output,omitempty" handlebars:"output"`
+
+	// The title of the report.
+	Title *string `json:"title,omitempty" yaml:"title,omitempty" handlebars:"title"`
+}
+
+/*
+Default constructor
+*/
+func NewImpactReport() *ImpactReport {
+	return &ImpactReport{}
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- name the name of the analyzer
+- title the title of the report
+- impact the flag telling if the analyzer detected an impact
+- bump the identifier bumped because of the impact, if any
+- output the output of the analyzer
+*/
+func NewImpactReportWith(name *string, title *string, impact bool, bump *string, output *string) *ImpactReport {
+	ir := ImpactReport{}
+
+	ir.Name = name
+	ir.Title = title
+	ir.Impact = impact
+	ir.Bump = bump
+	ir.Output = output
+
+	return &ir
+}
+
+/*
+Returns the identifier bumped because of the impact, if any.
+*/
+func (ir *ImpactReport) GetBump() *string {
+	return ir.Bump
+}
+
+/*
+Sets the identifier bumped because of the impact, if any.
+*/
+func (ir *ImpactReport) SetBump(bump *string) {
+	ir.Bump = bump
+}
+
+/*
+Returns the flag telling if the analyzer detected an impact.
+*/
+func (ir *ImpactReport) GetImpact() bool {
+	return ir.Impact
+}
+
+/*
+Sets the flag telling if the analyzer detected an impact.
+*/
+func (ir *ImpactReport) SetImpact(impact bool) {
+	ir.Impact = impact
+}
+
+/*
+Returns the name of the analyzer.
+*/
+func (ir *ImpactReport) GetName() *string {
+	return ir.Name
+}
+
+/*
+Sets the name of the analyzer.
+*/
+func (ir *ImpactReport) SetName(name *string) {
+	ir.Name = name
+}
+
+/*
+Returns the output of the analyzer.
+*/
+func (ir *ImpactReport) GetOutput() *string {
+	return ir.Output
+}
+
+/*
+Sets the output of the analyzer.
+*/
+func (ir *ImpactReport) SetOutput(output *string) {
+	ir.Output = output
+}
+
+/*
+Returns the title of the report.
+*/
+func (ir *ImpactReport) GetTitle() *string {
+	return ir.Title
+}
+
+/*
+Sets the title of the report.
+*/
+func (ir *ImpactReport) SetTitle(title *string) {
+	ir.Title = title
+}
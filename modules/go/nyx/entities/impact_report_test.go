@@ -0,0 +1,66 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+func TestImpactReportNewImpactReport(t *testing.T) {
+	ir := NewImpactReport()
+
+	// default constructor has its fields set to default values
+	assert.Nil(t, ir.GetBump())
+	assert.False(t, ir.GetImpact())
+	assert.Nil(t, ir.GetName())
+	assert.Nil(t, ir.GetOutput())
+	assert.Nil(t, ir.GetTitle())
+}
+
+func TestImpactReportNewImpactReportWith(t *testing.T) {
+	ir := NewImpactReportWith(utl.PointerToString("name1"), utl.PointerToString("title1"), true, utl.PointerToString("major"), utl.PointerToString("output1"))
+
+	assert.Equal(t, "name1", *ir.GetName())
+	assert.Equal(t, "title1", *ir.GetTitle())
+	assert.True(t, ir.GetImpact())
+	assert.Equal(t, "major", *ir.GetBump())
+	assert.Equal(t, "output1", *ir.GetOutput())
+}
+
+func TestImpactReportSetters(t *testing.T) {
+	ir := NewImpactReport()
+
+	ir.SetName(utl.PointerToString("name2"))
+	ir.SetTitle(utl.PointerToString("title2"))
+	ir.SetImpact(true)
+	ir.SetBump(utl.PointerToString("minor"))
+	ir.SetOutput(utl.PointerToString("output2"))
+	assert.Equal(t, "name2", *ir.GetName())
+	assert.Equal(t, "title2", *ir.GetTitle())
+	assert.True(t, ir.GetImpact())
+	assert.Equal(t, "minor", *ir.GetBump())
+	assert.Equal(t, "output2", *ir.GetOutput())
+}
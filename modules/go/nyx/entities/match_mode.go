@@ -0,0 +1,69 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+This class maps allowed values for the logic used to combine the matching criteria of a release type.
+*/
+type MatchMode string
+
+const (
+	// All the matching criteria defined for the release type must be satisfied (AND).
+	ALL MatchMode = "ALL"
+
+	// At least one of the matching criteria defined for the release type must be satisfied (OR).
+	ANY MatchMode = "ANY"
+)
+
+/*
+Returns the string representation of the match mode
+*/
+func (mm MatchMode) String() string {
+	switch mm {
+	case ALL:
+		return "ALL"
+	case ANY:
+		return "ANY"
+	default:
+		// this is never reached, but in case...
+		panic("unknown MatchMode. This means the switch/case statement needs to be updated")
+	}
+}
+
+/*
+Returns the match mode corresponding to the given string.
+
+Errors can be:
+
+- IllegalPropertyError in case an unknown match mode is passed
+*/
+func ValueOfMatchMode(s string) (MatchMode, error) {
+	switch s {
+	case "ALL":
+		return ALL, nil
+	case "ANY":
+		return ANY, nil
+	default:
+		return ALL, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal match mode '%s'", s)}
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+This class maps allowed values for the policy applied when no significant commits are found since the previous version,
+so there is nothing to release.
+*/
+type NothingToReleasePolicy string
+
+const (
+	// The version is the previous version, just like no release ever happened since then.
+	PREVIOUS_VERSION NothingToReleasePolicy = "PREVIOUS_VERSION"
+
+	// No version is emitted and the release is quietly skipped.
+	SKIP NothingToReleasePolicy = "SKIP"
+
+	// The inference fails with an error.
+	FAIL NothingToReleasePolicy = "FAIL"
+)
+
+/*
+Returns the string representation of the nothing to release policy
+*/
+func (p NothingToReleasePolicy) String() string {
+	switch p {
+	case PREVIOUS_VERSION:
+		return "PREVIOUS_VERSION"
+	case SKIP:
+		return "SKIP"
+	case FAIL:
+		return "FAIL"
+	default:
+		// this is never reached, but in case...
+		panic("unknown NothingToReleasePolicy. This means the switch/case statement needs to be updated")
+	}
+}
+
+/*
+Returns the nothing to release policy corresponding to the given string.
+
+Errors can be:
+
+- IllegalPropertyError in case an unknown nothing to release policy is passed
+*/
+func ValueOfNothingToReleasePolicy(s string) (NothingToReleasePolicy, error) {
+	switch s {
+	case "PREVIOUS_VERSION":
+		return PREVIOUS_VERSION, nil
+	case "SKIP":
+		return SKIP, nil
+	case "FAIL":
+		return FAIL, nil
+	default:
+		return PREVIOUS_VERSION, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal nothing to release policy '%s'", s)}
+	}
+}
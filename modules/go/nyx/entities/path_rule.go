@@ -0,0 +1,103 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+/*
+This object models a rule telling how commits changing some paths are evaluated when inferring the identifier
+to bump, regardless of (or in addition to) their messages.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type PathRule struct {
+	// The identifier to bump when a commit changes any path matched by the rule. If nil the rule bumps no identifier.
+	Bump *string `json:"bump,omitempty" yaml:"bump,omitempty"`
+
+	// The list of glob patterns matching the paths the rule applies to.
+	Paths *[]*string `json:"paths,omitempty" yaml:"paths,omitempty"`
+
+	// The flag telling whether changes to the paths matched by the rule are significant. If nil the changes are significant.
+	Significant *bool `json:"significant,omitempty" yaml:"significant,omitempty"`
+}
+
+/*
+Default constructor
+*/
+func NewPathRule() *PathRule {
+	return &PathRule{}
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+  - bump the identifier to bump when a commit changes any path matched by the rule. If nil the rule bumps no identifier.
+  - paths the list of glob patterns matching the paths the rule applies to.
+  - significant the flag telling whether changes to the paths matched by the rule are significant. If nil the changes are significant.
+*/
+func NewPathRuleWith(bump *string, paths *[]*string, significant *bool) *PathRule {
+	pr := PathRule{}
+
+	pr.Bump = bump
+	pr.Paths = paths
+	pr.Significant = significant
+
+	return &pr
+}
+
+/*
+Returns the identifier to bump when a commit changes any path matched by the rule. If nil the rule bumps no identifier.
+*/
+func (pr *PathRule) GetBump() *string {
+	return pr.Bump
+}
+
+/*
+Sets the identifier to bump when a commit changes any path matched by the rule. If nil the rule bumps no identifier.
+*/
+func (pr *PathRule) SetBump(bump *string) {
+	pr.Bump = bump
+}
+
+/*
+Returns the list of glob patterns matching the paths the rule applies to.
+*/
+func (pr *PathRule) GetPaths() *[]*string {
+	return pr.Paths
+}
+
+/*
+Sets the list of glob patterns matching the paths the rule applies to.
+*/
+func (pr *PathRule) SetPaths(paths *[]*string) {
+	pr.Paths = paths
+}
+
+/*
+Returns the flag telling whether changes to the paths matched by the rule are significant. If nil the changes are significant.
+*/
+func (pr *PathRule) GetSignificant() *bool {
+	return pr.Significant
+}
+
+/*
+Sets the flag telling whether changes to the paths matched by the rule are significant. If nil the changes are significant.
+*/
+func (pr *PathRule) SetSignificant(significant *bool) {
+	pr.Significant = significant
+}
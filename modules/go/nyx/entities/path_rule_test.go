@@ -0,0 +1,74 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+func TestPathRuleNewPathRule(t *testing.T) {
+	pr := NewPathRule()
+
+	// default constructor has its fields set to default values
+	assert.Nil(t, pr.GetBump())
+	assert.Nil(t, pr.GetPaths())
+	assert.Nil(t, pr.GetSignificant())
+}
+
+func TestPathRuleNewPathRuleWith(t *testing.T) {
+	pr := NewPathRuleWith(utl.PointerToString("minor"), &[]*string{utl.PointerToString("api/**")}, utl.PointerToBoolean(true))
+
+	assert.Equal(t, "minor", *pr.GetBump())
+	assert.Equal(t, 1, len(*pr.GetPaths()))
+	assert.Equal(t, "api/**", *(*pr.GetPaths())[0])
+	assert.True(t, *pr.GetSignificant())
+}
+
+func TestPathRuleGetBump(t *testing.T) {
+	pr := NewPathRuleWith(utl.PointerToString("minor"), &[]*string{utl.PointerToString("api/**")}, utl.PointerToBoolean(true))
+
+	assert.Equal(t, "minor", *pr.GetBump())
+	pr.SetBump(utl.PointerToString("major"))
+	assert.Equal(t, "major", *pr.GetBump())
+}
+
+func TestPathRuleGetPaths(t *testing.T) {
+	pr := NewPathRuleWith(utl.PointerToString("minor"), &[]*string{utl.PointerToString("api/**")}, utl.PointerToBoolean(true))
+
+	assert.Equal(t, "api/**", *(*pr.GetPaths())[0])
+	pr.SetPaths(&[]*string{utl.PointerToString("docs/**"), utl.PointerToString("*.md")})
+	assert.Equal(t, 2, len(*pr.GetPaths()))
+	assert.Equal(t, "docs/**", *(*pr.GetPaths())[0])
+	assert.Equal(t, "*.md", *(*pr.GetPaths())[1])
+}
+
+func TestPathRuleGetSignificant(t *testing.T) {
+	pr := NewPathRuleWith(utl.PointerToString("minor"), &[]*string{utl.PointerToString("api/**")}, utl.PointerToBoolean(true))
+
+	assert.True(t, *pr.GetSignificant())
+	pr.SetSignificant(utl.PointerToBoolean(false))
+	assert.False(t, *pr.GetSignificant())
+}
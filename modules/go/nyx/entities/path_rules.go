@@ -0,0 +1,121 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+A value holder that models a section containing a map of path rules.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type PathRules struct {
+	// The private list of enabled items.
+	Enabled *[]*string `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+
+	// The private map of the items.
+	// Due to the lack of an (acceptable) implementation of generics in Go, that doesn't allow
+	// to define T in a way that is not known upfront, this map needs to be
+	// redefined here along with getters/setters instead of the 'enabledItemsMap' struct
+	Items *map[string]*PathRule `json:"items,omitempty" yaml:"items,omitempty"`
+}
+
+/*
+Default constructor
+*/
+func NewPathRules() *PathRules {
+	return &PathRules{}
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- enabled the list of names of enabled items
+- items the map of items
+
+Errors can be:
+
+- NilPointerError in case any parameter is nil
+*/
+func NewPathRulesWith(enabled *[]*string, items *map[string]*PathRule) (*PathRules, error) {
+	prs := PathRules{}
+
+	if enabled == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "enabled")}
+	}
+	if items == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "items")}
+	}
+
+	prs.Enabled = enabled
+	prs.Items = items
+
+	return &prs, nil
+}
+
+/*
+Returns the list of enabled items. A nil value means undefined.
+*/
+func (prs *PathRules) GetEnabled() *[]*string {
+	return prs.Enabled
+}
+
+/*
+Sets the list of enabled items. A nil value means undefined.
+
+Errors can be:
+
+- NilPointerError in case the given parameter is nil
+*/
+func (prs *PathRules) SetEnabled(enabled *[]*string) error {
+	if enabled == nil {
+		return &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "enabled")}
+	}
+	prs.Enabled = enabled
+	return nil
+}
+
+/*
+Returns the map of the items configured in this section, where keys are item names
+and values are actual item objects. A nil value means undefined.
+*/
+func (prs *PathRules) GetItems() *map[string]*PathRule {
+	return prs.Items
+}
+
+/*
+Sets the map of the items configured in this section, where keys are item names
+and values are actual item objects. A nil value means undefined.
+
+Errors can be:
+
+- NilPointerError in case the given parameter is nil
+*/
+func (prs *PathRules) SetItems(items *map[string]*PathRule) error {
+	if items == nil {
+		return &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "items")}
+	}
+	prs.Items = items
+	return nil
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+This class maps allowed values for the way the scopes of pre-releases are merged into the changelog of the final release that follows them.
+*/
+type PreReleaseMerge string
+
+const (
+	// Pre-release scopes are not merged so the changelog of the final release only contains the commits since the latest pre-release.
+	SEPARATE PreReleaseMerge = "SEPARATE"
+
+	// The commits from pre-release scopes are merged into the sections of the final release, without any trace of the pre-releases.
+	FLAT PreReleaseMerge = "FLAT"
+
+	// The pre-release scopes are merged into the final release, each one with its own sub-header.
+	NESTED PreReleaseMerge = "NESTED"
+)
+
+/*
+Returns the string representation of the pre-release merge
+*/
+func (prm PreReleaseMerge) String() string {
+	switch prm {
+	case SEPARATE:
+		return "SEPARATE"
+	case FLAT:
+		return "FLAT"
+	case NESTED:
+		return "NESTED"
+	default:
+		// this is never reached, but in case...
+		panic("unknown PreReleaseMerge. This means the switch/case statement needs to be updated")
+	}
+}
+
+/*
+Returns the pre-release merge corresponding to the given string.
+
+Errors can be:
+
+- IllegalPropertyError in case an unknown pre-release merge is passed
+*/
+func ValueOfPreReleaseMerge(s string) (PreReleaseMerge, error) {
+	switch s {
+	case "SEPARATE":
+		return SEPARATE, nil
+	case "FLAT":
+		return FLAT, nil
+	case "NESTED":
+		return NESTED, nil
+	default:
+		return SEPARATE, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal pre-release merge '%s'", s)}
+	}
+}
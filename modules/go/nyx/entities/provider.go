@@ -28,11 +28,26 @@ These are the constants representing the available service providers.
 type Provider string
 
 const (
+	// The Gerrit Code Review (https://www.gerritcodereview.com/) service provider.
+	GERRIT Provider = "GERRIT"
+
 	// The GitHub (https://github.com) service provider.
 	GITHUB Provider = "GITHUB"
 
 	// The GitLab https://gitlab.com/) service provider.
 	GITLAB Provider = "GITLAB"
+
+	// The Go module proxy (https://proxy.golang.org/) service provider.
+	GO_PROXY Provider = "GO_PROXY"
+
+	// The generic package repository service provider (Aptly, Artifactory, Nexus) for Debian and RPM packages.
+	PACKAGE_REPOSITORY Provider = "PACKAGE_REPOSITORY"
+
+	// The Terraform module registry (https://app.terraform.io/) service provider.
+	TERRAFORM_REGISTRY Provider = "TERRAFORM_REGISTRY"
+
+	// The versions file service provider, recording released versions in a JSON file that can be local or remote (i.e. in a bucket).
+	VERSIONS_FILE Provider = "VERSIONS_FILE"
 )
 
 /*
@@ -40,10 +55,20 @@ Returns the string representation of the provider
 */
 func (p Provider) String() string {
 	switch p {
+	case GERRIT:
+		return "GERRIT"
 	case GITHUB:
 		return "GITHUB"
 	case GITLAB:
 		return "GITLAB"
+	case GO_PROXY:
+		return "GO_PROXY"
+	case PACKAGE_REPOSITORY:
+		return "PACKAGE_REPOSITORY"
+	case TERRAFORM_REGISTRY:
+		return "TERRAFORM_REGISTRY"
+	case VERSIONS_FILE:
+		return "VERSIONS_FILE"
 	default:
 		// this is never reached, but in case...
 		panic("unknown Provider. This means the switch/case statement needs to be updated")
@@ -59,10 +84,20 @@ Errors can be:
 */
 func ValueOfProvider(s string) (Provider, error) {
 	switch s {
+	case "GERRIT":
+		return GERRIT, nil
 	case "GITHUB":
 		return GITHUB, nil
 	case "GITLAB":
 		return GITLAB, nil
+	case "GO_PROXY":
+		return GO_PROXY, nil
+	case "PACKAGE_REPOSITORY":
+		return PACKAGE_REPOSITORY, nil
+	case "TERRAFORM_REGISTRY":
+		return TERRAFORM_REGISTRY, nil
+	case "VERSIONS_FILE":
+		return VERSIONS_FILE, nil
 	default:
 		return GITHUB, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal service '%s'", s)}
 	}
@@ -30,6 +30,11 @@ import (
 func TestProviderString(t *testing.T) {
 	assert.Equal(t, "GITHUB", GITHUB.String())
 	assert.Equal(t, "GITLAB", GITLAB.String())
+	assert.Equal(t, "GO_PROXY", GO_PROXY.String())
+	assert.Equal(t, "PACKAGE_REPOSITORY", PACKAGE_REPOSITORY.String())
+	assert.Equal(t, "TERRAFORM_REGISTRY", TERRAFORM_REGISTRY.String())
+	assert.Equal(t, "GERRIT", GERRIT.String())
+	assert.Equal(t, "VERSIONS_FILE", VERSIONS_FILE.String())
 }
 
 func TestProviderValueOfProvider(t *testing.T) {
@@ -39,4 +44,19 @@ func TestProviderValueOfProvider(t *testing.T) {
 	provider, err = ValueOfProvider("GITLAB")
 	assert.NoError(t, err)
 	assert.Equal(t, GITLAB, provider)
+	provider, err = ValueOfProvider("GO_PROXY")
+	assert.NoError(t, err)
+	assert.Equal(t, GO_PROXY, provider)
+	provider, err = ValueOfProvider("PACKAGE_REPOSITORY")
+	assert.NoError(t, err)
+	assert.Equal(t, PACKAGE_REPOSITORY, provider)
+	provider, err = ValueOfProvider("TERRAFORM_REGISTRY")
+	assert.NoError(t, err)
+	assert.Equal(t, TERRAFORM_REGISTRY, provider)
+	provider, err = ValueOfProvider("GERRIT")
+	assert.NoError(t, err)
+	assert.Equal(t, GERRIT, provider)
+	provider, err = ValueOfProvider("VERSIONS_FILE")
+	assert.NoError(t, err)
+	assert.Equal(t, VERSIONS_FILE, provider)
 }
@@ -29,6 +29,9 @@ type ReleaseScope struct {
 	// The internal list of commits in the scope. Elements are in reverse order so the newest commit is at position 0 and the oldest is in the final position.
 	Commits []*gitent.Commit `json:"commits,omitempty" yaml:"commits,omitempty" handlebars:"commits"`
 
+	// The list of reports from the impact analyzers run against the release scope.
+	ImpactReports []*ImpactReport `json:"impactReports,omitempty" yaml:"impactReports,omitempty" handlebars:"impactReports"`
+
 	// The version identifier of the most recent past release.
 	PreviousVersion *string `json:"previousVersion,omitempty" yaml:"previousVersion,omitempty" handlebars:"previousVersion"`
 
@@ -69,6 +72,9 @@ type FlatReleaseScope struct {
 	// The cached value for the initial commit within the scope. It's required to cache this value or marshalling/unmarshalling won't work
 	InitialCommitCache *gitent.Commit `json:"initialCommit,omitempty" yaml:"initialCommit,omitempty" handlebars:"initialCommit"`
 
+	// The list of reports from the impact analyzers run against the release scope.
+	ImpactReports []*ImpactReport `json:"impactReports,omitempty" yaml:"impactReports,omitempty" handlebars:"impactReports"`
+
 	// The version identifier of the most recent past release.
 	PreviousVersion *string `json:"previousVersion,omitempty" yaml:"previousVersion,omitempty" handlebars:"previousVersion"`
 
@@ -92,6 +98,7 @@ func NewReleaseScope() *ReleaseScope {
 	releaseScope := ReleaseScope{}
 
 	releaseScope.Commits = make([]*gitent.Commit, 0)
+	releaseScope.ImpactReports = make([]*ImpactReport, 0)
 	releaseScope.SignificantCommits = make([]*gitent.Commit, 0)
 
 	return &releaseScope
@@ -114,6 +121,7 @@ func (r *ReleaseScope) Flatten() (*FlatReleaseScope, error) {
 	resolvedReleaseScope.Commits = r.GetCommits()
 	resolvedReleaseScope.FinalCommitCache = r.GetFinalCommit()
 	resolvedReleaseScope.InitialCommitCache = r.GetInitialCommit()
+	resolvedReleaseScope.ImpactReports = r.GetImpactReports()
 	resolvedReleaseScope.PreviousVersion = r.GetPreviousVersion()
 	resolvedReleaseScope.PreviousVersionCommit = r.GetPreviousVersionCommit()
 	resolvedReleaseScope.PrimeVersion = r.GetPrimeVersion()
@@ -177,6 +185,20 @@ func (rs *ReleaseScope) SetCommits(commits []*gitent.Commit) {
 	rs.Commits = commits
 }
 
+/*
+Returns the list of reports from the impact analyzers run against the release scope.
+*/
+func (rs *ReleaseScope) GetImpactReports() []*ImpactReport {
+	return rs.ImpactReports
+}
+
+/*
+Sets the list of reports from the impact analyzers run against the release scope.
+*/
+func (rs *ReleaseScope) SetImpactReports(impactReports []*ImpactReport) {
+	rs.ImpactReports = impactReports
+}
+
 /*
 Returns the version identifier of the most recent past release.
 */
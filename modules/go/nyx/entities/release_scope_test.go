@@ -50,6 +50,16 @@ func TestReleaseScopeNewReleaseScope(t *testing.T) {
 	assert.Nil(t, rs.GetFinalCommit())
 	assert.False(t, rs.HasFinalCommit())
 	assert.Equal(t, make([]*gitent.Commit, 0), rs.GetSignificantCommits())
+	assert.Equal(t, make([]*ImpactReport, 0), rs.GetImpactReports())
+}
+
+func TestReleaseScopeGetImpactReports(t *testing.T) {
+	releaseScope := NewReleaseScope()
+
+	assert.Equal(t, 0, len(releaseScope.GetImpactReports()))
+	releaseScope.SetImpactReports([]*ImpactReport{NewImpactReportWith(utl.PointerToString("api"), utl.PointerToString("API changes"), true, utl.PointerToString("major"), utl.PointerToString("removed func F"))})
+	assert.Equal(t, 1, len(releaseScope.GetImpactReports()))
+	assert.Equal(t, "api", *releaseScope.GetImpactReports()[0].GetName())
 }
 
 func TestReleaseScopeGetCommits(t *testing.T) {
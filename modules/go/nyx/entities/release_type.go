@@ -29,6 +29,15 @@ type ReleaseType struct {
 	// keys defined in the global releaseAssets.
 	Assets *[]*string `json:"assets,omitempty" yaml:"assets,omitempty"`
 
+	// The optional template to render as the build metadata (like 'sha.abc1234' or 'build.42') to append to new versions. A nil value means undefined.
+	BuildMetadata *string `json:"buildMetadata,omitempty" yaml:"buildMetadata,omitempty"`
+
+	// The optional template to render as the comma separated list of the targets (STATE, TAGS, CHANGELOG) where the build metadata is included. A nil value means all targets.
+	BuildMetadataTargets *string `json:"buildMetadataTargets,omitempty" yaml:"buildMetadataTargets,omitempty"`
+
+	// The map of the bump labels, where keys are the names of pull request labels and values are the identifiers to bump for the commits merged by pull requests having those labels. A nil value means undefined.
+	BumpLabels *map[string]string `json:"bumpLabels,omitempty" yaml:"bumpLabels,omitempty"`
+
 	// The flag indicating whether or not the 'collapsed' versioning (pre-release style) must be used. A nil value means undefined.
 	CollapseVersions *bool `json:"collapseVersions,omitempty" yaml:"collapseVersions,omitempty"`
 
@@ -41,6 +50,12 @@ type ReleaseType struct {
 	// The optional template to render as a regular expression used to match tags from the commit history. A nil value means undefined.
 	FilterTags *string `json:"filterTags,omitempty" yaml:"filterTags,omitempty"`
 
+	// The optional flag or the template to render indicating whether or not all the parents of merge commits are followed when walking the commit history, instead of the first parent only. A nil value means undefined.
+	FollowAllParents *string `json:"followAllParents,omitempty" yaml:"followAllParents,omitempty"`
+
+	// The optional CEL expression that must evaluate to true against the state for the release to be issued. A nil value means undefined.
+	GatePolicy *string `json:"gatePolicy,omitempty" yaml:"gatePolicy,omitempty"`
+
 	// The optional flag or the template to render indicating whether or not a new commit must be generated in case new artifacts are generated. A nil value means undefined.
 	GitCommit *string `json:"gitCommit,omitempty" yaml:"gitCommit,omitempty"`
 
@@ -53,6 +68,9 @@ type ReleaseType struct {
 	// The optional flag or the template to enable/disable the Git push operation. A nil value means undefined.
 	GitPushForce *string `json:"gitPushForce,omitempty" yaml:"gitPushForce,omitempty"`
 
+	// The optional flag or the template to render indicating whether or not the branch must be force pushed with a lease, only overwriting the remote branch if it has not been updated since it was last fetched. A nil value means undefined.
+	GitPushForceWithLease *string `json:"gitPushForceWithLease,omitempty" yaml:"gitPushForceWithLease,omitempty"`
+
 	// The optional flag or the template to render indicating whether or not a new tag must be generated. A nil value means undefined.
 	GitTag *string `json:"gitTag,omitempty" yaml:"gitTag,omitempty"`
 
@@ -74,27 +92,78 @@ type ReleaseType struct {
 	// The identifiers configuration block. Elements of this list must be of type Identifier. A nil value means undefined.
 	Identifiers *[]*Identifier `json:"identifiers,omitempty" yaml:"identifiers,omitempty"`
 
+	// The optional flag telling whether commits cherry-picked from versions released on other branches are ignored when inferring the version. A nil value means undefined.
+	IgnoreCherryPicks *string `json:"ignoreCherryPicks,omitempty" yaml:"ignoreCherryPicks,omitempty"`
+
+	// The optional flag or the template to render indicating whether or not merge commits are ignored when inferring the version. A nil value means undefined.
+	IgnoreMerges *string `json:"ignoreMerges,omitempty" yaml:"ignoreMerges,omitempty"`
+
 	// The optional template to render as a regular expression used to match branch names. A nil value means undefined.
 	MatchBranches *string `json:"matchBranches,omitempty" yaml:"matchBranches,omitempty"`
 
+	// The map of the match branch metadata items, where keys are the names of the metadata extracted from the branch name and values are regular expressions. A nil value means undefined.
+	MatchBranchMetadata *map[string]string `json:"matchBranchMetadata,omitempty" yaml:"matchBranchMetadata,omitempty"`
+
+	// The optional template to render as a regular expression used to match the paths of the files changed by the latest commit. A nil value means undefined.
+	MatchChangedPaths *string `json:"matchChangedPaths,omitempty" yaml:"matchChangedPaths,omitempty"`
+
+	// The optional template to render as a comma separated list of the days of the week when the release type is enabled. A nil value means undefined.
+	MatchDaysOfWeek *string `json:"matchDaysOfWeek,omitempty" yaml:"matchDaysOfWeek,omitempty"`
+
 	// The map of the match environment variables items, where keys are environment variable names and values are regular expressions. A nil value means undefined.
 	MatchEnvironmentVariables *map[string]string `json:"matchEnvironmentVariables,omitempty" yaml:"matchEnvironmentVariables,omitempty"`
 
+	// The optional template that, once rendered, must evaluate to true for the release type to be matched. A nil value means undefined.
+	MatchExpression *string `json:"matchExpression,omitempty" yaml:"matchExpression,omitempty"`
+
+	// The optional logic used to combine the matching criteria. A nil value means undefined.
+	MatchMode *MatchMode `json:"matchMode,omitempty" yaml:"matchMode,omitempty"`
+
+	// The optional CEL expression that must evaluate to true against the state for the release type to be matched. A nil value means undefined.
+	MatchPolicy *string `json:"matchPolicy,omitempty" yaml:"matchPolicy,omitempty"`
+
+	// The optional template to render as a regular expression used to match the tags applied to the latest commit. A nil value means undefined.
+	MatchTags *string `json:"matchTags,omitempty" yaml:"matchTags,omitempty"`
+
 	// The identifier of a specific workspace status to be matched. A nil value means undefined.
 	MatchWorkspaceStatus *WorkspaceStatus `json:"matchWorkspaceStatus,omitempty" yaml:"matchWorkspaceStatus,omitempty"`
 
 	// The optional flag or the template to render indicating whether or not releases must be published. A nil value means undefined.
 	Publish *string `json:"publish,omitempty" yaml:"publish,omitempty"`
 
+	// The optional template to render as the name of the provider environment whose approval is required before publishing releases. A nil value means undefined.
+	PublishApprovalEnvironment *string `json:"publishApprovalEnvironment,omitempty" yaml:"publishApprovalEnvironment,omitempty"`
+
+	// The optional template to render as the number of seconds to wait between two subsequent checks for the publish approval. A nil value means undefined.
+	PublishApprovalPollingInterval *string `json:"publishApprovalPollingInterval,omitempty" yaml:"publishApprovalPollingInterval,omitempty"`
+
+	// The optional template to render as the maximum number of seconds to wait for the publish approval. A nil value means undefined.
+	PublishApprovalTimeout *string `json:"publishApprovalTimeout,omitempty" yaml:"publishApprovalTimeout,omitempty"`
+
 	// The optional template to set the draft flag of releases published to remote services. A nil value means undefined.
 	PublishDraft *string `json:"publishDraft,omitempty" yaml:"publishDraft,omitempty"`
 
 	// The optional template to set the pre-release flag of releases published to remote services. A nil value means undefined.
 	PublishPreRelease *string `json:"publishPreRelease,omitempty" yaml:"publishPreRelease,omitempty"`
 
+	// The optional template to render as the name of the service to fetch the merged pull requests from, whose titles and labels are used in place of commit messages. A nil value means undefined.
+	PullRequestMessages *string `json:"pullRequestMessages,omitempty" yaml:"pullRequestMessages,omitempty"`
+
+	// The optional template to render as the path of the release metadata file to write and commit when marking releases. A nil value means undefined.
+	ReleaseMetadataFile *string `json:"releaseMetadataFile,omitempty" yaml:"releaseMetadataFile,omitempty"`
+
 	// The optional template to set the name of releases published to remote services. A nil value means undefined.
 	ReleaseName *string `json:"releaseName,omitempty" yaml:"releaseName,omitempty"`
 
+	// The optional list of the names of the environment variables that must be set for this release type. A nil value means undefined.
+	RequiredEnvironmentVariables *[]*string `json:"requiredEnvironmentVariables,omitempty" yaml:"requiredEnvironmentVariables,omitempty"`
+
+	// The optional flag telling whether all the commits in the release scope must have a valid signature. A nil value means undefined.
+	RequireSignedCommits *string `json:"requireSignedCommits,omitempty" yaml:"requireSignedCommits,omitempty"`
+
+	// The optional template to render as a version constraint (like '>=1.2 <2.0', '~1.4' or '^2.1') that versions issued by this release type must satisfy. A nil value means undefined.
+	VersionConstraint *string `json:"versionConstraint,omitempty" yaml:"versionConstraint,omitempty"`
+
 	// The optional template to render as a regular expression used to constrain versions issued by this release type. A nil value means undefined.
 	VersionRange *string `json:"versionRange,omitempty" yaml:"versionRange,omitempty"`
 
@@ -114,6 +183,8 @@ func NewReleaseType() *ReleaseType {
 /*
 Standard constructor.
 
+Attributes not listed below are left undefined and can be set using their setters.
+
 Arguments are as follows:
 
 - assets the list of selected asset names to publish with the release. The names in this list are the map keys defined in the global releaseAssets.
@@ -180,26 +251,49 @@ Loads default values on the target instance
 */
 func (rt *ReleaseType) setDefaults() {
 	rt.Assets = RELEASE_TYPE_ASSETS
+	rt.BuildMetadata = RELEASE_TYPE_BUILD_METADATA
+	rt.BuildMetadataTargets = RELEASE_TYPE_BUILD_METADATA_TARGETS
+	rt.BumpLabels = RELEASE_TYPE_BUMP_LABELS
 	rt.CollapseVersions = RELEASE_TYPE_COLLAPSE_VERSIONS
 	rt.CollapsedVersionQualifier = RELEASE_TYPE_COLLAPSED_VERSION_QUALIFIER
 	rt.Description = RELEASE_TYPE_DESCRIPTION
 	rt.FilterTags = RELEASE_TYPE_FILTER_TAGS
+	rt.FollowAllParents = RELEASE_TYPE_FOLLOW_ALL_PARENTS
+	rt.GatePolicy = RELEASE_TYPE_GATE_POLICY
 	rt.GitCommit = RELEASE_TYPE_GIT_COMMIT
 	rt.GitCommitMessage = RELEASE_TYPE_GIT_COMMIT_MESSAGE
 	rt.GitPush = RELEASE_TYPE_GIT_PUSH
 	rt.GitPushForce = RELEASE_TYPE_GIT_PUSH_FORCE
+	rt.GitPushForceWithLease = RELEASE_TYPE_GIT_PUSH_FORCE_WITH_LEASE
 	rt.GitTag = RELEASE_TYPE_GIT_TAG
 	rt.GitTagForce = RELEASE_TYPE_GIT_TAG_FORCE
 	rt.GitTagMessage = RELEASE_TYPE_GIT_TAG_MESSAGE
 	rt.GitTagNames = RELEASE_TYPE_GIT_TAG_NAMES
 	rt.Identifiers = RELEASE_TYPE_IDENTIFIERS
+	rt.IgnoreCherryPicks = RELEASE_TYPE_IGNORE_CHERRY_PICKS
+	rt.IgnoreMerges = RELEASE_TYPE_IGNORE_MERGES
 	rt.MatchBranches = RELEASE_TYPE_MATCH_BRANCHES
+	rt.MatchBranchMetadata = RELEASE_TYPE_MATCH_BRANCH_METADATA
+	rt.MatchChangedPaths = RELEASE_TYPE_MATCH_CHANGED_PATHS
+	rt.MatchDaysOfWeek = RELEASE_TYPE_MATCH_DAYS_OF_WEEK
 	rt.MatchEnvironmentVariables = RELEASE_TYPE_MATCH_ENVIRONMENT_VARIABLES
+	rt.MatchExpression = RELEASE_TYPE_MATCH_EXPRESSION
+	rt.MatchMode = RELEASE_TYPE_MATCH_MODE
+	rt.MatchPolicy = RELEASE_TYPE_MATCH_POLICY
+	rt.MatchTags = RELEASE_TYPE_MATCH_TAGS
 	rt.MatchWorkspaceStatus = RELEASE_TYPE_MATCH_WORKSPACE_STATUS
 	rt.Publish = RELEASE_TYPE_PUBLISH
+	rt.PublishApprovalEnvironment = RELEASE_TYPE_PUBLISH_APPROVAL_ENVIRONMENT
+	rt.PublishApprovalPollingInterval = RELEASE_TYPE_PUBLISH_APPROVAL_POLLING_INTERVAL
+	rt.PublishApprovalTimeout = RELEASE_TYPE_PUBLISH_APPROVAL_TIMEOUT
 	rt.PublishDraft = RELEASE_TYPE_PUBLISH_DRAFT
 	rt.PublishPreRelease = RELEASE_TYPE_PUBLISH_PRE_RELEASE
+	rt.PullRequestMessages = RELEASE_TYPE_PULL_REQUEST_MESSAGES
+	rt.ReleaseMetadataFile = RELEASE_TYPE_RELEASE_METADATA_FILE
 	rt.ReleaseName = RELEASE_TYPE_RELEASE_NAME
+	rt.RequiredEnvironmentVariables = RELEASE_TYPE_REQUIRED_ENVIRONMENT_VARIABLES
+	rt.RequireSignedCommits = RELEASE_TYPE_REQUIRE_SIGNED_COMMITS
+	rt.VersionConstraint = RELEASE_TYPE_VERSION_CONSTRAINT
 	rt.VersionRange = RELEASE_TYPE_VERSION_RANGE
 	rt.VersionRangeFromBranchName = RELEASE_TYPE_VERSION_RANGE_FROM_BRANCH_NAME
 }
@@ -221,6 +315,48 @@ func (rt *ReleaseType) SetAssets(assets *[]*string) {
 	rt.Assets = assets
 }
 
+/*
+Returns the optional template to render as the build metadata to append to new versions. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetBuildMetadata() *string {
+	return rt.BuildMetadata
+}
+
+/*
+Sets the optional template to render as the build metadata to append to new versions. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetBuildMetadata(buildMetadata *string) {
+	rt.BuildMetadata = buildMetadata
+}
+
+/*
+Returns the optional template to render as the comma separated list of the targets (STATE, TAGS, CHANGELOG) where the build metadata is included. A nil value means all targets.
+*/
+func (rt *ReleaseType) GetBuildMetadataTargets() *string {
+	return rt.BuildMetadataTargets
+}
+
+/*
+Sets the optional template to render as the comma separated list of the targets (STATE, TAGS, CHANGELOG) where the build metadata is included. A nil value means all targets.
+*/
+func (rt *ReleaseType) SetBuildMetadataTargets(buildMetadataTargets *string) {
+	rt.BuildMetadataTargets = buildMetadataTargets
+}
+
+/*
+Returns the map of the bump labels, where keys are the names of pull request labels and values are the identifiers to bump for the commits merged by pull requests having those labels. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetBumpLabels() *map[string]string {
+	return rt.BumpLabels
+}
+
+/*
+Sets the map of the bump labels, where keys are the names of pull request labels and values are the identifiers to bump for the commits merged by pull requests having those labels. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetBumpLabels(bumpLabels *map[string]string) {
+	rt.BumpLabels = bumpLabels
+}
+
 /*
 Returns the flag indicating whether or not the 'collapsed' versioning (pre-release style) must be used. A nil value means undefined.
 */
@@ -277,6 +413,34 @@ func (rt *ReleaseType) SetFilterTags(filterTags *string) {
 	rt.FilterTags = filterTags
 }
 
+/*
+Returns the optional flag or the template to render indicating whether or not all the parents of merge commits are followed when walking the commit history, instead of the first parent only. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetFollowAllParents() *string {
+	return rt.FollowAllParents
+}
+
+/*
+Sets the optional flag or the template to render indicating whether or not all the parents of merge commits are followed when walking the commit history, instead of the first parent only. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetFollowAllParents(followAllParents *string) {
+	rt.FollowAllParents = followAllParents
+}
+
+/*
+Returns the optional CEL expression that must evaluate to true against the state for the release to be issued. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetGatePolicy() *string {
+	return rt.GatePolicy
+}
+
+/*
+Sets the optional CEL expression that must evaluate to true against the state for the release to be issued. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetGatePolicy(gatePolicy *string) {
+	rt.GatePolicy = gatePolicy
+}
+
 /*
 Returns the optional flag or the template to render indicating whether or not a new commit must be generated in case new artifacts are generated. A nil value means undefined.
 */
@@ -333,6 +497,20 @@ func (rt *ReleaseType) SetGitPushForce(gitPushForce *string) {
 	rt.GitPushForce = gitPushForce
 }
 
+/*
+Returns the optional flag or the template to render indicating whether or not the branch must be force pushed with a lease, only overwriting the remote branch if it has not been updated since it was last fetched. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetGitPushForceWithLease() *string {
+	return rt.GitPushForceWithLease
+}
+
+/*
+Sets the optional flag or the template to render indicating whether or not the branch must be force pushed with a lease, only overwriting the remote branch if it has not been updated since it was last fetched. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetGitPushForceWithLease(gitPushForceWithLease *string) {
+	rt.GitPushForceWithLease = gitPushForceWithLease
+}
+
 /*
 Returns the optional flag or the template to render indicating whether or not a new tag must be generated. A nil value means undefined.
 */
@@ -416,6 +594,34 @@ func (rt *ReleaseType) SetIdentifiers(identifiers *[]*Identifier) {
 	rt.Identifiers = identifiers
 }
 
+/*
+Returns the optional flag telling whether commits cherry-picked from versions released on other branches are ignored when inferring the version. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetIgnoreCherryPicks() *string {
+	return rt.IgnoreCherryPicks
+}
+
+/*
+Sets the optional flag telling whether commits cherry-picked from versions released on other branches are ignored when inferring the version. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetIgnoreCherryPicks(ignoreCherryPicks *string) {
+	rt.IgnoreCherryPicks = ignoreCherryPicks
+}
+
+/*
+Returns the optional flag or the template to render indicating whether or not merge commits are ignored when inferring the version. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetIgnoreMerges() *string {
+	return rt.IgnoreMerges
+}
+
+/*
+Sets the optional flag or the template to render indicating whether or not merge commits are ignored when inferring the version. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetIgnoreMerges(ignoreMerges *string) {
+	rt.IgnoreMerges = ignoreMerges
+}
+
 /*
 Returns the optional template to render as a regular expression used to match branch names. A nil value means undefined.
 */
@@ -430,6 +636,48 @@ func (rt *ReleaseType) SetMatchBranches(matchBranches *string) {
 	rt.MatchBranches = matchBranches
 }
 
+/*
+Returns the map of the match branch metadata items, where keys are the names of the metadata extracted from the branch name and values are regular expressions. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetMatchBranchMetadata() *map[string]string {
+	return rt.MatchBranchMetadata
+}
+
+/*
+Sets the map of the match branch metadata items, where keys are the names of the metadata extracted from the branch name and values are regular expressions. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetMatchBranchMetadata(matchBranchMetadata *map[string]string) {
+	rt.MatchBranchMetadata = matchBranchMetadata
+}
+
+/*
+Returns the optional template to render as a regular expression used to match the paths of the files changed by the latest commit. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetMatchChangedPaths() *string {
+	return rt.MatchChangedPaths
+}
+
+/*
+Sets the optional template to render as a regular expression used to match the paths of the files changed by the latest commit. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetMatchChangedPaths(matchChangedPaths *string) {
+	rt.MatchChangedPaths = matchChangedPaths
+}
+
+/*
+Returns the optional template to render as a comma separated list of the days of the week when the release type is enabled. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetMatchDaysOfWeek() *string {
+	return rt.MatchDaysOfWeek
+}
+
+/*
+Sets the optional template to render as a comma separated list of the days of the week when the release type is enabled. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetMatchDaysOfWeek(matchDaysOfWeek *string) {
+	rt.MatchDaysOfWeek = matchDaysOfWeek
+}
+
 /*
 Returns the match environment variables map. A nil value means undefined.
 */
@@ -444,6 +692,62 @@ func (rt *ReleaseType) SetMatchEnvironmentVariables(matchEnvironmentVariables *m
 	rt.MatchEnvironmentVariables = matchEnvironmentVariables
 }
 
+/*
+Returns the optional template that, once rendered, must evaluate to true for the release type to be matched. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetMatchExpression() *string {
+	return rt.MatchExpression
+}
+
+/*
+Sets the optional template that, once rendered, must evaluate to true for the release type to be matched. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetMatchExpression(matchExpression *string) {
+	rt.MatchExpression = matchExpression
+}
+
+/*
+Returns the optional logic used to combine the matching criteria. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetMatchMode() *MatchMode {
+	return rt.MatchMode
+}
+
+/*
+Sets the optional logic used to combine the matching criteria. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetMatchMode(matchMode *MatchMode) {
+	rt.MatchMode = matchMode
+}
+
+/*
+Returns the optional CEL expression that must evaluate to true against the state for the release type to be matched. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetMatchPolicy() *string {
+	return rt.MatchPolicy
+}
+
+/*
+Sets the optional CEL expression that must evaluate to true against the state for the release type to be matched. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetMatchPolicy(matchPolicy *string) {
+	rt.MatchPolicy = matchPolicy
+}
+
+/*
+Returns the optional template to render as a regular expression used to match the tags applied to the latest commit. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetMatchTags() *string {
+	return rt.MatchTags
+}
+
+/*
+Sets the optional template to render as a regular expression used to match the tags applied to the latest commit. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetMatchTags(matchTags *string) {
+	rt.MatchTags = matchTags
+}
+
 /*
 Returns the identifier of a specific workspace status to be matched. A nil value means undefined.
 */
@@ -472,6 +776,48 @@ func (rt *ReleaseType) SetPublish(publish *string) {
 	rt.Publish = publish
 }
 
+/*
+Returns the optional template to render as the name of the provider environment whose approval is required before publishing releases. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetPublishApprovalEnvironment() *string {
+	return rt.PublishApprovalEnvironment
+}
+
+/*
+Sets the optional template to render as the name of the provider environment whose approval is required before publishing releases. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetPublishApprovalEnvironment(publishApprovalEnvironment *string) {
+	rt.PublishApprovalEnvironment = publishApprovalEnvironment
+}
+
+/*
+Returns the optional template to render as the number of seconds to wait between two subsequent checks for the publish approval. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetPublishApprovalPollingInterval() *string {
+	return rt.PublishApprovalPollingInterval
+}
+
+/*
+Sets the optional template to render as the number of seconds to wait between two subsequent checks for the publish approval. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetPublishApprovalPollingInterval(publishApprovalPollingInterval *string) {
+	rt.PublishApprovalPollingInterval = publishApprovalPollingInterval
+}
+
+/*
+Returns the optional template to render as the maximum number of seconds to wait for the publish approval. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetPublishApprovalTimeout() *string {
+	return rt.PublishApprovalTimeout
+}
+
+/*
+Sets the optional template to render as the maximum number of seconds to wait for the publish approval. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetPublishApprovalTimeout(publishApprovalTimeout *string) {
+	rt.PublishApprovalTimeout = publishApprovalTimeout
+}
+
 /*
 Returns the optional template to set the draft flag of releases published to remote services. A nil value means undefined.
 */
@@ -500,6 +846,38 @@ func (rt *ReleaseType) SetPublishPreRelease(publishPreRelease *string) {
 	rt.PublishPreRelease = publishPreRelease
 }
 
+/*
+Returns the optional template to render as the name of the service to fetch the merged pull requests from, whose titles
+and labels are used in place of commit messages. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetPullRequestMessages() *string {
+	return rt.PullRequestMessages
+}
+
+/*
+Sets the optional template to render as the name of the service to fetch the merged pull requests from, whose titles
+and labels are used in place of commit messages. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetPullRequestMessages(pullRequestMessages *string) {
+	rt.PullRequestMessages = pullRequestMessages
+}
+
+/*
+Returns the optional template to render as the path of the release metadata file to write and commit when marking
+releases. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetReleaseMetadataFile() *string {
+	return rt.ReleaseMetadataFile
+}
+
+/*
+Sets the optional template to render as the path of the release metadata file to write and commit when marking
+releases. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetReleaseMetadataFile(releaseMetadataFile *string) {
+	rt.ReleaseMetadataFile = releaseMetadataFile
+}
+
 /*
 Returns the optional template to set the name of releases published to remote services.
 */
@@ -514,6 +892,48 @@ func (rt *ReleaseType) SetReleaseName(releaseName *string) {
 	rt.ReleaseName = releaseName
 }
 
+/*
+Returns the optional list of the names of the environment variables that must be set for this release type. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetRequiredEnvironmentVariables() *[]*string {
+	return rt.RequiredEnvironmentVariables
+}
+
+/*
+Sets the optional list of the names of the environment variables that must be set for this release type. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetRequiredEnvironmentVariables(requiredEnvironmentVariables *[]*string) {
+	rt.RequiredEnvironmentVariables = requiredEnvironmentVariables
+}
+
+/*
+Returns the optional flag telling whether all the commits in the release scope must have a valid signature. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetRequireSignedCommits() *string {
+	return rt.RequireSignedCommits
+}
+
+/*
+Sets the optional flag telling whether all the commits in the release scope must have a valid signature. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetRequireSignedCommits(requireSignedCommits *string) {
+	rt.RequireSignedCommits = requireSignedCommits
+}
+
+/*
+Returns the optional template to render as a version constraint that versions issued by this release type must satisfy. A nil value means undefined.
+*/
+func (rt *ReleaseType) GetVersionConstraint() *string {
+	return rt.VersionConstraint
+}
+
+/*
+Sets the optional template to render as a version constraint that versions issued by this release type must satisfy. A nil value means undefined.
+*/
+func (rt *ReleaseType) SetVersionConstraint(versionConstraint *string) {
+	rt.VersionConstraint = versionConstraint
+}
+
 /*
 Returns the optional template to render as a regular expression used to constrain versions issued by this release type. A nil value means undefined.
 */
@@ -33,22 +33,45 @@ func TestReleaseTypeNewReleaseType(t *testing.T) {
 	rt := NewReleaseType()
 
 	// default constructor has its fields set to default values
+	assert.Equal(t, RELEASE_TYPE_BUILD_METADATA, rt.GetBuildMetadata())
+	assert.Equal(t, RELEASE_TYPE_BUILD_METADATA_TARGETS, rt.GetBuildMetadataTargets())
+	assert.Equal(t, RELEASE_TYPE_BUMP_LABELS, rt.GetBumpLabels())
 	assert.Equal(t, RELEASE_TYPE_COLLAPSE_VERSIONS, rt.GetCollapseVersions())
 	assert.Equal(t, RELEASE_TYPE_COLLAPSED_VERSION_QUALIFIER, rt.GetCollapsedVersionQualifier())
 	assert.Equal(t, RELEASE_TYPE_DESCRIPTION, rt.GetDescription())
 	assert.Equal(t, RELEASE_TYPE_FILTER_TAGS, rt.GetFilterTags())
+	assert.Equal(t, RELEASE_TYPE_FOLLOW_ALL_PARENTS, rt.GetFollowAllParents())
+	assert.Equal(t, RELEASE_TYPE_GATE_POLICY, rt.GetGatePolicy())
 	assert.Equal(t, RELEASE_TYPE_GIT_COMMIT, rt.GetGitCommit())
 	assert.Equal(t, RELEASE_TYPE_GIT_COMMIT_MESSAGE, rt.GetGitCommitMessage())
 	assert.Equal(t, RELEASE_TYPE_GIT_PUSH, rt.GetGitPush())
+	assert.Equal(t, RELEASE_TYPE_GIT_PUSH_FORCE_WITH_LEASE, rt.GetGitPushForceWithLease())
 	assert.Equal(t, RELEASE_TYPE_GIT_TAG, rt.GetGitTag())
 	assert.Equal(t, RELEASE_TYPE_GIT_TAG_MESSAGE, rt.GetGitTagMessage())
 	assert.Equal(t, RELEASE_TYPE_IDENTIFIERS, rt.GetIdentifiers())
+	assert.Equal(t, RELEASE_TYPE_IGNORE_CHERRY_PICKS, rt.GetIgnoreCherryPicks())
+	assert.Equal(t, RELEASE_TYPE_IGNORE_MERGES, rt.GetIgnoreMerges())
 	assert.Equal(t, RELEASE_TYPE_MATCH_BRANCHES, rt.GetMatchBranches())
+	assert.Equal(t, RELEASE_TYPE_MATCH_BRANCH_METADATA, rt.GetMatchBranchMetadata())
+	assert.Equal(t, RELEASE_TYPE_MATCH_CHANGED_PATHS, rt.GetMatchChangedPaths())
+	assert.Equal(t, RELEASE_TYPE_MATCH_DAYS_OF_WEEK, rt.GetMatchDaysOfWeek())
 	assert.Equal(t, RELEASE_TYPE_MATCH_ENVIRONMENT_VARIABLES, rt.GetMatchEnvironmentVariables())
+	assert.Equal(t, RELEASE_TYPE_MATCH_EXPRESSION, rt.GetMatchExpression())
+	assert.Equal(t, RELEASE_TYPE_MATCH_MODE, rt.GetMatchMode())
+	assert.Equal(t, RELEASE_TYPE_MATCH_POLICY, rt.GetMatchPolicy())
+	assert.Equal(t, RELEASE_TYPE_MATCH_TAGS, rt.GetMatchTags())
 	assert.Equal(t, RELEASE_TYPE_MATCH_WORKSPACE_STATUS, rt.GetMatchWorkspaceStatus())
 	assert.Equal(t, RELEASE_TYPE_PUBLISH, rt.GetPublish())
+	assert.Equal(t, RELEASE_TYPE_PUBLISH_APPROVAL_ENVIRONMENT, rt.GetPublishApprovalEnvironment())
+	assert.Equal(t, RELEASE_TYPE_PUBLISH_APPROVAL_POLLING_INTERVAL, rt.GetPublishApprovalPollingInterval())
+	assert.Equal(t, RELEASE_TYPE_PUBLISH_APPROVAL_TIMEOUT, rt.GetPublishApprovalTimeout())
 	assert.Equal(t, RELEASE_TYPE_PUBLISH_DRAFT, rt.GetPublishDraft())
 	assert.Equal(t, RELEASE_TYPE_PUBLISH_PRE_RELEASE, rt.GetPublishPreRelease())
+	assert.Equal(t, RELEASE_TYPE_PULL_REQUEST_MESSAGES, rt.GetPullRequestMessages())
+	assert.Equal(t, RELEASE_TYPE_RELEASE_METADATA_FILE, rt.GetReleaseMetadataFile())
+	assert.Equal(t, RELEASE_TYPE_REQUIRED_ENVIRONMENT_VARIABLES, rt.GetRequiredEnvironmentVariables())
+	assert.Equal(t, RELEASE_TYPE_REQUIRE_SIGNED_COMMITS, rt.GetRequireSignedCommits())
+	assert.Equal(t, RELEASE_TYPE_VERSION_CONSTRAINT, rt.GetVersionConstraint())
 	assert.Equal(t, RELEASE_TYPE_VERSION_RANGE, rt.GetVersionRange())
 	assert.Equal(t, RELEASE_TYPE_VERSION_RANGE_FROM_BRANCH_NAME, rt.GetVersionRangeFromBranchName())
 }
@@ -63,7 +86,13 @@ func TestReleaseTypeNewReleaseTypeWith(t *testing.T) {
 	i2 := NewIdentifierWith(utl.PointerToString("build"), utl.PointerToString("123"), PointerToPosition(BUILD))
 	l := []*Identifier{i1, i2}
 
+	rev := []*string{utl.PointerToString("GITHUB_TOKEN")}
+
 	rt := NewReleaseTypeWith(&al, utl.PointerToBoolean(true), utl.PointerToString("{{#sanitizeLower}}{{branch}}{{/sanitizeLower}}"), utl.PointerToString("Release description"), utl.PointerToString("^({{configuration.releasePrefix}})?([0-9]\\d*)\\.([0-9]\\d*)\\.([0-9]\\d*)$"), utl.PointerToString("true"), utl.PointerToString("Committing {{version}}"), utl.PointerToString("true"), utl.PointerToString("true"), utl.PointerToString("true"), utl.PointerToString("true"), utl.PointerToString("Tagging {{version}}"), &[]*string{}, &l, utl.PointerToString(""), &m, nil, utl.PointerToString("true"), utl.PointerToString("false"), utl.PointerToString("true"), utl.PointerToString("myrelease"), utl.PointerToString(""), utl.PointerToBoolean(false))
+	rt.SetFollowAllParents(utl.PointerToString("true"))
+	rt.SetGitPushForceWithLease(utl.PointerToString("true"))
+	rt.SetIgnoreMerges(utl.PointerToString("true"))
+	rt.SetRequiredEnvironmentVariables(&rev)
 
 	a := rt.GetAssets()
 	assert.Equal(t, 2, len(*a))
@@ -77,6 +106,8 @@ func TestReleaseTypeNewReleaseTypeWith(t *testing.T) {
 	assert.Equal(t, "Release description", *d)
 	ft := rt.GetFilterTags()
 	assert.Equal(t, "^({{configuration.releasePrefix}})?([0-9]\\d*)\\.([0-9]\\d*)\\.([0-9]\\d*)$", *ft)
+	fap := rt.GetFollowAllParents()
+	assert.Equal(t, "true", *fap)
 	gc := rt.GetGitCommit()
 	assert.Equal(t, "true", *gc)
 	gcm := rt.GetGitCommitMessage()
@@ -85,6 +116,8 @@ func TestReleaseTypeNewReleaseTypeWith(t *testing.T) {
 	assert.Equal(t, "true", *gp)
 	gpf := rt.GetGitPushForce()
 	assert.Equal(t, "true", *gpf)
+	gpfwl := rt.GetGitPushForceWithLease()
+	assert.Equal(t, "true", *gpfwl)
 	gt := rt.GetGitTag()
 	assert.Equal(t, "true", *gt)
 	gtf := rt.GetGitTagForce()
@@ -93,6 +126,8 @@ func TestReleaseTypeNewReleaseTypeWith(t *testing.T) {
 	assert.Equal(t, "Tagging {{version}}", *gtm)
 	i := rt.GetIdentifiers()
 	assert.Equal(t, l, *i)
+	im := rt.GetIgnoreMerges()
+	assert.Equal(t, "true", *im)
 	mb := rt.GetMatchBranches()
 	assert.Equal(t, "", *mb)
 	mev := rt.GetMatchEnvironmentVariables()
@@ -107,6 +142,8 @@ func TestReleaseTypeNewReleaseTypeWith(t *testing.T) {
 	assert.Equal(t, "true", *ppr)
 	rn := rt.GetReleaseName()
 	assert.Equal(t, "myrelease", *rn)
+	renv := rt.GetRequiredEnvironmentVariables()
+	assert.Equal(t, rev, *renv)
 	vr := rt.GetVersionRange()
 	assert.Equal(t, "", *vr)
 	vrfbn := rt.GetVersionRangeFromBranchName()
@@ -125,6 +162,22 @@ func TestReleaseTypeGetAssets(t *testing.T) {
 	assert.Equal(t, "asset2", *(*a)[1])
 }
 
+func TestReleaseTypeGetBuildMetadata(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetBuildMetadata(utl.PointerToString("build.{{timestamp}}"))
+	bm := releaseType.GetBuildMetadata()
+	assert.Equal(t, "build.{{timestamp}}", *bm)
+}
+
+func TestReleaseTypeGetBuildMetadataTargets(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetBuildMetadataTargets(utl.PointerToString("STATE,TAGS"))
+	bmt := releaseType.GetBuildMetadataTargets()
+	assert.Equal(t, "STATE,TAGS", *bmt)
+}
+
 func TestReleaseTypeGetCollapseVersions(t *testing.T) {
 	releaseType := NewReleaseType()
 
@@ -157,6 +210,22 @@ func TestReleaseTypeGetFilterTags(t *testing.T) {
 	assert.Equal(t, "true", *gft)
 }
 
+func TestReleaseTypeGetFollowAllParents(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetFollowAllParents(utl.PointerToString("true"))
+	fap := releaseType.GetFollowAllParents()
+	assert.Equal(t, "true", *fap)
+}
+
+func TestReleaseTypeGetGatePolicy(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetGatePolicy(utl.PointerToString("state.branch == 'main'"))
+	gp := releaseType.GetGatePolicy()
+	assert.Equal(t, "state.branch == 'main'", *gp)
+}
+
 func TestReleaseTypeGetGitCommit(t *testing.T) {
 	releaseType := NewReleaseType()
 
@@ -209,6 +278,73 @@ func TestReleaseTypeGetIdentifiers(t *testing.T) {
 	assert.Equal(t, l, *mev)
 }
 
+func TestReleaseTypeGetBumpLabels(t *testing.T) {
+	m := map[string]string{"breaking": "major", "skip-release": "none"}
+	releaseType := NewReleaseType()
+
+	releaseType.SetBumpLabels(&m)
+	bl := releaseType.GetBumpLabels()
+	assert.Equal(t, m, *bl)
+}
+
+func TestReleaseTypeGetIgnoreCherryPicks(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetIgnoreCherryPicks(utl.PointerToString("true"))
+	icp := releaseType.GetIgnoreCherryPicks()
+	assert.Equal(t, "true", *icp)
+}
+
+func TestReleaseTypeGetIgnoreMerges(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetIgnoreMerges(utl.PointerToString("true"))
+	im := releaseType.GetIgnoreMerges()
+	assert.Equal(t, "true", *im)
+}
+
+func TestReleaseTypeGetRequiredEnvironmentVariables(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetRequiredEnvironmentVariables(&[]*string{utl.PointerToString("GITHUB_TOKEN"), utl.PointerToString("GPG_KEY")})
+	rev := releaseType.GetRequiredEnvironmentVariables()
+	assert.Equal(t, 2, len(*rev))
+	assert.Equal(t, "GITHUB_TOKEN", *(*rev)[0])
+	assert.Equal(t, "GPG_KEY", *(*rev)[1])
+}
+
+func TestReleaseTypeGetRequireSignedCommits(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetRequireSignedCommits(utl.PointerToString("true"))
+	rsc := releaseType.GetRequireSignedCommits()
+	assert.Equal(t, "true", *rsc)
+}
+
+func TestReleaseTypeGetVersionConstraint(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetVersionConstraint(utl.PointerToString("~1.4"))
+	vc := releaseType.GetVersionConstraint()
+	assert.Equal(t, "~1.4", *vc)
+}
+
+func TestReleaseTypeGetPullRequestMessages(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetPullRequestMessages(utl.PointerToString("github"))
+	prm := releaseType.GetPullRequestMessages()
+	assert.Equal(t, "github", *prm)
+}
+
+func TestReleaseTypeGetReleaseMetadataFile(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetReleaseMetadataFile(utl.PointerToString(".nyx-release.json"))
+	rmf := releaseType.GetReleaseMetadataFile()
+	assert.Equal(t, ".nyx-release.json", *rmf)
+}
+
 func TestReleaseTypeGetMatchBranches(t *testing.T) {
 	releaseType := NewReleaseType()
 
@@ -229,6 +365,54 @@ func TestReleaseTypeGetMatchEnvironmentVariables(t *testing.T) {
 	assert.Equal(t, &m, mev)
 }
 
+func TestReleaseTypeGetMatchChangedPaths(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetMatchChangedPaths(utl.PointerToString("^api/.*$"))
+	mcp := releaseType.GetMatchChangedPaths()
+	assert.Equal(t, "^api/.*$", *mcp)
+}
+
+func TestReleaseTypeGetMatchDaysOfWeek(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetMatchDaysOfWeek(utl.PointerToString("MONDAY,FRIDAY"))
+	mdow := releaseType.GetMatchDaysOfWeek()
+	assert.Equal(t, "MONDAY,FRIDAY", *mdow)
+}
+
+func TestReleaseTypeGetMatchExpression(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetMatchExpression(utl.PointerToString("{{#eq branch \"main\"}}true{{/eq}}"))
+	me := releaseType.GetMatchExpression()
+	assert.Equal(t, "{{#eq branch \"main\"}}true{{/eq}}", *me)
+}
+
+func TestReleaseTypeGetMatchMode(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetMatchMode(PointerToMatchMode(ANY))
+	mm := releaseType.GetMatchMode()
+	assert.Equal(t, ANY, *mm)
+}
+
+func TestReleaseTypeGetMatchPolicy(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetMatchPolicy(utl.PointerToString("state.bump == 'minor'"))
+	mp := releaseType.GetMatchPolicy()
+	assert.Equal(t, "state.bump == 'minor'", *mp)
+}
+
+func TestReleaseTypeGetMatchTags(t *testing.T) {
+	releaseType := NewReleaseType()
+
+	releaseType.SetMatchTags(utl.PointerToString("^deploy-.*$"))
+	mt := releaseType.GetMatchTags()
+	assert.Equal(t, "^deploy-.*$", *mt)
+}
+
 func TestReleaseTypeGetMatchWorkspaceStatus(t *testing.T) {
 	releaseType := NewReleaseType()
 
@@ -38,6 +38,9 @@ type ReleaseTypes struct {
 	// The private list of remote repositories.
 	RemoteRepositories *[]*string `json:"remoteRepositories,omitempty" yaml:"remoteRepositories,omitempty"`
 
+	// The private list of yanked versions.
+	Yanked *[]*string `json:"yanked,omitempty" yaml:"yanked,omitempty"`
+
 	// The private map of the items.
 	// Due to the lack of an (acceptable) implementation of generics in Go, that doesn't allow
 	// to define T in a way that is not known upfront, this map needs to be
@@ -184,3 +187,22 @@ func (rt *ReleaseTypes) SetRemoteRepositories(remoteRepositories *[]*string) err
 	rt.RemoteRepositories = remoteRepositories
 	return nil
 }
+
+/*
+Returns the list of yanked versions. A nil value means undefined.
+*/
+func (rt *ReleaseTypes) GetYanked() *[]*string {
+	return rt.Yanked
+}
+
+/*
+Sets the list of yanked versions. A nil value means undefined.
+
+Errors can be:
+
+- none
+*/
+func (rt *ReleaseTypes) SetYanked(yanked *[]*string) error {
+	rt.Yanked = yanked
+	return nil
+}
@@ -37,6 +37,7 @@ func TestReleaseTypesNewReleaseType(t *testing.T) {
 	assert.NotNil(t, releaseTypes.GetItems())
 	assert.NotNil(t, releaseTypes.GetPublicationServices())
 	assert.Nil(t, releaseTypes.GetRemoteRepositories())
+	assert.Nil(t, releaseTypes.GetYanked())
 }
 
 func TestReleaseTypeNewReleasesTypeWith(t *testing.T) {
@@ -55,13 +56,16 @@ func TestReleaseTypeNewReleasesTypeWith(t *testing.T) {
 	enabled := []*string{utl.PointerToString("one")}
 	publicationServices := []*string{utl.PointerToString("aservice")}
 	remoteRepositories := []*string{utl.PointerToString("arepo")}
+	yanked := []*string{utl.PointerToString("1.2.3")}
 
 	releaseTypes, err := NewReleaseTypesWith(&enabled, &publicationServices, &remoteRepositories, &items)
 	assert.NoError(t, err)
+	releaseTypes.SetYanked(&yanked)
 
 	assert.Equal(t, &enabled, releaseTypes.GetEnabled())
 	assert.Equal(t, &publicationServices, releaseTypes.GetPublicationServices())
 	assert.Equal(t, &remoteRepositories, releaseTypes.GetRemoteRepositories())
+	assert.Equal(t, &yanked, releaseTypes.GetYanked())
 	assert.Equal(t, &items, releaseTypes.GetItems())
 
 	// also test error conditions when nil parameters are passed
@@ -108,6 +112,15 @@ func TestReleaseTypesGetRemoteRepositories(t *testing.T) {
 	assert.Equal(t, &remoteRepositories, releaseTypes.GetRemoteRepositories())
 }
 
+func TestReleaseTypesGetYanked(t *testing.T) {
+	releaseTypes := NewReleaseTypes()
+
+	yanked := []*string{utl.PointerToString("1.2.3")}
+	err := releaseTypes.SetYanked(&yanked)
+	assert.NoError(t, err)
+	assert.Equal(t, &yanked, releaseTypes.GetYanked())
+}
+
 func TestReleaseTypesGetItems(t *testing.T) {
 	releaseTypes := NewReleaseTypes()
 
@@ -0,0 +1,142 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+/*
+This object models the fields used to configure the server mode, where Nyx listens for push webhooks sent by Git hosting
+services and runs the release process for the branches that have been pushed.
+
+This structure is JSON and YAML aware so all objects are properly managed for marshalling and unmarshalling. This comes with a downside
+as all internal fields must be exported (have the first capital letter in their names) or they can't be marshalled.
+*/
+type ServerConfiguration struct {
+	// The address (host and port) the server listens on.
+	Address *string `json:"address,omitempty" yaml:"address,omitempty"`
+
+	// The regular expression matching the names of the branches to run the release process for.
+	Branches *string `json:"branches,omitempty" yaml:"branches,omitempty"`
+
+	// The name of the command to run when a push is received.
+	Command *string `json:"command,omitempty" yaml:"command,omitempty"`
+
+	// The secret used to verify webhook signatures.
+	Secret *string `json:"secret,omitempty" yaml:"secret,omitempty"`
+}
+
+/*
+Default constructor
+*/
+func NewServerConfiguration() *ServerConfiguration {
+	return &ServerConfiguration{}
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- address the address (host and port) the server listens on. It may be nil
+- branches the regular expression matching the names of the branches to run the release process for. It may be nil
+- command the name of the command to run when a push is received. It may be nil
+- secret the secret used to verify webhook signatures. It may be nil
+*/
+func NewServerConfigurationWith(address *string, branches *string, command *string, secret *string) *ServerConfiguration {
+	sc := ServerConfiguration{}
+
+	sc.Address = address
+	sc.Branches = branches
+	sc.Command = command
+	sc.Secret = secret
+
+	return &sc
+}
+
+/*
+Returns the address (host and port) the server listens on.
+*/
+func (sc *ServerConfiguration) GetAddress() *string {
+	return sc.Address
+}
+
+/*
+Sets the address (host and port) the server listens on.
+
+Errors can be:
+
+- none
+*/
+func (sc *ServerConfiguration) SetAddress(address *string) error {
+	sc.Address = address
+	return nil
+}
+
+/*
+Returns the regular expression matching the names of the branches to run the release process for.
+*/
+func (sc *ServerConfiguration) GetBranches() *string {
+	return sc.Branches
+}
+
+/*
+Sets the regular expression matching the names of the branches to run the release process for.
+
+Errors can be:
+
+- none
+*/
+func (sc *ServerConfiguration) SetBranches(branches *string) error {
+	sc.Branches = branches
+	return nil
+}
+
+/*
+Returns the name of the command to run when a push is received.
+*/
+func (sc *ServerConfiguration) GetCommand() *string {
+	return sc.Command
+}
+
+/*
+Sets the name of the command to run when a push is received.
+
+Errors can be:
+
+- none
+*/
+func (sc *ServerConfiguration) SetCommand(command *string) error {
+	sc.Command = command
+	return nil
+}
+
+/*
+Returns the secret used to verify webhook signatures.
+*/
+func (sc *ServerConfiguration) GetSecret() *string {
+	return sc.Secret
+}
+
+/*
+Sets the secret used to verify webhook signatures.
+
+Errors can be:
+
+- none
+*/
+func (sc *ServerConfiguration) SetSecret(secret *string) error {
+	sc.Secret = secret
+	return nil
+}
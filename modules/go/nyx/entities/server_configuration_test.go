@@ -0,0 +1,81 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+func TestServerConfigurationNewServerConfiguration(t *testing.T) {
+	sc := NewServerConfiguration()
+
+	// default constructor has its fields set to default values
+	assert.Nil(t, sc.GetAddress())
+	assert.Nil(t, sc.GetBranches())
+	assert.Nil(t, sc.GetCommand())
+	assert.Nil(t, sc.GetSecret())
+}
+
+func TestServerConfigurationNewServerConfigurationWith(t *testing.T) {
+	sc := NewServerConfigurationWith(utl.PointerToString("address1"), utl.PointerToString("branches1"), utl.PointerToString("command1"), utl.PointerToString("secret1"))
+
+	assert.Equal(t, "address1", *sc.GetAddress())
+	assert.Equal(t, "branches1", *sc.GetBranches())
+	assert.Equal(t, "command1", *sc.GetCommand())
+	assert.Equal(t, "secret1", *sc.GetSecret())
+}
+
+func TestServerConfigurationGetAddress(t *testing.T) {
+	sc := NewServerConfigurationWith(utl.PointerToString("address1"), utl.PointerToString("branches1"), utl.PointerToString("command1"), utl.PointerToString("secret1"))
+
+	assert.Equal(t, "address1", *sc.GetAddress())
+	sc.SetAddress(utl.PointerToString("address2"))
+	assert.Equal(t, "address2", *sc.GetAddress())
+}
+
+func TestServerConfigurationGetBranches(t *testing.T) {
+	sc := NewServerConfigurationWith(utl.PointerToString("address1"), utl.PointerToString("branches1"), utl.PointerToString("command1"), utl.PointerToString("secret1"))
+
+	assert.Equal(t, "branches1", *sc.GetBranches())
+	sc.SetBranches(utl.PointerToString("branches2"))
+	assert.Equal(t, "branches2", *sc.GetBranches())
+}
+
+func TestServerConfigurationGetCommand(t *testing.T) {
+	sc := NewServerConfigurationWith(utl.PointerToString("address1"), utl.PointerToString("branches1"), utl.PointerToString("command1"), utl.PointerToString("secret1"))
+
+	assert.Equal(t, "command1", *sc.GetCommand())
+	sc.SetCommand(utl.PointerToString("command2"))
+	assert.Equal(t, "command2", *sc.GetCommand())
+}
+
+func TestServerConfigurationGetSecret(t *testing.T) {
+	sc := NewServerConfigurationWith(utl.PointerToString("address1"), utl.PointerToString("branches1"), utl.PointerToString("command1"), utl.PointerToString("secret1"))
+
+	assert.Equal(t, "secret1", *sc.GetSecret())
+	sc.SetSecret(utl.PointerToString("secret2"))
+	assert.Equal(t, "secret2", *sc.GetSecret())
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entities
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+This class maps allowed values for the source of the release timestamp.
+*/
+type TimestampSource string
+
+const (
+	// The timestamp is the current time, as it's returned by the clock in use.
+	SYSTEM TimestampSource = "SYSTEM"
+
+	// The timestamp is the date of the latest commit in the repository, so that it's the same on every run.
+	COMMIT TimestampSource = "COMMIT"
+)
+
+/*
+Returns the string representation of the timestamp source
+*/
+func (ts TimestampSource) String() string {
+	switch ts {
+	case SYSTEM:
+		return "SYSTEM"
+	case COMMIT:
+		return "COMMIT"
+	default:
+		// this is never reached, but in case...
+		panic("unknown TimestampSource. This means the switch/case statement needs to be updated")
+	}
+}
+
+/*
+Returns the timestamp source corresponding to the given string.
+
+Errors can be:
+
+- IllegalPropertyError in case an unknown timestamp source is passed
+*/
+func ValueOfTimestampSource(s string) (TimestampSource, error) {
+	switch s {
+	case "SYSTEM":
+		return SYSTEM, nil
+	case "COMMIT":
+		return COMMIT, nil
+	default:
+		return SYSTEM, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal timestamp source '%s'", s)}
+	}
+}
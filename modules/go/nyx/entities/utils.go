@@ -43,6 +43,15 @@ func PointerToProvider(p Provider) *Provider {
 	return &p
 }
 
+/*
+Returns a pointer to the match mode passed as parameter.
+
+This is useful for inline assignment of a constant match mode value.
+*/
+func PointerToMatchMode(mm MatchMode) *MatchMode {
+	return &mm
+}
+
 /*
 Returns a pointer to the workspace status passed as parameter.
 
@@ -60,3 +69,39 @@ This is useful for inline assignment of a constant verbosity value.
 func PointerToVerbosity(v Verbosity) *Verbosity {
 	return &v
 }
+
+/*
+Returns a pointer to the nothing to release policy passed as parameter.
+
+This is useful for inline assignment of a constant nothing to release policy value.
+*/
+func PointerToNothingToReleasePolicy(p NothingToReleasePolicy) *NothingToReleasePolicy {
+	return &p
+}
+
+/*
+Returns a pointer to the timestamp source passed as parameter.
+
+This is useful for inline assignment of a constant timestamp source value.
+*/
+func PointerToTimestampSource(ts TimestampSource) *TimestampSource {
+	return &ts
+}
+
+/*
+Returns a pointer to the Git backend passed as parameter.
+
+This is useful for inline assignment of a constant Git backend value.
+*/
+func PointerToGitBackend(gb GitBackend) *GitBackend {
+	return &gb
+}
+
+/*
+Returns a pointer to the pre-release merge passed as parameter.
+
+This is useful for inline assignment of a constant pre-release merge value.
+*/
+func PointerToPreReleaseMerge(prm PreReleaseMerge) *PreReleaseMerge {
+	return &prm
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+The enumeration of the supported event bus types.
+*/
+type EmitterType string
+
+const (
+	// Apache Kafka, reached through the Confluent REST Proxy (v2 API).
+	KAFKA EmitterType = "KAFKA"
+
+	// NATS, reached through the NATS client protocol.
+	NATS EmitterType = "NATS"
+
+	// AWS EventBridge, reached through the PutEvents API.
+	EVENTBRIDGE EmitterType = "EVENTBRIDGE"
+)
+
+/*
+Returns the string representation of the emitter type
+*/
+func (et EmitterType) String() string {
+	switch et {
+	case KAFKA:
+		return "KAFKA"
+	case NATS:
+		return "NATS"
+	case EVENTBRIDGE:
+		return "EVENTBRIDGE"
+	default:
+		// this is never reached, but in case...
+		panic("unknown EmitterType. This means the switch/case statement needs to be updated")
+	}
+}
+
+/*
+Returns the emitter type corresponding to the given string.
+
+Errors can be:
+
+- IllegalPropertyError in case an unknown emitter type is passed
+*/
+func ValueOfEmitterType(s string) (EmitterType, error) {
+	switch s {
+	case "KAFKA":
+		return KAFKA, nil
+	case "NATS":
+		return NATS, nil
+	case "EVENTBRIDGE":
+		return EVENTBRIDGE, nil
+	default:
+		return KAFKA, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal event emitter type '%s'", s)}
+	}
+}
+
+/*
+An emitter publishes events to an event bus.
+*/
+type Emitter interface {
+	/*
+		Publishes the given event.
+
+		Arguments are as follows:
+
+		- event the event to publish
+
+		Errors can be:
+
+		- DataAccessError in case the event cannot be marshalled
+		- TransportError in case the event can't be delivered to the event bus
+	*/
+	Emit(event Event) error
+}
+
+/*
+Returns a new emitter of the given type.
+
+Arguments are as follows:
+
+  - emitterType the type of the emitter. It can't be nil
+  - endpoint the URL of the event bus endpoint. If nil the default endpoint for the emitter type is used, when available
+  - topic the name of the Kafka topic, NATS subject or EventBridge event bus to publish to
+  - region the region hosting the event bus, only used by EventBridge
+  - user the user name (or access key) used to authenticate, it may be nil
+  - password the password (or secret key) used to authenticate, it may be nil
+
+Errors can be:
+
+- NilPointerError if the emitter type is nil
+- IllegalPropertyError if the emitter type is unknown or some required attribute is missing or illegal
+*/
+func NewEmitter(emitterType *string, endpoint *string, topic *string, region *string, user *string, password *string) (Emitter, error) {
+	if emitterType == nil || "" == strings.TrimSpace(*emitterType) {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the event emitter type cannot be nil")}
+	}
+	et, err := ValueOfEmitterType(strings.ToUpper(strings.TrimSpace(*emitterType)))
+	if err != nil {
+		return nil, err
+	}
+	switch et {
+	case KAFKA:
+		return newKafkaEmitter(endpoint, topic, user, password)
+	case NATS:
+		return newNATSEmitter(endpoint, topic, user, password)
+	case EVENTBRIDGE:
+		return newEventBridgeEmitter(endpoint, topic, region, user, password)
+	default:
+		// this is never reached, but in case...
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal event emitter type '%s'", *emitterType)}
+	}
+}
@@ -0,0 +1,249 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"bufio"             // https://pkg.go.dev/bufio
+	"encoding/json"     // https://pkg.go.dev/encoding/json
+	"io"                // https://pkg.go.dev/io
+	"net"               // https://pkg.go.dev/net
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"strings"           // https://pkg.go.dev/strings
+	"testing"           // https://pkg.go.dev/testing
+	"time"              // https://pkg.go.dev/time
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+Returns a sample event to publish in tests.
+*/
+func newTestEvent() Event {
+	return Event{SchemaVersion: SCHEMA_VERSION, ID: "0123456789abcdef", Type: PUBLISHED, Source: EVENT_SOURCE, Timestamp: 1, Command: "publish", Version: utl.PointerToString("1.2.3"), NewVersion: true, NewRelease: true}
+}
+
+/*
+Starts a fake NATS server accepting a single connection. The server replies to the client with the given reply
+after the PING and sends the received protocol lines to the returned channel.
+*/
+func newTestNATSServer(t *testing.T, reply string) (net.Listener, chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	lines := make(chan string, 10)
+	go func() {
+		defer close(lines)
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.Write([]byte("INFO {\"server_id\":\"test\"}\r\n"))
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimSpace(line)
+			lines <- line
+			if line == "PING" {
+				conn.Write([]byte(reply + "\r\n"))
+				return
+			}
+		}
+	}()
+	return listener, lines
+}
+
+func TestEmitterTypeValueOf(t *testing.T) {
+	for _, emitterType := range []EmitterType{KAFKA, NATS, EVENTBRIDGE} {
+		res, err := ValueOfEmitterType(emitterType.String())
+		assert.NoError(t, err)
+		assert.Equal(t, emitterType, res)
+	}
+
+	_, err := ValueOfEmitterType("RABBIT")
+	assert.Error(t, err)
+}
+
+func TestNewEmitter(t *testing.T) {
+	_, err := NewEmitter(nil, nil, nil, nil, nil, nil)
+	assert.Error(t, err)
+	_, err = NewEmitter(utl.PointerToString("RABBIT"), nil, utl.PointerToString("topic"), nil, nil, nil)
+	assert.Error(t, err)
+
+	// Kafka requires the endpoint and the topic
+	_, err = NewEmitter(utl.PointerToString("kafka"), nil, utl.PointerToString("topic"), nil, nil, nil)
+	assert.Error(t, err)
+	_, err = NewEmitter(utl.PointerToString("kafka"), utl.PointerToString("http://localhost:8082"), nil, nil, nil, nil)
+	assert.Error(t, err)
+	emitter, err := NewEmitter(utl.PointerToString("kafka"), utl.PointerToString("http://localhost:8082/"), utl.PointerToString("topic"), nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8082", emitter.(kafkaEmitter).endpoint)
+
+	// NATS requires the subject and defaults the endpoint
+	_, err = NewEmitter(utl.PointerToString("NATS"), nil, nil, nil, nil, nil)
+	assert.Error(t, err)
+	_, err = NewEmitter(utl.PointerToString("NATS"), utl.PointerToString("http://localhost:4222"), utl.PointerToString("releases"), nil, nil, nil)
+	assert.Error(t, err)
+	emitter, err = NewEmitter(utl.PointerToString("NATS"), nil, utl.PointerToString("releases"), nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost:4222", emitter.(natsEmitter).endpoint.Host)
+	emitter, err = NewEmitter(utl.PointerToString("NATS"), utl.PointerToString("tls://nats.example.com"), utl.PointerToString("releases"), nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "nats.example.com:4222", emitter.(natsEmitter).endpoint.Host)
+
+	// EventBridge requires the region and the credentials and defaults the endpoint and the event bus
+	_, err = NewEmitter(utl.PointerToString("EventBridge"), nil, nil, nil, utl.PointerToString("AKID"), utl.PointerToString("secret"))
+	assert.Error(t, err)
+	_, err = NewEmitter(utl.PointerToString("EventBridge"), nil, nil, utl.PointerToString("eu-west-1"), utl.PointerToString("AKID"), nil)
+	assert.Error(t, err)
+	emitter, err = NewEmitter(utl.PointerToString("EventBridge"), nil, nil, utl.PointerToString("eu-west-1"), utl.PointerToString("AKID"), utl.PointerToString("secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://events.eu-west-1.amazonaws.com/", emitter.(eventBridgeEmitter).endpoint.String())
+	assert.Equal(t, EVENTBRIDGE_DEFAULT_EVENT_BUS, emitter.(eventBridgeEmitter).eventBus)
+}
+
+func TestKafkaEmitterEmit(t *testing.T) {
+	var request *http.Request
+	var body []byte
+	reply := `{"offsets":[{"partition":0,"offset":1,"error_code":null,"error":null}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(reply))
+	}))
+	defer server.Close()
+
+	emitter, err := newKafkaEmitter(utl.PointerToString(server.URL), utl.PointerToString("releases"), utl.PointerToString("jdoe"), utl.PointerToString("s3cr3t"))
+	assert.NoError(t, err)
+	assert.NoError(t, emitter.Emit(newTestEvent()))
+	assert.Equal(t, http.MethodPost, request.Method)
+	assert.Equal(t, "/topics/releases", request.URL.Path)
+	assert.Equal(t, KAFKA_CONTENT_TYPE, request.Header.Get("Content-Type"))
+	user, password, ok := request.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "jdoe", user)
+	assert.Equal(t, "s3cr3t", password)
+
+	var records kafkaRecords
+	assert.NoError(t, json.Unmarshal(body, &records))
+	assert.Len(t, records.Records, 1)
+	assert.Equal(t, "0123456789abcdef", records.Records[0].Key)
+	assert.Equal(t, "1.2.3", *records.Records[0].Value.Version)
+
+	// record level errors are reported
+	reply = `{"offsets":[{"partition":null,"offset":null,"error_code":40403,"error":"topic not found"}]}`
+	assert.Error(t, emitter.Emit(newTestEvent()))
+}
+
+func TestNATSEmitterEmit(t *testing.T) {
+	listener, lines := newTestNATSServer(t, "PONG")
+	defer listener.Close()
+
+	emitter, err := newNATSEmitter(utl.PointerToString("nats://"+listener.Addr().String()), utl.PointerToString("releases.nyx"), utl.PointerToString("jdoe"), utl.PointerToString("s3cr3t"))
+	assert.NoError(t, err)
+	assert.NoError(t, emitter.Emit(newTestEvent()))
+
+	received := make([]string, 0)
+	for line := range lines {
+		received = append(received, line)
+	}
+	assert.Len(t, received, 4)
+	assert.True(t, strings.HasPrefix(received[0], "CONNECT "))
+	assert.Contains(t, received[0], `"user":"jdoe"`)
+	assert.Contains(t, received[0], `"pass":"s3cr3t"`)
+	assert.True(t, strings.HasPrefix(received[1], "PUB releases.nyx "))
+	assert.Contains(t, received[2], `"type":"PUBLISHED"`)
+	assert.Equal(t, "PING", received[3])
+
+	// errors returned by the server are reported
+	listener, _ = newTestNATSServer(t, "-ERR 'Authorization Violation'")
+	defer listener.Close()
+	emitter, err = newNATSEmitter(utl.PointerToString("nats://"+listener.Addr().String()), utl.PointerToString("releases.nyx"), nil, nil)
+	assert.NoError(t, err)
+	err = emitter.Emit(newTestEvent())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Authorization Violation")
+}
+
+func TestEventBridgeEmitterSign(t *testing.T) {
+	// the signature is checked against the example of the AWS Signature Version 4 test suite adapted to the EventBridge service
+	emitter, err := newEventBridgeEmitter(utl.PointerToString("https://events.us-east-1.amazonaws.com"), nil, utl.PointerToString("us-east-1"), utl.PointerToString("AKIDEXAMPLE"), utl.PointerToString("wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"))
+	assert.NoError(t, err)
+	request, err := http.NewRequest(http.MethodPost, emitter.endpoint.String(), strings.NewReader("{}"))
+	assert.NoError(t, err)
+	request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	request.Header.Set("X-Amz-Target", "AWSEvents.PutEvents")
+	emitter.sign(request, []byte("{}"), time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	assert.Equal(t, "20150830T123600Z", request.Header.Get("X-Amz-Date"))
+	authorization := request.Header.Get("Authorization")
+	assert.True(t, strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/events/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-target, Signature="))
+
+	// the signature is deterministic
+	other, _ := http.NewRequest(http.MethodPost, emitter.endpoint.String(), strings.NewReader("{}"))
+	other.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	other.Header.Set("X-Amz-Target", "AWSEvents.PutEvents")
+	emitter.sign(other, []byte("{}"), time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+	assert.Equal(t, authorization, other.Header.Get("Authorization"))
+}
+
+func TestEventBridgeEmitterEmit(t *testing.T) {
+	var request *http.Request
+	var body []byte
+	reply := `{"FailedEntryCount":0,"Entries":[{"EventId":"11710aed-b79e-4468-a20b-bb3c0c3b4860"}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(reply))
+	}))
+	defer server.Close()
+
+	emitter, err := newEventBridgeEmitter(utl.PointerToString(server.URL), utl.PointerToString("releases"), utl.PointerToString("eu-west-1"), utl.PointerToString("AKID"), utl.PointerToString("secret"))
+	assert.NoError(t, err)
+	assert.NoError(t, emitter.Emit(newTestEvent()))
+	assert.Equal(t, http.MethodPost, request.Method)
+	assert.Equal(t, "AWSEvents.PutEvents", request.Header.Get("X-Amz-Target"))
+	assert.True(t, strings.HasPrefix(request.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/"))
+
+	var entries eventBridgeRequest
+	assert.NoError(t, json.Unmarshal(body, &entries))
+	assert.Len(t, entries.Entries, 1)
+	assert.Equal(t, EVENT_SOURCE, entries.Entries[0].Source)
+	assert.Equal(t, "releases", entries.Entries[0].EventBusName)
+	assert.Equal(t, EVENTBRIDGE_DETAIL_TYPE_PREFIX+"PUBLISHED", entries.Entries[0].DetailType)
+	var detail Event
+	assert.NoError(t, json.Unmarshal([]byte(entries.Entries[0].Detail), &detail))
+	assert.Equal(t, "1.2.3", *detail.Version)
+
+	// failed entries are reported
+	reply = `{"FailedEntryCount":1,"Entries":[{"ErrorCode":"NotAuthorized","ErrorMessage":"not authorized"}]}`
+	err = emitter.Emit(newTestEvent())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "NotAuthorized")
+}
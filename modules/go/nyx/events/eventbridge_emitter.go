@@ -0,0 +1,302 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"bytes"         // https://pkg.go.dev/bytes
+	"crypto/hmac"   // https://pkg.go.dev/crypto/hmac
+	"crypto/sha256" // https://pkg.go.dev/crypto/sha256
+	"encoding/hex"  // https://pkg.go.dev/encoding/hex
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"io"            // https://pkg.go.dev/io
+	"net/http"      // https://pkg.go.dev/net/http
+	"net/url"       // https://pkg.go.dev/net/url
+	"os"            // https://pkg.go.dev/os
+	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The format string used to build the default EventBridge endpoint for a region.
+	EVENTBRIDGE_DEFAULT_ENDPOINT_FORMAT_STRING = "https://events.%s.amazonaws.com"
+
+	// The name of the event bus used when no topic is configured.
+	EVENTBRIDGE_DEFAULT_EVENT_BUS = "default"
+
+	// The prefix of the detail type of the events published to EventBridge. The event type is appended to this prefix.
+	EVENTBRIDGE_DETAIL_TYPE_PREFIX = "Nyx Release "
+
+	// The name of the AWS service used for request signing.
+	eventBridgeServiceName = "events"
+
+	// The name of the environment variable the access key is read from when no user is configured.
+	awsAccessKeyIDEnvVarName = "AWS_ACCESS_KEY_ID"
+
+	// The name of the environment variable the secret key is read from when no password is configured.
+	awsSecretAccessKeyEnvVarName = "AWS_SECRET_ACCESS_KEY"
+
+	// The name of the environment variable the optional session token is read from when no user and password are configured.
+	awsSessionTokenEnvVarName = "AWS_SESSION_TOKEN"
+)
+
+/*
+The emitter publishing events to an AWS EventBridge event bus using the PutEvents API.
+Requests are signed using the AWS Signature Version 4.
+*/
+type eventBridgeEmitter struct {
+	// The endpoint URL of the EventBridge API.
+	endpoint *url.URL
+
+	// The name (or ARN) of the event bus to publish to.
+	eventBus string
+
+	// The AWS region hosting the event bus.
+	region string
+
+	// The access key used to sign requests.
+	accessKey string
+
+	// The secret key used to sign requests.
+	secretKey string
+
+	// The session token to send along with requests, it may be empty.
+	sessionToken string
+
+	// The private HTTP client instance.
+	client *http.Client
+}
+
+/*
+The single entry of the PutEvents request.
+*/
+type eventBridgeEntry struct {
+	Source       string `json:"Source"`
+	DetailType   string `json:"DetailType"`
+	Detail       string `json:"Detail"`
+	EventBusName string `json:"EventBusName"`
+}
+
+/*
+The PutEvents request body.
+*/
+type eventBridgeRequest struct {
+	Entries []eventBridgeEntry `json:"Entries"`
+}
+
+/*
+The PutEvents response body.
+*/
+type eventBridgeResponse struct {
+	FailedEntryCount int `json:"FailedEntryCount"`
+	Entries          []struct {
+		EventId      *string `json:"EventId"`
+		ErrorCode    *string `json:"ErrorCode"`
+		ErrorMessage *string `json:"ErrorMessage"`
+	} `json:"Entries"`
+}
+
+/*
+Returns a new EventBridge emitter.
+
+Arguments are as follows:
+
+  - endpoint the endpoint URL of the EventBridge API. If nil the regional endpoint is used
+  - eventBus the name or ARN of the event bus to publish to. If nil EVENTBRIDGE_DEFAULT_EVENT_BUS is used
+  - region the AWS region hosting the event bus. It can't be nil
+  - user the access key used to sign requests. If nil the AWS_ACCESS_KEY_ID environment variable is used
+  - password the secret key used to sign requests. If nil the AWS_SECRET_ACCESS_KEY environment variable is used
+
+Errors can be:
+
+- IllegalPropertyError if some required attribute is missing or illegal
+*/
+func newEventBridgeEmitter(endpoint *string, eventBus *string, region *string, user *string, password *string) (eventBridgeEmitter, error) {
+	if region == nil || "" == strings.TrimSpace(*region) {
+		return eventBridgeEmitter{}, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' event emitter requires the region", EVENTBRIDGE.String())}
+	}
+	res := eventBridgeEmitter{region: strings.TrimSpace(*region), eventBus: EVENTBRIDGE_DEFAULT_EVENT_BUS, client: &http.Client{}}
+	if eventBus != nil && "" != strings.TrimSpace(*eventBus) {
+		res.eventBus = strings.TrimSpace(*eventBus)
+	}
+
+	rawEndpoint := fmt.Sprintf(EVENTBRIDGE_DEFAULT_ENDPOINT_FORMAT_STRING, res.region)
+	if endpoint == nil || "" == strings.TrimSpace(*endpoint) {
+		log.Debugf("no endpoint configured for the '%s' event emitter, the default endpoint '%s' will be used", EVENTBRIDGE.String(), rawEndpoint)
+	} else {
+		rawEndpoint = strings.TrimSpace(*endpoint)
+	}
+	parsedEndpoint, err := url.Parse(rawEndpoint)
+	if err != nil || parsedEndpoint.Host == "" {
+		return eventBridgeEmitter{}, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' event emitter endpoint '%s' is not a valid URL", EVENTBRIDGE.String(), rawEndpoint), Cause: err}
+	}
+	if parsedEndpoint.Path == "" {
+		parsedEndpoint.Path = "/"
+	}
+	res.endpoint = parsedEndpoint
+
+	if user == nil && password == nil {
+		log.Debugf("no credentials configured for the '%s' event emitter, reading them from the '%s' and '%s' environment variables", EVENTBRIDGE.String(), awsAccessKeyIDEnvVarName, awsSecretAccessKeyEnvVarName)
+		res.accessKey = os.Getenv(awsAccessKeyIDEnvVarName)
+		res.secretKey = os.Getenv(awsSecretAccessKeyEnvVarName)
+		res.sessionToken = os.Getenv(awsSessionTokenEnvVarName)
+	} else {
+		if user != nil {
+			res.accessKey = *user
+		}
+		if password != nil {
+			res.secretKey = *password
+		}
+	}
+	if "" == strings.TrimSpace(res.accessKey) || "" == strings.TrimSpace(res.secretKey) {
+		return eventBridgeEmitter{}, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' event emitter requires both the access key (user) and the secret key (password)", EVENTBRIDGE.String())}
+	}
+	return res, nil
+}
+
+/*
+Returns the HMAC-SHA256 of the given data using the given key.
+*/
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+/*
+Returns the hex encoded SHA256 hash of the given data.
+*/
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+/*
+Signs the given request using the AWS Signature Version 4. The request must already have all the headers
+to sign set, apart from the authorization and date headers which are set by this method.
+
+Arguments are as follows:
+
+- request the request to sign
+- body the request body
+- now the signing time
+*/
+func (e eventBridgeEmitter) sign(request *http.Request, body []byte, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := now.UTC().Format("20060102")
+	request.Header.Set("X-Amz-Date", amzDate)
+	if e.sessionToken != "" {
+		request.Header.Set("X-Amz-Security-Token", e.sessionToken)
+	}
+
+	// the headers to sign, in lexicographical order
+	signedHeaderNames := []string{"content-type", "host", "x-amz-date"}
+	if e.sessionToken != "" {
+		signedHeaderNames = append(signedHeaderNames, "x-amz-security-token")
+	}
+	signedHeaderNames = append(signedHeaderNames, "x-amz-target")
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaderNames {
+		value := request.Header.Get(name)
+		if name == "host" {
+			value = request.URL.Host
+		}
+		canonicalHeaders.WriteString(fmt.Sprintf("%s:%s\n", name, strings.TrimSpace(value)))
+	}
+	signedHeaders := strings.Join(signedHeaderNames, ";")
+
+	canonicalRequest := strings.Join([]string{request.Method, request.URL.EscapedPath(), request.URL.RawQuery, canonicalHeaders.String(), signedHeaders, sha256Hex(body)}, "\n")
+	scope := fmt.Sprintf("%s/%s/%s/aws4_request", date, e.region, eventBridgeServiceName)
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+e.secretKey), date)
+	signingKey = hmacSHA256(signingKey, e.region)
+	signingKey = hmacSHA256(signingKey, eventBridgeServiceName)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", e.accessKey, scope, signedHeaders, signature))
+}
+
+/*
+Publishes the given event to the configured event bus. The event is used as the entry detail while the
+detail type is EVENTBRIDGE_DETAIL_TYPE_PREFIX followed by the event type.
+
+Arguments are as follows:
+
+- event the event to publish
+
+Errors can be:
+
+- DataAccessError in case the event cannot be marshalled
+- TransportError in case the event can't be delivered to EventBridge
+*/
+func (e eventBridgeEmitter) Emit(event Event) error {
+	detail, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(eventBridgeRequest{Entries: []eventBridgeEntry{{Source: EVENT_SOURCE, DetailType: EVENTBRIDGE_DETAIL_TYPE_PREFIX + event.Type.String(), Detail: string(detail), EventBusName: e.eventBus}}})
+	if err != nil {
+		return &errs.DataAccessError{Message: fmt.Sprintf("unable to marshal the '%s' event", event.Type.String()), Cause: err}
+	}
+	requestURL := e.endpoint.String()
+	request, err := http.NewRequest(http.MethodPost, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to create the '%s' request to '%s'", http.MethodPost, requestURL), Cause: err}
+	}
+	request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	request.Header.Set("X-Amz-Target", "AWSEvents.PutEvents")
+	e.sign(request, body, time.Now())
+
+	log.Tracef("sending '%s' request to '%s'", http.MethodPost, requestURL)
+	response, err := e.client.Do(request)
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed", http.MethodPost, requestURL), Cause: err}
+	}
+	defer response.Body.Close()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to read the response to '%s' request to '%s'", http.MethodPost, requestURL), Cause: err}
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed with status '%s': %s", http.MethodPost, requestURL, response.Status, string(responseBody))}
+	}
+
+	var result eventBridgeResponse
+	err = json.Unmarshal(responseBody, &result)
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to parse the response to '%s' request to '%s': %s", http.MethodPost, requestURL, string(responseBody)), Cause: err}
+	}
+	if result.FailedEntryCount > 0 {
+		message := ""
+		for _, entry := range result.Entries {
+			if entry.ErrorCode != nil {
+				message = *entry.ErrorCode
+				if entry.ErrorMessage != nil {
+					message = fmt.Sprintf("%s: %s", message, *entry.ErrorMessage)
+				}
+			}
+		}
+		return &errs.TransportError{Message: fmt.Sprintf("the '%s' event was rejected by EventBridge at '%s': %s", event.Type.String(), requestURL, message)}
+	}
+	return nil
+}
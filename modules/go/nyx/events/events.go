@@ -0,0 +1,356 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This package provides the emitters publishing release lifecycle events to external event buses, like Kafka, NATS
+or AWS EventBridge, so that downstream automation can react to releases.
+*/
+package events
+
+import (
+	"crypto/rand"   // https://pkg.go.dev/crypto/rand
+	"encoding/hex"  // https://pkg.go.dev/encoding/hex
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"strings"       // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	clk "github.com/mooltiverse/nyx/modules/go/nyx/clock"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	tpl "github.com/mooltiverse/nyx/modules/go/nyx/template"
+)
+
+const (
+	// The version of the schema of the events published by emitters. This is incremented whenever an
+	// incompatible change is made to the Event structure.
+	SCHEMA_VERSION = 1
+
+	// The source reported in all the events published by Nyx.
+	EVENT_SOURCE = "nyx"
+)
+
+/*
+The enumeration of the release lifecycle event types.
+*/
+type EventType string
+
+const (
+	// The event published when the Infer command has completed and the version has been inferred.
+	INFERRED EventType = "INFERRED"
+
+	// The event published when the Mark command has completed and the new release has been committed, tagged and pushed.
+	MARKED EventType = "MARKED"
+
+	// The event published when the Publish command has completed and the new release has been published.
+	PUBLISHED EventType = "PUBLISHED"
+
+	// The event published when a command fails.
+	FAILED EventType = "FAILED"
+)
+
+/*
+Returns the string representation of the event type
+*/
+func (et EventType) String() string {
+	switch et {
+	case INFERRED:
+		return "INFERRED"
+	case MARKED:
+		return "MARKED"
+	case PUBLISHED:
+		return "PUBLISHED"
+	case FAILED:
+		return "FAILED"
+	default:
+		// this is never reached, but in case...
+		panic("unknown EventType. This means the switch/case statement needs to be updated")
+	}
+}
+
+/*
+Returns the event type corresponding to the given string.
+
+Errors can be:
+
+- IllegalPropertyError in case an unknown event type is passed
+*/
+func ValueOfEventType(s string) (EventType, error) {
+	switch s {
+	case "INFERRED":
+		return INFERRED, nil
+	case "MARKED":
+		return MARKED, nil
+	case "PUBLISHED":
+		return PUBLISHED, nil
+	case "FAILED":
+		return FAILED, nil
+	default:
+		return INFERRED, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal event type '%s'", s)}
+	}
+}
+
+/*
+The release lifecycle event published by emitters. This structure defines the event schema and is marshalled as JSON
+when the event is published.
+*/
+type Event struct {
+	// The version of the event schema. See SCHEMA_VERSION.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// The unique identifier of the event.
+	ID string `json:"id"`
+
+	// The event type.
+	Type EventType `json:"type"`
+
+	// The event source. See EVENT_SOURCE.
+	Source string `json:"source"`
+
+	// The time the event was generated, in milliseconds from the epoch.
+	Timestamp int64 `json:"timestamp"`
+
+	// The name of the command that generated the event.
+	Command string `json:"command"`
+
+	// The name of the current branch, if available.
+	Branch *string `json:"branch,omitempty"`
+
+	// The current version, if available.
+	Version *string `json:"version,omitempty"`
+
+	// The previous version, if available.
+	PreviousVersion *string `json:"previousVersion,omitempty"`
+
+	// The version identifier bumped to produce the current version, if any.
+	Bump *string `json:"bump,omitempty"`
+
+	// True if the current version is different than the previous version.
+	NewVersion bool `json:"newVersion"`
+
+	// True if the current version is going to be published as a new release.
+	NewRelease bool `json:"newRelease"`
+
+	// The error message, only available for FAILED events.
+	Error *string `json:"error,omitempty"`
+}
+
+/*
+Returns a new event of the given type, taking the release attributes from the given state.
+
+Arguments are as follows:
+
+- eventType the type of the event
+- command the name of the command generating the event
+- state the state to take the release attributes from. It can't be nil
+- cause the error that caused the event, only used for FAILED events. It may be nil
+
+Errors can be:
+
+- NilPointerError if the given state is nil
+- DataAccessError in case the state attributes cannot be read or accessed.
+- IllegalPropertyError in case the state attributes have incorrect values or can't be resolved.
+*/
+func NewEvent(eventType EventType, command string, state *stt.State, cause error) (*Event, error) {
+	if state == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the State object cannot be nil")}
+	}
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to generate the event identifier"), Cause: err}
+	}
+
+	event := Event{SchemaVersion: SCHEMA_VERSION, ID: hex.EncodeToString(id), Type: eventType, Source: EVENT_SOURCE, Timestamp: clk.Now().UnixMilli(), Command: command}
+	event.Branch, err = state.GetBranch()
+	if err != nil {
+		return nil, err
+	}
+	event.Version, err = state.GetVersion()
+	if err != nil {
+		return nil, err
+	}
+	releaseScope, err := state.GetReleaseScope()
+	if err != nil {
+		return nil, err
+	}
+	if releaseScope != nil {
+		event.PreviousVersion = releaseScope.GetPreviousVersion()
+	}
+	event.Bump, err = state.GetBump()
+	if err != nil {
+		return nil, err
+	}
+	event.NewVersion, err = state.GetNewVersion()
+	if err != nil {
+		return nil, err
+	}
+	event.NewRelease, err = state.GetNewRelease()
+	if err != nil {
+		return nil, err
+	}
+	if cause != nil {
+		message := cause.Error()
+		event.Error = &message
+	}
+	return &event, nil
+}
+
+/*
+Returns the JSON representation of the event.
+
+Errors can be:
+
+- DataAccessError in case the event cannot be marshalled
+*/
+func (e *Event) Marshal() ([]byte, error) {
+	res, err := json.Marshal(e)
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to marshal the '%s' event", e.Type.String()), Cause: err}
+	}
+	return res, nil
+}
+
+/*
+Publishes an event of the given type to all the enabled event emitters configured in the event bus section
+that are interested in the event type. Nothing is published when running in dry run mode.
+
+Arguments are as follows:
+
+- eventType the type of the event
+- command the name of the command generating the event
+- state the state to take the release attributes and the configuration from. It can't be nil
+- cause the error that caused the event, only used for FAILED events. It may be nil
+
+Errors can be:
+
+- NilPointerError if the given state is nil
+- DataAccessError in case the configuration or the state cannot be read or accessed.
+- IllegalPropertyError in case the configuration has some illegal options.
+- TransportError in case the event can't be published to some event bus.
+*/
+func Emit(eventType EventType, command string, state *stt.State, cause error) error {
+	if state == nil {
+		return &errs.NilPointerError{Message: fmt.Sprintf("the State object cannot be nil")}
+	}
+	eventBus, err := state.GetConfiguration().GetEventBus()
+	if err != nil {
+		return err
+	}
+	if eventBus == nil || eventBus.GetEnabled() == nil || len(*eventBus.GetEnabled()) == 0 {
+		log.Debugf("no event emitters have been configured")
+		return nil
+	}
+	dryRun, err := state.GetConfiguration().GetDryRun()
+	if err != nil {
+		return err
+	}
+	if dryRun != nil && *dryRun {
+		log.Infof("the '%s' event is not published as running in dry run mode", eventType.String())
+		return nil
+	}
+
+	var event *Event = nil
+	for _, emitterName := range *eventBus.GetEnabled() {
+		emitterConfiguration, ok := (*eventBus.GetItems())[*emitterName]
+		if !ok || emitterConfiguration == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("event emitter '%s' is configured among enabled ones but is not configured", *emitterName)}
+		}
+		interested, err := isInterestedIn(emitterConfiguration.GetEvents(), eventType)
+		if err != nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("event emitter '%s' has an illegal list of events", *emitterName), Cause: err}
+		}
+		if !interested {
+			log.Debugf("event emitter '%s' is not configured to publish '%s' events", *emitterName, eventType.String())
+			continue
+		}
+		if event == nil {
+			event, err = NewEvent(eventType, command, state, cause)
+			if err != nil {
+				return err
+			}
+		}
+		user, err := renderTemplate(state, emitterConfiguration.GetUser())
+		if err != nil {
+			return err
+		}
+		password, err := renderTemplate(state, emitterConfiguration.GetPassword())
+		if err != nil {
+			return err
+		}
+		emitter, err := NewEmitter(emitterConfiguration.GetType(), emitterConfiguration.GetEndpoint(), emitterConfiguration.GetTopic(), emitterConfiguration.GetRegion(), user, password)
+		if err != nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("event emitter '%s' cannot be created", *emitterName), Cause: err}
+		}
+		log.Debugf("publishing the '%s' event to the '%s' event emitter", eventType.String(), *emitterName)
+		err = emitter.Emit(*event)
+		if err != nil {
+			return err
+		}
+		log.Debugf("the '%s' event has been published to the '%s' event emitter", eventType.String(), *emitterName)
+	}
+	return nil
+}
+
+/*
+Returns true if the given comma separated list of event types contains the given event type, or if the list is nil
+or blank, meaning that all event types are of interest.
+
+Errors can be:
+
+- IllegalPropertyError in case the list contains an unknown event type
+*/
+func isInterestedIn(events *string, eventType EventType) (bool, error) {
+	if events == nil || "" == strings.TrimSpace(*events) {
+		return true, nil
+	}
+	res := false
+	for _, item := range strings.Split(*events, ",") {
+		if "" == strings.TrimSpace(item) {
+			continue
+		}
+		itemType, err := ValueOfEventType(strings.ToUpper(strings.TrimSpace(item)))
+		if err != nil {
+			return false, err
+		}
+		if itemType == eventType {
+			res = true
+		}
+	}
+	return res, nil
+}
+
+/*
+Renders the given template using the given State object as the context.
+
+Error is:
+- IllegalPropertyError in case the given template can't be rendered.
+*/
+func renderTemplate(state *stt.State, template *string) (*string, error) {
+	if template == nil || "" == strings.TrimSpace(*template) {
+		return template, nil
+	}
+	flatState, err := state.Flatten()
+	if err != nil {
+		return nil, &errs.IllegalStateError{Message: fmt.Sprintf("the internal state cannot be flattened for rendering"), Cause: err}
+	}
+	res, err := tpl.Render(*template, flatState)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("template '%s' cannot be rendered using the current state", *template), Cause: err}
+	}
+	return &res, nil
+}
@@ -0,0 +1,170 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"encoding/json"     // https://pkg.go.dev/encoding/json
+	"fmt"               // https://pkg.go.dev/fmt
+	"io"                // https://pkg.go.dev/io
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"testing"           // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+Returns a new state whose event bus enables all the given emitters.
+*/
+func newEventBusState(t *testing.T, dryRun bool, emitters map[string]*ent.EventEmitter) *stt.State {
+	enabled := make([]*string, 0)
+	for name := range emitters {
+		enabled = append(enabled, utl.PointerToString(name))
+	}
+	eventBus, err := ent.NewEventBusWith(&enabled, &emitters)
+	assert.NoError(t, err)
+	configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+	configurationLayerMock.SetEventBus(eventBus)
+	configurationLayerMock.SetDryRun(&dryRun)
+	configuration, _ := cnf.NewConfiguration()
+	var configurationLayer cnf.ConfigurationLayer
+	configurationLayer = configurationLayerMock
+	configuration.WithRuntimeConfiguration(&configurationLayer)
+	state, err := stt.NewStateWith(configuration)
+	assert.NoError(t, err)
+	return state
+}
+
+func TestEventTypeValueOf(t *testing.T) {
+	for _, eventType := range []EventType{INFERRED, MARKED, PUBLISHED, FAILED} {
+		res, err := ValueOfEventType(eventType.String())
+		assert.NoError(t, err)
+		assert.Equal(t, eventType, res)
+	}
+
+	_, err := ValueOfEventType("UNKNOWN")
+	assert.Error(t, err)
+}
+
+func TestIsInterestedIn(t *testing.T) {
+	res, err := isInterestedIn(nil, MARKED)
+	assert.NoError(t, err)
+	assert.True(t, res)
+
+	res, err = isInterestedIn(utl.PointerToString(" "), MARKED)
+	assert.NoError(t, err)
+	assert.True(t, res)
+
+	res, err = isInterestedIn(utl.PointerToString("published, marked"), MARKED)
+	assert.NoError(t, err)
+	assert.True(t, res)
+
+	res, err = isInterestedIn(utl.PointerToString("PUBLISHED,FAILED"), MARKED)
+	assert.NoError(t, err)
+	assert.False(t, res)
+
+	_, err = isInterestedIn(utl.PointerToString("PUBLISHED,RELEASED"), MARKED)
+	assert.Error(t, err)
+}
+
+func TestNewEvent(t *testing.T) {
+	_, err := NewEvent(INFERRED, "infer", nil, nil)
+	assert.Error(t, err)
+
+	configuration, _ := cnf.NewConfiguration()
+	state, err := stt.NewStateWith(configuration)
+	assert.NoError(t, err)
+	state.SetBranch(utl.PointerToString("main"))
+	state.SetVersion(utl.PointerToString("1.2.3"))
+	state.SetBump(utl.PointerToString("minor"))
+	event, err := NewEvent(FAILED, "publish", state, fmt.Errorf("something went wrong"))
+	assert.NoError(t, err)
+	assert.Equal(t, SCHEMA_VERSION, event.SchemaVersion)
+	assert.Equal(t, EVENT_SOURCE, event.Source)
+	assert.Equal(t, FAILED, event.Type)
+	assert.Equal(t, "publish", event.Command)
+	assert.Equal(t, "main", *event.Branch)
+	assert.Equal(t, "1.2.3", *event.Version)
+	assert.Equal(t, "minor", *event.Bump)
+	assert.Equal(t, "something went wrong", *event.Error)
+	assert.Len(t, event.ID, 32)
+
+	other, err := NewEvent(FAILED, "publish", state, nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, event.ID, other.ID)
+	assert.Nil(t, other.Error)
+
+	marshalled, err := event.Marshal()
+	assert.NoError(t, err)
+	var unmarshalled map[string]interface{}
+	assert.NoError(t, json.Unmarshal(marshalled, &unmarshalled))
+	assert.Equal(t, "FAILED", unmarshalled["type"])
+	assert.Equal(t, "1.2.3", unmarshalled["version"])
+	assert.Equal(t, float64(SCHEMA_VERSION), unmarshalled["schemaVersion"])
+}
+
+func TestEmit(t *testing.T) {
+	bodies := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"offsets":[{"partition":0,"offset":1}]}`))
+	}))
+	defer server.Close()
+
+	assert.Error(t, Emit(PUBLISHED, "publish", nil, nil))
+
+	// no emitters configured
+	state := newEventBusState(t, false, map[string]*ent.EventEmitter{})
+	assert.NoError(t, Emit(PUBLISHED, "publish", state, nil))
+	assert.Empty(t, bodies)
+
+	// only the emitters interested in the event type publish the event
+	state = newEventBusState(t, false, map[string]*ent.EventEmitter{
+		"all":       ent.NewEventEmitterWith(utl.PointerToString(server.URL), nil, nil, nil, utl.PointerToString("releases"), utl.PointerToString("kafka"), nil),
+		"published": ent.NewEventEmitterWith(utl.PointerToString(server.URL), utl.PointerToString("PUBLISHED"), nil, nil, utl.PointerToString("releases"), utl.PointerToString("KAFKA"), nil),
+	})
+	assert.NoError(t, Emit(MARKED, "mark", state, nil))
+	assert.Len(t, bodies, 1)
+	assert.NoError(t, Emit(PUBLISHED, "publish", state, nil))
+	assert.Len(t, bodies, 3)
+	assert.Contains(t, bodies[2], `"type":"PUBLISHED"`)
+
+	// nothing is published in dry run mode
+	state = newEventBusState(t, true, map[string]*ent.EventEmitter{
+		"all": ent.NewEventEmitterWith(utl.PointerToString(server.URL), nil, nil, nil, utl.PointerToString("releases"), utl.PointerToString("KAFKA"), nil),
+	})
+	assert.NoError(t, Emit(PUBLISHED, "publish", state, nil))
+	assert.Len(t, bodies, 3)
+
+	// illegal emitter configurations are reported
+	state = newEventBusState(t, false, map[string]*ent.EventEmitter{
+		"illegal": ent.NewEventEmitterWith(utl.PointerToString(server.URL), nil, nil, nil, utl.PointerToString("releases"), utl.PointerToString("RABBIT"), nil),
+	})
+	assert.Error(t, Emit(PUBLISHED, "publish", state, nil))
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"bytes"         // https://pkg.go.dev/bytes
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"io"            // https://pkg.go.dev/io
+	"net/http"      // https://pkg.go.dev/net/http
+	"net/url"       // https://pkg.go.dev/net/url
+	"strings"       // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The content type used to post JSON records to the Confluent REST Proxy.
+	KAFKA_CONTENT_TYPE = "application/vnd.kafka.json.v2+json"
+)
+
+/*
+The emitter publishing events to an Apache Kafka topic through the Confluent REST Proxy (v2 API).
+Events are published as JSON records keyed by the event identifier.
+*/
+type kafkaEmitter struct {
+	// The base URL of the REST Proxy, without the trailing slash.
+	endpoint string
+
+	// The name of the topic to publish to.
+	topic string
+
+	// The user name used for basic authentication, it may be nil.
+	user *string
+
+	// The password used for basic authentication, it may be nil.
+	password *string
+
+	// The private HTTP client instance.
+	client *http.Client
+}
+
+/*
+The record published to the REST Proxy.
+*/
+type kafkaRecord struct {
+	// The record key.
+	Key string `json:"key"`
+
+	// The record value.
+	Value Event `json:"value"`
+}
+
+/*
+The request body posted to the REST Proxy.
+*/
+type kafkaRecords struct {
+	// The records to publish.
+	Records []kafkaRecord `json:"records"`
+}
+
+/*
+Returns a new Kafka emitter.
+
+Arguments are as follows:
+
+- endpoint the base URL of the REST Proxy. It can't be nil
+- topic the name of the topic to publish to. It can't be nil
+- user the user name used for basic authentication, it may be nil
+- password the password used for basic authentication, it may be nil
+
+Errors can be:
+
+- IllegalPropertyError if the endpoint or the topic are missing or illegal
+*/
+func newKafkaEmitter(endpoint *string, topic *string, user *string, password *string) (kafkaEmitter, error) {
+	if endpoint == nil || "" == strings.TrimSpace(*endpoint) {
+		return kafkaEmitter{}, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' event emitter requires the endpoint of the REST Proxy", KAFKA.String())}
+	}
+	if topic == nil || "" == strings.TrimSpace(*topic) {
+		return kafkaEmitter{}, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' event emitter requires the topic to publish to", KAFKA.String())}
+	}
+	return kafkaEmitter{endpoint: strings.TrimRight(strings.TrimSpace(*endpoint), "/"), topic: strings.TrimSpace(*topic), user: user, password: password, client: &http.Client{}}, nil
+}
+
+/*
+Publishes the given event as a record to the configured topic.
+
+Arguments are as follows:
+
+- event the event to publish
+
+Errors can be:
+
+- DataAccessError in case the event cannot be marshalled
+- TransportError in case the event can't be delivered to the REST Proxy
+*/
+func (e kafkaEmitter) Emit(event Event) error {
+	body, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{Key: event.ID, Value: event}}})
+	if err != nil {
+		return &errs.DataAccessError{Message: fmt.Sprintf("unable to marshal the '%s' event", event.Type.String()), Cause: err}
+	}
+	requestURL := fmt.Sprintf("%s/topics/%s", e.endpoint, url.PathEscape(e.topic))
+	request, err := http.NewRequest(http.MethodPost, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to create the '%s' request to '%s'", http.MethodPost, requestURL), Cause: err}
+	}
+	request.Header.Set("Content-Type", KAFKA_CONTENT_TYPE)
+	request.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	if e.user != nil && e.password != nil {
+		request.SetBasicAuth(*e.user, *e.password)
+	}
+
+	log.Tracef("sending '%s' request to '%s'", http.MethodPost, requestURL)
+	response, err := e.client.Do(request)
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed", http.MethodPost, requestURL), Cause: err}
+	}
+	defer response.Body.Close()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to read the response to '%s' request to '%s'", http.MethodPost, requestURL), Cause: err}
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed with status '%s': %s", http.MethodPost, requestURL, response.Status, string(responseBody))}
+	}
+
+	// the REST Proxy may accept the request and still report errors for single records
+	var offsets struct {
+		Offsets []struct {
+			ErrorCode *int    `json:"error_code"`
+			Error     *string `json:"error"`
+		} `json:"offsets"`
+	}
+	if err := json.Unmarshal(responseBody, &offsets); err == nil {
+		for _, offset := range offsets.Offsets {
+			if offset.ErrorCode != nil || offset.Error != nil {
+				message := ""
+				if offset.Error != nil {
+					message = *offset.Error
+				}
+				return &errs.TransportError{Message: fmt.Sprintf("the '%s' event was rejected by the REST Proxy at '%s': %s", event.Type.String(), e.endpoint, message)}
+			}
+		}
+	}
+	return nil
+}
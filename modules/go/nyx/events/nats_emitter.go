@@ -0,0 +1,195 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"bufio"         // https://pkg.go.dev/bufio
+	"crypto/tls"    // https://pkg.go.dev/crypto/tls
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"net"           // https://pkg.go.dev/net
+	"net/url"       // https://pkg.go.dev/net/url
+	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The default NATS server URL.
+	NATS_DEFAULT_ENDPOINT = "nats://localhost:4222"
+
+	// The timeout used when connecting and talking to the NATS server.
+	NATS_TIMEOUT = 10 * time.Second
+)
+
+/*
+The emitter publishing events to a NATS subject using the NATS client protocol.
+See https://docs.nats.io/reference/reference-protocols/nats-protocol.
+*/
+type natsEmitter struct {
+	// The URL of the NATS server.
+	endpoint *url.URL
+
+	// The subject to publish to.
+	subject string
+
+	// The user name used to authenticate. When the password is nil this is used as the authentication token. It may be nil.
+	user *string
+
+	// The password used to authenticate, it may be nil.
+	password *string
+}
+
+/*
+The options sent to the NATS server with the CONNECT message.
+*/
+type natsConnectOptions struct {
+	Verbose   bool    `json:"verbose"`
+	Pedantic  bool    `json:"pedantic"`
+	Name      string  `json:"name"`
+	Lang      string  `json:"lang"`
+	Protocol  int     `json:"protocol"`
+	User      *string `json:"user,omitempty"`
+	Pass      *string `json:"pass,omitempty"`
+	AuthToken *string `json:"auth_token,omitempty"`
+}
+
+/*
+Returns a new NATS emitter.
+
+Arguments are as follows:
+
+- endpoint the URL of the NATS server, using the 'nats' or 'tls' scheme. If nil NATS_DEFAULT_ENDPOINT is used
+- subject the subject to publish to. It can't be nil
+- user the user name used to authenticate. When the password is nil this is used as the authentication token. It may be nil
+- password the password used to authenticate, it may be nil
+
+Errors can be:
+
+- IllegalPropertyError if the endpoint or the subject are missing or illegal
+*/
+func newNATSEmitter(endpoint *string, subject *string, user *string, password *string) (natsEmitter, error) {
+	if subject == nil || "" == strings.TrimSpace(*subject) {
+		return natsEmitter{}, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' event emitter requires the subject to publish to", NATS.String())}
+	}
+	if strings.ContainsAny(strings.TrimSpace(*subject), " \t\r\n") {
+		return natsEmitter{}, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' event emitter subject '%s' cannot contain whitespaces", NATS.String(), *subject)}
+	}
+	rawEndpoint := NATS_DEFAULT_ENDPOINT
+	if endpoint == nil || "" == strings.TrimSpace(*endpoint) {
+		log.Debugf("no endpoint configured for the '%s' event emitter, the default endpoint '%s' will be used", NATS.String(), NATS_DEFAULT_ENDPOINT)
+	} else {
+		rawEndpoint = strings.TrimSpace(*endpoint)
+	}
+	parsedEndpoint, err := url.Parse(rawEndpoint)
+	if err != nil {
+		return natsEmitter{}, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' event emitter endpoint '%s' is not a valid URL", NATS.String(), rawEndpoint), Cause: err}
+	}
+	if parsedEndpoint.Scheme != "nats" && parsedEndpoint.Scheme != "tls" {
+		return natsEmitter{}, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' event emitter endpoint '%s' must use the 'nats' or 'tls' scheme", NATS.String(), rawEndpoint)}
+	}
+	if parsedEndpoint.Port() == "" {
+		parsedEndpoint.Host = net.JoinHostPort(parsedEndpoint.Hostname(), "4222")
+	}
+	return natsEmitter{endpoint: parsedEndpoint, subject: strings.TrimSpace(*subject), user: user, password: password}, nil
+}
+
+/*
+Publishes the given event to the configured subject. The connection is closed after the server has
+acknowledged the message.
+
+Arguments are as follows:
+
+- event the event to publish
+
+Errors can be:
+
+- DataAccessError in case the event cannot be marshalled
+- TransportError in case the event can't be delivered to the NATS server
+*/
+func (e natsEmitter) Emit(event Event) error {
+	payload, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+
+	options := natsConnectOptions{Name: EVENT_SOURCE, Lang: "go"}
+	if e.user != nil && e.password != nil {
+		options.User = e.user
+		options.Pass = e.password
+	} else if e.user != nil {
+		options.AuthToken = e.user
+	}
+	connectOptions, err := json.Marshal(options)
+	if err != nil {
+		return &errs.DataAccessError{Message: fmt.Sprintf("unable to marshal the NATS connection options"), Cause: err}
+	}
+
+	log.Tracef("connecting to the NATS server at '%s'", e.endpoint.Host)
+	var conn net.Conn
+	dialer := &net.Dialer{Timeout: NATS_TIMEOUT}
+	if e.endpoint.Scheme == "tls" {
+		conn, err = tls.DialWithDialer(dialer, "tcp", e.endpoint.Host, &tls.Config{ServerName: e.endpoint.Hostname()})
+	} else {
+		conn, err = dialer.Dial("tcp", e.endpoint.Host)
+	}
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to connect to the NATS server at '%s'", e.endpoint.Host), Cause: err}
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(NATS_TIMEOUT))
+	reader := bufio.NewReader(conn)
+
+	// the server greets clients with the INFO message
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to read the greeting from the NATS server at '%s'", e.endpoint.Host), Cause: err}
+	}
+	if !strings.HasPrefix(line, "INFO") {
+		return &errs.TransportError{Message: fmt.Sprintf("unexpected greeting from the NATS server at '%s': %s", e.endpoint.Host, strings.TrimSpace(line))}
+	}
+
+	// PING is sent after the message so the PONG reply acknowledges both the connection and the message
+	message := fmt.Sprintf("CONNECT %s\r\nPUB %s %d\r\n%s\r\nPING\r\n", string(connectOptions), e.subject, len(payload), string(payload))
+	_, err = conn.Write([]byte(message))
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to send the '%s' event to the NATS server at '%s'", event.Type.String(), e.endpoint.Host), Cause: err}
+	}
+	for {
+		line, err = reader.ReadString('\n')
+		if err != nil {
+			return &errs.TransportError{Message: fmt.Sprintf("unable to read the reply from the NATS server at '%s'", e.endpoint.Host), Cause: err}
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "PONG"):
+			return nil
+		case strings.HasPrefix(line, "PING"):
+			_, err = conn.Write([]byte("PONG\r\n"))
+			if err != nil {
+				return &errs.TransportError{Message: fmt.Sprintf("unable to reply to the NATS server at '%s'", e.endpoint.Host), Cause: err}
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return &errs.TransportError{Message: fmt.Sprintf("the NATS server at '%s' rejected the '%s' event: %s", e.endpoint.Host, event.Type.String(), strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))}
+		default:
+			log.Tracef("ignoring message from the NATS server at '%s': %s", e.endpoint.Host, line)
+		}
+	}
+}
@@ -0,0 +1,3682 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"bufio"         // https://pkg.go.dev/bufio
+	"bytes"         // https://pkg.go.dev/bytes
+	"context"       // https://pkg.go.dev/context
+	"errors"        // https://pkg.go.dev/errors
+	"fmt"           // https://pkg.go.dev/fmt
+	"io"            // https://pkg.go.dev/io
+	"os"            // https://pkg.go.dev/os
+	"os/exec"       // https://pkg.go.dev/os/exec
+	"path/filepath" // https://pkg.go.dev/filepath
+	"sort"          // https://pkg.go.dev/sort
+	"strconv"       // https://pkg.go.dev/strconv
+	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
+
+	ggithttp "github.com/go-git/go-git/v5/plumbing/transport/http" // https://pkg.go.dev/github.com/go-git/go-git/v5
+	log "github.com/sirupsen/logrus"                               // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+)
+
+const (
+	// The backend using the go-git library, embedded in Nyx.
+	GO_GIT_BACKEND = "GO_GIT"
+
+	// The backend running the git executable available in the PATH.
+	CLI_BACKEND = "CLI"
+
+	// The name of the git executable used by the CLI backend, looked up in the PATH.
+	GIT_EXECUTABLE = "git"
+
+	// The separator of the fields in the output of the git commands parsed by the CLI backend (the ASCII unit separator).
+	cliFieldSeparator = "\x1f"
+
+	// The format of the commits printed by 'git log', parsed by parseCLICommit. Records are separated by NUL (-z).
+	cliCommitFormat = "--format=%H%x1f%P%x1f%an%x1f%ae%x1f%ad%x1f%cn%x1f%ce%x1f%cd%x1f%B"
+
+	// The format of the tags printed by 'git for-each-ref', parsed by parseCLITags. Records are terminated by NUL as messages may span multiple lines.
+	cliTagFormat = "--format=%(refname)%1f%(objecttype)%1f%(objectname)%1f%(*objectname)%1f%(taggername)%1f%(taggeremail)%1f%(taggerdate:raw)%1f%(contents:signature)%1f%(contents)%00"
+
+	// The name of the gpg executable used by the CLI backend to import the trusted keys, looked up in the PATH.
+	GPG_EXECUTABLE = "gpg"
+
+	// The prefix of the status lines printed by gpg when signatures are verified with the '--raw' option.
+	cliGPGStatusPrefix = "[GNUPG:] "
+
+	// The environment variable passing the user name to the credential helper used by the CLI backend.
+	cliUserEnvironmentVariable = "NYX_CLI_GIT_USER"
+
+	// The environment variable passing the password to the credential helper used by the CLI backend.
+	cliPasswordEnvironmentVariable = "NYX_CLI_GIT_PASSWORD"
+
+	// The environment variable passing the private key passphrase to the SSH askpass script used by the CLI backend.
+	cliPassphraseEnvironmentVariable = "NYX_CLI_SSH_PASSPHRASE"
+
+	// The credential helper used by the CLI backend, returning the credentials from the above environment variables
+	// so that they never show up in the command line.
+	cliCredentialHelper = "!f() { test \"$1\" = get && echo \"username=${" + cliUserEnvironmentVariable + "}\" && echo \"password=${" + cliPasswordEnvironmentVariable + "}\"; }; f"
+)
+
+/*
+Returns the Git implementation (backend) to use for repositories.
+
+Arguments are as follows:
+
+  - backend the backend to use, either GO_GIT_BACKEND or CLI_BACKEND. When empty GO_GIT_BACKEND is used.
+
+Errors can be:
+
+- IllegalArgumentError if the given backend is unknown
+*/
+func parseBackend(backend string) (string, error) {
+	switch backend {
+	case "", GO_GIT_BACKEND:
+		return GO_GIT_BACKEND, nil
+	case CLI_BACKEND:
+		log.Debugf("repositories will be accessed by running the '%s' executable", GIT_EXECUTABLE)
+		return CLI_BACKEND, nil
+	default:
+		return "", &errs.IllegalArgumentError{Message: fmt.Sprintf("unknown Git backend '%s'", backend)}
+	}
+}
+
+/*
+Returns the given repository, cloned or opened by go-git, as a repository using the backend in these options.
+This is meant to wrap the values returned by the clone functions, which always use go-git.
+
+Arguments are as follows:
+
+- repository the repository cloned or opened by go-git
+- err the error returned when cloning or opening the repository, if any. When not nil it's returned as is.
+*/
+func (o repositoryOptions) withBackend(repository goGitRepository, err error) (Repository, error) {
+	if err != nil || o.backend != CLI_BACKEND {
+		return repository, err
+	}
+	return o.openCLIRepository(repository.directory)
+}
+
+/*
+A local repository implementation that runs the git executable (https://git-scm.com/docs) available in the PATH.
+
+This is an alternative to the go-git backend for the features go-git lacks (i.e. commit signing or some
+protocol quirks) as it honors the whole Git configuration, including hooks and signing options.
+*/
+type cliRepository struct {
+	// The directory of the Git repository. Commands are run within this directory.
+	directory string
+
+	// The path of the git executable.
+	executable string
+
+	// The options of the repository.
+	options repositoryOptions
+}
+
+/*
+Returns a repository instance working in the given directory and backed by the git executable, using these options.
+
+Arguments are as follows:
+
+- directory the directory where the repository is.
+
+Errors can be:
+
+- IllegalArgumentError if the directory is blank, the git executable can't be found or the directory is not a Git repository
+*/
+func (o repositoryOptions) openCLIRepository(directory string) (cliRepository, error) {
+	if "" == strings.TrimSpace(directory) {
+		return cliRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank directory"}
+	}
+	executable, err := exec.LookPath(GIT_EXECUTABLE)
+	if err != nil {
+		return cliRepository{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("the '%s' executable can't be found in the PATH, which is required by the '%s' Git backend", GIT_EXECUTABLE, CLI_BACKEND), Cause: err}
+	}
+	repository := cliRepository{directory: directory, executable: executable, options: o}
+	_, err = repository.run(nil, nil, "rev-parse", "--git-dir")
+	if err != nil {
+		return cliRepository{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("unable to open Git repository in directory '%s'", directory), Cause: err}
+	}
+	log.Debugf("the repository in '%s' is accessed using the '%s' executable", directory, executable)
+	return repository, nil
+}
+
+/*
+Returns the git command with the given arguments, run in the repository directory, with the given extra environment
+variables. Interactive prompts are disabled so that commands never hang waiting for the user.
+
+Arguments are as follows:
+
+- env the extra environment variables, in the 'NAME=value' form. It may be nil.
+- args the arguments to pass to git
+*/
+func (r cliRepository) command(env []string, args ...string) *exec.Cmd {
+	return r.commandContext(context.Background(), env, args...)
+}
+
+/*
+Returns the git command with the given arguments, just like command, which is killed when the given context is done.
+
+Arguments are as follows:
+
+- ctx the context bounding the command execution
+- env the extra environment variables, in the 'NAME=value' form. It may be nil.
+- args the arguments to pass to git
+*/
+func (r cliRepository) commandContext(ctx context.Context, env []string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, r.executable, args...)
+	// don't wait for the processes spawned by git (i.e. ssh) to release the output when git is killed
+	cmd.WaitDelay = time.Second
+	cmd.Dir = r.directory
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "LC_ALL=C")
+	cmd.Env = append(cmd.Env, env...)
+	return cmd
+}
+
+/*
+Runs git with the given arguments and returns its standard output.
+
+Arguments are as follows:
+
+- env the extra environment variables, in the 'NAME=value' form. It may be nil.
+- input the standard input to pass to the command. It may be nil.
+- args the arguments to pass to git
+
+Errors can be:
+
+- GitError in case the command can't be run or exits with a non zero status. The message brings the command standard error.
+*/
+func (r cliRepository) run(env []string, input []byte, args ...string) (string, error) {
+	return r.runContext(context.Background(), env, input, args...)
+}
+
+/*
+Runs git with the given arguments, just like run, killing it when the given context is done.
+
+Arguments are as follows:
+
+- ctx the context bounding the command execution
+- env the extra environment variables, in the 'NAME=value' form. It may be nil.
+- input the standard input to pass to the command. It may be nil.
+- args the arguments to pass to git
+
+Errors can be:
+
+  - GitError in case the command can't be run, exits with a non zero status or is killed because the context is done.
+    The message brings the command standard error.
+*/
+func (r cliRepository) runContext(ctx context.Context, env []string, input []byte, args ...string) (string, error) {
+	cmd := r.commandContext(ctx, env, args...)
+	if input != nil {
+		cmd.Stdin = bytes.NewReader(input)
+	}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	log.Tracef("running 'git %s' in directory '%s'", strings.Join(args, " "), r.directory)
+	err := cmd.Run()
+	if err != nil && ctx.Err() == context.DeadlineExceeded && r.options.parentContext().Err() == nil {
+		return stdout.String(), &errs.GitError{Message: fmt.Sprintf("the 'git %s' command timed out after '%s'", args[0], r.options.timeout.String()), Cause: ctx.Err()}
+	}
+	if err != nil && ctx.Err() != nil {
+		return stdout.String(), &errs.GitError{Message: fmt.Sprintf("the 'git %s' command was interrupted: %v", args[0], ctx.Err()), Cause: ctx.Err()}
+	}
+	if err != nil {
+		return stdout.String(), &errs.GitError{Message: fmt.Sprintf("the 'git %s' command failed: %s", args[0], strings.TrimSpace(stderr.String())), Cause: err}
+	}
+	return stdout.String(), nil
+}
+
+/*
+Returns true if the given error has been returned by run() because git exited with the given status.
+*/
+func hasExitCode(err error, code int) bool {
+	var gitError *errs.GitError
+	if !errors.As(err, &gitError) {
+		return false
+	}
+	var exitError *exec.ExitError
+	return errors.As(gitError.Cause, &exitError) && exitError.ExitCode() == code
+}
+
+/*
+Returns the absolute path of the given file within the Git directory (i.e. 'index' or 'shallow').
+*/
+func (r cliRepository) gitPath(name string) (string, error) {
+	out, err := r.run(nil, nil, "rev-parse", "--git-path", name)
+	if err != nil {
+		return "", err
+	}
+	path := strings.TrimSpace(out)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(r.directory, path)
+	}
+	return path, nil
+}
+
+/*
+Returns the value of the given Git configuration option, looking up all the configuration scopes, or an empty
+string if the option is not set.
+*/
+func (r cliRepository) getConfig(name string) (string, error) {
+	out, err := r.run(nil, nil, "config", "--get-all", name)
+	if err != nil {
+		if hasExitCode(err, 1) {
+			// the option is not set
+			return "", nil
+		}
+		return "", err
+	}
+	// when the option has multiple values return the first one
+	return strings.SplitN(strings.TrimRight(out, "\n"), "\n", 2)[0], nil
+}
+
+/*
+Returns the paths of the submodules recorded in the index, relative to the root of the working tree.
+*/
+func (r cliRepository) getSubmodulePaths() ([]string, error) {
+	out, err := r.run(nil, nil, "ls-files", "--stage", "-z")
+	if err != nil {
+		return nil, err
+	}
+	res := []string{}
+	for _, entry := range strings.Split(out, "\x00") {
+		// entries are like '<mode> <hash> <stage>\t<path>' and submodules have the gitlink mode
+		if strings.HasPrefix(entry, "160000 ") {
+			if i := strings.Index(entry, "\t"); i >= 0 {
+				res = append(res, entry[i+1:])
+			}
+		}
+	}
+	return res, nil
+}
+
+/*
+Returns the first URL configured for the remote with the given name, or an empty string if the remote is not
+configured or has no URLs.
+
+Arguments are as follows:
+
+- remote the name of the remote. If empty the default remote (origin) is used.
+*/
+func (r cliRepository) getRemoteURL(remote string) string {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	uri, err := r.getConfig("remote." + remote + ".url")
+	if err != nil {
+		return ""
+	}
+	return uri
+}
+
+/*
+Returns the first URL configured for the remote with the given name, rewritten by the 'insteadOf' and, when pushing,
+the 'pushInsteadOf' rules of the Git configuration, or an empty string if the remote is not configured or has no URLs.
+This is the URL actually used by git to fetch from or push to the remote so it's the one authentication options
+are selected for.
+
+Arguments are as follows:
+
+- remote the name of the remote. If empty the default remote (origin) is used.
+- push true to get the URL used to push, false to get the URL used to fetch
+*/
+func (r cliRepository) getRewrittenRemoteURL(remote string, push bool) string {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	args := []string{"remote", "get-url"}
+	if push {
+		args = append(args, "--push")
+	}
+	out, err := r.run(nil, nil, append(args, remote)...)
+	if err != nil {
+		return ""
+	}
+	return strings.SplitN(strings.TrimRight(out, "\n"), "\n", 2)[0]
+}
+
+/*
+Returns the Git date for the given time, in the raw format accepted by the GIT_AUTHOR_DATE and GIT_COMMITTER_DATE
+environment variables.
+*/
+func cliDate(t time.Time) string {
+	return fmt.Sprintf("%d %s", t.Unix(), t.Format("-0700"))
+}
+
+/*
+Returns the time corresponding to the given raw Git date (i.e. '1700000000 +0100').
+*/
+func parseCLIDate(date string) time.Time {
+	fields := strings.Fields(date)
+	if len(fields) == 0 {
+		return time.Time{}
+	}
+	seconds, _ := strconv.ParseInt(fields[0], 10, 64)
+	t := time.Unix(seconds, 0)
+	if len(fields) > 1 {
+		if zone, err := time.Parse("-0700", fields[1]); err == nil {
+			t = t.In(zone.Location())
+		}
+	}
+	return t
+}
+
+/*
+Returns the commit parsed from the given record printed by 'git log' using cliCommitFormat.
+*/
+func parseCLICommit(record string, tags []gitent.Tag) (gitent.Commit, error) {
+	fields := strings.SplitN(record, cliFieldSeparator, 9)
+	if len(fields) != 9 {
+		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("unable to parse the commit '%s'", record)}
+	}
+	parents := strings.Fields(fields[1])
+	if parents == nil {
+		parents = []string{}
+	}
+	authorDate := parseCLIDate(fields[4])
+	commitDate := parseCLIDate(fields[7])
+	authorAction := gitent.Action{Identity: gitent.Identity{Name: fields[2], Email: fields[3]}, TimeStamp: *gitent.NewTimeStampFrom(authorDate)}
+	commitAction := gitent.Action{Identity: gitent.Identity{Name: fields[5], Email: fields[6]}, TimeStamp: *gitent.NewTimeStampFrom(commitDate)}
+	return gitent.Commit{Sha: fields[0], AuthorAction: authorAction, CommitAction: commitAction, Date: commitDate.UnixMilli(), Message: messageFromString(fields[8]), Parents: parents, Tags: tags, Trailers: gitent.ParseTrailers(fields[8])}, nil
+}
+
+/*
+Returns the tag parsed from the given record printed by 'git for-each-ref' using cliTagFormat.
+*/
+func parseCLITag(record string) gitent.Tag {
+	fields := strings.SplitN(record, cliFieldSeparator, 9)
+	for len(fields) < 9 {
+		fields = append(fields, "")
+	}
+	name := strings.Replace(fields[0], "refs/tags/", "", 1)
+	if "tag" == fields[1] {
+		// it's an annotated tag, the target is the object the tag object points to
+		// and the signature, if any, is stripped from the message like go-git does
+		tagger := gitent.Action{Identity: gitent.Identity{Name: fields[4], Email: strings.TrimSuffix(strings.TrimPrefix(fields[5], "<"), ">")}, TimeStamp: *gitent.NewTimeStampFrom(parseCLIDate(fields[6]))}
+		return *gitent.NewAnnotatedTagWith(name, fields[3], messageFromString(strings.TrimSuffix(fields[8], fields[7])), tagger)
+	}
+	return *gitent.NewTagWith(name, fields[2], false)
+}
+
+/*
+Returns the tags parsed from the given output of 'git for-each-ref' using cliTagFormat.
+*/
+func parseCLITags(out string) []gitent.Tag {
+	var res []gitent.Tag
+	for _, record := range strings.Split(out, "\x00") {
+		// git prints a newline after each record, right after the NUL terminator
+		record = strings.TrimPrefix(record, "\n")
+		if "" != record {
+			res = append(res, parseCLITag(record))
+		}
+	}
+	return res
+}
+
+/*
+Returns the commit with the given identifier.
+
+Arguments are as follows:
+
+- id the commit identifier to resolve. It can be a long or abbreviated SHA-1 or any other revision known to Git.
+- tags the tags to set on the commit
+*/
+func (r cliRepository) parseCommit(id string, tags []gitent.Tag) (gitent.Commit, error) {
+	log.Tracef("parsing commit '%s'", id)
+	out, err := r.run(nil, nil, "log", "-1", "-z", "--date=raw", cliCommitFormat, id+"^{commit}", "--")
+	if err != nil {
+		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("the '%s' commit identifier cannot be resolved as there is no such commit.", id), Cause: err}
+	}
+	return parseCLICommit(strings.TrimSuffix(out, "\x00"), tags)
+}
+
+/*
+Returns the SHA-1 identifier of the commit with the given identifier.
+
+Errors can be:
+
+- GitError in case the given identifier cannot be resolved
+*/
+func (r cliRepository) resolve(id string) (string, error) {
+	log.Tracef("resolving '%s'", id)
+	out, err := r.run(nil, nil, "rev-parse", "--verify", "-q", id+"^{commit}")
+	if err != nil {
+		if "HEAD" == id {
+			log.Warnf("Repository identifier '%s' cannot be resolved. This means that the repository has just been initialized and has no commits yet or the repository is in a 'detached HEAD' state. See the documentation to fix this.", "HEAD")
+		}
+		return "", &errs.GitError{Message: fmt.Sprintf("the '%s' identifier cannot be resolved", id), Cause: err}
+	}
+	return strings.TrimSpace(out), nil
+}
+
+/*
+Returns the name of the reference HEAD points to (i.e. 'refs/heads/main') or 'HEAD' if HEAD is detached.
+*/
+func (r cliRepository) getHeadReference() (string, error) {
+	out, err := r.run(nil, nil, "symbolic-ref", "-q", "HEAD")
+	if err != nil {
+		if hasExitCode(err, 1) {
+			// HEAD is detached
+			return "HEAD", nil
+		}
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	return strings.TrimSpace(out), nil
+}
+
+/*
+Returns the set of the commits at the boundary of the shallow repository, which is empty if the repository is not shallow.
+*/
+func (r cliRepository) getShallowCommits() (map[string]bool, error) {
+	res := make(map[string]bool)
+	path, err := r.gitPath("shallow")
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return res, nil
+		}
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the shallow commits"), Cause: err}
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if "" != strings.TrimSpace(line) {
+			res[strings.TrimSpace(line)] = true
+		}
+	}
+	return res, nil
+}
+
+/*
+Returns the parents stored in the commit object with the given SHA-1, regardless of the shallow boundary that hides
+the parents of the boundary commits to the other git commands.
+*/
+func (r cliRepository) getCommitObjectParents(sha string) ([]string, error) {
+	out, err := r.run(nil, nil, "cat-file", "commit", sha)
+	if err != nil {
+		return nil, err
+	}
+	parents := []string{}
+	for _, line := range strings.Split(out, "\n") {
+		if "" == line {
+			// the end of the headers
+			break
+		}
+		if strings.HasPrefix(line, "parent ") {
+			parents = append(parents, strings.TrimPrefix(line, "parent "))
+		}
+	}
+	return parents, nil
+}
+
+/*
+Returns the environment variables setting the given identity as the author or committer (also used for taggers)
+along with the given date.
+
+Arguments are as follows:
+
+- role either 'AUTHOR' or 'COMMITTER'
+- identity the identity. It may be nil, in which case only the date is set and the identity is read from the Git configuration
+- when the date
+*/
+func cliIdentityEnvironment(role string, identity *gitent.Identity, when time.Time) []string {
+	env := []string{fmt.Sprintf("GIT_%s_DATE=%s", role, cliDate(when))}
+	if identity != nil {
+		env = append(env, fmt.Sprintf("GIT_%s_NAME=%s", role, identity.Name), fmt.Sprintf("GIT_%s_EMAIL=%s", role, identity.Email))
+	}
+	return env
+}
+
+/*
+The options passed to the git commands connecting to remotes, used for authentication.
+*/
+type cliRemoteOptions struct {
+	// The arguments to pass to git before the command name (i.e. '-c name=value').
+	args []string
+
+	// The extra environment variables.
+	env []string
+
+	// The temporary files created for the command, to remove when the command is done.
+	files []string
+}
+
+/*
+Removes the temporary files created for the command.
+*/
+func (o cliRemoteOptions) clean() {
+	for _, file := range o.files {
+		os.Remove(file)
+	}
+}
+
+/*
+Creates a temporary file with the given content and permissions, recording it in the options so that it's removed by clean().
+*/
+func (o *cliRemoteOptions) createTempFile(pattern string, content []byte, mode os.FileMode) (string, error) {
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", &errs.IOError{Message: "unable to create a temporary file", Cause: err}
+	}
+	o.files = append(o.files, file.Name())
+	_, err = file.Write(content)
+	file.Close()
+	if err != nil {
+		return "", &errs.IOError{Message: fmt.Sprintf("unable to write the temporary file '%s'", file.Name()), Cause: err}
+	}
+	err = os.Chmod(file.Name(), mode)
+	if err != nil {
+		return "", &errs.IOError{Message: fmt.Sprintf("unable to set the permissions of the temporary file '%s'", file.Name()), Cause: err}
+	}
+	return file.Name(), nil
+}
+
+/*
+Returns the arguments to pass to git before the command name to apply the proxy and the extra headers in these
+options to the commands connecting to remotes.
+*/
+func (o repositoryOptions) cliTransportArguments() []string {
+	args := []string{}
+	if o.proxy != nil {
+		args = append(args, "-c", "http.proxy="+*o.proxy)
+	}
+	names := make([]string, 0, len(o.headers))
+	for name := range o.headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		args = append(args, "-c", fmt.Sprintf("http.extraHeader=%s: %s", name, o.headers[name]))
+	}
+	return args
+}
+
+/*
+Returns the options to authenticate on the given remote URI using the given user name and password, with the same
+semantics of getUserNameAndPasswordAuth, including the lookup of the netrc file when no credentials are given.
+Credentials are passed to git by a credential helper reading them from the environment, so they never show up in
+the command line.
+
+Errors can be:
+
+- IllegalArgumentError if credentials are given for a remote using the SSH protocol
+*/
+func getCLIUserNameAndPasswordOptions(user *string, password *string, uri string) (cliRemoteOptions, error) {
+	options := cliRemoteOptions{}
+	auth, err := getUserNameAndPasswordAuth(user, password, uri)
+	if err != nil {
+		return options, err
+	}
+	basicAuth, ok := auth.(*ggithttp.BasicAuth)
+	if !ok || basicAuth == nil {
+		log.Debugf("username and password authentication will not use any custom authentication options")
+		return options, nil
+	}
+	log.Debugf("username and password authentication will use custom authentication options")
+	// the first, empty, helper disables the helpers configured for the repository
+	options.args = append(options.args, "-c", "credential.helper=", "-c", "credential.helper="+cliCredentialHelper)
+	options.env = append(options.env, cliUserEnvironmentVariable+"="+basicAuth.Username, cliPasswordEnvironmentVariable+"="+basicAuth.Password)
+	return options, nil
+}
+
+/*
+Returns the options to authenticate on the given remote URI using SSH, with the same semantics of getSSHAuth.
+The SSH command used by git is set to use the given private key and known hosts, which are written to temporary
+files when they are not passed as paths, and the passphrase is passed by an askpass script reading it from the
+environment. When no private key is given the default keys and the SSH agent, if any, are used.
+
+Errors can be:
+
+- IllegalArgumentError if a private key is given for a remote using the HTTP(S) protocol
+- IOError in case the private key or the known hosts can't be read or written
+*/
+func getCLISSHOptions(privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, uri string) (cliRemoteOptions, error) {
+	options := cliRemoteOptions{}
+	if isHTTPURI(uri) {
+		if privateKey != nil && "" != strings.TrimSpace(*privateKey) {
+			return options, &errs.IllegalArgumentError{Message: fmt.Sprintf("the remote URI '%s' uses the HTTP(S) protocol, which doesn't support public key authentication; use user name and password (or token) authentication for this remote or change its URI to SSH (i.e. 'git@host:owner/repo.git')", uri)}
+		}
+		log.Debugf("the remote URI '%s' uses the HTTP(S) protocol and no private key has been given, no public key authentication will be used", uri)
+		return options, nil
+	}
+
+	sshCommand := []string{"ssh"}
+	if !strictHostKeyChecking {
+		log.Warnf("SSH host key checking is disabled, the identity of remote hosts will not be verified")
+		sshCommand = append(sshCommand, "-o", "StrictHostKeyChecking=no", "-o", "UserKnownHostsFile="+os.DevNull)
+	} else {
+		sshCommand = append(sshCommand, "-o", "StrictHostKeyChecking=yes")
+		if knownHosts != nil && "" != strings.TrimSpace(*knownHosts) {
+			value := strings.TrimSpace(*knownHosts)
+			var path string
+			var err error
+			if !strings.ContainsAny(value, " \t\n") {
+				path, err = expandHomeDirectory(value)
+			} else {
+				path, err = options.createTempFile("nyx-known-hosts-", []byte(value+"\n"), 0600)
+			}
+			if err != nil {
+				options.clean()
+				return options, err
+			}
+			sshCommand = append(sshCommand, "-o", "UserKnownHostsFile="+quoteShellArgument(path))
+		}
+	}
+	if privateKey != nil && "" != *privateKey {
+		pemBytes, err := readPrivateKey(*privateKey)
+		if err != nil {
+			options.clean()
+			return options, err
+		}
+		path, err := options.createTempFile("nyx-private-key-", pemBytes, 0600)
+		if err != nil {
+			options.clean()
+			return options, err
+		}
+		sshCommand = append(sshCommand, "-i", quoteShellArgument(path), "-o", "IdentitiesOnly=yes")
+		if passphrase != nil && "" != *passphrase {
+			script, err := options.createTempFile("nyx-askpass-", []byte("#!/bin/sh\nprintf '%s\\n' \"$"+cliPassphraseEnvironmentVariable+"\"\n"), 0700)
+			if err != nil {
+				options.clean()
+				return options, err
+			}
+			options.env = append(options.env, "SSH_ASKPASS="+script, "SSH_ASKPASS_REQUIRE=force", "DISPLAY=nyx", cliPassphraseEnvironmentVariable+"="+*passphrase)
+		}
+	}
+	options.env = append(options.env, "GIT_SSH_COMMAND="+strings.Join(sshCommand, " "))
+	return options, nil
+}
+
+/*
+Returns the given argument quoted so that it's passed as a single argument by the shell.
+*/
+func quoteShellArgument(argument string) string {
+	return "'" + strings.ReplaceAll(argument, "'", "'\\''") + "'"
+}
+
+/*
+Runs the given git command connecting to a remote, using the given options along with the proxy and the extra headers
+of the repository, and removes the temporary files created for the options when done. Attempts failing for transient
+reasons are retried (see repositoryOptions.withRetries).
+*/
+func (r cliRepository) runRemote(options cliRemoteOptions, args ...string) (string, error) {
+	defer options.clean()
+	operation := fmt.Sprintf("running 'git %s'", args[0])
+	args = append(append(r.options.cliTransportArguments(), options.args...), args...)
+	var output string
+	err := r.options.withRetries(operation, func() error {
+		ctx, cancel := r.options.remoteContext()
+		defer cancel()
+		var err error
+		output, err = r.runContext(ctx, options.env, nil, args...)
+		return err
+	})
+	return output, err
+}
+
+/*
+Arguments are as follows:
+
+- paths the file patterns of the contents to add to stage. Cannot be nil or empty. The path "." represents
+all files in the working area so with that you can add all locally changed files.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to add paths.
+*/
+func (r cliRepository) Add(paths []string) error {
+	log.Debugf("adding contents to repository staging area")
+	if paths == nil || len(paths) == 0 {
+		return &errs.GitError{Message: fmt.Sprintf("cannot stage a nil or empty set of paths")}
+	}
+	err := r.checkWorkTree()
+	if err != nil {
+		return err
+	}
+	pathspecs := append([]string{}, paths...)
+	if IGNORE_SUBMODULES_ALL == r.options.ignoreSubmodules {
+		submodules, err := r.getSubmodulePaths()
+		if err != nil {
+			return &errs.GitError{Message: fmt.Sprintf("unable to list the repository submodules"), Cause: err}
+		}
+		for _, submodule := range submodules {
+			pathspecs = append(pathspecs, ":(exclude)"+submodule)
+		}
+	}
+	_, err = r.run(nil, nil, append([]string{"add", "--all", "--"}, pathspecs...)...)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to add paths to the staging area"), Cause: err}
+	}
+	return nil
+}
+
+/*
+Adds a new remote with the given name and URL to the repository configuration, so that it can be used to fetch
+from and push to. The remote fetches all branches, just like remotes added with 'git remote add'.
+
+Arguments are as follows:
+
+- name the name of the remote to add. Cannot be empty
+- url the URL of the remote. Cannot be empty
+
+Errors can be:
+
+  - GitError in case the name or the URL are empty, a remote with the same name already exists or some problem
+    is encountered with the underlying Git repository.
+*/
+func (r cliRepository) AddRemote(name string, url string) error {
+	log.Debugf("adding remote '%s'", name)
+	if "" == strings.TrimSpace(name) {
+		return &errs.GitError{Message: fmt.Sprintf("the remote name cannot be empty")}
+	}
+	if "" == strings.TrimSpace(url) {
+		return &errs.GitError{Message: fmt.Sprintf("the URL of remote '%s' cannot be empty", name)}
+	}
+	_, err := r.run(nil, nil, "remote", "add", name, url)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to add remote '%s'", name), Cause: err}
+	}
+	log.Debugf("remote '%s' added", name)
+	return nil
+}
+
+/*
+Returns an error if the repository is bare and has no working tree.
+*/
+func (r cliRepository) checkWorkTree() error {
+	bare, err := r.IsBare()
+	if err != nil {
+		return err
+	}
+	if bare {
+		return &errs.GitError{Message: fmt.Sprintf("the repository in '%s' is bare and has no working tree so this operation is not available. Use a regular clone to stage or commit changes", r.directory)}
+	}
+	return nil
+}
+
+/*
+Commits changes to the repository. Files to commit must be staged separately using Add.
+
+- message the commit message. Cannot be nil.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+*/
+func (r cliRepository) CommitWithMessage(message *string) (gitent.Commit, error) {
+	return r.CommitWithMessageAndIdentities(message, nil, nil)
+}
+
+/*
+Commits changes to the repository. Files to commit must be staged separately using Add.
+
+Arguments are as follows:
+
+- message the commit message. Cannot be nil.
+- author the object modelling the commit author informations. It may be nil, in which case the default
+for the repository will be used
+- committer the object modelling the committer informations. It may be nil, in which case the author, when
+given, or the default for the repository will be used
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+*/
+func (r cliRepository) CommitWithMessageAndIdentities(message *string, author *gitent.Identity, committer *gitent.Identity) (gitent.Commit, error) {
+	log.Debugf("committing changes to repository")
+
+	if message == nil {
+		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("cannot commit with a nil message")}
+	}
+	err := r.checkWorkTree()
+	if err != nil {
+		return gitent.Commit{}, err
+	}
+	if committer == nil {
+		// just like go-git, the committer defaults to the author
+		committer = author
+	}
+	env := append(cliIdentityEnvironment("AUTHOR", author, r.options.timestamp()), cliIdentityEnvironment("COMMITTER", committer, r.options.timestamp())...)
+	// the message is passed verbatim and empty commits are allowed, just like go-git does
+	_, err = r.run(env, []byte(*message), r.options.cliCommitArguments("--allow-empty", "--cleanup=verbatim", "--file=-")...)
+	if err != nil {
+		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to commit"), Cause: err}
+	}
+	commit, err := r.parseCommit("HEAD", []gitent.Tag{})
+	if err != nil {
+		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("an error occurred when retrieving the commit that has been created"), Cause: err}
+	}
+	return commit, nil
+}
+
+/*
+Adds the given files to the staging area and commits changes to the repository. This method is a shorthand
+for Add and CommitWithMessage.
+
+Arguments are as follows:
+
+  - paths the file patterns of the contents to add to stage. Cannot be nil or empty. The path "." represents
+    all files in the working area so with that you can add all locally changed files.
+  - message the commit message. Cannot be nil.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+*/
+func (r cliRepository) CommitPathsWithMessage(paths []string, message *string) (gitent.Commit, error) {
+	return r.CommitPathsWithMessageAndIdentities(paths, message, nil, nil)
+}
+
+/*
+Adds the given files to the staging area and commits changes to the repository. This method is a shorthand
+for Add and CommitWithMessageAndIdentities.
+
+Arguments are as follows:
+
+  - paths the file patterns of the contents to add to stage. Cannot be nil or empty. The path "." represents
+    all files in the working area so with that you can add all locally changed files.
+  - message the commit message. Cannot be nil.
+  - author the object modelling the commit author informations. It may be nil, in which case the default
+    for the repository will be used
+  - committer the object modelling the committer informations. It may be nil, in which case the default
+    for the repository will be used
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+*/
+func (r cliRepository) CommitPathsWithMessageAndIdentities(paths []string, message *string, author *gitent.Identity, committer *gitent.Identity) (gitent.Commit, error) {
+	err := r.Add(paths)
+	if err != nil {
+		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("an error occurred while staging contents to the repository"), Cause: err}
+	}
+	return r.CommitWithMessageAndIdentities(message, author, committer)
+}
+
+/*
+Returns the number of commits reachable from the 'to' commit and not reachable from the 'from' commit, following
+all the parents of merge commits, just like 'git rev-list --count from..to'. In shallow repositories only the
+commits available locally are counted.
+
+Arguments are as follows:
+
+  - from the optional SHA-1 id of the commit to count from, excluded along with all of its ancestors. If nil
+    all the commits reachable from the 'to' commit are counted. This can be a long or abbreviated SHA-1.
+  - to the optional SHA-1 id of the commit to count to, included. If nil the latest commit in the
+    current branch (HEAD) is used. This can be a long or abbreviated SHA-1.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or a given commit identifier cannot be resolved.
+*/
+func (r cliRepository) CountCommitsBetween(from *string, to *string) (int, error) {
+	toID := "HEAD"
+	if to != nil {
+		toID = *to
+	}
+	toSHA, err := r.resolve(toID)
+	if err != nil {
+		return 0, err
+	}
+	revisions := []string{"rev-list", "--count", toSHA}
+	if from != nil {
+		fromSHA, err := r.resolve(*from)
+		if err != nil {
+			return 0, err
+		}
+		revisions = append(revisions, "^"+fromSHA)
+	}
+	out, err := r.run(nil, nil, append(revisions, "--")...)
+	if err != nil {
+		return 0, &errs.GitError{Message: fmt.Sprintf("an error occurred while counting commits"), Cause: err}
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(out))
+	if err != nil {
+		return 0, &errs.GitError{Message: fmt.Sprintf("unable to parse the number of commits '%s'", strings.TrimSpace(out)), Cause: err}
+	}
+	log.Debugf("there are '%d' commits up to '%s'", count, toID)
+	return count, nil
+}
+
+/*
+Deletes the local tag with the given name. Only the tag reference is removed while the tagged commit is left untouched.
+
+Arguments are as follows:
+
+- name the name of the tag to delete.
+
+Errors can be:
+
+- GitError in case the tag does not exist or some problem is encountered with the underlying Git repository, preventing to delete the tag.
+*/
+func (r cliRepository) DeleteTag(name string) error {
+	log.Debugf("deleting tag '%s'", name)
+	_, err := r.run(nil, nil, "tag", "--delete", name)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to delete tag '%s'", name), Cause: err}
+	}
+	return nil
+}
+
+/*
+Deletes the tag with the given name from the given remote, using the given options. Deleting a tag that does not
+exist on the remote is not an error.
+
+Returns the local name of the remote that the tag has been deleted from.
+*/
+func (r cliRepository) deleteRemoteTag(remote string, name string, options cliRemoteOptions) (string, error) {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	// an empty source in the refspec deletes the destination reference on the remote
+	_, err := r.runRemote(options, "push", remote, ":refs/tags/"+name)
+	if err != nil {
+		// the output is not localized as commands run with LC_ALL=C
+		if strings.Contains(err.Error(), "remote ref does not exist") {
+			log.Debugf("tag '%s' was already missing from remote repository '%s'", name, remote)
+		} else {
+			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to delete tag '%s' from remote '%s'", name, remote), Cause: classifyRemoteError(err)}
+		}
+	}
+	return remote, nil
+}
+
+/*
+Deletes the tag with the given name from the given remote. Deleting a tag that does not exist on the remote is not an error.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remote that the tag has been deleted from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to delete the tag from. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to delete.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to delete the tag.
+*/
+func (r cliRepository) DeleteRemoteTagWithUserNameAndPassword(remote *string, name string, user *string, password *string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("deleting tag '%s' from remote repository '%s' using username and password", name, remoteString)
+
+	options, err := getCLIUserNameAndPasswordOptions(user, password, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	return r.deleteRemoteTag(remoteString, name, options)
+}
+
+/*
+Deletes the tag with the given name from the given remote. Deleting a tag that does not exist on the remote is not an error.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remote that the tag has been deleted from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to delete the tag from. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to delete.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to delete the tag.
+*/
+func (r cliRepository) DeleteRemoteTagWithToken(remote *string, name string, token *string, user *string) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't delete remote tags using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, true))
+	return r.DeleteRemoteTagWithUserNameAndPassword(remote, name, &tokenUser, &tokenPassword)
+}
+
+/*
+Deletes the tag with the given name from the given remote. Deleting a tag that does not exist on the remote is not an error.
+This method allows using SSH authentication.
+
+Returns the local name of the remote that the tag has been deleted from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to delete the tag from. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to delete.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to delete the tag.
+*/
+func (r cliRepository) DeleteRemoteTagWithPublicKeyAndHostKeys(remote *string, name string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("deleting tag '%s' from remote repository '%s' using public key (SSH) authentication", name, remoteString)
+
+	options, err := getCLISSHOptions(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	return r.deleteRemoteTag(remoteString, name, options)
+}
+
+/*
+Fetches all the tags from the given remote, replacing local tags with the same name, using the given options.
+
+Returns the local name of the remote that tags have been fetched from.
+*/
+func (r cliRepository) fetchTags(remote string, options cliRemoteOptions) (string, error) {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	// the leading '+' lets local tags be replaced by remote tags with the same name
+	_, err := r.runRemote(options, "fetch", "--no-tags", remote, "+refs/tags/*:refs/tags/*")
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to fetch tags from remote '%s'", remote), Cause: classifyRemoteError(err)}
+	}
+	return remote, nil
+}
+
+/*
+Fetches all the tags from the given remote, replacing local tags with the same name.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remote that tags have been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r cliRepository) FetchTagsFromRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("fetching tags from remote repository '%s' using username and password", remoteString)
+
+	options, err := getCLIUserNameAndPasswordOptions(user, password, r.getRewrittenRemoteURL(remoteString, false))
+	if err != nil {
+		return "", err
+	}
+	return r.fetchTags(remoteString, options)
+}
+
+/*
+Fetches all the tags from the given remote, replacing local tags with the same name.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remote that tags have been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r cliRepository) FetchTagsFromRemoteWithToken(remote *string, token *string, user *string) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't fetch using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, false))
+	return r.FetchTagsFromRemoteWithUserNameAndPassword(remote, &tokenUser, &tokenPassword)
+}
+
+/*
+Fetches all the tags from the given remote, replacing local tags with the same name.
+This method allows using SSH authentication.
+
+Returns the local name of the remote that tags have been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r cliRepository) FetchTagsFromRemoteWithPublicKeyAndHostKeys(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("fetching tags from remote repository '%s' using public key (SSH) authentication", remoteString)
+
+	options, err := getCLISSHOptions(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, false))
+	if err != nil {
+		return "", err
+	}
+	return r.fetchTags(remoteString, options)
+}
+
+/*
+Returns the names of the branches in the repository, mapped to the SHA-1 identifier of the commit they point to.
+Local branches are returned with their short name (i.e. 'main'), while remote tracking branches, when requested,
+are returned with the remote name as a prefix (i.e. 'origin/main'). Symbolic references like 'origin/HEAD'
+are not returned.
+
+Arguments are as follows:
+
+- includeRemote when true remote tracking branches are returned along with local branches.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetBranchNames(includeRemote bool) (map[string]string, error) {
+	log.Debugf("retrieving repository branch names")
+	args := []string{"for-each-ref", "--format=%(refname) %(objectname) %(symref)", "refs/heads"}
+	if includeRemote {
+		args = append(args, "refs/remotes")
+	}
+	out, err := r.run(nil, nil, args...)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("cannot list repository branches"), Cause: err}
+	}
+	res := make(map[string]string)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			// empty lines and symbolic references, which have a third field with their target, are skipped
+			continue
+		}
+		if strings.HasPrefix(fields[0], "refs/heads/") {
+			res[strings.TrimPrefix(fields[0], "refs/heads/")] = fields[1]
+		} else if strings.HasPrefix(fields[0], "refs/remotes/") {
+			res[strings.TrimPrefix(fields[0], "refs/remotes/")] = fields[1]
+		}
+	}
+
+	log.Debugf("repository branch names are '%v'", res)
+	return res, nil
+}
+
+/*
+Returns the paths of the files changed by the given commit, compared to its first parent. When the commit
+has no parents (it's the root commit) all the files in the commit tree are returned. Paths are relative
+to the repository root and use the forward slash as the separator. Renamed files are returned with both
+their old and new paths.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the changed paths for. It can be a full or abbreviated SHA-1.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetCommitChangedPaths(commit string) ([]string, error) {
+	log.Debugf("retrieving changed paths for commit '%s'", commit)
+	c, err := r.parseCommit(commit, nil)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve commit '%s'", commit), Cause: err}
+	}
+
+	var out string
+	if len(c.Parents) == 0 {
+		// the root commit, all files are new
+		out, err = r.run(nil, nil, "ls-tree", "-r", "-z", "--name-only", c.Sha)
+	} else {
+		// always compare to the first parent, ignore others, if any, and don't detect renames so that both paths are returned
+		out, err = r.run(nil, nil, "diff-tree", "-r", "-z", "--name-only", "--no-renames", "--no-commit-id", c.Parents[0], c.Sha)
+	}
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to compute the changes for commit '%s'", commit), Cause: err}
+	}
+	res := []string{}
+	for _, path := range strings.Split(out, "\x00") {
+		if "" != path {
+			res = append(res, path)
+		}
+	}
+	return res, nil
+}
+
+/*
+Returns the files changed by the given commit, compared to its first parent, along with the number of lines
+added and deleted in each file. When the commit has no parents (it's the root commit) all the files in the
+commit tree are returned as added. Paths are relative to the repository root and use the forward slash as the
+separator. Renames are not detected so renamed files are returned as a deleted file and an added file.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the changes for. It can be a full or abbreviated SHA-1.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetCommitChanges(commit string) ([]gitent.FileChange, error) {
+	log.Debugf("retrieving changes for commit '%s'", commit)
+	c, err := r.parseCommit(commit, nil)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve commit '%s'", commit), Cause: err}
+	}
+
+	var out string
+	if len(c.Parents) == 0 {
+		// the root commit, compared to the empty tree so all files are new
+		out, err = r.run(nil, nil, "diff-tree", "-r", "-z", "--numstat", "--no-renames", "--no-commit-id", "--root", c.Sha)
+	} else {
+		// always compare to the first parent, ignore others, if any
+		out, err = r.run(nil, nil, "diff-tree", "-r", "-z", "--numstat", "--no-renames", "--no-commit-id", c.Parents[0], c.Sha)
+	}
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to compute the changes for commit '%s'", commit), Cause: err}
+	}
+	res := []gitent.FileChange{}
+	for _, record := range strings.Split(out, "\x00") {
+		// each record is '<additions>\t<deletions>\t<path>', where binary files have '-' in place of the counts
+		fields := strings.SplitN(strings.TrimPrefix(record, "\n"), "\t", 3)
+		if len(fields) < 3 {
+			continue
+		}
+		additions, _ := strconv.Atoi(fields[0])
+		deletions, _ := strconv.Atoi(fields[1])
+		res = append(res, *gitent.NewFileChangeWith(fields[2], additions, deletions))
+	}
+	return res, nil
+}
+
+/*
+Returns the content of the file with the given path as it is in the tree of the given commit, regardless of
+the contents of the working tree.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to read the file from. It can be a full or abbreviated SHA-1.
+- path the path of the file, relative to the repository root and using the forward slash as the separator.
+- maxSize the maximum size of the file, in bytes. Larger files are not read and an error is returned.
+
+Errors can be:
+
+  - GitError in case the file does not exist in the commit, it's larger than the given size or some problem is
+    encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetCommitFileContent(commit string, path string, maxSize int64) (string, error) {
+	log.Debugf("reading file '%s' from commit '%s'", path, commit)
+	sha, err := r.resolve(commit)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve commit '%s'", commit), Cause: err}
+	}
+	object := sha + ":" + path
+	out, err := r.run(nil, nil, "cat-file", "-s", object)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to find file '%s' in commit '%s'", path, commit), Cause: err}
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(out), 10, 64)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to parse the size of file '%s' in commit '%s'", path, commit), Cause: err}
+	}
+	if size > maxSize {
+		return "", &errs.GitError{Message: fmt.Sprintf("file '%s' in commit '%s' is %d bytes long, exceeding the maximum size of %d bytes", path, commit, size, maxSize)}
+	}
+	content, err := r.run(nil, nil, "cat-file", "blob", object)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to read file '%s' from commit '%s'", path, commit), Cause: err}
+	}
+	return content, nil
+}
+
+/*
+Returns the patch identifier of the given commit, as returned by 'git patch-id --stable'. The patch identifier is
+computed from the changes the commit introduces compared to its first parent, ignoring whitespaces and line numbers,
+so commits introducing the same changes (i.e. commits cherry-picked from one branch to another) have the same patch
+identifier. The returned value is empty when the commit introduces no changes.
+
+Patch identifiers returned by this backend are not the same returned by the go-git backend so they can only be
+compared with other patch identifiers returned by this backend.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the patch identifier for. It can be a full or abbreviated SHA-1.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetCommitPatchID(commit string) (string, error) {
+	log.Debugf("computing the patch identifier for commit '%s'", commit)
+	c, err := r.parseCommit(commit, nil)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve commit '%s'", commit), Cause: err}
+	}
+	var patch string
+	if len(c.Parents) == 0 {
+		patch, err = r.run(nil, nil, "diff-tree", "-p", "--root", "--full-index", "--binary", c.Sha)
+	} else {
+		// always compare to the first parent, ignore others, if any
+		patch, err = r.run(nil, nil, "diff-tree", "-p", "--full-index", "--binary", c.Parents[0], c.Sha)
+	}
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to compute the patch for commit '%s'", commit), Cause: err}
+	}
+	if "" == strings.TrimSpace(patch) {
+		return "", nil
+	}
+	out, err := r.run(nil, []byte(patch), "patch-id", "--stable")
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to compute the patch identifier for commit '%s'", commit), Cause: err}
+	}
+	fields := strings.Fields(out)
+	if len(fields) == 0 {
+		return "", nil
+	}
+	return fields[0], nil
+}
+
+/*
+Returns a set of objects representing all the tags for the given commit.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the tags for. It can be a full or abbreviated SHA-1.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetCommitTags(commit string) ([]gitent.Tag, error) {
+	log.Debugf("retrieving tags for commit '%s'", commit)
+	tags, err := r.GetTags()
+	if err != nil {
+		return nil, err
+	}
+	var res []gitent.Tag
+	for _, tag := range tags {
+		if strings.HasPrefix(tag.Target, commit) {
+			res = append(res, tag)
+		}
+	}
+	return res, nil
+}
+
+/*
+Returns the identity configured for the repository (the user.name and user.email Git options), looking up
+the repository, the global and the system configuration, or nil if no complete identity is configured.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetConfiguredIdentity() (*gitent.Identity, error) {
+	name, err := r.getConfig("user.name")
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the repository configuration"), Cause: err}
+	}
+	email, err := r.getConfig("user.email")
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the repository configuration"), Cause: err}
+	}
+	if "" == name || "" == email {
+		return nil, nil
+	}
+	return gitent.NewIdentityWith(name, email), nil
+}
+
+/*
+Returns the name of the current branch or 'HEAD' if the repository is in the detached head state.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet.
+*/
+func (r cliRepository) GetCurrentBranch() (string, error) {
+	_, err := r.run(nil, nil, "rev-parse", "--verify", "-q", "HEAD")
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	ref, err := r.getHeadReference()
+	if err != nil {
+		return "", err
+	}
+	// also strip the leading "refs/heads/" from the reference name
+	return strings.Replace(ref, "refs/heads/", "", 1), nil
+}
+
+/*
+Returns the name of the default branch of the given remote, which is the branch its HEAD points to. The
+remote tracking reference (i.e. 'refs/remotes/origin/HEAD') is used when available, otherwise the remote is
+queried for the branch its HEAD points to (like 'git ls-remote --symref' does), without fetching anything.
+The returned name has no 'refs/heads/' prefix (i.e. 'main').
+
+Arguments are as follows:
+
+- remote the name of the remote. If nil or empty the default remote name (origin) is used.
+
+Errors can be:
+
+- GitError in case the remote is not configured, can't be reached or doesn't advertise its HEAD.
+*/
+func (r cliRepository) GetDefaultBranch(remote *string) (string, error) {
+	remoteString := DEFAULT_REMOTE_NAME
+	if remote != nil && "" != *remote {
+		remoteString = *remote
+	}
+	log.Debugf("retrieving the default branch of remote '%s'", remoteString)
+	out, err := r.run(nil, nil, "symbolic-ref", "--quiet", "refs/remotes/"+remoteString+"/HEAD")
+	if err == nil && strings.HasPrefix(strings.TrimSpace(out), "refs/remotes/"+remoteString+"/") {
+		defaultBranch := strings.TrimPrefix(strings.TrimSpace(out), "refs/remotes/"+remoteString+"/")
+		log.Debugf("the default branch of remote '%s' is '%s', as tracked locally", remoteString, defaultBranch)
+		return defaultBranch, nil
+	}
+
+	if "" == r.getRemoteURL(remoteString) {
+		return "", &errs.GitError{Message: fmt.Sprintf("the remote '%s' is not configured or has no URL", remoteString)}
+	}
+	out, err = r.runRemote(cliRemoteOptions{}, "ls-remote", "--symref", remoteString, "HEAD")
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to list the references of remote '%s'", remoteString), Cause: err}
+	}
+	for _, line := range strings.Split(out, "\n") {
+		// the symbolic reference is advertised as 'ref: refs/heads/<BRANCH>\tHEAD'
+		fields := strings.Fields(line)
+		if len(fields) == 3 && "ref:" == fields[0] && "HEAD" == fields[2] && strings.HasPrefix(fields[1], "refs/heads/") {
+			defaultBranch := strings.TrimPrefix(fields[1], "refs/heads/")
+			log.Debugf("the default branch of remote '%s' is '%s', as advertised by the remote", remoteString, defaultBranch)
+			return defaultBranch, nil
+		}
+	}
+	return "", &errs.GitError{Message: fmt.Sprintf("the remote '%s' doesn't advertise the branch its HEAD points to", remoteString)}
+}
+
+/*
+Returns the hash algorithm (object format) used by the repository to identify objects.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetHashAlgorithm() (gitent.HashAlgorithm, error) {
+	out, err := r.run(nil, nil, "config", "--get", "extensions.objectformat")
+	if err != nil {
+		if hasExitCode(err, 1) {
+			// the option is not set so the default object format is used
+			return gitent.SHA1, nil
+		}
+		return gitent.SHA1, &errs.GitError{Message: fmt.Sprintf("unable to read the repository object format"), Cause: err}
+	}
+	hashAlgorithm, err := gitent.ValueOfHashAlgorithm(strings.TrimSpace(out))
+	if err != nil {
+		return gitent.SHA1, &errs.GitError{Message: fmt.Sprintf("unknown repository object format '%s'", strings.TrimSpace(out)), Cause: err}
+	}
+	return hashAlgorithm, nil
+}
+
+/*
+Returns the SHA-1 identifier of the last commit in the current branch.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or is in the 'detached HEAD' state.
+*/
+func (r cliRepository) GetLatestCommit() (string, error) {
+	commitSHA, err := r.resolve("HEAD")
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	log.Debugf("repository latest commit in HEAD branch is '%s'", commitSHA)
+	return commitSHA, nil
+}
+
+/*
+Returns the SHA-1 identifier of the first commit in the repository (the only commit with no parents).
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or is in the 'detached HEAD' state.
+*/
+func (r cliRepository) GetRootCommit() (string, error) {
+	head, err := r.resolve("HEAD")
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	// always follow the first parent, ignore others, if any
+	out, err := r.run(nil, nil, "rev-list", "--first-parent", "--max-parents=0", head)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("an error occurred while walking the commit history at commit '%s'", head), Cause: err}
+	}
+	commitSHA := strings.TrimSpace(out)
+	log.Debugf("repository latest commit in HEAD branch is '%s'", commitSHA)
+	return commitSHA, nil
+}
+
+/*
+Returns a set of objects representing all the tags for the repository.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetTags() ([]gitent.Tag, error) {
+	log.Debugf("retrieving all tags")
+	out, err := r.run(nil, nil, "for-each-ref", cliTagFormat, "refs/tags")
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("cannot list repository tags"), Cause: err}
+	}
+	return parseCLITags(out), nil
+}
+
+/*
+Returns how many commits the current branch is ahead of and behind its upstream branch (the one configured
+with the 'branch.<name>.remote' and 'branch.<name>.merge' options). The returned value is nil when the current
+branch has no upstream or its upstream has no remote tracking reference.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or is in the 'detached HEAD' state.
+*/
+func (r cliRepository) GetTrackingStatus() (*gitent.TrackingStatus, error) {
+	_, err := r.run(nil, nil, "rev-parse", "--verify", "-q", "HEAD")
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	head, err := r.getHeadReference()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(head, "refs/heads/") {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to get the tracking status as the repository is in the 'detached HEAD' state")}
+	}
+	branch := strings.TrimPrefix(head, "refs/heads/")
+	out, err := r.run(nil, nil, "for-each-ref", "--format=%(upstream) %(upstream:short)", head)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to get the upstream of branch '%s'", branch), Cause: err}
+	}
+	fields := strings.Fields(out)
+	if len(fields) != 2 {
+		log.Debugf("branch '%s' has no upstream", branch)
+		return nil, nil
+	}
+	upstreamReference, upstreamName := fields[0], fields[1]
+	_, err = r.run(nil, nil, "rev-parse", "--verify", "-q", upstreamReference+"^{commit}")
+	if err != nil {
+		log.Debugf("the upstream of branch '%s' has no reference '%s'", branch, upstreamReference)
+		return nil, nil
+	}
+
+	out, err = r.run(nil, nil, "rev-list", "--left-right", "--count", head+"..."+upstreamReference)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to count the commits between branch '%s' and its upstream '%s'", branch, upstreamName), Cause: err}
+	}
+	counts := strings.Fields(out)
+	if len(counts) != 2 {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unexpected output '%s' while counting the commits between branch '%s' and its upstream '%s'", strings.TrimSpace(out), branch, upstreamName)}
+	}
+	ahead, err := strconv.Atoi(counts[0])
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unexpected output '%s' while counting the commits between branch '%s' and its upstream '%s'", strings.TrimSpace(out), branch, upstreamName), Cause: err}
+	}
+	behind, err := strconv.Atoi(counts[1])
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unexpected output '%s' while counting the commits between branch '%s' and its upstream '%s'", strings.TrimSpace(out), branch, upstreamName), Cause: err}
+	}
+	log.Debugf("branch '%s' is '%d' commits ahead of and '%d' commits behind its upstream '%s'", branch, ahead, behind, upstreamName)
+	return gitent.NewTrackingStatusWith(branch, upstreamName, ahead, behind), nil
+}
+
+/*
+Returns true if the given branch exists on the given remote. The remote tracking reference (i.e.
+'refs/remotes/origin/main') is used when available, otherwise the remote is queried for its branches
+(like 'git ls-remote --heads' does), without fetching anything.
+
+Arguments are as follows:
+
+- remote the name of the remote. If nil or empty the default remote name (origin) is used.
+- branch the name of the branch, without the 'refs/heads/' prefix. If empty the current branch is used.
+
+Errors can be:
+
+- GitError in case the remote is not configured or can't be reached or the current branch can't be resolved.
+*/
+func (r cliRepository) HasRemoteBranch(remote *string, branch string) (bool, error) {
+	remoteString := DEFAULT_REMOTE_NAME
+	if remote != nil && "" != *remote {
+		remoteString = *remote
+	}
+	if "" == strings.TrimSpace(branch) {
+		currentBranch, err := r.GetCurrentBranch()
+		if err != nil {
+			return false, err
+		}
+		if "HEAD" == currentBranch {
+			return false, &errs.GitError{Message: fmt.Sprintf("unable to resolve the current branch as the repository is in the 'detached HEAD' state")}
+		}
+		branch = currentBranch
+	}
+	log.Debugf("checking if branch '%s' exists on remote '%s'", branch, remoteString)
+	_, err := r.run(nil, nil, "rev-parse", "--verify", "-q", "refs/remotes/"+remoteString+"/"+branch)
+	if err == nil {
+		log.Debugf("branch '%s' exists on remote '%s', as tracked locally", branch, remoteString)
+		return true, nil
+	}
+
+	if "" == r.getRemoteURL(remoteString) {
+		return false, &errs.GitError{Message: fmt.Sprintf("the remote '%s' is not configured or has no URL", remoteString)}
+	}
+	out, err := r.runRemote(cliRemoteOptions{}, "ls-remote", "--heads", remoteString, "refs/heads/"+branch)
+	if err != nil {
+		return false, &errs.GitError{Message: fmt.Sprintf("unable to list the references of remote '%s'", remoteString), Cause: err}
+	}
+	for _, line := range strings.Split(out, "\n") {
+		// references are advertised as '<SHA>\trefs/heads/<BRANCH>'
+		fields := strings.Fields(line)
+		if len(fields) == 2 && "refs/heads/"+branch == fields[1] {
+			log.Debugf("branch '%s' exists on remote '%s', as advertised by the remote", branch, remoteString)
+			return true, nil
+		}
+	}
+	log.Debugf("branch '%s' doesn't exist on remote '%s'", branch, remoteString)
+	return false, nil
+}
+
+/*
+Returns the names of configured remote repositories.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetRemoteNames() ([]string, error) {
+	log.Debugf("retrieving repository remote names")
+	out, err := r.run(nil, nil, "remote")
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to get the repository remotes"), Cause: err}
+	}
+	remoteNames := []string{}
+	for _, line := range strings.Split(out, "\n") {
+		if "" != strings.TrimSpace(line) {
+			remoteNames = append(remoteNames, strings.TrimSpace(line))
+		}
+	}
+
+	log.Debugf("repository remote names are '%v'", remoteNames)
+	return remoteNames, nil
+}
+
+/*
+Returns the URI of the remote repository with the given name, which is the first URL configured for the remote.
+
+Arguments are as follows:
+
+- remote the name of the remote. If nil or empty the default remote name (origin) is used.
+
+Errors can be:
+
+- GitError in case the remote is not configured or has no URL.
+*/
+func (r cliRepository) GetRemoteURL(remote *string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	uri := r.getRemoteURL(remoteString)
+	if "" == uri {
+		return "", &errs.GitError{Message: fmt.Sprintf("the remote '%s' is not configured or has no URL", remoteString)}
+	}
+	return uri, nil
+}
+
+/*
+Returns the configured remote repositories, sorted by name, with the URLs to fetch from and push to.
+When a remote has no push URL configured (the 'pushurl' option), its push URLs are the same used to fetch.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) GetRemotes() ([]gitent.Remote, error) {
+	log.Debugf("retrieving repository remotes")
+	out, err := r.run(nil, nil, "remote", "--verbose")
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to get the repository remotes"), Cause: err}
+	}
+	remotes := make(map[string]*gitent.Remote)
+	for _, line := range strings.Split(out, "\n") {
+		// each line is in the '<NAME>\t<URL> (fetch|push)' form
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		remote, ok := remotes[fields[0]]
+		if !ok {
+			remote = gitent.NewRemoteWith(fields[0], "", []string{})
+			remotes[fields[0]] = remote
+		}
+		switch fields[2] {
+		case "(fetch)":
+			remote.FetchURL = fields[1]
+		case "(push)":
+			remote.PushURLs = append(remote.PushURLs, fields[1])
+		}
+	}
+	remoteNames := make([]string, 0, len(remotes))
+	for name := range remotes {
+		remoteNames = append(remoteNames, name)
+	}
+	sort.Strings(remoteNames)
+
+	res := make([]gitent.Remote, 0, len(remoteNames))
+	for _, name := range remoteNames {
+		res = append(res, *remotes[name])
+	}
+
+	log.Debugf("repository remotes are '%v'", res)
+	return res, nil
+}
+
+/*
+Returns true if the repository is bare, which is when it has no working tree (i.e. when it's a mirror).
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) IsBare() (bool, error) {
+	out, err := r.run(nil, nil, "rev-parse", "--is-bare-repository")
+	if err != nil {
+		return false, &errs.GitError{Message: fmt.Sprintf("unable to tell whether the repository is bare"), Cause: err}
+	}
+	return "true" == strings.TrimSpace(out), nil
+}
+
+/*
+Returns true if the repository is clean, which is when no differences exist between the working tree, the index,
+and the current HEAD. Bare repositories are always clean.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) IsClean() (bool, error) {
+	log.Debugf("checking repository clean status")
+	bare, err := r.IsBare()
+	if err != nil {
+		return false, err
+	}
+	if bare {
+		log.Debugf("the repository is bare and has no working tree so it's clean")
+		return true, nil
+	}
+	out, err := r.run(nil, nil, r.options.cliStatusArguments()...)
+	if err != nil {
+		return false, &errs.GitError{Message: fmt.Sprintf("unable to get the repository worktree status"), Cause: err}
+	}
+	log.Debugf("the 'git status' command returned (empty means the repository is clean): '%v'", out)
+	return "" == strings.TrimSpace(out), nil
+}
+
+/*
+Returns true if the repository is shallow, which is when its history has been truncated (i.e. by a clone with a
+limited depth) and the commits beyond the shallow boundary are not available locally.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) IsShallow() (bool, error) {
+	shallows, err := r.getShallowCommits()
+	if err != nil {
+		return false, err
+	}
+	return len(shallows) > 0, nil
+}
+
+/*
+Acquires the advisory lock on the repository, which is shared by all of its worktrees, so that concurrent
+runs working on the same repository can't interfere with each other. The lock is backed by the
+LOCK_FILE_NAME file in the Git directory and is held until it's released.
+
+Arguments are as follows:
+
+- wait the maximum time to wait for the lock when it's held by someone else. When zero this method fails
+straight away when the lock is held by someone else
+
+Errors can be:
+
+- GitError in case the lock is still held by someone else when the wait time expires or some problem is
+encountered with the underlying Git repository.
+*/
+func (r cliRepository) Lock(wait time.Duration) (Lock, error) {
+	// the common directory is the same for all the worktrees, while the Git directory is private to each one
+	out, err := r.run(nil, nil, "rev-parse", "--git-common-dir")
+	if err != nil {
+		return nil, err
+	}
+	directory := strings.TrimSpace(out)
+	if !filepath.IsAbs(directory) {
+		directory = filepath.Join(r.directory, directory)
+	}
+	path, err := lockFilePath(directory)
+	if err != nil {
+		return nil, err
+	}
+	return acquireFileLock(path, wait)
+}
+
+/*
+Pushes local changes in the current branch and the tags to the given remote, using the given options.
+When lease is true the branch is pushed with the '--force-with-lease' option, so it's only overwritten if it
+still points to the commit of its remote tracking branch, while tags are not forced.
+
+Tags are pushed using the given refspecs or, when nil, they're all pushed.
+
+Returns the local name of the remote that has been pushed, as it was passed.
+*/
+func (r cliRepository) push(remote string, options cliRemoteOptions, force bool, lease bool, tagRefSpecs []string) (string, error) {
+	remoteName := remote
+	if "" == remoteName {
+		remoteName = DEFAULT_REMOTE_NAME
+	}
+	// get the current branch name
+	currentBranchRef, err := r.getHeadReference()
+	if err != nil {
+		options.clean()
+		return "", err
+	}
+	// the refspec is in the localBranch:remoteBranch form, and we assume they both have the same name here
+	args := []string{"push"}
+	if lease {
+		args = append(args, "--force-with-lease="+currentBranchRef)
+	} else if force {
+		args = append(args, "--force")
+	}
+	args = append(args, remoteName, currentBranchRef+":"+currentBranchRef)
+	if tagRefSpecs == nil {
+		args = append(args, ALL_TAGS_REFSPEC)
+	} else {
+		args = append(args, tagRefSpecs...)
+	}
+	_, err = r.runRemote(options, args...)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to push"), Cause: classifyRemoteError(err)}
+	}
+	return remote, nil
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushWithUserNameAndPassword(user *string, password *string) (string, error) {
+	s := DEFAULT_REMOTE_NAME
+	return r.PushToRemoteWithUserNameAndPassword(&s, user, password)
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushWithPublicKey(privateKey *string, passphrase *string) (string, error) {
+	s := DEFAULT_REMOTE_NAME
+	return r.PushToRemoteWithPublicKey(&s, privateKey, passphrase)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error) {
+	return r.PushToRemoteWithUserNameAndPasswordAndForce(remote, user, password, false)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+  - force set it to true if you want the push to be executed using the force option
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemoteWithUserNameAndPasswordAndForce(remote *string, user *string, password *string, force bool) (string, error) {
+	return r.PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(remote, user, password, force, nil)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+Unlike PushToRemoteWithUserNameAndPasswordAndForce, only the tags matching the given refspecs are pushed.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+  - force set it to true if you want the push to be executed using the force option
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(remote *string, user *string, password *string, force bool, tagRefSpecs []string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("pushing changes to remote repository '%s' using username and password", remoteString)
+
+	options, err := getCLIUserNameAndPasswordOptions(user, password, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	return r.push(remoteString, options, force, false, tagRefSpecs)
+}
+
+/*
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+This method allows using user name and password authentication (also used for tokens).
+
+The branch is only overwritten if it still points, on the remote, to the commit of its remote tracking branch,
+like 'git push --force-with-lease' does, while tags are not forced.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
+*/
+func (r cliRepository) PushToRemoteWithUserNameAndPasswordAndLease(remote *string, user *string, password *string) (string, error) {
+	return r.PushToRemoteWithUserNameAndPasswordAndLeaseAndTagRefSpecs(remote, user, password, nil)
+}
+
+/*
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+Unlike PushToRemoteWithUserNameAndPasswordAndLease, only the tags matching the given refspecs are pushed.
+This method allows using user name and password authentication (also used for tokens).
+
+The branch is only overwritten if it still points, on the remote, to the commit of its remote tracking branch,
+like 'git push --force-with-lease' does, while tags are not forced.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
+*/
+func (r cliRepository) PushToRemoteWithUserNameAndPasswordAndLeaseAndTagRefSpecs(remote *string, user *string, password *string, tagRefSpecs []string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("pushing changes to remote repository '%s' with lease using username and password", remoteString)
+
+	options, err := getCLIUserNameAndPasswordOptions(user, password, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	return r.push(remoteString, options, false, true, tagRefSpecs)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+  - force set it to true if you want the push to be executed using the force option
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemoteWithTokenAndForce(remote *string, token *string, user *string, force bool) (string, error) {
+	return r.PushToRemoteWithTokenAndForceAndTagRefSpecs(remote, token, user, force, nil)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+Unlike PushToRemoteWithTokenAndForce, only the tags matching the given refspecs are pushed.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+  - force set it to true if you want the push to be executed using the force option
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemoteWithTokenAndForceAndTagRefSpecs(remote *string, token *string, user *string, force bool, tagRefSpecs []string) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't push using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, true))
+	return r.PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(remote, &tokenUser, &tokenPassword, force, tagRefSpecs)
+}
+
+/*
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
+*/
+func (r cliRepository) PushToRemoteWithTokenAndLease(remote *string, token *string, user *string) (string, error) {
+	return r.PushToRemoteWithTokenAndLeaseAndTagRefSpecs(remote, token, user, nil)
+}
+
+/*
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+Unlike PushToRemoteWithTokenAndLease, only the tags matching the given refspecs are pushed.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
+*/
+func (r cliRepository) PushToRemoteWithTokenAndLeaseAndTagRefSpecs(remote *string, token *string, user *string, tagRefSpecs []string) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't push using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, true))
+	return r.PushToRemoteWithUserNameAndPasswordAndLeaseAndTagRefSpecs(remote, &tokenUser, &tokenPassword, tagRefSpecs)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemoteWithPublicKey(remote *string, privateKey *string, passphrase *string) (string, error) {
+	return r.PushToRemoteWithPublicKeyAndForce(remote, privateKey, passphrase, false)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  - force set it to true if you want the push to be executed using the force option
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemoteWithPublicKeyAndForce(remote *string, privateKey *string, passphrase *string, force bool) (string, error) {
+	return r.PushToRemoteWithPublicKeyAndHostKeysAndForce(remote, privateKey, passphrase, nil, false, force)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+  - force set it to true if you want the push to be executed using the force option
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemoteWithPublicKeyAndHostKeysAndForce(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool) (string, error) {
+	return r.PushToRemoteWithPublicKeyAndHostKeysAndForceAndTagRefSpecs(remote, privateKey, passphrase, knownHosts, strictHostKeyChecking, force, nil)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+Unlike PushToRemoteWithPublicKeyAndHostKeysAndForce, only the tags matching the given refspecs are pushed.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+  - force set it to true if you want the push to be executed using the force option
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemoteWithPublicKeyAndHostKeysAndForceAndTagRefSpecs(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool, tagRefSpecs []string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("pushing changes to remote repository '%s' using public key (SSH) authentication", remoteString)
+
+	options, err := getCLISSHOptions(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	return r.push(remoteString, options, force, false, tagRefSpecs)
+}
+
+/*
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
+*/
+func (r cliRepository) PushToRemoteWithPublicKeyAndHostKeysAndLease(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	return r.PushToRemoteWithPublicKeyAndHostKeysAndLeaseAndTagRefSpecs(remote, privateKey, passphrase, knownHosts, strictHostKeyChecking, nil)
+}
+
+/*
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+Unlike PushToRemoteWithPublicKeyAndHostKeysAndLease, only the tags matching the given refspecs are pushed.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
+*/
+func (r cliRepository) PushToRemoteWithPublicKeyAndHostKeysAndLeaseAndTagRefSpecs(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, tagRefSpecs []string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("pushing changes to remote repository '%s' with lease using public key (SSH) authentication", remoteString)
+
+	options, err := getCLISSHOptions(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	return r.push(remoteString, options, false, true, tagRefSpecs)
+}
+
+/*
+Pushes local changes in the current branch to the given remotes.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns a collection with the local names of remotes that have been pushed.
+
+Arguments are as follows:
+
+  - remotes remotes the names of remotes to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemotesWithUserNameAndPassword(remotes []string, user *string, password *string) ([]string, error) {
+	log.Debugf("pushing changes to '%d' remote repositories using username and password", len(remotes))
+	var res []string
+	for _, remote := range remotes {
+		r, err := r.PushToRemoteWithUserNameAndPassword(&remote, user, password)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+/*
+Pushes local changes in the current branch to the given remotes.
+This method allows using SSH authentication.
+
+Returns a collection with the local names of remotes that have been pushed.
+
+Arguments are as follows:
+
+  - remotes remotes the names of remotes to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r cliRepository) PushToRemotesWithPublicKey(remotes []string, privateKey *string, passphrase *string) ([]string, error) {
+	log.Debugf("pushing changes to '%d' remote repositories using public key (SSH) authentication", len(remotes))
+	var res []string
+	for _, remote := range remotes {
+		r, err := r.PushToRemoteWithPublicKey(&remote, privateKey, passphrase)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+/*
+Pushes the tag with the given name to the given remote, using the given options. When force is true the tag is updated
+on the remote even if it already exists there pointing to another object.
+
+Returns the local name of the remote that the tag has been pushed to.
+*/
+func (r cliRepository) pushTag(remote string, name string, options cliRemoteOptions, force bool) (string, error) {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	args := []string{"push"}
+	if force {
+		args = append(args, "--force")
+	}
+	args = append(args, remote, "refs/tags/"+name+":refs/tags/"+name)
+	_, err := r.runRemote(options, args...)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to push tag '%s' to remote '%s'", name, remote), Cause: classifyRemoteError(err)}
+	}
+	return remote, nil
+}
+
+/*
+Pushes the tag with the given name to the given remote. When force is true the tag is updated on the remote even if it
+already exists there pointing to another object.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remote that the tag has been pushed to.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push the tag to. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to push.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+  - force set it to true if you want the tag to be pushed using the force option
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push the tag.
+*/
+func (r cliRepository) PushTagToRemoteWithUserNameAndPasswordAndForce(remote *string, name string, user *string, password *string, force bool) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("pushing tag '%s' to remote repository '%s' using username and password", name, remoteString)
+
+	options, err := getCLIUserNameAndPasswordOptions(user, password, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	return r.pushTag(remoteString, name, options, force)
+}
+
+/*
+Pushes the tag with the given name to the given remote. When force is true the tag is updated on the remote even if it
+already exists there pointing to another object.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remote that the tag has been pushed to.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push the tag to. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to push.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+  - force set it to true if you want the tag to be pushed using the force option
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push the tag.
+*/
+func (r cliRepository) PushTagToRemoteWithTokenAndForce(remote *string, name string, token *string, user *string, force bool) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't push tags using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, true))
+	return r.PushTagToRemoteWithUserNameAndPasswordAndForce(remote, name, &tokenUser, &tokenPassword, force)
+}
+
+/*
+Pushes the tag with the given name to the given remote. When force is true the tag is updated on the remote even if it
+already exists there pointing to another object.
+This method allows using SSH authentication.
+
+Returns the local name of the remote that the tag has been pushed to.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push the tag to. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to push.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+  - force set it to true if you want the tag to be pushed using the force option
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push the tag.
+*/
+func (r cliRepository) PushTagToRemoteWithPublicKeyAndHostKeysAndForce(remote *string, name string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("pushing tag '%s' to remote repository '%s' using public key (SSH) authentication", name, remoteString)
+
+	options, err := getCLISSHOptions(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	return r.pushTag(remoteString, name, options, force)
+}
+
+/*
+Returns the local tags, mapped to the objects they point to, by reference name (i.e. 'refs/tags/1.0.0').
+*/
+func (r cliRepository) getTagReferences() (map[string]string, error) {
+	out, err := r.run(nil, nil, "for-each-ref", "--format=%(refname) %(objectname)", "refs/tags")
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("cannot list repository tags"), Cause: err}
+	}
+	res := make(map[string]string)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 {
+			res[fields[0]] = fields[1]
+		}
+	}
+	return res, nil
+}
+
+/*
+Removes the remote with the given name from the repository configuration, along with its remote tracking
+references.
+
+Arguments are as follows:
+
+- name the name of the remote to remove
+
+Errors can be:
+
+- GitError in case the remote is not configured or some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) RemoveRemote(name string) error {
+	log.Debugf("removing remote '%s'", name)
+	_, err := r.run(nil, nil, "remote", "remove", name)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to remove remote '%s'", name), Cause: err}
+	}
+	log.Debugf("remote '%s' removed", name)
+	return nil
+}
+
+/*
+Sets the URL of the remote with the given name, replacing the first URL configured for the remote. Push URLs
+(the 'pushurl' option), when configured, are left untouched.
+
+Arguments are as follows:
+
+- name the name of the remote to update
+- url the new URL of the remote. Cannot be empty
+
+Errors can be:
+
+  - GitError in case the URL is empty, the remote is not configured or some problem is encountered with the
+    underlying Git repository.
+*/
+func (r cliRepository) SetRemoteURL(name string, url string) error {
+	log.Debugf("setting the URL of remote '%s'", name)
+	if "" == strings.TrimSpace(url) {
+		return &errs.GitError{Message: fmt.Sprintf("the URL of remote '%s' cannot be empty", name)}
+	}
+	_, err := r.run(nil, nil, "remote", "set-url", name, url)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to set the URL of remote '%s'", name), Cause: err}
+	}
+	log.Debugf("URL of remote '%s' set", name)
+	return nil
+}
+
+/*
+Takes a snapshot of the current status of the repository, made of the current branch (or HEAD, when detached),
+the staging area (index) and the local tags, so that it can be restored later on.
+
+The working tree contents are not part of the snapshot as the operations Nyx performs on the repository
+(staging, committing and tagging) never change them. Use SnapshotWithPaths to also include the working
+tree files changed by other means.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) Snapshot() (Snapshot, error) {
+	return r.SnapshotWithPaths(nil)
+}
+
+/*
+Takes a snapshot of the current status of the repository just like Snapshot, also including the contents
+of the given working tree files, so that files changed in the meanwhile are brought back to their previous
+contents and files created in the meanwhile are removed.
+
+Arguments are as follows:
+
+  - paths the paths (absolute or relative to the repository directory) of the working tree files to include
+    in the snapshot. It may be nil or empty
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository or reading the files.
+*/
+func (r cliRepository) SnapshotWithPaths(paths []string) (Snapshot, error) {
+	log.Debugf("taking a snapshot of the repository")
+	snapshot := cliSnapshot{repository: r}
+	head, err := r.getHeadReference()
+	if err != nil {
+		return nil, err
+	}
+	if "HEAD" == head {
+		snapshot.head, err = r.resolve("HEAD")
+		if err != nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+		}
+	} else {
+		snapshot.branch = head
+		out, err := r.run(nil, nil, "rev-parse", "--verify", "-q", head)
+		if err == nil {
+			snapshot.branchTarget = strings.TrimSpace(out)
+		} else if !hasExitCode(err, 1) {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to '%s'", head), Cause: err}
+		}
+		// when the branch can't be resolved the repository has no commits yet and the target is left empty
+	}
+
+	snapshot.indexPath, err = r.gitPath("index")
+	if err != nil {
+		return nil, err
+	}
+	snapshot.index, err = os.ReadFile(snapshot.indexPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the repository index"), Cause: err}
+		}
+		snapshot.index = nil
+	}
+
+	snapshot.tags, err = r.getTagReferences()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.files, err = snapshotWorktreeFiles(r.directory, paths)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+/*
+Returns the SHA-1 of the most recent stash entry or the empty string if there are no stashed changes.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) getStashTop() (string, error) {
+	out, err := r.run(nil, nil, "rev-parse", "--verify", "-q", "refs/stash")
+	if err != nil {
+		if hasExitCode(err, 1) {
+			return "", nil
+		}
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve the stash reference"), Cause: err}
+	}
+	return strings.TrimSpace(out), nil
+}
+
+/*
+Stashes the uncommitted changes in the working tree and the staging area (index), including untracked
+files, so that the working tree is left clean (apart from the excluded paths) and the changes can be
+restored later on by StashPop(). Ignored files are not stashed.
+
+Returns true if some changes have been stashed, false if there was nothing to stash, in which case
+StashPop() must not be invoked.
+
+Arguments are as follows:
+
+  - excludedPaths the paths (absolute or relative to the repository directory) whose changes are left in
+    place instead of being stashed. It may be nil or empty
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) Stash(excludedPaths []string) (bool, error) {
+	log.Debugf("stashing local changes")
+	err := r.checkWorkTree()
+	if err != nil {
+		return false, err
+	}
+	before, err := r.getStashTop()
+	if err != nil {
+		return false, err
+	}
+	args := []string{"stash", "push", "--include-untracked", "--message", "Nyx: changes stashed while marking the release", "--", "."}
+	for _, excludedPath := range excludedPaths {
+		args = append(args, ":(exclude)"+excludedPath)
+	}
+	// when there is nothing to stash git exits with a zero status and leaves the stash untouched
+	_, err = r.run(nil, nil, args...)
+	if err != nil {
+		return false, &errs.GitError{Message: fmt.Sprintf("unable to stash local changes"), Cause: err}
+	}
+	after, err := r.getStashTop()
+	if err != nil {
+		return false, err
+	}
+	log.Debugf("local changes stashed: '%v'", before != after)
+	return before != after, nil
+}
+
+/*
+Restores the changes most recently stashed by Stash() to the working tree and the staging area (index) and
+drops them from the stash.
+
+Errors can be:
+
+  - GitError in case there are no stashed changes, the stashed changes conflict with the ones in the working
+    tree or some problem is encountered with the underlying Git repository.
+*/
+func (r cliRepository) StashPop() error {
+	log.Debugf("restoring stashed changes")
+	err := r.checkWorkTree()
+	if err != nil {
+		return err
+	}
+	_, err = r.run(nil, nil, "stash", "pop", "--index", "--quiet")
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to restore stashed changes"), Cause: err}
+	}
+	return nil
+}
+
+/*
+Tags the latest commit in the current branch with a tag with the given name. The resulting tag is lightweight.
+If the tag already exists it's updated.
+
+Returns the object modelling the new tag that was created. Never nil.
+
+Arguments are as follows:
+
+- name the name of the tag. Cannot be nil
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, preventing to tag
+    (i.e. when the tag name is nil).
+*/
+func (r cliRepository) Tag(name *string) (gitent.Tag, error) {
+	return r.TagWithMessage(name, nil)
+}
+
+/*
+Tags the latest commit in the current branch with a tag with the given name and optional message.
+If the tag already exists it's updated.
+
+Returns the object modelling the new tag that was created. Never nil.
+
+Arguments are as follows:
+
+  - name the name of the tag. Cannot be nil
+  - message the optional tag message. If nil the new tag will be lightweight, otherwise it will be an
+    annotated tag
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, preventing to tag
+    (i.e. when the tag name is nil).
+*/
+func (r cliRepository) TagWithMessage(name *string, message *string) (gitent.Tag, error) {
+	return r.TagWithMessageAndIdentity(name, message, nil)
+}
+
+/*
+Tags the latest commit in the current branch with a tag with the given name and optional message.
+If the tag already exists it's updated.
+
+Returns the object modelling the new tag that was created. Never nil.
+
+Arguments are as follows:
+
+  - name the name of the tag. Cannot be nil
+  - message the optional tag message. If nil the new tag will be lightweight, otherwise it will be an
+    annotated tag
+  - force set it to true if you want the tag to be applied using the force option
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, preventing to tag
+    (i.e. when the tag name is nil).
+*/
+func (r cliRepository) TagWithMessageAndForce(name *string, message *string, force bool) (gitent.Tag, error) {
+	return r.TagCommitWithMessageAndIdentityAndForce(nil, name, message, nil, force)
+}
+
+/*
+Tags the latest commit in the current branch with a tag with the given name and optional message using the optional
+tagger identity.
+If the tag already exists it's updated.
+
+Returns the object modelling the new tag that was created. Never nil.
+
+Arguments are as follows:
+
+  - name the name of the tag. Cannot be nil
+  - message the optional tag message. If nil the new tag will be lightweight, otherwise it will be an
+    annotated tag
+  - tagger the optional identity of the tagger. If nil Git defaults are used. If message is nil this is ignored.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, preventing to tag
+    (i.e. when the tag name is nil).
+*/
+func (r cliRepository) TagWithMessageAndIdentity(name *string, message *string, tagger *gitent.Identity) (gitent.Tag, error) {
+	return r.TagCommitWithMessageAndIdentity(nil, name, message, tagger)
+}
+
+/*
+Tags the object represented by the given SHA-1 with a tag with the given name and optional message using the optional
+tagger identity.
+If the tag already exists it's updated.
+
+Returns the object modelling the new tag that was created. Never nil.
+
+Arguments are as follows:
+
+  - target the SHA-1 identifier of the object to tag. If nil the latest commit in the current branch is tagged.
+  - name the name of the tag. Cannot be nil
+  - message the optional tag message. If nil the new tag will be lightweight, otherwise it will be an
+    annotated tag
+  - tagger the optional identity of the tagger. If nil Git defaults are used. If message is nil this is ignored.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, preventing to tag
+    (i.e. when the tag name is nil).
+*/
+func (r cliRepository) TagCommitWithMessageAndIdentity(target *string, name *string, message *string, tagger *gitent.Identity) (gitent.Tag, error) {
+	return r.TagCommitWithMessageAndIdentityAndForce(target, name, message, tagger, false)
+}
+
+/*
+Tags the object represented by the given SHA-1 with a tag with the given name and optional message using the optional
+tagger identity.
+If the tag already exists it's updated.
+
+Returns the object modelling the new tag that was created. Never nil.
+
+Arguments are as follows:
+
+  - target the SHA-1 identifier of the object to tag. If nil the latest commit in the current branch is tagged.
+  - name the name of the tag. Cannot be nil
+  - message the optional tag message. If nil the new tag will be lightweight, otherwise it will be an
+    annotated tag
+  - tagger the optional identity of the tagger. If nil Git defaults are used. If message is nil this is ignored.
+  - force set it to true if you want the tag to be applied using the force option
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, preventing to tag
+    (i.e. when the tag name is nil).
+*/
+func (r cliRepository) TagCommitWithMessageAndIdentityAndForce(target *string, name *string, message *string, tagger *gitent.Identity, force bool) (gitent.Tag, error) {
+	if name == nil {
+		return gitent.Tag{}, &errs.GitError{Message: fmt.Sprintf("tag name cannot be nil")}
+	}
+	tags, err := r.getTagReferences()
+	if err != nil {
+		return gitent.Tag{}, err
+	}
+	if _, ok := tags["refs/tags/"+*name]; ok {
+		if force {
+			log.Debugf("the repository already had a tag '%s' and the 'force' flag is enabled so the tag will be replaced", *name)
+		} else {
+			log.Warnf("the repository already had a tag '%s' but the 'force' flag is disabled so the tag will not be deleted before applying the new one", *name)
+		}
+	}
+
+	log.Debugf("tagging as '%s'", *name)
+	var targetSHA string
+	if target == nil {
+		targetSHA, err = r.GetLatestCommit()
+		if err != nil {
+			return gitent.Tag{}, &errs.GitError{Message: fmt.Sprintf("unable to get the latest commit (HEAD)"), Cause: err}
+		}
+	} else {
+		targetSHA = *target
+	}
+	args := []string{"tag"}
+	if force {
+		args = append(args, "--force")
+	}
+	var env []string
+	var input []byte
+	if message != nil {
+		// create an annotated tag, with the message passed verbatim just like go-git does
+		args = append(args, "--annotate", "--cleanup=verbatim", "--file=-")
+		env = cliIdentityEnvironment("COMMITTER", tagger, r.options.timestamp())
+		input = []byte(*message)
+	}
+	args = append(args, "--", *name, targetSHA)
+	_, err = r.run(env, input, args...)
+	if err != nil {
+		return gitent.Tag{}, &errs.GitError{Message: fmt.Sprintf("unable to create Git tag"), Cause: err}
+	}
+
+	out, err := r.run(nil, nil, "for-each-ref", cliTagFormat, "refs/tags/"+*name)
+	if err != nil || "" == strings.TrimSpace(out) {
+		return gitent.Tag{}, &errs.GitError{Message: fmt.Sprintf("unable to read the Git tag '%s' that has been created", *name), Cause: err}
+	}
+	return parseCLITags(out)[0], nil
+}
+
+/*
+Fetches the missing history from the given remote so that a shallow repository becomes complete, using the given options.
+Tags are also fetched.
+
+Returns the local name of the remote that the history has been fetched from.
+*/
+func (r cliRepository) unshallow(remote string, options cliRemoteOptions) (string, error) {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	shallow, err := r.IsShallow()
+	if err != nil {
+		options.clean()
+		return "", err
+	}
+	args := []string{"fetch", "--tags"}
+	if shallow {
+		args = append(args, "--unshallow")
+	}
+	_, err = r.runRemote(options, append(args, remote)...)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to fetch the missing history from remote '%s'", remote), Cause: classifyRemoteError(err)}
+	}
+	shallow, err = r.IsShallow()
+	if err == nil && shallow {
+		log.Warnf("the repository is still shallow after fetching from remote '%s'", remote)
+	}
+	return remote, nil
+}
+
+/*
+Fetches the missing history from the given remote so that a shallow repository becomes complete.
+Tags are also fetched. If the repository is not shallow this method just fetches from the remote.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remote that the history has been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r cliRepository) UnshallowFromRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("fetching the missing history from remote repository '%s' using username and password", remoteString)
+
+	options, err := getCLIUserNameAndPasswordOptions(user, password, r.getRewrittenRemoteURL(remoteString, false))
+	if err != nil {
+		return "", err
+	}
+	return r.unshallow(remoteString, options)
+}
+
+/*
+Fetches the missing history from the given remote so that a shallow repository becomes complete.
+Tags are also fetched. If the repository is not shallow this method just fetches from the remote.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remote that the history has been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r cliRepository) UnshallowFromRemoteWithToken(remote *string, token *string, user *string) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't fetch using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, false))
+	return r.UnshallowFromRemoteWithUserNameAndPassword(remote, &tokenUser, &tokenPassword)
+}
+
+/*
+Fetches the missing history from the given remote so that a shallow repository becomes complete.
+Tags are also fetched. If the repository is not shallow this method just fetches from the remote.
+This method allows using SSH authentication.
+
+Returns the local name of the remote that the history has been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the
+    default keys and the keys held by the running SSH agent are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r cliRepository) UnshallowFromRemoteWithPublicKeyAndHostKeys(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("fetching the missing history from remote repository '%s' using public key (SSH) authentication", remoteString)
+
+	options, err := getCLISSHOptions(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, false))
+	if err != nil {
+		return "", err
+	}
+	return r.unshallow(remoteString, options)
+}
+
+/*
+Verifies the signature of the given object using 'git verify-commit' or 'git verify-tag' and returns the outcome
+of the verification. When trusted keys are given they are imported in a temporary keyring, used instead of the
+user keyring.
+
+Arguments are as follows:
+
+- objectType the type of the object to verify, either 'commit' or 'tag'
+- object the identifier of the object to verify
+- trustedKeys the optional armored PGP public keys to verify the signature with
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository or the given keys
+    can't be imported.
+*/
+func (r cliRepository) verifySignature(objectType string, object string, trustedKeys *string) (gitent.Signature, error) {
+	content, err := r.run(nil, nil, "cat-file", objectType, object)
+	if err != nil {
+		return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("unable to read %s '%s'", objectType, object), Cause: err}
+	}
+	if !strings.Contains(content, "-----BEGIN PGP SIGNATURE-----") {
+		return *gitent.NewSignatureWith(false, false, ""), nil
+	}
+
+	var env []string
+	if trustedKeys != nil && "" != strings.TrimSpace(*trustedKeys) {
+		home, err := os.MkdirTemp("", "nyx-gnupg-")
+		if err != nil {
+			return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("unable to create the temporary keyring"), Cause: err}
+		}
+		defer os.RemoveAll(home)
+		cmd := exec.Command(GPG_EXECUTABLE, "--batch", "--homedir", home, "--import")
+		cmd.Stdin = strings.NewReader(*trustedKeys)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("unable to import the trusted keys: %s", strings.TrimSpace(string(out))), Cause: err}
+		}
+		env = append(env, "GNUPGHOME="+home)
+	}
+
+	// the verification outcome is printed to the standard error, and the exit status is non zero when the signature is not valid
+	cmd := r.command(env, "verify-"+objectType, "--raw", object)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	log.Tracef("running 'git verify-%s --raw %s' in directory '%s'", objectType, object, r.directory)
+	verifyErr := cmd.Run()
+	signer := ""
+	good := false
+	for _, line := range strings.Split(stderr.String(), "\n") {
+		if strings.HasPrefix(line, cliGPGStatusPrefix+"GOODSIG ") {
+			good = true
+			// the line is in the '[GNUPG:] GOODSIG <key id> <user id>' form
+			if fields := strings.SplitN(strings.TrimPrefix(line, cliGPGStatusPrefix+"GOODSIG "), " ", 2); len(fields) == 2 {
+				signer = strings.TrimSpace(fields[1])
+			}
+		}
+	}
+	if verifyErr != nil || !good {
+		log.Debugf("the signature of %s '%s' can't be verified: %s", objectType, object, strings.TrimSpace(stderr.String()))
+		return *gitent.NewSignatureWith(true, false, ""), nil
+	}
+	return *gitent.NewSignatureWith(true, true, signer), nil
+}
+
+/*
+Verifies the signature of the given commit and returns the outcome of the verification. A commit that is not
+signed is not an error and yields a signature that is neither signed nor valid.
+
+Arguments are as follows:
+
+  - commit the SHA-1 identifier of the commit to verify. It can be a full or abbreviated SHA-1.
+  - trustedKeys the optional armored PGP public keys to verify the signature with. When nil or empty
+    the keys in the keyring of the user running gpg are used.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository or the given keys
+    can't be imported.
+*/
+func (r cliRepository) VerifyCommitSignature(commit string, trustedKeys *string) (gitent.Signature, error) {
+	log.Debugf("verifying the signature of commit '%s'", commit)
+	c, err := r.parseCommit(commit, nil)
+	if err != nil {
+		return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("unable to resolve commit '%s'", commit), Cause: err}
+	}
+	return r.verifySignature("commit", c.Sha, trustedKeys)
+}
+
+/*
+Verifies the signature of the given tag and returns the outcome of the verification. A tag that is not
+signed (including lightweight tags) is not an error and yields a signature that is neither signed nor valid.
+
+Arguments are as follows:
+
+  - tag the name of the tag to verify.
+  - trustedKeys the optional armored PGP public keys to verify the signature with. When nil or empty
+    the keys in the keyring of the user running gpg are used.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, the tag doesn't exist
+    or the given keys can't be imported.
+*/
+func (r cliRepository) VerifyTagSignature(tag string, trustedKeys *string) (gitent.Signature, error) {
+	log.Debugf("verifying the signature of tag '%s'", tag)
+	objectType, err := r.run(nil, nil, "cat-file", "-t", "refs/tags/"+tag)
+	if err != nil {
+		return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("unable to resolve tag '%s'", tag), Cause: err}
+	}
+	if "tag" != strings.TrimSpace(objectType) {
+		// it's a lightweight tag, which can't be signed
+		return *gitent.NewSignatureWith(false, false, ""), nil
+	}
+	return r.verifySignature("tag", "refs/tags/"+tag, trustedKeys)
+}
+
+/*
+Browse the repository commit history using the given visitor to inspect each commit. Commits are
+evaluated in Git's natural order, from the most recent to oldest.
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the commit to start from. If nil the latest commit in the
+    current branch (HEAD) is used. This can be a long or abbreviated SHA-1. If this commit cannot be
+    resolved within the repository a GitError is thrown.
+  - end the optional SHA-1 id of the commit to end with, included. If nil the repository root
+    commit is used (until the given visitor returns false). If this commit is not reachable
+    from the start it will be ignored. This can be a long or abbreviated SHA-1. If this commit cannot be resolved
+    within the repository a GitError is thrown.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function isits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or a given commit identifier cannot be resolved.
+  - ShallowRepositoryError in case the walk reaches the boundary of a shallow repository before the visitor
+    stops it or the end commit is reached, as the commits beyond the boundary are not available locally.
+*/
+func (r cliRepository) WalkHistory(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+	return r.WalkHistoryBetweenDates(start, end, nil, nil, visit)
+}
+
+/*
+Browse the repository commit history using the given visitor to inspect each commit, just like WalkHistory,
+but besides the start and end commits the walk is also bounded by the commit dates, so that only the commits
+made within a time frame are visited.
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the commit to start from. If nil the latest commit in the
+    current branch (HEAD) is used. This can be a long or abbreviated SHA-1. If this commit cannot be
+    resolved within the repository a GitError is thrown.
+  - end the optional SHA-1 id of the commit to end with, included. If nil the repository root
+    commit is used (until the given visitor returns false). If this commit is not reachable
+    from the start it will be ignored. This can be a long or abbreviated SHA-1. If this commit cannot be resolved
+    within the repository a GitError is thrown.
+  - since the optional time stamp (in milliseconds since the epoch) of the oldest commit to visit. The walk
+    stops at the first commit whose committer date is older than this time stamp, which is not visited.
+    If nil commits are visited regardless of how old they are.
+  - until the optional time stamp (in milliseconds since the epoch) of the most recent commit to visit.
+    Commits whose committer date is newer than this time stamp are skipped without being visited, but
+    the walk goes on with their parents. If nil commits are visited regardless of how recent they are.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function isits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or a given commit identifier cannot be resolved.
+  - ShallowRepositoryError in case the walk reaches the boundary of a shallow repository before the visitor
+    stops it or the end commit is reached, as the commits beyond the boundary are not available locally.
+*/
+func (r cliRepository) WalkHistoryBetweenDates(start *string, end *string, since *int64, until *int64, visit func(commit gitent.Commit) bool) error {
+	if visit == nil {
+		return nil
+	}
+	return r.walkHistory(start, end, since, until, visit, false)
+}
+
+/*
+Browse the repository commit history using the given visitor to inspect each commit, just like WalkHistory
+but in chronological order, from the oldest to the most recent, so that the commits since a given commit
+can be inspected in the order they were made. Only the first parent of merge commits is followed.
+
+The commits are all read before the first one is visited so, unlike WalkHistory, stopping the visit early
+doesn't spare reading the older commits.
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the most recent commit, which is visited last. If nil the latest commit in the
+    current branch (HEAD) is used. This can be a long or abbreviated SHA-1. If this commit cannot be
+    resolved within the repository a GitError is thrown.
+  - end the optional SHA-1 id of the oldest commit, which is visited first. If nil the repository root
+    commit is used. If this commit is not reachable from the start it will be ignored. This can be a long
+    or abbreviated SHA-1. If this commit cannot be resolved within the repository a GitError is thrown.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function visits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or a given commit identifier cannot be resolved.
+  - ShallowRepositoryError in case the boundary of a shallow repository is reached before the end commit,
+    as the commits beyond the boundary are not available locally. No commit is visited in this case.
+*/
+func (r cliRepository) WalkHistoryInReverse(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+	if visit == nil {
+		return nil
+	}
+	commits := []gitent.Commit{}
+	err := r.WalkHistory(start, end, func(commit gitent.Commit) bool {
+		commits = append(commits, commit)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	log.Debugf("walking '%d' commits in reverse order", len(commits))
+	for i := len(commits) - 1; i >= 0; i-- {
+		log.Tracef("visiting commit '%s'", commits[i].Sha)
+		if !visit(commits[i]) {
+			log.Debugf("commit history walk interrupted by visitor")
+			break
+		}
+	}
+	return nil
+}
+
+/*
+Browse the repository commit history using the given visitor to inspect each commit, following all the
+parents of merge commits instead of the first parent only, so that commits merged from other branches are
+also visited. Commits are evaluated in topological order, so that no commit is visited before all of its
+children, and the commits of merged branches are visited before the older commits of the branch they're
+merged into (like 'git log --topo-order').
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the commit to start from. If nil the latest commit in the
+    current branch (HEAD) is used. This can be a long or abbreviated SHA-1. If this commit cannot be
+    resolved within the repository a GitError is thrown.
+  - end the optional SHA-1 id of the commit to end with, included. If nil the repository root
+    commits are used (until the given visitor returns false). The walk doesn't go past this commit, so the commits
+    reachable from its parents are not visited, while the other commits are (like 'git log <start> ^<end>^@').
+    This can be a long or abbreviated SHA-1. If this commit cannot be resolved within the repository a GitError is thrown.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function visits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or a given commit identifier cannot be resolved.
+  - ShallowRepositoryError in case the walk reaches the boundary of a shallow repository before the visitor
+    stops it or the end commit is reached, as the commits beyond the boundary are not available locally.
+*/
+func (r cliRepository) WalkHistoryWithAllParents(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+	if visit == nil {
+		return nil
+	}
+	return r.walkHistory(start, end, nil, nil, visit, true)
+}
+
+/*
+Returns a copy of this repository whose operations connecting to a remote (fetching, pushing, unshallowing, deleting
+remote tags and checking remote branches) are bounded by the given context, on top of the timeout set for the
+repository. This repository is left unchanged.
+
+Arguments are as follows:
+
+- ctx the context bounding the operations connecting to a remote. When nil operations are only bounded by the timeout.
+*/
+func (r cliRepository) WithContext(ctx context.Context) Repository {
+	r.options.context = ctx
+	return r
+}
+
+/*
+Browse the repository commit history using the given visitor to inspect each commit, following the first parent
+only or all the parents of merge commits, depending on the allParents flag. This is the implementation of
+WalkHistory and WalkHistoryWithAllParents, see them for the arguments and the errors, and expects the visitor
+not to be nil. The date boundaries are meant to be used along with the first parent walk only, as they
+stop the walk at the first commit older than the since boundary.
+*/
+func (r cliRepository) walkHistory(start *string, end *string, since *int64, until *int64, visit func(commit gitent.Commit) bool, allParents bool) error {
+	startString := "not defined"
+	if start != nil {
+		startString = *start
+	}
+	endString := "not defined"
+	if end != nil {
+		endString = *end
+	}
+	log.Debugf("walking commit history. Start commit boundary is '%s'. End commit boundary is '%s'", startString, endString)
+	parentsOption := "--first-parent"
+	if allParents {
+		log.Debugf("upon merge commits all parents are considered.")
+		parentsOption = "--topo-order"
+	} else {
+		log.Debugf("upon merge commits only the first parent is considered.")
+	}
+
+	var startSHA string
+	var err error
+	if start == nil {
+		startSHA, err = r.GetLatestCommit()
+	} else {
+		startSHA, err = r.resolve(*start)
+	}
+	if err != nil {
+		return err
+	}
+	log.Tracef("start boundary resolved to commit '%s'", startSHA)
+
+	revisions := []string{startSHA}
+	if end != nil {
+		// make sure it can be resolved
+		endSHA, err := r.resolve(*end)
+		if err != nil {
+			return err
+		}
+		log.Tracef("end boundary resolved to commit '%s'", endSHA)
+		if allParents {
+			// the commits reachable from the parents of the end boundary are excluded, so the walk doesn't go past
+			// the end boundary while the commits reachable from other parents are still visited
+			revisions = append(revisions, "^"+endSHA+"^@")
+		}
+	}
+
+	// tags are read once and matched to commits by their targets
+	tags, err := r.GetTags()
+	if err != nil {
+		return err
+	}
+	tagsByTarget := make(map[string][]gitent.Tag)
+	for _, tag := range tags {
+		tagsByTarget[tag.Target] = append(tagsByTarget[tag.Target], tag)
+	}
+	shallows, err := r.getShallowCommits()
+	if err != nil {
+		return err
+	}
+
+	// commits are streamed so that git can be stopped as soon as the visitor is done
+	arguments := append([]string{"log", parentsOption, "-z", "--date=raw", cliCommitFormat}, revisions...)
+	cmd := r.command(nil, append(arguments, "--")...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err = cmd.Start()
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
+	}
+	defer func() {
+		if cmd.ProcessState == nil {
+			cmd.Process.Kill()
+			cmd.Wait()
+		}
+	}()
+
+	reader := bufio.NewReader(stdout)
+	for {
+		record, readErr := reader.ReadString('\x00')
+		record = strings.TrimSuffix(record, "\x00")
+		if "" != record {
+			commit, err := parseCLICommit(record, tagsByTarget[strings.SplitN(record, cliFieldSeparator, 2)[0]])
+			if err != nil {
+				return err
+			}
+			if since != nil && commit.Date < *since {
+				log.Debugf("commit history walk reached the since boundary at commit '%s'", commit.Sha)
+				return nil
+			}
+			// the parents of the commits at the shallow boundary are hidden to 'git log' so they're read from the commit object
+			boundary := shallows[commit.Sha]
+			if boundary {
+				commit.Parents, err = r.getCommitObjectParents(commit.Sha)
+				if err != nil {
+					return &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
+				}
+			}
+
+			if until != nil && commit.Date > *until {
+				log.Tracef("skipping commit '%s' as it's newer than the until boundary", commit.Sha)
+			} else {
+				log.Tracef("visiting commit '%s'", commit.Sha)
+				if !visit(commit) {
+					log.Debugf("commit history walk interrupted by visitor")
+					return nil
+				}
+			}
+			if end != nil && strings.HasPrefix(commit.Sha, *end) {
+				log.Debugf("commit history walk reached the end boundary '%s'", *end)
+				if !allParents {
+					return nil
+				}
+			} else if boundary && len(commit.Parents) > 0 {
+				log.Debugf("commit history walk reached the shallow boundary at commit '%s'", commit.Sha)
+				return &errs.ShallowRepositoryError{Message: fmt.Sprintf("the commit history walk reached the boundary of the shallow repository at commit '%s' and the older commits are not available locally", commit.Sha)}
+			}
+		}
+		if readErr == io.EOF {
+			break
+		} else if readErr != nil {
+			return &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: readErr}
+		}
+	}
+	err = cmd.Wait()
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits: %s", strings.TrimSpace(stderr.String())), Cause: err}
+	}
+	log.Debugf("commit history walk reached the end")
+	return nil
+}
+
+/*
+The status of a repository taken by cliRepository.Snapshot().
+*/
+type cliSnapshot struct {
+	// The repository the snapshot was taken from.
+	repository cliRepository
+
+	// The SHA-1 of the commit HEAD pointed to when it was detached, or empty when HEAD pointed to a branch.
+	head string
+
+	// The name of the branch HEAD pointed to (i.e. 'refs/heads/main'), or empty when HEAD was detached.
+	branch string
+
+	// The SHA-1 of the commit the branch pointed to, or empty when the branch had no commits yet.
+	branchTarget string
+
+	// The path of the index file.
+	indexPath string
+
+	// The index file contents, as they were when the snapshot was taken, or nil when there was no index file.
+	index []byte
+
+	// The local tags, as they were when the snapshot was taken, mapped to the objects they pointed to.
+	tags map[string]string
+
+	// The working tree files included in the snapshot, as they were when the snapshot was taken.
+	files []worktreeFile
+}
+
+/*
+Restores the repository to the status it had when the snapshot was taken.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (s cliSnapshot) Restore() error {
+	log.Debugf("restoring the repository from the snapshot")
+
+	// restore tags, removing those created after the snapshot, and the branch in a single transaction
+	tags, err := s.repository.getTagReferences()
+	if err != nil {
+		return err
+	}
+	var commands strings.Builder
+	for name := range tags {
+		if _, ok := s.tags[name]; !ok {
+			log.Debugf("removing tag '%s'", strings.TrimPrefix(name, "refs/tags/"))
+			fmt.Fprintf(&commands, "delete %s\n", name)
+		}
+	}
+	for name, target := range s.tags {
+		fmt.Fprintf(&commands, "update %s %s\n", name, target)
+	}
+	if "" != s.branch {
+		// restore the branch, discarding the commits added after the snapshot
+		if "" == s.branchTarget {
+			fmt.Fprintf(&commands, "delete %s\n", s.branch)
+		} else {
+			fmt.Fprintf(&commands, "update %s %s\n", s.branch, s.branchTarget)
+		}
+	}
+	_, err = s.repository.run(nil, []byte(commands.String()), "update-ref", "--stdin")
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to restore the repository references"), Cause: err}
+	}
+
+	// restore HEAD
+	if "" != s.branch {
+		_, err = s.repository.run(nil, nil, "symbolic-ref", "HEAD", s.branch)
+	} else {
+		_, err = s.repository.run(nil, nil, "update-ref", "--no-deref", "HEAD", s.head)
+	}
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to restore reference to HEAD"), Cause: err}
+	}
+
+	// restore the index
+	if s.index == nil {
+		err = os.Remove(s.indexPath)
+		if os.IsNotExist(err) {
+			err = nil
+		}
+	} else {
+		err = os.WriteFile(s.indexPath, s.index, 0644)
+	}
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to restore the repository index"), Cause: err}
+	}
+
+	// restore the working tree files
+	return restoreWorktreeFiles(s.files)
+}
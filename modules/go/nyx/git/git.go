@@ -19,17 +19,65 @@ This is the Git package for Nyx, encapsulating the underlying Git implementation
 */
 package git
 
+import (
+	"context" // https://pkg.go.dev/context
+
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
 /*
 The entry point to the Git local and remote service. This is also the main entry point to retrieve Repository instances
 */
 type Git struct {
+	// The options of the repositories opened or cloned through this instance.
+	options repositoryOptions
 }
 
 /*
 Returns an instance using default options.
 */
 func GitInstance() Git {
-	return Git{}
+	// the default options are always valid
+	options, _ := newRepositoryOptions(Options{})
+	return Git{options: options}
+}
+
+/*
+Returns an instance using the given options, which only apply to the repositories opened or cloned through the
+returned instance (and the remote references it lists) so that instances with different options can be used at
+the same time.
+
+Arguments are as follows:
+
+- options the options to use
+
+Errors can be:
+
+- IllegalArgumentError if any of the given options is not valid
+*/
+func GitInstanceWith(options Options) (Git, error) {
+	repositoryOptions, err := newRepositoryOptions(options)
+	if err != nil {
+		return Git{}, err
+	}
+	return Git{options: repositoryOptions}, nil
+}
+
+/*
+Returns a copy of this instance whose operations connecting to a remote (cloning and listing the remote references)
+are bounded by the given context, so that they fail as soon as the context is canceled or its deadline expires.
+The context also bounds the operations connecting to a remote of the repositories opened or cloned through the
+returned instance (see Repository.WithContext). The timeout in the options (see Options.Timeout) still applies to
+each single operation.
+
+Arguments are as follows:
+
+- ctx the context bounding the operations connecting to a remote. When nil operations are only bounded by the timeout.
+*/
+func (g Git) WithContext(ctx context.Context) Git {
+	g.options.context = ctx
+	return g
 }
 
 /*
@@ -47,7 +95,7 @@ Errors can be:
 - GitError in case the operation fails for some reason, including when authentication fails
 */
 func (g Git) Clone(directory *string, uri *string) (Repository, error) {
-	return clone(directory, uri)
+	return g.options.withBackend(g.options.clone(directory, uri))
 }
 
 /*
@@ -58,10 +106,10 @@ Arguments are as follows:
 - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
 - uri the URI of the remote repository to clone.
 - user the user name to use when credentials are required. If this and password are both nil
-  then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+  then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
   this value may be the token or something other than a token, depending on the remote provider.
 - password the password to use when credentials are required. If this and user are both nil
-  then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+  then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
   this value may be the token or something other than a token, depending on the remote provider.
 
 Errors can be:
@@ -71,7 +119,7 @@ Errors can be:
 - GitError in case the operation fails for some reason, including when authentication fails
 */
 func (g Git) CloneWithUserNameAndPassword(directory *string, uri *string, user *string, password *string) (Repository, error) {
-	return cloneWithUserNameAndPassword(directory, uri, user, password)
+	return g.options.withBackend(g.options.cloneWithUserNameAndPassword(directory, uri, user, password))
 }
 
 /*
@@ -81,8 +129,57 @@ Arguments are as follows:
 
 - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
 - uri the URI of the remote repository to clone.
-- privateKey the SSH private key. If nil the private key will be searched in its default location
-  (i.e. in the users' $HOME/.ssh directory).
+- branch the name of the branch to check out after cloning. If nil the remote default branch is checked out.
+- user the user name to use when credentials are required. If this and password are both nil
+  then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+  this value may be the token or something other than a token, depending on the remote provider.
+- password the password to use when credentials are required. If this and user are both nil
+  then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+  this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- NilPointerError if any of the required objects is nil
+- IllegalArgumentError if a given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (g Git) CloneBranchWithUserNameAndPassword(directory *string, uri *string, branch *string, user *string, password *string) (Repository, error) {
+	return g.options.withBackend(g.options.cloneBranchWithUserNameAndPassword(directory, uri, branch, user, password))
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository: GitHub expects the token as the user name, GitLab as the password along with the 'oauth2'
+user name and Bitbucket as the password along with the 'x-token-auth' user name.
+
+Arguments are as follows:
+
+- directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+- uri the URI of the remote repository to clone.
+- branch the name of the branch to check out after cloning. If nil the remote default branch is checked out.
+- token the token to authenticate with
+- user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if any of the required objects is nil
+- IllegalArgumentError if a given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (g Git) CloneBranchWithToken(directory *string, uri *string, branch *string, token *string, user *string) (Repository, error) {
+	return g.options.withBackend(g.options.cloneBranchWithToken(directory, uri, branch, token, user))
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+
+Arguments are as follows:
+
+- directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+- uri the URI of the remote repository to clone.
+- privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+  (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
 - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
   This is required when the private key is password protected as this implementation does not support prompting
   the user interactively for entering the password.
@@ -94,11 +191,64 @@ Errors can be:
 - GitError in case the operation fails for some reason, including when authentication fails
 */
 func (g Git) CloneWithPublicKey(directory *string, uri *string, privateKey *string, passphrase *string) (Repository, error) {
-	return cloneWithPublicKey(directory, uri, privateKey, passphrase)
+	return g.options.withBackend(g.options.cloneWithPublicKey(directory, uri, privateKey, passphrase))
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+
+Arguments are as follows:
+
+- directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+- uri the URI of the remote repository to clone.
+- branch the name of the branch to check out after cloning. If nil the remote default branch is checked out.
+- privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+  (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  This is required when the private key is password protected as this implementation does not support prompting
+  the user interactively for entering the password.
+
+Errors can be:
+
+- NilPointerError if any of the required objects is nil
+- IllegalArgumentError if a given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (g Git) CloneBranchWithPublicKey(directory *string, uri *string, branch *string, privateKey *string, passphrase *string) (Repository, error) {
+	return g.options.withBackend(g.options.cloneBranchWithPublicKey(directory, uri, branch, privateKey, passphrase))
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+
+Arguments are as follows:
+
+- directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+- uri the URI of the remote repository to clone.
+- branch the name of the branch to check out after cloning. If nil the remote default branch is checked out.
+- privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+  (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  This is required when the private key is password protected as this implementation does not support prompting
+  the user interactively for entering the password.
+- knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+  If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+- strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+  in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- NilPointerError if any of the required objects is nil
+- IllegalArgumentError if a given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (g Git) CloneBranchWithPublicKeyAndHostKeys(directory *string, uri *string, branch *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (Repository, error) {
+	return g.options.withBackend(g.options.cloneBranchWithPublicKeyAndHostKeys(directory, uri, branch, privateKey, passphrase, knownHosts, strictHostKeyChecking))
 }
 
 /*
-Returns a repository instance working in the given directory.
+Returns a repository instance working in the given directory. The directory may also be a bare repository
+(i.e. a mirror), in which case the operations requiring a working tree are not available.
 
 Arguments are as follows:
 
@@ -110,5 +260,234 @@ Errors can be:
 - GitError in case the operation fails for some reason, including when authentication fails
 */
 func (g Git) Open(directory string) (Repository, error) {
-	return open(directory)
+	if g.options.backend == CLI_BACKEND {
+		return g.options.openCLIRepository(directory)
+	}
+	return g.options.open(directory)
+}
+
+/*
+Returns a read only repository instance with no local clone, reading the commit history and the tags from the
+APIs of the given service. Operations changing the repository (staging, committing, tagging and pushing) are
+not supported by the returned instance.
+
+Arguments are as follows:
+
+- service the service to read the repository from. The repository owner and name are taken from the service options.
+- branch the name of the branch to read the history from. When nil the repository default branch is used.
+
+Errors can be:
+
+- NilPointerError if the service is nil
+- GitError in case the default branch can't be read from the service
+*/
+func (g Git) OpenRemote(service svcapi.CommitHistoryService, branch *string) (Repository, error) {
+	repository, err := openRemoteRepository(service, branch)
+	if err != nil {
+		return nil, err
+	}
+	return repository, nil
+}
+
+/*
+Returns the tags of the remote repository at the given URI, sorted by name, without cloning or fetching anything
+(like 'git ls-remote --tags'). This is useful to check the tags on the remote when the local repository is missing
+or doesn't have the full history.
+
+The remote only advertises the object each tag points to so the returned tags are never marked as annotated
+and, for annotated tags, the target is the ID of the tag object rather than the ID of the tagged commit.
+This method allows using user name and password authentication (also used for tokens).
+
+Arguments are as follows:
+
+  - uri the URI of the remote repository.
+  - user the user name to use when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to use when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- NilPointerError if any of the required objects is nil
+- IllegalArgumentError if the given URI is blank
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (g Git) ListRemoteTagsWithUserNameAndPassword(uri *string, user *string, password *string) ([]gitent.Tag, error) {
+	references, err := g.options.listRemoteReferencesWithUserNameAndPassword(uri, user, password)
+	if err != nil {
+		return nil, err
+	}
+	return getRemoteTags(references), nil
+}
+
+/*
+Returns the tags of the remote repository at the given URI, sorted by name, without cloning or fetching anything
+(like 'git ls-remote --tags'). This is useful to check the tags on the remote when the local repository is missing
+or doesn't have the full history.
+
+The remote only advertises the object each tag points to so the returned tags are never marked as annotated
+and, for annotated tags, the target is the ID of the tag object rather than the ID of the tagged commit.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository: GitHub expects the token as the user name, GitLab as the password along with the 'oauth2'
+user name and Bitbucket as the password along with the 'x-token-auth' user name.
+
+Arguments are as follows:
+
+  - uri the URI of the remote repository.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if any of the required objects is nil
+- IllegalArgumentError if the given URI is blank
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (g Git) ListRemoteTagsWithToken(uri *string, token *string, user *string) ([]gitent.Tag, error) {
+	references, err := g.options.listRemoteReferencesWithToken(uri, token, user)
+	if err != nil {
+		return nil, err
+	}
+	return getRemoteTags(references), nil
+}
+
+/*
+Returns the tags of the remote repository at the given URI, sorted by name, without cloning or fetching anything
+(like 'git ls-remote --tags'). This is useful to check the tags on the remote when the local repository is missing
+or doesn't have the full history.
+
+The remote only advertises the object each tag points to so the returned tags are never marked as annotated
+and, for annotated tags, the target is the ID of the tag object rather than the ID of the tagged commit.
+This method allows using SSH authentication.
+
+Arguments are as follows:
+
+  - uri the URI of the remote repository.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- NilPointerError if any of the required objects is nil
+- IllegalArgumentError if the given URI is blank
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (g Git) ListRemoteTagsWithPublicKeyAndHostKeys(uri *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) ([]gitent.Tag, error) {
+	references, err := g.options.listRemoteReferencesWithPublicKeyAndHostKeys(uri, privateKey, passphrase, knownHosts, strictHostKeyChecking)
+	if err != nil {
+		return nil, err
+	}
+	return getRemoteTags(references), nil
+}
+
+/*
+Returns the branches of the remote repository at the given URI, without cloning or fetching anything
+(like 'git ls-remote --heads'). The returned map has the branch names as keys and the SHA-1 of the commits
+they point to as values.
+This method allows using user name and password authentication (also used for tokens).
+
+Arguments are as follows:
+
+  - uri the URI of the remote repository.
+  - user the user name to use when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to use when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- NilPointerError if any of the required objects is nil
+- IllegalArgumentError if the given URI is blank
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (g Git) ListRemoteBranchesWithUserNameAndPassword(uri *string, user *string, password *string) (map[string]string, error) {
+	references, err := g.options.listRemoteReferencesWithUserNameAndPassword(uri, user, password)
+	if err != nil {
+		return nil, err
+	}
+	return getRemoteBranches(references), nil
+}
+
+/*
+Returns the branches of the remote repository at the given URI, without cloning or fetching anything
+(like 'git ls-remote --heads'). The returned map has the branch names as keys and the SHA-1 of the commits
+they point to as values.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository: GitHub expects the token as the user name, GitLab as the password along with the 'oauth2'
+user name and Bitbucket as the password along with the 'x-token-auth' user name.
+
+Arguments are as follows:
+
+  - uri the URI of the remote repository.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if any of the required objects is nil
+- IllegalArgumentError if the given URI is blank
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (g Git) ListRemoteBranchesWithToken(uri *string, token *string, user *string) (map[string]string, error) {
+	references, err := g.options.listRemoteReferencesWithToken(uri, token, user)
+	if err != nil {
+		return nil, err
+	}
+	return getRemoteBranches(references), nil
+}
+
+/*
+Returns the branches of the remote repository at the given URI, without cloning or fetching anything
+(like 'git ls-remote --heads'). The returned map has the branch names as keys and the SHA-1 of the commits
+they point to as values.
+This method allows using SSH authentication.
+
+Arguments are as follows:
+
+  - uri the URI of the remote repository.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- NilPointerError if any of the required objects is nil
+- IllegalArgumentError if the given URI is blank
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (g Git) ListRemoteBranchesWithPublicKeyAndHostKeys(uri *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (map[string]string, error) {
+	references, err := g.options.listRemoteReferencesWithPublicKeyAndHostKeys(uri, privateKey, passphrase, knownHosts, strictHostKeyChecking)
+	if err != nil {
+		return nil, err
+	}
+	return getRemoteBranches(references), nil
+}
+
+/*
+Returns true if the given URI uses the SSH protocol, either in the URL form (i.e. 'ssh://git@github.com/owner/repo.git',
+also accepting the 'git+ssh://' and 'ssh+git://' schemes) or in the scp-like form (i.e. 'git@github.com:owner/repo.git').
+
+Arguments are as follows:
+
+- uri the URI to check
+*/
+func (g Git) IsSSHURI(uri string) bool {
+	return isSSHURI(uri)
 }
@@ -19,27 +19,60 @@ package git
 import (
 	"bufio"         // https://pkg.go.dev/bufio
 	"bytes"         // https://pkg.go.dev/bytes
+	"context"       // https://pkg.go.dev/context
+	"crypto/sha1"   // https://pkg.go.dev/crypto/sha1
+	"encoding/hex"  // https://pkg.go.dev/encoding/hex
 	"fmt"           // https://pkg.go.dev/fmt
+	"net/http"      // https://pkg.go.dev/net/http
+	"net/url"       // https://pkg.go.dev/net/url
 	"os"            // https://pkg.go.dev/os
 	"os/exec"       // https://pkg.go.dev/os/exec
 	"path/filepath" // https://pkg.go.dev/filepath
+	"runtime"       // https://pkg.go.dev/runtime
+	"sort"          // https://pkg.go.dev/sort
 	"strings"       // https://pkg.go.dev/strings
-
-	ggit "github.com/go-git/go-git/v5"                                // https://pkg.go.dev/github.com/go-git/go-git/v5
-	ggitconfig "github.com/go-git/go-git/v5/config"                   // https://pkg.go.dev/github.com/go-git/go-git/v5
-	ggitplumbing "github.com/go-git/go-git/v5/plumbing"               // https://pkg.go.dev/github.com/go-git/go-git/v5
-	gitignore "github.com/go-git/go-git/v5/plumbing/format/gitignore" // https://pkg.go.dev/github.com/go-git/go-git/v5
-	ggitobject "github.com/go-git/go-git/v5/plumbing/object"          // https://pkg.go.dev/github.com/go-git/go-git/v5
-	ggittransport "github.com/go-git/go-git/v5/plumbing/transport"    // https://pkg.go.dev/github.com/go-git/go-git/v5
-	ggithttp "github.com/go-git/go-git/v5/plumbing/transport/http"    // https://pkg.go.dev/github.com/go-git/go-git/v5
-	ggitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"      // https://pkg.go.dev/github.com/go-git/go-git/v5
-	log "github.com/sirupsen/logrus"                                  // https://pkg.go.dev/github.com/sirupsen/logrus
-	ssh "golang.org/x/crypto/ssh"                                     // https://pkg.go.dev/golang.org/x/crypto/ssh
+	"sync"          // https://pkg.go.dev/sync
+	"time"          // https://pkg.go.dev/time
+
+	openpgp "github.com/ProtonMail/go-crypto/openpgp"                  // https://pkg.go.dev/github.com/ProtonMail/go-crypto/openpgp
+	ggit "github.com/go-git/go-git/v5"                                 // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggitconfig "github.com/go-git/go-git/v5/config"                    // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggitplumbing "github.com/go-git/go-git/v5/plumbing"                // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggitdiff "github.com/go-git/go-git/v5/plumbing/format/diff"        // https://pkg.go.dev/github.com/go-git/go-git/v5
+	gitignore "github.com/go-git/go-git/v5/plumbing/format/gitignore"  // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggitindex "github.com/go-git/go-git/v5/plumbing/format/index"      // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggitobject "github.com/go-git/go-git/v5/plumbing/object"           // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggittransport "github.com/go-git/go-git/v5/plumbing/transport"     // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggitclient "github.com/go-git/go-git/v5/plumbing/transport/client" // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggithttp "github.com/go-git/go-git/v5/plumbing/transport/http"     // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"       // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggitfilesystem "github.com/go-git/go-git/v5/storage/filesystem"    // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggitmemory "github.com/go-git/go-git/v5/storage/memory"            // https://pkg.go.dev/github.com/go-git/go-git/v5
+	log "github.com/sirupsen/logrus"                                   // https://pkg.go.dev/github.com/sirupsen/logrus
+	ssh "golang.org/x/crypto/ssh"                                      // https://pkg.go.dev/golang.org/x/crypto/ssh
+	httpproxy "golang.org/x/net/http/httpproxy"                        // https://pkg.go.dev/golang.org/x/net/http/httpproxy
 
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
 )
 
+const (
+	// The name of the environment variable holding the path to the socket of the running SSH agent.
+	SSH_AUTH_SOCK_ENVIRONMENT_VARIABLE = "SSH_AUTH_SOCK"
+
+	// The user to authenticate with on SSH remotes when the remote URI doesn't specify one.
+	DEFAULT_SSH_USER = "git"
+
+	// The name of the environment variable overriding the path to the netrc file.
+	NETRC_ENVIRONMENT_VARIABLE = "NETRC"
+
+	// The user name GitLab expects along with OAuth and Personal Access Tokens passed as the password.
+	GITLAB_TOKEN_USER = "oauth2"
+
+	// The user name Bitbucket expects along with access tokens passed as the password.
+	BITBUCKET_TOKEN_USER = "x-token-auth"
+)
+
 var (
 	// This flag tells if we already emitted the warning about the workaround documented at https://github.com/mooltiverse/nyx/issues/130
 	// This warning is only needed for the workaround at https://github.com/mooltiverse/nyx/issues/130 so this variable can be
@@ -65,748 +98,4178 @@ type goGitRepository struct {
 
 	// The private instance of the underlying Git object.
 	repository *ggit.Repository
+
+	// True when the repository is bare and has no working tree.
+	bare bool
+
+	// The options of the repository.
+	options repositoryOptions
 }
 
 /*
-Builds the instance using the given backing object.
+Builds the instance using the given backing object and these options.
 */
 // TODO: remove the 'directory' attribute when https://github.com/mooltiverse/nyx/issues/130 is fixed
-func newGoGitRepository(directory string, repository *ggit.Repository) (goGitRepository, error) {
+func (o repositoryOptions) newGoGitRepository(directory string, repository *ggit.Repository) (goGitRepository, error) {
 	if repository == nil {
 		return goGitRepository{}, &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "repository")}
 	}
 
+	// go-git can only read repositories using the SHA-1 object format
+	hashAlgorithm, err := hashAlgorithmOf(repository)
+	if err != nil {
+		return goGitRepository{}, err
+	}
+	if gitent.SHA1 != hashAlgorithm {
+		return goGitRepository{}, &errs.GitError{Message: fmt.Sprintf("the Git repository in directory '%s' uses the '%s' object format, which is not supported by the '%s' backend. Please use the '%s' backend instead", directory, hashAlgorithm.String(), GO_GIT_BACKEND, CLI_BACKEND)}
+	}
+
 	gitRepository := goGitRepository{}
 	gitRepository.directory = directory
 	gitRepository.repository = repository
+	gitRepository.options = o
+	_, err = repository.Worktree()
+	gitRepository.bare = err == ggit.ErrIsBareRepository
 	return gitRepository, nil
 }
 
 /*
-Returns a new basic authentication method object using the given user name and password.
+Returns the hash algorithm (object format) used by the given repository, as it's configured by the
+'extensions.objectFormat' option, defaulting to SHA-1 when the option is not set.
 
-Returns nil if both the given credentials are nil.
+Errors can be:
 
-  - user the user name to use when credentials are required. It may be nil.
-    When using single token authentication (i.e. OAuth or Personal Access Tokens)
-    this value may be the token or something other than a token, depending on the remote provider.
-  - password the password to use when credentials are required. It may be nil.
-    When using single token authentication (i.e. OAuth or Personal Access Tokens)
-    this value may be the token or something other than a token, depending on the remote provider.
+- GitError in case the repository configuration can't be read or the object format is unknown.
 */
-func getBasicAuth(user *string, password *string) ggittransport.AuthMethod {
-	if user == nil && password == nil {
-		return nil
-	} else if user != nil && password == nil {
-		return &ggithttp.BasicAuth{Username: *user}
-	} else if user == nil && password != nil {
-		return &ggithttp.BasicAuth{Password: *password}
-	} else {
-		return &ggithttp.BasicAuth{Username: *user, Password: *password}
+func hashAlgorithmOf(repository *ggit.Repository) (gitent.HashAlgorithm, error) {
+	config, err := repository.Config()
+	if err != nil {
+		return gitent.SHA1, &errs.GitError{Message: fmt.Sprintf("unable to read the repository configuration"), Cause: err}
+	}
+	objectFormat := config.Raw.Section("extensions").Option("objectformat")
+	if "" == objectFormat {
+		return gitent.SHA1, nil
+	}
+	hashAlgorithm, err := gitent.ValueOfHashAlgorithm(objectFormat)
+	if err != nil {
+		return gitent.SHA1, &errs.GitError{Message: fmt.Sprintf("unknown repository object format '%s'", objectFormat), Cause: err}
 	}
+	return hashAlgorithm, nil
 }
 
 /*
-Returns a new public key authentication method object using the given private key and passphrase.
+Returns the function that selects the proxy to use for a given request URL, or nil when no proxy has to be used.
 
-Returns nil if both the given credentials are nil.
+When the given proxy is nil or empty the proxy is read from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+variables (or their lowercase versions), otherwise the given proxy is used for both HTTP and HTTPS URLs, while
+the NO_PROXY environment variable is still honored to exclude some hosts.
 
-  - privateKey the SSH private key. If nil the private key will be searched in its default location
-    (i.e. in the users' $HOME/.ssh directory).
-  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
-    This is required when the private key is password protected as this implementation does not support prompting
-    the user interactively for entering the password.
-*/
-func getPublicKeyAuth(privateKey *string, passphrase *string) ggittransport.AuthMethod {
-	if privateKey != nil && "" != *privateKey {
-		keyPassword := ""
-		if passphrase != nil {
-			keyPassword = *passphrase
-		}
-		// The key name is not relevant for us but it seems it must be 'git' for the underlying library,
-		// as per https://github.com/src-d/go-git/issues/637
-		publicKeys, err := ggitssh.NewPublicKeys("git", []byte(*privateKey), keyPassword)
-		if err != nil {
-			log.Errorf("cannot instantiate a PublicKeys object using the provided private key: %v", err)
-			return nil
-		}
+Arguments are as follows:
 
-		// disable host key checking
-		publicKeys.HostKeyCallback = ssh.InsecureIgnoreHostKey()
+- proxy the optional URL of the proxy (i.e. http://proxy.example.com:3128). It may contain credentials.
 
-		return publicKeys
-	} else {
-		// If no private key is passed just return a default auth method so that go-git will just load keys from their default
-		// location (~/.ssh) and connect to ssh-agent (if available) by simply recognizing the remote repository
-		// URL format (which must be SSH). See https://github.com/src-d/go-git/issues/550#issuecomment-323078245
-		// Some other sources sugges to just return nil for using the default SSH client and settings
-		log.Debugf("trying to instantiate a DefaultAuthBuilder for public key authentication, connecting to ssh-agent/Pageant")
-		authBuilder, err := ggitssh.DefaultAuthBuilder("keymaster")
-		if err != nil {
-			log.Debugf("cannot instantiate a DefaultAuthBuilder for public key authentication, probably due to the service not being available: %v", err)
-			return nil
+Errors can be:
+
+- IllegalArgumentError if the given proxy is not a valid URL
+*/
+func getProxyFunc(proxy *string) (func(*url.URL) (*url.URL, error), error) {
+	config := httpproxy.FromEnvironment()
+	if proxy != nil && "" != strings.TrimSpace(*proxy) {
+		proxyURL, err := url.Parse(strings.TrimSpace(*proxy))
+		if err != nil || proxyURL.Host == "" {
+			return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("the proxy '%s' is not a valid URL", *proxy), Cause: err}
 		}
-		return authBuilder
+		config.HTTPProxy = proxyURL.String()
+		config.HTTPSProxy = proxyURL.String()
 	}
+	return config.ProxyFunc(), nil
 }
 
 /*
-Returns a repository instance working in the given directory after cloning from the given URI.
-
-Arguments are as follows:
+The key of the context value bringing the options of the HTTP and HTTPS transports to the requests sent for an
+operation connecting to a remote (see repositoryOptions.remoteContext).
+*/
+type httpTransportOptionsKey struct{}
 
-- directory the directory where the repository has to be cloned. It is created if it doesn't exist.
-- uri the URI of the remote repository to clone.
+/*
+The options of the HTTP and HTTPS transports for the requests sent for an operation connecting to a remote.
+*/
+type httpTransportOptions struct {
+	// The function selecting the proxy for a given request URL, as returned by getProxyFunc.
+	proxyFunc func(*url.URL) (*url.URL, error)
 
-Errors can be:
+	// The extra headers to add to requests.
+	headers map[string]string
+}
 
-- NilPointerError if any of the given objects is nil
-- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
-- GitError in case the operation fails for some reason, including when authentication fails
+/*
+Returns the options of the HTTP and HTTPS transports brought by the context of the given request or the default
+options, reading the proxy from the environment and adding no extra headers, when the context brings none.
 */
-func clone(directory *string, uri *string) (goGitRepository, error) {
-	if directory == nil {
-		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null directory"}
-	}
-	if uri == nil {
-		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null URI"}
-	}
-	if "" == strings.TrimSpace(*directory) {
-		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank directory"}
-	}
-	if "" == strings.TrimSpace(*uri) {
-		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank URI"}
+func getHTTPTransportOptions(request *http.Request) httpTransportOptions {
+	options, ok := request.Context().Value(httpTransportOptionsKey{}).(httpTransportOptions)
+	if !ok || options.proxyFunc == nil {
+		// the proxy function never fails without a given proxy
+		options.proxyFunc, _ = getProxyFunc(nil)
 	}
+	return options
+}
 
-	log.Debugf("cloning repository in directory '%s' from URI '%s'", *directory, *uri)
+/*
+An HTTP round tripper that adds the extra headers brought by the request context (see getHTTPTransportOptions) to all
+requests before delegating them to another round tripper. Headers set by this round tripper replace those with the
+same name already set in requests, including the Authorization header set by the authentication method.
+*/
+type headersRoundTripper struct {
+	// The round tripper requests are delegated to.
+	delegate http.RoundTripper
+}
 
-	options := &ggit.CloneOptions{URL: *uri}
-	repository, err := ggit.PlainClone(*directory, false, options)
-	if err != nil {
-		return goGitRepository{}, &errs.GitError{Message: fmt.Sprintf("unable to clone the '%s' repository into '%s'", *uri, *directory), Cause: err}
+/*
+Adds the extra headers to a copy of the given request and sends it using the delegate round tripper.
+*/
+func (rt headersRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	headers := getHTTPTransportOptions(request).headers
+	if len(headers) == 0 {
+		return rt.delegate.RoundTrip(request)
+	}
+	// round trippers must not modify the original request
+	request = request.Clone(request.Context())
+	for name, value := range headers {
+		request.Header.Set(name, value)
 	}
+	return rt.delegate.RoundTrip(request)
+}
+
+/*
+Makes sure the HTTP and HTTPS transports are only installed once.
+*/
+var installHTTPTransportOnce sync.Once
 
-	return newGoGitRepository(*directory, repository)
+/*
+Installs the HTTP and HTTPS transports used for all the remote operations (i.e. cloning and pushing), if not already
+installed. go-git only supports one transport for each protocol in the whole process so the transports read the
+proxy and the extra headers from the context of each request, which brings the options of the repository performing
+the operation (see repositoryOptions.remoteContext).
+*/
+func installHTTPTransport() {
+	installHTTPTransportOnce.Do(func() {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = func(request *http.Request) (*url.URL, error) {
+			return getHTTPTransportOptions(request).proxyFunc(request.URL)
+		}
+		client := ggithttp.NewClient(&http.Client{Transport: headersRoundTripper{delegate: transport}})
+		ggitclient.InstallProtocol("http", client)
+		ggitclient.InstallProtocol("https", client)
+	})
 }
 
 /*
-Returns a repository instance working in the given directory after cloning from the given URI.
+Returns the extra headers to add to all the requests sent by the HTTP and HTTPS transports, after validating them.
 
 Arguments are as follows:
 
-  - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
-  - uri the URI of the remote repository to clone.
-  - user the user name to use when credentials are required. If this and password are both nil
-    then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
-    this value may be the token or something other than a token, depending on the remote provider.
-  - password the password to use when credentials are required. If this and user are both nil
-    then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
-    this value may be the token or something other than a token, depending on the remote provider.
+  - headers the extra headers, by name. Headers with blank names are ignored. When nil or empty no extra header
+    is added to requests.
 
 Errors can be:
 
-- NilPointerError if any of the given objects is nil
-- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
-- GitError in case the operation fails for some reason, including when authentication fails
+- IllegalArgumentError if a header name or value is not valid
 */
-func cloneWithUserNameAndPassword(directory *string, uri *string, user *string, password *string) (goGitRepository, error) {
-	if directory == nil {
-		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null directory"}
+func parseHeaders(headers map[string]string) (map[string]string, error) {
+	validHeaders := make(map[string]string)
+	for name, value := range headers {
+		name = strings.TrimSpace(name)
+		if "" == name {
+			continue
+		}
+		if strings.ContainsAny(name, " :\r\n") || strings.ContainsAny(value, "\r\n") {
+			return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("the HTTP header '%s' is not valid", name)}
+		}
+		validHeaders[name] = value
 	}
-	if uri == nil {
-		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null URI"}
+	if len(validHeaders) > 0 {
+		log.Debugf("adding %d extra headers to requests sent to HTTP and HTTPS remotes", len(validHeaders))
 	}
-	if "" == strings.TrimSpace(*directory) {
-		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank directory"}
+	return validHeaders, nil
+}
+
+/*
+Clones the repository into the given directory using the given options, creating a bare mirror instead of a
+regular clone when the mirror option applied to all clone operations is set.
+
+Just like git, the remote of the new repository is configured with the given URI, not the one it may be rewritten to
+by the 'insteadOf' rules (see rewriteURI), so that the rules keep applying to later operations.
+
+Arguments are as follows:
+
+  - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+  - uri the URI of the remote repository to clone, as given by the caller.
+  - options the clone options, as returned by getCloneOptions, along with the authentication method.
+*/
+func (o repositoryOptions) plainClone(directory string, uri string, options *ggit.CloneOptions) (*ggit.Repository, error) {
+	repository, err := o.plainCloneRewritten(directory, options)
+	if err != nil || normalizeURI(uri) == options.URL {
+		return repository, err
 	}
-	if "" == strings.TrimSpace(*uri) {
-		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank URI"}
+	config, err := repository.Config()
+	if err != nil {
+		return nil, err
 	}
+	config.Remotes[DEFAULT_REMOTE_NAME].URLs = []string{normalizeURI(uri)}
+	return repository, repository.SetConfig(config)
+}
 
-	log.Debugf("cloning repository in directory '%s' from URI '%s' using username and password", *directory, *uri)
+/*
+Clones the repository into the given directory using the given options, just like plainClone, leaving the remote
+configured with the URL in the options. Each attempt fails when it takes longer than the timeout set for remote
+operations (see Options.Timeout) and attempts failing for transient reasons are retried (see withRetries).
+*/
+func (o repositoryOptions) plainCloneRewritten(directory string, options *ggit.CloneOptions) (*ggit.Repository, error) {
+	if !o.mirror {
+		var repository *ggit.Repository
+		err := o.withRetries(fmt.Sprintf("cloning '%s'", options.URL), func() error {
+			ctx, cancel := o.remoteContext()
+			defer cancel()
+			var err error
+			repository, err = ggit.PlainCloneContext(ctx, directory, false, options)
+			return err
+		})
+		return repository, err
+	}
 
-	options := &ggit.CloneOptions{URL: *uri}
-	auth := getBasicAuth(user, password)
-	if auth != nil {
-		log.Debugf("username and password authentication will use custom authentication options")
-		options.Auth = auth
-	} else {
-		log.Debugf("username and password authentication will not use any custom authentication options")
+	log.Debugf("cloning a bare mirror of '%s'", options.URL)
+	repository, err := ggit.PlainInit(directory, true)
+	if err != nil {
+		return nil, err
 	}
-	repository, err := ggit.PlainClone(*directory, false, options)
+	remote, err := repository.CreateRemote(&ggitconfig.RemoteConfig{Name: DEFAULT_REMOTE_NAME, URLs: []string{options.URL}, Fetch: []ggitconfig.RefSpec{"+refs/*:refs/*"}})
 	if err != nil {
-		return goGitRepository{}, &errs.GitError{Message: fmt.Sprintf("unable to clone the '%s' repository into '%s'", *uri, *directory), Cause: err}
+		return nil, err
+	}
+	err = o.fetchRemote(remote, &ggit.FetchOptions{RemoteName: DEFAULT_REMOTE_NAME, Auth: options.Auth, Tags: ggit.AllTags})
+	if err != nil && err != ggit.NoErrAlreadyUpToDate {
+		return nil, err
 	}
 
-	return newGoGitRepository(*directory, repository)
+	// point HEAD to the branch to check out or, when not given, to the remote default branch
+	head := options.ReferenceName
+	if "" == head.String() || ggitplumbing.HEAD == head {
+		head = ""
+		references, err := o.listRemote(remote, &ggit.ListOptions{Auth: options.Auth})
+		if err != nil {
+			return nil, err
+		}
+		for _, reference := range references {
+			if reference.Name() == ggitplumbing.HEAD && reference.Type() == ggitplumbing.SymbolicReference {
+				head = reference.Target()
+				break
+			}
+		}
+	}
+	if "" != head.String() {
+		log.Debugf("the HEAD of the mirror points to '%s'", head.String())
+		err = repository.Storer.SetReference(ggitplumbing.NewSymbolicReference(ggitplumbing.HEAD, head))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return repository, nil
 }
 
 /*
-Returns a repository instance working in the given directory after cloning from the given URI.
+Returns the options to clone the repository from the given URI and check out the given branch, using the options
+applied to all clone operations.
 
 Arguments are as follows:
 
-  - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
   - uri the URI of the remote repository to clone.
-  - privateKey the SSH private key. If nil the private key will be searched in its default location
-    (i.e. in the users' $HOME/.ssh directory).
-  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
-    This is required when the private key is password protected as this implementation does not support prompting
-    the user interactively for entering the password.
+  - branch the name of the branch to check out after cloning. If nil the remote default branch is checked out.
+*/
+func (o repositoryOptions) getCloneOptions(uri string, branch *string) *ggit.CloneOptions {
+	options := &ggit.CloneOptions{URL: normalizeURI(rewriteURI(globalConfigScopes(), uri, false)), SingleBranch: o.singleBranch}
+	if branch != nil && "" != strings.TrimSpace(*branch) {
+		log.Debugf("checking out branch '%s' after cloning", *branch)
+		options.ReferenceName = ggitplumbing.NewBranchReferenceName(*branch)
+	}
+	if o.singleBranch {
+		log.Debugf("only the branch to check out is fetched from '%s'", uri)
+	}
+	return options
+}
 
-Errors can be:
+/*
+Returns a new basic authentication method object using the given user name and password.
 
-- NilPointerError if any of the given objects is nil
-- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
-- GitError in case the operation fails for some reason, including when authentication fails
+When both the given credentials are nil the credentials are looked up in the netrc file for the host of the
+given URI, like native git and curl do. Returns nil if both the given credentials are nil and no netrc entry is
+available for the host.
+
+  - user the user name to use when credentials are required. It may be nil.
+    When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to use when credentials are required. It may be nil.
+    When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - uri the URI of the remote repository, used to look up netrc credentials. It may be empty.
 */
-func cloneWithPublicKey(directory *string, uri *string, privateKey *string, passphrase *string) (goGitRepository, error) {
-	if directory == nil {
-		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null directory"}
+func getBasicAuth(user *string, password *string, uri string) ggittransport.AuthMethod {
+	if user == nil && password == nil {
+		login, netrcPassword, found := getNetrcCredentials(uri)
+		if !found {
+			return nil
+		}
+		log.Debugf("using credentials from the netrc file for URI '%s'", uri)
+		return &ggithttp.BasicAuth{Username: login, Password: netrcPassword}
+	} else if user != nil && password == nil {
+		return &ggithttp.BasicAuth{Username: *user}
+	} else if user == nil && password != nil {
+		return &ggithttp.BasicAuth{Password: *password}
+	} else {
+		return &ggithttp.BasicAuth{Username: *user, Password: *password}
 	}
-	if uri == nil {
-		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null URI"}
+}
+
+/*
+Returns the user name and password to authenticate with the given token on the given remote URI, according to the
+conventions of the provider hosting the remote, detected from the URI host:
+
+  - GitHub (hosts named 'github' or having a 'github' domain label): the token is the user name and the password is empty
+  - GitLab (hosts named 'gitlab' or having a 'gitlab' domain label): the user name is GITLAB_TOKEN_USER and the token is the password
+  - Bitbucket (hosts named 'bitbucket' or having a 'bitbucket' domain label): the user name is BITBUCKET_TOKEN_USER and the token is the password
+
+When the provider can't be detected the token is used as the user name, like for GitHub, which is also accepted by
+many other providers.
+
+Arguments are as follows:
+
+  - token the token to authenticate with
+  - user an optional user name that, when not nil or empty, overrides the one detected from the provider, in
+    which case the token is always used as the password. This is useful for self hosted instances whose host
+    doesn't tell the provider.
+  - uri the URI of the remote repository
+*/
+func getTokenCredentials(token string, user *string, uri string) (string, string) {
+	if user != nil && "" != strings.TrimSpace(*user) {
+		return *user, token
 	}
-	if "" == strings.TrimSpace(*directory) {
-		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank directory"}
+	host := ""
+	endpoint, err := ggittransport.NewEndpoint(uri)
+	if err == nil && endpoint != nil {
+		host = strings.ToLower(endpoint.Host)
 	}
-	if "" == strings.TrimSpace(*uri) {
-		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank URI"}
+	for _, label := range strings.Split(host, ".") {
+		switch label {
+		case "gitlab":
+			log.Debugf("the token for URI '%s' is passed as the password using the GitLab conventions", uri)
+			return GITLAB_TOKEN_USER, token
+		case "bitbucket":
+			log.Debugf("the token for URI '%s' is passed as the password using the Bitbucket conventions", uri)
+			return BITBUCKET_TOKEN_USER, token
+		case "github":
+			log.Debugf("the token for URI '%s' is passed as the user name using the GitHub conventions", uri)
+			return token, ""
+		}
 	}
+	log.Debugf("the provider hosting URI '%s' can't be detected so the token is passed as the user name", uri)
+	return token, ""
+}
 
-	log.Debugf("cloning repository in directory '%s' from URI '%s' using public key (SSH) authentication", *directory, *uri)
+/*
+Returns the path of the netrc file. This is the path set by the NETRC environment variable, if any, otherwise
+the '.netrc' file (or '_netrc' on Windows, when '.netrc' doesn't exist) in the user home directory.
 
-	options := &ggit.CloneOptions{URL: *uri}
-	auth := getPublicKeyAuth(privateKey, passphrase)
-	if auth != nil {
-		log.Debugf("public key (SSH) authentication will use custom authentication options")
-		options.Auth = auth
-	} else {
-		log.Debugf("public key (SSH) authentication will not use any custom authentication options")
+Returns an empty string if the path can't be resolved.
+*/
+func getNetrcPath() string {
+	if netrc, ok := os.LookupEnv(NETRC_ENVIRONMENT_VARIABLE); ok && "" != strings.TrimSpace(netrc) {
+		path, err := expandHomeDirectory(strings.TrimSpace(netrc))
+		if err != nil {
+			return ""
+		}
+		return path
 	}
-	repository, err := ggit.PlainClone(*directory, false, options)
+	homeDirectory, err := os.UserHomeDir()
 	if err != nil {
-		return goGitRepository{}, &errs.GitError{Message: fmt.Sprintf("unable to clone the '%s' repository into '%s'", *uri, *directory), Cause: err}
+		return ""
 	}
-
-	// TODO: remove the 'directory' attribute when https://github.com/mooltiverse/nyx/issues/130 is fixed
-	return newGoGitRepository(*directory, repository)
+	path := filepath.Join(homeDirectory, ".netrc")
+	if runtime.GOOS == "windows" {
+		if _, err := os.Stat(path); err != nil {
+			return filepath.Join(homeDirectory, "_netrc")
+		}
+	}
+	return path
 }
 
 /*
-Returns a repository instance working in the given directory.
+Returns the login and password configured in the netrc file for the host of the given URI. The entry for the
+host ('machine') is used when available, otherwise the 'default' entry, if any. The last returned value is
+false when no entry is available, including when the netrc file doesn't exist or the URI is not an HTTP(S) URI.
 
 Arguments are as follows:
 
-- directory the directory where the repository is.
+- uri the URI of the remote repository
+*/
+func getNetrcCredentials(uri string) (string, string, bool) {
+	if "" == strings.TrimSpace(uri) {
+		return "", "", false
+	}
+	endpoint, err := ggittransport.NewEndpoint(uri)
+	if err != nil || endpoint == nil || (endpoint.Protocol != "http" && endpoint.Protocol != "https") {
+		return "", "", false
+	}
+	path := getNetrcPath()
+	if "" == path {
+		return "", "", false
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		log.Tracef("unable to read the netrc file '%s': %v", path, err)
+		return "", "", false
+	}
+	return parseNetrc(string(content), endpoint.Host)
+}
 
-Errors can be:
+/*
+Parses the given netrc content and returns the login and password for the given host. The entry for the
+host ('machine') is used when available, otherwise the 'default' entry, if any. The last returned value is
+false when no entry is available.
 
-- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
-- IOError in case of any I/O issue accessing the repository
+Arguments are as follows:
+
+- content the netrc file content
+- host the host name to look up
 */
-func open(directory string) (goGitRepository, error) {
-	if "" == strings.TrimSpace(directory) {
-		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank directory"}
+func parseNetrc(content string, host string) (string, string, bool) {
+	type netrcEntry struct {
+		login    string
+		password string
 	}
-	repository, err := ggit.PlainOpen(directory)
-	if err != nil {
-		return goGitRepository{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("unable to open Git repository in directory '%s'", directory), Cause: err}
+	var machineEntry, defaultEntry, current *netrcEntry
+	tokens := strings.Fields(content)
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i] {
+		case "machine":
+			current = nil
+			if i+1 < len(tokens) {
+				i++
+				if machineEntry == nil && tokens[i] == host {
+					machineEntry = &netrcEntry{}
+					current = machineEntry
+				}
+			}
+		case "default":
+			current = nil
+			if defaultEntry == nil {
+				defaultEntry = &netrcEntry{}
+				current = defaultEntry
+			}
+		case "login":
+			if i+1 < len(tokens) {
+				i++
+				if current != nil {
+					current.login = tokens[i]
+				}
+			}
+		case "password":
+			if i+1 < len(tokens) {
+				i++
+				if current != nil {
+					current.password = tokens[i]
+				}
+			}
+		case "account":
+			// the account is not used but its value must be skipped
+			i++
+		case "macdef":
+			// macro definitions run until the next blank line, which can't be told apart once split into fields,
+			// so stop parsing as macros are conventionally placed at the end of the file
+			current = nil
+			i = len(tokens)
+		}
 	}
-	// TODO: remove the 'directory' attribute when https://github.com/mooltiverse/nyx/issues/130 is fixed
-	return newGoGitRepository(directory, repository)
+	if machineEntry != nil {
+		return machineEntry.login, machineEntry.password, true
+	}
+	if defaultEntry != nil {
+		return defaultEntry.login, defaultEntry.password, true
+	}
+	return "", "", false
 }
 
 /*
-Resolves the commit with the given id using the repository object and returns it as a typed object.
+Returns the PEM encoded private key from the given value, which may be the key content itself or the path
+to the file containing the key. A leading '~' in the path is expanded to the user home directory.
 
-This method is an utility wrapper around CommitObject which never returns
-nil and throws GitError if the identifier cannot be resolved or any other error occurs.
+Values containing a PEM block are returned as they are, otherwise the value is read as a file path.
 
 Arguments are as follows:
 
-- id the commit identifier to resolve. It must be a long or abbreviated SHA-1 but not nil.
+- privateKey the private key content or the path to the private key file
 
 Errors can be:
 
-- GitError in case the given identifier cannot be resolved or any other issue is encountered
+- IOError in case the value is not a PEM block and the file can't be read
 */
-func (r goGitRepository) parseCommit(id string) (ggitobject.Commit, error) {
-	log.Tracef("parsing commit '%s'", id)
-	commit, err := r.repository.CommitObject(ggitplumbing.NewHash(id))
+func readPrivateKey(privateKey string) ([]byte, error) {
+	if strings.Contains(privateKey, "-----BEGIN ") {
+		return []byte(privateKey), nil
+	}
+	path, err := expandHomeDirectory(strings.TrimSpace(privateKey))
 	if err != nil {
-		return ggitobject.Commit{}, &errs.GitError{Message: fmt.Sprintf("the '%s' commit identifier cannot be resolved as there is no such commit.", id), Cause: err}
+		return nil, err
 	}
-	return *commit, nil
+	log.Debugf("reading the private key from file '%s'", path)
+	pemBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &errs.IOError{Message: fmt.Sprintf("the private key is not a PEM encoded key and can't be read as a file from '%s'", path), Cause: err}
+	}
+	return pemBytes, nil
 }
 
 /*
-Resolves the object with the given id in the repository.
-
-This method is an utility wrapper around ResolveRevision which never returns
-nil and returns GitError if the identifier cannot be resolved or any other error occurs.
+Returns the given path with the leading '~', if any, expanded to the user home directory.
 
 Arguments are as follows:
 
-  - id the object identifier to resolve. It can't be nil. If it's a SHA-1 it can be long or abbreviated.
-    For allowed values see ResolveRevision
+- path the path to expand
 
 Errors can be:
 
-- GitError in case the given identifier cannot be resolved or any other issue is encountered
+- IOError in case the user home directory can't be resolved
 */
-func (r goGitRepository) resolve(id string) (ggitplumbing.Hash, error) {
-	log.Tracef("resolving '%s'", id)
-
-	rev, err := r.repository.ResolveRevision(ggitplumbing.Revision(id))
-	if err != nil {
-		return ggitplumbing.Hash{}, &errs.GitError{Message: fmt.Sprintf("the '%s' identifier cannot be resolved", id), Cause: err}
-	}
-	if rev == nil {
-		if "HEAD" == id {
-			log.Warnf("Repository identifier '%s' cannot be resolved. This means that the repository has just been initialized and has no commits yet or the repository is in a 'detached HEAD' state. See the documentation to fix this.", "HEAD")
+func expandHomeDirectory(path string) (string, error) {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		homeDirectory, err := os.UserHomeDir()
+		if err != nil {
+			return "", &errs.IOError{Message: fmt.Sprintf("unable to resolve the user home directory to expand the path '%s'", path), Cause: err}
 		}
-		return ggitplumbing.Hash{}, &errs.GitError{Message: fmt.Sprintf("Identifier '%s' cannot be resolved", id)}
-	} else {
-		return ggitplumbing.NewHash(rev.String()), nil
+		return filepath.Join(homeDirectory, strings.TrimPrefix(path, "~")), nil
 	}
+	return path, nil
 }
 
 /*
+Returns the callback used to verify the keys of SSH hosts.
+
 Arguments are as follows:
 
-- paths the file patterns of the contents to add to stage. Cannot be nil or empty. The path "." represents
-all files in the working area so with that you can add all locally changed files.
+  - knownHosts the known host keys, either as the known_hosts formatted content (one or more lines like
+    'github.com ssh-ed25519 AAAA...') or as the path to a known_hosts file. If nil or blank the system known_hosts
+    files are used (the files in the SSH_KNOWN_HOSTS environment variable or, when not set, the user's
+    $HOME/.ssh/known_hosts and /etc/ssh/ssh_known_hosts).
+  - strictHostKeyChecking when false host keys are not verified at all and knownHosts is ignored. This is insecure and
+    should only be used for ephemeral environments, like CI containers, where no known_hosts is available.
 
 Errors can be:
 
-- GitError in case some problem is encountered with the underlying Git repository, preventing to add paths.
+- IOError in case the known hosts can't be read or parsed
 */
-func (r goGitRepository) Add(paths []string) error {
-	log.Debugf("adding contents to repository staging area")
-	if paths == nil || len(paths) == 0 {
-		return &errs.GitError{Message: fmt.Sprintf("cannot stage a nil or empty set of paths")}
+func getHostKeyCallback(knownHosts *string, strictHostKeyChecking bool) (ssh.HostKeyCallback, error) {
+	if !strictHostKeyChecking {
+		log.Warnf("SSH host key checking is disabled, the identity of remote hosts will not be verified")
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+	if knownHosts == nil || "" == strings.TrimSpace(*knownHosts) {
+		log.Debugf("verifying SSH host keys against the system known_hosts files")
+		callback, err := ggitssh.NewKnownHostsCallback()
+		if err != nil {
+			return nil, &errs.IOError{Message: "unable to load the system known_hosts files", Cause: err}
+		}
+		return callback, nil
 	}
 
-	worktree, err := r.repository.Worktree()
+	// known_hosts lines always contain spaces (between host patterns, key type and key) while paths are not expected to
+	value := strings.TrimSpace(*knownHosts)
+	if !strings.ContainsAny(value, " \t\n") {
+		path, err := expandHomeDirectory(value)
+		if err != nil {
+			return nil, err
+		}
+		log.Debugf("verifying SSH host keys against the known_hosts file '%s'", path)
+		callback, err := ggitssh.NewKnownHostsCallback(path)
+		if err != nil {
+			return nil, &errs.IOError{Message: fmt.Sprintf("unable to load the known_hosts file '%s'", path), Cause: err}
+		}
+		return callback, nil
+	}
+
+	// the underlying library only reads known hosts from files so the pinned keys are stored in a temporary file,
+	// which can be removed as soon as the callback is created
+	log.Debugf("verifying SSH host keys against the configured known hosts")
+	file, err := os.CreateTemp("", "nyx-known-hosts-")
 	if err != nil {
-		return &errs.GitError{Message: fmt.Sprintf("an error occurred when getting the current worktree for the repository"), Cause: err}
+		return nil, &errs.IOError{Message: "unable to create a temporary file for the known hosts", Cause: err}
 	}
-	// TODO: remove this workaround (before the 'for' statement) when https://github.com/mooltiverse/nyx/issues/219 is fixed
-	// The go-git library has a bug that sometimes does not obey with the .gitignore file so we use the
-	// workaround suggested here: https://github.com/go-git/go-git/issues/597#issuecomment-1301637889
-	// to read the .gitignore and programmatically add the paths to the Worktree Excludes.
-	// This workaround is here to cope with:
-	// - https://github.com/mooltiverse/nyx/issues/219
-	// as long as the go-git library doesn't fix the bug. Bugs to keep an eye on for a fix are:
-	// - https://github.com/go-git/go-git/issues/597
-	if _, err := os.Stat(filepath.Join(r.directory, ".gitignore")); err == nil {
-		if !workaround231WarningsEmitted {
-			log.Warnf("workaround #231: due to the underlying go-git library not obeying to the .gitignore files the .gitignore content is read and each item passed to the Worktree Excludes. For more see https://github.com/mooltiverse/nyx/issues/219")
-			// make sure we emit this warning only once
-			workaround231WarningsEmitted = true
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(value + "\n")
+	file.Close()
+	if err != nil {
+		return nil, &errs.IOError{Message: "unable to write the known hosts to a temporary file", Cause: err}
+	}
+	callback, err := ggitssh.NewKnownHostsCallback(file.Name())
+	if err != nil {
+		return nil, &errs.IOError{Message: "unable to parse the configured known hosts", Cause: err}
+	}
+	return callback, nil
+}
+
+/*
+Returns a new public key authentication method object using the given private key and passphrase.
+
+Returns nil if both the given credentials are nil and no SSH agent is available.
+
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys are
+    requested to the running SSH agent, reachable through the socket in the SSH_AUTH_SOCK environment variable.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - user the user to authenticate on the remote, only used when keys are requested to the SSH agent.
+    See getSSHUser() to infer the user from a remote URI.
+  - hostKeyCallback the callback used to verify the keys of SSH hosts. See getHostKeyCallback().
+*/
+func getPublicKeyAuth(privateKey *string, passphrase *string, user string, hostKeyCallback ssh.HostKeyCallback) ggittransport.AuthMethod {
+	if privateKey != nil && "" != *privateKey {
+		keyPassword := ""
+		if passphrase != nil {
+			keyPassword = *passphrase
 		}
-		gitIgnoreFile, err := os.Open(filepath.Join(r.directory, ".gitignore"))
-		defer gitIgnoreFile.Close()
+		pemBytes, err := readPrivateKey(*privateKey)
 		if err != nil {
-			return &errs.GitError{Message: fmt.Sprintf("unable to read .gitignore (needed for workaround https://github.com/mooltiverse/nyx/issues/219)"), Cause: err}
+			log.Errorf("cannot read the provided private key: %v", err)
+			return nil
 		}
-		gitIgnoreFileScanner := bufio.NewScanner(gitIgnoreFile)
-		gitIgnoreFileScanner.Split(bufio.ScanLines)
-		for gitIgnoreFileScanner.Scan() {
-			ignorePattern := gitIgnoreFileScanner.Text()
-			if !workaround231WarningsEmitted {
-				log.Debugf("add %s from .gitignore to ignore list (needed for workaround https://github.com/mooltiverse/nyx/issues/219)", ignorePattern)
-			}
-			worktree.Excludes = append(worktree.Excludes, gitignore.ParsePattern(ignorePattern, nil))
+		// The key name is not relevant for us but it seems it must be 'git' for the underlying library,
+		// as per https://github.com/src-d/go-git/issues/637
+		publicKeys, err := ggitssh.NewPublicKeys("git", pemBytes, keyPassword)
+		if err != nil {
+			log.Errorf("cannot instantiate a PublicKeys object using the provided private key: %v", err)
+			return nil
 		}
-		// End of the workaround
-	}
-	for _, path := range paths {
-		err := worktree.AddWithOptions(&ggit.AddOptions{All: true, Path: "", Glob: path})
+
+		publicKeys.HostKeyCallback = hostKeyCallback
+
+		return publicKeys
+	} else {
+		// If no private key is passed use the keys held by the running ssh-agent (or Pageant on Windows), if any,
+		// which is the standard setup on developer workstations and many CI runners
+		if "" == strings.TrimSpace(os.Getenv(SSH_AUTH_SOCK_ENVIRONMENT_VARIABLE)) {
+			log.Debugf("no private key has been provided and the %s environment variable is not set, no SSH agent will be used", SSH_AUTH_SOCK_ENVIRONMENT_VARIABLE)
+			return nil
+		}
+		log.Debugf("trying to connect to the SSH agent at '%s' for public key authentication as user '%s'", os.Getenv(SSH_AUTH_SOCK_ENVIRONMENT_VARIABLE), user)
+		agentAuth, err := ggitssh.NewSSHAgentAuth(user)
 		if err != nil {
-			return &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to add paths to the staging area"), Cause: err}
+			log.Debugf("cannot connect to the SSH agent for public key authentication, probably due to the service not being available: %v", err)
+			return nil
 		}
-	}
 
-	return nil
+		agentAuth.HostKeyCallback = hostKeyCallback
+
+		return agentAuth
+	}
 }
 
 /*
-Commits changes to the repository. Files to commit must be staged separately using Add.
+Returns the user to authenticate with on the given remote URI when using SSH, which is the one in the URI (i.e.
+'git' in 'git@github.com:owner/repo.git') or DEFAULT_SSH_USER when the URI has none or can't be parsed.
 
-- message the commit message. Cannot be nil.
-
-Errors can be:
+Arguments are as follows:
 
-- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+- uri the remote URI
 */
-func (r goGitRepository) CommitWithMessage(message *string) (gitent.Commit, error) {
-	return r.CommitWithMessageAndIdentities(message, nil, nil)
+func getSSHUser(uri string) string {
+	endpoint, err := ggittransport.NewEndpoint(normalizeURI(uri))
+	if err != nil || endpoint == nil || "" == endpoint.User {
+		return DEFAULT_SSH_USER
+	}
+	return endpoint.User
 }
 
 /*
-Commits changes to the repository. Files to commit must be staged separately using Add.
+Returns the given URI with the alternative SSH schemes supported by Git ('git+ssh://' and 'ssh+git://') replaced
+by the standard 'ssh://' scheme, which is the only one known to the underlying library. Other URIs, including
+scp-like URIs (i.e. 'git@github.com:owner/repo.git'), are returned unchanged.
 
 Arguments are as follows:
 
-- message the commit message. Cannot be nil.
-- author the object modelling the commit author informations. It may be nil, in which case the default
-for the repository will be used
-- committer the object modelling the committer informations. It may be nil, in which case the default
-for the repository will be used
-
-Errors can be:
-
-- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+- uri the remote URI
 */
-func (r goGitRepository) CommitWithMessageAndIdentities(message *string, author *gitent.Identity, committer *gitent.Identity) (gitent.Commit, error) {
-	log.Debugf("committing changes to repository")
-
-	if message == nil {
-		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("cannot commit with a nil message")}
+func normalizeURI(uri string) string {
+	trimmed := strings.TrimSpace(uri)
+	for _, scheme := range []string{"git+ssh://", "ssh+git://"} {
+		if len(trimmed) >= len(scheme) && strings.EqualFold(trimmed[:len(scheme)], scheme) {
+			return "ssh://" + trimmed[len(scheme):]
+		}
 	}
+	return uri
+}
 
-	worktree, err := r.repository.Worktree()
-	if err != nil {
-		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("an error occurred when getting the current worktree for the repository"), Cause: err}
-	}
-	var gAuthor *ggitobject.Signature = nil
-	var gCommitter *ggitobject.Signature = nil
-	if author != nil {
-		gAuthor = &ggitobject.Signature{Name: author.Name, Email: author.Email}
+/*
+Returns the given URI rewritten by the 'url.<base>.insteadOf' rules of the given Git configurations or, when pushing,
+by the 'url.<base>.pushInsteadOf' rules, which take precedence. Just like git, the rule with the longest prefix
+matching the URI is applied, replacing the prefix with the base URL. The URI is returned unchanged when no rule
+matches. go-git only applies the 'insteadOf' rules in the repository configuration, and only one per base URL, so
+URIs are rewritten here.
+
+Arguments are as follows:
+
+- scopes the configurations to read the rules from, as returned by configScopes or globalConfigScopes
+- uri the URI to rewrite
+- push true if the URI is used to push, false if it's used to clone or fetch
+*/
+func rewriteURI(scopes []*ggitconfig.Config, uri string, push bool) string {
+	keys := []string{"insteadOf"}
+	if push {
+		// the push rules are looked up first so that they take precedence
+		keys = []string{"pushInsteadOf", "insteadOf"}
 	}
-	if committer != nil {
-		gCommitter = &ggitobject.Signature{Name: committer.Name, Email: committer.Email}
+	for _, key := range keys {
+		base := ""
+		prefix := ""
+		for _, cfg := range scopes {
+			if cfg.Raw == nil || !cfg.Raw.HasSection("url") {
+				continue
+			}
+			for _, subsection := range cfg.Raw.Section("url").Subsections {
+				for _, value := range subsection.OptionAll(key) {
+					if "" != value && len(value) > len(prefix) && strings.HasPrefix(uri, value) {
+						base = subsection.Name
+						prefix = value
+					}
+				}
+			}
+		}
+		if "" != prefix {
+			log.Debugf("the URI '%s' is rewritten to '%s' by the '%s' rule '%s'", uri, base+uri[len(prefix):], key, prefix)
+			return base + uri[len(prefix):]
+		}
 	}
-	commitHash, err := worktree.Commit(*message, &ggit.CommitOptions{All: false, Author: gAuthor, Committer: gCommitter})
-	if err != nil {
-		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to commit"), Cause: err}
+	return uri
+}
+
+/*
+Returns the protocol used by the given URI, like 'ssh' for both 'ssh://' and scp-like URIs
+(i.e. 'git@github.com:owner/repo.git'), 'http', 'https' or 'file' for local paths. Returns an empty string
+if the URI can't be parsed.
+
+Arguments are as follows:
+
+- uri the remote URI
+*/
+func getURIProtocol(uri string) string {
+	if "" == strings.TrimSpace(uri) {
+		return ""
 	}
-	commit, err := r.repository.CommitObject(commitHash)
-	if err != nil {
-		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("an error occurred when retrieving the commit that has been created"), Cause: err}
+	endpoint, err := ggittransport.NewEndpoint(normalizeURI(uri))
+	if err != nil || endpoint == nil {
+		return ""
 	}
-	return CommitFrom(*commit, []gitent.Tag{}), nil
+	return strings.ToLower(endpoint.Protocol)
 }
 
 /*
-Adds the given files to the staging area and commits changes to the repository. This method is a shorthand
-for Add and CommitWithMessage.
+Returns true if the given URI uses the SSH protocol, either in the URL form (i.e. 'ssh://git@github.com/owner/repo.git')
+or in the scp-like form (i.e. 'git@github.com:owner/repo.git').
 
 Arguments are as follows:
 
-  - paths the file patterns of the contents to add to stage. Cannot be nil or empty. The path "." represents
-    all files in the working area so with that you can add all locally changed files.
-  - message the commit message. Cannot be nil.
+- uri the remote URI
+*/
+func isSSHURI(uri string) bool {
+	return "ssh" == getURIProtocol(uri)
+}
 
-Errors can be:
+/*
+Returns true if the given URI uses the HTTP or HTTPS protocol.
 
-- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+Arguments are as follows:
+
+- uri the remote URI
 */
-func (r goGitRepository) CommitPathsWithMessage(paths []string, message *string) (gitent.Commit, error) {
-	return r.CommitPathsWithMessageAndIdentities(paths, message, nil, nil)
+func isHTTPURI(uri string) bool {
+	protocol := getURIProtocol(uri)
+	return "http" == protocol || "https" == protocol
 }
 
 /*
-Adds the given files to the staging area and commits changes to the repository. This method is a shorthand
-for Add and CommitWithMessageAndIdentities.
+Returns the authentication method to use with user name and password credentials on the given remote URI,
+or nil when no custom authentication is required.
+
+User name and password credentials are only supported by HTTP(S) remotes so when the URI uses the SSH protocol
+and some credentials are given an error is returned, suggesting to use public key authentication instead.
+When no credentials are given for an SSH remote nil is returned so that the underlying library uses the keys
+held by the SSH agent, if any, while the netrc file is never looked up.
 
 Arguments are as follows:
 
-  - paths the file patterns of the contents to add to stage. Cannot be nil or empty. The path "." represents
-    all files in the working area so with that you can add all locally changed files.
-  - message the commit message. Cannot be nil.
-  - author the object modelling the commit author informations. It may be nil, in which case the default
-    for the repository will be used
-  - committer the object modelling the committer informations. It may be nil, in which case the default
-    for the repository will be used
+  - user the user name to use when credentials are required. It may be nil.
+  - password the password to use when credentials are required. It may be nil.
+  - uri the URI of the remote repository. It may be empty.
 
 Errors can be:
 
-- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+- IllegalArgumentError if credentials are given for a remote using the SSH protocol
 */
-func (r goGitRepository) CommitPathsWithMessageAndIdentities(paths []string, message *string, author *gitent.Identity, committer *gitent.Identity) (gitent.Commit, error) {
-	err := r.Add(paths)
-	if err != nil {
-		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("an error occurred while staging contents to the repository"), Cause: err}
+func getUserNameAndPasswordAuth(user *string, password *string, uri string) (ggittransport.AuthMethod, error) {
+	if isSSHURI(uri) {
+		if (user != nil && "" != strings.TrimSpace(*user)) || (password != nil && "" != strings.TrimSpace(*password)) {
+			return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("the remote URI '%s' uses the SSH protocol, which doesn't support user name and password (or token) authentication; use public key authentication for this remote or change its URI to HTTPS", uri)}
+		}
+		log.Debugf("the remote URI '%s' uses the SSH protocol and no credentials have been given, the default SSH authentication will be used", uri)
+		return nil, nil
 	}
-	return r.CommitWithMessageAndIdentities(message, author, committer)
+	return getBasicAuth(user, password, uri), nil
 }
 
 /*
-Returns a set of objects representing all the tags for the given commit.
+Returns the authentication method to use with public key credentials on the given remote URI, or nil when
+no custom authentication is available.
+
+Public key credentials are only supported by SSH remotes so when the URI uses the HTTP(S) protocol and a private
+key is given an error is returned, suggesting to use user name and password or token authentication instead.
 
 Arguments are as follows:
 
-- commit the SHA-1 identifier of the commit to get the tags for. It can be a full or abbreviated SHA-1.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified.
+  - uri the URI of the remote repository. It may be empty.
 
 Errors can be:
 
-- GitError in case some problem is encountered with the underlying Git repository.
+- IllegalArgumentError if a private key is given for a remote using the HTTP(S) protocol
+- IOError in case the known hosts can't be read or parsed
 */
-func (r goGitRepository) GetCommitTags(commit string) ([]gitent.Tag, error) {
-	log.Debugf("retrieving tags for commit '%s'", commit)
-	var res []gitent.Tag
-	tagsIterator, err := r.repository.Tags()
-	if err != nil {
-		return nil, &errs.GitError{Message: fmt.Sprintf("cannot list repository tags"), Cause: err}
-	}
-	if err := tagsIterator.ForEach(func(ref *ggitplumbing.Reference) error {
-		// in order to check if the tag has this commit as target we first need to figure out if it's annotated or lightweight
-		tagObject, err := r.repository.TagObject(ref.Hash())
-		switch err {
-		case nil:
-			// it's an annotated tag
-			if strings.HasPrefix(tagObject.Target.String(), commit) {
-				res = append(res, TagFrom(r.repository, *ref))
-			}
-		case ggitplumbing.ErrObjectNotFound:
-			// it's a lightweight tag
-			if strings.HasPrefix(ref.Hash().String(), commit) {
-				res = append(res, TagFrom(r.repository, *ref))
-			}
-		default:
-			// Some other error occurred
-			return &errs.GitError{Message: fmt.Sprintf("error while listing repository tags"), Cause: err}
+func getSSHAuth(privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, uri string) (ggittransport.AuthMethod, error) {
+	if isHTTPURI(uri) {
+		if privateKey != nil && "" != strings.TrimSpace(*privateKey) {
+			return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("the remote URI '%s' uses the HTTP(S) protocol, which doesn't support public key authentication; use user name and password (or token) authentication for this remote or change its URI to SSH (i.e. 'git@host:owner/repo.git')", uri)}
 		}
-		return nil
-	}); err != nil {
-		return nil, &errs.GitError{Message: fmt.Sprintf("error while listing repository tags"), Cause: err}
+		log.Debugf("the remote URI '%s' uses the HTTP(S) protocol and no private key has been given, no public key authentication will be used", uri)
+		return nil, nil
 	}
-	return res, nil
+	hostKeyCallback, err := getHostKeyCallback(knownHosts, strictHostKeyChecking)
+	if err != nil {
+		return nil, err
+	}
+	return getPublicKeyAuth(privateKey, passphrase, getSSHUser(uri), hostKeyCallback), nil
 }
 
 /*
-Returns the name of the current branch or a commit SHA-1 if the repository is in the detached head state.
+Returns the first URL configured for the remote with the given name, or an empty string if the remote is not
+configured or has no URLs.
 
-Errors can be:
+Arguments are as follows:
 
-  - GitError in case some problem is encountered with the underlying Git repository, including when
-    the repository has no commits yet or is in the 'detached HEAD' state.
+- remote the name of the remote. If empty the default remote (origin) is used.
 */
-func (r goGitRepository) GetCurrentBranch() (string, error) {
-	ref, err := r.repository.Head()
-	if err != nil {
-		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+func (r goGitRepository) getRemoteURL(remote string) string {
+	if "" == remote {
+		remote = ggit.DefaultRemoteName
 	}
-
-	// also strip the leading "refs/heads/" from the reference name
-	return strings.Replace(ref.Name().String(), "refs/heads/", "", 1), nil
+	remoteObject, err := r.repository.Remote(remote)
+	if err != nil || remoteObject == nil || len(remoteObject.Config().URLs) == 0 {
+		return ""
+	}
+	return remoteObject.Config().URLs[0]
 }
 
 /*
-Returns the SHA-1 identifier of the last commit in the current branch.
+Returns the first URL configured for the remote with the given name, rewritten by the 'insteadOf' and, when pushing,
+the 'pushInsteadOf' rules of the Git configuration (see rewriteURI), or an empty string if the remote is not
+configured or has no URLs. This is the URL actually used to fetch from or push to the remote.
 
-Errors can be:
+Arguments are as follows:
 
-  - GitError in case some problem is encountered with the underlying Git repository, including when
-    the repository has no commits yet or is in the 'detached HEAD' state.
+- remote the name of the remote. If empty the default remote (origin) is used.
+- push true to get the URL used to push, false to get the URL used to fetch
 */
-func (r goGitRepository) GetLatestCommit() (string, error) {
-	ref, err := r.repository.Head()
-	if err != nil {
-		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+func (r goGitRepository) getRewrittenRemoteURL(remote string, push bool) string {
+	remoteObject, err := r.remote(remote, push)
+	if err != nil || len(remoteObject.Config().URLs) == 0 {
+		return ""
 	}
-	commitSHA := ref.Hash().String()
-	log.Debugf("repository latest commit in HEAD branch is '%s'", commitSHA)
-	return commitSHA, nil
+	return remoteObject.Config().URLs[0]
 }
 
 /*
-Returns the SHA-1 identifier of the first commit in the repository (the only commit with no parents).
+Returns the remote with the given name, with its URLs rewritten by the 'insteadOf' and, when pushing, the
+'pushInsteadOf' rules of the Git configuration (see rewriteURI).
+
+Arguments are as follows:
+
+- remote the name of the remote. If empty the default remote (origin) is used.
+- push true if the remote is used to push, false if it's used to fetch
 
 Errors can be:
 
-  - GitError in case some problem is encountered with the underlying Git repository, including when
+- ErrRemoteNotFound if the remote is not configured, or any error returned when reading the repository configuration
+*/
+func (r goGitRepository) remote(remote string, push bool) (*ggit.Remote, error) {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	config, err := r.repository.Config()
+	if err != nil {
+		return nil, err
+	}
+	remoteConfig, ok := config.Remotes[remote]
+	if !ok {
+		return nil, ggit.ErrRemoteNotFound
+	}
+	// the URLs parsed by go-git may already be rewritten by the rules in the repository configuration so the
+	// configured ones are read from the raw configuration
+	urls := remoteConfig.URLs
+	if config.Raw.HasSection("remote") && config.Raw.Section("remote").HasSubsection(remote) {
+		if rawURLs := config.Raw.Section("remote").Subsection(remote).OptionAll("url"); len(rawURLs) > 0 {
+			urls = rawURLs
+		}
+	}
+	scopes := r.configScopes()
+	rewrittenConfig := *remoteConfig
+	rewrittenConfig.URLs = make([]string, len(urls))
+	for i, url := range urls {
+		rewrittenConfig.URLs[i] = rewriteURI(scopes, url, push)
+	}
+	return ggit.NewRemote(r.repository.Storer, &rewrittenConfig), nil
+}
+
+/*
+Pushes to the remote named in the given options, using the URL rewritten by the Git configuration rules (see remote),
+within the timeout set for remote operations (see Options.Timeout) and retrying on transient failures (see withRetries).
+*/
+func (r goGitRepository) push(options *ggit.PushOptions) error {
+	remote, err := r.remote(options.RemoteName, true)
+	if err != nil {
+		return err
+	}
+	return r.options.withRetries(fmt.Sprintf("pushing to '%s'", options.RemoteName), func() error {
+		ctx, cancel := r.options.remoteContext()
+		defer cancel()
+		return remote.PushContext(ctx, options)
+	})
+}
+
+/*
+Fetches from the remote named in the given options, using the URL rewritten by the Git configuration rules (see remote),
+within the timeout set for remote operations (see Options.Timeout) and retrying on transient failures (see withRetries).
+*/
+func (r goGitRepository) fetch(options *ggit.FetchOptions) error {
+	remote, err := r.remote(options.RemoteName, false)
+	if err != nil {
+		return err
+	}
+	return r.options.fetchRemote(remote, options)
+}
+
+/*
+Fetches from the given remote using the given options within the timeout set for remote operations (see Options.Timeout),
+retrying on transient failures (see withRetries).
+*/
+func (o repositoryOptions) fetchRemote(remote *ggit.Remote, options *ggit.FetchOptions) error {
+	return o.withRetries(fmt.Sprintf("fetching from '%s'", remote.Config().Name), func() error {
+		ctx, cancel := o.remoteContext()
+		defer cancel()
+		return remote.FetchContext(ctx, options)
+	})
+}
+
+/*
+Lists the references advertised by the given remote using the given options within the timeout set for remote
+operations (see Options.Timeout), retrying on transient failures (see withRetries).
+*/
+func (o repositoryOptions) listRemote(remote *ggit.Remote, options *ggit.ListOptions) ([]*ggitplumbing.Reference, error) {
+	var references []*ggitplumbing.Reference
+	err := o.withRetries(fmt.Sprintf("listing the references of '%s'", remote.Config().Name), func() error {
+		ctx, cancel := o.remoteContext()
+		defer cancel()
+		var err error
+		references, err = remote.ListContext(ctx, options)
+		return err
+	})
+	return references, err
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+
+Arguments are as follows:
+
+- directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+- uri the URI of the remote repository to clone.
+
+Errors can be:
+
+- NilPointerError if any of the given objects is nil
+- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) clone(directory *string, uri *string) (goGitRepository, error) {
+	if directory == nil {
+		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null directory"}
+	}
+	if uri == nil {
+		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null URI"}
+	}
+	if "" == strings.TrimSpace(*directory) {
+		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank directory"}
+	}
+	if "" == strings.TrimSpace(*uri) {
+		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank URI"}
+	}
+
+	log.Debugf("cloning repository in directory '%s' from URI '%s'", *directory, *uri)
+
+	options := o.getCloneOptions(*uri, nil)
+	repository, err := o.plainClone(*directory, *uri, options)
+	if err != nil {
+		return goGitRepository{}, &errs.GitError{Message: fmt.Sprintf("unable to clone the '%s' repository into '%s'", *uri, *directory), Cause: err}
+	}
+
+	return o.newGoGitRepository(*directory, repository)
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+
+Arguments are as follows:
+
+  - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+  - uri the URI of the remote repository to clone.
+  - user the user name to use when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to use when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- NilPointerError if any of the given objects is nil
+- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) cloneWithUserNameAndPassword(directory *string, uri *string, user *string, password *string) (goGitRepository, error) {
+	return o.cloneBranchWithUserNameAndPassword(directory, uri, nil, user, password)
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+
+Arguments are as follows:
+
+  - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+  - uri the URI of the remote repository to clone.
+  - branch the name of the branch to check out after cloning. If nil the remote default branch is checked out.
+  - user the user name to use when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to use when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- NilPointerError if any of the given objects is nil
+- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) cloneBranchWithUserNameAndPassword(directory *string, uri *string, branch *string, user *string, password *string) (goGitRepository, error) {
+	if directory == nil {
+		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null directory"}
+	}
+	if uri == nil {
+		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null URI"}
+	}
+	if "" == strings.TrimSpace(*directory) {
+		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank directory"}
+	}
+	if "" == strings.TrimSpace(*uri) {
+		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank URI"}
+	}
+
+	log.Debugf("cloning repository in directory '%s' from URI '%s' using username and password", *directory, *uri)
+
+	options := o.getCloneOptions(*uri, branch)
+	auth, err := getUserNameAndPasswordAuth(user, password, options.URL)
+	if err != nil {
+		return goGitRepository{}, err
+	}
+	if auth != nil {
+		log.Debugf("username and password authentication will use custom authentication options")
+		options.Auth = auth
+	} else {
+		log.Debugf("username and password authentication will not use any custom authentication options")
+	}
+	repository, err := o.plainClone(*directory, *uri, options)
+	if err != nil {
+		return goGitRepository{}, &errs.GitError{Message: fmt.Sprintf("unable to clone the '%s' repository into '%s'", *uri, *directory), Cause: err}
+	}
+
+	return o.newGoGitRepository(*directory, repository)
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Arguments are as follows:
+
+  - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+  - uri the URI of the remote repository to clone.
+  - branch the name of the branch to check out after cloning. If nil the remote default branch is checked out.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if any of the given objects is nil
+- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) cloneBranchWithToken(directory *string, uri *string, branch *string, token *string, user *string) (goGitRepository, error) {
+	if token == nil {
+		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null token"}
+	}
+	if uri == nil {
+		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null URI"}
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, rewriteURI(globalConfigScopes(), *uri, false))
+	return o.cloneBranchWithUserNameAndPassword(directory, uri, branch, &tokenUser, &tokenPassword)
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+
+Arguments are as follows:
+
+  - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+  - uri the URI of the remote repository to clone.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+
+Errors can be:
+
+- NilPointerError if any of the given objects is nil
+- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) cloneWithPublicKey(directory *string, uri *string, privateKey *string, passphrase *string) (goGitRepository, error) {
+	return o.cloneBranchWithPublicKey(directory, uri, nil, privateKey, passphrase)
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+
+Arguments are as follows:
+
+  - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+  - uri the URI of the remote repository to clone.
+  - branch the name of the branch to check out after cloning. If nil the remote default branch is checked out.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+
+Errors can be:
+
+- NilPointerError if any of the given objects is nil
+- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) cloneBranchWithPublicKey(directory *string, uri *string, branch *string, privateKey *string, passphrase *string) (goGitRepository, error) {
+	return o.cloneBranchWithPublicKeyAndHostKeys(directory, uri, branch, privateKey, passphrase, nil, false)
+}
+
+/*
+Returns a repository instance working in the given directory after cloning from the given URI.
+
+Arguments are as follows:
+
+  - directory the directory where the repository has to be cloned. It is created if it doesn't exist.
+  - uri the URI of the remote repository to clone.
+  - branch the name of the branch to check out after cloning. If nil the remote default branch is checked out.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- NilPointerError if any of the given objects is nil
+- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) cloneBranchWithPublicKeyAndHostKeys(directory *string, uri *string, branch *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (goGitRepository, error) {
+	if directory == nil {
+		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null directory"}
+	}
+	if uri == nil {
+		return goGitRepository{}, &errs.NilPointerError{Message: "can't clone a repository instance with a null URI"}
+	}
+	if "" == strings.TrimSpace(*directory) {
+		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank directory"}
+	}
+	if "" == strings.TrimSpace(*uri) {
+		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank URI"}
+	}
+
+	log.Debugf("cloning repository in directory '%s' from URI '%s' using public key (SSH) authentication", *directory, *uri)
+
+	options := o.getCloneOptions(*uri, branch)
+	auth, err := getSSHAuth(privateKey, passphrase, knownHosts, strictHostKeyChecking, options.URL)
+	if err != nil {
+		return goGitRepository{}, err
+	}
+	if auth != nil {
+		log.Debugf("public key (SSH) authentication will use custom authentication options")
+		options.Auth = auth
+	} else {
+		log.Debugf("public key (SSH) authentication will not use any custom authentication options")
+	}
+	repository, err := o.plainClone(*directory, *uri, options)
+	if err != nil {
+		return goGitRepository{}, &errs.GitError{Message: fmt.Sprintf("unable to clone the '%s' repository into '%s'", *uri, *directory), Cause: err}
+	}
+
+	// TODO: remove the 'directory' attribute when https://github.com/mooltiverse/nyx/issues/130 is fixed
+	return o.newGoGitRepository(*directory, repository)
+}
+
+/*
+Returns a repository instance working in the given directory.
+
+The directory may also be a linked worktree (created with 'git worktree add'), where '.git' is a file pointing to
+the worktree private directory, holding its own HEAD and index, while objects, references and configuration are
+shared with the main worktree through the 'commondir' file.
+
+Arguments are as follows:
+
+- directory the directory where the repository is.
+
+Errors can be:
+
+- IllegalArgumentError if the given object is illegal for some reason, like referring to an illegal repository
+- IOError in case of any I/O issue accessing the repository
+*/
+func (o repositoryOptions) open(directory string) (goGitRepository, error) {
+	if "" == strings.TrimSpace(directory) {
+		return goGitRepository{}, &errs.IllegalArgumentError{Message: "can't create a repository instance with a blank directory"}
+	}
+	// without the commondir support go-git opens linked worktrees but can't find the shared objects and references
+	repository, err := ggit.PlainOpenWithOptions(directory, &ggit.PlainOpenOptions{EnableDotGitCommonDir: true})
+	if err != nil {
+		return goGitRepository{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("unable to open Git repository in directory '%s'", directory), Cause: err}
+	}
+	// TODO: remove the 'directory' attribute when https://github.com/mooltiverse/nyx/issues/130 is fixed
+	return o.newGoGitRepository(directory, repository)
+}
+
+/*
+Returns the references advertised by the remote repository at the given URI, without cloning or fetching anything.
+This is the equivalent of 'git ls-remote'.
+
+Arguments are as follows:
+
+- uri the URI of the remote repository.
+- auth the authentication method to use. It may be nil.
+
+Errors can be:
+
+- NilPointerError if the given URI is nil
+- IllegalArgumentError if the given URI is blank
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) listRemoteReferences(uri *string, auth ggittransport.AuthMethod) ([]*ggitplumbing.Reference, error) {
+	if uri == nil {
+		return nil, &errs.NilPointerError{Message: "can't list the references of a remote repository with a null URI"}
+	}
+	if "" == strings.TrimSpace(*uri) {
+		return nil, &errs.IllegalArgumentError{Message: "can't list the references of a remote repository with a blank URI"}
+	}
+
+	// the remote is only kept in memory as there is no local repository to configure it into
+	remote := ggit.NewRemote(ggitmemory.NewStorage(), &ggitconfig.RemoteConfig{Name: DEFAULT_REMOTE_NAME, URLs: []string{normalizeURI(*uri)}})
+	references, err := o.listRemote(remote, &ggit.ListOptions{Auth: auth})
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to list the references of the '%s' remote repository", *uri), Cause: err}
+	}
+	return references, nil
+}
+
+/*
+Returns the references advertised by the remote repository at the given URI, without cloning or fetching anything.
+This method allows using user name and password authentication (also used for tokens).
+
+Arguments are as follows:
+
+  - uri the URI of the remote repository.
+  - user the user name to use when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to use when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- NilPointerError if the given URI is nil
+- IllegalArgumentError if the given URI is blank
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) listRemoteReferencesWithUserNameAndPassword(uri *string, user *string, password *string) ([]*ggitplumbing.Reference, error) {
+	if uri == nil {
+		return nil, &errs.NilPointerError{Message: "can't list the references of a remote repository with a null URI"}
+	}
+	log.Debugf("listing references from URI '%s' using username and password", *uri)
+
+	auth, err := getUserNameAndPasswordAuth(user, password, *uri)
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		log.Debugf("username and password authentication will use custom authentication options")
+		return o.listRemoteReferences(uri, auth)
+	}
+	log.Debugf("username and password authentication will not use any custom authentication options")
+	return o.listRemoteReferences(uri, nil)
+}
+
+/*
+Returns the references advertised by the remote repository at the given URI, without cloning or fetching anything.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Arguments are as follows:
+
+  - uri the URI of the remote repository.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if the given URI or token is nil
+- IllegalArgumentError if the given URI is blank
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) listRemoteReferencesWithToken(uri *string, token *string, user *string) ([]*ggitplumbing.Reference, error) {
+	if token == nil {
+		return nil, &errs.NilPointerError{Message: "can't list the references of a remote repository with a null token"}
+	}
+	if uri == nil {
+		return nil, &errs.NilPointerError{Message: "can't list the references of a remote repository with a null URI"}
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, *uri)
+	return o.listRemoteReferencesWithUserNameAndPassword(uri, &tokenUser, &tokenPassword)
+}
+
+/*
+Returns the references advertised by the remote repository at the given URI, without cloning or fetching anything.
+This method allows using SSH authentication.
+
+Arguments are as follows:
+
+  - uri the URI of the remote repository.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- NilPointerError if the given URI is nil
+- IllegalArgumentError if the given URI is blank
+- GitError in case the operation fails for some reason, including when authentication fails
+*/
+func (o repositoryOptions) listRemoteReferencesWithPublicKeyAndHostKeys(uri *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) ([]*ggitplumbing.Reference, error) {
+	if uri == nil {
+		return nil, &errs.NilPointerError{Message: "can't list the references of a remote repository with a null URI"}
+	}
+	log.Debugf("listing references from URI '%s' using public key (SSH) authentication", *uri)
+
+	auth, err := getSSHAuth(privateKey, passphrase, knownHosts, strictHostKeyChecking, *uri)
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		log.Debugf("public key (SSH) authentication will use custom authentication options")
+		return o.listRemoteReferences(uri, auth)
+	}
+	log.Debugf("public key (SSH) authentication will not use any custom authentication options")
+	return o.listRemoteReferences(uri, nil)
+}
+
+/*
+Returns the tags among the given remote references, sorted by name.
+
+The remote only advertises the object each tag points to so the returned tags are never marked as annotated
+and, for annotated tags, the target is the ID of the tag object rather than the ID of the tagged commit.
+
+Arguments are as follows:
+
+- references the references advertised by a remote repository
+*/
+func getRemoteTags(references []*ggitplumbing.Reference) []gitent.Tag {
+	res := make([]gitent.Tag, 0)
+	for _, reference := range references {
+		if reference.Type() == ggitplumbing.HashReference && reference.Name().IsTag() {
+			res = append(res, *gitent.NewTagWith(reference.Name().Short(), reference.Hash().String(), false))
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].GetName() < res[j].GetName() })
+	return res
+}
+
+/*
+Returns the branches among the given remote references, mapping their names to the SHA-1 of the commits they point to.
+
+Arguments are as follows:
+
+- references the references advertised by a remote repository
+*/
+func getRemoteBranches(references []*ggitplumbing.Reference) map[string]string {
+	res := make(map[string]string)
+	for _, reference := range references {
+		if reference.Type() == ggitplumbing.HashReference && reference.Name().IsBranch() {
+			res[reference.Name().Short()] = reference.Hash().String()
+		}
+	}
+	return res
+}
+
+/*
+Returns the name of the branch the HEAD points to among the given remote references, without the 'refs/heads/'
+prefix, or an empty string if the remote doesn't advertise its HEAD as a symbolic reference.
+
+Arguments are as follows:
+
+- references the references advertised by a remote repository
+*/
+func getRemoteDefaultBranch(references []*ggitplumbing.Reference) string {
+	for _, reference := range references {
+		if reference.Type() == ggitplumbing.SymbolicReference && reference.Name() == ggitplumbing.HEAD && reference.Target().IsBranch() {
+			return reference.Target().Short()
+		}
+	}
+	return ""
+}
+
+/*
+Returns the working tree of the repository.
+
+Errors can be:
+
+- GitError in case the repository is bare or some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) worktree() (*ggit.Worktree, error) {
+	if r.bare {
+		return nil, &errs.GitError{Message: fmt.Sprintf("the repository in '%s' is bare and has no working tree so this operation is not available. Use a regular clone to stage or commit changes", r.directory)}
+	}
+	worktree, err := r.repository.Worktree()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("an error occurred when getting the current worktree for the repository"), Cause: err}
+	}
+	return worktree, nil
+}
+
+/*
+Resolves the commit with the given id using the repository object and returns it as a typed object.
+
+This method is an utility wrapper around CommitObject which never returns
+nil and throws GitError if the identifier cannot be resolved or any other error occurs.
+
+Arguments are as follows:
+
+- id the commit identifier to resolve. It must be a long or abbreviated object identifier but not nil.
+
+Errors can be:
+
+- GitError in case the given identifier cannot be resolved or any other issue is encountered
+*/
+func (r goGitRepository) parseCommit(id string) (ggitobject.Commit, error) {
+	log.Tracef("parsing commit '%s'", id)
+	hash := ggitplumbing.NewHash(id)
+	if hash.String() != id {
+		// the identifier is abbreviated (or it's not a full identifier at all) so it needs to be resolved first
+		var err error
+		hash, err = r.resolve(id)
+		if err != nil {
+			return ggitobject.Commit{}, &errs.GitError{Message: fmt.Sprintf("the '%s' commit identifier cannot be resolved as there is no such commit.", id), Cause: err}
+		}
+	}
+	commit, err := r.repository.CommitObject(hash)
+	if err != nil {
+		return ggitobject.Commit{}, &errs.GitError{Message: fmt.Sprintf("the '%s' commit identifier cannot be resolved as there is no such commit.", id), Cause: err}
+	}
+	return *commit, nil
+}
+
+/*
+Resolves the object with the given id in the repository.
+
+This method is an utility wrapper around ResolveRevision which never returns
+nil and returns GitError if the identifier cannot be resolved or any other error occurs.
+
+Arguments are as follows:
+
+  - id the object identifier to resolve. It can't be nil. If it's a SHA-1 it can be long or abbreviated.
+    For allowed values see ResolveRevision
+
+Errors can be:
+
+- GitError in case the given identifier cannot be resolved or any other issue is encountered
+*/
+func (r goGitRepository) resolve(id string) (ggitplumbing.Hash, error) {
+	log.Tracef("resolving '%s'", id)
+
+	rev, err := r.repository.ResolveRevision(ggitplumbing.Revision(id))
+	if err != nil {
+		return ggitplumbing.Hash{}, &errs.GitError{Message: fmt.Sprintf("the '%s' identifier cannot be resolved", id), Cause: err}
+	}
+	if rev == nil {
+		if "HEAD" == id {
+			log.Warnf("Repository identifier '%s' cannot be resolved. This means that the repository has just been initialized and has no commits yet or the repository is in a 'detached HEAD' state. See the documentation to fix this.", "HEAD")
+		}
+		return ggitplumbing.Hash{}, &errs.GitError{Message: fmt.Sprintf("Identifier '%s' cannot be resolved", id)}
+	} else {
+		return *rev, nil
+	}
+}
+
+/*
+Returns true if all the parents of the commit with the given hash are available in the repository.
+Returns false if the commit itself or any of its parents is missing, like for the commits at the boundary of
+a shallow repository.
+*/
+func (r goGitRepository) hasParents(hash ggitplumbing.Hash) bool {
+	commit, err := r.repository.CommitObject(hash)
+	if err != nil {
+		return false
+	}
+	for _, parent := range commit.ParentHashes {
+		_, err = r.repository.CommitObject(parent)
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+Arguments are as follows:
+
+- paths the file patterns of the contents to add to stage. Cannot be nil or empty. The path "." represents
+all files in the working area so with that you can add all locally changed files.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to add paths.
+*/
+func (r goGitRepository) Add(paths []string) error {
+	log.Debugf("adding contents to repository staging area")
+	if paths == nil || len(paths) == 0 {
+		return &errs.GitError{Message: fmt.Sprintf("cannot stage a nil or empty set of paths")}
+	}
+
+	worktree, err := r.worktree()
+	if err != nil {
+		return err
+	}
+	// TODO: remove this workaround (before the 'for' statement) when https://github.com/mooltiverse/nyx/issues/219 is fixed
+	// The go-git library has a bug that sometimes does not obey with the .gitignore file so we use the
+	// workaround suggested here: https://github.com/go-git/go-git/issues/597#issuecomment-1301637889
+	// to read the .gitignore and programmatically add the paths to the Worktree Excludes.
+	// This workaround is here to cope with:
+	// - https://github.com/mooltiverse/nyx/issues/219
+	// as long as the go-git library doesn't fix the bug. Bugs to keep an eye on for a fix are:
+	// - https://github.com/go-git/go-git/issues/597
+	if _, err := os.Stat(filepath.Join(r.directory, ".gitignore")); err == nil {
+		if !workaround231WarningsEmitted {
+			log.Warnf("workaround #231: due to the underlying go-git library not obeying to the .gitignore files the .gitignore content is read and each item passed to the Worktree Excludes. For more see https://github.com/mooltiverse/nyx/issues/219")
+			// make sure we emit this warning only once
+			workaround231WarningsEmitted = true
+		}
+		gitIgnoreFile, err := os.Open(filepath.Join(r.directory, ".gitignore"))
+		defer gitIgnoreFile.Close()
+		if err != nil {
+			return &errs.GitError{Message: fmt.Sprintf("unable to read .gitignore (needed for workaround https://github.com/mooltiverse/nyx/issues/219)"), Cause: err}
+		}
+		gitIgnoreFileScanner := bufio.NewScanner(gitIgnoreFile)
+		gitIgnoreFileScanner.Split(bufio.ScanLines)
+		for gitIgnoreFileScanner.Scan() {
+			ignorePattern := gitIgnoreFileScanner.Text()
+			if !workaround231WarningsEmitted {
+				log.Debugf("add %s from .gitignore to ignore list (needed for workaround https://github.com/mooltiverse/nyx/issues/219)", ignorePattern)
+			}
+			worktree.Excludes = append(worktree.Excludes, gitignore.ParsePattern(ignorePattern, nil))
+		}
+		// End of the workaround
+	}
+	for _, path := range paths {
+		err := worktree.AddWithOptions(&ggit.AddOptions{All: true, Path: "", Glob: path})
+		if err != nil {
+			return &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to add paths to the staging area"), Cause: err}
+		}
+	}
+	err = r.stageSubmodules(worktree, paths)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to add submodules to the staging area"), Cause: err}
+	}
+
+	return nil
+}
+
+/*
+Fixes the staging area after go-git added the given paths, as go-git doesn't know about submodules and adds the files
+within them as if they belonged to this repository, while it never stages the commits checked out in submodules.
+The files within submodules are removed from the index and the submodules selected by the given paths have their
+checked out commits staged, just like 'git add' does, unless submodules are ignored altogether
+(IGNORE_SUBMODULES_ALL, see Options.IgnoreSubmodules).
+
+Arguments are as follows:
+
+- worktree the working tree paths have been added from
+- paths the file patterns passed to Add
+*/
+func (r goGitRepository) stageSubmodules(worktree *ggit.Worktree, paths []string) error {
+	submodules, err := worktree.Submodules()
+	if err != nil || len(submodules) == 0 {
+		return err
+	}
+	index, err := r.repository.Storer.Index()
+	if err != nil {
+		return err
+	}
+	changed := false
+	for _, submodule := range submodules {
+		path := submodule.Config().Path
+		entries := []*ggitindex.Entry{}
+		for _, entry := range index.Entries {
+			if strings.HasPrefix(entry.Name, path+"/") {
+				changed = true
+				continue
+			}
+			entries = append(entries, entry)
+		}
+		index.Entries = entries
+
+		if IGNORE_SUBMODULES_ALL == r.options.ignoreSubmodules || !submoduleMatches(paths, path) {
+			continue
+		}
+		status, err := submodule.Status()
+		if err != nil {
+			// submodules that are not initialized have no commit checked out
+			log.Debugf("unable to get the status of submodule '%s' so it's not staged: %v", path, err)
+			continue
+		}
+		if status.Current.IsZero() || status.IsClean() {
+			continue
+		}
+		entry, err := index.Entry(path)
+		if err != nil {
+			continue
+		}
+		log.Debugf("staging commit '%s' for submodule '%s'", status.Current.String(), path)
+		entry.Hash = status.Current
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return r.repository.Storer.SetIndex(index)
+}
+
+/*
+Adds a new remote with the given name and URL to the repository configuration, so that it can be used to fetch
+from and push to. The remote fetches all branches, just like remotes added with 'git remote add'.
+
+Arguments are as follows:
+
+- name the name of the remote to add. Cannot be empty
+- url the URL of the remote. Cannot be empty
+
+Errors can be:
+
+  - GitError in case the name or the URL are empty, a remote with the same name already exists or some problem
+    is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) AddRemote(name string, url string) error {
+	log.Debugf("adding remote '%s'", name)
+	if "" == strings.TrimSpace(name) {
+		return &errs.GitError{Message: fmt.Sprintf("the remote name cannot be empty")}
+	}
+	if "" == strings.TrimSpace(url) {
+		return &errs.GitError{Message: fmt.Sprintf("the URL of remote '%s' cannot be empty", name)}
+	}
+	_, err := r.repository.CreateRemote(&ggitconfig.RemoteConfig{Name: name, URLs: []string{url}})
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to add remote '%s'", name), Cause: err}
+	}
+	log.Debugf("remote '%s' added", name)
+	return nil
+}
+
+/*
+Commits changes to the repository. Files to commit must be staged separately using Add.
+
+- message the commit message. Cannot be nil.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+*/
+func (r goGitRepository) CommitWithMessage(message *string) (gitent.Commit, error) {
+	return r.CommitWithMessageAndIdentities(message, nil, nil)
+}
+
+/*
+Returns the paths of the global and system Git configuration files, in the order options are looked up by git
+(which is the opposite of the order files are read in). Just like git, the GIT_CONFIG_GLOBAL, GIT_CONFIG_SYSTEM and
+GIT_CONFIG_NOSYSTEM environment variables are honored. go-git only reads the first global configuration file it finds
+and caches the home directory so the paths are resolved here.
+*/
+func configPaths() []string {
+	paths := []string{}
+	if global := os.Getenv("GIT_CONFIG_GLOBAL"); "" != global {
+		paths = append(paths, global)
+	} else if home, err := os.UserHomeDir(); err == nil {
+		xdg := os.Getenv("XDG_CONFIG_HOME")
+		if "" == xdg {
+			xdg = filepath.Join(home, ".config")
+		}
+		paths = append(paths, filepath.Join(home, ".gitconfig"), filepath.Join(xdg, "git", "config"))
+	}
+	if isConfigTrue(os.Getenv("GIT_CONFIG_NOSYSTEM")) {
+		return paths
+	}
+	if system := os.Getenv("GIT_CONFIG_SYSTEM"); "" != system {
+		return append(paths, system)
+	}
+	return append(paths, "/etc/gitconfig")
+}
+
+/*
+Returns the global and the system Git configurations, in this order, which is the order options are looked up by
+git. Configurations that don't exist or can't be read are skipped.
+*/
+func globalConfigScopes() []*ggitconfig.Config {
+	scopes := []*ggitconfig.Config{}
+	for _, path := range configPaths() {
+		file, err := os.Open(path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				log.Debugf("unable to read the Git configuration file '%s': %v", path, err)
+			}
+			continue
+		}
+		cfg, err := ggitconfig.ReadConfig(file)
+		file.Close()
+		if err != nil {
+			log.Debugf("unable to parse the Git configuration file '%s': %v", path, err)
+			continue
+		}
+		scopes = append(scopes, cfg)
+	}
+	return scopes
+}
+
+/*
+Returns the repository, the global and the system Git configurations, in this order, which is the order options
+are looked up by git. Configurations that don't exist or can't be read are skipped.
+*/
+func (r goGitRepository) configScopes() []*ggitconfig.Config {
+	local, err := r.repository.Storer.Config()
+	if err != nil {
+		log.Debugf("unable to read the repository Git configuration: %v", err)
+		return globalConfigScopes()
+	}
+	return append([]*ggitconfig.Config{local}, globalConfigScopes()...)
+}
+
+/*
+Returns the value of the given option from the first of the given configurations that sets it, or an empty string
+if none does. Options are looked up in each configuration separately as go-git merges configurations section by
+section, so an option set in the global configuration is lost when the repository configuration has the same section.
+
+Arguments are as follows:
+
+- scopes the configurations to look up, in order of precedence, as returned by configScopes
+- section the name of the section the option belongs to (i.e. 'user')
+- option the name of the option (i.e. 'email')
+*/
+func configOption(scopes []*ggitconfig.Config, section string, option string) string {
+	for _, cfg := range scopes {
+		if cfg.Raw == nil || !cfg.Raw.HasSection(section) {
+			continue
+		}
+		if value := cfg.Raw.Section(section).Option(option); "" != value {
+			return value
+		}
+	}
+	return ""
+}
+
+/*
+Returns true if the given value of a boolean Git configuration option stands for true.
+*/
+func isConfigTrue(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "yes", "on", "1":
+		return true
+	default:
+		return false
+	}
+}
+
+/*
+Returns the signature for the identity read from the Git configuration, dated with the given time, or nil if the configuration has no such identity.
+
+Just like git does, the name and the email are read from the 'author' or 'committer' section, according to the role,
+falling back to the 'user' section, each one on its own and looking up the repository, the global and the system
+configuration in this order. go-git would otherwise read the identity from the configurations merged section by
+section, ignoring the 'user' section when the 'author' or 'committer' one is only partially set, and always date it
+with the current time.
+
+Arguments are as follows:
+
+- scopes the configurations to read the identity from, as returned by configScopes
+- committer true to read the committer identity, false to read the author (also used for taggers)
+- when the date of the signature
+*/
+func configuredSignature(scopes []*ggitconfig.Config, committer bool, when time.Time) *ggitobject.Signature {
+	role := "author"
+	if committer {
+		role = "committer"
+	}
+	name := configOption(scopes, role, "name")
+	if "" == name {
+		name = configOption(scopes, "user", "name")
+	}
+	email := configOption(scopes, role, "email")
+	if "" == email {
+		email = configOption(scopes, "user", "email")
+	}
+	if "" == name || "" == email {
+		return nil
+	}
+	return &ggitobject.Signature{Name: name, Email: email, When: when}
+}
+
+/*
+Returns the key to sign commits with when the 'commit.gpgSign' option is enabled in the Git configuration, or nil
+if commits are not to be signed.
+
+The key is the one selected by the 'user.signingKey' option or, when not set, the one matching the email of the
+committer, just like git does. go-git can't access the keyring of the user so the key is exported from there using
+the gpg executable and it must not be protected by a passphrase. Use the CLI backend to sign with protected keys.
+
+Arguments are as follows:
+
+- scopes the configurations to read the options from, as returned by configScopes
+- committer the committer of the commit to sign. It may be nil.
+
+Errors can be:
+
+- GitError if signing is enabled but the key can't be found or used
+*/
+func configuredSignKey(scopes []*ggitconfig.Config, committer *ggitobject.Signature) (*openpgp.Entity, error) {
+	if !isConfigTrue(configOption(scopes, "commit", "gpgSign")) {
+		return nil, nil
+	}
+	if format := configOption(scopes, "gpg", "format"); "" != format && "openpgp" != strings.ToLower(format) {
+		return nil, &errs.GitError{Message: fmt.Sprintf("commit signing is enabled with the '%s' format, which is not supported by the go-git backend; use the CLI backend to sign commits with this format", format)}
+	}
+	keyID := configOption(scopes, "user", "signingKey")
+	if "" == keyID {
+		if committer == nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("commit signing is enabled but neither a signing key nor a committer identity is configured to select the key with")}
+		}
+		keyID = committer.Email
+	}
+	log.Debugf("commits will be signed with key '%s'", keyID)
+	cmd := exec.Command(GPG_EXECUTABLE, "--batch", "--armor", "--export-secret-keys", keyID)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil || len(out) == 0 {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to export the signing key '%s' using the '%s' executable: %s", keyID, GPG_EXECUTABLE, strings.TrimSpace(stderr.String())), Cause: err}
+	}
+	entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(out))
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the signing key '%s'", keyID), Cause: err}
+	}
+	for _, entity := range entities {
+		if entity.PrivateKey == nil {
+			continue
+		}
+		if entity.PrivateKey.Encrypted {
+			return nil, &errs.GitError{Message: fmt.Sprintf("the signing key '%s' is protected by a passphrase, which is not supported by the go-git backend; use the CLI backend to sign commits with this key", keyID)}
+		}
+		return entity, nil
+	}
+	return nil, &errs.GitError{Message: fmt.Sprintf("no private key found for the signing key '%s'", keyID)}
+}
+
+/*
+Commits changes to the repository. Files to commit must be staged separately using Add.
+
+Arguments are as follows:
+
+- message the commit message. Cannot be nil.
+- author the object modelling the commit author informations. It may be nil, in which case the default
+for the repository will be used
+- committer the object modelling the committer informations. It may be nil, in which case the default
+for the repository will be used
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+*/
+func (r goGitRepository) CommitWithMessageAndIdentities(message *string, author *gitent.Identity, committer *gitent.Identity) (gitent.Commit, error) {
+	log.Debugf("committing changes to repository")
+
+	if message == nil {
+		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("cannot commit with a nil message")}
+	}
+
+	worktree, err := r.worktree()
+	if err != nil {
+		return gitent.Commit{}, err
+	}
+	// the identities and signing options are read here as go-git doesn't honor them the way git does
+	scopes := r.configScopes()
+	var gAuthor *ggitobject.Signature = nil
+	var gCommitter *ggitobject.Signature = nil
+	if author != nil {
+		gAuthor = &ggitobject.Signature{Name: author.Name, Email: author.Email, When: r.options.timestamp()}
+	} else {
+		gAuthor = configuredSignature(scopes, false, r.options.timestamp())
+	}
+	if committer != nil {
+		gCommitter = &ggitobject.Signature{Name: committer.Name, Email: committer.Email, When: r.options.timestamp()}
+	} else if author == nil {
+		// just like go-git, an explicit author is also used as the committer
+		gCommitter = configuredSignature(scopes, true, r.options.timestamp())
+	}
+	signCommitter := gCommitter
+	if signCommitter == nil {
+		signCommitter = gAuthor
+	}
+	signKey, err := configuredSignKey(scopes, signCommitter)
+	if err != nil {
+		return gitent.Commit{}, err
+	}
+	commitHash, err := worktree.Commit(*message, &ggit.CommitOptions{All: false, Author: gAuthor, Committer: gCommitter, SignKey: signKey})
+	if err != nil {
+		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to commit"), Cause: err}
+	}
+	commit, err := r.repository.CommitObject(commitHash)
+	if err != nil {
+		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("an error occurred when retrieving the commit that has been created"), Cause: err}
+	}
+	return CommitFrom(*commit, []gitent.Tag{}), nil
+}
+
+/*
+Adds the given files to the staging area and commits changes to the repository. This method is a shorthand
+for Add and CommitWithMessage.
+
+Arguments are as follows:
+
+  - paths the file patterns of the contents to add to stage. Cannot be nil or empty. The path "." represents
+    all files in the working area so with that you can add all locally changed files.
+  - message the commit message. Cannot be nil.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+*/
+func (r goGitRepository) CommitPathsWithMessage(paths []string, message *string) (gitent.Commit, error) {
+	return r.CommitPathsWithMessageAndIdentities(paths, message, nil, nil)
+}
+
+/*
+Adds the given files to the staging area and commits changes to the repository. This method is a shorthand
+for Add and CommitWithMessageAndIdentities.
+
+Arguments are as follows:
+
+  - paths the file patterns of the contents to add to stage. Cannot be nil or empty. The path "." represents
+    all files in the working area so with that you can add all locally changed files.
+  - message the commit message. Cannot be nil.
+  - author the object modelling the commit author informations. It may be nil, in which case the default
+    for the repository will be used
+  - committer the object modelling the committer informations. It may be nil, in which case the default
+    for the repository will be used
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to commit.
+*/
+func (r goGitRepository) CommitPathsWithMessageAndIdentities(paths []string, message *string, author *gitent.Identity, committer *gitent.Identity) (gitent.Commit, error) {
+	err := r.Add(paths)
+	if err != nil {
+		return gitent.Commit{}, &errs.GitError{Message: fmt.Sprintf("an error occurred while staging contents to the repository"), Cause: err}
+	}
+	return r.CommitWithMessageAndIdentities(message, author, committer)
+}
+
+/*
+Returns the number of commits reachable from the 'to' commit and not reachable from the 'from' commit, following
+all the parents of merge commits, just like 'git rev-list --count from..to'. In shallow repositories only the
+commits available locally are counted.
+
+Arguments are as follows:
+
+  - from the optional SHA-1 id of the commit to count from, excluded along with all of its ancestors. If nil
+    all the commits reachable from the 'to' commit are counted. This can be a long or abbreviated SHA-1.
+  - to the optional SHA-1 id of the commit to count to, included. If nil the latest commit in the
+    current branch (HEAD) is used. This can be a long or abbreviated SHA-1.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or a given commit identifier cannot be resolved.
+*/
+func (r goGitRepository) CountCommitsBetween(from *string, to *string) (int, error) {
+	toID := "HEAD"
+	if to != nil {
+		toID = *to
+	}
+	toCommit, err := r.parseCommit(toID)
+	if err != nil {
+		return 0, err
+	}
+	excluded := map[ggitplumbing.Hash]bool{}
+	if from != nil {
+		fromCommit, err := r.parseCommit(*from)
+		if err != nil {
+			return 0, err
+		}
+		excluded, err = r.getAncestors(fromCommit, map[ggitplumbing.Hash]bool{})
+		if err != nil {
+			return 0, err
+		}
+	}
+	commits, err := r.getAncestors(toCommit, excluded)
+	if err != nil {
+		return 0, err
+	}
+	fromString := "not defined"
+	if from != nil {
+		fromString = *from
+	}
+	log.Debugf("there are '%d' commits between '%s' and '%s'", len(commits), fromString, toID)
+	return len(commits), nil
+}
+
+/*
+Returns the set of the given commit and all of its ancestors, following all the parents of merge commits, skipping
+the given excluded commits along with their ancestors. Parents that are not available locally because the repository
+is shallow are ignored.
+
+Arguments are as follows:
+
+  - commit the commit to start from
+  - excluded the set of commits to leave out, along with their ancestors
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) getAncestors(commit ggitobject.Commit, excluded map[ggitplumbing.Hash]bool) (map[ggitplumbing.Hash]bool, error) {
+	res := map[ggitplumbing.Hash]bool{}
+	if excluded[commit.Hash] {
+		return res, nil
+	}
+	shallow, err := r.IsShallow()
+	if err != nil {
+		return nil, err
+	}
+	res[commit.Hash] = true
+	queue := []ggitplumbing.Hash{commit.Hash}
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		c, err := r.repository.CommitObject(hash)
+		if err != nil {
+			if err == ggitplumbing.ErrObjectNotFound && shallow {
+				// the commit is beyond the shallow boundary
+				delete(res, hash)
+				continue
+			}
+			return nil, &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
+		}
+		for _, parentHash := range c.ParentHashes {
+			if !res[parentHash] && !excluded[parentHash] {
+				res[parentHash] = true
+				queue = append(queue, parentHash)
+			}
+		}
+	}
+	return res, nil
+}
+
+/*
+Deletes the local tag with the given name. Only the tag reference is removed while the tagged commit is left untouched.
+
+Arguments are as follows:
+
+- name the name of the tag to delete.
+
+Errors can be:
+
+- GitError in case the tag does not exist or some problem is encountered with the underlying Git repository, preventing to delete the tag.
+*/
+func (r goGitRepository) DeleteTag(name string) error {
+	log.Debugf("deleting tag '%s'", name)
+	err := r.repository.DeleteTag(name)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to delete tag '%s'", name), Cause: err}
+	}
+	return nil
+}
+
+/*
+Deletes the tag with the given name from the given remote, using the given options. Deleting a tag that does not
+exist on the remote is not an error.
+
+Returns the local name of the remote that the tag has been deleted from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to delete the tag from. If empty the default remote name (origin) is used.
+  - name the name of the tag to delete.
+  - auth the authentication method to use. It may be nil.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to delete the tag.
+*/
+func (r goGitRepository) deleteRemoteTag(remote string, name string, auth ggittransport.AuthMethod) (string, error) {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	// an empty source in the refspec deletes the destination reference on the remote
+	deleteRefSpec := ggitconfig.RefSpec(":" + ggitplumbing.NewTagReferenceName(name).String())
+	options := &ggit.PushOptions{RemoteName: remote, RefSpecs: []ggitconfig.RefSpec{deleteRefSpec}, Auth: auth}
+
+	err := r.push(options)
+	if err != nil {
+		if err == ggit.NoErrAlreadyUpToDate {
+			log.Debugf("tag '%s' was already missing from remote repository '%s'", name, remote)
+		} else {
+			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to delete tag '%s' from remote '%s'", name, remote), Cause: classifyRemoteError(err)}
+		}
+	}
+	return remote, nil
+}
+
+/*
+Deletes the tag with the given name from the given remote. Deleting a tag that does not exist on the remote is not an error.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remote that the tag has been deleted from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to delete the tag from. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to delete.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to delete the tag.
+*/
+func (r goGitRepository) DeleteRemoteTagWithUserNameAndPassword(remote *string, name string, user *string, password *string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("deleting tag '%s' from remote repository '%s' using username and password", name, remoteString)
+
+	auth, err := getUserNameAndPasswordAuth(user, password, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	if auth != nil {
+		log.Debugf("username and password authentication will use custom authentication options")
+		return r.deleteRemoteTag(remoteString, name, auth)
+	}
+	log.Debugf("username and password authentication will not use any custom authentication options")
+	return r.deleteRemoteTag(remoteString, name, nil)
+}
+
+/*
+Deletes the tag with the given name from the given remote. Deleting a tag that does not exist on the remote is not an error.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remote that the tag has been deleted from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to delete the tag from. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to delete.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to delete the tag.
+*/
+func (r goGitRepository) DeleteRemoteTagWithToken(remote *string, name string, token *string, user *string) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't delete remote tags using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, true))
+	return r.DeleteRemoteTagWithUserNameAndPassword(remote, name, &tokenUser, &tokenPassword)
+}
+
+/*
+Deletes the tag with the given name from the given remote. Deleting a tag that does not exist on the remote is not an error.
+This method allows using SSH authentication.
+
+Returns the local name of the remote that the tag has been deleted from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to delete the tag from. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to delete.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to delete the tag.
+*/
+func (r goGitRepository) DeleteRemoteTagWithPublicKeyAndHostKeys(remote *string, name string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("deleting tag '%s' from remote repository '%s' using public key (SSH) authentication", name, remoteString)
+
+	auth, err := getSSHAuth(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	if auth != nil {
+		log.Debugf("public key (SSH) authentication will use custom authentication options")
+		return r.deleteRemoteTag(remoteString, name, auth)
+	}
+	log.Debugf("public key (SSH) authentication will not use any custom authentication options")
+	return r.deleteRemoteTag(remoteString, name, nil)
+}
+
+/*
+Fetches all the tags from the given remote, replacing local tags with the same name, using the given options.
+
+Returns the local name of the remote that tags have been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If empty the default remote name (origin) is used.
+  - auth the authentication method to use. It may be nil.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r goGitRepository) fetchTags(remote string, auth ggittransport.AuthMethod) (string, error) {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	// the leading '+' lets local tags be replaced by remote tags with the same name
+	tagsRefSpec := ggitconfig.RefSpec("+refs/tags/*:refs/tags/*")
+	options := &ggit.FetchOptions{RemoteName: remote, RefSpecs: []ggitconfig.RefSpec{tagsRefSpec}, Tags: ggit.NoTags, Auth: auth}
+
+	err := r.fetch(options)
+	if err != nil {
+		if err == ggit.NoErrAlreadyUpToDate {
+			log.Debugf("tags were already up-to-date with remote repository '%s'", remote)
+		} else {
+			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to fetch tags from remote '%s'", remote), Cause: classifyRemoteError(err)}
+		}
+	}
+	return remote, nil
+}
+
+/*
+Fetches all the tags from the given remote, replacing local tags with the same name.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remote that tags have been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r goGitRepository) FetchTagsFromRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("fetching tags from remote repository '%s' using username and password", remoteString)
+
+	auth, err := getUserNameAndPasswordAuth(user, password, r.getRewrittenRemoteURL(remoteString, false))
+	if err != nil {
+		return "", err
+	}
+	if auth != nil {
+		log.Debugf("username and password authentication will use custom authentication options")
+		return r.fetchTags(remoteString, auth)
+	}
+	log.Debugf("username and password authentication will not use any custom authentication options")
+	return r.fetchTags(remoteString, nil)
+}
+
+/*
+Fetches all the tags from the given remote, replacing local tags with the same name.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remote that tags have been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r goGitRepository) FetchTagsFromRemoteWithToken(remote *string, token *string, user *string) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't fetch using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, false))
+	return r.FetchTagsFromRemoteWithUserNameAndPassword(remote, &tokenUser, &tokenPassword)
+}
+
+/*
+Fetches all the tags from the given remote, replacing local tags with the same name.
+This method allows using SSH authentication.
+
+Returns the local name of the remote that tags have been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r goGitRepository) FetchTagsFromRemoteWithPublicKeyAndHostKeys(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("fetching tags from remote repository '%s' using public key (SSH) authentication", remoteString)
+
+	auth, err := getSSHAuth(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, false))
+	if err != nil {
+		return "", err
+	}
+	if auth != nil {
+		log.Debugf("public key (SSH) authentication will use custom authentication options")
+		return r.fetchTags(remoteString, auth)
+	}
+	log.Debugf("public key (SSH) authentication will not use any custom authentication options")
+	return r.fetchTags(remoteString, nil)
+}
+
+/*
+Returns the names of the branches in the repository, mapped to the SHA-1 identifier of the commit they point to.
+Local branches are returned with their short name (i.e. 'main'), while remote tracking branches, when requested,
+are returned with the remote name as a prefix (i.e. 'origin/main'). Symbolic references like 'origin/HEAD'
+are not returned.
+
+Arguments are as follows:
+
+- includeRemote when true remote tracking branches are returned along with local branches.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) GetBranchNames(includeRemote bool) (map[string]string, error) {
+	log.Debugf("retrieving repository branch names")
+	referencesIterator, err := r.repository.References()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("cannot list repository references"), Cause: err}
+	}
+	res := make(map[string]string)
+	if err := referencesIterator.ForEach(func(ref *ggitplumbing.Reference) error {
+		if ref.Type() != ggitplumbing.HashReference {
+			return nil
+		}
+		if ref.Name().IsBranch() {
+			res[ref.Name().Short()] = ref.Hash().String()
+		} else if includeRemote && ref.Name().IsRemote() {
+			res[strings.TrimPrefix(ref.Name().String(), "refs/remotes/")] = ref.Hash().String()
+		}
+		return nil
+	}); err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("error while listing repository branches"), Cause: err}
+	}
+
+	log.Debugf("repository branch names are '%v'", res)
+	return res, nil
+}
+
+/*
+Returns the paths of the files changed by the given commit, compared to its first parent. When the commit
+has no parents (it's the root commit) all the files in the commit tree are returned. Paths are relative
+to the repository root and use the forward slash as the separator. Renamed files are returned with both
+their old and new paths.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the changed paths for. It can be a full or abbreviated SHA-1.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) GetCommitChangedPaths(commit string) ([]string, error) {
+	log.Debugf("retrieving changed paths for commit '%s'", commit)
+	c, err := r.parseCommit(commit)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve commit '%s'", commit), Cause: err}
+	}
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve the tree for commit '%s'", commit), Cause: err}
+	}
+
+	res := []string{}
+	if len(c.ParentHashes) == 0 {
+		// the root commit, all files are new
+		if err := tree.Files().ForEach(func(f *ggitobject.File) error {
+			res = append(res, f.Name)
+			return nil
+		}); err != nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to list the files for commit '%s'", commit), Cause: err}
+		}
+		return res, nil
+	}
+
+	parent, err := r.repository.CommitObject(c.ParentHashes[0]) // always compare to the first parent, ignore others, if any
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve the parent of commit '%s'", commit), Cause: err}
+	}
+	parentTree, err := parent.Tree()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve the tree for commit '%s'", parent.Hash.String()), Cause: err}
+	}
+	changes, err := ggitobject.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to compute the changes for commit '%s'", commit), Cause: err}
+	}
+	for _, change := range changes {
+		if change.From.Name != "" {
+			res = append(res, change.From.Name)
+		}
+		if change.To.Name != "" && change.To.Name != change.From.Name {
+			res = append(res, change.To.Name)
+		}
+	}
+	return res, nil
+}
+
+/*
+Returns the files changed by the given commit, compared to its first parent, along with the number of lines
+added and deleted in each file. When the commit has no parents (it's the root commit) all the files in the
+commit tree are returned as added. Paths are relative to the repository root and use the forward slash as the
+separator. Renames are not detected so renamed files are returned as a deleted file and an added file.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the changes for. It can be a full or abbreviated SHA-1.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) GetCommitChanges(commit string) ([]gitent.FileChange, error) {
+	log.Debugf("retrieving changes for commit '%s'", commit)
+	c, err := r.parseCommit(commit)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve commit '%s'", commit), Cause: err}
+	}
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve the tree for commit '%s'", commit), Cause: err}
+	}
+
+	// the root commit is compared to the empty tree, so all files are new
+	var parentTree *ggitobject.Tree
+	if len(c.ParentHashes) > 0 {
+		parent, err := r.repository.CommitObject(c.ParentHashes[0]) // always compare to the first parent, ignore others, if any
+		if err != nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve the parent of commit '%s'", commit), Cause: err}
+		}
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve the tree for commit '%s'", parent.Hash.String()), Cause: err}
+		}
+	}
+	changes, err := ggitobject.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to compute the changes for commit '%s'", commit), Cause: err}
+	}
+
+	// lines are counted here instead of using the go-git patch statistics, which don't always match those by Git
+	res := []gitent.FileChange{}
+	for _, change := range changes {
+		from, to, err := change.Files()
+		if err != nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the files changed by commit '%s'", commit), Cause: err}
+		}
+		path := change.To.Name
+		if to == nil {
+			path = change.From.Name
+		}
+		fromLines, fromBinary, err := fileLines(from)
+		if err != nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the files changed by commit '%s'", commit), Cause: err}
+		}
+		toLines, toBinary, err := fileLines(to)
+		if err != nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the files changed by commit '%s'", commit), Cause: err}
+		}
+		if fromBinary || toBinary {
+			// binary files have no lines
+			res = append(res, *gitent.NewFileChangeWith(path, 0, 0))
+		} else {
+			additions, deletions := countLineChanges(fromLines, toLines)
+			res = append(res, *gitent.NewFileChangeWith(path, additions, deletions))
+		}
+	}
+	return res, nil
+}
+
+/*
+Returns the lines of the given file, each with its line terminator, and a flag telling whether the file is binary,
+in which case lines are not returned. A nil file has no lines.
+
+Arguments are as follows:
+
+- file the file to read. It may be nil.
+*/
+func fileLines(file *ggitobject.File) ([]string, bool, error) {
+	if file == nil {
+		return nil, false, nil
+	}
+	binary, err := file.IsBinary()
+	if err != nil || binary {
+		return nil, binary, err
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return nil, false, err
+	}
+	if "" == content {
+		return nil, false, nil
+	}
+	lines := strings.SplitAfter(content, "\n")
+	if "" == lines[len(lines)-1] {
+		// the content ends with a line terminator
+		lines = lines[:len(lines)-1]
+	}
+	return lines, false, nil
+}
+
+/*
+Returns the number of lines added and deleted to turn the given lines into the others, computed as the shortest edit
+script between the two, like Git does by default (using the Myers algorithm).
+
+Arguments are as follows:
+
+- from the original lines
+- to the new lines
+*/
+func countLineChanges(from []string, to []string) (int, int) {
+	// lines in common at the beginning and at the end are skipped as they don't affect the result
+	for len(from) > 0 && len(to) > 0 && from[0] == to[0] {
+		from, to = from[1:], to[1:]
+	}
+	for len(from) > 0 && len(to) > 0 && from[len(from)-1] == to[len(to)-1] {
+		from, to = from[:len(from)-1], to[:len(to)-1]
+	}
+	n, m := len(from), len(to)
+	if n == 0 || m == 0 {
+		return m, n
+	}
+
+	// v holds the furthest reaching x on each diagonal k = x - y, offset by max
+	max := n + m
+	v := make([]int, 2*max+2)
+	for d := 0; d <= max; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[max+k-1] < v[max+k+1]) {
+				x = v[max+k+1]
+			} else {
+				x = v[max+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && from[x] == to[y] {
+				x, y = x+1, y+1
+			}
+			v[max+k] = x
+			if x >= n && y >= m {
+				// d is the number of lines deleted and added, while the common lines are (n + m - d) / 2
+				common := (n + m - d) / 2
+				return m - common, n - common
+			}
+		}
+	}
+	return m, n
+}
+
+/*
+Returns the content of the file with the given path as it is in the tree of the given commit, regardless of
+the contents of the working tree.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to read the file from. It can be a full or abbreviated SHA-1.
+- path the path of the file, relative to the repository root and using the forward slash as the separator.
+- maxSize the maximum size of the file, in bytes. Larger files are not read and an error is returned.
+
+Errors can be:
+
+  - GitError in case the file does not exist in the commit, it's larger than the given size or some problem is
+    encountered with the underlying Git repository.
+*/
+func (r goGitRepository) GetCommitFileContent(commit string, path string, maxSize int64) (string, error) {
+	log.Debugf("reading file '%s' from commit '%s'", path, commit)
+	c, err := r.parseCommit(commit)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve commit '%s'", commit), Cause: err}
+	}
+	file, err := c.File(path)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to find file '%s' in commit '%s'", path, commit), Cause: err}
+	}
+	if file.Size > maxSize {
+		return "", &errs.GitError{Message: fmt.Sprintf("file '%s' in commit '%s' is %d bytes long, exceeding the maximum size of %d bytes", path, commit, file.Size, maxSize)}
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to read file '%s' from commit '%s'", path, commit), Cause: err}
+	}
+	return content, nil
+}
+
+/*
+Returns the patch identifier of the given commit. The patch identifier is computed from the changes the commit
+introduces compared to its first parent, ignoring whitespaces and line numbers, so commits introducing the same
+changes (i.e. commits cherry-picked from one branch to another) have the same patch identifier, like the ones
+returned by 'git patch-id'. The returned value is empty when the commit introduces no changes.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the patch identifier for. It can be a full or abbreviated SHA-1.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) GetCommitPatchID(commit string) (string, error) {
+	log.Debugf("computing the patch identifier for commit '%s'", commit)
+	c, err := r.parseCommit(commit)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve commit '%s'", commit), Cause: err}
+	}
+	tree, err := c.Tree()
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve the tree for commit '%s'", commit), Cause: err}
+	}
+	var parentTree *ggitobject.Tree
+	if len(c.ParentHashes) > 0 {
+		parent, err := r.repository.CommitObject(c.ParentHashes[0]) // always compare to the first parent, ignore others, if any
+		if err != nil {
+			return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve the parent of commit '%s'", commit), Cause: err}
+		}
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve the tree for commit '%s'", parent.Hash.String()), Cause: err}
+		}
+	}
+	changes, err := ggitobject.DiffTree(parentTree, tree)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to compute the changes for commit '%s'", commit), Cause: err}
+	}
+	if len(changes) == 0 {
+		return "", nil
+	}
+	patch, err := changes.Patch()
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to compute the patch for commit '%s'", commit), Cause: err}
+	}
+
+	hash := sha1.New()
+	for _, filePatch := range patch.FilePatches() {
+		from, to := filePatch.Files()
+		if from != nil {
+			fmt.Fprintf(hash, "--- %s\n", from.Path())
+		}
+		if to != nil {
+			fmt.Fprintf(hash, "+++ %s\n", to.Path())
+		}
+		if filePatch.IsBinary() {
+			// binary contents can't be compared line by line so use the identifiers of the blobs
+			if from != nil {
+				fmt.Fprintf(hash, "-%s\n", from.Hash().String())
+			}
+			if to != nil {
+				fmt.Fprintf(hash, "+%s\n", to.Hash().String())
+			}
+			continue
+		}
+		for _, chunk := range filePatch.Chunks() {
+			var prefix string
+			switch chunk.Type() {
+			case ggitdiff.Add:
+				prefix = "+"
+			case ggitdiff.Delete:
+				prefix = "-"
+			default:
+				continue // context lines don't affect the patch identifier
+			}
+			for _, line := range strings.Split(chunk.Content(), "\n") {
+				// whitespaces are ignored, like 'git patch-id' does
+				line = strings.Join(strings.Fields(line), "")
+				if "" != line {
+					fmt.Fprintf(hash, "%s%s\n", prefix, line)
+				}
+			}
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+/*
+Returns a set of objects representing all the tags for the given commit.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the tags for. It can be a full or abbreviated SHA-1.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) GetCommitTags(commit string) ([]gitent.Tag, error) {
+	log.Debugf("retrieving tags for commit '%s'", commit)
+	var res []gitent.Tag
+	tagsIterator, err := r.repository.Tags()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("cannot list repository tags"), Cause: err}
+	}
+	if err := tagsIterator.ForEach(func(ref *ggitplumbing.Reference) error {
+		// in order to check if the tag has this commit as target we first need to figure out if it's annotated or lightweight
+		tagObject, err := r.repository.TagObject(ref.Hash())
+		switch err {
+		case nil:
+			// it's an annotated tag
+			if strings.HasPrefix(tagObject.Target.String(), commit) {
+				res = append(res, TagFrom(r.repository, *ref))
+			}
+		case ggitplumbing.ErrObjectNotFound:
+			// it's a lightweight tag
+			if strings.HasPrefix(ref.Hash().String(), commit) {
+				res = append(res, TagFrom(r.repository, *ref))
+			}
+		default:
+			// Some other error occurred
+			return &errs.GitError{Message: fmt.Sprintf("error while listing repository tags"), Cause: err}
+		}
+		return nil
+	}); err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("error while listing repository tags"), Cause: err}
+	}
+	return res, nil
+}
+
+/*
+Returns all the tags in the repository indexed by the full SHA-1 identifier of the commit they point to, so that
+the tags of many commits can be looked up without listing the repository tags for each commit, like GetCommitTags does.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) getTagsByTarget() (map[string][]gitent.Tag, error) {
+	tags, err := r.GetTags()
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string][]gitent.Tag)
+	for _, tag := range tags {
+		res[tag.Target] = append(res[tag.Target], tag)
+	}
+	log.Debugf("indexed '%d' tags by their target commits", len(tags))
+	return res, nil
+}
+
+/*
+Returns the identity configured for the repository (the user.name and user.email Git options), looking up
+the repository, the global and the system configuration, or nil if no complete identity is configured.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) GetConfiguredIdentity() (*gitent.Identity, error) {
+	_, err := r.repository.Storer.Config()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the repository configuration"), Cause: err}
+	}
+	scopes := r.configScopes()
+	name := configOption(scopes, "user", "name")
+	email := configOption(scopes, "user", "email")
+	if "" == name || "" == email {
+		return nil, nil
+	}
+	return gitent.NewIdentityWith(name, email), nil
+}
+
+/*
+Returns the name of the current branch or a commit SHA-1 if the repository is in the detached head state.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or is in the 'detached HEAD' state.
+*/
+func (r goGitRepository) GetCurrentBranch() (string, error) {
+	ref, err := r.repository.Head()
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+
+	// also strip the leading "refs/heads/" from the reference name
+	return strings.Replace(ref.Name().String(), "refs/heads/", "", 1), nil
+}
+
+/*
+Returns the name of the default branch of the given remote, which is the branch its HEAD points to. The
+remote tracking reference (i.e. 'refs/remotes/origin/HEAD') is used when available, otherwise the remote is
+queried for the branch its HEAD points to (like 'git ls-remote --symref' does), without fetching anything.
+When the remote requires authentication the credentials are read from the netrc file, if any.
+The returned name has no 'refs/heads/' prefix (i.e. 'main').
+
+Arguments are as follows:
+
+- remote the name of the remote. If nil or empty the default remote name (origin) is used.
+
+Errors can be:
+
+- GitError in case the remote is not configured, can't be reached or doesn't advertise its HEAD.
+*/
+func (r goGitRepository) GetDefaultBranch(remote *string) (string, error) {
+	remoteString := ggit.DefaultRemoteName
+	if remote != nil && "" != *remote {
+		remoteString = *remote
+	}
+	log.Debugf("retrieving the default branch of remote '%s'", remoteString)
+	head, err := r.repository.Reference(ggitplumbing.NewRemoteHEADReferenceName(remoteString), false)
+	if err == nil && head.Type() == ggitplumbing.SymbolicReference {
+		defaultBranch := strings.TrimPrefix(head.Target().String(), "refs/remotes/"+remoteString+"/")
+		log.Debugf("the default branch of remote '%s' is '%s', as tracked locally", remoteString, defaultBranch)
+		return defaultBranch, nil
+	}
+
+	uri := r.getRewrittenRemoteURL(remoteString, false)
+	if "" == uri {
+		return "", &errs.GitError{Message: fmt.Sprintf("the remote '%s' is not configured or has no URL", remoteString)}
+	}
+	references, err := r.options.listRemoteReferencesWithUserNameAndPassword(&uri, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	defaultBranch := getRemoteDefaultBranch(references)
+	if "" == defaultBranch {
+		return "", &errs.GitError{Message: fmt.Sprintf("the remote '%s' doesn't advertise the branch its HEAD points to", remoteString)}
+	}
+	log.Debugf("the default branch of remote '%s' is '%s', as advertised by the remote", remoteString, defaultBranch)
+	return defaultBranch, nil
+}
+
+/*
+Returns the hash algorithm (object format) used by the repository to identify objects.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) GetHashAlgorithm() (gitent.HashAlgorithm, error) {
+	return hashAlgorithmOf(r.repository)
+}
+
+/*
+Returns the SHA-1 identifier of the last commit in the current branch.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or is in the 'detached HEAD' state.
+*/
+func (r goGitRepository) GetLatestCommit() (string, error) {
+	ref, err := r.repository.Head()
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	commitSHA := ref.Hash().String()
+	log.Debugf("repository latest commit in HEAD branch is '%s'", commitSHA)
+	return commitSHA, nil
+}
+
+/*
+Returns the SHA-1 identifier of the first commit in the repository (the only commit with no parents).
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or is in the 'detached HEAD' state.
+*/
+func (r goGitRepository) GetRootCommit() (string, error) {
+	ref, err := r.repository.Head()
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	// the Log method doesn't let us follow the firt parent, so we need to go through all commits and stop at the end
+	commit, err := r.parseCommit(ref.Hash().String())
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("an error occurred while walking the commit history at commit '%s'", ref.Hash().String()), Cause: err}
+	}
+	for len(commit.ParentHashes) > 0 {
+		c, err := r.repository.CommitObject(commit.ParentHashes[0]) // always follow the first parent, ignore others, if any
+		if err != nil {
+			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred while walking the commit history at commit '%s'", ref.Hash().String()), Cause: err}
+		}
+		commit = *c
+	}
+	commitSHA := commit.Hash.String()
+	log.Debugf("repository latest commit in HEAD branch is '%s'", commitSHA)
+	return commitSHA, nil
+}
+
+/*
+Returns a set of objects representing all the tags for the repository.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) GetTags() ([]gitent.Tag, error) {
+	log.Debugf("retrieving all tags")
+	var res []gitent.Tag
+	tagsIterator, err := r.repository.Tags()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("cannot list repository tags"), Cause: err}
+	}
+	if err := tagsIterator.ForEach(func(ref *ggitplumbing.Reference) error {
+		switch err {
+		case nil:
+			// it's an annotated tag
+			res = append(res, TagFrom(r.repository, *ref))
+		case ggitplumbing.ErrObjectNotFound:
+			// it's a lightweight tag
+			res = append(res, TagFrom(r.repository, *ref))
+		default:
+			// Some other error occurred
+			return &errs.GitError{Message: fmt.Sprintf("error while listing repository tags"), Cause: err}
+		}
+		return nil
+	}); err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("error while listing repository tags"), Cause: err}
+	}
+	return res, nil
+}
+
+/*
+Returns how many commits the current branch is ahead of and behind its upstream branch (the one configured
+with the 'branch.<name>.remote' and 'branch.<name>.merge' options). The returned value is nil when the current
+branch has no upstream or its upstream has no remote tracking reference.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or is in the 'detached HEAD' state.
+*/
+func (r goGitRepository) GetTrackingStatus() (*gitent.TrackingStatus, error) {
+	head, err := r.repository.Head()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	if !head.Name().IsBranch() {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to get the tracking status as the repository is in the 'detached HEAD' state")}
+	}
+	branch := head.Name().Short()
+	config, err := r.repository.Config()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the repository configuration"), Cause: err}
+	}
+	branchConfig, ok := config.Branches[branch]
+	if !ok || "" == branchConfig.Remote || "" == branchConfig.Merge {
+		log.Debugf("branch '%s' has no upstream", branch)
+		return nil, nil
+	}
+
+	// the upstream is a local branch when the remote is '.', otherwise it's the remote tracking branch
+	upstreamReferenceName := branchConfig.Merge
+	if "." != branchConfig.Remote {
+		upstreamReferenceName = ggitplumbing.NewRemoteReferenceName(branchConfig.Remote, branchConfig.Merge.Short())
+	}
+	upstream, err := r.repository.Reference(upstreamReferenceName, true)
+	if err != nil {
+		log.Debugf("the upstream of branch '%s' has no reference '%s'", branch, upstreamReferenceName.String())
+		return nil, nil
+	}
+	upstreamName := upstreamReferenceName.Short()
+
+	ahead, err := r.countCommitsNotReachableFrom(head.Hash(), upstream.Hash())
+	if err != nil {
+		return nil, err
+	}
+	behind, err := r.countCommitsNotReachableFrom(upstream.Hash(), head.Hash())
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("branch '%s' is '%d' commits ahead of and '%d' commits behind its upstream '%s'", branch, ahead, behind, upstreamName)
+	return gitent.NewTrackingStatusWith(branch, upstreamName, ahead, behind), nil
+}
+
+/*
+Returns true if the given branch exists on the given remote. The remote tracking reference (i.e.
+'refs/remotes/origin/main') is used when available, otherwise the remote is queried for its branches
+(like 'git ls-remote --heads' does), without fetching anything.
+When the remote requires authentication the credentials are read from the netrc file, if any.
+
+Arguments are as follows:
+
+- remote the name of the remote. If nil or empty the default remote name (origin) is used.
+- branch the name of the branch, without the 'refs/heads/' prefix. If empty the current branch is used.
+
+Errors can be:
+
+- GitError in case the remote is not configured or can't be reached or the current branch can't be resolved.
+*/
+func (r goGitRepository) HasRemoteBranch(remote *string, branch string) (bool, error) {
+	remoteString := ggit.DefaultRemoteName
+	if remote != nil && "" != *remote {
+		remoteString = *remote
+	}
+	if "" == strings.TrimSpace(branch) {
+		currentBranch, err := r.GetCurrentBranch()
+		if err != nil {
+			return false, err
+		}
+		if "HEAD" == currentBranch {
+			return false, &errs.GitError{Message: fmt.Sprintf("unable to resolve the current branch as the repository is in the 'detached HEAD' state")}
+		}
+		branch = currentBranch
+	}
+	log.Debugf("checking if branch '%s' exists on remote '%s'", branch, remoteString)
+	_, err := r.repository.Reference(ggitplumbing.NewRemoteReferenceName(remoteString, branch), false)
+	if err == nil {
+		log.Debugf("branch '%s' exists on remote '%s', as tracked locally", branch, remoteString)
+		return true, nil
+	}
+
+	uri := r.getRewrittenRemoteURL(remoteString, false)
+	if "" == uri {
+		return false, &errs.GitError{Message: fmt.Sprintf("the remote '%s' is not configured or has no URL", remoteString)}
+	}
+	references, err := r.options.listRemoteReferencesWithUserNameAndPassword(&uri, nil, nil)
+	if err != nil {
+		// a remote with no commits yet has no branches at all
+		if gitErr, ok := err.(*errs.GitError); ok && gitErr.Cause == ggittransport.ErrEmptyRemoteRepository {
+			log.Debugf("branch '%s' doesn't exist on remote '%s' as the remote is empty", branch, remoteString)
+			return false, nil
+		}
+		return false, err
+	}
+	for _, reference := range references {
+		if reference.Name() == ggitplumbing.NewBranchReferenceName(branch) {
+			log.Debugf("branch '%s' exists on remote '%s', as advertised by the remote", branch, remoteString)
+			return true, nil
+		}
+	}
+	log.Debugf("branch '%s' doesn't exist on remote '%s'", branch, remoteString)
+	return false, nil
+}
+
+/*
+Returns the number of commits reachable from the given start commit and not reachable from the given excluded commit.
+*/
+func (r goGitRepository) countCommitsNotReachableFrom(start ggitplumbing.Hash, excluded ggitplumbing.Hash) (int, error) {
+	if start == excluded {
+		return 0, nil
+	}
+	excludedCommits := make(map[ggitplumbing.Hash]bool)
+	iterator, err := r.repository.Log(&ggit.LogOptions{From: excluded})
+	if err != nil {
+		return 0, &errs.GitError{Message: fmt.Sprintf("an error occurred while walking the commit history at commit '%s'", excluded.String()), Cause: err}
+	}
+	err = iterator.ForEach(func(commit *ggitobject.Commit) error {
+		excludedCommits[commit.Hash] = true
+		return nil
+	})
+	if err != nil {
+		return 0, &errs.GitError{Message: fmt.Sprintf("an error occurred while walking the commit history at commit '%s'", excluded.String()), Cause: err}
+	}
+	count := 0
+	iterator, err = r.repository.Log(&ggit.LogOptions{From: start})
+	if err != nil {
+		return 0, &errs.GitError{Message: fmt.Sprintf("an error occurred while walking the commit history at commit '%s'", start.String()), Cause: err}
+	}
+	err = iterator.ForEach(func(commit *ggitobject.Commit) error {
+		if !excludedCommits[commit.Hash] {
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, &errs.GitError{Message: fmt.Sprintf("an error occurred while walking the commit history at commit '%s'", start.String()), Cause: err}
+	}
+	return count, nil
+}
+
+/*
+Returns the names of configured remote repositories.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or is in the 'detached HEAD' state.
+*/
+func (r goGitRepository) GetRemoteNames() ([]string, error) {
+	log.Debugf("retrieving repository remote names")
+	remotes, err := r.repository.Remotes()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to get the repository remotes"), Cause: err}
+	}
+	remoteNames := make([]string, len(remotes))
+	for i, rmt := range remotes {
+		remoteNames[i] = rmt.Config().Name
+	}
+
+	log.Debugf("repository remote names are '%v'", remoteNames)
+	return remoteNames, nil
+}
+
+/*
+Returns the URI of the remote repository with the given name, which is the first URL configured for the remote.
+
+Arguments are as follows:
+
+- remote the name of the remote. If nil or empty the default remote name (origin) is used.
+
+Errors can be:
+
+- GitError in case the remote is not configured or has no URL.
+*/
+func (r goGitRepository) GetRemoteURL(remote *string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	uri := r.getRemoteURL(remoteString)
+	if "" == uri {
+		return "", &errs.GitError{Message: fmt.Sprintf("the remote '%s' is not configured or has no URL", remoteString)}
+	}
+	return uri, nil
+}
+
+/*
+Returns the configured remote repositories, sorted by name, with the URLs to fetch from and push to.
+When a remote has no push URL configured (the 'pushurl' option), its push URLs are the same used to fetch.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) GetRemotes() ([]gitent.Remote, error) {
+	log.Debugf("retrieving repository remotes")
+	config, err := r.repository.Config()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the repository configuration"), Cause: err}
+	}
+	remoteNames := make([]string, 0, len(config.Remotes))
+	for name := range config.Remotes {
+		remoteNames = append(remoteNames, name)
+	}
+	sort.Strings(remoteNames)
+
+	res := make([]gitent.Remote, 0, len(remoteNames))
+	for _, name := range remoteNames {
+		urls := config.Remotes[name].URLs
+		fetchURL := ""
+		if len(urls) > 0 {
+			fetchURL = urls[0]
+		}
+		// go-git doesn't model push URLs so they are read from the raw configuration
+		pushURLs := config.Raw.Section("remote").Subsection(name).OptionAll("pushurl")
+		if len(pushURLs) == 0 {
+			pushURLs = append([]string{}, urls...)
+		}
+		res = append(res, *gitent.NewRemoteWith(name, fetchURL, pushURLs))
+	}
+
+	log.Debugf("repository remotes are '%v'", res)
+	return res, nil
+}
+
+/*
+Returns true if the repository is clean, which is when no differences exist between the working tree, the index,
+and the current HEAD. Bare repositories are always clean.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
     the repository has no commits yet or is in the 'detached HEAD' state.
 */
-func (r goGitRepository) GetRootCommit() (string, error) {
+func (r goGitRepository) IsClean() (bool, error) {
+	log.Debugf("checking repository clean status")
+	if r.bare {
+		log.Debugf("the repository is bare and has no working tree so it's clean")
+		return true, nil
+	}
+	wt, err := r.worktree()
+	if err != nil {
+		return false, err
+	}
+	status, err := wt.Status()
+	if err != nil {
+		return false, &errs.GitError{Message: fmt.Sprintf("unable to get the repository worktree status"), Cause: err}
+	}
+	submodulesClean, err := r.options.areSubmodulesClean(wt, status)
+	if err != nil {
+		return false, &errs.GitError{Message: fmt.Sprintf("unable to get the status of the repository submodules"), Cause: err}
+	}
+	log.Debugf("repository clean status is: '%v' ('%v')", status.IsClean() && submodulesClean, status.String())
+	for fileName, fileStatus := range status {
+		log.Tracef("repository status for '%v' is: untracked='%v', staging='%v', worktree='%v', extra='%v', ", fileName, status.IsUntracked(fileName), string((*fileStatus).Staging), string((*fileStatus).Worktree), (*fileStatus).Extra)
+	}
+	log.Tracef("repository status flags are: Unmodified = ' ', Untracked = '?', Modified = 'M', Added = 'A', Deleted = 'D', Renamed = 'R', Copied = 'C', UpdatedButUnmerged = 'U'")
+
+	// TODO: remove this workaround (within the 'if' statement) when https://github.com/mooltiverse/nyx/issues/130 is fixed
+	// The go-git library has a bug that sometimes makes it return 'false' from status.IsClean() (meaning the repository is
+	// DIRTY, with uncommitted changes) even when it's clean (proven by using git on the command line).
+	// As per my tests, the bug occurs when the repository has text files with CR or CRLF (line endings), but is probably
+	// also connected to repositories with LFS and maybe others.
+	// This workaround is here to cope with:
+	// - https://github.com/mooltiverse/nyx/issues/130
+	// - https://github.com/mooltiverse/nyx/issues/129
+	// as long as the go-git library doesn't fix the bug. Bugs to keep an eye on for a fix are:
+	// - https://github.com/go-git/go-git/issues/500
+	// - https://github.com/go-git/go-git/issues/436
+	// - https://github.com/go-git/go-git/issues/227
+	// - https://github.com/go-git/go-git/issues/91
+	clean := status.IsClean() && submodulesClean
+	if !clean {
+		// When the repository return false (which may be wrong), double check by running the git executable.
+		log.Debugf("workaround #130: go-git returned 'false' when the repository status was checked to see whether it was clean or not, this means it considers the repository in a DIRTY state. However, go-git has a bug which sometimes returns 'false' even when the Git command returns true so now the 'git' command, if available, will be executed to double check, and its output will be considered the only one reliable, overcoming the result provided by the go-git library")
+		commandPath, err := exec.LookPath("git")
+		if err != nil {
+			log.Debugf("workaround #130: an error was returned when looking for the 'git' command in the local PATH, so the 'git' command will not be executed and the workaround cannot proceed. The error is: %v", err)
+			if !workaround130WarningsEmitted {
+				log.Warnf("workaround #130: the 'git' command wasn't found in the current PATH so the workaround documented at https://github.com/mooltiverse/nyx/issues/130 is disabled and the current Git repository status (CLEAN or DIRTY) may be wrong due to a bug in the underlying go-git library; disregard this message if you are not relying on the repository status in your release types configuration or you don't notice any suspect behavior that may be due to the repository status being wrongly detected")
+				// make sure we emit this warning only once
+				workaround130WarningsEmitted = true
+			}
+			return clean, nil
+		}
+		out := new(bytes.Buffer)
+		cmd := &exec.Cmd{Path: commandPath, Dir: r.directory, Env: os.Environ(), Args: append([]string{"git"}, r.options.cliStatusArguments()...), Stdout: out, Stderr: out}
+		log.Debugf("workaround #130: running the 'git' executable '%s' in directory '%s': %s", commandPath, r.directory, cmd.String())
+		err = cmd.Run()
+		if err != nil {
+			log.Debugf("workaround #130: an error was returned when running the 'git' command so the workaround cannot proceed. The error is: '%v' and the command output is '%s'", err, out.String())
+			return clean, nil
+		}
+		log.Debugf("workaround #130: the 'git status' command returned (empty means the repository is clean): '%v'", out.String())
+		// if the output is the empty string the repository is clean
+		if "" == strings.TrimSpace(out.String()) {
+			log.Debugf("workaround #130: the 'git status' command returned an empty output so the repository is clean")
+			clean = true
+		} else {
+			log.Debugf("workaround #130: the 'git status' command returned a non-empty output so the repository is dirty")
+			clean = false
+		}
+	}
+
+	return clean, nil
+}
+
+/*
+Returns true if the submodules of the given working tree are clean according to the mode in these options.
+go-git only reports the submodules whose checked out commit differs from the one recorded in the index, regardless
+of their contents, so the working trees of submodules are checked here, recursively, unless changes within
+submodules are ignored (IGNORE_SUBMODULES_DIRTY). When submodules are ignored altogether (IGNORE_SUBMODULES_ALL)
+they are removed from the given status, so that they don't make it dirty.
+
+Arguments are as follows:
+
+- worktree the working tree whose submodules are checked
+- status the status of the given working tree, as returned by go-git
+*/
+func (o repositoryOptions) areSubmodulesClean(worktree *ggit.Worktree, status ggit.Status) (bool, error) {
+	submodules, err := worktree.Submodules()
+	if err != nil {
+		return false, err
+	}
+	clean := true
+	for _, submodule := range submodules {
+		path := submodule.Config().Path
+		if IGNORE_SUBMODULES_ALL == o.ignoreSubmodules {
+			delete(status, path)
+			continue
+		}
+		if IGNORE_SUBMODULES_DIRTY == o.ignoreSubmodules || !clean {
+			continue
+		}
+		repository, err := submodule.Repository()
+		if err != nil {
+			// submodules that are not initialized have no working tree
+			log.Debugf("unable to open submodule '%s' so its working tree is not checked: %v", path, err)
+			continue
+		}
+		submoduleWorktree, err := repository.Worktree()
+		if err != nil {
+			return false, err
+		}
+		submoduleStatus, err := submoduleWorktree.Status()
+		if err != nil {
+			return false, err
+		}
+		nestedClean, err := o.areSubmodulesClean(submoduleWorktree, submoduleStatus)
+		if err != nil {
+			return false, err
+		}
+		for name, fileStatus := range submoduleStatus {
+			if IGNORE_SUBMODULES_UNTRACKED == o.ignoreSubmodules && submoduleStatus.IsUntracked(name) {
+				continue
+			}
+			if fileStatus.Staging != ggit.Unmodified || fileStatus.Worktree != ggit.Unmodified {
+				log.Debugf("submodule '%s' has changes in '%s'", path, name)
+				nestedClean = false
+			}
+		}
+		clean = nestedClean
+	}
+	return clean, nil
+}
+
+/*
+Returns true if the repository is bare, which is when it has no working tree (i.e. when it's a mirror).
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) IsBare() (bool, error) {
+	return r.bare, nil
+}
+
+/*
+Returns true if the repository is shallow, which is when its history has been truncated (i.e. by a clone with a
+limited depth) and the commits beyond the shallow boundary are not available locally.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) IsShallow() (bool, error) {
+	shallows, err := r.repository.Storer.Shallow()
+	if err != nil {
+		return false, &errs.GitError{Message: fmt.Sprintf("unable to read the shallow commits"), Cause: err}
+	}
+	return len(shallows) > 0, nil
+}
+
+/*
+Acquires the advisory lock on the repository, which is shared by all of its worktrees, so that concurrent
+runs working on the same repository can't interfere with each other. The lock is backed by the
+LOCK_FILE_NAME file in the Git directory and is held until it's released.
+
+Arguments are as follows:
+
+  - wait the maximum time to wait for the lock when it's held by someone else. When zero this method fails
+    straight away when the lock is held by someone else
+
+Errors can be:
+
+  - GitError in case the lock is still held by someone else when the wait time expires or some problem is
+    encountered with the underlying Git repository.
+*/
+func (r goGitRepository) Lock(wait time.Duration) (Lock, error) {
+	storage, ok := r.repository.Storer.(*ggitfilesystem.Storage)
+	if !ok {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to lock a repository which is not stored on the file system")}
+	}
+	directory := storage.Filesystem().Root()
+	// linked worktrees have their private Git directory, pointing to the one shared by all the worktrees by the 'commondir' file
+	commonDirectory, err := os.ReadFile(filepath.Join(directory, "commondir"))
+	if err == nil {
+		common := strings.TrimSpace(string(commonDirectory))
+		if !filepath.IsAbs(common) {
+			common = filepath.Join(directory, common)
+		}
+		directory = common
+	} else if !os.IsNotExist(err) {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the common directory of the repository in '%s'", directory), Cause: err}
+	}
+	path, err := lockFilePath(directory)
+	if err != nil {
+		return nil, err
+	}
+	return acquireFileLock(path, wait)
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushWithUserNameAndPassword(user *string, password *string) (string, error) {
+	s := DEFAULT_REMOTE_NAME
+	return r.PushToRemoteWithUserNameAndPassword(&s, user, password)
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushWithPublicKey(privateKey *string, passphrase *string) (string, error) {
+	s := DEFAULT_REMOTE_NAME
+	return r.PushToRemoteWithPublicKey(&s, privateKey, passphrase)
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushToRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error) {
+	return r.PushToRemoteWithUserNameAndPasswordAndForce(remote, user, password, false)
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - force set it to true if you want the push to be executed using the force option
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushToRemoteWithUserNameAndPasswordAndForce(remote *string, user *string, password *string, force bool) (string, error) {
+	return r.PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(remote, user, password, force, nil)
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+Unlike PushToRemoteWithUserNameAndPasswordAndForce, only the tags matching the given refspecs are pushed.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - force set it to true if you want the push to be executed using the force option
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(remote *string, user *string, password *string, force bool, tagRefSpecs []string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("pushing changes to remote repository '%s' using username and password", remoteString)
+
+	// get the current branch name
+	ref, err := r.repository.Head()
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	currentBranchRef := ref.Name()
+	// the refspec is in the localBranch:remoteBranch form, and we assume they both have the same name here
+	branchRefSpec := ggitconfig.RefSpec(currentBranchRef + ":" + currentBranchRef)
+
+	options := &ggit.PushOptions{RemoteName: remoteString, Force: force, RefSpecs: pushRefSpecs(branchRefSpec, tagRefSpecs)}
+	auth, err := getUserNameAndPasswordAuth(user, password, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	if auth != nil {
+		log.Debugf("username and password authentication will use custom authentication options")
+		options.Auth = auth
+	} else {
+		log.Debugf("username and password authentication will not use any custom authentication options")
+	}
+
+	err = r.push(options)
+	if err != nil {
+		if err == ggit.NoErrAlreadyUpToDate {
+			log.Debugf("remote repository was already up-to-date")
+		} else {
+			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to push"), Cause: classifyRemoteError(err)}
+		}
+	}
+	return remoteString, nil
+}
+
+/*
+Returns the refspecs to push, made of the given branch refspec followed by the given tag refspecs or, when they're nil,
+by the refspec pushing all tags.
+*/
+func pushRefSpecs(branchRefSpec ggitconfig.RefSpec, tagRefSpecs []string) []ggitconfig.RefSpec {
+	res := []ggitconfig.RefSpec{branchRefSpec}
+	if tagRefSpecs == nil {
+		return append(res, ggitconfig.RefSpec(ALL_TAGS_REFSPEC))
+	}
+	for _, tagRefSpec := range tagRefSpecs {
+		res = append(res, ggitconfig.RefSpec(tagRefSpec))
+	}
+	return res
+}
+
+/*
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease, using the given
+authentication method, which may be nil.
+
+Since this backend doesn't support the lease natively, the branch on the remote is checked right before pushing and
+the push is refused unless it still points to the commit of the remote tracking branch (or it doesn't exist on the
+remote when there is no remote tracking branch), like 'git push --force-with-lease' does. Then only the branch is
+force pushed, while tags are not forced.
+
+Tags are pushed using the given refspecs or, when nil, they're all pushed.
+
+Returns the local name of the remote that has been pushed, as it was passed.
+*/
+func (r goGitRepository) pushWithLease(remote string, auth ggittransport.AuthMethod, tagRefSpecs []string) (string, error) {
+	remoteName := remote
+	if "" == remoteName {
+		remoteName = DEFAULT_REMOTE_NAME
+	}
+
+	// get the current branch name
 	ref, err := r.repository.Head()
 	if err != nil {
 		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
 	}
-	// the Log method doesn't let us follow the firt parent, so we need to go through all commits and stop at the end
-	commit, err := r.parseCommit(ref.Hash().String())
+	currentBranchRef := ref.Name()
+
+	// the value expected on the remote is the one recorded by the remote tracking branch when it was last fetched
+	expected := ggitplumbing.ZeroHash
+	trackingRef, err := r.repository.Reference(ggitplumbing.NewRemoteReferenceName(remoteName, currentBranchRef.Short()), true)
+	if err == nil {
+		expected = trackingRef.Hash()
+	} else if err != ggitplumbing.ErrReferenceNotFound {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve the remote tracking branch of '%s' for remote '%s'", currentBranchRef.Short(), remoteName), Cause: err}
+	}
+	gitRemote, err := r.remote(remoteName, true)
 	if err != nil {
-		return "", &errs.GitError{Message: fmt.Sprintf("an error occurred while walking the commit history at commit '%s'", ref.Hash().String()), Cause: err}
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to find the remote '%s'", remoteName), Cause: err}
 	}
-	for len(commit.ParentHashes) > 0 {
-		c, err := r.repository.CommitObject(commit.ParentHashes[0]) // always follow the first parent, ignore others, if any
-		if err != nil {
-			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred while walking the commit history at commit '%s'", ref.Hash().String()), Cause: err}
+	references, err := r.options.listRemote(gitRemote, &ggit.ListOptions{Auth: auth})
+	if err != nil && err != ggittransport.ErrEmptyRemoteRepository {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to list the references of remote '%s'", remoteName), Cause: classifyRemoteError(err)}
+	}
+	actual := ggitplumbing.ZeroHash
+	for _, reference := range references {
+		if reference.Name() == currentBranchRef {
+			actual = reference.Hash()
 		}
-		commit = *c
 	}
-	commitSHA := commit.Hash.String()
-	log.Debugf("repository latest commit in HEAD branch is '%s'", commitSHA)
-	return commitSHA, nil
+	if actual != expected {
+		return "", &errs.GitError{Message: fmt.Sprintf("the branch '%s' on remote '%s' has been updated since it was last fetched (expected '%s', found '%s'), refusing to force push", currentBranchRef.Short(), remoteName, expected.String(), actual.String())}
+	}
+	log.Debugf("the branch '%s' on remote '%s' is at the expected commit '%s', force pushing", currentBranchRef.Short(), remoteName, expected.String())
+
+	// the leading '+' only forces the branch, not the tags
+	branchRefSpec := ggitconfig.RefSpec("+" + currentBranchRef + ":" + currentBranchRef)
+
+	options := &ggit.PushOptions{RemoteName: remote, RefSpecs: pushRefSpecs(branchRefSpec, tagRefSpecs), Auth: auth}
+	err = r.push(options)
+	if err != nil {
+		if err == ggit.NoErrAlreadyUpToDate {
+			log.Debugf("remote repository was already up-to-date")
+		} else {
+			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to push"), Cause: classifyRemoteError(err)}
+		}
+	}
+	return remote, nil
 }
 
 /*
-Returns a set of objects representing all the tags for the repository.
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+This method allows using user name and password authentication (also used for tokens).
+
+The branch is only overwritten if it still points, on the remote, to the commit of its remote tracking branch,
+like 'git push --force-with-lease' does, while tags are not forced.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
 
 Errors can be:
 
-- GitError in case some problem is encountered with the underlying Git repository.
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
 */
-func (r goGitRepository) GetTags() ([]gitent.Tag, error) {
-	log.Debugf("retrieving all tags")
-	var res []gitent.Tag
-	tagsIterator, err := r.repository.Tags()
+func (r goGitRepository) PushToRemoteWithUserNameAndPasswordAndLease(remote *string, user *string, password *string) (string, error) {
+	return r.PushToRemoteWithUserNameAndPasswordAndLeaseAndTagRefSpecs(remote, user, password, nil)
+}
+
+/*
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+Unlike PushToRemoteWithUserNameAndPasswordAndLease, only the tags matching the given refspecs are pushed.
+This method allows using user name and password authentication (also used for tokens).
+
+The branch is only overwritten if it still points, on the remote, to the commit of its remote tracking branch,
+like 'git push --force-with-lease' does, while tags are not forced.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
+*/
+func (r goGitRepository) PushToRemoteWithUserNameAndPasswordAndLeaseAndTagRefSpecs(remote *string, user *string, password *string, tagRefSpecs []string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("pushing changes to remote repository '%s' with lease using username and password", remoteString)
+
+	auth, err := getUserNameAndPasswordAuth(user, password, r.getRewrittenRemoteURL(remoteString, true))
 	if err != nil {
-		return nil, &errs.GitError{Message: fmt.Sprintf("cannot list repository tags"), Cause: err}
+		return "", err
 	}
-	if err := tagsIterator.ForEach(func(ref *ggitplumbing.Reference) error {
-		switch err {
-		case nil:
-			// it's an annotated tag
-			res = append(res, TagFrom(r.repository, *ref))
-		case ggitplumbing.ErrObjectNotFound:
-			// it's a lightweight tag
-			res = append(res, TagFrom(r.repository, *ref))
-		default:
-			// Some other error occurred
-			return &errs.GitError{Message: fmt.Sprintf("error while listing repository tags"), Cause: err}
+	return r.pushWithLease(remoteString, auth, tagRefSpecs)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+  - force set it to true if you want the push to be executed using the force option
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushToRemoteWithTokenAndForce(remote *string, token *string, user *string, force bool) (string, error) {
+	return r.PushToRemoteWithTokenAndForceAndTagRefSpecs(remote, token, user, force, nil)
+}
+
+/*
+Pushes local changes in the current branch to the given remote.
+Unlike PushToRemoteWithTokenAndForce, only the tags matching the given refspecs are pushed.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+  - force set it to true if you want the push to be executed using the force option
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushToRemoteWithTokenAndForceAndTagRefSpecs(remote *string, token *string, user *string, force bool, tagRefSpecs []string) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't push using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, true))
+	return r.PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(remote, &tokenUser, &tokenPassword, force, tagRefSpecs)
+}
+
+/*
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
+*/
+func (r goGitRepository) PushToRemoteWithTokenAndLease(remote *string, token *string, user *string) (string, error) {
+	return r.PushToRemoteWithTokenAndLeaseAndTagRefSpecs(remote, token, user, nil)
+}
+
+/*
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+Unlike PushToRemoteWithTokenAndLease, only the tags matching the given refspecs are pushed.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
+*/
+func (r goGitRepository) PushToRemoteWithTokenAndLeaseAndTagRefSpecs(remote *string, token *string, user *string, tagRefSpecs []string) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't push using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, true))
+	return r.PushToRemoteWithUserNameAndPasswordAndLeaseAndTagRefSpecs(remote, &tokenUser, &tokenPassword, tagRefSpecs)
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushToRemoteWithPublicKey(remote *string, privateKey *string, passphrase *string) (string, error) {
+	return r.PushToRemoteWithPublicKeyAndForce(remote, privateKey, passphrase, false)
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - force set it to true if you want the push to be executed using the force option
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushToRemoteWithPublicKeyAndForce(remote *string, privateKey *string, passphrase *string, force bool) (string, error) {
+	return r.PushToRemoteWithPublicKeyAndHostKeysAndForce(remote, privateKey, passphrase, nil, false, force)
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+  - force set it to true if you want the push to be executed using the force option
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushToRemoteWithPublicKeyAndHostKeysAndForce(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool) (string, error) {
+	return r.PushToRemoteWithPublicKeyAndHostKeysAndForceAndTagRefSpecs(remote, privateKey, passphrase, knownHosts, strictHostKeyChecking, force, nil)
+}
+
+/*
+Pushes local changes in the current branch to the default remote origin.
+Unlike PushToRemoteWithPublicKeyAndHostKeysAndForce, only the tags matching the given refspecs are pushed.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+  - force set it to true if you want the push to be executed using the force option
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+*/
+func (r goGitRepository) PushToRemoteWithPublicKeyAndHostKeysAndForceAndTagRefSpecs(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool, tagRefSpecs []string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("pushing changes to remote repository '%s' using public key (SSH) authentication", remoteString)
+
+	// get the current branch name
+	ref, err := r.repository.Head()
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	currentBranchRef := ref.Name()
+	// the refspec is in the localBranch:remoteBranch form, and we assume they both have the same name here
+	branchRefSpec := ggitconfig.RefSpec(currentBranchRef + ":" + currentBranchRef)
+
+	options := &ggit.PushOptions{RemoteName: remoteString, Force: force, RefSpecs: pushRefSpecs(branchRefSpec, tagRefSpecs)}
+	auth, err := getSSHAuth(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	if auth != nil {
+		log.Debugf("public key (SSH) authentication will use custom authentication options")
+		options.Auth = auth
+	} else {
+		log.Debugf("public key (SSH) authentication will not use any custom authentication options")
+	}
+
+	err = r.push(options)
+	if err != nil {
+		if err == ggit.NoErrAlreadyUpToDate {
+			log.Debugf("remote repository was already up-to-date")
+		} else {
+			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to push"), Cause: classifyRemoteError(err)}
 		}
-		return nil
-	}); err != nil {
-		return nil, &errs.GitError{Message: fmt.Sprintf("error while listing repository tags"), Cause: err}
 	}
-	return res, nil
+	return remoteString, nil
 }
 
 /*
-Returns the names of configured remote repositories.
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
 
 Errors can be:
 
-  - GitError in case some problem is encountered with the underlying Git repository, including when
-    the repository has no commits yet or is in the 'detached HEAD' state.
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
 */
-func (r goGitRepository) GetRemoteNames() ([]string, error) {
-	log.Debugf("retrieving repository remote names")
-	remotes, err := r.repository.Remotes()
-	if err != nil {
-		return nil, &errs.GitError{Message: fmt.Sprintf("unable to get the repository remotes"), Cause: err}
-	}
-	remoteNames := make([]string, len(remotes))
-	for i, rmt := range remotes {
-		remoteNames[i] = rmt.Config().Name
-	}
-
-	log.Debugf("repository remote names are '%v'", remoteNames)
-	return remoteNames, nil
+func (r goGitRepository) PushToRemoteWithPublicKeyAndHostKeysAndLease(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	return r.PushToRemoteWithPublicKeyAndHostKeysAndLeaseAndTagRefSpecs(remote, privateKey, passphrase, knownHosts, strictHostKeyChecking, nil)
 }
 
 /*
-Returns true if the repository is clean, which is when no differences exist between the working tree, the index,
-and the current HEAD.
+Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+Unlike PushToRemoteWithPublicKeyAndHostKeysAndLease, only the tags matching the given refspecs are pushed.
+This method allows using SSH authentication.
+
+Returns the local name of the remotes that has been pushed.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+  - tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+    If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
 
 Errors can be:
 
-  - GitError in case some problem is encountered with the underlying Git repository, including when
-    the repository has no commits yet or is in the 'detached HEAD' state.
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the remote branch has been updated since it was last fetched.
 */
-func (r goGitRepository) IsClean() (bool, error) {
-	log.Debugf("checking repository clean status")
-	wt, err := r.repository.Worktree()
-	if err != nil {
-		return false, &errs.GitError{Message: fmt.Sprintf("unable to get the repository worktree"), Cause: err}
-	}
-	status, err := wt.Status()
-	if err != nil {
-		return false, &errs.GitError{Message: fmt.Sprintf("unable to get the repository worktree status"), Cause: err}
-	}
-	log.Debugf("repository clean status is: '%v' ('%v')", status.IsClean(), status.String())
-	for fileName, fileStatus := range status {
-		log.Tracef("repository status for '%v' is: untracked='%v', staging='%v', worktree='%v', extra='%v', ", fileName, status.IsUntracked(fileName), string((*fileStatus).Staging), string((*fileStatus).Worktree), (*fileStatus).Extra)
+func (r goGitRepository) PushToRemoteWithPublicKeyAndHostKeysAndLeaseAndTagRefSpecs(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, tagRefSpecs []string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
 	}
-	log.Tracef("repository status flags are: Unmodified = ' ', Untracked = '?', Modified = 'M', Added = 'A', Deleted = 'D', Renamed = 'R', Copied = 'C', UpdatedButUnmerged = 'U'")
+	log.Debugf("pushing changes to remote repository '%s' with lease using public key (SSH) authentication", remoteString)
 
-	// TODO: remove this workaround (within the 'if' statement) when https://github.com/mooltiverse/nyx/issues/130 is fixed
-	// The go-git library has a bug that sometimes makes it return 'false' from status.IsClean() (meaning the repository is
-	// DIRTY, with uncommitted changes) even when it's clean (proven by using git on the command line).
-	// As per my tests, the bug occurs when the repository has text files with CR or CRLF (line endings), but is probably
-	// also connected to repositories with LFS and maybe others.
-	// This workaround is here to cope with:
-	// - https://github.com/mooltiverse/nyx/issues/130
-	// - https://github.com/mooltiverse/nyx/issues/129
-	// as long as the go-git library doesn't fix the bug. Bugs to keep an eye on for a fix are:
-	// - https://github.com/go-git/go-git/issues/500
-	// - https://github.com/go-git/go-git/issues/436
-	// - https://github.com/go-git/go-git/issues/227
-	// - https://github.com/go-git/go-git/issues/91
-	clean := status.IsClean()
-	if !clean {
-		// When the repository return false (which may be wrong), double check by running the git executable.
-		log.Debugf("workaround #130: go-git returned 'false' when the repository status was checked to see whether it was clean or not, this means it considers the repository in a DIRTY state. However, go-git has a bug which sometimes returns 'false' even when the Git command returns true so now the 'git' command, if available, will be executed to double check, and its output will be considered the only one reliable, overcoming the result provided by the go-git library")
-		commandPath, err := exec.LookPath("git")
-		if err != nil {
-			log.Debugf("workaround #130: an error was returned when looking for the 'git' command in the local PATH, so the 'git' command will not be executed and the workaround cannot proceed. The error is: %v", err)
-			if !workaround130WarningsEmitted {
-				log.Warnf("workaround #130: the 'git' command wasn't found in the current PATH so the workaround documented at https://github.com/mooltiverse/nyx/issues/130 is disabled and the current Git repository status (CLEAN or DIRTY) may be wrong due to a bug in the underlying go-git library; disregard this message if you are not relying on the repository status in your release types configuration or you don't notice any suspect behavior that may be due to the repository status being wrongly detected")
-				// make sure we emit this warning only once
-				workaround130WarningsEmitted = true
-			}
-			return clean, nil
-		}
-		out := new(bytes.Buffer)
-		cmd := &exec.Cmd{Path: commandPath, Dir: r.directory, Env: os.Environ(), Args: []string{"git", "status", "--porcelain"}, Stdout: out, Stderr: out}
-		log.Debugf("workaround #130: running the 'git' executable '%s' in directory '%s': %s", commandPath, r.directory, cmd.String())
-		err = cmd.Run()
-		if err != nil {
-			log.Debugf("workaround #130: an error was returned when running the 'git' command so the workaround cannot proceed. The error is: '%v' and the command output is '%s'", err, out.String())
-			return clean, nil
-		}
-		log.Debugf("workaround #130: the 'git status' command returned (empty means the repository is clean): '%v'", out.String())
-		// if the output is the empty string the repository is clean
-		if "" == strings.TrimSpace(out.String()) {
-			log.Debugf("workaround #130: the 'git status' command returned an empty output so the repository is clean")
-			clean = true
-		} else {
-			log.Debugf("workaround #130: the 'git status' command returned a non-empty output so the repository is dirty")
-			clean = false
-		}
+	auth, err := getSSHAuth(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
 	}
-
-	return clean, nil
+	return r.pushWithLease(remoteString, auth, tagRefSpecs)
 }
 
 /*
-Pushes local changes in the current branch to the default remote origin.
+Pushes local changes in the current branch to the given remotes.
 This method allows using user name and password authentication (also used for tokens).
 
-Returns the local name of the remotes that has been pushed.
+Returns a collection with the local names of remotes that have been pushed.
 
 Arguments are as follows:
 
+  - remotes remotes the names of remotes to push to. If nil or empty the default remote name (origin) is used.
   - user the user name to create when credentials are required. If this and password are both nil
-    then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
     this value may be the token or something other than a token, depending on the remote provider.
   - password the password to create when credentials are required. If this and user are both nil
-    then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
     this value may be the token or something other than a token, depending on the remote provider.
 
 Errors can be:
 
 - GitError in case some problem is encountered with the underlying Git repository, preventing to push.
 */
-func (r goGitRepository) PushWithUserNameAndPassword(user *string, password *string) (string, error) {
-	s := DEFAULT_REMOTE_NAME
-	return r.PushToRemoteWithUserNameAndPassword(&s, user, password)
+func (r goGitRepository) PushToRemotesWithUserNameAndPassword(remotes []string, user *string, password *string) ([]string, error) {
+	log.Debugf("pushing changes to '%d' remote repositories using username and password", len(remotes))
+	var res []string
+	for _, remote := range remotes {
+		r, err := r.PushToRemoteWithUserNameAndPassword(&remote, user, password)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	return res, nil
 }
 
 /*
-Pushes local changes in the current branch to the default remote origin.
+Pushes local changes in the current branch to the given remotes.
 This method allows using SSH authentication.
 
-Returns the local name of the remotes that has been pushed.
+Returns a collection with the local names of remotes that have been pushed.
 
 Arguments are as follows:
 
-  - privateKey the SSH private key. If nil the private key will be searched in its default location
-    (i.e. in the users' $HOME/.ssh directory).
+  - remotes remotes the names of remotes to push to. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
   - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
     This is required when the private key is password protected as this implementation does not support prompting
     the user interactively for entering the password.
@@ -815,236 +4278,408 @@ Errors can be:
 
 - GitError in case some problem is encountered with the underlying Git repository, preventing to push.
 */
-func (r goGitRepository) PushWithPublicKey(privateKey *string, passphrase *string) (string, error) {
-	s := DEFAULT_REMOTE_NAME
-	return r.PushToRemoteWithPublicKey(&s, privateKey, passphrase)
+func (r goGitRepository) PushToRemotesWithPublicKey(remotes []string, privateKey *string, passphrase *string) ([]string, error) {
+	log.Debugf("pushing changes to '%d' remote repositories using public key (SSH) authentication", len(remotes))
+	var res []string
+	for _, remote := range remotes {
+		r, err := r.PushToRemoteWithPublicKey(&remote, privateKey, passphrase)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	return res, nil
 }
 
 /*
-Pushes local changes in the current branch to the default remote origin.
-This method allows using user name and password authentication (also used for tokens).
+Pushes the tag with the given name to the given remote using the given authentication method. When force is true the
+tag is updated on the remote even if it already exists there pointing to another object.
 
-Returns the local name of the remotes that has been pushed.
+Returns the local name of the remote that the tag has been pushed to.
 
 Arguments are as follows:
 
-  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
-  - user the user name to create when credentials are required. If this and password are both nil
-    then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
-    this value may be the token or something other than a token, depending on the remote provider.
-  - password the password to create when credentials are required. If this and user are both nil
-    then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
-    this value may be the token or something other than a token, depending on the remote provider.
+  - remote the name of the remote to push the tag to. If empty the default remote name (origin) is used.
+  - name the name of the tag to push.
+  - auth the authentication method to use. It may be nil.
+  - force set it to true if you want the tag to be pushed using the force option
 
 Errors can be:
 
-- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push the tag.
 */
-func (r goGitRepository) PushToRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error) {
-	return r.PushToRemoteWithUserNameAndPasswordAndForce(remote, user, password, false)
+func (r goGitRepository) pushTag(remote string, name string, auth ggittransport.AuthMethod, force bool) (string, error) {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	tagRefSpec := ggitconfig.RefSpec(ggitplumbing.NewTagReferenceName(name).String() + ":" + ggitplumbing.NewTagReferenceName(name).String())
+	if force {
+		// the leading '+' in the refspec lets the remote tag be replaced
+		tagRefSpec = ggitconfig.RefSpec("+" + tagRefSpec.String())
+	}
+	options := &ggit.PushOptions{RemoteName: remote, RefSpecs: []ggitconfig.RefSpec{tagRefSpec}, Auth: auth}
+
+	err := r.push(options)
+	if err != nil {
+		if err == ggit.NoErrAlreadyUpToDate {
+			log.Debugf("tag '%s' was already up to date on remote repository '%s'", name, remote)
+		} else {
+			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to push tag '%s' to remote '%s'", name, remote), Cause: classifyRemoteError(err)}
+		}
+	}
+	return remote, nil
 }
 
 /*
-Pushes local changes in the current branch to the default remote origin.
+Pushes the tag with the given name to the given remote. When force is true the tag is updated on the remote even if it
+already exists there pointing to another object.
 This method allows using user name and password authentication (also used for tokens).
 
-Returns the local name of the remotes that has been pushed.
+Returns the local name of the remote that the tag has been pushed to.
 
 Arguments are as follows:
 
-  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+  - remote the name of the remote to push the tag to. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to push.
   - user the user name to create when credentials are required. If this and password are both nil
-    then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
     this value may be the token or something other than a token, depending on the remote provider.
   - password the password to create when credentials are required. If this and user are both nil
-    then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
     this value may be the token or something other than a token, depending on the remote provider.
-  - force set it to true if you want the push to be executed using the force option
+  - force set it to true if you want the tag to be pushed using the force option
 
 Errors can be:
 
-- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push the tag.
 */
-func (r goGitRepository) PushToRemoteWithUserNameAndPasswordAndForce(remote *string, user *string, password *string, force bool) (string, error) {
+func (r goGitRepository) PushTagToRemoteWithUserNameAndPasswordAndForce(remote *string, name string, user *string, password *string, force bool) (string, error) {
 	remoteString := ""
 	if remote != nil {
 		remoteString = *remote
 	}
-	log.Debugf("pushing changes to remote repository '%s' using username and password", remoteString)
+	log.Debugf("pushing tag '%s' to remote repository '%s' using username and password", name, remoteString)
 
-	// get the current branch name
-	ref, err := r.repository.Head()
+	auth, err := getUserNameAndPasswordAuth(user, password, r.getRewrittenRemoteURL(remoteString, true))
 	if err != nil {
-		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+		return "", err
 	}
-	currentBranchRef := ref.Name()
-	// the refspec is in the localBranch:remoteBranch form, and we assume they both have the same name here
-	branchRefSpec := ggitconfig.RefSpec(currentBranchRef + ":" + currentBranchRef)
-	tagsRefSpec := ggitconfig.RefSpec("refs/tags/*:refs/tags/*") // this is required to also push tags
-
-	options := &ggit.PushOptions{RemoteName: remoteString, Force: force, RefSpecs: []ggitconfig.RefSpec{branchRefSpec, tagsRefSpec}}
-	auth := getBasicAuth(user, password)
 	if auth != nil {
 		log.Debugf("username and password authentication will use custom authentication options")
-		options.Auth = auth
-	} else {
-		log.Debugf("username and password authentication will not use any custom authentication options")
+		return r.pushTag(remoteString, name, auth, force)
 	}
+	log.Debugf("username and password authentication will not use any custom authentication options")
+	return r.pushTag(remoteString, name, nil, force)
+}
 
-	err = r.repository.Push(options)
-	if err != nil {
-		if err == ggit.NoErrAlreadyUpToDate {
-			log.Debugf("remote repository was already up-to-date")
-		} else {
-			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to push"), Cause: err}
-		}
+/*
+Pushes the tag with the given name to the given remote. When force is true the tag is updated on the remote even if it
+already exists there pointing to another object.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remote that the tag has been pushed to.
+
+Arguments are as follows:
+
+  - remote the name of the remote to push the tag to. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to push.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+  - force set it to true if you want the tag to be pushed using the force option
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push the tag.
+*/
+func (r goGitRepository) PushTagToRemoteWithTokenAndForce(remote *string, name string, token *string, user *string, force bool) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't push tags using a null token"}
 	}
-	return remoteString, nil
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, true))
+	return r.PushTagToRemoteWithUserNameAndPasswordAndForce(remote, name, &tokenUser, &tokenPassword, force)
 }
 
 /*
-Pushes local changes in the current branch to the default remote origin.
+Pushes the tag with the given name to the given remote. When force is true the tag is updated on the remote even if it
+already exists there pointing to another object.
 This method allows using SSH authentication.
 
-Returns the local name of the remotes that has been pushed.
+Returns the local name of the remote that the tag has been pushed to.
 
 Arguments are as follows:
 
-  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
-  - privateKey the SSH private key. If nil the private key will be searched in its default location
-    (i.e. in the users' $HOME/.ssh directory).
+  - remote the name of the remote to push the tag to. If nil or empty the default remote name (origin) is used.
+  - name the name of the tag to push.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
   - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
     This is required when the private key is password protected as this implementation does not support prompting
     the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+  - force set it to true if you want the tag to be pushed using the force option
 
 Errors can be:
 
-- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+- GitError in case some problem is encountered with the underlying Git repository, preventing to push the tag.
 */
-func (r goGitRepository) PushToRemoteWithPublicKey(remote *string, privateKey *string, passphrase *string) (string, error) {
-	return r.PushToRemoteWithPublicKeyAndForce(remote, privateKey, passphrase, false)
+func (r goGitRepository) PushTagToRemoteWithPublicKeyAndHostKeysAndForce(remote *string, name string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("pushing tag '%s' to remote repository '%s' using public key (SSH) authentication", name, remoteString)
+
+	auth, err := getSSHAuth(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, true))
+	if err != nil {
+		return "", err
+	}
+	if auth != nil {
+		log.Debugf("public key (SSH) authentication will use custom authentication options")
+		return r.pushTag(remoteString, name, auth, force)
+	}
+	log.Debugf("public key (SSH) authentication will not use any custom authentication options")
+	return r.pushTag(remoteString, name, nil, force)
 }
 
 /*
-Pushes local changes in the current branch to the default remote origin.
-This method allows using SSH authentication.
+Removes the remote with the given name from the repository configuration, along with its remote tracking
+references.
+
+Arguments are as follows:
+
+- name the name of the remote to remove
+
+Errors can be:
+
+- GitError in case the remote is not configured or some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) RemoveRemote(name string) error {
+	log.Debugf("removing remote '%s'", name)
+	err := r.repository.DeleteRemote(name)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to remove remote '%s'", name), Cause: err}
+	}
+	// go-git only removes the remote from the configuration while Git also removes the remote tracking references
+	references, err := r.repository.References()
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to get the repository references"), Cause: err}
+	}
+	prefix := "refs/remotes/" + name + "/"
+	trackingReferences := []ggitplumbing.ReferenceName{}
+	references.ForEach(func(reference *ggitplumbing.Reference) error {
+		if strings.HasPrefix(reference.Name().String(), prefix) {
+			trackingReferences = append(trackingReferences, reference.Name())
+		}
+		return nil
+	})
+	for _, trackingReference := range trackingReferences {
+		err = r.repository.Storer.RemoveReference(trackingReference)
+		if err != nil {
+			return &errs.GitError{Message: fmt.Sprintf("unable to remove the remote tracking reference '%s'", trackingReference.String()), Cause: err}
+		}
+	}
+	log.Debugf("remote '%s' removed", name)
+	return nil
+}
+
+/*
+Sets the URL of the remote with the given name, replacing the first URL configured for the remote. Push URLs
+(the 'pushurl' option), when configured, are left untouched.
+
+Arguments are as follows:
+
+- name the name of the remote to update
+- url the new URL of the remote. Cannot be empty
+
+Errors can be:
+
+  - GitError in case the URL is empty, the remote is not configured or some problem is encountered with the
+    underlying Git repository.
+*/
+func (r goGitRepository) SetRemoteURL(name string, url string) error {
+	log.Debugf("setting the URL of remote '%s'", name)
+	if "" == strings.TrimSpace(url) {
+		return &errs.GitError{Message: fmt.Sprintf("the URL of remote '%s' cannot be empty", name)}
+	}
+	config, err := r.repository.Config()
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to read the repository configuration"), Cause: err}
+	}
+	remote, ok := config.Remotes[name]
+	if !ok {
+		return &errs.GitError{Message: fmt.Sprintf("the remote '%s' is not configured", name)}
+	}
+	if len(remote.URLs) == 0 {
+		remote.URLs = []string{url}
+	} else {
+		remote.URLs[0] = url
+	}
+	err = r.repository.SetConfig(config)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to set the URL of remote '%s'", name), Cause: err}
+	}
+	log.Debugf("URL of remote '%s' set", name)
+	return nil
+}
+
+/*
+Takes a snapshot of the current status of the repository, made of the current branch (or HEAD, when detached),
+the staging area (index) and the local tags, so that it can be restored later on.
+
+The working tree contents are not part of the snapshot as the operations Nyx performs on the repository
+(staging, committing and tagging) never change them. Use SnapshotWithPaths to also include the working
+tree files changed by other means.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (r goGitRepository) Snapshot() (Snapshot, error) {
+	return r.SnapshotWithPaths(nil)
+}
 
-Returns the local name of the remotes that has been pushed.
+/*
+Takes a snapshot of the current status of the repository just like Snapshot, also including the contents
+of the given working tree files, so that files changed in the meanwhile are brought back to their previous
+contents and files created in the meanwhile are removed.
 
 Arguments are as follows:
 
-  - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
-  - privateKey the SSH private key. If nil the private key will be searched in its default location
-    (i.e. in the users' $HOME/.ssh directory).
-  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
-    This is required when the private key is password protected as this implementation does not support prompting
-    the user interactively for entering the password.
-  - force set it to true if you want the push to be executed using the force option
+  - paths the paths (absolute or relative to the repository directory) of the working tree files to include
+    in the snapshot. It may be nil or empty
 
 Errors can be:
 
-- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+- GitError in case some problem is encountered with the underlying Git repository or reading the files.
 */
-func (r goGitRepository) PushToRemoteWithPublicKeyAndForce(remote *string, privateKey *string, passphrase *string, force bool) (string, error) {
-	remoteString := ""
-	if remote != nil {
-		remoteString = *remote
+func (r goGitRepository) SnapshotWithPaths(paths []string) (Snapshot, error) {
+	log.Debugf("taking a snapshot of the repository")
+	head, err := r.repository.Storer.Reference(ggitplumbing.HEAD)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+	}
+	var branch *ggitplumbing.Reference
+	if head.Type() == ggitplumbing.SymbolicReference {
+		branch, err = r.repository.Storer.Reference(head.Target())
+		if err == ggitplumbing.ErrReferenceNotFound {
+			// the repository has no commits yet
+			branch = nil
+		} else if err != nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to '%s'", head.Target().String()), Cause: err}
+		}
 	}
-	log.Debugf("pushing changes to remote repository '%s' using public key (SSH) authentication", remoteString)
 
-	// get the current branch name
-	ref, err := r.repository.Head()
+	// the index is stored in its encoded form so that later changes to the in-memory index don't affect the snapshot
+	idx, err := r.repository.Storer.Index()
 	if err != nil {
-		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve reference to HEAD"), Cause: err}
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the repository index"), Cause: err}
+	}
+	var indexBuffer bytes.Buffer
+	err = ggitindex.NewEncoder(&indexBuffer).Encode(idx)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the repository index"), Cause: err}
 	}
-	currentBranchRef := ref.Name()
-	// the refspec is in the localBranch:remoteBranch form, and we assume they both have the same name here
-	branchRefSpec := ggitconfig.RefSpec(currentBranchRef + ":" + currentBranchRef)
-	tagsRefSpec := ggitconfig.RefSpec("refs/tags/*:refs/tags/*") // this is required to also push tags
 
-	options := &ggit.PushOptions{RemoteName: remoteString, Force: force, RefSpecs: []ggitconfig.RefSpec{branchRefSpec, tagsRefSpec}}
-	auth := getPublicKeyAuth(privateKey, passphrase)
-	if auth != nil {
-		log.Debugf("public key (SSH) authentication will use custom authentication options")
-		options.Auth = auth
-	} else {
-		log.Debugf("public key (SSH) authentication will not use any custom authentication options")
+	tags := make(map[ggitplumbing.ReferenceName]ggitplumbing.Hash)
+	tagsIterator, err := r.repository.Tags()
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("cannot list repository tags"), Cause: err}
+	}
+	err = tagsIterator.ForEach(func(ref *ggitplumbing.Reference) error {
+		tags[ref.Name()] = ref.Hash()
+		return nil
+	})
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("error while listing repository tags"), Cause: err}
 	}
 
-	err = r.repository.Push(options)
+	files, err := snapshotWorktreeFiles(r.directory, paths)
 	if err != nil {
-		if err == ggit.NoErrAlreadyUpToDate {
-			log.Debugf("remote repository was already up-to-date")
-		} else {
-			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to push"), Cause: err}
-		}
+		return nil, err
 	}
-	return remoteString, nil
+
+	return goGitSnapshot{repository: r.repository, head: head, branch: branch, index: indexBuffer.Bytes(), tags: tags, files: files}, nil
 }
 
 /*
-Pushes local changes in the current branch to the given remotes.
-This method allows using user name and password authentication (also used for tokens).
+Stashes the uncommitted changes in the working tree and the staging area (index), including untracked
+files, so that the working tree is left clean (apart from the excluded paths) and the changes can be
+restored later on by StashPop(). Ignored files are not stashed.
 
-Returns a collection with the local names of remotes that have been pushed.
+The go-git library has no support for stashing so this backend only detects whether there is something to
+stash and, if so, returns an error suggesting to use the CLI backend instead.
+
+Returns true if some changes have been stashed, false if there was nothing to stash, in which case
+StashPop() must not be invoked.
 
 Arguments are as follows:
 
-  - remotes remotes the names of remotes to push to. If nil or empty the default remote name (origin) is used.
-  - user the user name to create when credentials are required. If this and password are both nil
-    then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
-    this value may be the token or something other than a token, depending on the remote provider.
-  - password the password to create when credentials are required. If this and user are both nil
-    then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
-    this value may be the token or something other than a token, depending on the remote provider.
+  - excludedPaths the paths (absolute or relative to the repository directory) whose changes are left in
+    place instead of being stashed. It may be nil or empty
 
 Errors can be:
 
-- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+  - GitError in case there are changes to stash or some problem is encountered with the underlying Git
+    repository.
 */
-func (r goGitRepository) PushToRemotesWithUserNameAndPassword(remotes []string, user *string, password *string) ([]string, error) {
-	log.Debugf("pushing changes to '%d' remote repositories using username and password", len(remotes))
-	var res []string
-	for _, remote := range remotes {
-		r, err := r.PushToRemoteWithUserNameAndPassword(&remote, user, password)
-		if err != nil {
-			return nil, err
+func (r goGitRepository) Stash(excludedPaths []string) (bool, error) {
+	log.Debugf("stashing local changes")
+	wt, err := r.worktree()
+	if err != nil {
+		return false, err
+	}
+	status, err := wt.Status()
+	if err != nil {
+		return false, &errs.GitError{Message: fmt.Sprintf("unable to get the repository worktree status"), Cause: err}
+	}
+	root, err := filepath.Abs(r.directory)
+	if err != nil {
+		return false, &errs.GitError{Message: fmt.Sprintf("unable to resolve the repository directory '%s'", r.directory), Cause: err}
+	}
+	excluded := make(map[string]bool)
+	for _, excludedPath := range excludedPaths {
+		if filepath.IsAbs(excludedPath) {
+			relativePath, err := filepath.Rel(root, excludedPath)
+			if err != nil {
+				continue
+			}
+			excludedPath = relativePath
 		}
-		res = append(res, r)
+		excluded[filepath.ToSlash(filepath.Clean(excludedPath))] = true
 	}
-	return res, nil
+	for fileName, fileStatus := range status {
+		if fileStatus.Staging == ggit.Unmodified && fileStatus.Worktree == ggit.Unmodified {
+			continue
+		}
+		if !excluded[fileName] {
+			return false, &errs.GitError{Message: fmt.Sprintf("the repository has uncommitted changes (i.e. '%s') but stashing is not supported by the go-git backend; use the CLI backend to stash changes", fileName)}
+		}
+	}
+	log.Debugf("there are no local changes to stash")
+	return false, nil
 }
 
 /*
-Pushes local changes in the current branch to the given remotes.
-This method allows using SSH authentication.
-
-Returns a collection with the local names of remotes that have been pushed.
+Restores the changes most recently stashed by Stash() to the working tree and the staging area (index) and
+drops them from the stash.
 
-Arguments are as follows:
-
-  - remotes remotes the names of remotes to push to. If nil or empty the default remote name (origin) is used.
-  - privateKey the SSH private key. If nil the private key will be searched in its default location
-    (i.e. in the users' $HOME/.ssh directory).
-  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
-    This is required when the private key is password protected as this implementation does not support prompting
-    the user interactively for entering the password.
+The go-git library has no support for stashing so this backend always returns an error.
 
 Errors can be:
 
-- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+- GitError as stashing is not supported by this backend.
 */
-func (r goGitRepository) PushToRemotesWithPublicKey(remotes []string, privateKey *string, passphrase *string) ([]string, error) {
-	log.Debugf("pushing changes to '%d' remote repositories using public key (SSH) authentication", len(remotes))
-	var res []string
-	for _, remote := range remotes {
-		r, err := r.PushToRemoteWithPublicKey(&remote, privateKey, passphrase)
-		if err != nil {
-			return nil, err
-		}
-		res = append(res, r)
-	}
-	return res, nil
+func (r goGitRepository) StashPop() error {
+	return &errs.GitError{Message: fmt.Sprintf("stashing is not supported by the go-git backend; use the CLI backend to restore stashed changes")}
 }
 
 /*
@@ -1202,7 +4837,10 @@ func (r goGitRepository) TagCommitWithMessageAndIdentityAndForce(target *string,
 	if message != nil {
 		var gTagger *ggitobject.Signature = nil
 		if tagger != nil {
-			gTagger = &ggitobject.Signature{Name: tagger.Name, Email: tagger.Email}
+			gTagger = &ggitobject.Signature{Name: tagger.Name, Email: tagger.Email, When: r.options.timestamp()}
+		} else {
+			// go-git doesn't read the identity from the configuration the way git does so it must be read here
+			gTagger = configuredSignature(r.configScopes(), false, r.options.timestamp())
 		}
 		// create an annotated tag, pass a CreateTagOptions
 		// when the message is nil we create a lightweight tag so CreateTagOptions needs to be nil
@@ -1220,15 +4858,301 @@ func (r goGitRepository) TagCommitWithMessageAndIdentityAndForce(target *string,
 	}
 	ref, err := r.repository.CreateTag(*name, targetHash, createTagOptions)
 
-	if err != nil {
-		return gitent.Tag{}, &errs.GitError{Message: fmt.Sprintf("unable to create Git tag"), Cause: err}
-	}
-	return TagFrom(r.repository, *ref), nil
+	if err != nil {
+		return gitent.Tag{}, &errs.GitError{Message: fmt.Sprintf("unable to create Git tag"), Cause: err}
+	}
+	return TagFrom(r.repository, *ref), nil
+}
+
+/*
+Fetches the missing history from the given remote so that a shallow repository becomes complete.
+Tags are also fetched.
+
+Returns the local name of the remote that the history has been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If empty the default remote name (origin) is used.
+  - auth the authentication method to use. It may be nil.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r goGitRepository) unshallow(remote string, auth ggittransport.AuthMethod) (string, error) {
+	if "" == remote {
+		remote = DEFAULT_REMOTE_NAME
+	}
+	options := &ggit.FetchOptions{RemoteName: remote, Depth: UNSHALLOW_DEPTH, Tags: ggit.AllTags, Auth: auth}
+
+	err := r.fetch(options)
+	if err != nil {
+		if err == ggit.NoErrAlreadyUpToDate {
+			log.Debugf("the history was already up-to-date with remote repository '%s'", remote)
+		} else {
+			return "", &errs.GitError{Message: fmt.Sprintf("an error occurred when trying to fetch the missing history from remote '%s'", remote), Cause: classifyRemoteError(err)}
+		}
+	}
+
+	// go-git doesn't drop the shallow commits whose parents have been fetched so they're pruned here
+	shallows, err := r.repository.Storer.Shallow()
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to read the shallow commits"), Cause: err}
+	}
+	remainingShallows := []ggitplumbing.Hash{}
+	for _, shallow := range shallows {
+		if !r.hasParents(shallow) {
+			remainingShallows = append(remainingShallows, shallow)
+		}
+	}
+	err = r.repository.Storer.SetShallow(remainingShallows)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to update the shallow commits"), Cause: err}
+	}
+	if len(remainingShallows) > 0 {
+		log.Warnf("the repository is still shallow after fetching from remote '%s', with %d shallow commits", remote, len(remainingShallows))
+	}
+	return remote, nil
+}
+
+/*
+Fetches the missing history from the given remote so that a shallow repository becomes complete.
+Tags are also fetched. If the repository is not shallow this method just fetches from the remote.
+This method allows using user name and password authentication (also used for tokens).
+
+Returns the local name of the remote that the history has been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - user the user name to create when credentials are required. If this and password are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+  - password the password to create when credentials are required. If this and user are both nil
+    then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+    this value may be the token or something other than a token, depending on the remote provider.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r goGitRepository) UnshallowFromRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("fetching the missing history from remote repository '%s' using username and password", remoteString)
+
+	auth, err := getUserNameAndPasswordAuth(user, password, r.getRewrittenRemoteURL(remoteString, false))
+	if err != nil {
+		return "", err
+	}
+	if auth != nil {
+		log.Debugf("username and password authentication will use custom authentication options")
+		return r.unshallow(remoteString, auth)
+	}
+	log.Debugf("username and password authentication will not use any custom authentication options")
+	return r.unshallow(remoteString, nil)
+}
+
+/*
+Fetches the missing history from the given remote so that a shallow repository becomes complete.
+Tags are also fetched. If the repository is not shallow this method just fetches from the remote.
+This method uses a single token, passed in the user name or password according to the provider hosting the
+remote repository (see getTokenCredentials).
+
+Returns the local name of the remote that the history has been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - token the token to authenticate with
+  - user an optional user name overriding the one detected from the provider. It may be nil.
+
+Errors can be:
+
+- NilPointerError if the given token is nil
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r goGitRepository) UnshallowFromRemoteWithToken(remote *string, token *string, user *string) (string, error) {
+	if token == nil {
+		return "", &errs.NilPointerError{Message: "can't fetch using a null token"}
+	}
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	tokenUser, tokenPassword := getTokenCredentials(*token, user, r.getRewrittenRemoteURL(remoteString, false))
+	return r.UnshallowFromRemoteWithUserNameAndPassword(remote, &tokenUser, &tokenPassword)
+}
+
+/*
+Fetches the missing history from the given remote so that a shallow repository becomes complete.
+Tags are also fetched. If the repository is not shallow this method just fetches from the remote.
+This method allows using SSH authentication.
+
+Returns the local name of the remote that the history has been fetched from.
+
+Arguments are as follows:
+
+  - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+  - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+    (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+  - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+    This is required when the private key is password protected as this implementation does not support prompting
+    the user interactively for entering the password.
+  - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+    If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+  - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+    in ephemeral environments, like CI containers.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+*/
+func (r goGitRepository) UnshallowFromRemoteWithPublicKeyAndHostKeys(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	remoteString := ""
+	if remote != nil {
+		remoteString = *remote
+	}
+	log.Debugf("fetching the missing history from remote repository '%s' using public key (SSH) authentication", remoteString)
+
+	auth, err := getSSHAuth(privateKey, passphrase, knownHosts, strictHostKeyChecking, r.getRewrittenRemoteURL(remoteString, false))
+	if err != nil {
+		return "", err
+	}
+	if auth != nil {
+		log.Debugf("public key (SSH) authentication will use custom authentication options")
+		return r.unshallow(remoteString, auth)
+	}
+	log.Debugf("public key (SSH) authentication will not use any custom authentication options")
+	return r.unshallow(remoteString, nil)
+}
+
+/*
+Returns the signature resulting from the verification of the given armored PGP signature, using the given verification
+function. When no trusted keys are given the signature can't be verified so it's reported as not valid.
+
+Arguments are as follows:
+
+- pgpSignature the armored PGP signature of the object. If empty the object is not signed.
+- trustedKeys the optional armored PGP public keys to verify the signature with
+- verify the function verifying the signature against the given key ring and returning the signer entity
+
+Errors can be:
+
+- GitError in case the given keys can't be read.
+*/
+func verifySignature(pgpSignature string, trustedKeys *string, verify func(armoredKeyRing string) (*openpgp.Entity, error)) (gitent.Signature, error) {
+	if "" == pgpSignature {
+		return *gitent.NewSignatureWith(false, false, ""), nil
+	}
+	if trustedKeys == nil || "" == strings.TrimSpace(*trustedKeys) {
+		log.Debugf("no trusted keys available, the signature can't be verified")
+		return *gitent.NewSignatureWith(true, false, ""), nil
+	}
+	if _, err := openpgp.ReadArmoredKeyRing(strings.NewReader(*trustedKeys)); err != nil {
+		return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("unable to read the trusted keys"), Cause: err}
+	}
+	entity, err := verify(*trustedKeys)
+	if err != nil {
+		log.Debugf("the signature can't be verified with the trusted keys: %v", err)
+		return *gitent.NewSignatureWith(true, false, ""), nil
+	}
+	signer := ""
+	if identity := entity.PrimaryIdentity(); identity != nil {
+		signer = identity.Name
+	}
+	return *gitent.NewSignatureWith(true, true, signer), nil
+}
+
+/*
+Verifies the signature of the given commit and returns the outcome of the verification. A commit that is not
+signed is not an error and yields a signature that is neither signed nor valid.
+
+Arguments are as follows:
+
+  - commit the SHA-1 identifier of the commit to verify. It can be a full or abbreviated SHA-1.
+  - trustedKeys the optional armored PGP public keys to verify the signature with. When nil or empty
+    signed commits are reported as not valid as this backend has no other keys to verify them with.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository or the given keys
+    can't be read.
+*/
+func (r goGitRepository) VerifyCommitSignature(commit string, trustedKeys *string) (gitent.Signature, error) {
+	log.Debugf("verifying the signature of commit '%s'", commit)
+	c, err := r.parseCommit(commit)
+	if err != nil {
+		return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("unable to resolve commit '%s'", commit), Cause: err}
+	}
+	return verifySignature(c.PGPSignature, trustedKeys, c.Verify)
+}
+
+/*
+Verifies the signature of the given tag and returns the outcome of the verification. A tag that is not
+signed (including lightweight tags) is not an error and yields a signature that is neither signed nor valid.
+
+Arguments are as follows:
+
+  - tag the name of the tag to verify.
+  - trustedKeys the optional armored PGP public keys to verify the signature with. When nil or empty
+    signed tags are reported as not valid as this backend has no other keys to verify them with.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, the tag doesn't exist
+    or the given keys can't be read.
+*/
+func (r goGitRepository) VerifyTagSignature(tag string, trustedKeys *string) (gitent.Signature, error) {
+	log.Debugf("verifying the signature of tag '%s'", tag)
+	ref, err := r.repository.Tag(tag)
+	if err != nil {
+		return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("unable to resolve tag '%s'", tag), Cause: err}
+	}
+	tagObject, err := r.repository.TagObject(ref.Hash())
+	switch err {
+	case nil:
+		return verifySignature(tagObject.PGPSignature, trustedKeys, tagObject.Verify)
+	case ggitplumbing.ErrObjectNotFound:
+		// it's a lightweight tag, which can't be signed
+		return *gitent.NewSignatureWith(false, false, ""), nil
+	default:
+		return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("unable to resolve tag '%s'", tag), Cause: err}
+	}
+}
+
+/*
+Browse the repository commit history using the given visitor to inspect each commit. Commits are
+evaluated in Git's natural order, from the most recent to oldest.
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the commit to start from. If nil the latest commit in the
+    current branch (HEAD) is used. This can be a long or abbreviated SHA-1. If this commit cannot be
+    resolved within the repository a GitError is thrown.
+  - end the optional SHA-1 id of the commit to end with, included. If nil the repository root
+    commit is used (until the given visitor returns false). If this commit is not reachable
+    from the start it will be ignored. This can be a long or abbreviated SHA-1. If this commit cannot be resolved
+    within the repository a GitError is thrown.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function isits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or a given commit identifier cannot be resolved.
+*/
+func (r goGitRepository) WalkHistory(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+	return r.WalkHistoryBetweenDates(start, end, nil, nil, visit)
 }
 
 /*
-Browse the repository commit history using the given visitor to inspect each commit. Commits are
-evaluated in Git's natural order, from the most recent to oldest.
+Browse the repository commit history using the given visitor to inspect each commit, just like WalkHistory,
+but besides the start and end commits the walk is also bounded by the commit dates, so that only the commits
+made within a time frame are visited.
 
 Arguments are as follows:
 
@@ -1239,6 +5163,12 @@ Arguments are as follows:
     commit is used (until the given visitor returns false). If this commit is not reachable
     from the start it will be ignored. This can be a long or abbreviated SHA-1. If this commit cannot be resolved
     within the repository a GitError is thrown.
+  - since the optional time stamp (in milliseconds since the epoch) of the oldest commit to visit. The walk
+    stops at the first commit whose committer date is older than this time stamp, which is not visited.
+    If nil commits are visited regardless of how old they are.
+  - until the optional time stamp (in milliseconds since the epoch) of the most recent commit to visit.
+    Commits whose committer date is newer than this time stamp are skipped without being visited, but
+    the walk goes on with their parents. If nil commits are visited regardless of how recent they are.
   - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
     The function isits a single commit and receives all of the commit simplified fields. Returns true
     to keep browsing next commits or false to stop.
@@ -1248,7 +5178,7 @@ Errors can be:
   - GitError in case some problem is encountered with the underlying Git repository, including when
     the repository has no commits yet or a given commit identifier cannot be resolved.
 */
-func (r goGitRepository) WalkHistory(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+func (r goGitRepository) WalkHistoryBetweenDates(start *string, end *string, since *int64, until *int64, visit func(commit gitent.Commit) bool) error {
 	if visit == nil {
 		return nil
 	}
@@ -1293,19 +5223,29 @@ func (r goGitRepository) WalkHistory(start *string, end *string, visit func(comm
 		log.Tracef("end boundary resolved to commit '%s'", endCommit.Hash.String())
 	}
 
+	// tags are read once and matched to commits by their targets
+	tagsByTarget, err := r.getTagsByTarget()
+	if err != nil {
+		return err
+	}
+
 	for commit != nil {
-		log.Tracef("visiting commit '%s'", commit.Hash.String())
+		if since != nil && commit.Committer.When.UnixMilli() < *since {
+			log.Debugf("commit history walk reached the since boundary at commit '%s'", commit.Hash.String())
+			break
+		}
+		if until != nil && commit.Committer.When.UnixMilli() > *until {
+			log.Tracef("skipping commit '%s' as it's newer than the until boundary", commit.Hash.String())
+		} else {
+			log.Tracef("visiting commit '%s'", commit.Hash.String())
 
-		tags, err := r.GetCommitTags(commit.Hash.String())
-		if err != nil {
-			return err
+			if !visit(CommitFrom(*commit, tagsByTarget[commit.Hash.String()])) {
+				log.Debugf("commit history walk interrupted by visitor")
+				break
+			}
 		}
-		visitorContinues := visit(CommitFrom(*commit, tags))
 
-		if !visitorContinues {
-			log.Debugf("commit history walk interrupted by visitor")
-			break
-		} else if end != nil && strings.HasPrefix(commit.Hash.String(), *end) {
+		if end != nil && strings.HasPrefix(commit.Hash.String(), *end) {
 			log.Debugf("commit history walk reached the end boundary '%s'", *end)
 			break
 		} else if len(commit.ParentHashes) == 0 {
@@ -1313,11 +5253,491 @@ func (r goGitRepository) WalkHistory(start *string, end *string, visit func(comm
 			log.Debugf("commit history walk reached the end")
 			break
 		} else {
-			commit, err = r.repository.CommitObject(commit.ParentHashes[0]) // follow the first parent upon merge commits
+			parent, err := r.repository.CommitObject(commit.ParentHashes[0]) // follow the first parent upon merge commits
+			if err != nil {
+				if err == ggitplumbing.ErrObjectNotFound {
+					shallow, shallowErr := r.IsShallow()
+					if shallowErr == nil && shallow {
+						log.Debugf("commit history walk reached the shallow boundary at commit '%s'", commit.Hash.String())
+						return &errs.ShallowRepositoryError{Message: fmt.Sprintf("the commit history walk reached the boundary of the shallow repository at commit '%s' and the older commits are not available locally", commit.Hash.String()), Cause: err}
+					}
+				}
+				return &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
+			}
+			commit = parent
+		}
+	}
+	return nil
+}
+
+/*
+Browse the repository commit history using the given visitor to inspect each commit, just like WalkHistory
+but in chronological order, from the oldest to the most recent, so that the commits since a given commit
+can be inspected in the order they were made. Only the first parent of merge commits is followed.
+
+The commits are all read before the first one is visited so, unlike WalkHistory, stopping the visit early
+doesn't spare reading the older commits.
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the most recent commit, which is visited last. If nil the latest commit in the
+    current branch (HEAD) is used. This can be a long or abbreviated SHA-1. If this commit cannot be
+    resolved within the repository a GitError is thrown.
+  - end the optional SHA-1 id of the oldest commit, which is visited first. If nil the repository root
+    commit is used. If this commit is not reachable from the start it will be ignored. This can be a long
+    or abbreviated SHA-1. If this commit cannot be resolved within the repository a GitError is thrown.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function visits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or a given commit identifier cannot be resolved.
+  - ShallowRepositoryError in case the boundary of a shallow repository is reached before the end commit,
+    as the commits beyond the boundary are not available locally. No commit is visited in this case.
+*/
+func (r goGitRepository) WalkHistoryInReverse(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+	if visit == nil {
+		return nil
+	}
+	commits := []gitent.Commit{}
+	err := r.WalkHistory(start, end, func(commit gitent.Commit) bool {
+		commits = append(commits, commit)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	log.Debugf("walking '%d' commits in reverse order", len(commits))
+	for i := len(commits) - 1; i >= 0; i-- {
+		log.Tracef("visiting commit '%s'", commits[i].Sha)
+		if !visit(commits[i]) {
+			log.Debugf("commit history walk interrupted by visitor")
+			break
+		}
+	}
+	return nil
+}
+
+/*
+Browse the repository commit history using the given visitor to inspect each commit, following all the
+parents of merge commits instead of the first parent only, so that commits merged from other branches are
+also visited. Commits are evaluated in topological order, so that no commit is visited before all of its
+children, and the commits of merged branches are visited before the older commits of the branch they're
+merged into (like 'git log --topo-order').
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the commit to start from. If nil the latest commit in the
+    current branch (HEAD) is used. This can be a long or abbreviated SHA-1. If this commit cannot be
+    resolved within the repository a GitError is thrown.
+  - end the optional SHA-1 id of the commit to end with, included. If nil the repository root
+    commits are used (until the given visitor returns false). The walk doesn't go past this commit, so the commits
+    reachable from its parents are not visited, while the other commits are (like 'git log <start> ^<end>^@').
+    This can be a long or abbreviated SHA-1. If this commit cannot be resolved within the repository a GitError is thrown.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function visits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case some problem is encountered with the underlying Git repository, including when
+    the repository has no commits yet or a given commit identifier cannot be resolved.
+  - ShallowRepositoryError in case the walk reaches the boundary of a shallow repository before the visitor
+    stops it or the end commit is reached, as the commits beyond the boundary are not available locally.
+*/
+func (r goGitRepository) WalkHistoryWithAllParents(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+	if visit == nil {
+		return nil
+	}
+	startString := "not defined"
+	if start != nil {
+		startString = *start
+	}
+	endString := "not defined"
+	if end != nil {
+		endString = *end
+	}
+	log.Debugf("walking commit history. Start commit boundary is '%s'. End commit boundary is '%s'", startString, endString)
+	log.Debugf("upon merge commits all parents are considered.")
+
+	var startCommit ggitobject.Commit
+	var err error
+	if start == nil {
+		startHash, err := r.GetLatestCommit()
+		if err != nil {
+			return err
+		}
+		startCommit, err = r.parseCommit(startHash)
+		if err != nil {
+			return err
+		}
+	} else {
+		startCommit, err = r.parseCommit(*start)
+		if err != nil {
+			return err
+		}
+	}
+	log.Tracef("start boundary resolved to commit '%s'", startCommit.Hash.String())
+
+	shallow, err := r.IsShallow()
+	if err != nil {
+		return err
+	}
+	walk := &allParentsWalk{repository: r.repository, shallow: shallow, commits: map[ggitplumbing.Hash]*ggitobject.Commit{startCommit.Hash: &startCommit}, children: map[ggitplumbing.Hash][]ggitplumbing.Hash{}, expanded: map[ggitplumbing.Hash]bool{}, frontier: map[ggitplumbing.Hash]bool{startCommit.Hash: true}, uninteresting: map[ggitplumbing.Hash]bool{}, boundaries: map[ggitplumbing.Hash]bool{}, visited: map[ggitplumbing.Hash]bool{}}
+
+	var endHash *ggitplumbing.Hash
+	if end != nil {
+		// make sure it can be resolved
+		endCommit, err := r.parseCommit(*end)
+		if err != nil {
+			return err
+		}
+		log.Tracef("end boundary resolved to commit '%s'", endCommit.Hash.String())
+		endHash = &endCommit.Hash
+
+		// the commits reachable from the parents of the end boundary are not visited, so the walk doesn't go past
+		// the end boundary while the commits reachable from other parents are still visited
+		for _, parentHash := range endCommit.ParentHashes {
+			_, err := walk.load(parentHash)
 			if err != nil {
+				if err == ggitplumbing.ErrObjectNotFound && shallow {
+					continue
+				}
 				return &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
 			}
+			walk.markUninteresting(parentHash)
+		}
+	}
+
+	// tags are read once and matched to commits by their targets
+	tagsByTarget, err := r.getTagsByTarget()
+	if err != nil {
+		return err
+	}
+
+	// commits are visited when all of their children have been visited, taking the last parent first
+	// so that merged branches are visited before the branch they're merged into
+	err = walk.resolve(startCommit.Hash)
+	if err != nil {
+		return err
+	}
+	stack := []*ggitobject.Commit{}
+	if !walk.uninteresting[startCommit.Hash] {
+		stack = append(stack, &startCommit)
+	}
+	for len(stack) > 0 {
+		commit := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		log.Tracef("visiting commit '%s'", commit.Hash.String())
+
+		if !visit(CommitFrom(*commit, tagsByTarget[commit.Hash.String()])) {
+			log.Debugf("commit history walk interrupted by visitor")
+			return nil
+		}
+		walk.visited[commit.Hash] = true
+		if endHash != nil && commit.Hash == *endHash {
+			log.Debugf("commit history walk reached the end boundary '%s'", *end)
+			continue
+		} else if walk.boundaries[commit.Hash] {
+			log.Debugf("commit history walk reached the shallow boundary at commit '%s'", commit.Hash.String())
+			return &errs.ShallowRepositoryError{Message: fmt.Sprintf("the commit history walk reached the boundary of the shallow repository at commit '%s' and the older commits are not available locally", commit.Hash.String())}
+		}
+		for _, parentHash := range commit.ParentHashes {
+			if _, loaded := walk.commits[parentHash]; !loaded || walk.uninteresting[parentHash] {
+				continue
+			}
+			err = walk.resolve(parentHash)
+			if err != nil {
+				return err
+			}
+			if !walk.uninteresting[parentHash] && walk.pendingChildren(parentHash) == 0 {
+				stack = append(stack, walk.commits[parentHash])
+			}
+		}
+	}
+	log.Debugf("commit history walk reached the end")
+	return nil
+}
+
+/*
+The state of a commit history walk following all the parents of merge commits. Commits are loaded lazily, only as far
+as it's needed to know all the children of the next commit to visit, so that walks stopped early don't load the whole history.
+*/
+type allParentsWalk struct {
+	// The repository to load commits from.
+	repository *ggit.Repository
+
+	// True when the repository is shallow, so that parents may be missing at the shallow boundary.
+	shallow bool
+
+	// The loaded commits.
+	commits map[ggitplumbing.Hash]*ggitobject.Commit
+
+	// The loaded children of each commit.
+	children map[ggitplumbing.Hash][]ggitplumbing.Hash
+
+	// The commits whose parents have been loaded.
+	expanded map[ggitplumbing.Hash]bool
+
+	// The loaded commits whose parents have not been loaded yet.
+	frontier map[ggitplumbing.Hash]bool
+
+	// The commits reachable from the parents of the end boundary, which are not visited.
+	uninteresting map[ggitplumbing.Hash]bool
+
+	// The commits at the shallow boundary, whose parents are not available locally.
+	boundaries map[ggitplumbing.Hash]bool
+
+	// The commits visited so far.
+	visited map[ggitplumbing.Hash]bool
+}
+
+/*
+Returns the commit with the given hash, loading it if it's not loaded yet.
+*/
+func (w *allParentsWalk) load(hash ggitplumbing.Hash) (*ggitobject.Commit, error) {
+	if commit, loaded := w.commits[hash]; loaded {
+		return commit, nil
+	}
+	commit, err := w.repository.CommitObject(hash)
+	if err != nil {
+		return nil, err
+	}
+	w.commits[hash] = commit
+	w.frontier[hash] = true
+	return commit, nil
+}
+
+/*
+Loads the parents of the given commit, which must be loaded already.
+*/
+func (w *allParentsWalk) expand(hash ggitplumbing.Hash) error {
+	if w.expanded[hash] {
+		return nil
+	}
+	w.expanded[hash] = true
+	delete(w.frontier, hash)
+	for _, parentHash := range w.commits[hash].ParentHashes {
+		_, err := w.load(parentHash)
+		if err != nil {
+			if err == ggitplumbing.ErrObjectNotFound && w.shallow {
+				w.boundaries[hash] = true
+				continue
+			}
+			return &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
+		}
+		w.children[parentHash] = append(w.children[parentHash], hash)
+		if w.uninteresting[hash] {
+			w.markUninteresting(parentHash)
 		}
 	}
 	return nil
 }
+
+/*
+Marks the given commit and its loaded ancestors as not to be visited.
+*/
+func (w *allParentsWalk) markUninteresting(hash ggitplumbing.Hash) {
+	queue := []ggitplumbing.Hash{hash}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if w.uninteresting[current] {
+			continue
+		}
+		w.uninteresting[current] = true
+		if w.expanded[current] {
+			for _, parentHash := range w.commits[current].ParentHashes {
+				if _, loaded := w.commits[parentHash]; loaded {
+					queue = append(queue, parentHash)
+				}
+			}
+		}
+	}
+}
+
+/*
+Adds the given commit and its loaded ancestors to the given set.
+*/
+func (w *allParentsWalk) addAncestors(hash ggitplumbing.Hash, ancestors map[ggitplumbing.Hash]bool) {
+	queue := []ggitplumbing.Hash{hash}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if ancestors[current] {
+			continue
+		}
+		ancestors[current] = true
+		if w.expanded[current] {
+			for _, parentHash := range w.commits[current].ParentHashes {
+				if _, loaded := w.commits[parentHash]; loaded {
+					queue = append(queue, parentHash)
+				}
+			}
+		}
+	}
+}
+
+/*
+Loads commits until all the children of the given commit, and whether they are to be visited, are known. This is when
+all the loaded commits whose parents are not loaded yet are ancestors of the given commit, as any other commit may lead
+to more children. The most recent commits are loaded first so that the walk usually loads no more than the commits it visits.
+*/
+func (w *allParentsWalk) resolve(hash ggitplumbing.Hash) error {
+	err := w.expand(hash)
+	if err != nil {
+		return err
+	}
+	ancestors := map[ggitplumbing.Hash]bool{}
+	w.addAncestors(hash, ancestors)
+	for {
+		resolved := true
+		var next *ggitobject.Commit
+		for candidate := range w.frontier {
+			if !ancestors[candidate] {
+				resolved = false
+			}
+			commit := w.commits[candidate]
+			if next == nil || commit.Committer.When.After(next.Committer.When) {
+				next = commit
+			}
+		}
+		if resolved {
+			return nil
+		}
+		err = w.expand(next.Hash)
+		if err != nil {
+			return err
+		}
+		if ancestors[next.Hash] {
+			for _, parentHash := range next.ParentHashes {
+				if _, loaded := w.commits[parentHash]; loaded {
+					w.addAncestors(parentHash, ancestors)
+				}
+			}
+		}
+	}
+}
+
+/*
+Returns the number of children of the given commit that are still to be visited.
+*/
+func (w *allParentsWalk) pendingChildren(hash ggitplumbing.Hash) int {
+	count := 0
+	for _, child := range w.children[hash] {
+		if !w.visited[child] && !w.uninteresting[child] {
+			count++
+		}
+	}
+	return count
+}
+
+/*
+Returns a copy of this repository whose operations connecting to a remote (fetching, pushing, unshallowing, deleting
+remote tags and checking remote branches) are bounded by the given context, on top of the timeout set for the
+repository. This repository is left unchanged.
+
+Arguments are as follows:
+
+- ctx the context bounding the operations connecting to a remote. When nil operations are only bounded by the timeout.
+*/
+func (r goGitRepository) WithContext(ctx context.Context) Repository {
+	r.options.context = ctx
+	return r
+}
+
+/*
+The status of a go-git repository taken by goGitRepository.Snapshot().
+*/
+type goGitSnapshot struct {
+	// The backing go-git repository.
+	repository *ggit.Repository
+
+	// The HEAD reference, as it was when the snapshot was taken. This is a symbolic reference unless HEAD was detached.
+	head *ggitplumbing.Reference
+
+	// The branch HEAD pointed to, as it was when the snapshot was taken. It's nil when HEAD was detached or the
+	// branch had no commits yet.
+	branch *ggitplumbing.Reference
+
+	// The encoded index, as it was when the snapshot was taken.
+	index []byte
+
+	// The local tags, as they were when the snapshot was taken, mapped to the objects they pointed to.
+	tags map[ggitplumbing.ReferenceName]ggitplumbing.Hash
+
+	// The working tree files included in the snapshot, as they were when the snapshot was taken.
+	files []worktreeFile
+}
+
+/*
+Restores the repository to the status it had when the snapshot was taken.
+
+Errors can be:
+
+- GitError in case some problem is encountered with the underlying Git repository.
+*/
+func (s goGitSnapshot) Restore() error {
+	log.Debugf("restoring the repository from the snapshot")
+
+	// restore tags, removing those created after the snapshot
+	tagsIterator, err := s.repository.Tags()
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("cannot list repository tags"), Cause: err}
+	}
+	var addedTags []ggitplumbing.ReferenceName
+	err = tagsIterator.ForEach(func(ref *ggitplumbing.Reference) error {
+		if _, ok := s.tags[ref.Name()]; !ok {
+			addedTags = append(addedTags, ref.Name())
+		}
+		return nil
+	})
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("error while listing repository tags"), Cause: err}
+	}
+	for _, name := range addedTags {
+		log.Debugf("removing tag '%s'", name.Short())
+		err = s.repository.Storer.RemoveReference(name)
+		if err != nil {
+			return &errs.GitError{Message: fmt.Sprintf("unable to remove tag '%s'", name.Short()), Cause: err}
+		}
+	}
+	for name, hash := range s.tags {
+		err = s.repository.Storer.SetReference(ggitplumbing.NewHashReference(name, hash))
+		if err != nil {
+			return &errs.GitError{Message: fmt.Sprintf("unable to restore tag '%s'", name.Short()), Cause: err}
+		}
+	}
+
+	// restore the branch, discarding the commits added after the snapshot, and HEAD
+	if s.head.Type() == ggitplumbing.SymbolicReference {
+		if s.branch == nil {
+			err = s.repository.Storer.RemoveReference(s.head.Target())
+		} else {
+			err = s.repository.Storer.SetReference(s.branch)
+		}
+		if err != nil {
+			return &errs.GitError{Message: fmt.Sprintf("unable to restore reference '%s'", s.head.Target().String()), Cause: err}
+		}
+	}
+	err = s.repository.Storer.SetReference(s.head)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to restore reference to HEAD"), Cause: err}
+	}
+
+	// restore the index
+	var idx ggitindex.Index
+	err = ggitindex.NewDecoder(bytes.NewReader(s.index)).Decode(&idx)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to restore the repository index"), Cause: err}
+	}
+	err = s.repository.Storer.SetIndex(&idx)
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("unable to restore the repository index"), Cause: err}
+	}
+
+	// restore the working tree files
+	return restoreWorktreeFiles(s.files)
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+/*
+Returns the git arguments to run a commit command honoring the options about hooks (see Options.NoVerify and
+Options.HooksPath). The given arguments are appended to the 'commit' subcommand.
+*/
+func (o repositoryOptions) cliCommitArguments(args ...string) []string {
+	res := []string{}
+	if o.hooksPath != nil {
+		res = append(res, "-c", "core.hooksPath="+*o.hooksPath)
+	}
+	res = append(res, "commit")
+	if o.noVerify {
+		res = append(res, "--no-verify")
+	}
+	return append(res, args...)
+}
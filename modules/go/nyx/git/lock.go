@@ -0,0 +1,144 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"errors"        // https://pkg.go.dev/errors
+	"fmt"           // https://pkg.go.dev/fmt
+	"io/fs"         // https://pkg.go.dev/io/fs
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The name of the file used as the advisory lock on the repository, created in the Git directory shared by all
+	// the worktrees.
+	LOCK_FILE_NAME = "nyx.lock"
+
+	// The interval between two attempts to acquire a lock held by someone else.
+	lockPollInterval = 500 * time.Millisecond
+)
+
+/*
+The advisory lock on a repository acquired by Repository.Lock(), held until it's released.
+*/
+type Lock interface {
+	/*
+	   Releases the lock so that others can acquire it.
+
+	   Errors can be:
+
+	   - GitError in case the lock can't be released.
+	*/
+	Release() error
+}
+
+/*
+The lock backed by a lock file, which exists as long as the lock is held.
+*/
+type fileLock struct {
+	// The absolute path of the lock file.
+	path string
+}
+
+/*
+Acquires the lock backed by the lock file with the given path, which is created exclusively so that only one
+process at a time can hold the lock. When the file already exists the lock is held by someone else and this
+method waits for it to be released, up to the given time.
+
+Arguments are as follows:
+
+  - path the path of the lock file
+  - wait the maximum time to wait for the lock when it's held by someone else. When zero or negative this method
+    fails straight away when the lock is held by someone else
+
+Errors can be:
+
+  - GitError in case the lock is still held by someone else when the wait time expires or the lock file can't be
+    created
+*/
+func acquireFileLock(path string, wait time.Duration) (Lock, error) {
+	deadline := time.Now().Add(wait)
+	waiting := false
+	for {
+		file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			hostname, _ := os.Hostname()
+			_, err = file.WriteString(fmt.Sprintf("pid %d on host '%s' since %s\n", os.Getpid(), hostname, time.Now().Format(time.RFC3339)))
+			closeErr := file.Close()
+			if err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(path)
+				return nil, &errs.GitError{Message: fmt.Sprintf("unable to write the lock file '%s'", path), Cause: err}
+			}
+			log.Debugf("lock '%s' acquired", path)
+			return fileLock{path: path}, nil
+		}
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to create the lock file '%s'", path), Cause: err}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			owner, _ := os.ReadFile(path)
+			return nil, &errs.GitError{Message: fmt.Sprintf("the repository is locked by another run (%s); if no other run is in progress the lock is stale and the '%s' file can be safely removed", strings.TrimSpace(string(owner)), path)}
+		}
+		if !waiting {
+			log.Infof("the repository is locked by another run, waiting up to %s for the lock '%s' to be released", remaining.Round(time.Second).String(), path)
+			waiting = true
+		}
+		if remaining > lockPollInterval {
+			remaining = lockPollInterval
+		}
+		time.Sleep(remaining)
+	}
+}
+
+/*
+Releases the lock by removing the lock file.
+
+Errors can be:
+
+- GitError in case the lock file can't be removed.
+*/
+func (l fileLock) Release() error {
+	err := os.Remove(l.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return &errs.GitError{Message: fmt.Sprintf("unable to remove the lock file '%s'", l.path), Cause: err}
+	}
+	log.Debugf("lock '%s' released", l.path)
+	return nil
+}
+
+/*
+Returns the absolute path of the lock file in the given Git directory.
+*/
+func lockFilePath(gitDirectory string) (string, error) {
+	path, err := filepath.Abs(filepath.Join(gitDirectory, LOCK_FILE_NAME))
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to resolve the path of the lock file in '%s'", gitDirectory), Cause: err}
+	}
+	return path, nil
+}
@@ -51,7 +51,7 @@ func CommitFrom(commit ggitobject.Commit, tags []gitent.Tag) gitent.Commit {
 	for i, parent := range commit.ParentHashes {
 		parents[i] = parent.String()
 	}
-	return gitent.Commit{Sha: commit.ID().String(), AuthorAction: ActionFrom(commit.Author), CommitAction: ActionFrom(commit.Committer), Date: commit.Committer.When.UnixMilli(), Message: MessageFrom(commit), Parents: parents, Tags: tags}
+	return gitent.Commit{Sha: commit.ID().String(), AuthorAction: ActionFrom(commit.Author), CommitAction: ActionFrom(commit.Committer), Date: commit.Committer.When.UnixMilli(), Message: MessageFrom(commit), Parents: parents, Tags: tags, Trailers: gitent.ParseTrailers(commit.Message)}
 }
 
 /*
@@ -120,21 +120,15 @@ Arguments are as follows:
 - ref the git reference to get the data from.
 */
 func TagFrom(repository *ggit.Repository, ref ggitplumbing.Reference) gitent.Tag {
-	var annotated bool
-	var target string
+	// also strip the leading "refs/tags/" from the tag name
+	name := strings.Replace(string(ref.Name()), "refs/tags/", "", 1)
 	annotatedTag, err := repository.TagObject(ref.Hash())
 	if err == nil {
 		// it's an annotated tag, annotatedTag is valid
-		annotated = true
-		target = annotatedTag.Target.String()
-	} else {
-		// it's a lightweight tag, annotatedTag is not valid
-		annotated = false
-		target = ref.Hash().String()
+		return *gitent.NewAnnotatedTagWith(name, annotatedTag.Target.String(), messageFromString(annotatedTag.Message), ActionFrom(annotatedTag.Tagger))
 	}
-
-	// also strip the leading "refs/tags/" from the tag name
-	return gitent.Tag{Name: strings.Replace(string(ref.Name()), "refs/tags/", "", 1), Target: target, Annotated: annotated}
+	// it's a lightweight tag, annotatedTag is not valid
+	return *gitent.NewTagWith(name, ref.Hash().String(), false)
 }
 
 /*
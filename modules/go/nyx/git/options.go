@@ -0,0 +1,205 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"context" // https://pkg.go.dev/context
+	"net/url" // https://pkg.go.dev/net/url
+	"strings" // https://pkg.go.dev/strings
+	"time"    // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+)
+
+/*
+The options of the repositories opened or cloned through a Git instance (see GitInstanceWith). Options only apply to
+the repositories obtained from the same Git instance, so that different runs in the same process (i.e. the server
+handling several events) never share them.
+
+The zero value brings the default options.
+*/
+type Options struct {
+	// The Git implementation (backend), either GO_GIT_BACKEND or CLI_BACKEND. When empty GO_GIT_BACKEND is used.
+	// Repositories are always cloned using go-git and then accessed using the selected backend.
+	Backend string
+
+	// The optional URL of the proxy used by the HTTP and HTTPS transports (i.e. http://proxy.example.com:3128).
+	// When nil or empty the proxy is read from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables
+	// (or their lowercase versions).
+	Proxy *string
+
+	// The extra headers added to all the requests sent by the HTTP and HTTPS transports, by name. Headers replace
+	// those with the same names set by Nyx, including the Authorization header. SSH remotes are not affected.
+	// Headers with blank names are ignored.
+	Headers map[string]string
+
+	// When true clones only fetch the branch to check out (the one passed to the clone methods or the remote default
+	// branch when none is passed) instead of all the remote branches.
+	SingleBranch bool
+
+	// When true clones are bare mirrors of the remote repositories (like 'git clone --mirror') instead of regular
+	// clones with a working tree.
+	Mirror bool
+
+	// The fixed time used as the author, committer and tagger date of the commits and tags, as the number of seconds
+	// since the epoch (UTC). When nil or empty the current time is used.
+	Timestamp *string
+
+	// The maximum time each operation connecting to a remote (cloning, fetching, pushing and listing the remote
+	// references) is allowed to take, as the number of seconds. When nil, empty or zero remote operations never
+	// time out.
+	Timeout *string
+
+	// The number of times each operation connecting to a remote is retried when it fails for transient reasons.
+	// When nil, empty or zero remote operations are never retried.
+	Retries *string
+
+	// The delay before the first retry of an operation connecting to a remote, as the number of seconds. The delay
+	// doubles at every further retry. When nil or empty DEFAULT_REMOTE_RETRY_DELAY is used.
+	RetryDelay *string
+
+	// When true the pre-commit and commit-msg hooks are bypassed, like 'git commit --no-verify'. Hooks are only run
+	// by the CLI backend.
+	NoVerify bool
+
+	// The directory to look up hooks from, overriding the 'core.hooksPath' Git option. Relative paths are resolved
+	// from the root of the working tree. When nil or empty the directory configured for the repository is used.
+	HooksPath *string
+
+	// The changes to submodules ignored when checking whether repositories are clean and when staging, one of
+	// IGNORE_SUBMODULES_NONE, IGNORE_SUBMODULES_UNTRACKED, IGNORE_SUBMODULES_DIRTY or IGNORE_SUBMODULES_ALL.
+	// When nil or empty the Git default applies.
+	IgnoreSubmodules *string
+}
+
+/*
+The options of a repository, parsed and validated from Options.
+*/
+type repositoryOptions struct {
+	// The Git implementation (backend), either GO_GIT_BACKEND or CLI_BACKEND.
+	backend string
+
+	// The optional URL of the proxy used by the HTTP and HTTPS transports.
+	proxy *string
+
+	// The function selecting the proxy for a given request URL, as returned by getProxyFunc.
+	proxyFunc func(*url.URL) (*url.URL, error)
+
+	// The extra headers added to all the requests sent by the HTTP and HTTPS transports.
+	headers map[string]string
+
+	// When true clones only fetch the branch to check out instead of all the remote branches.
+	singleBranch bool
+
+	// When true clones are bare mirrors of the remote repository.
+	mirror bool
+
+	// The fixed time used as the author, committer and tagger date of commits and tags. When nil the current time
+	// from the clock in use is used.
+	fixedTimestamp *time.Time
+
+	// The maximum time each operation connecting to a remote is allowed to take. When zero remote operations never
+	// time out.
+	timeout time.Duration
+
+	// The number of times an operation connecting to a remote is retried when it fails for transient reasons.
+	retries int
+
+	// The delay before the first retry of a failed operation connecting to a remote.
+	retryDelay time.Duration
+
+	// When true the hooks are bypassed, like 'git commit --no-verify'.
+	noVerify bool
+
+	// The directory to look up hooks from, overriding the 'core.hooksPath' Git option. When nil the directory
+	// configured for the repository is used.
+	hooksPath *string
+
+	// The changes to submodules ignored when checking the repository status and staging. When empty the Git
+	// default applies, which is IGNORE_SUBMODULES_NONE for go-git while git also honors the
+	// 'submodule.<name>.ignore' and 'diff.ignoreSubmodules' options.
+	ignoreSubmodules string
+
+	// The context bounding all the operations connecting to a remote, on top of the timeout. When nil the
+	// background context is used.
+	context context.Context
+}
+
+/*
+Returns the repository options parsed from the given options.
+
+Errors can be:
+
+  - IllegalArgumentError if any of the given options is not valid
+*/
+func newRepositoryOptions(options Options) (repositoryOptions, error) {
+	var res repositoryOptions
+	var err error
+	res.backend, err = parseBackend(options.Backend)
+	if err != nil {
+		return repositoryOptions{}, err
+	}
+	res.proxyFunc, err = getProxyFunc(options.Proxy)
+	if err != nil {
+		return repositoryOptions{}, err
+	}
+	if options.Proxy != nil && "" != strings.TrimSpace(*options.Proxy) {
+		log.Debugf("using the configured proxy for HTTP and HTTPS remotes")
+		res.proxy = options.Proxy
+	}
+	res.headers, err = parseHeaders(options.Headers)
+	if err != nil {
+		return repositoryOptions{}, err
+	}
+	res.singleBranch = options.SingleBranch
+	if res.singleBranch {
+		log.Debugf("clones will only fetch the branch to check out")
+	}
+	res.mirror = options.Mirror
+	if res.mirror {
+		log.Debugf("clones will be bare mirrors of the remote repositories")
+	}
+	res.fixedTimestamp, err = parseTimestamp(options.Timestamp)
+	if err != nil {
+		return repositoryOptions{}, err
+	}
+	res.timeout, err = parseTimeout(options.Timeout)
+	if err != nil {
+		return repositoryOptions{}, err
+	}
+	res.retries, err = parseRetries(options.Retries)
+	if err != nil {
+		return repositoryOptions{}, err
+	}
+	res.retryDelay, err = parseRetryDelay(options.RetryDelay)
+	if err != nil {
+		return repositoryOptions{}, err
+	}
+	res.noVerify = options.NoVerify
+	if res.noVerify {
+		log.Debugf("hooks will be bypassed when committing")
+	}
+	if options.HooksPath != nil && "" != strings.TrimSpace(*options.HooksPath) {
+		log.Debugf("hooks will be looked up from '%s' when committing", *options.HooksPath)
+		res.hooksPath = options.HooksPath
+	}
+	res.ignoreSubmodules, err = parseIgnoreSubmodules(options.IgnoreSubmodules)
+	if err != nil {
+		return repositoryOptions{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+var (
+	// The (lower case) fragments of the messages reported by go-git, the git CLI and SSH when the remote rejects the credentials.
+	authenticationFailureMessages = []string{"authentication required", "authorization failed", "authentication failed", "permission denied", "could not read username", "unable to authenticate", "invalid username or password"}
+
+	// The (lower case) fragments of the messages reported by go-git and the git CLI when the remote rejects an update as conflicting.
+	conflictMessages = []string{"non-fast-forward", "[rejected]", "fetch first", "already exists", "stale info"}
+
+	// The (lower case) fragments of the messages reported by go-git, the git CLI and SSH when the operation on the remote times out.
+	timeoutMessages = []string{"context deadline exceeded", "timed out"}
+
+	// The (lower case) fragments of the messages reported by go-git, the git CLI and SSH when the operation on the remote fails for transient network or provider issues.
+	transientMessages = []string{"connection refused", "connection reset", "broken pipe", "unexpected eof", "early eof", "remote end hung up", "could not resolve host", "no such host", "temporary failure in name resolution", "i/o timeout", "rpc failed", "bad gateway", "service unavailable", "gateway timeout", "returned error: 50", "status code: 50"}
+)
+
+/*
+Classifies the given error returned by an operation on a remote so that callers can tell the failure category apart.
+The returned error wraps the given one into a SecurityError when the remote rejected the credentials or into a
+ConflictError when the remote rejected the update because it conflicts with its contents or into a TransportError
+when the operation timed out. Other errors are returned as they are.
+
+Arguments are as follows:
+
+- err the error to classify. It may be nil, in which case nil is returned.
+*/
+func classifyRemoteError(err error) error {
+	if err == nil {
+		return nil
+	}
+	message := strings.ToLower(err.Error())
+	for _, fragment := range authenticationFailureMessages {
+		if strings.Contains(message, fragment) {
+			return &errs.SecurityError{Message: fmt.Sprintf("the remote rejected the credentials"), Cause: err}
+		}
+	}
+	for _, fragment := range conflictMessages {
+		if strings.Contains(message, fragment) {
+			return &errs.ConflictError{Message: fmt.Sprintf("the remote rejected the update as it conflicts with its contents"), Cause: err}
+		}
+	}
+	for _, fragment := range timeoutMessages {
+		if strings.Contains(message, fragment) {
+			return &errs.TransportError{Message: fmt.Sprintf("the operation on the remote timed out"), Cause: err}
+		}
+	}
+	return err
+}
+
+/*
+Returns true if the given error returned by an operation on a remote is transient, like network failures, timeouts
+and the temporary unavailability of the remote, so that the operation is worth retrying. Errors due to the remote
+rejecting the credentials or the update, or to missing repositories and references, are permanent.
+
+Arguments are as follows:
+
+- err the error to check. It may be nil, in which case false is returned.
+*/
+func isTransientRemoteError(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch classifyRemoteError(err).(type) {
+	case *errs.SecurityError, *errs.ConflictError:
+		return false
+	case *errs.TransportError:
+		return true
+	}
+	message := strings.ToLower(err.Error())
+	for _, fragment := range transientMessages {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"errors"  // https://pkg.go.dev/errors
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+func TestClassifyRemoteError(t *testing.T) {
+	assert.Nil(t, classifyRemoteError(nil))
+
+	// go-git and CLI authentication failures
+	for _, message := range []string{"authentication required", "fatal: Authentication failed for 'https://example.com/repo.git/'", "git@example.com: Permission denied (publickey)."} {
+		err := classifyRemoteError(errors.New(message))
+		_, ok := err.(*errs.SecurityError)
+		assert.True(t, ok, message)
+	}
+
+	// go-git and CLI rejected updates
+	for _, message := range []string{"non-fast-forward update: refs/heads/main", " ! [rejected]        main -> main (fetch first)", " ! [rejected]        1.0.0 -> 1.0.0 (already exists)"} {
+		err := classifyRemoteError(errors.New(message))
+		_, ok := err.(*errs.ConflictError)
+		assert.True(t, ok, message)
+	}
+
+	// go-git, CLI and SSH timeouts
+	for _, message := range []string{"context deadline exceeded", "the 'git fetch' command timed out after '30s'", "ssh: connect to host example.com port 22: Connection timed out"} {
+		err := classifyRemoteError(errors.New(message))
+		_, ok := err.(*errs.TransportError)
+		assert.True(t, ok, message)
+	}
+
+	// other errors are returned as they are
+	other := errors.New("repository not found")
+	assert.Equal(t, other, classifyRemoteError(other))
+}
+
+func TestIsTransientRemoteError(t *testing.T) {
+	assert.False(t, isTransientRemoteError(nil))
+
+	// go-git, CLI and SSH network and provider failures
+	for _, message := range []string{"context deadline exceeded", "dial tcp 127.0.0.1:80: connect: connection refused", "read tcp 127.0.0.1:80: read: connection reset by peer", "fatal: the remote end hung up unexpectedly", "fatal: unable to access 'https://example.com/repo.git/': Could not resolve host: example.com", "fatal: unable to access 'https://example.com/repo.git/': The requested URL returned error: 503", "unexpected client error: unexpected requesting \"https://example.com/repo.git/info/refs\" status code: 502"} {
+		assert.True(t, isTransientRemoteError(errors.New(message)), message)
+	}
+
+	// authentication failures, rejected updates and other errors are permanent
+	for _, message := range []string{"authentication required", "fatal: Authentication failed for 'https://example.com/repo.git/'", " ! [rejected]        main -> main (fetch first)", "repository not found", "couldn't find remote ref refs/heads/missing"} {
+		assert.False(t, isTransientRemoteError(errors.New(message)), message)
+	}
+}
@@ -0,0 +1,1054 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"context" // https://pkg.go.dev/context
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+	"time"    // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+const (
+	// The read only backend using the APIs of a hosting service, without a local repository.
+	REMOTE_BACKEND = "REMOTE"
+)
+
+/*
+A read only repository implementation that has no local clone and reads the commit history and the tags from the
+APIs of a hosting service supporting the COMMIT_HISTORY feature. This lets inferring the version without
+cloning (i.e. in serverless contexts) at the cost of one request for each page of commits.
+
+Operations changing the repository (staging, committing, tagging and pushing) are not supported. Operations
+reading from remotes (fetching tags and unshallowing) take no action as the history and the tags are always
+read from the service.
+*/
+type remoteRepository struct {
+	// The service used to read the repository.
+	service svcapi.CommitHistoryService
+
+	// The name of the branch to read the history from.
+	branch string
+
+	// The repository tags, lazily read by GetTags.
+	tags []gitent.Tag
+}
+
+/*
+Returns a read only repository instance backed by the given service.
+
+Arguments are as follows:
+
+- service the service to read the repository from. The repository owner and name are taken from the service options.
+- branch the name of the branch to read the history from. When nil the repository default branch is used.
+
+Errors can be:
+
+- NilPointerError if the service is nil
+- GitError in case the default branch can't be read from the service
+*/
+func openRemoteRepository(service svcapi.CommitHistoryService, branch *string) (*remoteRepository, error) {
+	if service == nil {
+		return nil, &errs.NilPointerError{Message: "can't create a remote repository instance with a nil service"}
+	}
+	repository := &remoteRepository{service: service}
+	if branch != nil && "" != strings.TrimSpace(*branch) {
+		repository.branch = *branch
+	} else {
+		defaultBranch, err := service.GetDefaultBranch(nil, nil)
+		if err != nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the default branch of the remote repository"), Cause: err}
+		}
+		repository.branch = defaultBranch
+	}
+	log.Debugf("the repository is accessed through the service APIs, reading branch '%s'", repository.branch)
+	return repository, nil
+}
+
+/*
+Returns the error returned by the operations not supported by this backend.
+*/
+func (r *remoteRepository) unsupported(operation string) error {
+	return &errs.GitError{Message: fmt.Sprintf("%s is not supported by the '%s' Git backend, which is read only", operation, REMOTE_BACKEND)}
+}
+
+/*
+Returns the commit built from the given commit read from the service.
+*/
+func (r *remoteRepository) toCommit(commit svcapi.Commit, tags []gitent.Tag) gitent.Commit {
+	authorAction := gitent.Action{Identity: gitent.Identity{Name: commit.GetAuthorName(), Email: commit.GetAuthorEmail()}, TimeStamp: *gitent.NewTimeStampFrom(time.UnixMilli(commit.GetAuthorDate()))}
+	commitAction := gitent.Action{Identity: gitent.Identity{Name: commit.GetCommitterName(), Email: commit.GetCommitterEmail()}, TimeStamp: *gitent.NewTimeStampFrom(time.UnixMilli(commit.GetCommitterDate()))}
+	parents := commit.GetParents()
+	if parents == nil {
+		parents = []string{}
+	}
+	return gitent.Commit{Sha: commit.GetSHA(), AuthorAction: authorAction, CommitAction: commitAction, Date: commit.GetCommitterDate(), Message: messageFromString(commit.GetMessage()), Parents: parents, Tags: tags, Trailers: gitent.ParseTrailers(commit.GetMessage())}
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) Add(paths []string) error {
+	return r.unsupported("staging")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) AddRemote(name string, url string) error {
+	return r.unsupported("configuring remotes")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) CommitWithMessage(message *string) (gitent.Commit, error) {
+	return gitent.Commit{}, r.unsupported("committing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) CommitWithMessageAndIdentities(message *string, author *gitent.Identity, committer *gitent.Identity) (gitent.Commit, error) {
+	return gitent.Commit{}, r.unsupported("committing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) CommitPathsWithMessage(paths []string, message *string) (gitent.Commit, error) {
+	return gitent.Commit{}, r.unsupported("committing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) CommitPathsWithMessageAndIdentities(paths []string, message *string, author *gitent.Identity, committer *gitent.Identity) (gitent.Commit, error) {
+	return gitent.Commit{}, r.unsupported("committing")
+}
+
+/*
+Returns the number of commits reachable from the 'to' commit and not reachable from the 'from' commit, following
+all the parents of merge commits, just like 'git rev-list --count from..to'. This requires reading the whole history
+reachable from both commits from the service.
+
+Arguments are as follows:
+
+  - from the optional SHA-1 id of the commit to count from, excluded along with all of its ancestors. If nil
+    all the commits reachable from the 'to' commit are counted. This can be a long or abbreviated SHA-1, as long
+    as the service can resolve it.
+  - to the optional SHA-1 id of the commit to count to, included. If nil the latest commit in the
+    branch the history is read from is used. This can be a long or abbreviated SHA-1, as long as the service
+    can resolve it.
+
+Errors can be:
+
+  - GitError in case the commits can't be read from the service or a given commit identifier cannot be resolved.
+*/
+func (r *remoteRepository) CountCommitsBetween(from *string, to *string) (int, error) {
+	excluded := map[string]bool{}
+	if from != nil {
+		err := r.service.WalkCommits(nil, nil, *from, func(serviceCommit svcapi.Commit) bool {
+			excluded[serviceCommit.GetSHA()] = true
+			return true
+		})
+		if err != nil {
+			return 0, &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
+		}
+	}
+	ref := r.branch
+	if to != nil {
+		ref = *to
+	}
+	count := 0
+	err := r.service.WalkCommits(nil, nil, ref, func(serviceCommit svcapi.Commit) bool {
+		if !excluded[serviceCommit.GetSHA()] {
+			count++
+		}
+		return true
+	})
+	if err != nil {
+		return 0, &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
+	}
+	log.Debugf("there are '%d' commits up to '%s'", count, ref)
+	return count, nil
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) DeleteTag(name string) error {
+	return r.unsupported("deleting tags")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) DeleteRemoteTagWithUserNameAndPassword(remote *string, name string, user *string, password *string) (string, error) {
+	return "", r.unsupported("deleting tags")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) DeleteRemoteTagWithToken(remote *string, name string, token *string, user *string) (string, error) {
+	return "", r.unsupported("deleting tags")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) DeleteRemoteTagWithPublicKeyAndHostKeys(remote *string, name string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	return "", r.unsupported("deleting tags")
+}
+
+/*
+Takes no action as tags are always read from the service.
+*/
+func (r *remoteRepository) FetchTagsFromRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error) {
+	log.Debugf("tags are read from the service APIs and don't need to be fetched")
+	return "", nil
+}
+
+/*
+Takes no action as tags are always read from the service.
+*/
+func (r *remoteRepository) FetchTagsFromRemoteWithToken(remote *string, token *string, user *string) (string, error) {
+	return r.FetchTagsFromRemoteWithUserNameAndPassword(remote, user, token)
+}
+
+/*
+Takes no action as tags are always read from the service.
+*/
+func (r *remoteRepository) FetchTagsFromRemoteWithPublicKeyAndHostKeys(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	return r.FetchTagsFromRemoteWithUserNameAndPassword(remote, nil, nil)
+}
+
+/*
+Returns the branch the history is read from, mapped to the SHA-1 identifier of its latest commit, as that's
+the only branch known to this repository. Remote tracking branches are not available so includeRemote is ignored.
+
+Errors can be:
+
+- GitError in case the latest commit can't be read from the service.
+*/
+func (r *remoteRepository) GetBranchNames(includeRemote bool) (map[string]string, error) {
+	commitSHA, err := r.GetLatestCommit()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{r.branch: commitSHA}, nil
+}
+
+/*
+Returns the paths of the files changed by the given commit, compared to its first parent, as reported by the service.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the changed paths for.
+
+Errors can be:
+
+- GitError in case the commit can't be read from the service.
+*/
+func (r *remoteRepository) GetCommitChangedPaths(commit string) ([]string, error) {
+	log.Debugf("retrieving changed paths for commit '%s'", commit)
+	paths, err := r.service.GetCommitChangedPaths(nil, nil, commit)
+	if err != nil {
+		return nil, &errs.GitError{Message: fmt.Sprintf("unable to compute the changes for commit '%s'", commit), Cause: err}
+	}
+	return paths, nil
+}
+
+/*
+Returns the files changed by the given commit, compared to its first parent, as reported by the service.
+The service only reports the paths so the number of lines added and deleted in each file is always 0.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the changes for.
+
+Errors can be:
+
+- GitError in case the commit can't be read from the service.
+*/
+func (r *remoteRepository) GetCommitChanges(commit string) ([]gitent.FileChange, error) {
+	paths, err := r.GetCommitChangedPaths(commit)
+	if err != nil {
+		return nil, err
+	}
+	res := []gitent.FileChange{}
+	for _, path := range paths {
+		res = append(res, *gitent.NewFileChangeWith(path, 0, 0))
+	}
+	return res, nil
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) GetCommitFileContent(commit string, path string, maxSize int64) (string, error) {
+	return "", r.unsupported("reading files")
+}
+
+/*
+Always returns an empty string as this backend can't compute patch identifiers, so cherry-picked commits are never
+detected.
+*/
+func (r *remoteRepository) GetCommitPatchID(commit string) (string, error) {
+	return "", nil
+}
+
+/*
+Returns a set of objects representing all the tags for the given commit.
+
+Arguments are as follows:
+
+- commit the SHA-1 identifier of the commit to get the tags for. It can be a full or abbreviated SHA-1.
+
+Errors can be:
+
+- GitError in case the tags can't be read from the service.
+*/
+func (r *remoteRepository) GetCommitTags(commit string) ([]gitent.Tag, error) {
+	log.Debugf("retrieving tags for commit '%s'", commit)
+	tags, err := r.GetTags()
+	if err != nil {
+		return nil, err
+	}
+	var res []gitent.Tag
+	for _, tag := range tags {
+		if strings.HasPrefix(tag.Target, commit) {
+			res = append(res, tag)
+		}
+	}
+	return res, nil
+}
+
+/*
+Always returns nil as there is no local configuration.
+*/
+func (r *remoteRepository) GetConfiguredIdentity() (*gitent.Identity, error) {
+	return nil, nil
+}
+
+/*
+Returns the name of the branch the history is read from.
+*/
+func (r *remoteRepository) GetCurrentBranch() (string, error) {
+	return r.branch, nil
+}
+
+/*
+Returns the default branch of the repository, as reported by the service. The remote argument is ignored as
+there are no remotes configured locally.
+
+Errors can be:
+
+- GitError in case the default branch can't be read from the service.
+*/
+func (r *remoteRepository) GetDefaultBranch(remote *string) (string, error) {
+	defaultBranch, err := r.service.GetDefaultBranch(nil, nil)
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to read the default branch of the remote repository"), Cause: err}
+	}
+	return defaultBranch, nil
+}
+
+/*
+Returns the hash algorithm used by the repository to identify objects, inferred from the identifier of the
+latest commit in the branch the history is read from as the services don't advertise the object format.
+
+Errors can be:
+
+  - GitError in case the commits can't be read from the service or the branch has no commits.
+*/
+func (r *remoteRepository) GetHashAlgorithm() (gitent.HashAlgorithm, error) {
+	commitSHA, err := r.GetLatestCommit()
+	if err != nil {
+		return gitent.SHA1, err
+	}
+	hashAlgorithm, err := gitent.HashAlgorithmOf(commitSHA)
+	if err != nil {
+		return gitent.SHA1, &errs.GitError{Message: fmt.Sprintf("unable to infer the hash algorithm from commit '%s'", commitSHA), Cause: err}
+	}
+	return hashAlgorithm, nil
+}
+
+/*
+Returns the SHA-1 identifier of the last commit in the branch the history is read from.
+
+Errors can be:
+
+  - GitError in case the commits can't be read from the service or the branch has no commits.
+*/
+func (r *remoteRepository) GetLatestCommit() (string, error) {
+	var commitSHA string
+	err := r.service.WalkCommits(nil, nil, r.branch, func(commit svcapi.Commit) bool {
+		commitSHA = commit.GetSHA()
+		return false
+	})
+	if err != nil {
+		return "", &errs.GitError{Message: fmt.Sprintf("unable to read the latest commit in branch '%s'", r.branch), Cause: err}
+	}
+	if "" == commitSHA {
+		return "", &errs.GitError{Message: fmt.Sprintf("branch '%s' has no commits", r.branch)}
+	}
+	log.Debugf("repository latest commit in '%s' branch is '%s'", r.branch, commitSHA)
+	return commitSHA, nil
+}
+
+/*
+Always returns an empty list as there are no remotes configured locally.
+*/
+func (r *remoteRepository) GetRemoteNames() ([]string, error) {
+	return []string{}, nil
+}
+
+/*
+Always returns an error as there are no remotes configured locally.
+*/
+func (r *remoteRepository) GetRemoteURL(remote *string) (string, error) {
+	return "", &errs.GitError{Message: fmt.Sprintf("remotes are not available with the '%s' Git backend", REMOTE_BACKEND)}
+}
+
+/*
+Always returns an empty list as there are no remotes configured locally.
+*/
+func (r *remoteRepository) GetRemotes() ([]gitent.Remote, error) {
+	return []gitent.Remote{}, nil
+}
+
+/*
+Returns the SHA-1 identifier of the first commit in the repository (the only commit with no parents), following
+the first parent of merge commits. This requires reading the whole history from the service.
+
+Errors can be:
+
+  - GitError in case the commits can't be read from the service or the branch has no commits.
+*/
+func (r *remoteRepository) GetRootCommit() (string, error) {
+	var commitSHA string
+	err := r.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		commitSHA = commit.Sha
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+	log.Debugf("repository root commit in '%s' branch is '%s'", r.branch, commitSHA)
+	return commitSHA, nil
+}
+
+/*
+Returns a set of objects representing all the tags for the repository. Tags are read from the service only once
+and their targets are always the SHA-1 identifiers of the tagged commits, even for annotated tags, which are never
+reported as such.
+
+Errors can be:
+
+- GitError in case the tags can't be read from the service.
+*/
+func (r *remoteRepository) GetTags() ([]gitent.Tag, error) {
+	if r.tags == nil {
+		log.Debugf("retrieving all tags")
+		tags, err := r.service.GetTags(nil, nil)
+		if err != nil {
+			return nil, &errs.GitError{Message: fmt.Sprintf("cannot list repository tags"), Cause: err}
+		}
+		r.tags = []gitent.Tag{}
+		for name, target := range tags {
+			r.tags = append(r.tags, gitent.Tag{Name: name, Target: target, Annotated: false})
+		}
+	}
+	return r.tags, nil
+}
+
+/*
+Always returns nil as the history is read from the remote itself and there are no upstream branches to compare with.
+*/
+func (r *remoteRepository) GetTrackingStatus() (*gitent.TrackingStatus, error) {
+	return nil, nil
+}
+
+/*
+Returns true if the given branch is the one the history is read from, as that's the only branch known to this
+repository. The remote argument is ignored as there are no remotes configured locally.
+
+Errors can be:
+
+- GitError in case the branch is not the one the history is read from.
+*/
+func (r *remoteRepository) HasRemoteBranch(remote *string, branch string) (bool, error) {
+	if "" == strings.TrimSpace(branch) || r.branch == branch {
+		return true, nil
+	}
+	return false, &errs.GitError{Message: fmt.Sprintf("only branch '%s' is known to the '%s' Git backend", r.branch, REMOTE_BACKEND)}
+}
+
+/*
+Always returns true as there is no working tree.
+*/
+func (r *remoteRepository) IsBare() (bool, error) {
+	return true, nil
+}
+
+/*
+Always returns true as there is no working tree.
+*/
+func (r *remoteRepository) IsClean() (bool, error) {
+	return true, nil
+}
+
+/*
+Always returns false as the whole history is available from the service.
+*/
+func (r *remoteRepository) IsShallow() (bool, error) {
+	return false, nil
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) Lock(wait time.Duration) (Lock, error) {
+	return nil, r.unsupported("locking")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushWithUserNameAndPassword(user *string, password *string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushWithPublicKey(privateKey *string, passphrase *string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithUserNameAndPasswordAndForce(remote *string, user *string, password *string, force bool) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(remote *string, user *string, password *string, force bool, tagRefSpecs []string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithUserNameAndPasswordAndLease(remote *string, user *string, password *string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithUserNameAndPasswordAndLeaseAndTagRefSpecs(remote *string, user *string, password *string, tagRefSpecs []string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithTokenAndForce(remote *string, token *string, user *string, force bool) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithTokenAndForceAndTagRefSpecs(remote *string, token *string, user *string, force bool, tagRefSpecs []string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithTokenAndLease(remote *string, token *string, user *string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithTokenAndLeaseAndTagRefSpecs(remote *string, token *string, user *string, tagRefSpecs []string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithPublicKey(remote *string, privateKey *string, passphrase *string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithPublicKeyAndForce(remote *string, privateKey *string, passphrase *string, force bool) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithPublicKeyAndHostKeysAndForce(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithPublicKeyAndHostKeysAndForceAndTagRefSpecs(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool, tagRefSpecs []string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithPublicKeyAndHostKeysAndLease(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemoteWithPublicKeyAndHostKeysAndLeaseAndTagRefSpecs(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, tagRefSpecs []string) (string, error) {
+	return "", r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemotesWithUserNameAndPassword(remotes []string, user *string, password *string) ([]string, error) {
+	return nil, r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushToRemotesWithPublicKey(remotes []string, privateKey *string, passphrase *string) ([]string, error) {
+	return nil, r.unsupported("pushing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushTagToRemoteWithUserNameAndPasswordAndForce(remote *string, name string, user *string, password *string, force bool) (string, error) {
+	return "", r.unsupported("pushing tags")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushTagToRemoteWithTokenAndForce(remote *string, name string, token *string, user *string, force bool) (string, error) {
+	return "", r.unsupported("pushing tags")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) PushTagToRemoteWithPublicKeyAndHostKeysAndForce(remote *string, name string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool) (string, error) {
+	return "", r.unsupported("pushing tags")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) RemoveRemote(name string) error {
+	return r.unsupported("configuring remotes")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) SetRemoteURL(name string, url string) error {
+	return r.unsupported("configuring remotes")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) Snapshot() (Snapshot, error) {
+	return nil, r.unsupported("taking snapshots")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) SnapshotWithPaths(paths []string) (Snapshot, error) {
+	return nil, r.unsupported("taking snapshots")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) Stash(excludedPaths []string) (bool, error) {
+	return false, r.unsupported("stashing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) StashPop() error {
+	return r.unsupported("stashing")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) Tag(name *string) (gitent.Tag, error) {
+	return gitent.Tag{}, r.unsupported("tagging")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) TagWithMessage(name *string, message *string) (gitent.Tag, error) {
+	return gitent.Tag{}, r.unsupported("tagging")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) TagWithMessageAndForce(name *string, message *string, force bool) (gitent.Tag, error) {
+	return gitent.Tag{}, r.unsupported("tagging")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) TagWithMessageAndIdentity(name *string, message *string, tagger *gitent.Identity) (gitent.Tag, error) {
+	return gitent.Tag{}, r.unsupported("tagging")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) TagCommitWithMessageAndIdentity(target *string, name *string, message *string, tagger *gitent.Identity) (gitent.Tag, error) {
+	return gitent.Tag{}, r.unsupported("tagging")
+}
+
+/*
+This operation is not supported by this backend.
+*/
+func (r *remoteRepository) TagCommitWithMessageAndIdentityAndForce(target *string, name *string, message *string, tagger *gitent.Identity, force bool) (gitent.Tag, error) {
+	return gitent.Tag{}, r.unsupported("tagging")
+}
+
+/*
+Takes no action as the whole history is available from the service.
+*/
+func (r *remoteRepository) UnshallowFromRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error) {
+	log.Debugf("the history is read from the service APIs and doesn't need to be unshallowed")
+	return "", nil
+}
+
+/*
+Takes no action as the whole history is available from the service.
+*/
+func (r *remoteRepository) UnshallowFromRemoteWithToken(remote *string, token *string, user *string) (string, error) {
+	return r.UnshallowFromRemoteWithUserNameAndPassword(remote, user, token)
+}
+
+/*
+Takes no action as the whole history is available from the service.
+*/
+func (r *remoteRepository) UnshallowFromRemoteWithPublicKeyAndHostKeys(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error) {
+	return r.UnshallowFromRemoteWithUserNameAndPassword(remote, nil, nil)
+}
+
+/*
+Always returns an error as signatures can't be verified by this backend.
+
+Errors can be:
+
+- GitError in any case.
+*/
+func (r *remoteRepository) VerifyCommitSignature(commit string, trustedKeys *string) (gitent.Signature, error) {
+	return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("verifying the signature of commit '%s' is not supported by the '%s' Git backend", commit, REMOTE_BACKEND)}
+}
+
+/*
+Always returns an error as signatures can't be verified by this backend.
+
+Errors can be:
+
+- GitError in any case.
+*/
+func (r *remoteRepository) VerifyTagSignature(tag string, trustedKeys *string) (gitent.Signature, error) {
+	return gitent.Signature{}, &errs.GitError{Message: fmt.Sprintf("verifying the signature of tag '%s' is not supported by the '%s' Git backend", tag, REMOTE_BACKEND)}
+}
+
+/*
+Browses the repository commit history using the given visitor to inspect each commit. Commits are
+evaluated in Git's natural order, from the most recent to oldest, following only the first parent of merge commits,
+and are read from the service one page at a time, so that no more pages than needed are requested.
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the commit to start from. If nil the latest commit in the
+    branch the history is read from is used. This can be a long or abbreviated SHA-1, as long as the service
+    can resolve it. If this commit cannot be resolved a GitError is thrown.
+  - end the optional SHA-1 id of the commit to end with, included. If nil the repository root
+    commit is used (until the given visitor returns false). If this commit is not reachable
+    from the start it will be ignored. This can be a long or abbreviated SHA-1.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function isits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case the commits can't be read from the service or a given commit identifier cannot be resolved.
+*/
+func (r *remoteRepository) WalkHistory(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+	return r.WalkHistoryBetweenDates(start, end, nil, nil, visit)
+}
+
+/*
+Browses the repository commit history using the given visitor to inspect each commit, just like WalkHistory,
+but besides the start and end commits the walk is also bounded by the commit dates, so that only the commits
+made within a time frame are visited. Bounding the walk with the since time stamp spares reading the pages of
+older commits.
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the commit to start from. If nil the latest commit in the
+    branch the history is read from is used. This can be a long or abbreviated SHA-1, as long as the service
+    can resolve it. If this commit cannot be resolved a GitError is thrown.
+  - end the optional SHA-1 id of the commit to end with, included. If nil the repository root
+    commit is used (until the given visitor returns false). If this commit is not reachable
+    from the start it will be ignored. This can be a long or abbreviated SHA-1.
+  - since the optional time stamp (in milliseconds since the epoch) of the oldest commit to visit. The walk
+    stops at the first commit whose committer date is older than this time stamp, which is not visited.
+    If nil commits are visited regardless of how old they are.
+  - until the optional time stamp (in milliseconds since the epoch) of the most recent commit to visit.
+    Commits whose committer date is newer than this time stamp are skipped without being visited, but
+    the walk goes on with their parents. If nil commits are visited regardless of how recent they are.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function isits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case the commits can't be read from the service or a given commit identifier cannot be resolved.
+*/
+func (r *remoteRepository) WalkHistoryBetweenDates(start *string, end *string, since *int64, until *int64, visit func(commit gitent.Commit) bool) error {
+	if visit == nil {
+		return nil
+	}
+	ref := r.branch
+	if start != nil {
+		ref = *start
+	}
+	endString := "not defined"
+	if end != nil {
+		endString = *end
+	}
+	log.Debugf("walking commit history. Start commit boundary is '%s'. End commit boundary is '%s'", ref, endString)
+	log.Debugf("upon merge commits only the first parent is considered.")
+
+	// tags are read once and matched to commits by their targets
+	tags, err := r.GetTags()
+	if err != nil {
+		return err
+	}
+	tagsByTarget := make(map[string][]gitent.Tag)
+	for _, tag := range tags {
+		tagsByTarget[tag.Target] = append(tagsByTarget[tag.Target], tag)
+	}
+
+	// the service lists all the reachable commits so those that are not first parents are skipped
+	next := ""
+	err = r.service.WalkCommits(nil, nil, ref, func(serviceCommit svcapi.Commit) bool {
+		if "" != next && serviceCommit.GetSHA() != next {
+			return true
+		}
+		commit := r.toCommit(serviceCommit, tagsByTarget[serviceCommit.GetSHA()])
+		if since != nil && commit.Date < *since {
+			log.Debugf("commit history walk reached the since boundary at commit '%s'", commit.Sha)
+			return false
+		}
+		if until != nil && commit.Date > *until {
+			log.Tracef("skipping commit '%s' as it's newer than the until boundary", commit.Sha)
+		} else {
+			log.Tracef("visiting commit '%s'", commit.Sha)
+			if !visit(commit) {
+				log.Debugf("commit history walk interrupted by visitor")
+				return false
+			}
+		}
+		if end != nil && strings.HasPrefix(commit.Sha, *end) {
+			log.Debugf("commit history walk reached the end boundary '%s'", *end)
+			return false
+		} else if len(commit.Parents) == 0 {
+			log.Debugf("commit history walk reached the end")
+			return false
+		}
+		next = commit.Parents[0]
+		return true
+	})
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
+	}
+	return nil
+}
+
+/*
+Browse the repository commit history using the given visitor to inspect each commit, just like WalkHistory
+but in chronological order, from the oldest to the most recent, so that the commits since a given commit
+can be inspected in the order they were made. Only the first parent of merge commits is followed.
+
+The commits are all read from the service before the first one is visited so, unlike WalkHistory, stopping
+the visit early doesn't spare reading the pages of older commits.
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the most recent commit, which is visited last. If nil the latest commit in the
+    branch the history is read from is used. This can be a long or abbreviated SHA-1, as long as the service
+    can resolve it. If this commit cannot be resolved a GitError is thrown.
+  - end the optional SHA-1 id of the oldest commit, which is visited first. If nil the repository root
+    commit is used. If this commit is not reachable from the start it will be ignored. This can be a long
+    or abbreviated SHA-1.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function visits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case the commits can't be read from the service or a given commit identifier cannot be resolved.
+*/
+func (r *remoteRepository) WalkHistoryInReverse(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+	if visit == nil {
+		return nil
+	}
+	commits := []gitent.Commit{}
+	err := r.WalkHistory(start, end, func(commit gitent.Commit) bool {
+		commits = append(commits, commit)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	log.Debugf("walking '%d' commits in reverse order", len(commits))
+	for i := len(commits) - 1; i >= 0; i-- {
+		log.Tracef("visiting commit '%s'", commits[i].Sha)
+		if !visit(commits[i]) {
+			log.Debugf("commit history walk interrupted by visitor")
+			break
+		}
+	}
+	return nil
+}
+
+/*
+Browses the repository commit history using the given visitor to inspect each commit, following all the parents
+of merge commits. Commits are evaluated in the order the service lists them, which is from the most recent to oldest,
+and are read from the service one page at a time, so that no more pages than needed are requested.
+
+Arguments are as follows:
+
+  - start the optional SHA-1 id of the commit to start from. If nil the latest commit in the
+    branch the history is read from is used. This can be a long or abbreviated SHA-1, as long as the service
+    can resolve it. If this commit cannot be resolved a GitError is thrown.
+  - end the optional SHA-1 id of the commit to end with, included. If nil the repository root
+    commits are used (until the given visitor returns false). If this commit is not reachable
+    from the start it will be ignored. This can be a long or abbreviated SHA-1.
+  - visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+    The function visits a single commit and receives all of the commit simplified fields. Returns true
+    to keep browsing next commits or false to stop.
+
+Errors can be:
+
+  - GitError in case the commits can't be read from the service or a given commit identifier cannot be resolved.
+*/
+func (r *remoteRepository) WalkHistoryWithAllParents(start *string, end *string, visit func(commit gitent.Commit) bool) error {
+	if visit == nil {
+		return nil
+	}
+	ref := r.branch
+	if start != nil {
+		ref = *start
+	}
+	endString := "not defined"
+	if end != nil {
+		endString = *end
+	}
+	log.Debugf("walking commit history. Start commit boundary is '%s'. End commit boundary is '%s'", ref, endString)
+	log.Debugf("upon merge commits all parents are considered.")
+
+	// tags are read once and matched to commits by their targets
+	tags, err := r.GetTags()
+	if err != nil {
+		return err
+	}
+	tagsByTarget := make(map[string][]gitent.Tag)
+	for _, tag := range tags {
+		tagsByTarget[tag.Target] = append(tagsByTarget[tag.Target], tag)
+	}
+
+	// the service lists all the reachable commits so they're all visited
+	err = r.service.WalkCommits(nil, nil, ref, func(serviceCommit svcapi.Commit) bool {
+		commit := r.toCommit(serviceCommit, tagsByTarget[serviceCommit.GetSHA()])
+		log.Tracef("visiting commit '%s'", commit.Sha)
+		if !visit(commit) {
+			log.Debugf("commit history walk interrupted by visitor")
+			return false
+		} else if end != nil && strings.HasPrefix(commit.Sha, *end) {
+			log.Debugf("commit history walk reached the end boundary '%s'", *end)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return &errs.GitError{Message: fmt.Sprintf("an error occurred while walking through commits"), Cause: err}
+	}
+	return nil
+}
+
+/*
+Returns this repository, as it reads from the APIs of the service and never connects to Git remotes.
+
+Arguments are as follows:
+
+- ctx the context, which is ignored
+*/
+func (r *remoteRepository) WithContext(ctx context.Context) Repository {
+	return r
+}
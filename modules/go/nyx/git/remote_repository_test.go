@@ -0,0 +1,362 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package git
+
+import (
+	"sort"    // https://pkg.go.dev/sort
+	"strings" // https://pkg.go.dev/strings
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	svcapi "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+A commit returned by the fake service.
+*/
+type fakeCommit struct {
+	sha     string
+	parents []string
+	message string
+}
+
+func (c fakeCommit) GetAuthorEmail() string    { return "jdoe@example.com" }
+func (c fakeCommit) GetAuthorName() string     { return "John Doe" }
+func (c fakeCommit) GetAuthorDate() int64      { return 1577836800000 }
+func (c fakeCommit) GetCommitterEmail() string { return "jdoe@example.com" }
+func (c fakeCommit) GetCommitterName() string  { return "John Doe" }
+func (c fakeCommit) GetCommitterDate() int64   { return 1577836800000 }
+func (c fakeCommit) GetMessage() string        { return c.message }
+func (c fakeCommit) GetParents() []string      { return c.parents }
+func (c fakeCommit) GetSHA() string            { return c.sha }
+
+/*
+A fake service with a history where c5 merges c4 into c3, so the first parent history is c5, c3, c2, c1.
+Commits are listed in the order the hosting services use, with all the reachable commits.
+*/
+type fakeCommitHistoryService struct {
+	// The number of commits returned by WalkCommits.
+	listed int
+}
+
+func (s *fakeCommitHistoryService) GetCommitChangedPaths(owner *string, repository *string, commit string) ([]string, error) {
+	return []string{"README.md"}, nil
+}
+
+func (s *fakeCommitHistoryService) GetDefaultBranch(owner *string, repository *string) (string, error) {
+	return "main", nil
+}
+
+func (s *fakeCommitHistoryService) GetTags(owner *string, repository *string) (map[string]string, error) {
+	return map[string]string{"1.0.0": "c3", "0.1.0": "c1"}, nil
+}
+
+func (s *fakeCommitHistoryService) WalkCommits(owner *string, repository *string, ref string, visit func(commit svcapi.Commit) bool) error {
+	commits := []fakeCommit{
+		{sha: "c5", parents: []string{"c3", "c4"}, message: "Merge branch 'feature'"},
+		{sha: "c4", parents: []string{"c2"}, message: "feat: side"},
+		{sha: "c3", parents: []string{"c2"}, message: "fix: third"},
+		{sha: "c2", parents: []string{"c1"}, message: "feat: second\n\nBREAKING CHANGE: removed"},
+		{sha: "c1", parents: []string{}, message: "Initial commit"},
+	}
+	started := "main" == ref
+	for _, commit := range commits {
+		if !started && strings.HasPrefix(commit.sha, ref) {
+			started = true
+		}
+		if started {
+			s.listed++
+			if !visit(commit) {
+				return nil
+			}
+		}
+	}
+	if !started {
+		return &errs.TransportError{Message: "unknown ref"}
+	}
+	return nil
+}
+
+func TestRemoteRepositoryOpenRemote(t *testing.T) {
+	_, err := GitInstance().OpenRemote(nil, nil)
+	assert.Error(t, err)
+
+	repository, err := GitInstance().OpenRemote(&fakeCommitHistoryService{}, nil)
+	assert.NoError(t, err)
+	branch, err := repository.GetCurrentBranch()
+	assert.NoError(t, err)
+	assert.Equal(t, "main", branch)
+
+	repository, err = GitInstance().OpenRemote(&fakeCommitHistoryService{}, utl.PointerToString("release"))
+	assert.NoError(t, err)
+	branch, err = repository.GetCurrentBranch()
+	assert.NoError(t, err)
+	assert.Equal(t, "release", branch)
+}
+
+func TestRemoteRepositoryWalkHistory(t *testing.T) {
+	repository, err := GitInstance().OpenRemote(&fakeCommitHistoryService{}, nil)
+	assert.NoError(t, err)
+
+	shas := []string{}
+	err = repository.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		shas = append(shas, commit.Sha)
+		switch commit.Sha {
+		case "c3":
+			assert.Equal(t, 1, len(commit.Tags))
+			assert.Equal(t, "1.0.0", commit.Tags[0].Name)
+		case "c2":
+			assert.Equal(t, "feat: second", commit.Message.ShortMessage)
+			assert.Equal(t, 1, len(commit.Message.Footers))
+			assert.Equal(t, "John Doe", commit.AuthorAction.Identity.Name)
+			assert.Equal(t, int64(1577836800000), commit.Date)
+		}
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c5", "c3", "c2", "c1"}, shas)
+
+	// with boundaries
+	shas = []string{}
+	err = repository.WalkHistory(utl.PointerToString("c3"), utl.PointerToString("c2"), func(commit gitent.Commit) bool {
+		shas = append(shas, commit.Sha)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c3", "c2"}, shas)
+
+	// stopped by the visitor
+	service := &fakeCommitHistoryService{}
+	repository, _ = GitInstance().OpenRemote(service, nil)
+	err = repository.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		return false
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, service.listed)
+
+	// an unknown start
+	err = repository.WalkHistory(utl.PointerToString("c9"), nil, func(commit gitent.Commit) bool {
+		return true
+	})
+	assert.Error(t, err)
+}
+
+func TestRemoteRepositoryWalkHistoryInReverse(t *testing.T) {
+	repository, err := GitInstance().OpenRemote(&fakeCommitHistoryService{}, nil)
+	assert.NoError(t, err)
+
+	shas := []string{}
+	err = repository.WalkHistoryInReverse(nil, nil, func(commit gitent.Commit) bool {
+		shas = append(shas, commit.Sha)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c1", "c2", "c3", "c5"}, shas)
+
+	// with the end boundary, stopped by the visitor
+	shas = []string{}
+	err = repository.WalkHistoryInReverse(nil, utl.PointerToString("c2"), func(commit gitent.Commit) bool {
+		shas = append(shas, commit.Sha)
+		return len(shas) < 2
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c2", "c3"}, shas)
+
+	// an unknown start
+	err = repository.WalkHistoryInReverse(utl.PointerToString("c9"), nil, func(commit gitent.Commit) bool {
+		return true
+	})
+	assert.Error(t, err)
+}
+
+func TestRemoteRepositoryWalkHistoryWithDateBoundaries(t *testing.T) {
+	service := &fakeCommitHistoryService{}
+	repository, err := GitInstance().OpenRemote(service, nil)
+	assert.NoError(t, err)
+
+	// all the fake commits have the same date
+	date := int64(1577836800000)
+	shas := []string{}
+	err = repository.WalkHistoryBetweenDates(nil, nil, &date, &date, func(commit gitent.Commit) bool {
+		shas = append(shas, commit.Sha)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c5", "c3", "c2", "c1"}, shas)
+
+	// the walk stops at the first commit older than the since boundary, without reading further commits
+	since := date + 1
+	shas = []string{}
+	service.listed = 0
+	err = repository.WalkHistoryBetweenDates(nil, nil, &since, nil, func(commit gitent.Commit) bool {
+		shas = append(shas, commit.Sha)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, shas)
+	assert.Equal(t, 1, service.listed)
+
+	// commits newer than the until boundary are skipped
+	until := date - 1
+	shas = []string{}
+	err = repository.WalkHistoryBetweenDates(nil, nil, nil, &until, func(commit gitent.Commit) bool {
+		shas = append(shas, commit.Sha)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, shas)
+}
+
+func TestRemoteRepositoryWalkHistoryWithAllParents(t *testing.T) {
+	repository, err := GitInstance().OpenRemote(&fakeCommitHistoryService{}, nil)
+	assert.NoError(t, err)
+
+	shas := []string{}
+	err = repository.WalkHistoryWithAllParents(nil, nil, func(commit gitent.Commit) bool {
+		shas = append(shas, commit.Sha)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c5", "c4", "c3", "c2", "c1"}, shas)
+
+	// with boundaries
+	shas = []string{}
+	err = repository.WalkHistoryWithAllParents(utl.PointerToString("c5"), utl.PointerToString("c3"), func(commit gitent.Commit) bool {
+		shas = append(shas, commit.Sha)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c5", "c4", "c3"}, shas)
+
+	// an unknown start
+	err = repository.WalkHistoryWithAllParents(utl.PointerToString("c9"), nil, func(commit gitent.Commit) bool {
+		return true
+	})
+	assert.Error(t, err)
+}
+
+func TestRemoteRepositoryCountCommitsBetween(t *testing.T) {
+	repository, err := GitInstance().OpenRemote(&fakeCommitHistoryService{}, nil)
+	assert.NoError(t, err)
+
+	count, err := repository.CountCommitsBetween(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, count)
+
+	// the commits merged from the side branch are counted too
+	count, err = repository.CountCommitsBetween(utl.PointerToString("c3"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	count, err = repository.CountCommitsBetween(utl.PointerToString("c5"), utl.PointerToString("c3"))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// an unknown commit
+	_, err = repository.CountCommitsBetween(utl.PointerToString("c9"), nil)
+	assert.Error(t, err)
+}
+
+func TestRemoteRepositoryReadOperations(t *testing.T) {
+	repository, err := GitInstance().OpenRemote(&fakeCommitHistoryService{}, nil)
+	assert.NoError(t, err)
+
+	latestCommit, err := repository.GetLatestCommit()
+	assert.NoError(t, err)
+	assert.Equal(t, "c5", latestCommit)
+	rootCommit, err := repository.GetRootCommit()
+	assert.NoError(t, err)
+	assert.Equal(t, "c1", rootCommit)
+	// the fake identifiers don't match any hash algorithm
+	_, err = repository.GetHashAlgorithm()
+	assert.Error(t, err)
+
+	tags, err := repository.GetTags()
+	assert.NoError(t, err)
+	tagNames := []string{}
+	for _, tag := range tags {
+		tagNames = append(tagNames, tag.Name)
+	}
+	sort.Strings(tagNames)
+	assert.Equal(t, []string{"0.1.0", "1.0.0"}, tagNames)
+	commitTags, err := repository.GetCommitTags("c1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(commitTags))
+	assert.Equal(t, "0.1.0", commitTags[0].Name)
+
+	paths, err := repository.GetCommitChangedPaths("c2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"README.md"}, paths)
+	changes, err := repository.GetCommitChanges("c2")
+	assert.NoError(t, err)
+	assert.Equal(t, []gitent.FileChange{*gitent.NewFileChangeWith("README.md", 0, 0)}, changes)
+	patchID, err := repository.GetCommitPatchID("c2")
+	assert.NoError(t, err)
+	assert.Equal(t, "", patchID)
+
+	clean, err := repository.IsClean()
+	assert.NoError(t, err)
+	assert.True(t, clean)
+	shallow, err := repository.IsShallow()
+	assert.NoError(t, err)
+	assert.False(t, shallow)
+	trackingStatus, err := repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Nil(t, trackingStatus)
+	hasRemoteBranch, err := repository.HasRemoteBranch(nil, "")
+	assert.NoError(t, err)
+	assert.True(t, hasRemoteBranch)
+	_, err = repository.HasRemoteBranch(nil, "missing")
+	assert.Error(t, err)
+	_, err = repository.FetchTagsFromRemoteWithUserNameAndPassword(nil, nil, nil)
+	assert.NoError(t, err)
+}
+
+func TestRemoteRepositoryWriteOperations(t *testing.T) {
+	repository, err := GitInstance().OpenRemote(&fakeCommitHistoryService{}, nil)
+	assert.NoError(t, err)
+
+	_, err = repository.CommitWithMessage(utl.PointerToString("message"))
+	assert.Error(t, err)
+	_, err = repository.Tag(utl.PointerToString("1.1.0"))
+	assert.Error(t, err)
+	_, err = repository.PushWithUserNameAndPassword(nil, nil)
+	assert.Error(t, err)
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndLease(nil, nil, nil)
+	assert.Error(t, err)
+	_, err = repository.Snapshot()
+	assert.Error(t, err)
+	_, err = repository.SnapshotWithPaths([]string{"CHANGELOG.md"})
+	assert.Error(t, err)
+	_, err = repository.Stash(nil)
+	assert.Error(t, err)
+	assert.Error(t, repository.StashPop())
+	_, err = repository.Lock(0)
+	assert.Error(t, err)
+	_, err = repository.VerifyCommitSignature("c5", nil)
+	assert.Error(t, err)
+	_, err = repository.VerifyTagSignature("1.0.0", nil)
+	assert.Error(t, err)
+}
@@ -17,6 +17,9 @@
 package git
 
 import (
+	"context" // https://pkg.go.dev/context
+	"time"    // https://pkg.go.dev/time
+
 	ggit "github.com/go-git/go-git/v5" // https://pkg.go.dev/github.com/go-git/go-git/v5
 
 	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
@@ -25,6 +28,12 @@ import (
 const (
 	// The default remote name.
 	DEFAULT_REMOTE_NAME = ggit.DefaultRemoteName
+
+	// The refspec used to push all the tags, when no other tag refspec is given.
+	ALL_TAGS_REFSPEC = "refs/tags/*:refs/tags/*"
+
+	// The depth used to fetch the whole history when unshallowing a repository. This is the same value used by Git.
+	UNSHALLOW_DEPTH = 2147483647
 )
 
 /*
@@ -43,6 +52,22 @@ type Repository interface {
 	*/
 	Add(paths []string) error
 
+	/*
+	   Adds a new remote with the given name and URL to the repository configuration, so that it can be used to fetch
+	   from and push to. The remote fetches all branches, just like remotes added with 'git remote add'.
+
+	   Arguments are as follows:
+
+	   - name the name of the remote to add. Cannot be empty
+	   - url the URL of the remote. Cannot be empty
+
+	   Errors can be:
+
+	   - GitError in case the name or the URL are empty, a remote with the same name already exists or some problem
+	     is encountered with the underlying Git repository.
+	*/
+	AddRemote(name string, url string) error
+
 	/*
 	   Commits changes to the repository. Files to commit must be staged separately using Add.
 
@@ -107,6 +132,256 @@ type Repository interface {
 	*/
 	CommitPathsWithMessageAndIdentities(paths []string, message *string, author *gitent.Identity, committer *gitent.Identity) (gitent.Commit, error)
 
+	/*
+	   Returns the number of commits reachable from the 'to' commit and not reachable from the 'from' commit, following
+	   all the parents of merge commits, just like 'git rev-list --count from..to'. This is useful to tell how many
+	   commits have been made since a given commit, like the one of the latest release.
+
+	   Arguments are as follows:
+
+	   - from the optional SHA-1 id of the commit to count from, excluded along with all of its ancestors. If nil
+	     all the commits reachable from the 'to' commit are counted. This can be a long or abbreviated SHA-1.
+	   - to the optional SHA-1 id of the commit to count to, included. If nil the latest commit in the
+	     current branch (HEAD) is used. This can be a long or abbreviated SHA-1.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, including when
+	     the repository has no commits yet or a given commit identifier cannot be resolved.
+	*/
+	CountCommitsBetween(from *string, to *string) (int, error)
+
+	/*
+	   Deletes the local tag with the given name. Only the tag reference is removed while the tagged commit is left untouched.
+
+	   Arguments are as follows:
+
+	   - name the name of the tag to delete.
+
+	   Errors can be:
+
+	   - GitError in case the tag does not exist or some problem is encountered with the underlying Git repository, preventing to delete the tag.
+	*/
+	DeleteTag(name string) error
+
+	/*
+	   Deletes the tag with the given name from the given remote. Deleting a tag that does not exist on the remote is not an error.
+	   This method allows using user name and password authentication (also used for tokens).
+
+	   Returns the local name of the remote that the tag has been deleted from.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to delete the tag from. If nil or empty the default remote name (origin) is used.
+	   - name the name of the tag to delete.
+	   - user the user name to create when credentials are required. If this and password are both nil
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     this value may be the token or something other than a token, depending on the remote provider.
+	   - password the password to create when credentials are required. If this and user are both nil
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     this value may be the token or something other than a token, depending on the remote provider.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to delete the tag.
+	*/
+	DeleteRemoteTagWithUserNameAndPassword(remote *string, name string, user *string, password *string) (string, error)
+
+	/*
+	   Deletes the tag with the given name from the given remote. Deleting a tag that does not exist on the remote is not an error.
+	   This method uses a single token, passed in the user name or password according to the provider hosting the
+	   remote repository, just like PushToRemoteWithTokenAndForce.
+
+	   Returns the local name of the remote that the tag has been deleted from.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to delete the tag from. If nil or empty the default remote name (origin) is used.
+	   - name the name of the tag to delete.
+	   - token the token to authenticate with
+	   - user an optional user name overriding the one detected from the provider. It may be nil.
+
+	   Errors can be:
+
+	   - NilPointerError if the given token is nil
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to delete the tag.
+	*/
+	DeleteRemoteTagWithToken(remote *string, name string, token *string, user *string) (string, error)
+
+	/*
+	   Deletes the tag with the given name from the given remote. Deleting a tag that does not exist on the remote is not an error.
+	   This method allows using SSH authentication.
+
+	   Returns the local name of the remote that the tag has been deleted from.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to delete the tag from. If nil or empty the default remote name (origin) is used.
+	   - name the name of the tag to delete.
+	   - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+	     (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+	   - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+	     This is required when the private key is password protected as this implementation does not support prompting
+	     the user interactively for entering the password.
+	   - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+	     If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+	   - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+	     in ephemeral environments, like CI containers.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to delete the tag.
+	*/
+	DeleteRemoteTagWithPublicKeyAndHostKeys(remote *string, name string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error)
+
+	/*
+	   Fetches all the tags from the given remote, replacing local tags with the same name.
+	   This method allows using user name and password authentication (also used for tokens).
+
+	   Returns the local name of the remote that tags have been fetched from.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+	   - user the user name to create when credentials are required. If this and password are both nil
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     this value may be the token or something other than a token, depending on the remote provider.
+	   - password the password to create when credentials are required. If this and user are both nil
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     this value may be the token or something other than a token, depending on the remote provider.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+	*/
+	FetchTagsFromRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error)
+
+	/*
+	   Fetches all the tags from the given remote, replacing local tags with the same name.
+	   This method uses a single token, passed in the user name or password according to the provider hosting the
+	   remote repository, just like PushToRemoteWithTokenAndForce.
+
+	   Returns the local name of the remote that tags have been fetched from.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+	   - token the token to authenticate with
+	   - user an optional user name overriding the one detected from the provider. It may be nil.
+
+	   Errors can be:
+
+	   - NilPointerError if the given token is nil
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+	*/
+	FetchTagsFromRemoteWithToken(remote *string, token *string, user *string) (string, error)
+
+	/*
+	   Fetches all the tags from the given remote, replacing local tags with the same name.
+	   This method allows using SSH authentication.
+
+	   Returns the local name of the remote that tags have been fetched from.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+	   - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+	     (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+	   - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+	     This is required when the private key is password protected as this implementation does not support prompting
+	     the user interactively for entering the password.
+	   - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+	     If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+	   - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+	     in ephemeral environments, like CI containers.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+	*/
+	FetchTagsFromRemoteWithPublicKeyAndHostKeys(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error)
+
+	/*
+	   Returns the names of the branches in the repository, mapped to the SHA-1 identifier of the commit they point to.
+	   Local branches are returned with their short name (i.e. 'main'), while remote tracking branches, when requested,
+	   are returned with the remote name as a prefix (i.e. 'origin/main'). Symbolic references like 'origin/HEAD'
+	   are not returned.
+
+	   Arguments are as follows:
+
+	   - includeRemote when true remote tracking branches are returned along with local branches.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	GetBranchNames(includeRemote bool) (map[string]string, error)
+
+	/*
+	   Returns the paths of the files changed by the given commit, compared to its first parent. When the commit
+	   has no parents (it's the root commit) all the files in the commit tree are returned. Paths are relative
+	   to the repository root and use the forward slash as the separator. Renamed files are returned with both
+	   their old and new paths.
+
+	   Arguments are as follows:
+
+	   - commit the SHA-1 identifier of the commit to get the changed paths for. It can be a full or abbreviated SHA-1.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	GetCommitChangedPaths(commit string) ([]string, error)
+
+	/*
+	   Returns the files changed by the given commit, compared to its first parent, along with the number of lines
+	   added and deleted in each file. When the commit has no parents (it's the root commit) all the files in the
+	   commit tree are returned as added. Paths are relative to the repository root and use the forward slash as the
+	   separator. Renames are not detected so renamed files are returned as a deleted file and an added file.
+
+	   Arguments are as follows:
+
+	   - commit the SHA-1 identifier of the commit to get the changes for. It can be a full or abbreviated SHA-1.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	GetCommitChanges(commit string) ([]gitent.FileChange, error)
+
+	/*
+	   Returns the content of the file with the given path as it is in the tree of the given commit, regardless of
+	   the contents of the working tree.
+
+	   Arguments are as follows:
+
+	   - commit the SHA-1 identifier of the commit to read the file from. It can be a full or abbreviated SHA-1.
+	   - path the path of the file, relative to the repository root and using the forward slash as the separator.
+	   - maxSize the maximum size of the file, in bytes. Larger files are not read and an error is returned.
+
+	   Errors can be:
+
+	   - GitError in case the file does not exist in the commit, it's larger than the given size or some problem is
+	     encountered with the underlying Git repository.
+	*/
+	GetCommitFileContent(commit string, path string, maxSize int64) (string, error)
+
+	/*
+	   Returns the patch identifier of the given commit. The patch identifier is computed from the changes the commit
+	   introduces compared to its first parent, ignoring whitespaces and line numbers, so commits introducing the same
+	   changes (i.e. commits cherry-picked from one branch to another) have the same patch identifier.
+	   The returned value is empty when the commit introduces no changes.
+
+	   Arguments are as follows:
+
+	   - commit the SHA-1 identifier of the commit to get the patch identifier for. It can be a full or abbreviated SHA-1.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	GetCommitPatchID(commit string) (string, error)
+
 	/*
 	   Returns a set of objects representing all the tags for the given commit.
 
@@ -120,6 +395,16 @@ type Repository interface {
 	*/
 	GetCommitTags(commit string) ([]gitent.Tag, error)
 
+	/*
+	   Returns the identity configured for the repository (the user.name and user.email Git options), looking up
+	   the repository, the global and the system configuration, or nil if no complete identity is configured.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	GetConfiguredIdentity() (*gitent.Identity, error)
+
 	/*
 	   Returns the name of the current branch or a commit SHA-1 if the repository is in the detached head state.
 
@@ -130,6 +415,32 @@ type Repository interface {
 	*/
 	GetCurrentBranch() (string, error)
 
+	/*
+	   Returns the name of the default branch of the given remote, which is the branch its HEAD points to. The
+	   remote tracking reference (i.e. 'refs/remotes/origin/HEAD') is used when available, otherwise the remote is
+	   queried for the branch its HEAD points to (like 'git ls-remote --symref' does), without fetching anything.
+	   The returned name has no 'refs/heads/' prefix (i.e. 'main').
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote. If nil or empty the default remote name (origin) is used.
+
+	   Errors can be:
+
+	   - GitError in case the remote is not configured, can't be reached or doesn't advertise its HEAD.
+	*/
+	GetDefaultBranch(remote *string) (string, error)
+
+	/*
+	   Returns the hash algorithm (object format) used by the repository to identify objects, so that callers
+	   don't need to make assumptions on the length of identifiers.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	GetHashAlgorithm() (gitent.HashAlgorithm, error)
+
 	/*
 	   Returns the SHA-1 identifier of the last commit in the current branch.
 
@@ -150,6 +461,29 @@ type Repository interface {
 	*/
 	GetRemoteNames() ([]string, error)
 
+	/*
+	   Returns the URI of the remote repository with the given name, which is the first URL configured for the remote.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote. If nil or empty the default remote name (origin) is used.
+
+	   Errors can be:
+
+	   - GitError in case the remote is not configured or has no URL.
+	*/
+	GetRemoteURL(remote *string) (string, error)
+
+	/*
+	   Returns the configured remote repositories, sorted by name, with the URLs to fetch from and push to.
+	   When a remote has no push URL configured (the 'pushurl' option), its push URLs are the same used to fetch.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	GetRemotes() ([]gitent.Remote, error)
+
 	/*
 	   Returns the SHA-1 identifier of the first commit in the repository (the only commit with no parents).
 
@@ -169,9 +503,51 @@ type Repository interface {
 	*/
 	GetTags() ([]gitent.Tag, error)
 
+	/*
+	   Returns how many commits the current branch is ahead of and behind its upstream branch (the one configured
+	   with the 'branch.<name>.remote' and 'branch.<name>.merge' options, like 'git push --set-upstream' does).
+	   The upstream is compared as it's known locally, using remote tracking references, so remotes should be
+	   fetched first for the counts to be up to date. The returned value is nil when the current branch has no
+	   upstream or its upstream has no remote tracking reference.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, including when
+	     the repository has no commits yet or is in the 'detached HEAD' state.
+	*/
+	GetTrackingStatus() (*gitent.TrackingStatus, error)
+
+	/*
+	   Returns true if the given branch exists on the given remote. The remote tracking reference (i.e.
+	   'refs/remotes/origin/main') is used when available, otherwise the remote is queried for its branches
+	   (like 'git ls-remote --heads' does), without fetching anything. This tells branches that have never been
+	   pushed from established ones.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote. If nil or empty the default remote name (origin) is used.
+	   - branch the name of the branch, without the 'refs/heads/' prefix. If empty the current branch is used.
+
+	   Errors can be:
+
+	   - GitError in case the remote is not configured or can't be reached or the current branch can't be resolved.
+	*/
+	HasRemoteBranch(remote *string, branch string) (bool, error)
+
+	/*
+	   Returns true if the repository is bare, which is when it has no working tree (i.e. when it's a mirror).
+	   Bare repositories can be inspected, tagged and pushed but operations requiring a working tree, like staging
+	   and committing, return an error.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	IsBare() (bool, error)
+
 	/*
 	   Returns true if the repository is clean, which is when no differences exist between the working tree, the index,
-	   and the current HEAD.
+	   and the current HEAD. Bare repositories are always clean.
 
 	   Errors can be:
 
@@ -180,6 +556,33 @@ type Repository interface {
 	*/
 	IsClean() (bool, error)
 
+	/*
+	   Returns true if the repository is shallow, which is when its history has been truncated (i.e. by a clone with a
+	   limited depth) and the commits beyond the shallow boundary are not available locally.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	IsShallow() (bool, error)
+
+	/*
+	   Acquires the advisory lock on the repository, which is shared by all of its worktrees, so that concurrent
+	   runs working on the same repository can't interfere with each other. The lock is backed by the
+	   LOCK_FILE_NAME file in the Git directory and is held until it's released.
+
+	   Arguments are as follows:
+
+	   - wait the maximum time to wait for the lock when it's held by someone else. When zero this method fails
+	     straight away when the lock is held by someone else
+
+	   Errors can be:
+
+	   - GitError in case the lock is still held by someone else when the wait time expires or some problem is
+	     encountered with the underlying Git repository.
+	*/
+	Lock(wait time.Duration) (Lock, error)
+
 	/*
 	   Pushes local changes in the current branch to the default remote origin.
 	   This method allows using user name and password authentication (also used for tokens).
@@ -189,10 +592,10 @@ type Repository interface {
 	   Arguments are as follows:
 
 	   - user the user name to create when credentials are required. If this and password are both nil
-	     then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
 	     this value may be the token or something other than a token, depending on the remote provider.
 	   - password the password to create when credentials are required. If this and user are both nil
-	     then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
 	     this value may be the token or something other than a token, depending on the remote provider.
 
 	   Errors can be:
@@ -209,8 +612,8 @@ type Repository interface {
 
 		Arguments are as follows:
 
-		- privateKey the SSH private key. If nil the private key will be searched in its default location
-			(i.e. in the users' $HOME/.ssh directory).
+		- privateKey the SSH private key. If nil the keys held by the running SSH agent
+			(reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
 		- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
 			This is required when the private key is password protected as this implementation does not support prompting
 			the user interactively for entering the password.
@@ -231,10 +634,10 @@ type Repository interface {
 
 	   - remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
 	   - user the user name to create when credentials are required. If this and password are both nil
-	     then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
 	     this value may be the token or something other than a token, depending on the remote provider.
 	   - password the password to create when credentials are required. If this and user are both nil
-	     then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
 	     this value may be the token or something other than a token, depending on the remote provider.
 
 	   Errors can be:
@@ -253,10 +656,10 @@ type Repository interface {
 
 		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
 		- user the user name to create when credentials are required. If this and password are both nil
-			then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+			then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
 			this value may be the token or something other than a token, depending on the remote provider.
 		- password the password to create when credentials are required. If this and user are both nil
-			then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+			then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
 			this value may be the token or something other than a token, depending on the remote provider.
 		- force set it to true if you want the push to be executed using the force option
 
@@ -268,50 +671,357 @@ type Repository interface {
 
 	/*
 		Pushes local changes in the current branch to the default remote origin.
-		This method allows using SSH authentication.
+		Unlike PushToRemoteWithUserNameAndPasswordAndForce, only the tags matching the given refspecs are pushed.
+		This method allows using user name and password authentication (also used for tokens).
 
 		Returns the local name of the remotes that has been pushed.
 
 		Arguments are as follows:
 
 		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
-		- privateKey the SSH private key. If nil the private key will be searched in its default location
-			(i.e. in the users' $HOME/.ssh directory).
-		- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
-			This is required when the private key is password protected as this implementation does not support prompting
-			the user interactively for entering the password.
+		- user the user name to create when credentials are required. If this and password are both nil
+			then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+			this value may be the token or something other than a token, depending on the remote provider.
+		- password the password to create when credentials are required. If this and user are both nil
+			then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+			this value may be the token or something other than a token, depending on the remote provider.
+		- force set it to true if you want the push to be executed using the force option
+		- tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+			If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
 
 		Errors can be:
 
 		- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
 	*/
-	PushToRemoteWithPublicKey(remote *string, privateKey *string, passphrase *string) (string, error)
+	PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(remote *string, user *string, password *string, force bool, tagRefSpecs []string) (string, error)
 
 	/*
-		Pushes local changes in the current branch to the default remote origin.
-		This method allows using SSH authentication.
+		Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+		This method allows using user name and password authentication (also used for tokens).
+
+		The branch is force pushed only if it still points, on the remote, to the commit recorded by the remote tracking
+		branch (i.e. 'origin/main') when it was last fetched, like 'git push --force-with-lease' does. If the remote branch
+		has been updated in the meantime the push is refused instead of overwriting the changes. A branch that has no
+		remote tracking branch is only pushed if it doesn't exist on the remote. Tags are pushed without forcing.
 
 		Returns the local name of the remotes that has been pushed.
 
 		Arguments are as follows:
 
 		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
-		- privateKey the SSH private key. If nil the private key will be searched in its default location
-			(i.e. in the users' $HOME/.ssh directory).
-		- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
-			This is required when the private key is password protected as this implementation does not support prompting
-			the user interactively for entering the password.
-		- force set it to true if you want the push to be executed using the force option
+		- user the user name to create when credentials are required. If this and password are both nil
+			then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+			this value may be the token or something other than a token, depending on the remote provider.
+		- password the password to create when credentials are required. If this and user are both nil
+			then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+			this value may be the token or something other than a token, depending on the remote provider.
 
 		Errors can be:
 
-		- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the
+			remote branch has been updated since it was last fetched.
 	*/
-	PushToRemoteWithPublicKeyAndForce(remote *string, privateKey *string, passphrase *string, force bool) (string, error)
+	PushToRemoteWithUserNameAndPasswordAndLease(remote *string, user *string, password *string) (string, error)
 
 	/*
-	   Pushes local changes in the current branch to the given remotes.
-	   This method allows using user name and password authentication (also used for tokens).
+		Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+		Unlike PushToRemoteWithUserNameAndPasswordAndLease, only the tags matching the given refspecs are pushed.
+		This method allows using user name and password authentication (also used for tokens).
+
+		The branch is force pushed only if it still points, on the remote, to the commit recorded by the remote tracking
+		branch (i.e. 'origin/main') when it was last fetched, like 'git push --force-with-lease' does. If the remote branch
+		has been updated in the meantime the push is refused instead of overwriting the changes. A branch that has no
+		remote tracking branch is only pushed if it doesn't exist on the remote. Tags are pushed without forcing.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- user the user name to create when credentials are required. If this and password are both nil
+			then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+			this value may be the token or something other than a token, depending on the remote provider.
+		- password the password to create when credentials are required. If this and user are both nil
+			then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+			this value may be the token or something other than a token, depending on the remote provider.
+		- tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+			If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+		Errors can be:
+
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the
+			remote branch has been updated since it was last fetched.
+	*/
+	PushToRemoteWithUserNameAndPasswordAndLeaseAndTagRefSpecs(remote *string, user *string, password *string, tagRefSpecs []string) (string, error)
+
+	/*
+		Pushes local changes in the current branch to the given remote.
+		This method uses a single token, passed in the user name or password according to the provider hosting the
+		remote repository: GitHub expects the token as the user name, GitLab as the password along with the 'oauth2'
+		user name and Bitbucket as the password along with the 'x-token-auth' user name.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- token the token to authenticate with
+		- user an optional user name overriding the one detected from the provider. It may be nil.
+		- force set it to true if you want the push to be executed using the force option
+
+		Errors can be:
+
+		- NilPointerError if the given token is nil
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+	*/
+	PushToRemoteWithTokenAndForce(remote *string, token *string, user *string, force bool) (string, error)
+
+	/*
+		Pushes local changes in the current branch to the given remote.
+		Unlike PushToRemoteWithTokenAndForce, only the tags matching the given refspecs are pushed.
+		This method uses a single token, passed in the user name or password according to the provider hosting the
+		remote repository: GitHub expects the token as the user name, GitLab as the password along with the 'oauth2'
+		user name and Bitbucket as the password along with the 'x-token-auth' user name.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- token the token to authenticate with
+		- user an optional user name overriding the one detected from the provider. It may be nil.
+		- force set it to true if you want the push to be executed using the force option
+		- tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+			If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+		Errors can be:
+
+		- NilPointerError if the given token is nil
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+	*/
+	PushToRemoteWithTokenAndForceAndTagRefSpecs(remote *string, token *string, user *string, force bool, tagRefSpecs []string) (string, error)
+
+	/*
+		Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+		This method uses a single token, passed in the user name or password according to the provider hosting the
+		remote repository, just like PushToRemoteWithTokenAndForce.
+
+		The branch is force pushed only if it still points, on the remote, to the commit recorded by the remote tracking
+		branch (i.e. 'origin/main') when it was last fetched, like 'git push --force-with-lease' does. If the remote branch
+		has been updated in the meantime the push is refused instead of overwriting the changes. A branch that has no
+		remote tracking branch is only pushed if it doesn't exist on the remote. Tags are pushed without forcing.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- token the token to authenticate with
+		- user an optional user name overriding the one detected from the provider. It may be nil.
+
+		Errors can be:
+
+		- NilPointerError if the given token is nil
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the
+			remote branch has been updated since it was last fetched.
+	*/
+	PushToRemoteWithTokenAndLease(remote *string, token *string, user *string) (string, error)
+
+	/*
+		Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+		Unlike PushToRemoteWithTokenAndLease, only the tags matching the given refspecs are pushed.
+		This method uses a single token, passed in the user name or password according to the provider hosting the
+		remote repository, just like PushToRemoteWithTokenAndForce.
+
+		The branch is force pushed only if it still points, on the remote, to the commit recorded by the remote tracking
+		branch (i.e. 'origin/main') when it was last fetched, like 'git push --force-with-lease' does. If the remote branch
+		has been updated in the meantime the push is refused instead of overwriting the changes. A branch that has no
+		remote tracking branch is only pushed if it doesn't exist on the remote. Tags are pushed without forcing.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- token the token to authenticate with
+		- user an optional user name overriding the one detected from the provider. It may be nil.
+		- tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+			If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+		Errors can be:
+
+		- NilPointerError if the given token is nil
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the
+			remote branch has been updated since it was last fetched.
+	*/
+	PushToRemoteWithTokenAndLeaseAndTagRefSpecs(remote *string, token *string, user *string, tagRefSpecs []string) (string, error)
+
+	/*
+		Pushes local changes in the current branch to the default remote origin.
+		This method allows using SSH authentication.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- privateKey the SSH private key. If nil the keys held by the running SSH agent
+			(reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+		- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+			This is required when the private key is password protected as this implementation does not support prompting
+			the user interactively for entering the password.
+
+		Errors can be:
+
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+	*/
+	PushToRemoteWithPublicKey(remote *string, privateKey *string, passphrase *string) (string, error)
+
+	/*
+		Pushes local changes in the current branch to the default remote origin.
+		This method allows using SSH authentication.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- privateKey the SSH private key. If nil the keys held by the running SSH agent
+			(reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+		- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+			This is required when the private key is password protected as this implementation does not support prompting
+			the user interactively for entering the password.
+		- force set it to true if you want the push to be executed using the force option
+
+		Errors can be:
+
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+	*/
+	PushToRemoteWithPublicKeyAndForce(remote *string, privateKey *string, passphrase *string, force bool) (string, error)
+
+	/*
+		Pushes local changes in the current branch to the default remote origin.
+		This method allows using SSH authentication.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- privateKey the SSH private key. If nil the keys held by the running SSH agent
+			(reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+		- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+			This is required when the private key is password protected as this implementation does not support prompting
+			the user interactively for entering the password.
+		- knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts
+			file. If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+		- strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+			in ephemeral environments, like CI containers.
+		- force set it to true if you want the push to be executed using the force option
+
+		Errors can be:
+
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+	*/
+	PushToRemoteWithPublicKeyAndHostKeysAndForce(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool) (string, error)
+
+	/*
+		Pushes local changes in the current branch to the default remote origin.
+		Unlike PushToRemoteWithPublicKeyAndHostKeysAndForce, only the tags matching the given refspecs are pushed.
+		This method allows using SSH authentication.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- privateKey the SSH private key. If nil the keys held by the running SSH agent
+			(reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+		- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+			This is required when the private key is password protected as this implementation does not support prompting
+			the user interactively for entering the password.
+		- knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts
+			file. If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+		- strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+			in ephemeral environments, like CI containers.
+		- force set it to true if you want the push to be executed using the force option
+		- tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+			If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+		Errors can be:
+
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push.
+	*/
+	PushToRemoteWithPublicKeyAndHostKeysAndForceAndTagRefSpecs(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool, tagRefSpecs []string) (string, error)
+
+	/*
+		Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+		This method allows using SSH authentication.
+
+		The branch is force pushed only if it still points, on the remote, to the commit recorded by the remote tracking
+		branch (i.e. 'origin/main') when it was last fetched, like 'git push --force-with-lease' does. If the remote branch
+		has been updated in the meantime the push is refused instead of overwriting the changes. A branch that has no
+		remote tracking branch is only pushed if it doesn't exist on the remote. Tags are pushed without forcing.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- privateKey the SSH private key. If nil the keys held by the running SSH agent
+			(reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+		- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+			This is required when the private key is password protected as this implementation does not support prompting
+			the user interactively for entering the password.
+		- knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts
+			file. If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+		- strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+			in ephemeral environments, like CI containers.
+
+		Errors can be:
+
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the
+			remote branch has been updated since it was last fetched.
+	*/
+	PushToRemoteWithPublicKeyAndHostKeysAndLease(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error)
+
+	/*
+		Pushes local changes in the current branch to the given remote, forcing the branch with a lease.
+		Unlike PushToRemoteWithPublicKeyAndHostKeysAndLease, only the tags matching the given refspecs are pushed.
+		This method allows using SSH authentication.
+
+		The branch is force pushed only if it still points, on the remote, to the commit recorded by the remote tracking
+		branch (i.e. 'origin/main') when it was last fetched, like 'git push --force-with-lease' does. If the remote branch
+		has been updated in the meantime the push is refused instead of overwriting the changes. A branch that has no
+		remote tracking branch is only pushed if it doesn't exist on the remote. Tags are pushed without forcing.
+
+		Returns the local name of the remotes that has been pushed.
+
+		Arguments are as follows:
+
+		- remote the name of the remote to push to. If nil or empty the default remote name (origin) is used.
+		- privateKey the SSH private key. If nil the keys held by the running SSH agent
+			(reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+		- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+			This is required when the private key is password protected as this implementation does not support prompting
+			the user interactively for entering the password.
+		- knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts
+			file. If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+		- strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+			in ephemeral environments, like CI containers.
+		- tagRefSpecs the refspecs used to push tags (i.e. 'refs/tags/1.2.3:refs/tags/1.2.3' or 'refs/tags/v*:refs/tags/v*').
+			If nil all tags are pushed ('refs/tags/*:refs/tags/*'), if empty no tag is pushed.
+
+		Errors can be:
+
+		- GitError in case some problem is encountered with the underlying Git repository, preventing to push, or the
+			remote branch has been updated since it was last fetched.
+	*/
+	PushToRemoteWithPublicKeyAndHostKeysAndLeaseAndTagRefSpecs(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, tagRefSpecs []string) (string, error)
+
+	/*
+	   Pushes local changes in the current branch to the given remotes.
+	   This method allows using user name and password authentication (also used for tokens).
 
 	   Returns a collection with the local names of remotes that have been pushed.
 
@@ -319,10 +1029,10 @@ type Repository interface {
 
 	   - remotes remotes the names of remotes to push to. If nil or empty the default remote name (origin) is used.
 	   - user the user name to create when credentials are required. If this and password are both nil
-	     then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
 	     this value may be the token or something other than a token, depending on the remote provider.
 	   - password the password to create when credentials are required. If this and user are both nil
-	     then no credentials is used. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
 	     this value may be the token or something other than a token, depending on the remote provider.
 
 	   Errors can be:
@@ -340,8 +1050,8 @@ type Repository interface {
 		Arguments are as follows:
 
 		- remotes remotes the names of remotes to push to. If nil or empty the default remote name (origin) is used.
-		- privateKey the SSH private key. If nil the private key will be searched in its default location
-			(i.e. in the users' $HOME/.ssh directory).
+		- privateKey the SSH private key. If nil the keys held by the running SSH agent
+			(reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
 		- passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
 			This is required when the private key is password protected as this implementation does not support prompting
 			the user interactively for entering the password.
@@ -352,6 +1062,172 @@ type Repository interface {
 	*/
 	PushToRemotesWithPublicKey(remotes []string, privateKey *string, passphrase *string) ([]string, error)
 
+	/*
+	   Pushes the tag with the given name to the given remote. When force is true the tag is updated on the remote even if it
+	   already exists there pointing to another object, which is how floating tags (i.e. 'v1' or 'latest') are moved across releases.
+	   This method allows using user name and password authentication (also used for tokens).
+
+	   Returns the local name of the remote that the tag has been pushed to.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to push the tag to. If nil or empty the default remote name (origin) is used.
+	   - name the name of the tag to push.
+	   - user the user name to create when credentials are required. If this and password are both nil
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     this value may be the token or something other than a token, depending on the remote provider.
+	   - password the password to create when credentials are required. If this and user are both nil
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     this value may be the token or something other than a token, depending on the remote provider.
+	   - force set it to true if you want the tag to be pushed using the force option
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to push the tag.
+	*/
+	PushTagToRemoteWithUserNameAndPasswordAndForce(remote *string, name string, user *string, password *string, force bool) (string, error)
+
+	/*
+	   Pushes the tag with the given name to the given remote. When force is true the tag is updated on the remote even if it
+	   already exists there pointing to another object.
+	   This method uses a single token, passed in the user name or password according to the provider hosting the
+	   remote repository, just like PushToRemoteWithTokenAndForce.
+
+	   Returns the local name of the remote that the tag has been pushed to.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to push the tag to. If nil or empty the default remote name (origin) is used.
+	   - name the name of the tag to push.
+	   - token the token to authenticate with
+	   - user an optional user name overriding the one detected from the provider. It may be nil.
+	   - force set it to true if you want the tag to be pushed using the force option
+
+	   Errors can be:
+
+	   - NilPointerError if the given token is nil
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to push the tag.
+	*/
+	PushTagToRemoteWithTokenAndForce(remote *string, name string, token *string, user *string, force bool) (string, error)
+
+	/*
+	   Pushes the tag with the given name to the given remote. When force is true the tag is updated on the remote even if it
+	   already exists there pointing to another object.
+	   This method allows using SSH authentication.
+
+	   Returns the local name of the remote that the tag has been pushed to.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to push the tag to. If nil or empty the default remote name (origin) is used.
+	   - name the name of the tag to push.
+	   - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+	     (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+	   - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+	     This is required when the private key is password protected as this implementation does not support prompting
+	     the user interactively for entering the password.
+	   - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+	     If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+	   - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+	     in ephemeral environments, like CI containers.
+	   - force set it to true if you want the tag to be pushed using the force option
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to push the tag.
+	*/
+	PushTagToRemoteWithPublicKeyAndHostKeysAndForce(remote *string, name string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool, force bool) (string, error)
+
+	/*
+	   Removes the remote with the given name from the repository configuration, along with its remote tracking
+	   references.
+
+	   Arguments are as follows:
+
+	   - name the name of the remote to remove
+
+	   Errors can be:
+
+	   - GitError in case the remote is not configured or some problem is encountered with the underlying Git repository.
+	*/
+	RemoveRemote(name string) error
+
+	/*
+	   Sets the URL of the remote with the given name, replacing the first URL configured for the remote. Push URLs
+	   (the 'pushurl' option), when configured, are left untouched.
+
+	   Arguments are as follows:
+
+	   - name the name of the remote to update
+	   - url the new URL of the remote. Cannot be empty
+
+	   Errors can be:
+
+	   - GitError in case the URL is empty, the remote is not configured or some problem is encountered with the
+	     underlying Git repository.
+	*/
+	SetRemoteURL(name string, url string) error
+
+	/*
+	   Takes a snapshot of the current status of the repository, made of the current branch (or HEAD, when detached),
+	   the staging area (index) and the local tags, so that it can be restored later on.
+
+	   The working tree contents are not part of the snapshot as the operations Nyx performs on the repository
+	   (staging, committing and tagging) never change them. Use SnapshotWithPaths to also include the working
+	   tree files changed by other means.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	Snapshot() (Snapshot, error)
+
+	/*
+	   Takes a snapshot of the current status of the repository just like Snapshot, also including the contents
+	   of the given working tree files, so that files changed in the meanwhile are brought back to their previous
+	   contents and files created in the meanwhile are removed.
+
+	   Arguments are as follows:
+
+	   - paths the paths (absolute or relative to the repository directory) of the working tree files to include
+	     in the snapshot. It may be nil or empty
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository or reading the files.
+	*/
+	SnapshotWithPaths(paths []string) (Snapshot, error)
+
+	/*
+	   Stashes the uncommitted changes in the working tree and the staging area (index), including untracked
+	   files, so that the working tree is left clean (apart from the excluded paths) and the changes can be
+	   restored later on by StashPop(). Ignored files are not stashed.
+
+	   Returns true if some changes have been stashed, false if there was nothing to stash, in which case
+	   StashPop() must not be invoked.
+
+	   Arguments are as follows:
+
+	   - excludedPaths the paths (absolute or relative to the repository directory) whose changes are left in
+	     place instead of being stashed. It may be nil or empty
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	Stash(excludedPaths []string) (bool, error)
+
+	/*
+	   Restores the changes most recently stashed by Stash() to the working tree and the staging area (index) and
+	   drops them from the stash.
+
+	   Errors can be:
+
+	   - GitError in case there are no stashed changes, the stashed changes conflict with the ones in the working
+	     tree or some problem is encountered with the underlying Git repository.
+	*/
+	StashPop() error
+
 	/*
 	   Tags the latest commit in the current branch with a tag with the given name. The resulting tag is lightweight.
 	   If the tag already exists it's updated.
@@ -474,6 +1350,112 @@ type Repository interface {
 	*/
 	TagCommitWithMessageAndIdentityAndForce(target *string, name *string, message *string, tagger *gitent.Identity, force bool) (gitent.Tag, error)
 
+	/*
+	   Fetches the missing history from the given remote so that a shallow repository becomes complete.
+	   Tags are also fetched. If the repository is not shallow this method just fetches from the remote.
+	   This method allows using user name and password authentication (also used for tokens).
+
+	   Returns the local name of the remote that the history has been fetched from.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+	   - user the user name to create when credentials are required. If this and password are both nil
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     this value may be the token or something other than a token, depending on the remote provider.
+	   - password the password to create when credentials are required. If this and user are both nil
+	     then credentials are read from the netrc file, if any. When using single token authentication (i.e. OAuth or Personal Access Tokens)
+	     this value may be the token or something other than a token, depending on the remote provider.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+	*/
+	UnshallowFromRemoteWithUserNameAndPassword(remote *string, user *string, password *string) (string, error)
+
+	/*
+	   Fetches the missing history from the given remote so that a shallow repository becomes complete.
+	   Tags are also fetched. If the repository is not shallow this method just fetches from the remote.
+	   This method uses a single token, passed in the user name or password according to the provider hosting the
+	   remote repository, just like PushToRemoteWithTokenAndForce.
+
+	   Returns the local name of the remote that the history has been fetched from.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+	   - token the token to authenticate with
+	   - user an optional user name overriding the one detected from the provider. It may be nil.
+
+	   Errors can be:
+
+	   - NilPointerError if the given token is nil
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+	*/
+	UnshallowFromRemoteWithToken(remote *string, token *string, user *string) (string, error)
+
+	/*
+	   Fetches the missing history from the given remote so that a shallow repository becomes complete.
+	   Tags are also fetched. If the repository is not shallow this method just fetches from the remote.
+	   This method allows using SSH authentication.
+
+	   Returns the local name of the remote that the history has been fetched from.
+
+	   Arguments are as follows:
+
+	   - remote the name of the remote to fetch from. If nil or empty the default remote name (origin) is used.
+	   - privateKey the SSH private key, either as the key content (PEM) or as the path to the key file. If nil the keys held by the running SSH agent
+	     (reachable through the SSH_AUTH_SOCK environment variable) are used, if any.
+	   - passphrase the optional password to use to open the private key, in case it's protected by a passphrase.
+	     This is required when the private key is password protected as this implementation does not support prompting
+	     the user interactively for entering the password.
+	   - knownHosts the known SSH host keys, either as the known_hosts formatted content or as the path to a known_hosts file.
+	     If nil the system known_hosts files are used. Ignored when strictHostKeyChecking is false.
+	   - strictHostKeyChecking when false the keys of SSH hosts are not verified. This is insecure and should only be used
+	     in ephemeral environments, like CI containers.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, preventing to fetch.
+	*/
+	UnshallowFromRemoteWithPublicKeyAndHostKeys(remote *string, privateKey *string, passphrase *string, knownHosts *string, strictHostKeyChecking bool) (string, error)
+
+	/*
+	   Verifies the signature of the given commit and returns the outcome of the verification. A commit that is not
+	   signed is not an error and yields a signature that is neither signed nor valid.
+
+	   Arguments are as follows:
+
+	   - commit the SHA-1 identifier of the commit to verify. It can be a full or abbreviated SHA-1.
+	   - trustedKeys the optional armored PGP public keys to verify the signature with. When nil or empty
+	     the backend may rely on the keys available to the Git installation, if any, or report signed commits
+	     as not valid.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository or the given keys
+	     can't be read.
+	*/
+	VerifyCommitSignature(commit string, trustedKeys *string) (gitent.Signature, error)
+
+	/*
+	   Verifies the signature of the given tag and returns the outcome of the verification. A tag that is not
+	   signed (including lightweight tags) is not an error and yields a signature that is neither signed nor valid.
+
+	   Arguments are as follows:
+
+	   - tag the name of the tag to verify.
+	   - trustedKeys the optional armored PGP public keys to verify the signature with. When nil or empty
+	     the backend may rely on the keys available to the Git installation, if any, or report signed tags
+	     as not valid.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository, the tag doesn't exist
+	     or the given keys can't be read.
+	*/
+	VerifyTagSignature(tag string, trustedKeys *string) (gitent.Signature, error)
+
 	/*
 		Browse the repository commit history using the given visitor to inspect each commit. Commits are
 		evaluated in Git's natural order, from the most recent to oldest.
@@ -495,6 +1477,141 @@ type Repository interface {
 
 		- GitError in case some problem is encountered with the underlying Git repository, including when
 			the repository has no commits yet or a given commit identifier cannot be resolved.
+		- ShallowRepositoryError in case the walk reaches the boundary of a shallow repository before the visitor
+			stops it or the end commit is reached, as the commits beyond the boundary are not available locally.
 	*/
 	WalkHistory(start *string, end *string, visit func(commit gitent.Commit) bool) error
+
+	/*
+		Browse the repository commit history using the given visitor to inspect each commit, just like WalkHistory,
+		but besides the start and end commits the walk is also bounded by the commit dates, so that only the commits
+		made within a time frame are visited.
+
+		Arguments are as follows:
+
+		- start the optional SHA-1 id of the commit to start from. If nil the latest commit in the
+			current branch (HEAD) is used. This can be a long or abbreviated SHA-1. If this commit cannot be
+			resolved within the repository a GitError is thrown.
+		- end the optional SHA-1 id of the commit to end with, included. If nil the repository root
+			commit is used (until the given visitor returns false). If this commit is not reachable
+			from the start it will be ignored. This can be a long or abbreviated SHA-1. If this commit cannot be resolved
+			within the repository a GitError is thrown.
+		- since the optional time stamp (in milliseconds since the epoch) of the oldest commit to visit. The walk
+			stops at the first commit whose committer date is older than this time stamp, which is not visited.
+			If nil commits are visited regardless of how old they are.
+		- until the optional time stamp (in milliseconds since the epoch) of the most recent commit to visit.
+			Commits whose committer date is newer than this time stamp are skipped without being visited, but
+			the walk goes on with their parents. If nil commits are visited regardless of how recent they are.
+		- visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+			The function isits a single commit and receives all of the commit simplified fields. Returns true
+			to keep browsing next commits or false to stop.
+
+		Errors can be:
+
+		- GitError in case some problem is encountered with the underlying Git repository, including when
+			the repository has no commits yet or a given commit identifier cannot be resolved.
+		- ShallowRepositoryError in case the walk reaches the boundary of a shallow repository before the visitor
+			stops it or the end commit is reached, as the commits beyond the boundary are not available locally.
+	*/
+	WalkHistoryBetweenDates(start *string, end *string, since *int64, until *int64, visit func(commit gitent.Commit) bool) error
+
+	/*
+		Browse the repository commit history using the given visitor to inspect each commit, just like WalkHistory
+		but in chronological order, from the oldest to the most recent, so that the commits since a given commit
+		can be inspected in the order they were made. Only the first parent of merge commits is followed.
+
+		The commits are all read before the first one is visited so, unlike WalkHistory, stopping the visit early
+		doesn't spare reading the older commits.
+
+		Arguments are as follows:
+
+		- start the optional SHA-1 id of the most recent commit, which is visited last. If nil the latest commit in the
+			current branch (HEAD) is used. This can be a long or abbreviated SHA-1. If this commit cannot be
+			resolved within the repository a GitError is thrown.
+		- end the optional SHA-1 id of the oldest commit, which is visited first. If nil the repository root
+			commit is used. If this commit is not reachable from the start it will be ignored. This can be a long
+			or abbreviated SHA-1. If this commit cannot be resolved within the repository a GitError is thrown.
+		- visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+			The function visits a single commit and receives all of the commit simplified fields. Returns true
+			to keep browsing next commits or false to stop.
+
+		Errors can be:
+
+		- GitError in case some problem is encountered with the underlying Git repository, including when
+			the repository has no commits yet or a given commit identifier cannot be resolved.
+		- ShallowRepositoryError in case the boundary of a shallow repository is reached before the end commit,
+			as the commits beyond the boundary are not available locally. No commit is visited in this case.
+	*/
+	WalkHistoryInReverse(start *string, end *string, visit func(commit gitent.Commit) bool) error
+
+	/*
+		Browse the repository commit history using the given visitor to inspect each commit, following all the
+		parents of merge commits instead of the first parent only, so that commits merged from other branches are
+		also visited. Commits are evaluated in topological order, so that no commit is visited before all of its
+		children, and the commits of merged branches are visited before the older commits of the branch they're
+		merged into (like 'git log --topo-order').
+
+		Arguments are as follows:
+
+		- start the optional SHA-1 id of the commit to start from. If nil the latest commit in the
+			current branch (HEAD) is used. This can be a long or abbreviated SHA-1. If this commit cannot be
+			resolved within the repository a GitError is thrown.
+		- end the optional SHA-1 id of the commit to end with, included. If nil the repository root
+			commits are used (until the given visitor returns false). The walk doesn't go past this commit, so the commits
+			reachable from its parents are not visited, while the other commits are (like 'git log <start> ^<end>^@').
+			This can be a long or abbreviated SHA-1. If this commit cannot be resolved within the repository a GitError is thrown.
+		- visit the visitor function that will receive commit data to evaluate. If nil this method takes no action.
+			The function visits a single commit and receives all of the commit simplified fields. Returns true
+			to keep browsing next commits or false to stop.
+
+		Errors can be:
+
+		- GitError in case some problem is encountered with the underlying Git repository, including when
+			the repository has no commits yet or a given commit identifier cannot be resolved.
+		- ShallowRepositoryError in case the walk reaches the boundary of a shallow repository before the visitor
+			stops it or the end commit is reached, as the commits beyond the boundary are not available locally.
+	*/
+	WalkHistoryWithAllParents(start *string, end *string, visit func(commit gitent.Commit) bool) error
+
+	/*
+		Returns a copy of this repository whose operations connecting to a remote (fetching, pushing, unshallowing,
+		deleting remote tags and checking remote branches) are bounded by the given context, so that they fail as
+		soon as the context is canceled or its deadline expires. The timeout set for the repository (see
+		Options.Timeout) still applies to each single operation. This repository is left unchanged.
+
+		Repositories reading from the APIs of a hosting service (see Git.OpenRemote) don't connect to Git remotes so
+		they return themselves.
+
+		Arguments are as follows:
+
+		- ctx the context bounding the operations connecting to a remote. When nil operations are only bounded
+			by the timeout.
+	*/
+	WithContext(ctx context.Context) Repository
+}
+
+/*
+The status of a repository taken by Repository.Snapshot(), that can be used to bring the repository back to the
+status it had when the snapshot was taken.
+*/
+type Snapshot interface {
+	/*
+	   Restores the repository to the status it had when the snapshot was taken, which is:
+
+	   - the current branch (or HEAD, when detached) points to the same commit, so commits added in the meanwhile
+	     are discarded
+	   - the staging area (index) has the same contents it had, so paths staged in the meanwhile are unstaged
+	     while paths that were staged are staged again
+	   - local tags point to the same objects, so tags added in the meanwhile are deleted and tags moved in the
+	     meanwhile are moved back
+	   - the working tree files included in the snapshot, if any, have the same contents they had, so files
+	     created in the meanwhile are removed
+
+	   Changes already pushed to remote repositories are not restored.
+
+	   Errors can be:
+
+	   - GitError in case some problem is encountered with the underlying Git repository.
+	*/
+	Restore() error
 }
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strconv" // https://pkg.go.dev/strconv
+	"strings" // https://pkg.go.dev/strings
+	"time"    // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The default delay before the first retry of a failed operation on a remote. The delay doubles at every further retry.
+	DEFAULT_REMOTE_RETRY_DELAY = 2 * time.Second
+)
+
+/*
+Returns the number of times each operation connecting to a remote (cloning, fetching, pushing and listing the remote
+references) is retried when it fails for transient reasons (see isTransientRemoteError).
+
+Arguments are as follows:
+
+  - retries the number of retries. When nil or empty operations are never retried
+
+Errors can be:
+
+  - IllegalArgumentError if the given value is not a valid number of retries
+*/
+func parseRetries(retries *string) (int, error) {
+	if retries == nil || "" == strings.TrimSpace(*retries) {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(strings.TrimSpace(*retries))
+	if err != nil || value < 0 {
+		return 0, &errs.IllegalArgumentError{Message: fmt.Sprintf("the value '%s' is not a valid number of retries", *retries), Cause: err}
+	}
+	if value > 0 {
+		log.Debugf("remote operations failing for transient reasons will be retried up to %d times", value)
+	}
+	return value, nil
+}
+
+/*
+Returns the delay before the first retry of a failed operation connecting to a remote. The delay doubles at every
+further retry.
+
+Arguments are as follows:
+
+  - delay the number of seconds. When nil or empty DEFAULT_REMOTE_RETRY_DELAY is used
+
+Errors can be:
+
+  - IllegalArgumentError if the given delay is not a valid number of seconds
+*/
+func parseRetryDelay(delay *string) (time.Duration, error) {
+	if delay == nil || "" == strings.TrimSpace(*delay) {
+		return DEFAULT_REMOTE_RETRY_DELAY, nil
+	}
+	seconds, err := strconv.ParseInt(strings.TrimSpace(*delay), 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, &errs.IllegalArgumentError{Message: fmt.Sprintf("the delay '%s' is not a valid number of seconds", *delay), Cause: err}
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+/*
+Runs the given operation connecting to a remote, running it again with an exponential backoff when it fails for
+transient reasons (see isTransientRemoteError), up to the number of retries in the options. Permanent errors are
+returned straight away.
+
+Arguments are as follows:
+
+  - operation the description of the operation, used for logging
+  - run the function running the operation. It's invoked once for every attempt
+
+Errors can be:
+
+  - any error returned by the last attempt of the operation
+*/
+func (o repositoryOptions) withRetries(operation string, run func() error) error {
+	parent := o.parentContext()
+	delay := o.retryDelay
+	for attempt := 0; ; attempt++ {
+		err := run()
+		// operations are never retried once the context bounding them is done
+		if err == nil || attempt >= o.retries || !isTransientRemoteError(err) || parent.Err() != nil {
+			return err
+		}
+		log.Warnf("%s failed (attempt %d out of %d), retrying in %s: %v", operation, attempt+1, o.retries+1, delay, err)
+		if delay > 0 {
+			select {
+			case <-parent.Done():
+				return err
+			case <-time.After(delay):
+			}
+			delay = delay * 2
+		}
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+The contents of a file in the working tree, as they were when a snapshot was taken.
+*/
+type worktreeFile struct {
+	// The absolute path of the file.
+	path string
+
+	// True if the file existed when the snapshot was taken, false otherwise.
+	exists bool
+
+	// The file contents, when the file existed.
+	content []byte
+
+	// The file permissions, when the file existed.
+	mode os.FileMode
+}
+
+/*
+Reads the contents of the given files in the working tree so that they can be restored by restoreWorktreeFiles.
+
+Arguments are as follows:
+
+  - directory the repository directory, used to resolve relative paths
+  - paths the paths (absolute or relative to the repository directory) of the files to read. It may be nil or empty
+
+Errors can be:
+
+  - GitError in case some of the files exists but can't be read
+*/
+func snapshotWorktreeFiles(directory string, paths []string) ([]worktreeFile, error) {
+	files := make([]worktreeFile, 0, len(paths))
+	for _, path := range paths {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(directory, path)
+		}
+		file := worktreeFile{path: path}
+		info, err := os.Stat(path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the working tree file '%s'", path), Cause: err}
+			}
+		} else {
+			file.content, err = os.ReadFile(path)
+			if err != nil {
+				return nil, &errs.GitError{Message: fmt.Sprintf("unable to read the working tree file '%s'", path), Cause: err}
+			}
+			file.exists = true
+			file.mode = info.Mode().Perm()
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+/*
+Brings the given files in the working tree back to the contents read by snapshotWorktreeFiles, removing those that
+didn't exist.
+
+Errors can be:
+
+  - GitError in case some of the files can't be written or removed
+*/
+func restoreWorktreeFiles(files []worktreeFile) error {
+	for _, file := range files {
+		if file.exists {
+			log.Debugf("restoring the working tree file '%s'", file.path)
+			err := os.WriteFile(file.path, file.content, file.mode)
+			if err != nil {
+				return &errs.GitError{Message: fmt.Sprintf("unable to restore the working tree file '%s'", file.path), Cause: err}
+			}
+		} else {
+			log.Debugf("removing the working tree file '%s'", file.path)
+			err := os.Remove(file.path)
+			if err != nil && !os.IsNotExist(err) {
+				return &errs.GitError{Message: fmt.Sprintf("unable to remove the working tree file '%s'", file.path), Cause: err}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"fmt"           // https://pkg.go.dev/fmt
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// Submodules are never ignored, so changes to their tracked and untracked files make the repository dirty.
+	IGNORE_SUBMODULES_NONE = "none"
+
+	// Untracked files within submodules are ignored.
+	IGNORE_SUBMODULES_UNTRACKED = "untracked"
+
+	// Changes within submodules are ignored and only the commits they point to are considered.
+	IGNORE_SUBMODULES_DIRTY = "dirty"
+
+	// Submodules are ignored altogether, so they never make the repository dirty and they are never staged.
+	IGNORE_SUBMODULES_ALL = "all"
+)
+
+/*
+Returns the changes to submodules ignored when checking the repository status and staging, like
+'git status --ignore-submodules'.
+
+Arguments are as follows:
+
+  - mode one of IGNORE_SUBMODULES_NONE, IGNORE_SUBMODULES_UNTRACKED, IGNORE_SUBMODULES_DIRTY or
+    IGNORE_SUBMODULES_ALL. When nil or empty an empty string is returned, meaning that the Git default applies
+
+Errors can be:
+
+- IllegalArgumentError if the given mode is unknown
+*/
+func parseIgnoreSubmodules(mode *string) (string, error) {
+	if mode == nil || "" == strings.TrimSpace(*mode) {
+		return "", nil
+	}
+	switch *mode {
+	case IGNORE_SUBMODULES_NONE, IGNORE_SUBMODULES_UNTRACKED, IGNORE_SUBMODULES_DIRTY, IGNORE_SUBMODULES_ALL:
+		log.Debugf("submodules will be ignored using the '%s' mode", *mode)
+		return *mode, nil
+	default:
+		return "", &errs.IllegalArgumentError{Message: fmt.Sprintf("unknown submodules ignore mode '%s'", *mode)}
+	}
+}
+
+/*
+Returns the git arguments to get the short status of the working tree honoring the submodules ignore mode in the
+options.
+*/
+func (o repositoryOptions) cliStatusArguments() []string {
+	if "" == o.ignoreSubmodules {
+		return []string{"status", "--porcelain"}
+	}
+	return []string{"status", "--porcelain", "--ignore-submodules=" + o.ignoreSubmodules}
+}
+
+/*
+Returns true if the submodule at the given path, relative to the root of the working tree, is selected by any of the
+given file patterns, as they are passed to the Add method of repositories.
+*/
+func submoduleMatches(paths []string, path string) bool {
+	for _, pattern := range paths {
+		pattern = strings.TrimSuffix(filepath.ToSlash(pattern), "/")
+		if "." == pattern || "" == pattern || path == pattern || strings.HasPrefix(path, pattern+"/") {
+			return true
+		}
+		if matched, err := filepath.Match(pattern, path); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
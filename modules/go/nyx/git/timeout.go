@@ -0,0 +1,82 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"context" // https://pkg.go.dev/context
+	"fmt"     // https://pkg.go.dev/fmt
+	"strconv" // https://pkg.go.dev/strconv
+	"strings" // https://pkg.go.dev/strings
+	"time"    // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+Returns the maximum time each operation connecting to a remote is allowed to take, so that operations stalling on
+bad networks fail instead of hanging indefinitely.
+
+Arguments are as follows:
+
+  - timeout the number of seconds. When nil, empty or zero remote operations never time out
+
+Errors can be:
+
+  - IllegalArgumentError if the given timeout is not a valid number of seconds
+*/
+func parseTimeout(timeout *string) (time.Duration, error) {
+	if timeout == nil || "" == strings.TrimSpace(*timeout) {
+		return 0, nil
+	}
+	seconds, err := strconv.ParseInt(strings.TrimSpace(*timeout), 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, &errs.IllegalArgumentError{Message: fmt.Sprintf("the timeout '%s' is not a valid number of seconds", *timeout), Cause: err}
+	}
+	res := time.Duration(seconds) * time.Second
+	if res > 0 {
+		log.Debugf("remote operations will time out after '%s'", res.String())
+	}
+	return res, nil
+}
+
+/*
+Returns the context bounding all the operations connecting to a remote, as set by Git.WithContext or
+Repository.WithContext, or the background context when none is set.
+*/
+func (o repositoryOptions) parentContext() context.Context {
+	if o.context == nil {
+		return context.Background()
+	}
+	return o.context
+}
+
+/*
+Returns a new context for an operation connecting to a remote, derived from the parent context (see parentContext),
+which is canceled when the timeout in the options expires or the parent context is done, whichever comes first,
+along with the function releasing its resources, to call as soon as the operation is done. The context also
+brings the proxy and the extra headers used by the HTTP and HTTPS transports (see installHTTPTransport).
+*/
+func (o repositoryOptions) remoteContext() (context.Context, context.CancelFunc) {
+	installHTTPTransport()
+	ctx := context.WithValue(o.parentContext(), httpTransportOptionsKey{}, httpTransportOptions{proxyFunc: o.proxyFunc, headers: o.headers})
+	if o.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, o.timeout)
+}
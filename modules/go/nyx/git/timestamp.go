@@ -0,0 +1,66 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strconv" // https://pkg.go.dev/strconv
+	"strings" // https://pkg.go.dev/strings
+	"time"    // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	clk "github.com/mooltiverse/nyx/modules/go/nyx/clock"
+)
+
+/*
+Returns the fixed time used as the author, committer and tagger date of the commits and tags, so that they are
+reproducible (i.e. using the value of SOURCE_DATE_EPOCH).
+
+Arguments are as follows:
+
+  - timestamp the number of seconds since the epoch (UTC). When nil or empty nil is returned, meaning that the
+    current time is used
+
+Errors can be:
+
+  - IllegalArgumentError if the given timestamp is not a valid number of seconds since the epoch
+*/
+func parseTimestamp(timestamp *string) (*time.Time, error) {
+	if timestamp == nil || "" == strings.TrimSpace(*timestamp) {
+		return nil, nil
+	}
+	seconds, err := strconv.ParseInt(strings.TrimSpace(*timestamp), 10, 64)
+	if err != nil || seconds < 0 {
+		return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("the timestamp '%s' is not a valid number of seconds since the epoch", *timestamp), Cause: err}
+	}
+	t := time.Unix(seconds, 0).UTC()
+	log.Debugf("commits and tags will be dated '%s'", t.Format(time.RFC3339))
+	return &t, nil
+}
+
+/*
+Returns the time to use as the author, committer and tagger date of the commits and tags being created, which is
+the fixed time in the options, if any, or the current time from the clock in use.
+*/
+func (o repositoryOptions) timestamp() time.Time {
+	if o.fixedTimestamp != nil {
+		return *o.fixedTimestamp
+	}
+	return clk.Now()
+}
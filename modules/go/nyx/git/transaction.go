@@ -0,0 +1,246 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+)
+
+/*
+A batch of changes to a repository, made of the paths to stage and commit and the tags to apply, that are all applied
+together by Apply(). Changes are just recorded until then, so nothing is visible in the repository while the batch
+is being prepared.
+
+Apply() either applies all the changes or none of them, as the repository is restored to the status it had before
+when any of them fails, so no commit or tag is left behind by failures. Changes can be pushed to remote repositories
+when Apply() succeeds.
+
+A transaction can only be applied once.
+*/
+type Transaction struct {
+	// The repository to apply the changes to.
+	repository Repository
+
+	// The paths to stage and commit, nil when there is no commit to make.
+	commitPaths []string
+
+	// The commit message.
+	commitMessage *string
+
+	// The optional commit author.
+	commitAuthor *gitent.Identity
+
+	// The optional committer.
+	commitCommitter *gitent.Identity
+
+	// The tags to apply, in the same order they were recorded.
+	tags []transactionTag
+
+	// The flag telling whether the transaction has already been applied.
+	applied bool
+}
+
+/*
+A tag recorded in a transaction.
+*/
+type transactionTag struct {
+	// The tag name.
+	name string
+
+	// The optional tag message. When nil the tag is lightweight.
+	message *string
+
+	// The optional tagger.
+	tagger *gitent.Identity
+
+	// The flag telling whether an existing tag with the same name has to be replaced.
+	force bool
+}
+
+/*
+Returns a new empty transaction for the given repository.
+
+Arguments are as follows:
+
+- repository the repository to apply the changes to
+
+Errors can be:
+
+- NilPointerError if the given repository is nil
+*/
+func NewTransaction(repository Repository) (*Transaction, error) {
+	if repository == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("the Repository object cannot be nil")}
+	}
+	return &Transaction{repository: repository}, nil
+}
+
+/*
+Records the commit of the given paths with the given message and optional identities. The paths are staged and committed
+when the transaction is applied. A transaction can only have one commit, which is always applied before the tags.
+
+Arguments are as follows:
+
+  - paths the file patterns of the contents to stage and commit. Cannot be nil or empty. The path "." represents
+    all the files
+  - message the commit message. Cannot be nil
+  - author the object modelling the author identity. It may be nil, in which case the default is used.
+  - committer the object modelling the committer identity. It may be nil, in which case the default is used.
+
+Errors can be:
+
+- NilPointerError if the given paths or message are nil
+- IllegalArgumentError if the given paths are empty
+- IllegalStateError if the transaction already has a commit or has already been applied
+*/
+func (t *Transaction) Commit(paths []string, message *string, author *gitent.Identity, committer *gitent.Identity) error {
+	if paths == nil || message == nil {
+		return &errs.NilPointerError{Message: fmt.Sprintf("the commit paths and message cannot be nil")}
+	}
+	if len(paths) == 0 {
+		return &errs.IllegalArgumentError{Message: fmt.Sprintf("the commit paths cannot be empty")}
+	}
+	if t.applied {
+		return &errs.IllegalStateError{Message: fmt.Sprintf("the transaction has already been applied")}
+	}
+	if t.commitPaths != nil {
+		return &errs.IllegalStateError{Message: fmt.Sprintf("the transaction already has a commit")}
+	}
+	t.commitPaths = paths
+	t.commitMessage = message
+	t.commitAuthor = author
+	t.commitCommitter = committer
+	return nil
+}
+
+/*
+Records a tag to apply to the latest commit in the current branch, which is the commit recorded in the same transaction,
+if any. Tags are applied in the same order they are recorded.
+
+Arguments are as follows:
+
+  - name the name of the tag. Cannot be nil
+  - message the optional tag message. If nil the new tag will be lightweight, otherwise it will be an
+    annotated tag
+  - tagger the optional identity of the tagger. If nil Git defaults are used. If message is nil this is ignored.
+  - force when true an existing tag with the same name is replaced, otherwise applying the transaction fails
+    if the tag already exists
+
+Errors can be:
+
+- NilPointerError if the given name is nil
+- IllegalStateError if the transaction has already been applied
+*/
+func (t *Transaction) Tag(name *string, message *string, tagger *gitent.Identity, force bool) error {
+	if name == nil {
+		return &errs.NilPointerError{Message: fmt.Sprintf("the tag name cannot be nil")}
+	}
+	if t.applied {
+		return &errs.IllegalStateError{Message: fmt.Sprintf("the transaction has already been applied")}
+	}
+	t.tags = append(t.tags, transactionTag{name: *name, message: message, tagger: tagger, force: force})
+	return nil
+}
+
+/*
+Returns true if the transaction has no changes to apply.
+*/
+func (t *Transaction) IsEmpty() bool {
+	return t.commitPaths == nil && len(t.tags) == 0
+}
+
+/*
+Applies all the recorded changes to the repository, committing first and then tagging. When any of the changes fails
+the repository is restored to the status it had before, so that none of the changes are left in the repository.
+
+Returns the commit that has been made, or nil if the transaction has no commit, and the tags that have been applied.
+
+Errors can be:
+
+- IllegalStateError if the transaction has already been applied
+- GitError in case some problem is encountered with the underlying Git repository, including when any of the changes fails
+*/
+func (t *Transaction) Apply() (*gitent.Commit, []gitent.Tag, error) {
+	if t.applied {
+		return nil, nil, &errs.IllegalStateError{Message: fmt.Sprintf("the transaction has already been applied")}
+	}
+	t.applied = true
+	if t.IsEmpty() {
+		log.Debugf("the transaction has no changes to apply")
+		return nil, []gitent.Tag{}, nil
+	}
+
+	snapshot, err := t.repository.Snapshot()
+	if err != nil {
+		return nil, nil, err
+	}
+	commit, tags, err := t.apply()
+	if err != nil {
+		log.Debugf("restoring the repository to the status it had before applying the transaction due to an error: %v", err)
+		restoreErr := snapshot.Restore()
+		if restoreErr != nil {
+			log.Errorf("unable to restore the repository to the status it had before applying the transaction: %v", restoreErr)
+		}
+		return nil, nil, err
+	}
+	return commit, tags, nil
+}
+
+/*
+Applies all the recorded changes to the repository, stopping at the first failure.
+*/
+func (t *Transaction) apply() (*gitent.Commit, []gitent.Tag, error) {
+	var commit *gitent.Commit
+	if t.commitPaths != nil {
+		c, err := t.repository.CommitPathsWithMessageAndIdentities(t.commitPaths, t.commitMessage, t.commitAuthor, t.commitCommitter)
+		if err != nil {
+			return nil, nil, err
+		}
+		log.Debugf("transaction commit applied at '%s'", c.GetSHA())
+		commit = &c
+	}
+	tags := []gitent.Tag{}
+	if len(t.tags) > 0 {
+		existingTags := map[string]bool{}
+		repositoryTags, err := t.repository.GetTags()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, repositoryTag := range repositoryTags {
+			existingTags[repositoryTag.GetName()] = true
+		}
+		for _, tag := range t.tags {
+			if existingTags[tag.name] && !tag.force {
+				return nil, nil, &errs.GitError{Message: fmt.Sprintf("the repository already has a tag '%s' and the transaction is not allowed to replace it", tag.name)}
+			}
+			name := tag.name
+			applied, err := t.repository.TagCommitWithMessageAndIdentityAndForce(nil, &name, tag.message, tag.tagger, tag.force)
+			if err != nil {
+				return nil, nil, err
+			}
+			log.Debugf("transaction tag '%s' applied to '%s'", applied.GetName(), applied.GetTarget())
+			existingTags[tag.name] = true
+			tags = append(tags, applied)
+		}
+	}
+	return commit, tags, nil
+}
@@ -0,0 +1,251 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package io
+
+import (
+	"archive/tar"   // https://pkg.go.dev/archive/tar
+	"archive/zip"   // https://pkg.go.dev/archive/zip
+	"compress/gzip" // https://pkg.go.dev/compress/gzip
+	"fmt"           // https://pkg.go.dev/fmt
+	"io"            // https://pkg.go.dev/io
+	"io/fs"         // https://pkg.go.dev/io/fs
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The format of gzip compressed tar archives.
+	ARCHIVE_FORMAT_TAR_GZ = "tar.gz"
+
+	// The format of zip archives.
+	ARCHIVE_FORMAT_ZIP = "zip"
+)
+
+/*
+An entry to be stored in an archive.
+*/
+type archiveEntry struct {
+	// The path of the entry within the archive, always using forward slashes as separators.
+	name string
+
+	// The path of the entry on the local file system.
+	path string
+
+	// The file information of the entry.
+	info fs.FileInfo
+}
+
+/*
+Creates an archive with the given format containing the given source file or directory (recursively) and saves it
+to the given destination file.
+
+Archives are reproducible, so that archiving the same contents always yields the same archive: entries are
+sorted by name, they all have the same modification time, permissions are normalized to 0755 for directories
+and executable files and 0644 for all other files and owner informations are not stored.
+
+When the source is a directory its contents are stored at the root of the archive, without the directory itself.
+
+Arguments are as follows:
+
+- source the path of the file or directory to archive
+- destination the path of the archive file to create. If the file already exists it's overwritten
+- format the archive format, one of ARCHIVE_FORMAT_TAR_GZ or ARCHIVE_FORMAT_ZIP
+- modTime the modification time to set on all the archive entries
+
+Errors can be:
+
+- IllegalArgumentError: in case the format is not supported.
+- IOError: in case the source cannot be read or the destination cannot be written.
+*/
+func Archive(source string, destination string, format string, modTime time.Time) error {
+	if ARCHIVE_FORMAT_TAR_GZ != format && ARCHIVE_FORMAT_ZIP != format {
+		return &errs.IllegalArgumentError{Message: fmt.Sprintf("unsupported archive format '%s', supported formats are '%s' and '%s'", format, ARCHIVE_FORMAT_TAR_GZ, ARCHIVE_FORMAT_ZIP)}
+	}
+	entries, err := archiveEntries(source)
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("creating the '%s' archive '%s' with %d entries from '%s'", format, destination, len(entries), source)
+	file, err := os.Create(destination)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to create the archive file '%s'", destination), Cause: err}
+	}
+	defer file.Close()
+
+	if ARCHIVE_FORMAT_TAR_GZ == format {
+		err = writeTarGz(file, entries, modTime)
+	} else {
+		err = writeZip(file, entries, modTime)
+	}
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to write the archive file '%s'", destination), Cause: err}
+	}
+	return nil
+}
+
+/*
+Returns the entries to store in an archive for the given source file or directory, sorted by name.
+*/
+func archiveEntries(source string) ([]archiveEntry, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, &errs.IOError{Message: fmt.Sprintf("unable to access the archive source '%s'", source), Cause: err}
+	}
+	if !info.IsDir() {
+		return []archiveEntry{{name: filepath.Base(source), path: source, info: info}}, nil
+	}
+
+	entries := []archiveEntry{}
+	// WalkDir visits entries in lexical order so there is no need to sort them afterwards
+	err = filepath.WalkDir(source, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == source {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, archiveEntry{name: filepath.ToSlash(name), path: path, info: info})
+		return nil
+	})
+	if err != nil {
+		return nil, &errs.IOError{Message: fmt.Sprintf("unable to read the archive source '%s'", source), Cause: err}
+	}
+	return entries, nil
+}
+
+/*
+Returns the normalized permissions for the given entry.
+*/
+func archiveEntryMode(entry archiveEntry) fs.FileMode {
+	if entry.info.IsDir() || entry.info.Mode().Perm()&0111 != 0 {
+		return 0755
+	}
+	return 0644
+}
+
+/*
+Writes the given entries to the given writer as a gzip compressed tar archive.
+*/
+func writeTarGz(writer io.Writer, entries []archiveEntry, modTime time.Time) error {
+	gzipWriter := gzip.NewWriter(writer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Mode: int64(archiveEntryMode(entry)), ModTime: modTime, Format: tar.FormatPAX}
+		switch {
+		case entry.info.IsDir():
+			header.Typeflag = tar.TypeDir
+			header.Name = entry.name + "/"
+		case entry.info.Mode()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(entry.path)
+			if err != nil {
+				return err
+			}
+			header.Typeflag = tar.TypeSymlink
+			header.Linkname = filepath.ToSlash(target)
+		case entry.info.Mode().IsRegular():
+			header.Typeflag = tar.TypeReg
+			header.Size = entry.info.Size()
+		default:
+			log.Warnf("skipping '%s' from the archive as it's not a regular file, a directory or a symbolic link", entry.path)
+			continue
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if tar.TypeReg == header.Typeflag {
+			if err := copyFile(tarWriter, entry.path); err != nil {
+				return err
+			}
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+/*
+Writes the given entries to the given writer as a zip archive.
+*/
+func writeZip(writer io.Writer, entries []archiveEntry, modTime time.Time) error {
+	zipWriter := zip.NewWriter(writer)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name, Method: zip.Deflate, Modified: modTime}
+		switch {
+		case entry.info.IsDir():
+			header.Name = entry.name + "/"
+			header.Method = zip.Store
+			header.SetMode(fs.ModeDir | archiveEntryMode(entry))
+		case entry.info.Mode()&fs.ModeSymlink != 0:
+			header.SetMode(fs.ModeSymlink | 0777)
+		case entry.info.Mode().IsRegular():
+			header.SetMode(archiveEntryMode(entry))
+		default:
+			log.Warnf("skipping '%s' from the archive as it's not a regular file, a directory or a symbolic link", entry.path)
+			continue
+		}
+		entryWriter, err := zipWriter.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		switch {
+		case entry.info.IsDir():
+		case entry.info.Mode()&fs.ModeSymlink != 0:
+			// zip archives store the symbolic link target as the entry contents
+			target, err := os.Readlink(entry.path)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(entryWriter, strings.NewReader(filepath.ToSlash(target))); err != nil {
+				return err
+			}
+		default:
+			if err := copyFile(entryWriter, entry.path); err != nil {
+				return err
+			}
+		}
+	}
+	return zipWriter.Close()
+}
+
+/*
+Copies the contents of the file with the given path to the given writer.
+*/
+func copyFile(writer io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(writer, file)
+	return err
+}
@@ -0,0 +1,138 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package io
+
+import (
+	"archive/tar"   // https://pkg.go.dev/archive/tar
+	"archive/zip"   // https://pkg.go.dev/archive/zip
+	"compress/gzip" // https://pkg.go.dev/compress/gzip
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"testing"       // https://pkg.go.dev/testing
+	"time"          // https://pkg.go.dev/time
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+/*
+Creates a directory with some files to archive and returns its path.
+*/
+func newArchiveSource(t *testing.T) string {
+	source := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(source, "bin"), 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(source, "README.md"), []byte("readme"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(source, "bin", "tool"), []byte("tool"), 0700))
+	return source
+}
+
+func TestArchiveWithUnsupportedFormat(t *testing.T) {
+	source := newArchiveSource(t)
+	err := Archive(source, filepath.Join(t.TempDir(), "archive.rar"), "rar", time.Unix(0, 0))
+	assert.Error(t, err)
+}
+
+func TestArchiveWithMissingSource(t *testing.T) {
+	err := Archive(filepath.Join(t.TempDir(), "missing"), filepath.Join(t.TempDir(), "archive.zip"), ARCHIVE_FORMAT_ZIP, time.Unix(0, 0))
+	assert.Error(t, err)
+}
+
+func TestArchiveTarGz(t *testing.T) {
+	source := newArchiveSource(t)
+	modTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	destination := filepath.Join(t.TempDir(), "archive.tar.gz")
+	assert.NoError(t, Archive(source, destination, ARCHIVE_FORMAT_TAR_GZ, modTime))
+
+	file, err := os.Open(destination)
+	assert.NoError(t, err)
+	defer file.Close()
+	gzipReader, err := gzip.NewReader(file)
+	assert.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+
+	names := []string{}
+	modes := map[string]int64{}
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, header.Name)
+		modes[header.Name] = header.Mode
+		assert.True(t, modTime.Equal(header.ModTime))
+		assert.Equal(t, 0, header.Uid)
+		assert.Equal(t, "", header.Uname)
+	}
+	assert.Equal(t, []string{"README.md", "bin/", "bin/tool"}, names)
+	assert.Equal(t, int64(0644), modes["README.md"])
+	assert.Equal(t, int64(0755), modes["bin/"])
+	assert.Equal(t, int64(0755), modes["bin/tool"])
+}
+
+func TestArchiveZip(t *testing.T) {
+	source := newArchiveSource(t)
+	modTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	destination := filepath.Join(t.TempDir(), "archive.zip")
+	assert.NoError(t, Archive(source, destination, ARCHIVE_FORMAT_ZIP, modTime))
+
+	zipReader, err := zip.OpenReader(destination)
+	assert.NoError(t, err)
+	defer zipReader.Close()
+
+	names := []string{}
+	for _, entry := range zipReader.File {
+		names = append(names, entry.Name)
+		assert.True(t, modTime.Equal(entry.Modified))
+	}
+	assert.Equal(t, []string{"README.md", "bin/", "bin/tool"}, names)
+	assert.Equal(t, os.FileMode(0644), zipReader.File[0].Mode().Perm())
+	assert.Equal(t, os.FileMode(0755), zipReader.File[2].Mode().Perm())
+}
+
+func TestArchiveIsReproducible(t *testing.T) {
+	for _, format := range []string{ARCHIVE_FORMAT_TAR_GZ, ARCHIVE_FORMAT_ZIP} {
+		t.Run(format, func(t *testing.T) {
+			modTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			destination1 := filepath.Join(t.TempDir(), "archive1."+format)
+			destination2 := filepath.Join(t.TempDir(), "archive2."+format)
+			assert.NoError(t, Archive(newArchiveSource(t), destination1, format, modTime))
+			assert.NoError(t, Archive(newArchiveSource(t), destination2, format, modTime))
+
+			content1, err := os.ReadFile(destination1)
+			assert.NoError(t, err)
+			content2, err := os.ReadFile(destination2)
+			assert.NoError(t, err)
+			assert.Equal(t, content1, content2)
+		})
+	}
+}
+
+func TestArchiveSingleFile(t *testing.T) {
+	source := newArchiveSource(t)
+	destination := filepath.Join(t.TempDir(), "archive.zip")
+	assert.NoError(t, Archive(filepath.Join(source, "README.md"), destination, ARCHIVE_FORMAT_ZIP, time.Unix(0, 0)))
+
+	zipReader, err := zip.OpenReader(destination)
+	assert.NoError(t, err)
+	defer zipReader.Close()
+	assert.Equal(t, 1, len(zipReader.File))
+	assert.Equal(t, "README.md", zipReader.File[0].Name)
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package io
+
+import (
+	"crypto/sha256" // https://pkg.go.dev/crypto/sha256
+	"encoding/hex"  // https://pkg.go.dev/encoding/hex
+	"fmt"           // https://pkg.go.dev/fmt
+	"io"            // https://pkg.go.dev/io
+	"os"            // https://pkg.go.dev/os
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+Returns the SHA-256 digest, hex encoded, of the content read from the given reader until EOF.
+
+Errors can be:
+
+- IOError: in case the content cannot be read.
+*/
+func Digest(reader io.Reader) (string, error) {
+	hash := sha256.New()
+	_, err := io.Copy(hash, reader)
+	if err != nil {
+		return "", &errs.IOError{Message: fmt.Sprintf("unable to read the content to compute the digest of"), Cause: err}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+/*
+Returns the SHA-256 digest, hex encoded, of the file at the given path.
+
+Errors can be:
+
+- IOError: in case the file cannot be read.
+*/
+func FileDigest(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", &errs.IOError{Message: fmt.Sprintf("unable to open file '%s'", path), Cause: err}
+	}
+	defer file.Close()
+	return Digest(file)
+}
@@ -0,0 +1,58 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package io
+
+import (
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
+	"testing"       // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+func TestDigest(t *testing.T) {
+	// the well known SHA-256 digests of an empty content and of 'abc'
+	digest, err := Digest(strings.NewReader(""))
+	assert.NoError(t, err)
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", digest)
+	digest, err = Digest(strings.NewReader("abc"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", digest)
+}
+
+func TestFileDigest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("abc"), 0644))
+
+	digest, err := FileDigest(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", digest)
+}
+
+func TestFileDigestWithMissingFile(t *testing.T) {
+	_, err := FileDigest(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IOError{}, err)
+}
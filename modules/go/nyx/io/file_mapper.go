@@ -147,6 +147,46 @@ func LoadFromURL(path url.URL, target any) error {
 	return nil
 }
 
+/*
+Unmarshals the given content to an object of the given type, using the given path only to detect the format.
+
+Arguments are as follows:
+
+  - content the content to unmarshal.
+  - path the path the content was read from.
+    The path must end with one of the supported extensions: json, yaml, yml (or JSON is used by default).
+  - target the pointer to the object to load the data into. It must be a pointer
+
+Errors can be:
+
+- DataAccessError in case the content can't be unmarshalled
+- IllegalArgumentError if the given target is nil or not a pointer
+*/
+func LoadFromContent(content []byte, path string, target any) error {
+	if target == nil {
+		return &errs.IllegalPropertyError{Message: "target can't be nil"}
+	} else if reflect.ValueOf(target).Kind() != reflect.Ptr {
+		return &errs.IllegalPropertyError{Message: fmt.Sprintf("target must be a pointer, '%v' was passed", reflect.ValueOf(target).Kind())}
+	}
+
+	if isYAML(path) {
+		log.Tracef("unmarshalling object as YAML from content of '%v' to type '%v'", path, reflect.ValueOf(target).Kind())
+
+		err := yaml.Unmarshal(content, target)
+		if err != nil {
+			return &errs.DataAccessError{Message: fmt.Sprintf("unable to unmarshal content of '%s'", path), Cause: err}
+		}
+	} else {
+		log.Tracef("unmarshalling object as JSON from content of '%v' to type '%v'", path, reflect.ValueOf(target).Kind())
+
+		err := json.Unmarshal(content, target)
+		if err != nil {
+			return &errs.DataAccessError{Message: fmt.Sprintf("unable to unmarshal content of '%s'", path), Cause: err}
+		}
+	}
+	return nil
+}
+
 /*
 Marshals the content of the given object to a file represented by the given path.
 
@@ -42,3 +42,21 @@ func TestFileMapperLoadFromURL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, *target.Ip)
 }
+
+func TestFileMapperLoadFromContent(t *testing.T) {
+	target := JSONTestIPOutput{}
+	err := LoadFromContent([]byte(`{"ip": "1.2.3.4"}`), "file.json", &target)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3.4", *target.Ip)
+
+	target = JSONTestIPOutput{}
+	err = LoadFromContent([]byte("ip: \"5.6.7.8\"\n"), "file.yaml", &target)
+	assert.NoError(t, err)
+	assert.Equal(t, "5.6.7.8", *target.Ip)
+
+	err = LoadFromContent([]byte("not: [valid"), "file.yml", &target)
+	assert.Error(t, err)
+
+	err = LoadFromContent([]byte(`{}`), "file.json", target)
+	assert.Error(t, err)
+}
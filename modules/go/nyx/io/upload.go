@@ -0,0 +1,233 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package io
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"io"      // https://pkg.go.dev/io
+	"os"      // https://pkg.go.dev/os
+	"strconv" // https://pkg.go.dev/strconv
+	"strings" // https://pkg.go.dev/strings
+	"time"    // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The default size of the chunks files are read by when uploading (1 MiB).
+	DEFAULT_UPLOAD_CHUNK_SIZE int64 = 1024 * 1024
+
+	// The default number of times a failed upload is retried.
+	DEFAULT_UPLOAD_RETRIES = 3
+
+	// The default delay before the first retry of a failed upload. The delay doubles at every further retry.
+	DEFAULT_UPLOAD_RETRY_DELAY = 2 * time.Second
+)
+
+/*
+The options used to upload files.
+*/
+type UploadOptions struct {
+	// The maximum number of bytes per second sent while uploading. 0 or negative means no limit.
+	BandwidthLimit int64
+
+	// The size of the chunks the file is read by. The bandwidth limit is applied and progress is tracked
+	// at every chunk. 0 or negative means DEFAULT_UPLOAD_CHUNK_SIZE.
+	ChunkSize int64
+
+	// The number of times a failed upload is retried. Negative means no retries.
+	Retries int
+
+	// The delay before the first retry. The delay doubles at every further retry. 0 or negative means no delay.
+	RetryDelay time.Duration
+}
+
+/*
+Returns the default upload options.
+*/
+func NewUploadOptions() UploadOptions {
+	return UploadOptions{BandwidthLimit: 0, ChunkSize: DEFAULT_UPLOAD_CHUNK_SIZE, Retries: DEFAULT_UPLOAD_RETRIES, RetryDelay: DEFAULT_UPLOAD_RETRY_DELAY}
+}
+
+/*
+Returns the upload options read from the given map of options, using the default value for each missing option.
+
+Arguments are as follows:
+
+  - options the map of options to read from. It may be nil
+  - bandwidthLimitOption the name of the option holding the bandwidth limit, in bytes per second
+  - chunkSizeOption the name of the option holding the chunk size, in bytes
+  - retriesOption the name of the option holding the number of retries
+
+Errors can be:
+
+- IllegalArgumentError: in case some option is not a valid integer.
+*/
+func ParseUploadOptions(options map[string]string, bandwidthLimitOption string, chunkSizeOption string, retriesOption string) (UploadOptions, error) {
+	res := NewUploadOptions()
+	if value, ok := options[bandwidthLimitOption]; ok && "" != strings.TrimSpace(value) {
+		bandwidthLimit, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return res, &errs.IllegalArgumentError{Message: fmt.Sprintf("the value '%s' of option '%s' is not a valid number of bytes per second", value, bandwidthLimitOption), Cause: err}
+		}
+		res.BandwidthLimit = bandwidthLimit
+	}
+	if value, ok := options[chunkSizeOption]; ok && "" != strings.TrimSpace(value) {
+		chunkSize, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return res, &errs.IllegalArgumentError{Message: fmt.Sprintf("the value '%s' of option '%s' is not a valid number of bytes", value, chunkSizeOption), Cause: err}
+		}
+		res.ChunkSize = chunkSize
+	}
+	if value, ok := options[retriesOption]; ok && "" != strings.TrimSpace(value) {
+		retries, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return res, &errs.IllegalArgumentError{Message: fmt.Sprintf("the value '%s' of option '%s' is not a valid number of retries", value, retriesOption), Cause: err}
+		}
+		res.Retries = retries
+	}
+	return res, nil
+}
+
+/*
+A reader reading the underlying reader by chunks, sleeping as needed to stay within the bandwidth limit
+and logging the progress.
+*/
+type uploadReader struct {
+	// The underlying reader.
+	reader io.Reader
+
+	// The name of the uploaded file, used for logging.
+	name string
+
+	// The total number of bytes to read.
+	size int64
+
+	// The upload options.
+	options UploadOptions
+
+	// The number of bytes read so far.
+	read int64
+
+	// The time the first chunk was read.
+	start time.Time
+
+	// The progress percentage last logged.
+	logged int64
+}
+
+/*
+Returns a new reader wrapping the given reader with the given options.
+
+Arguments are as follows:
+
+- reader the underlying reader
+- name the name of the uploaded file, used for logging
+- size the total number of bytes to read
+- options the upload options
+*/
+func newUploadReader(reader io.Reader, name string, size int64, options UploadOptions) *uploadReader {
+	return &uploadReader{reader: reader, name: name, size: size, options: options}
+}
+
+/*
+Reads up to one chunk into the given buffer, waiting as needed to stay within the bandwidth limit.
+*/
+func (r *uploadReader) Read(p []byte) (int, error) {
+	if r.start.IsZero() {
+		r.start = time.Now()
+	}
+	chunkSize := r.options.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DEFAULT_UPLOAD_CHUNK_SIZE
+	}
+	if int64(len(p)) > chunkSize {
+		p = p[:chunkSize]
+	}
+	n, err := r.reader.Read(p)
+	r.read = r.read + int64(n)
+
+	if r.options.BandwidthLimit > 0 && n > 0 {
+		// the time it should take to read this many bytes at the maximum rate, minus the time already elapsed
+		expected := time.Duration(float64(r.read) / float64(r.options.BandwidthLimit) * float64(time.Second))
+		if wait := expected - time.Since(r.start); wait > 0 {
+			time.Sleep(wait)
+		}
+	}
+	if r.size > 0 {
+		// log at every 10%
+		progress := r.read * 100 / r.size
+		if progress/10 > r.logged/10 {
+			r.logged = progress
+			log.Infof("uploading '%s': %d%% (%d out of %d bytes)", r.name, progress, r.read, r.size)
+		}
+	}
+	return n, err
+}
+
+/*
+Uploads the file at the given path using the given function, reading the file by chunks within the bandwidth
+limit, logging the progress and retrying with an exponential backoff when the upload fails.
+
+The upload function is invoked once for every attempt with a fresh reader over the whole file, along with
+the file size.
+
+Arguments are as follows:
+
+- path the path of the file to upload
+- options the upload options
+- upload the function performing the actual upload
+
+Errors can be:
+
+- IOError: in case the file cannot be read.
+- any error returned by the upload function during the last attempt.
+*/
+func Upload(path string, options UploadOptions, upload func(reader io.Reader, size int64) error) error {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to read file '%s'", path), Cause: err}
+	}
+	name := fileInfo.Name()
+	retries := options.Retries
+	if retries < 0 {
+		retries = 0
+	}
+	delay := options.RetryDelay
+
+	for attempt := 0; ; attempt++ {
+		file, err := os.Open(path)
+		if err != nil {
+			return &errs.IOError{Message: fmt.Sprintf("unable to open file '%s'", path), Cause: err}
+		}
+		err = upload(newUploadReader(file, name, fileInfo.Size(), options), fileInfo.Size())
+		file.Close()
+		if err == nil {
+			return nil
+		}
+		if attempt >= retries {
+			return err
+		}
+		log.Warnf("uploading '%s' failed (attempt %d out of %d), retrying in %s: %v", name, attempt+1, retries+1, delay, err)
+		if delay > 0 {
+			time.Sleep(delay)
+			delay = delay * 2
+		}
+	}
+}
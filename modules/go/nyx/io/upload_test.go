@@ -0,0 +1,145 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package io
+
+import (
+	"bytes"         // https://pkg.go.dev/bytes
+	"errors"        // https://pkg.go.dev/errors
+	"io"            // https://pkg.go.dev/io
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"testing"       // https://pkg.go.dev/testing
+	"time"          // https://pkg.go.dev/time
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+Creates a file of the given size to upload and returns its path.
+*/
+func newUploadSource(t *testing.T, size int) string {
+	path := filepath.Join(t.TempDir(), "asset.bin")
+	content := make([]byte, size)
+	for i := range content {
+		content[i] = byte(i)
+	}
+	assert.NoError(t, os.WriteFile(path, content, 0644))
+	return path
+}
+
+func TestParseUploadOptions(t *testing.T) {
+	options, err := ParseUploadOptions(nil, "LIMIT", "CHUNK", "RETRIES")
+	assert.NoError(t, err)
+	assert.Equal(t, NewUploadOptions(), options)
+
+	options, err = ParseUploadOptions(map[string]string{"LIMIT": "1024", "CHUNK": "512", "RETRIES": "5"}, "LIMIT", "CHUNK", "RETRIES")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), options.BandwidthLimit)
+	assert.Equal(t, int64(512), options.ChunkSize)
+	assert.Equal(t, 5, options.Retries)
+
+	_, err = ParseUploadOptions(map[string]string{"LIMIT": "fast"}, "LIMIT", "CHUNK", "RETRIES")
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IllegalArgumentError{}, err)
+}
+
+func TestUploadReadsTheWholeFileByChunks(t *testing.T) {
+	path := newUploadSource(t, 10000)
+	expected, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	var uploaded bytes.Buffer
+	var reportedSize int64
+	err = Upload(path, UploadOptions{ChunkSize: 100}, func(reader io.Reader, size int64) error {
+		reportedSize = size
+		buffer := make([]byte, 4096)
+		for {
+			n, err := reader.Read(buffer)
+			// the reader never returns more than a chunk at once
+			assert.LessOrEqual(t, n, 100)
+			uploaded.Write(buffer[:n])
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10000), reportedSize)
+	assert.Equal(t, expected, uploaded.Bytes())
+}
+
+func TestUploadRetriesFailedAttempts(t *testing.T) {
+	path := newUploadSource(t, 1000)
+
+	attempts := 0
+	err := Upload(path, UploadOptions{Retries: 2, RetryDelay: time.Millisecond}, func(reader io.Reader, size int64) error {
+		attempts++
+		content, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		// every attempt reads the file from the beginning
+		assert.Equal(t, 1000, len(content))
+		if attempts < 3 {
+			return errors.New("connection reset")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestUploadReturnsTheLastErrorWhenRetriesAreExhausted(t *testing.T) {
+	path := newUploadSource(t, 1000)
+
+	attempts := 0
+	err := Upload(path, UploadOptions{Retries: 1}, func(reader io.Reader, size int64) error {
+		attempts++
+		return errors.New("connection reset")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "connection reset", err.Error())
+	assert.Equal(t, 2, attempts)
+}
+
+func TestUploadWithMissingFile(t *testing.T) {
+	err := Upload(filepath.Join(t.TempDir(), "missing.bin"), NewUploadOptions(), func(reader io.Reader, size int64) error {
+		return nil
+	})
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IOError{}, err)
+}
+
+func TestUploadWithBandwidthLimit(t *testing.T) {
+	path := newUploadSource(t, 2000)
+
+	start := time.Now()
+	err := Upload(path, UploadOptions{BandwidthLimit: 10000, ChunkSize: 500}, func(reader io.Reader, size int64) error {
+		_, err := io.ReadAll(reader)
+		return err
+	})
+	assert.NoError(t, err)
+	// 2000 bytes at 10000 bytes per second take at least 200 milliseconds
+	assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This package provides the commit lint, checking the commits in the release scope against the configured
+commit message conventions and reporting violations as SARIF reports and GitHub workflow annotations.
+*/
+package lint
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"io"      // https://pkg.go.dev/io
+	"os"      // https://pkg.go.dev/os
+	"sort"    // https://pkg.go.dev/sort
+	"strings" // https://pkg.go.dev/strings
+
+	regexp2 "github.com/dlclark/regexp2" // https://pkg.go.dev/github.com/dlclark/regexp2, we need to use this instead of the standard 'regexp' to have support for lookarounds (look ahead), even if this implementation is a little slower
+	log "github.com/sirupsen/logrus"     // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+)
+
+const (
+	// The identifier of the rule violated by commits whose message doesn't match any commit message convention.
+	COMMIT_MESSAGE_CONVENTION_RULE_ID = "nyx/commit-message-convention"
+
+	// The name of the environment variable set to 'true' when running within GitHub Actions.
+	GITHUB_ACTIONS_ENVVAR_NAME = "GITHUB_ACTIONS"
+)
+
+/*
+A single commit lint violation.
+*/
+type Violation struct {
+	// The identifier of the violated rule.
+	RuleID string
+
+	// The SHA of the offending commit.
+	SHA string
+
+	// The short message of the offending commit.
+	ShortMessage string
+
+	// The human readable description of the violation.
+	Message string
+}
+
+/*
+Checks the commits in the release scope of the given state against the configured commit message conventions
+and returns the violations found, if any. A commit violates the conventions when its message is not matched
+by any of them.
+
+When no commit message convention is configured no violation is reported as there is nothing to check against.
+
+Arguments are as follows:
+
+- state the current state, already populated with the release scope. It can't be nil
+
+Error is:
+- NilPointerError: in case the state is nil.
+- DataAccessError: in case the configuration can't be loaded for some reason.
+- IllegalPropertyError: in case some commit message convention has an illegal expression.
+*/
+func Lint(state *stt.State) ([]Violation, error) {
+	if state == nil {
+		return nil, &errs.NilPointerError{Message: "the state cannot be nil"}
+	}
+	commitMessageConventions, err := state.GetConfiguration().GetCommitMessageConventions()
+	if err != nil {
+		return nil, err
+	}
+	if commitMessageConventions == nil || commitMessageConventions.GetItems() == nil || len(*commitMessageConventions.GetItems()) == 0 {
+		log.Debugf("no commit message convention has been configured, commits are not linted")
+		return []Violation{}, nil
+	}
+	releaseScope, err := state.GetReleaseScope()
+	if err != nil {
+		return nil, err
+	}
+	if releaseScope == nil {
+		return []Violation{}, nil
+	}
+
+	// sort the convention names so the outcome is consistent between runs
+	names := make([]string, 0, len(*commitMessageConventions.GetItems()))
+	expressions := make(map[string]*regexp2.Regexp)
+	for name, convention := range *commitMessageConventions.GetItems() {
+		if convention == nil || convention.GetExpression() == nil {
+			continue
+		}
+		re, err := regexp2.Compile(*convention.GetExpression(), 0)
+		if err != nil {
+			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot compile regular expression '%s' of commit message convention '%s'", *convention.GetExpression(), name), Cause: err}
+		}
+		names = append(names, name)
+		expressions[name] = re
+	}
+	sort.Strings(names)
+
+	violations := make([]Violation, 0)
+	for _, commit := range releaseScope.GetCommits() {
+		if commit == nil {
+			continue
+		}
+		matched := false
+		for _, name := range names {
+			match, err := expressions[name].MatchString(commit.GetMessage().GetFullMessage())
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot evaluate the regular expression of commit message convention '%s' against commit '%s'", name, commit.GetSHA()), Cause: err}
+			}
+			if match {
+				log.Debugf("commit message convention '%s' matches commit '%s'", name, commit.GetSHA())
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			log.Debugf("commit '%s' doesn't match any commit message convention", commit.GetSHA())
+			violations = append(violations, Violation{RuleID: COMMIT_MESSAGE_CONVENTION_RULE_ID, SHA: commit.GetSHA(), ShortMessage: commit.GetMessage().GetShortMessage(), Message: fmt.Sprintf("the message of commit '%s' doesn't match any of the commit message conventions (%s)", commit.GetSHA(), strings.Join(names, ", "))})
+		}
+	}
+	return violations, nil
+}
+
+/*
+Writes the given violations as GitHub workflow commands to the given writer so that they are displayed
+as annotations on the workflow run and the pull request.
+
+Arguments are as follows:
+
+- writer the writer to print the annotations to
+- violations the violations to print
+
+Error is:
+- IOError: in case the annotations can't be written.
+*/
+func WriteAnnotations(writer io.Writer, violations []Violation) error {
+	for _, violation := range violations {
+		_, err := fmt.Fprintf(writer, "::warning title=%s::%s\n", escapeAnnotationProperty(violation.RuleID), escapeAnnotationData(fmt.Sprintf("%s: %s", violation.Message, violation.ShortMessage)))
+		if err != nil {
+			return &errs.IOError{Message: "unable to write the commit lint annotations", Cause: err}
+		}
+	}
+	return nil
+}
+
+/*
+Returns true when running within GitHub Actions, where the annotations are rendered.
+*/
+func IsGitHubActions() bool {
+	return strings.EqualFold(strings.TrimSpace(os.Getenv(GITHUB_ACTIONS_ENVVAR_NAME)), "true")
+}
+
+/*
+Escapes the given value to be used as the data of a workflow command.
+*/
+func escapeAnnotationData(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(value)
+}
+
+/*
+Escapes the given value to be used as a property of a workflow command.
+*/
+func escapeAnnotationProperty(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(value)
+}
@@ -0,0 +1,114 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lint
+
+import (
+	"bytes"         // https://pkg.go.dev/bytes
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"testing"       // https://pkg.go.dev/testing
+	"time"          // https://pkg.go.dev/time
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+Returns a new commit with the given SHA-1 and message.
+*/
+func newCommit(sha string, message string) *gitent.Commit {
+	return gitent.NewCommitWith(sha, 0, []string{}, *gitent.NewActionWith(*gitent.NewIdentityWith("Jim", ""), *gitent.NewTimeStampFrom(time.Now())), *gitent.NewActionWith(*gitent.NewIdentityWith("Sam", ""), *gitent.NewTimeStampFrom(time.Now())), *gitent.NewMessageWith(message, message, map[string]string{}), []gitent.Tag{})
+}
+
+func TestLint(t *testing.T) {
+	_, err := Lint(nil)
+	assert.Error(t, err)
+
+	// without conventions nothing is checked
+	configuration, _ := cnf.NewConfiguration()
+	state, err := stt.NewStateWith(configuration)
+	assert.NoError(t, err)
+	releaseScope, _ := state.GetReleaseScope()
+	releaseScope.SetCommits([]*gitent.Commit{newCommit("d40fcded9e516158a2901f5657794931528af106", "untyped message")})
+	violations, err := Lint(state)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+	commitMessageConventions, err := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("conventionalCommits")}, &map[string]*ent.CommitMessageConvention{"conventionalCommits": ent.NewCommitMessageConventionWith(utl.PointerToString("(?m)^(?<type>[a-zA-Z0-9_]+)(!)?(\\((?<scope>[a-z ]+)\\))?:( (?<title>.+))$(?s).*"), &map[string]string{})})
+	assert.NoError(t, err)
+	configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+	configuration, _ = cnf.NewConfiguration()
+	var configurationLayer cnf.ConfigurationLayer
+	configurationLayer = configurationLayerMock
+	configuration.WithRuntimeConfiguration(&configurationLayer)
+	state, err = stt.NewStateWith(configuration)
+	assert.NoError(t, err)
+	releaseScope, _ = state.GetReleaseScope()
+	releaseScope.SetCommits([]*gitent.Commit{
+		newCommit("d40fcded9e516158a2901f5657794931528af106", "feat: a feature"),
+		newCommit("9bed70fac8a27a4b14b6b12307d034bc59da85c3", "untyped message"),
+	})
+	violations, err = Lint(state)
+	assert.NoError(t, err)
+	assert.Len(t, violations, 1)
+	assert.Equal(t, COMMIT_MESSAGE_CONVENTION_RULE_ID, violations[0].RuleID)
+	assert.Equal(t, "9bed70fac8a27a4b14b6b12307d034bc59da85c3", violations[0].SHA)
+	assert.Equal(t, "untyped message", violations[0].ShortMessage)
+}
+
+func TestWriteAnnotations(t *testing.T) {
+	var buffer bytes.Buffer
+	err := WriteAnnotations(&buffer, []Violation{{RuleID: COMMIT_MESSAGE_CONVENTION_RULE_ID, SHA: "9bed70f", ShortMessage: "100% wrong\nmessage", Message: "no convention matches"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "::warning title=nyx/commit-message-convention::no convention matches: 100%25 wrong%0Amessage\n", buffer.String())
+}
+
+func TestSaveSARIFReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reports", "commit-lint.sarif")
+	err := SaveSARIFReport(path, []Violation{{RuleID: COMMIT_MESSAGE_CONVENTION_RULE_ID, SHA: "9bed70fac8a27a4b14b6b12307d034bc59da85c3", ShortMessage: "untyped message", Message: "no convention matches"}})
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	var report SARIFReport
+	assert.NoError(t, json.Unmarshal(content, &report))
+	assert.Equal(t, SARIF_VERSION, report.Version)
+	assert.Len(t, report.Runs, 1)
+	assert.Equal(t, SARIF_TOOL_NAME, report.Runs[0].Tool.Driver.Name)
+	assert.Len(t, report.Runs[0].Results, 1)
+	assert.Equal(t, COMMIT_MESSAGE_CONVENTION_RULE_ID, report.Runs[0].Results[0].RuleID)
+	assert.Equal(t, "9bed70fac8a27a4b14b6b12307d034bc59da85c3", report.Runs[0].Results[0].Locations[0].LogicalLocations[0].Name)
+	assert.Equal(t, "commit", report.Runs[0].Results[0].Locations[0].LogicalLocations[0].Kind)
+
+	// an empty report still has the results array
+	assert.NoError(t, SaveSARIFReport(path, []Violation{}))
+	content, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `"results": []`)
+}
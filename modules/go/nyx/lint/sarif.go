@@ -0,0 +1,156 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lint
+
+import (
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The URI of the SARIF schema the reports comply with.
+	SARIF_SCHEMA = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	// The version of SARIF the reports comply with.
+	SARIF_VERSION = "2.1.0"
+
+	// The name of the tool reported in SARIF reports.
+	SARIF_TOOL_NAME = "Nyx"
+
+	// The URI of the tool documentation reported in SARIF reports.
+	SARIF_TOOL_INFORMATION_URI = "https://mooltiverse.github.io/nyx/"
+)
+
+// The SARIF report root object.
+type SARIFReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+// A SARIF run, grouping the results of a single tool invocation.
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+// The SARIF tool description.
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+// The SARIF tool driver, with the rules it checks.
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+// A SARIF rule.
+type SARIFRule struct {
+	ID               string       `json:"id"`
+	ShortDescription SARIFMessage `json:"shortDescription"`
+}
+
+// A SARIF result, one for each violation.
+type SARIFResult struct {
+	RuleID              string            `json:"ruleId"`
+	Level               string            `json:"level"`
+	Message             SARIFMessage      `json:"message"`
+	Locations           []SARIFLocation   `json:"locations"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+}
+
+// A SARIF message.
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+// A SARIF location. Commits have no physical location so they are reported as logical locations.
+type SARIFLocation struct {
+	LogicalLocations []SARIFLogicalLocation `json:"logicalLocations"`
+}
+
+// A SARIF logical location.
+type SARIFLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+/*
+Returns a new SARIF report with the given violations.
+*/
+func NewSARIFReport(violations []Violation) SARIFReport {
+	results := make([]SARIFResult, 0, len(violations))
+	for _, violation := range violations {
+		results = append(results, SARIFResult{
+			RuleID:  violation.RuleID,
+			Level:   "warning",
+			Message: SARIFMessage{Text: violation.Message + ": " + violation.ShortMessage},
+			Locations: []SARIFLocation{{LogicalLocations: []SARIFLogicalLocation{{
+				Name:               violation.SHA,
+				FullyQualifiedName: "commit/" + violation.SHA,
+				Kind:               "commit",
+			}}}},
+			PartialFingerprints: map[string]string{"commitSha": violation.SHA},
+		})
+	}
+	return SARIFReport{
+		Schema:  SARIF_SCHEMA,
+		Version: SARIF_VERSION,
+		Runs: []SARIFRun{{
+			Tool: SARIFTool{Driver: SARIFDriver{
+				Name:           SARIF_TOOL_NAME,
+				InformationURI: SARIF_TOOL_INFORMATION_URI,
+				Rules:          []SARIFRule{{ID: COMMIT_MESSAGE_CONVENTION_RULE_ID, ShortDescription: SARIFMessage{Text: "Commit messages must match one of the configured commit message conventions"}}},
+			}},
+			Results: results,
+		}},
+	}
+}
+
+/*
+Saves the given violations to the given file as a SARIF report, creating the parent directories if needed.
+
+Arguments are as follows:
+
+- path the path to the file to write
+- violations the violations to report
+
+Error is:
+- IOError: in case the file can't be written.
+*/
+func SaveSARIFReport(path string, violations []Violation) error {
+	content, err := json.MarshalIndent(NewSARIFReport(violations), "", "  ")
+	if err != nil {
+		return &errs.IOError{Message: "unable to marshal the commit lint SARIF report", Cause: err}
+	}
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to create the parent directory of the commit lint report '%s'", path), Cause: err}
+	}
+	err = os.WriteFile(path, content, 0644)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to write the commit lint report to '%s'", path), Cause: err}
+	}
+	return nil
+}
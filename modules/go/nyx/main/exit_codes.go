@@ -0,0 +1,149 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors" // https://golang.org/pkg/errors
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	. "github.com/mooltiverse/nyx/modules/go/nyx"
+	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
+)
+
+const (
+	// The exit code returned when the command completes successfully.
+	EXIT_CODE_SUCCESS = 0
+
+	// The exit code returned when the command fails for a reason not covered by the other exit codes.
+	EXIT_CODE_ERROR = 1
+
+	// The exit code returned when the configuration has illegal options or values.
+	EXIT_CODE_CONFIGURATION_ERROR = 2
+
+	// The exit code returned when strict exit codes are enabled, nothing has been released and the worktree has uncommitted changes.
+	EXIT_CODE_DIRTY_WORKTREE = 3
+
+	// The exit code returned when strict exit codes are enabled and nothing has been released or when the configuration requires
+	// to fail when there is nothing to release.
+	EXIT_CODE_NOTHING_TO_RELEASE = 4
+
+	// The exit code returned when a remote repository or service rejects the credentials.
+	EXIT_CODE_AUTHENTICATION_FAILURE = 5
+
+	// The exit code returned when a remote repository rejects an update as it conflicts with its contents.
+	EXIT_CODE_REMOTE_CONFLICT = 6
+
+	// The exit code returned when a release has only been published to some of the configured services.
+	EXIT_CODE_PARTIAL_PUBLISH = 7
+)
+
+/*
+Returns the exit code for the given error, depending on its category. The error and the chain of errors it wraps
+are inspected from the outermost to the innermost and the first one belonging to a known category determines the
+exit code. When none is found EXIT_CODE_ERROR is returned.
+
+Arguments are as follows:
+
+- err the error to return the exit code for. If nil EXIT_CODE_SUCCESS is returned.
+*/
+func exitCodeOf(err error) int {
+	if err == nil {
+		return EXIT_CODE_SUCCESS
+	}
+	for e := err; e != nil; {
+		switch e.(type) {
+		case *errs.PartialPublishError, errs.PartialPublishError:
+			return EXIT_CODE_PARTIAL_PUBLISH
+		case *errs.NothingToReleaseError, errs.NothingToReleaseError:
+			return EXIT_CODE_NOTHING_TO_RELEASE
+		case *errs.ConflictError, errs.ConflictError:
+			return EXIT_CODE_REMOTE_CONFLICT
+		case *errs.SecurityError, errs.SecurityError:
+			return EXIT_CODE_AUTHENTICATION_FAILURE
+		case *errs.IllegalPropertyError, errs.IllegalPropertyError, *errs.IllegalArgumentError, errs.IllegalArgumentError, *errs.PatternSyntaxError, errs.PatternSyntaxError:
+			return EXIT_CODE_CONFIGURATION_ERROR
+		}
+		// Nyx errors expose their causes with GetCause() while others may be wrapped the standard way
+		if wrapper, ok := e.(interface{ GetCause() error }); ok {
+			e = wrapper.GetCause()
+		} else {
+			e = errors.Unwrap(e)
+		}
+	}
+	return EXIT_CODE_ERROR
+}
+
+/*
+Returns the exit code for the outcome of the given command that completed successfully. Unless strict exit codes are
+enabled by the configuration, or the command is not meant to release, this is always EXIT_CODE_SUCCESS. Otherwise,
+when no new release has been issued, EXIT_CODE_DIRTY_WORKTREE or EXIT_CODE_NOTHING_TO_RELEASE are returned,
+depending on whether the worktree has uncommitted changes or not.
+
+Arguments are as follows:
+
+- nyx the Nyx instance that ran the command
+- command the command that has been run
+
+Error is:
+
+- DataAccessError in case the configuration or the state can't be read for some reason.
+- IllegalPropertyError in case the configuration has some illegal options.
+- GitError in case of unexpected issues when accessing the Git repository.
+*/
+func outcomeExitCodeOf(nyx *Nyx, command cmd.Commands) (int, error) {
+	if command != cmd.INFER && command != cmd.MAKE && command != cmd.MARK && command != cmd.PUBLISH {
+		return EXIT_CODE_SUCCESS, nil
+	}
+	configuration, err := nyx.Configuration()
+	if err != nil {
+		return EXIT_CODE_ERROR, err
+	}
+	strictExitCodes, err := configuration.GetStrictExitCodes()
+	if err != nil {
+		return EXIT_CODE_ERROR, err
+	}
+	if strictExitCodes == nil || !*strictExitCodes {
+		return EXIT_CODE_SUCCESS, nil
+	}
+	state, err := nyx.State()
+	if err != nil {
+		return EXIT_CODE_ERROR, err
+	}
+	newRelease, err := state.GetNewRelease()
+	if err != nil {
+		return EXIT_CODE_ERROR, err
+	}
+	if newRelease {
+		return EXIT_CODE_SUCCESS, nil
+	}
+	repository, err := nyx.Repository()
+	if err != nil {
+		return EXIT_CODE_ERROR, err
+	}
+	clean, err := (*repository).IsClean()
+	if err != nil {
+		return EXIT_CODE_ERROR, err
+	}
+	if clean {
+		log.Debugf("nothing has been released, exiting with status %d", EXIT_CODE_NOTHING_TO_RELEASE)
+		return EXIT_CODE_NOTHING_TO_RELEASE, nil
+	}
+	log.Debugf("nothing has been released and the worktree is dirty, exiting with status %d", EXIT_CODE_DIRTY_WORKTREE)
+	return EXIT_CODE_DIRTY_WORKTREE, nil
+}
@@ -0,0 +1,51 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+func TestMainExitCodeOf(t *testing.T) {
+	assert.Equal(t, EXIT_CODE_SUCCESS, exitCodeOf(nil))
+	assert.Equal(t, EXIT_CODE_ERROR, exitCodeOf(fmt.Errorf("generic failure")))
+	assert.Equal(t, EXIT_CODE_ERROR, exitCodeOf(&errs.GitError{Message: "generic failure"}))
+	assert.Equal(t, EXIT_CODE_CONFIGURATION_ERROR, exitCodeOf(&errs.IllegalPropertyError{Message: "illegal option"}))
+	assert.Equal(t, EXIT_CODE_CONFIGURATION_ERROR, exitCodeOf(&errs.PatternSyntaxError{Message: "illegal pattern"}))
+	assert.Equal(t, EXIT_CODE_AUTHENTICATION_FAILURE, exitCodeOf(&errs.SecurityError{Message: "authentication failed"}))
+	assert.Equal(t, EXIT_CODE_REMOTE_CONFLICT, exitCodeOf(&errs.ConflictError{Message: "non-fast-forward"}))
+	assert.Equal(t, EXIT_CODE_PARTIAL_PUBLISH, exitCodeOf(&errs.PartialPublishError{Message: "partially published"}))
+	assert.Equal(t, EXIT_CODE_NOTHING_TO_RELEASE, exitCodeOf(&errs.NothingToReleaseError{Message: "nothing to release"}))
+
+	// categories are also detected when wrapped by other errors
+	assert.Equal(t, EXIT_CODE_CONFIGURATION_ERROR, exitCodeOf(&errs.DataAccessError{Message: "unable to read", Cause: &errs.IllegalPropertyError{Message: "illegal option"}}))
+	assert.Equal(t, EXIT_CODE_AUTHENTICATION_FAILURE, exitCodeOf(&errs.GitError{Message: "unable to push", Cause: &errs.SecurityError{Message: "authentication failed"}}))
+	assert.Equal(t, EXIT_CODE_REMOTE_CONFLICT, exitCodeOf(fmt.Errorf("unable to push: %w", &errs.GitError{Message: "unable to push", Cause: &errs.ConflictError{Message: "non-fast-forward"}})))
+
+	// the outermost category wins
+	assert.Equal(t, EXIT_CODE_PARTIAL_PUBLISH, exitCodeOf(&errs.PartialPublishError{Message: "partially published", Cause: &errs.SecurityError{Message: "authentication failed"}}))
+}
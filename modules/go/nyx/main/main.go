@@ -33,11 +33,15 @@ import (
 	. "github.com/mooltiverse/nyx/modules/go/nyx"
 	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
 	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
+	srv "github.com/mooltiverse/nyx/modules/go/nyx/server"
 )
 
 const (
 	// the default command to run when no command is set on the command line
 	DEFAULT_COMMAND = cmd.INFER
+
+	// the command line argument starting the server mode instead of running a single command
+	SERVER_MODE_ARGUMENT = "serve"
 )
 
 var (
@@ -82,6 +86,22 @@ func selectCommand(args []string) (cmd.Commands, error) {
 	return DEFAULT_COMMAND, nil
 }
 
+/*
+Scans the given command line arguments and returns true if the server mode has been requested.
+
+Arguments are as follows:
+
+- args the command line arguments, it must not contain the first command line argument (as it's the executable name)
+*/
+func isServerMode(args []string) bool {
+	for _, arg := range args {
+		if arg != "" && !strings.HasPrefix(arg, "-") && strings.EqualFold(arg, SERVER_MODE_ARGUMENT) {
+			return true
+		}
+	}
+	return false
+}
+
 /*
 Entry point.
 */
@@ -102,45 +122,64 @@ func main() {
 	configuration, err := nyx.Configuration()
 	if err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(exitCodeOf(err))
 	}
 	verbosity, err := configuration.GetVerbosity()
 	if err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(exitCodeOf(err))
 	}
 	log.SetLevel(verbosity.GetLevel())
 
+	if isServerMode(os.Args[1:]) {
+		server, err := srv.NewServer(configuration)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCodeOf(err))
+		}
+		err = server.ListenAndServe()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCodeOf(err))
+		}
+		os.Exit(0)
+	}
+
 	command, err := selectCommand(os.Args[1:])
 	if err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(exitCodeOf(err))
 	}
 
 	err = nyx.Run(command)
 	if err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(exitCodeOf(err))
 	}
 
 	summary, err := configuration.GetSummary()
 	if err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(exitCodeOf(err))
 	}
 	if summary != nil && *summary {
 		state, err := nyx.State()
 		if err != nil {
 			fmt.Println(err)
-			os.Exit(1)
+			os.Exit(exitCodeOf(err))
 		}
 		summary, err := state.Summary()
 		if err != nil {
 			fmt.Println(err)
-			os.Exit(1)
+			os.Exit(exitCodeOf(err))
 		}
 		fmt.Println(summary)
 	}
 
-	os.Exit(0)
+	exitCode, err := outcomeExitCodeOf(nyx, command)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitCodeOf(err))
+	}
+	os.Exit(exitCode)
 }
@@ -52,3 +52,11 @@ func TestMainSelectCommand(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, selectedCommand, cmd.MAKE)
 }
+
+func TestMainIsServerMode(t *testing.T) {
+	assert.False(t, isServerMode([]string{}))
+	assert.False(t, isServerMode([]string{"infer", "--server-address=:9090"}))
+	assert.False(t, isServerMode([]string{"--serve"}))
+	assert.True(t, isServerMode([]string{"serve"}))
+	assert.True(t, isServerMode([]string{"--server-secret=abc", "SERVE"}))
+}
@@ -25,16 +25,25 @@ import (
 	"fmt"           // https://pkg.go.dev/fmt
 	"os"            // https://pkg.go.dev/os
 	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strconv"       // https://pkg.go.dev/strconv
 	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
 
 	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
 
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
 	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	evt "github.com/mooltiverse/nyx/modules/go/nyx/events"
 	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
 	io "github.com/mooltiverse/nyx/modules/go/nyx/io"
+	lnt "github.com/mooltiverse/nyx/modules/go/nyx/lint"
+	rpt "github.com/mooltiverse/nyx/modules/go/nyx/report"
+	svc "github.com/mooltiverse/nyx/modules/go/nyx/services"
 	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	tel "github.com/mooltiverse/nyx/modules/go/nyx/telemetry"
+	tpl "github.com/mooltiverse/nyx/modules/go/nyx/template"
 )
 
 /*
@@ -61,10 +70,20 @@ type Nyx struct {
 	// State() instead of reading this member.
 	state *stt.State
 
+	// The run collecting the command timings and warnings for the release report.
+	//
+	// This object is only created when the release report file is configured.
+	report *rpt.Run
+
 	// This map stores instances of commands so that they can be reused.
 	//
 	// Instances are lazily created and stored here.
 	commands map[string]*cmd.Command
+
+	// The lock on the repository, held while running the commands that need it, when locking is enabled.
+	//
+	// This is nil when the lock is not held.
+	repositoryLock git.Lock
 }
 
 /*
@@ -118,6 +137,42 @@ func (n *Nyx) Configuration() (*cnf.Configuration, error) {
 	return n.config, nil
 }
 
+/*
+Returns the Git instance opening and cloning repositories with all the options in the given Git configuration, so that
+every run uses its own options, regardless of those used by other runs in the same process (i.e. the server handling
+several events). Options missing from the configuration take their default values.
+
+Arguments are as follows:
+
+- gitConfiguration the Git configuration. When nil all the options take their default values.
+
+Error is:
+- IllegalArgumentError: in case any of the options in the configuration is not valid.
+*/
+func GitInstance(gitConfiguration *ent.GitConfiguration) (git.Git, error) {
+	options := git.Options{}
+	if gitConfiguration != nil {
+		// the REMOTE backend works without a local repository so it never applies to the repositories opened or cloned here
+		if gitConfiguration.GetBackend() != nil && ent.REMOTE != *gitConfiguration.GetBackend() {
+			options.Backend = gitConfiguration.GetBackend().String()
+		}
+		options.Proxy = gitConfiguration.GetProxy()
+		if gitConfiguration.GetHeaders() != nil {
+			options.Headers = *gitConfiguration.GetHeaders()
+		}
+		options.SingleBranch = gitConfiguration.GetSingleBranch() != nil && *gitConfiguration.GetSingleBranch()
+		options.Mirror = gitConfiguration.GetMirror() != nil && *gitConfiguration.GetMirror()
+		options.Timestamp = gitConfiguration.GetTimestamp()
+		options.Timeout = gitConfiguration.GetTimeout()
+		options.Retries = gitConfiguration.GetRetries()
+		options.RetryDelay = gitConfiguration.GetRetryDelay()
+		options.NoVerify = gitConfiguration.GetNoVerify() != nil && *gitConfiguration.GetNoVerify()
+		options.HooksPath = gitConfiguration.GetHooksPath()
+		options.IgnoreSubmodules = gitConfiguration.GetIgnoreSubmodules()
+	}
+	return git.GitInstanceWith(options)
+}
+
 /*
 Returns the repository.
 
@@ -136,8 +191,26 @@ func (n *Nyx) Repository() (*git.Repository, error) {
 		if err != nil {
 			return nil, err
 		}
+		gitConfiguration, err := configuration.GetGit()
+		if err != nil {
+			return nil, err
+		}
+		gitInstance, err := GitInstance(gitConfiguration)
+		if err != nil {
+			return nil, err
+		}
+		if gitConfiguration != nil && gitConfiguration.GetBackend() != nil && ent.REMOTE == *gitConfiguration.GetBackend() {
+			repository, err := n.openRemoteRepository(configuration, gitConfiguration.GetService())
+			if err != nil {
+				return nil, err
+			}
+			n.repository = &repository
+			return n.repository, nil
+		}
 		log.Debugf("instantiating the Git repository in '%s'", *repoDir)
-		repository, err := git.GitInstance().Open(*repoDir)
+		done := tel.Start(tel.GIT_CATEGORY, "open")
+		repository, err := gitInstance.Open(*repoDir)
+		done(err)
 		if err != nil {
 			return nil, err
 		}
@@ -146,6 +219,113 @@ func (n *Nyx) Repository() (*git.Repository, error) {
 	return n.repository, nil
 }
 
+/*
+Acquires the lock on the repository, when enabled by the configuration, so that concurrent runs working on the same
+repository can't interfere with each other. The lock is held until the returned function is invoked.
+
+When the lock is already held by this instance (i.e. when a command runs its dependencies) this method does nothing
+and the lock is released by the outermost caller only.
+
+Error is:
+- DataAccessError: in case the configuration can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+- GitError: in case the repository is locked by another run or the lock can't be acquired.
+*/
+func (n *Nyx) lockRepository() (func(), error) {
+	if n.repositoryLock != nil {
+		return func() {}, nil
+	}
+	configuration, err := n.Configuration()
+	if err != nil {
+		return nil, err
+	}
+	gitConfiguration, err := configuration.GetGit()
+	if err != nil {
+		return nil, err
+	}
+	if gitConfiguration == nil || gitConfiguration.GetLock() == nil || !*gitConfiguration.GetLock() {
+		return func() {}, nil
+	}
+	wait := time.Duration(0)
+	if gitConfiguration.GetLockTimeout() != nil && "" != strings.TrimSpace(*gitConfiguration.GetLockTimeout()) {
+		seconds, err := strconv.ParseInt(strings.TrimSpace(*gitConfiguration.GetLockTimeout()), 10, 64)
+		if err != nil || seconds < 0 {
+			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the lock timeout '%s' is not a valid number of seconds", *gitConfiguration.GetLockTimeout()), Cause: err}
+		}
+		wait = time.Duration(seconds) * time.Second
+	}
+	repository, err := n.Repository()
+	if err != nil {
+		return nil, err
+	}
+	lock, err := (*repository).Lock(wait)
+	if err != nil {
+		return nil, err
+	}
+	n.repositoryLock = lock
+	return func() {
+		n.repositoryLock = nil
+		if err := lock.Release(); err != nil {
+			log.Warnf("unable to release the lock on the repository: %v", err)
+		}
+	}, nil
+}
+
+/*
+Returns the read only repository used by the REMOTE Git backend, which has no local clone and reads the commit
+history and the tags through the APIs of the service with the given name. Service options are rendered as templates
+using the state.
+
+Arguments are as follows:
+
+- configuration the configuration to read the services from
+- serviceName the name of the service to use, among the configured services
+
+Error is:
+  - DataAccessError: in case an option cannot be read or accessed.
+  - IllegalPropertyError: in case the service is not configured, its options can't be rendered or it doesn't support
+    the COMMIT_HISTORY feature.
+  - GitError: in case the repository can't be read from the service.
+*/
+func (n *Nyx) openRemoteRepository(configuration *cnf.Configuration, serviceName *string) (git.Repository, error) {
+	if serviceName == nil || "" == strings.TrimSpace(*serviceName) {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' Git backend requires the name of the service used to access the repository to be configured", ent.REMOTE)}
+	}
+	services, err := configuration.GetServices()
+	if err != nil {
+		return nil, err
+	}
+	if services == nil || (*services)[*serviceName] == nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' Git backend uses the '%s' service but no such service has been configured", ent.REMOTE, *serviceName)}
+	}
+	serviceConfiguration := (*services)[*serviceName]
+
+	state, err := n.State()
+	if err != nil {
+		return nil, err
+	}
+	flatState, err := state.Flatten()
+	if err != nil {
+		return nil, &errs.IllegalStateError{Message: fmt.Sprintf("the internal state cannot be flattened for rendering"), Cause: err}
+	}
+	options := make(map[string]string)
+	if serviceConfiguration.GetOptions() != nil {
+		for optionKey, optionValue := range *serviceConfiguration.GetOptions() {
+			renderedValue, err := tpl.Render(optionValue, flatState)
+			if err != nil {
+				return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("template '%s' cannot be rendered using the current state", optionValue), Cause: err}
+			}
+			options[optionKey] = renderedValue
+		}
+	}
+	service, err := svc.CommitHistoryServiceInstance(*serviceConfiguration.GetType(), options)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the '%s' Git backend can't use the '%s' service", ent.REMOTE, *serviceName), Cause: err}
+	}
+	log.Debugf("instantiating the Git repository through the APIs of the '%s' service", *serviceName)
+	return git.GitInstance().OpenRemote(service, nil)
+}
+
 /*
 Returns the state. The state may be created from scratch or loaded from a previously saved file, if the configuration says so.
 
@@ -183,6 +363,17 @@ func (n *Nyx) State() (*stt.State, error) {
 				}
 				_, err = os.Stat(*stateFile)
 				if err == nil {
+					stateFileSigningKey, err := configuration.GetStateFileSigningKey()
+					if err != nil {
+						return nil, err
+					}
+					if stateFileSigningKey != nil && "" != *stateFileSigningKey {
+						log.Debugf("verifying the signature of the state file '%s'", *stateFile)
+						err = stt.VerifyFile(*stateFile, *stateFileSigningKey)
+						if err != nil {
+							return nil, err
+						}
+					}
 					log.Debugf("resuming the state from file '%s'", *stateFile)
 					state, err := stt.Resume(*stateFile, configuration)
 					if err != nil {
@@ -230,6 +421,12 @@ func (n *Nyx) newCommandInstance(command cmd.Commands) (*cmd.Command, error) {
 	}
 	var res cmd.Command
 	switch command {
+	case cmd.ANALYTICS:
+		res, err = cmd.NewAnalytics(state, repository)
+		if err != nil {
+			return nil, err
+		}
+		return &res, nil
 	case cmd.CLEAN:
 		res, err = cmd.NewClean(state, repository)
 		if err != nil {
@@ -254,6 +451,18 @@ func (n *Nyx) newCommandInstance(command cmd.Commands) (*cmd.Command, error) {
 			return nil, err
 		}
 		return &res, nil
+	case cmd.PREVIEW:
+		res, err = cmd.NewPreview(state, repository)
+		if err != nil {
+			return nil, err
+		}
+		return &res, nil
+	case cmd.PRUNE:
+		res, err = cmd.NewPrune(state, repository)
+		if err != nil {
+			return nil, err
+		}
+		return &res, nil
 	case cmd.PUBLISH:
 		res, err = cmd.NewPublish(state, repository)
 		if err != nil {
@@ -304,7 +513,10 @@ Arguments are as follows:
 
   - command the command
   - saveStateAndSummary a boolean that, when true saves the State to the configured state file if not nil,
-    and the summary to the configured summary file, if not nil
+    the summary to the configured summary file, if not nil, and the badges to the configured badges directory,
+    if not nil, and the commit lint report to the configured commit lint file, if not nil, and the telemetry
+    report to the configured telemetry file, if not nil, and the release report to the configured report file,
+    if not nil, and the JUnit XML release report to the configured JUnit report file, if not nil
 
 Error is:
 - DataAccessError: in case the configuration can't be loaded for some reason.
@@ -314,6 +526,24 @@ Error is:
 */
 func (n *Nyx) runCommand(command cmd.Commands, saveStateAndSummary bool) error {
 	log.Debugf("running command '%s'", command.String())
+	telemetryFile, err := n.telemetryFile()
+	if err != nil {
+		return err
+	}
+	if telemetryFile != nil {
+		tel.Enable()
+	}
+	reportFile, err := n.reportFile()
+	if err != nil {
+		return err
+	}
+	junitReportFile, err := n.junitReportFile()
+	if err != nil {
+		return err
+	}
+	if (reportFile != nil || junitReportFile != nil) && n.report == nil {
+		n.report = rpt.NewRun()
+	}
 	commandInstance, err := n.getCommandInstance(command)
 	if err != nil {
 		return err
@@ -326,8 +556,37 @@ func (n *Nyx) runCommand(command cmd.Commands, saveStateAndSummary bool) error {
 		log.Debugf("command '%s' is up to date, skipping.", command.String())
 	} else {
 		log.Debugf("command '%s' is not up to date, running...", command.String())
+		done := tel.Start(tel.COMMAND_CATEGORY, command.String())
+		reportDone := func(err error) {}
+		if reportFile != nil || junitReportFile != nil {
+			detach := n.report.Attach(log.StandardLogger())
+			timingDone := n.report.Start(command.String())
+			reportDone = func(err error) {
+				timingDone(err)
+				detach()
+			}
+		}
 		_, err := (*commandInstance).Run()
+		done(err)
+		reportDone(err)
 		if err != nil {
+			n.emitEvent(evt.FAILED, command, err)
+			// failures are worth reporting too but they must not hide the original error
+			if saveStateAndSummary && telemetryFile != nil {
+				if telemetryErr := n.saveTelemetryReport(*telemetryFile); telemetryErr != nil {
+					log.Warnf("unable to save the telemetry report: %v", telemetryErr)
+				}
+			}
+			if saveStateAndSummary && reportFile != nil {
+				if reportErr := n.saveReport(*reportFile); reportErr != nil {
+					log.Warnf("unable to save the release report: %v", reportErr)
+				}
+			}
+			if saveStateAndSummary && junitReportFile != nil {
+				if reportErr := n.saveJUnitReport(*junitReportFile); reportErr != nil {
+					log.Warnf("unable to save the JUnit release report: %v", reportErr)
+				}
+			}
 			return err
 		}
 		log.Debugf("command '%s' finished.", command.String())
@@ -365,6 +624,18 @@ func (n *Nyx) runCommand(command cmd.Commands, saveStateAndSummary bool) error {
 				return err
 			}
 			log.Debugf("state stored to to '%s'", *stateFile)
+			stateFileSigningKey, err := configuration.GetStateFileSigningKey()
+			if err != nil {
+				return err
+			}
+			if stateFileSigningKey != nil && "" != *stateFileSigningKey {
+				log.Debugf("signing the state file '%s'", *stateFile)
+				err = stt.SignFile(*stateFile, *stateFileSigningKey)
+				if err != nil {
+					return err
+				}
+				log.Debugf("state file signature stored to '%s'", stt.SignatureFileFor(*stateFile))
+			}
 		}
 		// optionally save the summary file
 		summaryFile, err := configuration.GetSummaryFile()
@@ -397,10 +668,358 @@ func (n *Nyx) runCommand(command cmd.Commands, saveStateAndSummary bool) error {
 			}
 			log.Debugf("summary stored to to '%s'", *summaryFile)
 		}
+		// optionally save the badges
+		badgesDirectory, err := configuration.GetBadgesDirectory()
+		if err != nil {
+			return err
+		}
+		// if the directory path is relative make it relative to the configured directory
+		if badgesDirectory != nil && "" != strings.TrimSpace(*badgesDirectory) && !filepath.IsAbs(*badgesDirectory) {
+			directory, err := configuration.GetDirectory()
+			if err != nil {
+				return err
+			}
+			badgesDirectoryAbsolutePath := filepath.Join(*directory, *badgesDirectory)
+			badgesDirectory = &badgesDirectoryAbsolutePath
+		}
+		if saveStateAndSummary && badgesDirectory != nil && "" != strings.TrimSpace(*badgesDirectory) {
+			log.Debugf("storing the badges to '%s'", *badgesDirectory)
+			state, err := n.State()
+			if err != nil {
+				return err
+			}
+			badges, err := state.Badges()
+			if err != nil {
+				return err
+			}
+			err = os.MkdirAll(*badgesDirectory, os.ModePerm)
+			if err != nil {
+				return err
+			}
+			for badgeFileName, badgeContent := range badges {
+				err = os.WriteFile(filepath.Join(*badgesDirectory, badgeFileName), []byte(badgeContent), 0644)
+				if err != nil {
+					return err
+				}
+			}
+			log.Debugf("badges stored to '%s'", *badgesDirectory)
+		}
+		// optionally lint the commits in the release scope, once they are known
+		if saveStateAndSummary && command == cmd.INFER {
+			err = n.lintCommits()
+			if err != nil {
+				return err
+			}
+		}
+		// optionally save the telemetry report
+		if saveStateAndSummary && telemetryFile != nil {
+			err = n.saveTelemetryReport(*telemetryFile)
+			if err != nil {
+				return err
+			}
+		}
+		// optionally save the release report
+		if saveStateAndSummary && reportFile != nil {
+			err = n.saveReport(*reportFile)
+			if err != nil {
+				return err
+			}
+		}
+		// optionally save the JUnit XML release report
+		if saveStateAndSummary && junitReportFile != nil {
+			err = n.saveJUnitReport(*junitReportFile)
+			if err != nil {
+				return err
+			}
+		}
+
+		// publish the release lifecycle event, if any, to the configured event emitters
+		switch command {
+		case cmd.INFER:
+			err = n.emitEvent(evt.INFERRED, command, nil)
+		case cmd.MARK:
+			err = n.emitEvent(evt.MARKED, command, nil)
+		case cmd.PUBLISH:
+			err = n.emitEvent(evt.PUBLISHED, command, nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Checks the commits in the release scope against the configured commit message conventions and, if the commit lint
+file is configured, writes the violations to the file as a SARIF report. When running within GitHub Actions the
+violations are also printed as workflow annotations.
+
+Error is:
+- DataAccessError: in case the configuration or the state can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+- IOError: in case the report can't be written.
+*/
+func (n *Nyx) lintCommits() error {
+	configuration, err := n.Configuration()
+	if err != nil {
+		return err
+	}
+	commitLintFile, err := configuration.GetCommitLintFile()
+	if err != nil {
+		return err
+	}
+	if commitLintFile == nil || "" == strings.TrimSpace(*commitLintFile) {
+		return nil
+	}
+	// if the file path is relative make it relative to the configured directory
+	if !filepath.IsAbs(*commitLintFile) {
+		directory, err := configuration.GetDirectory()
+		if err != nil {
+			return err
+		}
+		commitLintFileAbsolutePath := filepath.Join(*directory, *commitLintFile)
+		commitLintFile = &commitLintFileAbsolutePath
+	}
+	state, err := n.State()
+	if err != nil {
+		return err
+	}
+	violations, err := lnt.Lint(state)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		log.Warnf("%d commits in the release scope don't match any commit message convention", len(violations))
+	}
+	if lnt.IsGitHubActions() {
+		err = lnt.WriteAnnotations(os.Stdout, violations)
+		if err != nil {
+			return err
+		}
+	}
+	log.Debugf("storing the commit lint report to '%s'", *commitLintFile)
+	err = lnt.SaveSARIFReport(*commitLintFile, violations)
+	if err != nil {
+		return err
+	}
+	log.Debugf("commit lint report stored to '%s'", *commitLintFile)
+	return nil
+}
+
+/*
+Returns the absolute path of the configured telemetry report file or nil if it's not configured, in which case no
+metric must be collected. Relative paths are resolved against the configured directory.
+
+Error is:
+- DataAccessError: in case the configuration can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+*/
+func (n *Nyx) telemetryFile() (*string, error) {
+	configuration, err := n.Configuration()
+	if err != nil {
+		return nil, err
+	}
+	telemetryFile, err := configuration.GetTelemetryFile()
+	if err != nil {
+		return nil, err
+	}
+	if telemetryFile == nil || "" == strings.TrimSpace(*telemetryFile) {
+		return nil, nil
+	}
+	// if the file path is relative make it relative to the configured directory
+	if !filepath.IsAbs(*telemetryFile) {
+		directory, err := configuration.GetDirectory()
+		if err != nil {
+			return nil, err
+		}
+		telemetryFileAbsolutePath := filepath.Join(*directory, *telemetryFile)
+		telemetryFile = &telemetryFileAbsolutePath
+	}
+	return telemetryFile, nil
+}
+
+/*
+Saves the telemetry report to the given file and, if the telemetry endpoint is configured, uploads it. Upload
+failures are only logged as telemetry must never prevent a release.
+
+Arguments are as follows:
+
+  - telemetryFile the absolute path of the file to save the report to
+
+Error is:
+- DataAccessError: in case the configuration can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+- IOError: in case the report can't be written.
+*/
+func (n *Nyx) saveTelemetryReport(telemetryFile string) error {
+	log.Debugf("storing the telemetry report to '%s'", telemetryFile)
+	err := tel.SaveReport(telemetryFile)
+	if err != nil {
+		return err
+	}
+	log.Debugf("telemetry report stored to '%s'", telemetryFile)
+	configuration, err := n.Configuration()
+	if err != nil {
+		return err
+	}
+	telemetryEndpoint, err := configuration.GetTelemetryEndpoint()
+	if err != nil {
+		return err
+	}
+	if telemetryEndpoint != nil && "" != strings.TrimSpace(*telemetryEndpoint) {
+		err = tel.UploadReport(telemetryFile, *telemetryEndpoint)
+		if err != nil {
+			log.Warnf("unable to upload the telemetry report: %v", err)
+		}
+	}
+	return nil
+}
+
+/*
+Returns the absolute path of the configured release report file or nil if it's not configured, in which case no
+report must be generated. Relative paths are resolved against the configured directory.
+
+Error is:
+- DataAccessError: in case the configuration can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+*/
+func (n *Nyx) reportFile() (*string, error) {
+	configuration, err := n.Configuration()
+	if err != nil {
+		return nil, err
+	}
+	reportFile, err := configuration.GetReportFile()
+	if err != nil {
+		return nil, err
+	}
+	if reportFile == nil || "" == strings.TrimSpace(*reportFile) {
+		return nil, nil
+	}
+	// if the file path is relative make it relative to the configured directory
+	if !filepath.IsAbs(*reportFile) {
+		directory, err := configuration.GetDirectory()
+		if err != nil {
+			return nil, err
+		}
+		reportFileAbsolutePath := filepath.Join(*directory, *reportFile)
+		reportFile = &reportFileAbsolutePath
+	}
+	return reportFile, nil
+}
+
+/*
+Saves the release report, rendered with the current state and the commands run so far, to the given file.
+
+Arguments are as follows:
+
+  - reportFile the absolute path of the file to save the report to
+
+Error is:
+- DataAccessError: in case the configuration or the state can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+- IOError: in case the report can't be written.
+*/
+func (n *Nyx) saveReport(reportFile string) error {
+	log.Debugf("storing the release report to '%s'", reportFile)
+	state, err := n.State()
+	if err != nil {
+		return err
+	}
+	err = rpt.Save(reportFile, state, n.report)
+	if err != nil {
+		return err
+	}
+	log.Debugf("release report stored to '%s'", reportFile)
+	return nil
+}
+
+/*
+Returns the absolute path of the configured JUnit XML release report file or nil if it's not configured, in which
+case no JUnit report must be generated. Relative paths are resolved against the configured directory.
+
+Error is:
+- DataAccessError: in case the configuration can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+*/
+func (n *Nyx) junitReportFile() (*string, error) {
+	configuration, err := n.Configuration()
+	if err != nil {
+		return nil, err
+	}
+	junitReportFile, err := configuration.GetJUnitReportFile()
+	if err != nil {
+		return nil, err
+	}
+	if junitReportFile == nil || "" == strings.TrimSpace(*junitReportFile) {
+		return nil, nil
+	}
+	// if the file path is relative make it relative to the configured directory
+	if !filepath.IsAbs(*junitReportFile) {
+		directory, err := configuration.GetDirectory()
+		if err != nil {
+			return nil, err
+		}
+		junitReportFileAbsolutePath := filepath.Join(*directory, *junitReportFile)
+		junitReportFile = &junitReportFileAbsolutePath
 	}
+	return junitReportFile, nil
+}
+
+/*
+Saves the JUnit XML release report, built with the current state and the commands run so far, to the given file.
+
+Arguments are as follows:
+
+  - junitReportFile the absolute path of the file to save the report to
+
+Error is:
+- DataAccessError: in case the configuration or the state can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+- IOError: in case the report can't be written.
+*/
+func (n *Nyx) saveJUnitReport(junitReportFile string) error {
+	log.Debugf("storing the JUnit release report to '%s'", junitReportFile)
+	state, err := n.State()
+	if err != nil {
+		return err
+	}
+	err = rpt.SaveJUnit(junitReportFile, state, n.report)
+	if err != nil {
+		return err
+	}
+	log.Debugf("JUnit release report stored to '%s'", junitReportFile)
 	return nil
 }
 
+/*
+Publishes an event of the given type to the configured event emitters.
+
+When the event is a FAILED event the error returned by the emitters is only logged so that the
+original error is not hidden and this method always returns nil.
+
+Arguments are as follows:
+
+  - eventType the type of the event
+  - command the command generating the event
+  - cause the error that caused the event, only used for FAILED events. It may be nil
+
+Error is:
+- DataAccessError: in case the configuration or the state can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+- TransportError: in case the event can't be published to some event bus.
+*/
+func (n *Nyx) emitEvent(eventType evt.EventType, command cmd.Commands, cause error) error {
+	state, err := n.State()
+	if err == nil {
+		err = evt.Emit(eventType, command.String(), state, cause)
+	}
+	if err != nil && eventType == evt.FAILED {
+		log.Warnf("unable to publish the '%s' event: %v", eventType.String(), err)
+		return nil
+	}
+	return err
+}
+
 /*
 Runs true if the given command has already run and is up to date, false otherwise.
 
@@ -444,6 +1063,8 @@ Error is:
 func (n *Nyx) Run(command cmd.Commands) error {
 	log.Debugf("Nyx.run(%s)", command.String())
 	switch command {
+	case cmd.ANALYTICS:
+		return n.Analytics()
 	case cmd.CLEAN:
 		return n.Clean()
 	case cmd.INFER:
@@ -455,6 +1076,11 @@ func (n *Nyx) Run(command cmd.Commands) error {
 	case cmd.MARK:
 		_, err := n.Mark()
 		return err
+	case cmd.PREVIEW:
+		_, err := n.Preview()
+		return err
+	case cmd.PRUNE:
+		return n.Prune()
 	case cmd.PUBLISH:
 		_, err := n.Publish()
 		return err
@@ -464,6 +1090,24 @@ func (n *Nyx) Run(command cmd.Commands) error {
 	}
 }
 
+/*
+Runs the Analytics command to report the release timeline and velocity metrics.
+
+Error is:
+- DataAccessError: in case the configuration can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+- GitError: in case of unexpected issues when accessing the Git repository.
+- ReleaseError: if the task is unable to complete for reasons due to the release process.
+*/
+func (n *Nyx) Analytics() error {
+	log.Debugf("Nyx.analytics()")
+
+	// this command has no dependencies
+
+	// run the command
+	return n.runCommand(cmd.ANALYTICS, false)
+}
+
 /*
 Runs the Clean command to restore the state of the workspace to ints initial state.
 
@@ -494,10 +1138,17 @@ Error is:
 func (n *Nyx) Infer() (*stt.State, error) {
 	log.Debugf("Nyx.infer()")
 
+	// hold the lock on the repository, if enabled, while running the command
+	release, err := n.lockRepository()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	// this command has no dependencies
 
 	// run the command
-	err := n.runCommand(cmd.INFER, true)
+	err = n.runCommand(cmd.INFER, true)
 	if err != nil {
 		return nil, err
 	}
@@ -544,8 +1195,16 @@ Error is:
 func (n *Nyx) Mark() (*stt.State, error) {
 	log.Debugf("Nyx.mark()")
 
+	// hold the lock on the repository, if enabled, while running the command and its dependencies, so that no other
+	// run can release the same version in the meanwhile
+	release, err := n.lockRepository()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	// run dependent tasks first
-	_, err := n.Make()
+	_, err = n.Make()
 	if err != nil {
 		return nil, err
 	}
@@ -559,6 +1218,51 @@ func (n *Nyx) Mark() (*stt.State, error) {
 	return n.State()
 }
 
+/*
+Runs the Preview command and returns the updated state. Dependencies of this command are also executed first.
+
+Error is:
+- DataAccessError: in case the configuration can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+- GitError: in case of unexpected issues when accessing the Git repository.
+- ReleaseError: if the task is unable to complete for reasons due to the release process.
+*/
+func (n *Nyx) Preview() (*stt.State, error) {
+	log.Debugf("Nyx.preview()")
+
+	// run dependent tasks first
+	_, err := n.Make()
+	if err != nil {
+		return nil, err
+	}
+
+	// run the command
+	err = n.runCommand(cmd.PREVIEW, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.State()
+}
+
+/*
+Runs the Prune command to enforce the retention policies of the publication services.
+
+Error is:
+- DataAccessError: in case the configuration can't be loaded for some reason.
+- IllegalPropertyError: in case the configuration has some illegal options.
+- GitError: in case of unexpected issues when accessing the Git repository.
+- ReleaseError: if the task is unable to complete for reasons due to the release process.
+*/
+func (n *Nyx) Prune() error {
+	log.Debugf("Nyx.prune()")
+
+	// this command has no dependencies
+
+	// run the command
+	return n.runCommand(cmd.PRUNE, false)
+}
+
 /*
 Runs the Publish command and returns the updated state. Dependencies of this command are also executed first.
 
@@ -0,0 +1,113 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This package provides the evaluation of policies expressed as code against the state model.
+
+Policies are expressed using the Common Expression Language (CEL, https://github.com/google/cel-go) and
+must evaluate to a boolean value. Expressions can access the following variables:
+
+- state: the current state, with the same structure used when the state is marshalled to JSON
+- env: the environment variables, as a map of strings
+*/
+package policy
+
+import (
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"strings"       // https://pkg.go.dev/strings
+
+	cel "github.com/google/cel-go/cel" // https://pkg.go.dev/github.com/google/cel-go/cel
+	log "github.com/sirupsen/logrus"   // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+)
+
+const (
+	// The name of the variable bringing the state into policy expressions.
+	STATE_VARIABLE_NAME = "state"
+
+	// The name of the variable bringing the environment variables into policy expressions.
+	ENVIRONMENT_VARIABLE_NAME = "env"
+)
+
+/*
+Evaluates the given CEL expression against the given state and returns the boolean outcome.
+
+Arguments are as follows:
+
+- expression the CEL expression to evaluate. It must evaluate to a boolean value
+- state the current state. It can't be nil
+
+Error is:
+- NilPointerError: in case the state is nil.
+- IllegalStateError: in case the state can't be converted to be used within the expression.
+- IllegalPropertyError: in case the expression is malformed, can't be evaluated or doesn't evaluate to a boolean value.
+*/
+func Evaluate(expression string, state *stt.State) (bool, error) {
+	if state == nil {
+		return false, &errs.NilPointerError{Message: "the state cannot be nil"}
+	}
+	log.Tracef("evaluating policy '%s'", expression)
+
+	// use the JSON representation of the state so expressions see the same structure users see in state files
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return false, &errs.IllegalStateError{Message: fmt.Sprintf("the internal state cannot be marshalled for policy evaluation"), Cause: err}
+	}
+	var stateMap map[string]interface{}
+	err = json.Unmarshal(stateBytes, &stateMap)
+	if err != nil {
+		return false, &errs.IllegalStateError{Message: fmt.Sprintf("the internal state cannot be unmarshalled for policy evaluation"), Cause: err}
+	}
+
+	env, err := cel.NewEnv(cel.Variable(STATE_VARIABLE_NAME, cel.DynType), cel.Variable(ENVIRONMENT_VARIABLE_NAME, cel.MapType(cel.StringType, cel.StringType)))
+	if err != nil {
+		return false, &errs.IllegalStateError{Message: fmt.Sprintf("unable to create the policy evaluation environment"), Cause: err}
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return false, &errs.IllegalPropertyError{Message: fmt.Sprintf("the policy '%s' cannot be compiled", expression), Cause: issues.Err()}
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return false, &errs.IllegalPropertyError{Message: fmt.Sprintf("the policy '%s' cannot be compiled", expression), Cause: err}
+	}
+	out, _, err := program.Eval(map[string]interface{}{STATE_VARIABLE_NAME: stateMap, ENVIRONMENT_VARIABLE_NAME: environmentVariables()})
+	if err != nil {
+		return false, &errs.IllegalPropertyError{Message: fmt.Sprintf("the policy '%s' cannot be evaluated", expression), Cause: err}
+	}
+	res, ok := out.Value().(bool)
+	if !ok {
+		return false, &errs.IllegalPropertyError{Message: fmt.Sprintf("the policy '%s' evaluates to '%v' while a boolean value is expected", expression, out.Value())}
+	}
+	log.Tracef("policy '%s' evaluates to '%t'", expression, res)
+	return res, nil
+}
+
+/*
+Returns the environment variables as a map.
+*/
+func environmentVariables() map[string]string {
+	res := make(map[string]string)
+	for _, envVar := range os.Environ() {
+		name, value, _ := strings.Cut(envVar, "=")
+		res[name] = value
+	}
+	return res
+}
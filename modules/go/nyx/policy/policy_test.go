@@ -0,0 +1,93 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+func TestEvaluate(t *testing.T) {
+	_, err := Evaluate("true", nil)
+	assert.Error(t, err)
+
+	configuration, _ := cnf.NewConfiguration()
+	state, err := stt.NewStateWith(configuration)
+	assert.NoError(t, err)
+	state.SetBranch(utl.PointerToString("main"))
+	state.SetVersion(utl.PointerToString("1.2.3"))
+
+	res, err := Evaluate("true", state)
+	assert.NoError(t, err)
+	assert.True(t, res)
+
+	res, err = Evaluate("state.branch == 'main'", state)
+	assert.NoError(t, err)
+	assert.True(t, res)
+
+	res, err = Evaluate("state.branch == 'main' && state.version.startsWith('2.')", state)
+	assert.NoError(t, err)
+	assert.False(t, res)
+
+	res, err = Evaluate("state.branch in ['main', 'master'] || state.branch.matches('^release/.*$')", state)
+	assert.NoError(t, err)
+	assert.True(t, res)
+}
+
+func TestEvaluateWithEnvironmentVariables(t *testing.T) {
+	t.Setenv("NYX_POLICY_TEST_VARIABLE", "yes")
+	configuration, _ := cnf.NewConfiguration()
+	state, err := stt.NewStateWith(configuration)
+	assert.NoError(t, err)
+
+	res, err := Evaluate("env.NYX_POLICY_TEST_VARIABLE == 'yes'", state)
+	assert.NoError(t, err)
+	assert.True(t, res)
+
+	res, err = Evaluate("'NYX_POLICY_MISSING_VARIABLE' in env", state)
+	assert.NoError(t, err)
+	assert.False(t, res)
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	configuration, _ := cnf.NewConfiguration()
+	state, err := stt.NewStateWith(configuration)
+	assert.NoError(t, err)
+	state.SetBranch(utl.PointerToString("main"))
+
+	// malformed expression
+	_, err = Evaluate("state.branch ==", state)
+	assert.Error(t, err)
+
+	// non boolean result
+	_, err = Evaluate("state.branch", state)
+	assert.Error(t, err)
+
+	// missing attribute
+	_, err = Evaluate("state.nonexistent == 'x'", state)
+	assert.Error(t, err)
+}
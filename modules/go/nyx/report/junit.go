@@ -0,0 +1,255 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package report
+
+import (
+	"encoding/xml"  // https://pkg.go.dev/encoding/xml
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strconv"       // https://pkg.go.dev/strconv
+	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+)
+
+const (
+	// The name of the JUnit test suites element, grouping all the suites in the report.
+	JUNIT_NAME = "nyx"
+
+	// The name of the JUnit test suite with a test case for each command.
+	JUNIT_COMMANDS_SUITE_NAME = "nyx.commands"
+
+	// The name of the JUnit test suite with a test case for each publication service.
+	JUNIT_PUBLICATIONS_SUITE_NAME = "nyx.publications"
+)
+
+/*
+The root element of the JUnit report.
+*/
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+/*
+A JUnit test suite.
+*/
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+	SystemErr  string          `xml:"system-err,omitempty"`
+}
+
+/*
+A JUnit test suite property.
+*/
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+/*
+A JUnit test case, whose outcome is a failure, a skip or, when none of them is set, a success.
+*/
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+/*
+The failure of a JUnit test case.
+*/
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+/*
+The reason why a JUnit test case has been skipped.
+*/
+type junitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+/*
+Returns the given duration formatted as JUnit expects it, in seconds.
+*/
+func junitTime(duration time.Duration) string {
+	return fmt.Sprintf("%.3f", duration.Seconds())
+}
+
+/*
+Adds the given test case to the given suite, updating its counters.
+*/
+func (s *junitTestSuite) add(testCase junitTestCase) {
+	s.Tests++
+	if testCase.Failure != nil {
+		s.Failures++
+	}
+	if testCase.Skipped != nil {
+		s.Skipped++
+	}
+	s.TestCases = append(s.TestCases, testCase)
+}
+
+/*
+Returns the test suite with a test case for each command in the given run, along with the release outcome as
+properties and the logged warnings as the standard error.
+*/
+func newJUnitCommandsSuite(data reportData, run *Run, timestamp string) junitTestSuite {
+	suite := junitTestSuite{Name: JUNIT_COMMANDS_SUITE_NAME, Timestamp: timestamp, TestCases: []junitTestCase{}}
+	suite.Properties = []junitProperty{
+		{Name: "branch", Value: data.Branch},
+		{Name: "previousVersion", Value: data.PreviousVersion},
+		{Name: "bump", Value: data.Bump},
+		{Name: "version", Value: data.Version},
+		{Name: "newVersion", Value: strconv.FormatBool(data.NewVersion)},
+		{Name: "newRelease", Value: strconv.FormatBool(data.NewRelease)},
+		{Name: "dryRun", Value: strconv.FormatBool(data.DryRun)},
+	}
+	var total time.Duration
+	if run != nil {
+		for _, timing := range run.GetTimings() {
+			total = total + timing.Duration
+			testCase := junitTestCase{Name: timing.Command, ClassName: JUNIT_COMMANDS_SUITE_NAME, Time: junitTime(timing.Duration)}
+			if timing.Failure != "" {
+				testCase.Failure = &junitFailure{Message: timing.Failure, Content: timing.Failure}
+			}
+			suite.add(testCase)
+		}
+	}
+	suite.Time = junitTime(total)
+	suite.SystemErr = strings.Join(data.Warnings, "\n")
+	return suite
+}
+
+/*
+Returns the test suite with a test case for each configured publication service. Services the release has been
+published to succeed, while the others fail when the publish command failed or are skipped otherwise.
+*/
+func newJUnitPublicationsSuite(data reportData, timestamp string) junitTestSuite {
+	suite := junitTestSuite{Name: JUNIT_PUBLICATIONS_SUITE_NAME, Time: junitTime(0), Timestamp: timestamp, TestCases: []junitTestCase{}}
+	publishFailure := ""
+	for _, timing := range data.Timings {
+		if timing.Command == cmd.PUBLISH.String() {
+			publishFailure = timing.Failure
+		}
+	}
+	for _, target := range data.Targets {
+		testCase := junitTestCase{Name: target.Name, ClassName: JUNIT_PUBLICATIONS_SUITE_NAME, Time: junitTime(0)}
+		if target.Status == "published" {
+			testCase.SystemOut = fmt.Sprintf("version %s published to %s", data.Version, target.Name)
+		} else if publishFailure != "" && !data.DryRun {
+			testCase.Failure = &junitFailure{Message: publishFailure, Content: publishFailure}
+		} else {
+			testCase.Skipped = &junitSkipped{Message: target.Status}
+		}
+		suite.add(testCase)
+	}
+	return suite
+}
+
+/*
+Renders the JUnit XML report with the given state and run. Each command run is a test case in the commands suite,
+failing when the command failed, and each publication service is a test case in the publications suite, so that
+CI tools only able to show test reports can show the release outcome.
+
+Arguments are as follows:
+
+- state the state to render the report for
+- run the run with the command timings and warnings, it may be nil
+
+Errors can be:
+
+- NilPointerError: in case the state is nil.
+- DataAccessError: in case the state can't be read.
+- IllegalPropertyError: in case the configuration has illegal values.
+- IOError: in case the report can't be rendered.
+*/
+func RenderJUnit(state *stt.State, run *Run) (string, error) {
+	if state == nil {
+		return "", &errs.NilPointerError{Message: "the state cannot be nil"}
+	}
+	data, err := newReportData(state, run)
+	if err != nil {
+		return "", err
+	}
+	timestamp := time.Now().UTC().Format("2006-01-02T15:04:05")
+	commands := newJUnitCommandsSuite(data, run, timestamp)
+	publications := newJUnitPublicationsSuite(data, timestamp)
+	suites := junitTestSuites{Name: JUNIT_NAME, Time: commands.Time, Suites: []junitTestSuite{commands, publications}}
+	for _, suite := range suites.Suites {
+		suites.Tests = suites.Tests + suite.Tests
+		suites.Failures = suites.Failures + suite.Failures
+		suites.Skipped = suites.Skipped + suite.Skipped
+	}
+	content, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return "", &errs.IOError{Message: "unable to render the JUnit release report", Cause: err}
+	}
+	return xml.Header + string(content) + "\n", nil
+}
+
+/*
+Renders the JUnit XML report with the given state and run and saves it to the given file, creating the parent
+directories if needed.
+
+Arguments are as follows:
+
+- path the path to the file to write
+- state the state to render the report for
+- run the run with the command timings and warnings, it may be nil
+
+Errors can be:
+
+- IOError: in case the file can't be written.
+- any other error returned by RenderJUnit.
+*/
+func SaveJUnit(path string, state *stt.State, run *Run) error {
+	content, err := RenderJUnit(state, run)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to create the parent directory of the JUnit release report '%s'", path), Cause: err}
+	}
+	err = os.WriteFile(path, []byte(content), 0644)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to write the JUnit release report to '%s'", path), Cause: err}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package report
+
+import (
+	"encoding/xml"  // https://pkg.go.dev/encoding/xml
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"testing"       // https://pkg.go.dev/testing
+
+	log "github.com/sirupsen/logrus"            // https://pkg.go.dev/github.com/sirupsen/logrus
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
+	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+Renders the JUnit report with the given state and run and parses it back.
+*/
+func renderAndParseJUnit(t *testing.T, state *stt.State, run *Run) junitTestSuites {
+	content, err := RenderJUnit(state, run)
+	assert.NoError(t, err)
+	assert.Contains(t, content, xml.Header)
+	var suites junitTestSuites
+	assert.NoError(t, xml.Unmarshal([]byte(content), &suites))
+	assert.Equal(t, 2, len(suites.Suites))
+	return suites
+}
+
+func TestRenderJUnit(t *testing.T) {
+	_, err := RenderJUnit(nil, nil)
+	assert.Error(t, err)
+
+	// a brand new state renders with no test cases
+	configuration, _ := cnf.NewConfiguration()
+	state, err := stt.NewStateWith(configuration)
+	assert.NoError(t, err)
+	suites := renderAndParseJUnit(t, state, nil)
+	assert.Equal(t, JUNIT_NAME, suites.Name)
+	assert.Equal(t, 0, suites.Tests)
+	assert.Equal(t, JUNIT_COMMANDS_SUITE_NAME, suites.Suites[0].Name)
+	assert.Equal(t, JUNIT_PUBLICATIONS_SUITE_NAME, suites.Suites[1].Name)
+
+	// successful commands and publications
+	run := NewRun()
+	run.Start(cmd.INFER.String())(nil)
+	done := run.Start(cmd.PUBLISH.String())
+	run.Fire(&log.Entry{Message: "something <odd> happened"})
+	done(nil)
+	suites = renderAndParseJUnit(t, newReleaseState(t, false, []*string{utl.PointerToString("github")}), run)
+	assert.Equal(t, 3, suites.Tests)
+	assert.Equal(t, 0, suites.Failures)
+	assert.Equal(t, 0, suites.Skipped)
+	commands := suites.Suites[0]
+	assert.Equal(t, 2, commands.Tests)
+	assert.Equal(t, cmd.INFER.String(), commands.TestCases[0].Name)
+	assert.Nil(t, commands.TestCases[0].Failure)
+	assert.Contains(t, commands.Properties, junitProperty{Name: "version", Value: "1.3.0"})
+	assert.Equal(t, "something <odd> happened", commands.SystemErr)
+	publications := suites.Suites[1]
+	assert.Equal(t, "github", publications.TestCases[0].Name)
+	assert.Nil(t, publications.TestCases[0].Failure)
+	assert.Nil(t, publications.TestCases[0].Skipped)
+	assert.Equal(t, "version 1.3.0 published to github", publications.TestCases[0].SystemOut)
+
+	// in dry run mode publications are skipped
+	suites = renderAndParseJUnit(t, newReleaseState(t, true, []*string{utl.PointerToString("github")}), run)
+	assert.Equal(t, 1, suites.Skipped)
+	assert.Equal(t, "skipped (dry run)", suites.Suites[1].TestCases[0].Skipped.Message)
+
+	// a failing publish command fails the command and the publications
+	run = NewRun()
+	run.Start(cmd.INFER.String())(nil)
+	run.Start(cmd.PUBLISH.String())(fmt.Errorf("unable to publish"))
+	suites = renderAndParseJUnit(t, newReleaseState(t, false, []*string{utl.PointerToString("github"), utl.PointerToString("gitlab")}), run)
+	assert.Equal(t, 4, suites.Tests)
+	assert.Equal(t, 3, suites.Failures)
+	assert.Equal(t, "unable to publish", suites.Suites[0].TestCases[1].Failure.Message)
+	assert.Equal(t, 2, suites.Suites[1].Failures)
+	assert.Equal(t, "unable to publish", suites.Suites[1].TestCases[1].Failure.Message)
+
+	// without the publish command publications are skipped
+	suites = renderAndParseJUnit(t, newReleaseState(t, false, []*string{utl.PointerToString("github")}), NewRun())
+	assert.Equal(t, 1, suites.Skipped)
+	assert.Equal(t, "not published", suites.Suites[1].TestCases[0].Skipped.Message)
+}
+
+func TestSaveJUnit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reports", "junit.xml")
+	err := SaveJUnit(path, newReleaseState(t, false, []*string{}), NewRun())
+	assert.NoError(t, err)
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "<testsuites name=\"nyx\"")
+	assert.Contains(t, string(content), "<property name=\"version\" value=\"1.3.0\"></property>")
+}
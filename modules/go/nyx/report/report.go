@@ -0,0 +1,346 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package report
+
+import (
+	"bytes"         // https://pkg.go.dev/bytes
+	_ "embed"       // https://pkg.go.dev/embed
+	"fmt"           // https://pkg.go.dev/fmt
+	"html/template" // https://pkg.go.dev/html/template
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"time"          // https://pkg.go.dev/time
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+)
+
+const (
+	// The number of characters of commit SHAs shown in the report.
+	SHORT_SHA_LENGTH = 7
+)
+
+var (
+	// The report template is embedded at compile time and available in this variable.
+	//go:embed template/report.tpl
+	reportTemplate string
+)
+
+/*
+A commit as it's shown in the report.
+*/
+type reportCommit struct {
+	SHA     string
+	Message string
+}
+
+/*
+An impact report as it's shown in the report.
+*/
+type reportImpact struct {
+	Title  string
+	Impact bool
+	Bump   string
+}
+
+/*
+A changelog section as it's shown in the report.
+*/
+type reportSection struct {
+	Name    string
+	Commits []reportCommit
+}
+
+/*
+A changelog release as it's shown in the report.
+*/
+type reportRelease struct {
+	Name     string
+	Date     string
+	Sections []reportSection
+}
+
+/*
+A publication target as it's shown in the report.
+*/
+type reportTarget struct {
+	Name   string
+	Status string
+}
+
+/*
+A command timing as it's shown in the report.
+*/
+type reportTiming struct {
+	Command  string
+	Duration string
+	Failure  string
+}
+
+/*
+The data the report template is rendered with.
+*/
+type reportData struct {
+	Generated             string
+	Branch                string
+	PreviousVersion       string
+	PreviousVersionCommit string
+	PrimeVersion          string
+	CommitCount           int
+	SignificantCommits    []reportCommit
+	ImpactReports         []reportImpact
+	Bump                  string
+	Version               string
+	NewVersion            bool
+	NewRelease            bool
+	NothingToRelease      bool
+	DryRun                bool
+	Releases              []reportRelease
+	Targets               []reportTarget
+	Timings               []reportTiming
+	Warnings              []string
+}
+
+/*
+Returns the given string pointer value or the empty string if the pointer is nil.
+*/
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+/*
+Returns the short SHA of the given commit.
+*/
+func shortSHA(commit gitent.Commit) string {
+	sha := commit.GetSHA()
+	if len(sha) > SHORT_SHA_LENGTH {
+		return sha[:SHORT_SHA_LENGTH]
+	}
+	return sha
+}
+
+/*
+Returns the given commits as they're shown in the report.
+*/
+func toReportCommits(commits []*gitent.Commit) []reportCommit {
+	res := []reportCommit{}
+	for _, commit := range commits {
+		if commit != nil {
+			res = append(res, reportCommit{SHA: shortSHA(*commit), Message: commit.GetMessage().GetShortMessage()})
+		}
+	}
+	return res
+}
+
+/*
+Collects the data to render the report with from the given state and run.
+*/
+func newReportData(state *stt.State, run *Run) (reportData, error) {
+	data := reportData{Generated: time.Now().UTC().Format(time.RFC3339)}
+
+	// the version decision trace
+	if state.HasBranch() {
+		branch, err := state.GetBranch()
+		if err != nil {
+			return data, err
+		}
+		data.Branch = valueOf(branch)
+	}
+	releaseScope, err := state.GetReleaseScope()
+	if err != nil {
+		return data, err
+	}
+	if releaseScope != nil {
+		data.PreviousVersion = valueOf(releaseScope.GetPreviousVersion())
+		if releaseScope.HasPreviousVersionCommit() {
+			data.PreviousVersionCommit = shortSHA(*releaseScope.GetPreviousVersionCommit())
+		}
+		data.PrimeVersion = valueOf(releaseScope.GetPrimeVersion())
+		data.CommitCount = len(releaseScope.GetCommits())
+		data.SignificantCommits = toReportCommits(releaseScope.GetSignificantCommits())
+		for _, impactReport := range releaseScope.GetImpactReports() {
+			if impactReport != nil {
+				title := valueOf(impactReport.GetTitle())
+				if title == "" {
+					title = valueOf(impactReport.GetName())
+				}
+				data.ImpactReports = append(data.ImpactReports, reportImpact{Title: title, Impact: impactReport.GetImpact(), Bump: valueOf(impactReport.GetBump())})
+			}
+		}
+	}
+	if state.HasBump() {
+		bump, err := state.GetBump()
+		if err != nil {
+			return data, err
+		}
+		data.Bump = valueOf(bump)
+	}
+	if state.HasVersion() {
+		version, err := state.GetVersion()
+		if err != nil {
+			return data, err
+		}
+		data.Version = valueOf(version)
+	}
+	data.NewVersion, err = state.GetNewVersion()
+	if err != nil {
+		return data, err
+	}
+	data.NewRelease, err = state.GetNewRelease()
+	if err != nil {
+		return data, err
+	}
+	nothingToRelease, err := state.GetNothingToRelease()
+	if err != nil {
+		return data, err
+	}
+	data.NothingToRelease = nothingToRelease != nil && *nothingToRelease
+	dryRun, err := state.GetConfiguration().GetDryRun()
+	if err != nil {
+		return data, err
+	}
+	data.DryRun = dryRun != nil && *dryRun
+
+	// the changelog
+	if state.HasChangelog() {
+		changelog, err := state.GetChangelog()
+		if err != nil {
+			return data, err
+		}
+		for _, release := range changelog.GetReleases() {
+			if release == nil {
+				continue
+			}
+			reportRelease := reportRelease{Name: valueOf(release.GetName()), Date: valueOf(release.GetDate())}
+			for _, section := range release.GetSections() {
+				if section != nil {
+					reportRelease.Sections = append(reportRelease.Sections, reportSection{Name: valueOf(section.GetName()), Commits: toReportCommits(section.GetCommits())})
+				}
+			}
+			data.Releases = append(data.Releases, reportRelease)
+		}
+	}
+
+	// the published targets
+	published := false
+	if run != nil {
+		for _, timing := range run.GetTimings() {
+			if timing.Command == cmd.PUBLISH.String() && timing.Failure == "" {
+				published = true
+			}
+		}
+	}
+	releaseTypes, err := state.GetConfiguration().GetReleaseTypes()
+	if err != nil {
+		return data, err
+	}
+	if releaseTypes != nil && releaseTypes.GetPublicationServices() != nil {
+		for _, service := range *releaseTypes.GetPublicationServices() {
+			if service == nil {
+				continue
+			}
+			status := "not published"
+			if data.DryRun {
+				status = "skipped (dry run)"
+			} else if published && data.NewRelease {
+				status = "published"
+			}
+			data.Targets = append(data.Targets, reportTarget{Name: *service, Status: status})
+		}
+	}
+
+	// the timings and warnings
+	if run != nil {
+		for _, timing := range run.GetTimings() {
+			data.Timings = append(data.Timings, reportTiming{Command: timing.Command, Duration: timing.Duration.Round(time.Millisecond).String(), Failure: timing.Failure})
+		}
+		data.Warnings = run.GetWarnings()
+	}
+	return data, nil
+}
+
+/*
+Renders the HTML report with the given state and run. The report is a self-contained HTML page, with no reference
+to external resources, so it can be attached to CI builds as an artifact.
+
+Arguments are as follows:
+
+- state the state to render the report for
+- run the run with the command timings and warnings, it may be nil
+
+Errors can be:
+
+- NilPointerError: in case the state is nil.
+- DataAccessError: in case the state can't be read.
+- IllegalPropertyError: in case the configuration has illegal values.
+- IOError: in case the report template can't be rendered.
+*/
+func Render(state *stt.State, run *Run) (string, error) {
+	if state == nil {
+		return "", &errs.NilPointerError{Message: "the state cannot be nil"}
+	}
+	data, err := newReportData(state, run)
+	if err != nil {
+		return "", err
+	}
+	tpl, err := template.New("report").Parse(reportTemplate)
+	if err != nil {
+		return "", &errs.IOError{Message: "unable to parse the release report template", Cause: err}
+	}
+	var buf bytes.Buffer
+	err = tpl.Execute(&buf, data)
+	if err != nil {
+		return "", &errs.IOError{Message: "unable to render the release report", Cause: err}
+	}
+	return buf.String(), nil
+}
+
+/*
+Renders the HTML report with the given state and run and saves it to the given file, creating the parent
+directories if needed.
+
+Arguments are as follows:
+
+- path the path to the file to write
+- state the state to render the report for
+- run the run with the command timings and warnings, it may be nil
+
+Errors can be:
+
+- IOError: in case the file can't be written.
+- any other error returned by Render.
+*/
+func Save(path string, state *stt.State, run *Run) error {
+	content, err := Render(state, run)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to create the parent directory of the release report '%s'", path), Cause: err}
+	}
+	err = os.WriteFile(path, []byte(content), 0644)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to write the release report to '%s'", path), Cause: err}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package report
+
+import (
+	"fmt"           // https://pkg.go.dev/fmt
+	"io"            // https://pkg.go.dev/io
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"testing"       // https://pkg.go.dev/testing
+	"time"          // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus"            // https://pkg.go.dev/github.com/sirupsen/logrus
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
+	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+Returns a new state releasing version 1.3.0 from a single commit, to be published to the given services.
+*/
+func newReleaseState(t *testing.T, dryRun bool, publicationServices []*string) *stt.State {
+	configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+	configurationLayerMock.SetDryRun(&dryRun)
+	releaseTypes, err := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("mainline")}, &publicationServices, &[]*string{}, &map[string]*ent.ReleaseType{"mainline": ent.NewReleaseType()})
+	assert.NoError(t, err)
+	configurationLayerMock.SetReleaseTypes(releaseTypes)
+	configuration, _ := cnf.NewConfiguration()
+	var configurationLayer cnf.ConfigurationLayer
+	configurationLayer = configurationLayerMock
+	configuration.WithRuntimeConfiguration(&configurationLayer)
+
+	state, err := stt.NewStateWith(configuration)
+	assert.NoError(t, err)
+	commit := gitent.NewCommitWith("d40fcded9e516158a2901f5657794931528af106", 0, []string{}, *gitent.NewActionWith(*gitent.NewIdentityWith("Jim", ""), *gitent.NewTimeStampFrom(time.Now())), *gitent.NewActionWith(*gitent.NewIdentityWith("Sam", ""), *gitent.NewTimeStampFrom(time.Now())), *gitent.NewMessageWith("feat: add <widgets>", "feat: add <widgets>", map[string]string{}), []gitent.Tag{})
+	releaseScope, _ := state.GetReleaseScope()
+	releaseScope.SetPreviousVersion(utl.PointerToString("1.2.3"))
+	releaseScope.SetCommits([]*gitent.Commit{commit})
+	releaseScope.SetSignificantCommits([]*gitent.Commit{commit})
+	state.SetBranch(utl.PointerToString("main"))
+	state.SetBump(utl.PointerToString("minor"))
+	state.SetVersion(utl.PointerToString("1.3.0"))
+	releaseType := ent.NewReleaseType()
+	releaseType.SetPublish(utl.PointerToString("true"))
+	state.SetReleaseType(releaseType)
+	release := ent.NewReleaseWith(utl.PointerToString("1.3.0"), utl.PointerToString("2020-01-01"))
+	release.SetSections([]*ent.Section{ent.NewSectionWith(utl.PointerToString("Added"), []*gitent.Commit{commit})})
+	state.SetChangelog(ent.NewChangelogWith([]*ent.Release{release}))
+	return state
+}
+
+func TestRunStart(t *testing.T) {
+	run := NewRun()
+	assert.Empty(t, run.GetTimings())
+
+	run.Start(cmd.INFER.String())(nil)
+	run.Start(cmd.PUBLISH.String())(fmt.Errorf("unable to publish"))
+
+	timings := run.GetTimings()
+	assert.Equal(t, 2, len(timings))
+	assert.Equal(t, cmd.INFER.String(), timings[0].Command)
+	assert.Empty(t, timings[0].Failure)
+	assert.Equal(t, cmd.PUBLISH.String(), timings[1].Command)
+	assert.Equal(t, "unable to publish", timings[1].Failure)
+}
+
+func TestRunFire(t *testing.T) {
+	run := NewRun()
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	detach := run.Attach(logger)
+
+	// entries logged while no command is running are ignored
+	logger.Warn("outside")
+	assert.Empty(t, run.GetWarnings())
+
+	done := run.Start(cmd.INFER.String())
+	logger.Info("informational")
+	logger.Warn("careful")
+	logger.Error("broken")
+	done(nil)
+	logger.Warn("after")
+	assert.Equal(t, []string{"careful", "broken"}, run.GetWarnings())
+
+	// once detached nothing is recorded anymore
+	detach()
+	assert.Empty(t, logger.Hooks)
+	done = run.Start(cmd.MARK.String())
+	logger.Warn("detached")
+	done(nil)
+	assert.Equal(t, []string{"careful", "broken"}, run.GetWarnings())
+}
+
+func TestRender(t *testing.T) {
+	_, err := Render(nil, nil)
+	assert.Error(t, err)
+
+	// a brand new state renders with no data
+	configuration, _ := cnf.NewConfiguration()
+	state, err := stt.NewStateWith(configuration)
+	assert.NoError(t, err)
+	html, err := Render(state, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, html, "<!DOCTYPE html>")
+	assert.Contains(t, html, "No changelog has been generated.")
+	assert.Contains(t, html, "No command has been run.")
+	assert.NotContains(t, html, "http://")
+
+	run := NewRun()
+	run.Start(cmd.INFER.String())(nil)
+	done := run.Start(cmd.PUBLISH.String())
+	run.Fire(&log.Entry{Message: "something <odd> happened"})
+	done(nil)
+	html, err = Render(newReleaseState(t, false, []*string{utl.PointerToString("github")}), run)
+	assert.NoError(t, err)
+
+	// the version decision trace
+	assert.Contains(t, html, "<code>main</code>")
+	assert.Contains(t, html, "<code>1.2.3</code>")
+	assert.Contains(t, html, "<code>minor</code>")
+	assert.Contains(t, html, "<code>1.3.0</code>")
+	assert.Contains(t, html, "<code>d40fcde</code>")
+	// the changelog, with values escaped
+	assert.Contains(t, html, "<h4>Added</h4>")
+	assert.Contains(t, html, "feat: add &lt;widgets&gt;")
+	// the published targets
+	assert.Contains(t, html, "<tr><th>github</th><td>published</td></tr>")
+	// the timings and warnings
+	assert.Contains(t, html, "<tr><th>INFER</th>")
+	assert.Contains(t, html, "something &lt;odd&gt; happened")
+
+	// in dry run mode nothing is published
+	html, err = Render(newReleaseState(t, true, []*string{utl.PointerToString("github")}), run)
+	assert.NoError(t, err)
+	assert.Contains(t, html, "<tr><th>github</th><td>skipped (dry run)</td></tr>")
+
+	// without a successful publish command nothing is published
+	html, err = Render(newReleaseState(t, false, []*string{utl.PointerToString("github")}), NewRun())
+	assert.NoError(t, err)
+	assert.Contains(t, html, "<tr><th>github</th><td>not published</td></tr>")
+}
+
+func TestSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reports", "report.html")
+	err := Save(path, newReleaseState(t, false, []*string{}), NewRun())
+	assert.NoError(t, err)
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "<code>1.3.0</code>")
+	assert.Contains(t, string(content), "No publication service is configured.")
+}
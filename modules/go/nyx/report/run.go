@@ -0,0 +1,155 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This is the report package for Nyx, producing the self-contained HTML and the JUnit XML release reports.
+*/
+package report
+
+import (
+	"sync" // https://pkg.go.dev/sync
+	"time" // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+)
+
+/*
+The time spent running a command and its outcome.
+*/
+type Timing struct {
+	// The name of the command.
+	Command string
+
+	// The time spent running the command.
+	Duration time.Duration
+
+	// The error message when the command failed, empty otherwise.
+	Failure string
+}
+
+/*
+Collects what happens during a run, beyond what is stored in the state, so that it can be shown in the report:
+the time spent running each command and the warnings logged meanwhile.
+
+A run is also a logrus hook that records warnings and errors, registered with Attach(). Entries are only
+recorded while a command is running, between Start() and the invocation of the function it returns.
+*/
+type Run struct {
+	// The lock guarding the fields below, as the hook may be invoked concurrently.
+	lock sync.Mutex
+
+	// The number of commands currently running. Log entries are only recorded when greater than 0.
+	running int
+
+	// The commands run so far, in the order they completed.
+	timings []Timing
+
+	// The warnings and errors logged while commands were running.
+	warnings []string
+}
+
+/*
+Returns a new, empty, run.
+*/
+func NewRun() *Run {
+	return &Run{timings: []Timing{}, warnings: []string{}}
+}
+
+/*
+Starts measuring the given command and returns the function to invoke when the command completes, passing the error
+it returned, if any.
+
+Arguments are as follows:
+
+- command the name of the command
+*/
+func (r *Run) Start(command string) func(err error) {
+	start := time.Now()
+	r.lock.Lock()
+	r.running++
+	r.lock.Unlock()
+	return func(err error) {
+		timing := Timing{Command: command, Duration: time.Since(start)}
+		if err != nil {
+			timing.Failure = err.Error()
+		}
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.running--
+		r.timings = append(r.timings, timing)
+	}
+}
+
+/*
+Returns the commands run so far, in the order they completed.
+*/
+func (r *Run) GetTimings() []Timing {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]Timing{}, r.timings...)
+}
+
+/*
+Returns the warnings and errors logged while commands were running.
+*/
+func (r *Run) GetWarnings() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]string{}, r.warnings...)
+}
+
+/*
+Returns the log levels recorded by this hook.
+*/
+func (r *Run) Levels() []log.Level {
+	return []log.Level{log.PanicLevel, log.FatalLevel, log.ErrorLevel, log.WarnLevel}
+}
+
+/*
+Records the given log entry, if a command is running.
+*/
+func (r *Run) Fire(entry *log.Entry) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.running > 0 {
+		r.warnings = append(r.warnings, entry.Message)
+	}
+	return nil
+}
+
+/*
+Registers this run as a hook of the given logger and returns the function to invoke to remove it, leaving other
+hooks untouched. Hooks are removed when they're no longer needed so that they don't pile up when the same process
+performs several runs (i.e. when running as a server).
+
+Arguments are as follows:
+
+- logger the logger to register the hook with
+*/
+func (r *Run) Attach(logger *log.Logger) func() {
+	logger.AddHook(r)
+	return func() {
+		hooks := make(log.LevelHooks)
+		for level, levelHooks := range logger.Hooks {
+			for _, hook := range levelHooks {
+				if hook != r {
+					hooks[level] = append(hooks[level], hook)
+				}
+			}
+		}
+		logger.ReplaceHooks(hooks)
+	}
+}
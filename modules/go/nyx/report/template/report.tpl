@@ -0,0 +1,94 @@
+<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Release report{{ if .Version }} - {{ .Version }}{{ end }}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; color: #24292f; margin: 0 auto; max-width: 960px; padding: 24px; }
+h1 { border-bottom: 1px solid #d0d7de; padding-bottom: 8px; }
+h2 { margin-top: 32px; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 16px; }
+th, td { border: 1px solid #d0d7de; padding: 6px 12px; text-align: left; vertical-align: top; }
+th { background: #f6f8fa; width: 30%; }
+code { font-family: SFMono-Regular, Consolas, monospace; background: #f6f8fa; padding: 1px 4px; border-radius: 4px; }
+.badge { display: inline-block; padding: 2px 8px; border-radius: 12px; font-size: 0.9em; color: #ffffff; }
+.yes { background: #1a7f37; }
+.no { background: #6e7781; }
+.failed { color: #cf222e; }
+.muted { color: #6e7781; }
+.warning { background: #fff8c5; border: 1px solid #d4a72c; border-radius: 6px; padding: 6px 12px; margin-bottom: 8px; }
+</style>
+</head>
+<body>
+<h1>Release report{{ if .Version }} <code>{{ .Version }}</code>{{ end }}</h1>
+<p class="muted">Generated on {{ .Generated }}{{ if .DryRun }} in dry run mode{{ end }}</p>
+
+<h2>Version decision</h2>
+<table>
+<tr><th>Branch</th><td>{{ if .Branch }}<code>{{ .Branch }}</code>{{ else }}<span class="muted">unknown</span>{{ end }}</td></tr>
+<tr><th>Previous version</th><td>{{ if .PreviousVersion }}<code>{{ .PreviousVersion }}</code>{{ if .PreviousVersionCommit }} at commit <code>{{ .PreviousVersionCommit }}</code>{{ end }}{{ else }}<span class="muted">none</span>{{ end }}</td></tr>
+<tr><th>Prime version</th><td>{{ if .PrimeVersion }}<code>{{ .PrimeVersion }}</code>{{ else }}<span class="muted">none</span>{{ end }}</td></tr>
+<tr><th>Commits in scope</th><td>{{ .CommitCount }}</td></tr>
+<tr><th>Significant commits</th><td>{{ if .SignificantCommits }}{{ range .SignificantCommits }}<div><code>{{ .SHA }}</code> {{ .Message }}</div>{{ end }}{{ else }}<span class="muted">none</span>{{ end }}</td></tr>
+{{- range .ImpactReports }}
+<tr><th>{{ .Title }}</th><td><span class="badge {{ if .Impact }}yes{{ else }}no{{ end }}">{{ if .Impact }}impact{{ else }}no impact{{ end }}</span>{{ if .Bump }} <code>{{ .Bump }}</code>{{ end }}</td></tr>
+{{- end }}
+<tr><th>Bump</th><td>{{ if .Bump }}<code>{{ .Bump }}</code>{{ else }}<span class="muted">none</span>{{ end }}</td></tr>
+<tr><th>Version</th><td>{{ if .Version }}<code>{{ .Version }}</code>{{ else }}<span class="muted">unknown</span>{{ end }}</td></tr>
+<tr><th>New version</th><td><span class="badge {{ if .NewVersion }}yes{{ else }}no{{ end }}">{{ if .NewVersion }}yes{{ else }}no{{ end }}</span></td></tr>
+<tr><th>New release</th><td><span class="badge {{ if .NewRelease }}yes{{ else }}no{{ end }}">{{ if .NewRelease }}yes{{ else }}no{{ end }}</span></td></tr>
+{{- if .NothingToRelease }}
+<tr><th>Nothing to release</th><td><span class="badge no">yes</span></td></tr>
+{{- end }}
+</table>
+
+<h2>Changelog</h2>
+{{- if .Releases }}
+{{- range .Releases }}
+<h3>{{ .Name }}{{ if .Date }} <span class="muted">({{ .Date }})</span>{{ end }}</h3>
+{{- range .Sections }}
+<h4>{{ .Name }}</h4>
+<ul>
+{{- range .Commits }}
+<li><code>{{ .SHA }}</code> {{ .Message }}</li>
+{{- end }}
+</ul>
+{{- end }}
+{{- end }}
+{{- else }}
+<p class="muted">No changelog has been generated.</p>
+{{- end }}
+
+<h2>Published targets</h2>
+{{- if .Targets }}
+<table>
+{{- range .Targets }}
+<tr><th>{{ .Name }}</th><td>{{ .Status }}</td></tr>
+{{- end }}
+</table>
+{{- else }}
+<p class="muted">No publication service is configured.</p>
+{{- end }}
+
+<h2>Timings</h2>
+{{- if .Timings }}
+<table>
+{{- range .Timings }}
+<tr><th>{{ .Command }}</th><td>{{ .Duration }}{{ if .Failure }} <span class="failed">failed: {{ .Failure }}</span>{{ end }}</td></tr>
+{{- end }}
+</table>
+{{- else }}
+<p class="muted">No command has been run.</p>
+{{- end }}
+
+<h2>Warnings</h2>
+{{- if .Warnings }}
+{{- range .Warnings }}
+<div class="warning">{{ . }}</div>
+{{- end }}
+{{- else }}
+<p class="muted">No warnings.</p>
+{{- end }}
+</body>
+</html>
@@ -0,0 +1,574 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This is the server package for Nyx, implementing the server mode where Nyx listens for push webhooks sent by Git
+hosting services and runs the release process for the branches that have been pushed.
+*/
+package server
+
+import (
+	"crypto/hmac"   // https://pkg.go.dev/crypto/hmac
+	"crypto/sha256" // https://pkg.go.dev/crypto/sha256
+	"encoding/hex"  // https://pkg.go.dev/encoding/hex
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"io"            // https://pkg.go.dev/io
+	"net/http"      // https://pkg.go.dev/net/http
+	"strings"       // https://pkg.go.dev/strings
+	"sync"          // https://pkg.go.dev/sync
+
+	regexp2 "github.com/dlclark/regexp2" // https://pkg.go.dev/github.com/dlclark/regexp2
+	log "github.com/sirupsen/logrus"     // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	nyx "github.com/mooltiverse/nyx/modules/go/nyx"
+	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
+	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
+	github "github.com/mooltiverse/nyx/modules/go/nyx/services/github"
+	stt "github.com/mooltiverse/nyx/modules/go/nyx/state"
+	tpl "github.com/mooltiverse/nyx/modules/go/nyx/template"
+	wks "github.com/mooltiverse/nyx/modules/go/nyx/workspace"
+)
+
+const (
+	// The header bringing the event name in GitHub webhooks.
+	GITHUB_EVENT_HEADER = "X-GitHub-Event"
+
+	// The header bringing the HMAC SHA-256 signature of the payload in GitHub webhooks.
+	GITHUB_SIGNATURE_HEADER = "X-Hub-Signature-256"
+
+	// The prefix of the signature value in GitHub webhooks.
+	GITHUB_SIGNATURE_PREFIX = "sha256="
+
+	// The name of the GitHub push event.
+	GITHUB_PUSH_EVENT = "push"
+
+	// The header bringing the event name in GitLab webhooks.
+	GITLAB_EVENT_HEADER = "X-Gitlab-Event"
+
+	// The header bringing the secret token in GitLab webhooks.
+	GITLAB_TOKEN_HEADER = "X-Gitlab-Token"
+
+	// The name of the GitLab push event.
+	GITLAB_PUSH_EVENT = "Push Hook"
+
+	// The prefix of the references pointing to branches.
+	BRANCH_REFERENCE_PREFIX = "refs/heads/"
+
+	// The maximum size of a webhook payload. Larger payloads are rejected.
+	MAX_PAYLOAD_SIZE = 25 * 1024 * 1024
+
+	// The SHA used by Git hosting services to represent a missing commit, as in branch deletions.
+	ZERO_SHA = "0000000000000000000000000000000000000000"
+)
+
+/*
+The push event extracted from webhook payloads, regardless of the service that sent it.
+*/
+type pushEvent struct {
+	// The name of the branch that has been pushed.
+	branch string
+
+	// The URL to clone the repository from.
+	cloneURL string
+}
+
+/*
+The GitHub push webhook payload. Only the attributes used by the server are mapped.
+*/
+type gitHubPushPayload struct {
+	// The full name of the pushed reference.
+	Ref string `json:"ref"`
+
+	// The flag telling if the reference has been deleted.
+	Deleted bool `json:"deleted"`
+
+	// The repository the reference belongs to.
+	Repository struct {
+		// The HTTP URL to clone the repository from.
+		CloneURL string `json:"clone_url"`
+	} `json:"repository"`
+}
+
+/*
+The GitLab push webhook payload. Only the attributes used by the server are mapped.
+*/
+type gitLabPushPayload struct {
+	// The full name of the pushed reference.
+	Ref string `json:"ref"`
+
+	// The SHA of the commit the reference points to after the push.
+	After string `json:"after"`
+
+	// The project the reference belongs to.
+	Project struct {
+		// The HTTP URL to clone the project from.
+		GitHTTPURL string `json:"git_http_url"`
+	} `json:"project"`
+}
+
+/*
+The server listening for push webhooks and running the release process for the matching branches.
+
+Since Nyx instances rely on global configuration state, releases are run one at a time even when
+webhooks arrive concurrently.
+*/
+type Server struct {
+	// The address the server listens on.
+	address string
+
+	// The regular expression matching the branches to run the release process for. When nil all branches match.
+	branches *regexp2.Regexp
+
+	// The command to run for each push.
+	command cmd.Commands
+
+	// The configuration used by the server.
+	configuration *cnf.Configuration
+
+	// The lock used to run one release at a time.
+	lock sync.Mutex
+
+	// The function running the release process for a push event. This is replaced in tests.
+	runner func(event pushEvent) error
+
+	// The secret used to verify webhook signatures.
+	secret string
+
+	// The manager of the temporary workspaces the repositories are cloned into.
+	workspaces *wks.Manager
+}
+
+/*
+Standard constructor.
+
+Arguments are as follows:
+
+- configuration the configuration to read the server options, the Git credentials and the templates scope from
+
+Errors can be:
+
+- DataAccessError in case the configuration can't be read.
+- IllegalPropertyError in case the server configuration has missing or illegal values.
+*/
+func NewServer(configuration *cnf.Configuration) (*Server, error) {
+	if configuration == nil {
+		return nil, &errs.NilPointerError{Message: fmt.Sprintf("nil pointer '%s'", "configuration")}
+	}
+	serverConfiguration, err := configuration.GetServer()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Server{configuration: configuration}
+	res.runner = res.release
+	res.workspaces, err = wks.NewManager("", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if serverConfiguration.GetAddress() == nil || "" == strings.TrimSpace(*serverConfiguration.GetAddress()) {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the server configuration requires the '%s' option", "address")}
+	}
+	res.address = *serverConfiguration.GetAddress()
+
+	if serverConfiguration.GetBranches() != nil && "" != strings.TrimSpace(*serverConfiguration.GetBranches()) {
+		res.branches, err = regexp2.Compile(*serverConfiguration.GetBranches(), 0)
+		if err != nil {
+			return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot compile regular expression '%s'", *serverConfiguration.GetBranches()), Cause: err}
+		}
+	}
+
+	commandName := ent.SERVER.GetCommand()
+	if serverConfiguration.GetCommand() != nil && "" != strings.TrimSpace(*serverConfiguration.GetCommand()) {
+		commandName = serverConfiguration.GetCommand()
+	}
+	res.command, err = cmd.ValueOfCommands(strings.ToUpper(strings.TrimSpace(*commandName)))
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal command '%s' in the server configuration", *commandName), Cause: err}
+	}
+
+	secret, err := res.renderTemplate(serverConfiguration.GetSecret())
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil || "" == strings.TrimSpace(*secret) {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("the server configuration requires the '%s' option in order to verify webhook signatures", "secret")}
+	}
+	res.secret = *secret
+
+	return res, nil
+}
+
+/*
+Renders the given template using a new State as the context.
+
+Arguments are as follows:
+
+- template the string template to render.
+
+Error is:
+- IllegalPropertyError in case the given template can't be rendered.
+*/
+func (s *Server) renderTemplate(template *string) (*string, error) {
+	if template == nil || "" == strings.TrimSpace(*template) {
+		return template, nil
+	}
+	state, err := stt.NewStateWith(s.configuration)
+	if err != nil {
+		return nil, err
+	}
+	flatState, err := state.Flatten()
+	if err != nil {
+		return nil, &errs.IllegalStateError{Message: fmt.Sprintf("the internal state cannot be flattened for rendering"), Cause: err}
+	}
+	res, err := tpl.Render(*template, flatState)
+	if err != nil {
+		return nil, &errs.IllegalPropertyError{Message: fmt.Sprintf("template '%s' cannot be rendered using the current state", *template), Cause: err}
+	}
+	return &res, nil
+}
+
+/*
+Starts listening on the configured address and serves webhooks until the server fails.
+
+Errors can be:
+
+- TransportError in case the server can't listen or it fails serving requests.
+*/
+func (s *Server) ListenAndServe() error {
+	log.Infof("listening for webhooks on '%s'", s.address)
+	err := http.ListenAndServe(s.address, s)
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("the server listening on '%s' has failed", s.address), Cause: err}
+	}
+	return nil
+}
+
+/*
+Handles a webhook request, verifying its signature and running the release process in background if the
+request brings a push event for a matching branch.
+
+This method responds with:
+
+- 202 (Accepted) when the release process has been started
+- 204 (No Content) when the event has been ignored because it's not a push to a matching branch
+- 400 (Bad Request) when the request can't be recognized or parsed
+- 401 (Unauthorized) when the signature verification fails
+- 405 (Method Not Allowed) when the request method is not POST
+*/
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST requests are accepted", http.StatusMethodNotAllowed)
+		return
+	}
+	payload, err := io.ReadAll(io.LimitReader(r.Body, MAX_PAYLOAD_SIZE))
+	if err != nil {
+		http.Error(w, "unable to read the request body", http.StatusBadRequest)
+		return
+	}
+
+	var event *pushEvent
+	if r.Header.Get(GITHUB_EVENT_HEADER) != "" {
+		if !s.verifyGitHubSignature(r.Header.Get(GITHUB_SIGNATURE_HEADER), payload) {
+			log.Warnf("rejecting GitHub webhook with invalid signature")
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(GITHUB_EVENT_HEADER) == GITHUB_PUSH_EVENT {
+			event, err = parseGitHubPushEvent(payload)
+		}
+	} else if r.Header.Get(GITLAB_EVENT_HEADER) != "" {
+		if !s.verifyGitLabToken(r.Header.Get(GITLAB_TOKEN_HEADER)) {
+			log.Warnf("rejecting GitLab webhook with invalid token")
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(GITLAB_EVENT_HEADER) == GITLAB_PUSH_EVENT {
+			event, err = parseGitLabPushEvent(payload)
+		}
+	} else {
+		http.Error(w, "unrecognized webhook", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if event == nil {
+		log.Debugf("ignoring webhook as it doesn't bring a push to a branch")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	matches, err := s.matchesBranch(event.branch)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !matches {
+		log.Debugf("ignoring push to branch '%s' as it doesn't match the configured branches", event.branch)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	log.Infof("running the release process for the push to branch '%s' of '%s'", event.branch, event.cloneURL)
+	go func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		err := s.runner(*event)
+		if err != nil {
+			log.Errorf("the release process for branch '%s' of '%s' has failed: %v", event.branch, event.cloneURL, err)
+		} else {
+			log.Infof("the release process for branch '%s' of '%s' has completed", event.branch, event.cloneURL)
+		}
+	}()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+/*
+Returns true if the given GitHub signature header value is the valid HMAC SHA-256 signature of the payload
+using the configured secret.
+
+Arguments are as follows:
+
+- signature the value of the signature header
+- payload the request body
+*/
+func (s *Server) verifyGitHubSignature(signature string, payload []byte) bool {
+	if !strings.HasPrefix(signature, GITHUB_SIGNATURE_PREFIX) {
+		return false
+	}
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, GITHUB_SIGNATURE_PREFIX))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(s.secret))
+	mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+/*
+Returns true if the given GitLab token header value matches the configured secret.
+
+Arguments are as follows:
+
+- token the value of the token header
+*/
+func (s *Server) verifyGitLabToken(token string) bool {
+	return token != "" && hmac.Equal([]byte(token), []byte(s.secret))
+}
+
+/*
+Returns true if the given branch matches the configured branches expression, or no expression is configured.
+
+Arguments are as follows:
+
+- branch the branch name
+
+Errors can be:
+
+- IllegalPropertyError in case the expression can't be evaluated.
+*/
+func (s *Server) matchesBranch(branch string) (bool, error) {
+	if s.branches == nil {
+		return true, nil
+	}
+	res, err := s.branches.MatchString(branch)
+	if err != nil {
+		return false, &errs.IllegalPropertyError{Message: fmt.Sprintf("cannot evaluate regular expression '%s' against '%s'", s.branches.String(), branch), Cause: err}
+	}
+	return res, nil
+}
+
+/*
+Parses the given GitHub push payload and returns the event, or nil if it doesn't bring a push to a branch
+(i.e. it's a tag push or a branch deletion).
+
+Errors can be:
+
+- IllegalArgumentError in case the payload can't be parsed.
+*/
+func parseGitHubPushEvent(payload []byte) (*pushEvent, error) {
+	var gitHubPayload gitHubPushPayload
+	err := json.Unmarshal(payload, &gitHubPayload)
+	if err != nil {
+		return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("unable to parse the GitHub push payload"), Cause: err}
+	}
+	if gitHubPayload.Deleted || !strings.HasPrefix(gitHubPayload.Ref, BRANCH_REFERENCE_PREFIX) {
+		return nil, nil
+	}
+	if "" == strings.TrimSpace(gitHubPayload.Repository.CloneURL) {
+		return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("the GitHub push payload has no repository clone URL")}
+	}
+	return &pushEvent{branch: strings.TrimPrefix(gitHubPayload.Ref, BRANCH_REFERENCE_PREFIX), cloneURL: gitHubPayload.Repository.CloneURL}, nil
+}
+
+/*
+Parses the given GitLab push payload and returns the event, or nil if it doesn't bring a push to a branch
+(i.e. it's a tag push or a branch deletion).
+
+Errors can be:
+
+- IllegalArgumentError in case the payload can't be parsed.
+*/
+func parseGitLabPushEvent(payload []byte) (*pushEvent, error) {
+	var gitLabPayload gitLabPushPayload
+	err := json.Unmarshal(payload, &gitLabPayload)
+	if err != nil {
+		return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("unable to parse the GitLab push payload"), Cause: err}
+	}
+	if gitLabPayload.After == ZERO_SHA || !strings.HasPrefix(gitLabPayload.Ref, BRANCH_REFERENCE_PREFIX) {
+		return nil, nil
+	}
+	if "" == strings.TrimSpace(gitLabPayload.Project.GitHTTPURL) {
+		return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("the GitLab push payload has no project clone URL")}
+	}
+	return &pushEvent{branch: strings.TrimPrefix(gitLabPayload.Ref, BRANCH_REFERENCE_PREFIX), cloneURL: gitLabPayload.Project.GitHTTPURL}, nil
+}
+
+/*
+Runs the release process for the given push event by cloning the repository into a temporary workspace,
+checking out the pushed branch and running the configured command in there.
+
+Workspaces are discarded after each release so every push is released from a fresh clone.
+
+Arguments are as follows:
+
+- event the push event to run the release process for
+
+Errors can be:
+
+- any error returned by cloning the repository or running the command
+*/
+func (s *Server) release(event pushEvent) error {
+	workspace, err := s.workspaces.Acquire(event.cloneURL+"#"+event.branch, func(directory string) error {
+		return s.clone(directory, event)
+	})
+	if err != nil {
+		return err
+	}
+	defer s.workspaces.Discard(workspace)
+
+	return nyx.NewNyxIn(workspace.GetDirectory()).Run(s.command)
+}
+
+/*
+Clones the repository and checks out the branch of the given push event into the given directory.
+
+Credentials used for cloning are those configured for the default remote in the Git configuration.
+
+Arguments are as follows:
+
+- directory the directory to clone the repository into
+- event the push event to clone the repository for
+
+Errors can be:
+
+- any error returned by cloning the repository
+*/
+func (s *Server) clone(directory string, event pushEvent) error {
+	var err error
+	var authenticationMethod *ent.AuthenticationMethod
+	var user *string
+	var password *string
+	var privateKey *string
+	var passphrase *string
+	var knownHosts *string
+	var appID *string
+	var installationID *string
+	remoteConfigured := false
+	strictHostKeyChecking := *ent.GIT_REMOTE_STRICT_HOST_KEY_CHECKING
+	gitConfiguration, err := s.configuration.GetGit()
+	if err != nil {
+		return err
+	}
+	if gitConfiguration != nil && gitConfiguration.GetRemotes() != nil {
+		gitRemoteConfiguration, ok := (*gitConfiguration.GetRemotes())[git.DEFAULT_REMOTE_NAME]
+		if ok {
+			log.Debugf("using configured credentials for remote '%s' to clone '%s'", git.DEFAULT_REMOTE_NAME, event.cloneURL)
+			remoteConfigured = true
+			authenticationMethod = gitRemoteConfiguration.GetAuthenticationMethod()
+			user, err = s.renderTemplate(gitRemoteConfiguration.GetUser())
+			if err != nil {
+				return err
+			}
+			password, err = s.renderTemplate(gitRemoteConfiguration.GetPassword())
+			if err != nil {
+				return err
+			}
+			privateKey, err = s.renderTemplate(gitRemoteConfiguration.GetPrivateKey())
+			if err != nil {
+				return err
+			}
+			passphrase, err = s.renderTemplate(gitRemoteConfiguration.GetPassphrase())
+			if err != nil {
+				return err
+			}
+			knownHosts, err = s.renderTemplate(gitRemoteConfiguration.GetKnownHosts())
+			if err != nil {
+				return err
+			}
+			if gitRemoteConfiguration.GetStrictHostKeyChecking() != nil {
+				strictHostKeyChecking = *gitRemoteConfiguration.GetStrictHostKeyChecking()
+			}
+			appID, err = s.renderTemplate(gitRemoteConfiguration.GetAppID())
+			if err != nil {
+				return err
+			}
+			installationID, err = s.renderTemplate(gitRemoteConfiguration.GetInstallationID())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	gitInstance, err := nyx.GitInstance(gitConfiguration)
+	if err != nil {
+		return err
+	}
+	// user names and passwords can't be used with SSH remotes so public keys are assumed when no method is set
+	if remoteConfigured && authenticationMethod == nil && user == nil && password == nil && git.GitInstance().IsSSHURI(event.cloneURL) {
+		log.Debugf("the clone URL '%s' uses the SSH protocol and no authentication method is configured so '%s' is assumed", event.cloneURL, ent.PUBLIC_KEY.String())
+		publicKey := ent.PUBLIC_KEY
+		authenticationMethod = &publicKey
+	}
+	if authenticationMethod != nil && ent.GITHUB_APP == *authenticationMethod {
+		if appID == nil || privateKey == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the App ID or the private key are not configured", git.DEFAULT_REMOTE_NAME, ent.GITHUB_APP.String())}
+		}
+		token, err := github.GetInstallationToken(nil, *appID, *privateKey, installationID)
+		if err != nil {
+			return err
+		}
+		tokenUser := github.INSTALLATION_TOKEN_USER
+		user = &tokenUser
+		password = &token
+	}
+	if authenticationMethod != nil && ent.PUBLIC_KEY == *authenticationMethod {
+		_, err = gitInstance.CloneBranchWithPublicKeyAndHostKeys(&directory, &event.cloneURL, &event.branch, privateKey, passphrase, knownHosts, strictHostKeyChecking)
+	} else if authenticationMethod != nil && ent.TOKEN == *authenticationMethod {
+		if password == nil {
+			return &errs.IllegalPropertyError{Message: fmt.Sprintf("the remote '%s' uses the '%s' authentication method but the token is not configured as the password", git.DEFAULT_REMOTE_NAME, ent.TOKEN.String())}
+		}
+		_, err = gitInstance.CloneBranchWithToken(&directory, &event.cloneURL, &event.branch, password, user)
+	} else {
+		_, err = gitInstance.CloneBranchWithUserNameAndPassword(&directory, &event.cloneURL, &event.branch, user, password)
+	}
+	return err
+}
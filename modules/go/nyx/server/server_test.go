@@ -0,0 +1,245 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"crypto/hmac"       // https://pkg.go.dev/crypto/hmac
+	"crypto/sha256"     // https://pkg.go.dev/crypto/sha256
+	"encoding/hex"      // https://pkg.go.dev/encoding/hex
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"strings"           // https://pkg.go.dev/strings
+	"testing"           // https://pkg.go.dev/testing
+	"time"              // https://pkg.go.dev/time
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
+	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+const (
+	// the GitHub push payload used for tests
+	gitHubPushPayloadSample = `{"ref":"refs/heads/main","deleted":false,"repository":{"clone_url":"https://github.com/octocat/hello.git"}}`
+
+	// the GitLab push payload used for tests
+	gitLabPushPayloadSample = `{"ref":"refs/heads/main","after":"e6b1c65eac4d81aadde22e796bb2a8e48da4c5d9","project":{"git_http_url":"https://gitlab.com/octocat/hello.git"}}`
+)
+
+/*
+Returns a new server configured with the given options, whose runner sends the received events to the returned channel.
+*/
+func newTestServer(t *testing.T, branches *string, command *string, secret *string) (*Server, chan pushEvent) {
+	configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+	configurationLayerMock.SetServer(ent.NewServerConfigurationWith(nil, branches, command, secret))
+	configuration, _ := cnf.NewConfiguration()
+	var cl cnf.ConfigurationLayer = configurationLayerMock
+	configuration.WithRuntimeConfiguration(&cl)
+
+	server, err := NewServer(configuration)
+	assert.NoError(t, err)
+
+	events := make(chan pushEvent, 1)
+	server.runner = func(event pushEvent) error {
+		events <- event
+		return nil
+	}
+	return server, events
+}
+
+func sign(secret string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return GITHUB_SIGNATURE_PREFIX + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestNewServer(t *testing.T) {
+	configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+	configuration, _ := cnf.NewConfiguration()
+	var cl cnf.ConfigurationLayer = configurationLayerMock
+	configuration.WithRuntimeConfiguration(&cl)
+
+	// the secret is required
+	_, err := NewServer(configuration)
+	assert.Error(t, err)
+
+	// the command must be valid
+	configurationLayerMock.SetServer(ent.NewServerConfigurationWith(nil, nil, utl.PointerToString("dosomething"), utl.PointerToString("s3cr3t")))
+	configuration.WithRuntimeConfiguration(&cl)
+	_, err = NewServer(configuration)
+	assert.Error(t, err)
+
+	// the branches expression must be valid
+	configurationLayerMock.SetServer(ent.NewServerConfigurationWith(nil, utl.PointerToString("(unclosed"), nil, utl.PointerToString("s3cr3t")))
+	configuration.WithRuntimeConfiguration(&cl)
+	_, err = NewServer(configuration)
+	assert.Error(t, err)
+
+	// defaults are used when options are not set
+	configurationLayerMock.SetServer(ent.NewServerConfigurationWith(nil, nil, nil, utl.PointerToString("s3cr3t")))
+	configuration.WithRuntimeConfiguration(&cl)
+	server, err := NewServer(configuration)
+	assert.NoError(t, err)
+	assert.Equal(t, *ent.SERVER.GetAddress(), server.address)
+	assert.Equal(t, cmd.PUBLISH, server.command)
+	assert.Nil(t, server.branches)
+	assert.Equal(t, "s3cr3t", server.secret)
+
+	// the command name is case insensitive
+	configurationLayerMock.SetServer(ent.NewServerConfigurationWith(utl.PointerToString(":9090"), nil, utl.PointerToString("mark"), utl.PointerToString("s3cr3t")))
+	configuration.WithRuntimeConfiguration(&cl)
+	server, err = NewServer(configuration)
+	assert.NoError(t, err)
+	assert.Equal(t, ":9090", server.address)
+	assert.Equal(t, cmd.MARK, server.command)
+}
+
+func TestServerServeHTTPWithGitHubWebhook(t *testing.T) {
+	server, events := newTestServer(t, utl.PointerToString("^main$"), nil, utl.PointerToString("s3cr3t"))
+
+	// a valid signature starts the release process
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(gitHubPushPayloadSample))
+	request.Header.Set(GITHUB_EVENT_HEADER, GITHUB_PUSH_EVENT)
+	request.Header.Set(GITHUB_SIGNATURE_HEADER, sign("s3cr3t", gitHubPushPayloadSample))
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	select {
+	case event := <-events:
+		assert.Equal(t, "main", event.branch)
+		assert.Equal(t, "https://github.com/octocat/hello.git", event.cloneURL)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "the release process has not been started")
+	}
+
+	// an invalid signature is rejected
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(gitHubPushPayloadSample))
+	request.Header.Set(GITHUB_EVENT_HEADER, GITHUB_PUSH_EVENT)
+	request.Header.Set(GITHUB_SIGNATURE_HEADER, sign("wrong", gitHubPushPayloadSample))
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	// a missing signature is rejected
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(gitHubPushPayloadSample))
+	request.Header.Set(GITHUB_EVENT_HEADER, GITHUB_PUSH_EVENT)
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	// events other than push are ignored
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+	request.Header.Set(GITHUB_EVENT_HEADER, "ping")
+	request.Header.Set(GITHUB_SIGNATURE_HEADER, sign("s3cr3t", `{}`))
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	// pushes to branches not matching the configured expression are ignored
+	payload := strings.Replace(gitHubPushPayloadSample, "refs/heads/main", "refs/heads/feature", 1)
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+	request.Header.Set(GITHUB_EVENT_HEADER, GITHUB_PUSH_EVENT)
+	request.Header.Set(GITHUB_SIGNATURE_HEADER, sign("s3cr3t", payload))
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	// tag pushes are ignored
+	payload = strings.Replace(gitHubPushPayloadSample, "refs/heads/main", "refs/tags/1.2.3", 1)
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+	request.Header.Set(GITHUB_EVENT_HEADER, GITHUB_PUSH_EVENT)
+	request.Header.Set(GITHUB_SIGNATURE_HEADER, sign("s3cr3t", payload))
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	// branch deletions are ignored
+	payload = strings.Replace(gitHubPushPayloadSample, `"deleted":false`, `"deleted":true`, 1)
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+	request.Header.Set(GITHUB_EVENT_HEADER, GITHUB_PUSH_EVENT)
+	request.Header.Set(GITHUB_SIGNATURE_HEADER, sign("s3cr3t", payload))
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestServerServeHTTPWithGitLabWebhook(t *testing.T) {
+	server, events := newTestServer(t, nil, nil, utl.PointerToString("s3cr3t"))
+
+	// a valid token starts the release process
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(gitLabPushPayloadSample))
+	request.Header.Set(GITLAB_EVENT_HEADER, GITLAB_PUSH_EVENT)
+	request.Header.Set(GITLAB_TOKEN_HEADER, "s3cr3t")
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	select {
+	case event := <-events:
+		assert.Equal(t, "main", event.branch)
+		assert.Equal(t, "https://gitlab.com/octocat/hello.git", event.cloneURL)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "the release process has not been started")
+	}
+
+	// an invalid token is rejected
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(gitLabPushPayloadSample))
+	request.Header.Set(GITLAB_EVENT_HEADER, GITLAB_PUSH_EVENT)
+	request.Header.Set(GITLAB_TOKEN_HEADER, "wrong")
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	// branch deletions are ignored
+	payload := strings.Replace(gitLabPushPayloadSample, "e6b1c65eac4d81aadde22e796bb2a8e48da4c5d9", ZERO_SHA, 1)
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+	request.Header.Set(GITLAB_EVENT_HEADER, GITLAB_PUSH_EVENT)
+	request.Header.Set(GITLAB_TOKEN_HEADER, "s3cr3t")
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestServerServeHTTPWithUnrecognizedRequests(t *testing.T) {
+	server, _ := newTestServer(t, nil, nil, utl.PointerToString("s3cr3t"))
+
+	// only POST is accepted
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	// requests not coming from known services are rejected
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(gitHubPushPayloadSample))
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// malformed payloads are rejected
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("not json"))
+	request.Header.Set(GITHUB_EVENT_HEADER, GITHUB_PUSH_EVENT)
+	request.Header.Set(GITHUB_SIGNATURE_HEADER, sign("s3cr3t", "not json"))
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+/*
+A service that supports the RELEASE_APPROVALS feature to check for approvals recorded on protected environments
+before releases are published.
+*/
+type ApprovalService interface {
+	/*
+		Returns the status of the approval for the given environment. The approval is looked up in the context
+		that is specific to the service implementation (i.e. the current pipeline), please check the implementation
+		class for more details.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner to get the approval for. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository to get the approval for. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- environment the name of the protected environment the approval is required for. It can't be nil
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails
+		- UnsupportedOperationError if the underlying implementation does not support the RELEASE_APPROVALS feature.
+	*/
+	GetApprovalStatus(owner *string, repository *string, environment string) (ApprovalStatus, error)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"fmt" // https://pkg.go.dev/fmt
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+These are the constants representing the status of an approval recorded on a service.
+*/
+type ApprovalStatus string
+
+const (
+	// The approval has not been granted nor rejected yet.
+	PENDING ApprovalStatus = "PENDING"
+
+	// The approval has been granted.
+	APPROVED ApprovalStatus = "APPROVED"
+
+	// The approval has been rejected.
+	REJECTED ApprovalStatus = "REJECTED"
+)
+
+/*
+Returns the string representation of the approval status
+*/
+func (as ApprovalStatus) String() string {
+	switch as {
+	case PENDING:
+		return "PENDING"
+	case APPROVED:
+		return "APPROVED"
+	case REJECTED:
+		return "REJECTED"
+	default:
+		// this is never reached, but in case...
+		panic("unknown ApprovalStatus. This means the switch/case statement needs to be updated")
+	}
+}
+
+/*
+Returns the approval status corresponding to the given string.
+
+Errors can be:
+
+- IllegalPropertyError in case an unknown approval status is passed
+*/
+func ValueOfApprovalStatus(s string) (ApprovalStatus, error) {
+	switch s {
+	case "PENDING":
+		return PENDING, nil
+	case "APPROVED":
+		return APPROVED, nil
+	case "REJECTED":
+		return REJECTED, nil
+	default:
+		return PENDING, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal approval status '%s'", s)}
+	}
+}
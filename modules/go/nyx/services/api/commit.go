@@ -0,0 +1,68 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+/*
+A commit in a remote repository. These entities are managed through services implementing the
+CommitHistoryService interface and supporting the COMMIT_HISTORY feature.
+*/
+type Commit interface {
+	/*
+		Returns the email of the commit author.
+	*/
+	GetAuthorEmail() string
+
+	/*
+		Returns the name of the commit author.
+	*/
+	GetAuthorName() string
+
+	/*
+		Returns the date the commit was authored, in milliseconds since the epoch.
+	*/
+	GetAuthorDate() int64
+
+	/*
+		Returns the email of the committer.
+	*/
+	GetCommitterEmail() string
+
+	/*
+		Returns the name of the committer.
+	*/
+	GetCommitterName() string
+
+	/*
+		Returns the date the commit was committed, in milliseconds since the epoch.
+	*/
+	GetCommitterDate() int64
+
+	/*
+		Returns the full commit message.
+	*/
+	GetMessage() string
+
+	/*
+		Returns the SHA-1 identifiers of the parent commits, or an empty list if the commit has no parents.
+	*/
+	GetParents() []string
+
+	/*
+		Returns the commit SHA-1 identifier.
+	*/
+	GetSHA() string
+}
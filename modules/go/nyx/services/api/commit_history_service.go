@@ -0,0 +1,108 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+/*
+A service that supports the COMMIT_HISTORY feature to inspect the commits and tags of a repository through
+the service APIs, without a local clone.
+*/
+type CommitHistoryService interface {
+	/*
+		Returns the paths of the files changed by the commit with the given SHA-1, compared to its first parent.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner to read the commit from. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository to read the commit from. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- commit the SHA-1 of the commit to get the changed paths for
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails or the commit can't be found
+		- UnsupportedOperationError if the underlying implementation does not support the COMMIT_HISTORY feature.
+	*/
+	GetCommitChangedPaths(owner *string, repository *string, commit string) ([]string, error)
+
+	/*
+		Returns the name of the default branch of a repository.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails
+		- UnsupportedOperationError if the underlying implementation does not support the COMMIT_HISTORY feature.
+	*/
+	GetDefaultBranch(owner *string, repository *string) (string, error)
+
+	/*
+		Returns the tags of a repository, mapped by name to the SHA-1 of the commits they point to.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner to read the tags from. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository to read the tags from. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails
+		- UnsupportedOperationError if the underlying implementation does not support the COMMIT_HISTORY feature.
+	*/
+	GetTags(owner *string, repository *string) (map[string]string, error)
+
+	/*
+		Browses the commits reachable from the given reference, from the most recent to the oldest, passing each one
+		to the given visitor. Commits are fetched one page at a time so that no more pages than needed are requested
+		when the visitor stops the walk.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner to read the commits from. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository to read the commits from. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- ref the branch name or commit SHA-1 to start from
+		- visit the visitor function receiving each commit. Returns true to keep browsing next commits or false to stop.
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails or the reference can't be found
+		- UnsupportedOperationError if the underlying implementation does not support the COMMIT_HISTORY feature.
+	*/
+	WalkCommits(owner *string, repository *string, ref string, visit func(commit Commit) bool) error
+}
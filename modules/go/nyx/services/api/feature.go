@@ -34,6 +34,11 @@ the Supports method.
 type Feature string
 
 const (
+	// When this feature is supported then the implementation class implements the CommitHistoryService interface
+	// (so it can be safely cast to it) and the service specific methods can be safely invoked without an
+	// UnsupportedOperationError being thrown.
+	COMMIT_HISTORY Feature = "COMMIT_HISTORY"
+
 	// When this feature is supported then the implementation class implements the GitHostingService interface
 	// (so it can be safely cast to it) and the service specific methods can be safely invoked without an
 	// UnsupportedOperationError being thrown.
@@ -49,10 +54,40 @@ const (
 	// without errors. See for more details on the supported assets on the service implementation class.
 	RELEASE_ASSETS Feature = "RELEASE_ASSETS"
 
+	// When this feature is supported then the implementation class implements the PullRequestCommentService interface
+	// (so it can be safely cast to it) and the service specific methods can be safely invoked without an
+	// UnsupportedOperationError being thrown.
+	PULL_REQUEST_COMMENTS Feature = "PULL_REQUEST_COMMENTS"
+
+	// When this feature is supported then the implementation class implements the PullRequestService interface
+	// (so it can be safely cast to it) and the service specific methods can be safely invoked without an
+	// UnsupportedOperationError being thrown.
+	PULL_REQUESTS Feature = "PULL_REQUESTS"
+
+	// When this feature is supported then the implementation class implements the ApprovalService interface
+	// (so it can be safely cast to it) and the service specific methods can be safely invoked without an
+	// UnsupportedOperationError being thrown.
+	RELEASE_APPROVALS Feature = "RELEASE_APPROVALS"
+
+	// When this feature is supported then the implementation class implements the YankService interface
+	// (so it can be safely cast to it) and the service specific methods can be safely invoked without an
+	// UnsupportedOperationError being thrown.
+	RELEASE_YANKING Feature = "RELEASE_YANKING"
+
+	// When this feature is supported then the implementation class implements the RetentionService interface
+	// (so it can be safely cast to it) and the service specific methods can be safely invoked without an
+	// UnsupportedOperationError being thrown.
+	RELEASE_RETENTION Feature = "RELEASE_RETENTION"
+
 	// When this feature is supported then the implementation class implements the UserService interface
 	// (so it can be safely cast to it) and the service specific methods can be safely invoked without an
 	// UnsupportedOperationError being thrown.
 	USERS Feature = "USERS"
+
+	// When this feature is supported then the implementation class implements the VersionStorageService interface
+	// (so it can be safely cast to it) and the service specific methods can be safely invoked without an
+	// UnsupportedOperationError being thrown.
+	VERSION_STORAGE Feature = "VERSION_STORAGE"
 )
 
 /*
@@ -60,14 +95,28 @@ Returns the string representation of the feature
 */
 func (f Feature) String() string {
 	switch f {
+	case COMMIT_HISTORY:
+		return "COMMIT_HISTORY"
 	case GIT_HOSTING:
 		return "GIT_HOSTING"
+	case PULL_REQUEST_COMMENTS:
+		return "PULL_REQUEST_COMMENTS"
+	case PULL_REQUESTS:
+		return "PULL_REQUESTS"
 	case RELEASES:
 		return "RELEASES"
 	case RELEASE_ASSETS:
 		return "RELEASE_ASSETS"
+	case RELEASE_APPROVALS:
+		return "RELEASE_APPROVALS"
+	case RELEASE_YANKING:
+		return "RELEASE_YANKING"
+	case RELEASE_RETENTION:
+		return "RELEASE_RETENTION"
 	case USERS:
 		return "USERS"
+	case VERSION_STORAGE:
+		return "VERSION_STORAGE"
 	default:
 		// this is never reached, but in case...
 		panic("unknown Feature. This means the switch/case statement needs to be updated")
@@ -83,14 +132,28 @@ Errors can be:
 */
 func ValueOfFeature(s string) (Feature, error) {
 	switch s {
+	case "COMMIT_HISTORY":
+		return COMMIT_HISTORY, nil
 	case "GIT_HOSTING":
 		return GIT_HOSTING, nil
+	case "PULL_REQUEST_COMMENTS":
+		return PULL_REQUEST_COMMENTS, nil
+	case "PULL_REQUESTS":
+		return PULL_REQUESTS, nil
 	case "RELEASES":
 		return RELEASES, nil
 	case "RELEASE_ASSETS":
 		return RELEASE_ASSETS, nil
+	case "RELEASE_APPROVALS":
+		return RELEASE_APPROVALS, nil
+	case "RELEASE_YANKING":
+		return RELEASE_YANKING, nil
+	case "RELEASE_RETENTION":
+		return RELEASE_RETENTION, nil
 	case "USERS":
 		return USERS, nil
+	case "VERSION_STORAGE":
+		return VERSION_STORAGE, nil
 	default:
 		return USERS, &errs.IllegalPropertyError{Message: fmt.Sprintf("illegal workspace status '%s'", s)}
 	}
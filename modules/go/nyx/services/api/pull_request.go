@@ -0,0 +1,38 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+/*
+A pull request (or merge request). These entities are managed through services implementing the
+PullRequestService interface and supporting the PULL_REQUESTS feature.
+*/
+type PullRequest interface {
+	/*
+		Returns the names of the labels applied to the pull request, or an empty list if the pull request has no labels.
+	*/
+	GetLabels() []string
+
+	/*
+		Returns the pull request number.
+	*/
+	GetNumber() int
+
+	/*
+		Returns the pull request title.
+	*/
+	GetTitle() string
+}
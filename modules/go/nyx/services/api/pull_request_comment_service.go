@@ -0,0 +1,53 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+/*
+A service that supports the PULL_REQUEST_COMMENTS feature to publish comments on pull requests (or merge requests).
+*/
+type PullRequestCommentService interface {
+	/*
+		Publishes a sticky comment on the given pull request. Comments are identified by the given marker so that
+		if a comment containing the marker has already been published on the pull request it is updated with the
+		new contents, otherwise a new comment is created. This way subsequent invocations do not flood the pull
+		request with duplicate comments.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner the pull request belongs to. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository the pull request belongs to. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- pullRequest the number of the pull request to comment. It may be nil, in which case the pull request
+		  is detected from the service options or the environment (see services implementing this interface for more
+		  details). If not nil this value overrides the option passed to the service.
+		- marker the string that identifies the comment among other comments on the pull request. The marker is
+		  expected to be part of the comment body and should not be visible when the body is rendered
+		  (i.e. an HTML comment)
+		- body the body of the comment, including the marker
+
+		Errors can be:
+
+		- IllegalStateError if the pull request can't be determined
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails
+		- UnsupportedOperationError if the underlying implementation does not support the PULL_REQUEST_COMMENTS feature.
+	*/
+	PublishPullRequestComment(owner *string, repository *string, pullRequest *string, marker string, body string) error
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+/*
+A service that supports the PULL_REQUESTS feature to open pull requests (or merge requests) updating files in a repository.
+*/
+type PullRequestService interface {
+	/*
+		Returns the contents of the file with the given path in the given branch of a repository.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner to read the file from. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository to read the file from. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- branch the name of the branch to read the file from. It may be nil, in which case the repository
+		  default branch is used.
+		- path the path of the file within the repository
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails or the file can't be found
+		- UnsupportedOperationError if the underlying implementation does not support the PULL_REQUESTS feature.
+	*/
+	GetFileContent(owner *string, repository *string, branch *string, path string) (string, error)
+
+	/*
+		Returns the merged pull request that brought the commit with the given SHA-1 into the repository, or nil
+		if the commit has not been merged by any pull request. When the commit belongs to more than one merged pull
+		request the first one returned by the remote service is used.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner to look up the pull request in. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository to look up the pull request in. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- commit the SHA-1 of the commit to look up the pull request for
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails
+		- UnsupportedOperationError if the underlying implementation does not support the PULL_REQUESTS feature.
+	*/
+	GetMergedPullRequest(owner *string, repository *string, commit string) (*PullRequest, error)
+
+	/*
+		Commits the given contents to the file with the given path on the head branch and opens a pull request
+		to merge the head branch into the base branch. The head branch is created from the base branch and, if it
+		already exists, it's reset to the base branch so that subsequent invocations do not pile up changes. If a
+		pull request for the head branch is already open it's reused instead of opening a new one.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner to open the pull request in. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository to open the pull request in. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- base the name of the branch the pull request targets. It may be nil, in which case the repository
+		  default branch is used.
+		- head the name of the branch to commit the change to and open the pull request from
+		- path the path of the file to update within the repository
+		- content the new contents of the file
+		- title the title of the pull request, also used as the commit message
+		- body the description of the pull request
+
+		Returns the URL of the pull request.
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails
+		- UnsupportedOperationError if the underlying implementation does not support the PULL_REQUESTS feature.
+	*/
+	OpenFileUpdatePullRequest(owner *string, repository *string, base *string, head string, path string, content string, title string, body string) (string, error)
+}
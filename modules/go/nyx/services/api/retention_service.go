@@ -0,0 +1,126 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strconv" // https://pkg.go.dev/strconv
+	"strings" // https://pkg.go.dev/strings
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+The retention policy applied to the assets of published releases by services supporting the RELEASE_RETENTION
+feature. The zero value keeps all the assets.
+*/
+type RetentionPolicy struct {
+	// The number of most recent releases whose assets are kept. Assets attached to older releases are removed.
+	// 0 or negative means the assets of all releases are kept.
+	KeepAssets int
+
+	// The maximum size (in bytes) of the assets attached to pre-releases. Larger assets are removed from
+	// pre-releases while final releases are not affected. 0 or negative means no limit.
+	MaxPreReleaseAssetSize int64
+}
+
+/*
+Returns the retention policy read from the given map of options. Missing options leave the corresponding rule disabled.
+
+Arguments are as follows:
+
+  - options the map of options to read from. It may be nil
+  - keepAssetsOption the name of the option holding the number of most recent releases whose assets are kept
+  - maxPreReleaseAssetSizeOption the name of the option holding the maximum size of pre-release assets, in bytes
+
+Errors can be:
+
+- IllegalArgumentError: in case some option is not a valid integer.
+*/
+func ParseRetentionPolicy(options map[string]string, keepAssetsOption string, maxPreReleaseAssetSizeOption string) (RetentionPolicy, error) {
+	res := RetentionPolicy{}
+	if value, ok := options[keepAssetsOption]; ok && "" != strings.TrimSpace(value) {
+		keepAssets, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return res, &errs.IllegalArgumentError{Message: fmt.Sprintf("the value '%s' of option '%s' is not a valid number of releases", value, keepAssetsOption), Cause: err}
+		}
+		res.KeepAssets = keepAssets
+	}
+	if value, ok := options[maxPreReleaseAssetSizeOption]; ok && "" != strings.TrimSpace(value) {
+		maxPreReleaseAssetSize, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return res, &errs.IllegalArgumentError{Message: fmt.Sprintf("the value '%s' of option '%s' is not a valid number of bytes", value, maxPreReleaseAssetSizeOption), Cause: err}
+		}
+		res.MaxPreReleaseAssetSize = maxPreReleaseAssetSize
+	}
+	return res, nil
+}
+
+/*
+Returns true if at least one of the retention rules is enabled.
+*/
+func (p RetentionPolicy) IsEnabled() bool {
+	return p.KeepAssets > 0 || p.MaxPreReleaseAssetSize > 0
+}
+
+/*
+Returns true if the policy requires the asset with the given features to be removed.
+
+Arguments are as follows:
+
+  - position the position of the release the asset is attached to, among the published releases sorted from the
+    most recent (0) to the oldest
+  - preRelease true if the release the asset is attached to is a pre-release
+  - size the size of the asset, in bytes
+*/
+func (p RetentionPolicy) Expires(position int, preRelease bool, size int64) bool {
+	if p.KeepAssets > 0 && position >= p.KeepAssets {
+		return true
+	}
+	return preRelease && p.MaxPreReleaseAssetSize > 0 && size > p.MaxPreReleaseAssetSize
+}
+
+/*
+A service that supports the RELEASE_RETENTION feature to remove the assets of published releases according to the
+RetentionPolicy configured for the service, keeping storage quotas under control. Releases are never deleted, only
+their assets.
+*/
+type RetentionService interface {
+	/*
+		Removes the release assets exceeding the retention policy configured for the service. How the policy is
+		configured depends on the service implementation, please check the implementation class for more details.
+		When no policy is configured no asset is removed.
+
+		Returns the removed assets, each in the '<tag>/<asset name>' form.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner to enforce the retention for. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository to enforce the retention for. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails
+		- UnsupportedOperationError if the underlying implementation does not support the RELEASE_RETENTION feature.
+	*/
+	EnforceRetention(owner *string, repository *string) ([]string, error)
+}
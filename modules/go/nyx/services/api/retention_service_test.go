@@ -0,0 +1,72 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+func TestParseRetentionPolicy(t *testing.T) {
+	policy, err := ParseRetentionPolicy(nil, "KEEP", "MAX")
+	assert.NoError(t, err)
+	assert.Equal(t, RetentionPolicy{}, policy)
+	assert.False(t, policy.IsEnabled())
+
+	policy, err = ParseRetentionPolicy(map[string]string{"KEEP": "5", "MAX": " 1024 "}, "KEEP", "MAX")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, policy.KeepAssets)
+	assert.Equal(t, int64(1024), policy.MaxPreReleaseAssetSize)
+	assert.True(t, policy.IsEnabled())
+
+	_, err = ParseRetentionPolicy(map[string]string{"KEEP": "many"}, "KEEP", "MAX")
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IllegalArgumentError{}, err)
+
+	_, err = ParseRetentionPolicy(map[string]string{"MAX": "1MB"}, "KEEP", "MAX")
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IllegalArgumentError{}, err)
+}
+
+func TestRetentionPolicyExpires(t *testing.T) {
+	// the zero value keeps everything
+	assert.False(t, RetentionPolicy{}.Expires(100, true, 1000000))
+
+	policy := RetentionPolicy{KeepAssets: 2}
+	assert.False(t, policy.Expires(0, false, 1000))
+	assert.False(t, policy.Expires(1, true, 1000))
+	assert.True(t, policy.Expires(2, false, 1000))
+	assert.True(t, policy.Expires(3, true, 1000))
+
+	policy = RetentionPolicy{MaxPreReleaseAssetSize: 100}
+	assert.False(t, policy.Expires(5, false, 1000))
+	assert.False(t, policy.Expires(5, true, 100))
+	assert.True(t, policy.Expires(0, true, 101))
+
+	policy = RetentionPolicy{KeepAssets: 1, MaxPreReleaseAssetSize: 100}
+	assert.False(t, policy.Expires(0, false, 1000))
+	assert.True(t, policy.Expires(0, true, 1000))
+	assert.True(t, policy.Expires(1, false, 10))
+}
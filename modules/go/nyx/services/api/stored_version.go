@@ -0,0 +1,32 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+/*
+A version recorded by services implementing the VersionStorageService interface and supporting the VERSION_STORAGE feature.
+*/
+type StoredVersion interface {
+	/*
+		Returns the SHA-1 identifier of the commit the version has been released from.
+	*/
+	GetCommit() string
+
+	/*
+		Returns the version, as it would be used for the tag name (i.e. 1.2.3, v4.5.6).
+	*/
+	GetVersion() string
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+/*
+A service that supports the VERSION_STORAGE feature to record released versions somewhere else than (or in addition to)
+Git tags, like a file in a bucket or a deployment system. Stored versions are used as the source of previous versions
+when inferring a new release and new versions are stored when they are released.
+*/
+type VersionStorageService interface {
+	/*
+		Returns the versions stored by the service, in no particular order. When no version has been stored yet an empty
+		slice is returned.
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails
+		- TransportError if communication to the remote endpoint fails
+		- UnsupportedOperationError if the underlying implementation does not support the VERSION_STORAGE feature.
+	*/
+	GetStoredVersions() ([]StoredVersion, error)
+
+	/*
+		Stores the given version as released from the given commit. When the version has already been stored its commit
+		is updated.
+
+		Arguments are as follows:
+
+		- version the version to store (i.e. 1.2.3, v4.5.6). It can't be empty
+		- commit the SHA-1 identifier of the commit the version has been released from. It can't be empty
+
+		Errors can be:
+
+		- IllegalArgumentError if the version or the commit are empty
+		- SecurityError if authentication or authorization fails
+		- TransportError if communication to the remote endpoint fails
+		- UnsupportedOperationError if the underlying implementation does not support the VERSION_STORAGE feature.
+	*/
+	StoreVersion(version string, commit string) (StoredVersion, error)
+}
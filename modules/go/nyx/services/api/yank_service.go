@@ -0,0 +1,54 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+/*
+The prefix added to the title of yanked releases.
+*/
+const YANKED_RELEASE_TITLE_PREFIX = "[YANKED] "
+
+/*
+A service that supports the RELEASE_YANKING feature to mark published releases as yanked. Yanked releases are not
+deleted so their history is preserved, but they're flagged so that users know they must not be used.
+*/
+type YankService interface {
+	/*
+		Marks the release with the given tag as yanked. How a release is marked depends on the service implementation,
+		please check the implementation class for more details. Releases that are already marked as yanked are left
+		untouched.
+
+		Returns true if the release has been marked as yanked by this invocation, false if there is no release with the
+		given tag or it was already marked as yanked.
+
+		Arguments are as follows:
+
+		- owner the name of the repository owner to yank the release for. It may be nil, in which case,
+		  the repository owner must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- repository the name of the repository to yank the release for. It may be nil, in which case,
+		  the repository name must be passed as a service option (see services implementing this interface for more
+		  details on the options they accept). If not nil this value overrides the option passed to the service.
+		- tag the tag the release refers to (i.e. 1.2.3, v4.5.6). It can't be nil
+
+		Errors can be:
+
+		- SecurityError if authentication or authorization fails or there is no currently authenticated user
+		- TransportError if communication to the remote endpoint fails
+		- UnsupportedOperationError if the underlying implementation does not support the RELEASE_YANKING feature.
+	*/
+	YankRelease(owner *string, repository *string, tag string) (bool, error)
+}
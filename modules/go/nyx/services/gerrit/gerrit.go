@@ -0,0 +1,705 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This is the Gerrit package for Nyx, providing services to work with Gerrit Code Review
+(https://www.gerritcodereview.com/) through its REST API.
+*/
+package gerrit
+
+import (
+	"bytes"           // https://pkg.go.dev/bytes
+	"encoding/base64" // https://pkg.go.dev/encoding/base64
+	"encoding/json"   // https://pkg.go.dev/encoding/json
+	"fmt"             // https://pkg.go.dev/fmt
+	"io"              // https://pkg.go.dev/io
+	"net/http"        // https://pkg.go.dev/net/http
+	"net/url"         // https://pkg.go.dev/net/url
+	"strings"         // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://github.com/Sirupsen/logrus, https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+const (
+	/*
+		The name of the option used to pass the base URI of the Gerrit server to this object instance (i.e.
+		https://gerrit.example.com). This is the value of the key inside the options passed to get a new instance of
+		this class. This option is mandatory.
+	*/
+	BASE_URI_OPTION_NAME = "BASE_URI"
+
+	/*
+		The name of the option used to pass the user name to authenticate with to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed requests are anonymous, so only public data can be read.
+	*/
+	AUTHENTICATION_USER_OPTION_NAME = "AUTHENTICATION_USER"
+
+	/*
+		The name of the option used to pass the HTTP password (generated in the user settings) to authenticate with
+		to this object instance. This is the value of the key inside the options passed to get a new instance of this
+		class. This option is only used along with AUTHENTICATION_USER_OPTION_NAME.
+	*/
+	AUTHENTICATION_PASSWORD_OPTION_NAME = "AUTHENTICATION_PASSWORD"
+
+	/*
+		The name of the option used to pass the commit SHA tags are created for when publishing releases.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed tags are created for the project HEAD.
+	*/
+	COMMIT_SHA_OPTION_NAME = "COMMIT_SHA"
+
+	/*
+		The name of the option used to pass the name of the Gerrit project to this object instance.
+		If the project is platform/build, the value to pass for this option is 'build', along with the
+		REPOSITORY_OWNER_OPTION_NAME option set to 'platform', or 'platform/build' with no owner.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the service will not be able to perform some of its operations.
+	*/
+	REPOSITORY_NAME_OPTION_NAME = "REPOSITORY_NAME"
+
+	/*
+		The name of the option used to pass the parent path of the Gerrit project to this object instance.
+		If the project is platform/build, the value to pass for this option is 'platform'.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is only needed for projects nested in other paths and may be omitted by passing the
+		whole path as the REPOSITORY_NAME_OPTION_NAME.
+	*/
+	REPOSITORY_OWNER_OPTION_NAME = "REPOSITORY_OWNER"
+
+	// The prefix Gerrit adds to JSON responses to prevent cross site script inclusion.
+	XSSI_PREFIX = ")]}'"
+
+	// The footer Gerrit uses to track changes across patch sets.
+	CHANGE_ID_FOOTER = "Change-Id: "
+)
+
+/*
+The entry point to the Gerrit remote service.
+*/
+type Gerrit struct {
+	// The base URI of the server, without the trailing slash.
+	baseURI string
+
+	// The user name to authenticate with. It may be nil, in which case requests are anonymous.
+	user *string
+
+	// The HTTP password to authenticate with. It may be nil.
+	password *string
+
+	// The commit SHA tags are created for. It may be nil.
+	commitSHA *string
+
+	// The project name. It may be nil.
+	repositoryName *string
+
+	// The project parent path. It may be nil.
+	repositoryOwner *string
+
+	// The private HTTP client instance.
+	client *http.Client
+}
+
+/*
+The subset of the Gerrit change attributes used by this service.
+*/
+type changeInfo struct {
+	// The change ID footer value, like I8473b95934b5732ac55d26311a706c9c2bde9940.
+	ChangeID string `json:"change_id"`
+
+	// The numeric change number.
+	Number int `json:"_number"`
+
+	// The change subject (the first line of the commit message).
+	Subject string `json:"subject"`
+
+	// The hashtags applied to the change.
+	Hashtags []string `json:"hashtags"`
+}
+
+/*
+The subset of the Gerrit tag attributes used by this service.
+*/
+type tagInfo struct {
+	// The full tag reference, like refs/tags/1.2.3.
+	Ref string `json:"ref"`
+
+	// The SHA of the commit the tag points to.
+	Revision string `json:"revision"`
+
+	// The annotated tag message. It's empty for lightweight tags.
+	Message string `json:"message"`
+}
+
+/*
+Returns the value of the given option, or nil if the option is not present or is blank.
+
+Arguments are as follows:
+
+- options the map of options to read from
+- name the name of the option to read
+*/
+func optionalOption(options map[string]string, name string) *string {
+	value, ok := options[name]
+	if !ok || "" == strings.TrimSpace(value) {
+		return nil
+	}
+	value = strings.TrimSpace(value)
+	return &value
+}
+
+/*
+Returns an instance using the given options.
+
+Arguments are as follows:
+
+  - options the map of options for the requested service. It can't be nil.
+    Valid options are documented as constants on this class.
+
+Errors can be:
+
+- NilPointerError if the given options map is nil
+- IllegalArgumentError if some entries in the given options map are missing or illegal for some reason
+*/
+func Instance(options map[string]string) (Gerrit, error) {
+	if options == nil {
+		return Gerrit{}, &errs.NilPointerError{Message: fmt.Sprintf("can't create a new instance with a null options map")}
+	}
+
+	res := Gerrit{}
+	baseURI := optionalOption(options, BASE_URI_OPTION_NAME)
+	if baseURI == nil {
+		return Gerrit{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("the '%s' option is mandatory for the '%s' service", BASE_URI_OPTION_NAME, "Gerrit")}
+	}
+	res.baseURI = strings.TrimRight(*baseURI, "/")
+	res.user = optionalOption(options, AUTHENTICATION_USER_OPTION_NAME)
+	res.password = optionalOption(options, AUTHENTICATION_PASSWORD_OPTION_NAME)
+	if res.user == nil {
+		log.Debugf("no user passed to the '%s' service, requests will be anonymous and only public data will be available. Use the '%s' option to authenticate", "Gerrit", AUTHENTICATION_USER_OPTION_NAME)
+	}
+	res.commitSHA = optionalOption(options, COMMIT_SHA_OPTION_NAME)
+	res.repositoryName = optionalOption(options, REPOSITORY_NAME_OPTION_NAME)
+	res.repositoryOwner = optionalOption(options, REPOSITORY_OWNER_OPTION_NAME)
+
+	log.Tracef("instantiating new Gerrit service for server '%s'", res.baseURI)
+	res.client = &http.Client{}
+
+	return res, nil
+}
+
+/*
+Returns the project name to use for a request, built from the repository owner and name, giving priority to the given
+arguments, if not nil, over the ones passed as service options. The owner is optional so when it's not available
+the project name is just the repository name.
+*/
+func (s Gerrit) resolveProject(owner *string, repository *string) string {
+	requestRepository := ""
+	if repository != nil {
+		requestRepository = *repository
+	} else if s.repositoryName != nil {
+		requestRepository = *s.repositoryName
+	} else {
+		log.Warnf("the repository name was not passed as a service option nor overridden as an argument, the request may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_NAME_OPTION_NAME)
+	}
+	requestOwner := ""
+	if owner != nil {
+		requestOwner = *owner
+	} else if s.repositoryOwner != nil {
+		requestOwner = *s.repositoryOwner
+	}
+	if "" == requestOwner {
+		return requestRepository
+	}
+	return requestOwner + "/" + requestRepository
+}
+
+/*
+Returns the URL of the given REST API endpoint. When credentials are available the authenticated
+endpoint (prefixed by '/a') is used.
+
+Arguments are as follows:
+
+  - path the path of the endpoint, starting with a slash, whose parameters must already be escaped
+*/
+func (s Gerrit) endpointURL(path string) string {
+	if s.user != nil {
+		return s.baseURI + "/a" + path
+	}
+	return s.baseURI + path
+}
+
+/*
+Returns the URL of the web page of the given change.
+
+Arguments are as follows:
+
+  - project the name of the project the change belongs to
+  - number the change number
+*/
+func (s Gerrit) changeURL(project string, number int) string {
+	return fmt.Sprintf("%s/c/%s/+/%d", s.baseURI, project, number)
+}
+
+/*
+Returns the identifier of the given change to use in REST API endpoints, already escaped.
+
+Arguments are as follows:
+
+  - project the name of the project the change belongs to
+  - number the change number
+*/
+func changeIdentifier(project string, number int) string {
+	return fmt.Sprintf("%s~%d", url.PathEscape(project), number)
+}
+
+/*
+Sends a request to the given endpoint and returns the response body, along with the response status code.
+When the response is JSON the prefix Gerrit uses to prevent cross site script inclusion is removed.
+
+Arguments are as follows:
+
+  - method the HTTP method of the request
+  - path the path of the endpoint, starting with a slash, whose parameters must already be escaped
+  - payload the request body. When it's a string or a byte slice it's sent as it is, otherwise it's sent as JSON.
+    It may be nil
+  - accepted the status codes, other than the successful ones, that must be returned to the caller instead of
+    being treated as errors (i.e. http.StatusNotFound)
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails or the server returns an unexpected status
+*/
+func (s Gerrit) send(method string, path string, payload interface{}, accepted ...int) ([]byte, int, error) {
+	var reader io.Reader = nil
+	contentType := ""
+	switch p := payload.(type) {
+	case nil:
+	case string:
+		reader = strings.NewReader(p)
+		contentType = "application/octet-stream"
+	case []byte:
+		reader = bytes.NewReader(p)
+		contentType = "application/octet-stream"
+	default:
+		content, err := json.Marshal(p)
+		if err != nil {
+			return nil, 0, &errs.TransportError{Message: fmt.Sprintf("unable to build the '%s' request to '%s'", method, path), Cause: err}
+		}
+		reader = bytes.NewReader(content)
+		contentType = "application/json"
+	}
+	request, err := http.NewRequest(method, s.endpointURL(path), reader)
+	if err != nil {
+		return nil, 0, &errs.TransportError{Message: fmt.Sprintf("unable to build the '%s' request to '%s'", method, path), Cause: err}
+	}
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	request.Header.Set("Accept", "application/json")
+	if s.user != nil {
+		password := ""
+		if s.password != nil {
+			password = *s.password
+		}
+		request.SetBasicAuth(*s.user, password)
+	}
+
+	log.Tracef("sending '%s' request to '%s'", request.Method, request.URL.String())
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, 0, &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed", request.Method, request.URL.String()), Cause: err}
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, response.StatusCode, &errs.TransportError{Message: fmt.Sprintf("unable to read the response to '%s' request to '%s'", request.Method, request.URL.String()), Cause: err}
+	}
+	body = bytes.TrimPrefix(body, []byte(XSSI_PREFIX))
+	for _, status := range accepted {
+		if response.StatusCode == status {
+			return body, response.StatusCode, nil
+		}
+	}
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		return nil, response.StatusCode, &errs.SecurityError{Message: fmt.Sprintf("'%s' request to '%s' was rejected with status '%s'", request.Method, request.URL.String(), response.Status)}
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, response.StatusCode, &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed with status '%s': %s", request.Method, request.URL.String(), response.Status, strings.TrimSpace(string(body)))}
+	}
+	return body, response.StatusCode, nil
+}
+
+/*
+Sends a request to the given endpoint and unmarshals the JSON response to the given target.
+
+Arguments are as follows:
+
+  - method the HTTP method of the request
+  - path the path of the endpoint, starting with a slash, whose parameters must already be escaped
+  - payload the request body, sent as JSON. It may be nil
+  - target the object to unmarshal the response to
+
+Errors can be:
+
+  - SecurityError if authentication or authorization fails
+  - TransportError if communication to the remote endpoint fails, the server returns an unexpected status or
+    the response can't be parsed
+*/
+func (s Gerrit) sendJSON(method string, path string, payload interface{}, target interface{}) error {
+	body, _, err := s.send(method, path, payload)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(body, target)
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to parse the response to '%s' request to '%s'", method, path), Cause: err}
+	}
+	return nil
+}
+
+/*
+Returns the changes matching the given query, up to the given limit.
+
+Arguments are as follows:
+
+  - query the query, using the Gerrit search operators (i.e. 'status:open project:build')
+  - limit the maximum number of changes to return
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s Gerrit) queryChanges(query string, limit int) ([]changeInfo, error) {
+	parameters := url.Values{}
+	parameters.Set("q", query)
+	parameters.Set("n", fmt.Sprintf("%d", limit))
+	changes := []changeInfo{}
+	err := s.sendJSON(http.MethodGet, "/changes/?"+parameters.Encode(), nil, &changes)
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+/*
+Returns the name of the branch the HEAD of the given project points to.
+
+Arguments are as follows:
+
+  - project the name of the project
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s Gerrit) getDefaultBranch(project string) (string, error) {
+	log.Debugf("looking up the default branch of the Gerrit project '%s'", project)
+	var head string
+	err := s.sendJSON(http.MethodGet, fmt.Sprintf("/projects/%s/HEAD", url.PathEscape(project)), nil, &head)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(head, "refs/heads/"), nil
+}
+
+/*
+Returns the contents of the file with the given path in the given branch of a project.
+
+Arguments are as follows:
+
+  - owner the parent path of the project to read the file from. It may be nil, in which case,
+    the option passed to the service is used, if any. If not nil this value overrides the option passed to the service.
+  - repository the name of the project to read the file from. It may be nil, in which case,
+    the repository name must be passed as a service option. If not nil this value overrides the option passed to the service.
+  - branch the name of the branch to read the file from. It may be nil, in which case the branch the project HEAD
+    points to is used.
+  - path the path of the file within the repository
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails or the file can't be found
+*/
+func (s Gerrit) GetFileContent(owner *string, repository *string, branch *string, path string) (string, error) {
+	project := s.resolveProject(owner, repository)
+	if branch == nil {
+		defaultBranch, err := s.getDefaultBranch(project)
+		if err != nil {
+			return "", err
+		}
+		branch = &defaultBranch
+	}
+	log.Debugf("reading file '%s' from branch '%s' of the Gerrit project '%s'", path, *branch, project)
+	body, _, err := s.send(http.MethodGet, fmt.Sprintf("/projects/%s/branches/%s/files/%s/content", url.PathEscape(project), url.PathEscape(*branch), url.PathEscape(path)), nil)
+	if err != nil {
+		return "", err
+	}
+	content, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(body)))
+	if err != nil {
+		return "", &errs.TransportError{Message: fmt.Sprintf("unable to decode the content of file '%s' from the Gerrit project '%s'", path, project), Cause: err}
+	}
+	return string(content), nil
+}
+
+/*
+Returns the merged change that brought the commit with the given SHA-1 into the project, or nil if the commit
+has not been merged through any change. Change hashtags are returned as the pull request labels.
+
+Arguments are as follows:
+
+  - owner the parent path of the project to look up the change in. It may be nil, in which case,
+    the option passed to the service is used, if any. If not nil this value overrides the option passed to the service.
+  - repository the name of the project to look up the change in. It may be nil, in which case,
+    the repository name must be passed as a service option. If not nil this value overrides the option passed to the service.
+  - commit the SHA-1 of the commit to look up the change for
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s Gerrit) GetMergedPullRequest(owner *string, repository *string, commit string) (*api.PullRequest, error) {
+	project := s.resolveProject(owner, repository)
+	log.Debugf("looking up the change that merged commit '%s' in the Gerrit project '%s'", commit, project)
+	changes, err := s.queryChanges(fmt.Sprintf("commit:%s project:%s status:merged", commit, project), 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	var pullRequest api.PullRequest = newGerritChange(changes[0])
+	return &pullRequest, nil
+}
+
+/*
+Uploads a change to the base branch updating the file with the given path with the given contents, like pushing
+to refs/for/<base> would do. The head is used as the change topic so that when an open change with the same topic
+already exists for the base branch it's updated with a new patch set instead of uploading a new change, so that
+subsequent invocations do not pile up changes.
+
+Arguments are as follows:
+
+  - owner the parent path of the project to upload the change to. It may be nil, in which case,
+    the option passed to the service is used, if any. If not nil this value overrides the option passed to the service.
+  - repository the name of the project to upload the change to. It may be nil, in which case,
+    the repository name must be passed as a service option. If not nil this value overrides the option passed to the service.
+  - base the name of the branch the change targets. It may be nil, in which case the branch the project HEAD
+    points to is used.
+  - head the topic of the change
+  - path the path of the file to update within the repository
+  - content the new contents of the file
+  - title the subject of the change commit message
+  - body the body of the change commit message
+
+Returns the URL of the change.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s Gerrit) OpenFileUpdatePullRequest(owner *string, repository *string, base *string, head string, path string, content string, title string, body string) (string, error) {
+	project := s.resolveProject(owner, repository)
+	if base == nil {
+		defaultBranch, err := s.getDefaultBranch(project)
+		if err != nil {
+			return "", err
+		}
+		base = &defaultBranch
+	}
+
+	changes, err := s.queryChanges(fmt.Sprintf("status:open project:%s branch:%s topic:%s", project, *base, head), 1)
+	if err != nil {
+		return "", err
+	}
+	var change changeInfo
+	if len(changes) > 0 {
+		change = changes[0]
+		log.Debugf("updating the open change '%d' with topic '%s' for branch '%s' of the Gerrit project '%s'", change.Number, head, *base, project)
+	} else {
+		log.Debugf("uploading a new change with topic '%s' for branch '%s' of the Gerrit project '%s'", head, *base, project)
+		err = s.sendJSON(http.MethodPost, "/changes/", map[string]string{"project": project, "branch": *base, "subject": title, "topic": head}, &change)
+		if err != nil {
+			return "", err
+		}
+	}
+	changeID := changeIdentifier(project, change.Number)
+
+	// changes are updated by means of a change edit, which is then published as a new patch set. Gerrit replies
+	// with a conflict when the edit doesn't change anything
+	_, status, err := s.send(http.MethodPut, fmt.Sprintf("/changes/%s/edit/%s", changeID, url.PathEscape(path)), content, http.StatusConflict)
+	if err != nil {
+		return "", err
+	}
+	edited := status != http.StatusConflict
+	message := title
+	if "" != strings.TrimSpace(body) {
+		message = message + "\n\n" + strings.TrimSpace(body)
+	}
+	if "" != change.ChangeID {
+		message = message + "\n\n" + CHANGE_ID_FOOTER + change.ChangeID
+	}
+	_, status, err = s.send(http.MethodPut, fmt.Sprintf("/changes/%s/edit:message", changeID), map[string]string{"message": message + "\n"}, http.StatusConflict)
+	if err != nil {
+		return "", err
+	}
+	edited = edited || status != http.StatusConflict
+	if edited {
+		_, _, err = s.send(http.MethodPost, fmt.Sprintf("/changes/%s/edit:publish", changeID), map[string]string{})
+		if err != nil {
+			return "", err
+		}
+	} else {
+		log.Debugf("the change '%d' of the Gerrit project '%s' is already up to date", change.Number, project)
+	}
+	return s.changeURL(project, change.Number), nil
+}
+
+/*
+Finds the release (the tag) in the project with the given tag, or nil if the tag doesn't exist.
+
+Arguments are as follows:
+
+  - owner the parent path of the project to get the tag from. It may be nil, in which case,
+    the option passed to the service is used, if any. If not nil this value overrides the option passed to the service.
+  - repository the name of the project to get the tag from. It may be nil, in which case,
+    the repository name must be passed as a service option. If not nil this value overrides the option passed to the service.
+  - tag the tag the release refers to (i.e. 1.2.3, v4.5.6). It can't be nil
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s Gerrit) GetReleaseByTag(owner *string, repository *string, tag string) (*api.Release, error) {
+	project := s.resolveProject(owner, repository)
+	log.Debugf("looking up tag '%s' in the Gerrit project '%s'", tag, project)
+	body, status, err := s.send(http.MethodGet, fmt.Sprintf("/projects/%s/tags/%s", url.PathEscape(project), url.PathEscape(tag)), nil, http.StatusNotFound)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	var info tagInfo
+	err = json.Unmarshal(body, &info)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to parse the tag '%s' from the Gerrit project '%s'", tag, project), Cause: err}
+	}
+	var apiRelease api.Release = newGerritRelease(tag, tag, info.Revision)
+	return &apiRelease, nil
+}
+
+/*
+Publishes a new release by creating the given tag in the project. Gerrit has no notion of releases so the tag is
+all there is to publish. When the tag already exists, which happens when it has already been pushed, the existing
+tag is left untouched.
+
+Arguments are as follows:
+
+  - owner the parent path of the project to create the tag in. It may be nil, in which case,
+    the option passed to the service is used, if any. If not nil this value overrides the option passed to the service.
+  - repository the name of the project to create the tag in. It may be nil, in which case,
+    the repository name must be passed as a service option. If not nil this value overrides the option passed to the service.
+  - title the release title, used as the tag message when the description is nil. When both are nil
+    the tag is a lightweight tag
+  - tag tag to publish the release for (i.e. 1.2.3, v4.5.6). It can't be nil
+  - description the release description, used as the tag message. It may be nil
+  - options this argument is ignored
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s Gerrit) PublishRelease(owner *string, repository *string, title *string, tag string, description *string, options *map[string]interface{}) (*api.Release, error) {
+	project := s.resolveProject(owner, repository)
+	tagInput := map[string]string{}
+	if s.commitSHA != nil {
+		tagInput["revision"] = *s.commitSHA
+	}
+	if description != nil && "" != strings.TrimSpace(*description) {
+		tagInput["message"] = *description
+	} else if title != nil && "" != strings.TrimSpace(*title) {
+		tagInput["message"] = *title
+	}
+	log.Debugf("creating tag '%s' in the Gerrit project '%s'", tag, project)
+	body, status, err := s.send(http.MethodPut, fmt.Sprintf("/projects/%s/tags/%s", url.PathEscape(project), url.PathEscape(tag)), tagInput, http.StatusConflict)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusConflict {
+		log.Debugf("tag '%s' already exists in the Gerrit project '%s' and is left untouched", tag, project)
+		return s.GetReleaseByTag(owner, repository, tag)
+	}
+	var info tagInfo
+	err = json.Unmarshal(body, &info)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to parse the tag '%s' created in the Gerrit project '%s'", tag, project), Cause: err}
+	}
+
+	releaseTitle := tag
+	if title != nil {
+		releaseTitle = *title
+	}
+	var apiRelease api.Release = newGerritRelease(tag, releaseTitle, info.Revision)
+	return &apiRelease, nil
+}
+
+/*
+Gerrit does not support release assets so this method always returns an error.
+
+Errors can be:
+
+- UnsupportedOperationError as Gerrit does not support the RELEASE_ASSETS feature.
+*/
+func (s Gerrit) PublishReleaseAssets(owner *string, repository *string, release *api.Release, assets []ent.Attachment) (*api.Release, error) {
+	return nil, &errs.UnsupportedOperationError{Message: fmt.Sprintf("the '%s' service does not support release assets", "Gerrit")}
+}
+
+/*
+Safely checks if the underlying implementation supports the given operation. If this
+method returns true then the underlying class will not raise any
+UnsupportedOperationError when invoking the specific methods.
+
+Arguments are as follows:
+
+- feature the feature to check for support.
+*/
+func (s Gerrit) Supports(feature api.Feature) bool {
+	switch feature {
+	case api.GIT_HOSTING:
+		return false
+	case api.PULL_REQUESTS:
+		return true
+	case api.RELEASES:
+		return true
+	case api.RELEASE_ASSETS:
+		return false
+	case api.USERS:
+		return false
+	default:
+		return false
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gerrit
+
+/*
+A remote Gerrit change, which is what Gerrit has in place of pull requests.
+*/
+type GerritChange struct {
+	// The hashtags applied to the change, used as labels.
+	labels []string
+
+	// The change number.
+	number int
+
+	// The change subject.
+	title string
+}
+
+/*
+Creates the change object modelled by the attributes from the given reference.
+
+Arguments are as follows:
+
+  - change the object to read the attributes from
+*/
+func newGerritChange(change changeInfo) *GerritChange {
+	res := &GerritChange{}
+	res.labels = []string{}
+	res.labels = append(res.labels, change.Hashtags...)
+	res.number = change.Number
+	res.title = change.Subject
+	return res
+}
+
+/*
+Returns the hashtags applied to the change, or an empty list if the change has no hashtags.
+*/
+func (r *GerritChange) GetLabels() []string {
+	return r.labels
+}
+
+/*
+Returns the change number.
+*/
+func (r *GerritChange) GetNumber() int {
+	return r.number
+}
+
+/*
+Returns the change subject.
+*/
+func (r *GerritChange) GetTitle() string {
+	return r.title
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gerrit
+
+import (
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+/*
+A release published to Gerrit.
+
+Gerrit has no notion of releases like Git hosting services do, so this object just
+tracks the tag created in the project.
+*/
+type GerritRelease struct {
+	// The assets published for the relese. This is always nil as Gerrit does not support assets.
+	assets []ent.Attachment
+
+	// The SHA of the commit the tag points to.
+	revision string
+
+	// The tag the release refers to.
+	tag string
+
+	// The release title.
+	title string
+}
+
+/*
+Creates the release object with the given attributes.
+
+Arguments are as follows:
+
+  - tag the tag the release refers to
+  - title the release title
+  - revision the SHA of the commit the tag points to
+*/
+func newGerritRelease(tag string, title string, revision string) *GerritRelease {
+	res := &GerritRelease{}
+	res.tag = tag
+	res.title = title
+	res.revision = revision
+	return res
+}
+
+/*
+Returns the assets attached to the relese, otherwise returns nil.
+*/
+func (r *GerritRelease) GetAssets() []ent.Attachment {
+	return r.assets
+}
+
+/*
+Returns the SHA of the commit the tag points to.
+*/
+func (r *GerritRelease) GetRevision() string {
+	return r.revision
+}
+
+/*
+Returns the tag the release refers to.
+*/
+func (r *GerritRelease) GetTag() string {
+	return r.tag
+}
+
+/*
+Returns the release title.
+*/
+func (r *GerritRelease) GetTitle() string {
+	return r.title
+}
@@ -0,0 +1,268 @@
+//go:build unit
+// +build unit
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gerrit
+
+import (
+	"encoding/json"     // https://pkg.go.dev/encoding/json
+	"io"                // https://pkg.go.dev/io
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"testing"           // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+Writes the given object to the response as JSON, prefixed like Gerrit does.
+*/
+func writeJSON(w http.ResponseWriter, status int, object interface{}) {
+	content, _ := json.Marshal(object)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write([]byte(XSSI_PREFIX + "\n"))
+	w.Write(content)
+}
+
+func TestGerritInstance(t *testing.T) {
+	_, err := Instance(nil)
+	assert.Error(t, err)
+	_, err = Instance(map[string]string{})
+	assert.Error(t, err)
+
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: "https://gerrit.example.com/"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://gerrit.example.com", service.baseURI)
+	assert.Equal(t, "https://gerrit.example.com/projects/", service.endpointURL("/projects/"))
+
+	// authenticated requests use the authenticated endpoints
+	service, err = Instance(map[string]string{BASE_URI_OPTION_NAME: "https://gerrit.example.com", AUTHENTICATION_USER_OPTION_NAME: "jdoe", AUTHENTICATION_PASSWORD_OPTION_NAME: "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://gerrit.example.com/a/projects/", service.endpointURL("/projects/"))
+}
+
+func TestGerritResolveProject(t *testing.T) {
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: "https://gerrit.example.com", REPOSITORY_NAME_OPTION_NAME: "build"})
+	assert.NoError(t, err)
+	assert.Equal(t, "build", service.resolveProject(nil, nil))
+	assert.Equal(t, "platform/build", service.resolveProject(utl.PointerToString("platform"), nil))
+	assert.Equal(t, "tools", service.resolveProject(nil, utl.PointerToString("tools")))
+
+	service, err = Instance(map[string]string{BASE_URI_OPTION_NAME: "https://gerrit.example.com", REPOSITORY_NAME_OPTION_NAME: "build", REPOSITORY_OWNER_OPTION_NAME: "platform"})
+	assert.NoError(t, err)
+	assert.Equal(t, "platform/build", service.resolveProject(nil, nil))
+}
+
+func TestGerritSupports(t *testing.T) {
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: "https://gerrit.example.com"})
+	assert.NoError(t, err)
+
+	assert.False(t, service.Supports(api.GIT_HOSTING))
+	assert.True(t, service.Supports(api.PULL_REQUESTS))
+	assert.True(t, service.Supports(api.RELEASES))
+	assert.False(t, service.Supports(api.RELEASE_ASSETS))
+	assert.False(t, service.Supports(api.USERS))
+}
+
+func TestGerritGetFileContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/projects/platform%2Fbuild/HEAD":
+			writeJSON(w, http.StatusOK, "refs/heads/main")
+		case "/projects/platform%2Fbuild/branches/main/files/docs%2Fversion.txt/content":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("MS4yLjM="))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL, REPOSITORY_NAME_OPTION_NAME: "platform/build"})
+	assert.NoError(t, err)
+
+	content, err := service.GetFileContent(nil, nil, nil, "docs/version.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3", content)
+
+	_, err = service.GetFileContent(nil, nil, utl.PointerToString("other"), "docs/version.txt")
+	assert.Error(t, err)
+}
+
+func TestGerritGetMergedPullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/a/changes/", r.URL.Path)
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "jdoe", user)
+		assert.Equal(t, "secret", password)
+		switch r.URL.Query().Get("q") {
+		case "commit:b50926f2a4d6e1b1d2e9c6cb0e0f1d4e2b7c0a11 project:build status:merged":
+			writeJSON(w, http.StatusOK, []map[string]interface{}{{"_number": 42, "subject": "feat: new widget", "hashtags": []string{"minor"}, "change_id": "I0123"}})
+		default:
+			writeJSON(w, http.StatusOK, []map[string]interface{}{})
+		}
+	}))
+	defer server.Close()
+
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL, AUTHENTICATION_USER_OPTION_NAME: "jdoe", AUTHENTICATION_PASSWORD_OPTION_NAME: "secret", REPOSITORY_NAME_OPTION_NAME: "build"})
+	assert.NoError(t, err)
+
+	pullRequest, err := service.GetMergedPullRequest(nil, nil, "b50926f2a4d6e1b1d2e9c6cb0e0f1d4e2b7c0a11")
+	assert.NoError(t, err)
+	assert.NotNil(t, pullRequest)
+	assert.Equal(t, 42, (*pullRequest).GetNumber())
+	assert.Equal(t, "feat: new widget", (*pullRequest).GetTitle())
+	assert.Equal(t, []string{"minor"}, (*pullRequest).GetLabels())
+
+	pullRequest, err = service.GetMergedPullRequest(nil, nil, "0000000000000000000000000000000000000000")
+	assert.NoError(t, err)
+	assert.Nil(t, pullRequest)
+}
+
+func TestGerritOpenFileUpdatePullRequest(t *testing.T) {
+	openChanges := []map[string]interface{}{}
+	requests := []string{}
+	edits := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		body, _ := io.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /changes/":
+			assert.Equal(t, "status:open project:build branch:main topic:nyx-update", r.URL.Query().Get("q"))
+			writeJSON(w, http.StatusOK, openChanges)
+		case "POST /changes/":
+			input := map[string]string{}
+			json.Unmarshal(body, &input)
+			assert.Equal(t, map[string]string{"project": "build", "branch": "main", "subject": "Update version", "topic": "nyx-update"}, input)
+			change := map[string]interface{}{"_number": 7, "subject": "Update version", "change_id": "I7777"}
+			openChanges = append(openChanges, change)
+			writeJSON(w, http.StatusCreated, change)
+		case "PUT /changes/build~7/edit/version.txt":
+			if edits["version.txt"] == string(body) {
+				w.WriteHeader(http.StatusConflict)
+			} else {
+				edits["version.txt"] = string(body)
+				w.WriteHeader(http.StatusNoContent)
+			}
+		case "PUT /changes/build~7/edit:message":
+			input := map[string]string{}
+			json.Unmarshal(body, &input)
+			if edits["message"] == input["message"] {
+				w.WriteHeader(http.StatusConflict)
+			} else {
+				edits["message"] = input["message"]
+				w.WriteHeader(http.StatusNoContent)
+			}
+		case "POST /changes/build~7/edit:publish":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL, REPOSITORY_NAME_OPTION_NAME: "build"})
+	assert.NoError(t, err)
+
+	// the first time the change is uploaded
+	url, err := service.OpenFileUpdatePullRequest(nil, nil, utl.PointerToString("main"), "nyx-update", "version.txt", "1.2.3", "Update version", "Bump to 1.2.3")
+	assert.NoError(t, err)
+	assert.Equal(t, server.URL+"/c/build/+/7", url)
+	assert.Equal(t, "1.2.3", edits["version.txt"])
+	assert.Equal(t, "Update version\n\nBump to 1.2.3\n\nChange-Id: I7777\n", edits["message"])
+	assert.Equal(t, []string{"GET /changes/", "POST /changes/", "PUT /changes/build~7/edit/version.txt", "PUT /changes/build~7/edit:message", "POST /changes/build~7/edit:publish"}, requests)
+
+	// then the open change is updated with a new patch set
+	requests = []string{}
+	url, err = service.OpenFileUpdatePullRequest(nil, nil, utl.PointerToString("main"), "nyx-update", "version.txt", "1.2.4", "Update version", "Bump to 1.2.3")
+	assert.NoError(t, err)
+	assert.Equal(t, server.URL+"/c/build/+/7", url)
+	assert.Equal(t, "1.2.4", edits["version.txt"])
+	assert.Equal(t, []string{"GET /changes/", "PUT /changes/build~7/edit/version.txt", "PUT /changes/build~7/edit:message", "POST /changes/build~7/edit:publish"}, requests)
+
+	// and when nothing changes nothing is published
+	requests = []string{}
+	_, err = service.OpenFileUpdatePullRequest(nil, nil, utl.PointerToString("main"), "nyx-update", "version.txt", "1.2.4", "Update version", "Bump to 1.2.3")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET /changes/", "PUT /changes/build~7/edit/version.txt", "PUT /changes/build~7/edit:message"}, requests)
+}
+
+func TestGerritPublishRelease(t *testing.T) {
+	tags := map[string]map[string]string{"1.0.0": {"ref": "refs/tags/1.0.0", "revision": "a0a0a0a"}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /a/projects/build/tags/1.0.0", "GET /a/projects/build/tags/1.2.3", "GET /a/projects/build/tags/9.9.9":
+			tag, ok := tags[r.URL.EscapedPath()[len("/a/projects/build/tags/"):]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeJSON(w, http.StatusOK, tag)
+		case "PUT /a/projects/build/tags/1.0.0":
+			w.WriteHeader(http.StatusConflict)
+		case "PUT /a/projects/build/tags/1.2.3":
+			input := map[string]string{}
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &input)
+			assert.Equal(t, map[string]string{"revision": "b1b1b1b", "message": "Release 1.2.3"}, input)
+			tags["1.2.3"] = map[string]string{"ref": "refs/tags/1.2.3", "revision": input["revision"], "message": input["message"]}
+			writeJSON(w, http.StatusCreated, tags["1.2.3"])
+		case "PUT /a/projects/build/tags/9.9.9":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL, AUTHENTICATION_USER_OPTION_NAME: "jdoe", AUTHENTICATION_PASSWORD_OPTION_NAME: "secret", REPOSITORY_NAME_OPTION_NAME: "build", COMMIT_SHA_OPTION_NAME: "b1b1b1b"})
+	assert.NoError(t, err)
+
+	release, err := service.GetReleaseByTag(nil, nil, "1.2.3")
+	assert.NoError(t, err)
+	assert.Nil(t, release)
+
+	release, err = service.PublishRelease(nil, nil, utl.PointerToString("Release 1.2.3"), "1.2.3", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3", (*release).GetTag())
+	assert.Equal(t, "Release 1.2.3", (*release).GetTitle())
+	assert.Equal(t, "b1b1b1b", (*release).(*GerritRelease).GetRevision())
+
+	release, err = service.GetReleaseByTag(nil, nil, "1.2.3")
+	assert.NoError(t, err)
+	assert.NotNil(t, release)
+
+	// existing tags are left untouched
+	release, err = service.PublishRelease(nil, nil, nil, "1.0.0", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "a0a0a0a", (*release).(*GerritRelease).GetRevision())
+
+	// lacking permissions is a security error
+	_, err = service.PublishRelease(nil, nil, nil, "9.9.9", nil, nil)
+	assert.Error(t, err)
+	assert.ErrorAs(t, err, new(*errs.SecurityError))
+
+	_, err = service.PublishReleaseAssets(nil, nil, nil, nil)
+	assert.Error(t, err)
+}
@@ -20,12 +20,19 @@ This is the GitHub package for Nyx, providing services to work with the GitHub s
 package github
 
 import (
-	"context"  // https://pkg.go.dev/context
-	"fmt"      // https://pkg.go.dev/fmt
-	"net/http" // https://pkg.go.dev/net/http
-	"os"       // https://pkg.go.dev/os
-	"reflect"  // https://pkg.go.dev/reflect
-	"strings"  // https://pkg.go.dev/strings
+	"context"       // https://pkg.go.dev/context
+	"fmt"           // https://pkg.go.dev/fmt
+	stdio "io"      // https://pkg.go.dev/io
+	"mime"          // https://pkg.go.dev/mime
+	"net/http"      // https://pkg.go.dev/net/http
+	"net/url"       // https://pkg.go.dev/net/url
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"reflect"       // https://pkg.go.dev/reflect
+	"regexp"        // https://pkg.go.dev/regexp
+	"sort"          // https://pkg.go.dev/sort
+	"strconv"       // https://pkg.go.dev/strconv
+	"strings"       // https://pkg.go.dev/strings
 
 	gh "github.com/google/go-github/github" // https://pkg.go.dev/github.com/google/go-github/github
 	log "github.com/sirupsen/logrus"        // https://github.com/Sirupsen/logrus, https://pkg.go.dev/github.com/sirupsen/logrus
@@ -33,6 +40,7 @@ import (
 
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	io "github.com/mooltiverse/nyx/modules/go/nyx/io"
 	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
 	utl "github.com/mooltiverse/nyx/modules/go/utils"
 )
@@ -78,6 +86,67 @@ const (
 		If this option is not passed the service will not be able to perform some of its operations.
 	*/
 	REPOSITORY_OWNER_OPTION_NAME = "REPOSITORY_OWNER"
+
+	/*
+		The name of the option used to pass the number of the pull request to publish comments to.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the number is detected from the GITHUB_REF environment variable, if any,
+		when its value is in the 'refs/pull/<NUMBER>/merge' form.
+	*/
+	PULL_REQUEST_NUMBER_OPTION_NAME = "PULL_REQUEST_NUMBER"
+
+	/*
+		The name of the option used to pass the ID of the GitHub Actions workflow run to look up approvals for.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the value of the GITHUB_RUN_ID environment variable is used, if any.
+	*/
+	WORKFLOW_RUN_ID_OPTION_NAME = "WORKFLOW_RUN_ID"
+
+	/*
+		The name of the option used to pass the maximum number of bytes per second sent when uploading release assets.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed uploads are not throttled.
+	*/
+	UPLOAD_BANDWIDTH_LIMIT_OPTION_NAME = "UPLOAD_BANDWIDTH_LIMIT"
+
+	/*
+		The name of the option used to pass the size (in bytes) of the chunks release assets are read by when uploading.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed chunks of 1 MiB are used.
+	*/
+	UPLOAD_CHUNK_SIZE_OPTION_NAME = "UPLOAD_CHUNK_SIZE"
+
+	/*
+		The name of the option used to pass the number of times a failed release asset upload is retried.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed failed uploads are retried 3 times.
+	*/
+	UPLOAD_RETRIES_OPTION_NAME = "UPLOAD_RETRIES"
+
+	/*
+		The name of the option used to enable the verification of release assets after they are uploaded.
+		When 'true', each asset is downloaded back and its digest is compared to the one of the local file,
+		uploading the asset again (up to the number of upload retries) when they don't match.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed assets are not verified.
+	*/
+	VERIFY_ASSETS_OPTION_NAME = "VERIFY_ASSETS"
+
+	/*
+		The name of the option used to pass the number of most recent releases whose assets are kept when enforcing
+		the retention policy. Assets attached to older releases are removed.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the assets of all releases are kept.
+	*/
+	RETENTION_KEEP_ASSETS_OPTION_NAME = "RETENTION_KEEP_ASSETS"
+
+	/*
+		The name of the option used to pass the maximum size (in bytes) of the assets attached to pre-releases when
+		enforcing the retention policy. Larger assets are removed from pre-releases.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed pre-release assets are not limited in size.
+	*/
+	RETENTION_MAX_PRE_RELEASE_ASSET_SIZE_OPTION_NAME = "RETENTION_MAX_PRE_RELEASE_ASSET_SIZE"
 )
 
 /*
@@ -92,6 +161,22 @@ type GitHub struct {
 	// It may be nil, but some operations may fail.
 	repositoryName *string
 
+	// The number of the pull request comments are published to. It may be nil, but comments can't be published
+	// unless the pull request number is passed explicitly.
+	pullRequestNumber *string
+
+	// The ID of the GitHub Actions workflow run approvals are looked up for. It may be nil, but approvals can't be checked.
+	workflowRunID *string
+
+	// The options used to upload release assets.
+	uploadOptions io.UploadOptions
+
+	// The flag telling if release assets must be downloaded back and verified after they are uploaded.
+	verifyAssets bool
+
+	// The retention policy enforced on release assets.
+	retentionPolicy api.RetentionPolicy
+
 	// The private API client instance.
 	client gh.Client
 }
@@ -116,6 +201,7 @@ func newGitHub(client gh.Client, repositoryOwner *string, repositoryName *string
 	res.client = client
 	res.repositoryOwner = repositoryOwner
 	res.repositoryName = repositoryName
+	res.uploadOptions = io.NewUploadOptions()
 	return res, nil
 }
 
@@ -191,6 +277,37 @@ func Instance(options map[string]string) (GitHub, error) {
 		log.Warnf("no repository owner passed to the '%s' service, some features may not work. Use the '%s' option to set this value", "GitHub", REPOSITORY_OWNER_OPTION_NAME)
 	}
 
+	pullRequestNumber, ok := options[PULL_REQUEST_NUMBER_OPTION_NAME]
+	if !ok || "" == strings.TrimSpace(pullRequestNumber) {
+		pullRequestNumber = ""
+		if match := regexp.MustCompile(`^refs/pull/([0-9]+)/merge$`).FindStringSubmatch(os.Getenv("GITHUB_REF")); match != nil {
+			pullRequestNumber = match[1]
+		}
+	}
+
+	workflowRunID, ok := options[WORKFLOW_RUN_ID_OPTION_NAME]
+	if !ok || "" == strings.TrimSpace(workflowRunID) {
+		workflowRunID = os.Getenv("GITHUB_RUN_ID")
+	}
+
+	uploadOptions, err := io.ParseUploadOptions(options, UPLOAD_BANDWIDTH_LIMIT_OPTION_NAME, UPLOAD_CHUNK_SIZE_OPTION_NAME, UPLOAD_RETRIES_OPTION_NAME)
+	if err != nil {
+		return GitHub{}, err
+	}
+
+	verifyAssets := false
+	if verifyAssetsString, ok := options[VERIFY_ASSETS_OPTION_NAME]; ok && "" != strings.TrimSpace(verifyAssetsString) {
+		verifyAssets, err = strconv.ParseBool(strings.TrimSpace(verifyAssetsString))
+		if err != nil {
+			return GitHub{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("the value '%s' of option '%s' is not a valid boolean", verifyAssetsString, VERIFY_ASSETS_OPTION_NAME), Cause: err}
+		}
+	}
+
+	retentionPolicy, err := api.ParseRetentionPolicy(options, RETENTION_KEEP_ASSETS_OPTION_NAME, RETENTION_MAX_PRE_RELEASE_ASSET_SIZE_OPTION_NAME)
+	if err != nil {
+		return GitHub{}, err
+	}
+
 	log.Tracef("instantiating new GitHub service")
 
 	client, err := newClientInstance(&uriString, &authenticationToken)
@@ -198,7 +315,20 @@ func Instance(options map[string]string) (GitHub, error) {
 		return GitHub{}, &errs.NilPointerError{Message: fmt.Sprintf("could not create a GitHub service client"), Cause: err}
 	}
 
-	return newGitHub(client, &repositoryOwner, &repositoryName)
+	res, err := newGitHub(client, &repositoryOwner, &repositoryName)
+	if err != nil {
+		return res, err
+	}
+	if "" != strings.TrimSpace(pullRequestNumber) {
+		res.pullRequestNumber = &pullRequestNumber
+	}
+	if "" != strings.TrimSpace(workflowRunID) {
+		res.workflowRunID = &workflowRunID
+	}
+	res.uploadOptions = uploadOptions
+	res.verifyAssets = verifyAssets
+	res.retentionPolicy = retentionPolicy
+	return res, nil
 }
 
 /*
@@ -644,24 +774,53 @@ func (s GitHub) publishReleaseAssets(owner *string, repository *string, release
 		log.Warnf("the repository name was not passed as a service option nor overridden as an argument, getting the release may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_NAME_OPTION_NAME)
 	}
 
+	// assets already attached to the release are listed so that uploads interrupted by a previous run can be resumed
+	existingAssets, err := s.getReleaseAssetsByName(requestOwner, requestRepository, release.GetID())
+	if err != nil {
+		return nil, err
+	}
+
 	for i, asset := range assets {
 		log.Debugf("publishing asset %d out of %d for GitHub release '%s' to the remote service (%s - %s (%s))", i, len(assets), release.GetTag(), *asset.GetPath(), *asset.GetDescription(), *asset.GetType())
 		filePath := asset.GetPath()
-		_, err := os.Stat(*filePath)
+		fileInfo, err := os.Stat(*filePath)
 		if err == nil {
-			file, err := os.Open(*filePath)
-			if err != nil {
-				log.Debugf("an error occurred while opening file %s for asset %d out of %d: %v", *asset.GetPath(), i, len(assets), err)
-				return nil, errs.TransportError{Message: fmt.Sprintf("could not open file '%s'", *asset.GetPath()), Cause: err}
+			var releaseAsset *gh.ReleaseAsset
+			if existingAsset, ok := existingAssets[*asset.GetFileName()]; ok {
+				if existingAsset.GetState() == "uploaded" && int64(existingAsset.GetSize()) == fileInfo.Size() {
+					log.Infof("asset '%s' has already been uploaded to GitHub release '%s' and will not be uploaded again", *asset.GetFileName(), release.GetTag())
+					releaseAsset = existingAsset
+				} else {
+					// GitHub does not allow overwriting assets so incomplete or outdated ones must be deleted first
+					log.Debugf("deleting the incomplete or outdated asset '%s' from GitHub release '%s'", *asset.GetFileName(), release.GetTag())
+					_, err := s.client.Repositories.DeleteReleaseAsset(context.Background(), requestOwner, requestRepository, existingAsset.GetID())
+					if err != nil {
+						log.Debugf("an error occurred while deleting asset '%s' from the remote GitHub service: %v", *asset.GetFileName(), err)
+						return nil, errs.TransportError{Message: fmt.Sprintf("could not delete release asset '%s'", *asset.GetFileName()), Cause: err}
+					}
+				}
 			}
-			// This API does not support passing the Label (used for the Description), which is actually supported by the REST API but not by this library. Only the file name can be used here
-			releaseAsset, _, err := s.client.Repositories.UploadReleaseAsset(context.Background(), requestOwner, requestRepository, release.GetID(), &gh.UploadOptions{Name: *asset.GetFileName()}, file)
+			if releaseAsset == nil {
+				releaseAsset, err = s.uploadReleaseAsset(requestOwner, requestRepository, release.GetID(), *asset.GetFileName(), *filePath)
+				if err != nil {
+					log.Debugf("an error occurred while publishing file %s for asset %d out of %d to the remote GitHub service: %v", *asset.GetPath(), i, len(assets), err)
+					return nil, errs.TransportError{Message: fmt.Sprintf("could not upload release asset '%s'", *asset.GetPath()), Cause: err}
+				}
+			}
+			digest, err := io.FileDigest(*filePath)
 			if err != nil {
-				log.Debugf("an error occurred while publishing file %s for asset %d out of %d to the remote GitHub service: %v", *asset.GetPath(), i, len(assets), err)
-				return nil, errs.TransportError{Message: fmt.Sprintf("could not upload release asset '%s'", *asset.GetPath()), Cause: err}
+				return nil, err
+			}
+			if s.verifyAssets {
+				releaseAsset, err = s.verifyReleaseAsset(requestOwner, requestRepository, release.GetID(), releaseAsset, *asset.GetFileName(), *filePath, digest)
+				if err != nil {
+					return nil, err
+				}
 			}
 			log.Debugf("asset %d out of %d for GitHub release '%s' has been published to the remote service (%s - %s (%s): %s)", i, len(assets), release.GetTag(), *asset.GetFileName(), *asset.GetDescription(), *asset.GetType(), *releaseAsset.URL)
-			release.addAsset(*ent.NewAttachmentWith(asset.GetFileName(), asset.GetDescription(), releaseAsset.URL, asset.GetType()))
+			publishedAsset := ent.NewAttachmentWith(asset.GetFileName(), asset.GetDescription(), releaseAsset.URL, asset.GetType())
+			publishedAsset.SetDigest(&digest)
+			release.addAsset(*publishedAsset)
 		} else {
 			log.Warnf("the path '%s' for the asset '%s' cannot be resolved to a local file and will be skipped", *asset.GetPath(), *asset.GetFileName())
 		}
@@ -669,6 +828,135 @@ func (s GitHub) publishReleaseAssets(owner *string, repository *string, release
 	return release, nil
 }
 
+/*
+Returns the assets attached to the release with the given ID, by name.
+
+Errors can be:
+
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitHub) getReleaseAssetsByName(owner string, repository string, releaseID int64) (map[string]*gh.ReleaseAsset, error) {
+	res := make(map[string]*gh.ReleaseAsset)
+	listOptions := &gh.ListOptions{PerPage: 100}
+	for {
+		releaseAssets, response, err := s.client.Repositories.ListReleaseAssets(context.Background(), owner, repository, releaseID, listOptions)
+		if err != nil {
+			log.Debugf("an error occurred while listing the assets of release %d from the remote GitHub service: %v", releaseID, err)
+			return nil, errs.TransportError{Message: fmt.Sprintf("could not list the assets of release %d", releaseID), Cause: err}
+		}
+		for _, releaseAsset := range releaseAssets {
+			res[releaseAsset.GetName()] = releaseAsset
+		}
+		if response == nil || response.NextPage == 0 {
+			return res, nil
+		}
+		listOptions.Page = response.NextPage
+	}
+}
+
+/*
+Uploads the file at the given path as an asset with the given name for the release with the given ID.
+The file is uploaded within the configured bandwidth limit, logging the progress, and failed uploads are retried.
+
+Errors can be:
+
+- IOError if the file can't be read
+- any error returned by the remote endpoint during the last attempt
+*/
+func (s GitHub) uploadReleaseAsset(owner string, repository string, releaseID int64, name string, path string) (*gh.ReleaseAsset, error) {
+	// The request is built here instead of using UploadReleaseAsset as that only accepts plain files, which can't be throttled.
+	// The REST API also supports passing the Label (used for the Description) but only the file name is used here
+	uploadURL := fmt.Sprintf("repos/%s/%s/releases/%d/assets?name=%s", owner, repository, releaseID, url.QueryEscape(name))
+	mediaType := mime.TypeByExtension(filepath.Ext(name))
+	releaseAsset := new(gh.ReleaseAsset)
+	err := io.Upload(path, s.uploadOptions, func(reader stdio.Reader, size int64) error {
+		request, err := s.client.NewUploadRequest(uploadURL, reader, size, mediaType)
+		if err != nil {
+			return err
+		}
+		_, err = s.client.Do(context.Background(), request, releaseAsset)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return releaseAsset, nil
+}
+
+/*
+Downloads the asset with the given ID and returns the digest of its content.
+
+Errors can be:
+
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitHub) getReleaseAssetDigest(owner string, repository string, assetID int64) (string, error) {
+	reader, redirectURL, err := s.client.Repositories.DownloadReleaseAsset(context.Background(), owner, repository, assetID)
+	if err != nil {
+		log.Debugf("an error occurred while downloading asset %d from the remote GitHub service: %v", assetID, err)
+		return "", errs.TransportError{Message: fmt.Sprintf("could not download release asset %d", assetID), Cause: err}
+	}
+	if reader == nil {
+		// the content is served from another location (i.e. a storage service) which does not need authentication
+		response, err := http.Get(redirectURL)
+		if err != nil {
+			log.Debugf("an error occurred while downloading asset %d from '%s': %v", assetID, redirectURL, err)
+			return "", errs.TransportError{Message: fmt.Sprintf("could not download release asset %d", assetID), Cause: err}
+		}
+		if response.StatusCode != http.StatusOK {
+			response.Body.Close()
+			return "", errs.TransportError{Message: fmt.Sprintf("could not download release asset %d, the remote endpoint returned status %d", assetID, response.StatusCode)}
+		}
+		reader = response.Body
+	}
+	defer reader.Close()
+	return io.Digest(reader)
+}
+
+/*
+Verifies that the content of the given uploaded asset matches the given digest of the local file, downloading the
+asset. When they don't match the asset is deleted and uploaded again, up to the number of upload retries, to
+recover from silent upload corruption.
+
+Returns the verified asset, which may be a different one than the given asset when it has been uploaded again.
+
+Errors can be:
+
+- IOError if the file can't be read
+- TransportError if communication to the remote endpoint fails or the asset content still doesn't match the local
+file after all the retries
+*/
+func (s GitHub) verifyReleaseAsset(owner string, repository string, releaseID int64, releaseAsset *gh.ReleaseAsset, name string, path string, digest string) (*gh.ReleaseAsset, error) {
+	retries := s.uploadOptions.Retries
+	if retries < 0 {
+		retries = 0
+	}
+	for attempt := 0; ; attempt++ {
+		remoteDigest, err := s.getReleaseAssetDigest(owner, repository, releaseAsset.GetID())
+		if err != nil {
+			return nil, err
+		}
+		if remoteDigest == digest {
+			log.Debugf("the content of asset '%s' has been verified (SHA-256 digest '%s')", name, digest)
+			return releaseAsset, nil
+		}
+		if attempt >= retries {
+			return nil, errs.TransportError{Message: fmt.Sprintf("the content of release asset '%s' does not match the local file '%s' (expected SHA-256 digest '%s' but found '%s')", name, path, digest, remoteDigest)}
+		}
+		log.Warnf("the content of asset '%s' does not match the local file '%s' (expected SHA-256 digest '%s' but found '%s'), uploading it again (attempt %d out of %d)", name, path, digest, remoteDigest, attempt+1, retries)
+		_, err = s.client.Repositories.DeleteReleaseAsset(context.Background(), owner, repository, releaseAsset.GetID())
+		if err != nil {
+			log.Debugf("an error occurred while deleting asset '%s' from the remote GitHub service: %v", name, err)
+			return nil, errs.TransportError{Message: fmt.Sprintf("could not delete release asset '%s'", name), Cause: err}
+		}
+		releaseAsset, err = s.uploadReleaseAsset(owner, repository, releaseID, name, path)
+		if err != nil {
+			log.Debugf("an error occurred while publishing file %s to the remote GitHub service: %v", path, err)
+			return nil, errs.TransportError{Message: fmt.Sprintf("could not upload release asset '%s'", path), Cause: err}
+		}
+	}
+}
+
 /*
 Publishes a set of assets for a release. Even when the service supports the RELEASE_ASSETS
 feature not all types of assets may be supported. Please check the implementation class for any restrictions
@@ -717,6 +1005,657 @@ func (s GitHub) PublishReleaseAssets(owner *string, repository *string, release
 	}
 }
 
+/*
+Returns the status of the approval for the given environment. Approvals are looked up among the reviews of
+the deployments made by the current GitHub Actions workflow run, which is given by the WORKFLOW_RUN_ID option
+or the GITHUB_RUN_ID environment variable. The environment must be configured with required reviewers.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to get the approval for. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to get the approval for. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - environment the name of the protected environment the approval is required for. It can't be nil
+
+Errors can be:
+
+- IllegalStateError if the workflow run ID is not available
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitHub) GetApprovalStatus(owner *string, repository *string, environment string) (api.ApprovalStatus, error) {
+	if s.workflowRunID == nil {
+		return api.PENDING, &errs.IllegalStateError{Message: fmt.Sprintf("approvals can't be checked because the workflow run ID is not available. Use the '%s' option to set this value or run within GitHub Actions", WORKFLOW_RUN_ID_OPTION_NAME)}
+	}
+	requestOwner := ""
+	if owner != nil {
+		requestOwner = *owner
+	} else if s.repositoryOwner != nil {
+		requestOwner = *s.repositoryOwner
+	} else {
+		log.Warnf("the repository owner was not passed as a service option nor overridden as an argument, getting the approval may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_OWNER_OPTION_NAME)
+	}
+	requestRepository := ""
+	if repository != nil {
+		requestRepository = *repository
+	} else if s.repositoryName != nil {
+		requestRepository = *s.repositoryName
+	} else {
+		log.Warnf("the repository name was not passed as a service option nor overridden as an argument, getting the approval may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_NAME_OPTION_NAME)
+	}
+
+	log.Debugf("retrieving the approvals for environment '%s' of the GitHub workflow run '%s'", environment, *s.workflowRunID)
+	// this API is not supported by the client library so we use the raw request
+	request, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/actions/runs/%s/approvals", requestOwner, requestRepository, *s.workflowRunID), nil)
+	if err != nil {
+		return api.PENDING, &errs.TransportError{Message: fmt.Sprintf("could not build the request to retrieve the GitHub workflow run approvals"), Cause: err}
+	}
+	var approvals []struct {
+		State        string `json:"state"`
+		Environments []struct {
+			Name string `json:"name"`
+		} `json:"environments"`
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	}
+	response, err := s.client.Do(context.Background(), request, &approvals)
+	if err != nil {
+		if response != nil && (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) {
+			return api.PENDING, &errs.SecurityError{Message: fmt.Sprintf("could not retrieve the GitHub workflow run approvals"), Cause: err}
+		}
+		return api.PENDING, &errs.TransportError{Message: fmt.Sprintf("could not retrieve the GitHub workflow run approvals"), Cause: err}
+	}
+
+	for _, approval := range approvals {
+		for _, approvalEnvironment := range approval.Environments {
+			if approvalEnvironment.Name == environment {
+				switch approval.State {
+				case "approved":
+					log.Debugf("environment '%s' has been approved by '%s'", environment, approval.User.Login)
+					return api.APPROVED, nil
+				case "rejected":
+					log.Debugf("environment '%s' has been rejected by '%s'", environment, approval.User.Login)
+					return api.REJECTED, nil
+				}
+			}
+		}
+	}
+	log.Debugf("no approval has been recorded yet for environment '%s'", environment)
+	return api.PENDING, nil
+}
+
+/*
+Publishes a sticky comment on the given pull request. If a comment containing the given marker has already been
+published on the pull request it is updated with the new contents, otherwise a new comment is created.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner the pull request belongs to. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository the pull request belongs to. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - pullRequest the number of the pull request to comment. It may be nil, in which case the pull request
+    number must be passed as a service option or detected from the GitHub Actions environment.
+  - marker the string that identifies the comment among other comments on the pull request
+  - body the body of the comment, including the marker
+
+Errors can be:
+
+- IllegalStateError if the pull request number is not available or is not a valid number
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitHub) PublishPullRequestComment(owner *string, repository *string, pullRequest *string, marker string, body string) error {
+	if pullRequest == nil {
+		pullRequest = s.pullRequestNumber
+	}
+	if pullRequest == nil {
+		return &errs.IllegalStateError{Message: fmt.Sprintf("the pull request comment can't be published because the pull request number is not available. Use the '%s' option to set this value or run within a GitHub Actions pull request workflow", PULL_REQUEST_NUMBER_OPTION_NAME)}
+	}
+	number, err := strconv.Atoi(*pullRequest)
+	if err != nil {
+		return &errs.IllegalStateError{Message: fmt.Sprintf("the pull request number '%s' is not a valid number", *pullRequest), Cause: err}
+	}
+	requestOwner := ""
+	if owner != nil {
+		requestOwner = *owner
+	} else if s.repositoryOwner != nil {
+		requestOwner = *s.repositoryOwner
+	} else {
+		log.Warnf("the repository owner was not passed as a service option nor overridden as an argument, publishing the comment may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_OWNER_OPTION_NAME)
+	}
+	requestRepository := ""
+	if repository != nil {
+		requestRepository = *repository
+	} else if s.repositoryName != nil {
+		requestRepository = *s.repositoryName
+	} else {
+		log.Warnf("the repository name was not passed as a service option nor overridden as an argument, publishing the comment may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_NAME_OPTION_NAME)
+	}
+
+	log.Debugf("looking up existing comments on the GitHub pull request '%d'", number)
+	var existingComment *gh.IssueComment = nil
+	listOptions := &gh.IssueListCommentsOptions{ListOptions: gh.ListOptions{PerPage: 100}}
+	for existingComment == nil {
+		comments, response, err := s.client.Issues.ListComments(context.Background(), requestOwner, requestRepository, number, listOptions)
+		if err != nil {
+			if response != nil && (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) {
+				return &errs.SecurityError{Message: fmt.Sprintf("could not list the comments of the GitHub pull request '%d'", number), Cause: err}
+			}
+			return &errs.TransportError{Message: fmt.Sprintf("could not list the comments of the GitHub pull request '%d'", number), Cause: err}
+		}
+		for _, comment := range comments {
+			if strings.Contains(comment.GetBody(), marker) {
+				existingComment = comment
+				break
+			}
+		}
+		if response.NextPage == 0 {
+			break
+		}
+		listOptions.Page = response.NextPage
+	}
+
+	comment := &gh.IssueComment{Body: &body}
+	var response *gh.Response
+	if existingComment == nil {
+		log.Debugf("creating a new comment on the GitHub pull request '%d'", number)
+		_, response, err = s.client.Issues.CreateComment(context.Background(), requestOwner, requestRepository, number, comment)
+	} else {
+		log.Debugf("updating comment '%d' on the GitHub pull request '%d'", existingComment.GetID(), number)
+		_, response, err = s.client.Issues.EditComment(context.Background(), requestOwner, requestRepository, existingComment.GetID(), comment)
+	}
+	if err != nil {
+		if response != nil && (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) {
+			return &errs.SecurityError{Message: fmt.Sprintf("could not publish the comment to the GitHub pull request '%d'", number), Cause: err}
+		}
+		return &errs.TransportError{Message: fmt.Sprintf("could not publish the comment to the GitHub pull request '%d'", number), Cause: err}
+	}
+	return nil
+}
+
+/*
+Returns the contents of the file with the given path in the given branch of a repository.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to read the file from. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to read the file from. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - branch the name of the branch to read the file from. It may be nil, in which case the repository
+    default branch is used.
+  - path the path of the file within the repository
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails or the file can't be found
+*/
+func (s GitHub) GetFileContent(owner *string, repository *string, branch *string, path string) (string, error) {
+	requestOwner, requestRepository := s.resolveRepository(owner, repository)
+
+	getOptions := &gh.RepositoryContentGetOptions{}
+	if branch != nil {
+		getOptions.Ref = *branch
+	}
+	log.Debugf("reading file '%s' from the GitHub repository '%s/%s'", path, requestOwner, requestRepository)
+	file, _, response, err := s.client.Repositories.GetContents(context.Background(), requestOwner, requestRepository, path, getOptions)
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not read file '%s' from the GitHub repository '%s/%s'", path, requestOwner, requestRepository), err)
+	}
+	if file == nil {
+		return "", &errs.TransportError{Message: fmt.Sprintf("the path '%s' in the GitHub repository '%s/%s' is not a file", path, requestOwner, requestRepository)}
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return "", &errs.TransportError{Message: fmt.Sprintf("could not decode file '%s' from the GitHub repository '%s/%s'", path, requestOwner, requestRepository), Cause: err}
+	}
+	return content, nil
+}
+
+/*
+Returns the merged pull request that brought the commit with the given SHA-1 into the repository, or nil
+if the commit has not been merged by any pull request. When the commit belongs to more than one merged pull
+request the first one returned by GitHub is used.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to look up the pull request in. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to look up the pull request in. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - commit the SHA-1 of the commit to look up the pull request for
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitHub) GetMergedPullRequest(owner *string, repository *string, commit string) (*api.PullRequest, error) {
+	requestOwner, requestRepository := s.resolveRepository(owner, repository)
+
+	// the library version in use has no method for this endpoint so the request is built here
+	log.Debugf("looking up the pull requests for commit '%s' in the GitHub repository '%s/%s'", commit, requestOwner, requestRepository)
+	request, err := s.client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/commits/%s/pulls", requestOwner, requestRepository, commit), nil)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("could not build the request to list the pull requests for commit '%s'", commit), Cause: err}
+	}
+	// this endpoint used to require a preview media type, which is harmless now that it's generally available
+	request.Header.Set("Accept", "application/vnd.github.groot-preview+json")
+	var pullRequests []*gh.PullRequest
+	response, err := s.client.Do(context.Background(), request, &pullRequests)
+	if err != nil {
+		return nil, s.toServiceError(response, fmt.Sprintf("could not list the pull requests for commit '%s' in the GitHub repository '%s/%s'", commit, requestOwner, requestRepository), err)
+	}
+	for _, pullRequest := range pullRequests {
+		if pullRequest.MergedAt != nil {
+			log.Debugf("commit '%s' has been merged by the GitHub pull request '%d'", commit, pullRequest.GetNumber())
+			var apiPullRequest api.PullRequest = newGitHubPullRequest(*pullRequest)
+			return &apiPullRequest, nil
+		}
+	}
+	return nil, nil
+}
+
+/*
+Commits the given contents to the file with the given path on the head branch and opens a pull request
+to merge the head branch into the base branch. The head branch is created from the base branch and, if it
+already exists, it's reset to the base branch. If a pull request for the head branch is already open it's
+reused instead of opening a new one.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to open the pull request in. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to open the pull request in. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - base the name of the branch the pull request targets. It may be nil, in which case the repository
+    default branch is used.
+  - head the name of the branch to commit the change to and open the pull request from
+  - path the path of the file to update within the repository
+  - content the new contents of the file
+  - title the title of the pull request, also used as the commit message
+  - body the description of the pull request
+
+Returns the URL of the pull request.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitHub) OpenFileUpdatePullRequest(owner *string, repository *string, base *string, head string, path string, content string, title string, body string) (string, error) {
+	requestOwner, requestRepository := s.resolveRepository(owner, repository)
+
+	if base == nil {
+		log.Debugf("looking up the default branch of the GitHub repository '%s/%s'", requestOwner, requestRepository)
+		repo, response, err := s.client.Repositories.Get(context.Background(), requestOwner, requestRepository)
+		if err != nil {
+			return "", s.toServiceError(response, fmt.Sprintf("could not read the GitHub repository '%s/%s'", requestOwner, requestRepository), err)
+		}
+		defaultBranch := repo.GetDefaultBranch()
+		base = &defaultBranch
+	}
+
+	baseRef, response, err := s.client.Git.GetRef(context.Background(), requestOwner, requestRepository, "heads/"+*base)
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not read branch '%s' from the GitHub repository '%s/%s'", *base, requestOwner, requestRepository), err)
+	}
+	headRef := &gh.Reference{Ref: gh.String("refs/heads/" + head), Object: &gh.GitObject{SHA: baseRef.GetObject().SHA}}
+	log.Debugf("creating branch '%s' from '%s' in the GitHub repository '%s/%s'", head, *base, requestOwner, requestRepository)
+	_, response, err = s.client.Git.CreateRef(context.Background(), requestOwner, requestRepository, headRef)
+	if err != nil && response != nil && response.StatusCode == http.StatusUnprocessableEntity {
+		log.Debugf("branch '%s' already exists in the GitHub repository '%s/%s' and is reset to '%s'", head, requestOwner, requestRepository, *base)
+		_, response, err = s.client.Git.UpdateRef(context.Background(), requestOwner, requestRepository, headRef, true)
+	}
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not create branch '%s' in the GitHub repository '%s/%s'", head, requestOwner, requestRepository), err)
+	}
+
+	file, _, response, err := s.client.Repositories.GetContents(context.Background(), requestOwner, requestRepository, path, &gh.RepositoryContentGetOptions{Ref: head})
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not read file '%s' from the GitHub repository '%s/%s'", path, requestOwner, requestRepository), err)
+	}
+	if file == nil {
+		return "", &errs.TransportError{Message: fmt.Sprintf("the path '%s' in the GitHub repository '%s/%s' is not a file", path, requestOwner, requestRepository)}
+	}
+	log.Debugf("committing file '%s' to branch '%s' in the GitHub repository '%s/%s'", path, head, requestOwner, requestRepository)
+	_, response, err = s.client.Repositories.UpdateFile(context.Background(), requestOwner, requestRepository, path, &gh.RepositoryContentFileOptions{Message: &title, Content: []byte(content), SHA: file.SHA, Branch: &head})
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not update file '%s' in the GitHub repository '%s/%s'", path, requestOwner, requestRepository), err)
+	}
+
+	pullRequests, response, err := s.client.PullRequests.List(context.Background(), requestOwner, requestRepository, &gh.PullRequestListOptions{State: "open", Head: requestOwner + ":" + head, Base: *base})
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not list the pull requests of the GitHub repository '%s/%s'", requestOwner, requestRepository), err)
+	}
+	if len(pullRequests) > 0 {
+		log.Debugf("the GitHub pull request '%d' is already open for branch '%s' and has been updated", pullRequests[0].GetNumber(), head)
+		return pullRequests[0].GetHTMLURL(), nil
+	}
+	log.Debugf("opening a pull request from '%s' to '%s' in the GitHub repository '%s/%s'", head, *base, requestOwner, requestRepository)
+	pullRequest, response, err := s.client.PullRequests.Create(context.Background(), requestOwner, requestRepository, &gh.NewPullRequest{Title: &title, Head: &head, Base: base, Body: &body})
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not open the pull request in the GitHub repository '%s/%s'", requestOwner, requestRepository), err)
+	}
+	return pullRequest.GetHTMLURL(), nil
+}
+
+/*
+Marks the release with the given tag as yanked. On GitHub the release title is prefixed with YANKED_RELEASE_TITLE_PREFIX
+and the release is flagged as a pre-release so that it's no longer reported as the latest release.
+Releases whose title already starts with the yanked prefix are left untouched.
+
+Returns true if the release has been marked as yanked by this invocation, false if there is no release with the
+given tag or it was already marked as yanked.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to yank the release for. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to yank the release for. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - tag the tag the release refers to (i.e. 1.2.3, v4.5.6). It can't be nil
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitHub) YankRelease(owner *string, repository *string, tag string) (bool, error) {
+	requestOwner, requestRepository := s.resolveRepository(owner, repository)
+
+	log.Debugf("retrieving GitHub release '%s' from the repository '%s/%s' to yank it", tag, requestOwner, requestRepository)
+	release, response, err := s.client.Repositories.GetReleaseByTag(context.Background(), requestOwner, requestRepository, tag)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			log.Debugf("no GitHub release with tag '%s' was found, nothing to yank", tag)
+			return false, nil
+		}
+		return false, s.toServiceError(response, fmt.Sprintf("could not retrieve GitHub release by tag '%s'", tag), err)
+	}
+	if strings.HasPrefix(release.GetName(), api.YANKED_RELEASE_TITLE_PREFIX) {
+		log.Debugf("the GitHub release '%s' is already marked as yanked", tag)
+		return false, nil
+	}
+	name := release.GetName()
+	if name == "" {
+		name = tag
+	}
+	log.Debugf("marking GitHub release '%s' as yanked", tag)
+	_, response, err = s.client.Repositories.EditRelease(context.Background(), requestOwner, requestRepository, release.GetID(), &gh.RepositoryRelease{Name: gh.String(api.YANKED_RELEASE_TITLE_PREFIX + name), Prerelease: gh.Bool(true)})
+	if err != nil {
+		return false, s.toServiceError(response, fmt.Sprintf("could not mark GitHub release '%s' as yanked", tag), err)
+	}
+	return true, nil
+}
+
+/*
+Removes the release assets exceeding the retention policy configured with the RETENTION_KEEP_ASSETS and
+RETENTION_MAX_PRE_RELEASE_ASSET_SIZE options. Releases are considered from the most recent to the oldest, as
+returned by GitHub, while drafts are not affected and don't count among the releases whose assets are kept.
+
+Returns the removed assets, each in the '<tag>/<asset name>' form.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to enforce the retention for. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to enforce the retention for. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitHub) EnforceRetention(owner *string, repository *string) ([]string, error) {
+	res := []string{}
+	if !s.retentionPolicy.IsEnabled() {
+		log.Debugf("no retention policy has been configured for the GitHub service, no release asset is removed")
+		return res, nil
+	}
+	requestOwner, requestRepository := s.resolveRepository(owner, repository)
+
+	log.Debugf("enforcing the retention policy on the releases of the GitHub repository '%s/%s'", requestOwner, requestRepository)
+	position := 0
+	listOptions := &gh.ListOptions{PerPage: 100}
+	for {
+		releases, response, err := s.client.Repositories.ListReleases(context.Background(), requestOwner, requestRepository, listOptions)
+		if err != nil {
+			return nil, s.toServiceError(response, fmt.Sprintf("could not list the releases of the GitHub repository '%s/%s'", requestOwner, requestRepository), err)
+		}
+		for _, release := range releases {
+			if release.GetDraft() {
+				continue
+			}
+			releaseAssets, err := s.getReleaseAssetsByName(requestOwner, requestRepository, release.GetID())
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(releaseAssets))
+			for name := range releaseAssets {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				releaseAsset := releaseAssets[name]
+				if !s.retentionPolicy.Expires(position, release.GetPrerelease(), int64(releaseAsset.GetSize())) {
+					continue
+				}
+				log.Debugf("removing asset '%s' from GitHub release '%s' as per the retention policy", name, release.GetTagName())
+				response, err := s.client.Repositories.DeleteReleaseAsset(context.Background(), requestOwner, requestRepository, releaseAsset.GetID())
+				if err != nil {
+					return nil, s.toServiceError(response, fmt.Sprintf("could not remove asset '%s' from GitHub release '%s'", name, release.GetTagName()), err)
+				}
+				res = append(res, release.GetTagName()+"/"+name)
+			}
+			position++
+		}
+		if response == nil || response.NextPage == 0 {
+			break
+		}
+		listOptions.Page = response.NextPage
+	}
+	return res, nil
+}
+
+/*
+Returns the name of the default branch of a repository.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitHub) GetDefaultBranch(owner *string, repository *string) (string, error) {
+	requestOwner, requestRepository := s.resolveRepository(owner, repository)
+
+	log.Debugf("looking up the default branch of the GitHub repository '%s/%s'", requestOwner, requestRepository)
+	repo, response, err := s.client.Repositories.Get(context.Background(), requestOwner, requestRepository)
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not read the GitHub repository '%s/%s'", requestOwner, requestRepository), err)
+	}
+	return repo.GetDefaultBranch(), nil
+}
+
+/*
+Returns the tags of a repository, mapped by name to the SHA-1 of the commits they point to.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to read the tags from. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to read the tags from. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitHub) GetTags(owner *string, repository *string) (map[string]string, error) {
+	requestOwner, requestRepository := s.resolveRepository(owner, repository)
+
+	log.Debugf("listing the tags of the GitHub repository '%s/%s'", requestOwner, requestRepository)
+	res := make(map[string]string)
+	listOptions := &gh.ListOptions{PerPage: 100}
+	for {
+		tags, response, err := s.client.Repositories.ListTags(context.Background(), requestOwner, requestRepository, listOptions)
+		if err != nil {
+			return nil, s.toServiceError(response, fmt.Sprintf("could not list the tags of the GitHub repository '%s/%s'", requestOwner, requestRepository), err)
+		}
+		for _, tag := range tags {
+			if tag.Commit != nil {
+				res[tag.GetName()] = tag.Commit.GetSHA()
+			}
+		}
+		if response == nil || response.NextPage == 0 {
+			break
+		}
+		listOptions.Page = response.NextPage
+	}
+	return res, nil
+}
+
+/*
+Browses the commits reachable from the given reference, from the most recent to the oldest, passing each one
+to the given visitor. Commits are fetched one page at a time so that no more pages than needed are requested
+when the visitor stops the walk.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to read the commits from. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to read the commits from. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - ref the branch name or commit SHA-1 to start from
+  - visit the visitor function receiving each commit. Returns true to keep browsing next commits or false to stop.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails or the reference can't be found
+*/
+func (s GitHub) WalkCommits(owner *string, repository *string, ref string, visit func(commit api.Commit) bool) error {
+	requestOwner, requestRepository := s.resolveRepository(owner, repository)
+
+	log.Debugf("browsing the commits from '%s' in the GitHub repository '%s/%s'", ref, requestOwner, requestRepository)
+	listOptions := &gh.CommitsListOptions{SHA: ref, ListOptions: gh.ListOptions{PerPage: 100}}
+	for {
+		commits, response, err := s.client.Repositories.ListCommits(context.Background(), requestOwner, requestRepository, listOptions)
+		if err != nil {
+			return s.toServiceError(response, fmt.Sprintf("could not list the commits from '%s' in the GitHub repository '%s/%s'", ref, requestOwner, requestRepository), err)
+		}
+		for _, commit := range commits {
+			if !visit(newGitHubCommit(*commit)) {
+				return nil
+			}
+		}
+		if response == nil || response.NextPage == 0 {
+			return nil
+		}
+		listOptions.Page = response.NextPage
+	}
+}
+
+/*
+Returns the paths of the files changed by the commit with the given SHA-1, compared to its first parent.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to read the commit from. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to read the commit from. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - commit the SHA-1 of the commit to get the changed paths for
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails or the commit can't be found
+*/
+func (s GitHub) GetCommitChangedPaths(owner *string, repository *string, commit string) ([]string, error) {
+	requestOwner, requestRepository := s.resolveRepository(owner, repository)
+
+	log.Debugf("reading the files changed by commit '%s' in the GitHub repository '%s/%s'", commit, requestOwner, requestRepository)
+	repositoryCommit, response, err := s.client.Repositories.GetCommit(context.Background(), requestOwner, requestRepository, commit)
+	if err != nil {
+		return nil, s.toServiceError(response, fmt.Sprintf("could not read commit '%s' from the GitHub repository '%s/%s'", commit, requestOwner, requestRepository), err)
+	}
+	res := []string{}
+	for _, file := range repositoryCommit.Files {
+		res = append(res, file.GetFilename())
+	}
+	return res, nil
+}
+
+/*
+Returns the repository owner and name to use for a request, giving priority to the given arguments, if not nil, over the
+ones passed as service options.
+*/
+func (s GitHub) resolveRepository(owner *string, repository *string) (string, string) {
+	requestOwner := ""
+	if owner != nil {
+		requestOwner = *owner
+	} else if s.repositoryOwner != nil {
+		requestOwner = *s.repositoryOwner
+	} else {
+		log.Warnf("the repository owner was not passed as a service option nor overridden as an argument, the request may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_OWNER_OPTION_NAME)
+	}
+	requestRepository := ""
+	if repository != nil {
+		requestRepository = *repository
+	} else if s.repositoryName != nil {
+		requestRepository = *s.repositoryName
+	} else {
+		log.Warnf("the repository name was not passed as a service option nor overridden as an argument, the request may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_NAME_OPTION_NAME)
+	}
+	return requestOwner, requestRepository
+}
+
+/*
+Returns a SecurityError if the given response has an authentication or authorization failure status, a TransportError otherwise.
+*/
+func (s GitHub) toServiceError(response *gh.Response, message string, err error) error {
+	if response != nil && (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) {
+		return &errs.SecurityError{Message: message, Cause: err}
+	}
+	return &errs.TransportError{Message: message, Cause: err}
+}
+
 /*
 Safely checks if the underlying implementation supports the given operation. If this
 method returns true then the underlying class will not raise any
@@ -728,12 +1667,24 @@ Arguments are as follows:
 */
 func (s GitHub) Supports(feature api.Feature) bool {
 	switch feature {
+	case api.COMMIT_HISTORY:
+		return true
 	case api.GIT_HOSTING:
 		return true
+	case api.PULL_REQUEST_COMMENTS:
+		return true
+	case api.PULL_REQUESTS:
+		return true
 	case api.RELEASES:
 		return true
 	case api.RELEASE_ASSETS:
 		return true
+	case api.RELEASE_APPROVALS:
+		return true
+	case api.RELEASE_RETENTION:
+		return true
+	case api.RELEASE_YANKING:
+		return true
 	case api.USERS:
 		return true
 	default:
@@ -0,0 +1,230 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	"context"         // https://pkg.go.dev/context
+	"crypto"          // https://pkg.go.dev/crypto
+	"crypto/rand"     // https://pkg.go.dev/crypto/rand
+	"crypto/rsa"      // https://pkg.go.dev/crypto/rsa
+	"crypto/sha256"   // https://pkg.go.dev/crypto/sha256
+	"crypto/x509"     // https://pkg.go.dev/crypto/x509
+	"encoding/base64" // https://pkg.go.dev/encoding/base64
+	"encoding/json"   // https://pkg.go.dev/encoding/json
+	"encoding/pem"    // https://pkg.go.dev/encoding/pem
+	"fmt"             // https://pkg.go.dev/fmt
+	"os"              // https://pkg.go.dev/os
+	"strconv"         // https://pkg.go.dev/strconv
+	"strings"         // https://pkg.go.dev/strings
+	"sync"            // https://pkg.go.dev/sync
+	"time"            // https://pkg.go.dev/time
+
+	gh "github.com/google/go-github/github" // https://pkg.go.dev/github.com/google/go-github/github
+	log "github.com/sirupsen/logrus"        // https://github.com/Sirupsen/logrus, https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The user name to use along with installation tokens when authenticating Git operations.
+	INSTALLATION_TOKEN_USER = "x-access-token"
+
+	// Installation tokens are refreshed when they expire within this interval, so that they don't expire while in use.
+	INSTALLATION_TOKEN_REFRESH_MARGIN = 5 * time.Minute
+
+	// The validity of the JSON Web Tokens used to authenticate as a GitHub App. GitHub allows up to 10 minutes.
+	APP_JWT_VALIDITY = 9 * time.Minute
+)
+
+var (
+	// The installation tokens minted so far, by App, installation and endpoint, so they can be reused until they expire.
+	installationTokens = make(map[string]installationToken)
+
+	// The mutex guarding installationTokens.
+	installationTokensMutex sync.Mutex
+)
+
+/*
+An installation token along with its expiration time.
+*/
+type installationToken struct {
+	// The token value.
+	token string
+
+	// The time the token expires at.
+	expiresAt time.Time
+}
+
+/*
+Returns a token for the given GitHub App installation, to be used as the password (along with the INSTALLATION_TOKEN_USER
+user name) to authenticate Git operations.
+
+Tokens are minted on the fly and reused for subsequent calls until they are about to expire (within
+INSTALLATION_TOKEN_REFRESH_MARGIN), in which case a new token is minted, so that long running releases never use
+expired tokens.
+
+Arguments are as follows:
+
+  - baseURI the custom endpoint to use (for private GitHub instances). If nil or empty the standard endpoint will be used
+  - appID the ID of the GitHub App
+  - privateKey the private key of the GitHub App, either as the PEM encoded content or as the path to a PEM file
+  - installationID the ID of the App installation. If nil or empty the App must have exactly one installation, which is used
+
+Errors can be:
+
+  - IllegalArgumentError if some argument is missing or illegal
+  - IOError if the private key can't be read
+  - SecurityError if the private key can't be used or the installation can't be resolved
+  - TransportError if communication to the remote endpoint fails
+*/
+func GetInstallationToken(baseURI *string, appID string, privateKey string, installationID *string) (string, error) {
+	if "" == strings.TrimSpace(appID) {
+		return "", &errs.IllegalArgumentError{Message: fmt.Sprintf("the GitHub App ID is required to mint installation tokens")}
+	}
+	if "" == strings.TrimSpace(privateKey) {
+		return "", &errs.IllegalArgumentError{Message: fmt.Sprintf("the GitHub App private key is required to mint installation tokens")}
+	}
+	installation := ""
+	if installationID != nil {
+		installation = strings.TrimSpace(*installationID)
+	}
+	endpoint := ""
+	if baseURI != nil {
+		endpoint = strings.TrimSpace(*baseURI)
+	}
+	key := endpoint + "/" + strings.TrimSpace(appID) + "/" + installation
+
+	installationTokensMutex.Lock()
+	defer installationTokensMutex.Unlock()
+	if cached, ok := installationTokens[key]; ok && time.Now().Add(INSTALLATION_TOKEN_REFRESH_MARGIN).Before(cached.expiresAt) {
+		log.Debugf("reusing the installation token for the GitHub App '%s', expiring at %s", appID, cached.expiresAt.Format(time.RFC3339))
+		return cached.token, nil
+	}
+
+	log.Debugf("minting a new installation token for the GitHub App '%s'", appID)
+	pemBytes, err := readAppPrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+	jwt, err := newAppJWT(strings.TrimSpace(appID), pemBytes, time.Now())
+	if err != nil {
+		return "", err
+	}
+	client, err := newClientInstance(baseURI, &jwt)
+	if err != nil {
+		return "", &errs.TransportError{Message: fmt.Sprintf("unable to instantiate the GitHub client"), Cause: err}
+	}
+
+	var id int64
+	if "" == installation {
+		installations, _, err := client.Apps.ListInstallations(context.Background(), nil)
+		if err != nil {
+			return "", &errs.TransportError{Message: fmt.Sprintf("unable to list the installations of the GitHub App '%s'", appID), Cause: err}
+		}
+		if len(installations) != 1 || installations[0].ID == nil {
+			return "", &errs.SecurityError{Message: fmt.Sprintf("the GitHub App '%s' has %d installations so the installation ID must be configured", appID, len(installations))}
+		}
+		id = *installations[0].ID
+		log.Debugf("using the only installation '%d' of the GitHub App '%s'", id, appID)
+	} else {
+		id, err = strconv.ParseInt(installation, 10, 64)
+		if err != nil {
+			return "", &errs.IllegalArgumentError{Message: fmt.Sprintf("the GitHub App installation ID '%s' is not a number", installation), Cause: err}
+		}
+	}
+
+	// the go-github CreateInstallationToken uses a legacy endpoint so the request is built here
+	request, err := client.NewRequest("POST", fmt.Sprintf("app/installations/%d/access_tokens", id), nil)
+	if err != nil {
+		return "", &errs.TransportError{Message: fmt.Sprintf("unable to build the request to mint an installation token"), Cause: err}
+	}
+	token := new(gh.InstallationToken)
+	_, err = client.Do(context.Background(), request, token)
+	if err != nil {
+		return "", &errs.TransportError{Message: fmt.Sprintf("unable to mint an installation token for the installation '%d' of the GitHub App '%s'", id, appID), Cause: err}
+	}
+	if token.Token == nil {
+		return "", &errs.SecurityError{Message: fmt.Sprintf("no installation token was returned for the installation '%d' of the GitHub App '%s'", id, appID)}
+	}
+	expiresAt := time.Now().Add(time.Hour)
+	if token.ExpiresAt != nil {
+		expiresAt = *token.ExpiresAt
+	}
+	log.Debugf("a new installation token for the GitHub App '%s' has been minted, expiring at %s", appID, expiresAt.Format(time.RFC3339))
+	installationTokens[key] = installationToken{token: *token.Token, expiresAt: expiresAt}
+	return *token.Token, nil
+}
+
+/*
+Returns the PEM encoded private key, either the given one or the one read from the file at the given path.
+
+Errors can be:
+
+  - IOError if the private key can't be read from the given path
+*/
+func readAppPrivateKey(privateKey string) ([]byte, error) {
+	if strings.Contains(privateKey, "-----BEGIN ") {
+		return []byte(privateKey), nil
+	}
+	pemBytes, err := os.ReadFile(strings.TrimSpace(privateKey))
+	if err != nil {
+		return nil, &errs.IOError{Message: fmt.Sprintf("the GitHub App private key is not a PEM encoded key and can't be read as a file"), Cause: err}
+	}
+	return pemBytes, nil
+}
+
+/*
+Returns a new JSON Web Token, signed with the given RSA private key, to authenticate as the GitHub App with the given ID.
+The token is issued 60 seconds in the past to allow for clock drift, as recommended by GitHub.
+
+Errors can be:
+
+  - SecurityError if the private key can't be parsed or used
+*/
+func newAppJWT(appID string, pemBytes []byte, now time.Time) (string, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return "", &errs.SecurityError{Message: fmt.Sprintf("the GitHub App private key is not PEM encoded")}
+	}
+	var rsaKey *rsa.PrivateKey
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		rsaKey = key
+	} else if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		ok := false
+		if rsaKey, ok = key.(*rsa.PrivateKey); !ok {
+			return "", &errs.SecurityError{Message: fmt.Sprintf("the GitHub App private key is not an RSA key")}
+		}
+	} else {
+		return "", &errs.SecurityError{Message: fmt.Sprintf("the GitHub App private key can't be parsed"), Cause: err}
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", &errs.SecurityError{Message: fmt.Sprintf("unable to encode the JSON Web Token header"), Cause: err}
+	}
+	claims, err := json.Marshal(map[string]interface{}{"iat": now.Add(-60 * time.Second).Unix(), "exp": now.Add(APP_JWT_VALIDITY).Unix(), "iss": appID})
+	if err != nil {
+		return "", &errs.SecurityError{Message: fmt.Sprintf("unable to encode the JSON Web Token claims"), Cause: err}
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", &errs.SecurityError{Message: fmt.Sprintf("unable to sign the JSON Web Token"), Cause: err}
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,181 @@
+//go:build unit
+// +build unit
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	"crypto"            // https://pkg.go.dev/crypto
+	"crypto/rand"       // https://pkg.go.dev/crypto/rand
+	"crypto/rsa"        // https://pkg.go.dev/crypto/rsa
+	"crypto/sha256"     // https://pkg.go.dev/crypto/sha256
+	"crypto/x509"       // https://pkg.go.dev/crypto/x509
+	"encoding/base64"   // https://pkg.go.dev/encoding/base64
+	"encoding/json"     // https://pkg.go.dev/encoding/json
+	"encoding/pem"      // https://pkg.go.dev/encoding/pem
+	"fmt"               // https://pkg.go.dev/fmt
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"os"                // https://pkg.go.dev/os
+	"path/filepath"     // https://pkg.go.dev/path/filepath
+	"strings"           // https://pkg.go.dev/strings
+	"testing"           // https://pkg.go.dev/testing
+	"time"              // https://pkg.go.dev/time
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+Returns a new RSA private key along with its PEM encoded representation.
+*/
+func newAppPrivateKey(t *testing.T) (*rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+/*
+Returns a fake GitHub API server that mints installation tokens expiring after the given duration, along with the
+pointer to the number of tokens minted so far.
+*/
+func newAppServer(t *testing.T, installations string, tokenValidity time.Duration) (*httptest.Server, *int) {
+	minted := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "Bearer "))
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/app/installations":
+			fmt.Fprint(w, installations)
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/app/installations/") && strings.HasSuffix(r.URL.Path, "/access_tokens"):
+			minted++
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"token":"token-%d-%s","expires_at":"%s"}`, minted, strings.Split(r.URL.Path, "/")[3], time.Now().Add(tokenValidity).UTC().Format(time.RFC3339))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() {
+		installationTokensMutex.Lock()
+		defer installationTokensMutex.Unlock()
+		installationTokens = make(map[string]installationToken)
+	})
+	return server, &minted
+}
+
+func TestNewAppJWT(t *testing.T) {
+	key, pemString := newAppPrivateKey(t)
+	now := time.Now()
+
+	jwt, err := newAppJWT("12345", []byte(pemString), now)
+	assert.NoError(t, err)
+	parts := strings.Split(jwt, ".")
+	assert.Equal(t, 3, len(parts))
+
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"alg":"RS256","typ":"JWT"}`, string(header))
+
+	claimsBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	assert.NoError(t, err)
+	claims := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(claimsBytes, &claims))
+	assert.Equal(t, "12345", claims["iss"])
+	assert.Equal(t, float64(now.Add(-60*time.Second).Unix()), claims["iat"])
+	assert.Equal(t, float64(now.Add(APP_JWT_VALIDITY).Unix()), claims["exp"])
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	assert.NoError(t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+
+	// malformed keys
+	_, err = newAppJWT("12345", []byte("not a key"), now)
+	assert.Error(t, err)
+}
+
+func TestGetInstallationTokenWithMissingArguments(t *testing.T) {
+	_, pemString := newAppPrivateKey(t)
+
+	_, err := GetInstallationToken(nil, "", pemString, nil)
+	assert.Error(t, err)
+	_, err = GetInstallationToken(nil, "12345", "", nil)
+	assert.Error(t, err)
+	_, err = GetInstallationToken(nil, "12345", filepath.Join(t.TempDir(), "missing.pem"), nil)
+	assert.Error(t, err)
+}
+
+func TestGetInstallationTokenWithInstallationID(t *testing.T) {
+	server, minted := newAppServer(t, `[]`, time.Hour)
+	_, pemString := newAppPrivateKey(t)
+
+	token, err := GetInstallationToken(utl.PointerToString(server.URL), "12345", pemString, utl.PointerToString("678"))
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1-678", token)
+
+	// the token is reused as long as it's not about to expire
+	token, err = GetInstallationToken(utl.PointerToString(server.URL), "12345", pemString, utl.PointerToString("678"))
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1-678", token)
+	assert.Equal(t, 1, *minted)
+
+	// the installation ID must be a number
+	_, err = GetInstallationToken(utl.PointerToString(server.URL), "12345", pemString, utl.PointerToString("abc"))
+	assert.Error(t, err)
+}
+
+func TestGetInstallationTokenWithPrivateKeyFile(t *testing.T) {
+	server, _ := newAppServer(t, `[]`, time.Hour)
+	_, pemString := newAppPrivateKey(t)
+	path := filepath.Join(t.TempDir(), "app.pem")
+	assert.NoError(t, os.WriteFile(path, []byte(pemString), 0600))
+
+	token, err := GetInstallationToken(utl.PointerToString(server.URL), "12345", path, utl.PointerToString("678"))
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1-678", token)
+}
+
+func TestGetInstallationTokenRefreshesExpiringTokens(t *testing.T) {
+	// tokens expire within the refresh margin so they're never reused
+	server, minted := newAppServer(t, `[]`, INSTALLATION_TOKEN_REFRESH_MARGIN/2)
+	_, pemString := newAppPrivateKey(t)
+
+	token, err := GetInstallationToken(utl.PointerToString(server.URL), "12345", pemString, utl.PointerToString("678"))
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1-678", token)
+	token, err = GetInstallationToken(utl.PointerToString(server.URL), "12345", pemString, utl.PointerToString("678"))
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2-678", token)
+	assert.Equal(t, 2, *minted)
+}
+
+func TestGetInstallationTokenWithoutInstallationID(t *testing.T) {
+	_, pemString := newAppPrivateKey(t)
+
+	// the only installation is used
+	server, _ := newAppServer(t, `[{"id":901}]`, time.Hour)
+	token, err := GetInstallationToken(utl.PointerToString(server.URL), "12345", pemString, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1-901", token)
+
+	// the installation can't be resolved when there are many
+	server, _ = newAppServer(t, `[{"id":901},{"id":902}]`, time.Hour)
+	_, err = GetInstallationToken(utl.PointerToString(server.URL), "12345", pemString, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	gh "github.com/google/go-github/github" // https://pkg.go.dev/github.com/google/go-github/github
+)
+
+/*
+A remote GitHub commit.
+*/
+type GitHubCommit struct {
+	// The email of the commit author.
+	authorEmail string
+
+	// The name of the commit author.
+	authorName string
+
+	// The date the commit was authored, in milliseconds since the epoch.
+	authorDate int64
+
+	// The email of the committer.
+	committerEmail string
+
+	// The name of the committer.
+	committerName string
+
+	// The date the commit was committed, in milliseconds since the epoch.
+	committerDate int64
+
+	// The full commit message.
+	message string
+
+	// The SHA-1 identifiers of the parent commits.
+	parents []string
+
+	// The commit SHA-1 identifier.
+	sha string
+}
+
+/*
+Creates the commit object modelled by the attributes from the given reference.
+
+Arguments are as follows:
+
+  - commit the object to read the attributes from
+*/
+func newGitHubCommit(commit gh.RepositoryCommit) *GitHubCommit {
+	res := &GitHubCommit{}
+	res.sha = commit.GetSHA()
+	res.parents = []string{}
+	for _, parent := range commit.Parents {
+		res.parents = append(res.parents, parent.GetSHA())
+	}
+	if commit.Commit != nil {
+		res.message = commit.Commit.GetMessage()
+		if commit.Commit.Author != nil {
+			res.authorEmail = commit.Commit.Author.GetEmail()
+			res.authorName = commit.Commit.Author.GetName()
+			res.authorDate = commit.Commit.Author.GetDate().UnixMilli()
+		}
+		if commit.Commit.Committer != nil {
+			res.committerEmail = commit.Commit.Committer.GetEmail()
+			res.committerName = commit.Commit.Committer.GetName()
+			res.committerDate = commit.Commit.Committer.GetDate().UnixMilli()
+		}
+	}
+	return res
+}
+
+/*
+Returns the email of the commit author.
+*/
+func (c *GitHubCommit) GetAuthorEmail() string {
+	return c.authorEmail
+}
+
+/*
+Returns the name of the commit author.
+*/
+func (c *GitHubCommit) GetAuthorName() string {
+	return c.authorName
+}
+
+/*
+Returns the date the commit was authored, in milliseconds since the epoch.
+*/
+func (c *GitHubCommit) GetAuthorDate() int64 {
+	return c.authorDate
+}
+
+/*
+Returns the email of the committer.
+*/
+func (c *GitHubCommit) GetCommitterEmail() string {
+	return c.committerEmail
+}
+
+/*
+Returns the name of the committer.
+*/
+func (c *GitHubCommit) GetCommitterName() string {
+	return c.committerName
+}
+
+/*
+Returns the date the commit was committed, in milliseconds since the epoch.
+*/
+func (c *GitHubCommit) GetCommitterDate() int64 {
+	return c.committerDate
+}
+
+/*
+Returns the full commit message.
+*/
+func (c *GitHubCommit) GetMessage() string {
+	return c.message
+}
+
+/*
+Returns the SHA-1 identifiers of the parent commits, or an empty list if the commit has no parents.
+*/
+func (c *GitHubCommit) GetParents() []string {
+	return c.parents
+}
+
+/*
+Returns the commit SHA-1 identifier.
+*/
+func (c *GitHubCommit) GetSHA() string {
+	return c.sha
+}
@@ -0,0 +1,118 @@
+//go:build unit
+// +build unit
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	"fmt"               // https://pkg.go.dev/fmt
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"testing"           // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+/*
+Returns a GitHub service backed by a fake GitHub API server serving a repository with three commits, paginated
+two per page, and two tags.
+*/
+func newCommitHistoryService(t *testing.T) GitHub {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/repos/owner/repo":
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		case r.URL.Path == "/repos/owner/repo/tags":
+			fmt.Fprint(w, `[{"name":"1.0.0","commit":{"sha":"c1"}},{"name":"0.1.0","commit":{"sha":"c3"}}]`)
+		case r.URL.Path == "/repos/owner/repo/commits" && r.URL.Query().Get("page") == "":
+			w.Header().Set("Link", fmt.Sprintf(`<%srepos/owner/repo/commits?page=2>; rel="next"`, server.URL+"/"))
+			fmt.Fprint(w, `[{"sha":"c1","parents":[{"sha":"c2"}],"commit":{"message":"fix: third","author":{"name":"Jim","email":"jim@example.com","date":"2020-01-03T00:00:00Z"},"committer":{"name":"Jim","email":"jim@example.com","date":"2020-01-03T00:00:00Z"}}},{"sha":"c2","parents":[{"sha":"c3"}],"commit":{"message":"feat: second"}}]`)
+		case r.URL.Path == "/repos/owner/repo/commits" && r.URL.Query().Get("page") == "2":
+			fmt.Fprint(w, `[{"sha":"c3","parents":[],"commit":{"message":"Initial commit"}}]`)
+		case r.URL.Path == "/repos/owner/repo/commits/c1":
+			fmt.Fprint(w, `{"sha":"c1","files":[{"filename":"README.md"},{"filename":"src/main.go"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL + "/", REPOSITORY_OWNER_OPTION_NAME: "owner", REPOSITORY_NAME_OPTION_NAME: "repo"})
+	assert.NoError(t, err)
+	return service
+}
+
+func TestGetDefaultBranch(t *testing.T) {
+	service := newCommitHistoryService(t)
+
+	branch, err := service.GetDefaultBranch(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "main", branch)
+}
+
+func TestGetTags(t *testing.T) {
+	service := newCommitHistoryService(t)
+
+	tags, err := service.GetTags(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"1.0.0": "c1", "0.1.0": "c3"}, tags)
+}
+
+func TestWalkCommits(t *testing.T) {
+	service := newCommitHistoryService(t)
+
+	shas := []string{}
+	err := service.WalkCommits(nil, nil, "main", func(commit api.Commit) bool {
+		shas = append(shas, commit.GetSHA())
+		if commit.GetSHA() == "c1" {
+			assert.Equal(t, []string{"c2"}, commit.GetParents())
+			assert.Equal(t, "fix: third", commit.GetMessage())
+			assert.Equal(t, "Jim", commit.GetAuthorName())
+			assert.Equal(t, "jim@example.com", commit.GetCommitterEmail())
+			assert.Equal(t, int64(1578009600000), commit.GetCommitterDate())
+		}
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c1", "c2", "c3"}, shas)
+}
+
+func TestWalkCommitsStoppedByVisitor(t *testing.T) {
+	service := newCommitHistoryService(t)
+
+	shas := []string{}
+	err := service.WalkCommits(nil, nil, "main", func(commit api.Commit) bool {
+		shas = append(shas, commit.GetSHA())
+		return false
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c1"}, shas)
+}
+
+func TestGetCommitChangedPaths(t *testing.T) {
+	service := newCommitHistoryService(t)
+
+	paths, err := service.GetCommitChangedPaths(nil, nil, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"README.md", "src/main.go"}, paths)
+
+	_, err = service.GetCommitChangedPaths(nil, nil, "unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	gh "github.com/google/go-github/github" // https://pkg.go.dev/github.com/google/go-github/github
+)
+
+/*
+A remote GitHub pull request.
+*/
+type GitHubPullRequest struct {
+	// The names of the labels applied to the pull request.
+	labels []string
+
+	// The pull request number.
+	number int
+
+	// The pull request title.
+	title string
+}
+
+/*
+Creates the pull request object modelled by the attributes from the given reference.
+
+Arguments are as follows:
+
+  - pullRequest the object to read the attributes from
+*/
+func newGitHubPullRequest(pullRequest gh.PullRequest) *GitHubPullRequest {
+	res := &GitHubPullRequest{}
+	res.labels = []string{}
+	for _, label := range pullRequest.Labels {
+		res.labels = append(res.labels, label.GetName())
+	}
+	res.number = pullRequest.GetNumber()
+	res.title = pullRequest.GetTitle()
+	return res
+}
+
+/*
+Returns the names of the labels applied to the pull request, or an empty list if the pull request has no labels.
+*/
+func (r *GitHubPullRequest) GetLabels() []string {
+	return r.labels
+}
+
+/*
+Returns the pull request number.
+*/
+func (r *GitHubPullRequest) GetNumber() int {
+	return r.number
+}
+
+/*
+Returns the pull request title.
+*/
+func (r *GitHubPullRequest) GetTitle() string {
+	return r.title
+}
@@ -0,0 +1,72 @@
+//go:build unit
+// +build unit
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	"fmt"               // https://pkg.go.dev/fmt
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"testing"           // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+/*
+Returns a GitHub service backed by a fake GitHub API server that returns the given pull requests for every commit.
+*/
+func newPullRequestsService(t *testing.T, pullRequests string) GitHub {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/repos/owner/repo/commits/abc123/pulls" {
+			fmt.Fprint(w, pullRequests)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL + "/", REPOSITORY_OWNER_OPTION_NAME: "owner", REPOSITORY_NAME_OPTION_NAME: "repo"})
+	assert.NoError(t, err)
+	return service
+}
+
+func TestGetMergedPullRequest(t *testing.T) {
+	service := newPullRequestsService(t, `[{"number":1,"title":"feat: open","labels":[]},{"number":2,"title":"feat: merged","merged_at":"2020-01-01T00:00:00Z","labels":[{"name":"enhancement"},{"name":"breaking"}]}]`)
+
+	pullRequest, err := service.GetMergedPullRequest(nil, nil, "abc123")
+	assert.NoError(t, err)
+	assert.NotNil(t, pullRequest)
+	assert.Equal(t, 2, (*pullRequest).GetNumber())
+	assert.Equal(t, "feat: merged", (*pullRequest).GetTitle())
+	assert.Equal(t, []string{"enhancement", "breaking"}, (*pullRequest).GetLabels())
+}
+
+func TestGetMergedPullRequestWithUnmergedCommit(t *testing.T) {
+	service := newPullRequestsService(t, `[{"number":1,"title":"feat: open","labels":[]}]`)
+
+	pullRequest, err := service.GetMergedPullRequest(nil, nil, "abc123")
+	assert.NoError(t, err)
+	assert.Nil(t, pullRequest)
+}
+
+func TestGetMergedPullRequestWithUnknownCommit(t *testing.T) {
+	service := newPullRequestsService(t, `[]`)
+
+	_, err := service.GetMergedPullRequest(nil, nil, "def456")
+	assert.Error(t, err)
+}
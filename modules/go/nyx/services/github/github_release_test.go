@@ -0,0 +1,276 @@
+//go:build unit
+// +build unit
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	"encoding/json"     // https://pkg.go.dev/encoding/json
+	"fmt"               // https://pkg.go.dev/fmt
+	"io"                // https://pkg.go.dev/io
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"os"                // https://pkg.go.dev/os
+	"path/filepath"     // https://pkg.go.dev/path/filepath
+	"strconv"           // https://pkg.go.dev/strconv
+	"strings"           // https://pkg.go.dev/strings
+	"sync"              // https://pkg.go.dev/sync
+	"testing"           // https://pkg.go.dev/testing
+
+	gh "github.com/google/go-github/github"     // https://pkg.go.dev/github.com/google/go-github/github
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+A fake GitHub API server storing the assets uploaded to release 1, which can be told to corrupt a number of uploads.
+*/
+type assetsServer struct {
+	// The mutex guarding the server fields.
+	mutex sync.Mutex
+
+	// The asset contents, by asset ID.
+	contents map[int64]string
+
+	// The asset names, by asset ID.
+	names map[int64]string
+
+	// The ID of the next uploaded asset.
+	nextID int64
+
+	// The number of the next uploads to corrupt.
+	corruptUploads int
+
+	// The number of uploads received.
+	uploads int
+
+	// The number of deletions received.
+	deletions int
+}
+
+/*
+Returns a GitHub service backed by a fake GitHub API server for release assets, along with the server.
+*/
+func newAssetsService(t *testing.T, options map[string]string) (GitHub, *assetsServer) {
+	assets := &assetsServer{contents: map[int64]string{}, names: map[int64]string{}, nextID: 1}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assets.mutex.Lock()
+		defer assets.mutex.Unlock()
+		if r.Method == http.MethodGet && r.URL.Path == "/repos/owner/repo/releases/1/assets" {
+			list := []gh.ReleaseAsset{}
+			for id, name := range assets.names {
+				list = append(list, gh.ReleaseAsset{ID: gh.Int64(id), Name: gh.String(name), Size: gh.Int(len(assets.contents[id])), State: gh.String("uploaded")})
+			}
+			json.NewEncoder(w).Encode(list)
+		} else if r.Method == http.MethodPost && r.URL.Path == "/repos/owner/repo/releases/1/assets" {
+			body, _ := io.ReadAll(r.Body)
+			content := string(body)
+			assets.uploads++
+			if assets.corruptUploads > 0 {
+				assets.corruptUploads--
+				content = strings.ToUpper(content)
+			}
+			id := assets.nextID
+			assets.nextID++
+			assets.contents[id] = content
+			assets.names[id] = r.URL.Query().Get("name")
+			json.NewEncoder(w).Encode(gh.ReleaseAsset{ID: gh.Int64(id), Name: gh.String(assets.names[id]), URL: gh.String(fmt.Sprintf("http://%s/assets/%d", r.Host, id))})
+		} else if strings.HasPrefix(r.URL.Path, "/repos/owner/repo/releases/assets/") {
+			id, _ := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/releases/assets/"), 10, 64)
+			content, ok := assets.contents[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+			} else if r.Method == http.MethodGet {
+				fmt.Fprint(w, content)
+			} else if r.Method == http.MethodDelete {
+				assets.deletions++
+				delete(assets.contents, id)
+				delete(assets.names, id)
+				w.WriteHeader(http.StatusNoContent)
+			}
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	options[BASE_URI_OPTION_NAME] = server.URL + "/"
+	options[REPOSITORY_OWNER_OPTION_NAME] = "owner"
+	options[REPOSITORY_NAME_OPTION_NAME] = "repo"
+	service, err := Instance(options)
+	assert.NoError(t, err)
+	// don't wait between retries
+	service.uploadOptions.RetryDelay = 0
+	return service, assets
+}
+
+/*
+Creates a local asset file with the given content and returns the attachment for it.
+*/
+func newLocalAsset(t *testing.T, content string) ent.Attachment {
+	path := filepath.Join(t.TempDir(), "asset.txt")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return *ent.NewAttachmentWith(utl.PointerToString("asset.txt"), utl.PointerToString("The asset"), &path, utl.PointerToString("text/plain"))
+}
+
+func TestInstanceWithIllegalVerifyAssetsOption(t *testing.T) {
+	_, err := Instance(map[string]string{VERIFY_ASSETS_OPTION_NAME: "maybe"})
+	assert.Error(t, err)
+}
+
+func TestPublishReleaseAssetsRecordsDigests(t *testing.T) {
+	service, assets := newAssetsService(t, map[string]string{})
+	release := &GitHubRelease{id: 1, tag: "1.0.0"}
+
+	published, err := service.publishReleaseAssets(nil, nil, release, []ent.Attachment{newLocalAsset(t, "abc")})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(published.GetAssets()))
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", *published.GetAssets()[0].GetDigest())
+	assert.Equal(t, 1, assets.uploads)
+}
+
+func TestPublishReleaseAssetsWithVerificationUploadsCorruptedAssetsAgain(t *testing.T) {
+	service, assets := newAssetsService(t, map[string]string{VERIFY_ASSETS_OPTION_NAME: "true"})
+	assets.corruptUploads = 2
+	release := &GitHubRelease{id: 1, tag: "1.0.0"}
+
+	published, err := service.publishReleaseAssets(nil, nil, release, []ent.Attachment{newLocalAsset(t, "abc")})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(published.GetAssets()))
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", *published.GetAssets()[0].GetDigest())
+	// the two corrupted uploads have been deleted and the asset uploaded again
+	assert.Equal(t, 3, assets.uploads)
+	assert.Equal(t, 2, assets.deletions)
+	assert.Equal(t, 1, len(assets.contents))
+	for _, content := range assets.contents {
+		assert.Equal(t, "abc", content)
+	}
+}
+
+func TestPublishReleaseAssetsWithVerificationFailsWhenRetriesAreExhausted(t *testing.T) {
+	service, assets := newAssetsService(t, map[string]string{VERIFY_ASSETS_OPTION_NAME: "true", UPLOAD_RETRIES_OPTION_NAME: "1"})
+	assets.corruptUploads = 2
+	release := &GitHubRelease{id: 1, tag: "1.0.0"}
+
+	_, err := service.publishReleaseAssets(nil, nil, release, []ent.Attachment{newLocalAsset(t, "abc")})
+	assert.Error(t, err)
+	assert.Equal(t, 2, assets.uploads)
+}
+
+func TestPublishReleaseAssetsWithoutVerificationDoesNotDetectCorruption(t *testing.T) {
+	service, assets := newAssetsService(t, map[string]string{VERIFY_ASSETS_OPTION_NAME: "false"})
+	assets.corruptUploads = 1
+	release := &GitHubRelease{id: 1, tag: "1.0.0"}
+
+	_, err := service.publishReleaseAssets(nil, nil, release, []ent.Attachment{newLocalAsset(t, "abc")})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, assets.uploads)
+	assert.Equal(t, 0, assets.deletions)
+}
+
+func TestPublishReleaseAssetsWithVerificationReplacesExistingCorruptedAssets(t *testing.T) {
+	service, assets := newAssetsService(t, map[string]string{VERIFY_ASSETS_OPTION_NAME: "true"})
+	// an asset with the same name and size but a different content has been uploaded by a previous run
+	assets.contents[assets.nextID] = "ABC"
+	assets.names[assets.nextID] = "asset.txt"
+	assets.nextID++
+	release := &GitHubRelease{id: 1, tag: "1.0.0"}
+
+	_, err := service.publishReleaseAssets(nil, nil, release, []ent.Attachment{newLocalAsset(t, "abc")})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, assets.uploads)
+	assert.Equal(t, 1, assets.deletions)
+	for _, content := range assets.contents {
+		assert.Equal(t, "abc", content)
+	}
+}
+
+/*
+Returns a GitHub service backed by a fake GitHub API server publishing the given releases, each with a small and a
+large asset, along with the names of the deleted assets.
+*/
+func newRetentionService(t *testing.T, options map[string]string, releases []gh.RepositoryRelease) (GitHub, *[]string) {
+	deleted := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/repos/owner/repo/releases" {
+			json.NewEncoder(w).Encode(releases)
+		} else if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/repos/owner/repo/releases/") && strings.HasSuffix(r.URL.Path, "/assets") {
+			id, _ := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/releases/"), "/assets"), 10, 64)
+			json.NewEncoder(w).Encode([]gh.ReleaseAsset{
+				{ID: gh.Int64(id*10 + 1), Name: gh.String("small.txt"), Size: gh.Int(10)},
+				{ID: gh.Int64(id*10 + 2), Name: gh.String("large.bin"), Size: gh.Int(1000)},
+			})
+		} else if r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/repos/owner/repo/releases/assets/") {
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/releases/assets/"))
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	options[BASE_URI_OPTION_NAME] = server.URL + "/"
+	options[REPOSITORY_OWNER_OPTION_NAME] = "owner"
+	options[REPOSITORY_NAME_OPTION_NAME] = "repo"
+	service, err := Instance(options)
+	assert.NoError(t, err)
+	return service, &deleted
+}
+
+func TestInstanceWithIllegalRetentionOptions(t *testing.T) {
+	_, err := Instance(map[string]string{RETENTION_KEEP_ASSETS_OPTION_NAME: "all"})
+	assert.Error(t, err)
+	_, err = Instance(map[string]string{RETENTION_MAX_PRE_RELEASE_ASSET_SIZE_OPTION_NAME: "1GB"})
+	assert.Error(t, err)
+}
+
+func TestEnforceRetention(t *testing.T) {
+	// releases are returned from the most recent to the oldest
+	releases := []gh.RepositoryRelease{
+		{ID: gh.Int64(5), TagName: gh.String("2.0.0-alpha.1"), Draft: gh.Bool(true)},
+		{ID: gh.Int64(4), TagName: gh.String("1.1.0-alpha.1"), Prerelease: gh.Bool(true)},
+		{ID: gh.Int64(3), TagName: gh.String("1.0.0")},
+		{ID: gh.Int64(2), TagName: gh.String("0.2.0")},
+		{ID: gh.Int64(1), TagName: gh.String("0.1.0")},
+	}
+
+	t.Run("NoPolicy", func(t *testing.T) {
+		service, deleted := newRetentionService(t, map[string]string{}, releases)
+		removed, err := service.EnforceRetention(nil, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, removed)
+		assert.Empty(t, *deleted)
+	})
+
+	t.Run("KeepAssets", func(t *testing.T) {
+		service, deleted := newRetentionService(t, map[string]string{RETENTION_KEEP_ASSETS_OPTION_NAME: "2"}, releases)
+		removed, err := service.EnforceRetention(nil, nil)
+		assert.NoError(t, err)
+		// the draft doesn't count so the assets of the two oldest releases are removed
+		assert.Equal(t, []string{"0.2.0/large.bin", "0.2.0/small.txt", "0.1.0/large.bin", "0.1.0/small.txt"}, removed)
+		assert.Equal(t, []string{"22", "21", "12", "11"}, *deleted)
+	})
+
+	t.Run("MaxPreReleaseAssetSize", func(t *testing.T) {
+		service, deleted := newRetentionService(t, map[string]string{RETENTION_MAX_PRE_RELEASE_ASSET_SIZE_OPTION_NAME: "100"}, releases)
+		removed, err := service.EnforceRetention(nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1.1.0-alpha.1/large.bin"}, removed)
+		assert.Equal(t, []string{"42"}, *deleted)
+	})
+}
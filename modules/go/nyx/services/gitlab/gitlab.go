@@ -20,17 +20,22 @@ This is the GitLab package for Nyx, providing services to work with the GitLab s
 package gitlab
 
 import (
-	"fmt"     // https://pkg.go.dev/fmt
-	"net/url" // https://pkg.go.dev/net/url
-	"os"      // https://pkg.go.dev/os
-	"strings" // https://pkg.go.dev/strings
+	"fmt"      // https://pkg.go.dev/fmt
+	stdio "io" // https://pkg.go.dev/io
+	"net/http" // https://pkg.go.dev/net/http
+	"net/url"  // https://pkg.go.dev/net/url
+	"os"       // https://pkg.go.dev/os
+	"strconv"  // https://pkg.go.dev/strconv
+	"strings"  // https://pkg.go.dev/strings
 
 	log "github.com/sirupsen/logrus" // https://github.com/Sirupsen/logrus, https://pkg.go.dev/github.com/sirupsen/logrus
 	gl "github.com/xanzy/go-gitlab"  // https://pkg.go.dev/github.com/xanzy/go-gitlab
 
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	io "github.com/mooltiverse/nyx/modules/go/nyx/io"
 	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+	ver "github.com/mooltiverse/nyx/modules/go/version"
 )
 
 const (
@@ -78,6 +83,58 @@ const (
 		If this option is not passed the service will not be able to perform some of its operations.
 	*/
 	REPOSITORY_OWNER_OPTION_NAME = "REPOSITORY_OWNER"
+
+	/*
+		The name of the option used to pass the ID of the GitLab CI/CD pipeline to look up approvals for.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the value of the CI_PIPELINE_ID environment variable is used, if any.
+		When no pipeline ID is available the latest deployment to the environment is used to look up approvals.
+	*/
+	PIPELINE_ID_OPTION_NAME = "PIPELINE_ID"
+
+	/*
+		The name of the option used to pass the internal ID (IID) of the merge request to publish comments to.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the value of the CI_MERGE_REQUEST_IID environment variable is used, if any.
+	*/
+	MERGE_REQUEST_IID_OPTION_NAME = "MERGE_REQUEST_IID"
+
+	/*
+		The name of the option used to pass the maximum number of bytes per second sent when uploading release assets.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed uploads are not throttled.
+	*/
+	UPLOAD_BANDWIDTH_LIMIT_OPTION_NAME = "UPLOAD_BANDWIDTH_LIMIT"
+
+	/*
+		The name of the option used to pass the size (in bytes) of the chunks release assets are read by when uploading.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed chunks of 1 MiB are used.
+	*/
+	UPLOAD_CHUNK_SIZE_OPTION_NAME = "UPLOAD_CHUNK_SIZE"
+
+	/*
+		The name of the option used to pass the number of times a failed release asset upload is retried.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed failed uploads are retried 3 times.
+	*/
+	UPLOAD_RETRIES_OPTION_NAME = "UPLOAD_RETRIES"
+
+	/*
+		The name of the option used to pass the number of most recent releases whose assets are kept when enforcing
+		the retention policy. Assets attached to older releases are removed.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the assets of all releases are kept.
+	*/
+	RETENTION_KEEP_ASSETS_OPTION_NAME = "RETENTION_KEEP_ASSETS"
+
+	/*
+		The name of the option used to pass the maximum size (in bytes) of the assets attached to pre-releases when
+		enforcing the retention policy. Larger assets are removed from pre-releases.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed pre-release assets are not limited in size.
+	*/
+	RETENTION_MAX_PRE_RELEASE_ASSET_SIZE_OPTION_NAME = "RETENTION_MAX_PRE_RELEASE_ASSET_SIZE"
 )
 
 /*
@@ -92,6 +149,19 @@ type GitLab struct {
 	// It may be nil, but some operations may fail.
 	repositoryName *string
 
+	// The internal ID (IID) of the merge request comments are published to. It may be nil, but comments can't be published
+	// unless the merge request IID is passed explicitly.
+	mergeRequestIID *string
+
+	// The ID of the GitLab CI/CD pipeline approvals are looked up for. It may be nil.
+	pipelineID *string
+
+	// The options used to upload release assets.
+	uploadOptions io.UploadOptions
+
+	// The retention policy enforced on release assets.
+	retentionPolicy api.RetentionPolicy
+
 	// The private API client instance.
 	client gl.Client
 }
@@ -116,6 +186,7 @@ func newGitLab(client gl.Client, repositoryOwner *string, repositoryName *string
 	res.client = client
 	res.repositoryOwner = repositoryOwner
 	res.repositoryName = repositoryName
+	res.uploadOptions = io.NewUploadOptions()
 	return res, nil
 }
 
@@ -186,6 +257,16 @@ func Instance(options map[string]string) (GitLab, error) {
 		log.Warnf("no repository owner passed to the '%s' service, some features may not work. Use the '%s' option to set this value", "GitLab", REPOSITORY_OWNER_OPTION_NAME)
 	}
 
+	uploadOptions, err := io.ParseUploadOptions(options, UPLOAD_BANDWIDTH_LIMIT_OPTION_NAME, UPLOAD_CHUNK_SIZE_OPTION_NAME, UPLOAD_RETRIES_OPTION_NAME)
+	if err != nil {
+		return GitLab{}, err
+	}
+
+	retentionPolicy, err := api.ParseRetentionPolicy(options, RETENTION_KEEP_ASSETS_OPTION_NAME, RETENTION_MAX_PRE_RELEASE_ASSET_SIZE_OPTION_NAME)
+	if err != nil {
+		return GitLab{}, err
+	}
+
 	log.Tracef("instantiating new GitLab service")
 
 	client, err := newClientInstance(&uriString, &authenticationToken)
@@ -193,7 +274,27 @@ func Instance(options map[string]string) (GitLab, error) {
 		return GitLab{}, &errs.NilPointerError{Message: fmt.Sprintf("could not create a GitLab service client"), Cause: err}
 	}
 
-	return newGitLab(client, &repositoryOwner, &repositoryName)
+	res, err := newGitLab(client, &repositoryOwner, &repositoryName)
+	if err != nil {
+		return res, err
+	}
+	pipelineID, ok := options[PIPELINE_ID_OPTION_NAME]
+	if !ok || "" == strings.TrimSpace(pipelineID) {
+		pipelineID = os.Getenv("CI_PIPELINE_ID")
+	}
+	if "" != strings.TrimSpace(pipelineID) {
+		res.pipelineID = &pipelineID
+	}
+	mergeRequestIID, ok := options[MERGE_REQUEST_IID_OPTION_NAME]
+	if !ok || "" == strings.TrimSpace(mergeRequestIID) {
+		mergeRequestIID = os.Getenv("CI_MERGE_REQUEST_IID")
+	}
+	if "" != strings.TrimSpace(mergeRequestIID) {
+		res.mergeRequestIID = &mergeRequestIID
+	}
+	res.uploadOptions = uploadOptions
+	res.retentionPolicy = retentionPolicy
+	return res, nil
 }
 
 /*
@@ -654,18 +755,31 @@ func (s GitLab) publishReleaseAssets(owner *string, repository *string, release
 	var result []ent.Attachment
 	for _, asset := range assets {
 		filePath := asset.GetPath()
-		_, err := os.Stat(*filePath)
+		fileInfo, err := os.Stat(*filePath)
 		if err == nil {
-			file, err := os.Open(*filePath)
-			log.Debugf("uploading asset '%s' (description: '%s', type: '%s', path: '%s')", *asset.GetFileName(), *asset.GetDescription(), *asset.GetType(), *asset.GetPath())
 			// we always upload to the 'generic' package registry here
-			genericPackageSelectValue := gl.SelectPackageFile
-			publishedPackage, _, err := s.client.GenericPackages.PublishPackageFile(requestOwner+"/"+requestRepository, url.QueryEscape(*asset.GetDescription()), release.GetTag(), *asset.GetFileName(), file, &gl.PublishPackageFileOptions{Select: &genericPackageSelectValue})
+			packageName := url.QueryEscape(*asset.GetDescription())
+			// files uploaded by a previous run are not uploaded again so that interrupted uploads can be resumed
+			packageFile, err := s.getGenericPackageFile(requestOwner+"/"+requestRepository, packageName, release.GetTag(), *asset.GetFileName())
 			if err != nil {
-				log.Debugf("an error occurred while uploading GitLab asset '%s': %v", *asset.GetFileName(), err)
-				return nil, errs.TransportError{Message: fmt.Sprintf("an error occurred while uploading GitLab asset '%s'", *asset.GetFileName()), Cause: err}
+				return nil, err
+			}
+			var assetURL string
+			if packageFile != nil && int64(packageFile.Size) == fileInfo.Size() {
+				log.Infof("asset '%s' has already been uploaded to the GitLab package registry and will not be uploaded again", *asset.GetFileName())
+				packageURL, err := s.client.GenericPackages.FormatPackageURL(requestOwner+"/"+requestRepository, packageName, release.GetTag(), *asset.GetFileName())
+				if err != nil {
+					return nil, errs.TransportError{Message: fmt.Sprintf("could not format the URL of GitLab asset '%s'", *asset.GetFileName()), Cause: err}
+				}
+				assetURL = s.client.BaseURL().String() + packageURL
+			} else {
+				log.Debugf("uploading asset '%s' (description: '%s', type: '%s', path: '%s')", *asset.GetFileName(), *asset.GetDescription(), *asset.GetType(), *asset.GetPath())
+				assetURL, err = s.uploadGenericPackageFile(requestOwner+"/"+requestRepository, packageName, release.GetTag(), *asset.GetFileName(), *filePath)
+				if err != nil {
+					log.Debugf("an error occurred while uploading GitLab asset '%s': %v", *asset.GetFileName(), err)
+					return nil, errs.TransportError{Message: fmt.Sprintf("an error occurred while uploading GitLab asset '%s'", *asset.GetFileName()), Cause: err}
+				}
 			}
-			assetURL := publishedPackage.File.URL
 			log.Debugf("asset '%s' (type: '%s', path: '%s') has been uploaded and is available to URL '%s'", *asset.GetFileName(), *asset.GetType(), *asset.GetPath(), assetURL)
 			result = append(result, *ent.NewAttachmentWith(asset.GetFileName(), asset.GetDescription(), &assetURL, asset.GetType()))
 		} else {
@@ -681,8 +795,20 @@ func (s GitLab) publishReleaseAssets(owner *string, repository *string, release
 		}
 	}
 
-	// step 2: upload asset links to the release
+	// step 2: upload asset links to the release, skipping those already attached by a previous run
+	existingLinks, err := s.listReleaseAssets(&requestOwner, &requestRepository, release.GetTag())
+	if err != nil {
+		return nil, err
+	}
+	existingLinkNames := make(map[string]bool)
+	for _, existingLink := range existingLinks {
+		existingLinkNames[*existingLink.GetFileName()] = true
+	}
 	for _, asset := range result {
+		if existingLinkNames[*asset.GetDescription()] {
+			log.Debugf("release '%s' already has a link for asset '%s' which will not be added again", release.GetTag(), *asset.GetFileName())
+			continue
+		}
 		log.Debugf("updating release '%s' with asset '%s' (description: '%s', type: '%s', path: '%s')", release.GetTag(), *asset.GetFileName(), *asset.GetDescription(), *asset.GetType(), *asset.GetPath())
 
 		_, _, err := s.client.ReleaseLinks.CreateReleaseLink(requestOwner+"/"+requestRepository, release.GetTag(), &gl.CreateReleaseLinkOptions{Name: /*asset.GetName()*/ asset.GetDescription(), URL: asset.GetPath()})
@@ -697,6 +823,67 @@ func (s GitLab) publishReleaseAssets(owner *string, repository *string, release
 	return &GitLabRelease{title: release.GetTitle(), tag: release.GetTag(), assets: result}, nil
 }
 
+/*
+Returns the file with the given name within the generic package with the given name and version, or nil if
+the package or the file do not exist.
+
+Errors can be:
+
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) getGenericPackageFile(project string, packageName string, packageVersion string, fileName string) (*gl.PackageFile, error) {
+	packageType := "generic"
+	packages, _, err := s.client.Packages.ListProjectPackages(project, &gl.ListProjectPackagesOptions{PackageName: &packageName, PackageType: &packageType})
+	if err != nil {
+		log.Debugf("an error occurred while listing the GitLab packages named '%s': %v", packageName, err)
+		return nil, errs.TransportError{Message: fmt.Sprintf("could not list the GitLab packages named '%s'", packageName), Cause: err}
+	}
+	for _, p := range packages {
+		// the package name filter also matches packages whose name just contains the given one
+		if p.Name != packageName || p.Version != packageVersion {
+			continue
+		}
+		packageFiles, _, err := s.client.Packages.ListPackageFiles(project, p.ID, &gl.ListPackageFilesOptions{PerPage: 100})
+		if err != nil {
+			log.Debugf("an error occurred while listing the files of GitLab package '%s': %v", packageName, err)
+			return nil, errs.TransportError{Message: fmt.Sprintf("could not list the files of GitLab package '%s'", packageName), Cause: err}
+		}
+		// when the same file is uploaded more times the latest one is returned
+		var res *gl.PackageFile
+		for _, packageFile := range packageFiles {
+			if packageFile.FileName == fileName {
+				res = packageFile
+			}
+		}
+		return res, nil
+	}
+	return nil, nil
+}
+
+/*
+Uploads the file at the given path to the generic package with the given name and version and returns the URL
+of the uploaded file. The file is uploaded within the configured bandwidth limit, logging the progress, and
+failed uploads are retried.
+
+Errors can be:
+
+- IOError if the file can't be read
+- any error returned by the remote endpoint during the last attempt
+*/
+func (s GitLab) uploadGenericPackageFile(project string, packageName string, packageVersion string, fileName string, path string) (string, error) {
+	genericPackageSelectValue := gl.SelectPackageFile
+	var publishedPackage *gl.GenericPackagesFile
+	err := io.Upload(path, s.uploadOptions, func(reader stdio.Reader, size int64) error {
+		var err error
+		publishedPackage, _, err = s.client.GenericPackages.PublishPackageFile(project, packageName, packageVersion, fileName, reader, &gl.PublishPackageFileOptions{Select: &genericPackageSelectValue})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return publishedPackage.File.URL, nil
+}
+
 /*
 Publishes a set of assets for a release. Even when the service supports the RELEASE_ASSETS
 feature not all types of assets may be supported. Please check the implementation class for any restrictions
@@ -745,6 +932,697 @@ func (s GitLab) PublishReleaseAssets(owner *string, repository *string, release
 	}
 }
 
+/*
+Returns the status of the approval for the given environment. Approvals are looked up on the most recent deployment
+to the given environment made by the current CI/CD pipeline, which is given by the PIPELINE_ID option or the
+CI_PIPELINE_ID environment variable or, when no pipeline is available, on the most recent deployment to the
+environment. The environment must be a protected environment with required approvals.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to get the approval for. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to get the approval for. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - environment the name of the protected environment the approval is required for. It can't be nil
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) GetApprovalStatus(owner *string, repository *string, environment string) (api.ApprovalStatus, error) {
+	requestOwner := ""
+	if owner != nil {
+		requestOwner = *owner
+	} else if s.repositoryOwner != nil {
+		requestOwner = *s.repositoryOwner
+	} else {
+		log.Warnf("the repository owner was not passed as a service option nor overridden as an argument, getting the approval may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_OWNER_OPTION_NAME)
+	}
+	requestRepository := ""
+	if repository != nil {
+		requestRepository = *repository
+	} else if s.repositoryName != nil {
+		requestRepository = *s.repositoryName
+	} else {
+		log.Warnf("the repository name was not passed as a service option nor overridden as an argument, getting the approval may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_NAME_OPTION_NAME)
+	}
+
+	log.Debugf("retrieving the deployments to environment '%s' from the remote GitLab service", environment)
+	// approvals are not modelled by the client library so we use the raw request
+	request, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/deployments", gl.PathEscape(requestOwner+"/"+requestRepository)), &gl.ListProjectDeploymentsOptions{Environment: gl.String(environment), OrderBy: gl.String("id"), Sort: gl.String("desc")}, nil)
+	if err != nil {
+		return api.PENDING, &errs.TransportError{Message: fmt.Sprintf("could not build the request to retrieve the GitLab deployments"), Cause: err}
+	}
+	var deployments []struct {
+		ID         int    `json:"id"`
+		Status     string `json:"status"`
+		Deployable struct {
+			Pipeline struct {
+				ID int `json:"id"`
+			} `json:"pipeline"`
+		} `json:"deployable"`
+		Approvals []struct {
+			Status string `json:"status"`
+			User   struct {
+				Username string `json:"username"`
+			} `json:"user"`
+		} `json:"approvals"`
+	}
+	response, err := s.client.Do(request, &deployments)
+	if err != nil {
+		if response != nil && (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) {
+			return api.PENDING, &errs.SecurityError{Message: fmt.Sprintf("could not retrieve the GitLab deployments"), Cause: err}
+		}
+		return api.PENDING, &errs.TransportError{Message: fmt.Sprintf("could not retrieve the GitLab deployments"), Cause: err}
+	}
+
+	for _, deployment := range deployments {
+		if s.pipelineID != nil && strconv.Itoa(deployment.Deployable.Pipeline.ID) != *s.pipelineID {
+			continue
+		}
+		for _, approval := range deployment.Approvals {
+			if approval.Status == "rejected" {
+				log.Debugf("deployment '%d' to environment '%s' has been rejected by '%s'", deployment.ID, environment, approval.User.Username)
+				return api.REJECTED, nil
+			}
+		}
+		if deployment.Status == "blocked" {
+			log.Debugf("deployment '%d' to environment '%s' is still waiting for approval", deployment.ID, environment)
+			return api.PENDING, nil
+		}
+		log.Debugf("deployment '%d' to environment '%s' is no longer waiting for approval (status is '%s')", deployment.ID, environment, deployment.Status)
+		return api.APPROVED, nil
+	}
+	log.Debugf("no deployment to environment '%s' has been found yet", environment)
+	return api.PENDING, nil
+}
+
+/*
+Publishes a sticky comment (note) on the given merge request. If a comment containing the given marker has already been
+published on the merge request it is updated with the new contents, otherwise a new comment is created.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner the merge request belongs to. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository the merge request belongs to. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - pullRequest the internal ID (IID) of the merge request to comment. It may be nil, in which case the merge request
+    IID must be passed as a service option or detected from the GitLab CI/CD environment.
+  - marker the string that identifies the comment among other comments on the merge request
+  - body the body of the comment, including the marker
+
+Errors can be:
+
+- IllegalStateError if the merge request IID is not available or is not a valid number
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) PublishPullRequestComment(owner *string, repository *string, pullRequest *string, marker string, body string) error {
+	if pullRequest == nil {
+		pullRequest = s.mergeRequestIID
+	}
+	if pullRequest == nil {
+		return &errs.IllegalStateError{Message: fmt.Sprintf("the merge request comment can't be published because the merge request IID is not available. Use the '%s' option to set this value or run within a GitLab CI/CD merge request pipeline", MERGE_REQUEST_IID_OPTION_NAME)}
+	}
+	iid, err := strconv.Atoi(*pullRequest)
+	if err != nil {
+		return &errs.IllegalStateError{Message: fmt.Sprintf("the merge request IID '%s' is not a valid number", *pullRequest), Cause: err}
+	}
+	requestOwner := ""
+	if owner != nil {
+		requestOwner = *owner
+	} else if s.repositoryOwner != nil {
+		requestOwner = *s.repositoryOwner
+	} else {
+		log.Warnf("the repository owner was not passed as a service option nor overridden as an argument, publishing the comment may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_OWNER_OPTION_NAME)
+	}
+	requestRepository := ""
+	if repository != nil {
+		requestRepository = *repository
+	} else if s.repositoryName != nil {
+		requestRepository = *s.repositoryName
+	} else {
+		log.Warnf("the repository name was not passed as a service option nor overridden as an argument, publishing the comment may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_NAME_OPTION_NAME)
+	}
+
+	log.Debugf("looking up existing comments on the GitLab merge request '%d'", iid)
+	var existingNote *gl.Note = nil
+	listOptions := &gl.ListMergeRequestNotesOptions{ListOptions: gl.ListOptions{PerPage: 100}}
+	for existingNote == nil {
+		notes, response, err := s.client.Notes.ListMergeRequestNotes(requestOwner+"/"+requestRepository, iid, listOptions)
+		if err != nil {
+			if response != nil && (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) {
+				return &errs.SecurityError{Message: fmt.Sprintf("could not list the comments of the GitLab merge request '%d'", iid), Cause: err}
+			}
+			return &errs.TransportError{Message: fmt.Sprintf("could not list the comments of the GitLab merge request '%d'", iid), Cause: err}
+		}
+		for _, note := range notes {
+			if !note.System && strings.Contains(note.Body, marker) {
+				existingNote = note
+				break
+			}
+		}
+		if response.NextPage == 0 {
+			break
+		}
+		listOptions.Page = response.NextPage
+	}
+
+	var response *gl.Response
+	if existingNote == nil {
+		log.Debugf("creating a new comment on the GitLab merge request '%d'", iid)
+		_, response, err = s.client.Notes.CreateMergeRequestNote(requestOwner+"/"+requestRepository, iid, &gl.CreateMergeRequestNoteOptions{Body: &body})
+	} else {
+		log.Debugf("updating comment '%d' on the GitLab merge request '%d'", existingNote.ID, iid)
+		_, response, err = s.client.Notes.UpdateMergeRequestNote(requestOwner+"/"+requestRepository, iid, existingNote.ID, &gl.UpdateMergeRequestNoteOptions{Body: &body})
+	}
+	if err != nil {
+		if response != nil && (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) {
+			return &errs.SecurityError{Message: fmt.Sprintf("could not publish the comment to the GitLab merge request '%d'", iid), Cause: err}
+		}
+		return &errs.TransportError{Message: fmt.Sprintf("could not publish the comment to the GitLab merge request '%d'", iid), Cause: err}
+	}
+	return nil
+}
+
+/*
+Returns the contents of the file with the given path in the given branch of a repository.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to read the file from. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to read the file from. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - branch the name of the branch to read the file from. It may be nil, in which case the repository
+    default branch is used.
+  - path the path of the file within the repository
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails or the file can't be found
+*/
+func (s GitLab) GetFileContent(owner *string, repository *string, branch *string, path string) (string, error) {
+	project := s.resolveProject(owner, repository)
+
+	getOptions := &gl.GetRawFileOptions{}
+	if branch == nil {
+		defaultBranch, err := s.getDefaultBranch(project)
+		if err != nil {
+			return "", err
+		}
+		branch = &defaultBranch
+	}
+	getOptions.Ref = branch
+	log.Debugf("reading file '%s' from the GitLab project '%s'", path, project)
+	content, response, err := s.client.RepositoryFiles.GetRawFile(project, path, getOptions)
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not read file '%s' from the GitLab project '%s'", path, project), err)
+	}
+	return string(content), nil
+}
+
+/*
+Returns the merged merge request that brought the commit with the given SHA-1 into the repository, or nil
+if the commit has not been merged by any merge request. When the commit belongs to more than one merged merge
+request the first one returned by GitLab is used.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to look up the merge request in. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to look up the merge request in. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - commit the SHA-1 of the commit to look up the merge request for
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) GetMergedPullRequest(owner *string, repository *string, commit string) (*api.PullRequest, error) {
+	project := s.resolveProject(owner, repository)
+
+	log.Debugf("looking up the merge requests for commit '%s' in the GitLab project '%s'", commit, project)
+	mergeRequests, response, err := s.client.Commits.ListMergeRequestsByCommit(project, commit)
+	if err != nil {
+		return nil, s.toServiceError(response, fmt.Sprintf("could not list the merge requests for commit '%s' in the GitLab project '%s'", commit, project), err)
+	}
+	for _, mergeRequest := range mergeRequests {
+		if "merged" == mergeRequest.State {
+			log.Debugf("commit '%s' has been merged by the GitLab merge request '%d'", commit, mergeRequest.IID)
+			var apiPullRequest api.PullRequest = newGitLabMergeRequest(*mergeRequest)
+			return &apiPullRequest, nil
+		}
+	}
+	return nil, nil
+}
+
+/*
+Commits the given contents to the file with the given path on the head branch and opens a merge request
+to merge the head branch into the base branch. The head branch is created from the base branch and, if it
+already exists, it's reset to the base branch. If a merge request for the head branch is already open it's
+reused instead of opening a new one.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to open the merge request in. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to open the merge request in. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - base the name of the branch the merge request targets. It may be nil, in which case the repository
+    default branch is used.
+  - head the name of the branch to commit the change to and open the merge request from
+  - path the path of the file to update within the repository
+  - content the new contents of the file
+  - title the title of the merge request, also used as the commit message
+  - body the description of the merge request
+
+Returns the URL of the merge request.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) OpenFileUpdatePullRequest(owner *string, repository *string, base *string, head string, path string, content string, title string, body string) (string, error) {
+	project := s.resolveProject(owner, repository)
+
+	if base == nil {
+		defaultBranch, err := s.getDefaultBranch(project)
+		if err != nil {
+			return "", err
+		}
+		base = &defaultBranch
+	}
+
+	// the force flag makes the head branch start over from the base branch in case it already exists
+	log.Debugf("committing file '%s' to branch '%s' in the GitLab project '%s'", path, head, project)
+	commitOptions := &gl.CreateCommitOptions{
+		Branch:        &head,
+		StartBranch:   base,
+		CommitMessage: &title,
+		Force:         gl.Bool(true),
+		Actions:       []*gl.CommitActionOptions{{Action: gl.FileAction(gl.FileUpdate), FilePath: &path, Content: &content}},
+	}
+	_, response, err := s.client.Commits.CreateCommit(project, commitOptions)
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not commit file '%s' to branch '%s' in the GitLab project '%s'", path, head, project), err)
+	}
+
+	mergeRequests, response, err := s.client.MergeRequests.ListProjectMergeRequests(project, &gl.ListProjectMergeRequestsOptions{State: gl.String("opened"), SourceBranch: &head, TargetBranch: base})
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not list the merge requests of the GitLab project '%s'", project), err)
+	}
+	if len(mergeRequests) > 0 {
+		log.Debugf("the GitLab merge request '%d' is already open for branch '%s' and has been updated", mergeRequests[0].IID, head)
+		return mergeRequests[0].WebURL, nil
+	}
+	log.Debugf("opening a merge request from '%s' to '%s' in the GitLab project '%s'", head, *base, project)
+	mergeRequest, response, err := s.client.MergeRequests.CreateMergeRequest(project, &gl.CreateMergeRequestOptions{Title: &title, Description: &body, SourceBranch: &head, TargetBranch: base})
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not open the merge request in the GitLab project '%s'", project), err)
+	}
+	return mergeRequest.WebURL, nil
+}
+
+/*
+Marks the release with the given tag as yanked. On GitLab the release title is prefixed with YANKED_RELEASE_TITLE_PREFIX.
+Releases whose title already starts with the yanked prefix are left untouched.
+
+Returns true if the release has been marked as yanked by this invocation, false if there is no release with the
+given tag or it was already marked as yanked.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to yank the release for. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to yank the release for. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - tag the tag the release refers to (i.e. 1.2.3, v4.5.6). It can't be nil
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) YankRelease(owner *string, repository *string, tag string) (bool, error) {
+	project := s.resolveProject(owner, repository)
+
+	log.Debugf("retrieving GitLab release '%s' from the project '%s' to yank it", tag, project)
+	release, response, err := s.client.Releases.GetRelease(project, tag)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			log.Debugf("no GitLab release with tag '%s' was found, nothing to yank", tag)
+			return false, nil
+		}
+		return false, s.toServiceError(response, fmt.Sprintf("could not retrieve GitLab release by tag '%s'", tag), err)
+	}
+	if strings.HasPrefix(release.Name, api.YANKED_RELEASE_TITLE_PREFIX) {
+		log.Debugf("the GitLab release '%s' is already marked as yanked", tag)
+		return false, nil
+	}
+	name := release.Name
+	if name == "" {
+		name = tag
+	}
+	log.Debugf("marking GitLab release '%s' as yanked", tag)
+	_, response, err = s.client.Releases.UpdateRelease(project, tag, &gl.UpdateReleaseOptions{Name: gl.String(api.YANKED_RELEASE_TITLE_PREFIX + name)})
+	if err != nil {
+		return false, s.toServiceError(response, fmt.Sprintf("could not mark GitLab release '%s' as yanked", tag), err)
+	}
+	return true, nil
+}
+
+/*
+Removes the release assets exceeding the retention policy configured with the RETENTION_KEEP_ASSETS and
+RETENTION_MAX_PRE_RELEASE_ASSET_SIZE options. Releases are considered from the most recent to the oldest, as
+returned by GitLab. Since GitLab has no pre-release flag, releases whose tag is a semantic version with a
+pre-release identifier are considered pre-releases.
+
+Only the assets uploaded to the generic package registry (as published by PublishReleaseAssets) are removed,
+along with the release links pointing to them, while links to external resources are left untouched as they don't
+take any storage.
+
+Returns the removed assets, each in the '<tag>/<asset name>' form.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to enforce the retention for. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to enforce the retention for. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) EnforceRetention(owner *string, repository *string) ([]string, error) {
+	res := []string{}
+	if !s.retentionPolicy.IsEnabled() {
+		log.Debugf("no retention policy has been configured for the GitLab service, no release asset is removed")
+		return res, nil
+	}
+	project := s.resolveProject(owner, repository)
+
+	log.Debugf("enforcing the retention policy on the releases of the GitLab project '%s'", project)
+	packagesByVersion, err := s.getGenericPackagesByVersion(project)
+	if err != nil {
+		return nil, err
+	}
+	position := 0
+	listOptions := &gl.ListReleasesOptions{ListOptions: gl.ListOptions{PerPage: 100}}
+	for {
+		releases, response, err := s.client.Releases.ListReleases(project, listOptions)
+		if err != nil {
+			return nil, s.toServiceError(response, fmt.Sprintf("could not list the releases of the GitLab project '%s'", project), err)
+		}
+		for _, release := range releases {
+			preRelease := false
+			if version, err := ver.ValueOfSemanticVersionWithSanitization(release.TagName, true); err == nil {
+				preRelease = version.GetPrerelease() != nil
+			}
+			for _, p := range packagesByVersion[release.TagName] {
+				packageFiles, response, err := s.client.Packages.ListPackageFiles(project, p.ID, &gl.ListPackageFilesOptions{PerPage: 100})
+				if err != nil {
+					return nil, s.toServiceError(response, fmt.Sprintf("could not list the files of GitLab package '%s'", p.Name), err)
+				}
+				for _, packageFile := range packageFiles {
+					if !s.retentionPolicy.Expires(position, preRelease, int64(packageFile.Size)) {
+						continue
+					}
+					log.Debugf("removing asset '%s' from GitLab release '%s' as per the retention policy", packageFile.FileName, release.TagName)
+					response, err := s.client.Packages.DeletePackageFile(project, p.ID, packageFile.ID)
+					if err != nil {
+						return nil, s.toServiceError(response, fmt.Sprintf("could not remove asset '%s' from GitLab release '%s'", packageFile.FileName, release.TagName), err)
+					}
+					for _, link := range release.Assets.Links {
+						if strings.HasSuffix(link.URL, "/"+p.Version+"/"+packageFile.FileName) {
+							_, response, err := s.client.ReleaseLinks.DeleteReleaseLink(project, release.TagName, link.ID)
+							if err != nil {
+								return nil, s.toServiceError(response, fmt.Sprintf("could not remove the link to asset '%s' from GitLab release '%s'", packageFile.FileName, release.TagName), err)
+							}
+						}
+					}
+					res = append(res, release.TagName+"/"+packageFile.FileName)
+				}
+			}
+			position++
+		}
+		if response == nil || response.NextPage == 0 {
+			break
+		}
+		listOptions.Page = response.NextPage
+	}
+	return res, nil
+}
+
+/*
+Returns the generic packages of the given project, grouped by version.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) getGenericPackagesByVersion(project string) (map[string][]*gl.Package, error) {
+	res := make(map[string][]*gl.Package)
+	packageType := "generic"
+	listOptions := &gl.ListProjectPackagesOptions{ListOptions: gl.ListOptions{PerPage: 100}, PackageType: &packageType}
+	for {
+		packages, response, err := s.client.Packages.ListProjectPackages(project, listOptions)
+		if err != nil {
+			return nil, s.toServiceError(response, fmt.Sprintf("could not list the generic packages of the GitLab project '%s'", project), err)
+		}
+		for _, p := range packages {
+			res[p.Version] = append(res[p.Version], p)
+		}
+		if response == nil || response.NextPage == 0 {
+			return res, nil
+		}
+		listOptions.Page = response.NextPage
+	}
+}
+
+/*
+Returns the name of the default branch of a repository.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) GetDefaultBranch(owner *string, repository *string) (string, error) {
+	return s.getDefaultBranch(s.resolveProject(owner, repository))
+}
+
+/*
+Returns the tags of a repository, mapped by name to the SHA-1 of the commits they point to.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to read the tags from. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to read the tags from. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) GetTags(owner *string, repository *string) (map[string]string, error) {
+	project := s.resolveProject(owner, repository)
+
+	log.Debugf("listing the tags of the GitLab project '%s'", project)
+	res := make(map[string]string)
+	listOptions := &gl.ListTagsOptions{ListOptions: gl.ListOptions{PerPage: 100}}
+	for {
+		tags, response, err := s.client.Tags.ListTags(project, listOptions)
+		if err != nil {
+			return nil, s.toServiceError(response, fmt.Sprintf("could not list the tags of the GitLab project '%s'", project), err)
+		}
+		for _, tag := range tags {
+			if tag.Commit != nil {
+				res[tag.Name] = tag.Commit.ID
+			}
+		}
+		if response.NextPage == 0 {
+			break
+		}
+		listOptions.Page = response.NextPage
+	}
+	return res, nil
+}
+
+/*
+Browses the commits reachable from the given reference, from the most recent to the oldest, passing each one
+to the given visitor. Commits are fetched one page at a time so that no more pages than needed are requested
+when the visitor stops the walk.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to read the commits from. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to read the commits from. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - ref the branch name or commit SHA-1 to start from
+  - visit the visitor function receiving each commit. Returns true to keep browsing next commits or false to stop.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails or the reference can't be found
+*/
+func (s GitLab) WalkCommits(owner *string, repository *string, ref string, visit func(commit api.Commit) bool) error {
+	project := s.resolveProject(owner, repository)
+
+	log.Debugf("browsing the commits from '%s' in the GitLab project '%s'", ref, project)
+	listOptions := &gl.ListCommitsOptions{RefName: gl.String(ref), ListOptions: gl.ListOptions{PerPage: 100}}
+	for {
+		commits, response, err := s.client.Commits.ListCommits(project, listOptions)
+		if err != nil {
+			return s.toServiceError(response, fmt.Sprintf("could not list the commits from '%s' in the GitLab project '%s'", ref, project), err)
+		}
+		for _, commit := range commits {
+			if !visit(newGitLabCommit(*commit)) {
+				return nil
+			}
+		}
+		if response.NextPage == 0 {
+			return nil
+		}
+		listOptions.Page = response.NextPage
+	}
+}
+
+/*
+Returns the paths of the files changed by the commit with the given SHA-1, compared to its first parent.
+Renamed files are reported with both their old and new paths.
+
+Arguments are as follows:
+
+  - owner the name of the repository owner to read the commit from. It may be nil, in which case,
+    the repository owner must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - repository the name of the repository to read the commit from. It may be nil, in which case,
+    the repository name must be passed as a service option (see services implementing this interface for more
+    details on the options they accept). If not nil this value overrides the option passed to the service.
+  - commit the SHA-1 of the commit to get the changed paths for
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails or the commit can't be found
+*/
+func (s GitLab) GetCommitChangedPaths(owner *string, repository *string, commit string) ([]string, error) {
+	project := s.resolveProject(owner, repository)
+
+	log.Debugf("reading the files changed by commit '%s' in the GitLab project '%s'", commit, project)
+	res := []string{}
+	listOptions := &gl.GetCommitDiffOptions{PerPage: 100}
+	for {
+		diffs, response, err := s.client.Commits.GetCommitDiff(project, commit, listOptions)
+		if err != nil {
+			return nil, s.toServiceError(response, fmt.Sprintf("could not read commit '%s' from the GitLab project '%s'", commit, project), err)
+		}
+		for _, diff := range diffs {
+			if diff.OldPath != "" && diff.OldPath != diff.NewPath {
+				res = append(res, diff.OldPath)
+			}
+			res = append(res, diff.NewPath)
+		}
+		if response.NextPage == 0 {
+			break
+		}
+		listOptions.Page = response.NextPage
+	}
+	return res, nil
+}
+
+/*
+Returns the name of the default branch of the given project.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails or there is no currently authenticated user
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GitLab) getDefaultBranch(project string) (string, error) {
+	log.Debugf("looking up the default branch of the GitLab project '%s'", project)
+	p, response, err := s.client.Projects.GetProject(project, nil)
+	if err != nil {
+		return "", s.toServiceError(response, fmt.Sprintf("could not read the GitLab project '%s'", project), err)
+	}
+	return p.DefaultBranch, nil
+}
+
+/*
+Returns the project path to use for a request, built from the repository owner and name, giving priority to the given
+arguments, if not nil, over the ones passed as service options.
+*/
+func (s GitLab) resolveProject(owner *string, repository *string) string {
+	requestOwner := ""
+	if owner != nil {
+		requestOwner = *owner
+	} else if s.repositoryOwner != nil {
+		requestOwner = *s.repositoryOwner
+	} else {
+		log.Warnf("the repository owner was not passed as a service option nor overridden as an argument, the request may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_OWNER_OPTION_NAME)
+	}
+	requestRepository := ""
+	if repository != nil {
+		requestRepository = *repository
+	} else if s.repositoryName != nil {
+		requestRepository = *s.repositoryName
+	} else {
+		log.Warnf("the repository name was not passed as a service option nor overridden as an argument, the request may fail. Use the '%s' option to set this option or override it when invoking this method.", REPOSITORY_NAME_OPTION_NAME)
+	}
+	return requestOwner + "/" + requestRepository
+}
+
+/*
+Returns a SecurityError if the given response has an authentication or authorization failure status, a TransportError otherwise.
+*/
+func (s GitLab) toServiceError(response *gl.Response, message string, err error) error {
+	if response != nil && (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) {
+		return &errs.SecurityError{Message: message, Cause: err}
+	}
+	return &errs.TransportError{Message: message, Cause: err}
+}
+
 /*
 Safely checks if the underlying implementation supports the given operation. If this
 method returns true then the underlying class will not raise any
@@ -756,12 +1634,24 @@ Arguments are as follows:
 */
 func (s GitLab) Supports(feature api.Feature) bool {
 	switch feature {
+	case api.COMMIT_HISTORY:
+		return true
 	case api.GIT_HOSTING:
 		return true
+	case api.PULL_REQUEST_COMMENTS:
+		return true
+	case api.PULL_REQUESTS:
+		return true
 	case api.RELEASES:
 		return true
 	case api.RELEASE_ASSETS:
 		return true
+	case api.RELEASE_APPROVALS:
+		return true
+	case api.RELEASE_RETENTION:
+		return true
+	case api.RELEASE_YANKING:
+		return true
 	case api.USERS:
 		return true
 	default:
@@ -0,0 +1,142 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gitlab
+
+import (
+	gl "github.com/xanzy/go-gitlab" // https://pkg.go.dev/github.com/xanzy/go-gitlab
+)
+
+/*
+A remote GitLab commit.
+*/
+type GitLabCommit struct {
+	// The email of the commit author.
+	authorEmail string
+
+	// The name of the commit author.
+	authorName string
+
+	// The date the commit was authored, in milliseconds since the epoch.
+	authorDate int64
+
+	// The email of the committer.
+	committerEmail string
+
+	// The name of the committer.
+	committerName string
+
+	// The date the commit was committed, in milliseconds since the epoch.
+	committerDate int64
+
+	// The full commit message.
+	message string
+
+	// The SHA-1 identifiers of the parent commits.
+	parents []string
+
+	// The commit SHA-1 identifier.
+	sha string
+}
+
+/*
+Creates the commit object modelled by the attributes from the given reference.
+
+Arguments are as follows:
+
+  - commit the object to read the attributes from
+*/
+func newGitLabCommit(commit gl.Commit) *GitLabCommit {
+	res := &GitLabCommit{}
+	res.sha = commit.ID
+	res.parents = []string{}
+	res.parents = append(res.parents, commit.ParentIDs...)
+	res.message = commit.Message
+	res.authorEmail = commit.AuthorEmail
+	res.authorName = commit.AuthorName
+	if commit.AuthoredDate != nil {
+		res.authorDate = commit.AuthoredDate.UnixMilli()
+	}
+	res.committerEmail = commit.CommitterEmail
+	res.committerName = commit.CommitterName
+	if commit.CommittedDate != nil {
+		res.committerDate = commit.CommittedDate.UnixMilli()
+	}
+	return res
+}
+
+/*
+Returns the email of the commit author.
+*/
+func (c *GitLabCommit) GetAuthorEmail() string {
+	return c.authorEmail
+}
+
+/*
+Returns the name of the commit author.
+*/
+func (c *GitLabCommit) GetAuthorName() string {
+	return c.authorName
+}
+
+/*
+Returns the date the commit was authored, in milliseconds since the epoch.
+*/
+func (c *GitLabCommit) GetAuthorDate() int64 {
+	return c.authorDate
+}
+
+/*
+Returns the email of the committer.
+*/
+func (c *GitLabCommit) GetCommitterEmail() string {
+	return c.committerEmail
+}
+
+/*
+Returns the name of the committer.
+*/
+func (c *GitLabCommit) GetCommitterName() string {
+	return c.committerName
+}
+
+/*
+Returns the date the commit was committed, in milliseconds since the epoch.
+*/
+func (c *GitLabCommit) GetCommitterDate() int64 {
+	return c.committerDate
+}
+
+/*
+Returns the full commit message.
+*/
+func (c *GitLabCommit) GetMessage() string {
+	return c.message
+}
+
+/*
+Returns the SHA-1 identifiers of the parent commits, or an empty list if the commit has no parents.
+*/
+func (c *GitLabCommit) GetParents() []string {
+	return c.parents
+}
+
+/*
+Returns the commit SHA-1 identifier.
+*/
+func (c *GitLabCommit) GetSHA() string {
+	return c.sha
+}
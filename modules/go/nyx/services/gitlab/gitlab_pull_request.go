@@ -0,0 +1,72 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gitlab
+
+import (
+	gl "github.com/xanzy/go-gitlab" // https://pkg.go.dev/github.com/xanzy/go-gitlab
+)
+
+/*
+A remote GitLab merge request.
+*/
+type GitLabMergeRequest struct {
+	// The names of the labels applied to the merge request.
+	labels []string
+
+	// The merge request number.
+	number int
+
+	// The merge request title.
+	title string
+}
+
+/*
+Creates the merge request object modelled by the attributes from the given reference.
+
+Arguments are as follows:
+
+  - mergeRequest the object to read the attributes from
+*/
+func newGitLabMergeRequest(mergeRequest gl.MergeRequest) *GitLabMergeRequest {
+	res := &GitLabMergeRequest{}
+	res.labels = []string{}
+	res.labels = append(res.labels, mergeRequest.Labels...)
+	res.number = mergeRequest.IID
+	res.title = mergeRequest.Title
+	return res
+}
+
+/*
+Returns the names of the labels applied to the merge request, or an empty list if the merge request has no labels.
+*/
+func (r *GitLabMergeRequest) GetLabels() []string {
+	return r.labels
+}
+
+/*
+Returns the merge request number.
+*/
+func (r *GitLabMergeRequest) GetNumber() int {
+	return r.number
+}
+
+/*
+Returns the merge request title.
+*/
+func (r *GitLabMergeRequest) GetTitle() string {
+	return r.title
+}
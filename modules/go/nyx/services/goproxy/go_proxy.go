@@ -0,0 +1,315 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This is the Go proxy package for Nyx, providing services to warm up the Go module proxy and checksum database
+so that Go module consumers see new releases immediately.
+*/
+package goproxy
+
+import (
+	"fmt"      // https://pkg.go.dev/fmt
+	"io"       // https://pkg.go.dev/io
+	"net/http" // https://pkg.go.dev/net/http
+	"strings"  // https://pkg.go.dev/strings
+	"unicode"  // https://pkg.go.dev/unicode
+
+	log "github.com/sirupsen/logrus" // https://github.com/Sirupsen/logrus, https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+const (
+	/*
+		The name of the option used to pass the base URI of the Go module proxy to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the DEFAULT_BASE_URI is used.
+	*/
+	BASE_URI_OPTION_NAME = "BASE_URI"
+
+	/*
+		The name of the option used to pass the base URI of the Go checksum database to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the DEFAULT_SUM_URI is used. If this option is SUM_URI_OFF the checksum
+		database is not queried.
+	*/
+	SUM_URI_OPTION_NAME = "SUM_URI"
+
+	/*
+		The name of the option used to pass the module path (i.e. 'github.com/octocat/hello-world') to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is mandatory.
+	*/
+	MODULE_PATH_OPTION_NAME = "MODULE_PATH"
+
+	// The default Go module proxy URI.
+	DEFAULT_BASE_URI = "https://proxy.golang.org"
+
+	// The default Go checksum database URI.
+	DEFAULT_SUM_URI = "https://sum.golang.org"
+
+	// The value to use for the SUM_URI option to disable queries to the checksum database.
+	SUM_URI_OFF = "off"
+)
+
+/*
+The entry point to the Go module proxy remote service.
+*/
+type GoProxy struct {
+	// The base URI of the module proxy, without the trailing slash.
+	baseURI string
+
+	// The base URI of the checksum database, without the trailing slash. It's nil when the checksum database must not be queried.
+	sumURI *string
+
+	// The module path.
+	modulePath string
+
+	// The private HTTP client instance.
+	client *http.Client
+}
+
+/*
+Returns an instance using the given options.
+
+Arguments are as follows:
+
+  - options the map of options for the requested service. It can't be nil.
+    Valid options are documented as constants on this class.
+
+Errors can be:
+
+- NilPointerError if the given options map is nil
+- IllegalArgumentError if some entries in the given options map are missing or illegal for some reason
+*/
+func Instance(options map[string]string) (GoProxy, error) {
+	if options == nil {
+		return GoProxy{}, &errs.NilPointerError{Message: fmt.Sprintf("can't create a new instance with a null options map")}
+	}
+
+	res := GoProxy{}
+	modulePath, ok := options[MODULE_PATH_OPTION_NAME]
+	if !ok || "" == strings.TrimSpace(modulePath) {
+		return GoProxy{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("no module path passed to the '%s' service. Use the '%s' option to set this value", "GoProxy", MODULE_PATH_OPTION_NAME)}
+	}
+	res.modulePath = strings.TrimSpace(modulePath)
+
+	baseURI, ok := options[BASE_URI_OPTION_NAME]
+	if !ok || "" == strings.TrimSpace(baseURI) {
+		log.Debugf("no custom URI passed to the '%s' service, the default endpoint '%s' will be used", "GoProxy", DEFAULT_BASE_URI)
+		baseURI = DEFAULT_BASE_URI
+	}
+	res.baseURI = strings.TrimRight(strings.TrimSpace(baseURI), "/")
+
+	sumURI, ok := options[SUM_URI_OPTION_NAME]
+	if !ok || "" == strings.TrimSpace(sumURI) {
+		log.Debugf("no custom checksum database URI passed to the '%s' service, the default endpoint '%s' will be used", "GoProxy", DEFAULT_SUM_URI)
+		sumURI = DEFAULT_SUM_URI
+	}
+	if !strings.EqualFold(SUM_URI_OFF, strings.TrimSpace(sumURI)) {
+		trimmedSumURI := strings.TrimRight(strings.TrimSpace(sumURI), "/")
+		res.sumURI = &trimmedSumURI
+	}
+
+	log.Tracef("instantiating new GoProxy service for module '%s'", res.modulePath)
+	res.client = &http.Client{}
+
+	return res, nil
+}
+
+/*
+Returns the given module path or version escaped according to the module proxy protocol, where each
+upper case letter is replaced by an exclamation mark followed by the lower case letter.
+See https://go.dev/ref/mod#goproxy-protocol.
+
+Arguments are as follows:
+
+- s the string to escape
+*/
+func escape(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			sb.WriteRune('!')
+			sb.WriteRune(unicode.ToLower(r))
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+/*
+Returns the Go canonical version for the given tag, adding the 'v' prefix when missing.
+
+Arguments are as follows:
+
+- tag the tag to get the version for
+*/
+func canonicalVersion(tag string) string {
+	if strings.HasPrefix(tag, "v") {
+		return tag
+	}
+	return "v" + tag
+}
+
+/*
+Sends a GET request to the given URL and returns true if the resource exists, false if the server
+replies that the resource does not exist.
+
+Arguments are as follows:
+
+- requestURL the URL to query
+
+Errors can be:
+
+- TransportError if communication to the remote endpoint fails or the server returns an unexpected status
+*/
+func (s GoProxy) get(requestURL string) (bool, error) {
+	log.Tracef("sending '%s' request to '%s'", http.MethodGet, requestURL)
+	response, err := s.client.Get(requestURL)
+	if err != nil {
+		return false, &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed", http.MethodGet, requestURL), Cause: err}
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return false, &errs.TransportError{Message: fmt.Sprintf("unable to read the response to '%s' request to '%s'", http.MethodGet, requestURL), Cause: err}
+	}
+	if response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusGone {
+		log.Debugf("'%s' request to '%s' returned '%s': %s", http.MethodGet, requestURL, response.Status, string(body))
+		return false, nil
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return false, &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed with status '%s': %s", http.MethodGet, requestURL, response.Status, string(body))}
+	}
+	return true, nil
+}
+
+/*
+Finds the release for the given tag by querying the module proxy for the version info.
+Returns nil if the module proxy doesn't know about the version.
+
+Arguments are as follows:
+
+  - owner this argument is ignored
+  - repository this argument is ignored
+  - tag the tag the release refers to (i.e. 1.2.3, v4.5.6). It can't be nil
+
+Errors can be:
+
+- TransportError if communication to the remote endpoint fails
+*/
+func (s GoProxy) GetReleaseByTag(owner *string, repository *string, tag string) (*api.Release, error) {
+	version := canonicalVersion(tag)
+	found, err := s.get(fmt.Sprintf("%s/%s/@v/%s.info", s.baseURI, escape(s.modulePath), escape(version)))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+	var apiRelease api.Release = newGoProxyRelease(tag, version)
+	return &apiRelease, nil
+}
+
+/*
+Publishes a new release by requesting the module proxy to fetch the version and, unless disabled,
+the checksum database to record the module checksum, so that module consumers see the release immediately.
+
+The tag must have already been pushed to the remote repository.
+
+Arguments are as follows:
+
+  - owner this argument is ignored
+  - repository this argument is ignored
+  - title the release title, it may be the same of tag but not necessarily. It may be nil
+  - tag tag to publish the release for (i.e. 1.2.3, v4.5.6). When the tag doesn't start with 'v' the prefix is added. It can't be nil
+  - description this argument is ignored
+  - options this argument is ignored
+
+Errors can be:
+
+- TransportError if communication to the remote endpoint fails or the module proxy can't find the version
+*/
+func (s GoProxy) PublishRelease(owner *string, repository *string, title *string, tag string, description *string, options *map[string]interface{}) (*api.Release, error) {
+	version := canonicalVersion(tag)
+	log.Debugf("requesting the module proxy at '%s' to fetch version '%s' of module '%s'", s.baseURI, version, s.modulePath)
+	found, err := s.get(fmt.Sprintf("%s/%s/@v/%s.info", s.baseURI, escape(s.modulePath), escape(version)))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("the module proxy at '%s' could not find version '%s' of module '%s'. Make sure the tag has been pushed", s.baseURI, version, s.modulePath)}
+	}
+
+	if s.sumURI == nil {
+		log.Debugf("the checksum database lookup is disabled")
+	} else {
+		log.Debugf("requesting the checksum database at '%s' to look up version '%s' of module '%s'", *s.sumURI, version, s.modulePath)
+		found, err = s.get(fmt.Sprintf("%s/lookup/%s@%s", *s.sumURI, escape(s.modulePath), escape(version)))
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, &errs.TransportError{Message: fmt.Sprintf("the checksum database at '%s' could not find version '%s' of module '%s'", *s.sumURI, version, s.modulePath)}
+		}
+	}
+
+	releaseTitle := version
+	if title != nil {
+		releaseTitle = *title
+	}
+	var apiRelease api.Release = newGoProxyRelease(tag, releaseTitle)
+	return &apiRelease, nil
+}
+
+/*
+The module proxy does not support release assets so this method always returns an error.
+
+Errors can be:
+
+- UnsupportedOperationError as the module proxy does not support the RELEASE_ASSETS feature.
+*/
+func (s GoProxy) PublishReleaseAssets(owner *string, repository *string, release *api.Release, assets []ent.Attachment) (*api.Release, error) {
+	return nil, &errs.UnsupportedOperationError{Message: fmt.Sprintf("the '%s' service does not support release assets", "GoProxy")}
+}
+
+/*
+Safely checks if the underlying implementation supports the given operation. If this
+method returns true then the underlying class will not raise any
+UnsupportedOperationError when invoking the specific methods.
+
+Arguments are as follows:
+
+- feature the feature to check for support.
+*/
+func (s GoProxy) Supports(feature api.Feature) bool {
+	switch feature {
+	case api.GIT_HOSTING:
+		return false
+	case api.RELEASES:
+		return true
+	case api.RELEASE_ASSETS:
+		return false
+	case api.USERS:
+		return false
+	default:
+		return false
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package goproxy
+
+import (
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+/*
+A release published to the Go module proxy.
+
+The Go module proxy has no notion of releases like Git hosting services do, so this object just
+tracks the version the proxy has been requested to fetch.
+*/
+type GoProxyRelease struct {
+	// The assets published for the relese. This is always nil as the Go module proxy does not support assets.
+	assets []ent.Attachment
+
+	// The tag the release refers to.
+	tag string
+
+	// The release title.
+	title string
+}
+
+/*
+Creates the release object with the given attributes.
+
+Arguments are as follows:
+
+  - tag the tag (version) the release refers to
+  - title the release title
+*/
+func newGoProxyRelease(tag string, title string) *GoProxyRelease {
+	res := &GoProxyRelease{}
+	res.tag = tag
+	res.title = title
+	return res
+}
+
+/*
+Returns the assets attached to the relese, otherwise returns nil.
+*/
+func (r *GoProxyRelease) GetAssets() []ent.Attachment {
+	return r.assets
+}
+
+/*
+Returns the tag the release refers to.
+*/
+func (r *GoProxyRelease) GetTag() string {
+	return r.tag
+}
+
+/*
+Returns the release title.
+*/
+func (r *GoProxyRelease) GetTitle() string {
+	return r.title
+}
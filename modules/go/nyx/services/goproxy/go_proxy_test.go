@@ -0,0 +1,99 @@
+//go:build unit
+// +build unit
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package goproxy
+
+import (
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"testing"           // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+func TestGoProxyInstance(t *testing.T) {
+	_, err := Instance(nil)
+	assert.Error(t, err)
+	_, err = Instance(map[string]string{})
+	assert.Error(t, err)
+
+	service, err := Instance(map[string]string{MODULE_PATH_OPTION_NAME: "github.com/octocat/hello-world"})
+	assert.NoError(t, err)
+	assert.Equal(t, DEFAULT_BASE_URI, service.baseURI)
+	assert.Equal(t, DEFAULT_SUM_URI, *service.sumURI)
+
+	service, err = Instance(map[string]string{MODULE_PATH_OPTION_NAME: "github.com/octocat/hello-world", BASE_URI_OPTION_NAME: "https://proxy.example.com/", SUM_URI_OPTION_NAME: SUM_URI_OFF})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://proxy.example.com", service.baseURI)
+	assert.Nil(t, service.sumURI)
+}
+
+func TestGoProxyEscape(t *testing.T) {
+	assert.Equal(t, "github.com/!octo!cat/hello-world", escape("github.com/OctoCat/hello-world"))
+	assert.Equal(t, "v1.2.3-!r!c.1", escape("v1.2.3-RC.1"))
+}
+
+func TestGoProxyCanonicalVersion(t *testing.T) {
+	assert.Equal(t, "v1.2.3", canonicalVersion("1.2.3"))
+	assert.Equal(t, "v1.2.3", canonicalVersion("v1.2.3"))
+}
+
+func TestGoProxySupports(t *testing.T) {
+	service, err := Instance(map[string]string{MODULE_PATH_OPTION_NAME: "github.com/octocat/hello-world"})
+	assert.NoError(t, err)
+
+	assert.False(t, service.Supports(api.GIT_HOSTING))
+	assert.True(t, service.Supports(api.RELEASES))
+	assert.False(t, service.Supports(api.RELEASE_ASSETS))
+	assert.False(t, service.Supports(api.USERS))
+}
+
+func TestGoProxyPublishRelease(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		if r.URL.Path == "/github.com/!octo!cat/hello-world/@v/v9.9.9.info" {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	service, err := Instance(map[string]string{MODULE_PATH_OPTION_NAME: "github.com/OctoCat/hello-world", BASE_URI_OPTION_NAME: server.URL, SUM_URI_OPTION_NAME: server.URL})
+	assert.NoError(t, err)
+
+	release, err := service.PublishRelease(nil, nil, nil, "1.2.3", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3", (*release).GetTag())
+	assert.Equal(t, []string{"/github.com/!octo!cat/hello-world/@v/v1.2.3.info", "/lookup/github.com/!octo!cat/hello-world@v1.2.3"}, requests)
+
+	// an unknown version is an error
+	_, err = service.PublishRelease(nil, nil, nil, "9.9.9", nil, nil)
+	assert.Error(t, err)
+
+	release, err = service.GetReleaseByTag(nil, nil, "9.9.9")
+	assert.NoError(t, err)
+	assert.Nil(t, release)
+
+	_, err = service.PublishReleaseAssets(nil, nil, nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,662 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This is the package repository package for Nyx, providing services to publish Debian (.deb) and RPM (.rpm)
+packages to package repositories like Aptly, Artifactory and Nexus.
+*/
+package packagerepository
+
+import (
+	"bytes"          // https://pkg.go.dev/bytes
+	"fmt"            // https://pkg.go.dev/fmt
+	"io"             // https://pkg.go.dev/io
+	"mime/multipart" // https://pkg.go.dev/mime/multipart
+	"net/http"       // https://pkg.go.dev/net/http
+	"net/url"        // https://pkg.go.dev/net/url
+	"os"             // https://pkg.go.dev/os
+	"path/filepath"  // https://pkg.go.dev/path/filepath
+	"strings"        // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://github.com/Sirupsen/logrus, https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+const (
+	/*
+		The name of the option used to pass the base URI of the package repository server to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is mandatory.
+	*/
+	BASE_URI_OPTION_NAME = "BASE_URI"
+
+	/*
+		The name of the option used to pass the flavor of the package repository server to this object instance.
+		Allowed values are FLAVOR_APTLY, FLAVOR_ARTIFACTORY and FLAVOR_NEXUS.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is mandatory.
+	*/
+	FLAVOR_OPTION_NAME = "FLAVOR"
+
+	/*
+		The name of the option used to pass the user name to authenticate to the package repository server.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed (along with AUTHENTICATION_PASSWORD) basic authentication is not used.
+	*/
+	AUTHENTICATION_USER_OPTION_NAME = "AUTHENTICATION_USER"
+
+	/*
+		The name of the option used to pass the password to authenticate to the package repository server.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed (along with AUTHENTICATION_USER) basic authentication is not used.
+	*/
+	AUTHENTICATION_PASSWORD_OPTION_NAME = "AUTHENTICATION_PASSWORD"
+
+	/*
+		The name of the option used to pass the authentication (bearer) token to authenticate to the package repository server.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		When this option is passed it takes precedence over AUTHENTICATION_USER and AUTHENTICATION_PASSWORD.
+	*/
+	AUTHENTICATION_TOKEN_OPTION_NAME = "AUTHENTICATION_TOKEN"
+
+	/*
+		The name of the option used to pass the name of the repository on the package repository server
+		(the local repository name for Aptly, the repository key for Artifactory, the repository name for Nexus).
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is mandatory.
+	*/
+	REPOSITORY_NAME_OPTION_NAME = "REPOSITORY_NAME"
+
+	/*
+		The name of the option used to pass the Debian distribution (i.e. 'stable', 'bookworm') packages are published for.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		With Aptly, when this option is set, the publication for this distribution is updated after packages are uploaded.
+		With Artifactory this is used for the 'deb.distribution' property.
+	*/
+	DISTRIBUTION_OPTION_NAME = "DISTRIBUTION"
+
+	/*
+		The name of the option used to pass the Debian component (i.e. 'main') packages are published for.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the DEFAULT_COMPONENT is used.
+	*/
+	COMPONENT_OPTION_NAME = "COMPONENT"
+
+	/*
+		The name of the option used to pass the Debian architecture (i.e. 'amd64') packages are published for.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is only used by Artifactory for the 'deb.architecture' property and it's
+		not mandatory.
+	*/
+	ARCHITECTURE_OPTION_NAME = "ARCHITECTURE"
+
+	/*
+		The name of the option used to pass the Aptly publishing prefix. This is the value of the key inside the options
+		passed to get a new instance of this class. If this option is not passed the default prefix ('.') is used.
+	*/
+	PUBLISH_PREFIX_OPTION_NAME = "PUBLISH_PREFIX"
+
+	// The flavor value to use for Aptly (https://www.aptly.info/) servers.
+	FLAVOR_APTLY = "APTLY"
+
+	// The flavor value to use for JFrog Artifactory (https://jfrog.com/artifactory/) servers.
+	FLAVOR_ARTIFACTORY = "ARTIFACTORY"
+
+	// The flavor value to use for Sonatype Nexus (https://www.sonatype.com/products/nexus-repository) servers.
+	FLAVOR_NEXUS = "NEXUS"
+
+	// The Debian component used when no component is configured.
+	DEFAULT_COMPONENT = "main"
+
+	// The file extension of Debian packages.
+	DEB_EXTENSION = ".deb"
+
+	// The file extension of RPM packages.
+	RPM_EXTENSION = ".rpm"
+)
+
+/*
+The entry point to the package repository remote service.
+*/
+type PackageRepository struct {
+	// The base URI of the server, without the trailing slash.
+	baseURI string
+
+	// The server flavor, one of FLAVOR_APTLY, FLAVOR_ARTIFACTORY or FLAVOR_NEXUS.
+	flavor string
+
+	// The name of the repository packages are published to.
+	repositoryName string
+
+	// The user name for basic authentication. It may be nil.
+	user *string
+
+	// The password for basic authentication. It may be nil.
+	password *string
+
+	// The token for bearer authentication. It may be nil.
+	token *string
+
+	// The Debian distribution. It may be nil.
+	distribution *string
+
+	// The Debian component.
+	component string
+
+	// The Debian architecture. It may be nil.
+	architecture *string
+
+	// The Aptly publishing prefix. It may be nil.
+	publishPrefix *string
+
+	// The private HTTP client instance.
+	client *http.Client
+}
+
+/*
+Returns the value of the given option as a pointer, or nil if the option is not present or is blank.
+
+Arguments are as follows:
+
+- options the map of options to read from
+- name the name of the option to read
+*/
+func optionalOption(options map[string]string, name string) *string {
+	value, ok := options[name]
+	if !ok || "" == strings.TrimSpace(value) {
+		return nil
+	}
+	return &value
+}
+
+/*
+Returns an instance using the given options.
+
+Arguments are as follows:
+
+  - options the map of options for the requested service. It can't be nil.
+    Valid options are documented as constants on this class.
+
+Errors can be:
+
+- NilPointerError if the given options map is nil
+- IllegalArgumentError if some entries in the given options map are missing or illegal for some reason
+*/
+func Instance(options map[string]string) (PackageRepository, error) {
+	if options == nil {
+		return PackageRepository{}, &errs.NilPointerError{Message: fmt.Sprintf("can't create a new instance with a null options map")}
+	}
+
+	res := PackageRepository{}
+	baseURI := optionalOption(options, BASE_URI_OPTION_NAME)
+	if baseURI == nil {
+		return PackageRepository{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("no base URI passed to the '%s' service. Use the '%s' option to set this value", "PackageRepository", BASE_URI_OPTION_NAME)}
+	}
+	res.baseURI = strings.TrimRight(strings.TrimSpace(*baseURI), "/")
+
+	flavor := optionalOption(options, FLAVOR_OPTION_NAME)
+	if flavor == nil {
+		return PackageRepository{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("no flavor passed to the '%s' service. Use the '%s' option to set this value to one of '%s', '%s', '%s'", "PackageRepository", FLAVOR_OPTION_NAME, FLAVOR_APTLY, FLAVOR_ARTIFACTORY, FLAVOR_NEXUS)}
+	}
+	switch strings.ToUpper(strings.TrimSpace(*flavor)) {
+	case FLAVOR_APTLY:
+		res.flavor = FLAVOR_APTLY
+	case FLAVOR_ARTIFACTORY:
+		res.flavor = FLAVOR_ARTIFACTORY
+	case FLAVOR_NEXUS:
+		res.flavor = FLAVOR_NEXUS
+	default:
+		return PackageRepository{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("illegal flavor '%s' passed to the '%s' service. Allowed values are '%s', '%s', '%s'", *flavor, "PackageRepository", FLAVOR_APTLY, FLAVOR_ARTIFACTORY, FLAVOR_NEXUS)}
+	}
+
+	repositoryName := optionalOption(options, REPOSITORY_NAME_OPTION_NAME)
+	if repositoryName == nil {
+		return PackageRepository{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("no repository name passed to the '%s' service. Use the '%s' option to set this value", "PackageRepository", REPOSITORY_NAME_OPTION_NAME)}
+	}
+	res.repositoryName = *repositoryName
+
+	res.token = optionalOption(options, AUTHENTICATION_TOKEN_OPTION_NAME)
+	res.user = optionalOption(options, AUTHENTICATION_USER_OPTION_NAME)
+	res.password = optionalOption(options, AUTHENTICATION_PASSWORD_OPTION_NAME)
+	if res.token == nil && (res.user == nil || res.password == nil) {
+		log.Warnf("no authentication credentials passed to the '%s' service, no authentication protected operation will be available. Use the '%s' or the '%s' and '%s' options to set these values", "PackageRepository", AUTHENTICATION_TOKEN_OPTION_NAME, AUTHENTICATION_USER_OPTION_NAME, AUTHENTICATION_PASSWORD_OPTION_NAME)
+	}
+
+	res.distribution = optionalOption(options, DISTRIBUTION_OPTION_NAME)
+	component := optionalOption(options, COMPONENT_OPTION_NAME)
+	if component == nil {
+		res.component = DEFAULT_COMPONENT
+	} else {
+		res.component = *component
+	}
+	res.architecture = optionalOption(options, ARCHITECTURE_OPTION_NAME)
+	res.publishPrefix = optionalOption(options, PUBLISH_PREFIX_OPTION_NAME)
+
+	log.Tracef("instantiating new PackageRepository service of flavor '%s' for '%s'", res.flavor, res.baseURI)
+	res.client = &http.Client{}
+
+	return res, nil
+}
+
+/*
+Sends the given request adding the authentication headers, if configured, and returns the response body.
+
+Arguments are as follows:
+
+- request the request to send
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails or the server returns an unexpected status
+*/
+func (s PackageRepository) send(request *http.Request) ([]byte, error) {
+	if s.token != nil {
+		request.Header.Set("Authorization", "Bearer "+*s.token)
+	} else if s.user != nil && s.password != nil {
+		request.SetBasicAuth(*s.user, *s.password)
+	}
+
+	log.Tracef("sending '%s' request to '%s'", request.Method, request.URL.String())
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed", request.Method, request.URL.String()), Cause: err}
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to read the response to '%s' request to '%s'", request.Method, request.URL.String()), Cause: err}
+	}
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		return nil, &errs.SecurityError{Message: fmt.Sprintf("'%s' request to '%s' was rejected with status '%s'", request.Method, request.URL.String(), response.Status)}
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed with status '%s': %s", request.Method, request.URL.String(), response.Status, string(body))}
+	}
+	return body, nil
+}
+
+/*
+Sends a multipart form POST request with the given file and extra form fields.
+
+Arguments are as follows:
+
+  - requestURL the URL to send the request to
+  - fileField the name of the form field to use for the file
+  - fileName the name of the uploaded file
+  - file the file to upload
+  - fields the extra form fields. It may be nil
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s PackageRepository) postMultipart(requestURL string, fileField string, fileName string, file io.Reader, fields map[string]string) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+	for name, value := range fields {
+		err := writer.WriteField(name, value)
+		if err != nil {
+			return nil, &errs.TransportError{Message: fmt.Sprintf("unable to build the multipart request for '%s'", requestURL), Cause: err}
+		}
+	}
+	part, err := writer.CreateFormFile(fileField, fileName)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to build the multipart request for '%s'", requestURL), Cause: err}
+	}
+	_, err = io.Copy(part, file)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to build the multipart request for '%s'", requestURL), Cause: err}
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to build the multipart request for '%s'", requestURL), Cause: err}
+	}
+
+	request, err := http.NewRequest(http.MethodPost, requestURL, &buffer)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to build the request for '%s'", requestURL), Cause: err}
+	}
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	return s.send(request)
+}
+
+/*
+Returns the package type for the given asset, which is one of DEB_EXTENSION or RPM_EXTENSION, or nil
+if the asset is not a supported package.
+
+The package type is detected by the file name extension or, when the extension is not significant,
+by the MIME type.
+
+Arguments are as follows:
+
+- asset the asset to detect the type for
+*/
+func packageTypeOf(asset ent.Attachment) *string {
+	deb := DEB_EXTENSION
+	rpm := RPM_EXTENSION
+	for _, name := range []*string{asset.GetFileName(), asset.GetPath()} {
+		if name != nil {
+			switch strings.ToLower(filepath.Ext(*name)) {
+			case DEB_EXTENSION:
+				return &deb
+			case RPM_EXTENSION:
+				return &rpm
+			}
+		}
+	}
+	if asset.GetType() != nil {
+		switch strings.ToLower(*asset.GetType()) {
+		case "application/vnd.debian.binary-package", "application/x-debian-package":
+			return &deb
+		case "application/x-rpm", "application/x-redhat-package-manager":
+			return &rpm
+		}
+	}
+	return nil
+}
+
+/*
+Uploads the given package to an Aptly server and adds it to the configured local repository, returning the
+URL of the uploaded file.
+
+Arguments are as follows:
+
+  - version the version the package is published for
+  - packageType the package type (DEB_EXTENSION or RPM_EXTENSION)
+  - fileName the package file name
+  - file the package file
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+- UnsupportedOperationError if the package type is not supported by Aptly
+*/
+func (s PackageRepository) uploadToAptly(version string, packageType string, fileName string, file io.Reader) (string, error) {
+	if packageType != DEB_EXTENSION {
+		return "", &errs.UnsupportedOperationError{Message: fmt.Sprintf("Aptly only supports Debian packages while '%s' is not", fileName)}
+	}
+	// Aptly uploads files to a temporary directory first, then the directory is imported into the repository
+	directory := url.PathEscape(s.repositoryName + "-" + version)
+	_, err := s.postMultipart(fmt.Sprintf("%s/api/files/%s", s.baseURI, directory), "file", fileName, file, nil)
+	if err != nil {
+		return "", err
+	}
+	request, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/repos/%s/file/%s", s.baseURI, url.PathEscape(s.repositoryName), directory), nil)
+	if err != nil {
+		return "", &errs.TransportError{Message: fmt.Sprintf("unable to build the request to import '%s'", fileName), Cause: err}
+	}
+	_, err = s.send(request)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/api/repos/%s/packages", s.baseURI, url.PathEscape(s.repositoryName)), nil
+}
+
+/*
+Updates the Aptly publication for the configured distribution, if any, so that packages added to the local
+repository become available to clients.
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s PackageRepository) updateAptlyPublication() error {
+	if s.distribution == nil {
+		log.Debugf("no distribution has been configured for the Aptly repository '%s' so the publication will not be updated. Use the '%s' option to set this value", s.repositoryName, DISTRIBUTION_OPTION_NAME)
+		return nil
+	}
+	// Aptly uses ':.' for the default prefix and replaces slashes with underscores in prefixes
+	prefix := ":."
+	if s.publishPrefix != nil {
+		prefix = strings.ReplaceAll(strings.Trim(*s.publishPrefix, "/"), "/", "_")
+	}
+	request, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/api/publish/%s/%s", s.baseURI, url.PathEscape(prefix), url.PathEscape(*s.distribution)), strings.NewReader("{}"))
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to build the request to update the publication for distribution '%s'", *s.distribution), Cause: err}
+	}
+	request.Header.Set("Content-Type", "application/json")
+	_, err = s.send(request)
+	return err
+}
+
+/*
+Uploads the given package to an Artifactory server, returning the URL of the uploaded file.
+
+Debian packages are deployed to the repository pool along with the distribution, component and architecture
+properties so that Artifactory can index them. RPM packages are deployed into a directory named after the version.
+
+Arguments are as follows:
+
+  - version the version the package is published for
+  - packageType the package type (DEB_EXTENSION or RPM_EXTENSION)
+  - fileName the package file name
+  - file the package file
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s PackageRepository) uploadToArtifactory(version string, packageType string, fileName string, file io.Reader) (string, error) {
+	var fileURL string
+	var properties string
+	if packageType == DEB_EXTENSION {
+		fileURL = fmt.Sprintf("%s/%s/pool/%s/%s", s.baseURI, url.PathEscape(s.repositoryName), url.PathEscape(s.component), url.PathEscape(fileName))
+		if s.distribution != nil {
+			properties = properties + ";deb.distribution=" + url.PathEscape(*s.distribution)
+		}
+		properties = properties + ";deb.component=" + url.PathEscape(s.component)
+		if s.architecture != nil {
+			properties = properties + ";deb.architecture=" + url.PathEscape(*s.architecture)
+		}
+	} else {
+		fileURL = fmt.Sprintf("%s/%s/%s/%s", s.baseURI, url.PathEscape(s.repositoryName), url.PathEscape(version), url.PathEscape(fileName))
+	}
+
+	request, err := http.NewRequest(http.MethodPut, fileURL+properties, file)
+	if err != nil {
+		return "", &errs.TransportError{Message: fmt.Sprintf("unable to build the request to upload '%s'", fileName), Cause: err}
+	}
+	request.Header.Set("Content-Type", "application/octet-stream")
+	_, err = s.send(request)
+	if err != nil {
+		return "", err
+	}
+	return fileURL, nil
+}
+
+/*
+Uploads the given package to a Nexus server using the components API, returning the URL of the uploaded file.
+
+Arguments are as follows:
+
+  - version the version the package is published for
+  - packageType the package type (DEB_EXTENSION or RPM_EXTENSION)
+  - fileName the package file name
+  - file the package file
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s PackageRepository) uploadToNexus(version string, packageType string, fileName string, file io.Reader) (string, error) {
+	requestURL := fmt.Sprintf("%s/service/rest/v1/components?repository=%s", s.baseURI, url.QueryEscape(s.repositoryName))
+	if packageType == DEB_EXTENSION {
+		_, err := s.postMultipart(requestURL, "apt.asset", fileName, file, nil)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s/repository/%s/", s.baseURI, url.PathEscape(s.repositoryName)), nil
+	} else {
+		_, err := s.postMultipart(requestURL, "yum.asset", fileName, file, map[string]string{"yum.asset.filename": fileName, "yum.directory": version})
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s/repository/%s/%s/%s", s.baseURI, url.PathEscape(s.repositoryName), url.PathEscape(version), url.PathEscape(fileName)), nil
+	}
+}
+
+/*
+Package repositories have no notion of releases, so this method always returns nil.
+
+Arguments are as follows:
+
+  - owner this argument is ignored
+  - repository this argument is ignored
+  - tag the tag the release refers to (i.e. 1.2.3, v4.5.6). It can't be nil
+*/
+func (s PackageRepository) GetReleaseByTag(owner *string, repository *string, tag string) (*api.Release, error) {
+	log.Debugf("package repositories have no notion of releases so release '%s' can't be retrieved", tag)
+	return nil, nil
+}
+
+/*
+Publishes a new release. Package repositories have no notion of releases so this method just returns
+a release object to be used to publish packages (assets) with PublishReleaseAssets for the given version.
+
+Arguments are as follows:
+
+  - owner this argument is ignored
+  - repository this argument is ignored
+  - title the release title, it may be the same of tag but not necessarily. It may be nil
+  - tag tag to publish the release for (i.e. 1.2.3, v4.5.6). This is the version packages are published for. It can't be nil
+  - description this argument is ignored
+  - options this argument is ignored
+*/
+func (s PackageRepository) PublishRelease(owner *string, repository *string, title *string, tag string, description *string, options *map[string]interface{}) (*api.Release, error) {
+	log.Debugf("publishing release '%s' to the '%s' repository '%s' at '%s'", tag, s.flavor, s.repositoryName, s.baseURI)
+	releaseTitle := tag
+	if title != nil {
+		releaseTitle = *title
+	}
+	var apiRelease api.Release = newPackageRepositoryRelease(tag, releaseTitle)
+	return &apiRelease, nil
+}
+
+/*
+Publishes a set of assets for a release. Only local Debian (.deb) and RPM (.rpm) packages are supported, while
+other assets are skipped. Aptly servers only support Debian packages.
+
+Returns the given release with also the links to the uploaded assets. Only the assets that were actually published
+are returned while those not supported are not within the list of assets referred by the returned object.
+
+Arguments are as follows:
+
+  - owner this argument is ignored
+  - repository this argument is ignored
+  - release the release to publish the assets for. It must be an object created by the same service
+    implementation
+  - assets the set of assets to publish
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+- UnsupportedOperationError if the given release has not been created by this service.
+*/
+func (s PackageRepository) PublishReleaseAssets(owner *string, repository *string, release *api.Release, assets []ent.Attachment) (*api.Release, error) {
+	packageRelease, castOK := (*release).(*PackageRepositoryRelease)
+	if !castOK {
+		return nil, &errs.UnsupportedOperationError{Message: fmt.Sprintf("the given release must be of type PackageRepositoryRelease")}
+	}
+	log.Debugf("publishing %d assets for release '%s' to the '%s' repository '%s'", len(assets), packageRelease.GetTag(), s.flavor, s.repositoryName)
+
+	aptlyUpdated := false
+	for i, asset := range assets {
+		if asset.GetPath() == nil {
+			log.Warnf("the asset %d out of %d has no path and will be skipped", i, len(assets))
+			continue
+		}
+		fileName := filepath.Base(*asset.GetPath())
+		if asset.GetFileName() != nil && "" != strings.TrimSpace(*asset.GetFileName()) {
+			fileName = *asset.GetFileName()
+		}
+		packageType := packageTypeOf(asset)
+		if packageType == nil {
+			log.Warnf("the asset '%s' is neither a Debian nor an RPM package and will be skipped", fileName)
+			continue
+		}
+		if s.flavor == FLAVOR_APTLY && *packageType != DEB_EXTENSION {
+			log.Warnf("the asset '%s' is not a Debian package and will be skipped as Aptly only supports Debian packages", fileName)
+			continue
+		}
+		file, err := os.Open(*asset.GetPath())
+		if err != nil {
+			log.Warnf("the path '%s' for the asset '%s' cannot be resolved to a local file and will be skipped", *asset.GetPath(), fileName)
+			continue
+		}
+
+		log.Debugf("publishing asset %d out of %d for release '%s' to the '%s' repository '%s' (%s)", i, len(assets), packageRelease.GetTag(), s.flavor, s.repositoryName, *asset.GetPath())
+		var assetURL string
+		switch s.flavor {
+		case FLAVOR_APTLY:
+			assetURL, err = s.uploadToAptly(packageRelease.GetTag(), *packageType, fileName, file)
+			aptlyUpdated = aptlyUpdated || err == nil
+		case FLAVOR_ARTIFACTORY:
+			assetURL, err = s.uploadToArtifactory(packageRelease.GetTag(), *packageType, fileName, file)
+		case FLAVOR_NEXUS:
+			assetURL, err = s.uploadToNexus(packageRelease.GetTag(), *packageType, fileName, file)
+		}
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		log.Debugf("asset %d out of %d for release '%s' has been published to '%s'", i, len(assets), packageRelease.GetTag(), assetURL)
+		packageRelease.addAsset(*ent.NewAttachmentWith(&fileName, asset.GetDescription(), &assetURL, asset.GetType()))
+	}
+
+	if aptlyUpdated {
+		err := s.updateAptlyPublication()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var apiRelease api.Release = packageRelease
+	return &apiRelease, nil
+}
+
+/*
+Safely checks if the underlying implementation supports the given operation. If this
+method returns true then the underlying class will not raise any
+UnsupportedOperationError when invoking the specific methods.
+
+Arguments are as follows:
+
+- feature the feature to check for support.
+*/
+func (s PackageRepository) Supports(feature api.Feature) bool {
+	switch feature {
+	case api.GIT_HOSTING:
+		return false
+	case api.RELEASES:
+		return true
+	case api.RELEASE_ASSETS:
+		return true
+	case api.USERS:
+		return false
+	default:
+		return false
+	}
+}
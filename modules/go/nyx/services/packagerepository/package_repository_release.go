@@ -0,0 +1,88 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packagerepository
+
+import (
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+/*
+A release published to a package repository.
+
+Package repositories have no notion of releases like Git hosting services do, so this object just
+tracks the version packages are published for and the packages that have been uploaded.
+*/
+type PackageRepositoryRelease struct {
+	// The assets (packages) published for the relese, or nil.
+	assets []ent.Attachment
+
+	// The tag the release refers to.
+	tag string
+
+	// The release title.
+	title string
+}
+
+/*
+Creates the release object with the given attributes.
+
+Arguments are as follows:
+
+  - tag the tag (version) the release refers to
+  - title the release title
+*/
+func newPackageRepositoryRelease(tag string, title string) *PackageRepositoryRelease {
+	res := &PackageRepositoryRelease{}
+	res.tag = tag
+	res.title = title
+	return res
+}
+
+/*
+Adds the given asset to the internal set of assets. The internal set of assets is initialized
+in case it was still nil.
+
+Arguments are as follows:
+
+- asset the asset to add
+*/
+func (r *PackageRepositoryRelease) addAsset(asset ent.Attachment) *PackageRepositoryRelease {
+	r.assets = append(r.assets, asset)
+
+	return r
+}
+
+/*
+Returns the assets attached to the relese, otherwise returns nil.
+*/
+func (r *PackageRepositoryRelease) GetAssets() []ent.Attachment {
+	return r.assets
+}
+
+/*
+Returns the tag the release refers to.
+*/
+func (r *PackageRepositoryRelease) GetTag() string {
+	return r.tag
+}
+
+/*
+Returns the release title.
+*/
+func (r *PackageRepositoryRelease) GetTitle() string {
+	return r.title
+}
@@ -0,0 +1,157 @@
+//go:build unit
+// +build unit
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packagerepository
+
+import (
+	"io"                // https://pkg.go.dev/io
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"os"                // https://pkg.go.dev/os
+	"path/filepath"     // https://pkg.go.dev/path/filepath
+	"testing"           // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+// Starts a test server recording the method and path of all the requests it receives
+func newRecordingServer(t *testing.T, status int) (*httptest.Server, *[]string) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+// Creates a temporary file with the given name and returns its path
+func newPackageFile(t *testing.T, name string) string {
+	path := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(path, []byte("package content"), 0644)
+	assert.NoError(t, err)
+	return path
+}
+
+func TestPackageRepositoryInstance(t *testing.T) {
+	_, err := Instance(nil)
+	assert.Error(t, err)
+
+	_, err = Instance(map[string]string{FLAVOR_OPTION_NAME: FLAVOR_APTLY, REPOSITORY_NAME_OPTION_NAME: "repo"})
+	assert.Error(t, err)
+	_, err = Instance(map[string]string{BASE_URI_OPTION_NAME: "http://localhost", REPOSITORY_NAME_OPTION_NAME: "repo"})
+	assert.Error(t, err)
+	_, err = Instance(map[string]string{BASE_URI_OPTION_NAME: "http://localhost", FLAVOR_OPTION_NAME: "unknown", REPOSITORY_NAME_OPTION_NAME: "repo"})
+	assert.Error(t, err)
+	_, err = Instance(map[string]string{BASE_URI_OPTION_NAME: "http://localhost", FLAVOR_OPTION_NAME: FLAVOR_APTLY})
+	assert.Error(t, err)
+
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: "http://localhost/", FLAVOR_OPTION_NAME: "nexus", REPOSITORY_NAME_OPTION_NAME: "repo"})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost", service.baseURI)
+	assert.Equal(t, FLAVOR_NEXUS, service.flavor)
+	assert.Equal(t, DEFAULT_COMPONENT, service.component)
+}
+
+func TestPackageRepositorySupports(t *testing.T) {
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: "http://localhost", FLAVOR_OPTION_NAME: FLAVOR_APTLY, REPOSITORY_NAME_OPTION_NAME: "repo"})
+	assert.NoError(t, err)
+
+	assert.False(t, service.Supports(api.GIT_HOSTING))
+	assert.True(t, service.Supports(api.RELEASES))
+	assert.True(t, service.Supports(api.RELEASE_ASSETS))
+	assert.False(t, service.Supports(api.USERS))
+}
+
+func TestPackageRepositoryPublishRelease(t *testing.T) {
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: "http://localhost", FLAVOR_OPTION_NAME: FLAVOR_APTLY, REPOSITORY_NAME_OPTION_NAME: "repo"})
+	assert.NoError(t, err)
+
+	release, err := service.PublishRelease(nil, nil, nil, "1.2.3", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3", (*release).GetTag())
+	assert.Equal(t, "1.2.3", (*release).GetTitle())
+	assert.Empty(t, (*release).GetAssets())
+}
+
+func TestPackageRepositoryPublishReleaseAssetsToAptly(t *testing.T) {
+	server, requests := newRecordingServer(t, http.StatusOK)
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL, FLAVOR_OPTION_NAME: FLAVOR_APTLY, REPOSITORY_NAME_OPTION_NAME: "repo", DISTRIBUTION_OPTION_NAME: "stable"})
+	assert.NoError(t, err)
+
+	release, err := service.PublishRelease(nil, nil, nil, "1.2.3", nil, nil)
+	assert.NoError(t, err)
+	deb := newPackageFile(t, "tool_1.2.3_amd64.deb")
+	rpm := newPackageFile(t, "tool-1.2.3.x86_64.rpm")
+	release, err = service.PublishReleaseAssets(nil, nil, release, []ent.Attachment{*ent.NewAttachmentWith(utl.PointerToString("tool_1.2.3_amd64.deb"), nil, &deb, nil), *ent.NewAttachmentWith(utl.PointerToString("tool-1.2.3.x86_64.rpm"), nil, &rpm, nil)})
+	assert.NoError(t, err)
+
+	// the RPM package is skipped as Aptly only supports Debian packages
+	assert.Equal(t, 1, len((*release).GetAssets()))
+	assert.Equal(t, []string{"POST /api/files/repo-1.2.3", "POST /api/repos/repo/file/repo-1.2.3", "PUT /api/publish/:./stable"}, *requests)
+}
+
+func TestPackageRepositoryPublishReleaseAssetsToArtifactory(t *testing.T) {
+	server, requests := newRecordingServer(t, http.StatusCreated)
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL, FLAVOR_OPTION_NAME: FLAVOR_ARTIFACTORY, REPOSITORY_NAME_OPTION_NAME: "repo", DISTRIBUTION_OPTION_NAME: "stable", ARCHITECTURE_OPTION_NAME: "amd64"})
+	assert.NoError(t, err)
+
+	release, err := service.PublishRelease(nil, nil, nil, "1.2.3", nil, nil)
+	assert.NoError(t, err)
+	deb := newPackageFile(t, "tool.deb")
+	rpm := newPackageFile(t, "tool.rpm")
+	txt := newPackageFile(t, "README.txt")
+	release, err = service.PublishReleaseAssets(nil, nil, release, []ent.Attachment{*ent.NewAttachmentWith(utl.PointerToString("tool.deb"), nil, &deb, nil), *ent.NewAttachmentWith(utl.PointerToString("tool.rpm"), nil, &rpm, nil), *ent.NewAttachmentWith(utl.PointerToString("README.txt"), nil, &txt, nil)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len((*release).GetAssets()))
+	assert.Equal(t, []string{"PUT /repo/pool/main/tool.deb;deb.distribution=stable;deb.component=main;deb.architecture=amd64", "PUT /repo/1.2.3/tool.rpm"}, *requests)
+}
+
+func TestPackageRepositoryPublishReleaseAssetsToNexus(t *testing.T) {
+	server, requests := newRecordingServer(t, http.StatusNoContent)
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL, FLAVOR_OPTION_NAME: FLAVOR_NEXUS, REPOSITORY_NAME_OPTION_NAME: "repo"})
+	assert.NoError(t, err)
+
+	release, err := service.PublishRelease(nil, nil, nil, "1.2.3", nil, nil)
+	assert.NoError(t, err)
+	rpm := newPackageFile(t, "tool.rpm")
+	release, err = service.PublishReleaseAssets(nil, nil, release, []ent.Attachment{*ent.NewAttachmentWith(utl.PointerToString("tool.rpm"), nil, &rpm, nil)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len((*release).GetAssets()))
+	assert.Equal(t, []string{"POST /service/rest/v1/components?repository=repo"}, *requests)
+}
+
+func TestPackageRepositoryPublishReleaseAssetsWithAuthenticationFailure(t *testing.T) {
+	server, _ := newRecordingServer(t, http.StatusUnauthorized)
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL, FLAVOR_OPTION_NAME: FLAVOR_NEXUS, REPOSITORY_NAME_OPTION_NAME: "repo"})
+	assert.NoError(t, err)
+
+	release, err := service.PublishRelease(nil, nil, nil, "1.2.3", nil, nil)
+	assert.NoError(t, err)
+	deb := newPackageFile(t, "tool.deb")
+	_, err = service.PublishReleaseAssets(nil, nil, release, []ent.Attachment{*ent.NewAttachmentWith(utl.PointerToString("tool.deb"), nil, &deb, nil)})
+	assert.Error(t, err)
+}
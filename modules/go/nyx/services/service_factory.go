@@ -25,8 +25,13 @@ import (
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
 	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+	gerrit "github.com/mooltiverse/nyx/modules/go/nyx/services/gerrit"
 	github "github.com/mooltiverse/nyx/modules/go/nyx/services/github"
 	gitlab "github.com/mooltiverse/nyx/modules/go/nyx/services/gitlab"
+	goproxy "github.com/mooltiverse/nyx/modules/go/nyx/services/goproxy"
+	packagerepository "github.com/mooltiverse/nyx/modules/go/nyx/services/packagerepository"
+	terraform "github.com/mooltiverse/nyx/modules/go/nyx/services/terraform"
+	versionsfile "github.com/mooltiverse/nyx/modules/go/nyx/services/versionsfile"
 )
 
 /*
@@ -38,6 +43,41 @@ type ServiceFactory struct {
 /*
 Returns an instance for the given provider using the given options.
 
+Arguments are as follows:
+
+  - provider the provider to retrieve the instance for.
+  - options the map of options for the requested service. It may be nil if the requested
+    service does not require the options map. To know if the service needs rhese options and, if so, which
+    entries are to be present please check with the specific service.
+
+Errors can be:
+
+  - NilPointerError if the given provider is nil or the given options map is nil
+    and the service instance does not allow nil options
+  - IllegalArgumentError if the given provider is not supported or some entries in the given options
+    map are illegal for some reason
+  - UnsupportedOperationError if the service provider does not support the COMMIT_HISTORY feature.
+*/
+func CommitHistoryServiceInstance(provider ent.Provider, options map[string]string) (api.CommitHistoryService, error) {
+	instance, err := Instance(provider, options)
+	if err != nil {
+		return nil, err
+	}
+	if instance.Supports(api.COMMIT_HISTORY) {
+		service, castOK := instance.(api.CommitHistoryService)
+		if castOK {
+			return service, nil
+		} else {
+			return nil, &errs.UnsupportedOperationError{Message: fmt.Sprintf("the %s provider supports the %s feature but instances do not implement the %s interface", provider, api.COMMIT_HISTORY, "CommitHistoryService")}
+		}
+	} else {
+		return nil, &errs.UnsupportedOperationError{Message: fmt.Sprintf("the %s provider does not support the %s feature", provider, api.COMMIT_HISTORY)}
+	}
+}
+
+/*
+Returns an instance for the given provider using the given options.
+
 Arguments are as follows:
 
   - provider the provider to retrieve the instance for.
@@ -89,10 +129,20 @@ Errors can be:
 */
 func Instance(provider ent.Provider, options map[string]string) (api.Service, error) {
 	switch provider {
+	case ent.GERRIT:
+		return gerrit.Instance(options)
 	case ent.GITHUB:
 		return github.Instance(options)
 	case ent.GITLAB:
 		return gitlab.Instance(options)
+	case ent.GO_PROXY:
+		return goproxy.Instance(options)
+	case ent.PACKAGE_REPOSITORY:
+		return packagerepository.Instance(options)
+	case ent.TERRAFORM_REGISTRY:
+		return terraform.Instance(options)
+	case ent.VERSIONS_FILE:
+		return versionsfile.Instance(options)
 	default:
 		// this is never reached, but in case...
 		panic("unknown Provider. This means the switch/case statement needs to be updated")
@@ -168,3 +218,38 @@ func UserServiceInstance(provider ent.Provider, options map[string]string) (api.
 		return nil, &errs.UnsupportedOperationError{Message: fmt.Sprintf("the %s provider does not support the %s feature", provider, api.USERS)}
 	}
 }
+
+/*
+Returns an instance for the given provider using the given options.
+
+Arguments are as follows:
+
+  - provider the provider to retrieve the instance for.
+  - options the map of options for the requested service. It may be nil if the requested
+    service does not require the options map. To know if the service needs rhese options and, if so, which
+    entries are to be present please check with the specific service.
+
+Errors can be:
+
+  - NilPointerError if the given provider is nil or the given options map is nil
+    and the service instance does not allow nil options
+  - IllegalArgumentError if the given provider is not supported or some entries in the given options
+    map are illegal for some reason
+  - UnsupportedOperationError if the service provider does not support the VERSION_STORAGE feature.
+*/
+func VersionStorageServiceInstance(provider ent.Provider, options map[string]string) (api.VersionStorageService, error) {
+	instance, err := Instance(provider, options)
+	if err != nil {
+		return nil, err
+	}
+	if instance.Supports(api.VERSION_STORAGE) {
+		service, castOK := instance.(api.VersionStorageService)
+		if castOK {
+			return service, nil
+		} else {
+			return nil, &errs.UnsupportedOperationError{Message: fmt.Sprintf("the %s provider supports the %s feature but instances do not implement the %s interface", provider, api.VERSION_STORAGE, "VersionStorageService")}
+		}
+	} else {
+		return nil, &errs.UnsupportedOperationError{Message: fmt.Sprintf("the %s provider does not support the %s feature", provider, api.VERSION_STORAGE)}
+	}
+}
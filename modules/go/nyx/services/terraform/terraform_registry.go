@@ -0,0 +1,398 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This is the Terraform package for Nyx, providing services to publish module versions to the private module
+registry of Terraform Cloud or Terraform Enterprise.
+*/
+package terraform
+
+import (
+	"bytes"         // https://pkg.go.dev/bytes
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"io"            // https://pkg.go.dev/io
+	"net/http"      // https://pkg.go.dev/net/http
+	"net/url"       // https://pkg.go.dev/net/url
+	"os"            // https://pkg.go.dev/os
+	"strings"       // https://pkg.go.dev/strings
+
+	log "github.com/sirupsen/logrus" // https://github.com/Sirupsen/logrus, https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+const (
+	/*
+		The name of the option used to pass the base URI of the Terraform Cloud or Enterprise instance to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the DEFAULT_BASE_URI is used.
+	*/
+	BASE_URI_OPTION_NAME = "BASE_URI"
+
+	/*
+		The name of the option used to pass the authentication (API) token to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is mandatory.
+	*/
+	AUTHENTICATION_TOKEN_OPTION_NAME = "AUTHENTICATION_TOKEN"
+
+	/*
+		The name of the option used to pass the commit SHA the module version is created from. This is only
+		required for modules linked to a VCS repository using branch based publishing.
+		This is the value of the key inside the options passed to get a new instance of this class.
+	*/
+	COMMIT_SHA_OPTION_NAME = "COMMIT_SHA"
+
+	/*
+		The name of the option used to pass the name of the module to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is mandatory.
+	*/
+	MODULE_NAME_OPTION_NAME = "MODULE_NAME"
+
+	/*
+		The name of the option used to pass the namespace of the module to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		If this option is not passed the organization is used.
+	*/
+	MODULE_NAMESPACE_OPTION_NAME = "MODULE_NAMESPACE"
+
+	/*
+		The name of the option used to pass the provider of the module (i.e. 'aws') to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is mandatory.
+	*/
+	MODULE_PROVIDER_OPTION_NAME = "MODULE_PROVIDER"
+
+	/*
+		The name of the option used to pass the name of the organization owning the registry to this object instance.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is mandatory.
+	*/
+	ORGANIZATION_OPTION_NAME = "ORGANIZATION"
+
+	// The default Terraform Cloud URI.
+	DEFAULT_BASE_URI = "https://app.terraform.io"
+)
+
+/*
+The entry point to the Terraform module registry remote service.
+*/
+type TerraformRegistry struct {
+	// The base URI of the registry, without the trailing slash.
+	baseURI string
+
+	// The API token.
+	token string
+
+	// The commit SHA module versions are created from. It may be nil.
+	commitSHA *string
+
+	// The organization owning the registry.
+	organization string
+
+	// The module namespace.
+	namespace string
+
+	// The module name.
+	name string
+
+	// The module provider.
+	provider string
+
+	// The private HTTP client instance.
+	client *http.Client
+}
+
+/*
+Returns the value of the given option, or nil if the option is not present or is blank.
+
+Arguments are as follows:
+
+- options the map of options to read from
+- name the name of the option to read
+*/
+func optionalOption(options map[string]string, name string) *string {
+	value, ok := options[name]
+	if !ok || "" == strings.TrimSpace(value) {
+		return nil
+	}
+	value = strings.TrimSpace(value)
+	return &value
+}
+
+/*
+Returns an instance using the given options.
+
+Arguments are as follows:
+
+  - options the map of options for the requested service. It can't be nil.
+    Valid options are documented as constants on this class.
+
+Errors can be:
+
+- NilPointerError if the given options map is nil
+- IllegalArgumentError if some entries in the given options map are missing or illegal for some reason
+*/
+func Instance(options map[string]string) (TerraformRegistry, error) {
+	if options == nil {
+		return TerraformRegistry{}, &errs.NilPointerError{Message: fmt.Sprintf("can't create a new instance with a null options map")}
+	}
+
+	res := TerraformRegistry{}
+	for _, mandatory := range []string{AUTHENTICATION_TOKEN_OPTION_NAME, ORGANIZATION_OPTION_NAME, MODULE_NAME_OPTION_NAME, MODULE_PROVIDER_OPTION_NAME} {
+		if optionalOption(options, mandatory) == nil {
+			return TerraformRegistry{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("the '%s' option is mandatory for the '%s' service", mandatory, "TerraformRegistry")}
+		}
+	}
+	res.token = *optionalOption(options, AUTHENTICATION_TOKEN_OPTION_NAME)
+	res.organization = *optionalOption(options, ORGANIZATION_OPTION_NAME)
+	res.name = *optionalOption(options, MODULE_NAME_OPTION_NAME)
+	res.provider = *optionalOption(options, MODULE_PROVIDER_OPTION_NAME)
+	namespace := optionalOption(options, MODULE_NAMESPACE_OPTION_NAME)
+	if namespace == nil {
+		res.namespace = res.organization
+	} else {
+		res.namespace = *namespace
+	}
+	res.commitSHA = optionalOption(options, COMMIT_SHA_OPTION_NAME)
+
+	baseURI := optionalOption(options, BASE_URI_OPTION_NAME)
+	if baseURI == nil {
+		log.Debugf("no custom URI passed to the '%s' service, the default endpoint '%s' will be used", "TerraformRegistry", DEFAULT_BASE_URI)
+		res.baseURI = DEFAULT_BASE_URI
+	} else {
+		res.baseURI = strings.TrimRight(*baseURI, "/")
+	}
+
+	log.Tracef("instantiating new TerraformRegistry service for module '%s/%s/%s'", res.namespace, res.name, res.provider)
+	res.client = &http.Client{}
+
+	return res, nil
+}
+
+/*
+Returns the URL of the module versions API endpoint.
+*/
+func (s TerraformRegistry) versionsURL() string {
+	return fmt.Sprintf("%s/api/v2/organizations/%s/registry-modules/private/%s/%s/%s/versions", s.baseURI, url.PathEscape(s.organization), url.PathEscape(s.namespace), url.PathEscape(s.name), url.PathEscape(s.provider))
+}
+
+/*
+Sends the given request and returns the response body.
+
+Arguments are as follows:
+
+  - request the request to send
+  - authenticate when true the authentication token is added to the request
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails or the server returns an unexpected status
+*/
+func (s TerraformRegistry) send(request *http.Request, authenticate bool) ([]byte, error) {
+	if authenticate {
+		request.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	log.Tracef("sending '%s' request to '%s'", request.Method, request.URL.String())
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed", request.Method, request.URL.String()), Cause: err}
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to read the response to '%s' request to '%s'", request.Method, request.URL.String()), Cause: err}
+	}
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		return nil, &errs.SecurityError{Message: fmt.Sprintf("'%s' request to '%s' was rejected with status '%s'", request.Method, request.URL.String(), response.Status)}
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed with status '%s': %s", request.Method, request.URL.String(), response.Status, string(body))}
+	}
+	return body, nil
+}
+
+/*
+Module registries have no notion of releases, so this method always returns nil.
+
+Arguments are as follows:
+
+  - owner this argument is ignored
+  - repository this argument is ignored
+  - tag the tag the release refers to (i.e. 1.2.3, v4.5.6). It can't be nil
+*/
+func (s TerraformRegistry) GetReleaseByTag(owner *string, repository *string, tag string) (*api.Release, error) {
+	log.Debugf("module registries have no notion of releases so release '%s' can't be retrieved", tag)
+	return nil, nil
+}
+
+/*
+Publishes a new release by creating a new module version in the registry, which triggers the registry ingestion.
+
+Modules linked to a VCS repository ingest the new version from the repository (when using branch based publishing
+the COMMIT_SHA option must be set) while for other modules the module archive must be uploaded using
+PublishReleaseAssets.
+
+Arguments are as follows:
+
+  - owner this argument is ignored
+  - repository this argument is ignored
+  - title the release title, it may be the same of tag but not necessarily. It may be nil
+  - tag tag to publish the release for (i.e. 1.2.3, v4.5.6). The leading 'v', if any, is removed as the registry
+    only accepts plain semantic versions. It can't be nil
+  - description this argument is ignored
+  - options this argument is ignored
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s TerraformRegistry) PublishRelease(owner *string, repository *string, title *string, tag string, description *string, options *map[string]interface{}) (*api.Release, error) {
+	version := strings.TrimPrefix(tag, "v")
+	log.Debugf("creating version '%s' of module '%s/%s/%s' in the registry at '%s'", version, s.namespace, s.name, s.provider, s.baseURI)
+
+	attributes := map[string]string{"version": version}
+	if s.commitSHA != nil {
+		attributes["commit-sha"] = *s.commitSHA
+	}
+	payload, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{"type": "registry-module-versions", "attributes": attributes}})
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to build the request to create version '%s'", version), Cause: err}
+	}
+	request, err := http.NewRequest(http.MethodPost, s.versionsURL(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to build the request to create version '%s'", version), Cause: err}
+	}
+	request.Header.Set("Content-Type", "application/vnd.api+json")
+	body, err := s.send(request, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data struct {
+			Links struct {
+				Upload *string `json:"upload"`
+			} `json:"links"`
+		} `json:"data"`
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, &errs.TransportError{Message: fmt.Sprintf("unable to parse the response to the request to create version '%s'", version), Cause: err}
+	}
+
+	releaseTitle := version
+	if title != nil {
+		releaseTitle = *title
+	}
+	var apiRelease api.Release = newTerraformRegistryRelease(tag, releaseTitle, response.Data.Links.Upload)
+	return &apiRelease, nil
+}
+
+/*
+Publishes a set of assets for a release. Only local files are supported and each file must be a module archive
+(.tar.gz) that is uploaded as the content of the module version. Since a module version has only one archive,
+only the first asset that can be resolved to a local file is uploaded while the others are skipped.
+
+Arguments are as follows:
+
+  - owner this argument is ignored
+  - repository this argument is ignored
+  - release the release to publish the assets for. It must be an object created by the same service
+    implementation
+  - assets the set of assets to publish
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+- UnsupportedOperationError if the given release has not been created by this service.
+*/
+func (s TerraformRegistry) PublishReleaseAssets(owner *string, repository *string, release *api.Release, assets []ent.Attachment) (*api.Release, error) {
+	registryRelease, castOK := (*release).(*TerraformRegistryRelease)
+	if !castOK {
+		return nil, &errs.UnsupportedOperationError{Message: fmt.Sprintf("the given release must be of type TerraformRegistryRelease")}
+	}
+
+	for i, asset := range assets {
+		if len(registryRelease.GetAssets()) > 0 {
+			log.Warnf("a module archive has already been uploaded for version '%s' so asset %d out of %d will be skipped", registryRelease.GetTag(), i, len(assets))
+			continue
+		}
+		if registryRelease.GetUploadLink() == nil {
+			log.Warnf("the registry did not provide an upload link for version '%s', which usually means the module is linked to a VCS repository, so asset %d out of %d will be skipped", registryRelease.GetTag(), i, len(assets))
+			continue
+		}
+		if asset.GetPath() == nil {
+			log.Warnf("the asset %d out of %d has no path and will be skipped", i, len(assets))
+			continue
+		}
+		file, err := os.Open(*asset.GetPath())
+		if err != nil {
+			log.Warnf("the path '%s' for the asset %d out of %d cannot be resolved to a local file and will be skipped", *asset.GetPath(), i, len(assets))
+			continue
+		}
+
+		log.Debugf("uploading the module archive '%s' for version '%s'", *asset.GetPath(), registryRelease.GetTag())
+		request, err := http.NewRequest(http.MethodPut, *registryRelease.GetUploadLink(), file)
+		if err != nil {
+			file.Close()
+			return nil, &errs.TransportError{Message: fmt.Sprintf("unable to build the request to upload '%s'", *asset.GetPath()), Cause: err}
+		}
+		request.Header.Set("Content-Type", "application/octet-stream")
+		// the upload link is pre-authorized so the token must not be sent along with it
+		_, err = s.send(request, false)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		registryRelease.addAsset(*ent.NewAttachmentWith(asset.GetFileName(), asset.GetDescription(), registryRelease.GetUploadLink(), asset.GetType()))
+	}
+
+	var apiRelease api.Release = registryRelease
+	return &apiRelease, nil
+}
+
+/*
+Safely checks if the underlying implementation supports the given operation. If this
+method returns true then the underlying class will not raise any
+UnsupportedOperationError when invoking the specific methods.
+
+Arguments are as follows:
+
+- feature the feature to check for support.
+*/
+func (s TerraformRegistry) Supports(feature api.Feature) bool {
+	switch feature {
+	case api.GIT_HOSTING:
+		return false
+	case api.RELEASES:
+		return true
+	case api.RELEASE_ASSETS:
+		return true
+	case api.USERS:
+		return false
+	default:
+		return false
+	}
+}
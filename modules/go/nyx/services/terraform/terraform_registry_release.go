@@ -0,0 +1,100 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package terraform
+
+import (
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+)
+
+/*
+A module version published to a Terraform module registry.
+
+Module registries have no notion of releases like Git hosting services do, so this object just
+tracks the module version and the link to upload the module archive to.
+*/
+type TerraformRegistryRelease struct {
+	// The assets (module archives) published for the relese, or nil.
+	assets []ent.Attachment
+
+	// The tag the release refers to.
+	tag string
+
+	// The release title.
+	title string
+
+	// The link to upload the module archive to. It may be nil.
+	uploadLink *string
+}
+
+/*
+Creates the release object with the given attributes.
+
+Arguments are as follows:
+
+  - tag the tag (version) the release refers to
+  - title the release title
+  - uploadLink the link to upload the module archive to. It may be nil
+*/
+func newTerraformRegistryRelease(tag string, title string, uploadLink *string) *TerraformRegistryRelease {
+	res := &TerraformRegistryRelease{}
+	res.tag = tag
+	res.title = title
+	res.uploadLink = uploadLink
+	return res
+}
+
+/*
+Adds the given asset to the internal set of assets. The internal set of assets is initialized
+in case it was still nil.
+
+Arguments are as follows:
+
+- asset the asset to add
+*/
+func (r *TerraformRegistryRelease) addAsset(asset ent.Attachment) *TerraformRegistryRelease {
+	r.assets = append(r.assets, asset)
+
+	return r
+}
+
+/*
+Returns the assets attached to the relese, otherwise returns nil.
+*/
+func (r *TerraformRegistryRelease) GetAssets() []ent.Attachment {
+	return r.assets
+}
+
+/*
+Returns the tag the release refers to.
+*/
+func (r *TerraformRegistryRelease) GetTag() string {
+	return r.tag
+}
+
+/*
+Returns the release title.
+*/
+func (r *TerraformRegistryRelease) GetTitle() string {
+	return r.title
+}
+
+/*
+Returns the link to upload the module archive to, or nil if the registry didn't provide one.
+*/
+func (r *TerraformRegistryRelease) GetUploadLink() *string {
+	return r.uploadLink
+}
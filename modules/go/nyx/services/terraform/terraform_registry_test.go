@@ -0,0 +1,107 @@
+//go:build unit
+// +build unit
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package terraform
+
+import (
+	"encoding/json"     // https://pkg.go.dev/encoding/json
+	"io"                // https://pkg.go.dev/io
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"os"                // https://pkg.go.dev/os
+	"path/filepath"     // https://pkg.go.dev/path/filepath
+	"testing"           // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+func TestTerraformRegistryInstance(t *testing.T) {
+	_, err := Instance(nil)
+	assert.Error(t, err)
+	_, err = Instance(map[string]string{AUTHENTICATION_TOKEN_OPTION_NAME: "token", ORGANIZATION_OPTION_NAME: "org", MODULE_NAME_OPTION_NAME: "vpc"})
+	assert.Error(t, err)
+
+	service, err := Instance(map[string]string{AUTHENTICATION_TOKEN_OPTION_NAME: "token", ORGANIZATION_OPTION_NAME: "org", MODULE_NAME_OPTION_NAME: "vpc", MODULE_PROVIDER_OPTION_NAME: "aws"})
+	assert.NoError(t, err)
+	assert.Equal(t, DEFAULT_BASE_URI, service.baseURI)
+	assert.Equal(t, "org", service.namespace)
+	assert.Equal(t, "https://app.terraform.io/api/v2/organizations/org/registry-modules/private/org/vpc/aws/versions", service.versionsURL())
+}
+
+func TestTerraformRegistrySupports(t *testing.T) {
+	service, err := Instance(map[string]string{AUTHENTICATION_TOKEN_OPTION_NAME: "token", ORGANIZATION_OPTION_NAME: "org", MODULE_NAME_OPTION_NAME: "vpc", MODULE_PROVIDER_OPTION_NAME: "aws"})
+	assert.NoError(t, err)
+
+	assert.False(t, service.Supports(api.GIT_HOSTING))
+	assert.True(t, service.Supports(api.RELEASES))
+	assert.True(t, service.Supports(api.RELEASE_ASSETS))
+	assert.False(t, service.Supports(api.USERS))
+}
+
+func TestTerraformRegistryPublishRelease(t *testing.T) {
+	var serverURL string
+	var createdVersion string
+	var uploadedContent string
+	var uploadAuthorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch r.URL.Path {
+		case "/api/v2/organizations/org/registry-modules/private/org/vpc/aws/versions":
+			var payload struct {
+				Data struct {
+					Attributes map[string]string `json:"attributes"`
+				} `json:"data"`
+			}
+			json.Unmarshal(body, &payload)
+			createdVersion = payload.Data.Attributes["version"]
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data":{"links":{"upload":"` + serverURL + `/upload"}}}`))
+		case "/upload":
+			uploadedContent = string(body)
+			uploadAuthorization = r.Header.Get("Authorization")
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	serverURL = server.URL
+
+	service, err := Instance(map[string]string{BASE_URI_OPTION_NAME: server.URL, AUTHENTICATION_TOKEN_OPTION_NAME: "token", ORGANIZATION_OPTION_NAME: "org", MODULE_NAME_OPTION_NAME: "vpc", MODULE_PROVIDER_OPTION_NAME: "aws"})
+	assert.NoError(t, err)
+
+	release, err := service.PublishRelease(nil, nil, nil, "v1.2.3", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3", createdVersion)
+	assert.Equal(t, server.URL+"/upload", *(*release).(*TerraformRegistryRelease).GetUploadLink())
+
+	archive := filepath.Join(t.TempDir(), "module.tar.gz")
+	err = os.WriteFile(archive, []byte("archive content"), 0644)
+	assert.NoError(t, err)
+	release, err = service.PublishReleaseAssets(nil, nil, release, []ent.Attachment{*ent.NewAttachmentWith(utl.PointerToString("module.tar.gz"), nil, &archive, nil), *ent.NewAttachmentWith(utl.PointerToString("other.tar.gz"), nil, &archive, nil)})
+	assert.NoError(t, err)
+	assert.Equal(t, "archive content", uploadedContent)
+	assert.Empty(t, uploadAuthorization)
+	// only one archive can be uploaded for each version
+	assert.Equal(t, 1, len((*release).GetAssets()))
+}
@@ -0,0 +1,352 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This is the versions file package for Nyx, providing services to record released versions in a JSON file that can be
+stored locally or on a remote HTTP endpoint (i.e. a bucket), as an alternative to Git tags.
+*/
+package versionsfile
+
+import (
+	"bytes"         // https://pkg.go.dev/bytes
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"io"            // https://pkg.go.dev/io
+	"net/http"      // https://pkg.go.dev/net/http
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
+	"time"          // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://github.com/Sirupsen/logrus, https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+const (
+	/*
+		The name of the option used to pass the location of the versions file to this object instance.
+		The location can be an 'http://' or 'https://' URL, in which case the file is read with a GET request and
+		written with a PUT request, or a local path.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is mandatory.
+	*/
+	LOCATION_OPTION_NAME = "LOCATION"
+
+	/*
+		The name of the option used to pass the authentication (bearer) token to authenticate to the remote endpoint.
+		This is the value of the key inside the options passed to get a new instance of this class.
+		This option is only used when the location is a URL and it's not mandatory.
+	*/
+	AUTHENTICATION_TOKEN_OPTION_NAME = "AUTHENTICATION_TOKEN"
+)
+
+/*
+The contents of the versions file.
+*/
+type versionsFileContents struct {
+	// The stored versions.
+	Versions []*VersionsFileVersion `json:"versions"`
+}
+
+/*
+The entry point to the versions file service.
+*/
+type VersionsFile struct {
+	// The location of the file, either a URL or a local path.
+	location string
+
+	// True when the location is a URL, false when it's a local path.
+	remote bool
+
+	// The token for bearer authentication. It may be nil.
+	token *string
+
+	// The private HTTP client instance.
+	client *http.Client
+}
+
+/*
+Returns an instance using the given options.
+
+Arguments are as follows:
+
+  - options the map of options for the requested service. It can't be nil.
+    Valid options are documented as constants on this class.
+
+Errors can be:
+
+- NilPointerError if the given options map is nil
+- IllegalArgumentError if some entries in the given options map are missing or illegal for some reason
+*/
+func Instance(options map[string]string) (VersionsFile, error) {
+	if options == nil {
+		return VersionsFile{}, &errs.NilPointerError{Message: fmt.Sprintf("can't create a new instance with a null options map")}
+	}
+
+	res := VersionsFile{}
+	location, ok := options[LOCATION_OPTION_NAME]
+	if !ok || "" == strings.TrimSpace(location) {
+		return VersionsFile{}, &errs.IllegalArgumentError{Message: fmt.Sprintf("no location passed to the '%s' service. Use the '%s' option to set this value", "VersionsFile", LOCATION_OPTION_NAME)}
+	}
+	res.location = strings.TrimSpace(location)
+	lowerLocation := strings.ToLower(res.location)
+	res.remote = strings.HasPrefix(lowerLocation, "http://") || strings.HasPrefix(lowerLocation, "https://")
+
+	token, ok := options[AUTHENTICATION_TOKEN_OPTION_NAME]
+	if ok && "" != strings.TrimSpace(token) {
+		res.token = &token
+	}
+
+	log.Tracef("instantiating new VersionsFile service for '%s'", res.location)
+	res.client = &http.Client{}
+
+	return res, nil
+}
+
+/*
+Sends the given request adding the authentication headers, if configured, and returns the response body.
+The returned flag is false when the server replies that the resource does not exist.
+
+Arguments are as follows:
+
+- request the request to send
+
+Errors can be:
+
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails or the server returns an unexpected status
+*/
+func (s VersionsFile) send(request *http.Request) ([]byte, bool, error) {
+	if s.token != nil {
+		request.Header.Set("Authorization", "Bearer "+*s.token)
+	}
+
+	log.Tracef("sending '%s' request to '%s'", request.Method, request.URL.String())
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, false, &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed", request.Method, request.URL.String()), Cause: err}
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, false, &errs.TransportError{Message: fmt.Sprintf("unable to read the response to '%s' request to '%s'", request.Method, request.URL.String()), Cause: err}
+	}
+	if response.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		return nil, false, &errs.SecurityError{Message: fmt.Sprintf("'%s' request to '%s' was rejected with status '%s'", request.Method, request.URL.String(), response.Status)}
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, false, &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed with status '%s': %s", request.Method, request.URL.String(), response.Status, string(body))}
+	}
+	return body, true, nil
+}
+
+/*
+Reads the versions file. When the file does not exist yet empty contents are returned.
+
+Errors can be:
+
+- DataAccessError if the file can't be read or parsed
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s VersionsFile) read() (versionsFileContents, error) {
+	var data []byte
+	if s.remote {
+		request, err := http.NewRequest(http.MethodGet, s.location, nil)
+		if err != nil {
+			return versionsFileContents{}, &errs.TransportError{Message: fmt.Sprintf("unable to create the request to '%s'", s.location), Cause: err}
+		}
+		body, found, err := s.send(request)
+		if err != nil {
+			return versionsFileContents{}, err
+		}
+		if !found {
+			log.Debugf("the versions file '%s' does not exist yet", s.location)
+			return versionsFileContents{}, nil
+		}
+		data = body
+	} else {
+		body, err := os.ReadFile(s.location)
+		if err != nil {
+			if os.IsNotExist(err) {
+				log.Debugf("the versions file '%s' does not exist yet", s.location)
+				return versionsFileContents{}, nil
+			}
+			return versionsFileContents{}, &errs.DataAccessError{Message: fmt.Sprintf("unable to read the versions file '%s'", s.location), Cause: err}
+		}
+		data = body
+	}
+
+	contents := versionsFileContents{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return contents, nil
+	}
+	err := json.Unmarshal(data, &contents)
+	if err != nil {
+		return versionsFileContents{}, &errs.DataAccessError{Message: fmt.Sprintf("unable to parse the versions file '%s'", s.location), Cause: err}
+	}
+	return contents, nil
+}
+
+/*
+Writes the given contents to the versions file, replacing the previous contents.
+
+Arguments are as follows:
+
+- contents the contents to write
+
+Errors can be:
+
+- DataAccessError if the file can't be written
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s VersionsFile) write(contents versionsFileContents) error {
+	data, err := json.MarshalIndent(contents, "", "  ")
+	if err != nil {
+		return &errs.DataAccessError{Message: fmt.Sprintf("unable to marshal the versions file '%s'", s.location), Cause: err}
+	}
+	if s.remote {
+		request, err := http.NewRequest(http.MethodPut, s.location, bytes.NewReader(data))
+		if err != nil {
+			return &errs.TransportError{Message: fmt.Sprintf("unable to create the request to '%s'", s.location), Cause: err}
+		}
+		request.Header.Set("Content-Type", "application/json")
+		_, found, err := s.send(request)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return &errs.TransportError{Message: fmt.Sprintf("'%s' request to '%s' failed with status '%s'", http.MethodPut, s.location, http.StatusText(http.StatusNotFound))}
+		}
+		return nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(s.location), os.ModePerm)
+	if err != nil {
+		return &errs.DataAccessError{Message: fmt.Sprintf("unable to create the directory for the versions file '%s'", s.location), Cause: err}
+	}
+	err = os.WriteFile(s.location, data, 0644)
+	if err != nil {
+		return &errs.DataAccessError{Message: fmt.Sprintf("unable to write the versions file '%s'", s.location), Cause: err}
+	}
+	return nil
+}
+
+/*
+Returns the versions stored in the versions file, in the same order they appear in the file. When the file does not
+exist yet an empty slice is returned.
+
+Errors can be:
+
+- DataAccessError if the file can't be read or parsed
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s VersionsFile) GetStoredVersions() ([]api.StoredVersion, error) {
+	contents, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]api.StoredVersion, 0, len(contents.Versions))
+	for _, version := range contents.Versions {
+		if version != nil {
+			res = append(res, version)
+		}
+	}
+	log.Debugf("%d versions have been read from '%s'", len(res), s.location)
+	return res, nil
+}
+
+/*
+Stores the given version in the versions file. When the version is already in the file its commit and date are
+updated, otherwise it's appended.
+
+Arguments are as follows:
+
+- version the version to store (i.e. 1.2.3, v4.5.6). It can't be empty
+- commit the SHA-1 identifier of the commit the version has been released from. It can't be empty
+
+Errors can be:
+
+- IllegalArgumentError if the version or the commit are empty
+- DataAccessError if the file can't be read, parsed or written
+- SecurityError if authentication or authorization fails
+- TransportError if communication to the remote endpoint fails
+*/
+func (s VersionsFile) StoreVersion(version string, commit string) (api.StoredVersion, error) {
+	if "" == strings.TrimSpace(version) {
+		return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("can't store an empty version")}
+	}
+	if "" == strings.TrimSpace(commit) {
+		return nil, &errs.IllegalArgumentError{Message: fmt.Sprintf("can't store version '%s' with an empty commit", version)}
+	}
+
+	contents, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	var stored *VersionsFileVersion
+	for _, existing := range contents.Versions {
+		if existing != nil && existing.Version == version {
+			stored = existing
+		}
+	}
+	if stored == nil {
+		stored = &VersionsFileVersion{Version: version}
+		contents.Versions = append(contents.Versions, stored)
+	}
+	stored.Commit = commit
+	stored.Date = time.Now().UTC().Format(time.RFC3339)
+
+	log.Debugf("storing version '%s' released from commit '%s' to '%s'", version, commit, s.location)
+	err = s.write(contents)
+	if err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+/*
+Safely checks if the underlying implementation supports the given operation. If this
+method returns true then the underlying class will not raise any
+UnsupportedOperationError when invoking the specific methods.
+
+Arguments are as follows:
+
+- feature the feature to check for support.
+*/
+func (s VersionsFile) Supports(feature api.Feature) bool {
+	switch feature {
+	case api.GIT_HOSTING:
+		return false
+	case api.RELEASES:
+		return false
+	case api.RELEASE_ASSETS:
+		return false
+	case api.USERS:
+		return false
+	case api.VERSION_STORAGE:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,167 @@
+//go:build unit
+// +build unit
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package versionsfile
+
+import (
+	"encoding/json"     // https://pkg.go.dev/encoding/json
+	"io"                // https://pkg.go.dev/io
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"os"                // https://pkg.go.dev/os
+	"path/filepath"     // https://pkg.go.dev/path/filepath
+	"testing"           // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	api "github.com/mooltiverse/nyx/modules/go/nyx/services/api"
+)
+
+func TestVersionsFileInstance(t *testing.T) {
+	_, err := Instance(nil)
+	assert.Error(t, err)
+	_, err = Instance(map[string]string{})
+	assert.Error(t, err)
+
+	service, err := Instance(map[string]string{LOCATION_OPTION_NAME: "versions.json"})
+	assert.NoError(t, err)
+	assert.False(t, service.remote)
+	assert.Nil(t, service.token)
+
+	service, err = Instance(map[string]string{LOCATION_OPTION_NAME: "HTTPS://bucket.example.com/versions.json", AUTHENTICATION_TOKEN_OPTION_NAME: "secret"})
+	assert.NoError(t, err)
+	assert.True(t, service.remote)
+	assert.Equal(t, "secret", *service.token)
+}
+
+func TestVersionsFileSupports(t *testing.T) {
+	service, err := Instance(map[string]string{LOCATION_OPTION_NAME: "versions.json"})
+	assert.NoError(t, err)
+
+	assert.False(t, service.Supports(api.GIT_HOSTING))
+	assert.False(t, service.Supports(api.RELEASES))
+	assert.False(t, service.Supports(api.RELEASE_ASSETS))
+	assert.False(t, service.Supports(api.USERS))
+	assert.True(t, service.Supports(api.VERSION_STORAGE))
+}
+
+func TestVersionsFileStoreVersionWithLocalFile(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "releases", "versions.json")
+	service, err := Instance(map[string]string{LOCATION_OPTION_NAME: location})
+	assert.NoError(t, err)
+
+	// the file does not exist yet
+	versions, err := service.GetStoredVersions()
+	assert.NoError(t, err)
+	assert.Empty(t, versions)
+
+	_, err = service.StoreVersion("", "abc")
+	assert.Error(t, err)
+	_, err = service.StoreVersion("1.0.0", "")
+	assert.Error(t, err)
+
+	stored, err := service.StoreVersion("1.0.0", "abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", stored.GetVersion())
+	assert.Equal(t, "abc", stored.GetCommit())
+	_, err = service.StoreVersion("1.1.0", "def")
+	assert.NoError(t, err)
+	// storing an existing version updates its commit
+	_, err = service.StoreVersion("1.0.0", "ghi")
+	assert.NoError(t, err)
+
+	versions, err = service.GetStoredVersions()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(versions))
+	assert.Equal(t, "1.0.0", versions[0].GetVersion())
+	assert.Equal(t, "ghi", versions[0].GetCommit())
+	assert.Equal(t, "1.1.0", versions[1].GetVersion())
+	assert.Equal(t, "def", versions[1].GetCommit())
+
+	// a malformed file is an error
+	err = os.WriteFile(location, []byte("not json"), 0644)
+	assert.NoError(t, err)
+	_, err = service.GetStoredVersions()
+	assert.Error(t, err)
+}
+
+func TestVersionsFileStoreVersionWithRemoteFile(t *testing.T) {
+	var data []byte
+	authorizations := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		switch r.Method {
+		case http.MethodGet:
+			if data == nil {
+				w.WriteHeader(http.StatusNotFound)
+			} else {
+				w.WriteHeader(http.StatusOK)
+				w.Write(data)
+			}
+		case http.MethodPut:
+			data, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	service, err := Instance(map[string]string{LOCATION_OPTION_NAME: server.URL + "/versions.json", AUTHENTICATION_TOKEN_OPTION_NAME: "secret"})
+	assert.NoError(t, err)
+
+	// the file does not exist yet
+	versions, err := service.GetStoredVersions()
+	assert.NoError(t, err)
+	assert.Empty(t, versions)
+
+	_, err = service.StoreVersion("v1.0.0", "abc")
+	assert.NoError(t, err)
+
+	contents := versionsFileContents{}
+	assert.NoError(t, json.Unmarshal(data, &contents))
+	assert.Equal(t, 1, len(contents.Versions))
+	assert.Equal(t, "v1.0.0", contents.Versions[0].Version)
+	assert.Equal(t, "abc", contents.Versions[0].Commit)
+	assert.NotEmpty(t, contents.Versions[0].Date)
+
+	versions, err = service.GetStoredVersions()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(versions))
+	assert.Equal(t, "v1.0.0", versions[0].GetVersion())
+
+	for _, authorization := range authorizations {
+		assert.Equal(t, "Bearer secret", authorization)
+	}
+}
+
+func TestVersionsFileGetStoredVersionsWithRejectedCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	service, err := Instance(map[string]string{LOCATION_OPTION_NAME: server.URL + "/versions.json"})
+	assert.NoError(t, err)
+
+	_, err = service.GetStoredVersions()
+	assert.Error(t, err)
+	_, err = service.StoreVersion("1.0.0", "abc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package versionsfile
+
+/*
+A version recorded in the versions file.
+
+This structure is JSON aware as it's directly read from and written to the versions file.
+*/
+type VersionsFileVersion struct {
+	// The SHA-1 identifier of the commit the version has been released from.
+	Commit string `json:"commit"`
+
+	// The date the version has been stored, in the RFC 3339 format.
+	Date string `json:"date,omitempty"`
+
+	// The version.
+	Version string `json:"version"`
+}
+
+/*
+Returns the SHA-1 identifier of the commit the version has been released from.
+*/
+func (v *VersionsFileVersion) GetCommit() string {
+	return v.Commit
+}
+
+/*
+Returns the date the version has been stored, in the RFC 3339 format. It may be empty for versions added to the file
+by other means.
+*/
+func (v *VersionsFileVersion) GetDate() string {
+	return v.Date
+}
+
+/*
+Returns the version, as it would be used for the tag name (i.e. 1.2.3, v4.5.6).
+*/
+func (v *VersionsFileVersion) GetVersion() string {
+	return v.Version
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package state
+
+import (
+	"crypto/hmac"   // https://pkg.go.dev/crypto/hmac
+	"crypto/sha256" // https://pkg.go.dev/crypto/sha256
+	"encoding/hex"  // https://pkg.go.dev/encoding/hex
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"strings"       // https://pkg.go.dev/strings
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The extension appended to the state file name to get the name of the file storing its signature.
+	SIGNATURE_FILE_EXTENSION = ".sig"
+)
+
+/*
+Returns the path of the file storing the signature for the given state file.
+*/
+func SignatureFileFor(stateFile string) string {
+	return stateFile + SIGNATURE_FILE_EXTENSION
+}
+
+/*
+Computes the HMAC-SHA256 of the given content using the given key.
+*/
+func computeSignature(content []byte, key string) []byte {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(content)
+	return mac.Sum(nil)
+}
+
+/*
+Signs the given state file using the given secret key and stores the signature (an hex encoded HMAC-SHA256)
+into a file with the same name plus the SIGNATURE_FILE_EXTENSION, overwriting it if it already exists.
+
+Error is:
+- IllegalArgumentError: if the given key is empty.
+- IOError: in case of any I/O error.
+*/
+func SignFile(stateFile string, key string) error {
+	if "" == key {
+		return &errs.IllegalArgumentError{Message: "the state file signing key cannot be empty"}
+	}
+	content, err := os.ReadFile(stateFile)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to read the state file '%s'", stateFile), Cause: err}
+	}
+	err = os.WriteFile(SignatureFileFor(stateFile), []byte(hex.EncodeToString(computeSignature(content, key))), 0600)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to write the signature file for the state file '%s'", stateFile), Cause: err}
+	}
+	return nil
+}
+
+/*
+Verifies the signature of the given state file using the given secret key. The signature is read from the
+file with the same name as the state file plus the SIGNATURE_FILE_EXTENSION.
+
+Error is:
+- IllegalArgumentError: if the given key is empty.
+- IOError: in case the state file cannot be read.
+- SecurityError: if the signature file is missing or unreadable or the signature doesn't match the state file contents.
+*/
+func VerifyFile(stateFile string, key string) error {
+	if "" == key {
+		return &errs.IllegalArgumentError{Message: "the state file signing key cannot be empty"}
+	}
+	content, err := os.ReadFile(stateFile)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to read the state file '%s'", stateFile), Cause: err}
+	}
+	signature, err := os.ReadFile(SignatureFileFor(stateFile))
+	if err != nil {
+		return &errs.SecurityError{Message: fmt.Sprintf("unable to read the signature for the state file '%s' from '%s'", stateFile, SignatureFileFor(stateFile)), Cause: err}
+	}
+	actual, err := hex.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil || !hmac.Equal(computeSignature(content, key), actual) {
+		return &errs.SecurityError{Message: fmt.Sprintf("the signature for the state file '%s' doesn't match its contents, the file may have been tampered with", stateFile)}
+	}
+	return nil
+}
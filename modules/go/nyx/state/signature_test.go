@@ -0,0 +1,81 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package state
+
+import (
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"testing"       // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+func TestSignFileWithEmptyKey(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	assert.NoError(t, os.WriteFile(stateFile, []byte("{}"), 0600))
+	assert.Error(t, SignFile(stateFile, ""))
+	assert.Error(t, VerifyFile(stateFile, ""))
+}
+
+func TestSignFileWithMissingFile(t *testing.T) {
+	assert.Error(t, SignFile(filepath.Join(t.TempDir(), "missing.json"), "secret"))
+}
+
+func TestSignAndVerifyFile(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	assert.NoError(t, os.WriteFile(stateFile, []byte("{\"version\":\"1.2.3\"}"), 0600))
+	assert.NoError(t, SignFile(stateFile, "secret"))
+	assert.FileExists(t, SignatureFileFor(stateFile))
+	assert.NoError(t, VerifyFile(stateFile, "secret"))
+}
+
+func TestVerifyFileWithWrongKey(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	assert.NoError(t, os.WriteFile(stateFile, []byte("{\"version\":\"1.2.3\"}"), 0600))
+	assert.NoError(t, SignFile(stateFile, "secret"))
+	err := VerifyFile(stateFile, "another")
+	assert.Error(t, err)
+	_, ok := err.(*errs.SecurityError)
+	assert.True(t, ok)
+}
+
+func TestVerifyFileWithTamperedFile(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	assert.NoError(t, os.WriteFile(stateFile, []byte("{\"version\":\"1.2.3\"}"), 0600))
+	assert.NoError(t, SignFile(stateFile, "secret"))
+	assert.NoError(t, os.WriteFile(stateFile, []byte("{\"version\":\"9.9.9\"}"), 0600))
+	err := VerifyFile(stateFile, "secret")
+	assert.Error(t, err)
+	_, ok := err.(*errs.SecurityError)
+	assert.True(t, ok)
+}
+
+func TestVerifyFileWithMissingSignature(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	assert.NoError(t, os.WriteFile(stateFile, []byte("{\"version\":\"1.2.3\"}"), 0600))
+	err := VerifyFile(stateFile, "secret")
+	assert.Error(t, err)
+	_, ok := err.(*errs.SecurityError)
+	assert.True(t, ok)
+}
@@ -31,6 +31,7 @@ import (
 	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
 
 	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	clk "github.com/mooltiverse/nyx/modules/go/nyx/clock"
 	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
 	io "github.com/mooltiverse/nyx/modules/go/nyx/io"
@@ -39,6 +40,31 @@ import (
 	ver "github.com/mooltiverse/nyx/modules/go/version"
 )
 
+const (
+	// The name of the badge file reporting the current version.
+	VERSION_BADGE_FILE_NAME = "version.json"
+
+	// The name of the badge file reporting the release date.
+	RELEASE_DATE_BADGE_FILE_NAME = "release-date.json"
+)
+
+/*
+The badge structure, compliant with the shields.io endpoint schema (https://shields.io/badges/endpoint-badge).
+*/
+type badge struct {
+	// The schema version, always 1.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// The left text of the badge.
+	Label string `json:"label"`
+
+	// The right text of the badge.
+	Message string `json:"message"`
+
+	// The right color of the badge.
+	Color string `json:"color"`
+}
+
 /*
 The State class holds a number of attributes resulting from the execution of one or more command and so represents
 the current status of a release process at a certain point in time.
@@ -52,6 +78,12 @@ type State struct {
 	// The current Git branch name.
 	Branch *string `json:"branch,omitempty" yaml:"branch,omitempty" handlebars:"branch"`
 
+	// The map of the metadata extracted from the current Git branch name.
+	BranchMetadata *map[string]string `json:"branchMetadata,omitempty" yaml:"branchMetadata,omitempty" handlebars:"branchMetadata"`
+
+	// The build metadata rendered from the release type, regardless of the targets it's included in.
+	BuildMetadata *string `json:"buildMetadata,omitempty" yaml:"buildMetadata,omitempty" handlebars:"buildMetadata"`
+
 	// The identifier to bump.
 	Bump *string `json:"bump,omitempty" yaml:"bump,omitempty" handlebars:"bump"`
 
@@ -67,6 +99,9 @@ type State struct {
 	// The flag indicating if the version is the latest in the repository, according to the scheme.
 	LatestVersion *bool `json:"latestVersion,omitempty" yaml:"latestVersion,omitempty" handlebars:"latestVersion"`
 
+	// The flag indicating if no significant commits have been found since the previous version, so there is nothing to release.
+	NothingToRelease *bool `json:"nothingToRelease,omitempty" yaml:"nothingToRelease,omitempty" handlebars:"nothingToRelease"`
+
 	// The list containing the released assets.
 	ReleaseAssets *[]ent.Attachment `json:"releaseAssets,omitempty" yaml:"releaseAssets,omitempty" handlebars:"releaseAssets"`
 
@@ -120,6 +155,12 @@ type FlatState struct {
 	// The current Git branch name.
 	Branch *string `json:"branch,omitempty" yaml:"branch,omitempty" handlebars:"branch"`
 
+	// The map of the metadata extracted from the current Git branch name.
+	BranchMetadata *map[string]string `json:"branchMetadata,omitempty" yaml:"branchMetadata,omitempty" handlebars:"branchMetadata"`
+
+	// The build metadata rendered from the release type, regardless of the targets it's included in.
+	BuildMetadata *string `json:"buildMetadata,omitempty" yaml:"buildMetadata,omitempty" handlebars:"buildMetadata"`
+
 	// The identifier to bump.
 	Bump *string `json:"bump,omitempty" yaml:"bump,omitempty" handlebars:"bump"`
 
@@ -147,6 +188,9 @@ type FlatState struct {
 	// The cached value for the newRelease attribute. It's required to cache this value or marshalling/unmarshalling won't work
 	NewReleaseCache *bool `json:"newRelease,omitempty" yaml:"newRelease,omitempty" handlebars:"newRelease"`
 
+	// The flag indicating if no significant commits have been found since the previous version, so there is nothing to release.
+	NothingToRelease *bool `json:"nothingToRelease,omitempty" yaml:"nothingToRelease,omitempty" handlebars:"nothingToRelease"`
+
 	// The list containing the released assets.
 	ReleaseAssets *[]ent.Attachment `json:"releaseAssets,omitempty" yaml:"releaseAssets,omitempty" handlebars:"releaseAssets"`
 
@@ -258,6 +302,14 @@ func (s *State) Flatten() (*FlatState, error) {
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "branch"), Cause: err}
 	}
+	resolvedState.BranchMetadata, err = s.GetBranchMetadata()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "branchMetadata"), Cause: err}
+	}
+	resolvedState.BuildMetadata, err = s.GetBuildMetadata()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "buildMetadata"), Cause: err}
+	}
 	resolvedState.Bump, err = s.GetBump()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "bump"), Cause: err}
@@ -307,6 +359,10 @@ func (s *State) Flatten() (*FlatState, error) {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "newVersion"), Cause: err}
 	}
 	resolvedState.NewVersionCache = &nVersion
+	resolvedState.NothingToRelease, err = s.GetNothingToRelease()
+	if err != nil {
+		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "nothingToRelease"), Cause: err}
+	}
 	resolvedState.ReleaseAssets, err = s.GetReleaseAssets()
 	if err != nil {
 		return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to resolve configuration option '%s'", "releaseAssets"), Cause: err}
@@ -441,6 +497,68 @@ func (s *State) SetBranch(branch *string) error {
 	return nil
 }
 
+/*
+Returns the map of the metadata extracted from the current Git branch name using the configured
+branch metadata expression, where keys are the names of the capturing groups.
+This value is only available after Nyx.infer() has run.
+
+Error is:
+- DataAccessError: in case the attribute cannot be read or accessed.
+- IllegalPropertyError: in case the attribute has been defined but has incorrect values or it can't be resolved.
+*/
+func (s *State) GetBranchMetadata() (*map[string]string, error) {
+	return s.BranchMetadata, nil
+}
+
+/*
+Sets the map of the metadata extracted from the current Git branch name.
+
+Error is:
+- DataAccessError: in case the attribute cannot be written or accessed.
+- IllegalPropertyError: in case the attribute has incorrect values or it can't be resolved.
+*/
+func (s *State) SetBranchMetadata(branchMetadata *map[string]string) error {
+	s.BranchMetadata = branchMetadata
+	return nil
+}
+
+/*
+Returns the build metadata rendered from the ReleaseType.GetBuildMetadata() template for the new version.
+This value is only available after Nyx.infer() has run and a new version has been inferred. The value is
+available even when the build metadata is not included in the version held by this state because of
+ReleaseType.GetBuildMetadataTargets().
+
+Error is:
+- DataAccessError: in case the attribute cannot be read or accessed.
+- IllegalPropertyError: in case the attribute has been defined but has incorrect values or it can't be resolved.
+*/
+func (s *State) GetBuildMetadata() (*string, error) {
+	return s.BuildMetadata, nil
+}
+
+/*
+Returns true if the state has a non nil build metadata.
+*/
+func (s *State) HasBuildMetadata() bool {
+	buildMetadata, err := s.GetBuildMetadata()
+	if err != nil {
+		return false
+	}
+	return buildMetadata != nil
+}
+
+/*
+Sets the build metadata rendered for the new version.
+
+Error is:
+- DataAccessError: in case the attribute cannot be written or accessed.
+- IllegalPropertyError: in case the attribute has incorrect values or it can't be resolved.
+*/
+func (s *State) SetBuildMetadata(buildMetadata *string) error {
+	s.BuildMetadata = buildMetadata
+	return nil
+}
+
 /*
 Returns the version identifier to bump or bumped on the previous release to produce the new release, if any.
 This value is only available after Nyx.infer() has run unless it's overridden by the configuration,
@@ -563,14 +681,17 @@ func (s *State) GetCoreVersion() (bool, error) {
 		if err != nil {
 			return false, err
 		}
+		// the epoch, if any, is not part of the version so it's removed before checking
 		if releaseLenient != nil && *releaseLenient {
-			return ver.IsCoreWithLenience(*scheme, *version, *releaseLenient), nil
+			_, v := ver.SplitEpochWithLenience(*version, *releaseLenient)
+			return ver.IsCoreWithLenience(*scheme, v, *releaseLenient), nil
 		} else {
 			releasePrefix, err := s.GetConfiguration().GetReleasePrefix()
 			if err != nil {
 				return false, err
 			}
-			return ver.IsCoreWithPrefix(*scheme, *version, releasePrefix), nil
+			_, v := ver.SplitEpochWithPrefix(*version, releasePrefix)
+			return ver.IsCoreWithPrefix(*scheme, v, releasePrefix), nil
 		}
 	} else {
 		return false, nil
@@ -723,6 +844,30 @@ func (s *State) GetNewVersion() (bool, error) {
 	}
 }
 
+/*
+Returns the flag indicating if no significant commits have been found since the previous version, so there is nothing to release.
+The flag is nil until the version has been inferred.
+
+Error is:
+- DataAccessError: in case the attribute cannot be read or accessed.
+- IllegalPropertyError: in case the attribute has been defined but has incorrect values or it can't be resolved.
+*/
+func (s *State) GetNothingToRelease() (*bool, error) {
+	return s.NothingToRelease, nil
+}
+
+/*
+Sets the flag indicating if no significant commits have been found since the previous version, so there is nothing to release.
+
+Error is:
+- DataAccessError: in case the attribute cannot be written or accessed.
+- IllegalPropertyError: in case the attribute has incorrect values or it can't be resolved.
+*/
+func (s *State) SetNothingToRelease(nothingToRelease *bool) error {
+	s.NothingToRelease = nothingToRelease
+	return nil
+}
+
 /*
 Returns the list of assets published with the release.
 */
@@ -1061,10 +1206,10 @@ func (s *State) SetVersionRange(versionRange *string) error {
 }
 
 /*
-Updates the current timestamp and returns the updated value.
+Updates the current timestamp, as it's returned by the clock in use, and returns the updated value.
 */
 func (s *State) TouchTimestamp() *int64 {
-	now := time.Now().UnixMilli()
+	now := clk.Now().UnixMilli()
 	s.Timestamp = &now
 	return &now
 }
@@ -1127,6 +1272,12 @@ func (s *State) Summary() (string, error) {
 	}
 	fmt.Fprintf(&buf, "new version      = %t\n", newVersion)
 
+	if s.NothingToRelease != nil {
+		fmt.Fprintf(&buf, "nothing to release = %t\n", *s.NothingToRelease)
+	} else {
+		fmt.Fprintf(&buf, "nothing to release = %s\n", "")
+	}
+
 	scheme, err := s.GetScheme()
 	if err != nil {
 		return "", err
@@ -1172,3 +1323,66 @@ func (s *State) Summary() (string, error) {
 
 	return buf.String(), nil
 }
+
+/*
+Returns the version and release date badges, compliant with the shields.io endpoint schema, so that they
+can be published as static files and embedded in web pages.
+
+The returned map has the badge file names as keys (VERSION_BADGE_FILE_NAME and RELEASE_DATE_BADGE_FILE_NAME)
+and their JSON contents as values.
+
+The release date is the state timestamp when a new release is being issued or the date of the commit
+holding the previous version otherwise. When none is available the release date badge reports 'unreleased'.
+
+Errors can be:
+
+- DataAccessError in case the attribute cannot be read or accessed.
+- IllegalPropertyError in case the attribute has been defined but has incorrect values or it can't be resolved.
+*/
+func (s *State) Badges() (map[string]string, error) {
+	versionBadge := badge{SchemaVersion: 1, Label: "version", Message: "unknown", Color: "lightgrey"}
+	if s.HasVersion() {
+		version, err := s.GetVersion()
+		if err != nil {
+			return nil, err
+		}
+		versionBadge.Message = *version
+		versionBadge.Color = "blue"
+	}
+
+	releaseDateBadge := badge{SchemaVersion: 1, Label: "release date", Message: "unreleased", Color: "lightgrey"}
+	newRelease, err := s.GetNewRelease()
+	if err != nil {
+		return nil, err
+	}
+	var releaseDate *int64 = nil
+	if newRelease {
+		timestamp, err := s.GetTimestamp()
+		if err != nil {
+			return nil, err
+		}
+		releaseDate = timestamp
+	} else {
+		releaseScope, err := s.GetReleaseScope()
+		if err != nil {
+			return nil, err
+		}
+		if releaseScope != nil && releaseScope.GetPreviousVersionCommit() != nil {
+			releaseDate = &releaseScope.GetPreviousVersionCommit().Date
+		}
+	}
+	if releaseDate != nil {
+		releaseDateBadge.Message = time.UnixMilli(*releaseDate).UTC().Format("2006-01-02")
+		releaseDateBadge.Color = "green"
+	}
+
+	res := make(map[string]string)
+	for fileName, b := range map[string]badge{VERSION_BADGE_FILE_NAME: versionBadge, RELEASE_DATE_BADGE_FILE_NAME: releaseDateBadge} {
+		content, err := json.Marshal(b)
+		if err != nil {
+			return nil, &errs.DataAccessError{Message: fmt.Sprintf("unable to marshal the '%s' badge", fileName), Cause: err}
+		}
+		res[fileName] = string(content)
+	}
+	return res, nil
+}
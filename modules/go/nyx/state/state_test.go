@@ -83,6 +83,29 @@ func TestStateSetBranch(t *testing.T) {
 	assert.Equal(t, "abranch", *branch)
 }
 
+func TestStateGetBuildMetadata(t *testing.T) {
+	// make sure the build metadata is nil in the beginning (it's set only after the Infer task has run)
+	configuration, _ := cnf.NewConfiguration()
+	state, _ := NewStateWith(configuration)
+	buildMetadata, _ := state.GetBuildMetadata()
+	assert.Nil(t, buildMetadata)
+	assert.False(t, state.HasBuildMetadata())
+
+	state.SetBuildMetadata(utl.PointerToString("sha.abc1234"))
+	buildMetadata, _ = state.GetBuildMetadata()
+	assert.Equal(t, "sha.abc1234", *buildMetadata)
+	assert.True(t, state.HasBuildMetadata())
+}
+
+func TestStateSetBuildMetadata(t *testing.T) {
+	configuration, _ := cnf.NewConfiguration()
+	state, _ := NewStateWith(configuration)
+
+	state.SetBuildMetadata(utl.PointerToString("build.42"))
+	buildMetadata, _ := state.GetBuildMetadata()
+	assert.Equal(t, "build.42", *buildMetadata)
+}
+
 func TestStateGetBump(t *testing.T) {
 	// make sure the bump is nil in the beginning (it's set only after the Infer task has run)
 	configuration, err := cnf.NewConfiguration()
@@ -382,6 +405,25 @@ func TestStateGetInternals(t *testing.T) {
 	assert.Equal(t, 0, len(*internals))
 }
 
+func TestStateGetNothingToRelease(t *testing.T) {
+	configuration, _ := cnf.NewConfiguration()
+	state, _ := NewStateWith(configuration)
+
+	nothingToRelease, err := state.GetNothingToRelease()
+	assert.NoError(t, err)
+	assert.Nil(t, nothingToRelease)
+
+	state.SetNothingToRelease(utl.PointerToBoolean(true))
+	nothingToRelease, err = state.GetNothingToRelease()
+	assert.NoError(t, err)
+	assert.True(t, *nothingToRelease)
+
+	state.SetNothingToRelease(utl.PointerToBoolean(false))
+	nothingToRelease, err = state.GetNothingToRelease()
+	assert.NoError(t, err)
+	assert.False(t, *nothingToRelease)
+}
+
 func TestStateGetLatestVersion(t *testing.T) {
 	configuration, _ := cnf.NewConfiguration()
 	state, _ := NewStateWith(configuration)
@@ -1261,6 +1303,7 @@ func TestSummary(t *testing.T) {
 	//state.SetCoreVersion() // no setter for this attribute as it's dynamically computed
 	state.SetLatestVersion(utl.PointerToBoolean(true))
 	//state.SetNewRelease() // no setter for this attribute as it's dynamically computed
+	state.SetNothingToRelease(utl.PointerToBoolean(false))
 	//state.SetNewVersion() // no setter for this attribute as it's dynamically computed
 	//state.SetScheme() // no setter for this attribute as it's dynamically computed
 	//state.SetTimestamp() // no setter for this attribute as it's dynamically computed
@@ -1292,6 +1335,8 @@ func TestSummary(t *testing.T) {
 	assert.True(t, strings.Contains(summary, "new release      = "+strconv.FormatBool(newRelease)))
 	newVersion, _ := state.GetNewVersion()
 	assert.True(t, strings.Contains(summary, "new version      = "+strconv.FormatBool(newVersion)))
+	nothingToRelease, _ := state.GetNothingToRelease()
+	assert.True(t, strings.Contains(summary, "nothing to release = "+strconv.FormatBool(*nothingToRelease)))
 	scheme, _ := state.GetScheme()
 	assert.True(t, strings.Contains(summary, "scheme           = "+(*scheme).String()))
 	timestamp, _ := state.GetTimestamp()
@@ -1302,3 +1347,27 @@ func TestSummary(t *testing.T) {
 	assert.True(t, strings.Contains(summary, "previous version = "+*releaseScope.GetPreviousVersion()))
 	assert.True(t, strings.Contains(summary, "prime version    = "+*releaseScope.GetPrimeVersion()))
 }
+
+func TestBadges(t *testing.T) {
+	configuration, _ := cnf.NewConfiguration()
+	state, _ := NewStateWith(configuration)
+
+	// with no version and no previous release both badges report placeholders
+	badges, err := state.Badges()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(badges))
+	assert.Equal(t, "{\"schemaVersion\":1,\"label\":\"version\",\"message\":\"unknown\",\"color\":\"lightgrey\"}", badges[VERSION_BADGE_FILE_NAME])
+	assert.Equal(t, "{\"schemaVersion\":1,\"label\":\"release date\",\"message\":\"unreleased\",\"color\":\"lightgrey\"}", badges[RELEASE_DATE_BADGE_FILE_NAME])
+
+	// when there is no new release the release date is taken from the previous version commit
+	state.SetVersion(utl.PointerToString("4.5.6"))
+	releaseScope, err := state.GetReleaseScope()
+	assert.NoError(t, err)
+	releaseScope.SetPreviousVersion(utl.PointerToString("4.5.6"))
+	releaseScope.SetPreviousVersionCommit(gitent.NewCommitWith("05cbfd58fadbec3d96b220a0054d96875aa37011", 1577833200000, []string{"c97e4b3d0ffed8405a6b50460a1bf0177f0fde1f"}, *gitent.NewActionWith(*gitent.NewIdentityWith("Jim", "jim@example.com"), *gitent.NewTimeStampWithIn(time.Now().UnixMilli(), utl.PointerToInt(0))), *gitent.NewActionWith(*gitent.NewIdentityWith("Jim", "jim@example.com"), *gitent.NewTimeStampWithIn(time.Now().UnixMilli(), utl.PointerToInt(0))), *gitent.NewMessageWith("fix: a commit that fixes something", "fix: a commit that fixes something", nil), []gitent.Tag{*gitent.NewTagWith("4.5.6", "05cbfd58fadbec3d96b220a0054d96875aa37011", false)}))
+
+	badges, err = state.Badges()
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"schemaVersion\":1,\"label\":\"version\",\"message\":\"4.5.6\",\"color\":\"blue\"}", badges[VERSION_BADGE_FILE_NAME])
+	assert.Equal(t, "{\"schemaVersion\":1,\"label\":\"release date\",\"message\":\"2019-12-31\",\"color\":\"green\"}", badges[RELEASE_DATE_BADGE_FILE_NAME])
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"bytes"         // https://pkg.go.dev/bytes
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"fmt"           // https://pkg.go.dev/fmt
+	"net/http"      // https://pkg.go.dev/net/http
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"runtime"       // https://pkg.go.dev/runtime
+	"time"          // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The version of the telemetry report format, incremented whenever the format changes.
+	REPORT_FORMAT_VERSION = 1
+
+	// The timeout of the request uploading the report.
+	UPLOAD_TIMEOUT = 10 * time.Second
+)
+
+/*
+The telemetry report, with the metrics collected so far along with the few details needed to put them in context.
+*/
+type Report struct {
+	// The version of the report format.
+	FormatVersion int `json:"formatVersion"`
+
+	// The operating system Nyx is running on (i.e. 'linux').
+	OS string `json:"os"`
+
+	// The architecture Nyx is running on (i.e. 'amd64').
+	Arch string `json:"arch"`
+
+	// The metrics collected so far, sorted by category and name.
+	Metrics []Metric `json:"metrics"`
+}
+
+/*
+Returns a new report with the metrics collected so far.
+*/
+func NewReport() Report {
+	return Report{FormatVersion: REPORT_FORMAT_VERSION, OS: runtime.GOOS, Arch: runtime.GOARCH, Metrics: Metrics()}
+}
+
+/*
+Saves the report with the metrics collected so far to the given file, in JSON format, creating the parent
+directories if needed. The file can be reviewed before it's uploaded, if ever.
+
+Arguments are as follows:
+
+- path the path to the file to write
+
+Error is:
+- IOError: in case the file can't be written.
+*/
+func SaveReport(path string) error {
+	content, err := json.MarshalIndent(NewReport(), "", "  ")
+	if err != nil {
+		return &errs.IOError{Message: "unable to marshal the telemetry report", Cause: err}
+	}
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to create the parent directory of the telemetry report '%s'", path), Cause: err}
+	}
+	err = os.WriteFile(path, content, 0644)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to write the telemetry report to '%s'", path), Cause: err}
+	}
+	return nil
+}
+
+/*
+Uploads the report saved to the given file to the given endpoint with a POST request. The file is sent as it is, so
+what's uploaded is exactly what can be reviewed locally.
+
+Arguments are as follows:
+
+- path the path to the report file, as it was written by SaveReport
+- endpoint the URL of the endpoint to upload the report to
+
+Error is:
+- IOError: in case the file can't be read.
+- TransportError: in case the upload fails or the endpoint doesn't accept the report.
+*/
+func UploadReport(path string, endpoint string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to read the telemetry report from '%s'", path), Cause: err}
+	}
+	request, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(content))
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to build the request to upload the telemetry report to '%s'", endpoint), Cause: err}
+	}
+	request.Header.Set("Content-Type", "application/json")
+	log.Debugf("uploading the telemetry report to '%s'", endpoint)
+	client := &http.Client{Timeout: UPLOAD_TIMEOUT}
+	response, err := client.Do(request)
+	if err != nil {
+		return &errs.TransportError{Message: fmt.Sprintf("unable to upload the telemetry report to '%s'", endpoint), Cause: err}
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return &errs.TransportError{Message: fmt.Sprintf("the upload of the telemetry report to '%s' failed with status '%s'", endpoint, response.Status)}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This is the telemetry package for Nyx, collecting anonymous usage and performance metrics.
+
+Telemetry is strictly opt-in: nothing is collected until Enable() is invoked, which only happens when the user
+configures the telemetry report file. Metrics are aggregated in memory and only leave the process when the report
+is saved locally (so that it can be reviewed) and, optionally, uploaded to an endpoint chosen by the user.
+
+Metrics are identified by a category and a name that are fixed in the code (i.e. 'command' and 'infer'). No value
+coming from the repository, the configuration or the environment (like paths, URLs, names, versions or error
+messages) is ever collected.
+*/
+package telemetry
+
+import (
+	"sort" // https://pkg.go.dev/sort
+	"sync" // https://pkg.go.dev/sync
+	"time" // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+)
+
+const (
+	// The category of the metrics about commands.
+	COMMAND_CATEGORY = "command"
+
+	// The category of the metrics about Git operations.
+	GIT_CATEGORY = "git"
+
+	// The category of the metrics about hosting services operations.
+	SERVICES_CATEGORY = "services"
+)
+
+/*
+The aggregated measures of an operation, identified by its category and name.
+*/
+type Metric struct {
+	// The category of the operation (i.e. 'command', 'git', 'services').
+	Category string `json:"category"`
+
+	// The name of the operation within its category.
+	Name string `json:"name"`
+
+	// The number of times the operation has been performed.
+	Count int `json:"count"`
+
+	// The number of times the operation has failed.
+	Failures int `json:"failures"`
+
+	// The overall time spent on the operation, in milliseconds.
+	TotalMillis int64 `json:"totalMillis"`
+
+	// The time spent on the shortest execution, in milliseconds.
+	MinMillis int64 `json:"minMillis"`
+
+	// The time spent on the longest execution, in milliseconds.
+	MaxMillis int64 `json:"maxMillis"`
+}
+
+/*
+The state of the telemetry collection, shared by the whole process.
+*/
+var (
+	// The lock guarding the state below, as operations may be recorded concurrently (i.e. when running as a server).
+	lock sync.Mutex
+
+	// When false (the default) operations are not recorded.
+	enabled bool = false
+
+	// The metrics collected so far, by their category and name.
+	metrics map[string]*Metric = make(map[string]*Metric)
+)
+
+/*
+Enables the collection of metrics. Until this method is invoked no operation is recorded.
+*/
+func Enable() {
+	lock.Lock()
+	defer lock.Unlock()
+	if !enabled {
+		log.Debugf("anonymous usage and performance metrics will be collected")
+	}
+	enabled = true
+}
+
+/*
+Disables the collection of metrics and discards those collected so far.
+*/
+func Disable() {
+	lock.Lock()
+	defer lock.Unlock()
+	enabled = false
+	metrics = make(map[string]*Metric)
+}
+
+/*
+Returns true if metrics are being collected.
+*/
+func IsEnabled() bool {
+	lock.Lock()
+	defer lock.Unlock()
+	return enabled
+}
+
+/*
+Starts measuring an operation and returns the function to invoke when the operation completes, passing the error
+it returned, if any. When the collection is not enabled nothing is recorded.
+
+This is meant to be used like:
+
+	done := telemetry.Start(telemetry.GIT_CATEGORY, "push")
+	err := push()
+	done(err)
+
+Arguments are as follows:
+
+- category the category of the operation. Use one of the *_CATEGORY constants.
+- name the name of the operation. It must be a constant and never come from user data.
+*/
+func Start(category string, name string) func(err error) {
+	start := time.Now()
+	return func(err error) {
+		Record(category, name, time.Since(start), err)
+	}
+}
+
+/*
+Records an execution of an operation. When the collection is not enabled nothing is recorded.
+
+Arguments are as follows:
+
+- category the category of the operation. Use one of the *_CATEGORY constants.
+- name the name of the operation. It must be a constant and never come from user data.
+- duration the time spent on the operation
+- err the error returned by the operation, if any. Only the fact that the operation failed is recorded.
+*/
+func Record(category string, name string, duration time.Duration, err error) {
+	lock.Lock()
+	defer lock.Unlock()
+	if !enabled {
+		return
+	}
+	key := category + "/" + name
+	metric, ok := metrics[key]
+	if !ok {
+		metric = &Metric{Category: category, Name: name, MinMillis: duration.Milliseconds()}
+		metrics[key] = metric
+	}
+	millis := duration.Milliseconds()
+	metric.Count++
+	if err != nil {
+		metric.Failures++
+	}
+	metric.TotalMillis += millis
+	if millis < metric.MinMillis {
+		metric.MinMillis = millis
+	}
+	if millis > metric.MaxMillis {
+		metric.MaxMillis = millis
+	}
+}
+
+/*
+Returns a copy of the metrics collected so far, sorted by category and name.
+*/
+func Metrics() []Metric {
+	lock.Lock()
+	defer lock.Unlock()
+	res := make([]Metric, 0, len(metrics))
+	for _, metric := range metrics {
+		res = append(res, *metric)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Category != res[j].Category {
+			return res[i].Category < res[j].Category
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
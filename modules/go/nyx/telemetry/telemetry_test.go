@@ -0,0 +1,126 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"encoding/json"     // https://pkg.go.dev/encoding/json
+	"fmt"               // https://pkg.go.dev/fmt
+	"io"                // https://pkg.go.dev/io
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"os"                // https://pkg.go.dev/os
+	"path/filepath"     // https://pkg.go.dev/path/filepath
+	"runtime"           // https://pkg.go.dev/runtime
+	"testing"           // https://pkg.go.dev/testing
+	"time"              // https://pkg.go.dev/time
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+func TestRecordIsOptIn(t *testing.T) {
+	defer Disable()
+	assert.False(t, IsEnabled())
+	Record(GIT_CATEGORY, "open", time.Second, nil)
+	Start(COMMAND_CATEGORY, "infer")(nil)
+	assert.Equal(t, 0, len(Metrics()))
+
+	Enable()
+	assert.True(t, IsEnabled())
+	Record(GIT_CATEGORY, "open", time.Second, nil)
+	assert.Equal(t, 1, len(Metrics()))
+
+	// disabling also discards the metrics collected so far
+	Disable()
+	assert.False(t, IsEnabled())
+	assert.Equal(t, 0, len(Metrics()))
+}
+
+func TestRecordAggregatesMetrics(t *testing.T) {
+	Enable()
+	defer Disable()
+	Record(GIT_CATEGORY, "push", 300*time.Millisecond, nil)
+	Record(GIT_CATEGORY, "push", 100*time.Millisecond, fmt.Errorf("failed"))
+	Record(GIT_CATEGORY, "push", 200*time.Millisecond, nil)
+	Record(COMMAND_CATEGORY, "mark", 50*time.Millisecond, nil)
+	Record(GIT_CATEGORY, "open", 10*time.Millisecond, nil)
+
+	metrics := Metrics()
+	assert.Equal(t, 3, len(metrics))
+	// metrics are sorted by category and name
+	assert.Equal(t, Metric{Category: COMMAND_CATEGORY, Name: "mark", Count: 1, Failures: 0, TotalMillis: 50, MinMillis: 50, MaxMillis: 50}, metrics[0])
+	assert.Equal(t, Metric{Category: GIT_CATEGORY, Name: "open", Count: 1, Failures: 0, TotalMillis: 10, MinMillis: 10, MaxMillis: 10}, metrics[1])
+	assert.Equal(t, Metric{Category: GIT_CATEGORY, Name: "push", Count: 3, Failures: 1, TotalMillis: 600, MinMillis: 100, MaxMillis: 300}, metrics[2])
+}
+
+func TestSaveReport(t *testing.T) {
+	Enable()
+	defer Disable()
+	Record(SERVICES_CATEGORY, "publishRelease", 20*time.Millisecond, nil)
+
+	path := filepath.Join(t.TempDir(), "reports", "telemetry.json")
+	assert.NoError(t, SaveReport(path))
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	var report Report
+	assert.NoError(t, json.Unmarshal(content, &report))
+	assert.Equal(t, REPORT_FORMAT_VERSION, report.FormatVersion)
+	assert.Equal(t, runtime.GOOS, report.OS)
+	assert.Equal(t, runtime.GOARCH, report.Arch)
+	assert.Equal(t, 1, len(report.Metrics))
+	assert.Equal(t, "publishRelease", report.Metrics[0].Name)
+}
+
+func TestUploadReport(t *testing.T) {
+	Enable()
+	defer Disable()
+	Record(COMMAND_CATEGORY, "infer", 20*time.Millisecond, nil)
+	path := filepath.Join(t.TempDir(), "telemetry.json")
+	assert.NoError(t, SaveReport(path))
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	// the report is uploaded exactly as it was saved
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	assert.NoError(t, UploadReport(path, server.URL))
+	assert.Equal(t, content, received)
+
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failingServer.Close()
+	err = UploadReport(path, failingServer.URL)
+	assert.Error(t, err)
+	assert.IsType(t, &errs.TransportError{}, err)
+
+	err = UploadReport(filepath.Join(t.TempDir(), "missing.json"), server.URL)
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IOError{}, err)
+}
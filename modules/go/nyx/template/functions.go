@@ -19,6 +19,7 @@ package templates
 import (
 	"fmt"           // https://pkg.go.dev/fmt
 	"os"            // https://pkg.go.dev/os
+	"path"          // https://pkg.go.dev/path
 	"path/filepath" // https://pkg.go.dev/path/filepath
 	"strconv"       // https://pkg.go.dev/strconv
 	"strings"       // https://pkg.go.dev/strings
@@ -27,6 +28,9 @@ import (
 	raymond "github.com/aymerick/raymond" // https://pkg.go.dev/github.com/aymerick/raymond
 	regexp2 "github.com/dlclark/regexp2"  // https://pkg.go.dev/github.com/dlclark/regexp2, we need to use this instead of the standard 'regexp' to have support for lookarounds (look ahead), even if this implementation is a little slower
 	log "github.com/sirupsen/logrus"      // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	clk "github.com/mooltiverse/nyx/modules/go/nyx/clock"
+	ver "github.com/mooltiverse/nyx/modules/go/version"
 )
 
 var (
@@ -109,6 +113,9 @@ func registerHelpers() {
 		raymond.RegisterHelper("fileExists", func(options *raymond.Options) raymond.SafeString {
 			return raymond.SafeString(fileExists(options.Fn()))
 		})
+		raymond.RegisterHelper("repositoryFile", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(repositoryFile(options.Fn(), options.Data(REPOSITORY_FILE_READER_DATA_NAME)))
+		})
 
 		raymond.RegisterHelper("capture", func(options *raymond.Options) raymond.SafeString {
 			return raymond.SafeString(capture(options.Fn(), options.Hash()))
@@ -125,6 +132,43 @@ func registerHelpers() {
 		raymond.RegisterHelper("timeFormat", func(options *raymond.Options) raymond.SafeString {
 			return raymond.SafeString(timeFormat(options.Fn(), options.Hash()))
 		})
+
+		raymond.RegisterHelper("majorOf", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(majorOf(options.Fn()))
+		})
+		raymond.RegisterHelper("minorOf", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(minorOf(options.Fn()))
+		})
+		raymond.RegisterHelper("patchOf", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(patchOf(options.Fn()))
+		})
+		raymond.RegisterHelper("coreOf", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(coreOf(options.Fn()))
+		})
+		raymond.RegisterHelper("prereleaseOf", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(prereleaseOf(options.Fn()))
+		})
+		raymond.RegisterHelper("buildOf", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(buildOf(options.Fn()))
+		})
+		raymond.RegisterHelper("withoutPrerelease", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(withoutPrerelease(options.Fn()))
+		})
+		raymond.RegisterHelper("withoutBuild", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(withoutBuild(options.Fn()))
+		})
+		raymond.RegisterHelper("nextMajor", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(nextMajor(options.Fn()))
+		})
+		raymond.RegisterHelper("nextMinor", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(nextMinor(options.Fn()))
+		})
+		raymond.RegisterHelper("nextPatch", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(nextPatch(options.Fn()))
+		})
+		raymond.RegisterHelper("compareVersions", func(options *raymond.Options) raymond.SafeString {
+			return raymond.SafeString(compareVersions(options.Fn(), options.Hash()))
+		})
 	}
 	helpersRegistered = true
 }
@@ -376,6 +420,49 @@ func fileExists(input string) string {
 	}
 }
 
+/*
+This method returns the given path cleaned and using the forward slash as the separator, or an error if the path
+is empty, absolute or points outside of the repository.
+*/
+func repositoryFilePath(input string) (string, error) {
+	p := strings.ReplaceAll(strings.TrimSpace(input), "\\", "/")
+	if "" == p {
+		return "", fmt.Errorf("the path is empty")
+	}
+	if path.IsAbs(p) || filepath.IsAbs(p) || "" != filepath.VolumeName(p) {
+		return "", fmt.Errorf("the path must be relative to the repository root")
+	}
+	p = path.Clean(p)
+	if "." == p || ".." == p || strings.HasPrefix(p, "../") {
+		return "", fmt.Errorf("the path must point to a file within the repository")
+	}
+	return p, nil
+}
+
+/*
+This method returns the content of the file with the given path, relative to the repository root, read by the
+given reader, which must be a RepositoryFileReader. Errors, including those due to invalid paths and to a missing
+reader, are logged and an empty string is returned.
+*/
+func repositoryFile(input string, reader interface{}) string {
+	fileReader, ok := reader.(RepositoryFileReader)
+	if !ok || fileReader == nil {
+		log.Errorf("unable to read file '%s' from the repository as the repository is not available to this template", input)
+		return ""
+	}
+	p, err := repositoryFilePath(input)
+	if err != nil {
+		log.Errorf("unable to read file '%s' from the repository: %v", input, err)
+		return ""
+	}
+	content, err := fileReader(p)
+	if err != nil {
+		log.Errorf("unable to read file '%s' from the repository: %v", input, err)
+		return ""
+	}
+	return content
+}
+
 /*
 This method uses a given regular expression to match the string input and uses the given (named) group
 value as a return value. The 'expression' option gives the regular expression to use, while the
@@ -509,7 +596,7 @@ This method returns a time value (expressed in milliseconds) and is also able to
 format string.
 */
 func timeFormat(input string, options map[string]interface{}) string {
-	currentTime := time.Now().UnixMilli()
+	currentTime := clk.Now().UnixMilli()
 	var err error
 	if "" != strings.TrimSpace(input) {
 		currentTime, err = strconv.ParseInt(input, 10, 64)
@@ -531,3 +618,210 @@ func timeFormat(input string, options map[string]interface{}) string {
 		return strconv.FormatInt(currentTime, 10)
 	}
 }
+
+/*
+This method parses the input string as a semantic version, tolerating a prefix (like 'v' in 'v1.2.3'), and returns
+the prefix (which may be empty) and the version. If the input is not a valid semantic version an error is logged and
+false is returned.
+*/
+func parseSemanticVersion(input string, function string) (string, ver.SemanticVersion, bool) {
+	trimmed := strings.TrimSpace(input)
+	prefix, err := ver.GetSemanticVersionPrefix(trimmed)
+	if err != nil || "" == trimmed {
+		log.Errorf("the value '%s' for the '%s' function is not a valid semantic version", input, function)
+		return "", ver.SemanticVersion{}, false
+	}
+	prefixString := ""
+	if prefix != nil {
+		prefixString = *prefix
+	}
+	version, err := ver.ValueOfSemanticVersion(trimmed[len(prefixString):])
+	if err != nil {
+		log.Errorf("the value '%s' for the '%s' function is not a valid semantic version: %v", input, function, err)
+		return "", ver.SemanticVersion{}, false
+	}
+	return prefixString, version, true
+}
+
+/*
+This method returns the major number of the input semantic version. If the input is not a valid semantic
+version an empty string is returned.
+*/
+func majorOf(input string) string {
+	_, version, ok := parseSemanticVersion(input, "majorOf")
+	if !ok {
+		return ""
+	}
+	return strconv.Itoa(version.GetMajor())
+}
+
+/*
+This method returns the minor number of the input semantic version. If the input is not a valid semantic
+version an empty string is returned.
+*/
+func minorOf(input string) string {
+	_, version, ok := parseSemanticVersion(input, "minorOf")
+	if !ok {
+		return ""
+	}
+	return strconv.Itoa(version.GetMinor())
+}
+
+/*
+This method returns the patch number of the input semantic version. If the input is not a valid semantic
+version an empty string is returned.
+*/
+func patchOf(input string) string {
+	_, version, ok := parseSemanticVersion(input, "patchOf")
+	if !ok {
+		return ""
+	}
+	return strconv.Itoa(version.GetPatch())
+}
+
+/*
+This method returns the core part (major, minor and patch numbers, without the prefix) of the input semantic
+version. If the input is not a valid semantic version an empty string is returned.
+*/
+func coreOf(input string) string {
+	_, version, ok := parseSemanticVersion(input, "coreOf")
+	if !ok {
+		return ""
+	}
+	return version.GetCore()
+}
+
+/*
+This method returns the prerelease part of the input semantic version, or an empty string if the version has no
+prerelease part or the input is not a valid semantic version.
+*/
+func prereleaseOf(input string) string {
+	_, version, ok := parseSemanticVersion(input, "prereleaseOf")
+	if !ok || version.GetPrerelease() == nil {
+		return ""
+	}
+	return *version.GetPrerelease()
+}
+
+/*
+This method returns the build part of the input semantic version, or an empty string if the version has no
+build part or the input is not a valid semantic version.
+*/
+func buildOf(input string) string {
+	_, version, ok := parseSemanticVersion(input, "buildOf")
+	if !ok || version.GetBuild() == nil {
+		return ""
+	}
+	return *version.GetBuild()
+}
+
+/*
+This method returns the input semantic version without the prerelease part, retaining the prefix and the build part.
+If the input is not a valid semantic version an empty string is returned.
+*/
+func withoutPrerelease(input string) string {
+	prefix, version, ok := parseSemanticVersion(input, "withoutPrerelease")
+	if !ok {
+		return ""
+	}
+	version, err := version.SetPrerelease()
+	if err != nil {
+		log.Errorf("unable to remove the prerelease part from version '%s': %v", input, err)
+		return ""
+	}
+	return prefix + version.String()
+}
+
+/*
+This method returns the input semantic version without the build part, retaining the prefix and the prerelease part.
+If the input is not a valid semantic version an empty string is returned.
+*/
+func withoutBuild(input string) string {
+	prefix, version, ok := parseSemanticVersion(input, "withoutBuild")
+	if !ok {
+		return ""
+	}
+	version, err := version.SetBuild()
+	if err != nil {
+		log.Errorf("unable to remove the build part from version '%s': %v", input, err)
+		return ""
+	}
+	return prefix + version.String()
+}
+
+/*
+This method returns the version following the input semantic version when bumping the given core identifier,
+retaining the prefix but not the prerelease and build parts. If the input is not a valid semantic version an
+empty string is returned.
+*/
+func nextVersion(input string, identifier ver.CoreIdentifiers, function string) string {
+	prefix, version, ok := parseSemanticVersion(input, function)
+	if !ok {
+		return ""
+	}
+	version, err := version.BumpIdentifier(identifier)
+	if err == nil {
+		version, err = version.SetPrerelease()
+	}
+	if err == nil {
+		version, err = version.SetBuild()
+	}
+	if err != nil {
+		log.Errorf("unable to bump version '%s': %v", input, err)
+		return ""
+	}
+	return prefix + version.String()
+}
+
+/*
+This method returns the next major version of the input semantic version (i.e. '2.0.0' for '1.2.3-alpha.1').
+If the input is not a valid semantic version an empty string is returned.
+*/
+func nextMajor(input string) string {
+	return nextVersion(input, ver.MAJOR, "nextMajor")
+}
+
+/*
+This method returns the next minor version of the input semantic version (i.e. '1.3.0' for '1.2.3-alpha.1').
+If the input is not a valid semantic version an empty string is returned.
+*/
+func nextMinor(input string) string {
+	return nextVersion(input, ver.MINOR, "nextMinor")
+}
+
+/*
+This method returns the next patch version of the input semantic version (i.e. '1.2.4' for '1.2.3-alpha.1').
+If the input is not a valid semantic version an empty string is returned.
+*/
+func nextPatch(input string) string {
+	return nextVersion(input, ver.PATCH, "nextPatch")
+}
+
+/*
+This method compares the input semantic version with the one given in the 'to' option and returns '-1', '0' or '1'
+when the input is lower than, equal to or greater than the other version, according to the semantic versioning
+precedence rules. Prefixes are ignored. If any of the two versions is missing or not valid an empty string is returned.
+*/
+func compareVersions(input string, options map[string]interface{}) string {
+	toString, found := options["to"]
+	if !found {
+		log.Errorf("the '%s' option is required by the '%s' function", "to", "compareVersions")
+		return ""
+	}
+	_, version, ok := parseSemanticVersion(input, "compareVersions")
+	if !ok {
+		return ""
+	}
+	_, other, ok := parseSemanticVersion(fmt.Sprintf("%v", toString), "compareVersions")
+	if !ok {
+		return ""
+	}
+	comparison := version.CompareTo(other)
+	if comparison < 0 {
+		return "-1"
+	} else if comparison > 0 {
+		return "1"
+	} else {
+		return "0"
+	}
+}
@@ -204,6 +204,43 @@ func TestFunctionsFileExists(t *testing.T) {
 	assert.Equal(t, "true", fileExists(fileName))
 }
 
+func TestFunctionsRepositoryFile(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	paths := []string{}
+	reader := RepositoryFileReader(func(path string) (string, error) {
+		paths = append(paths, path)
+		if "docs/UPGRADING.md" == path {
+			return "upgrade notes", nil
+		}
+		return "", fmt.Errorf("file '%s' not found", path)
+	})
+
+	// without a reader nothing can be read
+	assert.Equal(t, "", repositoryFile("docs/UPGRADING.md", nil))
+	assert.Equal(t, "", repositoryFile("docs/UPGRADING.md", "not a reader"))
+
+	// paths are cleaned before they are read
+	assert.Equal(t, "upgrade notes", repositoryFile("docs/UPGRADING.md", reader))
+	assert.Equal(t, "upgrade notes", repositoryFile(" ./docs//UPGRADING.md ", reader))
+	assert.Equal(t, "upgrade notes", repositoryFile("docs\\UPGRADING.md", reader))
+	assert.Equal(t, "upgrade notes", repositoryFile("other/../docs/UPGRADING.md", reader))
+	assert.Equal(t, "", repositoryFile("afilethatdoesnotexists", reader))
+
+	// paths that are empty, absolute or outside the repository are never read
+	paths = []string{}
+	assert.Equal(t, "", repositoryFile("", reader))
+	assert.Equal(t, "", repositoryFile(".", reader))
+	assert.Equal(t, "", repositoryFile("/etc/passwd", reader))
+	assert.Equal(t, "", repositoryFile("..", reader))
+	assert.Equal(t, "", repositoryFile("../secret.txt", reader))
+	assert.Equal(t, "", repositoryFile("docs/../../secret.txt", reader))
+	assert.Empty(t, paths)
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
 func TestFunctionsCapture(t *testing.T) {
 	logLevel := log.GetLevel()   // save the previous logging level
 	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
@@ -342,3 +379,159 @@ func TestFunctionsTimeFormat(t *testing.T) {
 
 	log.SetLevel(logLevel) // restore the original logging level
 }
+
+func TestFunctionsMajorOf(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", majorOf(""))
+	assert.Equal(t, "", majorOf("not a version"))
+	assert.Equal(t, "1", majorOf("1.2.3"))
+	assert.Equal(t, "1", majorOf("v1.2.3"))
+	assert.Equal(t, "12", majorOf("12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsMinorOf(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", minorOf(""))
+	assert.Equal(t, "", minorOf("not a version"))
+	assert.Equal(t, "2", minorOf("1.2.3"))
+	assert.Equal(t, "2", minorOf("v1.2.3"))
+	assert.Equal(t, "0", minorOf("12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsPatchOf(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", patchOf(""))
+	assert.Equal(t, "", patchOf("not a version"))
+	assert.Equal(t, "3", patchOf("1.2.3"))
+	assert.Equal(t, "3", patchOf("v1.2.3"))
+	assert.Equal(t, "0", patchOf("12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsCoreOf(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", coreOf(""))
+	assert.Equal(t, "", coreOf("not a version"))
+	assert.Equal(t, "1.2.3", coreOf("1.2.3"))
+	assert.Equal(t, "1.2.3", coreOf("v1.2.3"))
+	assert.Equal(t, "12.0.0", coreOf("12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsPrereleaseOf(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", prereleaseOf(""))
+	assert.Equal(t, "", prereleaseOf("not a version"))
+	assert.Equal(t, "", prereleaseOf("1.2.3"))
+	assert.Equal(t, "", prereleaseOf("1.2.3+build.2"))
+	assert.Equal(t, "alpha.1", prereleaseOf("v12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsBuildOf(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", buildOf(""))
+	assert.Equal(t, "", buildOf("not a version"))
+	assert.Equal(t, "", buildOf("1.2.3"))
+	assert.Equal(t, "", buildOf("1.2.3-alpha.1"))
+	assert.Equal(t, "build.2", buildOf("v12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsWithoutPrerelease(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", withoutPrerelease(""))
+	assert.Equal(t, "", withoutPrerelease("not a version"))
+	assert.Equal(t, "1.2.3", withoutPrerelease("1.2.3"))
+	assert.Equal(t, "1.2.3", withoutPrerelease("1.2.3-alpha.1"))
+	assert.Equal(t, "v12.0.0+build.2", withoutPrerelease("v12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsWithoutBuild(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", withoutBuild(""))
+	assert.Equal(t, "", withoutBuild("not a version"))
+	assert.Equal(t, "1.2.3", withoutBuild("1.2.3"))
+	assert.Equal(t, "1.2.3", withoutBuild("1.2.3+build.2"))
+	assert.Equal(t, "v12.0.0-alpha.1", withoutBuild("v12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsNextMajor(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", nextMajor(""))
+	assert.Equal(t, "", nextMajor("not a version"))
+	assert.Equal(t, "2.0.0", nextMajor("1.2.3"))
+	assert.Equal(t, "v13.0.0", nextMajor("v12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsNextMinor(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", nextMinor(""))
+	assert.Equal(t, "", nextMinor("not a version"))
+	assert.Equal(t, "1.3.0", nextMinor("1.2.3"))
+	assert.Equal(t, "v12.1.0", nextMinor("v12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsNextPatch(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", nextPatch(""))
+	assert.Equal(t, "", nextPatch("not a version"))
+	assert.Equal(t, "1.2.4", nextPatch("1.2.3"))
+	assert.Equal(t, "v12.0.1", nextPatch("v12.0.0-alpha.1+build.2"))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestFunctionsCompareVersions(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings produced during tests
+
+	assert.Equal(t, "", compareVersions("1.2.3", map[string]interface{}{}))
+	assert.Equal(t, "", compareVersions("", map[string]interface{}{"to": "1.2.3"}))
+	assert.Equal(t, "", compareVersions("1.2.3", map[string]interface{}{"to": "not a version"}))
+	assert.Equal(t, "0", compareVersions("1.2.3", map[string]interface{}{"to": "1.2.3"}))
+	assert.Equal(t, "0", compareVersions("v1.2.3", map[string]interface{}{"to": "1.2.3"}))
+	assert.Equal(t, "-1", compareVersions("1.2.3", map[string]interface{}{"to": "1.10.0"}))
+	assert.Equal(t, "1", compareVersions("2.0.0", map[string]interface{}{"to": "1.10.0"}))
+	assert.Equal(t, "-1", compareVersions("1.2.3-alpha.1", map[string]interface{}{"to": "1.2.3"}))
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
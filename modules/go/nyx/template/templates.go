@@ -37,8 +37,24 @@ const (
 
 	// The closing delimiter of a template.
 	CLOSING_DELIMITER = "}}"
+
+	// The name of the private variable bringing the RepositoryFileReader used by the repositoryFile helper.
+	REPOSITORY_FILE_READER_DATA_NAME = "repositoryFileReader"
+
+	// The maximum size, in bytes, of the repository files that can be read by the repositoryFile helper.
+	REPOSITORY_FILE_MAX_SIZE int64 = 65536
 )
 
+/*
+The function used by the repositoryFile helper to read the file with the given path, relative to the repository
+root, from the repository. Templates can only read repository files when an instance of this type is passed to
+RenderWithData as the private variable named REPOSITORY_FILE_READER_DATA_NAME.
+
+Paths are validated by the helper before invoking the function, which is also responsible for limiting the size
+of the files it reads to REPOSITORY_FILE_MAX_SIZE.
+*/
+type RepositoryFileReader func(path string) (string, error)
+
 /*
 Returns true if the given buffer is a template, false otherwise.
 
@@ -70,8 +86,36 @@ Errors can be:
 - IOError: in case data cannot be read or accessed.
 */
 func Render(template string, scope interface{}) (string, error) {
+	return RenderWithData(template, scope, nil)
+}
+
+/*
+Renders the given template using the given scope to fetch the values, also making the given data available
+to the template as private variables (i.e. {{@name}}).
+
+Standard functions are available in the rendering engine.
+
+Arguments are as follows:
+
+  - template the template
+  - scope the object representing the value to use in rendering. If nil it won't be used.
+  - data the private variables to make available to the template, by name. If nil it won't be used.
+
+Errors can be:
+
+- IOError: in case data cannot be read or accessed.
+*/
+func RenderWithData(template string, scope interface{}, data map[string]interface{}) (string, error) {
 	registerHelpers() // register custom helpers
-	output, err := raymond.Render(template, scope)
+	tpl, err := raymond.Parse(template)
+	if err != nil {
+		return "", &errs.IOError{Message: fmt.Sprintf("unable to render the template using the given values"), Cause: err}
+	}
+	frame := raymond.NewDataFrame()
+	for name, value := range data {
+		frame.Set(name, value)
+	}
+	output, err := tpl.ExecWith(scope, frame)
 
 	if err != nil {
 		return "", &errs.IOError{Message: fmt.Sprintf("unable to render the template using the given values"), Cause: err}
@@ -83,7 +127,6 @@ func Render(template string, scope interface{}) (string, error) {
 	output = strings.ReplaceAll(output, "%!s(*string=&lt;nil&gt;)", "")
 
 	return output, nil
-
 }
 
 /*
@@ -105,6 +105,24 @@ func TestTemplatesIsTemplateWithInvalidString(t *testing.T) {
 	}
 }
 
+/*
+RenderWithData
+*/
+func TestTemplatesRenderWithData(t *testing.T) {
+	scope := map[string]string{"name": "nyx"}
+
+	output, err := RenderWithData("{{name}}-{{@os}}-{{@arch}}", scope, map[string]interface{}{"os": "linux", "arch": "amd64"})
+	assert.NoError(t, err)
+	assert.Equal(t, "nyx-linux-amd64", output)
+
+	output, err = RenderWithData("{{name}}-{{@os}}", scope, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "nyx-", output)
+
+	_, err = RenderWithData("{{#if}}", scope, nil)
+	assert.Error(t, err)
+}
+
 /*
 ToBoolean
 */
@@ -259,6 +277,20 @@ func TestTemplatesRenderFileExists(t *testing.T) {
 	assert.Equal(t, "true", output)
 }
 
+func TestTemplatesRenderRepositoryFile(t *testing.T) {
+	reader := RepositoryFileReader(func(path string) (string, error) {
+		return "content of " + path, nil
+	})
+	output, _ := RenderWithData("{{#repositoryFile}}docs/UPGRADING.md{{/repositoryFile}}", nil, map[string]interface{}{REPOSITORY_FILE_READER_DATA_NAME: reader})
+	assert.Equal(t, "content of docs/UPGRADING.md", output)
+	// the reader is also available within nested blocks
+	output, _ = RenderWithData("{{#each items}}{{#repositoryFile}}{{this}}{{/repositoryFile}};{{/each}}", map[string]interface{}{"items": []string{"a.md", "b.md"}}, map[string]interface{}{REPOSITORY_FILE_READER_DATA_NAME: reader})
+	assert.Equal(t, "content of a.md;content of b.md;", output)
+	// without a reader the output is empty
+	output, _ = Render("{{#repositoryFile}}docs/UPGRADING.md{{/repositoryFile}}", nil)
+	assert.Equal(t, "", output)
+}
+
 func TestTemplatesRenderCapture(t *testing.T) {
 	output, _ := Render("{{#capture expression=\"(?<type>[a-zA-Z0-9_]+)(\\((?<scope>[a-z ]+)\\))?:( (?<title>.+))\" group=\"type\"}}mytype(myscope): mytitle{{/capture}}", nil)
 	assert.Equal(t, "mytype", output)
@@ -283,6 +315,71 @@ func TestTemplatesRenderTimeFormat(t *testing.T) {
 	assert.Equal(t, "20200101", output)
 }
 
+func TestTemplatesRenderMajorOf(t *testing.T) {
+	output, _ := Render("{{#majorOf}}v1.2.3{{/majorOf}}", nil)
+	assert.Equal(t, "1", output)
+}
+
+func TestTemplatesRenderMinorOf(t *testing.T) {
+	output, _ := Render("{{#minorOf}}v1.2.3{{/minorOf}}", nil)
+	assert.Equal(t, "2", output)
+}
+
+func TestTemplatesRenderPatchOf(t *testing.T) {
+	output, _ := Render("{{#patchOf}}v1.2.3{{/patchOf}}", nil)
+	assert.Equal(t, "3", output)
+}
+
+func TestTemplatesRenderCoreOf(t *testing.T) {
+	output, _ := Render("{{#coreOf}}v1.2.3-alpha.1{{/coreOf}}", nil)
+	assert.Equal(t, "1.2.3", output)
+}
+
+func TestTemplatesRenderPrereleaseOf(t *testing.T) {
+	output, _ := Render("{{#prereleaseOf}}1.2.3-alpha.1+build.2{{/prereleaseOf}}", nil)
+	assert.Equal(t, "alpha.1", output)
+}
+
+func TestTemplatesRenderBuildOf(t *testing.T) {
+	output, _ := Render("{{#buildOf}}1.2.3-alpha.1+build.2{{/buildOf}}", nil)
+	assert.Equal(t, "build.2", output)
+}
+
+func TestTemplatesRenderWithoutPrerelease(t *testing.T) {
+	output, _ := Render("{{#withoutPrerelease}}v1.2.3-alpha.1{{/withoutPrerelease}}", nil)
+	assert.Equal(t, "v1.2.3", output)
+}
+
+func TestTemplatesRenderWithoutBuild(t *testing.T) {
+	output, _ := Render("{{#withoutBuild}}v1.2.3+build.2{{/withoutBuild}}", nil)
+	assert.Equal(t, "v1.2.3", output)
+}
+
+func TestTemplatesRenderNextMajor(t *testing.T) {
+	output, _ := Render("{{#nextMajor}}1.2.3{{/nextMajor}}", nil)
+	assert.Equal(t, "2.0.0", output)
+}
+
+func TestTemplatesRenderNextMinor(t *testing.T) {
+	output, _ := Render("{{#nextMinor}}1.2.3{{/nextMinor}}", nil)
+	assert.Equal(t, "1.3.0", output)
+}
+
+func TestTemplatesRenderNextPatch(t *testing.T) {
+	output, _ := Render("{{#nextPatch}}1.2.3{{/nextPatch}}", nil)
+	assert.Equal(t, "1.2.4", output)
+}
+
+func TestTemplatesRenderCompareVersions(t *testing.T) {
+	output, _ := Render("{{#compareVersions to=\"1.10.0\"}}1.2.3{{/compareVersions}}", nil)
+	assert.Equal(t, "-1", output)
+}
+
+func TestTemplatesRenderWithNestedVersionFunctions(t *testing.T) {
+	output, _ := Render("v{{#majorOf}}{{#nextMinor}}{{version}}{{/nextMinor}}{{/majorOf}}", map[string]string{"version": "1.2.3-rc.1"})
+	assert.Equal(t, "v1", output)
+}
+
 /*
 Render with nil scope
 */
@@ -0,0 +1,210 @@
+//go:build integration
+// +build integration
+
+// Only run these tests as part of the integration test suite, when the 'integration' build flag is passed (i.e. running go test --tags=integration)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package command_test
+
+import (
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"testing"       // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
+	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
+	cmdtpl "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/command/template"
+	gittools "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/git/tools"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+	ver "github.com/mooltiverse/nyx/modules/go/version"
+)
+
+func TestAnalyticsConstructor(t *testing.T) {
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.ANALYTICS, gittools.FROM_SCRATCH()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			assert.NotNil(t, command)
+		})
+	}
+}
+
+func TestAnalyticsIsUpToDate(t *testing.T) {
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.ANALYTICS, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			upToDate, err := (*command).IsUpToDate()
+			assert.NoError(t, err)
+			assert.False(t, upToDate)
+		})
+	}
+}
+
+func TestAnalyticsRunToStandardOutput(t *testing.T) {
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.ANALYTICS, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAnalyticsRunWithJSONFile(t *testing.T) {
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.ANALYTICS, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			(*command).Script().AndCommitWithTag("0.1.0")
+			(*command).Script().AndCommitWithTag("1.0.0-alpha.1")
+			(*command).Script().AndCommitWithTag("1.0.0")
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetDirectory(utl.PointerToString((*command).Script().GetWorkingDirectory()))
+			configurationLayerMock.SetAnalyticsFile(utl.PointerToString("analytics.json"))
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			content, err := os.ReadFile(filepath.Join((*command).Script().GetWorkingDirectory(), "analytics.json"))
+			assert.NoError(t, err)
+			var report map[string]interface{}
+			assert.NoError(t, json.Unmarshal(content, &report))
+
+			releases := report["releases"].([]interface{})
+			versions := []string{}
+			bumps := []string{}
+			commits := []float64{}
+			for _, release := range releases {
+				versions = append(versions, release.(map[string]interface{})["version"].(string))
+				bumps = append(bumps, release.(map[string]interface{})["bump"].(string))
+				commits = append(commits, release.(map[string]interface{})["commits"].(float64))
+			}
+			assert.Equal(t, []string{"0.0.1", "0.0.2", "0.0.3", "0.0.4", "0.1.0", "1.0.0-alpha.1", "1.0.0"}, versions)
+			assert.Equal(t, []string{"initial", "patch", "patch", "patch", "minor", "major", "promotion"}, bumps)
+			// the first release also has the initial commit, 0.1.0 also has the two untagged commits before it
+			assert.Equal(t, []float64{2, 1, 1, 1, 3, 1, 1}, commits)
+			assert.Nil(t, releases[0].(map[string]interface{})["intervalDays"])
+			assert.NotNil(t, releases[1].(map[string]interface{})["intervalDays"])
+
+			summary := report["summary"].(map[string]interface{})
+			assert.Equal(t, float64(7), summary["releases"])
+			assert.Equal(t, map[string]interface{}{"initial": float64(1), "patch": float64(3), "minor": float64(1), "major": float64(1), "promotion": float64(1)}, summary["bumps"])
+			assert.Equal(t, 1, len(summary["contributors"].([]interface{})))
+			assert.Equal(t, float64(10), summary["contributors"].([]interface{})[0].(map[string]interface{})["commits"])
+		})
+	}
+}
+
+func TestAnalyticsRunWithEpoch(t *testing.T) {
+	for _, releaseLenient := range []bool{true, false} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.ANALYTICS, gittools.ONE_BRANCH_SHORT()) {
+			t.Run((*command).GetContextName(), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				(*command).Script().AndCommitWithTag("5.3.0")
+				(*command).Script().AndCommitWithTag("v1!1.0.0")
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				configurationLayerMock.SetDirectory(utl.PointerToString((*command).Script().GetWorkingDirectory()))
+				configurationLayerMock.SetAnalyticsFile(utl.PointerToString("analytics.json"))
+				configurationLayerMock.SetReleaseLenient(utl.PointerToBoolean(releaseLenient))
+				configurationLayerMock.SetReleasePrefix(utl.PointerToString("v"))
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+
+				content, err := os.ReadFile(filepath.Join((*command).Script().GetWorkingDirectory(), "analytics.json"))
+				assert.NoError(t, err)
+				var report map[string]interface{}
+				assert.NoError(t, json.Unmarshal(content, &report))
+
+				versions := []string{}
+				for _, release := range report["releases"].([]interface{}) {
+					versions = append(versions, release.(map[string]interface{})["version"].(string))
+				}
+				assert.Equal(t, []string{"0.0.1", "0.0.2", "0.0.3", "0.0.4", "5.3.0", "v1!1.0.0"}, versions)
+			})
+		}
+	}
+}
+
+func TestAnalyticsRunWithFourSegmentScheme(t *testing.T) {
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.ANALYTICS, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			(*command).Script().AndCommitWithTag("1.0.0.0")
+			(*command).Script().AndCommitWithTag("1.0.0.1")
+			(*command).Script().AndCommitWithTag("1.1.0.0")
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetDirectory(utl.PointerToString((*command).Script().GetWorkingDirectory()))
+			configurationLayerMock.SetAnalyticsFile(utl.PointerToString("analytics.json"))
+			configurationLayerMock.SetScheme(ver.PointerToScheme(ver.FOUR_SEGMENT))
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			content, err := os.ReadFile(filepath.Join((*command).Script().GetWorkingDirectory(), "analytics.json"))
+			assert.NoError(t, err)
+			var report map[string]interface{}
+			assert.NoError(t, json.Unmarshal(content, &report))
+
+			versions := []string{}
+			bumps := []string{}
+			for _, release := range report["releases"].([]interface{}) {
+				versions = append(versions, release.(map[string]interface{})["version"].(string))
+				bumps = append(bumps, release.(map[string]interface{})["bump"].(string))
+			}
+			assert.Equal(t, []string{"0.0.1", "0.0.2", "0.0.3", "0.0.4", "1.0.0.0", "1.0.0.1", "1.1.0.0"}, versions)
+			assert.Equal(t, []string{"initial", "patch", "patch", "patch", "major", "revision", "minor"}, bumps)
+		})
+	}
+}
+
+func TestAnalyticsRunWithMarkdownFile(t *testing.T) {
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.ANALYTICS, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetDirectory(utl.PointerToString((*command).Script().GetWorkingDirectory()))
+			configurationLayerMock.SetAnalyticsFile(utl.PointerToString("analytics.md"))
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			content, err := os.ReadFile(filepath.Join((*command).Script().GetWorkingDirectory(), "analytics.md"))
+			assert.NoError(t, err)
+			assert.Contains(t, string(content), "# Release Analytics")
+			assert.Contains(t, string(content), "- Releases: 4\n")
+			assert.Contains(t, string(content), "| 0.0.4 |")
+		})
+	}
+}
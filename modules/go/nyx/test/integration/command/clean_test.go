@@ -389,3 +389,42 @@ func TestCleanRunDeleteChangelogFile(t *testing.T) {
 		})
 	}
 }
+
+func TestCleanRunDeleteTags(t *testing.T) {
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.CLEAN, gittools.INITIAL_COMMIT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// create a new empty repository to use as remote and push a tag to it
+			remoteScript := gittools.BARE().RealizeBare(true)
+			defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+			(*command).Script().AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+			(*command).Script().Tag("1.2.3", nil)
+			(*command).Script().PushTo("origin")
+
+			// simulate the tag applied and pushed by the Mark command
+			internals, err := (*command).State().GetInternals()
+			assert.NoError(t, err)
+			(*internals)[cmd.MARK_INTERNAL_OUTPUT_ATTRIBUTE_TAGS] = "1.2.3"
+			(*internals)[cmd.MARK_INTERNAL_OUTPUT_ATTRIBUTE_REMOTES] = "origin"
+
+			upToDate, err := (*command).IsUpToDate()
+			assert.NoError(t, err)
+			assert.False(t, upToDate)
+
+			// now running the clean must delete the tag locally and on the remote
+			_, err = (*command).Run()
+			assert.NoError(t, err)
+			_, ok := (*command).Script().GetTags()["1.2.3"]
+			assert.False(t, ok)
+			_, ok = remoteScript.GetTags()["1.2.3"]
+			assert.False(t, ok)
+			upToDate, err = (*command).IsUpToDate()
+			assert.NoError(t, err)
+			assert.True(t, upToDate)
+
+			// run again and test for idempotency
+			_, err = (*command).Run()
+			assert.NoError(t, err)
+		})
+	}
+}
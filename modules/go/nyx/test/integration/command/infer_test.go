@@ -22,20 +22,30 @@
 package command_test
 
 import (
-	"fmt"     // https://pkg.go.dev/fmt
-	"os"      // https://pkg.go.dev/os
-	"strings" // https://pkg.go.dev/strings
-	"testing" // https://pkg.go.dev/testing
-
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strconv"       // https://pkg.go.dev/strconv
+	"strings"       // https://pkg.go.dev/strings
+	"testing"       // https://pkg.go.dev/testing
+	"time"          // https://pkg.go.dev/time
+
+	ggit "github.com/go-git/go-git/v5"          // https://pkg.go.dev/github.com/go-git/go-git/v5
 	log "github.com/sirupsen/logrus"            // https://pkg.go.dev/github.com/sirupsen/logrus
 	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
 
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	clk "github.com/mooltiverse/nyx/modules/go/nyx/clock"
 	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
 	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	versionsfile "github.com/mooltiverse/nyx/modules/go/nyx/services/versionsfile"
 	cmdtpl "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/command/template"
 	gittools "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/git/tools"
+	gitutil "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/git/util"
 	utl "github.com/mooltiverse/nyx/modules/go/utils"
+	ver "github.com/mooltiverse/nyx/modules/go/version"
 )
 
 var (
@@ -1386,6 +1396,186 @@ func TestInferMatchReleaseTypeBasedOnEnvironmentVariables(t *testing.T) {
 	log.SetLevel(logLevel) // restore the original logging level
 }
 
+func TestInferMatchReleaseTypeBasedOnBranchMetadata(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.EXTENDED_PRESET_BRANCHES_SHORT_UNMERGED()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetBranchMetadataExpression(utl.PointerToString("^(?<stream>master|integration)$"))
+			// add some fictional release types
+			unmatchedReleaseType := ent.NewReleaseType()
+			unmatchedReleaseType.SetGitCommitMessage(utl.PointerToString("UNMATCHED"))     // use this value to see if the release type has been matched
+			unmatchedReleaseType.SetMatchBranchMetadata(&map[string]string{"scope": ".*"}) // require a metadata item that is never extracted
+			unmatchedReleaseType.SetMatchEnvironmentVariables(nil)
+			unmatchedReleaseType.SetMatchWorkspaceStatus(nil)
+			matchedReleaseType := ent.NewReleaseType()
+			matchedReleaseType.SetGitCommitMessage(utl.PointerToString("MATCHED")) // use this value to see if the release type has been matched
+			matchedReleaseType.SetMatchBranchMetadata(&map[string]string{"stream": "^integration$"})
+			matchedReleaseType.SetMatchEnvironmentVariables(nil)
+			matchedReleaseType.SetMatchWorkspaceStatus(nil)
+			fallbackReleaseType := ent.NewReleaseType()
+			fallbackReleaseType.SetGitCommitMessage(utl.PointerToString("FALLBACK")) // use this value to see if the release type has been matched
+			fallbackReleaseType.SetMatchEnvironmentVariables(nil)
+			fallbackReleaseType.SetMatchWorkspaceStatus(nil)
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("unmatched"), utl.PointerToString("matched"), utl.PointerToString("fallback")},
+				&[]*string{}, &[]*string{},
+				&map[string]*ent.ReleaseType{"unmatched": unmatchedReleaseType, "matched": matchedReleaseType, "fallback": fallbackReleaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			// the integration branch yields the metadata required by the matched release type
+			(*command).Script().Checkout("integration")
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+			releaseType, _ := (*command).State().GetReleaseType()
+			assert.Equal(t, "MATCHED", *releaseType.GetGitCommitMessage())
+			branchMetadata, _ := (*command).State().GetBranchMetadata()
+			assert.Equal(t, 1, len(*branchMetadata))
+			assert.Equal(t, "integration", (*branchMetadata)["stream"])
+
+			// the master branch yields a different value so the fallback release type must be matched
+			(*command).Script().Checkout("master")
+			_, err = (*command).Run()
+			assert.NoError(t, err)
+			releaseType, _ = (*command).State().GetReleaseType()
+			assert.Equal(t, "FALLBACK", *releaseType.GetGitCommitMessage())
+			branchMetadata, _ = (*command).State().GetBranchMetadata()
+			assert.Equal(t, "master", (*branchMetadata)["stream"])
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferMatchReleaseTypeBasedOnExpressionWithAnyMatchMode(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.EXTENDED_PRESET_BRANCHES_SHORT_UNMERGED()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// add some fictional release types
+			unmatchedReleaseType := ent.NewReleaseType()
+			unmatchedReleaseType.SetGitCommitMessage(utl.PointerToString("UNMATCHED"))        // use this value to see if the release type has been matched
+			unmatchedReleaseType.SetMatchBranches(utl.PointerToString("^nonexistendbranch$")) // match only a branch name that doesn't exist
+			unmatchedReleaseType.SetMatchEnvironmentVariables(nil)
+			unmatchedReleaseType.SetMatchExpression(utl.PointerToString("false"))
+			unmatchedReleaseType.SetMatchMode(ent.PointerToMatchMode(ent.ANY))
+			unmatchedReleaseType.SetMatchWorkspaceStatus(nil)
+			matchedReleaseType := ent.NewReleaseType()
+			matchedReleaseType.SetGitCommitMessage(utl.PointerToString("MATCHED"))          // use this value to see if the release type has been matched
+			matchedReleaseType.SetMatchBranches(utl.PointerToString("^nonexistendbranch$")) // match only a branch name that doesn't exist
+			matchedReleaseType.SetMatchEnvironmentVariables(nil)
+			matchedReleaseType.SetMatchExpression(utl.PointerToString("{{#if timestamp}}true{{/if}}")) // the timestamp is always available
+			matchedReleaseType.SetMatchMode(ent.PointerToMatchMode(ent.ANY))
+			matchedReleaseType.SetMatchWorkspaceStatus(nil)
+			fallbackReleaseType := ent.NewReleaseType()
+			fallbackReleaseType.SetGitCommitMessage(utl.PointerToString("FALLBACK")) // use this value to see if the release type has been matched
+			fallbackReleaseType.SetMatchEnvironmentVariables(nil)
+			fallbackReleaseType.SetMatchWorkspaceStatus(nil)
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("unmatched"), utl.PointerToString("matched"), utl.PointerToString("fallback")},
+				&[]*string{}, &[]*string{},
+				&map[string]*ent.ReleaseType{"unmatched": unmatchedReleaseType, "matched": matchedReleaseType, "fallback": fallbackReleaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+			releaseType, _ := (*command).State().GetReleaseType()
+			assert.Equal(t, "MATCHED", *releaseType.GetGitCommitMessage())
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferMatchReleaseTypeBasedOnDaysOfWeek(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.EXTENDED_PRESET_BRANCHES_SHORT_UNMERGED()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			today := time.Now().UTC().Weekday()
+			// add some fictional release types
+			unmatchedReleaseType := ent.NewReleaseType()
+			unmatchedReleaseType.SetGitCommitMessage(utl.PointerToString("UNMATCHED"))               // use this value to see if the release type has been matched
+			unmatchedReleaseType.SetMatchDaysOfWeek(utl.PointerToString(((today + 1) % 7).String())) // only match tomorrow
+			unmatchedReleaseType.SetMatchEnvironmentVariables(nil)
+			unmatchedReleaseType.SetMatchWorkspaceStatus(nil)
+			matchedReleaseType := ent.NewReleaseType()
+			matchedReleaseType.SetGitCommitMessage(utl.PointerToString("MATCHED")) // use this value to see if the release type has been matched
+			matchedReleaseType.SetMatchDaysOfWeek(utl.PointerToString(((today + 1) % 7).String() + "," + strings.ToUpper(today.String())))
+			matchedReleaseType.SetMatchEnvironmentVariables(nil)
+			matchedReleaseType.SetMatchWorkspaceStatus(nil)
+			fallbackReleaseType := ent.NewReleaseType()
+			fallbackReleaseType.SetGitCommitMessage(utl.PointerToString("FALLBACK")) // use this value to see if the release type has been matched
+			fallbackReleaseType.SetMatchEnvironmentVariables(nil)
+			fallbackReleaseType.SetMatchWorkspaceStatus(nil)
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("unmatched"), utl.PointerToString("matched"), utl.PointerToString("fallback")},
+				&[]*string{}, &[]*string{},
+				&map[string]*ent.ReleaseType{"unmatched": unmatchedReleaseType, "matched": matchedReleaseType, "fallback": fallbackReleaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+			releaseType, _ := (*command).State().GetReleaseType()
+			assert.Equal(t, "MATCHED", *releaseType.GetGitCommitMessage())
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferMatchReleaseTypeBasedOnChangedPathsAndTags(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.EXTENDED_PRESET_BRANCHES_SHORT_UNMERGED()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// add a commit changing some files and tag it so both the changed paths and the tag can be matched
+			(*command).Script().AndAddFiles().AndStage().AndCommit().AndTag("deploy-production", nil)
+			// add some fictional release types
+			unmatchedReleaseType := ent.NewReleaseType()
+			unmatchedReleaseType.SetGitCommitMessage(utl.PointerToString("UNMATCHED")) // use this value to see if the release type has been matched
+			unmatchedReleaseType.SetMatchChangedPaths(utl.PointerToString("^nonexistentpath/.*$"))
+			unmatchedReleaseType.SetMatchEnvironmentVariables(nil)
+			unmatchedReleaseType.SetMatchTags(utl.PointerToString("^deploy-.*$"))
+			unmatchedReleaseType.SetMatchWorkspaceStatus(nil)
+			matchedReleaseType := ent.NewReleaseType()
+			matchedReleaseType.SetGitCommitMessage(utl.PointerToString("MATCHED")) // use this value to see if the release type has been matched
+			matchedReleaseType.SetMatchChangedPaths(utl.PointerToString(".*"))
+			matchedReleaseType.SetMatchEnvironmentVariables(nil)
+			matchedReleaseType.SetMatchTags(utl.PointerToString("^deploy-.*$"))
+			matchedReleaseType.SetMatchWorkspaceStatus(nil)
+			fallbackReleaseType := ent.NewReleaseType()
+			fallbackReleaseType.SetGitCommitMessage(utl.PointerToString("FALLBACK")) // use this value to see if the release type has been matched
+			fallbackReleaseType.SetMatchEnvironmentVariables(nil)
+			fallbackReleaseType.SetMatchWorkspaceStatus(nil)
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("unmatched"), utl.PointerToString("matched"), utl.PointerToString("fallback")},
+				&[]*string{}, &[]*string{},
+				&map[string]*ent.ReleaseType{"unmatched": unmatchedReleaseType, "matched": matchedReleaseType, "fallback": fallbackReleaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+			releaseType, _ := (*command).State().GetReleaseType()
+			assert.Equal(t, "MATCHED", *releaseType.GetGitCommitMessage())
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
 func TestInferMatchCleanReleaseTypeBasedOnWorkspaceStatus(t *testing.T) {
 	logLevel := log.GetLevel()   // save the previous logging level
 	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
@@ -1550,6 +1740,712 @@ func TestInferMatchReleaseTypeBasedOnBranchNameAndEnvironmentVariablesAndWorkspa
 	log.SetLevel(logLevel) // restore the original logging level
 }
 
+func TestInferIgnoreCherryPicks(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, ignoreCherryPicks := range []string{"false", "true"} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+			t.Run((*command).GetContextName()+" ignoreCherryPicks="+ignoreCherryPicks, func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				directory := (*command).Script().GetWorkingDirectory()
+				// release a fix on a maintenance branch and cherry-pick it to the master branch
+				(*command).Script().InBranch("maintenance")
+				assert.NoError(t, os.WriteFile(filepath.Join(directory, "fix.txt"), []byte("a fix\n"), 0644))
+				(*command).Script().AndStage().AndCommitWith(utl.PointerToString("fix: a fix")).AndTag("0.1.1", nil)
+				(*command).Script().InBranch("master")
+				assert.NoError(t, os.WriteFile(filepath.Join(directory, "fix.txt"), []byte("a fix\n"), 0644))
+				(*command).Script().AndStage().AndCommitWith(utl.PointerToString("fix: a fix (cherry picked)"))
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				// add a mock convention that accepts all non nil messages and dumps the patch identifier for each
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				releaseType := ent.NewReleaseType()
+				releaseType.SetIgnoreCherryPicks(utl.PointerToString(ignoreCherryPicks))
+				releaseType.SetMatchEnvironmentVariables(nil)
+				releaseType.SetMatchWorkspaceStatus(nil)
+				releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("default")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"default": releaseType})
+				configurationLayerMock.SetReleaseTypes(releaseTypes)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				newVersion, _ := (*command).State().GetNewVersion()
+				version, _ := (*command).State().GetVersion()
+				if ignoreCherryPicks == "true" {
+					// the cherry-picked fix has already been released as 0.1.1 so it doesn't bump the version again
+					assert.False(t, newVersion)
+					assert.Equal(t, "0.1.0", *version)
+				} else {
+					assert.True(t, newVersion)
+					assert.Equal(t, "0.1.1", *version)
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferFollowAllParents(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, followAllParents := range []string{"false", "true"} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+			t.Run((*command).GetContextName()+" followAllParents="+followAllParents, func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				directory := (*command).Script().GetWorkingDirectory()
+				// add a feature on a separate branch and merge it into the master branch after another commit
+				(*command).Script().InBranch("feature")
+				assert.NoError(t, os.WriteFile(filepath.Join(directory, "feature.txt"), []byte("a feature\n"), 0644))
+				(*command).Script().AndStage().AndCommitWith(utl.PointerToString("feat: a feature"))
+				(*command).Script().InBranch("master")
+				assert.NoError(t, os.WriteFile(filepath.Join(directory, "chore.txt"), []byte("a chore\n"), 0644))
+				(*command).Script().AndStage().AndCommitWith(utl.PointerToString("chore: a chore"))
+				(*command).Script().AndMergeFromWithMessage("feature", utl.PointerToString("Merge branch 'feature'"))
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				// add a mock convention that only bumps the minor identifier for features
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"minor": "^feat: .*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				releaseType := ent.NewReleaseType()
+				releaseType.SetFollowAllParents(utl.PointerToString(followAllParents))
+				releaseType.SetMatchEnvironmentVariables(nil)
+				releaseType.SetMatchWorkspaceStatus(nil)
+				releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("default")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"default": releaseType})
+				configurationLayerMock.SetReleaseTypes(releaseTypes)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				newVersion, _ := (*command).State().GetNewVersion()
+				version, _ := (*command).State().GetVersion()
+				releaseScope, _ := (*command).State().GetReleaseScope()
+				if followAllParents == "true" {
+					// the feature merged from the other branch bumps the version
+					assert.True(t, newVersion)
+					assert.Equal(t, "0.2.0", *version)
+					assert.Equal(t, 3, len(releaseScope.GetCommits()))
+				} else {
+					// only the merge commit and the chore are in the first parent history
+					assert.False(t, newVersion)
+					assert.Equal(t, "0.1.0", *version)
+					assert.Equal(t, 2, len(releaseScope.GetCommits()))
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferReleaseScopeCommitChanges(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, commitChanges := range []bool{false, true} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+			t.Run((*command).GetContextName(), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				configurationLayerMock.SetCommitChanges(utl.PointerToBoolean(commitChanges))
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				directory := (*command).Script().GetWorkingDirectory()
+				assert.NoError(t, os.MkdirAll(filepath.Join(directory, "docs"), 0755))
+				assert.NoError(t, os.WriteFile(filepath.Join(directory, "docs", "guide.md"), []byte("one\ntwo\n"), 0644))
+				(*command).Script().AndStage().AndCommitWith(utl.PointerToString("docs: a guide"))
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				releaseScope, _ := (*command).State().GetReleaseScope()
+				assert.Equal(t, 1, len(releaseScope.GetCommits()))
+				if commitChanges {
+					// the files changed by the commits in the release scope are available
+					assert.Equal(t, []gitent.FileChange{*gitent.NewFileChangeWith("docs/guide.md", 2, 0)}, releaseScope.GetCommits()[0].GetChanges())
+				} else {
+					// changes are not computed unless enabled
+					assert.Nil(t, releaseScope.GetCommits()[0].GetChanges())
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferIgnoreMerges(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, ignoreMerges := range []string{"false", "true"} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+			t.Run((*command).GetContextName()+" ignoreMerges="+ignoreMerges, func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				directory := (*command).Script().GetWorkingDirectory()
+				// merge a branch with a merge commit message that would bump the minor identifier
+				(*command).Script().InBranch("feature")
+				assert.NoError(t, os.WriteFile(filepath.Join(directory, "feature.txt"), []byte("a feature\n"), 0644))
+				(*command).Script().AndStage().AndCommitWith(utl.PointerToString("chore: a side chore"))
+				(*command).Script().InBranch("master")
+				assert.NoError(t, os.WriteFile(filepath.Join(directory, "chore.txt"), []byte("a chore\n"), 0644))
+				(*command).Script().AndStage().AndCommitWith(utl.PointerToString("chore: a chore"))
+				(*command).Script().AndMergeFromWithMessage("feature", utl.PointerToString("feat: merge the feature"))
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				// add a mock convention that only bumps the minor identifier for features
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"minor": "^feat: .*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				releaseType := ent.NewReleaseType()
+				releaseType.SetIgnoreMerges(utl.PointerToString(ignoreMerges))
+				releaseType.SetMatchEnvironmentVariables(nil)
+				releaseType.SetMatchWorkspaceStatus(nil)
+				releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("default")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"default": releaseType})
+				configurationLayerMock.SetReleaseTypes(releaseTypes)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				newVersion, _ := (*command).State().GetNewVersion()
+				version, _ := (*command).State().GetVersion()
+				releaseScope, _ := (*command).State().GetReleaseScope()
+				if ignoreMerges == "true" {
+					// only the chore is left in the release scope
+					assert.False(t, newVersion)
+					assert.Equal(t, "0.1.0", *version)
+					assert.Equal(t, 1, len(releaseScope.GetCommits()))
+				} else {
+					// the merge commit bumps the version
+					assert.True(t, newVersion)
+					assert.Equal(t, "0.2.0", *version)
+					assert.Equal(t, 2, len(releaseScope.GetCommits()))
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferRequireSignedCommits(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	signKey, publicKey := gitutil.NewPGPKey("John Doe", "jdoe@example.com")
+	for _, signed := range []bool{false, true} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+			t.Run((*command).GetContextName()+" signed="+fmt.Sprintf("%t", signed), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				(*command).Script().AndSignedCommitWith(utl.PointerToString("fix: a signed fix"), signKey)
+				if !signed {
+					(*command).Script().AndCommitWith(utl.PointerToString("fix: an unsigned fix"))
+				}
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				gitConfiguration := ent.NewGitConfiguration()
+				gitConfiguration.SetTrustedKeys(&publicKey)
+				configurationLayerMock.SetGit(gitConfiguration)
+				releaseType := ent.NewReleaseType()
+				releaseType.SetRequireSignedCommits(utl.PointerToString("true"))
+				releaseType.SetMatchEnvironmentVariables(nil)
+				releaseType.SetMatchWorkspaceStatus(nil)
+				releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("default")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"default": releaseType})
+				configurationLayerMock.SetReleaseTypes(releaseTypes)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				if signed {
+					assert.NoError(t, err)
+					version, _ := (*command).State().GetVersion()
+					assert.Equal(t, "0.1.1", *version)
+					releaseScope, _ := (*command).State().GetReleaseScope()
+					assert.Equal(t, 1, len(releaseScope.GetCommits()))
+					assert.True(t, releaseScope.GetCommits()[0].GetSignature().IsValid())
+				} else {
+					assert.Error(t, err)
+					_, ok := err.(*errs.ReleaseError)
+					assert.True(t, ok)
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferRequiredEnvironmentVariables(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, set := range []bool{false, true} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+			t.Run((*command).GetContextName()+" set="+fmt.Sprintf("%t", set), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				if set {
+					t.Setenv("NYX_TEST_REQUIRED_VARIABLE_ONE", "one")
+					t.Setenv("NYX_TEST_REQUIRED_VARIABLE_TWO", "two")
+				} else {
+					// variables set to the empty string are considered missing
+					t.Setenv("NYX_TEST_REQUIRED_VARIABLE_ONE", "")
+				}
+				(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix"))
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				releaseType := ent.NewReleaseType()
+				releaseType.SetRequiredEnvironmentVariables(&[]*string{utl.PointerToString("NYX_TEST_REQUIRED_VARIABLE_ONE"), utl.PointerToString("NYX_TEST_REQUIRED_VARIABLE_TWO")})
+				releaseType.SetMatchEnvironmentVariables(nil)
+				releaseType.SetMatchWorkspaceStatus(nil)
+				releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("default")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"default": releaseType})
+				configurationLayerMock.SetReleaseTypes(releaseTypes)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				if set {
+					assert.NoError(t, err)
+					version, _ := (*command).State().GetVersion()
+					assert.Equal(t, "0.1.1", *version)
+				} else {
+					assert.Error(t, err)
+					_, ok := err.(*errs.ReleaseError)
+					assert.True(t, ok)
+					// all the missing variables are reported at once
+					assert.Contains(t, err.Error(), "NYX_TEST_REQUIRED_VARIABLE_ONE, NYX_TEST_REQUIRED_VARIABLE_TWO")
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferWithVersionStorageService(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// the latest commit has been released as 0.0.7 but it's not tagged, the version is only in the versions file
+			versionsFile := filepath.Join(t.TempDir(), "versions.json")
+			err := os.WriteFile(versionsFile, []byte(fmt.Sprintf(`{"versions":[{"version":"0.0.7","commit":"%s"}]}`, (*command).Script().GetLastCommitID())), 0644)
+			assert.NoError(t, err)
+			(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix"))
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			configurationLayerMock.SetServices(&map[string]*ent.ServiceConfiguration{
+				"versions": ent.NewServiceConfigurationWith(ent.PointerToProvider(ent.VERSIONS_FILE), &map[string]string{versionsfile.LOCATION_OPTION_NAME: versionsFile}),
+			})
+			configurationLayerMock.SetVersionStorageService(utl.PointerToString("versions"))
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err = (*command).Run()
+			assert.NoError(t, err)
+			releaseScope, _ := (*command).State().GetReleaseScope()
+			assert.Equal(t, "0.0.7", *releaseScope.GetPreviousVersion())
+			version, _ := (*command).State().GetVersion()
+			assert.Equal(t, "0.0.8", *version)
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferYankedVersions(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	// maps the yanked versions to the expected previous version and version
+	expectations := map[string][]string{
+		"":            {"0.1.1", "0.1.2"},
+		"0.1.1":       {"0.1.0", "0.1.2"},
+		"0.1.1,0.1.2": {"0.1.0", "0.1.3"},
+	}
+	for yanked, expected := range expectations {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+			t.Run((*command).GetContextName()+" yanked="+yanked, func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix")).AndTag("0.1.1", nil)
+				(*command).Script().AndCommitWith(utl.PointerToString("fix: another fix"))
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				releaseType := ent.NewReleaseType()
+				releaseType.SetMatchEnvironmentVariables(nil)
+				releaseType.SetMatchWorkspaceStatus(nil)
+				yankedVersions := []*string{}
+				if yanked != "" {
+					for _, yankedVersion := range strings.Split(yanked, ",") {
+						yankedVersions = append(yankedVersions, utl.PointerToString(yankedVersion))
+					}
+				}
+				releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("default")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"default": releaseType})
+				releaseTypes.SetYanked(&yankedVersions)
+				configurationLayerMock.SetReleaseTypes(releaseTypes)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				releaseScope, _ := (*command).State().GetReleaseScope()
+				version, _ := (*command).State().GetVersion()
+				// yanked tags are ignored when inferring the previous version and yanked versions are never released again
+				assert.Equal(t, expected[0], *releaseScope.GetPreviousVersion())
+				assert.Equal(t, expected[1], *version)
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferFetchTags(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, fetchTags := range []bool{false, true} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+			t.Run((*command).GetContextName()+" fetchTags="+strconv.FormatBool(fetchTags), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				remoteScript := gittools.BARE().RealizeBare(true)
+				defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+				(*command).Script().AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+				(*command).Script().PushTo("origin")
+				// tag the latest commit in another clone so that the tag is only available in the remote
+				cloneScript := gittools.CloneFromWithUserNameAndPassword(remoteScript.GetWorkingDirectory(), nil, nil)
+				defer os.RemoveAll(cloneScript.GetWorkingDirectory())
+				cloneScript.Tag("0.1.5", nil)
+				cloneScript.Push()
+				(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix"))
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				gitConfiguration, _ := configurationLayerMock.GetGit()
+				gitConfiguration.SetFetchTags(utl.PointerToBoolean(fetchTags))
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				releaseScope, _ := (*command).State().GetReleaseScope()
+				version, _ := (*command).State().GetVersion()
+				if fetchTags {
+					// the tag only available in the remote has been fetched and used as the previous version
+					assert.Equal(t, "0.1.5", *releaseScope.GetPreviousVersion())
+					assert.Equal(t, "0.1.6", *version)
+				} else {
+					assert.Equal(t, "0.1.0", *releaseScope.GetPreviousVersion())
+					assert.Equal(t, "0.1.1", *version)
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferTimestampSource(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, timestampSource := range []ent.TimestampSource{ent.SYSTEM, ent.COMMIT} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+			t.Run((*command).GetContextName()+" timestampSource="+timestampSource.String(), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				configurationLayerMock.SetTimestampSource(ent.PointerToTimestampSource(timestampSource))
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+				initialTimestamp, _ := (*command).State().GetTimestamp()
+				initialTimestampValue := *initialTimestamp
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				timestamp, _ := (*command).State().GetTimestamp()
+				if ent.COMMIT == timestampSource {
+					assert.Equal(t, (*command).Script().GetLastCommit().Committer.When.UnixMilli(), *timestamp)
+				} else {
+					assert.Equal(t, initialTimestampValue, *timestamp)
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferTimestampWithFixedClock(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	instant := time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC)
+	clk.SetClock(clk.NewFixedClock(instant))
+	defer clk.SetClock(nil)
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+			timestamp, _ := (*command).State().GetTimestamp()
+			assert.Equal(t, instant.UnixMilli(), *timestamp)
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferNothingToReleasePolicy(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, nothingToReleasePolicy := range []ent.NothingToReleasePolicy{ent.PREVIOUS_VERSION, ent.SKIP, ent.FAIL} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+			t.Run((*command).GetContextName()+" nothingToRelease="+nothingToReleasePolicy.String(), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				configurationLayerMock.SetNothingToRelease(ent.PointerToNothingToReleasePolicy(nothingToReleasePolicy))
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				if ent.FAIL == nothingToReleasePolicy {
+					assert.Error(t, err)
+					return
+				}
+				assert.NoError(t, err)
+				nothingToRelease, _ := (*command).State().GetNothingToRelease()
+				assert.True(t, *nothingToRelease)
+				newVersion, _ := (*command).State().GetNewVersion()
+				assert.False(t, newVersion)
+				releaseScope, _ := (*command).State().GetReleaseScope()
+				version, _ := (*command).State().GetVersion()
+				if ent.SKIP == nothingToReleasePolicy {
+					assert.Nil(t, version)
+				} else {
+					assert.Equal(t, *releaseScope.GetPreviousVersion(), *version)
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferUnshallow(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out errors produced during tests
+	for _, unshallow := range []bool{false, true} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+			t.Run((*command).GetContextName()+" unshallow="+strconv.FormatBool(unshallow), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				remoteScript := gittools.BARE().RealizeBare(true)
+				defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+				(*command).Script().AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+				(*command).Script().PushTo("origin")
+				// replace the repository with a shallow clone of the remote only having the two latest (untagged) commits, and no tags
+				workingDirectory := (*command).Script().GetWorkingDirectory()
+				entries, err := os.ReadDir(workingDirectory)
+				assert.NoError(t, err)
+				for _, entry := range entries {
+					assert.NoError(t, os.RemoveAll(filepath.Join(workingDirectory, entry.Name())))
+				}
+				_, err = ggit.PlainClone(workingDirectory, false, &ggit.CloneOptions{URL: remoteScript.GetWorkingDirectory(), Depth: 2, Tags: ggit.NoTags})
+				assert.NoError(t, err)
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				gitConfiguration, _ := configurationLayerMock.GetGit()
+				gitConfiguration.SetUnshallow(utl.PointerToBoolean(unshallow))
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err = (*command).Run()
+				if unshallow {
+					// the missing history has been fetched and the previous version found beyond the shallow boundary
+					assert.NoError(t, err)
+					releaseScope, _ := (*command).State().GetReleaseScope()
+					version, _ := (*command).State().GetVersion()
+					assert.Equal(t, "0.0.4", *releaseScope.GetPreviousVersion())
+					assert.Equal(t, 2, len(releaseScope.GetCommits()))
+					assert.Equal(t, "0.0.5", *version)
+				} else {
+					assert.Error(t, err)
+					_, ok := err.(*errs.ShallowRepositoryError)
+					assert.True(t, ok)
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferImpactAnalyzers(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	// maps the analyzer commands to the analyzer expression, the expected impact and the expected version
+	expectations := map[string][]string{
+		"echo 'Incompatible: removed func F'":   {"^Incompatible", "true", "1.0.0"},
+		"echo 'Compatible: added func G'":       {"^Incompatible", "false", "0.1.1"},
+		"echo 'anything'":                       {"", "true", "1.0.0"},
+		"true":                                  {"", "false", "0.1.1"},
+		"exit 1":                                {"", "true", "1.0.0"},
+		"echo {{releaseScope.previousVersion}}": {"^0\\.1\\.0$", "true", "1.0.0"},
+	}
+	for analyzerCommand, expected := range expectations {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+			t.Run((*command).GetContextName()+" command="+analyzerCommand, func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix"))
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				var expression *string
+				if expected[0] != "" {
+					expression = utl.PointerToString(expected[0])
+				}
+				impactAnalyzers, _ := ent.NewImpactAnalyzersWith(&[]*string{utl.PointerToString("api")}, &map[string]*ent.ImpactAnalyzer{"api": ent.NewImpactAnalyzerWith(nil, utl.PointerToString(analyzerCommand), expression, utl.PointerToString("API changes"))})
+				configurationLayerMock.SetImpactAnalyzers(impactAnalyzers)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				version, _ := (*command).State().GetVersion()
+				assert.Equal(t, expected[2], *version)
+				// the standalone context doesn't restore the state so the report can only be inspected in the other contexts
+				if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+					releaseScope, _ := (*command).State().GetReleaseScope()
+					assert.Equal(t, 1, len(releaseScope.GetImpactReports()))
+					assert.Equal(t, "api", *releaseScope.GetImpactReports()[0].GetName())
+					assert.Equal(t, "API changes", *releaseScope.GetImpactReports()[0].GetTitle())
+					assert.Equal(t, expected[1] == "true", releaseScope.GetImpactReports()[0].GetImpact())
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferImpactAnalyzersWithMissingCommandThrowsError(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix"))
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// a command that can't be found by the shell is an error rather than an impact
+			impactAnalyzers, _ := ent.NewImpactAnalyzersWith(&[]*string{utl.PointerToString("api")}, &map[string]*ent.ImpactAnalyzer{"api": ent.NewImpactAnalyzerWith(nil, utl.PointerToString("nyx-missing-impact-analyzer"), nil, nil)})
+			configurationLayerMock.SetImpactAnalyzers(impactAnalyzers)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.Error(t, err)
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferPathRules(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	// maps the comma separated paths changed by the commit to the commit message and the expected version
+	expectations := map[string][]string{
+		"docs/guide.md":            {"fix: a fix", "0.1.0"},
+		"docs/guide.md,README.md":  {"fix: a fix", "0.1.0"},
+		"docs/guide.md,src/main.c": {"fix: a fix", "0.1.1"},
+		"src/main.c":               {"fix: a fix", "0.1.1"},
+		"src/main.c,README.md":     {"chore: a chore", "0.1.0"},
+		"api/service.proto":        {"chore: a chore", "0.2.0"},
+		"api/service.proto,docs/a": {"fix: a fix", "0.2.0"},
+	}
+	for changedPaths, expected := range expectations {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+			t.Run((*command).GetContextName()+" paths="+changedPaths, func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				for _, changedPath := range strings.Split(changedPaths, ",") {
+					path := filepath.Join((*command).Script().GetWorkingDirectory(), changedPath)
+					os.MkdirAll(filepath.Dir(path), os.ModePerm)
+					os.WriteFile(path, []byte(changedPath), 0644)
+				}
+				(*command).Script().AndStage().AndCommitWith(utl.PointerToString(expected[0]))
+
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": "^fix"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				pathRules, _ := ent.NewPathRulesWith(&[]*string{utl.PointerToString("api"), utl.PointerToString("docs")}, &map[string]*ent.PathRule{
+					"api":  ent.NewPathRuleWith(utl.PointerToString("minor"), &[]*string{utl.PointerToString("api/**")}, nil),
+					"docs": ent.NewPathRuleWith(nil, &[]*string{utl.PointerToString("docs/**"), utl.PointerToString("*.md")}, utl.PointerToBoolean(false))})
+				configurationLayerMock.SetPathRules(pathRules)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				version, _ := (*command).State().GetVersion()
+				assert.Equal(t, expected[1], *version)
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferPathRulesWithMissingPathsThrowsError(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix"))
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			pathRules, _ := ent.NewPathRulesWith(&[]*string{utl.PointerToString("api")}, &map[string]*ent.PathRule{"api": ent.NewPathRuleWith(utl.PointerToString("minor"), nil, nil)})
+			configurationLayerMock.SetPathRules(pathRules)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.Error(t, err)
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
 func TestInferExtraNonIntegerPrereleaseIdentifierThrowsError(t *testing.T) {
 	logLevel := log.GetLevel()   // save the previous logging level
 	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
@@ -1897,6 +2793,366 @@ func TestInferVersionRangeCheckWithStaticMalformedExpression(t *testing.T) {
 	log.SetLevel(logLevel) // restore the original logging level
 }
 
+func TestInferWithFourSegmentScheme(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetScheme(ver.PointerToScheme(ver.FOUR_SEGMENT))
+			// map fixes to the fourth segment
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString("^(?<type>[a-zA-Z0-9_]+)(!)?(\\((?<scope>[a-z ]+)\\))?:( (?<title>.+))$(?s).*"),
+					&map[string]string{"minor": "^feat(!{0})(\\([a-z ]+\\))?:( (?<title>.+))$(?s).*", "revision": "^fix(!{0})(\\([a-z ]+\\))?:( (?<title>.+))$(?s).*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			// the existing '0.1.0' tag is read as '0.1.0.0'
+			(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix"))
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+			version, _ := (*command).State().GetVersion()
+			assert.Equal(t, "0.1.0.1", *version)
+			bump, _ := (*command).State().GetBump()
+			assert.Equal(t, "revision", *bump)
+
+			(*command).Script().AndCommitWithTag("1.2.3.4")
+			(*command).Script().AndCommitWith(utl.PointerToString("fix: another fix"))
+			_, err = (*command).Run()
+			assert.NoError(t, err)
+			version, _ = (*command).State().GetVersion()
+			assert.Equal(t, "1.2.3.5", *version)
+
+			(*command).Script().AndCommitWith(utl.PointerToString("feat: a feature"))
+			_, err = (*command).Run()
+			assert.NoError(t, err)
+			version, _ = (*command).State().GetVersion()
+			assert.Equal(t, "1.3.0.0", *version)
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferWithFourSegmentSchemeAndRevisionBump(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.INITIAL_VERSION()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetScheme(ver.PointerToScheme(ver.FOUR_SEGMENT))
+			// fixes have the patch significance, which is moved to the fourth segment
+			configurationLayerMock.SetRevisionBump(utl.PointerToString("patch"))
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString("^(?<type>[a-zA-Z0-9_]+)(!)?(\\((?<scope>[a-z ]+)\\))?:( (?<title>.+))$(?s).*"),
+					&map[string]string{"minor": "^feat(!{0})(\\([a-z ]+\\))?:( (?<title>.+))$(?s).*", "patch": "^fix(!{0})(\\([a-z ]+\\))?:( (?<title>.+))$(?s).*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix"))
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+			version, _ := (*command).State().GetVersion()
+			assert.Equal(t, "0.1.0.1", *version)
+			bump, _ := (*command).State().GetBump()
+			assert.Equal(t, "revision", *bump)
+
+			// other significances are not affected
+			(*command).Script().AndCommitWith(utl.PointerToString("feat: a feature"))
+			_, err = (*command).Run()
+			assert.NoError(t, err)
+			version, _ = (*command).State().GetVersion()
+			assert.Equal(t, "0.2.0.0", *version)
+
+			// only major, minor and patch can be moved to the fourth segment
+			configurationLayerMock.SetRevisionBump(utl.PointerToString("revision"))
+			(*command).Script().AndCommitWith(utl.PointerToString("fix: another fix"))
+			_, err = (*command).Run()
+			assert.Error(t, err)
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferWithPrereleaseOrder(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, prereleaseOrder := range []*string{nil, utl.PointerToString("dev,alpha,beta,rc")} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+			t.Run((*command).GetContextName(), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				configurationLayerMock.SetPrereleaseOrder(prereleaseOrder)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				// two coexisting pre-release tags on the same commit
+				(*command).Script().AndCommitWithTag("1.0.0-dev.5").AndTag("1.0.0-alpha.1", nil)
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				releaseScope, _ := (*command).State().GetReleaseScope()
+				version, _ := (*command).State().GetVersion()
+				if prereleaseOrder == nil {
+					// 'dev' comes after 'alpha' in ASCII sort order
+					assert.Equal(t, "1.0.0-dev.5", *releaseScope.GetPreviousVersion())
+					assert.Equal(t, "1.0.0-dev.5", *version)
+				} else {
+					// 'dev' comes before 'alpha' in the custom order
+					assert.Equal(t, "1.0.0-alpha.1", *releaseScope.GetPreviousVersion())
+					assert.Equal(t, "1.0.0-alpha.1", *version)
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferWithReleaseCoercion(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, releaseCoercion := range []bool{false, true} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+			t.Run((*command).GetContextName(), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				configurationLayerMock.SetReleaseCoercion(utl.PointerToBoolean(releaseCoercion))
+				// add a mock convention that accepts all non nil messages and dumps the patch identifier for each
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				// tags that are not semantic versions but look like them
+				(*command).Script().AndCommitWithTag("v1.2")
+				(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix"))
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				releaseScope, _ := (*command).State().GetReleaseScope()
+				version, _ := (*command).State().GetVersion()
+				if releaseCoercion {
+					assert.Equal(t, "1.2.0", *releaseScope.GetPreviousVersion())
+					assert.Equal(t, "1.2.1", *version)
+				} else {
+					// the tag is ignored
+					assert.Equal(t, "0.0.4", *releaseScope.GetPreviousVersion())
+					assert.Equal(t, "0.0.5", *version)
+				}
+
+				(*command).Script().AndCommitWithTag("release-1.2.3.4")
+				(*command).Script().AndCommitWith(utl.PointerToString("fix: another fix"))
+				_, err = (*command).Run()
+				assert.NoError(t, err)
+				releaseScope, _ = (*command).State().GetReleaseScope()
+				version, _ = (*command).State().GetVersion()
+				if releaseCoercion {
+					assert.Equal(t, "1.2.3", *releaseScope.GetPreviousVersion())
+					assert.Equal(t, "1.2.4", *version)
+				} else {
+					// the lenient parsing takes the last three numbers
+					assert.Equal(t, "release-1.2.3.4", *releaseScope.GetPreviousVersion())
+					assert.Equal(t, "2.3.5", *version)
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferWithEpoch(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, releaseLenient := range []bool{true, false} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+			t.Run((*command).GetContextName(), func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				configurationLayerMock.SetReleaseLenient(utl.PointerToBoolean(releaseLenient))
+				configurationLayerMock.SetReleasePrefix(utl.PointerToString("v"))
+				// add a mock convention that accepts all non nil messages and dumps the patch identifier for each
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				// the versioning is re-baselined using a new epoch
+				(*command).Script().AndCommitWithTag("v5.3.0")
+				(*command).Script().AndCommitWithTag("v1!1.0.0")
+				(*command).Script().AndCommitWith(utl.PointerToString("fix: a fix"))
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+				releaseScope, _ := (*command).State().GetReleaseScope()
+				version, _ := (*command).State().GetVersion()
+				assert.Equal(t, "v1!1.0.0", *releaseScope.GetPreviousVersion())
+				assert.Equal(t, "v1!1.0.1", *version)
+				coreVersion, _ := (*command).State().GetCoreVersion()
+				assert.True(t, coreVersion)
+
+				// when the same commit has multiple tags the one with the higher epoch is selected
+				(*command).Script().AndCommitWithTag("v9.9.9").AndTag("v1!1.1.0", nil)
+				(*command).Script().AndCommitWith(utl.PointerToString("fix: another fix"))
+				_, err = (*command).Run()
+				assert.NoError(t, err)
+				releaseScope, _ = (*command).State().GetReleaseScope()
+				version, _ = (*command).State().GetVersion()
+				assert.Equal(t, "v1!1.1.0", *releaseScope.GetPreviousVersion())
+				assert.Equal(t, "v1!1.1.1", *version)
+
+				// a '!' in the body of the version is not an epoch so the tag is not a legal version
+				(*command).Script().AndCommitWithTag("v1.0.0-rc1!2.0.0")
+				(*command).Script().AndCommitWith(utl.PointerToString("fix: yet another fix"))
+				_, err = (*command).Run()
+				assert.NoError(t, err)
+				releaseScope, _ = (*command).State().GetReleaseScope()
+				version, _ = (*command).State().GetVersion()
+				assert.Equal(t, "v1!1.1.1", *version)
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferVersionConstraintCheck(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, versionConstraint := range []string{"~0.0", ">=1.2 <2.0", "~~1"} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+			t.Run((*command).GetContextName()+" constraint="+versionConstraint, func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				// add some fictional release types
+				releaseType := ent.NewReleaseType()
+				releaseType.SetVersionConstraint(utl.PointerToString(versionConstraint))
+				releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("matched")},
+					&[]*string{}, &[]*string{},
+					&map[string]*ent.ReleaseType{"matched": releaseType})
+				configurationLayerMock.SetReleaseTypes(releaseTypes)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				switch versionConstraint {
+				case "~0.0":
+					assert.NoError(t, err)
+					version, _ := (*command).State().GetVersion()
+					assert.Equal(t, "0.0.5", *version)
+				case ">=1.2 <2.0":
+					// the generated version does not satisfy the constraint so it raises an error
+					assert.Error(t, err)
+					_, ok := err.(*errs.ReleaseError)
+					assert.True(t, ok)
+				default:
+					// the constraint is malformed
+					assert.Error(t, err)
+					_, ok := err.(*errs.IllegalPropertyError)
+					assert.True(t, ok)
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferBuildMetadata(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, buildMetadataTargets := range []string{"", "TAGS, changelog", "STATE,SOMEWHERE"} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+			t.Run((*command).GetContextName()+" targets="+buildMetadataTargets, func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				// add a mock convention that accepts all non nil messages and dumps the patch identifier for each
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				// add some fictional release types
+				releaseType := ent.NewReleaseType()
+				releaseType.SetBuildMetadata(utl.PointerToString("+build.{{#short5}}{{releaseScope.finalCommit}}{{/short5}}"))
+				if buildMetadataTargets != "" {
+					releaseType.SetBuildMetadataTargets(utl.PointerToString(buildMetadataTargets))
+				}
+				releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("matched")},
+					&[]*string{}, &[]*string{},
+					&map[string]*ent.ReleaseType{"matched": releaseType})
+				configurationLayerMock.SetReleaseTypes(releaseTypes)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				switch buildMetadataTargets {
+				case "":
+					assert.NoError(t, err)
+					buildMetadata, _ := (*command).State().GetBuildMetadata()
+					assert.Equal(t, "build."+(*command).Script().GetLastCommitID()[0:5], *buildMetadata)
+					version, _ := (*command).State().GetVersion()
+					assert.Equal(t, "0.0.5+"+*buildMetadata, *version)
+					newVersion, _ := (*command).State().GetNewVersion()
+					assert.True(t, newVersion)
+				case "TAGS, changelog":
+					// the build metadata is available in the state but it's not part of the version
+					assert.NoError(t, err)
+					buildMetadata, _ := (*command).State().GetBuildMetadata()
+					assert.Equal(t, "build."+(*command).Script().GetLastCommitID()[0:5], *buildMetadata)
+					version, _ := (*command).State().GetVersion()
+					assert.Equal(t, "0.0.5", *version)
+				default:
+					// the targets contain an illegal value
+					assert.Error(t, err)
+					_, ok := err.(*errs.IllegalPropertyError)
+					assert.True(t, ok)
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestInferBuildMetadataWithNothingToRelease(t *testing.T) {
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.INFER, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// no commit message convention is configured so there are no significant commits
+			releaseType := ent.NewReleaseType()
+			releaseType.SetBuildMetadata(utl.PointerToString("build.42"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("matched")},
+				&[]*string{}, &[]*string{},
+				&map[string]*ent.ReleaseType{"matched": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+			version, _ := (*command).State().GetVersion()
+			assert.Equal(t, "0.0.4", *version)
+			assert.False(t, (*command).State().HasBuildMetadata())
+			newVersion, _ := (*command).State().GetNewVersion()
+			assert.False(t, newVersion)
+		})
+	}
+}
+
 func TestInferVersionRangeCheckWithDynamicExpressionInferredFromParseableBranchNames(t *testing.T) {
 	logLevel := log.GetLevel()   // save the previous logging level
 	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
@@ -808,6 +808,305 @@ func TestMakeRunWithConventionalCommitsConventionAndWithCustomSections(t *testin
 	log.SetLevel(logLevel) // restore the original logging level
 }
 
+func TestMakeRunWithConventionalCommitsConventionAndWithDecoratedSections(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MAKE, gittools.ONE_BRANCH_SHORT_CONVENTIONAL_COMMITS()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// first create the temporary directory and the abstract destination file
+			destinationDir, _ := os.MkdirTemp("", "nyx-test-make-test-")
+			defer os.RemoveAll(destinationDir)
+			changelogFile := filepath.Join(destinationDir, "CHANGELOG.md")
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			changelogConfiguration, _ := configurationLayerMock.GetChangelog()
+			changelogConfiguration.SetPath(&changelogFile)
+			// add one section for all the commits and decorate it so it's also collapsed
+			changelogConfiguration.SetSections(&map[string]string{
+				"Changes": "^(feat|fix)$",
+			})
+			changelogConfiguration.SetEmojis(&map[string]string{
+				"Changes": ":sparkles:",
+			})
+			changelogConfiguration.SetBadges(&map[string]string{
+				"Changes": "![changes](https://img.shields.io/badge/type-changes-green?style=flat&logo=git)",
+			})
+			changelogConfiguration.SetCollapseThreshold(utl.PointerToInt(1))
+			// add the conventional commits convention
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("conventionalCommits")},
+				&map[string]*ent.CommitMessageConvention{"conventionalCommits": cnf.COMMIT_MESSAGE_CONVENTIONS_CONVENTIONAL_COMMITS})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				// test the data model
+				changelog, _ := (*command).State().GetChangelog()
+				assert.Equal(t, 1, len(changelog.GetReleases()))
+				assert.Equal(t, 1, len((*changelog.GetReleases()[0]).GetSections()))
+				section := (*changelog.GetReleases()[0]).GetSections()[0]
+				assert.Equal(t, "Changes", *section.GetName())
+				assert.Equal(t, 2, len(section.GetCommits()))
+				assert.Equal(t, ":sparkles:", *section.GetEmoji())
+				assert.Equal(t, "![changes](https://img.shields.io/badge/type-changes-green?style=flat&logo=git)", *section.GetBadge())
+				assert.True(t, section.GetCollapsed())
+
+				// test the rendered file, the badge must not be escaped
+				fileContent := readFile(changelogFile)
+				assert.True(t, strings.Contains(fileContent, "### :sparkles: Changes ![changes](https://img.shields.io/badge/type-changes-green?style=flat&logo=git)\n")) // section header check
+				assert.True(t, strings.Contains(fileContent, "<details>\n<summary>Show all changes</summary>\n"))                                                         // collapsed section check
+				assert.True(t, strings.Contains(fileContent, "] feat: Untagged commit #2 ("))                                                                             // partial line check
+				assert.True(t, strings.Contains(fileContent, "</details>\n"))                                                                                             // collapsed section check
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMakeRunWithConventionalCommitsConventionAndWithGroupedDependencyUpdates(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MAKE, gittools.INITIAL_VERSION()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// two npm updates are grouped while the single go update and the fix are left untouched
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("chore(deps): bump @types/node from 20.1.0 to 20.2.0\n\nSigned-off-by: dependabot[bot] <support@github.com>"))
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("fix: a fix"))
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("chore(deps): bump golang.org/x/net from 0.1.0 to 0.2.0\n\nSigned-off-by: dependabot[bot] <support@github.com>"))
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("chore(deps): bump @types/jest from 29.1.0 to 29.2.0\n\nSigned-off-by: dependabot[bot] <support@github.com>"))
+
+			// first create the temporary directory and the abstract destination file
+			destinationDir, _ := os.MkdirTemp("", "nyx-test-make-test-")
+			defer os.RemoveAll(destinationDir)
+			changelogFile := filepath.Join(destinationDir, "CHANGELOG.md")
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			changelogConfiguration, _ := configurationLayerMock.GetChangelog()
+			changelogConfiguration.SetPath(&changelogFile)
+			changelogConfiguration.SetSections(&map[string]string{
+				"Changes": "^(chore|fix)$",
+			})
+			changelogConfiguration.SetGroupDependencyUpdates(utl.PointerToBoolean(true))
+			// add the conventional commits convention
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("conventionalCommits")},
+				&map[string]*ent.CommitMessageConvention{"conventionalCommits": cnf.COMMIT_MESSAGE_CONVENTIONS_CONVENTIONAL_COMMITS})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				// test the data model
+				changelog, _ := (*command).State().GetChangelog()
+				assert.Equal(t, 1, len(changelog.GetReleases()))
+				assert.Equal(t, 1, len((*changelog.GetReleases()[0]).GetSections()))
+				section := (*changelog.GetReleases()[0]).GetSections()[0]
+				assert.Equal(t, 3, len(section.GetCommits()))
+				assert.Equal(t, "Update 2 npm dependencies: @types/jest, @types/node", section.GetCommits()[0].GetMessage().GetShortMessage())
+				assert.Equal(t, "chore(deps): bump golang.org/x/net from 0.1.0 to 0.2.0", section.GetCommits()[1].GetMessage().GetShortMessage())
+				assert.Equal(t, "fix: a fix", section.GetCommits()[2].GetMessage().GetShortMessage())
+
+				// test the rendered file
+				fileContent := readFile(changelogFile)
+				assert.True(t, strings.Contains(fileContent, "] Update 2 npm dependencies: @types/jest, @types/node ("))
+				assert.False(t, strings.Contains(fileContent, "bump @types"))
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMakeRunWithConventionalCommitsConventionAndWithFlatMergedPreReleases(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MAKE, gittools.INITIAL_VERSION()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// two pre-releases precede the final release
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("feat: a feature in rc.1")).AndTag("0.2.0-rc.1", nil)
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("fix: a fix in rc.2")).AndTag("0.2.0-rc.2", nil)
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("fix: a fix in the final release"))
+
+			// first create the temporary directory and the abstract destination file
+			destinationDir, _ := os.MkdirTemp("", "nyx-test-make-test-")
+			defer os.RemoveAll(destinationDir)
+			changelogFile := filepath.Join(destinationDir, "CHANGELOG.md")
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			changelogConfiguration, _ := configurationLayerMock.GetChangelog()
+			changelogConfiguration.SetPath(&changelogFile)
+			changelogConfiguration.SetSections(&map[string]string{
+				"Added": "^feat$",
+				"Fixed": "^fix$",
+			})
+			changelogConfiguration.SetMergePreReleases(ent.PointerToPreReleaseMerge(ent.FLAT))
+			// the mainline release type issues the final release
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("mainline")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"mainline": cnf.RELEASE_TYPES_MAINLINE})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			// add the conventional commits convention
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("conventionalCommits")},
+				&map[string]*ent.CommitMessageConvention{"conventionalCommits": cnf.COMMIT_MESSAGE_CONVENTIONS_CONVENTIONAL_COMMITS})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				// test the data model
+				changelog, _ := (*command).State().GetChangelog()
+				assert.Equal(t, 1, len(changelog.GetReleases()))
+				release := changelog.GetReleases()[0]
+				assert.Equal(t, 0, len(release.GetPreReleases()))
+				assert.Equal(t, 2, len(release.GetSections()))
+				assert.Equal(t, 1, len(release.GetSection("Added", false).GetCommits()))
+				assert.Equal(t, "feat: a feature in rc.1", release.GetSection("Added", false).GetCommits()[0].GetMessage().GetShortMessage())
+				assert.Equal(t, 2, len(release.GetSection("Fixed", false).GetCommits()))
+				assert.Equal(t, "fix: a fix in the final release", release.GetSection("Fixed", false).GetCommits()[0].GetMessage().GetShortMessage())
+				assert.Equal(t, "fix: a fix in rc.2", release.GetSection("Fixed", false).GetCommits()[1].GetMessage().GetShortMessage())
+
+				// test the rendered file
+				fileContent := readFile(changelogFile)
+				assert.True(t, strings.Contains(fileContent, "] feat: a feature in rc.1 ("))
+				assert.False(t, strings.Contains(fileContent, "0.2.0-rc.1"))
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMakeRunWithConventionalCommitsConventionAndWithNestedMergedPreReleases(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MAKE, gittools.INITIAL_VERSION()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// two pre-releases precede the final release
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("feat: a feature in rc.1")).AndTag("0.2.0-rc.1", nil)
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("fix: a fix in rc.2")).AndTag("0.2.0-rc.2", nil)
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("fix: a fix in the final release"))
+
+			// first create the temporary directory and the abstract destination file
+			destinationDir, _ := os.MkdirTemp("", "nyx-test-make-test-")
+			defer os.RemoveAll(destinationDir)
+			changelogFile := filepath.Join(destinationDir, "CHANGELOG.md")
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			changelogConfiguration, _ := configurationLayerMock.GetChangelog()
+			changelogConfiguration.SetPath(&changelogFile)
+			changelogConfiguration.SetSections(&map[string]string{
+				"Added": "^feat$",
+				"Fixed": "^fix$",
+			})
+			changelogConfiguration.SetMergePreReleases(ent.PointerToPreReleaseMerge(ent.NESTED))
+			// the mainline release type issues the final release
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("mainline")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"mainline": cnf.RELEASE_TYPES_MAINLINE})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			// add the conventional commits convention
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("conventionalCommits")},
+				&map[string]*ent.CommitMessageConvention{"conventionalCommits": cnf.COMMIT_MESSAGE_CONVENTIONS_CONVENTIONAL_COMMITS})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				// test the data model
+				changelog, _ := (*command).State().GetChangelog()
+				assert.Equal(t, 1, len(changelog.GetReleases()))
+				release := changelog.GetReleases()[0]
+				assert.Equal(t, 1, len(release.GetSections()))
+				assert.Equal(t, "fix: a fix in the final release", release.GetSection("Fixed", false).GetCommits()[0].GetMessage().GetShortMessage())
+				assert.Equal(t, 2, len(release.GetPreReleases()))
+				assert.Equal(t, "0.2.0-rc.2", *release.GetPreReleases()[0].GetName())
+				assert.Equal(t, "fix: a fix in rc.2", release.GetPreReleases()[0].GetSection("Fixed", false).GetCommits()[0].GetMessage().GetShortMessage())
+				assert.Equal(t, "0.2.0-rc.1", *release.GetPreReleases()[1].GetName())
+				assert.Equal(t, "feat: a feature in rc.1", release.GetPreReleases()[1].GetSection("Added", false).GetCommits()[0].GetMessage().GetShortMessage())
+
+				// test the rendered file
+				fileContent := readFile(changelogFile)
+				assert.True(t, strings.Contains(fileContent, "### 0.2.0-rc.2 ("))
+				assert.True(t, strings.Contains(fileContent, "#### Added"))
+				assert.True(t, strings.Contains(fileContent, "] feat: a feature in rc.1 ("))
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMakeRunWithImpactReports(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MAKE, gittools.INITIAL_VERSION()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			(*command).Script().AndAddFiles().AndStage().AndCommitWith(utl.PointerToString("fix: a fix"))
+
+			// first create the temporary directory and the abstract destination file
+			destinationDir, _ := os.MkdirTemp("", "nyx-test-make-test-")
+			defer os.RemoveAll(destinationDir)
+			changelogFile := filepath.Join(destinationDir, "CHANGELOG.md")
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			changelogConfiguration, _ := configurationLayerMock.GetChangelog()
+			changelogConfiguration.SetPath(&changelogFile)
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("conventionalCommits")},
+				&map[string]*ent.CommitMessageConvention{"conventionalCommits": cnf.COMMIT_MESSAGE_CONVENTIONS_CONVENTIONAL_COMMITS})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// only the analyzers detecting an impact are reported
+			impactAnalyzers, _ := ent.NewImpactAnalyzersWith(&[]*string{utl.PointerToString("api"), utl.PointerToString("schema")}, &map[string]*ent.ImpactAnalyzer{
+				"api":    ent.NewImpactAnalyzerWith(nil, utl.PointerToString("echo 'Incompatible: removed func F'"), utl.PointerToString("^Incompatible"), utl.PointerToString("API changes")),
+				"schema": ent.NewImpactAnalyzerWith(nil, utl.PointerToString("true"), nil, utl.PointerToString("Schema changes")),
+			})
+			configurationLayerMock.SetImpactAnalyzers(impactAnalyzers)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				version, _ := (*command).State().GetVersion()
+				assert.Equal(t, "1.0.0", *version)
+
+				// test the data model
+				changelog, _ := (*command).State().GetChangelog()
+				assert.Equal(t, 1, len(changelog.GetReleases()))
+				impactReports := (*changelog.GetReleases()[0]).GetImpactReports()
+				assert.Equal(t, 1, len(impactReports))
+				assert.Equal(t, "API changes", *impactReports[0].GetTitle())
+				assert.Equal(t, "Incompatible: removed func F", *impactReports[0].GetOutput())
+
+				// test the rendered file
+				fileContent := readFile(changelogFile)
+				assert.True(t, strings.Contains(fileContent, "### API changes"))
+				assert.True(t, strings.Contains(fileContent, "Incompatible: removed func F"))
+				assert.False(t, strings.Contains(fileContent, "### Schema changes"))
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
 func TestMakeRunWithConventionalCommitsConventionAndWithCustomSectionsAndSubstitutions(t *testing.T) {
 	logLevel := log.GetLevel()   // save the previous logging level
 	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
@@ -953,6 +1252,150 @@ func TestMakeRunWithCustomTemplateFromLocalFile(t *testing.T) {
 	log.SetLevel(logLevel) // restore the original logging level
 }
 
+func TestMakeRunWithCustomTemplateReadingRepositoryFiles(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out errors produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MAKE, gittools.ONE_BRANCH_SHORT_CONVENTIONAL_COMMITS()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// first create the temporary directory and the abstract destination file
+			destinationDir, _ := os.MkdirTemp("", "nyx-test-make-test-")
+			defer os.RemoveAll(destinationDir)
+			// create the custom template, embedding repository files
+			templateFile := filepath.Join(destinationDir, "template.tpl")
+			writeFile(templateFile, "# This is a custom changelog\n{{#releases}}## {{name}}\n{{/releases}}[{{#repositoryFile}}docs/UPGRADING.md{{/repositoryFile}}][{{#repositoryFile}}../outside.md{{/repositoryFile}}][{{#repositoryFile}}missing.md{{/repositoryFile}}]\n")
+			changelogFile := filepath.Join(destinationDir, "CHANGELOG.md")
+
+			// commit the file to embed, then change it in the working tree, so that only the committed content is expected
+			upgradingFile := filepath.Join((*command).Script().GetWorkingDirectory(), "docs", "UPGRADING.md")
+			os.MkdirAll(filepath.Dir(upgradingFile), os.ModePerm)
+			writeFile(upgradingFile, "committed upgrade notes")
+			writeFile(filepath.Join(filepath.Dir((*command).Script().GetWorkingDirectory()), "outside.md"), "outside")
+			defer os.Remove(filepath.Join(filepath.Dir((*command).Script().GetWorkingDirectory()), "outside.md"))
+			(*command).Script().AndStage().AndCommitWith(utl.PointerToString("docs: add upgrade notes"))
+			writeFile(upgradingFile, "uncommitted upgrade notes")
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			changelogConfiguration, _ := configurationLayerMock.GetChangelog()
+			changelogConfiguration.SetPath(&changelogFile)
+			changelogConfiguration.SetTemplate(&templateFile)
+			// add the conventional commits convention
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("conventionalCommits")},
+				&map[string]*ent.CommitMessageConvention{"conventionalCommits": cnf.COMMIT_MESSAGE_CONVENTIONS_CONVENTIONAL_COMMITS})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				fileContent := readFile(changelogFile)
+				assert.True(t, strings.HasPrefix(fileContent, "# This is a custom changelog"))
+				assert.True(t, strings.Contains(fileContent, "[committed upgrade notes][][]"))
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMakeRunWithUpgradeNotes(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out errors produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MAKE, gittools.ONE_BRANCH_SHORT_CONVENTIONAL_COMMITS()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// first create the temporary directory and the abstract destination file
+			destinationDir, _ := os.MkdirTemp("", "nyx-test-make-test-")
+			defer os.RemoveAll(destinationDir)
+			changelogFile := filepath.Join(destinationDir, "CHANGELOG.md")
+
+			// commit the upgrade notes where they are expected by default, with a front matter that is stripped
+			upgradeNotesFile := filepath.Join((*command).Script().GetWorkingDirectory(), "docs", "upgrade-notes", "0.1.0.md")
+			os.MkdirAll(filepath.Dir(upgradeNotesFile), os.ModePerm)
+			writeFile(upgradeNotesFile, "---\ntitle: Upgrading to 0.1.0\n---\n\nRename the `foo` option to `bar`.\n")
+			(*command).Script().AndStage().AndCommitWith(utl.PointerToString("docs: add upgrade notes"))
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			changelogConfiguration, _ := configurationLayerMock.GetChangelog()
+			changelogConfiguration.SetPath(&changelogFile)
+			// add the conventional commits convention
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("conventionalCommits")},
+				&map[string]*ent.CommitMessageConvention{"conventionalCommits": cnf.COMMIT_MESSAGE_CONVENTIONS_CONVENTIONAL_COMMITS})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				changelog, _ := (*command).State().GetChangelog()
+				assert.Equal(t, 1, len(changelog.GetReleases()))
+				assert.Equal(t, "0.1.0", *(*changelog.GetReleases()[0]).GetName())
+				assert.Equal(t, "Rename the `foo` option to `bar`.", *(*changelog.GetReleases()[0]).GetUpgradeNotes())
+
+				fileContent := readFile(changelogFile)
+				assert.True(t, strings.Contains(fileContent, "## 0.1.0 ("))
+				assert.True(t, strings.Contains(fileContent, "### Upgrade notes\n\nRename the `foo` option to `bar`.\n"))
+				assert.False(t, strings.Contains(fileContent, "title: Upgrading to 0.1.0"))
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMakeRunWithUpgradeNotesDisabled(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out errors produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MAKE, gittools.ONE_BRANCH_SHORT_CONVENTIONAL_COMMITS()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// first create the temporary directory and the abstract destination file
+			destinationDir, _ := os.MkdirTemp("", "nyx-test-make-test-")
+			defer os.RemoveAll(destinationDir)
+			changelogFile := filepath.Join(destinationDir, "CHANGELOG.md")
+
+			// commit the upgrade notes where they are expected by default, even if they are not used
+			upgradeNotesFile := filepath.Join((*command).Script().GetWorkingDirectory(), "docs", "upgrade-notes", "0.1.0.md")
+			os.MkdirAll(filepath.Dir(upgradeNotesFile), os.ModePerm)
+			writeFile(upgradeNotesFile, "---\ntitle: Upgrading to 0.1.0\n---\n\nRename the `foo` option to `bar`.\n")
+			(*command).Script().AndStage().AndCommitWith(utl.PointerToString("docs: add upgrade notes"))
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			changelogConfiguration, _ := configurationLayerMock.GetChangelog()
+			changelogConfiguration.SetPath(&changelogFile)
+			changelogConfiguration.SetUpgradeNotes(utl.PointerToString(""))
+			// add the conventional commits convention
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("conventionalCommits")},
+				&map[string]*ent.CommitMessageConvention{"conventionalCommits": cnf.COMMIT_MESSAGE_CONVENTIONS_CONVENTIONAL_COMMITS})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				changelog, _ := (*command).State().GetChangelog()
+				assert.Equal(t, 1, len(changelog.GetReleases()))
+				assert.Equal(t, "0.1.0", *(*changelog.GetReleases()[0]).GetName())
+				assert.Nil(t, (*changelog.GetReleases()[0]).GetUpgradeNotes())
+
+				fileContent := readFile(changelogFile)
+				assert.False(t, strings.Contains(fileContent, "### Upgrade notes"))
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
 func TestMakeRunWithCustomTemplateFromURL(t *testing.T) {
 	logLevel := log.GetLevel()   // save the previous logging level
 	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
@@ -1160,6 +1603,53 @@ func TestMakeRunWithExistingFileAndAppendingToTail(t *testing.T) {
 	log.SetLevel(logLevel) // restore the original logging level
 }
 
+func TestMakeRunWithExistingFileAndAppendingAnnotatesYankedReleases(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MAKE, gittools.ONE_BRANCH_SHORT_CONVENTIONAL_COMMITS()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// first create the temporary directory and the abstract destination file
+			destinationDir, _ := os.MkdirTemp("", "nyx-test-make-test-")
+			defer os.RemoveAll(destinationDir)
+			// create the custom template, with simple strings used as markers
+			templateFile := filepath.Join(destinationDir, "template.tpl")
+			// this template only writes static content, which is easier to match after the changelog has been generated
+			writeFile(templateFile, "NEW CHANGELOG CONTENT\n")
+			changelogFile := filepath.Join(destinationDir, "CHANGELOG.md")
+
+			// create a changelog with some existing releases, one of which has been yanked
+			writeFile(changelogFile, "## 0.0.3\n\n## [0.0.2](https://example.com/0.0.2)\n\n## 0.0.1\n")
+
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			changelogConfiguration, _ := configurationLayerMock.GetChangelog()
+			changelogConfiguration.SetAppend(utl.PointerToString("head"))
+			changelogConfiguration.SetPath(&changelogFile)
+			changelogConfiguration.SetTemplate(&templateFile)
+			releaseTypes, _ := configurationLayerMock.GetReleaseTypes()
+			releaseTypes.SetYanked(&[]*string{utl.PointerToString("0.0.2")})
+			// add the conventional commits convention
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("conventionalCommits")},
+				&map[string]*ent.CommitMessageConvention{"conventionalCommits": cnf.COMMIT_MESSAGE_CONVENTIONS_CONVENTIONAL_COMMITS})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				// test the rendered file
+				fileContent := strings.Replace(readFile(changelogFile), "\r", "", -1)
+				assert.Equal(t, "NEW CHANGELOG CONTENT\n## 0.0.3\n\n## [0.0.2](https://example.com/0.0.2) [YANKED]\n\n## 0.0.1\n", fileContent)
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
 func TestMakeRunWithSubstitutionsUsingCargoVersionPreset(t *testing.T) {
 	logLevel := log.GetLevel()   // save the previous logging level
 	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
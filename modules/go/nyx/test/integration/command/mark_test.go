@@ -22,9 +22,11 @@
 package command_test
 
 import (
-	"os"      // https://pkg.go.dev/os
-	"testing" // https://pkg.go.dev/testing
-	"time"    // https://pkg.go.dev/time
+	"encoding/json" // https://pkg.go.dev/encoding/json
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"testing"       // https://pkg.go.dev/testing
+	"time"          // https://pkg.go.dev/time
 
 	log "github.com/sirupsen/logrus"            // https://pkg.go.dev/github.com/sirupsen/logrus
 	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
@@ -33,8 +35,10 @@ import (
 	cmd "github.com/mooltiverse/nyx/modules/go/nyx/command"
 	cnf "github.com/mooltiverse/nyx/modules/go/nyx/configuration"
 	ent "github.com/mooltiverse/nyx/modules/go/nyx/entities"
+	git "github.com/mooltiverse/nyx/modules/go/nyx/git"
 	github "github.com/mooltiverse/nyx/modules/go/nyx/services/github"
 	gitlab "github.com/mooltiverse/nyx/modules/go/nyx/services/gitlab"
+	versionsfile "github.com/mooltiverse/nyx/modules/go/nyx/services/versionsfile"
 	cmdtpl "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/command/template"
 	gittools "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/git/tools"
 	gitutil "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/git/util"
@@ -1365,6 +1369,169 @@ func TestMarkRunOnCleanWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagAndPus
 	log.SetLevel(logLevel) // restore the original logging level
 }
 
+func TestMarkRunOnCleanWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagAndPushEnabledAndPushTagsNew(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			remoteScript := gittools.BARE().RealizeBare(true)
+			defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+			(*command).Script().AddRemote(remoteScript.GetWorkingDirectory(), "replica") // use the GitDirectory even if it's a bare repository as it's managed internally and still points to the repo dir
+			previousLastCommit := (*command).Script().GetLastCommitID()
+			previousCommits := (*command).Script().GetCommitIDs()
+			previousTags := (*command).Script().GetTags()
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that always enables committing, tagging and pushing
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("true"))
+			releaseType.SetGitTag(utl.PointerToString("true"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")},
+				&[]*string{}, &[]*string{utl.PointerToString("replica")},
+				&map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			gitConfiguration := ent.NewGitConfiguration()
+			gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
+				"replica": ent.NewGitRemoteConfigurationWith(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, utl.PointerToString("NEW")),
+			})
+			configurationLayerMock.SetGit(gitConfiguration)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				version2, _ := (*command).State().GetVersion()
+				assert.Equal(t, "0.0.5", *version2)
+				assert.Equal(t, previousLastCommit, (*command).Script().GetLastCommitID())
+				assert.Equal(t, len(previousCommits), len((*command).Script().GetCommitIDs()))
+				assert.Equal(t, len(previousTags)+1, len((*command).Script().GetTags()))
+				// only the tag applied by the release is pushed
+				assert.Equal(t, 1, len(remoteScript.GetTags()))
+				assert.Contains(t, remoteScript.GetTags(), "0.0.5")
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunOnCleanWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagAndPushEnabledAndPushTagsNone(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			remoteScript := gittools.BARE().RealizeBare(true)
+			defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+			(*command).Script().AddRemote(remoteScript.GetWorkingDirectory(), "replica") // use the GitDirectory even if it's a bare repository as it's managed internally and still points to the repo dir
+			previousLastCommit := (*command).Script().GetLastCommitID()
+			previousCommits := (*command).Script().GetCommitIDs()
+			previousTags := (*command).Script().GetTags()
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that always enables committing, tagging and pushing
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("true"))
+			releaseType.SetGitTag(utl.PointerToString("true"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")},
+				&[]*string{}, &[]*string{utl.PointerToString("replica")},
+				&map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			gitConfiguration := ent.NewGitConfiguration()
+			gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
+				"replica": ent.NewGitRemoteConfigurationWith(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, utl.PointerToString("NONE")),
+			})
+			configurationLayerMock.SetGit(gitConfiguration)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				version2, _ := (*command).State().GetVersion()
+				assert.Equal(t, "0.0.5", *version2)
+				assert.Equal(t, previousLastCommit, (*command).Script().GetLastCommitID())
+				assert.Equal(t, len(previousCommits), len((*command).Script().GetCommitIDs()))
+				assert.Equal(t, len(previousTags)+1, len((*command).Script().GetTags()))
+				assert.Equal(t, 0, len(remoteScript.GetTags()))
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunOnCleanWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagAndPushEnabledAndPushTagsPattern(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			remoteScript := gittools.BARE().RealizeBare(true)
+			defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+			(*command).Script().AddRemote(remoteScript.GetWorkingDirectory(), "replica") // use the GitDirectory even if it's a bare repository as it's managed internally and still points to the repo dir
+			previousLastCommit := (*command).Script().GetLastCommitID()
+			previousCommits := (*command).Script().GetCommitIDs()
+			previousTags := (*command).Script().GetTags()
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that always enables committing, tagging and pushing
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("true"))
+			releaseType.SetGitTag(utl.PointerToString("true"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")},
+				&[]*string{}, &[]*string{utl.PointerToString("replica")},
+				&map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			gitConfiguration := ent.NewGitConfiguration()
+			gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
+				"replica": ent.NewGitRemoteConfigurationWith(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, utl.PointerToString("*.5")),
+			})
+			configurationLayerMock.SetGit(gitConfiguration)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				version2, _ := (*command).State().GetVersion()
+				assert.Equal(t, "0.0.5", *version2)
+				assert.Equal(t, previousLastCommit, (*command).Script().GetLastCommitID())
+				assert.Equal(t, len(previousCommits), len((*command).Script().GetCommitIDs()))
+				assert.Equal(t, len(previousTags)+1, len((*command).Script().GetTags()))
+				// only the tags matching the pattern are pushed
+				assert.Equal(t, 1, len(remoteScript.GetTags()))
+				assert.Contains(t, remoteScript.GetTags(), "0.0.5")
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
 func TestMarkRunOnDirtyWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagAndPushEnabled(t *testing.T) {
 	logLevel := log.GetLevel()   // save the previous logging level
 	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
@@ -1416,6 +1583,495 @@ func TestMarkRunOnDirtyWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagAndPus
 	log.SetLevel(logLevel) // restore the original logging level
 }
 
+func TestMarkRunOnCleanWorkspaceWithNewVersionOrNewReleaseWithCommitAndReleaseMetadataFile(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			previousLastCommit := (*command).Script().GetLastCommitID()
+			previousCommits := (*command).Script().GetCommitIDs()
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetDirectory(utl.PointerToString((*command).Script().GetWorkingDirectory()))
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that enables committing and writes the release metadata file
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("false"))
+			releaseType.SetGitTag(utl.PointerToString("false"))
+			releaseType.SetReleaseMetadataFile(utl.PointerToString(".nyx-release.json"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				// the release metadata file has been written and committed
+				assert.NotEqual(t, previousLastCommit, (*command).Script().GetLastCommitID())
+				assert.Equal(t, len(previousCommits)+1, len((*command).Script().GetCommitIDs()))
+
+				content, err := os.ReadFile(filepath.Join((*command).Script().GetWorkingDirectory(), ".nyx-release.json"))
+				assert.NoError(t, err)
+				var metadata map[string]interface{}
+				assert.NoError(t, json.Unmarshal(content, &metadata))
+				assert.Equal(t, "0.0.5", metadata["version"])
+				assert.Equal(t, previousLastCommit, metadata["commit"])
+				assert.Equal(t, "master", metadata["branch"])
+				assert.NotEmpty(t, metadata["date"])
+			} else {
+				_, err := os.Stat(filepath.Join((*command).Script().GetWorkingDirectory(), ".nyx-release.json"))
+				assert.True(t, os.IsNotExist(err))
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunOnDirtyWorkspaceWithNewVersionOrNewReleaseWithCommitAndStash(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			previousCommits := (*command).Script().GetCommitIDs()
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetDirectory(utl.PointerToString((*command).Script().GetWorkingDirectory()))
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that enables committing and writes the release metadata file
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("false"))
+			releaseType.SetGitTag(utl.PointerToString("true"))
+			releaseType.SetReleaseMetadataFile(utl.PointerToString(".nyx-release.json"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			gitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{}, nil, nil, nil, nil, ent.PointerToGitBackend(ent.CLI), nil, nil, nil, nil, nil, nil, utl.PointerToBoolean(true), nil, nil, nil, nil, nil)
+			configurationLayerMock.SetGit(gitConfiguration)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			// add some uncommitted changes unrelated to the release
+			unrelatedFile := filepath.Join((*command).Script().GetWorkingDirectory(), "unrelated.txt")
+			assert.NoError(t, os.WriteFile(unrelatedFile, []byte("unrelated\n"), 0644))
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// the unrelated changes are back in the working tree and no stash is left behind
+			content, err := os.ReadFile(unrelatedFile)
+			assert.NoError(t, err)
+			assert.Equal(t, "unrelated\n", string(content))
+			repository := (*command).Script().Repository
+			_, err = repository.Reference("refs/stash", false)
+			assert.Error(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				// only the release metadata file has been committed
+				assert.Equal(t, len(previousCommits)+1, len((*command).Script().GetCommitIDs()))
+				lastCommit := (*command).Script().GetLastCommit()
+				_, err = lastCommit.File(".nyx-release.json")
+				assert.NoError(t, err)
+				_, err = lastCommit.File("unrelated.txt")
+				assert.Error(t, err)
+				_, err = repository.Tag("0.0.5")
+				assert.NoError(t, err)
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunOnDirtyWorkspaceWithNewVersionOrNewReleaseWithCommitAndStashFailingToRestore(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetDirectory(utl.PointerToString((*command).Script().GetWorkingDirectory()))
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that enables committing and writes the release metadata file
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("false"))
+			releaseType.SetGitTag(utl.PointerToString("true"))
+			releaseType.SetReleaseMetadataFile(utl.PointerToString(".nyx-release.json"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			gitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{}, nil, nil, nil, nil, ent.PointerToGitBackend(ent.CLI), nil, nil, nil, nil, nil, nil, utl.PointerToBoolean(true), nil, nil, nil, nil, nil)
+			configurationLayerMock.SetGit(gitConfiguration)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			// add some uncommitted changes unrelated to the release
+			unrelatedFile := filepath.Join((*command).Script().GetWorkingDirectory(), "unrelated.txt")
+			assert.NoError(t, os.WriteFile(unrelatedFile, []byte("unrelated\n"), 0644))
+			// the hook recreates the stashed file after committing so that the stashed changes can't be restored
+			hook := filepath.Join((*command).Script().GetWorkingDirectory(), ".git", "hooks", "post-commit")
+			assert.NoError(t, os.MkdirAll(filepath.Dir(hook), 0755))
+			assert.NoError(t, os.WriteFile(hook, []byte("#!/bin/sh\necho conflicting > unrelated.txt\n"), 0755))
+
+			_, err := (*command).Run()
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				// the failure to restore the stashed changes is reported and the changes are left in the stash
+				assert.Error(t, err)
+				repository := (*command).Script().Repository
+				_, err = repository.Reference("refs/stash", false)
+				assert.NoError(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunWithRepositoryLock(t *testing.T) {
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	lockFile := filepath.Join(script.GetWorkingDirectory(), ".git", git.LOCK_FILE_NAME)
+
+	configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+	// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+		&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+			&map[string]string{"patch": ".*"})})
+	configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+	// add a custom release type that enables tagging
+	releaseType := ent.NewReleaseType()
+	releaseType.SetGitCommit(utl.PointerToString("false"))
+	releaseType.SetGitPush(utl.PointerToString("false"))
+	releaseType.SetGitTag(utl.PointerToString("true"))
+	releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+	configurationLayerMock.SetReleaseTypes(releaseTypes)
+	gitConfiguration, _ := ent.NewGitConfigurationWith(nil, nil, nil, &map[string]*ent.GitRemoteConfiguration{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, utl.PointerToBoolean(true), nil)
+	configurationLayerMock.SetGit(gitConfiguration)
+	var configurationLayer cnf.ConfigurationLayer
+	configurationLayer = configurationLayerMock
+
+	// another run holds the lock so this one fails without tagging
+	assert.NoError(t, os.WriteFile(lockFile, []byte("pid 1 on host 'other'\n"), 0644))
+	lockedNyx := nyx.NewNyxIn(script.GetWorkingDirectory())
+	nyxConfiguration, _ := lockedNyx.Configuration()
+	nyxConfiguration.WithRuntimeConfiguration(&configurationLayer)
+	_, err := lockedNyx.Mark()
+	assert.Error(t, err)
+	_, ok := script.GetTags()["0.0.5"]
+	assert.False(t, ok)
+
+	// once the lock is released the run succeeds and releases the lock when done
+	assert.NoError(t, os.Remove(lockFile))
+	unlockedNyx := nyx.NewNyxIn(script.GetWorkingDirectory())
+	nyxConfiguration, _ = unlockedNyx.Configuration()
+	nyxConfiguration.WithRuntimeConfiguration(&configurationLayer)
+	_, err = unlockedNyx.Mark()
+	assert.NoError(t, err)
+	_, ok = script.GetTags()["0.0.5"]
+	assert.True(t, ok)
+	assert.NoFileExists(t, lockFile)
+}
+
+func TestMarkRunOnCleanWorkspaceWithNewVersionOrNewReleaseWithVersionStorageService(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			previousLastCommit := (*command).Script().GetLastCommitID()
+			previousTags := (*command).Script().GetTags()
+			versionsFile := filepath.Join(t.TempDir(), "versions.json")
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetDirectory(utl.PointerToString((*command).Script().GetWorkingDirectory()))
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			configurationLayerMock.SetServices(&map[string]*ent.ServiceConfiguration{
+				"versions": ent.NewServiceConfigurationWith(ent.PointerToProvider(ent.VERSIONS_FILE), &map[string]string{versionsfile.LOCATION_OPTION_NAME: versionsFile}),
+			})
+			configurationLayerMock.SetVersionStorageService(utl.PointerToString("versions"))
+			// add a custom release type that only records the version on the version storage service
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("false"))
+			releaseType.SetGitPush(utl.PointerToString("false"))
+			releaseType.SetGitTag(utl.PointerToString("false"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+			assert.Equal(t, len(previousTags), len((*command).Script().GetTags()))
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				content, err := os.ReadFile(versionsFile)
+				assert.NoError(t, err)
+				var versions map[string][]map[string]interface{}
+				assert.NoError(t, json.Unmarshal(content, &versions))
+				assert.Equal(t, 1, len(versions["versions"]))
+				assert.Equal(t, "0.0.5", versions["versions"][0]["version"])
+				assert.Equal(t, previousLastCommit, versions["versions"][0]["commit"])
+			} else {
+				_, err := os.Stat(versionsFile)
+				assert.True(t, os.IsNotExist(err))
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunOnDirtyWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagUsingDefaultIdentity(t *testing.T) {
+	// make sure the global configuration of the current user is not used
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// the default identity is only used when the repository has no identity configured
+			(*command).Script().RemoveUserIdentity()
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that always enables committing and tagging
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("false"))
+			releaseType.SetGitTag(utl.PointerToString("true"))
+			releaseType.SetGitTagMessage(utl.PointerToString("Release {{version}}"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			// configure the default identity with no email so it's inferred from the provider
+			gitConfiguration, _ := ent.NewGitConfigurationWith(nil, ent.NewGitIdentityConfigurationWith(nil, utl.PointerToString("nyx[bot]"), ent.PointerToProvider(ent.GITHUB), nil, nil), nil, &map[string]*ent.GitRemoteConfiguration{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			configurationLayerMock.SetGit(gitConfiguration)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			// add some uncommitted changes
+			(*command).Script().AndAddFiles()
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				lastCommit := (*command).Script().GetLastCommit()
+				assert.Equal(t, "nyx[bot]", lastCommit.Author.Name)
+				assert.Equal(t, "nyx[bot]@users.noreply.github.com", lastCommit.Author.Email)
+				assert.Equal(t, "nyx[bot]", lastCommit.Committer.Name)
+				assert.Equal(t, "nyx[bot]@users.noreply.github.com", lastCommit.Committer.Email)
+				assert.True(t, time.Since(lastCommit.Author.When) < time.Hour)
+
+				repository := (*command).Script().Repository
+				tag, err := repository.Tag("0.0.5")
+				assert.NoError(t, err)
+				tagObject, err := repository.TagObject(tag.Hash())
+				assert.NoError(t, err)
+				assert.Equal(t, "nyx[bot]", tagObject.Tagger.Name)
+				assert.Equal(t, "nyx[bot]@users.noreply.github.com", tagObject.Tagger.Email)
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunOnDirtyWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagUsingDefaultIdentityFromEnvironmentVariables(t *testing.T) {
+	// make sure the global configuration of the current user is not used
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("BOT_GIT_NAME", "Release Bot")
+	t.Setenv("BOT_GIT_EMAIL", "release-bot@example.com")
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// the default identity is only used when the repository has no identity configured
+			(*command).Script().RemoveUserIdentity()
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that always enables committing and tagging
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("false"))
+			releaseType.SetGitTag(utl.PointerToString("true"))
+			releaseType.SetGitTagMessage(utl.PointerToString("Release {{version}}"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			// configure the default identity to be read from custom environment variables
+			gitConfiguration, _ := ent.NewGitConfigurationWith(nil, ent.NewGitIdentityConfigurationWith(nil, nil, nil, utl.PointerToString("BOT_GIT_EMAIL"), utl.PointerToString("BOT_GIT_NAME")), nil, &map[string]*ent.GitRemoteConfiguration{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			configurationLayerMock.SetGit(gitConfiguration)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			// add some uncommitted changes
+			(*command).Script().AndAddFiles()
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				lastCommit := (*command).Script().GetLastCommit()
+				assert.Equal(t, "Release Bot", lastCommit.Author.Name)
+				assert.Equal(t, "release-bot@example.com", lastCommit.Author.Email)
+				assert.Equal(t, "Release Bot", lastCommit.Committer.Name)
+				assert.Equal(t, "release-bot@example.com", lastCommit.Committer.Email)
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunOnDirtyWorkspaceRestoresTheRepositoryWhenPushFails(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings and errors produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// the remote points to a non existing repository so pushing fails
+			remoteDirectory, err := os.MkdirTemp("", "nyx-test-mark-test-")
+			assert.NoError(t, err)
+			os.RemoveAll(remoteDirectory)
+			(*command).Script().AddRemote(remoteDirectory, "replica")
+			previousLastCommit := (*command).Script().GetLastCommitID()
+			previousCommits := (*command).Script().GetCommitIDs()
+			previousTags := (*command).Script().GetTags()
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that always enables committing, tagging and pushing
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("true"))
+			releaseType.SetGitTag(utl.PointerToString("true"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")},
+				&[]*string{}, &[]*string{utl.PointerToString("replica")},
+				&map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			// add some uncommitted changes
+			(*command).Script().AndAddFiles()
+
+			_, err = (*command).Run()
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				assert.Error(t, err)
+				// the commit and the tag have been rolled back and the uncommitted changes are still there
+				assert.Equal(t, previousLastCommit, (*command).Script().GetLastCommitID())
+				assert.Equal(t, len(previousCommits), len((*command).Script().GetCommitIDs()))
+				assert.Equal(t, previousTags, (*command).Script().GetTags())
+				script := (*command).Script()
+				worktree, err := script.Repository.Worktree()
+				assert.NoError(t, err)
+				status, err := worktree.Status()
+				assert.NoError(t, err)
+				assert.False(t, status.IsClean())
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunRemovesTheReleaseMetadataFileWhenPushFails(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out warnings and errors produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			// the remote points to a non existing repository so pushing fails
+			remoteDirectory, err := os.MkdirTemp("", "nyx-test-mark-test-")
+			assert.NoError(t, err)
+			os.RemoveAll(remoteDirectory)
+			(*command).Script().AddRemote(remoteDirectory, "replica")
+			previousLastCommit := (*command).Script().GetLastCommitID()
+			previousTags := (*command).Script().GetTags()
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			configurationLayerMock.SetDirectory(utl.PointerToString((*command).Script().GetWorkingDirectory()))
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that always enables committing, tagging and pushing and writes the release metadata file
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("true"))
+			releaseType.SetGitTag(utl.PointerToString("true"))
+			releaseType.SetReleaseMetadataFile(utl.PointerToString(".nyx-release.json"))
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")},
+				&[]*string{}, &[]*string{utl.PointerToString("replica")},
+				&map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err = (*command).Run()
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				assert.Error(t, err)
+				// the commit and the tag have been rolled back and the release metadata file has been removed
+				assert.Equal(t, previousLastCommit, (*command).Script().GetLastCommitID())
+				assert.Equal(t, previousTags, (*command).Script().GetTags())
+				_, err = os.Stat(filepath.Join((*command).Script().GetWorkingDirectory(), ".nyx-release.json"))
+				assert.True(t, os.IsNotExist(err))
+				script := (*command).Script()
+				worktree, err := script.Repository.Worktree()
+				assert.NoError(t, err)
+				status, err := worktree.Status()
+				assert.NoError(t, err)
+				assert.True(t, status.IsClean())
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
 func TestMarkRunOnCleanWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagAndPushEnabledUsingMultipleTagNames(t *testing.T) {
 	logLevel := log.GetLevel()   // save the previous logging level
 	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
@@ -1476,6 +2132,124 @@ func TestMarkRunOnCleanWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagAndPus
 				assert.True(t, ok)
 				_, ok = remoteScript.GetTags()[*majorVersion2+"."+*minorVersion2]
 				assert.True(t, ok)
+
+				// only the new tags are recorded for the Clean command, along with the remotes they have been pushed to
+				internals, _ := (*command).State().GetInternals()
+				assert.Equal(t, *version2+"\n"+*majorVersion2+"\n"+*majorVersion2+"."+*minorVersion2, (*internals)[cmd.MARK_INTERNAL_OUTPUT_ATTRIBUTE_TAGS])
+				assert.Equal(t, "replica", (*internals)[cmd.MARK_INTERNAL_OUTPUT_ATTRIBUTE_REMOTES])
+			}
+		})
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunOnCleanWorkspaceWithNewVersionOrNewReleaseWithBuildMetadataTargets(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, buildMetadataTargets := range []string{"STATE", "TAGS", "STATE,TAGS"} {
+		for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+			t.Run((*command).GetContextName()+" targets="+buildMetadataTargets, func(t *testing.T) {
+				defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+				previousTags := (*command).Script().GetTags()
+				configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+				// add a mock convention that accepts all non nil messages and dumps the patch identifier for each
+				commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+					&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+						&map[string]string{"patch": ".*"})})
+				configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+				// add a custom release type that only enables tagging
+				releaseType := ent.NewReleaseType()
+				releaseType.SetBuildMetadata(utl.PointerToString("build.42"))
+				releaseType.SetBuildMetadataTargets(utl.PointerToString(buildMetadataTargets))
+				releaseType.SetGitCommit(utl.PointerToString("false"))
+				releaseType.SetGitPush(utl.PointerToString("false"))
+				releaseType.SetGitTag(utl.PointerToString("true"))
+				releaseType.SetGitTagNames(&[]*string{utl.PointerToString("v{{version}}")})
+				releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")}, &[]*string{}, &[]*string{}, &map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+				configurationLayerMock.SetReleaseTypes(releaseTypes)
+				var configurationLayer cnf.ConfigurationLayer
+				configurationLayer = configurationLayerMock
+				(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+				_, err := (*command).Run()
+				assert.NoError(t, err)
+
+				// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+				if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+					version, _ := (*command).State().GetVersion()
+					assert.Equal(t, len(previousTags)+1, len((*command).Script().GetTags()))
+					switch buildMetadataTargets {
+					case "STATE":
+						assert.Equal(t, "0.0.5+build.42", *version)
+						_, ok := (*command).Script().GetTags()["v0.0.5"]
+						assert.True(t, ok)
+					case "TAGS":
+						assert.Equal(t, "0.0.5", *version)
+						_, ok := (*command).Script().GetTags()["v0.0.5+build.42"]
+						assert.True(t, ok)
+					default:
+						assert.Equal(t, "0.0.5+build.42", *version)
+						_, ok := (*command).Script().GetTags()["v0.0.5+build.42"]
+						assert.True(t, ok)
+					}
+				}
+			})
+		}
+	}
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestMarkRunOnCleanWorkspaceWithNewVersionOrNewReleaseWithCommitAndTagAndPushEnabledUsingFloatingTags(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	for _, command := range cmdtpl.CommandInvocationProxies(cmd.MARK, gittools.ONE_BRANCH_SHORT()) {
+		t.Run((*command).GetContextName(), func(t *testing.T) {
+			defer os.RemoveAll((*command).Script().GetWorkingDirectory())
+			remoteScript := gittools.BARE().RealizeBare(true)
+			defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+			(*command).Script().AddRemote(remoteScript.GetWorkingDirectory(), "replica") // use the GitDirectory even if it's a bare repository as it's managed internally and still points to the repo dir
+			// the remote already has the 0.0.1 tag, pointing to an older commit
+			(*command).Script().PushTo("replica")
+			previousLastCommit := (*command).Script().GetLastCommitID()
+			assert.NotEqual(t, previousLastCommit, remoteScript.GetTags()["0.0.1"])
+			configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+			// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
+			commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+				&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+					&map[string]string{"patch": ".*"})})
+			configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+			// add a custom release type that moves the existing 0.0.1 tag as a floating tag, without forcing the push
+			releaseType := ent.NewReleaseType()
+			releaseType.SetGitCommit(utl.PointerToString("true"))
+			releaseType.SetGitPush(utl.PointerToString("true"))
+			releaseType.SetGitPushForce(utl.PointerToString("false"))
+			releaseType.SetGitTag(utl.PointerToString("true"))
+			releaseType.SetGitTagForce(utl.PointerToString("true"))
+			releaseType.SetGitTagNames(&[]*string{utl.PointerToString("0.0.1"), utl.PointerToString("{{version}}")})
+			releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")},
+				&[]*string{}, &[]*string{utl.PointerToString("replica")},
+				&map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+			configurationLayerMock.SetReleaseTypes(releaseTypes)
+			var configurationLayer cnf.ConfigurationLayer
+			configurationLayer = configurationLayerMock
+			(*command).State().GetConfiguration().WithRuntimeConfiguration(&configurationLayer)
+
+			_, err := (*command).Run()
+			assert.NoError(t, err)
+
+			// when the command is executed standalone, Infer is not executed so Run() will just do nothing as the release scope is undefined
+			if (*command).GetContextName() != cmdtpl.STANDALONE_CONTEXT_NAME {
+				version, _ := (*command).State().GetVersion()
+				assert.Equal(t, "0.0.5", *version)
+				// the floating tag has been moved both locally and on the remote
+				assert.Equal(t, previousLastCommit, (*command).Script().GetTags()["0.0.1"])
+				assert.Equal(t, previousLastCommit, remoteScript.GetTags()["0.0.1"])
+				assert.Equal(t, previousLastCommit, remoteScript.GetTags()[*version])
+
+				// the floating tag is not recorded for the Clean command as it existed before
+				internals, _ := (*command).State().GetInternals()
+				assert.Equal(t, *version, (*internals)[cmd.MARK_INTERNAL_OUTPUT_ATTRIBUTE_TAGS])
+				assert.Equal(t, "0.0.1\n"+*version, (*internals)[cmd.MARK_INTERNAL_OUTPUT_ATTRIBUTE_FORCED_TAGS])
 			}
 		})
 	}
@@ -1511,7 +2285,7 @@ func TestMarkRunOnGitHubClonedWorkspaceWithAdditionalRemoteWithNewVersionOrNewRe
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
 	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
@@ -1591,7 +2365,7 @@ func TestMarkRunOnGitHubClonedWorkspaceWithWithMultipleTagNamesAndNewVersionOrNe
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
 	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
@@ -1729,7 +2503,7 @@ func TestMarkRunOnGitHubClonedWorkspaceWithAdditionalRemoteWithNewVersionOrNewRe
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), nil, nil, utl.PointerToString(os.Getenv("gitHubTestUserPrivateKeyWithoutPassphrase")), nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), nil, nil, utl.PointerToString(os.Getenv("gitHubTestUserPrivateKeyWithoutPassphrase")), nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
 	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
@@ -1812,7 +2586,7 @@ func TestMarkRunOnGitHubClonedWorkspaceWithAdditionalRemoteWithNewVersionOrNewRe
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), nil, nil, utl.PointerToString(os.Getenv("gitHubTestUserPrivateKeyWithoutPassphrase")), utl.PointerToString(os.Getenv("gitHubTestUserPrivateKeyPassphrase"))),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), nil, nil, utl.PointerToString(os.Getenv("gitHubTestUserPrivateKeyWithoutPassphrase")), utl.PointerToString(os.Getenv("gitHubTestUserPrivateKeyPassphrase")), nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
 	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
@@ -1895,7 +2669,7 @@ func TestMarkRunOnGitLabClonedWorkspaceWithAdditionalRemoteWithNewVersionOrNewRe
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
 	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
@@ -1975,7 +2749,7 @@ func TestMarkRunOnGitLabClonedWorkspaceWithWithMultipleTagNamesAndNewVersionOrNe
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
 	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
@@ -2113,7 +2887,7 @@ func TestMarkRunOnGitLabClonedWorkspaceWithAdditionalRemoteWithNewVersionOrNewRe
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), nil, nil, utl.PointerToString(os.Getenv("gitLabTestUserPrivateKeyWithoutPassphrase")), nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), nil, nil, utl.PointerToString(os.Getenv("gitLabTestUserPrivateKeyWithoutPassphrase")), nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
 	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
@@ -2196,7 +2970,7 @@ func TestMarkRunOnGitLabClonedWorkspaceWithAdditionalRemoteWithNewVersionOrNewRe
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), nil, nil, utl.PointerToString(os.Getenv("gitLabTestUserPrivateKeyWithoutPassphrase")), utl.PointerToString(os.Getenv("gitLabTestUserPrivateKeyPassphrase"))),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.PUBLIC_KEY), nil, nil, utl.PointerToString(os.Getenv("gitLabTestUserPrivateKeyWithoutPassphrase")), utl.PointerToString(os.Getenv("gitLabTestUserPrivateKeyPassphrase")), nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a mock convention that accepts all non nil messages and dumps the minor identifier for each
 	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
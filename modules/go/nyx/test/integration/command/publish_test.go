@@ -204,7 +204,7 @@ func TestPublishRunWithNewReleaseAndGlobalAssetsOnGitHubRepository(t *testing.T)
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a custom release type that always enables committing, tagging and pushing
 	// and all the publishing service enabled
@@ -286,6 +286,109 @@ func TestPublishRunWithNewReleaseAndGlobalAssetsOnGitHubRepository(t *testing.T)
 	log.SetLevel(logLevel) // restore the original logging level
 }
 
+func TestPublishRunWithNewReleaseAndArchivedAssetsOnGitHubRepository(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	randomID := gitutil.RandomAlphabeticString(5, 201)
+	// the 'gitHubTestUserToken' system property is set by the build script, which in turn reads it from an environment variable
+	assert.NotEmpty(t, os.Getenv("gitHubTestUserToken"), "A GitHub authentication token must be passed to this test as an environment variable but it was not set")
+	gitHub, err := github.Instance(map[string]string{github.AUTHENTICATION_TOKEN_OPTION_NAME: os.Getenv("gitHubTestUserToken")})
+	assert.NoError(t, err)
+	user, err := gitHub.GetAuthenticatedUser()
+	assert.NoError(t, err)
+	gitHubRepository, err := gitHub.CreateGitRepository(randomID, utl.PointerToString("Test repository "+randomID), false, true)
+	assert.NoError(t, err)
+
+	// create one directory to archive for each platform
+	distDirectory, _ := os.MkdirTemp("", "nyx-test-github-release-test-dist-")
+	defer os.RemoveAll(distDirectory)
+	for _, platform := range []string{"linux/amd64", "darwin/arm64"} {
+		os.MkdirAll(filepath.Join(distDirectory, platform), 0700)
+		os.WriteFile(filepath.Join(distDirectory, platform, "tool"), []byte(platform), 0700)
+	}
+
+	// if we clone too quickly next calls may fail
+	time.Sleep(4000 * time.Millisecond)
+
+	script := gittools.ONE_BRANCH_SHORT().ApplyOnCloneFromWithUserNameAndPassword((*gitHubRepository).GetHTTPURL(), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""))
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.PushWithUserNameAndPassword(utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""))
+
+	configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+	// add an archived release asset for two platforms, using templates for the platform dependent options
+	archivedAsset := ent.NewAttachmentWith(utl.PointerToString("tool-{{version}}-{{@os}}-{{@arch}}.tar.gz"), utl.PointerToString("Tool for {{@os}}/{{@arch}}"), utl.PointerToString(filepath.Join(distDirectory, "{{@os}}", "{{@arch}}")), nil)
+	archivedAsset.SetArchive(utl.PointerToString("tar.gz"))
+	archivedAsset.SetPlatforms(utl.PointerToString("linux/amd64, darwin/arm64"))
+	configurationLayerMock.SetReleaseAssets(&map[string]*ent.Attachment{
+		"tool": archivedAsset,
+	})
+
+	// add a mock convention that accepts all non nil messages and dumps the major identifier for each
+	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+		&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+			&map[string]string{"major": ".*"})})
+	configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+	// add the test publishing service
+	configurationLayerMock.SetServices(&map[string]*ent.ServiceConfiguration{
+		"github": ent.NewServiceConfigurationWith(ent.PointerToProvider(ent.GITHUB),
+			&map[string]string{
+				github.AUTHENTICATION_TOKEN_OPTION_NAME: os.Getenv("gitHubTestUserToken"),
+				github.REPOSITORY_NAME_OPTION_NAME:      (*gitHubRepository).GetName(),
+				github.REPOSITORY_OWNER_OPTION_NAME:     (*user).GetUserName(),
+			}),
+	})
+	// set up the Git remote credentials
+	gitConfiguration, _ := configurationLayerMock.GetGit()
+	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil, nil, nil, nil, nil, nil, nil, nil),
+	})
+	// add a custom release type that always enables committing, tagging and pushing
+	// and all the publishing service enabled
+	releaseType := ent.NewReleaseType()
+	releaseType.SetGitCommit(utl.PointerToString("true"))
+	releaseType.SetGitPush(utl.PointerToString("true"))
+	releaseType.SetGitTag(utl.PointerToString("true"))
+	releaseType.SetPublish(utl.PointerToString("true"))
+	releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")},
+		&[]*string{utl.PointerToString("github")}, &[]*string{},
+		&map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+	configurationLayerMock.SetReleaseTypes(releaseTypes)
+
+	nyx := nyx.NewNyxIn(script.GetWorkingDirectory())
+	nyxConfiguration, _ := nyx.Configuration()
+	var configurationLayer cnf.ConfigurationLayer
+	configurationLayer = configurationLayerMock
+	nyxConfiguration.WithRuntimeConfiguration(&configurationLayer)
+
+	state, err := nyx.Publish()
+	assert.NoError(t, err)
+
+	// one asset must have been published for each platform, with the archive type inferred from the format
+	releaseAssets, _ := state.GetReleaseAssets()
+	assert.Equal(t, 2, len(*releaseAssets))
+	assert.Equal(t, "tool-1.0.0-linux-amd64.tar.gz", *(*releaseAssets)[0].GetFileName())
+	assert.Equal(t, "Tool for linux/amd64", *(*releaseAssets)[0].GetDescription())
+	assert.Equal(t, "application/gzip", *(*releaseAssets)[0].GetType())
+	assert.Equal(t, "tool-1.0.0-darwin-arm64.tar.gz", *(*releaseAssets)[1].GetFileName())
+
+	// if we read too quickly we often get a 404 from the server so let's wait a short while
+	time.Sleep(2000 * time.Millisecond)
+
+	// read the release from the hosting service
+	gitHubRelease, err := gitHub.GetReleaseByTag(utl.PointerToString((*user).GetUserName()), utl.PointerToString((*gitHubRepository).GetName()), "1.0.0")
+	assert.NoError(t, err)
+	assert.NotNil(t, gitHubRelease)
+	assert.Equal(t, 2, len((*gitHubRelease).GetAssets()))
+	for _, asset := range (*gitHubRelease).GetAssets() {
+		assert.True(t, *asset.GetFileName() == "tool-1.0.0-linux-amd64.tar.gz" || *asset.GetFileName() == "tool-1.0.0-darwin-arm64.tar.gz")
+	}
+
+	// now delete it
+	gitHub.DeleteGitRepository(randomID)
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
 func TestPublishRunWithNewReleaseWithCustomNameOnGitHubRepository(t *testing.T) {
 	logLevel := log.GetLevel()   // save the previous logging level
 	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
@@ -325,7 +428,7 @@ func TestPublishRunWithNewReleaseWithCustomNameOnGitHubRepository(t *testing.T)
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a custom release type that always enables committing, tagging and pushing
 	// and all the publishing service enabled
@@ -430,7 +533,7 @@ func TestPublishRunWithNewReleaseAndFilteredAssetsOnGitHubRepository(t *testing.
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a custom release type that always enables committing, tagging and pushing
 	// and all the publishing service enabled
@@ -552,7 +655,7 @@ func TestPublishRunWithNewReleaseWithDraftFlagOnGitHubRepository(t *testing.T) {
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a custom release type that always enables committing, tagging and pushing
 	// and all the publishing service enabled
@@ -632,7 +735,7 @@ func TestPublishRunWithNewReleaseWithPreReleaseFlagOnGitHubRepository(t *testing
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a custom release type that always enables committing, tagging and pushing
 	// and all the publishing service enabled
@@ -730,7 +833,7 @@ func TestPublishRunWithNewReleaseAndGlobalAssetsOnGitLabRepository(t *testing.T)
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a custom release type that always enables committing, tagging and pushing
 	// and all the publishing service enabled
@@ -849,7 +952,7 @@ func TestPublishRunWithNewReleaseWithCustomNameOnGitLabRepository(t *testing.T)
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a custom release type that always enables committing, tagging and pushing
 	// and all the publishing service enabled
@@ -956,7 +1059,7 @@ func TestPublishRunWithNewReleaseAndFilteredAssetsOnGitLabRepository(t *testing.
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a custom release type that always enables committing, tagging and pushing
 	// and all the publishing service enabled
@@ -1077,7 +1180,7 @@ func TestPublishRunWithNewReleaseWithDraftFlagOnGitLabRepository(t *testing.T) {
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a custom release type that always enables committing, tagging and pushing
 	// and all the publishing service enabled
@@ -1158,7 +1261,7 @@ func TestPublishRunWithNewReleaseWithPreReleaseFlagOnGitLabRepository(t *testing
 	// set up the Git remote credentials
 	gitConfiguration, _ := configurationLayerMock.GetGit()
 	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
-		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil),
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil, nil, nil, nil, nil, nil, nil, nil),
 	})
 	// add a custom release type that always enables committing, tagging and pushing
 	// and all the publishing service enabled
@@ -1196,3 +1299,177 @@ func TestPublishRunWithNewReleaseWithPreReleaseFlagOnGitLabRepository(t *testing
 
 	log.SetLevel(logLevel) // restore the original logging level
 }
+
+func TestPublishRunWithDownstreamUpdateOnGitHubRepository(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	randomID := gitutil.RandomAlphabeticString(5, 231)
+	// the 'gitHubTestUserToken' system property is set by the build script, which in turn reads it from an environment variable
+	assert.NotEmpty(t, os.Getenv("gitHubTestUserToken"), "A GitHub authentication token must be passed to this test as an environment variable but it was not set")
+	gitHub, err := github.Instance(map[string]string{github.AUTHENTICATION_TOKEN_OPTION_NAME: os.Getenv("gitHubTestUserToken")})
+	assert.NoError(t, err)
+	user, err := gitHub.GetAuthenticatedUser()
+	assert.NoError(t, err)
+	gitHubRepository, err := gitHub.CreateGitRepository(randomID, utl.PointerToString("Test repository "+randomID), false, true)
+	assert.NoError(t, err)
+
+	// if we clone too quickly next calls may fail
+	time.Sleep(4000 * time.Millisecond)
+
+	script := gittools.ONE_BRANCH_SHORT().ApplyOnCloneFromWithUserNameAndPassword((*gitHubRepository).GetHTTPURL(), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""))
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.PushWithUserNameAndPassword(utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""))
+
+	configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+
+	// add a mock convention that accepts all non nil messages and dumps the major identifier for each
+	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+		&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+			&map[string]string{"major": ".*"})})
+	configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+	// add the test publishing service
+	configurationLayerMock.SetServices(&map[string]*ent.ServiceConfiguration{
+		"github": ent.NewServiceConfigurationWith(ent.PointerToProvider(ent.GITHUB),
+			&map[string]string{
+				github.AUTHENTICATION_TOKEN_OPTION_NAME: os.Getenv("gitHubTestUserToken"),
+				github.REPOSITORY_NAME_OPTION_NAME:      (*gitHubRepository).GetName(),
+				github.REPOSITORY_OWNER_OPTION_NAME:     (*user).GetUserName(),
+			}),
+	})
+	// set up the Git remote credentials
+	gitConfiguration, _ := configurationLayerMock.GetGit()
+	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString(os.Getenv("gitHubTestUserToken")), utl.PointerToString(""), nil, nil, nil, nil, nil, nil, nil, nil, nil),
+	})
+	// add a custom release type that always enables committing, tagging and pushing
+	// and all the publishing service enabled
+	releaseType := ent.NewReleaseType()
+	releaseType.SetGitCommit(utl.PointerToString("true"))
+	releaseType.SetGitPush(utl.PointerToString("true"))
+	releaseType.SetGitTag(utl.PointerToString("true"))
+	releaseType.SetPublish(utl.PointerToString("true"))
+	releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")},
+		&[]*string{utl.PointerToString("github")}, &[]*string{},
+		&map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+	configurationLayerMock.SetReleaseTypes(releaseTypes)
+	// add the downstream update, using the same repository as the downstream one
+	downstreamUpdates, _ := ent.NewDownstreamUpdatesWith(&[]*string{utl.PointerToString("readme")},
+		&map[string]*ent.DownstreamUpdate{"readme": ent.NewDownstreamUpdateWith(nil, utl.PointerToString("(?m)^# (.*)$"), nil, utl.PointerToString("README.md"), utl.PointerToString("# $1 {{version}}"), nil, utl.PointerToString("github"), utl.PointerToString("Depend on {{version}}"))})
+	configurationLayerMock.SetDownstreamUpdates(downstreamUpdates)
+
+	nyx := nyx.NewNyxIn(script.GetWorkingDirectory())
+	nyxConfiguration, _ := nyx.Configuration()
+	var configurationLayer cnf.ConfigurationLayer
+	configurationLayer = configurationLayerMock
+	nyxConfiguration.WithRuntimeConfiguration(&configurationLayer)
+
+	state, err := nyx.Publish()
+	assert.NoError(t, err)
+
+	// if we read too quickly we often get a 404 from the server so let's wait a short while
+	time.Sleep(2000 * time.Millisecond)
+
+	// read the updated file from the pull request branch
+	content, err := gitHub.GetFileContent(utl.PointerToString((*user).GetUserName()), utl.PointerToString((*gitHubRepository).GetName()), utl.PointerToString("nyx/readme/1.0.0"), "README.md")
+	assert.NoError(t, err)
+
+	version, _ := state.GetVersion()
+	assert.Equal(t, "1.0.0", *version)
+	assert.Contains(t, content, "1.0.0")
+
+	// if we delete too quickly we often get a 404 from the server so let's wait a short while
+	time.Sleep(2000 * time.Millisecond)
+
+	// now delete it
+	gitHub.DeleteGitRepository(randomID)
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
+
+func TestPublishRunWithDownstreamUpdateOnGitLabRepository(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	randomID := gitutil.RandomAlphabeticString(5, 233)
+	// the 'gitLabTestUserToken' system property is set by the build script, which in turn reads it from an environment variable
+	assert.NotEmpty(t, os.Getenv("gitLabTestUserToken"), "A GitLab authentication token must be passed to this test as an environment variable but it was not set")
+	gitLab, err := gitlab.Instance(map[string]string{gitlab.AUTHENTICATION_TOKEN_OPTION_NAME: os.Getenv("gitLabTestUserToken")})
+	assert.NoError(t, err)
+	user, err := gitLab.GetAuthenticatedUser()
+	assert.NoError(t, err)
+	gitLabRepository, err := gitLab.CreateGitRepository(randomID, utl.PointerToString("Test repository "+randomID), false, true)
+	assert.NoError(t, err)
+
+	// if we clone too quickly next calls may fail
+	time.Sleep(4000 * time.Millisecond)
+
+	// when a token for user and password authentication for plain Git operations against a GitLab repository,
+	// the user is the "PRIVATE-TOKEN" string and the password is the token
+	script := gittools.ONE_BRANCH_SHORT().ApplyOnCloneFromWithUserNameAndPassword((*gitLabRepository).GetHTTPURL(), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")))
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.PushWithUserNameAndPassword(utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")))
+
+	configurationLayerMock := cnf.NewSimpleConfigurationLayer()
+
+	// add a mock convention that accepts all non nil messages and dumps the major identifier for each
+	commitMessageConventions, _ := ent.NewCommitMessageConventionsWith(&[]*string{utl.PointerToString("testConvention")},
+		&map[string]*ent.CommitMessageConvention{"testConvention": ent.NewCommitMessageConventionWith(utl.PointerToString(".*"),
+			&map[string]string{"major": ".*"})})
+	configurationLayerMock.SetCommitMessageConventions(commitMessageConventions)
+	// add the test publishing service
+	configurationLayerMock.SetServices(&map[string]*ent.ServiceConfiguration{
+		"gitlab": ent.NewServiceConfigurationWith(ent.PointerToProvider(ent.GITLAB),
+			&map[string]string{
+				gitlab.AUTHENTICATION_TOKEN_OPTION_NAME: os.Getenv("gitLabTestUserToken"),
+				gitlab.REPOSITORY_NAME_OPTION_NAME:      (*gitLabRepository).GetName(),
+				gitlab.REPOSITORY_OWNER_OPTION_NAME:     (*user).GetUserName(),
+			}),
+	})
+	// set up the Git remote credentials
+	gitConfiguration, _ := configurationLayerMock.GetGit()
+	gitConfiguration.SetRemotes(&map[string]*ent.GitRemoteConfiguration{
+		"origin": ent.NewGitRemoteConfigurationWith(ent.PointerToAuthenticationMethod(ent.USER_PASSWORD), utl.PointerToString("PRIVATE-TOKEN"), utl.PointerToString(os.Getenv("gitLabTestUserToken")), nil, nil, nil, nil, nil, nil, nil, nil, nil),
+	})
+	// add a custom release type that always enables committing, tagging and pushing
+	// and all the publishing service enabled
+	releaseType := ent.NewReleaseType()
+	releaseType.SetGitCommit(utl.PointerToString("true"))
+	releaseType.SetGitPush(utl.PointerToString("true"))
+	releaseType.SetGitTag(utl.PointerToString("true"))
+	releaseType.SetPublish(utl.PointerToString("true"))
+	releaseTypes, _ := ent.NewReleaseTypesWith(&[]*string{utl.PointerToString("testReleaseType")},
+		&[]*string{utl.PointerToString("gitlab")}, &[]*string{},
+		&map[string]*ent.ReleaseType{"testReleaseType": releaseType})
+	configurationLayerMock.SetReleaseTypes(releaseTypes)
+	// add the downstream update, using the same repository as the downstream one
+	downstreamUpdates, _ := ent.NewDownstreamUpdatesWith(&[]*string{utl.PointerToString("readme")},
+		&map[string]*ent.DownstreamUpdate{"readme": ent.NewDownstreamUpdateWith(nil, utl.PointerToString("(?m)^# (.*)$"), nil, utl.PointerToString("README.md"), utl.PointerToString("# $1 {{version}}"), nil, utl.PointerToString("gitlab"), utl.PointerToString("Depend on {{version}}"))})
+	configurationLayerMock.SetDownstreamUpdates(downstreamUpdates)
+
+	nyx := nyx.NewNyxIn(script.GetWorkingDirectory())
+	nyxConfiguration, _ := nyx.Configuration()
+	var configurationLayer cnf.ConfigurationLayer
+	configurationLayer = configurationLayerMock
+	nyxConfiguration.WithRuntimeConfiguration(&configurationLayer)
+
+	state, err := nyx.Publish()
+	assert.NoError(t, err)
+
+	// if we read too quickly we often get a 404 from the server so let's wait a short while
+	time.Sleep(2000 * time.Millisecond)
+
+	// read the updated file from the pull request branch
+	content, err := gitLab.GetFileContent(utl.PointerToString((*user).GetUserName()), utl.PointerToString((*gitLabRepository).GetName()), utl.PointerToString("nyx/readme/1.0.0"), "README.md")
+	assert.NoError(t, err)
+
+	version, _ := state.GetVersion()
+	assert.Equal(t, "1.0.0", *version)
+	assert.Contains(t, content, "1.0.0")
+
+	// if we delete too quickly we often get a 404 from the server so let's wait a short while
+	time.Sleep(2000 * time.Millisecond)
+
+	// now delete it
+	gitLab.DeleteGitRepository((*gitLabRepository).GetID())
+
+	log.SetLevel(logLevel) // restore the original logging level
+}
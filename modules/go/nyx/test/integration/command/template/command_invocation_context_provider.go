@@ -49,6 +49,12 @@ func newCommand(command cmd.Commands, directory string) *cmd.Command {
 
 	var res cmd.Command
 	switch command {
+	case cmd.ANALYTICS:
+		res, err = cmd.NewAnalytics(state, &repository)
+		if err != nil {
+			panic(err)
+		}
+		return &res
 	case cmd.CLEAN:
 		res, err = cmd.NewClean(state, &repository)
 		if err != nil {
@@ -73,6 +79,18 @@ func newCommand(command cmd.Commands, directory string) *cmd.Command {
 			panic(err)
 		}
 		return &res
+	case cmd.PREVIEW:
+		res, err = cmd.NewPreview(state, &repository)
+		if err != nil {
+			panic(err)
+		}
+		return &res
+	case cmd.PRUNE:
+		res, err = cmd.NewPrune(state, &repository)
+		if err != nil {
+			panic(err)
+		}
+		return &res
 	case cmd.PUBLISH:
 		res, err = cmd.NewPublish(state, &repository)
 		if err != nil {
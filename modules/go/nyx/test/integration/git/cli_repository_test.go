@@ -0,0 +1,1345 @@
+//go:build integration
+// +build integration
+
+// Only run these tests as part of the integration test suite, when the 'integration' build flag is passed (i.e. running go test --tags=integration)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git_test
+
+import (
+	"os"            // https://pkg.go.dev/os
+	"os/exec"       // https://pkg.go.dev/os/exec
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
+	"testing"       // https://pkg.go.dev/testing
+	"time"          // https://pkg.go.dev/time
+
+	ggit "github.com/go-git/go-git/v5"          // https://pkg.go.dev/github.com/go-git/go-git/v5
+	log "github.com/sirupsen/logrus"            // https://pkg.go.dev/github.com/sirupsen/logrus
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	clk "github.com/mooltiverse/nyx/modules/go/nyx/clock"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	. "github.com/mooltiverse/nyx/modules/go/nyx/git"
+	gittools "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/git/tools"
+	gitutil "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/git/util"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+Returns a Git instance using the CLI backend.
+*/
+func cliGitInstance(t *testing.T) Git {
+	gitInstance, err := GitInstanceWith(Options{Backend: CLI_BACKEND})
+	assert.NoError(t, err)
+	return gitInstance
+}
+
+/*
+Opens the repository in the given directory using the CLI backend.
+*/
+func openCLIRepository(t *testing.T, directory string) Repository {
+	repository, err := cliGitInstance(t).Open(directory)
+	assert.NoError(t, err)
+	return repository
+}
+
+/*
+Returns the directory of a new repository using the SHA-256 object format, with one commit. The caller is responsible
+for removing the directory.
+*/
+func newSHA256Repository(t *testing.T) string {
+	directory, err := os.MkdirTemp("", "nyx-test-cli-repository-")
+	assert.NoError(t, err)
+	out, err := exec.Command("git", "init", "--object-format=sha256", directory).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	assert.NoError(t, os.WriteFile(filepath.Join(directory, "README.md"), []byte("content"), 0644))
+	repository := openCLIRepository(t, directory)
+	assert.NoError(t, repository.Add([]string{"."}))
+	_, err = repository.CommitWithMessageAndIdentities(utl.PointerToString("Initial commit"), gitent.NewIdentityWith("Jim", "jim@example.com"), gitent.NewIdentityWith("Jim", "jim@example.com"))
+	assert.NoError(t, err)
+	return directory
+}
+
+/*
+Returns all the commits in the history of the given repository.
+*/
+func walkAllCommits(t *testing.T, repository Repository) []gitent.Commit {
+	var visitedCommits []gitent.Commit
+	err := repository.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+	return visitedCommits
+}
+
+func TestCLIRepositoryGitInstanceWithBackend(t *testing.T) {
+	_, err := GitInstanceWith(Options{Backend: CLI_BACKEND})
+	assert.NoError(t, err)
+	_, err = GitInstanceWith(Options{Backend: GO_GIT_BACKEND})
+	assert.NoError(t, err)
+	_, err = GitInstanceWith(Options{Backend: ""})
+	assert.NoError(t, err)
+	_, err = GitInstanceWith(Options{Backend: "unknown"})
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IllegalArgumentError{}, err)
+}
+
+func TestCLIRepositoryOpenErrorWithEmptyDirectory(t *testing.T) {
+	gitInstance := cliGitInstance(t)
+	_, err := gitInstance.Open("")
+	assert.Error(t, err)
+	_, err = gitInstance.Open("  ")
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryOpenErrorWithNewEmptyDirectory(t *testing.T) {
+	gitInstance := cliGitInstance(t)
+	directory, err := os.MkdirTemp("", "nyx-test-cli-repository-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	_, err = gitInstance.Open(directory)
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IllegalArgumentError{}, err)
+}
+
+func TestCLIRepositoryGetHashAlgorithm(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+	hashAlgorithm, err := repository.GetHashAlgorithm()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.SHA1, hashAlgorithm)
+
+	directory := newSHA256Repository(t)
+	defer os.RemoveAll(directory)
+	repository = openCLIRepository(t, directory)
+	hashAlgorithm, err = repository.GetHashAlgorithm()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.SHA256, hashAlgorithm)
+
+	// full and abbreviated identifiers are both resolved
+	latestCommit, err := repository.GetLatestCommit()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.SHA256.GetHexLength(), len(latestCommit))
+	commits := walkAllCommits(t, repository)
+	assert.Equal(t, 1, len(commits))
+	assert.Equal(t, latestCommit, commits[0].GetSHA())
+	abbreviated := latestCommit[0:12]
+	err = repository.WalkHistory(&abbreviated, nil, func(commit gitent.Commit) bool {
+		assert.Equal(t, latestCommit, commit.GetSHA())
+		return true
+	})
+	assert.NoError(t, err)
+}
+
+func TestCLIRepositoryWalkHistoryReturnsTheSameCommitsAsGoGit(t *testing.T) {
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	message := "Annotated"
+	script.AndTag("2.0.0", &message)
+	goGitRepository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	goGitCommits := walkAllCommits(t, goGitRepository)
+
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+	commits := walkAllCommits(t, repository)
+	assert.Equal(t, 10, len(commits))
+	assert.Equal(t, goGitCommits, commits)
+
+	rootCommit, err := repository.GetRootCommit()
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetRootCommitID(), rootCommit)
+	latestCommit, err := repository.GetLatestCommit()
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommitID(), latestCommit)
+	currentBranch, err := repository.GetCurrentBranch()
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetCurrentBranch(), currentBranch)
+}
+
+func TestCLIRepositoryWalkHistoryInReverseReturnsTheSameCommitsAsGoGit(t *testing.T) {
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	goGitRepository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	var goGitCommits []gitent.Commit
+	err = goGitRepository.WalkHistoryInReverse(nil, nil, func(commit gitent.Commit) bool {
+		goGitCommits = append(goGitCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+	var commits []gitent.Commit
+	err = repository.WalkHistoryInReverse(nil, nil, func(commit gitent.Commit) bool {
+		commits = append(commits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 10, len(commits))
+	assert.Equal(t, goGitCommits, commits)
+	assert.Equal(t, 0, len(commits[0].GetParents()))
+}
+
+func TestCLIRepositoryWalkHistoryWithDateBoundariesReturnsTheSameCommitsAsGoGit(t *testing.T) {
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	goGitRepository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+	commits := walkAllCommits(t, repository)
+	boundary := commits[len(commits)/2].Date
+
+	for _, dates := range [][]*int64{{&boundary, nil}, {nil, &boundary}} {
+		var goGitCommits []gitent.Commit
+		err = goGitRepository.WalkHistoryBetweenDates(nil, nil, dates[0], dates[1], func(commit gitent.Commit) bool {
+			goGitCommits = append(goGitCommits, commit)
+			return true
+		})
+		assert.NoError(t, err)
+		var cliCommits []gitent.Commit
+		err = repository.WalkHistoryBetweenDates(nil, nil, dates[0], dates[1], func(commit gitent.Commit) bool {
+			cliCommits = append(cliCommits, commit)
+			return true
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, cliCommits)
+		assert.Equal(t, goGitCommits, cliCommits)
+	}
+}
+
+func TestCLIRepositoryWalkHistoryWithAllParentsReturnsTheSameCommitsAsGoGit(t *testing.T) {
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	goGitRepository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	var goGitCommits []gitent.Commit
+	err = goGitRepository.WalkHistoryWithAllParents(nil, nil, func(commit gitent.Commit) bool {
+		goGitCommits = append(goGitCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+	var commits []gitent.Commit
+	err = repository.WalkHistoryWithAllParents(nil, nil, func(commit gitent.Commit) bool {
+		commits = append(commits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+	// merged commits are visited along with the 10 commits in the first parent history, in the same order
+	assert.Equal(t, 14, len(commits))
+	assert.Equal(t, goGitCommits, commits)
+}
+
+func TestCLIRepositoryWalkHistoryWithAllParentsAndEndBoundaryReturnsTheSameCommitsAsGoGit(t *testing.T) {
+	script, end := realizeMainlineMergedIntoFeature()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	goGitRepository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	for _, boundary := range []*string{nil, &end} {
+		var goGitCommits []gitent.Commit
+		err = goGitRepository.WalkHistoryWithAllParents(nil, boundary, func(commit gitent.Commit) bool {
+			goGitCommits = append(goGitCommits, commit)
+			return true
+		})
+		assert.NoError(t, err)
+		var commits []gitent.Commit
+		err = repository.WalkHistoryWithAllParents(nil, boundary, func(commit gitent.Commit) bool {
+			commits = append(commits, commit)
+			return true
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, goGitCommits, commits)
+	}
+}
+
+func TestCLIRepositoryCountCommitsBetweenReturnsTheSameCountAsGoGit(t *testing.T) {
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	goGitRepository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+	commits := walkAllCommits(t, repository)
+
+	for _, from := range []*string{nil, utl.PointerToString(commits[1].GetSHA()), utl.PointerToString(commits[len(commits)-1].GetSHA()[0:7])} {
+		goGitCount, err := goGitRepository.CountCommitsBetween(from, nil)
+		assert.NoError(t, err)
+		count, err := repository.CountCommitsBetween(from, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, goGitCount, count)
+	}
+	count, err := repository.CountCommitsBetween(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 14, count)
+
+	_, err = repository.CountCommitsBetween(utl.PointerToString("0000000000000000000000000000000000000000"), nil)
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryWalkHistoryWithBoundaries(t *testing.T) {
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+	commits := walkAllCommits(t, repository)
+
+	// start from the second commit and end at the fourth, using abbreviated identifiers
+	start := commits[1].GetSHA()[0:7]
+	end := commits[3].GetSHA()[0:7]
+	var visitedCommits []gitent.Commit
+	err := repository.WalkHistory(&start, &end, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commits[1:4], visitedCommits)
+
+	// the visitor can stop the walk
+	visitedCommits = []gitent.Commit{}
+	err = repository.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return len(visitedCommits) < 2
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(visitedCommits))
+
+	// unresolved boundaries are errors
+	unresolved := "999999"
+	err = repository.WalkHistory(&unresolved, nil, func(commit gitent.Commit) bool { return true })
+	assert.Error(t, err)
+	err = repository.WalkHistory(nil, &unresolved, func(commit gitent.Commit) bool { return true })
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryWalkHistoryErrorWithRepositoryWithNoCommits(t *testing.T) {
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	err := repository.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		return true
+	})
+	assert.Error(t, err)
+	_, err = repository.GetCurrentBranch()
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryWalkHistoryWithShallowRepository(t *testing.T) {
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// push the history to a bare remote and make a shallow clone of it, with the latest commit only
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	script.PushTo("origin")
+	cloneDirectory, err := os.MkdirTemp("", "nyx-test-shallow-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(cloneDirectory)
+	_, err = ggit.PlainClone(cloneDirectory, false, &ggit.CloneOptions{URL: remoteScript.GetWorkingDirectory(), Depth: 1})
+	assert.NoError(t, err)
+
+	repository := openCLIRepository(t, cloneDirectory)
+	shallow, err := repository.IsShallow()
+	assert.NoError(t, err)
+	assert.True(t, shallow)
+
+	// walking the history stops at the shallow boundary with a specific error
+	var visitedCommits []gitent.Commit
+	err = repository.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.Error(t, err)
+	assert.IsType(t, &errs.ShallowRepositoryError{}, err)
+	assert.Equal(t, 1, len(visitedCommits))
+	// the boundary commit still reports its parents
+	assert.Equal(t, 1, len(visitedCommits[0].GetParents()))
+
+	// after unshallowing the whole history is available, along with tags
+	fetchedRemote, err := repository.UnshallowFromRemoteWithUserNameAndPassword(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", fetchedRemote)
+	shallow, err = repository.IsShallow()
+	assert.NoError(t, err)
+	assert.False(t, shallow)
+	assert.Equal(t, 7, len(walkAllCommits(t, repository)))
+	tags, err := repository.GetTags()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(tags))
+}
+
+func TestCLIRepositoryAddAndCommit(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	// errors with nil or empty arguments
+	assert.Error(t, repository.Add(nil))
+	assert.Error(t, repository.Add([]string{}))
+	_, err := repository.CommitWithMessage(nil)
+	assert.Error(t, err)
+
+	// the message is kept verbatim
+	prevLastCommit := script.GetLastCommit()
+	script.AddRandomTextWorkbenchFiles(1)
+	msg := "A message\n\n# not a comment\n"
+	commit, err := repository.CommitPathsWithMessage([]string{"."}, &msg)
+	assert.NoError(t, err)
+	assert.NotEqual(t, prevLastCommit.Hash.String(), script.GetLastCommit().Hash.String())
+	assert.Equal(t, script.GetLastCommit().Hash.String(), commit.GetSHA())
+	assert.Equal(t, script.GetLastCommit().Message, commit.GetMessage().GetFullMessage())
+	assert.Equal(t, msg, commit.GetMessage().GetFullMessage())
+	assert.Equal(t, []string{prevLastCommit.Hash.String()}, commit.GetParents())
+	clean, err := repository.IsClean()
+	assert.NoError(t, err)
+	assert.True(t, clean)
+
+	// identities and dates are set as given, the committer defaults to the author
+	assert.NoError(t, os.WriteFile(filepath.Join(script.GetWorkingDirectory(), "file.txt"), []byte("content\n"), 0644))
+	instant := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	clk.SetClock(clk.NewFixedClock(instant))
+	defer clk.SetClock(nil)
+	author := gitent.NewIdentityWith("Jim", "jim@example.com")
+	commit, err = repository.CommitPathsWithMessageAndIdentities([]string{"file.txt"}, utl.PointerToString("Another message"), author, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, *author, commit.GetAuthorAction().GetIdentity())
+	assert.Equal(t, *author, commit.GetCommitAction().GetIdentity())
+	assert.Equal(t, instant.UnixMilli(), commit.GetDate())
+	assert.Equal(t, "Jim", script.GetLastCommit().Author.Name)
+	assert.Equal(t, "jim@example.com", script.GetLastCommit().Committer.Email)
+
+	committer := gitent.NewIdentityWith("Sam", "sam@example.com")
+	commit, err = repository.CommitWithMessageAndIdentities(utl.PointerToString("Empty commit"), author, committer)
+	assert.NoError(t, err)
+	assert.Equal(t, *author, commit.GetAuthorAction().GetIdentity())
+	assert.Equal(t, *committer, commit.GetCommitAction().GetIdentity())
+}
+
+func TestCLIRepositoryCommitRunsHooks(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	// unlike go-git, the CLI backend honors the hooks configured for the repository
+	hook := filepath.Join(script.GetGitDirectory(), "hooks", "commit-msg")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(hook), 0755))
+	assert.NoError(t, os.WriteFile(hook, []byte("#!/bin/sh\necho 'Signed-off-by: Hook <hook@example.com>' >> \"$1\"\n"), 0755))
+
+	commit, err := repository.CommitWithMessage(utl.PointerToString("A message\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "A message\nSigned-off-by: Hook <hook@example.com>\n", commit.GetMessage().GetFullMessage())
+}
+
+func TestCLIRepositoryTag(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.ErrorLevel) // set the logging level to filter out warnings produced during tests
+	defer log.SetLevel(logLevel) // restore the original logging level
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+	latestCommit, err := repository.GetLatestCommit()
+	assert.NoError(t, err)
+
+	// make sure an error is thrown when the tag name is nil
+	_, err = repository.Tag(nil)
+	assert.Error(t, err)
+
+	lTag, err := repository.Tag(utl.PointerToString("ltag"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ltag", lTag.GetName())
+	assert.Equal(t, latestCommit, lTag.GetTarget())
+	assert.False(t, lTag.IsAnnotated())
+	assert.Equal(t, latestCommit, script.GetTags()["ltag"])
+
+	// duplicated tags are errors unless forced
+	_, err = repository.Tag(utl.PointerToString("ltag"))
+	assert.Error(t, err)
+
+	tagger := gitent.NewIdentityWith("Jim", "jim@example.com")
+	aTag, err := repository.TagWithMessageAndIdentity(utl.PointerToString("atag"), utl.PointerToString("Tag message"), tagger)
+	assert.NoError(t, err)
+	assert.Equal(t, "atag", aTag.GetName())
+	assert.Equal(t, latestCommit, aTag.GetTarget())
+	assert.True(t, aTag.IsAnnotated())
+	assert.Equal(t, latestCommit, *script.GetCommitByTag("atag"))
+	assert.Equal(t, "Tag message", aTag.GetMessage().GetShortMessage())
+	assert.Equal(t, "Jim", aTag.GetTagger().GetIdentity().GetName())
+	assert.Equal(t, "jim@example.com", aTag.GetTagger().GetIdentity().GetEmail())
+	assert.True(t, aTag.GetTagger().GetTimeStamp().GetTimeStamp() > 0)
+	assert.Nil(t, lTag.GetMessage())
+	assert.Nil(t, lTag.GetTagger())
+
+	// tag an older commit, replacing the existing tag
+	script.AndAddFiles().AndStage().AndCommit()
+	fTag, err := repository.TagCommitWithMessageAndIdentityAndForce(&latestCommit, utl.PointerToString("atag"), utl.PointerToString("Another message"), nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, latestCommit, fTag.GetTarget())
+	tags, err := repository.GetCommitTags(latestCommit)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tags))
+	tags, err = repository.GetCommitTags(script.GetLastCommitID())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(tags))
+
+	// multi-line messages are preserved when listing tags
+	_, err = repository.TagWithMessageAndIdentity(utl.PointerToString("mtag"), utl.PointerToString("Tag header\n\nTag body\n\nIssue: 123"), tagger)
+	assert.NoError(t, err)
+	allTags, err := repository.GetTags()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(allTags))
+	for _, tag := range allTags {
+		if "mtag" == tag.GetName() {
+			assert.Equal(t, "Tag header", tag.GetMessage().GetShortMessage())
+			assert.Contains(t, tag.GetMessage().GetFullMessage(), "Tag body")
+			assert.Equal(t, "123", tag.GetMessage().GetFooters()["Issue"])
+		}
+	}
+}
+
+func TestCLIRepositoryGetCommitChangedPathsAndPatchID(t *testing.T) {
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository := openCLIRepository(t, dir)
+
+	// the root commit returns all of its files
+	script.AndAddFiles().AndStage()
+	rootCommit := script.Commit("A message")
+	paths, err := repository.GetCommitChangedPaths(rootCommit.Hash.String())
+	assert.NoError(t, err)
+	assert.Equal(t, len(script.GetFiles()), len(paths))
+	rootPatchID, err := repository.GetCommitPatchID(rootCommit.Hash.String())
+	assert.NoError(t, err)
+	assert.NotEqual(t, "", rootPatchID)
+
+	// apply the same change in two branches, with different whitespaces and messages
+	script.InBranch("maintenance")
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "fix.txt"), []byte("a fix\n"), 0644))
+	script.AndStage()
+	maintenanceCommit := script.Commit("fix: a fix")
+	script.InBranch("master")
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "fix.txt"), []byte("a  fix \n"), 0644))
+	script.AndStage()
+	masterCommit := script.Commit("fix: a fix (cherry picked)")
+	paths, err = repository.GetCommitChangedPaths(masterCommit.Hash.String())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fix.txt"}, paths)
+	maintenancePatchID, err := repository.GetCommitPatchID(maintenanceCommit.Hash.String())
+	assert.NoError(t, err)
+	masterPatchID, err := repository.GetCommitPatchID(masterCommit.Hash.String())
+	assert.NoError(t, err)
+	assert.Equal(t, maintenancePatchID, masterPatchID)
+	assert.NotEqual(t, rootPatchID, masterPatchID)
+
+	// an unknown commit yields an error
+	_, err = repository.GetCommitChangedPaths("0000000000000000000000000000000000000000")
+	assert.Error(t, err)
+	_, err = repository.GetCommitPatchID("0000000000000000000000000000000000000000")
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryGetCommitChangesReturnsTheSameChangesAsGoGit(t *testing.T) {
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	goGitRepository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+	repository := openCLIRepository(t, dir)
+
+	script.AndAddFiles().AndStage()
+	rootCommit := script.Commit("A message")
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("one\ntwo\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "image.bin"), []byte{0, 1, 2, 0}, 0644))
+	script.AndStage()
+	commit := script.Commit("Another message")
+
+	for _, sha := range []string{rootCommit.Hash.String(), commit.Hash.String()} {
+		goGitChanges, err := goGitRepository.GetCommitChanges(sha)
+		assert.NoError(t, err)
+		changes, err := repository.GetCommitChanges(sha)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, changes)
+		assert.ElementsMatch(t, goGitChanges, changes)
+	}
+	changes, err := repository.GetCommitChanges(commit.Hash.String())
+	assert.NoError(t, err)
+	// binary files have no lines
+	assert.ElementsMatch(t, []gitent.FileChange{*gitent.NewFileChangeWith("notes.txt", 2, 0), *gitent.NewFileChangeWith("image.bin", 0, 0)}, changes)
+
+	_, err = repository.GetCommitChanges("0000000000000000000000000000000000000000")
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryGetCommitFileContent(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository := openCLIRepository(t, dir)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "docs"), os.ModePerm))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "UPGRADING.md"), []byte("first notes\n"), 0644))
+	script.AndStage()
+	firstCommit := script.Commit("docs: first notes")
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "UPGRADING.md"), []byte("second notes\n"), 0644))
+	script.AndStage()
+	secondCommit := script.Commit("docs: second notes")
+	// uncommitted changes are ignored
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "UPGRADING.md"), []byte("uncommitted notes\n"), 0644))
+
+	content, err := repository.GetCommitFileContent(firstCommit.Hash.String(), "docs/UPGRADING.md", 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, "first notes\n", content)
+	content, err = repository.GetCommitFileContent(secondCommit.Hash.String()[:7], "docs/UPGRADING.md", 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, "second notes\n", content)
+
+	// files larger than the maximum size are not read
+	_, err = repository.GetCommitFileContent(secondCommit.Hash.String(), "docs/UPGRADING.md", 5)
+	assert.Error(t, err)
+	// missing files, directories and unknown commits yield an error
+	_, err = repository.GetCommitFileContent(secondCommit.Hash.String(), "docs/MISSING.md", 1024)
+	assert.Error(t, err)
+	_, err = repository.GetCommitFileContent(secondCommit.Hash.String(), "docs", 1024)
+	assert.Error(t, err)
+	_, err = repository.GetCommitFileContent("0000000000000000000000000000000000000000", "docs/UPGRADING.md", 1024)
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryVerifySignatures(t *testing.T) {
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	signKey, publicKey := gitutil.NewPGPKey("John Doe", "jdoe@example.com")
+	_, otherPublicKey := gitutil.NewPGPKey("Jane Doe", "jane@example.com")
+
+	script.AndAddFiles().AndStage()
+	unsignedCommit := script.Commit("An unsigned commit")
+	script.AndAddFiles().AndStage()
+	signedCommit := script.SignedCommit("A signed commit", signKey)
+	script.Tag("lightweight", nil)
+	script.Tag("annotated", utl.PointerToString("An unsigned tag"))
+	script.SignedTag("signed", "A signed tag", signKey)
+
+	// unsigned objects are never valid
+	signature, err := repository.VerifyCommitSignature(unsignedCommit.Hash.String(), &publicKey)
+	assert.NoError(t, err)
+	assert.False(t, signature.IsSigned())
+	assert.False(t, signature.IsValid())
+	signature, err = repository.VerifyTagSignature("lightweight", &publicKey)
+	assert.NoError(t, err)
+	assert.False(t, signature.IsSigned())
+	signature, err = repository.VerifyTagSignature("annotated", &publicKey)
+	assert.NoError(t, err)
+	assert.False(t, signature.IsSigned())
+
+	// signed objects are valid when verified with the signing key
+	signature, err = repository.VerifyCommitSignature(signedCommit.Hash.String(), &publicKey)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsSigned())
+	assert.True(t, signature.IsValid())
+	assert.Equal(t, "John Doe <jdoe@example.com>", signature.GetSigner())
+	signature, err = repository.VerifyTagSignature("signed", &publicKey)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsSigned())
+	assert.True(t, signature.IsValid())
+
+	// and are not valid when verified with other keys
+	signature, err = repository.VerifyCommitSignature(signedCommit.Hash.String(), &otherPublicKey)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsSigned())
+	assert.False(t, signature.IsValid())
+	signature, err = repository.VerifyTagSignature("signed", &otherPublicKey)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsSigned())
+	assert.False(t, signature.IsValid())
+
+	// unknown objects yield an error
+	_, err = repository.VerifyCommitSignature("0000000000000000000000000000000000000000", &publicKey)
+	assert.Error(t, err)
+	_, err = repository.VerifyTagSignature("missing", &publicKey)
+	assert.Error(t, err)
+}
+
+func TestCLIRepositorySnapshotAndRestore(t *testing.T) {
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository := openCLIRepository(t, dir)
+
+	// stage a new file and leave other changes unstaged
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "staged.txt"), []byte("staged\n"), 0644))
+	assert.NoError(t, repository.Add([]string{"staged.txt"}))
+	script.AndUpdateFiles()
+	latestCommit := script.GetLastCommitID()
+	tags := script.GetTags()
+
+	worktree, err := script.Repository.Worktree()
+	assert.NoError(t, err)
+	status, err := worktree.Status()
+	assert.NoError(t, err)
+	snapshot, err := repository.Snapshot()
+	assert.NoError(t, err)
+
+	// commit everything, add a new tag and move an existing one
+	assert.NoError(t, repository.Add([]string{"."}))
+	_, err = repository.CommitWithMessage(utl.PointerToString("Release"))
+	assert.NoError(t, err)
+	_, err = repository.TagWithMessage(utl.PointerToString("1.0.0"), utl.PointerToString("Release 1.0.0"))
+	assert.NoError(t, err)
+	_, err = repository.TagWithMessageAndForce(utl.PointerToString("0.0.4"), nil, true)
+	assert.NoError(t, err)
+	assert.NotEqual(t, latestCommit, script.GetLastCommitID())
+	assert.NotEqual(t, tags, script.GetTags())
+
+	assert.NoError(t, snapshot.Restore())
+	assert.Equal(t, latestCommit, script.GetLastCommitID())
+	assert.Equal(t, tags, script.GetTags())
+	// staged and unstaged changes are back as they were
+	restoredStatus, err := worktree.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, status, restoredStatus)
+	assert.Equal(t, ggit.Added, restoredStatus.File("staged.txt").Staging)
+}
+
+func TestCLIRepositorySnapshotWithPathsAndRestore(t *testing.T) {
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository := openCLIRepository(t, dir)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "existing.txt"), []byte("existing\n"), 0600))
+	latestCommit := script.GetLastCommitID()
+
+	snapshot, err := repository.SnapshotWithPaths([]string{"existing.txt", filepath.Join(dir, "created.json")})
+	assert.NoError(t, err)
+
+	// change the existing file, create the new one and commit them both
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "existing.txt"), []byte("changed\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "created.json"), []byte("{}\n"), 0644))
+	assert.NoError(t, repository.Add([]string{"existing.txt", "created.json"}))
+	_, err = repository.CommitWithMessage(utl.PointerToString("Release"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, snapshot.Restore())
+	assert.Equal(t, latestCommit, script.GetLastCommitID())
+	content, err := os.ReadFile(filepath.Join(dir, "existing.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "existing\n", string(content))
+	info, err := os.Stat(filepath.Join(dir, "existing.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	_, err = os.Stat(filepath.Join(dir, "created.json"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCLIRepositorySnapshotAndRestoreInRepositoryWithNoCommits(t *testing.T) {
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	snapshot, err := repository.Snapshot()
+	assert.NoError(t, err)
+	script.AndAddFiles().AndStage().AndCommit()
+	_, err = repository.GetLatestCommit()
+	assert.NoError(t, err)
+
+	assert.NoError(t, snapshot.Restore())
+	_, err = repository.GetLatestCommit()
+	assert.Error(t, err)
+	clean, err := repository.IsClean()
+	assert.NoError(t, err)
+	assert.False(t, clean)
+}
+
+func TestCLIRepositoryStashAndStashPop(t *testing.T) {
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository := openCLIRepository(t, dir)
+
+	// there is nothing to stash in a clean repository
+	stashed, err := repository.Stash(nil)
+	assert.NoError(t, err)
+	assert.False(t, stashed)
+
+	// stage a new file, leave other changes unstaged and add an untracked file to exclude from the stash
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "staged.txt"), []byte("staged\n"), 0644))
+	assert.NoError(t, repository.Add([]string{"staged.txt"}))
+	script.AndUpdateFiles()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "excluded.txt"), []byte("excluded\n"), 0644))
+
+	worktree, err := script.Repository.Worktree()
+	assert.NoError(t, err)
+	status, err := worktree.Status()
+	assert.NoError(t, err)
+
+	stashed, err = repository.Stash([]string{filepath.Join(dir, "excluded.txt")})
+	assert.NoError(t, err)
+	assert.True(t, stashed)
+	// only the excluded file is left in the working tree
+	stashedStatus, err := worktree.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(stashedStatus))
+	assert.True(t, stashedStatus.IsUntracked("excluded.txt"))
+	stashed, err = repository.Stash([]string{"excluded.txt"})
+	assert.NoError(t, err)
+	assert.False(t, stashed)
+
+	// staged and unstaged changes are back as they were
+	assert.NoError(t, repository.StashPop())
+	restoredStatus, err := worktree.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, status, restoredStatus)
+	assert.Equal(t, ggit.Added, restoredStatus.File("staged.txt").Staging)
+
+	// there is nothing left to restore
+	assert.Error(t, repository.StashPop())
+}
+
+func TestCLIRepositoryIsBareAndIsClean(t *testing.T) {
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// push the history to a bare remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	script.PushTo("origin")
+
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+	bare, err := repository.IsBare()
+	assert.NoError(t, err)
+	assert.False(t, bare)
+	clean, err := repository.IsClean()
+	assert.NoError(t, err)
+	assert.True(t, clean)
+	script.AndUpdateFiles()
+	clean, err = repository.IsClean()
+	assert.NoError(t, err)
+	assert.False(t, clean)
+
+	bareRepository := openCLIRepository(t, remoteScript.GetWorkingDirectory())
+	bare, err = bareRepository.IsBare()
+	assert.NoError(t, err)
+	assert.True(t, bare)
+	clean, err = bareRepository.IsClean()
+	assert.NoError(t, err)
+	assert.True(t, clean)
+
+	// operations requiring a working tree fail
+	err = bareRepository.Add([]string{"."})
+	assert.Error(t, err)
+	assert.IsType(t, &errs.GitError{}, err)
+	_, err = bareRepository.CommitWithMessage(utl.PointerToString("A message"))
+	assert.Error(t, err)
+	assert.IsType(t, &errs.GitError{}, err)
+}
+
+func TestCLIRepositoryGetBranchNames(t *testing.T) {
+	remoteScript := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	defaultBranch := remoteScript.GetCurrentBranch()
+	remoteScript.InBranch("feature").AndAddFiles().AndStage().AndCommit()
+	featureCommit := remoteScript.GetLastCommitID()
+	remoteScript.Checkout(defaultBranch)
+	script := gittools.CloneFromWithUserNameAndPassword(remoteScript.GetWorkingDirectory(), nil, nil)
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.InBranch("local")
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	branchNames, err := repository.GetBranchNames(false)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{defaultBranch: remoteScript.GetLastCommitID(), "local": remoteScript.GetLastCommitID()}, branchNames)
+
+	// the symbolic origin/HEAD reference, if any, is not returned
+	branchNames, err = repository.GetBranchNames(true)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(branchNames))
+	assert.Equal(t, remoteScript.GetLastCommitID(), branchNames["origin/"+defaultBranch])
+	assert.Equal(t, featureCommit, branchNames["origin/feature"])
+}
+
+func TestCLIRepositoryGetDefaultBranch(t *testing.T) {
+	remoteScript := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	defaultBranch := remoteScript.GetCurrentBranch()
+
+	// the default branch of the remote the repository was cloned from
+	cloneScript := gittools.CloneFromWithUserNameAndPassword(remoteScript.GetWorkingDirectory(), nil, nil)
+	defer os.RemoveAll(cloneScript.GetWorkingDirectory())
+	repository := openCLIRepository(t, cloneScript.GetWorkingDirectory())
+	branch, err := repository.GetDefaultBranch(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultBranch, branch)
+
+	// remotes that have not been fetched are queried directly
+	remoteScript.InBranch("feature")
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "replica")
+	repository = openCLIRepository(t, script.GetWorkingDirectory())
+	branch, err = repository.GetDefaultBranch(utl.PointerToString("replica"))
+	assert.NoError(t, err)
+	assert.Equal(t, "feature", branch)
+
+	// the default remote is not configured
+	_, err = repository.GetDefaultBranch(nil)
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryRemotes(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	remoteNames, err := repository.GetRemoteNames()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(remoteNames))
+	_, err = repository.GetRemoteURL(nil)
+	assert.Error(t, err)
+
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "replica")
+	remoteNames, err = repository.GetRemoteNames()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"origin", "replica"}, remoteNames)
+	remoteURL, err := repository.GetRemoteURL(utl.PointerToString("replica"))
+	assert.NoError(t, err)
+	assert.Equal(t, remoteScript.GetWorkingDirectory(), remoteURL)
+}
+
+func TestCLIRepositoryGetRemotes(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	remotes, err := repository.GetRemotes()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(remotes))
+
+	script.AddRemote("https://github.com/mooltiverse/nyx.git", "origin")
+	script.AddRemote("https://gitlab.com/mooltiverse/nyx.git", "mirror")
+	out, err := exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "--add", "remote.mirror.pushurl", "git@gitlab.com:mooltiverse/nyx.git").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "--add", "remote.mirror.pushurl", "git@gitlab.com:mooltiverse/nyx-backup.git").CombinedOutput()
+	assert.NoError(t, err, string(out))
+
+	remotes, err = repository.GetRemotes()
+	assert.NoError(t, err)
+	assert.Equal(t, []gitent.Remote{
+		*gitent.NewRemoteWith("mirror", "https://gitlab.com/mooltiverse/nyx.git", []string{"git@gitlab.com:mooltiverse/nyx.git", "git@gitlab.com:mooltiverse/nyx-backup.git"}),
+		*gitent.NewRemoteWith("origin", "https://github.com/mooltiverse/nyx.git", []string{"https://github.com/mooltiverse/nyx.git"}),
+	}, remotes)
+}
+
+func TestCLIRepositoryAddSetAndRemoveRemote(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+	var err error
+
+	// remotes can't be added with empty names or URLs
+	assert.Error(t, repository.AddRemote("", remoteScript.GetWorkingDirectory()))
+	assert.Error(t, repository.AddRemote("mirror", " "))
+
+	err = repository.AddRemote("mirror", remoteScript.GetWorkingDirectory())
+	assert.NoError(t, err)
+	remoteURL, err := repository.GetRemoteURL(utl.PointerToString("mirror"))
+	assert.NoError(t, err)
+	assert.Equal(t, remoteScript.GetWorkingDirectory(), remoteURL)
+	// the same remote can't be added twice
+	assert.Error(t, repository.AddRemote("mirror", "https://gitlab.com/mooltiverse/nyx.git"))
+
+	// the new remote can be pushed to
+	_, err = repository.PushToRemoteWithUserNameAndPassword(utl.PointerToString("mirror"), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommitID(), remoteScript.GetLastCommitID())
+
+	// setting the URL leaves the push URLs untouched
+	out, err := exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "--add", "remote.mirror.pushurl", "git@gitlab.com:mooltiverse/nyx.git").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	assert.Error(t, repository.SetRemoteURL("mirror", ""))
+	assert.Error(t, repository.SetRemoteURL("missing", "https://gitlab.com/mooltiverse/nyx.git"))
+	err = repository.SetRemoteURL("mirror", "https://gitlab.com/mooltiverse/nyx.git")
+	assert.NoError(t, err)
+	remotes, err := repository.GetRemotes()
+	assert.NoError(t, err)
+	assert.Equal(t, []gitent.Remote{*gitent.NewRemoteWith("mirror", "https://gitlab.com/mooltiverse/nyx.git", []string{"git@gitlab.com:mooltiverse/nyx.git"})}, remotes)
+
+	// removing the remote also removes its remote tracking references
+	out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "update-ref", "refs/remotes/mirror/master", script.GetLastCommitID()).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	branchNames, err := repository.GetBranchNames(true)
+	assert.NoError(t, err)
+	assert.Contains(t, branchNames, "mirror/master")
+	assert.Error(t, repository.RemoveRemote("missing"))
+	err = repository.RemoveRemote("mirror")
+	assert.NoError(t, err)
+	remoteNames, err := repository.GetRemoteNames()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(remoteNames))
+	branchNames, err = repository.GetBranchNames(true)
+	assert.NoError(t, err)
+	assert.NotContains(t, branchNames, "mirror/master")
+}
+
+func TestCLIRepositoryGetTrackingStatus(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository := openCLIRepository(t, dir)
+
+	// the branch has no upstream yet
+	trackingStatus, err := repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Nil(t, trackingStatus)
+
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	branch, err := repository.GetCurrentBranch()
+	assert.NoError(t, err)
+	out, err := exec.Command("git", "-C", dir, "push", "--set-upstream", "origin", branch).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	trackingStatus, err = repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.NewTrackingStatusWith(branch, "origin/"+branch, 0, 0), trackingStatus)
+
+	// local commits make the branch ahead
+	script.AndAddFiles().AndStage()
+	script.Commit("One")
+	script.AndAddFiles().AndStage()
+	script.Commit("Two")
+	trackingStatus, err = repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.NewTrackingStatusWith(branch, "origin/"+branch, 2, 0), trackingStatus)
+
+	// pushing updates the remote tracking branch
+	out, err = exec.Command("git", "-C", dir, "push", "origin", branch).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	trackingStatus, err = repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.NewTrackingStatusWith(branch, "origin/"+branch, 0, 0), trackingStatus)
+
+	// dropping the last commit makes the branch behind, then a new commit makes it diverge
+	out, err = exec.Command("git", "-C", dir, "reset", "--hard", "HEAD~1").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	trackingStatus, err = repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.NewTrackingStatusWith(branch, "origin/"+branch, 0, 1), trackingStatus)
+	script.AndAddFiles().AndStage()
+	script.Commit("Three")
+	trackingStatus, err = repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.NewTrackingStatusWith(branch, "origin/"+branch, 1, 1), trackingStatus)
+
+	// the tracking status is not available in the 'detached HEAD' state
+	out, err = exec.Command("git", "-C", dir, "checkout", "--detach").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	_, err = repository.GetTrackingStatus()
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryHasRemoteBranch(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository := openCLIRepository(t, dir)
+	var err error
+
+	// the remote must be configured
+	_, err = repository.HasRemoteBranch(nil, "")
+	assert.Error(t, err)
+
+	// the remote has no branches yet
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	hasRemoteBranch, err := repository.HasRemoteBranch(nil, "")
+	assert.NoError(t, err)
+	assert.False(t, hasRemoteBranch)
+
+	// once pushed the branch is tracked locally
+	branch, err := repository.GetCurrentBranch()
+	assert.NoError(t, err)
+	out, err := exec.Command("git", "-C", dir, "push", "origin", branch).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	hasRemoteBranch, err = repository.HasRemoteBranch(utl.PointerToString("origin"), branch)
+	assert.NoError(t, err)
+	assert.True(t, hasRemoteBranch)
+
+	// without the remote tracking reference the remote is queried
+	out, err = exec.Command("git", "-C", dir, "update-ref", "-d", "refs/remotes/origin/"+branch).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	hasRemoteBranch, err = repository.HasRemoteBranch(nil, "")
+	assert.NoError(t, err)
+	assert.True(t, hasRemoteBranch)
+	hasRemoteBranch, err = repository.HasRemoteBranch(nil, "missing")
+	assert.NoError(t, err)
+	assert.False(t, hasRemoteBranch)
+	_, err = repository.HasRemoteBranch(utl.PointerToString("missing"), branch)
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryPushAndFetchTags(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// create a new empty repository to use as remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	// push the current branch along with tags
+	script.AndAddFiles().AndStage().AndCommit()
+	_, err := repository.Tag(utl.PointerToString("1.0.0"))
+	assert.NoError(t, err)
+	pushedRemote, err := repository.PushWithUserNameAndPassword(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", pushedRemote)
+	assert.Equal(t, script.GetLastCommitID(), remoteScript.GetLastCommitID())
+	assert.Equal(t, script.GetTags(), remoteScript.GetTags())
+	pushedRemotes, err := repository.PushToRemotesWithUserNameAndPassword([]string{"origin"}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"origin"}, pushedRemotes)
+
+	// tag the commit in another clone and push the tag to the remote only
+	cloneScript := gittools.CloneFromWithUserNameAndPassword(remoteScript.GetWorkingDirectory(), nil, nil)
+	defer os.RemoveAll(cloneScript.GetWorkingDirectory())
+	cloneScript.Tag("1.2.3", nil)
+	cloneScript.Push()
+	_, ok := script.GetTags()["1.2.3"]
+	assert.False(t, ok)
+
+	fetchedRemote, err := repository.FetchTagsFromRemoteWithUserNameAndPassword(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", fetchedRemote)
+	_, ok = script.GetTags()["1.2.3"]
+	assert.True(t, ok)
+
+	// fetching from a remote that doesn't exist is an error
+	_, err = repository.FetchTagsFromRemoteWithUserNameAndPassword(utl.PointerToString("missing"), nil, nil)
+	assert.Error(t, err)
+	// user names and passwords can't be used with SSH remotes
+	script.AddRemote("git@github.com:mooltiverse/nyx.git", "ssh")
+	_, err = repository.PushToRemoteWithUserNameAndPassword(utl.PointerToString("ssh"), utl.PointerToString("user"), utl.PointerToString("password"))
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryDeleteTags(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// create a new empty repository to use as remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	_, err := repository.Tag(utl.PointerToString("1.2.3"))
+	assert.NoError(t, err)
+	_, err = repository.PushWithUserNameAndPassword(nil, nil)
+	assert.NoError(t, err)
+	_, ok := remoteScript.GetTags()["1.2.3"]
+	assert.True(t, ok)
+
+	deletedRemote, err := repository.DeleteRemoteTagWithUserNameAndPassword(nil, "1.2.3", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", deletedRemote)
+	_, ok = remoteScript.GetTags()["1.2.3"]
+	assert.False(t, ok)
+	// deleting a tag that is already missing from the remote is not an error
+	_, err = repository.DeleteRemoteTagWithUserNameAndPassword(utl.PointerToString("origin"), "1.2.3", nil, nil)
+	assert.NoError(t, err)
+	// deleting from a remote that doesn't exist is an error
+	_, err = repository.DeleteRemoteTagWithUserNameAndPassword(utl.PointerToString("missing"), "1.2.3", nil, nil)
+	assert.Error(t, err)
+
+	err = repository.DeleteTag("1.2.3")
+	assert.NoError(t, err)
+	_, ok = script.GetTags()["1.2.3"]
+	assert.False(t, ok)
+	// deleting a tag that doesn't exist is an error
+	err = repository.DeleteTag("1.2.3")
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryPushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// create a new empty repository to use as remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	script.AndCommitWith(utl.PointerToString("One"))
+	script.Tag("1.0.0", nil)
+	script.Tag("other", nil)
+
+	// an empty list of refspecs pushes no tag at all
+	_, err := repository.PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(utl.PointerToString("origin"), nil, nil, false, []string{})
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommitID(), remoteScript.GetLastCommitID())
+	assert.Equal(t, 0, len(remoteScript.GetTags()))
+
+	// only the tags matching the refspecs are pushed
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(utl.PointerToString("origin"), nil, nil, false, []string{"refs/tags/1.*:refs/tags/1.*"})
+	assert.NoError(t, err)
+	_, ok := remoteScript.GetTags()["1.0.0"]
+	assert.True(t, ok)
+	_, ok = remoteScript.GetTags()["other"]
+	assert.False(t, ok)
+
+	// the variant without refspecs pushes all tags
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndForce(utl.PointerToString("origin"), nil, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetTags(), remoteScript.GetTags())
+}
+
+func TestCLIRepositoryPushTagToRemoteWithUserNameAndPasswordAndForce(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// create a new empty repository to use as remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	repository := openCLIRepository(t, script.GetWorkingDirectory())
+
+	_, err := repository.Tag(utl.PointerToString("v1"))
+	assert.NoError(t, err)
+	_, err = repository.PushWithUserNameAndPassword(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), remoteScript.GetTags()["v1"])
+
+	// move the tag to a new commit
+	script.AndCommitWith(utl.PointerToString("A message"))
+	_, err = repository.TagWithMessageAndForce(utl.PointerToString("v1"), nil, true)
+	assert.NoError(t, err)
+
+	// the remote rejects moving the tag unless forced
+	_, err = repository.PushTagToRemoteWithUserNameAndPasswordAndForce(nil, "v1", nil, nil, false)
+	assert.Error(t, err)
+	assert.NotEqual(t, script.GetLastCommit().Hash.String(), remoteScript.GetTags()["v1"])
+
+	pushedRemote, err := repository.PushTagToRemoteWithUserNameAndPasswordAndForce(nil, "v1", nil, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", pushedRemote)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), remoteScript.GetTags()["v1"])
+
+	// pushing to a remote that doesn't exist is an error
+	_, err = repository.PushTagToRemoteWithUserNameAndPasswordAndForce(utl.PointerToString("missing"), "v1", nil, nil, true)
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryPushToRemoteWithUserNameAndPasswordAndLease(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// also create a new empty repository to use as remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin") // use the GitDirectory even if it's a bare repository as it's managed internally and still points to the repo dir
+	dir := script.GetWorkingDirectory()
+	repository := openCLIRepository(t, dir)
+	branch, err := repository.GetCurrentBranch()
+	assert.NoError(t, err)
+	remoteBranchSHA := func() string {
+		out, err := exec.Command("git", "-C", remoteScript.GetWorkingDirectory(), "rev-parse", "refs/heads/"+branch).CombinedOutput()
+		assert.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+
+	// a branch that doesn't exist on the remote yet is pushed
+	script.AndCommitWith(utl.PointerToString("One"))
+	pushedRemote, err := repository.PushToRemoteWithUserNameAndPasswordAndLease(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", pushedRemote)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), remoteBranchSHA())
+	out, err := exec.Command("git", "-C", dir, "fetch", "origin").CombinedOutput()
+	assert.NoError(t, err, string(out))
+
+	// rewriting the last commit is force pushed as long as the remote branch didn't move
+	out, err = exec.Command("git", "-C", dir, "commit", "--amend", "--allow-empty", "-m", "One amended").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	pushedRemote, err = repository.PushToRemoteWithUserNameAndPasswordAndLease(utl.PointerToString("origin"), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", pushedRemote)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), remoteBranchSHA())
+
+	// when someone else pushes to the remote branch the push is refused and the remote is left untouched
+	cloneScript := gittools.CloneFromWithUserNameAndPassword(remoteScript.GetWorkingDirectory(), nil, nil)
+	defer os.RemoveAll(cloneScript.GetWorkingDirectory())
+	cloneScript.AndCommitWith(utl.PointerToString("Two"))
+	cloneScript.Push()
+	concurrentSHA := remoteBranchSHA()
+	assert.NotEqual(t, script.GetLastCommit().Hash.String(), concurrentSHA)
+	out, err = exec.Command("git", "-C", dir, "commit", "--amend", "--allow-empty", "-m", "One amended again").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndLease(utl.PointerToString("origin"), nil, nil)
+	assert.Error(t, err)
+	assert.Equal(t, concurrentSHA, remoteBranchSHA())
+
+	// once the remote changes are fetched the lease is renewed
+	out, err = exec.Command("git", "-C", dir, "fetch", "origin").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndLease(utl.PointerToString("origin"), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), remoteBranchSHA())
+
+	// pushing to a remote that doesn't exist is an error
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndLease(utl.PointerToString("missing"), nil, nil)
+	assert.Error(t, err)
+}
+
+func TestCLIRepositoryCloneUsesTheCLIBackend(t *testing.T) {
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	script.PushTo("origin")
+
+	gitInstance := cliGitInstance(t)
+	directory, err := os.MkdirTemp("", "nyx-test-cli-clone-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	uri := remoteScript.GetWorkingDirectory()
+	repository, err := gitInstance.Clone(&directory, &uri)
+	assert.NoError(t, err)
+	latestCommit, err := repository.GetLatestCommit()
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommitID(), latestCommit)
+	currentBranch, err := repository.GetCurrentBranch()
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetCurrentBranch(), currentBranch)
+}
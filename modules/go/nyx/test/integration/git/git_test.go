@@ -22,15 +22,25 @@
 package git_test
 
 import (
-	"os"            // https://pkg.go.dev/os
-	"path/filepath" // https://pkg.go.dev/path/filepath
-	"strings"       // https://pkg.go.dev/strings
-	"testing"       // https://pkg.go.dev/testing
-	"time"          // https://pkg.go.dev/time
-
-	log "github.com/sirupsen/logrus"            // https://pkg.go.dev/github.com/sirupsen/logrus
-	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
-
+	"context"           // https://pkg.go.dev/context
+	"net"               // https://pkg.go.dev/net
+	"net/http"          // https://pkg.go.dev/net/http
+	"net/http/httptest" // https://pkg.go.dev/net/http/httptest
+	"os"                // https://pkg.go.dev/os
+	"os/exec"           // https://pkg.go.dev/os/exec
+	"path/filepath"     // https://pkg.go.dev/path/filepath
+	"strings"           // https://pkg.go.dev/strings
+	"sync/atomic"       // https://pkg.go.dev/sync/atomic
+	"testing"           // https://pkg.go.dev/testing
+	"time"              // https://pkg.go.dev/time
+
+	ggit "github.com/go-git/go-git/v5"                  // https://pkg.go.dev/github.com/go-git/go-git/v5
+	ggitplumbing "github.com/go-git/go-git/v5/plumbing" // https://pkg.go.dev/github.com/go-git/go-git/v5
+	log "github.com/sirupsen/logrus"                    // https://pkg.go.dev/github.com/sirupsen/logrus
+	assert "github.com/stretchr/testify/assert"         // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
 	. "github.com/mooltiverse/nyx/modules/go/nyx/git"
 	github "github.com/mooltiverse/nyx/modules/go/nyx/services/github"
 	gittools "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/git/tools"
@@ -118,6 +128,97 @@ func TestGitClone(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGitCloneBranchWithSingleBranch(t *testing.T) {
+	source := gittools.TWO_BRANCH_SHORT_UNMERGED().Realize()
+	defer os.RemoveAll(source.GetWorkingDirectory())
+	uri := source.GetWorkingDirectory()
+
+	for _, singleBranch := range []bool{false, true} {
+		dir := "nyx-test-git-clone-test-"
+		directory := gitutil.NewTempDirectory("", &dir)
+		defer os.RemoveAll(directory)
+
+		gitInstance, err := GitInstanceWith(Options{SingleBranch: singleBranch})
+		assert.NoError(t, err)
+		_, err = gitInstance.CloneBranchWithUserNameAndPassword(&directory, &uri, utl.PointerToString("alpha"), nil, nil)
+		assert.NoError(t, err)
+
+		// collect the remote branches fetched by the clone
+		repository, err := ggit.PlainOpen(directory)
+		assert.NoError(t, err)
+		references, err := repository.References()
+		assert.NoError(t, err)
+		remoteBranches := []string{}
+		references.ForEach(func(reference *ggitplumbing.Reference) error {
+			if reference.Name().IsRemote() {
+				remoteBranches = append(remoteBranches, reference.Name().Short())
+			}
+			return nil
+		})
+		if singleBranch {
+			assert.Equal(t, []string{"origin/alpha"}, remoteBranches)
+		} else {
+			assert.Contains(t, remoteBranches, "origin/alpha")
+			assert.Contains(t, remoteBranches, "origin/master")
+		}
+	}
+}
+
+func TestGitCloneBranchWithMirror(t *testing.T) {
+	source := gittools.TWO_BRANCH_SHORT_UNMERGED().Realize()
+	defer os.RemoveAll(source.GetWorkingDirectory())
+	uri := source.GetWorkingDirectory()
+
+	for _, branch := range []*string{nil, utl.PointerToString("alpha")} {
+		dir := "nyx-test-git-clone-test-"
+		directory := gitutil.NewTempDirectory("", &dir)
+		defer os.RemoveAll(directory)
+
+		gitInstance, err := GitInstanceWith(Options{Mirror: true})
+		assert.NoError(t, err)
+		repository, err := gitInstance.CloneBranchWithUserNameAndPassword(&directory, &uri, branch, nil, nil)
+		assert.NoError(t, err)
+
+		// the mirror has no working tree
+		_, err = os.Stat(filepath.Join(directory, ".git"))
+		assert.True(t, os.IsNotExist(err))
+		bare, err := repository.IsBare()
+		assert.NoError(t, err)
+		assert.True(t, bare)
+
+		// HEAD points to the requested branch or to the remote default branch
+		currentBranch, err := repository.GetCurrentBranch()
+		assert.NoError(t, err)
+		if branch == nil {
+			assert.Equal(t, "master", currentBranch)
+		} else {
+			assert.Equal(t, "alpha", currentBranch)
+		}
+
+		// all the remote branches are mirrored as local branches, along with tags
+		mirror, err := ggit.PlainOpen(directory)
+		assert.NoError(t, err)
+		references, err := mirror.References()
+		assert.NoError(t, err)
+		localBranches := []string{}
+		remoteBranches := []string{}
+		references.ForEach(func(reference *ggitplumbing.Reference) error {
+			if reference.Name().IsBranch() {
+				localBranches = append(localBranches, reference.Name().Short())
+			} else if reference.Name().IsRemote() {
+				remoteBranches = append(remoteBranches, reference.Name().Short())
+			}
+			return nil
+		})
+		assert.Contains(t, localBranches, "alpha")
+		assert.Contains(t, localBranches, "master")
+		assert.Empty(t, remoteBranches)
+		tags, err := repository.GetTags()
+		assert.NoError(t, err)
+		assert.Equal(t, len(source.GetTags()), len(tags))
+	}
+}
+
 func TestGitCloneWithNonRequiredUserAndPasswordCredentials(t *testing.T) {
 	tr := REMOTE_TEST_REPOSITORY_HTTP_URL
 	dir := "nyx-test-git-clone-test-"
@@ -302,6 +403,37 @@ func TestGitCloneWithRequiredSSHProtectedCredentials(t *testing.T) {
 	log.SetLevel(logLevel) // restore the original logging level
 }
 
+func TestGitCloneErrorWithUserNameAndPasswordOnSSHURI(t *testing.T) {
+	directory, err := os.MkdirTemp("", "nyx-test-git-clone-test-")
+	defer os.RemoveAll(directory)
+
+	// the credential type is checked before connecting so no network access is required
+	for _, uri := range []string{REMOTE_TEST_REPOSITORY_SSH_URL, "ssh://git@github.com/mooltiverse/nyx.git", "git+ssh://git@github.com/mooltiverse/nyx.git"} {
+		_, err = GitInstance().CloneWithUserNameAndPassword(&directory, utl.PointerToString(uri), utl.PointerToString("jdoe"), utl.PointerToString("secret"))
+		assert.Error(t, err)
+		assert.IsType(t, &errs.IllegalArgumentError{}, err)
+		_, err = GitInstance().CloneBranchWithToken(&directory, utl.PointerToString(uri), nil, utl.PointerToString("token"), nil)
+		assert.Error(t, err)
+		assert.IsType(t, &errs.IllegalArgumentError{}, err)
+	}
+}
+
+func TestGitCloneErrorWithPrivateKeyOnHTTPURI(t *testing.T) {
+	directory, err := os.MkdirTemp("", "nyx-test-git-clone-test-")
+	defer os.RemoveAll(directory)
+
+	// the credential type is checked before connecting so no network access is required
+	_, err = GitInstance().CloneWithPublicKey(&directory, utl.PointerToString(REMOTE_TEST_REPOSITORY_HTTP_URL), utl.PointerToString("~/.ssh/id_rsa"), nil)
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IllegalArgumentError{}, err)
+}
+
+func TestGitIsSSHURI(t *testing.T) {
+	assert.True(t, GitInstance().IsSSHURI(REMOTE_TEST_REPOSITORY_SSH_URL))
+	assert.True(t, GitInstance().IsSSHURI("ssh://git@github.com/mooltiverse/nyx.git"))
+	assert.False(t, GitInstance().IsSSHURI(REMOTE_TEST_REPOSITORY_HTTP_URL))
+}
+
 func TestGitOpenErrorWithEmptyDirectory(t *testing.T) {
 	_, err := GitInstance().Open("")
 	assert.Error(t, err)
@@ -329,3 +461,554 @@ func TestGitOpen(t *testing.T) {
 	_, err := GitInstance().Open(dir)
 	assert.NoError(t, err)
 }
+
+func TestGitOpenLinkedWorktree(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.AndCommitWith(utl.PointerToString("A commit")).AndTag("1.0.0", nil)
+	lastCommit := script.GetLastCommit().Hash.String()
+
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			worktreeBranch := "feature-" + strings.ToLower(backend)
+			worktreeDirectory := filepath.Join(t.TempDir(), "worktree")
+			out, err := exec.Command("git", "-C", script.GetWorkingDirectory(), "worktree", "add", "-b", worktreeBranch, worktreeDirectory).CombinedOutput()
+			assert.NoError(t, err, string(out))
+
+			// the linked worktree has its own HEAD and index while objects, references and configuration are shared
+			repository := openRepositoryWithBackend(t, worktreeDirectory, backend)
+			branch, err := repository.GetCurrentBranch()
+			assert.NoError(t, err)
+			assert.Equal(t, worktreeBranch, branch)
+			commit, err := repository.GetLatestCommit()
+			assert.NoError(t, err)
+			assert.Equal(t, lastCommit, commit)
+			tags, err := repository.GetCommitTags(lastCommit)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(tags))
+			clean, err := repository.IsClean()
+			assert.NoError(t, err)
+			assert.True(t, clean)
+
+			// commits go to the branch checked out in the linked worktree and are visible from the main one
+			assert.NoError(t, os.WriteFile(filepath.Join(worktreeDirectory, backend+".txt"), []byte(backend), 0644))
+			commitObject, err := repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("Worktree commit"))
+			assert.NoError(t, err)
+			clean, err = repository.IsClean()
+			assert.NoError(t, err)
+			assert.True(t, clean)
+			out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "rev-parse", worktreeBranch).CombinedOutput()
+			assert.NoError(t, err, string(out))
+			assert.Equal(t, commitObject.GetSHA(), strings.TrimSpace(string(out)))
+			out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "rev-parse", "HEAD").CombinedOutput()
+			assert.NoError(t, err, string(out))
+			assert.Equal(t, lastCommit, strings.TrimSpace(string(out)))
+		})
+	}
+}
+
+func TestGitRepositoryLock(t *testing.T) {
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			script := gittools.INITIAL_COMMIT().Realize()
+			defer os.RemoveAll(script.GetWorkingDirectory())
+			repository := openRepositoryWithBackend(t, script.GetWorkingDirectory(), backend)
+			lockFile := filepath.Join(script.GetWorkingDirectory(), ".git", LOCK_FILE_NAME)
+
+			lock, err := repository.Lock(0)
+			assert.NoError(t, err)
+			assert.FileExists(t, lockFile)
+
+			// the lock is held by the first instance, also when trying from a linked worktree
+			_, err = openRepositoryWithBackend(t, script.GetWorkingDirectory(), backend).Lock(0)
+			assert.Error(t, err)
+			assert.IsType(t, &errs.GitError{}, err)
+			worktreeDirectory := filepath.Join(t.TempDir(), "worktree")
+			out, err := exec.Command("git", "-C", script.GetWorkingDirectory(), "worktree", "add", "-b", "feature", worktreeDirectory).CombinedOutput()
+			assert.NoError(t, err, string(out))
+			_, err = openRepositoryWithBackend(t, worktreeDirectory, backend).Lock(0)
+			assert.Error(t, err)
+
+			// waiting for the lock succeeds once it's released
+			go func() {
+				time.Sleep(time.Second)
+				lock.Release()
+			}()
+			lock, err = openRepositoryWithBackend(t, worktreeDirectory, backend).Lock(10 * time.Second)
+			assert.NoError(t, err)
+			assert.FileExists(t, lockFile)
+			assert.NoError(t, lock.Release())
+			assert.NoFileExists(t, lockFile)
+		})
+	}
+}
+
+func TestGitListRemoteTagsWithUserNameAndPassword(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	initialCommit := script.GetLastCommit().Hash.String()
+	script.AndTag("1.0.0", nil).AndCommitWith(utl.PointerToString("A commit")).AndTag("1.1.0", utl.PointerToString("Release 1.1.0"))
+	lastCommit := script.GetLastCommit().Hash.String()
+	dir := script.GetWorkingDirectory()
+
+	tags, err := GitInstance().ListRemoteTagsWithUserNameAndPassword(&dir, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tags))
+	assert.Equal(t, "1.0.0", tags[0].GetName())
+	assert.Equal(t, "1.1.0", tags[1].GetName())
+	// the lightweight tag points to the commit while the annotated tag points to the tag object
+	assert.Equal(t, initialCommit, tags[0].GetTarget())
+	assert.NotEqual(t, lastCommit, tags[1].GetTarget())
+}
+
+func TestGitListRemoteTagsWithToken(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.AndTag("1.0.0", nil)
+	lastCommit := script.GetLastCommit().Hash.String()
+	dir := script.GetWorkingDirectory()
+
+	tags, err := GitInstance().ListRemoteTagsWithToken(&dir, utl.PointerToString("token"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tags))
+	assert.Equal(t, "1.0.0", tags[0].GetName())
+	assert.Equal(t, lastCommit, tags[0].GetTarget())
+}
+
+func TestGitListRemoteTagsErrorWithNilOrBlankURI(t *testing.T) {
+	_, err := GitInstance().ListRemoteTagsWithUserNameAndPassword(nil, nil, nil)
+	assert.Error(t, err)
+	_, err = GitInstance().ListRemoteTagsWithToken(utl.PointerToString(" "), utl.PointerToString("token"), nil)
+	assert.Error(t, err)
+	_, err = GitInstance().ListRemoteTagsWithPublicKeyAndHostKeys(nil, nil, nil, nil, false)
+	assert.Error(t, err)
+}
+
+func TestGitListRemoteTagsErrorWithNonExistingRepository(t *testing.T) {
+	directory := gitutil.NewTempDirectory("", nil)
+	defer os.RemoveAll(directory)
+
+	_, err := GitInstance().ListRemoteTagsWithUserNameAndPassword(&directory, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestGitListRemoteBranchesWithUserNameAndPassword(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	mainCommit := script.GetLastCommit().Hash.String()
+	mainBranch := script.GetCurrentBranch()
+	script.InBranch("feature").AndCommitWith(utl.PointerToString("A feature commit"))
+	featureCommit := script.GetLastCommit().Hash.String()
+	dir := script.GetWorkingDirectory()
+
+	branches, err := GitInstance().ListRemoteBranchesWithUserNameAndPassword(&dir, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(branches))
+	assert.Equal(t, mainCommit, branches[mainBranch])
+	assert.Equal(t, featureCommit, branches["feature"])
+}
+
+func TestGitListRemoteBranchesWithToken(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+
+	branches, err := GitInstance().ListRemoteBranchesWithToken(&dir, utl.PointerToString("token"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(branches))
+}
+
+func TestGitListRemoteBranchesErrorWithNilOrBlankURI(t *testing.T) {
+	_, err := GitInstance().ListRemoteBranchesWithUserNameAndPassword(nil, nil, nil)
+	assert.Error(t, err)
+	_, err = GitInstance().ListRemoteBranchesWithToken(nil, utl.PointerToString("token"), nil)
+	assert.Error(t, err)
+	_, err = GitInstance().ListRemoteBranchesWithPublicKeyAndHostKeys(utl.PointerToString(""), nil, nil, nil, false)
+	assert.Error(t, err)
+}
+
+func TestGitInstanceWithTimestamp(t *testing.T) {
+	for _, timestamp := range []*string{nil, utl.PointerToString(""), utl.PointerToString("1700000000")} {
+		_, err := GitInstanceWith(Options{Timestamp: timestamp})
+		assert.NoError(t, err)
+	}
+	for _, timestamp := range []string{"yesterday", "-1", "1700000000.5"} {
+		_, err := GitInstanceWith(Options{Timestamp: utl.PointerToString(timestamp)})
+		assert.Error(t, err)
+		assert.IsType(t, &errs.IllegalArgumentError{}, err)
+	}
+}
+
+func TestGitInstanceWithTimeout(t *testing.T) {
+	for _, timeout := range []*string{nil, utl.PointerToString(""), utl.PointerToString("0"), utl.PointerToString("30")} {
+		_, err := GitInstanceWith(Options{Timeout: timeout})
+		assert.NoError(t, err)
+	}
+	for _, timeout := range []string{"forever", "-1", "1.5", "30s"} {
+		_, err := GitInstanceWith(Options{Timeout: utl.PointerToString(timeout)})
+		assert.Error(t, err)
+		assert.IsType(t, &errs.IllegalArgumentError{}, err)
+	}
+}
+
+func TestGitInstanceWithTimeoutAppliesToRemoteOperations(t *testing.T) {
+	// a remote accepting connections and never answering
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			connection, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer connection.Close()
+		}
+	}()
+	uri := "http://" + listener.Addr().String() + "/repository.git"
+
+	gitInstance, err := GitInstanceWith(Options{Timeout: utl.PointerToString("1")})
+	assert.NoError(t, err)
+
+	directory := gitutil.NewTempDirectory("", utl.PointerToString("nyx-test-timeout-"))
+	defer os.RemoveAll(directory)
+	start := time.Now()
+	_, err = gitInstance.Clone(&directory, &uri)
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 30*time.Second)
+
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			script := gittools.ONE_BRANCH_SHORT().Realize()
+			defer os.RemoveAll(script.GetWorkingDirectory())
+			repository := openRepositoryWithOptions(t, script.GetWorkingDirectory(), Options{Backend: backend, Timeout: utl.PointerToString("1")})
+			assert.NoError(t, repository.AddRemote("stalling", uri))
+
+			start := time.Now()
+			_, err := repository.FetchTagsFromRemoteWithUserNameAndPassword(utl.PointerToString("stalling"), nil, nil)
+			assert.Error(t, err)
+			_, err = repository.PushToRemoteWithUserNameAndPassword(utl.PointerToString("stalling"), nil, nil)
+			assert.Error(t, err)
+			_, err = repository.HasRemoteBranch(utl.PointerToString("stalling"), "master")
+			assert.Error(t, err)
+			assert.Less(t, time.Since(start), 30*time.Second)
+		})
+	}
+}
+
+func TestGitWithContextAppliesToRemoteOperations(t *testing.T) {
+	// a remote accepting connections and never answering
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			connection, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer connection.Close()
+		}
+	}()
+	uri := "http://" + listener.Addr().String() + "/repository.git"
+
+	// operations fail when the deadline of the context expires, even without a timeout, and are not retried
+	gitInstance, err := GitInstanceWith(Options{Retries: utl.PointerToString("3")})
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	directory := gitutil.NewTempDirectory("", utl.PointerToString("nyx-test-context-"))
+	defer os.RemoveAll(directory)
+	start := time.Now()
+	_, err = gitInstance.WithContext(ctx).Clone(&directory, &uri)
+	assert.Error(t, err)
+	_, err = gitInstance.WithContext(ctx).ListRemoteTagsWithUserNameAndPassword(&uri, nil, nil)
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 30*time.Second)
+
+	// a canceled context makes operations fail immediately
+	canceled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			script := gittools.ONE_BRANCH_SHORT().Realize()
+			defer os.RemoveAll(script.GetWorkingDirectory())
+			repository := openRepositoryWithBackend(t, script.GetWorkingDirectory(), backend)
+			assert.NoError(t, repository.AddRemote("stalling", uri))
+			contextRepository := repository.WithContext(canceled)
+
+			start := time.Now()
+			_, err := contextRepository.FetchTagsFromRemoteWithUserNameAndPassword(utl.PointerToString("stalling"), nil, nil)
+			assert.Error(t, err)
+			_, err = contextRepository.PushToRemoteWithUserNameAndPassword(utl.PointerToString("stalling"), nil, nil)
+			assert.Error(t, err)
+			_, err = contextRepository.HasRemoteBranch(utl.PointerToString("stalling"), "master")
+			assert.Error(t, err)
+			assert.Less(t, time.Since(start), 30*time.Second)
+
+			// the original repository is not bound to the context
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			_, err = repository.WithContext(ctx).GetLatestCommit()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGitInstanceWithRetries(t *testing.T) {
+	for _, retries := range []*string{nil, utl.PointerToString(""), utl.PointerToString("0"), utl.PointerToString("3")} {
+		_, err := GitInstanceWith(Options{Retries: retries})
+		assert.NoError(t, err)
+	}
+	for _, delay := range []*string{nil, utl.PointerToString("0"), utl.PointerToString("5")} {
+		_, err := GitInstanceWith(Options{RetryDelay: delay})
+		assert.NoError(t, err)
+	}
+	for _, value := range []string{"always", "-1", "1.5", "5s"} {
+		_, err := GitInstanceWith(Options{Retries: utl.PointerToString(value)})
+		assert.Error(t, err)
+		assert.IsType(t, &errs.IllegalArgumentError{}, err)
+		_, err = GitInstanceWith(Options{RetryDelay: utl.PointerToString(value)})
+		assert.Error(t, err)
+		assert.IsType(t, &errs.IllegalArgumentError{}, err)
+	}
+}
+
+func TestGitInstanceWithRetriesAppliesToRemoteOperations(t *testing.T) {
+	// a remote counting the requests and answering with the given status code
+	var requests atomic.Int32
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	uri := server.URL + "/repository.git"
+
+	options := Options{Retries: utl.PointerToString("2"), RetryDelay: utl.PointerToString("0")}
+	gitInstance, err := GitInstanceWith(options)
+	assert.NoError(t, err)
+
+	directory := gitutil.NewTempDirectory("", utl.PointerToString("nyx-test-retries-"))
+	defer os.RemoveAll(directory)
+	_, err = gitInstance.Clone(&directory, &uri)
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), requests.Load())
+
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			script := gittools.ONE_BRANCH_SHORT().Realize()
+			defer os.RemoveAll(script.GetWorkingDirectory())
+			options.Backend = backend
+			repository := openRepositoryWithOptions(t, script.GetWorkingDirectory(), options)
+			assert.NoError(t, repository.AddRemote("unavailable", uri))
+
+			// transient errors are retried
+			status = http.StatusServiceUnavailable
+			requests.Store(0)
+			_, err := repository.FetchTagsFromRemoteWithUserNameAndPassword(utl.PointerToString("unavailable"), nil, nil)
+			assert.Error(t, err)
+			assert.Equal(t, int32(3), requests.Load())
+
+			// permanent errors are not
+			status = http.StatusNotFound
+			requests.Store(0)
+			_, err = repository.FetchTagsFromRemoteWithUserNameAndPassword(utl.PointerToString("unavailable"), nil, nil)
+			assert.Error(t, err)
+			assert.Equal(t, int32(1), requests.Load())
+		})
+	}
+}
+
+func TestGitInstanceWithHeadersOnlyAppliesToItsRepositories(t *testing.T) {
+	// a remote recording the extra header of the last request
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Custom")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	uri := server.URL + "/repository.git"
+
+	gitInstanceWithHeaders, err := GitInstanceWith(Options{Headers: map[string]string{"X-Custom": "value"}})
+	assert.NoError(t, err)
+	directory := gitutil.NewTempDirectory("", utl.PointerToString("nyx-test-headers-"))
+	defer os.RemoveAll(directory)
+	_, err = gitInstanceWithHeaders.Clone(&directory, &uri)
+	assert.Error(t, err)
+	assert.Equal(t, "value", header)
+
+	// instances created later, or with other options, don't inherit the headers
+	otherDirectory := gitutil.NewTempDirectory("", utl.PointerToString("nyx-test-headers-"))
+	defer os.RemoveAll(otherDirectory)
+	_, err = GitInstance().Clone(&otherDirectory, &uri)
+	assert.Error(t, err)
+	assert.Equal(t, "", header)
+
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			script := gittools.ONE_BRANCH_SHORT().Realize()
+			defer os.RemoveAll(script.GetWorkingDirectory())
+			repository := openRepositoryWithOptions(t, script.GetWorkingDirectory(), Options{Backend: backend, Headers: map[string]string{"X-Custom": "value"}})
+			assert.NoError(t, repository.AddRemote("custom", uri))
+			_, err := repository.FetchTagsFromRemoteWithUserNameAndPassword(utl.PointerToString("custom"), nil, nil)
+			assert.Error(t, err)
+			assert.Equal(t, "value", header)
+
+			repository = openRepositoryWithBackend(t, script.GetWorkingDirectory(), backend)
+			_, err = repository.FetchTagsFromRemoteWithUserNameAndPassword(utl.PointerToString("custom"), nil, nil)
+			assert.Error(t, err)
+			assert.Equal(t, "", header)
+		})
+	}
+}
+
+func TestGitInstanceWithTimestampAppliesToCommitsAndTags(t *testing.T) {
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			script := gittools.INITIAL_COMMIT().Realize()
+			defer os.RemoveAll(script.GetWorkingDirectory())
+			repository := openRepositoryWithOptions(t, script.GetWorkingDirectory(), Options{Backend: backend, Timestamp: utl.PointerToString("1700000000")})
+
+			// the timestamp applies to the identities passed explicitly as well as to those from the Git configuration
+			identity := gitent.NewIdentityWith("Jim", "jim@example.com")
+			script.AndAddFiles()
+			commit, err := repository.CommitPathsWithMessageAndIdentities([]string{"."}, utl.PointerToString("Release"), identity, identity)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1700000000000), commit.GetAuthorAction().GetTimeStamp().GetTimeStamp())
+			assert.Equal(t, int64(1700000000000), commit.GetCommitAction().GetTimeStamp().GetTimeStamp())
+			script.AndAddFiles()
+			commit, err = repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("Another release"))
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1700000000000), commit.GetAuthorAction().GetTimeStamp().GetTimeStamp())
+			assert.Equal(t, int64(1700000000000), commit.GetCommitAction().GetTimeStamp().GetTimeStamp())
+
+			tag, err := repository.TagWithMessageAndIdentity(utl.PointerToString("1.0.0"), utl.PointerToString("Release 1.0.0"), identity)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1700000000000), tag.GetTagger().GetTimeStamp().GetTimeStamp())
+			tag, err = repository.TagWithMessage(utl.PointerToString("1.0.1"), utl.PointerToString("Release 1.0.1"))
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1700000000000), tag.GetTagger().GetTimeStamp().GetTimeStamp())
+		})
+	}
+}
+
+func TestGitInstanceWithNoVerifyBypassesHooksOnCommits(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	// a hook rejecting all commits
+	hooksDirectory := filepath.Join(script.GetWorkingDirectory(), ".git", "hooks")
+	assert.NoError(t, os.MkdirAll(hooksDirectory, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(hooksDirectory, "pre-commit"), []byte("#!/bin/sh\nexit 1\n"), 0755))
+
+	// go-git doesn't run hooks at all
+	repository := openRepositoryWithBackend(t, script.GetWorkingDirectory(), GO_GIT_BACKEND)
+	script.AndAddFiles()
+	_, err := repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("Release"))
+	assert.NoError(t, err)
+
+	repository = openRepositoryWithBackend(t, script.GetWorkingDirectory(), CLI_BACKEND)
+	script.AndAddFiles()
+	_, err = repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("Release"))
+	assert.Error(t, err)
+
+	repository = openRepositoryWithOptions(t, script.GetWorkingDirectory(), Options{Backend: CLI_BACKEND, NoVerify: true})
+	commit, err := repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("Release"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Release", commit.GetMessage().GetFullMessage())
+}
+
+func TestGitInstanceWithHooksPathOverridesTheRepositoryHooks(t *testing.T) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	// a hooks directory, relative to the working tree, with a hook rewriting the commit message
+	hooksDirectory := filepath.Join(script.GetWorkingDirectory(), ".githooks")
+	assert.NoError(t, os.Mkdir(hooksDirectory, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(hooksDirectory, "commit-msg"), []byte("#!/bin/sh\necho 'Rewritten' > \"$1\"\n"), 0755))
+	repository := openRepositoryWithBackend(t, script.GetWorkingDirectory(), CLI_BACKEND)
+
+	// hooks are looked up from the repository hooks directory unless a path is set
+	script.AndAddFiles()
+	commit, err := repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("Release"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Release", commit.GetMessage().GetFullMessage())
+
+	repository = openRepositoryWithOptions(t, script.GetWorkingDirectory(), Options{Backend: CLI_BACKEND, HooksPath: utl.PointerToString(".githooks")})
+	script.AndAddFiles()
+	commit, err = repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("Release"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Rewritten\n", commit.GetMessage().GetFullMessage())
+
+	// bypassing hooks also applies to the hooks looked up from the given path
+	repository = openRepositoryWithOptions(t, script.GetWorkingDirectory(), Options{Backend: CLI_BACKEND, HooksPath: utl.PointerToString(".githooks"), NoVerify: true})
+	script.AndAddFiles()
+	commit, err = repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("Release"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Release", commit.GetMessage().GetFullMessage())
+}
+
+func TestGitInstanceWithIgnoreSubmodulesAppliesToCleanChecksAndStaging(t *testing.T) {
+	_, err := GitInstanceWith(Options{IgnoreSubmodules: utl.PointerToString("some")})
+	assert.Error(t, err)
+	git := func(dir string, args ...string) {
+		out, err := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=John Doe", "-c", "user.email=jdoe@example.com", "-c", "protocol.file.allow=always"}, args...)...).CombinedOutput()
+		assert.NoError(t, err, string(out))
+	}
+
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			submoduleScript := gittools.INITIAL_COMMIT().Realize()
+			defer os.RemoveAll(submoduleScript.GetWorkingDirectory())
+			script := gittools.INITIAL_COMMIT().Realize()
+			defer os.RemoveAll(script.GetWorkingDirectory())
+			git(script.GetWorkingDirectory(), "add", "--all")
+			git(script.GetWorkingDirectory(), "submodule", "add", submoduleScript.GetWorkingDirectory(), "sub")
+			git(script.GetWorkingDirectory(), "commit", "-m", "Add submodule")
+			submoduleDirectory := filepath.Join(script.GetWorkingDirectory(), "sub")
+			openRepository := func(mode *string) Repository {
+				return openRepositoryWithOptions(t, script.GetWorkingDirectory(), Options{Backend: backend, IgnoreSubmodules: mode})
+			}
+			isClean := func(mode string) bool {
+				clean, err := openRepository(&mode).IsClean()
+				assert.NoError(t, err)
+				return clean
+			}
+
+			assert.True(t, isClean(""))
+
+			// untracked files within the submodule
+			assert.NoError(t, os.WriteFile(filepath.Join(submoduleDirectory, "untracked.txt"), []byte("untracked"), 0644))
+			assert.False(t, isClean(""))
+			assert.False(t, isClean(IGNORE_SUBMODULES_NONE))
+			assert.True(t, isClean(IGNORE_SUBMODULES_UNTRACKED))
+			assert.True(t, isClean(IGNORE_SUBMODULES_DIRTY))
+			assert.True(t, isClean(IGNORE_SUBMODULES_ALL))
+
+			// changes to the files tracked by the submodule
+			git(submoduleDirectory, "add", "untracked.txt")
+			assert.False(t, isClean(IGNORE_SUBMODULES_UNTRACKED))
+			assert.True(t, isClean(IGNORE_SUBMODULES_DIRTY))
+			assert.True(t, isClean(IGNORE_SUBMODULES_ALL))
+
+			// a new commit checked out in the submodule
+			git(submoduleDirectory, "commit", "-m", "Submodule change")
+			assert.False(t, isClean(IGNORE_SUBMODULES_DIRTY))
+			assert.True(t, isClean(IGNORE_SUBMODULES_ALL))
+
+			// ignored submodules are not staged
+			assert.NoError(t, openRepository(utl.PointerToString(IGNORE_SUBMODULES_ALL)).Add([]string{"."}))
+			out, err := exec.Command("git", "-C", script.GetWorkingDirectory(), "diff", "--cached", "--name-only").CombinedOutput()
+			assert.NoError(t, err, string(out))
+			assert.Equal(t, "", strings.TrimSpace(string(out)))
+
+			// otherwise the commit checked out in the submodule is staged, not the files within it
+			assert.NoError(t, openRepository(nil).Add([]string{"."}))
+			out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "diff", "--cached", "--name-only").CombinedOutput()
+			assert.NoError(t, err, string(out))
+			assert.Equal(t, "sub", strings.TrimSpace(string(out)))
+			out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "status", "--porcelain").CombinedOutput()
+			assert.NoError(t, err, string(out))
+			assert.Equal(t, "M  sub", strings.TrimSpace(string(out)))
+		})
+	}
+}
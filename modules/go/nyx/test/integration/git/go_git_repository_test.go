@@ -27,12 +27,15 @@ import (
 	"os"            // https://pkg.go.dev/os
 	"os/exec"       // https://pkg.go.dev/os/exec
 	"path/filepath" // https://pkg.go.dev/path/filepath
+	"strings"       // https://pkg.go.dev/strings
 	"testing"       // https://pkg.go.dev/testing
 	"time"          // https://pkg.go.dev/time
 
+	ggit "github.com/go-git/go-git/v5"          // https://pkg.go.dev/github.com/go-git/go-git/v5
 	log "github.com/sirupsen/logrus"            // https://pkg.go.dev/github.com/sirupsen/logrus
 	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
 
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
 	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
 	. "github.com/mooltiverse/nyx/modules/go/nyx/git"
 	github "github.com/mooltiverse/nyx/modules/go/nyx/services/github"
@@ -344,6 +347,13 @@ func TestGoGitRepositoryOpen(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGoGitRepositoryOpenErrorWithSHA256Repository(t *testing.T) {
+	directory := newSHA256Repository(t)
+	defer os.RemoveAll(directory)
+	_, err := GitInstance().Open(directory)
+	assert.Error(t, err)
+}
+
 func TestGoGitRepositoryAddErrorWithEmptyPaths(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.FROM_SCRATCH().Realize()
@@ -590,6 +600,280 @@ func TestGoGitRepositoryCommit4Params(t *testing.T) {
 	assert.Equal(t, "A message", commit.GetMessage().GetFullMessage())
 }
 
+func TestGoGitRepositoryDeleteTag(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	script.Tag("1.2.3", nil)
+	_, ok := script.GetTags()["1.2.3"]
+	assert.True(t, ok)
+
+	err = repository.DeleteTag("1.2.3")
+	assert.NoError(t, err)
+	_, ok = script.GetTags()["1.2.3"]
+	assert.False(t, ok)
+
+	// deleting a tag that doesn't exist is an error
+	err = repository.DeleteTag("1.2.3")
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryDeleteRemoteTagWithUserNameAndPassword(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// also create a new empty repository to use as remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin") // use the GitDirectory even if it's a bare repository as it's managed internally and still points to the repo dir
+	script.Tag("1.2.3", nil)
+	script.PushTo("origin")
+	_, ok := remoteScript.GetTags()["1.2.3"]
+	assert.True(t, ok)
+
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	deletedRemote, err := repository.DeleteRemoteTagWithUserNameAndPassword(nil, "1.2.3", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", deletedRemote)
+	_, ok = remoteScript.GetTags()["1.2.3"]
+	assert.False(t, ok)
+	// the local tag is left untouched
+	_, ok = script.GetTags()["1.2.3"]
+	assert.True(t, ok)
+
+	// deleting a tag that is already missing from the remote is not an error
+	_, err = repository.DeleteRemoteTagWithUserNameAndPassword(utl.PointerToString("origin"), "1.2.3", nil, nil)
+	assert.NoError(t, err)
+
+	// deleting from a remote that doesn't exist is an error
+	_, err = repository.DeleteRemoteTagWithUserNameAndPassword(utl.PointerToString("missing"), "1.2.3", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryPushTagToRemoteWithUserNameAndPasswordAndForce(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// also create a new empty repository to use as remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin") // use the GitDirectory even if it's a bare repository as it's managed internally and still points to the repo dir
+	script.Tag("v1", nil)
+	script.PushTo("origin")
+	assert.Equal(t, script.GetLastCommit().Hash.String(), remoteScript.GetTags()["v1"])
+
+	// move the tag to a new commit
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	script.AndCommitWith(utl.PointerToString("A message"))
+	_, err = repository.TagWithMessageAndForce(utl.PointerToString("v1"), nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), script.GetTags()["v1"])
+	assert.NotEqual(t, script.GetLastCommit().Hash.String(), remoteScript.GetTags()["v1"])
+
+	pushedRemote, err := repository.PushTagToRemoteWithUserNameAndPasswordAndForce(nil, "v1", nil, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", pushedRemote)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), remoteScript.GetTags()["v1"])
+
+	// pushing a tag that is already up to date is not an error
+	_, err = repository.PushTagToRemoteWithUserNameAndPasswordAndForce(utl.PointerToString("origin"), "v1", nil, nil, true)
+	assert.NoError(t, err)
+
+	// pushing to a remote that doesn't exist is an error
+	_, err = repository.PushTagToRemoteWithUserNameAndPasswordAndForce(utl.PointerToString("missing"), "v1", nil, nil, true)
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryPushToRemoteWithUserNameAndPasswordAndLease(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// also create a new empty repository to use as remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin") // use the GitDirectory even if it's a bare repository as it's managed internally and still points to the repo dir
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+	branch, err := repository.GetCurrentBranch()
+	assert.NoError(t, err)
+	remoteBranchSHA := func() string {
+		out, err := exec.Command("git", "-C", remoteScript.GetWorkingDirectory(), "rev-parse", "refs/heads/"+branch).CombinedOutput()
+		assert.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+
+	// a branch that doesn't exist on the remote yet is pushed
+	script.AndCommitWith(utl.PointerToString("One"))
+	pushedRemote, err := repository.PushToRemoteWithUserNameAndPasswordAndLease(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", pushedRemote)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), remoteBranchSHA())
+	out, err := exec.Command("git", "-C", dir, "fetch", "origin").CombinedOutput()
+	assert.NoError(t, err, string(out))
+
+	// rewriting the last commit is force pushed as long as the remote branch didn't move
+	out, err = exec.Command("git", "-C", dir, "commit", "--amend", "--allow-empty", "-m", "One amended").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	pushedRemote, err = repository.PushToRemoteWithUserNameAndPasswordAndLease(utl.PointerToString("origin"), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", pushedRemote)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), remoteBranchSHA())
+
+	// when someone else pushes to the remote branch the push is refused and the remote is left untouched
+	cloneScript := gittools.CloneFromWithUserNameAndPassword(remoteScript.GetWorkingDirectory(), nil, nil)
+	defer os.RemoveAll(cloneScript.GetWorkingDirectory())
+	cloneScript.AndCommitWith(utl.PointerToString("Two"))
+	cloneScript.Push()
+	concurrentSHA := remoteBranchSHA()
+	assert.NotEqual(t, script.GetLastCommit().Hash.String(), concurrentSHA)
+	out, err = exec.Command("git", "-C", dir, "commit", "--amend", "--allow-empty", "-m", "One amended again").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndLease(utl.PointerToString("origin"), nil, nil)
+	assert.Error(t, err)
+	assert.Equal(t, concurrentSHA, remoteBranchSHA())
+
+	// once the remote changes are fetched the lease is renewed
+	out, err = exec.Command("git", "-C", dir, "fetch", "origin").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndLease(utl.PointerToString("origin"), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), remoteBranchSHA())
+
+	// pushing to a remote that doesn't exist is an error
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndLease(utl.PointerToString("missing"), nil, nil)
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryPushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// also create a new empty repository to use as remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin") // use the GitDirectory even if it's a bare repository as it's managed internally and still points to the repo dir
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	script.AndCommitWith(utl.PointerToString("One"))
+	script.Tag("1.0.0", nil)
+	script.Tag("other", nil)
+
+	// an empty list of refspecs pushes no tag at all
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(utl.PointerToString("origin"), nil, nil, false, []string{})
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommitID(), remoteScript.GetLastCommitID())
+	assert.Equal(t, 0, len(remoteScript.GetTags()))
+
+	// only the tags matching the refspecs are pushed
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndForceAndTagRefSpecs(utl.PointerToString("origin"), nil, nil, false, []string{"refs/tags/1.*:refs/tags/1.*"})
+	assert.NoError(t, err)
+	_, ok := remoteScript.GetTags()["1.0.0"]
+	assert.True(t, ok)
+	_, ok = remoteScript.GetTags()["other"]
+	assert.False(t, ok)
+
+	// the variant without refspecs pushes all tags
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndForce(utl.PointerToString("origin"), nil, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetTags(), remoteScript.GetTags())
+}
+
+func TestGoGitRepositoryFetchTagsFromRemoteWithUserNameAndPassword(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// also create a new empty repository to use as remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin") // use the GitDirectory even if it's a bare repository as it's managed internally and still points to the repo dir
+	script.PushTo("origin")
+
+	// tag the commit in another clone and push the tag to the remote only
+	cloneScript := gittools.CloneFromWithUserNameAndPassword(remoteScript.GetWorkingDirectory(), nil, nil)
+	defer os.RemoveAll(cloneScript.GetWorkingDirectory())
+	cloneScript.Tag("1.2.3", nil)
+	cloneScript.Push()
+	_, ok := script.GetTags()["1.2.3"]
+	assert.False(t, ok)
+
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	fetchedRemote, err := repository.FetchTagsFromRemoteWithUserNameAndPassword(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", fetchedRemote)
+	_, ok = script.GetTags()["1.2.3"]
+	assert.True(t, ok)
+
+	// fetching again when tags are already up to date is not an error
+	_, err = repository.FetchTagsFromRemoteWithUserNameAndPassword(utl.PointerToString("origin"), nil, nil)
+	assert.NoError(t, err)
+
+	// fetching from a remote that doesn't exist is an error
+	_, err = repository.FetchTagsFromRemoteWithUserNameAndPassword(utl.PointerToString("missing"), nil, nil)
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryRemotesHonorTheURLRewritingRules(t *testing.T) {
+	// make sure the global configuration of the current user is not used
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	globalConfig := filepath.Join(t.TempDir(), "gitconfig")
+	t.Setenv("GIT_CONFIG_GLOBAL", globalConfig)
+
+	// the remote is only reachable through the rules rewriting the URL it's configured with
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	script.PushTo("origin")
+	remoteURL := "https://git.example.com/" + filepath.Base(remoteScript.GetWorkingDirectory())
+	out, err := exec.Command("git", "config", "--file", globalConfig, "url."+filepath.Dir(remoteScript.GetWorkingDirectory())+"/.insteadOf", "https://git.example.com/").CombinedOutput()
+	assert.NoError(t, err, string(out))
+
+	// clones use the rules from the global configuration
+	directory := t.TempDir()
+	repository, err := GitInstance().Clone(&directory, &remoteURL)
+	assert.NoError(t, err)
+	remoteURLs, err := repository.GetRemoteURL(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, remoteURL, remoteURLs)
+
+	// fetches use the same rules
+	cloneScript := gittools.CloneFromWithUserNameAndPassword(remoteScript.GetWorkingDirectory(), nil, nil)
+	defer os.RemoveAll(cloneScript.GetWorkingDirectory())
+	cloneScript.Tag("1.2.3", nil)
+	cloneScript.Push()
+	_, err = repository.FetchTagsFromRemoteWithUserNameAndPassword(nil, nil, nil)
+	assert.NoError(t, err)
+	tags, err := repository.GetTags()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tags))
+
+	// pushes use the push rules, from the repository configuration here, when they match
+	pushScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(pushScript.GetWorkingDirectory())
+	out, err = exec.Command("git", "-C", directory, "config", "url."+pushScript.GetWorkingDirectory()+".pushInsteadOf", remoteURL).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	_, err = repository.PushTagToRemoteWithUserNameAndPasswordAndForce(nil, "1.2.3", nil, nil, false)
+	assert.NoError(t, err)
+	out, err = exec.Command("git", "-C", pushScript.GetWorkingDirectory(), "tag", "--list").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	assert.Equal(t, "1.2.3", strings.TrimSpace(string(out)))
+}
+
 func TestGoGitRepositoryPushWithNonRequiredUserAndPasswordCredentials(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.INITIAL_COMMIT().Realize()
@@ -1854,6 +2138,131 @@ func TestGoGitRepositoryTagCommitWithMessageAndIdentityAndForce(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGoGitRepositoryGetConfiguredIdentity(t *testing.T) {
+	// make sure the global configuration of the current user is not used
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	// the identity configured by the test tools
+	identity, err := repository.GetConfiguredIdentity()
+	assert.NoError(t, err)
+	assert.NotNil(t, identity)
+	assert.Equal(t, "John Doe", identity.GetName())
+	assert.Equal(t, "johndoe@example.com", identity.GetEmail())
+
+	script.RemoveUserIdentity()
+	identity, err = repository.GetConfiguredIdentity()
+	assert.NoError(t, err)
+	assert.Nil(t, identity)
+}
+
+func TestGoGitRepositoryCommitWithIdentitiesFromTheConfiguration(t *testing.T) {
+	// make sure the global configuration of the current user is not used
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	// the user name is set in the repository configuration while the email is only set in the global one
+	script.RemoveUserIdentity()
+	assert.NoError(t, os.WriteFile(filepath.Join(home, ".gitconfig"), []byte("[user]\n\tname = Global User\n\temail = global@example.com\n"), 0644))
+	out, err := exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "user.name", "Local User").CombinedOutput()
+	assert.NoError(t, err, string(out))
+
+	identity, err := repository.GetConfiguredIdentity()
+	assert.NoError(t, err)
+	assert.Equal(t, "Local User", identity.GetName())
+	assert.Equal(t, "global@example.com", identity.GetEmail())
+
+	script.AndAddFiles()
+	commit, err := repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("A commit"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Local User", commit.GetAuthorAction().GetIdentity().GetName())
+	assert.Equal(t, "global@example.com", commit.GetAuthorAction().GetIdentity().GetEmail())
+	assert.Equal(t, "Local User", commit.GetCommitAction().GetIdentity().GetName())
+	assert.Equal(t, "global@example.com", commit.GetCommitAction().GetIdentity().GetEmail())
+
+	// the author and committer sections override the user section, option by option
+	out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "author.name", "Local Author").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	script.AndAddFiles()
+	commit, err = repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("Another commit"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Local Author", commit.GetAuthorAction().GetIdentity().GetName())
+	assert.Equal(t, "global@example.com", commit.GetAuthorAction().GetIdentity().GetEmail())
+	assert.Equal(t, "Local User", commit.GetCommitAction().GetIdentity().GetName())
+	assert.Equal(t, "global@example.com", commit.GetCommitAction().GetIdentity().GetEmail())
+
+	tag, err := repository.TagWithMessage(utl.PointerToString("t1"), utl.PointerToString("A tag"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Local Author", tag.GetTagger().GetIdentity().GetName())
+	assert.Equal(t, "global@example.com", tag.GetTagger().GetIdentity().GetEmail())
+}
+
+func TestGoGitRepositoryCommitSignedWithTheConfiguredKey(t *testing.T) {
+	// make sure the global configuration and the keyring of the current user are not used
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	gnupgHome := t.TempDir()
+	t.Setenv("GNUPGHOME", gnupgHome)
+	defer exec.Command("gpgconf", "--kill", "gpg-agent").Run()
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	signKey, publicKey := gitutil.NewPGPKey("John Doe", "johndoe@example.com")
+	cmd := exec.Command("gpg", "--batch", "--import")
+	cmd.Stdin = strings.NewReader(gitutil.ArmoredPrivateKey(signKey))
+	out, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(out))
+
+	// commits are not signed unless configured
+	script.AndAddFiles()
+	commit, err := repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("An unsigned commit"))
+	assert.NoError(t, err)
+	signature, err := repository.VerifyCommitSignature(commit.GetSHA(), &publicKey)
+	assert.NoError(t, err)
+	assert.False(t, signature.IsSigned())
+
+	// when no signing key is configured the key is selected by the committer email
+	out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "commit.gpgSign", "true").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	script.AndAddFiles()
+	commit, err = repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("A signed commit"))
+	assert.NoError(t, err)
+	signature, err = repository.VerifyCommitSignature(commit.GetSHA(), &publicKey)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsValid())
+
+	out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "user.signingKey", signKey.PrimaryKey.KeyIdString()).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	script.AndAddFiles()
+	commit, err = repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("Another signed commit"))
+	assert.NoError(t, err)
+	signature, err = repository.VerifyCommitSignature(commit.GetSHA(), &publicKey)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsValid())
+
+	// an unknown key yields an error
+	out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "user.signingKey", "0000000000000000").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	script.AndAddFiles()
+	_, err = repository.CommitPathsWithMessage([]string{"."}, utl.PointerToString("A commit"))
+	assert.Error(t, err)
+	assert.IsType(t, &errs.GitError{}, err)
+}
+
 func TestGoGitRepositoryGetCurrentBranch(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.INITIAL_COMMIT().Realize()
@@ -1880,6 +2289,28 @@ func TestGoGitRepositoryGetCurrentBranch(t *testing.T) {
 	assert.Equal(t, "testbranch", currentBranch)
 }
 
+func TestGoGitRepositoryGetHashAlgorithm(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	hashAlgorithm, err := repository.GetHashAlgorithm()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.SHA1, hashAlgorithm)
+
+	// abbreviated identifiers of any length are resolved
+	script.AndAddFiles().AndStage().Commit("A commit")
+	latestCommit, err := repository.GetLatestCommit()
+	assert.NoError(t, err)
+	for _, length := range []int{7, 12, 39} {
+		paths, err := repository.GetCommitChangedPaths(latestCommit[0:length])
+		assert.NoError(t, err)
+		assert.NotEqual(t, 0, len(paths))
+	}
+}
+
 func TestGoGitRepositoryGetLatestCommitErrorWithRepositoryWithNoCommits(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.FROM_SCRATCH().Realize()
@@ -2004,6 +2435,50 @@ func TestGoGitRepositoryGetRootCommit(t *testing.T) {
 	assert.NotEqual(t, rootCommit, latestCommit)
 }
 
+func TestGoGitRepositoryIsBare(t *testing.T) {
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// push the history to a bare remote
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	script.PushTo("origin")
+
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	bare, err := repository.IsBare()
+	assert.NoError(t, err)
+	assert.False(t, bare)
+
+	bareRepository, err := GitInstance().Open(remoteScript.GetWorkingDirectory())
+	assert.NoError(t, err)
+	bare, err = bareRepository.IsBare()
+	assert.NoError(t, err)
+	assert.True(t, bare)
+
+	// bare repositories can be inspected and tagged
+	clean, err := bareRepository.IsClean()
+	assert.NoError(t, err)
+	assert.True(t, clean)
+	latestCommit, err := bareRepository.GetLatestCommit()
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommit().Hash.String(), latestCommit)
+	_, err = bareRepository.Tag(utl.PointerToString("9.9.9"))
+	assert.NoError(t, err)
+	tags, err := bareRepository.GetCommitTags(latestCommit)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tags))
+
+	// while operations requiring a working tree fail
+	err = bareRepository.Add([]string{"."})
+	assert.Error(t, err)
+	assert.IsType(t, &errs.GitError{}, err)
+	_, err = bareRepository.CommitWithMessage(utl.PointerToString("A message"))
+	assert.Error(t, err)
+	assert.IsType(t, &errs.GitError{}, err)
+}
+
 func TestGoGitRepositoryIsClean(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.FROM_SCRATCH().Realize()
@@ -2179,7 +2654,7 @@ func TestGoGitRepositoryIsCleanWithTextFileContainingLineFeedsUsingGitCommand(t
 	assert.True(t, clean)
 }
 
-func TestGoGitRepositoryGetCommitTagsReturnsEmptyResultWithRepositoryWithNoCommits(t *testing.T) {
+func TestGoGitRepositoryGetCommitChangedPaths(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.FROM_SCRATCH().Realize()
 	defer os.RemoveAll(script.GetWorkingDirectory())
@@ -2187,12 +2662,30 @@ func TestGoGitRepositoryGetCommitTagsReturnsEmptyResultWithRepositoryWithNoCommi
 	repository, err := GitInstance().Open(dir)
 	assert.NoError(t, err)
 
-	tags, err := repository.GetCommitTags("")
+	// the root commit returns all of its files
+	script.AndAddFiles().AndStage()
+	rootCommit := script.Commit("A message")
+	paths, err := repository.GetCommitChangedPaths(rootCommit.Hash.String())
 	assert.NoError(t, err)
-	assert.Equal(t, 0, len(tags))
+	assert.NotEqual(t, 0, len(paths))
+
+	// other commits only return the files changed since their parent
+	script.AndAddNFiles(2).AndStage()
+	commit := script.Commit("Another message")
+	paths, err = repository.GetCommitChangedPaths(commit.Hash.String())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(paths))
+	for _, path := range paths {
+		assert.NotContains(t, path, "\\")
+		assert.False(t, filepath.IsAbs(path))
+	}
+
+	// an unknown commit yields an error
+	_, err = repository.GetCommitChangedPaths("0000000000000000000000000000000000000000")
+	assert.Error(t, err)
 }
 
-func TestGoGitRepositoryGetCommitTags(t *testing.T) {
+func TestGoGitRepositoryGetCommitChanges(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.FROM_SCRATCH().Realize()
 	defer os.RemoveAll(script.GetWorkingDirectory())
@@ -2200,57 +2693,69 @@ func TestGoGitRepositoryGetCommitTags(t *testing.T) {
 	repository, err := GitInstance().Open(dir)
 	assert.NoError(t, err)
 
-	// add a commit
-	script.AndAddFiles().AndStage()
-	commit := script.Commit("A message")
-
-	// test with no tags
-	tags, err := repository.GetCommitTags(commit.Hash.String())
+	// the root commit returns all of its files as added
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("one\ntwo\nthree\n"), 0644))
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "src"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "src", "main.txt"), []byte("main\n"), 0644))
+	script.AndStage()
+	rootCommit := script.Commit("A message")
+	changes, err := repository.GetCommitChanges(rootCommit.Hash.String())
 	assert.NoError(t, err)
-	assert.Equal(t, 0, len(tags))
+	assert.ElementsMatch(t, []gitent.FileChange{*gitent.NewFileChangeWith("README.md", 3, 0), *gitent.NewFileChangeWith("src/main.txt", 1, 0)}, changes)
 
-	// test with one lightweight tag
-	script.Tag("t1", nil)
-	tags, err = repository.GetCommitTags(commit.Hash.String())
+	// other commits only return the files changed since their parent
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("one\n2\nthree\nfour\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "image.bin"), []byte{0, 1, 2, 0}, 0644))
+	script.AndStage()
+	commit := script.Commit("Another message")
+	changes, err = repository.GetCommitChanges(commit.Hash.String())
 	assert.NoError(t, err)
-	assert.Equal(t, 1, len(tags))
-	assert.Equal(t, "t1", tags[0].GetName())
-	assert.False(t, tags[0].IsAnnotated())
+	// binary files have no lines
+	assert.ElementsMatch(t, []gitent.FileChange{*gitent.NewFileChangeWith("README.md", 2, 1), *gitent.NewFileChangeWith("image.bin", 0, 0)}, changes)
 
-	// test with one more tag
-	tagMessage := "Tag message"
-	script.Tag("a1", &tagMessage)
-	tags, err = repository.GetCommitTags(commit.Hash.String())
-	assert.NoError(t, err)
-	assert.Equal(t, 2, len(tags))
+	// an unknown commit yields an error
+	_, err = repository.GetCommitChanges("0000000000000000000000000000000000000000")
+	assert.Error(t, err)
 }
 
-func TestGoGitRepositoryGetRemoteNamesWithNoRemotes(t *testing.T) {
+func TestGoGitRepositoryGetCommitFileContent(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
-	script := gittools.FROM_SCRATCH().Realize()
+	script := gittools.INITIAL_COMMIT().Realize()
 	defer os.RemoveAll(script.GetWorkingDirectory())
 	dir := script.GetWorkingDirectory()
 	repository, err := GitInstance().Open(dir)
 	assert.NoError(t, err)
 
-	remoteNames, err := repository.GetRemoteNames()
-	assert.Equal(t, 0, len(remoteNames))
-}
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "docs"), os.ModePerm))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "UPGRADING.md"), []byte("first notes\n"), 0644))
+	script.AndStage()
+	firstCommit := script.Commit("docs: first notes")
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "UPGRADING.md"), []byte("second notes\n"), 0644))
+	script.AndStage()
+	secondCommit := script.Commit("docs: second notes")
+	// uncommitted changes are ignored
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "UPGRADING.md"), []byte("uncommitted notes\n"), 0644))
 
-func TestGoGitRepositoryGetRemoteNamesAfterClone(t *testing.T) {
-	dir := "nyx-test-git-remote-names-test-"
-	dir = gitutil.NewTempDirectory("", &dir)
-	defer os.RemoveAll(dir)
-	tr := REMOTE_TEST_REPOSITORY_HTTP_URL
-	repository, err := GitInstance().Clone(&dir, &tr)
+	content, err := repository.GetCommitFileContent(firstCommit.Hash.String(), "docs/UPGRADING.md", 1024)
 	assert.NoError(t, err)
+	assert.Equal(t, "first notes\n", content)
+	content, err = repository.GetCommitFileContent(secondCommit.Hash.String()[:7], "docs/UPGRADING.md", 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, "second notes\n", content)
 
-	remoteNames, err := repository.GetRemoteNames()
-	assert.Equal(t, 1, len(remoteNames))
-	assert.Equal(t, "origin", remoteNames[0])
+	// files larger than the maximum size are not read
+	_, err = repository.GetCommitFileContent(secondCommit.Hash.String(), "docs/UPGRADING.md", 5)
+	assert.Error(t, err)
+	// missing files, directories and unknown commits yield an error
+	_, err = repository.GetCommitFileContent(secondCommit.Hash.String(), "docs/MISSING.md", 1024)
+	assert.Error(t, err)
+	_, err = repository.GetCommitFileContent(secondCommit.Hash.String(), "docs", 1024)
+	assert.Error(t, err)
+	_, err = repository.GetCommitFileContent("0000000000000000000000000000000000000000", "docs/UPGRADING.md", 1024)
+	assert.Error(t, err)
 }
 
-func TestGoGitRepositoryGetRemoteNamesAfterAddingLocalRepository(t *testing.T) {
+func TestGoGitRepositoryGetCommitPatchID(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.FROM_SCRATCH().Realize()
 	defer os.RemoveAll(script.GetWorkingDirectory())
@@ -2258,14 +2763,497 @@ func TestGoGitRepositoryGetRemoteNamesAfterAddingLocalRepository(t *testing.T) {
 	repository, err := GitInstance().Open(dir)
 	assert.NoError(t, err)
 
-	localRepositoryScript := gittools.FROM_SCRATCH().Realize()
-	defer os.RemoveAll(script.GetWorkingDirectory())
-	localRepositoryScriptDir := localRepositoryScript.GetWorkingDirectory()
-
-	script.AddRemote(localRepositoryScriptDir, "local")
+	// the root commit has a patch identifier too
+	script.AndAddFiles().AndStage()
+	rootCommit := script.Commit("A message")
+	rootPatchID, err := repository.GetCommitPatchID(rootCommit.Hash.String())
+	assert.NoError(t, err)
+	assert.NotEqual(t, "", rootPatchID)
 
-	remoteNames, err := repository.GetRemoteNames()
-	assert.Equal(t, 1, len(remoteNames))
+	// apply the same change in two branches, with different whitespaces and messages
+	script.InBranch("maintenance")
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "fix.txt"), []byte("a fix\n"), 0644))
+	script.AndStage()
+	maintenanceCommit := script.Commit("fix: a fix")
+	script.InBranch("master")
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "fix.txt"), []byte("a  fix \n"), 0644))
+	script.AndStage()
+	masterCommit := script.Commit("fix: a fix (cherry picked)")
+	maintenancePatchID, err := repository.GetCommitPatchID(maintenanceCommit.Hash.String())
+	assert.NoError(t, err)
+	masterPatchID, err := repository.GetCommitPatchID(masterCommit.Hash.String())
+	assert.NoError(t, err)
+	assert.Equal(t, maintenancePatchID, masterPatchID)
+	assert.NotEqual(t, rootPatchID, masterPatchID)
+
+	// a different change has a different patch identifier
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "fix.txt"), []byte("another fix\n"), 0644))
+	script.AndStage()
+	otherCommit := script.Commit("fix: another fix")
+	otherPatchID, err := repository.GetCommitPatchID(otherCommit.Hash.String())
+	assert.NoError(t, err)
+	assert.NotEqual(t, masterPatchID, otherPatchID)
+
+	// an unknown commit yields an error
+	_, err = repository.GetCommitPatchID("0000000000000000000000000000000000000000")
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryVerifySignatures(t *testing.T) {
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	signKey, publicKey := gitutil.NewPGPKey("John Doe", "jdoe@example.com")
+	_, otherPublicKey := gitutil.NewPGPKey("Jane Doe", "jane@example.com")
+
+	script.AndAddFiles().AndStage()
+	unsignedCommit := script.Commit("An unsigned commit")
+	script.AndAddFiles().AndStage()
+	signedCommit := script.SignedCommit("A signed commit", signKey)
+	script.Tag("lightweight", nil)
+	script.Tag("annotated", utl.PointerToString("An unsigned tag"))
+	script.SignedTag("signed", "A signed tag", signKey)
+
+	// unsigned objects are never valid
+	signature, err := repository.VerifyCommitSignature(unsignedCommit.Hash.String(), &publicKey)
+	assert.NoError(t, err)
+	assert.False(t, signature.IsSigned())
+	assert.False(t, signature.IsValid())
+	signature, err = repository.VerifyTagSignature("lightweight", &publicKey)
+	assert.NoError(t, err)
+	assert.False(t, signature.IsSigned())
+	signature, err = repository.VerifyTagSignature("annotated", &publicKey)
+	assert.NoError(t, err)
+	assert.False(t, signature.IsSigned())
+
+	// signed objects are valid when verified with the signing key
+	signature, err = repository.VerifyCommitSignature(signedCommit.Hash.String(), &publicKey)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsSigned())
+	assert.True(t, signature.IsValid())
+	assert.Equal(t, "John Doe <jdoe@example.com>", signature.GetSigner())
+	signature, err = repository.VerifyTagSignature("signed", &publicKey)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsSigned())
+	assert.True(t, signature.IsValid())
+
+	// and are not valid when verified with other keys
+	signature, err = repository.VerifyCommitSignature(signedCommit.Hash.String(), &otherPublicKey)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsSigned())
+	assert.False(t, signature.IsValid())
+	signature, err = repository.VerifyTagSignature("signed", &otherPublicKey)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsSigned())
+	assert.False(t, signature.IsValid())
+
+	// without trusted keys signatures can't be verified
+	signature, err = repository.VerifyCommitSignature(signedCommit.Hash.String(), nil)
+	assert.NoError(t, err)
+	assert.True(t, signature.IsSigned())
+	assert.False(t, signature.IsValid())
+
+	// unknown objects yield an error
+	_, err = repository.VerifyCommitSignature("0000000000000000000000000000000000000000", &publicKey)
+	assert.Error(t, err)
+	_, err = repository.VerifyTagSignature("missing", &publicKey)
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositorySnapshotAndRestore(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	// stage a new file and leave other changes unstaged
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "staged.txt"), []byte("staged\n"), 0644))
+	assert.NoError(t, repository.Add([]string{"staged.txt"}))
+	script.AndUpdateFiles()
+	latestCommit := script.GetLastCommitID()
+	tags := script.GetTags()
+
+	worktree, err := script.Repository.Worktree()
+	assert.NoError(t, err)
+	status, err := worktree.Status()
+	assert.NoError(t, err)
+	snapshot, err := repository.Snapshot()
+	assert.NoError(t, err)
+
+	// commit everything, add a new tag and move an existing one
+	assert.NoError(t, repository.Add([]string{"."}))
+	_, err = repository.CommitWithMessage(utl.PointerToString("Release"))
+	assert.NoError(t, err)
+	_, err = repository.TagWithMessage(utl.PointerToString("1.0.0"), utl.PointerToString("Release 1.0.0"))
+	assert.NoError(t, err)
+	_, err = repository.TagWithMessageAndForce(utl.PointerToString("0.0.4"), nil, true)
+	assert.NoError(t, err)
+	assert.NotEqual(t, latestCommit, script.GetLastCommitID())
+	assert.NotEqual(t, tags, script.GetTags())
+
+	assert.NoError(t, snapshot.Restore())
+	assert.Equal(t, latestCommit, script.GetLastCommitID())
+	assert.Equal(t, tags, script.GetTags())
+	// staged and unstaged changes are back as they were
+	restoredStatus, err := worktree.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, status, restoredStatus)
+	assert.Equal(t, ggit.Added, restoredStatus.File("staged.txt").Staging)
+}
+
+func TestGoGitRepositoryStashAndStashPop(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	// there is nothing to stash in a clean repository or when all the changes are excluded
+	stashed, err := repository.Stash(nil)
+	assert.NoError(t, err)
+	assert.False(t, stashed)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "excluded.txt"), []byte("excluded\n"), 0644))
+	stashed, err = repository.Stash([]string{filepath.Join(dir, "excluded.txt")})
+	assert.NoError(t, err)
+	assert.False(t, stashed)
+
+	// stashing is not supported by this backend so other changes yield an error and are left in place
+	script.AndUpdateFiles()
+	_, err = repository.Stash([]string{"excluded.txt"})
+	assert.Error(t, err)
+	clean, err := repository.IsClean()
+	assert.NoError(t, err)
+	assert.False(t, clean)
+	assert.Error(t, repository.StashPop())
+}
+
+func TestGoGitRepositorySnapshotWithPathsAndRestore(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "existing.txt"), []byte("existing\n"), 0600))
+	latestCommit := script.GetLastCommitID()
+
+	snapshot, err := repository.SnapshotWithPaths([]string{"existing.txt", filepath.Join(dir, "created.json")})
+	assert.NoError(t, err)
+
+	// change the existing file, create the new one and commit them both
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "existing.txt"), []byte("changed\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "created.json"), []byte("{}\n"), 0644))
+	assert.NoError(t, repository.Add([]string{"existing.txt", "created.json"}))
+	_, err = repository.CommitWithMessage(utl.PointerToString("Release"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, snapshot.Restore())
+	assert.Equal(t, latestCommit, script.GetLastCommitID())
+	content, err := os.ReadFile(filepath.Join(dir, "existing.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "existing\n", string(content))
+	info, err := os.Stat(filepath.Join(dir, "existing.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	_, err = os.Stat(filepath.Join(dir, "created.json"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestGoGitRepositorySnapshotAndRestoreInRepositoryWithNoCommits(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	snapshot, err := repository.Snapshot()
+	assert.NoError(t, err)
+	script.AndAddFiles().AndStage().AndCommit()
+	_, err = repository.GetLatestCommit()
+	assert.NoError(t, err)
+
+	assert.NoError(t, snapshot.Restore())
+	_, err = repository.GetLatestCommit()
+	assert.Error(t, err)
+	clean, err := repository.IsClean()
+	assert.NoError(t, err)
+	assert.False(t, clean)
+}
+
+func TestGoGitRepositoryGetCommitTagsReturnsEmptyResultWithRepositoryWithNoCommits(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	tags, err := repository.GetCommitTags("")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(tags))
+}
+
+func TestGoGitRepositoryGetCommitTags(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	// add a commit
+	script.AndAddFiles().AndStage()
+	commit := script.Commit("A message")
+
+	// test with no tags
+	tags, err := repository.GetCommitTags(commit.Hash.String())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(tags))
+
+	// test with one lightweight tag
+	script.Tag("t1", nil)
+	tags, err = repository.GetCommitTags(commit.Hash.String())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tags))
+	assert.Equal(t, "t1", tags[0].GetName())
+	assert.False(t, tags[0].IsAnnotated())
+
+	// test with one more tag
+	tagMessage := "Tag message"
+	script.Tag("a1", &tagMessage)
+	tags, err = repository.GetCommitTags(commit.Hash.String())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tags))
+}
+
+func TestGoGitRepositoryGetBranchNames(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	remoteScript := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	defaultBranch := remoteScript.GetCurrentBranch()
+	remoteScript.InBranch("feature").AndAddFiles().AndStage().AndCommit()
+	featureCommit := remoteScript.GetLastCommitID()
+	remoteScript.Checkout(defaultBranch)
+	script := gittools.CloneFromWithUserNameAndPassword(remoteScript.GetWorkingDirectory(), nil, nil)
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.InBranch("local")
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	branchNames, err := repository.GetBranchNames(false)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{defaultBranch: remoteScript.GetLastCommitID(), "local": remoteScript.GetLastCommitID()}, branchNames)
+
+	branchNames, err = repository.GetBranchNames(true)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(branchNames))
+	assert.Equal(t, remoteScript.GetLastCommitID(), branchNames["origin/"+defaultBranch])
+	assert.Equal(t, featureCommit, branchNames["origin/feature"])
+}
+
+func TestGoGitRepositoryGetDefaultBranch(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	remoteScript := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	defaultBranch := remoteScript.GetCurrentBranch()
+
+	// the default branch of the remote the repository was cloned from
+	cloneScript := gittools.CloneFromWithUserNameAndPassword(remoteScript.GetWorkingDirectory(), nil, nil)
+	defer os.RemoveAll(cloneScript.GetWorkingDirectory())
+	repository, err := GitInstance().Open(cloneScript.GetWorkingDirectory())
+	assert.NoError(t, err)
+	branch, err := repository.GetDefaultBranch(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultBranch, branch)
+
+	// remotes that have not been fetched are queried directly
+	remoteScript.InBranch("feature")
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "replica")
+	repository, err = GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+	branch, err = repository.GetDefaultBranch(utl.PointerToString("replica"))
+	assert.NoError(t, err)
+	assert.Equal(t, "feature", branch)
+
+	// the default remote is not configured
+	_, err = repository.GetDefaultBranch(nil)
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryGetRemoteNamesWithNoRemotes(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	remoteNames, err := repository.GetRemoteNames()
+	assert.Equal(t, 0, len(remoteNames))
+}
+
+func TestGoGitRepositoryGetRemoteNamesAfterClone(t *testing.T) {
+	dir := "nyx-test-git-remote-names-test-"
+	dir = gitutil.NewTempDirectory("", &dir)
+	defer os.RemoveAll(dir)
+	tr := REMOTE_TEST_REPOSITORY_HTTP_URL
+	repository, err := GitInstance().Clone(&dir, &tr)
+	assert.NoError(t, err)
+
+	remoteNames, err := repository.GetRemoteNames()
+	assert.Equal(t, 1, len(remoteNames))
+	assert.Equal(t, "origin", remoteNames[0])
+}
+
+func TestGoGitRepositoryGetRemoteURL(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	// the remote is not configured yet
+	_, err = repository.GetRemoteURL(nil)
+	assert.Error(t, err)
+
+	script.AddRemote("git@github.com:mooltiverse/nyx.git", "origin")
+	script.AddRemote("https://github.com/mooltiverse/nyx.git", "custom")
+
+	uri, err := repository.GetRemoteURL(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "git@github.com:mooltiverse/nyx.git", uri)
+	assert.True(t, GitInstance().IsSSHURI(uri))
+	uri, err = repository.GetRemoteURL(utl.PointerToString("custom"))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://github.com/mooltiverse/nyx.git", uri)
+	assert.False(t, GitInstance().IsSSHURI(uri))
+	_, err = repository.GetRemoteURL(utl.PointerToString("missing"))
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryGetRemotes(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	remotes, err := repository.GetRemotes()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(remotes))
+
+	script.AddRemote("https://github.com/mooltiverse/nyx.git", "origin")
+	script.AddRemote("https://gitlab.com/mooltiverse/nyx.git", "mirror")
+	out, err := exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "--add", "remote.mirror.pushurl", "git@gitlab.com:mooltiverse/nyx.git").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "--add", "remote.mirror.pushurl", "git@gitlab.com:mooltiverse/nyx-backup.git").CombinedOutput()
+	assert.NoError(t, err, string(out))
+
+	remotes, err = repository.GetRemotes()
+	assert.NoError(t, err)
+	assert.Equal(t, []gitent.Remote{
+		*gitent.NewRemoteWith("mirror", "https://gitlab.com/mooltiverse/nyx.git", []string{"git@gitlab.com:mooltiverse/nyx.git", "git@gitlab.com:mooltiverse/nyx-backup.git"}),
+		*gitent.NewRemoteWith("origin", "https://github.com/mooltiverse/nyx.git", []string{"https://github.com/mooltiverse/nyx.git"}),
+	}, remotes)
+}
+
+func TestGoGitRepositoryAddSetAndRemoveRemote(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	// remotes can't be added with empty names or URLs
+	assert.Error(t, repository.AddRemote("", remoteScript.GetWorkingDirectory()))
+	assert.Error(t, repository.AddRemote("mirror", " "))
+
+	err = repository.AddRemote("mirror", remoteScript.GetWorkingDirectory())
+	assert.NoError(t, err)
+	remoteURL, err := repository.GetRemoteURL(utl.PointerToString("mirror"))
+	assert.NoError(t, err)
+	assert.Equal(t, remoteScript.GetWorkingDirectory(), remoteURL)
+	// the same remote can't be added twice
+	assert.Error(t, repository.AddRemote("mirror", "https://gitlab.com/mooltiverse/nyx.git"))
+
+	// the new remote can be pushed to
+	_, err = repository.PushToRemoteWithUserNameAndPassword(utl.PointerToString("mirror"), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, script.GetLastCommitID(), remoteScript.GetLastCommitID())
+
+	// setting the URL leaves the push URLs untouched
+	out, err := exec.Command("git", "-C", script.GetWorkingDirectory(), "config", "--add", "remote.mirror.pushurl", "git@gitlab.com:mooltiverse/nyx.git").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	assert.Error(t, repository.SetRemoteURL("mirror", ""))
+	assert.Error(t, repository.SetRemoteURL("missing", "https://gitlab.com/mooltiverse/nyx.git"))
+	err = repository.SetRemoteURL("mirror", "https://gitlab.com/mooltiverse/nyx.git")
+	assert.NoError(t, err)
+	remotes, err := repository.GetRemotes()
+	assert.NoError(t, err)
+	assert.Equal(t, []gitent.Remote{*gitent.NewRemoteWith("mirror", "https://gitlab.com/mooltiverse/nyx.git", []string{"git@gitlab.com:mooltiverse/nyx.git"})}, remotes)
+
+	// removing the remote also removes its remote tracking references
+	out, err = exec.Command("git", "-C", script.GetWorkingDirectory(), "update-ref", "refs/remotes/mirror/master", script.GetLastCommitID()).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	branchNames, err := repository.GetBranchNames(true)
+	assert.NoError(t, err)
+	assert.Contains(t, branchNames, "mirror/master")
+	assert.Error(t, repository.RemoveRemote("missing"))
+	err = repository.RemoveRemote("mirror")
+	assert.NoError(t, err)
+	remoteNames, err := repository.GetRemoteNames()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(remoteNames))
+	branchNames, err = repository.GetBranchNames(true)
+	assert.NoError(t, err)
+	assert.NotContains(t, branchNames, "mirror/master")
+}
+
+func TestGoGitRepositoryPushErrorWithUserNameAndPasswordOnSSHRemote(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	script.AddRemote("git@github.com:mooltiverse/nyx.git", "origin")
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	// the credential type is checked before connecting so no network access is required
+	_, err = repository.PushToRemoteWithUserNameAndPasswordAndForce(nil, utl.PointerToString("jdoe"), utl.PointerToString("secret"), false)
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IllegalArgumentError{}, err)
+	_, err = repository.FetchTagsFromRemoteWithToken(nil, utl.PointerToString("token"), nil)
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IllegalArgumentError{}, err)
+}
+
+func TestGoGitRepositoryGetRemoteNamesAfterAddingLocalRepository(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	localRepositoryScript := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	localRepositoryScriptDir := localRepositoryScript.GetWorkingDirectory()
+
+	script.AddRemote(localRepositoryScriptDir, "local")
+
+	remoteNames, err := repository.GetRemoteNames()
+	assert.Equal(t, 1, len(remoteNames))
 	assert.Equal(t, "local", remoteNames[0])
 }
 
@@ -2336,6 +3324,107 @@ func TestGoGitRepositoryGetTags(t *testing.T) {
 	assert.Equal(t, 2, len(tags))
 }
 
+func TestGoGitRepositoryGetTrackingStatus(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	// the branch has no upstream yet
+	trackingStatus, err := repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Nil(t, trackingStatus)
+
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	branch, err := repository.GetCurrentBranch()
+	assert.NoError(t, err)
+	out, err := exec.Command("git", "-C", dir, "push", "--set-upstream", "origin", branch).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	trackingStatus, err = repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.NewTrackingStatusWith(branch, "origin/"+branch, 0, 0), trackingStatus)
+
+	// local commits make the branch ahead
+	script.AndAddFiles().AndStage()
+	script.Commit("One")
+	script.AndAddFiles().AndStage()
+	script.Commit("Two")
+	trackingStatus, err = repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.NewTrackingStatusWith(branch, "origin/"+branch, 2, 0), trackingStatus)
+
+	// pushing updates the remote tracking branch
+	out, err = exec.Command("git", "-C", dir, "push", "origin", branch).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	trackingStatus, err = repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.NewTrackingStatusWith(branch, "origin/"+branch, 0, 0), trackingStatus)
+
+	// dropping the last commit makes the branch behind, then a new commit makes it diverge
+	out, err = exec.Command("git", "-C", dir, "reset", "--hard", "HEAD~1").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	trackingStatus, err = repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.NewTrackingStatusWith(branch, "origin/"+branch, 0, 1), trackingStatus)
+	script.AndAddFiles().AndStage()
+	script.Commit("Three")
+	trackingStatus, err = repository.GetTrackingStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, gitent.NewTrackingStatusWith(branch, "origin/"+branch, 1, 1), trackingStatus)
+
+	// the tracking status is not available in the 'detached HEAD' state
+	out, err = exec.Command("git", "-C", dir, "checkout", "--detach").CombinedOutput()
+	assert.NoError(t, err, string(out))
+	_, err = repository.GetTrackingStatus()
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryHasRemoteBranch(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.INITIAL_COMMIT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	// the remote must be configured
+	_, err = repository.HasRemoteBranch(nil, "")
+	assert.Error(t, err)
+
+	// the remote has no branches yet
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	hasRemoteBranch, err := repository.HasRemoteBranch(nil, "")
+	assert.NoError(t, err)
+	assert.False(t, hasRemoteBranch)
+
+	// once pushed the branch is tracked locally
+	branch, err := repository.GetCurrentBranch()
+	assert.NoError(t, err)
+	out, err := exec.Command("git", "-C", dir, "push", "origin", branch).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	hasRemoteBranch, err = repository.HasRemoteBranch(utl.PointerToString("origin"), branch)
+	assert.NoError(t, err)
+	assert.True(t, hasRemoteBranch)
+
+	// without the remote tracking reference the remote is queried
+	out, err = exec.Command("git", "-C", dir, "update-ref", "-d", "refs/remotes/origin/"+branch).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	hasRemoteBranch, err = repository.HasRemoteBranch(nil, "")
+	assert.NoError(t, err)
+	assert.True(t, hasRemoteBranch)
+	hasRemoteBranch, err = repository.HasRemoteBranch(nil, "missing")
+	assert.NoError(t, err)
+	assert.False(t, hasRemoteBranch)
+	_, err = repository.HasRemoteBranch(utl.PointerToString("missing"), branch)
+	assert.Error(t, err)
+}
+
 func TestGoGitRepositoryWalkHistoryWithNoBoundaries(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
@@ -2360,6 +3449,98 @@ func TestGoGitRepositoryWalkHistoryWithNoBoundaries(t *testing.T) {
 	assert.Equal(t, rootCommit, visitedCommits[len(visitedCommits)-1].GetSHA())
 }
 
+func TestGoGitRepositoryWalkHistoryReturnsTheSameTagsAsGetCommitTags(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	// add a few more tags, also on a commit that's already tagged
+	tagMessage := "Tag message"
+	script.Tag("t1", nil)
+	script.Tag("a1", &tagMessage)
+	script.AndAddFiles().AndStage().AndCommit()
+	script.Tag("t2", nil)
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	visitedCommits := 0
+	taggedCommits := 0
+	checkTags := func(commit gitent.Commit) bool {
+		visitedCommits++
+		tags, err := repository.GetCommitTags(commit.GetSHA())
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, tags, commit.GetTags())
+		if len(commit.GetTags()) > 0 {
+			taggedCommits++
+		}
+		return true
+	}
+
+	err = repository.WalkHistory(nil, nil, checkTags)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, visitedCommits)
+	assert.Less(t, 1, taggedCommits)
+
+	visitedCommits = 0
+	taggedCommits = 0
+	err = repository.WalkHistoryWithAllParents(nil, nil, checkTags)
+	assert.NoError(t, err)
+	assert.Equal(t, 15, visitedCommits)
+	assert.Less(t, 1, taggedCommits)
+}
+
+func TestGoGitRepositoryWalkHistoryWithShallowRepository(t *testing.T) {
+	script := gittools.ONE_BRANCH_SHORT().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	// push the history to a bare remote and make a shallow clone of it, with the latest commit only
+	remoteScript := gittools.BARE().RealizeBare(true)
+	defer os.RemoveAll(remoteScript.GetWorkingDirectory())
+	script.AddRemote(remoteScript.GetWorkingDirectory(), "origin")
+	script.PushTo("origin")
+	cloneDirectory, err := os.MkdirTemp("", "nyx-test-shallow-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(cloneDirectory)
+	_, err = ggit.PlainClone(cloneDirectory, false, &ggit.CloneOptions{URL: remoteScript.GetWorkingDirectory(), Depth: 1})
+	assert.NoError(t, err)
+
+	repository, err := GitInstance().Open(cloneDirectory)
+	assert.NoError(t, err)
+	shallow, err := repository.IsShallow()
+	assert.NoError(t, err)
+	assert.True(t, shallow)
+
+	// walking the history stops at the shallow boundary with a specific error
+	var visitedCommits []gitent.Commit
+	err = repository.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.Error(t, err)
+	_, ok := err.(*errs.ShallowRepositoryError)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(visitedCommits))
+
+	// after unshallowing the whole history is available, along with tags
+	fetchedRemote, err := repository.UnshallowFromRemoteWithUserNameAndPassword(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "origin", fetchedRemote)
+	shallow, err = repository.IsShallow()
+	assert.NoError(t, err)
+	assert.False(t, shallow)
+
+	visitedCommits = []gitent.Commit{}
+	err = repository.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, len(visitedCommits))
+	tags, err := repository.GetTags()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(tags))
+}
+
 func TestGoGitRepositoryWalkHistoryErrorWithRepositoryWithNoCommits(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.FROM_SCRATCH().Realize()
@@ -2548,6 +3729,265 @@ func TestGoGitRepositoryWalkHistoryWithBothBoundariesUnresolved(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGoGitRepositoryCountCommitsBetween(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	// the first parent history has 10 commits, the others come from the merged branch
+	count, err := repository.CountCommitsBetween(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 14, count)
+
+	// counting from the latest commit yields nothing
+	latestCommit := script.GetLastCommitID()
+	count, err = repository.CountCommitsBetween(&latestCommit, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// counting up to the root commit yields only the root commit
+	rootCommit, err := repository.GetRootCommit()
+	assert.NoError(t, err)
+	count, err = repository.CountCommitsBetween(nil, &rootCommit)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// counting from the root commit excludes it
+	count, err = repository.CountCommitsBetween(&rootCommit, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, count)
+
+	// an unknown commit
+	unknownCommit := "0000000000000000000000000000000000000000"
+	_, err = repository.CountCommitsBetween(&unknownCommit, nil)
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryWalkHistoryInReverse(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	var commits []gitent.Commit
+	err = repository.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		commits = append(commits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+
+	// the same commits are visited, from the oldest to the most recent
+	var visitedCommits []gitent.Commit
+	err = repository.WalkHistoryInReverse(nil, nil, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, len(commits), len(visitedCommits))
+	for i, commit := range visitedCommits {
+		assert.Equal(t, commits[len(commits)-1-i], commit)
+	}
+
+	// the end boundary is the first commit visited and the visitor can stop the walk
+	end := commits[3].GetSHA()
+	visitedCommits = []gitent.Commit{}
+	err = repository.WalkHistoryInReverse(nil, &end, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return len(visitedCommits) < 2
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []gitent.Commit{commits[3], commits[2]}, visitedCommits)
+
+	// an unknown start yields an error
+	start := "0000000000000000000000000000000000000000"
+	err = repository.WalkHistoryInReverse(&start, nil, func(commit gitent.Commit) bool {
+		return true
+	})
+	assert.Error(t, err)
+}
+
+func TestGoGitRepositoryWalkHistoryWithDateBoundaries(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	var allCommits []gitent.Commit
+	err = repository.WalkHistory(nil, nil, func(commit gitent.Commit) bool {
+		allCommits = append(allCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+
+	// commits may share the same date so the expected ones are selected by date
+	boundary := allCommits[len(allCommits)/2].Date
+	var expectedCommits []gitent.Commit
+	for _, commit := range allCommits {
+		if commit.Date < boundary {
+			break
+		}
+		expectedCommits = append(expectedCommits, commit)
+	}
+	var visitedCommits []gitent.Commit
+	err = repository.WalkHistoryBetweenDates(nil, nil, &boundary, nil, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCommits, visitedCommits)
+
+	expectedCommits = []gitent.Commit{}
+	for _, commit := range allCommits {
+		if commit.Date <= boundary {
+			expectedCommits = append(expectedCommits, commit)
+		}
+	}
+	visitedCommits = []gitent.Commit{}
+	err = repository.WalkHistoryBetweenDates(nil, nil, nil, &boundary, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCommits, visitedCommits)
+
+	// boundaries excluding all commits
+	future := allCommits[0].Date + 1
+	past := allCommits[len(allCommits)-1].Date - 1
+	for _, dates := range [][]*int64{{&future, nil}, {nil, &past}, {&future, &past}} {
+		visitedCommits = []gitent.Commit{}
+		err = repository.WalkHistoryBetweenDates(nil, nil, dates[0], dates[1], func(commit gitent.Commit) bool {
+			visitedCommits = append(visitedCommits, commit)
+			return true
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, visitedCommits)
+	}
+
+	// the end boundary stops the walk even if the end commit is skipped
+	end := allCommits[1].GetSHA()
+	visitedCommits = []gitent.Commit{}
+	err = repository.WalkHistoryBetweenDates(nil, &end, nil, &past, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, visitedCommits)
+}
+
+func TestGoGitRepositoryWalkHistoryWithAllParents(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	dir := script.GetWorkingDirectory()
+	repository, err := GitInstance().Open(dir)
+	assert.NoError(t, err)
+
+	// find out the SHA-1 of the alpha branch HEAD, which is only reachable through the second parent of the merge commit
+	script.Checkout("alpha")
+	alphaHead := script.GetLastCommit().Hash.String()
+	alphaParents := script.GetLastCommit().ParentHashes
+	script.Checkout("master")
+
+	// Keep track of the visited commits
+	var visitedCommits []gitent.Commit
+	err = repository.WalkHistoryWithAllParents(nil, nil, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+
+	// the first parent history has 10 commits, the others come from the merged branch
+	assert.Equal(t, 14, len(visitedCommits))
+	visitedPositions := map[string]int{}
+	for i, commit := range visitedCommits {
+		_, alreadyVisited := visitedPositions[commit.GetSHA()]
+		assert.False(t, alreadyVisited)
+		visitedPositions[commit.GetSHA()] = i
+	}
+	assert.Contains(t, visitedPositions, alphaHead)
+	// no commit is visited before its children
+	for i, commit := range visitedCommits {
+		for _, parent := range commit.GetParents() {
+			assert.Less(t, i, visitedPositions[parent])
+		}
+	}
+	// the root commit is the last one
+	rootCommit, err := repository.GetRootCommit()
+	assert.NoError(t, err)
+	assert.Equal(t, rootCommit, visitedCommits[len(visitedCommits)-1].GetSHA())
+
+	// the visitor can stop the walk
+	visitedCommits = []gitent.Commit{}
+	err = repository.WalkHistoryWithAllParents(nil, nil, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return len(visitedCommits) < 3
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(visitedCommits))
+
+	// the end boundary is included while its ancestors are not
+	visitedCommits = []gitent.Commit{}
+	err = repository.WalkHistoryWithAllParents(nil, &alphaHead, func(commit gitent.Commit) bool {
+		visitedCommits = append(visitedCommits, commit)
+		return true
+	})
+	assert.NoError(t, err)
+	visitedSHAs := map[string]bool{}
+	for _, commit := range visitedCommits {
+		visitedSHAs[commit.GetSHA()] = true
+	}
+	assert.True(t, visitedSHAs[alphaHead])
+	for _, parent := range alphaParents {
+		assert.False(t, visitedSHAs[parent.String()])
+	}
+
+	// unresolved boundaries are errors
+	unresolved := "999999"
+	err = repository.WalkHistoryWithAllParents(&unresolved, nil, func(commit gitent.Commit) bool { return true })
+	assert.Error(t, err)
+	err = repository.WalkHistoryWithAllParents(nil, &unresolved, func(commit gitent.Commit) bool { return true })
+	assert.Error(t, err)
+}
+
+/*
+Realizes a repository where the master branch has been merged into a feature branch, which has then been fast
+forwarded into master, so the last parent of the merge commit is the mainline. Returns the script and the SHA-1
+of the mainline commit merged into the feature branch.
+*/
+func realizeMainlineMergedIntoFeature() (gittools.Script, string) {
+	script := gittools.INITIAL_COMMIT().Realize()
+	script.AndCommitWith(utl.PointerToString("A"))
+	script.InBranch("feature").AndCommitWith(utl.PointerToString("F1")).AndCommitWith(utl.PointerToString("F2"))
+	script.InBranch("master").AndCommitWith(utl.PointerToString("B")).AndCommitWith(utl.PointerToString("C"))
+	mainline := script.GetLastCommit().Hash.String()
+	script.Checkout("feature")
+	script.Merge("master", "M")
+	script.Checkout("master")
+	script.Merge("feature", "Fast forward")
+	return script, mainline
+}
+
+func TestGoGitRepositoryWalkHistoryWithAllParentsAndEndBoundaryOnLastParent(t *testing.T) {
+	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
+	script, end := realizeMainlineMergedIntoFeature()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+	repository, err := GitInstance().Open(script.GetWorkingDirectory())
+	assert.NoError(t, err)
+
+	// the commits from the feature branch are still visited after the end boundary
+	var messages []string
+	err = repository.WalkHistoryWithAllParents(nil, &end, func(commit gitent.Commit) bool {
+		messages = append(messages, commit.GetMessage().GetShortMessage())
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"M", "C", "F2", "F1"}, messages)
+}
+
 func TestGoGitRepositoryWalkHistoryWithEndBoundaryOutOfScope(t *testing.T) {
 	// since the goGitRepository is not visible outside the package we need to retrieve it through the Git object
 	script := gittools.TWO_BRANCH_SHORT_MERGED().Realize()
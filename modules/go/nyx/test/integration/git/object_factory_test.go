@@ -115,6 +115,8 @@ func TestObjectFactoryTagFrom(t *testing.T) {
 	assert.Equal(t, "t1", tag1.Name)
 	assert.Equal(t, commit.Hash.String(), tag1.Target)
 	assert.Equal(t, false, tag1.Annotated)
+	assert.Nil(t, tag1.GetMessage())
+	assert.Nil(t, tag1.GetTagger())
 
 	// test an annotated tag
 	msg := "Tag message"
@@ -123,6 +125,12 @@ func TestObjectFactoryTagFrom(t *testing.T) {
 	assert.Equal(t, "t2", tag2.Name)
 	assert.Equal(t, commit.Hash.String(), tag2.Target)
 	assert.Equal(t, true, tag2.Annotated)
+	assert.Equal(t, "Tag message", tag2.GetMessage().GetShortMessage())
+	tagObject, err := script.Repository.TagObject(refTag2.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, tagObject.Tagger.Name, tag2.GetTagger().GetIdentity().GetName())
+	assert.Equal(t, tagObject.Tagger.Email, tag2.GetTagger().GetIdentity().GetEmail())
+	assert.Equal(t, tagObject.Tagger.When.UnixMilli(), tag2.GetTagger().GetTimeStamp().GetTimeStamp())
 }
 
 func TestObjectFactoryCommitFrom(t *testing.T) {
@@ -164,3 +172,15 @@ func TestObjectFactoryCommitFrom(t *testing.T) {
 	assert.Equal(t, 1, len(commit2.GetTags()))
 	assert.Equal(t, "t2", commit2.GetTags()[0].GetName())
 }
+
+func TestObjectFactoryCommitFromWithTrailers(t *testing.T) {
+	script := gittools.FROM_SCRATCH().Realize()
+	defer os.RemoveAll(script.GetWorkingDirectory())
+
+	revCommit := script.AndAddFiles().Commit("Commit 1\n\nSome body\n\nSigned-off-by: Jim <jim@example.com>\nCo-authored-by: Sam <sam@example.com>\nCo-authored-by: Tom <tom@example.com>")
+	commit := CommitFrom(revCommit, []gitent.Tag{})
+
+	assert.Equal(t, 2, len(commit.GetTrailers()))
+	assert.Equal(t, []string{"Jim <jim@example.com>"}, commit.GetTrailer("Signed-off-by"))
+	assert.Equal(t, []string{"Sam <sam@example.com>", "Tom <tom@example.com>"}, commit.GetTrailer("Co-authored-by"))
+}
@@ -19,7 +19,8 @@ package tools
 import (
 	"path/filepath" // https://pkg.go.dev/path/filepath
 
-	ggit "github.com/go-git/go-git/v5" // https://pkg.go.dev/github.com/go-git/go-git/v5
+	openpgp "github.com/ProtonMail/go-crypto/openpgp" // https://pkg.go.dev/github.com/ProtonMail/go-crypto/openpgp
+	ggit "github.com/go-git/go-git/v5"                // https://pkg.go.dev/github.com/go-git/go-git/v5
 
 	gitutil "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/git/util"
 )
@@ -251,6 +252,23 @@ func (s Script) AndCommitWith(message *string) Script {
 	return s
 }
 
+/*
+Commits the changes to the repository with the given message, signing the commit with the given key.
+
+Arguments are as follows:
+
+  - message the commit message. If nil a random message is generated
+  - signKey the key to sign the commit with
+*/
+func (s Script) AndSignedCommitWith(message *string, signKey *openpgp.Entity) Script {
+	if message == nil {
+		s.SignedCommit("Commit "+gitutil.RandomAlphabeticString(3, 27), signKey)
+	} else {
+		s.SignedCommit(*message, signKey)
+	}
+	return s
+}
+
 /*
 Tags the latest commit with the given name. The tag is a lightweight tag unless the given message is not nil,
 in which case the message is used for the annotation.
@@ -23,6 +23,7 @@ import (
 	"path/filepath" // https://pkg.go.dev/path/filepath
 	"strings"       // https://pkg.go.dev/strings
 
+	openpgp "github.com/ProtonMail/go-crypto/openpgp"              // https://pkg.go.dev/github.com/ProtonMail/go-crypto/openpgp
 	ggit "github.com/go-git/go-git/v5"                             // https://pkg.go.dev/github.com/go-git/go-git/v5
 	ggitconfig "github.com/go-git/go-git/v5/config"                // https://pkg.go.dev/github.com/go-git/go-git/v5
 	ggitplumbing "github.com/go-git/go-git/v5/plumbing"            // https://pkg.go.dev/github.com/go-git/go-git/v5
@@ -342,6 +343,30 @@ func (w Workbench) Commit(message string) ggitobject.Commit {
 	return *commit
 }
 
+/*
+Commits the changes to the repository, signing the commit with the given key.
+
+Arguments are as follows:
+
+- message the commit message
+- signKey the key to sign the commit with
+*/
+func (w Workbench) SignedCommit(message string, signKey *openpgp.Entity) ggitobject.Commit {
+	worktree, err := w.Repository.Worktree()
+	if err != nil {
+		panic(err)
+	}
+	commitHash, err := worktree.Commit(message, &ggit.CommitOptions{All: false, SignKey: signKey})
+	if err != nil {
+		panic(err)
+	}
+	commit, err := w.Repository.CommitObject(commitHash)
+	if err != nil {
+		panic(err)
+	}
+	return *commit
+}
+
 /*
 Creates a new branch if none with the given name exists yet and checks it out.
 Watch out as there must be one commit before this command runs without error because the HEAD
@@ -629,6 +654,25 @@ func (w Workbench) Merge(fromBranch string, message string) ggitobject.Commit {
 	return w.GetLastCommit()
 }
 
+/*
+Removes the user identity (the user.name and user.email options) from the repository configuration, so that
+no identity is configured unless one is available from the global or system configuration.
+*/
+func (w Workbench) RemoveUserIdentity() {
+	cfg, err := w.Repository.Config()
+	if err != nil {
+		panic(err)
+	}
+	cfg.User.Name = ""
+	cfg.User.Email = ""
+	// empty values are not marshalled so the options must also be removed from the raw configuration
+	cfg.Raw.RemoveSection("user")
+	err = w.Repository.SetConfig(cfg)
+	if err != nil {
+		panic(err)
+	}
+}
+
 /*
 Adds all the local changed files to the staging area, without committing.
 */
@@ -677,6 +721,23 @@ func (w Workbench) TagObject(name string, message *string, target *ggitplumbing.
 	return *ref
 }
 
+/*
+Tags the last commit with the given name and message, signing the tag with the given key.
+
+Arguments are as follows:
+
+- name the tag name
+- message the tag message
+- signKey the key to sign the tag with
+*/
+func (w Workbench) SignedTag(name string, message string, signKey *openpgp.Entity) ggitplumbing.Reference {
+	ref, err := w.Repository.CreateTag(name, w.GetLastCommit().Hash, &ggit.CreateTagOptions{Message: message, SignKey: signKey})
+	if err != nil {
+		panic(err)
+	}
+	return *ref
+}
+
 /*
 *
 Tags the last commit with the given name.
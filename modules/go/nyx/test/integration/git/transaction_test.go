@@ -0,0 +1,160 @@
+//go:build integration
+// +build integration
+
+// Only run these tests as part of the integration test suite, when the 'integration' build flag is passed (i.e. running go test --tags=integration)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git_test
+
+import (
+	"os"      // https://pkg.go.dev/os
+	"testing" // https://pkg.go.dev/testing
+
+	log "github.com/sirupsen/logrus"            // https://pkg.go.dev/github.com/sirupsen/logrus
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+	gitent "github.com/mooltiverse/nyx/modules/go/nyx/entities/git"
+	. "github.com/mooltiverse/nyx/modules/go/nyx/git"
+	gittools "github.com/mooltiverse/nyx/modules/go/nyx/test/integration/git/tools"
+	utl "github.com/mooltiverse/nyx/modules/go/utils"
+)
+
+/*
+Opens the repository in the given directory using the given backend.
+*/
+func openRepositoryWithBackend(t *testing.T, directory string, backend string) Repository {
+	return openRepositoryWithOptions(t, directory, Options{Backend: backend})
+}
+
+/*
+Opens the repository in the given directory using the given options.
+*/
+func openRepositoryWithOptions(t *testing.T, directory string, options Options) Repository {
+	gitInstance, err := GitInstanceWith(options)
+	assert.NoError(t, err)
+	repository, err := gitInstance.Open(directory)
+	assert.NoError(t, err)
+	return repository
+}
+
+func TestTransactionErrorWithNilRepository(t *testing.T) {
+	_, err := NewTransaction(nil)
+	assert.Error(t, err)
+	assert.IsType(t, &errs.NilPointerError{}, err)
+}
+
+func TestTransactionApply(t *testing.T) {
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			script := gittools.INITIAL_COMMIT().Realize()
+			defer os.RemoveAll(script.GetWorkingDirectory())
+			repository := openRepositoryWithBackend(t, script.GetWorkingDirectory(), backend)
+			transaction, err := NewTransaction(repository)
+			assert.NoError(t, err)
+			assert.True(t, transaction.IsEmpty())
+
+			// nothing is visible in the repository until the transaction is applied
+			script.AndAddFiles()
+			identity := gitent.NewIdentityWith("Jim", "jim@example.com")
+			assert.NoError(t, transaction.Commit([]string{"."}, utl.PointerToString("Release"), identity, identity))
+			assert.Error(t, transaction.Commit([]string{"."}, utl.PointerToString("Another"), nil, nil))
+			assert.NoError(t, transaction.Tag(utl.PointerToString("1.0.0"), nil, nil, false))
+			assert.NoError(t, transaction.Tag(utl.PointerToString("v1"), utl.PointerToString("Release 1"), identity, false))
+			assert.False(t, transaction.IsEmpty())
+			assert.Equal(t, 0, len(script.GetTags()))
+			clean, err := repository.IsClean()
+			assert.NoError(t, err)
+			assert.False(t, clean)
+
+			commit, tags, err := transaction.Apply()
+			assert.NoError(t, err)
+			assert.NotNil(t, commit)
+			assert.Equal(t, script.GetLastCommitID(), commit.GetSHA())
+			assert.Equal(t, 2, len(tags))
+			assert.Equal(t, "1.0.0", tags[0].GetName())
+			assert.False(t, tags[0].IsAnnotated())
+			assert.Equal(t, "v1", tags[1].GetName())
+			assert.True(t, tags[1].IsAnnotated())
+			assert.Equal(t, commit.GetSHA(), script.GetTags()["1.0.0"])
+			assert.Equal(t, commit.GetSHA(), *script.GetCommitByTag("v1"))
+			clean, err = repository.IsClean()
+			assert.NoError(t, err)
+			assert.True(t, clean)
+
+			// a transaction can only be applied once
+			_, _, err = transaction.Apply()
+			assert.Error(t, err)
+			assert.Error(t, transaction.Tag(utl.PointerToString("1.0.1"), nil, nil, false))
+		})
+	}
+}
+
+func TestTransactionApplyIsAllOrNothing(t *testing.T) {
+	logLevel := log.GetLevel()   // save the previous logging level
+	log.SetLevel(log.FatalLevel) // set the logging level to filter out errors produced during tests
+	defer log.SetLevel(logLevel) // restore the original logging level
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			script := gittools.INITIAL_COMMIT().Realize()
+			defer os.RemoveAll(script.GetWorkingDirectory())
+			script.AndTag("1.0.0", nil)
+			latestCommit := script.GetLastCommitID()
+			repository := openRepositoryWithBackend(t, script.GetWorkingDirectory(), backend)
+			transaction, err := NewTransaction(repository)
+			assert.NoError(t, err)
+
+			// the second tag already exists and can't be replaced so the whole transaction fails
+			script.AndAddFiles()
+			assert.NoError(t, transaction.Commit([]string{"."}, utl.PointerToString("Release"), nil, nil))
+			assert.NoError(t, transaction.Tag(utl.PointerToString("latest"), nil, nil, false))
+			assert.NoError(t, transaction.Tag(utl.PointerToString("1.0.0"), nil, nil, false))
+			_, _, err = transaction.Apply()
+			assert.Error(t, err)
+
+			// neither the commit nor the tags are left in the repository
+			assert.Equal(t, latestCommit, script.GetLastCommitID())
+			assert.Equal(t, 1, len(script.GetTags()))
+			assert.Equal(t, latestCommit, script.GetTags()["1.0.0"])
+			clean, err := repository.IsClean()
+			assert.NoError(t, err)
+			assert.False(t, clean)
+		})
+	}
+}
+
+func TestTransactionApplyWithForcedTag(t *testing.T) {
+	for _, backend := range []string{GO_GIT_BACKEND, CLI_BACKEND} {
+		t.Run(backend, func(t *testing.T) {
+			script := gittools.INITIAL_COMMIT().Realize()
+			defer os.RemoveAll(script.GetWorkingDirectory())
+			script.AndTag("latest", nil)
+			repository := openRepositoryWithBackend(t, script.GetWorkingDirectory(), backend)
+			transaction, err := NewTransaction(repository)
+			assert.NoError(t, err)
+
+			script.AndAddFiles()
+			assert.NoError(t, transaction.Commit([]string{"."}, utl.PointerToString("Release"), nil, nil))
+			assert.NoError(t, transaction.Tag(utl.PointerToString("latest"), nil, nil, true))
+			commit, tags, err := transaction.Apply()
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(tags))
+			assert.Equal(t, commit.GetSHA(), script.GetTags()["latest"])
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"bytes" // https://pkg.go.dev/bytes
+
+	openpgp "github.com/ProtonMail/go-crypto/openpgp"     // https://pkg.go.dev/github.com/ProtonMail/go-crypto/openpgp
+	armor "github.com/ProtonMail/go-crypto/openpgp/armor" // https://pkg.go.dev/github.com/ProtonMail/go-crypto/openpgp/armor
+)
+
+/*
+Generates a new PGP key that can be used to sign commits and tags and returns it along with its armored public key,
+that can be used to verify the signatures.
+
+Arguments are as follows:
+
+- name the name of the key owner
+- email the email of the key owner
+*/
+func NewPGPKey(name string, email string) (*openpgp.Entity, string) {
+	entity, err := openpgp.NewEntity(name, "", email, nil)
+	if err != nil {
+		panic(err)
+	}
+	var buf bytes.Buffer
+	writer, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		panic(err)
+	}
+	if err := entity.Serialize(writer); err != nil {
+		panic(err)
+	}
+	if err := writer.Close(); err != nil {
+		panic(err)
+	}
+	return entity, buf.String()
+}
+
+/*
+Returns the armored private key of the given PGP key, which can be imported in a keyring.
+
+Arguments are as follows:
+
+- entity the key, as returned by NewPGPKey
+*/
+func ArmoredPrivateKey(entity *openpgp.Entity) string {
+	var buf bytes.Buffer
+	writer, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+	if err != nil {
+		panic(err)
+	}
+	if err := entity.SerializePrivate(writer, nil); err != nil {
+		panic(err)
+	}
+	if err := writer.Close(); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
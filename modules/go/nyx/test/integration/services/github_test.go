@@ -67,7 +67,7 @@ func TestGitHubSupports(t *testing.T) {
 		t.Run(f.String(), func(t *testing.T) {
 			gitHub, err := github.Instance(map[string]string{})
 			assert.NoError(t, err)
-			if f == svcapi.GIT_HOSTING || f == svcapi.RELEASES || f == svcapi.RELEASE_ASSETS || f == svcapi.USERS {
+			if f == svcapi.GIT_HOSTING || f == svcapi.PULL_REQUEST_COMMENTS || f == svcapi.PULL_REQUESTS || f == svcapi.RELEASES || f == svcapi.RELEASE_ASSETS || f == svcapi.RELEASE_APPROVALS || f == svcapi.RELEASE_RETENTION || f == svcapi.RELEASE_YANKING || f == svcapi.USERS {
 				assert.True(t, gitHub.Supports(f))
 			} else {
 				assert.False(t, gitHub.Supports(f))
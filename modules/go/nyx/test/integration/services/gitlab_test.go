@@ -66,7 +66,7 @@ func TestGitLabSupports(t *testing.T) {
 		t.Run(f.String(), func(t *testing.T) {
 			gitLab, err := gitlab.Instance(map[string]string{})
 			assert.NoError(t, err)
-			if f == svcapi.GIT_HOSTING || f == svcapi.RELEASES || f == svcapi.RELEASE_ASSETS || f == svcapi.USERS {
+			if f == svcapi.GIT_HOSTING || f == svcapi.PULL_REQUEST_COMMENTS || f == svcapi.PULL_REQUESTS || f == svcapi.RELEASES || f == svcapi.RELEASE_ASSETS || f == svcapi.RELEASE_APPROVALS || f == svcapi.RELEASE_RETENTION || f == svcapi.RELEASE_YANKING || f == svcapi.USERS {
 				assert.True(t, gitLab.Supports(f))
 			} else {
 				assert.False(t, gitLab.Supports(f))
@@ -42,8 +42,13 @@ var (
 	// use this slice to parametrize tests based on the features
 	serviceFeatures = []svcapi.Feature{
 		svcapi.GIT_HOSTING,
+		svcapi.PULL_REQUEST_COMMENTS,
+		svcapi.PULL_REQUESTS,
 		svcapi.RELEASES,
 		svcapi.RELEASE_ASSETS,
+		svcapi.RELEASE_APPROVALS,
+		svcapi.RELEASE_RETENTION,
+		svcapi.RELEASE_YANKING,
 		svcapi.USERS,
 	}
 )
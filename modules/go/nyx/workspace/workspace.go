@@ -0,0 +1,374 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+This is the workspace package for Nyx, managing isolated temporary directories (usually clones of other
+repositories) used by operations that must not touch the user's checkout, like publishing documents or
+formulas to other repositories.
+*/
+package workspace
+
+import (
+	"fmt"           // https://pkg.go.dev/fmt
+	"io/fs"         // https://pkg.go.dev/io/fs
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"sort"          // https://pkg.go.dev/sort
+	"sync"          // https://pkg.go.dev/sync
+	"time"          // https://pkg.go.dev/time
+
+	log "github.com/sirupsen/logrus" // https://pkg.go.dev/github.com/sirupsen/logrus
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+const (
+	// The prefix used for the names of workspace directories.
+	WORKSPACE_DIRECTORY_PREFIX = "nyx-workspace-"
+)
+
+/*
+The function invoked to initialize a newly created workspace, usually by cloning a repository into the given directory.
+*/
+type Initializer func(directory string) error
+
+/*
+A temporary workspace handed out by a Manager.
+
+A workspace is used by one caller at a time, from the moment it's acquired until it's released or discarded.
+*/
+type Workspace struct {
+	// The key the workspace was acquired for.
+	key string
+
+	// The directory of the workspace.
+	directory string
+
+	// The flag telling if the workspace is currently in use.
+	inUse bool
+
+	// The flag telling if the workspace was reused from a previous acquisition.
+	reused bool
+
+	// The last time the workspace was released.
+	lastUsed time.Time
+}
+
+/*
+Returns the key the workspace was acquired for.
+*/
+func (w *Workspace) GetKey() string {
+	return w.key
+}
+
+/*
+Returns the directory of the workspace.
+*/
+func (w *Workspace) GetDirectory() string {
+	return w.directory
+}
+
+/*
+Returns true if the workspace was already initialized by a previous acquisition and has been handed out
+again, in which case callers may need to refresh its contents.
+*/
+func (w *Workspace) IsReused() bool {
+	return w.reused
+}
+
+/*
+The manager of temporary workspaces. It's safe to use a manager from multiple goroutines.
+
+Workspaces are identified by a key (like the URL and the branch of a repository) so that a workspace
+released for a key can be handed out again for the same key without initializing it again. Concurrent
+callers acquiring the same key get distinct workspaces so they never share the same directory.
+
+When a quota is set, the overall disk usage of the workspaces is kept within the quota by removing idle
+workspaces, starting from the least recently used ones.
+*/
+type Manager struct {
+	// The mutex guarding the manager state.
+	mutex sync.Mutex
+
+	// The directory to create workspaces in. When empty the system temporary directory is used.
+	root string
+
+	// The maximum number of bytes all workspaces can use on disk. When 0 or negative there is no limit.
+	quota int64
+
+	// The workspaces handed out by this manager, by key.
+	workspaces map[string][]*Workspace
+}
+
+/*
+Returns a new workspace manager.
+
+Arguments are as follows:
+
+- root the directory to create workspaces in. It's created if it doesn't exist. When empty the system temporary directory is used.
+- quota the maximum number of bytes all workspaces can use on disk. When 0 or negative there is no limit.
+
+Errors can be:
+
+- IOError in case the root directory can't be created
+*/
+func NewManager(root string, quota int64) (*Manager, error) {
+	if "" != root {
+		err := os.MkdirAll(root, 0700)
+		if err != nil {
+			return nil, &errs.IOError{Message: fmt.Sprintf("unable to create the workspace root directory '%s'", root), Cause: err}
+		}
+	}
+	return &Manager{root: root, quota: quota, workspaces: make(map[string][]*Workspace)}, nil
+}
+
+/*
+Returns a workspace for the given key, reusing an idle one previously released for the same key, if any,
+or creating a new one otherwise. New workspaces are initialized by the given initializer, if not nil.
+The returned workspace must be released or discarded when no longer needed.
+
+Arguments are as follows:
+
+- key the key identifying the contents of the workspace
+- initializer the function initializing new workspaces, it may be nil
+
+Errors can be:
+
+- IOError in case the workspace directory can't be created
+- IllegalStateError in case the quota is exceeded even after evicting all idle workspaces
+- any error returned by the initializer
+*/
+func (m *Manager) Acquire(key string, initializer Initializer) (*Workspace, error) {
+	m.mutex.Lock()
+	for _, workspace := range m.workspaces[key] {
+		if !workspace.inUse {
+			workspace.inUse = true
+			workspace.reused = true
+			m.mutex.Unlock()
+			log.Debugf("reusing workspace '%s' for '%s'", workspace.directory, key)
+			return workspace, nil
+		}
+	}
+	directory, err := os.MkdirTemp(m.root, WORKSPACE_DIRECTORY_PREFIX)
+	if err != nil {
+		m.mutex.Unlock()
+		return nil, &errs.IOError{Message: fmt.Sprintf("unable to create a workspace directory for '%s'", key), Cause: err}
+	}
+	workspace := &Workspace{key: key, directory: directory, inUse: true}
+	m.workspaces[key] = append(m.workspaces[key], workspace)
+	m.mutex.Unlock()
+	log.Debugf("created workspace '%s' for '%s'", directory, key)
+
+	// initialization may take long so it's run without holding the lock
+	if initializer != nil {
+		err = initializer(directory)
+		if err != nil {
+			m.Discard(workspace)
+			return nil, err
+		}
+	}
+
+	err = m.enforceQuota()
+	if err != nil {
+		m.Discard(workspace)
+		return nil, err
+	}
+	return workspace, nil
+}
+
+/*
+Releases the given workspace so that it can be reused by later acquisitions for the same key.
+*/
+func (m *Manager) Release(workspace *Workspace) {
+	if workspace == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	workspace.inUse = false
+	workspace.lastUsed = time.Now()
+}
+
+/*
+Discards the given workspace, removing its directory.
+
+Errors can be:
+
+- IOError in case the workspace directory can't be removed
+*/
+func (m *Manager) Discard(workspace *Workspace) error {
+	if workspace == nil {
+		return nil
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.remove(workspace)
+}
+
+/*
+Removes all the idle workspaces, leaving those in use untouched.
+
+Errors can be:
+
+- IOError in case some workspace directory can't be removed
+*/
+func (m *Manager) Cleanup() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	var res error
+	for _, workspace := range m.idleWorkspaces() {
+		err := m.remove(workspace)
+		if err != nil && res == nil {
+			res = err
+		}
+	}
+	return res
+}
+
+/*
+Returns the number of bytes used on disk by all the workspaces handed out by this manager.
+
+Errors can be:
+
+- IOError in case some workspace directory can't be inspected
+*/
+func (m *Manager) GetDiskUsage() (int64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.diskUsage()
+}
+
+/*
+Removes idle workspaces, least recently used first, until the disk usage is within the quota, if any.
+This method acquires the lock.
+
+Errors can be:
+
+- IOError in case some workspace directory can't be inspected or removed
+- IllegalStateError in case the quota is exceeded even after evicting all idle workspaces
+*/
+func (m *Manager) enforceQuota() error {
+	if m.quota <= 0 {
+		return nil
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	usage, err := m.diskUsage()
+	if err != nil {
+		return err
+	}
+	idle := m.idleWorkspaces()
+	sort.SliceStable(idle, func(i, j int) bool { return idle[i].lastUsed.Before(idle[j].lastUsed) })
+	for _, workspace := range idle {
+		if usage <= m.quota {
+			break
+		}
+		size, err := directorySize(workspace.directory)
+		if err != nil {
+			return err
+		}
+		log.Debugf("evicting workspace '%s' for '%s' to stay within the quota of %d bytes", workspace.directory, workspace.key, m.quota)
+		err = m.remove(workspace)
+		if err != nil {
+			return err
+		}
+		usage = usage - size
+	}
+	if usage > m.quota {
+		return &errs.IllegalStateError{Message: fmt.Sprintf("the workspaces use %d bytes, exceeding the quota of %d bytes", usage, m.quota)}
+	}
+	return nil
+}
+
+/*
+Returns the workspaces not currently in use. The caller must hold the lock.
+*/
+func (m *Manager) idleWorkspaces() []*Workspace {
+	res := []*Workspace{}
+	for _, workspaces := range m.workspaces {
+		for _, workspace := range workspaces {
+			if !workspace.inUse {
+				res = append(res, workspace)
+			}
+		}
+	}
+	return res
+}
+
+/*
+Returns the number of bytes used on disk by all the workspaces. The caller must hold the lock.
+*/
+func (m *Manager) diskUsage() (int64, error) {
+	var res int64
+	for _, workspaces := range m.workspaces {
+		for _, workspace := range workspaces {
+			size, err := directorySize(workspace.directory)
+			if err != nil {
+				return res, err
+			}
+			res = res + size
+		}
+	}
+	return res, nil
+}
+
+/*
+Removes the given workspace directory and forgets about the workspace. The caller must hold the lock.
+*/
+func (m *Manager) remove(workspace *Workspace) error {
+	workspaces := m.workspaces[workspace.key]
+	for i, w := range workspaces {
+		if w == workspace {
+			workspaces = append(workspaces[:i], workspaces[i+1:]...)
+			break
+		}
+	}
+	if len(workspaces) == 0 {
+		delete(m.workspaces, workspace.key)
+	} else {
+		m.workspaces[workspace.key] = workspaces
+	}
+	log.Debugf("removing workspace '%s' for '%s'", workspace.directory, workspace.key)
+	err := os.RemoveAll(workspace.directory)
+	if err != nil {
+		return &errs.IOError{Message: fmt.Sprintf("unable to remove the workspace directory '%s'", workspace.directory), Cause: err}
+	}
+	return nil
+}
+
+/*
+Returns the overall size of the regular files in the given directory and its subdirectories.
+*/
+func directorySize(directory string) (int64, error) {
+	var res int64
+	err := filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() {
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			res = res + info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return res, &errs.IOError{Message: fmt.Sprintf("unable to compute the size of the workspace directory '%s'", directory), Cause: err}
+	}
+	return res, nil
+}
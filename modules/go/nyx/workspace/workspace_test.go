@@ -0,0 +1,184 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workspace
+
+import (
+	"fmt"           // https://pkg.go.dev/fmt
+	"os"            // https://pkg.go.dev/os
+	"path/filepath" // https://pkg.go.dev/path/filepath
+	"sync"          // https://pkg.go.dev/sync
+	"testing"       // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+
+	errs "github.com/mooltiverse/nyx/modules/go/errors"
+)
+
+/*
+Returns an initializer writing a file of the given size into the workspace.
+*/
+func fileInitializer(size int) Initializer {
+	return func(directory string) error {
+		return os.WriteFile(filepath.Join(directory, "content"), make([]byte, size), 0600)
+	}
+}
+
+func TestManagerAcquireCreatesAndInitializesWorkspace(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	workspace, err := manager.Acquire("key", fileInitializer(10))
+	assert.NoError(t, err)
+	assert.Equal(t, "key", workspace.GetKey())
+	assert.False(t, workspace.IsReused())
+	assert.FileExists(t, filepath.Join(workspace.GetDirectory(), "content"))
+}
+
+func TestManagerAcquireReusesReleasedWorkspace(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	workspace1, err := manager.Acquire("key", fileInitializer(10))
+	assert.NoError(t, err)
+	manager.Release(workspace1)
+
+	initialized := false
+	workspace2, err := manager.Acquire("key", func(directory string) error {
+		initialized = true
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.False(t, initialized)
+	assert.True(t, workspace2.IsReused())
+	assert.Equal(t, workspace1.GetDirectory(), workspace2.GetDirectory())
+}
+
+func TestManagerAcquireIsolatesWorkspacesInUse(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	workspace1, err := manager.Acquire("key", nil)
+	assert.NoError(t, err)
+	workspace2, err := manager.Acquire("key", nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, workspace1.GetDirectory(), workspace2.GetDirectory())
+}
+
+func TestManagerAcquireWithFailingInitializer(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	var directory string
+	_, err = manager.Acquire("key", func(d string) error {
+		directory = d
+		return &errs.GitError{Message: "clone failed"}
+	})
+	assert.Error(t, err)
+	assert.NoDirExists(t, directory)
+}
+
+func TestManagerDiscard(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	workspace, err := manager.Acquire("key", fileInitializer(10))
+	assert.NoError(t, err)
+	assert.NoError(t, manager.Discard(workspace))
+	assert.NoDirExists(t, workspace.GetDirectory())
+
+	workspace, err = manager.Acquire("key", nil)
+	assert.NoError(t, err)
+	assert.False(t, workspace.IsReused())
+}
+
+func TestManagerCleanupRemovesIdleWorkspacesOnly(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	idle, err := manager.Acquire("idle", nil)
+	assert.NoError(t, err)
+	manager.Release(idle)
+	busy, err := manager.Acquire("busy", nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, manager.Cleanup())
+	assert.NoDirExists(t, idle.GetDirectory())
+	assert.DirExists(t, busy.GetDirectory())
+}
+
+func TestManagerQuotaEvictsIdleWorkspaces(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), 150)
+	assert.NoError(t, err)
+
+	workspace1, err := manager.Acquire("key1", fileInitializer(100))
+	assert.NoError(t, err)
+	manager.Release(workspace1)
+
+	workspace2, err := manager.Acquire("key2", fileInitializer(100))
+	assert.NoError(t, err)
+	assert.NoDirExists(t, workspace1.GetDirectory())
+	assert.DirExists(t, workspace2.GetDirectory())
+
+	usage, err := manager.GetDiskUsage()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), usage)
+}
+
+func TestManagerQuotaExceededByWorkspacesInUse(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), 150)
+	assert.NoError(t, err)
+
+	_, err = manager.Acquire("key1", fileInitializer(100))
+	assert.NoError(t, err)
+
+	var directory string
+	_, err = manager.Acquire("key2", func(d string) error {
+		directory = d
+		return fileInitializer(100)(d)
+	})
+	assert.Error(t, err)
+	assert.IsType(t, &errs.IllegalStateError{}, err)
+	assert.NoDirExists(t, directory)
+}
+
+func TestManagerConcurrentAcquisitions(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	directories := map[string]bool{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			workspace, err := manager.Acquire(fmt.Sprintf("key%d", i%2), fileInitializer(10))
+			assert.NoError(t, err)
+			mutex.Lock()
+			directories[workspace.GetDirectory()] = true
+			mutex.Unlock()
+		}(i)
+	}
+	wg.Wait()
+	// no workspace is released so every caller must get its own directory
+	assert.Equal(t, 10, len(directories))
+}
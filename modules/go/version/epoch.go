@@ -0,0 +1,123 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"strconv" // https://pkg.go.dev/strconv
+	"strings" // https://pkg.go.dev/strings
+)
+
+const (
+	// The delimiter between the epoch and the rest of the version (i.e. the '!' in '2!1.4.0').
+	EPOCH_DELIMITER = "!"
+)
+
+/*
+Splits the given string into the epoch and the version without the epoch. The epoch is the non negative number
+followed by the EPOCH_DELIMITER at the beginning of the string (i.e. 2 in '2!1.4.0') and is used by projects that
+had to reset or re-baseline their versioning so that versions with higher epochs always come after the ones with
+lower epochs, regardless of the rest of the version.
+
+This method uses a strict criteria so the epoch is only recognized at the very beginning of the string.
+
+When the string has no epoch the returned epoch is 0 and the string is returned unchanged.
+
+Arguments are as follows:
+
+- s the string to split
+*/
+func SplitEpoch(s string) (int, string) {
+	delimiterIndex := strings.Index(s, EPOCH_DELIMITER)
+	if delimiterIndex <= 0 || delimiterIndex == len(s)-len(EPOCH_DELIMITER) {
+		return 0, s
+	}
+	for _, c := range s[:delimiterIndex] {
+		if c < '0' || c > '9' {
+			return 0, s
+		}
+	}
+	epoch, err := strconv.Atoi(s[:delimiterIndex])
+	if err != nil {
+		return 0, s
+	}
+	return epoch, s[delimiterIndex+len(EPOCH_DELIMITER):]
+}
+
+/*
+Splits the given string into the epoch and the version without the epoch, just like SplitEpoch, but when lenient
+is true the epoch is also recognized after a prefix made of non numeric characters (i.e. 'v' in 'v2!1.4.0').
+The prefix is retained so 'v2!1.4.0' is split into 2 and 'v1.4.0'.
+
+Arguments are as follows:
+
+  - s the string to split
+  - lenient when true the epoch is also recognized after a non numeric prefix
+*/
+func SplitEpochWithLenience(s string, lenient bool) (int, string) {
+	if !lenient {
+		return SplitEpoch(s)
+	}
+	prefixLength := strings.IndexFunc(s, func(c rune) bool {
+		return (c >= '0' && c <= '9') || EPOCH_DELIMITER == string(c)
+	})
+	if prefixLength < 0 {
+		return 0, s
+	}
+	epoch, version := SplitEpoch(s[prefixLength:])
+	if epoch == 0 {
+		return 0, s
+	}
+	return epoch, s[:prefixLength] + version
+}
+
+/*
+Splits the given string into the epoch and the version without the epoch, just like SplitEpoch, but the epoch
+is also recognized right after the given prefix. The prefix is retained so 'v2!1.4.0' is split into 2 and 'v1.4.0'
+when the prefix is 'v'.
+
+Arguments are as follows:
+
+  - s the string to split
+  - prefix the initial string that is used for the version prefix. It can be nil or empty, in which case it's
+    ignored. If not empty and the given version string doesn't start with this prefix, this prefix is ignored.
+*/
+func SplitEpochWithPrefix(s string, prefix *string) (int, string) {
+	if prefix == nil || !strings.HasPrefix(s, *prefix) {
+		return SplitEpoch(s)
+	}
+	epoch, version := SplitEpoch(strings.Replace(s, *prefix, "", 1))
+	if epoch == 0 {
+		return SplitEpoch(s)
+	}
+	return epoch, *prefix + version
+}
+
+/*
+Returns the given version string with the given epoch, or the string unchanged when the epoch is 0 or negative.
+This is the opposite of SplitEpoch, so the prefix, if any, must be prepended to the returned string by the caller.
+
+Arguments are as follows:
+
+- epoch the epoch to prepend to the version
+- s the version string, without the prefix and the epoch
+*/
+func JoinEpoch(epoch int, s string) string {
+	if epoch <= 0 {
+		return s
+	}
+	return strconv.Itoa(epoch) + EPOCH_DELIMITER + s
+}
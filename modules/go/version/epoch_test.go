@@ -0,0 +1,109 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+func TestEpochSplit(t *testing.T) {
+	epoch, s := SplitEpoch("1.4.0")
+	assert.Equal(t, 0, epoch)
+	assert.Equal(t, "1.4.0", s)
+
+	epoch, s = SplitEpoch("2!1.4.0")
+	assert.Equal(t, 2, epoch)
+	assert.Equal(t, "1.4.0", s)
+
+	epoch, s = SplitEpoch("12!1.4.0-alpha.1+build.2")
+	assert.Equal(t, 12, epoch)
+	assert.Equal(t, "1.4.0-alpha.1+build.2", s)
+
+	epoch, s = SplitEpoch("0!1.4.0")
+	assert.Equal(t, 0, epoch)
+	assert.Equal(t, "1.4.0", s)
+}
+
+func TestEpochSplitWithoutEpoch(t *testing.T) {
+	for _, v := range []string{"", "!", "!1.4.0", "v!1.4.0", "2!", "1.4.0-alpha!", "v2!1.4.0", "1.0.0-rc1!2.0.0", "1.0!2.0.0"} {
+		epoch, s := SplitEpoch(v)
+		assert.Equal(t, 0, epoch, v)
+		assert.Equal(t, v, s, v)
+	}
+}
+
+func TestEpochSplitWithLenience(t *testing.T) {
+	epoch, s := SplitEpochWithLenience("v12!1.4.0-alpha.1+build.2", true)
+	assert.Equal(t, 12, epoch)
+	assert.Equal(t, "v1.4.0-alpha.1+build.2", s)
+
+	epoch, s = SplitEpochWithLenience("release-2!1.4.0", true)
+	assert.Equal(t, 2, epoch)
+	assert.Equal(t, "release-1.4.0", s)
+
+	epoch, s = SplitEpochWithLenience("v12!1.4.0", false)
+	assert.Equal(t, 0, epoch)
+	assert.Equal(t, "v12!1.4.0", s)
+
+	// the epoch is only recognized before the version, never in its body
+	for _, v := range []string{"1.0.0-rc1!2.0.0", "v1.0.0-rc1!2.0.0", "v!1.4.0", "v2!"} {
+		epoch, s := SplitEpochWithLenience(v, true)
+		assert.Equal(t, 0, epoch, v)
+		assert.Equal(t, v, s, v)
+	}
+}
+
+func TestEpochSplitWithPrefix(t *testing.T) {
+	epoch, s := SplitEpochWithPrefix("v2!1.4.0", strptr("v"))
+	assert.Equal(t, 2, epoch)
+	assert.Equal(t, "v1.4.0", s)
+
+	epoch, s = SplitEpochWithPrefix("rel1-2!1.4.0", strptr("rel1-"))
+	assert.Equal(t, 2, epoch)
+	assert.Equal(t, "rel1-1.4.0", s)
+
+	epoch, s = SplitEpochWithPrefix("2!1.4.0", strptr("v"))
+	assert.Equal(t, 2, epoch)
+	assert.Equal(t, "1.4.0", s)
+
+	epoch, s = SplitEpochWithPrefix("2!1.4.0", nil)
+	assert.Equal(t, 2, epoch)
+	assert.Equal(t, "1.4.0", s)
+
+	for _, v := range []string{"r2!1.4.0", "v1.0.0-rc1!2.0.0"} {
+		epoch, s := SplitEpochWithPrefix(v, strptr("v"))
+		assert.Equal(t, 0, epoch, v)
+		assert.Equal(t, v, s, v)
+	}
+}
+
+func TestEpochJoin(t *testing.T) {
+	assert.Equal(t, "1.4.0", JoinEpoch(0, "1.4.0"))
+	assert.Equal(t, "1.4.0", JoinEpoch(-1, "1.4.0"))
+	assert.Equal(t, "2!1.4.0", JoinEpoch(2, "1.4.0"))
+
+	epoch, s := SplitEpoch(JoinEpoch(3, "1.4.0-rc.1"))
+	assert.Equal(t, 3, epoch)
+	assert.Equal(t, "1.4.0-rc.1", s)
+}
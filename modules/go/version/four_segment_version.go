@@ -0,0 +1,537 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strconv" // https://pkg.go.dev/strconv
+	"strings" // https://pkg.go.dev/strings
+
+	regexp2 "github.com/dlclark/regexp2" // https://pkg.go.dev/github.com/dlclark/regexp2
+)
+
+const (
+	// The default initial version that can be used when non version is yet available.
+	FOUR_SEGMENT_VERSION_DEFAULT_INITIAL_VERSION = "0.1.0.0"
+
+	// The name of the identifier used to bump the fourth number, following major, minor and patch.
+	REVISION_IDENTIFIER = "revision"
+
+	// A relaxed version of the FOUR_SEGMENT_VERSION_PATTERN that works also when a prefix appears at the beginning
+	// of the version string, some zeroes appear in front of numbers or the fourth number is missing.
+	FOUR_SEGMENT_VERSION_PATTERN_RELAXED = "([0-9]\\d*)\\.([0-9]\\d*)\\.([0-9]\\d*)(?:\\.([0-9]\\d*))?(?:-((?:[0-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\\.(?:[0-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\\+([0-9a-zA-Z-]+(?:\\.[0-9a-zA-Z-]+)*))?$"
+
+	// The regexp pattern used to parse four segment versions. The pre-release and build parts follow the same rules
+	// as in Semantic Versioning.
+	FOUR_SEGMENT_VERSION_PATTERN = "^(0|[1-9]\\d*)\\.(0|[1-9]\\d*)\\.(0|[1-9]\\d*)\\.(0|[1-9]\\d*)(?:-((?:0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\\.(?:0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\\+([0-9a-zA-Z-]+(?:\\.[0-9a-zA-Z-]+)*))?$"
+)
+
+/*
+The implementation of a four segment version (major.minor.patch.revision), as used by ecosystems like Maven or
+NuGet that require a fourth revision number.
+
+Besides the fourth number, these versions behave like semantic versions so they can have optional pre-release and
+build parts (i.e. 1.2.3.4-alpha.1+build.5), which are ordered and bumped with the same rules.
+
+Instances of this class are immutable so whenever you alter some values you actually receive a
+new instance holding the new value, while the old one remains unchanged.
+*/
+type FourSegmentVersion struct {
+	// The major, minor, patch and revision numbers.
+	numbers [4]int
+
+	// The identifier of the pre-release part of the version. It may be nil.
+	prereleaseIdentifier *semanticVersionPreReleaseIdentifier
+
+	// The identifier of the build part of the version. It may be nil.
+	buildIdentifier *semanticVersionBuildIdentifier
+}
+
+/*
+Builds the version with the given core identifier values.
+
+Arguments are as follows:
+
+- major the major number
+- minor the minor number
+- patch the patch number
+- revision the revision number
+
+Errors can be returned if:
+
+- some number is negative
+*/
+func NewFourSegmentVersionWith(major int, minor int, patch int, revision int) (FourSegmentVersion, error) {
+	if major < 0 || minor < 0 || patch < 0 || revision < 0 {
+		return FourSegmentVersion{}, fmt.Errorf("version numbers can't be negative: %d.%d.%d.%d", major, minor, patch, revision)
+	}
+	return FourSegmentVersion{numbers: [4]int{major, minor, patch, revision}}, nil
+}
+
+/*
+Returns a FourSegmentVersion instance representing the specified String value. No sanitization attempt is done.
+
+Arguments are as follows:
+
+- s the string to parse
+
+Errors can be returned if:
+
+- the given string doesn't represent a legal four segment version
+*/
+func ValueOfFourSegmentVersion(s string) (FourSegmentVersion, error) {
+	if "" == s {
+		return FourSegmentVersion{}, fmt.Errorf("can't parse an empty string")
+	}
+
+	re, err := regexp2.Compile(FOUR_SEGMENT_VERSION_PATTERN, 0)
+	if err != nil {
+		return FourSegmentVersion{}, fmt.Errorf("regular expression '%s' can't be compiled: %w", FOUR_SEGMENT_VERSION_PATTERN, err)
+	}
+	m, err := re.FindStringMatch(s)
+	if err != nil {
+		return FourSegmentVersion{}, fmt.Errorf("regular expression '%s' can't be matched: %w", FOUR_SEGMENT_VERSION_PATTERN, err)
+	}
+	if m == nil {
+		return FourSegmentVersion{}, fmt.Errorf("the string '%s' does not contain a valid four segment version", s)
+	}
+
+	// groups 1 to 4 are the core numbers, group 5 (optional) is the prerelease and group 6 (optional) is the build
+	res := FourSegmentVersion{}
+	for i := 0; i < 4; i++ {
+		res.numbers[i], err = strconv.Atoi(m.GroupByNumber(i + 1).Captures[0].String())
+		if err != nil {
+			return FourSegmentVersion{}, fmt.Errorf("numeric identifiers in string '%s' can't be converted to valid integers", s)
+		}
+	}
+	if len(m.GroupByNumber(5).Captures) > 0 && "" != m.GroupByNumber(5).Captures[0].String() {
+		pi, err := valueOfSemanticVersionPreReleaseIdentifierFromString(true, m.GroupByNumber(5).Captures[0].String())
+		if err != nil {
+			return FourSegmentVersion{}, err
+		}
+		res.prereleaseIdentifier = &pi
+	}
+	if len(m.GroupByNumber(6).Captures) > 0 && "" != m.GroupByNumber(6).Captures[0].String() {
+		bi, err := valueOfSemanticVersionBuildIdentifierFromString(true, m.GroupByNumber(6).Captures[0].String())
+		if err != nil {
+			return FourSegmentVersion{}, err
+		}
+		res.buildIdentifier = &bi
+	}
+	return res, nil
+}
+
+/*
+This method is a shorthand for ValueOfFourSegmentVersion and SanitizeFourSegmentVersion.
+
+Returns a FourSegmentVersion instance representing the specified String value. If sanitize is
+true this method will try to sanitize the given string before parsing so that if there are
+illegal characters like a prefix or leading zeroes in numeric identifiers they are removed and a missing
+fourth number is assumed to be zero.
+
+Arguments are as follows:
+
+- s the string to parse
+- sanitize optionally enables sanitization before parsing
+
+Errors can be returned if:
+
+- the given string doesn't represent a legal four segment version
+*/
+func ValueOfFourSegmentVersionWithSanitization(s string, sanitize bool) (FourSegmentVersion, error) {
+	if sanitize {
+		sanitizedVersion, err := SanitizeFourSegmentVersion(s)
+		if err != nil {
+			return FourSegmentVersion{}, err
+		}
+		return ValueOfFourSegmentVersion(sanitizedVersion)
+	} else {
+		return ValueOfFourSegmentVersion(s)
+	}
+}
+
+/*
+Takes the given string and tries to parse it as a four segment version, even with a prefix (like the 'v' often
+used in Git tags), leading zeroes in numeric identifiers or with the fourth number missing, which is then assumed
+to be zero. This allows reading the tags applied with three numbers only, before moving to four segment versions.
+
+Arguments are as follows:
+
+- s the version string to sanitize
+
+Errors can be returned if:
+
+- the given string doesn't represent a legal four segment version, even tolerating the aspects to sanitize
+*/
+func SanitizeFourSegmentVersion(s string) (string, error) {
+	if "" == s {
+		return "", fmt.Errorf("can't sanitize an empty string")
+	}
+
+	re, err := regexp2.Compile(FOUR_SEGMENT_VERSION_PATTERN_RELAXED, 0)
+	if err != nil {
+		return "", fmt.Errorf("regular expression '%s' can't be compiled: %w", FOUR_SEGMENT_VERSION_PATTERN_RELAXED, err)
+	}
+	m, err := re.FindStringMatch(s)
+	if err != nil {
+		return "", fmt.Errorf("regular expression '%s' can't be matched: %w", FOUR_SEGMENT_VERSION_PATTERN_RELAXED, err)
+	}
+	if m == nil {
+		return "", fmt.Errorf("the string '%s' does not contain a valid four segment version", s)
+	}
+
+	var result strings.Builder
+	for i := 1; i <= 4; i++ {
+		number := 0
+		if len(m.GroupByNumber(i).Captures) > 0 {
+			number, err = strconv.Atoi(m.GroupByNumber(i).Captures[0].String())
+			if err != nil {
+				return "", fmt.Errorf("numeric identifiers in string '%s' can't be converted to valid integers", s)
+			}
+		}
+		if i > 1 {
+			result.WriteString(DEFAULT_SEPARATOR)
+		}
+		result.WriteString(strconv.Itoa(number))
+	}
+	if len(m.GroupByNumber(5).Captures) > 0 && "" != m.GroupByNumber(5).Captures[0].String() {
+		result.WriteString(PRERELEASE_DELIMITER)
+		identifiers := strings.Split(m.GroupByNumber(5).Captures[0].String(), DEFAULT_SEPARATOR)
+		for i, identifier := range identifiers {
+			if i > 0 {
+				result.WriteString(DEFAULT_SEPARATOR)
+			}
+			integerIdentifier, err := strconv.Atoi(identifier)
+			if err == nil {
+				result.WriteString(strconv.Itoa(integerIdentifier))
+			} else {
+				result.WriteString(identifier)
+			}
+		}
+	}
+	if len(m.GroupByNumber(6).Captures) > 0 && "" != m.GroupByNumber(6).Captures[0].String() {
+		result.WriteString(BUILD_DELIMITER)
+		result.WriteString(m.GroupByNumber(6).Captures[0].String())
+	}
+	return result.String(), nil
+}
+
+/*
+Returns true if the given string is a legal four segment version which, for example, can be parsed using
+ValueOfFourSegmentVersion(string) without errors.
+
+Arguments are as follows:
+
+- s the string version to check.
+*/
+func IsLegalFourSegmentVersion(s string) bool {
+	return IsLegalFourSegmentVersionWithLenience(s, false)
+}
+
+/*
+Returns true if the given string is a legal four segment version which, for example, can be parsed using
+ValueOfFourSegmentVersionWithSanitization(string, bool) without errors.
+
+Arguments are as follows:
+
+  - s the string version to check.
+  - lenient when true prefixes, leading zeroes and a missing fourth number are tolerated even if they are
+    not strictly legal
+*/
+func IsLegalFourSegmentVersionWithLenience(s string, lenient bool) bool {
+	_, err := ValueOfFourSegmentVersionWithSanitization(s, lenient)
+	return err == nil
+}
+
+/*
+Indicates whether some other object is "equal to" this one. This object equals to the given one if they are the
+same object or they are of the same type and hold exactly the same version.
+
+Arguments are as follows:
+
+- obj the reference object with which to compare.
+*/
+func (fsv FourSegmentVersion) Equals(obj interface{}) bool {
+	if obj == nil {
+		return false
+	}
+	other, ok := obj.(FourSegmentVersion)
+	if !ok {
+		return false
+	}
+	return fsv.String() == other.String()
+}
+
+/*
+Compares this version with the given one for order. Returns a negative integer, zero, or a positive integer as
+this object is less than, equal to, or greater than the specified object.
+
+The major, minor, patch and revision numbers are compared first, in this order, then the pre-release and build
+parts are compared with the same rules used by SemanticVersion.CompareTo.
+
+Arguments are as follows:
+
+- v the version to be compared.
+*/
+func (fsv FourSegmentVersion) CompareTo(v FourSegmentVersion) int {
+	return fsv.compareTo(v, nil)
+}
+
+/*
+Compares this version with the given one for order, just like CompareTo, but the pre-release part is compared
+with the same rules used by SemanticVersion.CompareToWithPrereleaseOrder.
+
+Arguments are as follows:
+
+- v the version to be compared.
+- prereleaseOrder the pre-release identifiers, from the lowest to the highest precedence. It may be nil.
+*/
+func (fsv FourSegmentVersion) CompareToWithPrereleaseOrder(v FourSegmentVersion, prereleaseOrder []string) int {
+	return fsv.compareTo(v, prereleaseOrder)
+}
+
+/*
+Implements CompareTo and CompareToWithPrereleaseOrder. prereleaseOrder may be nil.
+*/
+func (fsv FourSegmentVersion) compareTo(v FourSegmentVersion, prereleaseOrder []string) int {
+	for i := 0; i < 4; i++ {
+		if fsv.numbers[i] != v.numbers[i] {
+			return fsv.numbers[i] - v.numbers[i]
+		}
+	}
+
+	// the core numbers are the same so the rest can be compared as per Semantic Versioning
+	coreIdentifier, err := newSemanticVersionCoreIdentifierFromIntegers(0, 0, 0)
+	if err != nil {
+		panic("unable to build the core identifier for comparison")
+	}
+	sv1, _ := newSemanticVersion(&coreIdentifier, fsv.prereleaseIdentifier, fsv.buildIdentifier)
+	sv2, _ := newSemanticVersion(&coreIdentifier, v.prereleaseIdentifier, v.buildIdentifier)
+	return sv1.compareTo(sv2, prereleaseOrder)
+}
+
+/*
+Returns FOUR_SEGMENT.
+*/
+func (fsv FourSegmentVersion) GetScheme() Scheme {
+	return FOUR_SEGMENT
+}
+
+/*
+Returns a string representation of the object.
+*/
+func (fsv FourSegmentVersion) String() string {
+	var sb strings.Builder
+	sb.WriteString(fsv.GetCore())
+	if fsv.prereleaseIdentifier != nil {
+		sb.WriteString(PRERELEASE_DELIMITER)
+		sb.WriteString(fsv.prereleaseIdentifier.String())
+	}
+	if fsv.buildIdentifier != nil {
+		sb.WriteString(BUILD_DELIMITER)
+		sb.WriteString(fsv.buildIdentifier.String())
+	}
+	return sb.String()
+}
+
+/*
+Returns the major number.
+*/
+func (fsv FourSegmentVersion) GetMajor() int {
+	return fsv.numbers[0]
+}
+
+/*
+Returns the minor number.
+*/
+func (fsv FourSegmentVersion) GetMinor() int {
+	return fsv.numbers[1]
+}
+
+/*
+Returns the patch number.
+*/
+func (fsv FourSegmentVersion) GetPatch() int {
+	return fsv.numbers[2]
+}
+
+/*
+Returns the revision number.
+*/
+func (fsv FourSegmentVersion) GetRevision() int {
+	return fsv.numbers[3]
+}
+
+/*
+Returns the core part (major.minor.patch.revision) of the version as a string.
+*/
+func (fsv FourSegmentVersion) GetCore() string {
+	return fmt.Sprintf("%d.%d.%d.%d", fsv.numbers[0], fsv.numbers[1], fsv.numbers[2], fsv.numbers[3])
+}
+
+/*
+Returns the pre-release part of the version, if any. It returns nil if the version doesn't have a pre-release part.
+*/
+func (fsv FourSegmentVersion) GetPrerelease() *string {
+	if fsv.prereleaseIdentifier == nil {
+		return nil
+	}
+	s := fsv.prereleaseIdentifier.String()
+	return &s
+}
+
+/*
+Returns the build part of the version, if any. It returns nil if the version doesn't have a build part.
+*/
+func (fsv FourSegmentVersion) GetBuild() *string {
+	if fsv.buildIdentifier == nil {
+		return nil
+	}
+	s := fsv.buildIdentifier.String()
+	return &s
+}
+
+/*
+Returns a new instance with the number identified by the given value bumped. If the given value is one of major,
+minor, patch or revision then that number is bumped and the following ones are reset to zero, otherwise the
+given id is used to bump a prerelease identifier, with the same rules as SemanticVersion.BumpPrerelease.
+Prerelease and build parts are left intact when bumping core numbers.
+
+Arguments are as follows:
+
+- id the name of the identifier to bump
+
+Errors can be returned if:
+
+- the given string is empty, contains illegal characters or represents a number
+*/
+func (fsv FourSegmentVersion) Bump(id string) (FourSegmentVersion, error) {
+	if "" == id {
+		return FourSegmentVersion{}, fmt.Errorf("can't bump an empty identifier")
+	}
+
+	position := fourSegmentVersionCorePosition(id)
+	if position >= 0 {
+		res := FourSegmentVersion{numbers: fsv.numbers, prereleaseIdentifier: fsv.prereleaseIdentifier, buildIdentifier: fsv.buildIdentifier}
+		res.numbers[position]++
+		for i := position + 1; i < 4; i++ {
+			res.numbers[i] = 0
+		}
+		return res, nil
+	}
+
+	_, err := strconv.Atoi(id)
+	if err == nil {
+		// it's a number and can't be bumped
+		return FourSegmentVersion{}, fmt.Errorf("the value '%s' is numeric and can't be used as a string identifier in the prerelease", id)
+	}
+	var pri semanticVersionPreReleaseIdentifier
+	if fsv.prereleaseIdentifier == nil {
+		pri, err = valueOfSemanticVersionPreReleaseIdentifierFromObjects(false, id, DEFAULT_BUMP_VALUE)
+	} else {
+		pri, err = fsv.prereleaseIdentifier.bump(id, DEFAULT_BUMP_VALUE)
+	}
+	if err != nil {
+		return FourSegmentVersion{}, err
+	}
+	return FourSegmentVersion{numbers: fsv.numbers, prereleaseIdentifier: &pri, buildIdentifier: fsv.buildIdentifier}, nil
+}
+
+/*
+Returns a new instance with the number identified by the given value bumped.
+This is the same as Bump method but returns a generic object.
+
+Arguments are as follows:
+
+- id the name of the identifier to bump
+
+Errors can be returned if:
+
+- the given string is empty, contains illegal characters or does not represent
+a valid identifier to be bumped
+*/
+func (fsv FourSegmentVersion) BumpVersion(id string) (Version, error) {
+	return fsv.Bump(id)
+}
+
+/*
+Returns a copy of the given bump identifiers where each occurrence of the given significance is replaced by
+REVISION_IDENTIFIER, so that changes with that significance bump the fourth number instead.
+
+Arguments are as follows:
+
+- identifiers the bump identifiers to map
+- significance the name of the core identifier (major, minor or patch) to map to the fourth number
+
+Errors can be returned if:
+
+- the given significance is not major, minor or patch
+*/
+func MapFourSegmentRevisionSignificance(identifiers []string, significance string) ([]string, error) {
+	position := fourSegmentVersionCorePosition(significance)
+	if position < 0 || position > 2 {
+		return nil, fmt.Errorf("the significance mapped to the fourth number must be one of '%s', '%s' or '%s' while '%s' was given", MAJOR.GetName(), MINOR.GetName(), PATCH.GetName(), significance)
+	}
+	res := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		if identifier == significance {
+			res[i] = REVISION_IDENTIFIER
+		} else {
+			res[i] = identifier
+		}
+	}
+	return res, nil
+}
+
+/*
+Returns the position of the core number with the given name (0 for major, 1 for minor, 2 for patch and 3 for
+revision) or -1 if the given name is not a core identifier.
+*/
+func fourSegmentVersionCorePosition(id string) int {
+	switch id {
+	case MAJOR.GetName():
+		return 0
+	case MINOR.GetName():
+		return 1
+	case PATCH.GetName():
+		return 2
+	case REVISION_IDENTIFIER:
+		return 3
+	default:
+		return -1
+	}
+}
+
+/*
+A comparator for identifier names, sorting them by their relevance for four segment versions, provided that
+core identifiers come first (as major, minor, patch, revision) and other identifiers are sorted by their natural
+order.
+
+Returns a negative integer, zero, or a positive integer as the first argument is less than, equal to, or greater than the second.
+*/
+func compareFourSegmentVersionIdentifiers(i1 string, i2 string) int {
+	p1 := fourSegmentVersionCorePosition(i1)
+	p2 := fourSegmentVersionCorePosition(i2)
+	if p1 >= 0 && p2 >= 0 {
+		return p1 - p2
+	} else if p1 >= 0 {
+		return -1
+	} else if p2 >= 0 {
+		return 1
+	}
+	return strings.Compare(i1, i2)
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+/*
+The implementation of the four segment (major.minor.patch.revision) scheme.
+*/
+type fourSegmentVersionScheme struct {
+}
+
+/*
+Returns FOUR_SEGMENT.
+*/
+func (s fourSegmentVersionScheme) GetScheme() Scheme {
+	return FOUR_SEGMENT
+}
+
+/*
+Returns true if the given string is a legal four segment version.
+*/
+func (s fourSegmentVersionScheme) IsLegal(v string, lenient bool) bool {
+	return IsLegalFourSegmentVersionWithLenience(v, lenient)
+}
+
+/*
+Returns the FourSegmentVersion represented by the given string.
+*/
+func (s fourSegmentVersionScheme) Parse(v string, sanitize bool) (Version, error) {
+	return ValueOfFourSegmentVersionWithSanitization(v, sanitize)
+}
+
+/*
+Compares the given four segment versions as per FourSegmentVersion.CompareTo.
+*/
+func (s fourSegmentVersionScheme) Compare(v1 Version, v2 Version) int {
+	return v1.(FourSegmentVersion).CompareTo(v2.(FourSegmentVersion))
+}
+
+/*
+Compares the given four segment versions as per FourSegmentVersion.CompareToWithPrereleaseOrder.
+*/
+func (s fourSegmentVersionScheme) CompareWithPrereleaseOrder(v1 Version, v2 Version, prereleaseOrder []string) int {
+	return v1.(FourSegmentVersion).CompareToWithPrereleaseOrder(v2.(FourSegmentVersion), prereleaseOrder)
+}
+
+/*
+Returns true if the given four segment version has no pre-release and build identifiers.
+*/
+func (s fourSegmentVersionScheme) IsCore(v Version) bool {
+	return v.(FourSegmentVersion).GetPrerelease() == nil && v.(FourSegmentVersion).GetBuild() == nil
+}
+
+/*
+Returns the FOUR_SEGMENT_VERSION_DEFAULT_INITIAL_VERSION.
+*/
+func (s fourSegmentVersionScheme) DefaultInitial() Version {
+	res, err := ValueOfFourSegmentVersion(FOUR_SEGMENT_VERSION_DEFAULT_INITIAL_VERSION)
+	if err != nil {
+		panic("unable to build a new four segment version from the default initial value")
+	}
+	return res
+}
+
+/*
+Returns the most relevant identifier, with core identifiers (major, minor, patch and revision) coming first.
+*/
+func (s fourSegmentVersionScheme) MostRelevantIdentifierIn(identifiers []string) string {
+	res := identifiers[0]
+	for _, identifier := range identifiers[1:] {
+		if compareFourSegmentVersionIdentifiers(identifier, res) < 0 {
+			res = identifier
+		}
+	}
+	return res
+}
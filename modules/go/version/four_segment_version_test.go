@@ -0,0 +1,174 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+func TestFourSegmentVersionValueOfFourSegmentVersion(t *testing.T) {
+	for _, s := range []string{"0.0.0.0", "1.2.3.4", "10.20.30.40", "1.2.3.4-alpha.1", "1.2.3.4+build.5", "1.2.3.4-alpha.1+build.5"} {
+		v, err := ValueOfFourSegmentVersion(s)
+		assert.NoError(t, err)
+		assert.Equal(t, s, v.String())
+		assert.True(t, IsLegalFourSegmentVersion(s))
+	}
+	for _, s := range []string{"", "1.2.3", "1.2.3.4.5", "v1.2.3.4", "01.2.3.4", "1.2.3.-4", "1.2.3.4-"} {
+		_, err := ValueOfFourSegmentVersion(s)
+		assert.Error(t, err, "version '%s' is expected to be illegal", s)
+		assert.False(t, IsLegalFourSegmentVersion(s))
+	}
+
+	v, err := ValueOfFourSegmentVersion("1.2.3.4-alpha.1+build.5")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v.GetMajor())
+	assert.Equal(t, 2, v.GetMinor())
+	assert.Equal(t, 3, v.GetPatch())
+	assert.Equal(t, 4, v.GetRevision())
+	assert.Equal(t, "1.2.3.4", v.GetCore())
+	assert.Equal(t, "alpha.1", *v.GetPrerelease())
+	assert.Equal(t, "build.5", *v.GetBuild())
+	assert.Equal(t, FOUR_SEGMENT, v.GetScheme())
+}
+
+func TestFourSegmentVersionValueOfFourSegmentVersionWithSanitization(t *testing.T) {
+	for s, expected := range map[string]string{
+		"1.2.3.4":            "1.2.3.4",
+		"v1.2.3.4":           "1.2.3.4",
+		"rel-01.02.03.04":    "1.2.3.4",
+		"v1.2.3":             "1.2.3.0",
+		"v1.2.3-alpha.01":    "1.2.3.0-alpha.1",
+		"v1.2.3.4+build.005": "1.2.3.4+build.005",
+	} {
+		v, err := ValueOfFourSegmentVersionWithSanitization(s, true)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v.String())
+		assert.True(t, IsLegalFourSegmentVersionWithLenience(s, true))
+	}
+	_, err := ValueOfFourSegmentVersionWithSanitization("v1.2", true)
+	assert.Error(t, err)
+	assert.False(t, IsLegalFourSegmentVersionWithLenience("v1.2.3", false))
+}
+
+func TestFourSegmentVersionCompareTo(t *testing.T) {
+	ordered := []string{"0.0.0.0", "0.0.0.1", "0.0.1.0", "0.1.0.0", "1.0.0.0-alpha.1", "1.0.0.0-alpha.2", "1.0.0.0-beta", "1.0.0.0", "1.0.0.9", "1.0.0.10", "1.0.1.0", "2.0.0.0"}
+	for i := 0; i < len(ordered); i++ {
+		for j := 0; j < len(ordered); j++ {
+			v1, _ := ValueOfFourSegmentVersion(ordered[i])
+			v2, _ := ValueOfFourSegmentVersion(ordered[j])
+			switch {
+			case i < j:
+				assert.Less(t, v1.CompareTo(v2), 0, "'%s' is expected to be less than '%s'", ordered[i], ordered[j])
+			case i > j:
+				assert.Greater(t, v1.CompareTo(v2), 0, "'%s' is expected to be greater than '%s'", ordered[i], ordered[j])
+			default:
+				assert.Equal(t, 0, v1.CompareTo(v2))
+				assert.True(t, v1.Equals(v2))
+			}
+		}
+	}
+}
+
+func TestFourSegmentVersionCompareToWithPrereleaseOrder(t *testing.T) {
+	prereleaseOrder := []string{"dev", "alpha", "beta", "rc"}
+	ordered := []string{"1.0.0.0-dev", "1.0.0.0-alpha.1", "1.0.0.0-beta", "1.0.0.0-rc.1", "1.0.0.0", "1.0.0.1-dev"}
+	for i := 0; i < len(ordered); i++ {
+		for j := 0; j < len(ordered); j++ {
+			v1, _ := ValueOfFourSegmentVersion(ordered[i])
+			v2, _ := ValueOfFourSegmentVersion(ordered[j])
+			switch {
+			case i < j:
+				assert.Less(t, v1.CompareToWithPrereleaseOrder(v2, prereleaseOrder), 0, "'%s' is expected to be less than '%s'", ordered[i], ordered[j])
+			case i > j:
+				assert.Greater(t, v1.CompareToWithPrereleaseOrder(v2, prereleaseOrder), 0, "'%s' is expected to be greater than '%s'", ordered[i], ordered[j])
+			default:
+				assert.Equal(t, 0, v1.CompareToWithPrereleaseOrder(v2, prereleaseOrder))
+			}
+		}
+	}
+}
+
+func TestFourSegmentVersionBump(t *testing.T) {
+	v, _ := ValueOfFourSegmentVersion("1.2.3.4")
+	for id, expected := range map[string]string{
+		"major":    "2.0.0.0",
+		"minor":    "1.3.0.0",
+		"patch":    "1.2.4.0",
+		"revision": "1.2.3.5",
+		"alpha":    "1.2.3.4-alpha.1",
+	} {
+		b, err := v.Bump(id)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, b.String())
+		bv, err := v.BumpVersion(id)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, bv.String())
+	}
+
+	v, _ = ValueOfFourSegmentVersion("1.2.3.4-alpha.1")
+	b, err := v.Bump("alpha")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3.4-alpha.2", b.String())
+
+	_, err = v.Bump("")
+	assert.Error(t, err)
+	_, err = v.Bump("123")
+	assert.Error(t, err)
+}
+
+func TestFourSegmentVersionMapFourSegmentRevisionSignificance(t *testing.T) {
+	identifiers, err := MapFourSegmentRevisionSignificance([]string{"patch", "alpha", "minor", "patch"}, "patch")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"revision", "alpha", "minor", "revision"}, identifiers)
+
+	identifiers, err = MapFourSegmentRevisionSignificance([]string{"major", "minor"}, "patch")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"major", "minor"}, identifiers)
+
+	identifiers, err = MapFourSegmentRevisionSignificance([]string{}, "minor")
+	assert.NoError(t, err)
+	assert.Empty(t, identifiers)
+
+	for _, significance := range []string{"", "revision", "alpha"} {
+		_, err = MapFourSegmentRevisionSignificance([]string{"patch"}, significance)
+		assert.Error(t, err)
+	}
+}
+
+func TestFourSegmentVersionUsedByVersionFunctions(t *testing.T) {
+	assert.Equal(t, FOUR_SEGMENT_VERSION_DEFAULT_INITIAL_VERSION, DefaultInitial(FOUR_SEGMENT).String())
+	assert.True(t, IsLegal(FOUR_SEGMENT, "1.2.3.4"))
+	assert.False(t, IsLegal(FOUR_SEGMENT, "1.2.3"))
+	assert.True(t, IsLegalWithPrefix(FOUR_SEGMENT, "v1.2.3.4", strptr("v")))
+	assert.True(t, IsCore(FOUR_SEGMENT, "1.2.3.4"))
+	assert.False(t, IsCore(FOUR_SEGMENT, "1.2.3.4-alpha.1"))
+	assert.Less(t, Compare(FOUR_SEGMENT, strptr("1.2.3.4"), strptr("1.2.3.10")), 0)
+	assert.Equal(t, "revision", *MostRelevantIdentifierIn(FOUR_SEGMENT, []string{"alpha", "revision"}))
+	assert.Equal(t, "patch", *MostRelevantIdentifierIn(FOUR_SEGMENT, []string{"alpha", "revision", "patch"}))
+	assert.Equal(t, "major", *MostRelevantIdentifierBetween(FOUR_SEGMENT, strptr("revision"), strptr("major")))
+
+	v, err := ValueOfWithSanitization(FOUR_SEGMENT, "v1.2.3", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3.0", v.String())
+}
@@ -16,16 +16,16 @@
 
 package version
 
-import (
-	"fmt" // https://pkg.go.dev/fmt
-)
-
 /*
-The values of this enum are used to select the versioning scheme to use.
+The values of this enum are used to select the versioning scheme to use. Besides the built in values, the schemes
+registered with RegisterVersionScheme can be used.
 */
 type Scheme string
 
 const (
+	// The four segment (major.minor.patch.revision) scheme, used by ecosystems like Maven or NuGet.
+	FOUR_SEGMENT Scheme = "FOUR_SEGMENT"
+
 	// The Maven versioning scheme.
 	// TODO: uncomment this value as per https://github.com/mooltiverse/nyx/issues/4. As of now this is just a placeholder.
 	//MAVEN Scheme = "MAVEN"
@@ -39,13 +39,14 @@ Returns the string representation of the scheme
 */
 func (s Scheme) String() string {
 	switch s {
+	case FOUR_SEGMENT:
+		return "FOUR_SEGMENT"
 	//case MAVEN:
 	//	return "MAVEN"
 	case SEMVER:
 		return "SEMVER"
 	default:
-		// this is never reached, but in case...
-		panic("unknown Scheme. This means the switch/case statement needs to be updated")
+		return string(mustVersionSchemeOf(s).GetScheme())
 	}
 }
 
@@ -58,11 +59,17 @@ Errors can be returned:
 */
 func ValueOfScheme(s string) (Scheme, error) {
 	switch s {
+	case "FOUR_SEGMENT":
+		return FOUR_SEGMENT, nil
 	//case "MAVEN":
 	//	return MAVEN, nil
 	case "SEMVER":
 		return SEMVER, nil
 	default:
-		return SEMVER, fmt.Errorf("illegal scheme '%s'", s)
+		implementation, err := VersionSchemeOf(Scheme(s))
+		if err != nil {
+			return SEMVER, err
+		}
+		return implementation.GetScheme(), nil
 	}
 }
@@ -28,15 +28,19 @@ import (
 )
 
 func TestSchemeString(t *testing.T) {
+	assert.Equal(t, "FOUR_SEGMENT", FOUR_SEGMENT.String())
 	//assert.Equal(t, "MAVEN", MAVEN.String())
 	assert.Equal(t, "SEMVER", SEMVER.String())
 }
 
 func TestSchemeValueOfScheme(t *testing.T) {
+	scheme, err := ValueOfScheme("FOUR_SEGMENT")
+	assert.NoError(t, err)
+	assert.Equal(t, FOUR_SEGMENT, scheme)
 	//scheme, err := ValueOfScheme("MAVEN")
 	//assert.NoError(t, err)
 	//assert.Equal(t, MAVEN, scheme)
-	scheme, err := ValueOfScheme("SEMVER")
+	scheme, err = ValueOfScheme("SEMVER")
 	assert.NoError(t, err)
 	assert.Equal(t, SEMVER, scheme)
 }
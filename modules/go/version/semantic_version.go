@@ -43,6 +43,11 @@ const (
 	// of the version string or some zeroes appear in front of numbers.
 	SEMANTIC_VERSION_PATTERN_RELAXED = "([0-9]\\d*)\\.([0-9]\\d*)\\.([0-9]\\d*)(?:-((?:[0-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\\.(?:[0-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\\+([0-9a-zA-Z-]+(?:\\.[0-9a-zA-Z-]+)*))?$"
 
+	// A loose pattern used to coerce near-semver strings (like '1.2', 'v1.2.3.4' or 'release-1.2.3') into semantic
+	// versions. It matches two or more dot separated numbers, regardless of any prefix, optionally followed by a
+	// prerelease and a build part up to the end of the string. Numbers after the third one are matched but ignored.
+	SEMANTIC_VERSION_PATTERN_COERCIBLE = "([0-9]+)\\.([0-9]+)(?:\\.([0-9]+))?(?:\\.[0-9]+)*(?:-((?:[0-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\\.(?:[0-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\\+([0-9a-zA-Z-]+(?:\\.[0-9a-zA-Z-]+)*))?$"
+
 	//T he regexp pattern taken directly from >Semantic Versioning 2.0.0 (https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string)
 	// used to parse semantic versions.
 	SEMANTIC_VERSION_PATTERN = "^(0|[1-9]\\d*)\\.(0|[1-9]\\d*)\\.(0|[1-9]\\d*)(?:-((?:0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\\.(?:0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\\+([0-9a-zA-Z-]+(?:\\.[0-9a-zA-Z-]+)*))?$"
@@ -369,6 +374,32 @@ Arguments are as follows:
 - v the version to be compared.
 */
 func (sv SemanticVersion) CompareTo(v SemanticVersion) int {
+	return sv.compareTo(v, nil)
+}
+
+/*
+Compares this version with the specified version for order, just like CompareTo, but string identifiers in the
+pre-release part are compared by their position in the given prereleaseOrder instead of lexically. This allows
+defining a custom precedence for pre-release identifiers, like dev &lt; alpha &lt; beta &lt; rc, when it doesn't
+match their ASCII sort order.
+
+String identifiers that don't appear in prereleaseOrder have lower precedence than those that appear in it and
+are compared lexically among themselves. When prereleaseOrder is nil or empty this method behaves just like
+CompareTo.
+
+Arguments are as follows:
+
+- v the version to be compared.
+- prereleaseOrder the pre-release identifiers, from the lowest to the highest precedence. It may be nil.
+*/
+func (sv SemanticVersion) CompareToWithPrereleaseOrder(v SemanticVersion, prereleaseOrder []string) int {
+	return sv.compareTo(v, prereleaseOrder)
+}
+
+/*
+Implements CompareTo and CompareToWithPrereleaseOrder. prereleaseOrder may be nil.
+*/
+func (sv SemanticVersion) compareTo(v SemanticVersion, prereleaseOrder []string) int {
 	// Rule #9
 	if sv.GetMajor() != v.GetMajor() {
 		return sv.GetMajor() - v.GetMajor()
@@ -430,7 +461,7 @@ func (sv SemanticVersion) CompareTo(v SemanticVersion) int {
 						return 1
 					} else {
 						// Also the other item is a string so let's see how they compare as strings
-						res := strings.Compare(fmt.Sprint(thisItem), fmt.Sprint(otherItem))
+						res := comparePrereleaseStringIdentifiers(fmt.Sprint(thisItem), fmt.Sprint(otherItem), prereleaseOrder)
 						if res != 0 {
 							return res
 						}
@@ -488,6 +519,28 @@ func (sv SemanticVersion) CompareTo(v SemanticVersion) int {
 	return strings.Compare(sv.String(), v.String())
 }
 
+/*
+Compares two string identifiers from the pre-release part. If prereleaseOrder is not empty the identifiers are
+compared by their position in it and those not appearing in it have lower precedence than those appearing in it,
+otherwise, or when none of them appears in it, they are compared lexically in ASCII sort order.
+*/
+func comparePrereleaseStringIdentifiers(i1 string, i2 string, prereleaseOrder []string) int {
+	p1 := -1
+	p2 := -1
+	for i, identifier := range prereleaseOrder {
+		if p1 < 0 && identifier == i1 {
+			p1 = i
+		}
+		if p2 < 0 && identifier == i2 {
+			p2 = i
+		}
+	}
+	if p1 != p2 {
+		return p1 - p2
+	}
+	return strings.Compare(i1, i2)
+}
+
 /*
 Returns SEMVER.
 */
@@ -866,6 +919,80 @@ func GetSemanticVersionPrefix(s string) (*string, error) {
 	}
 }
 
+/*
+Takes the given string and tries to coerce it into a legal semantic version, even when it's not a semantic version
+at all but it just looks like one. This is more tolerant than SanitizeSemanticVersion as, besides removing the
+prefix and leading zeroes, it also:
+  - fills the missing patch number with 0 (i.e. '1.2' becomes '1.2.0')
+  - drops the numbers after the patch (i.e. '1.2.3.4' becomes '1.2.3')
+
+The prerelease and build parts, if any, are retained. At least the major and minor numbers must be present so that
+strings just ending with a number (i.e. 'build-42') are not coerced.
+
+Arguments are as follows:
+
+- s the string to coerce
+
+Errors can be returned if:
+
+- the given string can't be coerced into a legal semantic version
+*/
+func CoerceSemanticVersion(s string) (string, error) {
+	if "" == s {
+		return "", fmt.Errorf("can't coerce an empty string")
+	}
+
+	re, err := regexp2.Compile(SEMANTIC_VERSION_PATTERN_COERCIBLE, 0)
+	if err != nil {
+		return "", fmt.Errorf("regular expression '%s' can't be compiled: %w", SEMANTIC_VERSION_PATTERN_COERCIBLE, err)
+	}
+	m, err := re.FindStringMatch(s)
+	if err != nil {
+		return "", fmt.Errorf("regular expression '%s' can't be matched: %w", SEMANTIC_VERSION_PATTERN_COERCIBLE, err)
+	}
+	if m == nil {
+		return "", fmt.Errorf("the string '%s' can't be coerced to a valid semantic number", s)
+	}
+
+	// group 1 is the major number
+	// group 2 is the minor number
+	// group 3 (optional) is the patch number
+	// group 4 (optional) is the prerelease
+	// group 5 (optional) is the build
+	var result strings.Builder
+	for i := 1; i <= 3; i++ {
+		number := 0
+		if m.GroupByNumber(i) != nil && len(m.GroupByNumber(i).Captures) > 0 {
+			number, err = strconv.Atoi(m.GroupByNumber(i).Captures[0].String())
+			if err != nil {
+				return "", fmt.Errorf("numeric identifiers in string '%s' can't be converted to valid integers", s)
+			}
+		}
+		if i > 1 {
+			result.WriteString(DEFAULT_SEPARATOR)
+		}
+		result.WriteString(fmt.Sprint(number))
+	}
+	if m.GroupByNumber(4) != nil && len(m.GroupByNumber(4).Captures) > 0 && "" != m.GroupByNumber(4).Captures[0].String() {
+		result.WriteString(PRERELEASE_DELIMITER)
+		result.WriteString(m.GroupByNumber(4).Captures[0].String())
+	}
+	if m.GroupByNumber(5) != nil && len(m.GroupByNumber(5).Captures) > 0 && "" != m.GroupByNumber(5).Captures[0].String() {
+		result.WriteString(BUILD_DELIMITER)
+		result.WriteString(m.GroupByNumber(5).Captures[0].String())
+	}
+
+	// remove the leading zeroes from the prerelease numeric identifiers, if any
+	res, err := SanitizeSemanticVersionNumbers(result.String())
+	if err != nil {
+		return "", err
+	}
+	if !IsLegalSemanticVersion(res) {
+		return "", fmt.Errorf("the string '%s' can't be coerced to a valid semantic number", s)
+	}
+	return res, nil
+}
+
 /*
 Returns the major version number
 */
@@ -1097,6 +1224,32 @@ func (sv SemanticVersion) SetBuild(identifiers ...string) (SemanticVersion, erro
 	return newSemanticVersion(sv.coreIdentifier, sv.prereleaseIdentifier, svbi)
 }
 
+/*
+Returns a new version object with the given values appended to the build part. If this version has no build part
+the returned version will have one with the given identifiers, otherwise the given identifiers follow the existing
+ones. Each value may contain multiple identifiers separated by dots, so for example appending sha.abc1234 to
+1.2.3+build.42 yields to 1.2.3+build.42.sha.abc1234. If no identifiers are passed the same version is returned.
+
+Arguments are as follows:
+
+  - identifiers the identifiers to append to the build part
+
+Errors can be returned if:
+
+- some item passed contains illegal characters
+*/
+func (sv SemanticVersion) AppendBuild(identifiers ...string) (SemanticVersion, error) {
+	if len(identifiers) == 0 {
+		return sv, nil
+	}
+	buildIdentifiers := []string{}
+	if sv.buildIdentifier != nil {
+		buildIdentifiers = append(buildIdentifiers, *sv.GetBuildIdentifiers()...)
+	}
+	buildIdentifiers = append(buildIdentifiers, identifiers...)
+	return sv.SetBuild(buildIdentifiers...)
+}
+
 /*
 Returns true if an attribute with the given name is present in the prerelease part, false otherwise.
 
@@ -0,0 +1,399 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strconv" // https://pkg.go.dev/strconv
+	"strings" // https://pkg.go.dev/strings
+
+	regexp2 "github.com/dlclark/regexp2" // https://pkg.go.dev/github.com/dlclark/regexp2
+)
+
+const (
+	// The string separating alternative sets of comparators in a constraint. A version satisfies the constraint
+	// when it satisfies all the comparators in at least one of the alternatives.
+	CONSTRAINT_ALTERNATIVES_DELIMITER = "||"
+
+	// The regexp pattern used to parse the (possibly partial) versions used in constraints. Minor and patch numbers
+	// can be omitted or replaced by one of the 'x', 'X' or '*' wildcards, while the pre-release and build parts are
+	// only allowed when all the core numbers are given. A leading 'v' is tolerated.
+	SEMANTIC_VERSION_CONSTRAINT_PATTERN = "^v?(0|[1-9]\\d*|[xX*])(?:\\.(0|[1-9]\\d*|[xX*])(?:\\.(0|[1-9]\\d*|[xX*])(?:-((?:0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\\.(?:0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\\+([0-9a-zA-Z-]+(?:\\.[0-9a-zA-Z-]+)*))?)?)?$"
+)
+
+var (
+	// The operators that can precede a version in a constraint, longest first so that they can be matched
+	// as prefixes.
+	constraintOperators = []string{">=", "<=", "!=", ">", "<", "=", "~", "^"}
+)
+
+/*
+A constraint on semantic versions, like '>=1.2 <2.0', '~1.4' or '^2.1'.
+
+A constraint is made of one or more alternatives separated by '||', each being a list of comparators separated
+by whitespaces or commas. A version satisfies the constraint when it satisfies all the comparators of at least
+one alternative.
+
+Each comparator is made of an optional operator followed by a version, which may be partial (like '1' or '1.2')
+or use the 'x', 'X' or '*' wildcards in place of the missing numbers (like '1.x' or '1.2.*'):
+
+  - '=' (or no operator) matches the version exactly when it's complete, otherwise any version within the given
+    numbers (i.e. '1.2' means '>=1.2.0 <1.3.0-0')
+  - '!=' matches any version not matched by '='
+  - '>', '>=', '<', '<=' compare versions by their precedence, with partial versions treated as the range of the
+    versions they cover (i.e. '>1.2' means '>=1.3.0-0' and '<=1.2' means '<1.3.0-0')
+  - '~' allows patch level changes when the minor number is given, minor level changes otherwise (i.e. '~1.4'
+    means '>=1.4.0 <1.5.0-0' and '~1' means '>=1.0.0 <2.0.0-0')
+  - '^' allows changes that do not modify the left-most non-zero number (i.e. '^2.1' means '>=2.1.0 <3.0.0-0'
+    and '^0.2.3' means '>=0.2.3 <0.3.0-0')
+
+Unlike other implementations, pre-release versions are not treated specially and are just compared by their
+precedence so, for example, '1.5.0-alpha.1' satisfies '>=1.2 <2.0'. Build metadata is ignored.
+
+Instances of this class are immutable.
+*/
+type SemanticVersionConstraint struct {
+	// The string representation of the constraint, as it was parsed.
+	expression string
+
+	// The alternatives of the constraint, each being the list of the ranges that must all be satisfied.
+	alternatives [][]semanticVersionRange
+}
+
+/*
+A range of versions, defined by its optional lower and upper bounds. When negated, the range matches the versions
+outside of the bounds.
+*/
+type semanticVersionRange struct {
+	// The lower bound of the range. A nil value means there is no lower bound.
+	lower *SemanticVersion
+
+	// Tells whether the lower bound belongs to the range.
+	lowerInclusive bool
+
+	// The upper bound of the range. A nil value means there is no upper bound.
+	upper *SemanticVersion
+
+	// Tells whether the upper bound belongs to the range.
+	upperInclusive bool
+
+	// Tells whether the range matches the versions outside of the bounds instead of the ones within.
+	negated bool
+}
+
+/*
+Returns a SemanticVersionConstraint instance representing the specified String value.
+
+Arguments are as follows:
+
+- s the string to parse
+
+Errors can be returned if:
+
+- the given string doesn't represent a legal constraint
+*/
+func ValueOfSemanticVersionConstraint(s string) (SemanticVersionConstraint, error) {
+	if "" == strings.TrimSpace(s) {
+		return SemanticVersionConstraint{}, fmt.Errorf("can't parse an empty constraint")
+	}
+
+	re, err := regexp2.Compile(SEMANTIC_VERSION_CONSTRAINT_PATTERN, 0)
+	if err != nil {
+		return SemanticVersionConstraint{}, fmt.Errorf("regular expression '%s' can't be compiled: %w", SEMANTIC_VERSION_CONSTRAINT_PATTERN, err)
+	}
+
+	alternatives := [][]semanticVersionRange{}
+	for _, alternative := range strings.Split(s, CONSTRAINT_ALTERNATIVES_DELIMITER) {
+		tokens := strings.FieldsFunc(alternative, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+		if len(tokens) == 0 {
+			return SemanticVersionConstraint{}, fmt.Errorf("the constraint '%s' has an empty alternative", s)
+		}
+
+		ranges := []semanticVersionRange{}
+		for i := 0; i < len(tokens); i++ {
+			token := tokens[i]
+			// operators can be separated from their version by whitespaces, like in '>= 1.2'
+			if isConstraintOperator(token) {
+				if i+1 >= len(tokens) {
+					return SemanticVersionConstraint{}, fmt.Errorf("the operator '%s' in constraint '%s' is not followed by a version", token, s)
+				}
+				i++
+				token = token + tokens[i]
+			}
+			r, err := parseSemanticVersionRange(re, token)
+			if err != nil {
+				return SemanticVersionConstraint{}, fmt.Errorf("the constraint '%s' is not legal: %w", s, err)
+			}
+			ranges = append(ranges, r)
+		}
+		alternatives = append(alternatives, ranges)
+	}
+
+	return SemanticVersionConstraint{expression: strings.TrimSpace(s), alternatives: alternatives}, nil
+}
+
+/*
+Returns true if the given string is a legal semantic version constraint, false otherwise.
+
+Arguments are as follows:
+
+- s the string to check
+*/
+func IsLegalSemanticVersionConstraint(s string) bool {
+	_, err := ValueOfSemanticVersionConstraint(s)
+	return err == nil
+}
+
+/*
+Returns true if the given version satisfies this constraint, false otherwise.
+
+Arguments are as follows:
+
+- v the version to check
+*/
+func (c SemanticVersionConstraint) Check(v SemanticVersion) bool {
+	for _, alternative := range c.alternatives {
+		satisfied := true
+		for _, r := range alternative {
+			if !r.contains(v) {
+				satisfied = false
+				break
+			}
+		}
+		if satisfied {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Returns the string representation of the constraint, as it was parsed.
+*/
+func (c SemanticVersionConstraint) String() string {
+	return c.expression
+}
+
+/*
+Returns true if the given string is one of the supported operators.
+
+Arguments are as follows:
+
+- s the string to check
+*/
+func isConstraintOperator(s string) bool {
+	for _, operator := range constraintOperators {
+		if s == operator {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Parses a single comparator, made of an optional operator and a (possibly partial) version, and returns the range
+of versions it matches.
+
+Arguments are as follows:
+
+- re the compiled SEMANTIC_VERSION_CONSTRAINT_PATTERN
+- s the comparator to parse
+*/
+func parseSemanticVersionRange(re *regexp2.Regexp, s string) (semanticVersionRange, error) {
+	operator := ""
+	for _, o := range constraintOperators {
+		if strings.HasPrefix(s, o) {
+			operator = o
+			break
+		}
+	}
+	versionString := s[len(operator):]
+
+	m, err := re.FindStringMatch(versionString)
+	if err != nil {
+		return semanticVersionRange{}, fmt.Errorf("regular expression '%s' can't be matched: %w", SEMANTIC_VERSION_CONSTRAINT_PATTERN, err)
+	}
+	if m == nil {
+		return semanticVersionRange{}, fmt.Errorf("'%s' is not a legal comparator", s)
+	}
+
+	// collect the core numbers up to the first missing or wildcard one
+	numbers := []int{}
+	for group := 1; group <= 3; group++ {
+		if len(m.GroupByNumber(group).Captures) == 0 {
+			break
+		}
+		number, err := strconv.Atoi(m.GroupByNumber(group).Captures[0].String())
+		if err != nil {
+			// wildcard
+			break
+		}
+		numbers = append(numbers, number)
+	}
+	partial := len(numbers) < 3
+	if partial && (len(m.GroupByNumber(4).Captures) > 0 || len(m.GroupByNumber(5).Captures) > 0) {
+		return semanticVersionRange{}, fmt.Errorf("'%s' has pre-release or build identifiers in a partial version", s)
+	}
+
+	// the lowest version covered by the comparator
+	var lower SemanticVersion
+	if partial {
+		lower, err = NewSemanticVersionWith(numberAt(numbers, 0), numberAt(numbers, 1), numberAt(numbers, 2))
+	} else {
+		lower, err = ValueOfSemanticVersion(strings.TrimPrefix(versionString, "v"))
+		if err == nil {
+			lower, err = newSemanticVersion(lower.coreIdentifier, lower.prereleaseIdentifier, nil)
+		}
+	}
+	if err != nil {
+		return semanticVersionRange{}, err
+	}
+
+	// the lowest version not covered by the comparator, when the version is partial
+	var next *SemanticVersion
+	if partial && len(numbers) > 0 {
+		n, err := lowestPrerelease(bumpedNumbers(numbers, len(numbers)-1))
+		if err != nil {
+			return semanticVersionRange{}, err
+		}
+		next = &n
+	}
+
+	if len(numbers) == 0 {
+		// wildcards alone match any version, or none when compared strictly
+		switch operator {
+		case "", "=", ">=", "<=", "~", "^":
+			return semanticVersionRange{}, nil
+		default:
+			return semanticVersionRange{negated: true}, nil
+		}
+	}
+
+	switch operator {
+	case "", "=":
+		if partial {
+			return semanticVersionRange{lower: &lower, lowerInclusive: true, upper: next}, nil
+		}
+		return semanticVersionRange{lower: &lower, lowerInclusive: true, upper: &lower, upperInclusive: true}, nil
+	case "!=":
+		if partial {
+			return semanticVersionRange{lower: &lower, lowerInclusive: true, upper: next, negated: true}, nil
+		}
+		return semanticVersionRange{lower: &lower, lowerInclusive: true, upper: &lower, upperInclusive: true, negated: true}, nil
+	case ">":
+		if partial {
+			return semanticVersionRange{lower: next, lowerInclusive: true}, nil
+		}
+		return semanticVersionRange{lower: &lower}, nil
+	case ">=":
+		return semanticVersionRange{lower: &lower, lowerInclusive: true}, nil
+	case "<":
+		if partial {
+			l, err := lowestPrerelease(numbers)
+			if err != nil {
+				return semanticVersionRange{}, err
+			}
+			return semanticVersionRange{upper: &l}, nil
+		}
+		return semanticVersionRange{upper: &lower}, nil
+	case "<=":
+		if partial {
+			return semanticVersionRange{upper: next}, nil
+		}
+		return semanticVersionRange{upper: &lower, upperInclusive: true}, nil
+	case "~":
+		// only the patch can change when the minor is given, otherwise the minor can change too
+		position := 1
+		if len(numbers) == 1 {
+			position = 0
+		}
+		u, err := lowestPrerelease(bumpedNumbers(numbers, position))
+		if err != nil {
+			return semanticVersionRange{}, err
+		}
+		return semanticVersionRange{lower: &lower, lowerInclusive: true, upper: &u}, nil
+	case "^":
+		// the left-most non-zero number can't change, unless it's one of the missing ones
+		position := len(numbers) - 1
+		for i, number := range numbers {
+			if number != 0 {
+				position = i
+				break
+			}
+		}
+		u, err := lowestPrerelease(bumpedNumbers(numbers, position))
+		if err != nil {
+			return semanticVersionRange{}, err
+		}
+		return semanticVersionRange{lower: &lower, lowerInclusive: true, upper: &u}, nil
+	default:
+		return semanticVersionRange{}, fmt.Errorf("'%s' is not a legal operator", operator)
+	}
+}
+
+/*
+Returns the number at the given position or 0 if the position is not available.
+*/
+func numberAt(numbers []int, position int) int {
+	if position < len(numbers) {
+		return numbers[position]
+	}
+	return 0
+}
+
+/*
+Returns the core numbers obtained by incrementing the one at the given position and resetting the following ones.
+*/
+func bumpedNumbers(numbers []int, position int) []int {
+	res := make([]int, position+1)
+	copy(res, numbers[:position+1])
+	res[position]++
+	return res
+}
+
+/*
+Returns the lowest version having the given core numbers (with missing numbers treated as 0), which is the one with
+the '0' pre-release identifier.
+*/
+func lowestPrerelease(numbers []int) (SemanticVersion, error) {
+	return NewSemanticVersionWithAllIdentifiers(numberAt(numbers, 0), numberAt(numbers, 1), numberAt(numbers, 2), []interface{}{0}, nil)
+}
+
+/*
+Returns true if the given version is matched by this range, ignoring build metadata.
+
+Arguments are as follows:
+
+- v the version to check
+*/
+func (r semanticVersionRange) contains(v SemanticVersion) bool {
+	v, _ = newSemanticVersion(v.coreIdentifier, v.prereleaseIdentifier, nil)
+	within := true
+	if r.lower != nil {
+		c := v.CompareTo(*r.lower)
+		if c < 0 || (c == 0 && !r.lowerInclusive) {
+			within = false
+		}
+	}
+	if r.upper != nil {
+		c := v.CompareTo(*r.upper)
+		if c > 0 || (c == 0 && !r.upperInclusive) {
+			within = false
+		}
+	}
+	return within != r.negated
+}
@@ -0,0 +1,88 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+func TestSemanticVersionConstraintValueOfSemanticVersionConstraint(t *testing.T) {
+	for _, s := range []string{"1", "1.2", "1.2.3", "v1.2.3", "1.2.3-alpha.1", "=1.2.3", "!=1.2", ">1", ">= 1.2", ">=1.2 <2.0", ">=1.2, <2.0", "~1.4", "^2.1", "1.x", "1.2.*", "*", "^1 || ~2.3"} {
+		c, err := ValueOfSemanticVersionConstraint(s)
+		assert.NoError(t, err, "constraint '%s' is expected to be legal", s)
+		assert.Equal(t, s, c.String())
+		assert.True(t, IsLegalSemanticVersionConstraint(s), "constraint '%s' is expected to be legal", s)
+	}
+	for _, s := range []string{"", " ", "a", "1.2.3.4", "01.2", ">=", "=>1.2", "1.2-alpha", "^1 ||", "1.x.3-alpha"} {
+		_, err := ValueOfSemanticVersionConstraint(s)
+		assert.Error(t, err, "constraint '%s' is expected to be illegal", s)
+		assert.False(t, IsLegalSemanticVersionConstraint(s), "constraint '%s' is expected to be illegal", s)
+	}
+}
+
+func TestSemanticVersionConstraintCheck(t *testing.T) {
+	tests := []struct {
+		constraint string
+		matching   []string
+		unmatching []string
+	}{
+		{"1.2.3", []string{"1.2.3", "1.2.3+build.1"}, []string{"1.2.4", "1.2.3-alpha.1"}},
+		{"=1.2", []string{"1.2.0", "1.2.9", "1.2.9-alpha.1"}, []string{"1.1.9", "1.3.0", "1.3.0-alpha.1"}},
+		{"!=1.2", []string{"1.1.9", "1.3.0"}, []string{"1.2.0", "1.2.9"}},
+		{"!=1.2.3", []string{"1.2.4"}, []string{"1.2.3"}},
+		{">1.2", []string{"1.3.0", "1.3.0-alpha.1", "2.0.0"}, []string{"1.2.9", "1.2.9-alpha.1"}},
+		{">1.2.3", []string{"1.2.4", "1.2.4-alpha.1"}, []string{"1.2.3", "1.2.3-alpha.1"}},
+		{">=1.2", []string{"1.2.0", "2.0.0"}, []string{"1.1.9", "1.2.0-alpha.1"}},
+		{"<1.2", []string{"1.1.9"}, []string{"1.2.0", "1.2.0-alpha.1"}},
+		{"<=1.2", []string{"1.2.9", "1.2.9-alpha.1"}, []string{"1.3.0", "1.3.0-alpha.1"}},
+		{">=1.2 <2.0", []string{"1.2.0", "1.5.0-alpha.1", "1.99.0"}, []string{"1.1.0", "2.0.0", "2.0.0-alpha.1"}},
+		{">= 1.2, < 2.0", []string{"1.2.0", "1.99.0"}, []string{"1.1.0", "2.0.0"}},
+		{"~1.4", []string{"1.4.0", "1.4.9"}, []string{"1.3.9", "1.5.0"}},
+		{"~1.4.2", []string{"1.4.2", "1.4.9"}, []string{"1.4.1", "1.5.0"}},
+		{"~1", []string{"1.0.0", "1.9.0"}, []string{"0.9.0", "2.0.0"}},
+		{"^2.1", []string{"2.1.0", "2.9.9"}, []string{"2.0.9", "3.0.0"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.2.2", "0.3.0"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.2", "0.0.4"}},
+		{"^0", []string{"0.0.0", "0.9.9"}, []string{"1.0.0"}},
+		{"1.x", []string{"1.0.0", "1.9.9"}, []string{"0.9.9", "2.0.0"}},
+		{"1.2.*", []string{"1.2.0", "1.2.9"}, []string{"1.1.9", "1.3.0"}},
+		{"*", []string{"0.0.0", "0.0.0-alpha", "9.9.9"}, []string{}},
+		{">*", []string{}, []string{"0.0.0", "9.9.9"}},
+		{"^1 || ~2.3", []string{"1.0.0", "1.9.9", "2.3.0", "2.3.9"}, []string{"0.9.9", "2.0.0", "2.4.0"}},
+	}
+	for _, test := range tests {
+		c, err := ValueOfSemanticVersionConstraint(test.constraint)
+		assert.NoError(t, err)
+		for _, s := range test.matching {
+			v, err := ValueOfSemanticVersion(s)
+			assert.NoError(t, err)
+			assert.True(t, c.Check(v), "version '%s' is expected to satisfy constraint '%s'", s, test.constraint)
+		}
+		for _, s := range test.unmatching {
+			v, err := ValueOfSemanticVersion(s)
+			assert.NoError(t, err)
+			assert.False(t, c.Check(v), "version '%s' is not expected to satisfy constraint '%s'", s, test.constraint)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"sort" // https://pkg.go.dev/sort
+)
+
+/*
+The implementation of the Semantic Versioning (https://semver.org/) scheme.
+*/
+type semanticVersionScheme struct {
+}
+
+/*
+Returns SEMVER.
+*/
+func (s semanticVersionScheme) GetScheme() Scheme {
+	return SEMVER
+}
+
+/*
+Returns true if the given string is a legal semantic version.
+*/
+func (s semanticVersionScheme) IsLegal(v string, lenient bool) bool {
+	return IsLegalSemanticVersionWithLenience(v, lenient)
+}
+
+/*
+Returns the SemanticVersion represented by the given string.
+*/
+func (s semanticVersionScheme) Parse(v string, sanitize bool) (Version, error) {
+	return ValueOfSemanticVersionWithSanitization(v, sanitize)
+}
+
+/*
+Compares the given semantic versions as per SemanticVersion.CompareTo.
+*/
+func (s semanticVersionScheme) Compare(v1 Version, v2 Version) int {
+	return v1.(SemanticVersion).CompareTo(v2.(SemanticVersion))
+}
+
+/*
+Compares the given semantic versions as per SemanticVersion.CompareToWithPrereleaseOrder.
+*/
+func (s semanticVersionScheme) CompareWithPrereleaseOrder(v1 Version, v2 Version, prereleaseOrder []string) int {
+	return v1.(SemanticVersion).CompareToWithPrereleaseOrder(v2.(SemanticVersion), prereleaseOrder)
+}
+
+/*
+Returns true if the given semantic version has no pre-release and build identifiers.
+*/
+func (s semanticVersionScheme) IsCore(v Version) bool {
+	return v.(SemanticVersion).GetPrerelease() == nil && v.(SemanticVersion).GetBuild() == nil
+}
+
+/*
+Returns the SEMANTIC_VERSION_DEFAULT_INITIAL_VERSION.
+*/
+func (s semanticVersionScheme) DefaultInitial() Version {
+	res, err := ValueOfSemanticVersion(SEMANTIC_VERSION_DEFAULT_INITIAL_VERSION)
+	if err != nil {
+		panic("unable to build a new semantic version from the default initial value")
+	}
+	return res
+}
+
+/*
+Returns the most relevant identifier, with core identifiers (major, minor and patch) coming first.
+*/
+func (s semanticVersionScheme) MostRelevantIdentifierIn(identifiers []string) string {
+	orderedList := make([]string, len(identifiers))
+	copy(orderedList, identifiers)
+	sort.Sort(semanticVersionIdentifiers(orderedList))
+	return orderedList[0]
+}
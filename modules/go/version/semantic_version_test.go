@@ -1224,6 +1224,32 @@ func TestSemanticVersionSetBuild(t *testing.T) {
 	}
 }
 
+func TestSemanticVersionAppendBuild(t *testing.T) {
+	sv, err := ValueOfSemanticVersion("1.2.3")
+	assert.NoError(t, err)
+	sv, err = sv.AppendBuild()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3", sv.String())
+	sv, err = sv.AppendBuild("sha.abc1234")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3+sha.abc1234", sv.String())
+	sv, err = sv.AppendBuild("build", "42")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3+sha.abc1234.build.42", sv.String())
+	assert.Equal(t, []string{"sha", "abc1234", "build", "42"}, *sv.GetBuildIdentifiers())
+
+	sv, err = ValueOfSemanticVersion("1.2.3-alpha.1+build.42")
+	assert.NoError(t, err)
+	sv, err = sv.AppendBuild("sha.abc1234")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3-alpha.1+build.42.sha.abc1234", sv.String())
+
+	_, err = sv.AppendBuild("sha!abc")
+	assert.Error(t, err)
+	_, err = sv.AppendBuild("sha..abc")
+	assert.Error(t, err)
+}
+
 func TestSemanticVersionString(t *testing.T) {
 	for _, vv := range wellKnownValidVersions {
 		t.Run(*vv.version, func(t *testing.T) {
@@ -1329,6 +1355,47 @@ func TestSemanticVersionGetSemanticVersionPrefix(t *testing.T) {
 	}
 }
 
+func TestSemanticVersionCoerceWithEmptyString(t *testing.T) {
+	_, err := CoerceSemanticVersion("")
+	assert.Error(t, err)
+}
+
+func TestSemanticVersionCoerceWithValidString(t *testing.T) {
+	for _, vv := range wellKnownValidVersions {
+		t.Run(*vv.version, func(t *testing.T) {
+			ss, err := CoerceSemanticVersion(*vv.version)
+			assert.NoError(t, err)
+			assert.Equal(t, *vv.version, ss)
+		})
+	}
+}
+
+func TestSemanticVersionCoerce(t *testing.T) {
+	for v, coercedOutcome := range map[string]string{
+		"1.2":                  "1.2.0",
+		"v1.2":                 "1.2.0",
+		"v01.02":               "1.2.0",
+		"v1.2.3.4":             "1.2.3",
+		"release-1.2.3":        "1.2.3",
+		"v1.2-beta":            "1.2.0-beta",
+		"1.2.3-rc.01":          "1.2.3-rc.1",
+		"1.2.3.4-rc.1+build.7": "1.2.3-rc.1+build.7",
+	} {
+		t.Run(v, func(t *testing.T) {
+			ss, err := CoerceSemanticVersion(v)
+			assert.NoError(t, err)
+			assert.Equal(t, coercedOutcome, ss)
+		})
+	}
+
+	for _, v := range []string{"1", "build-42", "alpha", "1.2.3-"} {
+		t.Run(v, func(t *testing.T) {
+			_, err := CoerceSemanticVersion(v)
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestSemanticVersionEqualsToNil(t *testing.T) {
 	for _, vv := range wellKnownValidVersions {
 		t.Run(*vv.version, func(t *testing.T) {
@@ -1402,6 +1469,32 @@ func TestSemanticVersionCompareTo(t *testing.T) {
 	}
 }
 
+func TestSemanticVersionCompareToWithPrereleaseOrder(t *testing.T) {
+	prereleaseOrder := []string{"dev", "alpha", "beta", "rc"}
+	ordered := []string{"1.0.0-pre", "1.0.0-snapshot", "1.0.0-dev", "1.0.0-dev.2", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-alpha.rc", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0"}
+	for i := 0; i < len(ordered); i++ {
+		for j := 0; j < len(ordered); j++ {
+			sv1, _ := ValueOfSemanticVersion(ordered[i])
+			sv2, _ := ValueOfSemanticVersion(ordered[j])
+			switch {
+			case i < j:
+				assert.Less(t, sv1.CompareToWithPrereleaseOrder(sv2, prereleaseOrder), 0, "'%s' is expected to be less than '%s'", ordered[i], ordered[j])
+			case i > j:
+				assert.Greater(t, sv1.CompareToWithPrereleaseOrder(sv2, prereleaseOrder), 0, "'%s' is expected to be greater than '%s'", ordered[i], ordered[j])
+			default:
+				assert.Equal(t, 0, sv1.CompareToWithPrereleaseOrder(sv2, prereleaseOrder))
+			}
+		}
+	}
+
+	// without an order the identifiers are compared lexically
+	sv1, _ := ValueOfSemanticVersion("1.0.0-dev")
+	sv2, _ := ValueOfSemanticVersion("1.0.0-alpha")
+	assert.Greater(t, sv1.CompareToWithPrereleaseOrder(sv2, nil), 0)
+	assert.Greater(t, sv1.CompareToWithPrereleaseOrder(sv2, []string{}), 0)
+	assert.Less(t, sv1.CompareToWithPrereleaseOrder(sv2, prereleaseOrder), 0)
+}
+
 func TestSemanticVersionSort(t *testing.T) {
 	// create a slice with all the well known semantic versions
 	orderedList := make([]SemanticVersion, len(wellKnownOrderedSemanticVersionsArray))
@@ -0,0 +1,171 @@
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"strings" // https://pkg.go.dev/strings
+	"sync"    // https://pkg.go.dev/sync
+)
+
+/*
+The interface to implement to plug in a versioning scheme other than the built in ones, like marketing versions or
+build train numbers, so that it can be selected by its Scheme just like the built in ones once registered with
+RegisterVersionScheme.
+
+Parsing and comparing versions is up to this interface while bumping and formatting them is up to the Version
+instances returned by Parse, through their BumpVersion and String methods.
+*/
+type VersionScheme interface {
+	/*
+		Returns the scheme identifying this implementation, which is also the name used to select it
+	*/
+	GetScheme() Scheme
+
+	/*
+		Returns true if the given string is a legal version for this scheme, which can be parsed without errors.
+
+		Arguments are as follows:
+
+		- s the string version to check.
+		- lenient when true prefixes and non critical extra characters are tolerated even if they are not
+		  strictly legal for the scheme.
+	*/
+	IsLegal(s string, lenient bool) bool
+
+	/*
+		Returns a Version instance representing the given string.
+
+		Arguments are as follows:
+
+		- s the string to parse
+		- sanitize when true prefixes and non critical extra characters are removed before parsing
+
+		Errors can be returned if:
+
+		- the given string doesn't represent a legal version for this scheme
+	*/
+	Parse(s string, sanitize bool) (Version, error)
+
+	/*
+		Returns a negative integer, zero, or a positive integer as the first version is less than, equal to,
+		or greater than the second one. Both versions have been returned by Parse.
+	*/
+	Compare(v1 Version, v2 Version) int
+
+	/*
+		Returns true if the given version, returned by Parse, only has core identifiers (i.e. it's not a
+		pre-release).
+	*/
+	IsCore(v Version) bool
+
+	/*
+		Returns the version to start from when there are no previous versions.
+	*/
+	DefaultInitial() Version
+
+	/*
+		Returns the most relevant among the given identifiers that can be bumped, which is the one bumping
+		the most significant number. The given slice is never empty.
+	*/
+	MostRelevantIdentifierIn(identifiers []string) string
+}
+
+/*
+The optional interface that a VersionScheme can also implement when its versions have a pre-release part, so that
+they can be compared using a custom precedence for pre-release identifiers. Schemes not implementing this interface
+ignore the custom precedence and just use VersionScheme.Compare.
+*/
+type PrereleaseOrderedVersionScheme interface {
+	/*
+		Returns a negative integer, zero, or a positive integer as the first version is less than, equal to,
+		or greater than the second one, comparing pre-release identifiers by their position in the given
+		prereleaseOrder. Both versions have been returned by Parse.
+	*/
+	CompareWithPrereleaseOrder(v1 Version, v2 Version, prereleaseOrder []string) int
+}
+
+var (
+	// The implementations of the version schemes, by scheme.
+	versionSchemes = map[Scheme]VersionScheme{FOUR_SEGMENT: fourSegmentVersionScheme{}, SEMVER: semanticVersionScheme{}}
+
+	// The lock guarding the implementations of the version schemes, as they may be registered concurrently.
+	versionSchemesLock sync.RWMutex
+)
+
+/*
+Registers the given implementation of a version scheme so that it can be used by all the functions accepting a
+Scheme, like ValueOf or Compare, and selected by name using ValueOfScheme.
+
+Arguments are as follows:
+
+- scheme the implementation of the version scheme to register
+
+Errors can be returned if:
+
+- the given implementation is nil or its scheme name is blank
+- another implementation is already registered for the same scheme, including the built in ones
+*/
+func RegisterVersionScheme(scheme VersionScheme) error {
+	if scheme == nil {
+		return fmt.Errorf("can't register a nil version scheme")
+	}
+	if "" == strings.TrimSpace(string(scheme.GetScheme())) {
+		return fmt.Errorf("can't register a version scheme with a blank name")
+	}
+	versionSchemesLock.Lock()
+	defer versionSchemesLock.Unlock()
+	if _, ok := versionSchemes[scheme.GetScheme()]; ok {
+		return fmt.Errorf("the version scheme '%s' is already registered", string(scheme.GetScheme()))
+	}
+	versionSchemes[scheme.GetScheme()] = scheme
+	return nil
+}
+
+/*
+Returns the implementation of the given scheme.
+
+Arguments are as follows:
+
+- scheme the scheme to get the implementation for
+
+Errors can be returned if:
+
+- no implementation is registered for the given scheme
+*/
+func VersionSchemeOf(scheme Scheme) (VersionScheme, error) {
+	versionSchemesLock.RLock()
+	defer versionSchemesLock.RUnlock()
+	implementation, ok := versionSchemes[scheme]
+	if !ok {
+		return nil, fmt.Errorf("illegal scheme '%s'", string(scheme))
+	}
+	return implementation, nil
+}
+
+/*
+Returns the implementation of the given scheme, panicking when there is none, like when the functions accepting
+a Scheme are invoked with an unknown one.
+*/
+func mustVersionSchemeOf(scheme Scheme) VersionScheme {
+	implementation, err := VersionSchemeOf(scheme)
+	if err != nil {
+		// this is never reached, but in case...
+		panic("unknown Scheme. This means it needs to be registered with RegisterVersionScheme")
+	}
+	return implementation
+}
@@ -0,0 +1,180 @@
+//go:build unit
+// +build unit
+
+// Only run these tests as part of the unit test suite, when the 'unit' build flag is passed (i.e. running go test --tags=unit)
+
+/*
+ * Copyright 2020 Mooltiverse
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"fmt"     // https://pkg.go.dev/fmt
+	"regexp"  // https://pkg.go.dev/regexp
+	"strconv" // https://pkg.go.dev/strconv
+	"strings" // https://pkg.go.dev/strings
+	"testing" // https://pkg.go.dev/testing
+
+	assert "github.com/stretchr/testify/assert" // https://pkg.go.dev/github.com/stretchr/testify/assert
+)
+
+// A custom scheme made of a train number and a build number within the train, like '24.3'.
+const TRAIN Scheme = "TRAIN"
+
+type trainVersion struct {
+	train int
+	build int
+}
+
+func (v trainVersion) BumpVersion(id string) (Version, error) {
+	switch id {
+	case "train":
+		return trainVersion{train: v.train + 1, build: 0}, nil
+	case "build":
+		return trainVersion{train: v.train, build: v.build + 1}, nil
+	default:
+		return nil, fmt.Errorf("illegal identifier '%s'", id)
+	}
+}
+
+func (v trainVersion) Equals(obj interface{}) bool {
+	return v == obj
+}
+
+func (v trainVersion) GetScheme() Scheme {
+	return TRAIN
+}
+
+func (v trainVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.train, v.build)
+}
+
+type trainVersionScheme struct {
+}
+
+var trainVersionPattern = regexp.MustCompile(`^([0-9]+)\.([0-9]+)$`)
+
+func (s trainVersionScheme) GetScheme() Scheme {
+	return TRAIN
+}
+
+func (s trainVersionScheme) IsLegal(v string, lenient bool) bool {
+	if lenient {
+		v = strings.TrimPrefix(v, "v")
+	}
+	return trainVersionPattern.MatchString(v)
+}
+
+func (s trainVersionScheme) Parse(v string, sanitize bool) (Version, error) {
+	if sanitize {
+		v = strings.TrimPrefix(v, "v")
+	}
+	matches := trainVersionPattern.FindStringSubmatch(v)
+	if matches == nil {
+		return nil, fmt.Errorf("illegal train version '%s'", v)
+	}
+	train, _ := strconv.Atoi(matches[1])
+	build, _ := strconv.Atoi(matches[2])
+	return trainVersion{train: train, build: build}, nil
+}
+
+func (s trainVersionScheme) Compare(v1 Version, v2 Version) int {
+	tv1, tv2 := v1.(trainVersion), v2.(trainVersion)
+	if tv1.train != tv2.train {
+		return tv1.train - tv2.train
+	}
+	return tv1.build - tv2.build
+}
+
+func (s trainVersionScheme) IsCore(v Version) bool {
+	return true
+}
+
+func (s trainVersionScheme) DefaultInitial() Version {
+	return trainVersion{train: 1, build: 0}
+}
+
+func (s trainVersionScheme) MostRelevantIdentifierIn(identifiers []string) string {
+	for _, identifier := range identifiers {
+		if identifier == "train" {
+			return identifier
+		}
+	}
+	return identifiers[0]
+}
+
+func init() {
+	if err := RegisterVersionScheme(trainVersionScheme{}); err != nil {
+		panic(err)
+	}
+}
+
+func TestVersionSchemeRegisterVersionScheme(t *testing.T) {
+	assert.Error(t, RegisterVersionScheme(nil))
+	assert.Error(t, RegisterVersionScheme(semanticVersionScheme{}))
+	assert.Error(t, RegisterVersionScheme(trainVersionScheme{}))
+
+	implementation, err := VersionSchemeOf(SEMVER)
+	assert.NoError(t, err)
+	assert.Equal(t, SEMVER, implementation.GetScheme())
+	implementation, err = VersionSchemeOf(TRAIN)
+	assert.NoError(t, err)
+	assert.Equal(t, TRAIN, implementation.GetScheme())
+	_, err = VersionSchemeOf(Scheme("UNKNOWN"))
+	assert.Error(t, err)
+}
+
+func TestVersionSchemeSelectedByName(t *testing.T) {
+	assert.Equal(t, "TRAIN", TRAIN.String())
+	scheme, err := ValueOfScheme("TRAIN")
+	assert.NoError(t, err)
+	assert.Equal(t, TRAIN, scheme)
+	_, err = ValueOfScheme("UNKNOWN")
+	assert.Error(t, err)
+}
+
+func TestVersionSchemeUsedByVersionFunctions(t *testing.T) {
+	assert.True(t, IsLegal(TRAIN, "24.3"))
+	assert.False(t, IsLegal(TRAIN, "v24.3"))
+	assert.True(t, IsLegalWithLenience(TRAIN, "v24.3", true))
+	assert.True(t, IsLegalWithPrefix(TRAIN, "release-24.3", strptr("release-")))
+	assert.False(t, IsLegal(TRAIN, "1.2.3"))
+	assert.True(t, IsCore(TRAIN, "24.3"))
+	assert.False(t, IsCore(TRAIN, "24"))
+
+	assert.Equal(t, "1.0", DefaultInitial(TRAIN).String())
+
+	version, err := ValueOf(TRAIN, "24.3")
+	assert.NoError(t, err)
+	assert.Equal(t, TRAIN, version.GetScheme())
+	bumped, err := version.BumpVersion("build")
+	assert.NoError(t, err)
+	assert.Equal(t, "24.4", bumped.String())
+	bumped, err = version.BumpVersion("train")
+	assert.NoError(t, err)
+	assert.Equal(t, "25.0", bumped.String())
+	_, err = ValueOf(TRAIN, "1.2.3")
+	assert.Error(t, err)
+
+	assert.Equal(t, 0, Compare(TRAIN, strptr("24.3"), strptr("24.3")))
+	assert.Less(t, Compare(TRAIN, strptr("24.3"), strptr("24.10")), 0)
+	assert.Greater(t, Compare(TRAIN, strptr("25.0"), strptr("24.10")), 0)
+	assert.Greater(t, Compare(TRAIN, strptr("24.3"), nil), 0)
+	assert.Less(t, CompareWithSanitization(TRAIN, strptr("illegal"), strptr("v24.3"), true), 0)
+
+	assert.Equal(t, "train", *MostRelevantIdentifierIn(TRAIN, []string{"build", "train"}))
+	assert.Equal(t, "train", *MostRelevantIdentifierBetween(TRAIN, strptr("build"), strptr("train")))
+}
@@ -16,19 +16,20 @@
 
 /*
 This is the version package for Nyx, providing classes required to manage the supported versioning schemes
-(i.e. SemVer and Maven).
+(i.e. SemVer and Maven). Other schemes can be plugged in by implementing VersionScheme.
 */
 package version
 
 import (
-	"sort"    // https://pkg.go.dev/sort
 	"strings" // https://pkg.go.dev/strings
 )
 
 /*
 Returns a negative integer, zero, or a positive integer as the version represented by v1 is less than, equal to,
 or greater than the version represented by v2, according to the given scheme. nil values are always
-considered less than non nil valid version identifiers.
+considered less than non nil valid version identifiers. The epoch, if any, is removed before parsing (see
+SplitEpoch) and versions with a higher epoch are always greater than versions with a lower epoch, regardless
+of the rest of the version.
 This method does no sanitization or prefix interpretation.
 
 Arguments are as follows:
@@ -67,6 +68,26 @@ Errors can be returned if:
 - a given string doesn't represent a legal version, according to the selected scheme
 */
 func CompareWithPrefix(scheme Scheme, v1 *string, v2 *string, prefix *string) int {
+	return CompareWithPrefixAndPrereleaseOrder(scheme, v1, v2, prefix, nil)
+}
+
+/*
+Returns a negative integer, zero, or a positive integer as the version represented by v1 is less than, equal to,
+or greater than the version represented by v2, according to the given scheme, just like CompareWithPrefix, but
+pre-release identifiers are compared by their position in the given prereleaseOrder.
+The prereleaseOrder is ignored by schemes that don't implement PrereleaseOrderedVersionScheme.
+
+Arguments are as follows:
+
+  - scheme the scheme to check against.
+  - v1 the first version to compare. It may be nil. If it's not a valid version it's considered as nil.
+  - v2 the second version to compare. It may be nil. If it's not a valid version it's considered as nil.
+  - prefix the initial string that is used for the version prefix. This will be stripped off from the given
+    string representation of the versions. It can be nil or empty, in which case it's ignored. If not empty
+    and the given version string doesn't start with this prefix, this prefix is ignored.
+  - prereleaseOrder the pre-release identifiers, from the lowest to the highest precedence. It may be nil.
+*/
+func CompareWithPrefixAndPrereleaseOrder(scheme Scheme, v1 *string, v2 *string, prefix *string, prereleaseOrder []string) int {
 	if v1 == nil && v2 == nil {
 		return 0
 	}
@@ -92,7 +113,7 @@ func CompareWithPrefix(scheme Scheme, v1 *string, v2 *string, prefix *string) in
 			v2b = v2
 		}
 	}
-	return CompareWithSanitization(scheme, v1b, v2b, false)
+	return CompareWithSanitizationAndPrereleaseOrder(scheme, v1b, v2b, false, prereleaseOrder)
 }
 
 /*
@@ -117,37 +138,57 @@ Errors can be returned if:
 - a given string doesn't represent a legal version, according to the selected scheme
 */
 func CompareWithSanitization(scheme Scheme, v1 *string, v2 *string, sanitize bool) int {
-	switch scheme {
-	case SEMVER:
-		{
-			var sv1 *SemanticVersion = nil
-			if v1 != nil {
-				svp1, err := ValueOfSemanticVersionWithSanitization(*v1, sanitize)
-				if err == nil {
-					sv1 = &svp1
-				}
-			}
-			var sv2 *SemanticVersion = nil
-			if v2 != nil {
-				svp2, err := ValueOfSemanticVersionWithSanitization(*v2, sanitize)
-				if err == nil {
-					sv2 = &svp2
-				}
-			}
-			if sv1 == nil && sv2 == nil {
-				return 0
-			} else if sv1 == nil {
-				return -1
-			} else if sv2 == nil {
-				return 1
-			} else {
-				return sv1.CompareTo(*sv2)
-			}
+	return CompareWithSanitizationAndPrereleaseOrder(scheme, v1, v2, sanitize, nil)
+}
+
+/*
+Returns a negative integer, zero, or a positive integer as the version represented by v1 is less than, equal to,
+or greater than the version represented by v2, according to the given scheme, just like CompareWithSanitization,
+but pre-release identifiers are compared by their position in the given prereleaseOrder.
+The prereleaseOrder is ignored by schemes that don't implement PrereleaseOrderedVersionScheme.
+
+Arguments are as follows:
+
+  - scheme the scheme to check against.
+  - v1 the first version to compare. It may be nil. If it's not a valid version it's considered as nil.
+  - v2 the second version to compare. It may be nil. If it's not a valid version it's considered as nil.
+  - sanitize optionally enables sanitization before parsing versions
+  - prereleaseOrder the pre-release identifiers, from the lowest to the highest precedence. It may be nil.
+*/
+func CompareWithSanitizationAndPrereleaseOrder(scheme Scheme, v1 *string, v2 *string, sanitize bool, prereleaseOrder []string) int {
+	implementation := mustVersionSchemeOf(scheme)
+	var pv1 Version = nil
+	epoch1 := 0
+	if v1 != nil {
+		e, s := SplitEpochWithLenience(*v1, sanitize)
+		v, err := implementation.Parse(s, sanitize)
+		if err == nil {
+			pv1 = v
+			epoch1 = e
+		}
+	}
+	var pv2 Version = nil
+	epoch2 := 0
+	if v2 != nil {
+		e, s := SplitEpochWithLenience(*v2, sanitize)
+		v, err := implementation.Parse(s, sanitize)
+		if err == nil {
+			pv2 = v
+			epoch2 = e
 		}
-		//MAVEN: not yet supported
-	default:
-		// this is never reached, but in case...
-		panic("unknown Scheme. This means the switch/case statement needs to be updated")
+	}
+	if pv1 == nil && pv2 == nil {
+		return 0
+	} else if pv1 == nil {
+		return -1
+	} else if pv2 == nil {
+		return 1
+	} else if epoch1 != epoch2 {
+		return epoch1 - epoch2
+	} else if orderedImplementation, ok := implementation.(PrereleaseOrderedVersionScheme); ok && len(prereleaseOrder) > 0 {
+		return orderedImplementation.CompareWithPrereleaseOrder(pv1, pv2, prereleaseOrder)
+	} else {
+		return implementation.Compare(pv1, pv2)
 	}
 }
 
@@ -159,20 +200,7 @@ Arguments are as follows:
 - scheme the scheme to get the initial version for
 */
 func DefaultInitial(scheme Scheme) Version {
-	switch scheme {
-	case SEMVER:
-		{
-			res, err := ValueOfSemanticVersion(SEMANTIC_VERSION_DEFAULT_INITIAL_VERSION)
-			if err != nil {
-				panic("unable to build a new semantic version from the default initial value")
-			}
-			return res
-		}
-		//MAVEN: not yet supported
-	default:
-		// this is never reached, but in case...
-		panic("unknown Scheme. This means the switch/case statement needs to be updated")
-	}
+	return mustVersionSchemeOf(scheme).DefaultInitial()
 }
 
 /*
@@ -205,24 +233,15 @@ Arguments are as follows:
     strictly legal from the version scheme specification perspective.
 */
 func IsCoreWithLenience(scheme Scheme, s string, lenient bool) bool {
-	switch scheme {
-	case SEMVER:
-		{
-			if !IsLegalSemanticVersionWithLenience(s, lenient) {
-				return false
-			}
-			res, _ := ValueOfSemanticVersionWithSanitization(s, lenient)
-			if res.GetPrerelease() == nil && res.GetBuild() == nil {
-				return true
-			} else {
-				return false
-			}
-		}
-		//MAVEN: not yet supported
-	default:
-		// this is never reached, but in case...
-		panic("unknown Scheme. This means the switch/case statement needs to be updated")
+	implementation := mustVersionSchemeOf(scheme)
+	if !implementation.IsLegal(s, lenient) {
+		return false
+	}
+	res, err := implementation.Parse(s, lenient)
+	if err != nil {
+		return false
 	}
+	return implementation.IsCore(res)
 }
 
 /*
@@ -269,6 +288,7 @@ ValueOf(Scheme, String, boolean) without errors using the implementation selecte
 
 This method is different than IsLegalWithPrefix as it also sanitizes extra characters
 in the body of the version identifier instead of just an optional prefix (when sanitize is true).
+Strings with an epoch are not legal versions unless the epoch is removed first (see SplitEpoch).
 
 Arguments are as follows:
 
@@ -278,16 +298,7 @@ Arguments are as follows:
     strictly legal from the version scheme specification perspective.
 */
 func IsLegalWithLenience(scheme Scheme, s string, lenient bool) bool {
-	switch scheme {
-	case SEMVER:
-		{
-			return IsLegalSemanticVersionWithLenience(s, lenient)
-		}
-		//MAVEN: not yet supported
-	default:
-		// this is never reached, but in case...
-		panic("unknown Scheme. This means the switch/case statement needs to be updated")
-	}
+	return mustVersionSchemeOf(scheme).IsLegal(s, lenient)
 }
 
 /*
@@ -327,19 +338,8 @@ func MostRelevantIdentifierIn(scheme Scheme, identifiers []string) *string {
 		return nil
 	}
 
-	switch scheme {
-	case SEMVER:
-		{
-			orderedList := make([]string, len(identifiers))
-			copy(orderedList, identifiers)
-			sort.Sort(semanticVersionIdentifiers(orderedList))
-			return &orderedList[0]
-		}
-		//MAVEN: not yet supported
-	default:
-		// this is never reached, but in case...
-		panic("unknown Scheme. This means the switch/case statement needs to be updated")
-	}
+	res := mustVersionSchemeOf(scheme).MostRelevantIdentifierIn(identifiers)
+	return &res
 }
 
 /*
@@ -359,18 +359,8 @@ func MostRelevantIdentifierBetween(scheme Scheme, identifier1 *string, identifie
 		return identifier1
 	}
 
-	switch scheme {
-	case SEMVER:
-		{
-			identifiers := []string{*identifier1, *identifier2}
-			sort.Sort(semanticVersionIdentifiers(identifiers))
-			return &identifiers[0]
-		}
-		//MAVEN: not yet supported
-	default:
-		// this is never reached, but in case...
-		panic("unknown Scheme. This means the switch/case statement needs to be updated")
-	}
+	res := mustVersionSchemeOf(scheme).MostRelevantIdentifierIn([]string{*identifier1, *identifier2})
+	return &res
 }
 
 /*
@@ -412,16 +402,7 @@ Errors can be returned if:
 - the given string doesn't represent a legal version, according to the selected scheme
 */
 func ValueOfWithSanitization(scheme Scheme, s string, sanitize bool) (Version, error) {
-	switch scheme {
-	case SEMVER:
-		{
-			return ValueOfSemanticVersionWithSanitization(s, sanitize)
-		}
-		//MAVEN: not yet supported
-	default:
-		// this is never reached, but in case...
-		panic("unknown Scheme. This means the switch/case statement needs to be updated")
-	}
+	return mustVersionSchemeOf(scheme).Parse(s, sanitize)
 }
 
 /*
@@ -120,6 +120,37 @@ func TestVersionsCompareVersionsWithPrefix(t *testing.T) {
 	assert.True(t, CompareWithPrefix(SEMVER, strptr("rel-1.0.0-alpha.9"), strptr("1.0.0-alpha.10"), strptr("rel-")) < 0)
 }
 
+func TestVersionsCompareVersionsWithPrereleaseOrder(t *testing.T) {
+	prereleaseOrder := []string{"dev", "alpha", "beta", "rc"}
+
+	assert.Equal(t, 0, CompareWithPrefixAndPrereleaseOrder(SEMVER, nil, nil, strptr("rel-"), prereleaseOrder))
+	assert.Equal(t, 0, CompareWithPrefixAndPrereleaseOrder(SEMVER, strptr("rel-1.0.0-rc.1"), strptr("1.0.0-rc.1"), strptr("rel-"), prereleaseOrder))
+	assert.True(t, CompareWithPrefixAndPrereleaseOrder(SEMVER, strptr("rel-1.0.0-dev.3"), strptr("1.0.0-alpha.1"), strptr("rel-"), prereleaseOrder) < 0)
+	assert.True(t, CompareWithPrefixAndPrereleaseOrder(SEMVER, strptr("rel-1.0.0-dev.3"), strptr("1.0.0-alpha.1"), strptr("rel-"), nil) > 0)
+	assert.True(t, CompareWithPrefixAndPrereleaseOrder(SEMVER, strptr("1.0.0-rc.1"), strptr("rel-1.0.0-beta.5"), strptr("rel-"), prereleaseOrder) > 0)
+	assert.True(t, CompareWithPrefixAndPrereleaseOrder(SEMVER, strptr("1.0.0-rc.1"), strptr("rel-1.0.0"), strptr("rel-"), prereleaseOrder) < 0)
+
+	assert.Equal(t, 0, CompareWithSanitizationAndPrereleaseOrder(SEMVER, strptr("v1.0.0-dev.1"), strptr("1.0.0-dev.1"), true, prereleaseOrder))
+	assert.True(t, CompareWithSanitizationAndPrereleaseOrder(SEMVER, strptr("v1.0.0-dev.3"), strptr("1.0.0-alpha.1"), true, prereleaseOrder) < 0)
+	assert.True(t, CompareWithSanitizationAndPrereleaseOrder(SEMVER, strptr("v1.0.0-dev.3"), strptr("1.0.0-alpha.1"), true, nil) > 0)
+	assert.True(t, CompareWithSanitizationAndPrereleaseOrder(FOUR_SEGMENT, strptr("1.0.0.0-dev.3"), strptr("1.0.0.0-alpha.1"), false, prereleaseOrder) < 0)
+	assert.True(t, CompareWithSanitizationAndPrereleaseOrder(SEMVER, nil, strptr("1.0.0-dev.1"), false, prereleaseOrder) < 0)
+}
+
+func TestVersionsCompareVersionsWithEpoch(t *testing.T) {
+	assert.Equal(t, 0, Compare(SEMVER, strptr("1!1.0.0"), strptr("1!1.0.0")))
+	assert.Equal(t, 0, Compare(SEMVER, strptr("0!1.0.0"), strptr("1.0.0")))
+	assert.True(t, Compare(SEMVER, strptr("1!1.0.0"), strptr("5.3.0")) > 0)
+	assert.True(t, Compare(SEMVER, strptr("1!1.0.0"), strptr("2!0.1.0")) < 0)
+	assert.True(t, Compare(SEMVER, strptr("1!1.0.1"), strptr("1!1.0.0")) > 0)
+	assert.True(t, Compare(SEMVER, strptr("1!notaversion"), strptr("1.0.0")) < 0)
+	assert.True(t, Compare(SEMVER, strptr("1.0.0-rc1!2.0.0"), strptr("1.0.0")) < 0)
+
+	assert.True(t, CompareWithPrefix(SEMVER, strptr("v1!1.0.0"), strptr("v5.3.0"), strptr("v")) > 0)
+	assert.True(t, CompareWithSanitization(SEMVER, strptr("v1!1.0.0"), strptr("v5.3.0"), true) > 0)
+	assert.True(t, CompareWithSanitization(FOUR_SEGMENT, strptr("2!1.0.0.0"), strptr("1!5.3.0.0"), false) > 0)
+}
+
 func TestVersionsDefaultInitial(t *testing.T) {
 	assert.Equal(t, SEMANTIC_VERSION_DEFAULT_INITIAL_VERSION, DefaultInitial(SEMVER).String())
 }
@@ -186,6 +217,32 @@ func TestVersionsIsCoreSanitizableString(t *testing.T) {
 	}
 }
 
+func TestVersionsIsCoreAndIsLegalWithEpoch(t *testing.T) {
+	// the epoch is not part of the version so strings with an epoch are only legal once it's removed
+	for _, v := range []string{"2!1.4.0", "1.0.0-rc1!2.0.0"} {
+		assert.False(t, IsLegal(SEMVER, v), v)
+		assert.False(t, IsCore(SEMVER, v), v)
+		_, err := ValueOf(SEMVER, v)
+		assert.Error(t, err, v)
+	}
+	assert.False(t, IsLegalWithPrefix(SEMVER, "v2!1.4.0", strptr("v")))
+	assert.False(t, IsCoreWithPrefix(SEMVER, "v2!1.4.0", strptr("v")))
+	_, err := ValueOfWithPrefix(SEMVER, "v2!1.4.0", strptr("v"))
+	assert.Error(t, err)
+
+	_, s := SplitEpoch("2!1.4.0")
+	assert.True(t, IsLegal(SEMVER, s))
+	assert.True(t, IsCore(SEMVER, s))
+	_, err = ValueOf(SEMVER, s)
+	assert.NoError(t, err)
+
+	_, s = SplitEpochWithPrefix("v2!1.4.0-alpha.1", strptr("v"))
+	assert.True(t, IsLegalWithPrefix(SEMVER, s, strptr("v")))
+	assert.False(t, IsCoreWithPrefix(SEMVER, s, strptr("v")))
+	_, err = ValueOfWithPrefix(SEMVER, s, strptr("v"))
+	assert.NoError(t, err)
+}
+
 func TestVersionsIsLegalWithEmptyString(t *testing.T) {
 	assert.False(t, IsLegal(SEMVER, ""))
 }